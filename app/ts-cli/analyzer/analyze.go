@@ -136,5 +136,10 @@ func (a *Analyzer) Print(file immutable.TSSPFile) {
 	printCompressAlgo("Integer", ret.intCompressAlgo)
 	printCompressAlgo("Float", ret.floatCompressAlgo)
 
+	fmt.Println("")
+	fmt.Println("==== Expected Compression Ratio of Each Codec ====")
+	printCodecEstimate("Integer", ret.intCodecEstimate)
+	printCodecEstimate("Float", ret.floatCodecEstimate)
+
 	fmt.Println("")
 }
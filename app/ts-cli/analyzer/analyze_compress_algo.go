@@ -16,7 +16,9 @@ package analyzer
 
 import (
 	"fmt"
+	"sort"
 
+	"github.com/openGemini/openGemini/lib/compress"
 	"github.com/openGemini/openGemini/lib/encoding"
 	"github.com/openGemini/openGemini/lib/numberenc"
 	"github.com/openGemini/openGemini/lib/record"
@@ -151,6 +153,63 @@ func printCompressAlgo(name string, algo map[string]*CompressAlgo) {
 	}
 }
 
+// estimateCodecs encodes the values of the segment with each codec, to report
+// the compression ratio the column would get if its measurement forced the codec
+func (p *ColumnIterator) estimateCodecs(col *record.ColVal, ref *record.Field) {
+	if len(col.Val) == 0 || ref.Name == record.TimeField {
+		return
+	}
+
+	switch ref.Type {
+	case influx.Field_Type_Float:
+		coder := encoding.NewFloat()
+		for _, codec := range append([]string{compress.FloatCodecAuto}, compress.FloatCodecs...) {
+			coder.SetCodec(codec)
+			estimateCodec(p.result.floatCodecEstimate, codec, coder, col.Val)
+		}
+	case influx.Field_Type_Int:
+		coder := encoding.GetIntCoder()
+		for _, codec := range append([]string{encoding.IntCodecAuto}, encoding.IntCodecs...) {
+			coder.SetCodec(codec)
+			estimateCodec(p.result.intCodecEstimate, codec, coder, col.Val)
+		}
+		coder.SetCodec(encoding.IntCodecAuto)
+		encoding.PutDataCoder(coder)
+	default:
+		break
+	}
+}
+
+func estimateCodec(m map[string]*CompressAlgo, codec string, coder encoding.DataCoder, values []byte) {
+	buf, err := coder.Encoding(values, nil)
+	if err != nil {
+		return
+	}
+	if codec == "" {
+		codec = "adaptive"
+	}
+	algo := allocAlgo(m, codec, len(buf))
+	algo.originSize += len(values)
+}
+
+func printCodecEstimate(name string, algo map[string]*CompressAlgo) {
+	if len(algo) == 0 {
+		return
+	}
+
+	fmt.Println("")
+	fmt.Println("--------------- " + name + " --------------")
+	codecs := make([]string, 0, len(algo))
+	for codec := range algo {
+		codecs = append(codecs, codec)
+	}
+	sort.Strings(codecs)
+	for _, codec := range codecs {
+		item := algo[codec]
+		printSizeRatio("  ["+codec+"]", item.compressSize, item.originSize)
+	}
+}
+
 func getTimeAlgo(b byte) string {
 	b = b >> 4
 	switch b {
@@ -178,6 +237,8 @@ func getIntAlgo(b byte) string {
 		return "ZSTD"
 	case 4:
 		return "Uncompressed"
+	case 5:
+		return "FOR"
 	default:
 		return "Unknown"
 	}
@@ -198,6 +259,10 @@ func getFloatAlgo(b byte) string {
 		return "Same"
 	case 5:
 		return "RLE"
+	case 6:
+		return "Chimp"
+	case 7:
+		return "ALP"
 	default:
 		return "Unknown"
 	}
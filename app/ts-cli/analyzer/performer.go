@@ -51,6 +51,7 @@ func (p *ColumnIterator) Handle(col *record.ColVal, times []int64, lastSeg bool)
 	}
 
 	ret.originSize[ref.Type] += len(col.Val)
+	p.estimateCodecs(col, ref)
 
 	if p.ref.IsString() {
 		ret.offsetSize += len(col.Offset) * 4
@@ -33,6 +33,10 @@ type AnalyzeResult struct {
 	intCompressAlgo   map[string]*CompressAlgo
 	floatCompressAlgo map[string]*CompressAlgo
 
+	// expected compression ratio if all the segments are encoded by the same codec
+	intCodecEstimate   map[string]*CompressAlgo
+	floatCodecEstimate map[string]*CompressAlgo
+
 	allNilSegment int
 	noNilSegment  int
 }
@@ -44,5 +48,8 @@ func NewAnalyzeResult() *AnalyzeResult {
 		timeCompressAlgo:  make(map[string]*CompressAlgo),
 		intCompressAlgo:   make(map[string]*CompressAlgo),
 		floatCompressAlgo: make(map[string]*CompressAlgo),
+
+		intCodecEstimate:   make(map[string]*CompressAlgo),
+		floatCodecEstimate: make(map[string]*CompressAlgo),
 	}
 }
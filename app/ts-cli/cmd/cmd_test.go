@@ -94,3 +94,24 @@ func TestExecute(t *testing.T) {
 		t.Errorf("execute error: %v", err)
 	}
 }
+
+func TestDecommissionStatement(t *testing.T) {
+	stmt, err := decommissionStatement([]string{"3"}, false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "DECOMMISSION NODE 3", stmt)
+
+	stmt, err = decommissionStatement([]string{"3"}, true, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "KILL DECOMMISSION NODE 3", stmt)
+
+	stmt, err = decommissionStatement(nil, false, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "SHOW DECOMMISSION", stmt)
+
+	_, err = decommissionStatement(nil, false, false)
+	assert.NotEqual(t, nil, err)
+	_, err = decommissionStatement([]string{"node3"}, false, false)
+	assert.NotEqual(t, nil, err)
+	_, err = decommissionStatement([]string{"3"}, false, true)
+	assert.NotEqual(t, nil, err)
+}
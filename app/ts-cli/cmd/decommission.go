@@ -0,0 +1,85 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	decommissionCancel bool
+	decommissionStatus bool
+)
+
+func init() {
+	rootCmd.AddCommand(decommissionCmd)
+	decommissionCmd.Flags().StringVar(&options.Host, "host", DEFAULT_HOST, "ts-sql host to connect to.")
+	decommissionCmd.Flags().IntVar(&options.Port, "port", DEFAULT_PORT, "ts-sql tcp port to connect to.")
+	decommissionCmd.Flags().StringVarP(&options.Username, "username", "u", "", "Username to connect to openGemini.")
+	decommissionCmd.Flags().StringVarP(&options.Password, "password", "p", "", "Password to connect to openGemini.")
+	decommissionCmd.Flags().BoolVar(&options.Ssl, "ssl", false, "Use https for connecting to openGemini.")
+	decommissionCmd.Flags().BoolVar(&decommissionCancel, "cancel", false, "Cancel the decommission of the ts-store node.")
+	decommissionCmd.Flags().BoolVar(&decommissionStatus, "status", false, "Show the progress of the decommissioned ts-store nodes.")
+}
+
+var decommissionCmd = &cobra.Command{
+	Use:   "decommission [node-id]",
+	Short: "Decommission a ts-store node",
+	Long: `Move all pts of a ts-store node to the other nodes and remove the node from the cluster after the moved pts are verified.
+The node serves its pts until they are moved, and no new pts are placed on it.`,
+	Example: `
+$ ts-cli decommission 3 --host=127.0.0.1 --port=8086
+$ ts-cli decommission 3 --cancel
+$ ts-cli decommission --status`,
+	CompletionOptions: cobra.CompletionOptions{
+		DisableDefaultCmd:   true,
+		DisableDescriptions: true,
+		DisableNoDescFlag:   true,
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		stmt, err := decommissionStatement(args, decommissionCancel, decommissionStatus)
+		if err != nil {
+			return err
+		}
+		if err = connectCLI(); err != nil {
+			return err
+		}
+		return cli.Execute(stmt)
+	},
+}
+
+// decommissionStatement builds the statement executed by ts-sql for the decommission command
+func decommissionStatement(args []string, cancel, status bool) (string, error) {
+	if status {
+		if len(args) > 0 || cancel {
+			return "", fmt.Errorf("--status does not accept a node id or --cancel")
+		}
+		return "SHOW DECOMMISSION", nil
+	}
+	if len(args) != 1 {
+		return "", fmt.Errorf("exactly one node id must be specified")
+	}
+	nodeID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid node id %q: %s", args[0], err)
+	}
+	if cancel {
+		return fmt.Sprintf("KILL DECOMMISSION NODE %d", nodeID), nil
+	}
+	return fmt.Sprintf("DECOMMISSION NODE %d", nodeID), nil
+}
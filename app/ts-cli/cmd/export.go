@@ -0,0 +1,92 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/openGemini/openGemini/app/ts-cli/geminicli"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportOptions = geminicli.ExportConfig{}
+	exportStart   string
+	exportEnd     string
+)
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringVar(&options.Host, "host", DEFAULT_HOST, "ts-sql host to connect to.")
+	exportCmd.Flags().IntVar(&options.Port, "port", DEFAULT_PORT, "ts-sql tcp port to connect to.")
+	exportCmd.Flags().StringVarP(&options.Username, "username", "u", "", "Username to connect to openGemini.")
+	exportCmd.Flags().StringVarP(&options.Password, "password", "p", "", "Password to connect to openGemini.")
+	exportCmd.Flags().BoolVar(&options.Ssl, "ssl", false, "Use https for connecting to openGemini.")
+	exportCmd.Flags().StringVar(&exportOptions.Database, "database", "", "Database to export.")
+	exportCmd.Flags().StringVar(&exportOptions.RetentionPolicy, "retentionPolicy", "", "Retention policy to export, required by the offline export.")
+	exportCmd.Flags().StringVar(&exportOptions.Measurement, "measurement", "", "Measurement to export, all measurements are exported if empty.")
+	exportCmd.Flags().StringVar(&exportOptions.Format, "format", geminicli.ExportFormatLineProtocol, "Output format: line_protocol, csv or parquet.")
+	exportCmd.Flags().StringVar(&exportOptions.Out, "out", "", "Output file of line_protocol, or output directory of csv and parquet.")
+	exportCmd.Flags().StringVar(&exportOptions.DataDir, "data-dir", "", "Data dir of a stopped ts-store, read the shards directly instead of querying ts-sql.")
+	exportCmd.Flags().StringVar(&exportOptions.WalDir, "wal-dir", "", "Wal dir of a stopped ts-store, the rows not yet flushed are read from it. Defaults to the wal dir next to the data dir.")
+	exportCmd.Flags().StringVar(&exportStart, "start", "", "Start time of the export in RFC3339 format, inclusive.")
+	exportCmd.Flags().StringVar(&exportEnd, "end", "", "End time of the export in RFC3339 format, exclusive.")
+	exportCmd.Flags().DurationVar(&exportOptions.Chunk, "chunk", geminicli.DefaultExportChunk, "Time range read per request, the export can be resumed from the last finished chunk. The offline export reads a measurement at once.")
+	exportCmd.Flags().BoolVar(&exportOptions.Resume, "resume", false, "Resume an interrupted export from its checkpoint file.")
+	if err := exportCmd.MarkFlagRequired("database"); err != nil {
+		return
+	}
+	if err := exportCmd.MarkFlagRequired("out"); err != nil {
+		return
+	}
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export data from openGemini",
+	Long:  `Export data to line protocol, csv or parquet files, online through ts-sql or offline from the data dir of ts-store`,
+	Example: `
+$ ts-cli export --database=db0 --out=db0.txt --host=127.0.0.1 --port=8086
+$ ts-cli export --database=db0 --measurement=cpu --format=csv --out=./export --start=2024-01-01T00:00:00Z --end=2024-01-02T00:00:00Z
+$ ts-cli export --database=db0 --retentionPolicy=autogen --format=parquet --out=./export --data-dir=/opt/openGemini/data`,
+	CompletionOptions: cobra.CompletionOptions{
+		DisableDefaultCmd:   true,
+		DisableDescriptions: true,
+		DisableNoDescFlag:   true,
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+		if exportOptions.Start, err = parseExportTime(exportStart, math.MinInt64); err != nil {
+			return err
+		}
+		if exportOptions.End, err = parseExportTime(exportEnd, math.MaxInt64); err != nil {
+			return err
+		}
+		return geminicli.NewExporter().Export(&options, &exportOptions)
+	},
+}
+
+func parseExportTime(s string, def int64) (int64, error) {
+	if s == "" {
+		return def, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, it must be in RFC3339 format: %s", s, err)
+	}
+	return t.UnixNano(), nil
+}
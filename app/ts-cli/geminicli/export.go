@@ -0,0 +1,295 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geminicli
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+)
+
+const (
+	ExportFormatLineProtocol = "line_protocol"
+	ExportFormatCSV          = "csv"
+	ExportFormatParquet      = "parquet"
+
+	DefaultExportChunk = time.Hour
+
+	checkpointSuffix = ".checkpoint"
+)
+
+// ExportConfig is the config of the export cmd
+type ExportConfig struct {
+	Database        string
+	RetentionPolicy string
+	Measurement     string
+	Format          string
+	// Out is the output file of line protocol exports, and the output directory
+	// of csv and parquet exports which write one file per measurement
+	Out string
+	// DataDir enables the offline export which reads the shards of a stopped ts-store
+	DataDir string
+	// WalDir is the wal dir of the stopped ts-store, the wal dir next to DataDir if empty
+	WalDir string
+	Start   int64
+	End     int64
+	Chunk   time.Duration
+	Resume  bool
+}
+
+// seriesBatch is the rows of one series, exported by a source and written by an exportWriter
+type seriesBatch struct {
+	measurement string
+	tags        [][2]string
+	fields      []string
+	fieldTypes  []int
+	times       []int64
+	// values[i][j] is the value of fields[j] at times[i], nil if absent
+	values [][]interface{}
+}
+
+// exportSource reads the rows of a measurement within [start, end)
+type exportSource interface {
+	Measurements() ([]string, error)
+	Schema(mst string) (tags []string, fields []string, fieldTypes []int, err error)
+	// TimeRange returns the min and max time of the measurement, ok is false if it has no data
+	TimeRange(mst string) (min, max int64, ok bool, err error)
+	Read(mst string, start, end int64, fn func(b *seriesBatch) error) error
+	Close() error
+}
+
+type exportWriter interface {
+	BeginMeasurement(mst string, tags, fields []string, fieldTypes []int) error
+	WriteBatch(b *seriesBatch) error
+	// EndMeasurement is called once all windows of the measurement are written
+	EndMeasurement() error
+	// Offsets returns the committed size of every output file, used for resuming
+	Offsets() (map[string]int64, error)
+	Close() error
+}
+
+// exportCheckpoint records the progress of an export, so that an interrupted export
+// can be resumed with the Resume option. Output written after the checkpoint is discarded.
+type exportCheckpoint struct {
+	Done    []string         `json:"done"`
+	Current string           `json:"current"`
+	Next    int64            `json:"next"`
+	Offsets map[string]int64 `json:"offsets"`
+}
+
+func (c *exportCheckpoint) isDone(mst string) bool {
+	for _, m := range c.Done {
+		if m == mst {
+			return true
+		}
+	}
+	return false
+}
+
+// Exporter is the exporter used for exporting data, it is the counterpart of Importer
+type Exporter struct {
+	clientCreator HttpClientCreator
+	source        exportSource
+	writer        exportWriter
+	checkpoint    *exportCheckpoint
+	cpPath        string
+
+	totalRows    int
+	stdoutLogger *log.Logger
+}
+
+func NewExporter() *Exporter {
+	return &Exporter{
+		clientCreator: defaultHttpClientCreator,
+		stdoutLogger:  log.New(os.Stdout, "", log.LstdFlags),
+	}
+}
+
+func (e *Exporter) Export(clc *CommandLineConfig, ec *ExportConfig) error {
+	if err := validateExportConfig(ec); err != nil {
+		return err
+	}
+
+	var err error
+	if ec.DataDir != "" {
+		if ec.WalDir == "" {
+			ec.WalDir = filepath.Join(filepath.Dir(filepath.Clean(ec.DataDir)), config.WalDirectory)
+		}
+		// the offline source reads the files of a measurement in one pass, a time window per
+		// measurement avoids reading them again for every window
+		ec.Chunk = math.MaxInt64
+		e.source, err = newOfflineSource(ec.DataDir, ec.WalDir, ec.Database, ec.RetentionPolicy)
+	} else {
+		e.source, err = e.newOnlineSource(clc, ec)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = e.source.Close()
+	}()
+
+	e.cpPath = ec.Out + checkpointSuffix
+	e.checkpoint = &exportCheckpoint{}
+	if ec.Resume {
+		if err = readCheckpoint(e.cpPath, e.checkpoint); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	e.writer, err = newExportWriter(ec, e.checkpoint.Offsets)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = e.writer.Close()
+	}()
+
+	msts, err := e.source.Measurements()
+	if err != nil {
+		return err
+	}
+	for _, mst := range msts {
+		if ec.Measurement != "" && mst != ec.Measurement {
+			continue
+		}
+		if e.checkpoint.isDone(mst) {
+			continue
+		}
+		if err = e.exportMeasurement(mst, ec); err != nil {
+			return err
+		}
+	}
+	e.stdoutLogger.Printf("Exported %d rows to %s\n", e.totalRows, ec.Out)
+	if err = os.Remove(e.cpPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (e *Exporter) exportMeasurement(mst string, ec *ExportConfig) error {
+	tags, fields, fieldTypes, err := e.source.Schema(mst)
+	if err != nil {
+		return err
+	}
+	if err = e.writer.BeginMeasurement(mst, tags, fields, fieldTypes); err != nil {
+		return err
+	}
+
+	minTime, maxTime, ok, err := e.source.TimeRange(mst)
+	if err != nil {
+		return err
+	}
+	start, stop := ec.Start, ec.End
+	if !ok {
+		stop = start
+	}
+	if minTime > start {
+		start = minTime
+	}
+	if maxTime < math.MaxInt64 && maxTime+1 < stop {
+		stop = maxTime + 1
+	}
+	// parquet files can not be appended, an unfinished measurement is exported again
+	if e.checkpoint.Current == mst && ec.Format != ExportFormatParquet && e.checkpoint.Next > start {
+		start = e.checkpoint.Next
+	}
+	for start < stop {
+		end := start + int64(ec.Chunk)
+		if end > stop || end < start {
+			end = stop
+		}
+		err = e.source.Read(mst, start, end, func(b *seriesBatch) error {
+			e.totalRows += len(b.times)
+			return e.writer.WriteBatch(b)
+		})
+		if err != nil {
+			return err
+		}
+		if err = e.saveCheckpoint(mst, end); err != nil {
+			return err
+		}
+		start = end
+	}
+
+	if err = e.writer.EndMeasurement(); err != nil {
+		return err
+	}
+	e.checkpoint.Done = append(e.checkpoint.Done, mst)
+	return e.saveCheckpoint("", 0)
+}
+
+func (e *Exporter) saveCheckpoint(mst string, next int64) error {
+	offsets, err := e.writer.Offsets()
+	if err != nil {
+		return err
+	}
+	e.checkpoint.Current = mst
+	e.checkpoint.Next = next
+	e.checkpoint.Offsets = offsets
+
+	content, err := json.Marshal(e.checkpoint)
+	if err != nil {
+		return err
+	}
+	tmp := e.cpPath + ".tmp"
+	if err = os.WriteFile(tmp, content, 0640); err != nil {
+		return err
+	}
+	return os.Rename(tmp, e.cpPath)
+}
+
+func readCheckpoint(path string, cp *exportCheckpoint) error {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(buf, cp)
+}
+
+func validateExportConfig(ec *ExportConfig) error {
+	if ec.Database == "" {
+		return fmt.Errorf("execute export cmd, -database is required")
+	}
+	if ec.Out == "" {
+		return fmt.Errorf("execute export cmd, -out is required")
+	}
+	switch ec.Format {
+	case "":
+		ec.Format = ExportFormatLineProtocol
+	case ExportFormatLineProtocol, ExportFormatCSV, ExportFormatParquet:
+	default:
+		return fmt.Errorf("unknown export format %q. format must be line_protocol, csv or parquet", ec.Format)
+	}
+	if ec.RetentionPolicy == "" && ec.DataDir != "" {
+		return fmt.Errorf("execute offline export cmd, -retentionPolicy is required")
+	}
+	if ec.End == 0 {
+		ec.End = math.MaxInt64
+	}
+	if ec.Start >= ec.End {
+		return fmt.Errorf("invalid time range [%d, %d)", ec.Start, ec.End)
+	}
+	if ec.Chunk <= 0 {
+		ec.Chunk = DefaultExportChunk
+	}
+	return nil
+}
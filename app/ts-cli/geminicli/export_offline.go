@@ -0,0 +1,664 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geminicli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/openGemini/openGemini/engine"
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/index"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// offlineFile is a TSSP file of a shard
+type offlineFile struct {
+	path  string
+	mst   string
+	order bool
+}
+
+// offlineShard is a shard of a stopped ts-store. Its TSSP files are kept per measurement with the
+// ordered files before the out-of-order files, and the rows not yet flushed are replayed from its wal
+type offlineShard struct {
+	indexPath string
+	files     map[string][]offlineFile
+	wal       map[string]map[string]*offlineSeries
+}
+
+// offlineSource reads the TSSP files and the wal of a stopped ts-store directly from its data dir.
+// Each measurement is read in a single pass over its files: the series of the files of a shard are
+// merged by series id, and a row read later overwrites the fields of the row with the same time,
+// so the newer files win over the older ones and the wal wins over the files
+type offlineSource struct {
+	shards  []*offlineShard
+	lock    string
+	indexes map[string]*tsi.MergeSetIndex
+	series  map[string]map[uint64][][2]string
+}
+
+func newOfflineSource(dataDir, walDir, db, rp string) (*offlineSource, error) {
+	s := &offlineSource{
+		indexes: make(map[string]*tsi.MergeSetIndex),
+		series:  make(map[string]map[uint64][][2]string),
+	}
+
+	dbDir := filepath.Join(dataDir, config.DataDirectory, db)
+	pts, err := os.ReadDir(dbDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, pt := range pts {
+		if !pt.IsDir() {
+			continue
+		}
+		walRpDir := filepath.Join(walDir, config.WalDirectory, db, pt.Name(), rp)
+		if err = s.scanRP(filepath.Join(dbDir, pt.Name(), rp), walRpDir); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *offlineSource) scanRP(rpDir, walRpDir string) error {
+	shards, err := os.ReadDir(rpDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	indexDirs := make(map[string]string)
+	if dirs, err := os.ReadDir(filepath.Join(rpDir, config.IndexFileDirectory)); err == nil {
+		for _, d := range dirs {
+			// index dir name: indexID_startTime_endTime
+			indexDirs[strings.Split(d.Name(), "_")[0]] = filepath.Join(rpDir, config.IndexFileDirectory, d.Name())
+		}
+	}
+
+	for _, sh := range shards {
+		// shard dir name: shardID_startTime_endTime_indexID
+		items := strings.Split(sh.Name(), "_")
+		if !sh.IsDir() || len(items) != 4 {
+			continue
+		}
+		indexPath, ok := indexDirs[items[3]]
+		if !ok {
+			continue
+		}
+		shard := &offlineShard{
+			indexPath: indexPath,
+			files:     make(map[string][]offlineFile),
+			wal:       make(map[string]map[string]*offlineSeries),
+		}
+		tsspDir := filepath.Join(rpDir, sh.Name(), immutable.TsspDirName)
+		if msts, err := os.ReadDir(tsspDir); err == nil {
+			for _, mst := range msts {
+				if !mst.IsDir() {
+					continue
+				}
+				name := influx.GetOriginMstName(mst.Name())
+				mstDir := filepath.Join(tsspDir, mst.Name())
+				shard.appendFiles(mstDir, name, true)
+				shard.appendFiles(filepath.Join(mstDir, "out-of-order"), name, false)
+			}
+		}
+		if err = shard.replayWal(filepath.Join(walRpDir, sh.Name())); err != nil {
+			return err
+		}
+		s.shards = append(s.shards, shard)
+	}
+	return nil
+}
+
+func (sh *offlineShard) appendFiles(dir, mst string, order bool) {
+	names, _ := filepath.Glob(filepath.Join(dir, "*.tssp"))
+	sort.Strings(names)
+	for _, name := range names {
+		sh.files[mst] = append(sh.files[mst], offlineFile{path: name, mst: mst, order: order})
+	}
+}
+
+// replayWal keeps the rows of the wal in memory, they are bounded by the size of the mem table
+func (sh *offlineShard) replayWal(walPath string) error {
+	return engine.ReplayWalDir(walPath, func(rows []influx.Row) error {
+		for i := range rows {
+			row := &rows[i]
+			mst := influx.GetOriginMstName(row.Name)
+			tags := make([][2]string, 0, len(row.Tags))
+			for _, tag := range row.Tags {
+				tags = append(tags, [2]string{tag.Key, tag.Value})
+			}
+			sort.Slice(tags, func(i, j int) bool {
+				return tags[i][0] < tags[j][0]
+			})
+
+			series, ok := sh.wal[mst]
+			if !ok {
+				series = make(map[string]*offlineSeries)
+				sh.wal[mst] = series
+			}
+			key := offlineSeriesKey(tags)
+			ser, ok := series[key]
+			if !ok {
+				ser = newOfflineSeries(tags)
+				series[key] = ser
+			}
+			for _, f := range row.Fields {
+				ser.set(row.Timestamp, f.Key, int(f.Type), fieldValue(&f))
+			}
+		}
+		return nil
+	})
+}
+
+func fieldValue(f *influx.Field) interface{} {
+	switch f.Type {
+	case influx.Field_Type_Float:
+		return f.NumValue
+	case influx.Field_Type_Int:
+		return int64(f.NumValue)
+	case influx.Field_Type_Boolean:
+		return f.NumValue == 1
+	case influx.Field_Type_String:
+		return strings.Clone(f.StrValue)
+	default:
+		return nil
+	}
+}
+
+func (s *offlineSource) Measurements() ([]string, error) {
+	seen := make(map[string]struct{})
+	var msts []string
+	add := func(mst string) {
+		if _, ok := seen[mst]; !ok {
+			seen[mst] = struct{}{}
+			msts = append(msts, mst)
+		}
+	}
+	for _, sh := range s.shards {
+		for mst := range sh.files {
+			add(mst)
+		}
+		for mst := range sh.wal {
+			add(mst)
+		}
+	}
+	sort.Strings(msts)
+	return msts, nil
+}
+
+func (s *offlineSource) openIndex(path string) (*tsi.MergeSetIndex, error) {
+	if idx, ok := s.indexes[path]; ok {
+		return idx, nil
+	}
+	opts := new(tsi.Options).
+		Path(path).
+		IndexType(index.MergeSet).
+		EngineType(config.TSSTORE).
+		Lock(&s.lock)
+	idx, err := tsi.NewMergeSetIndex(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err = idx.Open(); err != nil {
+		return nil, err
+	}
+	s.indexes[path] = idx
+	return idx, nil
+}
+
+func (s *offlineSource) seriesTags(indexPath string, sid uint64) ([][2]string, error) {
+	cache, ok := s.series[indexPath]
+	if !ok {
+		cache = make(map[uint64][][2]string)
+		s.series[indexPath] = cache
+	}
+	if tags, ok := cache[sid]; ok {
+		return tags, nil
+	}
+	idx, err := s.openIndex(indexPath)
+	if err != nil {
+		return nil, err
+	}
+	var tags [][2]string
+	err = idx.GetSeries(sid, nil, nil, func(key *influx.SeriesKey) {
+		tags = tags[:0]
+		for _, kv := range key.TagSet {
+			tags = append(tags, [2]string{string(kv.Key), string(kv.Value)})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	cache[sid] = tags
+	return tags, nil
+}
+
+// Schema walks the chunk metas of all files, which is cheap compared with reading the data
+func (s *offlineSource) Schema(mst string) ([]string, []string, []int, error) {
+	tagSet := make(map[string]struct{})
+	fieldSet := make(map[string]int)
+	for _, sh := range s.shards {
+		err := s.walkFiles(sh, mst, func(_ *offlineFile, file immutable.TSSPFile) error {
+			fi := immutable.NewFileIterator(file, logger.NewLogger(errno.ModuleUnknown))
+			defer fi.Close()
+			itr := immutable.NewColumnIterator(fi)
+			defer itr.Close()
+			for cm := fi.GetCurtChunkMeta(); cm != nil; cm = fi.GetCurtChunkMeta() {
+				for _, col := range cm.GetColMeta() {
+					if !col.IsTime() {
+						fieldSet[col.Name()] = int(col.Type())
+					}
+				}
+				tags, err := s.seriesTags(sh.indexPath, cm.GetSid())
+				if err != nil {
+					return err
+				}
+				for _, kv := range tags {
+					tagSet[kv[0]] = struct{}{}
+				}
+				if !itr.NextChunkMeta() {
+					break
+				}
+			}
+			return itr.Error()
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for _, ser := range sh.wal[mst] {
+			for _, kv := range ser.tags {
+				tagSet[kv[0]] = struct{}{}
+			}
+			for k, typ := range ser.fields {
+				fieldSet[k] = typ
+			}
+		}
+	}
+
+	tags := make([]string, 0, len(tagSet))
+	for k := range tagSet {
+		tags = append(tags, k)
+	}
+	sort.Strings(tags)
+	fields := make([]string, 0, len(fieldSet))
+	for k := range fieldSet {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+	types := make([]int, len(fields))
+	for i, k := range fields {
+		types[i] = fieldSet[k]
+	}
+	return tags, fields, types, nil
+}
+
+func (s *offlineSource) TimeRange(mst string) (int64, int64, bool, error) {
+	var minTime, maxTime int64
+	found := false
+	update := func(min, max int64) {
+		if !found || min < minTime {
+			minTime = min
+		}
+		if !found || max > maxTime {
+			maxTime = max
+		}
+		found = true
+	}
+	for _, sh := range s.shards {
+		err := s.walkFiles(sh, mst, func(_ *offlineFile, file immutable.TSSPFile) error {
+			min, max, err := file.MinMaxTime()
+			if err != nil {
+				return err
+			}
+			update(min, max)
+			return nil
+		})
+		if err != nil {
+			return 0, 0, false, err
+		}
+		for _, ser := range sh.wal[mst] {
+			for tm := range ser.rows {
+				update(tm, tm)
+			}
+		}
+	}
+	return minTime, maxTime, found, nil
+}
+
+// Read reads every file of the measurement once. The exporter reads the measurement of an offline
+// source in a single window, see Exporter.Export
+func (s *offlineSource) Read(mst string, start, end int64, fn func(b *seriesBatch) error) error {
+	tr := util.TimeRange{Min: start, Max: end - 1}
+	for _, sh := range s.shards {
+		if err := s.readShard(sh, mst, tr, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *offlineSource) readShard(sh *offlineShard, mst string, tr util.TimeRange, fn func(b *seriesBatch) error) error {
+	var cursors []*offlineCursor
+	defer func() {
+		for _, c := range cursors {
+			c.close()
+		}
+	}()
+	for _, f := range sh.files[mst] {
+		c, err := newOfflineCursor(f, tr)
+		if err != nil {
+			return err
+		}
+		if c != nil {
+			cursors = append(cursors, c)
+		}
+	}
+
+	// the series of the wal which are also in the files are merged with them
+	wal := sh.wal[mst]
+	merged := make(map[string]struct{})
+	for {
+		sid, ok := uint64(0), false
+		for _, c := range cursors {
+			if !c.done && (!ok || c.sid() < sid) {
+				sid, ok = c.sid(), true
+			}
+		}
+		if !ok {
+			break
+		}
+
+		tags, err := s.seriesTags(sh.indexPath, sid)
+		if err != nil {
+			return err
+		}
+		ser := newOfflineSeries(tags)
+		// cursors are in the order of the files, a later file overwrites an earlier one
+		for _, c := range cursors {
+			if c.done || c.sid() != sid {
+				continue
+			}
+			if err = c.read(ser); err != nil {
+				return err
+			}
+		}
+		key := offlineSeriesKey(tags)
+		if w, ok := wal[key]; ok {
+			ser.merge(w)
+			merged[key] = struct{}{}
+		}
+		if err = ser.emit(mst, tr, fn); err != nil {
+			return err
+		}
+	}
+
+	keys := make([]string, 0, len(wal))
+	for key := range wal {
+		if _, ok := merged[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := wal[key].emit(mst, tr, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *offlineSource) walkFiles(sh *offlineShard, mst string, fn func(f *offlineFile, file immutable.TSSPFile) error) error {
+	for _, f := range sh.files[mst] {
+		file, err := openOfflineFile(&f)
+		if err != nil {
+			return err
+		}
+		err = fn(&f, file)
+		util.MustClose(file)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func openOfflineFile(f *offlineFile) (immutable.TSSPFile, error) {
+	lockPath := ""
+	file, err := immutable.OpenTSSPFile(f.path, &lockPath, f.order, false)
+	if err != nil {
+		return nil, fmt.Errorf("open %s failed: %w", f.path, err)
+	}
+	return file, nil
+}
+
+func (s *offlineSource) Close() error {
+	for _, idx := range s.indexes {
+		_ = idx.Close()
+	}
+	return nil
+}
+
+// offlineCursor walks the series of a TSSP file in the order of series id
+type offlineCursor struct {
+	file immutable.TSSPFile
+	fi   *immutable.FileIterator
+	itr  *immutable.ColumnIterator
+	p    offlinePerformer
+	done bool
+}
+
+// newOfflineCursor returns nil if the file has no data within tr
+func newOfflineCursor(f offlineFile, tr util.TimeRange) (*offlineCursor, error) {
+	file, err := openOfflineFile(&f)
+	if err != nil {
+		return nil, err
+	}
+	min, max, err := file.MinMaxTime()
+	if err != nil || !tr.Overlaps(min, max) {
+		util.MustClose(file)
+		return nil, err
+	}
+	c := &offlineCursor{file: file}
+	c.fi = immutable.NewFileIterator(file, logger.NewLogger(errno.ModuleUnknown))
+	c.itr = immutable.NewColumnIterator(c.fi)
+	c.done = c.fi.GetCurtChunkMeta() == nil
+	return c, nil
+}
+
+func (c *offlineCursor) sid() uint64 {
+	return c.fi.GetCurtChunkMeta().GetSid()
+}
+
+// read merges the current series into ser and moves to the next series
+func (c *offlineCursor) read(ser *offlineSeries) error {
+	if err := c.itr.IterCurrentChunk(&c.p); err != nil {
+		return err
+	}
+	c.p.mergeInto(ser)
+	if !c.itr.NextChunkMeta() {
+		c.done = true
+	}
+	return c.itr.Error()
+}
+
+func (c *offlineCursor) close() {
+	c.itr.Close()
+	c.fi.Close()
+	util.MustClose(c.file)
+}
+
+// offlinePerformer collects the columns of one series of a TSSP file
+type offlinePerformer struct {
+	times []int64
+	ref   record.Field
+	cols  []record.ColVal
+	refs  []record.Field
+}
+
+func (p *offlinePerformer) Handle(col *record.ColVal, _ []int64, _ bool) error {
+	cv := &p.cols[len(p.cols)-1]
+	cv.AppendColVal(col, p.ref.Type, 0, col.Len)
+	return nil
+}
+
+func (p *offlinePerformer) HasSeries(uint64) bool {
+	return true
+}
+
+func (p *offlinePerformer) ColumnChanged(ref *record.Field) error {
+	p.ref = *ref
+	p.refs = append(p.refs, *ref)
+	p.cols = append(p.cols, record.ColVal{})
+	return nil
+}
+
+func (p *offlinePerformer) SeriesChanged(_ uint64, times []int64) error {
+	p.times = append(p.times[:0], times...)
+	p.refs = p.refs[:0]
+	p.cols = p.cols[:0]
+	return nil
+}
+
+func (p *offlinePerformer) WriteOriginal(*immutable.FileIterator) error {
+	return nil
+}
+
+func (p *offlinePerformer) mergeInto(ser *offlineSeries) {
+	for i, tm := range p.times {
+		for j := range p.refs {
+			if v := colValue(&p.cols[j], p.refs[j].Type, i); v != nil {
+				ser.set(tm, p.refs[j].Name, p.refs[j].Type, v)
+			}
+		}
+	}
+}
+
+// offlineSeries is the rows of a series merged from the files and the wal of a shard
+type offlineSeries struct {
+	tags   [][2]string
+	fields map[string]int
+	rows   map[int64]map[string]interface{}
+}
+
+func newOfflineSeries(tags [][2]string) *offlineSeries {
+	return &offlineSeries{
+		tags:   tags,
+		fields: make(map[string]int),
+		rows:   make(map[int64]map[string]interface{}),
+	}
+}
+
+func offlineSeriesKey(tags [][2]string) string {
+	var sb strings.Builder
+	for _, kv := range tags {
+		sb.WriteString(kv[0])
+		sb.WriteByte(0)
+		sb.WriteString(kv[1])
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
+
+// set overwrites the field of the row at tm, the other fields of the row are kept
+func (ser *offlineSeries) set(tm int64, field string, typ int, v interface{}) {
+	if v == nil {
+		return
+	}
+	row, ok := ser.rows[tm]
+	if !ok {
+		row = make(map[string]interface{})
+		ser.rows[tm] = row
+	}
+	row[field] = v
+	ser.fields[field] = typ
+}
+
+func (ser *offlineSeries) merge(newer *offlineSeries) {
+	for tm, row := range newer.rows {
+		for field, v := range row {
+			ser.set(tm, field, newer.fields[field], v)
+		}
+	}
+}
+
+func (ser *offlineSeries) emit(mst string, tr util.TimeRange, fn func(b *seriesBatch) error) error {
+	b := &seriesBatch{measurement: mst, tags: ser.tags}
+	for field := range ser.fields {
+		b.fields = append(b.fields, field)
+	}
+	sort.Strings(b.fields)
+	for _, field := range b.fields {
+		b.fieldTypes = append(b.fieldTypes, ser.fields[field])
+	}
+	for tm := range ser.rows {
+		if tm >= tr.Min && tm <= tr.Max {
+			b.times = append(b.times, tm)
+		}
+	}
+	if len(b.times) == 0 {
+		return nil
+	}
+	sort.Slice(b.times, func(i, j int) bool {
+		return b.times[i] < b.times[j]
+	})
+	for _, tm := range b.times {
+		row := ser.rows[tm]
+		values := make([]interface{}, len(b.fields))
+		for j, field := range b.fields {
+			values[j] = row[field]
+		}
+		b.values = append(b.values, values)
+	}
+	return fn(b)
+}
+
+func colValue(cv *record.ColVal, typ int, i int) interface{} {
+	if i >= cv.Len {
+		return nil
+	}
+	var v interface{}
+	var isNil bool
+	switch typ {
+	case influx.Field_Type_Float:
+		v, isNil = cv.FloatValue(i)
+	case influx.Field_Type_Int:
+		v, isNil = cv.IntegerValue(i)
+	case influx.Field_Type_Boolean:
+		v, isNil = cv.BooleanValue(i)
+	case influx.Field_Type_String:
+		var str string
+		str, isNil = cv.StringValueUnsafe(i)
+		v = strings.Clone(str)
+	default:
+		return nil
+	}
+	if isNil {
+		return nil
+	}
+	return v
+}
@@ -0,0 +1,146 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geminicli
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/openGemini/openGemini/engine"
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/index"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+const offlineTestMst = "cpu_0000"
+
+func offlineTestRow(tm int64, value float64) influx.Row {
+	row := influx.Row{
+		Name:      offlineTestMst,
+		Tags:      influx.PointTags{{Key: "host", Value: "h1"}},
+		Fields:    influx.Fields{{Key: "value", NumValue: value, Type: influx.Field_Type_Float}},
+		Timestamp: tm,
+	}
+	row.UnmarshalIndexKeys(nil)
+	return row
+}
+
+func createOfflineIndex(t *testing.T, indexPath string) uint64 {
+	lockPath := ""
+	ident := &meta.IndexIdentifier{OwnerDb: "db0", Policy: "rp0", Index: &meta.IndexDescriptor{IndexID: 1}}
+	opts := new(tsi.Options).
+		Path(indexPath).
+		Ident(ident).
+		IndexType(index.MergeSet).
+		EngineType(config.TSSTORE).
+		LogicalClock(1).
+		SequenceId(new(uint64)).
+		Lock(&lockPath)
+	idx, err := tsi.NewMergeSetIndex(opts)
+	require.NoError(t, err)
+	idx.SetIndexBuilder(tsi.NewIndexBuilder(opts))
+	require.NoError(t, idx.Open())
+	row := offlineTestRow(0, 0)
+	sid, err := idx.CreateIndexIfNotExistsByRow(&row)
+	require.NoError(t, err)
+	require.NoError(t, idx.Close())
+	return sid
+}
+
+func writeOfflineFile(t *testing.T, dir string, seq uint64, order bool, sid uint64, times []int64, values []float64) {
+	lockPath := ""
+	fileName := immutable.NewTSSPFileName(seq, 0, 0, 0, order, &lockPath)
+	builder := immutable.NewMsBuilder(dir, offlineTestMst, &lockPath, immutable.GetTsStoreConfig(), 1, fileName, 1, nil, 2, config.TSSTORE, nil, 0)
+	rec := record.NewRecordBuilder(record.Schemas{
+		record.Field{Type: influx.Field_Type_Float, Name: "value"},
+		record.Field{Type: influx.Field_Type_Int, Name: record.TimeField},
+	})
+	rec.Column(0).AppendFloats(values...)
+	rec.AppendTime(times...)
+	require.NoError(t, builder.WriteData(sid, rec))
+	file, err := builder.NewTSSPFile(false)
+	require.NoError(t, err)
+	path := file.Path()
+	require.NoError(t, file.Close())
+	if name := strings.TrimSuffix(path, ".init"); name != path {
+		require.NoError(t, os.Rename(path, name))
+	}
+}
+
+func writeOfflineWal(t *testing.T, dir string, rows []influx.Row) {
+	buf, err := influx.FastMarshalMultiRows(nil, rows)
+	require.NoError(t, err)
+	comp := snappy.Encode(nil, buf)
+	head := make([]byte, engine.WalRecordHeadSize)
+	head[0] = engine.WriteWalLineProtocol
+	binary.BigEndian.PutUint32(head[1:], uint32(len(comp)))
+	require.NoError(t, os.MkdirAll(dir, 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1.wal"), append(head, comp...), 0640))
+}
+
+func TestOfflineSource_MergeFilesAndWal(t *testing.T) {
+	root := t.TempDir()
+	dataDir := filepath.Join(root, "data")
+	rpDir := filepath.Join(dataDir, config.DataDirectory, "db0", "0", "rp0")
+	sid := createOfflineIndex(t, filepath.Join(rpDir, config.IndexFileDirectory, "1_0_100"))
+
+	tsspDir := filepath.Join(rpDir, "1_0_100_1", immutable.TsspDirName)
+	writeOfflineFile(t, tsspDir, 1, true, sid, []int64{1, 2, 3}, []float64{1, 2, 3})
+	// overwrites the ordered file at 2
+	writeOfflineFile(t, tsspDir, 2, false, sid, []int64{2, 4}, []float64{20, 40})
+	// the rows not flushed overwrite the files at 4
+	walDir := filepath.Join(root, config.WalDirectory)
+	writeOfflineWal(t, filepath.Join(walDir, config.WalDirectory, "db0", "0", "rp0", "1_0_100_1", "0"),
+		[]influx.Row{offlineTestRow(4, 400), offlineTestRow(5, 500)})
+
+	s, err := newOfflineSource(dataDir, walDir, "db0", "rp0")
+	require.NoError(t, err)
+	defer s.Close()
+
+	msts, err := s.Measurements()
+	require.NoError(t, err)
+	require.Equal(t, []string{"cpu"}, msts)
+	tags, fields, _, err := s.Schema("cpu")
+	require.NoError(t, err)
+	require.Equal(t, []string{"host"}, tags)
+	require.Equal(t, []string{"value"}, fields)
+	min, max, ok, err := s.TimeRange("cpu")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []int64{1, 5}, []int64{min, max})
+
+	var times []int64
+	var values []interface{}
+	err = s.Read("cpu", 0, 100, func(b *seriesBatch) error {
+		require.Equal(t, [][2]string{{"host", "h1"}}, b.tags)
+		times = append(times, b.times...)
+		for _, v := range b.values {
+			values = append(values, v[0])
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, times)
+	require.Equal(t, []interface{}{1.0, 20.0, 3.0, 400.0, 500.0}, values)
+}
@@ -0,0 +1,261 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geminicli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/influxdata/influxdb/client"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const exportChunkSize = 10000
+
+// onlineSource reads the data through the query path of ts-sql
+type onlineSource struct {
+	client   HttpClient
+	database string
+	rp       string
+
+	fieldTypes map[string]int
+}
+
+func (e *Exporter) newOnlineSource(clc *CommandLineConfig, ec *ExportConfig) (*onlineSource, error) {
+	clc.Precision = "ns"
+	config, err := parseClientConfig(clc)
+	if err != nil {
+		return nil, err
+	}
+	cli, err := e.clientCreator(*config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create client %s", err)
+	}
+	if _, _, err = cli.Ping(); err != nil {
+		return nil, err
+	}
+	return &onlineSource{client: cli, database: ec.Database, rp: ec.RetentionPolicy}, nil
+}
+
+func (s *onlineSource) query(command string, chunked bool) ([]models.Row, error) {
+	q := client.Query{Command: command, Database: s.database, RetentionPolicy: s.rp}
+	if chunked {
+		q.Chunked = true
+		q.ChunkSize = exportChunkSize
+	}
+	resp, err := s.client.QueryContext(context.Background(), q)
+	if err != nil {
+		return nil, err
+	}
+	if err = resp.Error(); err != nil {
+		return nil, err
+	}
+	var rows []models.Row
+	for _, r := range resp.Results {
+		rows = append(rows, r.Series...)
+	}
+	return rows, nil
+}
+
+func (s *onlineSource) source(mst string) string {
+	if s.rp == "" {
+		return influxql.QuoteIdent(mst)
+	}
+	return influxql.QuoteIdent(s.rp, mst)
+}
+
+func (s *onlineSource) Measurements() ([]string, error) {
+	rows, err := s.query("SHOW MEASUREMENTS", false)
+	if err != nil {
+		return nil, err
+	}
+	var msts []string
+	for _, row := range rows {
+		for _, v := range row.Values {
+			if name, ok := v[0].(string); ok {
+				msts = append(msts, name)
+			}
+		}
+	}
+	sort.Strings(msts)
+	return msts, nil
+}
+
+func (s *onlineSource) Schema(mst string) ([]string, []string, []int, error) {
+	rows, err := s.query("SHOW TAG KEYS FROM "+s.source(mst), false)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var tags []string
+	for _, row := range rows {
+		for _, v := range row.Values {
+			if key, ok := v[0].(string); ok {
+				tags = append(tags, key)
+			}
+		}
+	}
+
+	rows, err = s.query("SHOW FIELD KEYS FROM "+s.source(mst), false)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var fields []string
+	var types []int
+	s.fieldTypes = make(map[string]int)
+	for _, row := range rows {
+		for _, v := range row.Values {
+			key, ok1 := v[0].(string)
+			typ, ok2 := v[1].(string)
+			if !ok1 || !ok2 {
+				continue
+			}
+			fields = append(fields, key)
+			types = append(types, fieldTypeByName(typ))
+			s.fieldTypes[key] = fieldTypeByName(typ)
+		}
+	}
+	return tags, fields, types, nil
+}
+
+func (s *onlineSource) TimeRange(mst string) (int64, int64, bool, error) {
+	first, ok, err := s.boundaryTime(mst, "ASC")
+	if err != nil || !ok {
+		return 0, 0, ok, err
+	}
+	last, ok, err := s.boundaryTime(mst, "DESC")
+	return first, last, ok, err
+}
+
+func (s *onlineSource) boundaryTime(mst, order string) (int64, bool, error) {
+	rows, err := s.query(fmt.Sprintf("SELECT * FROM %s ORDER BY time %s LIMIT 1", s.source(mst), order), false)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(rows) == 0 || len(rows[0].Values) == 0 {
+		return 0, false, nil
+	}
+	tm, ok := toInt64(rows[0].Values[0][0])
+	return tm, ok, nil
+}
+
+func (s *onlineSource) Read(mst string, start, end int64, fn func(b *seriesBatch) error) error {
+	command := fmt.Sprintf("SELECT * FROM %s WHERE time >= %d AND time < %d GROUP BY *", s.source(mst), start, end)
+	rows, err := s.query(command, true)
+	if err != nil {
+		return err
+	}
+	for i := range rows {
+		if err = fn(s.rowToBatch(mst, &rows[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *onlineSource) rowToBatch(mst string, row *models.Row) *seriesBatch {
+	b := &seriesBatch{measurement: mst}
+	for k, v := range row.Tags {
+		// series without the tag are grouped with an empty value
+		if v != "" {
+			b.tags = append(b.tags, [2]string{k, v})
+		}
+	}
+	sort.Slice(b.tags, func(i, j int) bool { return b.tags[i][0] < b.tags[j][0] })
+
+	// the first column is time
+	for _, col := range row.Columns[1:] {
+		b.fields = append(b.fields, col)
+		b.fieldTypes = append(b.fieldTypes, s.fieldTypes[col])
+	}
+	for _, v := range row.Values {
+		tm, ok := toInt64(v[0])
+		if !ok {
+			continue
+		}
+		values := make([]interface{}, len(b.fields))
+		for j := range b.fields {
+			values[j] = convertExportValue(v[j+1], b.fieldTypes[j])
+		}
+		b.times = append(b.times, tm)
+		b.values = append(b.values, values)
+	}
+	return b
+}
+
+func (s *onlineSource) Close() error {
+	return nil
+}
+
+func fieldTypeByName(name string) int {
+	switch name {
+	case "float":
+		return influx.Field_Type_Float
+	case "integer":
+		return influx.Field_Type_Int
+	case "boolean":
+		return influx.Field_Type_Boolean
+	default:
+		return influx.Field_Type_String
+	}
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch val := v.(type) {
+	case json.Number:
+		i, err := val.Int64()
+		return i, err == nil
+	case float64:
+		return int64(val), true
+	case int64:
+		return val, true
+	}
+	return 0, false
+}
+
+func convertExportValue(v interface{}, typ int) interface{} {
+	if v == nil {
+		return nil
+	}
+	switch typ {
+	case influx.Field_Type_Float:
+		switch val := v.(type) {
+		case json.Number:
+			f, err := val.Float64()
+			if err != nil {
+				return nil
+			}
+			return f
+		case float64:
+			return val
+		}
+	case influx.Field_Type_Int:
+		if i, ok := toInt64(v); ok {
+			return i
+		}
+	case influx.Field_Type_Boolean:
+		if b, ok := v.(bool); ok {
+			return b
+		}
+	default:
+		if str, ok := v.(string); ok {
+			return str
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geminicli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/client"
+	"github.com/influxdata/influxdb/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type exportMockClient struct {
+	mockClient
+	queries []string
+}
+
+func (m *exportMockClient) QueryContext(ctx context.Context, query client.Query) (*client.Response, error) {
+	m.queries = append(m.queries, query.Command)
+	var rows []models.Row
+	switch {
+	case query.Command == "SHOW MEASUREMENTS":
+		rows = []models.Row{{Name: "measurements", Columns: []string{"name"}, Values: [][]interface{}{{"cpu"}, {"mem"}}}}
+	case strings.HasPrefix(query.Command, "SHOW TAG KEYS"):
+		rows = []models.Row{{Columns: []string{"tagKey"}, Values: [][]interface{}{{"host"}}}}
+	case strings.HasPrefix(query.Command, "SHOW FIELD KEYS"):
+		rows = []models.Row{{Columns: []string{"fieldKey", "fieldType"}, Values: [][]interface{}{{"idle", "float"}, {"num", "integer"}}}}
+	case strings.Contains(query.Command, "ORDER BY time ASC"):
+		rows = []models.Row{{Columns: []string{"time"}, Values: [][]interface{}{{json.Number("10")}}}}
+	case strings.Contains(query.Command, "ORDER BY time DESC"):
+		rows = []models.Row{{Columns: []string{"time"}, Values: [][]interface{}{{json.Number("20")}}}}
+	case strings.Contains(query.Command, "cpu WHERE time >= 10 AND"):
+		rows = []models.Row{{
+			Name:    "cpu",
+			Tags:    map[string]string{"host": "h1"},
+			Columns: []string{"time", "idle", "num"},
+			Values: [][]interface{}{
+				{json.Number("10"), json.Number("1.5"), json.Number("3")},
+				{json.Number("12"), nil, json.Number("4")},
+			},
+		}}
+	}
+	return &client.Response{Results: []client.Result{{Series: rows}}}, nil
+}
+
+func newTestExporter(cli *exportMockClient) *Exporter {
+	e := NewExporter()
+	e.clientCreator = func(config client.Config) (HttpClient, error) {
+		return cli, nil
+	}
+	return e
+}
+
+func TestExporter_Export_Check(t *testing.T) {
+	e := newTestExporter(&exportMockClient{})
+	err := e.Export(&CommandLineConfig{}, &ExportConfig{Out: "a.txt"})
+	assert.EqualError(t, err, "execute export cmd, -database is required")
+
+	err = e.Export(&CommandLineConfig{}, &ExportConfig{Database: "db0"})
+	assert.EqualError(t, err, "execute export cmd, -out is required")
+
+	err = e.Export(&CommandLineConfig{}, &ExportConfig{Database: "db0", Out: "a.txt", Format: "xml"})
+	assert.EqualError(t, err, `unknown export format "xml". format must be line_protocol, csv or parquet`)
+
+	err = e.Export(&CommandLineConfig{}, &ExportConfig{Database: "db0", Out: "a.txt", DataDir: "/tmp"})
+	assert.EqualError(t, err, "execute offline export cmd, -retentionPolicy is required")
+
+	err = e.Export(&CommandLineConfig{}, &ExportConfig{Database: "db0", Out: "a.txt", Start: 10, End: 5})
+	assert.EqualError(t, err, "invalid time range [10, 5)")
+}
+
+func TestExporter_Export_LineProtocol(t *testing.T) {
+	cli := &exportMockClient{}
+	out := filepath.Join(t.TempDir(), "db0.txt")
+	err := newTestExporter(cli).Export(&CommandLineConfig{Host: "127.0.0.1", Port: 8086},
+		&ExportConfig{Database: "db0", RetentionPolicy: "rp0", Measurement: "cpu", Out: out, Chunk: 5})
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile(out)
+	require.NoError(t, err)
+	expect := "# DDL\nCREATE DATABASE db0\n\n# DML\n# CONTEXT-DATABASE: db0\n# CONTEXT-RETENTION-POLICY: rp0\n" +
+		"cpu,host=h1 idle=1.5,num=3i 10\n" +
+		"cpu,host=h1 num=4i 12\n"
+	assert.Equal(t, expect, string(buf))
+	assert.Contains(t, cli.queries, `SELECT * FROM "rp0".cpu WHERE time >= 15 AND time < 20 GROUP BY *`)
+	assert.Contains(t, cli.queries, `SELECT * FROM "rp0".cpu WHERE time >= 20 AND time < 21 GROUP BY *`)
+
+	_, err = os.Stat(out + checkpointSuffix)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestExporter_Export_CSV(t *testing.T) {
+	out := t.TempDir()
+	err := newTestExporter(&exportMockClient{}).Export(&CommandLineConfig{Host: "127.0.0.1", Port: 8086},
+		&ExportConfig{Database: "db0", Format: ExportFormatCSV, Out: out})
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile(filepath.Join(out, "cpu.csv"))
+	require.NoError(t, err)
+	assert.Equal(t, "time,host,idle,num\n10,h1,1.5,3\n12,h1,,4\n", string(buf))
+}
+
+func TestExporter_Export_Resume(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "db0.txt")
+	header := "# DDL\nCREATE DATABASE db0\n\n# DML\n# CONTEXT-DATABASE: db0\n"
+	// the partial line was written after the checkpoint and must be discarded
+	require.NoError(t, os.WriteFile(out, []byte(header+"mem value=1 1\ncpu,host=h1 idle"), 0640))
+
+	cp := &exportCheckpoint{
+		Done:    []string{"mem"},
+		Current: "cpu",
+		Next:    10,
+		Offsets: map[string]int64{out: int64(len(header) + len("mem value=1 1\n"))},
+	}
+	content, err := json.Marshal(cp)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(out+checkpointSuffix, content, 0640))
+
+	cli := &exportMockClient{}
+	err = newTestExporter(cli).Export(&CommandLineConfig{Host: "127.0.0.1", Port: 8086},
+		&ExportConfig{Database: "db0", Out: out, Resume: true})
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, header+"mem value=1 1\ncpu,host=h1 idle=1.5,num=3i 10\ncpu,host=h1 num=4i 12\n", string(buf))
+	for _, q := range cli.queries {
+		assert.NotContains(t, q, "FROM mem")
+	}
+}
+
+func TestExporter_Export_Parquet(t *testing.T) {
+	out := t.TempDir()
+	err := newTestExporter(&exportMockClient{}).Export(&CommandLineConfig{Host: "127.0.0.1", Port: 8086},
+		&ExportConfig{Database: "db0", Measurement: "cpu", Format: ExportFormatParquet, Out: out})
+	require.NoError(t, err)
+
+	fi, err := os.Stat(filepath.Join(out, "cpu.parquet"))
+	require.NoError(t, err)
+	assert.True(t, fi.Size() > 0)
+}
@@ -0,0 +1,420 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geminicli
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/parquet"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+func newExportWriter(ec *ExportConfig, offsets map[string]int64) (exportWriter, error) {
+	switch ec.Format {
+	case ExportFormatCSV:
+		if err := os.MkdirAll(ec.Out, 0750); err != nil {
+			return nil, err
+		}
+		return &csvExportWriter{dir: ec.Out, offsets: offsets, done: offsets}, nil
+	case ExportFormatParquet:
+		if err := os.MkdirAll(ec.Out, 0750); err != nil {
+			return nil, err
+		}
+		return &parquetExportWriter{dir: ec.Out}, nil
+	default:
+		return newLineProtocolWriter(ec.Out, ec.Database, ec.RetentionPolicy, offsets)
+	}
+}
+
+// openExportFile opens the output file for appending. A file known by the checkpoint
+// is truncated to its committed size, otherwise it is created from scratch.
+func openExportFile(name string, offsets map[string]int64) (*os.File, bool, error) {
+	offset, ok := offsets[name]
+	if !ok {
+		f, err := os.OpenFile(name, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+		return f, false, err
+	}
+	f, err := os.OpenFile(name, os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, false, err
+	}
+	if err = f.Truncate(offset); err != nil {
+		_ = f.Close()
+		return nil, false, err
+	}
+	if _, err = f.Seek(offset, 0); err != nil {
+		_ = f.Close()
+		return nil, false, err
+	}
+	return f, true, nil
+}
+
+func fileOffset(f *os.File, w *bufio.Writer) (int64, error) {
+	if err := w.Flush(); err != nil {
+		return 0, err
+	}
+	if err := f.Sync(); err != nil {
+		return 0, err
+	}
+	return f.Seek(0, 1)
+}
+
+// lineProtocolWriter writes a file which can be imported again by Importer
+type lineProtocolWriter struct {
+	file *os.File
+	w    *bufio.Writer
+	buf  []byte
+}
+
+func newLineProtocolWriter(name, db, rp string, offsets map[string]int64) (*lineProtocolWriter, error) {
+	f, resumed, err := openExportFile(name, offsets)
+	if err != nil {
+		return nil, err
+	}
+	lw := &lineProtocolWriter{file: f, w: bufio.NewWriterSize(f, 1024*1024)}
+	if resumed {
+		return lw, nil
+	}
+	header := fmt.Sprintf("# DDL\nCREATE DATABASE %s\n\n# DML\n# CONTEXT-DATABASE: %s\n", influxql.QuoteIdent(db), db)
+	if rp != "" {
+		header += fmt.Sprintf("# CONTEXT-RETENTION-POLICY: %s\n", rp)
+	}
+	if _, err = lw.w.WriteString(header); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return lw, nil
+}
+
+func (lw *lineProtocolWriter) BeginMeasurement(string, []string, []string, []int) error {
+	return nil
+}
+
+func (lw *lineProtocolWriter) WriteBatch(b *seriesBatch) error {
+	tags := make(models.Tags, 0, len(b.tags))
+	for _, kv := range b.tags {
+		tags = append(tags, models.NewTag([]byte(kv[0]), []byte(kv[1])))
+	}
+	for i, tm := range b.times {
+		fields := make(models.Fields, len(b.fields))
+		for j, name := range b.fields {
+			if b.values[i][j] != nil {
+				fields[name] = b.values[i][j]
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		pt, err := models.NewPoint(b.measurement, tags, fields, time.Unix(0, tm))
+		if err != nil {
+			return err
+		}
+		lw.buf = pt.AppendString(lw.buf[:0])
+		lw.buf = append(lw.buf, '\n')
+		if _, err = lw.w.Write(lw.buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (lw *lineProtocolWriter) EndMeasurement() error {
+	return nil
+}
+
+func (lw *lineProtocolWriter) Offsets() (map[string]int64, error) {
+	offset, err := fileOffset(lw.file, lw.w)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]int64{lw.file.Name(): offset}, nil
+}
+
+func (lw *lineProtocolWriter) Close() error {
+	if err := lw.w.Flush(); err != nil {
+		_ = lw.file.Close()
+		return err
+	}
+	return lw.file.Close()
+}
+
+// csvExportWriter writes one csv file per measurement, the columns are time, tags and fields
+type csvExportWriter struct {
+	dir     string
+	offsets map[string]int64
+
+	file    *os.File
+	bw      *bufio.Writer
+	w       *csv.Writer
+	tagIdx  map[string]int
+	fields  []string
+	done    map[string]int64
+	columns []string
+}
+
+func (cw *csvExportWriter) BeginMeasurement(mst string, tags, fields []string, _ []int) error {
+	name := filepath.Join(cw.dir, mst+".csv")
+	f, resumed, err := openExportFile(name, cw.offsets)
+	if err != nil {
+		return err
+	}
+	cw.file = f
+	cw.bw = bufio.NewWriterSize(f, 1024*1024)
+	cw.w = csv.NewWriter(cw.bw)
+	cw.fields = fields
+	cw.tagIdx = make(map[string]int, len(tags))
+	cw.columns = make([]string, 0, 1+len(tags)+len(fields))
+	cw.columns = append(cw.columns, record.TimeField)
+	for i, tag := range tags {
+		cw.tagIdx[tag] = i + 1
+		cw.columns = append(cw.columns, tag)
+	}
+	cw.columns = append(cw.columns, fields...)
+	if resumed {
+		return nil
+	}
+	return cw.w.Write(cw.columns)
+}
+
+func (cw *csvExportWriter) WriteBatch(b *seriesBatch) error {
+	fieldIdx := make([]int, len(b.fields))
+	for j, name := range b.fields {
+		fieldIdx[j] = -1
+		for k, f := range cw.fields {
+			if f == name {
+				fieldIdx[j] = 1 + len(cw.tagIdx) + k
+				break
+			}
+		}
+	}
+
+	row := make([]string, len(cw.columns))
+	for i, tm := range b.times {
+		for k := range row {
+			row[k] = ""
+		}
+		row[0] = strconv.FormatInt(tm, 10)
+		for _, kv := range b.tags {
+			if idx, ok := cw.tagIdx[kv[0]]; ok {
+				row[idx] = kv[1]
+			}
+		}
+		for j, v := range b.values[i] {
+			if v == nil || fieldIdx[j] < 0 {
+				continue
+			}
+			row[fieldIdx[j]] = formatExportValue(v)
+		}
+		if err := cw.w.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cw *csvExportWriter) EndMeasurement() error {
+	offsets, err := cw.Offsets()
+	if err != nil {
+		return err
+	}
+	cw.done = offsets
+	err = cw.file.Close()
+	cw.file = nil
+	return err
+}
+
+func (cw *csvExportWriter) Offsets() (map[string]int64, error) {
+	offsets := make(map[string]int64, len(cw.done)+1)
+	for k, v := range cw.done {
+		offsets[k] = v
+	}
+	if cw.file == nil {
+		return offsets, nil
+	}
+	cw.w.Flush()
+	if err := cw.w.Error(); err != nil {
+		return nil, err
+	}
+	offset, err := fileOffset(cw.file, cw.bw)
+	if err != nil {
+		return nil, err
+	}
+	offsets[cw.file.Name()] = offset
+	return offsets, nil
+}
+
+func (cw *csvExportWriter) Close() error {
+	if cw.file == nil {
+		return nil
+	}
+	cw.w.Flush()
+	if err := cw.bw.Flush(); err != nil {
+		_ = cw.file.Close()
+		return err
+	}
+	return cw.file.Close()
+}
+
+// parquetExportWriter writes one parquet file per measurement by lib/parquet,
+// tags are written as string columns beside the series column
+type parquetExportWriter struct {
+	dir    string
+	writer *parquet.Writer
+	schema record.Schemas
+}
+
+func (pw *parquetExportWriter) BeginMeasurement(mst string, tags, fields []string, fieldTypes []int) error {
+	schemas := make(map[string]uint8, len(tags)+len(fields)+1)
+	pw.schema = pw.schema[:0]
+	for _, tag := range tags {
+		schemas[tag] = influx.Field_Type_String
+		pw.schema = append(pw.schema, record.Field{Name: tag, Type: influx.Field_Type_String})
+	}
+	for i, field := range fields {
+		schemas[field] = uint8(fieldTypes[i])
+		pw.schema = append(pw.schema, record.Field{Name: field, Type: fieldTypes[i]})
+	}
+	sort.Sort(pw.schema)
+	schemas[record.TimeField] = influx.Field_Type_Int
+	pw.schema = append(pw.schema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+
+	w, err := parquet.NewWriter(filepath.Join(pw.dir, mst+".parquet"), "", parquet.MetaData{Mst: mst, Schemas: schemas})
+	if err != nil {
+		return err
+	}
+	pw.writer = w
+	return nil
+}
+
+func (pw *parquetExportWriter) WriteBatch(b *seriesBatch) error {
+	if len(b.times) == 0 {
+		return nil
+	}
+	tagValues := make(map[string]string, len(b.tags))
+	for _, kv := range b.tags {
+		tagValues[kv[0]] = kv[1]
+	}
+	fieldIdx := make(map[string]int, len(b.fields))
+	for j, name := range b.fields {
+		fieldIdx[name] = j
+	}
+
+	rec := record.NewRecordBuilder(pw.schema)
+	for c := range pw.schema {
+		field := &pw.schema[c]
+		col := rec.Column(c)
+		if field.Name == record.TimeField {
+			col.AppendIntegers(b.times...)
+			continue
+		}
+		if tv, ok := tagValues[field.Name]; ok {
+			for range b.times {
+				col.AppendString(tv)
+			}
+			continue
+		}
+		j, ok := fieldIdx[field.Name]
+		for i := range b.times {
+			var v interface{}
+			if ok {
+				v = b.values[i][j]
+			}
+			appendExportValue(col, field.Type, v)
+		}
+	}
+	return pw.writer.WriteRecord(seriesKey(b), rec)
+}
+
+func (pw *parquetExportWriter) EndMeasurement() error {
+	if pw.writer == nil {
+		return nil
+	}
+	err := pw.writer.WriteStop()
+	pw.writer = nil
+	return err
+}
+
+func (pw *parquetExportWriter) Offsets() (map[string]int64, error) {
+	return nil, nil
+}
+
+func (pw *parquetExportWriter) Close() error {
+	if pw.writer != nil {
+		pw.writer.Close()
+	}
+	return nil
+}
+
+func seriesKey(b *seriesBatch) string {
+	tags := make(models.Tags, 0, len(b.tags))
+	for _, kv := range b.tags {
+		tags = append(tags, models.NewTag([]byte(kv[0]), []byte(kv[1])))
+	}
+	return string(models.MakeKey([]byte(b.measurement), tags))
+}
+
+func appendExportValue(col *record.ColVal, typ int, v interface{}) {
+	switch typ {
+	case influx.Field_Type_Float:
+		if f, ok := v.(float64); ok {
+			col.AppendFloat(f)
+			return
+		}
+		col.AppendFloatNull()
+	case influx.Field_Type_Int:
+		if i, ok := v.(int64); ok {
+			col.AppendInteger(i)
+			return
+		}
+		col.AppendIntegerNull()
+	case influx.Field_Type_Boolean:
+		if b, ok := v.(bool); ok {
+			col.AppendBoolean(b)
+			return
+		}
+		col.AppendBooleanNull()
+	default:
+		if s, ok := v.(string); ok {
+			col.AppendString(s)
+			return
+		}
+		col.AppendStringNull()
+	}
+}
+
+func formatExportValue(v interface{}) string {
+	switch val := v.(type) {
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case bool:
+		return strconv.FormatBool(val)
+	case string:
+		return val
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
@@ -15,15 +15,26 @@
 package main
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/openGemini/openGemini/app"
+	"github.com/openGemini/openGemini/app/ts-meta/metatool"
 	"github.com/openGemini/openGemini/app/ts-meta/run"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 )
 
 func main() {
+	// the subcommands operating the meta data through the http service of a running ts-meta
+	if len(os.Args) > 1 && metatool.IsCommand(os.Args[1]) {
+		if err := metatool.Run(os.Stdout, os.Args[1], os.Args[2:]...); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	errno.SetNode(errno.NodeMeta)
 	app.InitParse()
 	info := app.ServerInfo{
@@ -33,6 +33,9 @@ type BalanceManager struct {
 	wg      sync.WaitGroup
 	stopped int32
 	algoFn  func()
+
+	// moves the pts by their loads on the write-available-first clusters, nil if the rebalancing is disabled
+	rebalancer *Rebalancer
 }
 
 func NewBalanceManager(algo string) *BalanceManager {
@@ -50,6 +53,10 @@ func (b *BalanceManager) Start() {
 	atomic.StoreInt32(&b.stopped, 0)
 	b.wg.Add(1)
 	go b.algoFn()
+	if b.rebalancer != nil {
+		b.wg.Add(1)
+		go b.rebalanceIfNeeded()
+	}
 }
 
 func (b *BalanceManager) balanceIfNeeded() {
@@ -90,6 +97,19 @@ func (b *BalanceManager) balanceIfNeededEx() {
 	}
 }
 
+func (b *BalanceManager) rebalanceIfNeeded() {
+	logger.GetLogger().Info("[rebalancer] load-aware rebalancing start")
+	defer b.wg.Done()
+	for {
+		if atomic.LoadInt32(&b.stopped) == 1 || config.GetHaPolicy() != config.WriteAvailableFirst {
+			return
+		}
+		b.rebalancer.rebalance()
+
+		time.Sleep(balanceInterval)
+	}
+}
+
 // Stop balance goroutine
 func (b *BalanceManager) Stop() {
 	if !atomic.CompareAndSwapInt32(&b.stopped, 0, 1) {
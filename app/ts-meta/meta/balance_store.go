@@ -143,8 +143,12 @@ func (s *Store) balanceDBPts() []*MoveEvent {
 			logger.GetLogger().Error("balance pts db error", zap.String("db", db), zap.String("error", err.Error()))
 			continue
 		}
+		dbNodes := s.pinnedNodeIds(db, aliveNodes)
+		if len(dbNodes) == 0 {
+			continue
+		}
 		dbInfo := s.data.GetDBBriefInfo(db)
-		moveEvents = s.balanceOneDBPts(db, &aliveNodes, moveEvents, dbInfo)
+		moveEvents = s.balanceOneDBPts(db, &dbNodes, moveEvents, dbInfo)
 	}
 	return moveEvents
 }
@@ -542,29 +546,33 @@ func (s *Store) selectDbPtsToMove() []*MoveEvent {
 			nodePtsMap[ptInfo.Owner.NodeID] = append(nodePtsMap[ptInfo.Owner.NodeID], ptInfo)
 		}
 
+		dbNodes := s.pinnedNodeIds(db, aliveNodes)
+		if len(dbNodes) == 0 {
+			continue
+		}
 		dbInfo := s.data.GetDBBriefInfo(db)
 		maxPtNum := -1
 		minPtNum := math.MaxInt32
 		var from uint64
 		var to uint64
-		for i := range aliveNodes {
-			if _, ok := nodePtsMap[aliveNodes[i]]; !ok {
-				nodePtsMap[aliveNodes[i]] = []meta.PtInfo{}
+		for i := range dbNodes {
+			if _, ok := nodePtsMap[dbNodes[i]]; !ok {
+				nodePtsMap[dbNodes[i]] = []meta.PtInfo{}
 			}
-			if minPtNum > len(nodePtsMap[aliveNodes[i]]) {
-				minPtNum = len(nodePtsMap[aliveNodes[i]])
-				to = aliveNodes[i]
+			if minPtNum > len(nodePtsMap[dbNodes[i]]) {
+				minPtNum = len(nodePtsMap[dbNodes[i]])
+				to = dbNodes[i]
 			}
-			if maxPtNum < len(nodePtsMap[aliveNodes[i]]) {
-				maxPtNum = len(nodePtsMap[aliveNodes[i]])
-				from = aliveNodes[i]
+			if maxPtNum < len(nodePtsMap[dbNodes[i]]) {
+				maxPtNum = len(nodePtsMap[dbNodes[i]])
+				from = dbNodes[i]
 			}
 		}
 
 		if maxPtNum-minPtNum > 1 {
 			moveEvents = s.balanceByPtNum(db, from, to, nodePtsMap, moveEvents, dbInfo)
 		} else {
-			moveEvents = s.balanceByOldAndNew(db, aliveNodes, nodePtsMap, moveEvents, dbInfo)
+			moveEvents = s.balanceByOldAndNew(db, dbNodes, nodePtsMap, moveEvents, dbInfo)
 		}
 	}
 	return moveEvents
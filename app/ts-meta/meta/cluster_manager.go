@@ -468,10 +468,17 @@ func (cm *ClusterManager) processFailedDbPtNormal(dbPt *meta.DbPtInfo, nodePtNum
 		logger.GetLogger().Info("no need to assign online pt", zap.String("db", dbPt.Db), zap.Uint32("pt", dbPt.Pti.PtId))
 		return nil
 	}
-	targetId, err := cm.getTakeOverNode(cm, dbPt.Pti.Owner.NodeID, nodePtNumMap, isRetry)
+	// the pts of a pinned database are only taken over by the pinned nodes of its tenant
+	pinnedPtNumMap := globalService.store.getPinnedPtNumPerAliveNode(dbPt.Db, nodePtNumMap)
+	targetId, err := cm.getTakeOverNode(cm, dbPt.Pti.Owner.NodeID, pinnedPtNumMap, isRetry)
 	if err != nil {
 		return err
 	}
+	if pinnedPtNumMap != nodePtNumMap {
+		if n, ok := (*pinnedPtNumMap)[targetId]; ok {
+			(*nodePtNumMap)[targetId] = n
+		}
+	}
 	aliveConnId, err := globalService.store.getDataNodeAliveConnId(targetId)
 	if err != nil {
 		return err
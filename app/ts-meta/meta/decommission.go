@@ -0,0 +1,463 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	mproto "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+var decommissionInterval = 5 * time.Second
+
+// Decommissioner moves the pts of the decommissioning ts-store nodes to the other nodes one by one and removes
+// the nodes from the meta data after the moved pts are verified online on their new owners. The progress and
+// the moved pts of a node are kept in the meta data, so a new leader continues the decommission where the old
+// one stopped. The node keeps taking the writes of the pts it still owns, a pt is only fenced by the offload of
+// its own move and the final transfer copies the rows written to the pt during the bulk copy.
+type Decommissioner struct {
+	wg      sync.WaitGroup
+	stopped int32
+	closing chan struct{}
+
+	// serializes the updates of the progress
+	mu     sync.Mutex
+	logger *logger.Logger
+}
+
+func NewDecommissioner() *Decommissioner {
+	return &Decommissioner{
+		stopped: 1,
+		logger:  logger.NewLogger(errno.ModuleHA),
+	}
+}
+
+// Start decommission goroutine, nothing is started without the store
+func (d *Decommissioner) Start(store *Store) {
+	if store == nil {
+		return
+	}
+	atomic.StoreInt32(&d.stopped, 0)
+	d.closing = make(chan struct{})
+	d.wg.Add(1)
+	go d.decommissionIfNeeded(store, d.closing)
+}
+
+// Stop decommission goroutine
+func (d *Decommissioner) Stop() {
+	if !atomic.CompareAndSwapInt32(&d.stopped, 0, 1) {
+		return
+	}
+	// do not wait for the interval, the step down of the leader is blocked until the goroutine exits
+	close(d.closing)
+	d.wg.Wait()
+}
+
+func (d *Decommissioner) decommissionIfNeeded(store *Store, closing chan struct{}) {
+	d.logger.Info("[decommission] start")
+	defer d.wg.Done()
+	for {
+		if atomic.LoadInt32(&d.stopped) == 1 {
+			return
+		}
+		for _, nodeId := range store.getDecommissioningNodes() {
+			d.decommission(store, nodeId)
+		}
+
+		select {
+		case <-closing:
+			return
+		case <-time.After(decommissionInterval):
+		}
+	}
+}
+
+// decommission makes one step of decommissioning the node: moves one of its pts, or verifies the moved pts
+// and removes the node after all of its pts are moved
+func (d *Decommissioner) decommission(store *Store, nodeId uint64) {
+	if globalService.msm.CheckNodeEventExsit([]uint64{nodeId}) {
+		// wait for the pt being moved
+		return
+	}
+
+	store.mu.RLock()
+	pts := store.data.GetPtInfosByNodeId(nodeId)
+	store.mu.RUnlock()
+	if err := d.updateProgress(store, nodeId, len(pts)); err != nil {
+		d.logger.Warn("[decommission] failed to update the progress", zap.Uint64("node", nodeId), zap.Error(err))
+		return
+	}
+	if len(pts) > 0 {
+		d.updateTask(store, nodeId, meta.DecommissionMoving, d.movePt(store, nodeId, pts))
+		return
+	}
+
+	if err := d.verify(store, nodeId); err != nil {
+		d.updateTask(store, nodeId, meta.DecommissionVerifying, err)
+		return
+	}
+
+	store.segregateMu.Lock()
+	defer store.segregateMu.Unlock()
+	// the decommission may be canceled while the pts are verified
+	store.mu.RLock()
+	status := store.data.GetSegregateStatusByNodeId(nodeId)
+	store.mu.RUnlock()
+	if status != meta.Decommissioning {
+		return
+	}
+	if err := store.RemoveNode([]uint64{nodeId}); err != nil {
+		d.updateTask(store, nodeId, meta.DecommissionVerifying, err)
+		return
+	}
+	d.logger.Info("[decommission] node is removed", zap.Uint64("node", nodeId))
+	d.updateTask(store, nodeId, meta.DecommissionDone, nil)
+}
+
+// movePt moves the first movable pt of the node to the alive node owning the fewest pts,
+// the pts of a pinned database are moved to the pinned nodes of its tenant
+func (d *Decommissioner) movePt(store *Store, nodeId uint64, pts []*meta.DbPtInfo) error {
+	nodePtNumMap := store.getDbPtNumPerAliveNode()
+
+	var err error
+	for _, pt := range pts {
+		target, ok := selectDecommissionTarget(*store.getPinnedPtNumPerAliveNode(pt.Db, nodePtNumMap))
+		if !ok {
+			err = fmt.Errorf("no alive node to take over the pt %s/%d of node %d", pt.Db, pt.Pti.PtId, nodeId)
+			continue
+		}
+		if pt.Pti.Status != meta.Online {
+			err = fmt.Errorf("pt %s/%d is not online, status:%d", pt.Db, pt.Pti.PtId, pt.Pti.Status)
+			continue
+		}
+		store.mu.RLock()
+		err = store.data.CheckCanMoveDb(pt.Db)
+		store.mu.RUnlock()
+		if err != nil {
+			continue
+		}
+		aliveConnId, connErr := store.getDataNodeAliveConnId(target)
+		if connErr != nil {
+			return connErr
+		}
+
+		// the pt is recorded before it is moved, so it is verified even if the leader changes while it is moved
+		if err = d.addMoved(store, nodeId, pt.Db, pt.Pti.PtId); err != nil {
+			return err
+		}
+		d.logger.Info("[decommission] move pt", zap.String("db", pt.Db), zap.Uint32("pt", pt.Pti.PtId),
+			zap.Uint64("from", nodeId), zap.Uint64("to", target))
+		return globalService.msm.executeEvent(newDecommissionMoveEvent(pt, nodeId, target, aliveConnId))
+	}
+	return err
+}
+
+// newDecommissionMoveEvent moves the pt with its files unless the storage is shared, the files are verified by
+// the target before it loads the pt, and the files left on the decommissioning node are dropped after the target
+// is committed as the new owner
+func newDecommissionMoveEvent(pt *meta.DbPtInfo, from, to, aliveConnId uint64) *MoveEvent {
+	e := NewMoveEvent(pt, from, to, aliveConnId, false)
+	e.transferFiles = true
+	return e
+}
+
+// selectDecommissionTarget selects the node owning the fewest pts, the smaller node id wins the tie
+func selectDecommissionTarget(nodePtNumMap map[uint64]uint32) (uint64, bool) {
+	nodeIds := make([]uint64, 0, len(nodePtNumMap))
+	for nodeId := range nodePtNumMap {
+		nodeIds = append(nodeIds, nodeId)
+	}
+	if len(nodeIds) == 0 {
+		return 0, false
+	}
+	sort.Slice(nodeIds, func(i, j int) bool {
+		return nodeIds[i] < nodeIds[j]
+	})
+	target := nodeIds[0]
+	for _, nodeId := range nodeIds[1:] {
+		if nodePtNumMap[nodeId] < nodePtNumMap[target] {
+			target = nodeId
+		}
+	}
+	return target, true
+}
+
+// verify checks that the moved pts are online on their new owners and the owners have loaded them
+func (d *Decommissioner) verify(store *Store, nodeId uint64) error {
+	owners := make(map[uint64][]meta.DecommissionPt)
+	store.mu.RLock()
+	var moved []meta.DecommissionPt
+	if info, ok := store.data.Decommissions[nodeId]; ok {
+		moved = info.Moved
+	}
+	for _, pt := range moved {
+		if store.data.Database(pt.Db) == nil {
+			// the database is dropped after the pt is moved
+			continue
+		}
+		pti := store.data.GetPtInfo(pt.Db, pt.PtId)
+		if pti == nil {
+			continue
+		}
+		if pti.Status != meta.Online || pti.Owner.NodeID == nodeId {
+			store.mu.RUnlock()
+			return fmt.Errorf("pt %s/%d is not online on node %d, status:%d", pt.Db, pt.PtId, pti.Owner.NodeID, pti.Status)
+		}
+		owners[pti.Owner.NodeID] = append(owners[pti.Owner.NodeID], pt)
+	}
+	store.mu.RUnlock()
+
+	for owner, pts := range owners {
+		loads, err := store.NetStore.GetPtLoadsOnNode(owner)
+		if err != nil {
+			return err
+		}
+		loaded := make(map[string]struct{}, len(loads))
+		for _, load := range loads {
+			loaded[ptLoadKey(owner, load.Db, load.PtId)] = struct{}{}
+		}
+		for _, pt := range pts {
+			if _, ok := loaded[ptLoadKey(owner, pt.Db, pt.PtId)]; !ok {
+				return fmt.Errorf("pt %s/%d is not loaded on node %d", pt.Db, pt.PtId, owner)
+			}
+		}
+	}
+	return nil
+}
+
+// update applies fn to a copy of the progress of decommissioning the node and commits the copy
+// to the meta data if fn changes it
+func (d *Decommissioner) update(store *Store, nodeId uint64, fn func(info *meta.DecommissionInfo) bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	store.mu.RLock()
+	info, ok := store.data.Decommissions[nodeId]
+	if ok {
+		info = info.Clone()
+	}
+	store.mu.RUnlock()
+	if !ok {
+		info = &meta.DecommissionInfo{NodeID: nodeId}
+	}
+	if !fn(info) {
+		return nil
+	}
+	info.UpdateTime = time.Now().UnixNano()
+	return store.updateDecommission(info)
+}
+
+func isDecommissionFinished(state string) bool {
+	return state == meta.DecommissionDone || state == meta.DecommissionCanceled
+}
+
+// addTask starts a new decommission of the node unless the node is being decommissioned
+func (d *Decommissioner) addTask(store *Store, nodeId uint64, totalPts int) error {
+	return d.update(store, nodeId, func(info *meta.DecommissionInfo) bool {
+		if info.State != "" && !isDecommissionFinished(info.State) {
+			return false
+		}
+		*info = meta.DecommissionInfo{
+			NodeID:    nodeId,
+			State:     meta.DecommissionMoving,
+			TotalPts:  totalPts,
+			StartTime: time.Now().UnixNano(),
+		}
+		return true
+	})
+}
+
+func (d *Decommissioner) updateProgress(store *Store, nodeId uint64, remainPts int) error {
+	return d.update(store, nodeId, func(info *meta.DecommissionInfo) bool {
+		if isDecommissionFinished(info.State) {
+			// canceled while the pts are counted
+			return false
+		}
+		if info.State == "" {
+			// the node is marked decommissioning without the progress in the meta data
+			info.State = meta.DecommissionMoving
+			info.StartTime = time.Now().UnixNano()
+		}
+		total, moved := info.TotalPts, info.MovedPts
+		if info.TotalPts < info.MovedPts+remainPts {
+			info.TotalPts = info.MovedPts + remainPts
+		}
+		info.MovedPts = info.TotalPts - remainPts
+		return info.UpdateTime == 0 || total != info.TotalPts || moved != info.MovedPts
+	})
+}
+
+func (d *Decommissioner) addMoved(store *Store, nodeId uint64, db string, ptId uint32) error {
+	return d.update(store, nodeId, func(info *meta.DecommissionInfo) bool {
+		return info.AddMoved(db, ptId)
+	})
+}
+
+// updateTask sets the state of the decommission, the progress is only committed if the state or the error changes
+func (d *Decommissioner) updateTask(store *Store, nodeId uint64, state string, err error) {
+	var errMsg string
+	if err != nil {
+		errMsg = err.Error()
+		d.logger.Warn("[decommission] node is not decommissioned", zap.Uint64("node", nodeId), zap.String("state", state), zap.Error(err))
+	}
+	updateErr := d.update(store, nodeId, func(info *meta.DecommissionInfo) bool {
+		if info.State == "" || (isDecommissionFinished(info.State) && !isDecommissionFinished(state)) {
+			return false
+		}
+		if info.State == state && info.Error == errMsg {
+			return false
+		}
+		info.State, info.Error = state, errMsg
+		return true
+	})
+	if updateErr != nil {
+		d.logger.Warn("[decommission] failed to update the state", zap.Uint64("node", nodeId), zap.String("state", state), zap.Error(updateErr))
+	}
+}
+
+func (d *Decommissioner) show(store *Store) []meta.DecommissionInfo {
+	store.mu.RLock()
+	infos := make([]meta.DecommissionInfo, 0, len(store.data.Decommissions))
+	for _, info := range store.data.Decommissions {
+		infos = append(infos, *info)
+	}
+	store.mu.RUnlock()
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].NodeID < infos[j].NodeID
+	})
+	return infos
+}
+
+// updateDecommission commits the progress of decommissioning a node to the meta data
+func (s *Store) updateDecommission(info *meta.DecommissionInfo) error {
+	if !s.IsLeader() {
+		return raft.ErrNotLeader
+	}
+	val := &mproto.UpdateDecommissionCommand{Info: info.Marshal()}
+	t := mproto.Command_UpdateDecommissionCommand
+	cmd := &mproto.Command{Type: &t}
+	if err := proto.SetExtension(cmd, mproto.E_UpdateDecommissionCommand_Command, val); err != nil {
+		return err
+	}
+	return s.ApplyCmd(cmd)
+}
+
+func (s *Store) getDecommissioningNodes() []uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var nodeIds []uint64
+	for _, dataNode := range s.data.DataNodes {
+		if dataNode.SegregateStatus == meta.Decommissioning {
+			nodeIds = append(nodeIds, dataNode.ID)
+		}
+	}
+	return nodeIds
+}
+
+// Decommission starts, cancels or shows the decommission of the ts-store nodes on the meta leader
+func (s *Store) Decommission(cmd string, nodeId uint64) ([]byte, error) {
+	if !s.IsLeader() {
+		return nil, raft.ErrNotLeader
+	}
+	switch cmd {
+	case meta.DecommissionStart:
+		return nil, s.startDecommission(nodeId)
+	case meta.DecommissionCancel:
+		return nil, s.cancelDecommission(nodeId)
+	case meta.DecommissionShow:
+		return json.Marshal(globalService.decommissioner.show(s))
+	default:
+		return nil, fmt.Errorf("unknown decommission command: %s", cmd)
+	}
+}
+
+// startDecommission marks the node decommissioning, no new pts are placed on it and its pts are moved
+// to the other nodes by the decommissioner
+func (s *Store) startDecommission(nodeId uint64) error {
+	s.segregateMu.Lock()
+	defer s.segregateMu.Unlock()
+
+	s.mu.RLock()
+	dataNode := s.data.DataNode(nodeId)
+	if dataNode == nil {
+		s.mu.RUnlock()
+		return errno.NewError(errno.DataNodeNotFound, nodeId, "")
+	}
+	status := dataNode.SegregateStatus
+	var takeOverNodes int
+	for _, dn := range s.data.DataNodes {
+		if dn.ID != nodeId && dn.SegregateStatus == meta.Normal && dn.Status == serf.StatusAlive && dn.AliveConnID == dn.ConnID {
+			takeOverNodes++
+		}
+	}
+	totalPts := len(s.data.GetPtInfosByNodeId(nodeId))
+	s.mu.RUnlock()
+
+	if status == meta.Decommissioning {
+		return fmt.Errorf("node %d is already decommissioning", nodeId)
+	}
+	if status != meta.Normal {
+		return fmt.Errorf("node %d is segregated, segregatedStatus:%d", nodeId, status)
+	}
+	if takeOverNodes == 0 && totalPts > 0 {
+		return fmt.Errorf("no alive node to take over the pts of node %d", nodeId)
+	}
+	if err := s.SetSegregateNodeStatus([]uint64{meta.Decommissioning}, []uint64{nodeId}); err != nil {
+		return err
+	}
+	if err := globalService.decommissioner.addTask(s, nodeId, totalPts); err != nil {
+		// the progress is added by the decommissioner later
+		s.Logger.Warn("[decommission] failed to add the progress", zap.Uint64("node", nodeId), zap.Error(err))
+	}
+	s.Logger.Info("[decommission] node is decommissioning", zap.Uint64("node", nodeId), zap.Int("pts", totalPts))
+	return nil
+}
+
+// cancelDecommission marks the node normal again, the pt being moved is not rolled back
+func (s *Store) cancelDecommission(nodeId uint64) error {
+	s.segregateMu.Lock()
+	defer s.segregateMu.Unlock()
+
+	s.mu.RLock()
+	dataNode := s.data.DataNode(nodeId)
+	var status uint64
+	if dataNode != nil {
+		status = dataNode.SegregateStatus
+	}
+	s.mu.RUnlock()
+	if dataNode == nil {
+		return errno.NewError(errno.DataNodeNotFound, nodeId, "")
+	}
+	if status != meta.Decommissioning {
+		return fmt.Errorf("node %d is not decommissioning", nodeId)
+	}
+	if err := s.SetSegregateNodeStatus([]uint64{meta.Normal}, []uint64{nodeId}); err != nil {
+		return err
+	}
+	globalService.decommissioner.updateTask(s, nodeId, meta.DecommissionCanceled, nil)
+	s.Logger.Info("[decommission] decommission is canceled", zap.Uint64("node", nodeId))
+	return nil
+}
@@ -0,0 +1,229 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/raft"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	mproto "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRaftForDecommission applies the progress of the decommissions to the data
+type MockRaftForDecommission struct {
+	MockRaftForSG
+	data *meta.Data
+}
+
+func (m *MockRaftForDecommission) Apply(b []byte) error {
+	if err := m.MockRaftForSG.Apply(b); err != nil {
+		return err
+	}
+	var cmd mproto.Command
+	if err := proto.Unmarshal(b, &cmd); err != nil {
+		return err
+	}
+	if cmd.GetType() != mproto.Command_UpdateDecommissionCommand {
+		return nil
+	}
+	return meta.ApplyUpdateDecommission(m.data, &cmd)
+}
+
+func newDecommissionTestStore(isLeader bool) *Store {
+	s := &Store{
+		data: &meta.Data{
+			DataNodes: []meta.DataNode{
+				{NodeInfo: meta.NodeInfo{ID: 1, Status: serf.StatusAlive, SegregateStatus: meta.Normal}},
+				{NodeInfo: meta.NodeInfo{ID: 2, Status: serf.StatusAlive, SegregateStatus: meta.Normal}},
+				{NodeInfo: meta.NodeInfo{ID: 3, Status: serf.StatusAlive, SegregateStatus: meta.Decommissioning}},
+			},
+			Databases: map[string]*meta.DatabaseInfo{"db0": {Name: "db0"}},
+			PtView: map[string]meta.DBPtInfos{
+				"db0": {
+					{PtId: 0, Owner: meta.PtOwner{NodeID: 1}, Status: meta.Online},
+					{PtId: 1, Owner: meta.PtOwner{NodeID: 2}, Status: meta.Online},
+					{PtId: 2, Owner: meta.PtOwner{NodeID: 2}, Status: meta.Online},
+				},
+			},
+		},
+		Logger: logger.NewLogger(errno.ModuleMeta),
+	}
+	s.raft = &MockRaftForDecommission{MockRaftForSG: MockRaftForSG{isLeader: isLeader}, data: s.data}
+	return s
+}
+
+func TestSelectDecommissionTarget(t *testing.T) {
+	target, ok := selectDecommissionTarget(map[uint64]uint32{1: 2, 2: 1, 3: 1})
+	require.True(t, ok)
+	assert.Equal(t, uint64(2), target)
+
+	_, ok = selectDecommissionTarget(map[uint64]uint32{})
+	assert.False(t, ok)
+}
+
+func TestStore_Decommission(t *testing.T) {
+	s := newDecommissionTestStore(true)
+	globalService = &Service{store: s, decommissioner: NewDecommissioner()}
+	defer func() {
+		globalService = nil
+	}()
+
+	_, err := s.Decommission(meta.DecommissionStart, 4)
+	assert.True(t, errno.Equal(err, errno.DataNodeNotFound))
+	_, err = s.Decommission(meta.DecommissionStart, 3)
+	assert.EqualError(t, err, "node 3 is already decommissioning")
+	_, err = s.Decommission(meta.DecommissionCancel, 1)
+	assert.EqualError(t, err, "node 1 is not decommissioning")
+	_, err = s.Decommission("drop", 1)
+	assert.Error(t, err)
+
+	_, err = s.Decommission(meta.DecommissionStart, 2)
+	require.NoError(t, err)
+	_, err = s.Decommission(meta.DecommissionCancel, 3)
+	require.NoError(t, err)
+
+	b, err := s.Decommission(meta.DecommissionShow, 0)
+	require.NoError(t, err)
+	var infos []meta.DecommissionInfo
+	require.NoError(t, json.Unmarshal(b, &infos))
+	require.Equal(t, 1, len(infos))
+	assert.Equal(t, uint64(2), infos[0].NodeID)
+	assert.Equal(t, meta.DecommissionMoving, infos[0].State)
+	assert.Equal(t, 2, infos[0].TotalPts)
+
+	s.raft = &MockRaftForDecommission{data: s.data}
+	_, err = s.Decommission(meta.DecommissionShow, 0)
+	assert.Equal(t, raft.ErrNotLeader, err)
+}
+
+func TestDecommissioner_Verify(t *testing.T) {
+	s := newDecommissionTestStore(true)
+	loaded := []*netstorage.PtLoad{{Db: "db0", PtId: 1}}
+	s.NetStore = &MockNetStorage{GetPtLoadsOnNodeFn: func(nodeID uint64) ([]*netstorage.PtLoad, error) {
+		return loaded, nil
+	}}
+	d := NewDecommissioner()
+	globalService = &Service{store: s, decommissioner: d}
+	defer func() {
+		globalService = nil
+	}()
+
+	require.NoError(t, d.updateProgress(s, 3, 0))
+	require.NoError(t, d.addMoved(s, 3, "db0", 1))
+	require.NoError(t, d.addMoved(s, 3, "db0", 2))
+	require.NoError(t, d.addMoved(s, 3, "db0", 2))
+	assert.Equal(t, []meta.DecommissionPt{{Db: "db0", PtId: 1}, {Db: "db0", PtId: 2}}, s.data.Decommissions[3].Moved)
+	assert.EqualError(t, d.verify(s, 3), "pt db0/2 is not loaded on node 2")
+
+	loaded = append(loaded, &netstorage.PtLoad{Db: "db0", PtId: 2})
+	require.NoError(t, d.verify(s, 3))
+
+	s.data.PtView["db0"][2].Status = meta.Offline
+	assert.Error(t, d.verify(s, 3))
+
+	// the pts of the dropped database are not verified
+	delete(s.data.Databases, "db0")
+	require.NoError(t, d.verify(s, 3))
+}
+
+func TestDecommissioner_Progress(t *testing.T) {
+	s := newDecommissionTestStore(true)
+	d := NewDecommissioner()
+	globalService = &Service{store: s, decommissioner: d}
+	defer func() {
+		globalService = nil
+	}()
+
+	require.NoError(t, d.addTask(s, 3, 4))
+	require.NoError(t, d.updateProgress(s, 3, 3))
+	require.NoError(t, d.updateProgress(s, 3, 1))
+	infos := d.show(s)
+	require.Equal(t, 1, len(infos))
+	assert.Equal(t, 3, infos[0].MovedPts)
+	assert.Equal(t, 4, infos[0].TotalPts)
+
+	// a pt assigned to the node while it is decommissioning
+	require.NoError(t, d.updateProgress(s, 3, 2))
+	infos = d.show(s)
+	assert.Equal(t, 3, infos[0].MovedPts)
+	assert.Equal(t, 5, infos[0].TotalPts)
+
+	// the progress is kept by the new leader
+	data := &meta.Data{}
+	require.NoError(t, data.UnmarshalBinary(mustMarshalData(t, s.data)))
+	require.Equal(t, 1, len(data.Decommissions))
+	assert.Equal(t, infos[0], *data.Decommissions[3])
+
+	d.updateTask(s, 3, meta.DecommissionCanceled, nil)
+	// the canceled decommission is not moved again by the decommissioner
+	d.updateTask(s, 3, meta.DecommissionMoving, nil)
+	require.NoError(t, d.updateProgress(s, 3, 2))
+	assert.Equal(t, meta.DecommissionCanceled, d.show(s)[0].State)
+
+	require.NoError(t, d.addTask(s, 3, 1))
+	infos = d.show(s)
+	assert.Equal(t, meta.DecommissionMoving, infos[0].State)
+	assert.Equal(t, 0, infos[0].MovedPts)
+}
+
+func mustMarshalData(t *testing.T, data *meta.Data) []byte {
+	b, err := data.MarshalBinary()
+	require.NoError(t, err)
+	return b
+}
+
+func TestDecommissioner_MoveEvent(t *testing.T) {
+	var dropped []uint64
+	s := newDecommissionTestStore(true)
+	s.NetStore = &MockNetStorage{DropPtFilesFn: func(nodeID uint64, db string, pt uint32) error {
+		dropped = append(dropped, nodeID)
+		return nil
+	}}
+	globalService = &Service{store: s}
+	defer func() {
+		globalService = nil
+	}()
+
+	pt := &meta.DbPtInfo{Db: "db0", Pti: &meta.PtInfo{PtId: 2, Owner: meta.PtOwner{NodeID: 3}},
+		DBBriefInfo: &meta.DatabaseBriefInfo{Name: "db0"}}
+	e := newDecommissionMoveEvent(pt, 3, 1, 0)
+	// the files of the pt are transferred to the target node
+	assert.True(t, e.marshalEvent().GetTransferFiles())
+
+	e.dropSourcePtFiles()
+	assert.Equal(t, []uint64{3}, dropped)
+}
+
+func TestDecommissioner_StartWithoutStore(t *testing.T) {
+	d := NewDecommissioner()
+	d.Start(nil)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&d.stopped))
+	d.Stop()
+
+	c := NewTenantUsageCollector()
+	c.Start(nil)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&c.stopped))
+	c.Stop()
+}
@@ -55,6 +55,8 @@ type IStore interface {
 	leadershipTransfer() error
 	SpecialCtlData(cmd string) error
 	ModifyRepDBMasterPt(db string, rgId uint32, newMasterPtId uint32) error
+	ExportMeta(databases []string) (*meta.MetaExport, error)
+	RestoreDatabase(exp *meta.MetaExport, database string, overwrite bool) error
 }
 
 var httpScheme = map[bool]string{
@@ -117,6 +119,8 @@ func (h *httpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			h.WrapHandler(h.serveAnalysisHeartInfo).ServeHTTP(w, r)
 		case "/debug/vars":
 			h.WrapHandler(h.serveExpvar).ServeHTTP(w, r)
+		case "/exportMeta":
+			h.WrapHandler(h.exportMeta).ServeHTTP(w, r)
 		}
 		h.logger.Info("serve get")
 	case "POST":
@@ -142,6 +146,8 @@ func (h *httpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			h.WrapHandler(h.specialCtlData).ServeHTTP(w, r)
 		case "/modifyRepDBMasterPt":
 			h.WrapHandler(h.modifyRepDBMasterPt).ServeHTTP(w, r)
+		case "/importMeta":
+			h.WrapHandler(h.importMeta).ServeHTTP(w, r)
 		}
 		h.logger.Info("serve post")
 	default:
@@ -550,3 +556,70 @@ func (h *httpHandler) modifyRepDBMasterPt(w http.ResponseWriter, r *http.Request
 	h.handleResponse(w, err)
 	h.logger.Info("modifyRepDBMasterPt", zap.String("db", db), zap.Uint64("rgId", rgId), zap.Uint64("newMasterPtId", newMasterPtId), zap.Error(err))
 }
+
+// export the meta data of the databases in json, use like this: curl -i -GET 'http://127.0.0.1:8091/exportMeta?db=db0,db1'
+// all the databases are exported without the parameter db, the password hashes of the users are redacted
+// unless withPassword=true is given
+func (h *httpHandler) exportMeta(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	var databases []string
+	if db := q.Get("db"); db != "" {
+		databases = strings.Split(db, ",")
+	}
+	exp, err := h.store.ExportMeta(databases)
+	if err != nil {
+		h.httpErr(err, w, http.StatusBadRequest)
+		return
+	}
+	if q.Get("withPassword") != "true" {
+		exp.RedactPasswords()
+	}
+	b, err := json.MarshalIndent(exp, "", "  ")
+	if err != nil {
+		h.httpErr(err, w, http.StatusInternalServerError)
+		return
+	}
+	w.Header().Add("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+// restore the meta data of a database exported by exportMeta, the body is the exported json,
+// use like this: curl -i -XPOST 'http://127.0.0.1:8091/importMeta?db=db0&overwrite=true' --data-binary @meta.json
+func (h *httpHandler) importMeta(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	db := q.Get("db")
+	if db == "" {
+		http.Error(w, "you should input db in importMeta command", http.StatusBadRequest)
+		return
+	}
+	overwrite := q.Get("overwrite") == "true"
+
+	exp := &meta.MetaExport{}
+	if err := json.NewDecoder(r.Body).Decode(exp); err != nil {
+		h.httpErr(fmt.Errorf("invalid meta export: %s", err), w, http.StatusBadRequest)
+		return
+	}
+	if exp.Version != meta.MetaExportVersion {
+		h.httpErr(fmt.Errorf("unsupported meta export version %d", exp.Version), w, http.StatusBadRequest)
+		return
+	}
+
+	err := h.store.RestoreDatabase(exp, db, overwrite)
+	if errno.Equal(err, errno.MetaIsNotLeader) {
+		l := h.store.leaderHTTP()
+		if l == "" {
+			h.httpErr(errors.New("no leader"), w, http.StatusServiceUnavailable)
+			return
+		}
+		scheme := "http://"
+		if h.config.HTTPSEnabled {
+			scheme = "https://"
+		}
+		url := fmt.Sprintf("%s%s/importMeta?%s", scheme, l, r.URL.RawQuery)
+		h.logger.Info("importMeta Redirect", zap.String("url", url))
+		http.Redirect(w, r, url, http.StatusTemporaryRedirect)
+		return
+	}
+	h.logger.Info("importMeta", zap.String("db", db), zap.Bool("overwrite", overwrite), zap.Error(err))
+	h.handleResponse(w, err)
+}
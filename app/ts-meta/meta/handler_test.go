@@ -19,6 +19,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -207,11 +208,67 @@ func (s *MockIStore) ModifyRepDBMasterPt(db string, rgId uint32, newMasterPtId u
 	return nil
 }
 
+func (s *MockIStore) ExportMeta(databases []string) (*meta2.MetaExport, error) {
+	exp := &meta2.MetaExport{Version: meta2.MetaExportVersion, Databases: make(map[string]*meta2.DatabaseInfo)}
+	for _, db := range databases {
+		if db != "db0" {
+			return nil, fmt.Errorf("database not found: %s", db)
+		}
+		exp.Databases[db] = &meta2.DatabaseInfo{Name: db}
+	}
+	exp.Users = []meta2.UserInfo{{Name: "user0", Hash: "hash0"}}
+	return exp, nil
+}
+
+func (s *MockIStore) RestoreDatabase(exp *meta2.MetaExport, database string, overwrite bool) error {
+	if _, ok := exp.Databases[database]; !ok {
+		return fmt.Errorf("database not found: %s", database)
+	}
+	return nil
+}
+
 func TestServeExpandGroups(t *testing.T) {
 	handler := newHttpHandler(&config.Meta{}, &MockIStore{})
 	handler.serveExpandGroups(&MockResponseWriter{}, nil)
 }
 
+func TestExportAndImportMeta(t *testing.T) {
+	handler := newHttpHandler(&config.Meta{}, &MockIStore{})
+
+	w := httptest.NewRecorder()
+	handler.exportMeta(w, httptest.NewRequest(http.MethodGet, "/exportMeta?db=db0", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	body := w.Body.String()
+	assert.Contains(t, body, `"Name": "db0"`)
+	// the password hashes are only exported on demand
+	assert.NotContains(t, body, "hash0")
+
+	w = httptest.NewRecorder()
+	handler.exportMeta(w, httptest.NewRequest(http.MethodGet, "/exportMeta?db=db0&withPassword=true", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"Hash": "hash0"`)
+
+	w = httptest.NewRecorder()
+	handler.exportMeta(w, httptest.NewRequest(http.MethodGet, "/exportMeta?db=db1", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.importMeta(w, httptest.NewRequest(http.MethodPost, "/importMeta?db=db0", strings.NewReader(body)))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.importMeta(w, httptest.NewRequest(http.MethodPost, "/importMeta?db=db1", strings.NewReader(body)))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.importMeta(w, httptest.NewRequest(http.MethodPost, "/importMeta", strings.NewReader(body)))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.importMeta(w, httptest.NewRequest(http.MethodPost, "/importMeta?db=db0", strings.NewReader(`{"Version":100}`)))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
 func TestGetDBBriefInfo_FromStore(t *testing.T) {
 	dir := t.TempDir()
 	mms, err := NewMockMetaService(dir, testIp)
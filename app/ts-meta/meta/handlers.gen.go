@@ -69,6 +69,8 @@ func New(typ uint8) RPCHandler {
 		return &SendSysCtrlToMeta{}
 	case message.ShowClusterRequestMessage:
 		return &ShowCluster{}
+	case message.DecommissionRequestMessage:
+		return &Decommission{}
 	default:
 		return nil
 	}
@@ -472,3 +474,22 @@ func (h *ShowCluster) SetRequestMsg(data transport.Codec) error {
 func (h *ShowCluster) Instance() RPCHandler {
 	return &ShowCluster{}
 }
+
+type Decommission struct {
+	BaseHandler
+
+	req *message.DecommissionRequest
+}
+
+func (h *Decommission) SetRequestMsg(data transport.Codec) error {
+	msg, ok := data.(*message.DecommissionRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*message.DecommissionRequest", data)
+	}
+	h.req = msg
+	return nil
+}
+
+func (h *Decommission) Instance() RPCHandler {
+	return &Decommission{}
+}
@@ -565,3 +565,26 @@ func (h *ShowCluster) Process() (transport.Codec, error) {
 	rsp.Data = b
 	return rsp, nil
 }
+
+func (h *Decommission) Process() (transport.Codec, error) {
+	rsp := &message.DecommissionResponse{}
+
+	b, err := h.store.Decommission(h.req.Cmd, h.req.NodeID)
+	if err == raft.ErrNotLeader {
+		rsp.Err = "node is not the leader"
+		return rsp, nil
+	}
+
+	if err != nil {
+		h.logger.Error("decommission fail", zap.String("cmd", h.req.Cmd), zap.Uint64("node", h.req.NodeID), zap.Error(err))
+		switch stdErr := err.(type) {
+		case *errno.Error:
+			rsp.ErrCode = stdErr.Errno()
+		default:
+		}
+		rsp.Err = err.Error()
+		return rsp, nil
+	}
+	rsp.Data = b
+	return rsp, nil
+}
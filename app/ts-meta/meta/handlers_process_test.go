@@ -448,3 +448,32 @@ func Test_ShowCluster(t *testing.T) {
 	msg1 := message.NewMetaMessage(message.SnapshotV2RequestMessage, &message.SnapshotV2Request{})
 	require.Error(t, h.SetRequestMsg(msg1.Data()))
 }
+
+func Test_Decommission(t *testing.T) {
+	mockStore := NewMockRPCStore()
+	req := &message.DecommissionRequest{Cmd: meta.DecommissionShow}
+	msg := message.NewMetaMessage(message.DecommissionRequestMessage, req)
+	h := New(msg.Type())
+	h.InitHandler(mockStore, nil, nil)
+	require.NoError(t, h.SetRequestMsg(msg.Data()))
+
+	mockStore.DecommissionFn = func(cmd string, nodeID uint64) ([]byte, error) { return []byte("[]"), nil }
+	rsp, err := h.Process()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("[]"), rsp.(*message.DecommissionResponse).Data)
+
+	mockStore.DecommissionFn = func(cmd string, nodeID uint64) ([]byte, error) { return nil, raft.ErrNotLeader }
+	rsp, err = h.Process()
+	require.NoError(t, err)
+	assert.Equal(t, "node is not the leader", rsp.(*message.DecommissionResponse).Err)
+
+	mockStore.DecommissionFn = func(cmd string, nodeID uint64) ([]byte, error) {
+		return nil, errno.NewError(errno.DataNodeNotFound)
+	}
+	rsp, err = h.Process()
+	require.NoError(t, err)
+	assert.Equal(t, errno.Errno(errno.DataNodeNotFound), rsp.(*message.DecommissionResponse).ErrCode)
+
+	msg1 := message.NewMetaMessage(message.ShowClusterRequestMessage, &message.ShowClusterRequest{})
+	require.Error(t, h.SetRequestMsg(msg1.Data()))
+}
@@ -112,6 +112,18 @@ func (bh *baseHandler) takeoverDbPts(id uint64) {
 	}
 }
 
+// takeoverPinnedDbPts assigns the pts added for the joined node to the pinned nodes of their tenants
+func (bh *baseHandler) takeoverPinnedDbPts(id uint64) {
+	dbPtInfos := globalService.store.getPinnedFailedDbPts(id, meta.Offline)
+	if len(dbPtInfos) == 0 {
+		return
+	}
+	nodePtNumMap := globalService.store.getDbPtNumPerAliveNode()
+	for i := range dbPtInfos {
+		bh.takeoverBase(dbPtInfos, nodePtNumMap, i, "pinned", false)
+	}
+}
+
 func (bh *baseHandler) takeoverBase(dbPtInfos []*meta.DbPtInfo, nodePtNumMap *map[uint64]uint32, i int, haConf string, isRepDb bool) {
 	err := bh.cm.processFailedDbPt(dbPtInfos[i], nodePtNumMap, false, isRepDb)
 	if err != nil {
@@ -165,6 +177,7 @@ func joinHandlerForSSOrWAF(jh *joinHandler, id uint64, event *serf.MemberEvent,
 	}
 	jh.cm.handleClusterMember(id, event)
 	jh.takeoverDbPts(id)
+	jh.takeoverPinnedDbPts(id)
 	return nil
 }
 
@@ -1269,3 +1269,66 @@ func (o *ShowClusterResponse) Size() int {
 func (o *ShowClusterResponse) Instance() transport.Codec {
 	return &ShowClusterResponse{}
 }
+
+func (o *DecommissionRequest) Marshal(buf []byte) ([]byte, error) {
+	buf = codec.AppendString(buf, o.Cmd)
+	buf = codec.AppendUint64(buf, o.NodeID)
+
+	return buf, nil
+}
+
+func (o *DecommissionRequest) Unmarshal(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	o.Cmd = dec.String()
+	o.NodeID = dec.Uint64()
+
+	return nil
+}
+
+func (o *DecommissionRequest) Size() int {
+	size := 0
+	size += codec.SizeOfString(o.Cmd)
+	size += codec.SizeOfUint64()
+
+	return size
+}
+
+func (o *DecommissionRequest) Instance() transport.Codec {
+	return &DecommissionRequest{}
+}
+
+func (o *DecommissionResponse) Marshal(buf []byte) ([]byte, error) {
+	buf = codec.AppendBytes(buf, o.Data)
+	buf = codec.AppendUint16(buf, uint16(o.ErrCode))
+	buf = codec.AppendString(buf, o.Err)
+
+	return buf, nil
+}
+
+func (o *DecommissionResponse) Unmarshal(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	o.Data = dec.Bytes()
+	o.ErrCode = errno.Errno(dec.Uint16())
+	o.Err = dec.String()
+
+	return nil
+}
+
+func (o *DecommissionResponse) Size() int {
+	size := 0
+	size += codec.SizeOfByteSlice(o.Data)
+	size += 2
+	size += codec.SizeOfString(o.Err)
+
+	return size
+}
+
+func (o *DecommissionResponse) Instance() transport.Codec {
+	return &DecommissionResponse{}
+}
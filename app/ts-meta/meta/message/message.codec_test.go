@@ -316,3 +316,12 @@ func Test_CreateSqlNode_Unmarshal(t *testing.T) {
 	err = myRep.Unmarshal(nil)
 	assert.NoError(t, err)
 }
+
+func Test_Decommission_Request_Response(t *testing.T) {
+	testCodec(t, &message.DecommissionRequest{Cmd: "start", NodeID: 3})
+	testCodec(t, &message.DecommissionResponse{Data: []byte("[]"), ErrCode: 4001, Err: "mock error"})
+
+	assert.NotNil(t, message.MetaMessageBinaryCodec[message.DecommissionRequestMessage])
+	assert.NotNil(t, message.MetaMessageBinaryCodec[message.DecommissionResponseMessage])
+	assert.Equal(t, message.DecommissionResponseMessage, message.MetaMessageResponseTyp[message.DecommissionRequestMessage])
+}
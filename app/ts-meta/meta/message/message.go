@@ -284,3 +284,14 @@ type ShowClusterResponse struct {
 	ErrCode errno.Errno
 	Err     string
 }
+
+type DecommissionRequest struct {
+	Cmd    string
+	NodeID uint64
+}
+
+type DecommissionResponse struct {
+	Data    []byte
+	ErrCode errno.Errno
+	Err     string
+}
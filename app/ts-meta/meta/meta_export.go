@@ -0,0 +1,66 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	mproto "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+// ExportMeta exports the meta data of the databases, all the databases are exported if databases is empty
+func (s *Store) ExportMeta(databases []string) (*meta.MetaExport, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.Export(databases...)
+}
+
+// RestoreDatabase restores the meta data of the exported database through the raft log. The pts of the database
+// which does not exist are created and assigned to the store nodes as creating the database before restore.
+func (s *Store) RestoreDatabase(exp *meta.MetaExport, database string, overwrite bool) error {
+	if !s.IsLeader() {
+		return errno.NewError(errno.MetaIsNotLeader)
+	}
+	cmd, err := exp.RestoreCommand(database, overwrite)
+	if err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	_, ok := s.data.PtView[database]
+	s.mu.RUnlock()
+	if !ok {
+		dbi := exp.Databases[database]
+		val := &mproto.CreateDatabaseCommand{
+			Name:           proto.String(database),
+			ReplicaNum:     proto.Uint32(uint32(max(dbi.ReplicaN, 1))),
+			EnableTagArray: proto.Bool(dbi.EnableTagArray),
+		}
+		t := mproto.Command_CreateDatabaseCommand
+		create := &mproto.Command{Type: &t}
+		if err = proto.SetExtension(create, mproto.E_CreateDatabaseCommand_Command, val); err != nil {
+			panic(err)
+		}
+		if err = createDatabase(create); err != nil {
+			return err
+		}
+	}
+
+	s.Logger.Info("restore database", zap.String("db", database), zap.Bool("overwrite", overwrite),
+		zap.Uint64("exportIndex", exp.Index))
+	return s.ApplyCmd(cmd)
+}
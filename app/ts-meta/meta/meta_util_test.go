@@ -494,6 +494,9 @@ type MockNetStorage struct {
 	DeleteRetentionPolicyFn func(node *meta2.DataNode, db string, rp string, ptId uint32) error
 	DeleteMeasurementFn     func(node *meta2.DataNode, db string, rp, name string, shardIds []uint64) error
 	MigratePtFn             func(nodeID uint64, data transport.Codec, cb transport.Callback) error
+	GetPtLoadsOnNodeFn      func(nodeID uint64) ([]*netstorage.PtLoad, error)
+	DropPtFilesFn           func(nodeID uint64, db string, pt uint32) error
+	SplitShardFn            func(nodeID uint64, db, rp string, pt uint32, shardID uint64) (int64, error)
 }
 
 func (s *MockNetStorage) GetShardSplitPoints(node *meta2.DataNode, database string, pt uint32,
@@ -517,6 +520,21 @@ func (s *MockNetStorage) MigratePt(nodeID uint64, data transport.Codec, cb trans
 	return s.MigratePtFn(nodeID, data, cb)
 }
 
+func (s *MockNetStorage) GetPtLoadsOnNode(nodeID uint64) ([]*netstorage.PtLoad, error) {
+	return s.GetPtLoadsOnNodeFn(nodeID)
+}
+
+func (s *MockNetStorage) DropPtFiles(nodeID uint64, db string, pt uint32) error {
+	if s.DropPtFilesFn == nil {
+		return nil
+	}
+	return s.DropPtFilesFn(nodeID, db, pt)
+}
+
+func (s *MockNetStorage) SplitShard(nodeID uint64, db, rp string, pt uint32, shardID uint64) (int64, error) {
+	return s.SplitShardFn(nodeID, db, rp, pt, shardID)
+}
+
 func (s *MockNetStorage) SendSegregateNodeCmds(nodeIDs []uint64, address []string) (int, error) {
 	if len(nodeIDs) == 1 && nodeIDs[0] == 10 {
 		return 0, fmt.Errorf("first node segregate error")
@@ -542,6 +560,12 @@ func NewMockNetStorage() *MockNetStorage {
 		cb.Handle(&netstorage.PtResponse{})
 		return nil
 	}
+	netStore.GetPtLoadsOnNodeFn = func(nodeID uint64) ([]*netstorage.PtLoad, error) {
+		return nil, nil
+	}
+	netStore.SplitShardFn = func(nodeID uint64, db, rp string, pt uint32, shardID uint64) (int64, error) {
+		return 0, nil
+	}
 	return netStore
 }
 
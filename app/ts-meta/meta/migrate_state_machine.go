@@ -120,7 +120,9 @@ func (m *MigrateStateMachine) createEventFromInfo(e *meta.MigrateEventInfo) Migr
 	case AssignType:
 		me = NewAssignEvent(e.GetPtInfo(), e.GetDst(), e.GetAliveConnId(), false)
 	case MoveType:
-		me = NewMoveEvent(e.GetPtInfo(), e.GetSrc(), e.GetDst(), e.GetAliveConnId(), false)
+		moveEvent := NewMoveEvent(e.GetPtInfo(), e.GetSrc(), e.GetDst(), e.GetAliveConnId(), false)
+		moveEvent.transferFiles = e.IsTransferFiles()
+		me = moveEvent
 	default:
 
 	}
@@ -169,6 +171,9 @@ func (m *MigrateStateMachine) sendMigrateCommand(e MigrateEvent) (NextAction, er
 	// todo you should send operation id to store to make sure store response should not delete other events
 	ptReq.OpId = proto.Uint64(e.getOpId())
 	ptReq.AliveConnId = proto.Uint64(e.getAliveConnId())
+	if me, ok := e.(*MoveEvent); ok && me.transferFiles {
+		ptReq.TransferFiles = proto.Bool(true)
+	}
 
 	// todo if you want async handle response do not set callback
 	cb := &netstorage.MigratePtCallback{}
@@ -218,6 +223,12 @@ func (m *MigrateStateMachine) handleCmdResult(err error, e MigrateEvent) Schedul
 		return ScheduleNormal
 	}
 
+	if errno.Equal(err, errno.PtIsTransferring) {
+		// the files of the pt are still copied to the target node by the rebalancing, poll the progress slowly
+		time.Sleep(ptTransferPollInterval)
+		return ScheduleRetry
+	}
+
 	if !errno.Equal(err, errno.SelectClosedConn) && !errno.Equal(err, errno.PtIsAlreadyMigrating) &&
 		!errno.Equal(err, errno.SessionSelectTimeout) {
 		e.increaseRetryCnt()
@@ -396,7 +407,8 @@ func (m *MigrateStateMachine) deleteEvent(e MigrateEvent) {
 func (m *MigrateStateMachine) addToEventMap(e MigrateEvent) error {
 	m.eventMapMu.Lock()
 	srcNodeSegStatus := globalService.store.data.GetSegregateStatusByNodeId(e.getSrc())
-	if srcNodeSegStatus != meta.Normal {
+	// the pts of the decommissioning node are moved out of it
+	if srcNodeSegStatus != meta.Normal && srcNodeSegStatus != meta.Decommissioning {
 		m.eventMapMu.Unlock()
 		return errno.NewError(errno.EventSrcNodeSegregating, e.getSrc())
 	}
@@ -34,6 +34,10 @@ type MoveEvent struct {
 	curState      meta.MoveState
 	preState      meta.MoveState
 	rollbackState meta.MoveState
+
+	// the files of the pt are transferred from the source node to the destination node by the store,
+	// only set for the moves of the rebalancing, the other moves load the pt from the data of the destination node
+	transferFiles bool
 }
 
 func NewMoveEvent(pt *meta.DbPtInfo, src, dst uint64, aliveConnId uint64, isUserCommand bool) *MoveEvent {
@@ -68,6 +72,7 @@ func (e *MoveEvent) marshalEvent() *mproto.MigrateEventInfo {
 		Dest:          proto.Uint64(e.getDst()),
 		OpId:          proto.Uint64(e.getOpId()),
 		CheckConflict: proto.Bool(true),
+		TransferFiles: proto.Bool(e.transferFiles),
 	}
 }
 
@@ -316,6 +321,7 @@ func moveAssignedHandler(e *MoveEvent) (NextAction, error) {
 	if err != nil {
 		return ActionContinue, err
 	}
+	e.dropSourcePtFiles()
 
 	return ActionFinish, nil
 }
@@ -58,6 +58,7 @@ type MetaStoreInterface interface {
 	getContinuousQueryLease(host string) ([]string, error)
 	verifyDataNodeStatus(nodeID uint64) error
 	ShowCluster(body []byte) ([]byte, error)
+	Decommission(cmd string, nodeID uint64) ([]byte, error)
 }
 
 type RPCHandler interface {
@@ -65,8 +65,9 @@ var mockAfterIndexFail bool = true
 type MockRPCStore struct {
 	MetaStoreInterface
 
-	stat          raft.RaftState
-	ShowClusterFn func(body []byte) ([]byte, error)
+	stat           raft.RaftState
+	ShowClusterFn  func(body []byte) ([]byte, error)
+	DecommissionFn func(cmd string, nodeID uint64) ([]byte, error)
 }
 
 func NewMockRPCStore() *MockRPCStore {
@@ -97,6 +98,13 @@ func (s *MockRPCStore) ShowCluster(body []byte) ([]byte, error) {
 	return s.ShowClusterFn(body)
 }
 
+func (s *MockRPCStore) Decommission(cmd string, nodeID uint64) ([]byte, error) {
+	if s.DecommissionFn == nil {
+		return nil, nil
+	}
+	return s.DecommissionFn(cmd, nodeID)
+}
+
 func (s *MockRPCStore) CreateSqlNode(httpAddr string, gossipAddr string) ([]byte, error) {
 	nodeStartInfo := meta.NodeStartInfo{}
 	nodeStartInfo.NodeId = 1
@@ -0,0 +1,295 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// the interval of polling the store while the files of a moved pt are transferred
+var ptTransferPollInterval = time.Second
+
+// ptLoadScore is the load of a pt on a node, the write and the query loads are the rates per second
+type ptLoadScore struct {
+	db     string
+	ptId   uint32
+	nodeId uint64
+
+	disk   float64
+	series float64
+	write  float64
+	query  float64
+	score  float64
+}
+
+// Rebalancer moves the pts between the ts-store nodes by their loads on the write-available-first clusters.
+// The load of a pt weighs the disk usage, the series count and the write and the query rates reported by the stores,
+// a pt of the busiest node is moved to the idlest node when the busiest node exceeds the average by the threshold.
+// The files of the moved pt are streamed to the target node by the store during the PreAssign and the Assign.
+type Rebalancer struct {
+	conf config.RebalanceConfig
+
+	lastRun     time.Time
+	lastSample  time.Time
+	lastLoads   map[string]*netstorage.PtLoad // key: node/db/pt
+	logger      *logger.Logger
+	selectMoves func() []*MoveEvent
+}
+
+func NewRebalancer(conf config.RebalanceConfig) *Rebalancer {
+	r := &Rebalancer{
+		conf:      conf,
+		lastLoads: make(map[string]*netstorage.PtLoad),
+		logger:    logger.NewLogger(errno.ModuleHA),
+	}
+	r.selectMoves = r.selectPtsToRebalance
+	return r
+}
+
+func ptLoadKey(nodeId uint64, db string, ptId uint32) string {
+	return fmt.Sprintf("%d/%s/%d", nodeId, db, ptId)
+}
+
+// rebalance moves the pts selected by the loads, it is called every balanceInterval
+// and collects the loads every run-interval
+func (r *Rebalancer) rebalance() {
+	if time.Since(r.lastRun) < time.Duration(r.conf.RunInterval) {
+		return
+	}
+	r.lastRun = time.Now()
+	for _, e := range r.selectMoves() {
+		if err := globalService.msm.executeEvent(e); err != nil {
+			r.logger.Error("[rebalancer] move pt failed", zap.String("event", e.String()), zap.Error(err))
+		}
+	}
+}
+
+func (r *Rebalancer) selectPtsToRebalance() []*MoveEvent {
+	store := globalService.store
+	store.mu.RLock()
+	if !store.data.BalancerEnabled {
+		store.mu.RUnlock()
+		return nil
+	}
+	var aliveNodes []uint64
+	for _, dataNode := range store.data.DataNodes {
+		if dataNode.SegregateStatus == meta.Normal && dataNode.Status == serf.StatusAlive && dataNode.AliveConnID == dataNode.ConnID {
+			aliveNodes = append(aliveNodes, dataNode.ID)
+		}
+	}
+	moving := 0
+	store.data.WalkMigrateEvents(func(eventId string, info *meta.MigrateEventInfo) {
+		if info.GetEventType() == int(MoveType) {
+			moving++
+		}
+	})
+	store.mu.RUnlock()
+
+	if len(aliveNodes) < 2 || moving >= r.conf.MaxConcurrentMoves {
+		return nil
+	}
+	sort.Slice(aliveNodes, func(i, j int) bool {
+		return aliveNodes[i] < aliveNodes[j]
+	})
+
+	var loads []*ptLoadScore
+	for _, nodeId := range aliveNodes {
+		ptLoads, err := store.NetStore.GetPtLoadsOnNode(nodeId)
+		if err != nil {
+			// the loads of the cluster are incomplete, wait for the next run
+			r.logger.Warn("[rebalancer] get pt loads failed", zap.Uint64("node", nodeId), zap.Error(err))
+			return nil
+		}
+		loads = append(loads, r.samplePtLoads(nodeId, ptLoads)...)
+	}
+	r.lastSample = time.Now()
+
+	r.scorePtLoads(loads)
+	// the pts placed out of the pinned nodes of their tenants are moved first
+	mv := selectMisplacedPt(aliveNodes, loads, store.nodeAllowed)
+	if mv == nil {
+		mv = selectPtToMove(aliveNodes, loads, r.conf.ImbalanceThreshold, store.nodeAllowed)
+	}
+	if mv == nil {
+		return nil
+	}
+	return r.newMoveEvent(mv.from, mv.to, mv.pt)
+}
+
+// samplePtLoads converts the accumulated write rows and queries of the pts into the rates since the last sample
+func (r *Rebalancer) samplePtLoads(nodeId uint64, ptLoads []*netstorage.PtLoad) []*ptLoadScore {
+	elapsed := time.Since(r.lastSample).Seconds()
+	scores := make([]*ptLoadScore, 0, len(ptLoads))
+	for _, load := range ptLoads {
+		key := ptLoadKey(nodeId, load.Db, load.PtId)
+		score := &ptLoadScore{
+			db:     load.Db,
+			ptId:   load.PtId,
+			nodeId: nodeId,
+			disk:   float64(load.DiskBytes),
+			series: float64(load.Series),
+		}
+		if last, ok := r.lastLoads[key]; ok && elapsed > 0 && load.WriteRows >= last.WriteRows && load.Queries >= last.Queries {
+			score.write = float64(load.WriteRows-last.WriteRows) / elapsed
+			score.query = float64(load.Queries-last.Queries) / elapsed
+		}
+		r.lastLoads[key] = load
+		scores = append(scores, score)
+	}
+	return scores
+}
+
+// scorePtLoads normalizes every dimension of the loads by its total in the cluster and weighs them
+func (r *Rebalancer) scorePtLoads(loads []*ptLoadScore) {
+	var disk, series, write, query float64
+	for _, l := range loads {
+		disk += l.disk
+		series += l.series
+		write += l.write
+		query += l.query
+	}
+	normalize := func(v, total float64) float64 {
+		if total == 0 {
+			return 0
+		}
+		return v / total
+	}
+	for _, l := range loads {
+		l.score = r.conf.DiskWeight*normalize(l.disk, disk) + r.conf.SeriesWeight*normalize(l.series, series) +
+			r.conf.WriteWeight*normalize(l.write, write) + r.conf.QueryWeight*normalize(l.query, query)
+	}
+}
+
+type ptMove struct {
+	pt       *ptLoadScore
+	from, to uint64
+}
+
+// selectPtToMove selects a pt of the busiest node to move to the idlest node. The pt whose score is the closest to
+// the half of the gap between the two nodes is selected, so the move narrows the gap instead of swapping the nodes.
+// The pts which are not allowed on the idlest node are skipped, nil allowed means all pts are allowed.
+func selectPtToMove(nodes []uint64, loads []*ptLoadScore, threshold float64, allowed func(db string, nodeId uint64) bool) *ptMove {
+	nodeLoads := make(map[uint64]float64, len(nodes))
+	var total float64
+	for _, l := range loads {
+		nodeLoads[l.nodeId] += l.score
+		total += l.score
+	}
+	avg := total / float64(len(nodes))
+	if avg == 0 {
+		return nil
+	}
+
+	busiest, idlest := nodes[0], nodes[0]
+	for _, n := range nodes {
+		if nodeLoads[n] > nodeLoads[busiest] {
+			busiest = n
+		}
+		if nodeLoads[n] < nodeLoads[idlest] {
+			idlest = n
+		}
+	}
+	if nodeLoads[busiest]-avg <= threshold*avg {
+		return nil
+	}
+
+	gap := nodeLoads[busiest] - nodeLoads[idlest]
+	var selected *ptLoadScore
+	for _, l := range loads {
+		if l.nodeId != busiest || l.score <= 0 || l.score >= gap {
+			continue
+		}
+		if allowed != nil && !allowed(l.db, idlest) {
+			continue
+		}
+		if selected == nil || math.Abs(l.score-gap/2) < math.Abs(selected.score-gap/2) {
+			selected = l
+		}
+	}
+	if selected == nil {
+		return nil
+	}
+	return &ptMove{pt: selected, from: busiest, to: idlest}
+}
+
+// selectMisplacedPt selects a pt placed out of the pinned nodes of its tenant, it is moved to the idlest allowed node
+func selectMisplacedPt(nodes []uint64, loads []*ptLoadScore, allowed func(db string, nodeId uint64) bool) *ptMove {
+	nodeLoads := make(map[uint64]float64, len(nodes))
+	for _, l := range loads {
+		nodeLoads[l.nodeId] += l.score
+	}
+	for _, l := range loads {
+		if allowed(l.db, l.nodeId) {
+			continue
+		}
+		var to uint64
+		found := false
+		for _, n := range nodes {
+			if allowed(l.db, n) && (!found || nodeLoads[n] < nodeLoads[to]) {
+				to, found = n, true
+			}
+		}
+		if found {
+			return &ptMove{pt: l, from: l.nodeId, to: to}
+		}
+	}
+	return nil
+}
+
+func (r *Rebalancer) newMoveEvent(from, to uint64, l *ptLoadScore) []*MoveEvent {
+	store := globalService.store
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	if err := store.data.CheckCanMoveDb(l.db); err != nil {
+		r.logger.Warn("[rebalancer] can not move pt", zap.String("db", l.db), zap.Uint32("pt", l.ptId), zap.Error(err))
+		return nil
+	}
+	pt := store.data.GetPtInfo(l.db, l.ptId)
+	dn := store.data.DataNode(to)
+	if pt == nil || dn == nil || pt.Status != meta.Online || pt.Owner.NodeID != from {
+		return nil
+	}
+	r.logger.Info("[rebalancer] move pt", zap.String("db", l.db), zap.Uint32("pt", l.ptId), zap.Uint64("from", from),
+		zap.Uint64("to", to), zap.Float64("score", l.score))
+	ptInfo := *pt
+	e := NewMoveEvent(&meta.DbPtInfo{Db: l.db, Pti: &ptInfo, Shards: store.data.GetShardDurationsByDbPt(l.db, l.ptId),
+		DBBriefInfo: store.data.GetDBBriefInfo(l.db)}, from, to, dn.AliveConnID, false)
+	e.transferFiles = true
+	return []*MoveEvent{e}
+}
+
+// dropSourcePtFiles removes the files of the transferred pt on the source node. It is called after the new owner
+// of the pt is committed, so the source node keeps the complete files until the destination node owns the pt.
+func (e *MoveEvent) dropSourcePtFiles() {
+	if !e.transferFiles || e.src == e.dst {
+		return
+	}
+	err := globalService.store.NetStore.DropPtFiles(e.src, e.pt.Db, e.pt.Pti.PtId)
+	if err != nil {
+		logger.GetLogger().Warn("[rebalancer] drop pt files on the source node failed", zap.String("event", e.String()), zap.Error(err))
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectPtToMove(t *testing.T) {
+	nodes := []uint64{1, 2, 3}
+	loads := []*ptLoadScore{
+		{db: "db0", ptId: 0, nodeId: 1, score: 0.3},
+		{db: "db0", ptId: 1, nodeId: 1, score: 0.2},
+		{db: "db0", ptId: 2, nodeId: 1, score: 0.1},
+		{db: "db0", ptId: 3, nodeId: 2, score: 0.3},
+		{db: "db0", ptId: 4, nodeId: 2, score: 0.1},
+	}
+
+	// node 3 is new, the pt closest to the half of the gap 0.6 is moved
+	mv := selectPtToMove(nodes, loads, 0.2, nil)
+	require.NotNil(t, mv)
+	assert.Equal(t, uint64(1), mv.from)
+	assert.Equal(t, uint64(3), mv.to)
+	assert.Equal(t, uint32(0), mv.pt.ptId)
+
+	// the busiest node does not exceed the threshold
+	assert.Nil(t, selectPtToMove(nodes, loads, 0.9, nil))
+
+	// no load in the cluster
+	assert.Nil(t, selectPtToMove(nodes, nil, 0.2, nil))
+
+	// the only pt of the busiest node is larger than the gap
+	assert.Nil(t, selectPtToMove([]uint64{1, 2}, []*ptLoadScore{{nodeId: 1, score: 1}}, 0.2, nil))
+
+	// db0 is pinned to the nodes 1 and 2, so the pts of db1 are moved to node 3
+	pinned := func(db string, nodeId uint64) bool {
+		return db != "db0" || nodeId != 3
+	}
+	assert.Nil(t, selectPtToMove(nodes, loads, 0.2, pinned))
+	loads = append(loads, &ptLoadScore{db: "db1", ptId: 0, nodeId: 1, score: 0.25})
+	mv = selectPtToMove(nodes, loads, 0.2, pinned)
+	require.NotNil(t, mv)
+	assert.Equal(t, "db1", mv.pt.db)
+	assert.Equal(t, uint64(3), mv.to)
+}
+
+func TestSelectMisplacedPt(t *testing.T) {
+	nodes := []uint64{1, 2, 3}
+	loads := []*ptLoadScore{
+		{db: "db0", ptId: 0, nodeId: 1, score: 0.3},
+		{db: "db0", ptId: 1, nodeId: 2, score: 0.1},
+		{db: "db1", ptId: 0, nodeId: 3, score: 0.2},
+	}
+	all := func(db string, nodeId uint64) bool { return true }
+	assert.Nil(t, selectMisplacedPt(nodes, loads, all))
+
+	// db1 is pinned to the nodes 1 and 2, its pt is moved to the idlest of them
+	pinned := func(db string, nodeId uint64) bool {
+		return db != "db1" || nodeId != 3
+	}
+	mv := selectMisplacedPt(nodes, loads, pinned)
+	require.NotNil(t, mv)
+	assert.Equal(t, "db1", mv.pt.db)
+	assert.Equal(t, uint64(3), mv.from)
+	assert.Equal(t, uint64(2), mv.to)
+}
+
+func TestRebalancer_ScorePtLoads(t *testing.T) {
+	conf := config.NewRebalanceConfig()
+	r := NewRebalancer(conf)
+
+	loads := r.samplePtLoads(1, []*netstorage.PtLoad{
+		{Db: "db0", PtId: 0, DiskBytes: 300, Series: 10, WriteRows: 100, Queries: 10},
+		{Db: "db0", PtId: 1, DiskBytes: 100, Series: 30, WriteRows: 100, Queries: 10},
+	})
+	// the rates are unknown before the second sample
+	assert.Equal(t, float64(0), loads[0].write)
+	assert.Equal(t, float64(0), loads[1].query)
+
+	r.lastSample = time.Now().Add(-10 * time.Second)
+	loads = r.samplePtLoads(1, []*netstorage.PtLoad{
+		{Db: "db0", PtId: 0, DiskBytes: 300, Series: 10, WriteRows: 1100, Queries: 10},
+		{Db: "db0", PtId: 1, DiskBytes: 100, Series: 30, WriteRows: 100, Queries: 110},
+	})
+	assert.InDelta(t, 100, loads[0].write, 1)
+	assert.InDelta(t, 10, loads[1].query, 1)
+
+	r.scorePtLoads(loads)
+	assert.InDelta(t, conf.DiskWeight*0.75+conf.SeriesWeight*0.25+conf.WriteWeight, loads[0].score, 0.001)
+	assert.InDelta(t, conf.DiskWeight*0.25+conf.SeriesWeight*0.75+conf.QueryWeight, loads[1].score, 0.001)
+}
+
+func TestRebalancer_Rebalance(t *testing.T) {
+	conf := config.NewRebalanceConfig()
+	r := NewRebalancer(conf)
+	calls := 0
+	r.selectMoves = func() []*MoveEvent {
+		calls++
+		return nil
+	}
+	r.rebalance()
+	r.rebalance()
+	// the loads are collected once in the run-interval
+	assert.Equal(t, 1, calls)
+}
+
+func TestMoveEvent_TransferFiles(t *testing.T) {
+	var dropped []uint64
+	s := &Store{
+		data: &meta.Data{Databases: map[string]*meta.DatabaseInfo{"db0": {Name: "db0"}}},
+		NetStore: &MockNetStorage{DropPtFilesFn: func(nodeID uint64, db string, pt uint32) error {
+			dropped = append(dropped, nodeID)
+			return nil
+		}},
+	}
+	globalService = &Service{store: s}
+	defer func() {
+		globalService = nil
+	}()
+
+	pt := &meta.DbPtInfo{Db: "db0", Pti: &meta.PtInfo{PtId: 1, Owner: meta.PtOwner{NodeID: 1}},
+		DBBriefInfo: &meta.DatabaseBriefInfo{Name: "db0"}}
+	e := NewMoveEvent(pt, 1, 2, 0, false)
+	// the moves which are not started by the rebalancing do not transfer the files
+	e.dropSourcePtFiles()
+	assert.Empty(t, dropped)
+	assert.False(t, e.marshalEvent().GetTransferFiles())
+
+	// the transfer survives the change of the leader
+	e.transferFiles = true
+	require.NoError(t, s.data.CreateMigrateEvent(e.marshalEvent()))
+	info := s.data.MigrateEvents[e.getEventId()]
+	require.True(t, info.IsTransferFiles())
+	recovered, ok := (&MigrateStateMachine{}).createEventFromInfo(info).(*MoveEvent)
+	require.True(t, ok)
+	assert.True(t, recovered.transferFiles)
+
+	recovered.dropSourcePtFiles()
+	assert.Equal(t, []uint64{1}, dropped)
+}
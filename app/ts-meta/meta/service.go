@@ -58,6 +58,9 @@ type Service struct {
 	clusterManager *ClusterManager
 	msm            *MigrateStateMachine
 	balanceManager *BalanceManager
+	decommissioner *Decommissioner
+	shardSplitter  *ShardSplitter
+	tenantUsage    *TenantUsageCollector
 
 	httpServer *httpServer
 	metaServer *MetaServer
@@ -103,6 +106,14 @@ func (s *Service) startMetaServer() error {
 
 	s.clusterManager = NewClusterManager(s.store)
 	s.balanceManager = NewBalanceManager(s.config.BalanceAlgo)
+	if s.config.Rebalance.Enabled {
+		s.balanceManager.rebalancer = NewRebalancer(s.config.Rebalance)
+	}
+	s.decommissioner = NewDecommissioner()
+	s.tenantUsage = NewTenantUsageCollector()
+	if s.config.ShardSplit.Enabled {
+		s.shardSplitter = NewShardSplitter(s.config.ShardSplit)
+	}
 	s.msm = NewMigrateStateMachine()
 	s.store.cm = s.clusterManager
 	return nil
@@ -205,6 +216,15 @@ func (s *Service) Close() error {
 	if s.balanceManager != nil {
 		s.balanceManager.Stop()
 	}
+	if s.decommissioner != nil {
+		s.decommissioner.Stop()
+	}
+	if s.tenantUsage != nil {
+		s.tenantUsage.Stop()
+	}
+	if s.shardSplitter != nil {
+		s.shardSplitter.Stop()
+	}
 	if s.msm != nil {
 		s.msm.Stop()
 	}
@@ -0,0 +1,414 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	mproto "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+var shardSplitCheckInterval = time.Second
+
+// shardWriteStat is the row count of the newest shard of a retention policy reported by the store owning the shard,
+// the write rate of the shard is computed from the row counts between two checks
+type shardWriteStat struct {
+	db   string
+	rp   string
+	ptId uint32
+
+	rows     uint64
+	reportAt time.Time
+
+	checkRows uint64
+	checkAt   time.Time
+	hotRounds int
+}
+
+type hotShard struct {
+	db      string
+	rp      string
+	shardID uint64
+	rows    uint64
+	rate    float64
+}
+
+type splitPlan struct {
+	sgID    uint64
+	ownerPt uint32
+	node    *meta.DataNode
+	pt      uint32
+}
+
+// ShardSplitter splits the hot shards of the range sharding retention policies. A shard is hot when its write rate
+// exceeds the threshold in the consecutive checks, it is split at the shard key in the middle of its rows, and a new
+// shard owned by the idlest pt takes the rows after the split key. The new rows are routed to the new shard once the
+// split is applied, the rows written before are moved by the store owning the hot shard. The splits whose rows are
+// not moved are kept in the meta data, so a new leader continues moving the rows where the old one stopped.
+type ShardSplitter struct {
+	conf config.ShardSplitConfig
+
+	wg      sync.WaitGroup
+	stopped int32
+	lastRun time.Time
+
+	mu     sync.Mutex
+	stats  map[uint64]*shardWriteStat // key: shard id
+	logger *logger.Logger
+}
+
+func NewShardSplitter(conf config.ShardSplitConfig) *ShardSplitter {
+	return &ShardSplitter{
+		conf:    conf,
+		stopped: 1,
+		stats:   make(map[uint64]*shardWriteStat),
+		logger:  logger.NewLogger(errno.ModuleHA),
+	}
+}
+
+// Start shard split goroutine
+func (s *ShardSplitter) Start() {
+	atomic.StoreInt32(&s.stopped, 0)
+	s.wg.Add(1)
+	go s.splitIfNeeded()
+}
+
+// Stop shard split goroutine
+func (s *ShardSplitter) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.stopped, 0, 1) {
+		return
+	}
+	s.wg.Wait()
+}
+
+func (s *ShardSplitter) splitIfNeeded() {
+	s.logger.Info("[shard split] start")
+	defer s.wg.Done()
+	for {
+		if atomic.LoadInt32(&s.stopped) == 1 {
+			return
+		}
+		if time.Since(s.lastRun) >= time.Duration(s.conf.RunInterval) {
+			s.lastRun = time.Now()
+			s.check()
+		}
+		time.Sleep(shardSplitCheckInterval)
+	}
+}
+
+// check moves the rows of the unfinished splits, then splits the hot shards
+func (s *ShardSplitter) check() {
+	for _, sp := range pendingSplits() {
+		if err := s.moveRows(sp.db, sp.rp, sp.sgID, sp.shardID); err != nil {
+			s.logger.Warn("[shard split] move rows failed", zap.String("db", sp.db), zap.Uint64("shard", sp.shardID), zap.Error(err))
+		}
+	}
+	for _, h := range s.hotShards(time.Now()) {
+		if err := s.split(h); err != nil {
+			s.logger.Warn("[shard split] split shard failed", zap.String("db", h.db), zap.String("rp", h.rp),
+				zap.Uint64("shard", h.shardID), zap.Float64("rate", h.rate), zap.Error(err))
+		}
+	}
+}
+
+// updateLoad records the row count of the newest shard reported by the store
+func (s *ShardSplitter) updateLoad(db, rp string, ptId uint32, stat *mproto.ShardStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.stats[stat.GetShardID()]
+	if !ok {
+		st = &shardWriteStat{db: db, rp: rp, ptId: ptId}
+		s.stats[stat.GetShardID()] = st
+	}
+	st.rows = stat.GetShardSize()
+	st.reportAt = time.Now()
+}
+
+// hotShards returns the shards whose write rates exceed the threshold in hot-rounds consecutive checks,
+// the stats of the shards which are not reported for a while are removed
+func (s *ShardSplitter) hotShards(now time.Time) []*hotShard {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var shards []*hotShard
+	expired := 3 * time.Duration(s.conf.RunInterval)
+	for id, st := range s.stats {
+		if now.Sub(st.reportAt) > expired {
+			delete(s.stats, id)
+			continue
+		}
+		elapsed := st.reportAt.Sub(st.checkAt).Seconds()
+		if st.checkAt.IsZero() || elapsed <= 0 || st.rows < st.checkRows {
+			st.checkRows, st.checkAt = st.rows, st.reportAt
+			continue
+		}
+		rate := float64(st.rows-st.checkRows) / elapsed
+		st.checkRows, st.checkAt = st.rows, st.reportAt
+		if rate < float64(s.conf.HotWriteRate) {
+			st.hotRounds = 0
+			continue
+		}
+		st.hotRounds++
+		if st.hotRounds < s.conf.HotRounds {
+			continue
+		}
+		st.hotRounds = 0
+		shards = append(shards, &hotShard{db: st.db, rp: st.rp, shardID: id, rows: st.rows, rate: rate})
+	}
+	sort.Slice(shards, func(i, j int) bool {
+		return shards[i].rate > shards[j].rate
+	})
+	return shards
+}
+
+// split splits the hot shard at the shard key in the middle of its rows and moves the rows after the split key
+func (s *ShardSplitter) split(h *hotShard) error {
+	store := globalService.store
+	store.mu.RLock()
+	plan, err := s.planSplit(store.data, h)
+	store.mu.RUnlock()
+	if err != nil || plan == nil {
+		return err
+	}
+
+	splitKeys, err := store.NetStore.GetShardSplitPoints(plan.node, h.db, plan.ownerPt, h.shardID, []int64{int64(h.rows / 2)})
+	if err != nil {
+		return err
+	}
+	if len(splitKeys) == 0 {
+		return fmt.Errorf("no split key of shard %d", h.shardID)
+	}
+	if err = store.splitShard(h.db, h.rp, plan.sgID, h.shardID, splitKeys[0], plan.pt); err != nil {
+		return err
+	}
+	s.logger.Info("[shard split] split hot shard", zap.String("db", h.db), zap.String("rp", h.rp), zap.Uint64("shard", h.shardID),
+		zap.Float64("rate", h.rate), zap.String("splitKey", splitKeys[0]), zap.Uint32("pt", plan.pt))
+	return s.moveRows(h.db, h.rp, plan.sgID, h.shardID)
+}
+
+// planSplit selects the pt owning the new shard, the pt owning the fewest shards of the shard group is selected
+// and the pts other than the owner of the hot shard are preferred. It returns nil if the shard is not to be split.
+func (s *ShardSplitter) planSplit(data *meta.Data, h *hotShard) (*splitPlan, error) {
+	rp, err := data.RetentionPolicy(h.db, h.rp)
+	if err != nil {
+		return nil, err
+	}
+	if !rp.RangeSharding() {
+		return nil, nil
+	}
+	sg := data.NewestShardGroup(h.db, h.rp)
+	if sg == nil || sg.EngineType != config.TSSTORE {
+		return nil, nil
+	}
+	sh := sg.Shard(h.shardID)
+	if sh == nil || sh.MarkDelete || sh.SplitFrom != 0 || sg.Splitting(sh.ID) || len(sh.Owners) == 0 {
+		return nil, nil
+	}
+	pts := data.PtView[h.db]
+	maxShards := s.conf.MaxShardsPerGroup
+	if maxShards == 0 {
+		maxShards = len(pts)
+	}
+	if len(sg.Shards) >= maxShards {
+		return nil, nil
+	}
+
+	ownerPt := sh.Owners[0]
+	if int(ownerPt) >= len(pts) {
+		return nil, errno.NewError(errno.PtNotFound)
+	}
+	node := data.DataNode(pts[ownerPt].Owner.NodeID)
+	if node == nil {
+		return nil, errno.NewError(errno.DataNodeNotFound, pts[ownerPt].Owner.NodeID)
+	}
+
+	shardsOfPt := make(map[uint32]int, len(pts))
+	for i := range sg.Shards {
+		if !sg.Shards[i].MarkDelete && len(sg.Shards[i].Owners) > 0 {
+			shardsOfPt[sg.Shards[i].Owners[0]]++
+		}
+	}
+	target, found := uint32(0), false
+	for i := range pts {
+		if pts[i].Status != meta.Online {
+			continue
+		}
+		dn := data.DataNode(pts[i].Owner.NodeID)
+		if dn == nil || dn.Status != serf.StatusAlive || dn.SegregateStatus != meta.Normal {
+			continue
+		}
+		pt := pts[i].PtId
+		if !found || lessSplitTarget(pt, target, ownerPt, shardsOfPt) {
+			target, found = pt, true
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no pt available for the shard split from shard %d", h.shardID)
+	}
+	return &splitPlan{sgID: sg.ID, ownerPt: ownerPt, node: node, pt: target}, nil
+}
+
+func lessSplitTarget(pt, target, ownerPt uint32, shardsOfPt map[uint32]int) bool {
+	if shardsOfPt[pt] != shardsOfPt[target] {
+		return shardsOfPt[pt] < shardsOfPt[target]
+	}
+	if (pt == ownerPt) != (target == ownerPt) {
+		return target == ownerPt
+	}
+	return pt < target
+}
+
+// moveRows makes the store owning the shard move its rows to the shards split from it,
+// then marks the splits done so the queries read the moved rows from the new shards only
+func (s *ShardSplitter) moveRows(db, rp string, sgID, shardID uint64) error {
+	store := globalService.store
+	store.mu.RLock()
+	var children []meta.ShardInfo
+	var nodeID uint64
+	var ptId uint32
+	var err error
+	sg := shardGroupByID(store.data, db, rp, sgID)
+	if sg == nil {
+		err = meta.ErrShardGroupNotFound
+	} else if sh := sg.Shard(shardID); sh == nil || len(sh.Owners) == 0 {
+		err = errno.NewError(errno.ShardNotFound, shardID)
+	} else if pts := store.data.PtView[db]; int(sh.Owners[0]) >= len(pts) {
+		err = errno.NewError(errno.PtNotFound)
+	} else {
+		ptId = sh.Owners[0]
+		nodeID = pts[ptId].Owner.NodeID
+		children = sg.SplitShards(shardID)
+	}
+	store.mu.RUnlock()
+	if err != nil || len(children) == 0 {
+		return err
+	}
+
+	rows, err := store.NetStore.SplitShard(nodeID, db, rp, ptId, shardID)
+	if err != nil {
+		return err
+	}
+	for i := range children {
+		if err = store.splitShardDone(db, rp, sgID, children[i].ID); err != nil {
+			return err
+		}
+	}
+	s.logger.Info("[shard split] rows moved", zap.String("db", db), zap.String("rp", rp), zap.Uint64("shard", shardID),
+		zap.Int64("rows", rows))
+	return nil
+}
+
+func shardGroupByID(data *meta.Data, db, rp string, sgID uint64) *meta.ShardGroupInfo {
+	rpi, err := data.RetentionPolicy(db, rp)
+	if err != nil || rpi == nil {
+		return nil
+	}
+	for i := range rpi.ShardGroups {
+		if rpi.ShardGroups[i].ID == sgID {
+			return &rpi.ShardGroups[i]
+		}
+	}
+	return nil
+}
+
+type pendingSplit struct {
+	db      string
+	rp      string
+	sgID    uint64
+	shardID uint64
+}
+
+// pendingSplits returns the shards whose rows are not moved to the shards split from them
+func pendingSplits() []pendingSplit {
+	store := globalService.store
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	var splits []pendingSplit
+	for db, dbi := range store.data.Databases {
+		if dbi.MarkDeleted {
+			continue
+		}
+		for rp, rpi := range dbi.RetentionPolicies {
+			if rpi.MarkDeleted {
+				continue
+			}
+			for i := range rpi.ShardGroups {
+				sg := &rpi.ShardGroups[i]
+				if sg.Deleted() {
+					continue
+				}
+				for j := range sg.Shards {
+					if sg.Shards[j].SplitFrom != 0 && !containsSplit(splits, sg.ID, sg.Shards[j].SplitFrom) {
+						splits = append(splits, pendingSplit{db: db, rp: rp, sgID: sg.ID, shardID: sg.Shards[j].SplitFrom})
+					}
+				}
+			}
+		}
+	}
+	return splits
+}
+
+func containsSplit(splits []pendingSplit, sgID, shardID uint64) bool {
+	for i := range splits {
+		if splits[i].sgID == sgID && splits[i].shardID == shardID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store) splitShard(db, rp string, sgID, shardID uint64, splitKey string, ptID uint32) error {
+	val := &mproto.SplitShardCommand{
+		Database:     proto.String(db),
+		RpName:       proto.String(rp),
+		ShardGroupID: proto.Uint64(sgID),
+		ShardID:      proto.Uint64(shardID),
+		SplitKey:     proto.String(splitKey),
+		PtID:         proto.Uint32(ptID),
+	}
+	t := mproto.Command_SplitShardCommand
+	cmd := &mproto.Command{Type: &t}
+	if err := proto.SetExtension(cmd, mproto.E_SplitShardCommand_Command, val); err != nil {
+		return err
+	}
+	return s.ApplyCmd(cmd)
+}
+
+func (s *Store) splitShardDone(db, rp string, sgID, shardID uint64) error {
+	val := &mproto.SplitShardDoneCommand{
+		Database:     proto.String(db),
+		RpName:       proto.String(rp),
+		ShardGroupID: proto.Uint64(sgID),
+		ShardID:      proto.Uint64(shardID),
+	}
+	t := mproto.Command_SplitShardDoneCommand
+	cmd := &mproto.Command{Type: &t}
+	if err := proto.SetExtension(cmd, mproto.E_SplitShardDoneCommand_Command, val); err != nil {
+		return err
+	}
+	return s.ApplyCmd(cmd)
+}
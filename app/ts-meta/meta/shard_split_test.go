@@ -0,0 +1,166 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	mproto "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newShardSplitTestData() *meta.Data {
+	return &meta.Data{
+		DataNodes: []meta.DataNode{
+			{NodeInfo: meta.NodeInfo{ID: 1, Status: serf.StatusAlive, SegregateStatus: meta.Normal}},
+			{NodeInfo: meta.NodeInfo{ID: 2, Status: serf.StatusAlive, SegregateStatus: meta.Normal}},
+			{NodeInfo: meta.NodeInfo{ID: 3, Status: serf.StatusFailed, SegregateStatus: meta.Normal}},
+		},
+		Databases: map[string]*meta.DatabaseInfo{"db0": {
+			Name:                   "db0",
+			DefaultRetentionPolicy: "rp0",
+			RetentionPolicies: map[string]*meta.RetentionPolicyInfo{"rp0": {
+				Name: "rp0",
+				Measurements: map[string]*meta.MeasurementInfo{"cpu_0000": {
+					Name:      "cpu_0000",
+					ShardKeys: []meta.ShardKeyInfo{{ShardKey: []string{"host"}, Type: meta.RANGE}},
+				}},
+				ShardGroups: []meta.ShardGroupInfo{{
+					ID:         1,
+					EngineType: config.TSSTORE,
+					Shards: []meta.ShardInfo{
+						{ID: 1, Owners: []uint32{0}, Max: "cpu_0000,host=h5"},
+						{ID: 2, Owners: []uint32{1}, Min: "cpu_0000,host=h5"},
+					},
+				}},
+			}},
+		}},
+		PtView: map[string]meta.DBPtInfos{
+			"db0": {
+				{PtId: 0, Owner: meta.PtOwner{NodeID: 1}, Status: meta.Online},
+				{PtId: 1, Owner: meta.PtOwner{NodeID: 2}, Status: meta.Online},
+				{PtId: 2, Owner: meta.PtOwner{NodeID: 3}, Status: meta.Online},
+				{PtId: 3, Owner: meta.PtOwner{NodeID: 1}, Status: meta.Online},
+			},
+		},
+	}
+}
+
+func TestShardSplitter_HotShards(t *testing.T) {
+	s := NewShardSplitter(config.ShardSplitConfig{RunInterval: toml.Duration(10 * time.Second), HotWriteRate: 100, HotRounds: 2})
+	stat := &mproto.ShardStatus{ShardID: proto.Uint64(1), ShardSize: proto.Uint64(0)}
+	s.updateLoad("db0", "rp0", 0, stat)
+
+	now := time.Now()
+	require.Equal(t, 0, len(s.hotShards(now)))
+	for i := 1; i <= 2; i++ {
+		s.stats[1].rows = uint64(i * 1000)
+		s.stats[1].reportAt = s.stats[1].checkAt.Add(time.Second)
+		hot := s.hotShards(s.stats[1].reportAt)
+		if i == 1 {
+			require.Equal(t, 0, len(hot))
+			continue
+		}
+		require.Equal(t, 1, len(hot))
+		assert.Equal(t, uint64(1), hot[0].shardID)
+		assert.Equal(t, float64(1000), hot[0].rate)
+	}
+
+	// the cold shard resets the hot rounds
+	s.stats[1].rows += 10
+	s.stats[1].reportAt = s.stats[1].checkAt.Add(time.Second)
+	require.Equal(t, 0, len(s.hotShards(s.stats[1].reportAt)))
+	assert.Equal(t, 0, s.stats[1].hotRounds)
+
+	// the stats not reported for a while are removed
+	s.hotShards(s.stats[1].reportAt.Add(time.Minute))
+	assert.Equal(t, 0, len(s.stats))
+}
+
+func TestShardSplitter_PlanSplit(t *testing.T) {
+	s := NewShardSplitter(config.ShardSplitConfig{})
+	data := newShardSplitTestData()
+
+	// the pt owning no shard of the group is selected, the pt of the failed node is skipped
+	plan, err := s.planSplit(data, &hotShard{db: "db0", rp: "rp0", shardID: 1})
+	require.NoError(t, err)
+	require.NotNil(t, plan)
+	assert.Equal(t, uint32(3), plan.pt)
+	assert.Equal(t, uint32(0), plan.ownerPt)
+	assert.Equal(t, uint64(1), plan.node.ID)
+
+	// the shard being split is not split again
+	sg := &data.Databases["db0"].RetentionPolicies["rp0"].ShardGroups[0]
+	sg.Shards = append(sg.Shards, meta.ShardInfo{ID: 3, Owners: []uint32{3}, Min: "cpu_0000,host=h8", SplitFrom: 2})
+	plan, err = s.planSplit(data, &hotShard{db: "db0", rp: "rp0", shardID: 2})
+	require.NoError(t, err)
+	assert.Nil(t, plan)
+
+	// the group has as many shards as the pts
+	sg.Shards = append(sg.Shards, meta.ShardInfo{ID: 4, Owners: []uint32{2}})
+	plan, err = s.planSplit(data, &hotShard{db: "db0", rp: "rp0", shardID: 1})
+	require.NoError(t, err)
+	assert.Nil(t, plan)
+
+	// the hash sharding shards are not split
+	data = newShardSplitTestData()
+	data.Databases["db0"].RetentionPolicies["rp0"].Measurements["cpu_0000"].ShardKeys[0].Type = meta.HASH
+	plan, err = s.planSplit(data, &hotShard{db: "db0", rp: "rp0", shardID: 1})
+	require.NoError(t, err)
+	assert.Nil(t, plan)
+}
+
+func TestLessSplitTarget(t *testing.T) {
+	shardsOfPt := map[uint32]int{0: 1, 1: 1}
+	assert.True(t, lessSplitTarget(2, 0, 0, shardsOfPt))
+	assert.True(t, lessSplitTarget(1, 0, 0, shardsOfPt))
+	assert.False(t, lessSplitTarget(0, 1, 0, shardsOfPt))
+	assert.True(t, lessSplitTarget(1, 2, 0, map[uint32]int{}))
+}
+
+func TestShardSplitter_PendingSplits(t *testing.T) {
+	data := newShardSplitTestData()
+	sg := &data.Databases["db0"].RetentionPolicies["rp0"].ShardGroups[0]
+	sg.Shards = append(sg.Shards,
+		meta.ShardInfo{ID: 3, Owners: []uint32{3}, Min: "cpu_0000,host=h8", SplitFrom: 2},
+		meta.ShardInfo{ID: 4, Owners: []uint32{0}, Min: "cpu_0000,host=h9", SplitFrom: 2})
+	var moved []uint32
+	netStore := NewMockNetStorage()
+	netStore.SplitShardFn = func(nodeID uint64, db, rp string, pt uint32, shardID uint64) (int64, error) {
+		moved = append(moved, pt)
+		assert.Equal(t, uint64(2), shardID)
+		assert.Equal(t, uint64(2), nodeID)
+		return 10, nil
+	}
+	globalService = &Service{store: &Store{raft: &MockRaftForSG{isLeader: true}, data: data, NetStore: netStore}}
+	defer func() {
+		globalService = nil
+	}()
+
+	splits := pendingSplits()
+	require.Equal(t, []pendingSplit{{db: "db0", rp: "rp0", sgID: 1, shardID: 2}}, splits)
+
+	s := NewShardSplitter(config.ShardSplitConfig{})
+	require.NoError(t, s.moveRows("db0", "rp0", 1, 2))
+	assert.Equal(t, []uint32{1}, moved)
+	assert.Equal(t, meta.ErrShardGroupNotFound, s.moveRows("db0", "rp0", 2, 2))
+}
@@ -405,6 +405,9 @@ type Store struct {
 		DeleteMeasurement(node *meta.DataNode, db string, rp string, name string, shardIds []uint64) error
 		MigratePt(nodeID uint64, data transport.Codec, cb transport.Callback) error
 		SendSegregateNodeCmds(nodeIDs []uint64, address []string) (int, error)
+		GetPtLoadsOnNode(nodeID uint64) ([]*netstorage.PtLoad, error)
+		DropPtFiles(nodeID uint64, db string, pt uint32) error
+		SplitShard(nodeID uint64, db, rp string, pt uint32, shardID uint64) (int64, error)
 	}
 
 	statMu       sync.RWMutex
@@ -477,6 +480,11 @@ func (s *Store) checkLeaderChanged() {
 				if globalService.clusterManager != nil {
 					globalService.msm.Start()
 					globalService.balanceManager.Start()
+					globalService.decommissioner.Start(s)
+					globalService.tenantUsage.Start(s)
+					if globalService.shardSplitter != nil {
+						globalService.shardSplitter.Start()
+					}
 					globalService.clusterManager.Start()
 				}
 
@@ -491,6 +499,11 @@ func (s *Store) checkLeaderChanged() {
 			close(s.stepDown)
 			if globalService.clusterManager != nil {
 				globalService.clusterManager.Stop()
+				if globalService.shardSplitter != nil {
+					globalService.shardSplitter.Stop()
+				}
+				globalService.tenantUsage.Stop()
+				globalService.decommissioner.Stop()
 				globalService.balanceManager.Stop()
 				globalService.msm.Stop()
 			}
@@ -1685,7 +1698,14 @@ func (s *Store) UpdateLoad(b []byte) error {
 			if sgInfo == nil || sgInfo.Shards == nil {
 				continue
 			}
+			if globalService != nil && globalService.shardSplitter != nil {
+				globalService.shardSplitter.updateLoad(db, rpStat.GetRpName(), v.GetDBPTStat()[i].GetPtID(), rpStat.GetShardStats())
+			}
 			minShardID, maxShardID := sgInfo.Shards[0].ID, sgInfo.Shards[len(sgInfo.Shards)-1].ID
+			// the shard ids of the groups with split shards are not contiguous
+			if maxShardID-minShardID+1 != uint64(len(sgInfo.Shards)) {
+				continue
+			}
 			// new shard group
 			if minShardID != rpinfo.minShardID {
 				rpinfo.minShardID, rpinfo.maxShardID = minShardID, maxShardID
@@ -2169,6 +2189,13 @@ func (s *Store) getFailedDbPts(ownerNode uint64, status meta.PtStatus) []*meta.D
 	return ptInfos
 }
 
+func (s *Store) getPinnedFailedDbPts(id uint64, status meta.PtStatus) []*meta.DbPtInfo {
+	s.mu.RLock()
+	ptInfos := s.data.GetPinnedFailedPtInfos(id, status)
+	s.mu.RUnlock()
+	return ptInfos
+}
+
 // 1.rg full 2.pt failed 3.ownerNode alive
 func (s *Store) getFullRGAllFailedPtsOwnedAliveNodeBasedPtId(basePt *meta.DbPtInfo, database string) []*meta.DbPtInfo {
 	s.mu.RLock()
@@ -2225,6 +2252,45 @@ func (s *Store) getDbPtNumPerAliveNode() *map[uint64]uint32 {
 	return &nodePtNumMap
 }
 
+// getPinnedPtNumPerAliveNode returns the pt numbers of the alive nodes which the pts of the database can be placed on
+func (s *Store) getPinnedPtNumPerAliveNode(db string, nodePtNumMap *map[uint64]uint32) *map[uint64]uint32 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	t := s.data.DatabaseTenant(db)
+	if t == nil || !t.Pinned() || nodePtNumMap == nil {
+		return nodePtNumMap
+	}
+	pinnedPtNumMap := make(map[uint64]uint32, len(t.Nodes))
+	for id, n := range *nodePtNumMap {
+		if t.HasNode(id) {
+			pinnedPtNumMap[id] = n
+		}
+	}
+	return &pinnedPtNumMap
+}
+
+// nodeAllowed returns true if the pts of the database can be placed on the node
+func (s *Store) nodeAllowed(db string, nodeId uint64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.NodeAllowed(db, nodeId)
+}
+
+// pinnedNodeIds returns the nodes which the pts of the database can be placed on, the caller holds s.mu
+func (s *Store) pinnedNodeIds(db string, nodes []uint64) []uint64 {
+	t := s.data.DatabaseTenant(db)
+	if t == nil || !t.Pinned() {
+		return nodes
+	}
+	pinned := make([]uint64, 0, len(t.Nodes))
+	for _, id := range nodes {
+		if t.HasNode(id) {
+			pinned = append(pinned, id)
+		}
+	}
+	return pinned
+}
+
 func (s *Store) shouldTakeOver() bool {
 	return s.data.TakeOverEnabled
 }
@@ -104,67 +104,82 @@ func (fsm *storeFSM) Apply(l *raft.Log) interface{} {
 }
 
 var applyFunc = map[proto2.Command_Type]func(fsm *storeFSM, cmd *proto2.Command) interface{}{
-	proto2.Command_CreateDatabaseCommand:            applyCreateDatabase,
-	proto2.Command_DropDatabaseCommand:              applyDropDatabase,
-	proto2.Command_CreateRetentionPolicyCommand:     applyCreateRetentionPolicy,
-	proto2.Command_DropRetentionPolicyCommand:       applyDropRetentionPolicy,
-	proto2.Command_SetDefaultRetentionPolicyCommand: applySetDefaultRetentionPolicy,
-	proto2.Command_UpdateRetentionPolicyCommand:     applyUpdateRetentionPolicy,
-	proto2.Command_CreateShardGroupCommand:          applyCreateShardGroup,
-	proto2.Command_DeleteShardGroupCommand:          applyDeleteShardGroup,
-	proto2.Command_CreateSubscriptionCommand:        applyCreateSubscription,
-	proto2.Command_DropSubscriptionCommand:          applyDropSubscription,
-	proto2.Command_CreateUserCommand:                applyCreateUser,
-	proto2.Command_DropUserCommand:                  applyDropUser,
-	proto2.Command_UpdateUserCommand:                applyUpdateUser,
-	proto2.Command_SetPrivilegeCommand:              applySetPrivilege,
-	proto2.Command_SetAdminPrivilegeCommand:         applySetAdminPrivilege,
-	proto2.Command_SetDataCommand:                   applySetData,
-	proto2.Command_CreateMetaNodeCommand:            applyCreateMetaNode,
-	proto2.Command_DeleteMetaNodeCommand:            applyDeleteMetaNode,
-	proto2.Command_SetMetaNodeCommand:               applySetMetaNode,
-	proto2.Command_CreateDataNodeCommand:            applyCreateDataNode,
-	proto2.Command_CreateSqlNodeCommand:             applyCreateSqlNode,
-	proto2.Command_DeleteDataNodeCommand:            applyDeleteDataNode,
-	proto2.Command_MarkDatabaseDeleteCommand:        applyMarkDatabaseDelete,
-	proto2.Command_MarkRetentionPolicyDeleteCommand: applyMarkRetentionPolicyDelete,
-	proto2.Command_CreateMeasurementCommand:         applyCreateMeasurement,
-	proto2.Command_ReShardingCommand:                applyReSharding,
-	proto2.Command_UpdateSchemaCommand:              applyUpdateSchema,
-	proto2.Command_AlterShardKeyCmd:                 applyAlterShardKey,
-	proto2.Command_PruneGroupsCommand:               applyPruneGroups,
-	proto2.Command_MarkMeasurementDeleteCommand:     applyMarkMeasurementDelete,
-	proto2.Command_DropMeasurementCommand:           applyDropMeasurement,
-	proto2.Command_DeleteIndexGroupCommand:          applyDeleteIndexGroup,
-	proto2.Command_UpdateShardInfoTierCommand:       applyUpdateShardInfoTier,
-	proto2.Command_UpdateNodeStatusCommand:          applyUpdateNodeStatus,
-	proto2.Command_UpdateSqlNodeStatusCommand:       applyUpdateSqlNodeStatus,
-	proto2.Command_CreateEventCommand:               applyCreateEvent,
-	proto2.Command_UpdateEventCommand:               applyUpdateEvent,
-	proto2.Command_UpdatePtInfoCommand:              applyUpdatePtInfo,
-	proto2.Command_RemoveEventCommand:               applyRemoveEvent,
-	proto2.Command_CreateDownSamplePolicyCommand:    applyCreateDownSample,
-	proto2.Command_DropDownSamplePolicyCommand:      applyDropDownSample,
-	proto2.Command_CreateDbPtViewCommand:            applyCreateDbPtView,
-	proto2.Command_UpdateShardDownSampleInfoCommand: applyUpdateShardDownSampleInfo,
-	proto2.Command_MarkTakeoverCommand:              applyMarkTakeover,
-	proto2.Command_MarkBalancerCommand:              applyMarkBalancer,
-	proto2.Command_CreateStreamCommand:              applyCreateStream,
-	proto2.Command_DropStreamCommand:                applyDropStream,
-	proto2.Command_VerifyDataNodeCommand:            applyVerifyDataNode,
-	proto2.Command_ExpandGroupsCommand:              applyExpandGroups,
-	proto2.Command_UpdatePtVersionCommand:           applyUpdatePtVersion,
-	proto2.Command_RegisterQueryIDOffsetCommand:     applyRegisterQueryIDOffset,
-	proto2.Command_CreateContinuousQueryCommand:     applyCreateContinuousQuery,
-	proto2.Command_ContinuousQueryReportCommand:     applyContinuousQueryReport,
-	proto2.Command_DropContinuousQueryCommand:       applyDropContinuousQuery,
-	proto2.Command_NotifyCQLeaseChangedCommand:      applyNotifyCQLeaseChanged,
-	proto2.Command_SetNodeSegregateStatusCommand:    applySetNodeSegregateStatusCommand,
-	proto2.Command_RemoveNodeCommand:                applyRemoveNodeCommand,
-	proto2.Command_UpdateReplicationCommand:         applyUpdateReplicationCommand,
-	proto2.Command_UpdateMeasurementCommand:         applyUpdateMeasurement,
-	proto2.Command_UpdateNodeTmpIndexCommand:        applyUpdateNodeTmpIndexCommand,
-	proto2.Command_InsertFilesCommand:               applyInsertFilesCommand,
+	proto2.Command_CreateDatabaseCommand:              applyCreateDatabase,
+	proto2.Command_DropDatabaseCommand:                applyDropDatabase,
+	proto2.Command_CreateRetentionPolicyCommand:       applyCreateRetentionPolicy,
+	proto2.Command_DropRetentionPolicyCommand:         applyDropRetentionPolicy,
+	proto2.Command_SetDefaultRetentionPolicyCommand:   applySetDefaultRetentionPolicy,
+	proto2.Command_UpdateRetentionPolicyCommand:       applyUpdateRetentionPolicy,
+	proto2.Command_CreateShardGroupCommand:            applyCreateShardGroup,
+	proto2.Command_DeleteShardGroupCommand:            applyDeleteShardGroup,
+	proto2.Command_CreateSubscriptionCommand:          applyCreateSubscription,
+	proto2.Command_DropSubscriptionCommand:            applyDropSubscription,
+	proto2.Command_CreateUserCommand:                  applyCreateUser,
+	proto2.Command_DropUserCommand:                    applyDropUser,
+	proto2.Command_UpdateUserCommand:                  applyUpdateUser,
+	proto2.Command_SetPrivilegeCommand:                applySetPrivilege,
+	proto2.Command_SetAdminPrivilegeCommand:           applySetAdminPrivilege,
+	proto2.Command_SetDataCommand:                     applySetData,
+	proto2.Command_CreateMetaNodeCommand:              applyCreateMetaNode,
+	proto2.Command_DeleteMetaNodeCommand:              applyDeleteMetaNode,
+	proto2.Command_SetMetaNodeCommand:                 applySetMetaNode,
+	proto2.Command_CreateDataNodeCommand:              applyCreateDataNode,
+	proto2.Command_CreateSqlNodeCommand:               applyCreateSqlNode,
+	proto2.Command_DeleteDataNodeCommand:              applyDeleteDataNode,
+	proto2.Command_MarkDatabaseDeleteCommand:          applyMarkDatabaseDelete,
+	proto2.Command_MarkRetentionPolicyDeleteCommand:   applyMarkRetentionPolicyDelete,
+	proto2.Command_CreateMeasurementCommand:           applyCreateMeasurement,
+	proto2.Command_ReShardingCommand:                  applyReSharding,
+	proto2.Command_SplitShardCommand:                  applySplitShard,
+	proto2.Command_SplitShardDoneCommand:              applySplitShardDone,
+	proto2.Command_CreateReplicationCommand:           applyCreateReplication,
+	proto2.Command_DropReplicationCommand:             applyDropReplication,
+	proto2.Command_UpdateReplicationCheckpointCommand: applyUpdateReplicationCheckpoint,
+	proto2.Command_RestoreDatabaseCommand:             applyRestoreDatabase,
+	proto2.Command_UpdateSchemaCommand:                applyUpdateSchema,
+	proto2.Command_AlterShardKeyCmd:                   applyAlterShardKey,
+	proto2.Command_PruneGroupsCommand:                 applyPruneGroups,
+	proto2.Command_MarkMeasurementDeleteCommand:       applyMarkMeasurementDelete,
+	proto2.Command_DropMeasurementCommand:             applyDropMeasurement,
+	proto2.Command_DeleteIndexGroupCommand:            applyDeleteIndexGroup,
+	proto2.Command_UpdateShardInfoTierCommand:         applyUpdateShardInfoTier,
+	proto2.Command_UpdateNodeStatusCommand:            applyUpdateNodeStatus,
+	proto2.Command_UpdateSqlNodeStatusCommand:         applyUpdateSqlNodeStatus,
+	proto2.Command_CreateEventCommand:                 applyCreateEvent,
+	proto2.Command_UpdateEventCommand:                 applyUpdateEvent,
+	proto2.Command_UpdatePtInfoCommand:                applyUpdatePtInfo,
+	proto2.Command_RemoveEventCommand:                 applyRemoveEvent,
+	proto2.Command_CreateDownSamplePolicyCommand:      applyCreateDownSample,
+	proto2.Command_DropDownSamplePolicyCommand:        applyDropDownSample,
+	proto2.Command_CreateDbPtViewCommand:              applyCreateDbPtView,
+	proto2.Command_UpdateShardDownSampleInfoCommand:   applyUpdateShardDownSampleInfo,
+	proto2.Command_MarkTakeoverCommand:                applyMarkTakeover,
+	proto2.Command_MarkBalancerCommand:                applyMarkBalancer,
+	proto2.Command_CreateStreamCommand:                applyCreateStream,
+	proto2.Command_DropStreamCommand:                  applyDropStream,
+	proto2.Command_VerifyDataNodeCommand:              applyVerifyDataNode,
+	proto2.Command_ExpandGroupsCommand:                applyExpandGroups,
+	proto2.Command_UpdatePtVersionCommand:             applyUpdatePtVersion,
+	proto2.Command_RegisterQueryIDOffsetCommand:       applyRegisterQueryIDOffset,
+	proto2.Command_CreateContinuousQueryCommand:       applyCreateContinuousQuery,
+	proto2.Command_ContinuousQueryReportCommand:       applyContinuousQueryReport,
+	proto2.Command_DropContinuousQueryCommand:         applyDropContinuousQuery,
+	proto2.Command_NotifyCQLeaseChangedCommand:        applyNotifyCQLeaseChanged,
+	proto2.Command_SetNodeSegregateStatusCommand:      applySetNodeSegregateStatusCommand,
+	proto2.Command_RemoveNodeCommand:                  applyRemoveNodeCommand,
+	proto2.Command_UpdateReplicationCommand:           applyUpdateReplicationCommand,
+	proto2.Command_UpdateMeasurementCommand:           applyUpdateMeasurement,
+	proto2.Command_UpdateNodeTmpIndexCommand:          applyUpdateNodeTmpIndexCommand,
+	proto2.Command_InsertFilesCommand:                 applyInsertFilesCommand,
+
+	proto2.Command_CreateContinuousQueryBackfillCommand: applyCreateContinuousQueryBackfill,
+	proto2.Command_UpdateContinuousQueryBackfillCommand: applyUpdateContinuousQueryBackfill,
+
+	proto2.Command_CreateTenantCommand:       applyCreateTenant,
+	proto2.Command_DropTenantCommand:         applyDropTenant,
+	proto2.Command_UpdateTenantMemberCommand: applyUpdateTenantMember,
+	proto2.Command_UpdateTenantUsageCommand:  applyUpdateTenantUsage,
+	proto2.Command_UpdateDecommissionCommand: applyUpdateDecommission,
 }
 
 func applyCreateDatabase(fsm *storeFSM, cmd *proto2.Command) interface{} {
@@ -271,6 +286,30 @@ func applyReSharding(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyReShardingCommand(cmd)
 }
 
+func applySplitShard(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applySplitShardCommand(cmd)
+}
+
+func applySplitShardDone(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applySplitShardDoneCommand(cmd)
+}
+
+func applyCreateReplication(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyCreateReplicationCommand(cmd)
+}
+
+func applyDropReplication(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyDropReplicationCommand(cmd)
+}
+
+func applyUpdateReplicationCheckpoint(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyUpdateReplicationCheckpointCommand(cmd)
+}
+
+func applyRestoreDatabase(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyRestoreDatabaseCommand(cmd)
+}
+
 func applyUpdateSchema(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyUpdateSchemaCommand(cmd)
 }
@@ -383,6 +422,34 @@ func applyDropContinuousQuery(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyDropContinuousQueryCommand(cmd)
 }
 
+func applyCreateContinuousQueryBackfill(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyCreateContinuousQueryBackfillCommand(cmd)
+}
+
+func applyUpdateContinuousQueryBackfill(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyUpdateContinuousQueryBackfillCommand(cmd)
+}
+
+func applyCreateTenant(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyCreateTenantCommand(cmd)
+}
+
+func applyDropTenant(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyDropTenantCommand(cmd)
+}
+
+func applyUpdateTenantMember(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyUpdateTenantMemberCommand(cmd)
+}
+
+func applyUpdateTenantUsage(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyUpdateTenantUsageCommand(cmd)
+}
+
+func applyUpdateDecommission(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyUpdateDecommissionCommand(cmd)
+}
+
 func applyNotifyCQLeaseChanged(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyNotifyCQLeaseChangedCommand(cmd)
 }
@@ -423,6 +490,30 @@ func (fsm *storeFSM) applyReShardingCommand(cmd *proto2.Command) interface{} {
 	return meta2.ApplyReSharding(fsm.data, cmd)
 }
 
+func (fsm *storeFSM) applySplitShardCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplySplitShard(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applySplitShardDoneCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplySplitShardDone(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyCreateReplicationCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyCreateReplication(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyDropReplicationCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyDropReplication(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyUpdateReplicationCheckpointCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyUpdateReplicationCheckpoint(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyRestoreDatabaseCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyRestoreDatabase(fsm.data, cmd)
+}
+
 func (fsm *storeFSM) applyUpdateSchemaCommand(cmd *proto2.Command) interface{} {
 	return meta2.ApplyUpdateSchema(fsm.data, cmd)
 }
@@ -568,6 +659,34 @@ func (fsm *storeFSM) applyDropContinuousQueryCommand(cmd *proto2.Command) interf
 	return nil
 }
 
+func (fsm *storeFSM) applyCreateContinuousQueryBackfillCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyCreateContinuousQueryBackfill(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyUpdateContinuousQueryBackfillCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyUpdateContinuousQueryBackfill(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyCreateTenantCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyCreateTenant(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyDropTenantCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyDropTenant(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyUpdateTenantMemberCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyUpdateTenantMember(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyUpdateTenantUsageCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyUpdateTenantUsage(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyUpdateDecommissionCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyUpdateDecommission(fsm.data, cmd)
+}
+
 // applyNotifyCQLeaseChangedCommand notify all sql that cq lease has been changed.
 func (fsm *storeFSM) applyNotifyCQLeaseChangedCommand(cmd *proto2.Command) interface{} {
 	ext, _ := proto.GetExtension(cmd, proto2.E_NotifyCQLeaseChangedCommand_Command)
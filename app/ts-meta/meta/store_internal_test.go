@@ -464,6 +464,52 @@ func Test_applyContinuousQueryReportCommand(t *testing.T) {
 	require.Equal(t, ts.UnixNano(), fsm.data.Databases["db0"].ContinuousQueries["cq0"].LastRunTime.UnixNano())
 }
 
+func Test_applyContinuousQueryBackfillCommand(t *testing.T) {
+	s := &Store{
+		raft: &MockRaftForCQ{isLeader: true},
+		data: &meta2.Data{
+			Databases: map[string]*meta2.DatabaseInfo{
+				"db0": {
+					Name: "db0",
+					ContinuousQueries: map[string]*meta2.ContinuousQueryInfo{
+						"cq0": {
+							Name:  "cq0",
+							Query: `CREATE CONTINUOUS QUERY "cq0" ON "db0" BEGIN SELECT max("passengers") INTO "max_passengers" FROM "bus_data" GROUP BY time(1h) END`,
+						},
+					},
+				},
+			},
+		},
+		Logger: logger.NewLogger(errno.ModuleUnknown).SetZapLogger(zap.NewNop()),
+	}
+	fsm := (*storeFSM)(s)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	typ := proto2.Command_CreateContinuousQueryBackfillCommand
+	cmd := &proto2.Command{Type: &typ}
+	require.NoError(t, proto.SetExtension(cmd, proto2.E_CreateContinuousQueryBackfillCommand_Command, &proto2.CreateContinuousQueryBackfillCommand{
+		Database:  proto.String("db0"),
+		Name:      proto.String("cq0"),
+		StartTime: proto.Int64(start.UnixNano()),
+		EndTime:   proto.Int64(start.Add(2 * time.Hour).UnixNano()),
+	}))
+	require.Nil(t, applyCreateContinuousQueryBackfill(fsm, cmd))
+	require.Equal(t, meta2.ErrContinuousQueryBackfillExists, applyCreateContinuousQueryBackfill(fsm, cmd))
+
+	typ = proto2.Command_UpdateContinuousQueryBackfillCommand
+	cmd = &proto2.Command{Type: &typ}
+	require.NoError(t, proto.SetExtension(cmd, proto2.E_UpdateContinuousQueryBackfillCommand_Command, &proto2.UpdateContinuousQueryBackfillCommand{
+		Database: proto.String("db0"),
+		Name:     proto.String("cq0"),
+		NextTime: proto.Int64(start.Add(time.Hour).UnixNano()),
+		Host:     proto.String("127.0.0.1:8086"),
+	}))
+	require.Nil(t, applyUpdateContinuousQueryBackfill(fsm, cmd))
+	backfill := fsm.data.Databases["db0"].ContinuousQueries["cq0"].Backfill
+	require.Equal(t, start.Add(time.Hour).UnixNano(), backfill.NextTime.UnixNano())
+	require.Equal(t, "127.0.0.1:8086", backfill.Host)
+}
+
 func Test_applyDropContinuousQuery(t *testing.T) {
 	s := &Store{
 		raft: &MockRaftForCQ{isLeader: true},
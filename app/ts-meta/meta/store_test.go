@@ -35,6 +35,7 @@ import (
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	logger2 "github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
 	"github.com/openGemini/openGemini/lib/obs"
 	stat "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
@@ -189,6 +190,9 @@ type MockStore interface {
 	DeleteMeasurement(node *meta2.DataNode, db string, rp, name string, shardIds []uint64) error
 	MigratePt(uint64, transport.Codec, transport.Callback) error
 	SendSegregateNodeCmds(nodeIDs []uint64, address []string) (int, error)
+	GetPtLoadsOnNode(nodeID uint64) ([]*netstorage.PtLoad, error)
+	DropPtFiles(nodeID uint64, db string, pt uint32) error
+	SplitShard(nodeID uint64, db, rp string, pt uint32, shardID uint64) (int64, error)
 }
 
 type MockNetStorage struct {
@@ -234,6 +238,18 @@ func (s *MockNetStorage) SendSegregateNodeCmds(nodeIDs []uint64, address []strin
 	return 0, nil
 }
 
+func (s *MockNetStorage) GetPtLoadsOnNode(nodeID uint64) ([]*netstorage.PtLoad, error) {
+	return nil, nil
+}
+
+func (s *MockNetStorage) DropPtFiles(nodeID uint64, db string, pt uint32) error {
+	return nil
+}
+
+func (s *MockNetStorage) SplitShard(nodeID uint64, db, rp string, pt uint32, shardID uint64) (int64, error) {
+	return 0, nil
+}
+
 func NewMockNetStorage() MockStore {
 	return &MockNetStorage{}
 }
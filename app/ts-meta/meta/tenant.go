@@ -0,0 +1,192 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	mproto "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+var tenantUsageInterval = 30 * time.Second
+
+type tenantUsage struct {
+	series       int64
+	storageBytes int64
+}
+
+// TenantUsageCollector sums up the series and the storage bytes of the pts of each tenant and records them in the
+// meta data, the ts-sql nodes check the write quotas of the tenants against the recorded usage.
+type TenantUsageCollector struct {
+	wg      sync.WaitGroup
+	stopped int32
+	closing chan struct{}
+
+	logger *logger.Logger
+}
+
+func NewTenantUsageCollector() *TenantUsageCollector {
+	return &TenantUsageCollector{
+		stopped: 1,
+		logger:  logger.NewLogger(errno.ModuleHA),
+	}
+}
+
+// Start tenant usage collecting goroutine, nothing is started without the store
+func (c *TenantUsageCollector) Start(store *Store) {
+	if store == nil {
+		return
+	}
+	atomic.StoreInt32(&c.stopped, 0)
+	c.closing = make(chan struct{})
+	c.wg.Add(1)
+	go c.collectLoop(store, c.closing)
+}
+
+// Stop tenant usage collecting goroutine
+func (c *TenantUsageCollector) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.stopped, 0, 1) {
+		return
+	}
+	close(c.closing)
+	c.wg.Wait()
+}
+
+func (c *TenantUsageCollector) collectLoop(store *Store, closing chan struct{}) {
+	c.logger.Info("[tenant] start collecting usage")
+	defer c.wg.Done()
+	for {
+		if atomic.LoadInt32(&c.stopped) == 1 {
+			return
+		}
+		c.collect(store)
+
+		select {
+		case <-closing:
+			return
+		case <-time.After(tenantUsageInterval):
+		}
+	}
+}
+
+func (c *TenantUsageCollector) collect(store *Store) {
+	store.mu.RLock()
+	tenants := store.data.CloneTenants()
+	masters := masterPtsOfTenants(store.data, tenants)
+	var aliveNodes []uint64
+	for _, dataNode := range store.data.DataNodes {
+		if dataNode.Status == serf.StatusAlive && dataNode.AliveConnID == dataNode.ConnID {
+			aliveNodes = append(aliveNodes, dataNode.ID)
+		}
+	}
+	store.mu.RUnlock()
+	if len(tenants) == 0 {
+		return
+	}
+
+	var loads []*netstorage.PtLoad
+	for _, nodeId := range aliveNodes {
+		ptLoads, err := store.NetStore.GetPtLoadsOnNode(nodeId)
+		if err != nil {
+			// the usage is incomplete, keep the last recorded usage until the next run
+			c.logger.Warn("[tenant] get pt loads failed", zap.Uint64("node", nodeId), zap.Error(err))
+			return
+		}
+		loads = append(loads, ptLoads...)
+	}
+
+	for name, usage := range sumTenantUsage(tenants, masters, loads) {
+		t := tenants[name]
+		if t.Series == usage.series && t.StorageBytes == usage.storageBytes {
+			continue
+		}
+		if err := store.updateTenantUsage(name, usage.series, usage.storageBytes); err != nil {
+			c.logger.Error("[tenant] update usage failed", zap.String("tenant", name), zap.Error(err))
+			return
+		}
+	}
+}
+
+// masterPtsOfTenants returns the master pts of the replicated databases of the tenants, key: database name
+func masterPtsOfTenants(data *meta.Data, tenants map[string]*meta.TenantInfo) map[string]map[uint32]struct{} {
+	masters := make(map[string]map[uint32]struct{})
+	for _, t := range tenants {
+		for _, db := range t.Databases {
+			for _, rg := range data.DBRepGroups(db) {
+				if masters[db] == nil {
+					masters[db] = make(map[uint32]struct{})
+				}
+				masters[db][rg.MasterPtID] = struct{}{}
+			}
+		}
+	}
+	return masters
+}
+
+// sumTenantUsage returns the series and the storage bytes of each tenant summed up over the pts of its databases.
+// The replicas of a pt hold the same data, only the master pts of the replicated databases are counted.
+func sumTenantUsage(tenants map[string]*meta.TenantInfo, masters map[string]map[uint32]struct{}, loads []*netstorage.PtLoad) map[string]tenantUsage {
+	dbTenants := make(map[string]string)
+	usages := make(map[string]tenantUsage, len(tenants))
+	for name, t := range tenants {
+		for _, db := range t.Databases {
+			dbTenants[db] = name
+		}
+		usages[name] = tenantUsage{}
+	}
+	for _, load := range loads {
+		name, ok := dbTenants[load.Db]
+		if !ok {
+			continue
+		}
+		if pts, ok := masters[load.Db]; ok {
+			if _, ok = pts[load.PtId]; !ok {
+				continue
+			}
+		}
+		usage := usages[name]
+		usage.series += int64(load.Series)
+		usage.storageBytes += int64(load.DiskBytes)
+		usages[name] = usage
+	}
+	return usages
+}
+
+func (s *Store) updateTenantUsage(name string, series, storageBytes int64) error {
+	if !s.IsLeader() {
+		return raft.ErrNotLeader
+	}
+	val := &mproto.UpdateTenantUsageCommand{
+		Name:         proto.String(name),
+		Series:       proto.Int64(series),
+		StorageBytes: proto.Int64(storageBytes),
+	}
+	t := mproto.Command_UpdateTenantUsageCommand
+	cmd := &mproto.Command{Type: &t}
+	if err := proto.SetExtension(cmd, mproto.E_UpdateTenantUsageCommand_Command, val); err != nil {
+		panic(err)
+	}
+	return s.ApplyCmd(cmd)
+}
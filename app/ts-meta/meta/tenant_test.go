@@ -0,0 +1,104 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	mproto "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type MockRaftForTenant struct {
+	MockRaftForSG
+	usages []*mproto.UpdateTenantUsageCommand
+}
+
+func (m *MockRaftForTenant) Apply(b []byte) error {
+	var cmd mproto.Command
+	if err := proto.Unmarshal(b, &cmd); err != nil {
+		return err
+	}
+	ext, err := proto.GetExtension(&cmd, mproto.E_UpdateTenantUsageCommand_Command)
+	if err != nil {
+		return err
+	}
+	m.usages = append(m.usages, ext.(*mproto.UpdateTenantUsageCommand))
+	return nil
+}
+
+func TestSumTenantUsage(t *testing.T) {
+	tenants := map[string]*meta.TenantInfo{
+		"t1": {Name: "t1", Databases: []string{"db0", "db1"}},
+		"t2": {Name: "t2"},
+	}
+	loads := []*netstorage.PtLoad{
+		{Db: "db0", PtId: 0, Series: 10, DiskBytes: 100},
+		{Db: "db0", PtId: 1, Series: 20, DiskBytes: 200},
+		{Db: "db1", PtId: 0, Series: 1, DiskBytes: 10},
+		{Db: "db2", PtId: 0, Series: 1000, DiskBytes: 1000},
+	}
+	usages := sumTenantUsage(tenants, nil, loads)
+	assert.Equal(t, map[string]tenantUsage{
+		"t1": {series: 31, storageBytes: 310},
+		"t2": {},
+	}, usages)
+
+	// only the master pts of the replicated database are counted
+	data := &meta.Data{ReplicaGroups: map[string][]meta.ReplicaGroup{
+		"db0": {{ID: 0, MasterPtID: 1, Peers: []meta.Peer{{ID: 0, PtRole: meta.Slave}}}},
+	}}
+	masters := masterPtsOfTenants(data, tenants)
+	assert.Equal(t, map[string]map[uint32]struct{}{"db0": {1: {}}}, masters)
+	usages = sumTenantUsage(tenants, masters, loads)
+	assert.Equal(t, tenantUsage{series: 21, storageBytes: 210}, usages["t1"])
+}
+
+func TestTenantUsageCollector_Collect(t *testing.T) {
+	s := newDecommissionTestStore(true)
+	mockRaft := &MockRaftForTenant{MockRaftForSG: MockRaftForSG{isLeader: true}}
+	s.raft = mockRaft
+	s.data.Tenants = map[string]*meta.TenantInfo{
+		"t1": {Name: "t1", Databases: []string{"db0"}},
+		"t2": {Name: "t2", Databases: []string{"db1"}},
+	}
+	var loadErr error
+	s.NetStore = &MockNetStorage{GetPtLoadsOnNodeFn: func(nodeID uint64) ([]*netstorage.PtLoad, error) {
+		return []*netstorage.PtLoad{{Db: "db0", PtId: uint32(nodeID), Series: 5, DiskBytes: 50}}, loadErr
+	}}
+	c := NewTenantUsageCollector()
+	globalService = &Service{store: s, tenantUsage: c}
+	defer func() {
+		globalService = nil
+	}()
+
+	// the usage of t2 is unchanged
+	c.collect(s)
+	require.Len(t, mockRaft.usages, 1)
+	assert.Equal(t, "t1", mockRaft.usages[0].GetName())
+	assert.Equal(t, int64(15), mockRaft.usages[0].GetSeries())
+	assert.Equal(t, int64(150), mockRaft.usages[0].GetStorageBytes())
+
+	// the usage is not updated if the loads of any node are unknown
+	mockRaft.usages = nil
+	loadErr = errors.New("node unreachable")
+	c.collect(s)
+	assert.Len(t, mockRaft.usages, 0)
+}
@@ -0,0 +1,259 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metatool
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+const Usage = `Export, diff and restore the meta data of openGemini.
+
+Usage: ts-meta [export|diff|import] [flags]
+
+export: export the meta data of the databases to a json file
+    -host <url>
+            The http address of ts-meta. Defaults to "http://127.0.0.1:8091".
+    -db <names>
+            The databases to export separated by comma. All the databases are exported by default.
+    -out <path>
+            The file to write. Defaults to the standard output.
+    -with-password
+            Export the password hashes of the users, they are redacted by default. The users created by
+            importing a redacted file have no password until it is set again.
+    -username <name>
+    -password <password>
+            The user to authenticate if the authentication of ts-meta is enabled.
+
+diff: show the differences of the schema between two exported files
+    ts-meta diff <from.json> <to.json>
+
+import: restore the meta data of a database from an exported file through the raft log
+    -host <url>
+            The http address of ts-meta. Defaults to "http://127.0.0.1:8091".
+    -db <name>
+            The database to restore, required.
+    -file <path>
+            The exported file, required.
+    -overwrite
+            Replace the database if it exists.
+    -username <name>
+    -password <password>
+            The user to authenticate if the authentication of ts-meta is enabled.
+`
+
+const defaultHost = "http://127.0.0.1:8091"
+
+// IsCommand returns whether name is a subcommand of ts-meta operating the meta data
+func IsCommand(name string) bool {
+	switch name {
+	case "export", "diff", "import":
+		return true
+	default:
+		return false
+	}
+}
+
+// Run runs the subcommand, and the result is written to w
+func Run(w io.Writer, name string, args ...string) error {
+	switch name {
+	case "export":
+		return runExport(w, args...)
+	case "diff":
+		return runDiff(w, args...)
+	case "import":
+		return runImport(w, args...)
+	default:
+		return errors.New(Usage)
+	}
+}
+
+type client struct {
+	host     string
+	username string
+	password string
+	http     *http.Client
+}
+
+func newFlagSet(c *client) *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&c.host, "host", defaultHost, "")
+	fs.StringVar(&c.username, "username", "", "")
+	fs.StringVar(&c.password, "password", "", "")
+	return fs
+}
+
+func (c *client) init() {
+	c.host = strings.TrimSuffix(c.host, "/")
+	c.http = &http.Client{
+		Timeout: 30 * time.Second,
+		// the request to the follower is redirected to the leader, which may be another host
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			c.setAuth(req)
+			return nil
+		},
+	}
+}
+
+func (c *client) setAuth(req *http.Request) {
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+}
+
+func (c *client) do(method, path string, params url.Values, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, c.host+path+"?"+params.Encode(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	c.setAuth(req)
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s %s failed, status: %d, %s", method, path, resp.StatusCode, bytes.TrimSpace(b))
+	}
+	return b, nil
+}
+
+func runExport(w io.Writer, args ...string) error {
+	c := &client{}
+	var db, out string
+	var withPassword bool
+	fs := newFlagSet(c)
+	fs.StringVar(&db, "db", "", "")
+	fs.StringVar(&out, "out", "", "")
+	fs.BoolVar(&withPassword, "with-password", false, "")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%s\n%s", err, Usage)
+	}
+	c.init()
+
+	params := url.Values{}
+	if db != "" {
+		params.Set("db", db)
+	}
+	if withPassword {
+		params.Set("withPassword", "true")
+	}
+	b, err := c.do(http.MethodGet, "/exportMeta", params, nil)
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		_, err = w.Write(append(b, '\n'))
+		return err
+	}
+	if err = os.WriteFile(out, b, 0600); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "meta data exported to %s\n", out)
+	return err
+}
+
+func readExport(path string) (*meta.MetaExport, []byte, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	exp := &meta.MetaExport{}
+	if err = json.Unmarshal(b, exp); err != nil {
+		return nil, nil, fmt.Errorf("invalid meta export %s: %s", path, err)
+	}
+	if exp.Version != meta.MetaExportVersion {
+		return nil, nil, fmt.Errorf("unsupported meta export version %d of %s", exp.Version, path)
+	}
+	return exp, b, nil
+}
+
+func runDiff(w io.Writer, args ...string) error {
+	if len(args) != 2 {
+		return errors.New(Usage)
+	}
+	from, _, err := readExport(args[0])
+	if err != nil {
+		return err
+	}
+	to, _, err := readExport(args[1])
+	if err != nil {
+		return err
+	}
+
+	diffs := meta.DiffMetaExport(from, to)
+	if len(diffs) == 0 {
+		_, err = fmt.Fprintln(w, "no differences")
+		return err
+	}
+	for _, d := range diffs {
+		if _, err = fmt.Fprintln(w, d.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runImport(w io.Writer, args ...string) error {
+	c := &client{}
+	var db, file string
+	var overwrite bool
+	fs := newFlagSet(c)
+	fs.StringVar(&db, "db", "", "")
+	fs.StringVar(&file, "file", "", "")
+	fs.BoolVar(&overwrite, "overwrite", false, "")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%s\n%s", err, Usage)
+	}
+	if db == "" || file == "" {
+		return errors.New("missing required parameter: db and file")
+	}
+	c.init()
+
+	exp, b, err := readExport(file)
+	if err != nil {
+		return err
+	}
+	if _, ok := exp.Databases[db]; !ok {
+		return fmt.Errorf("database %s not found in %s", db, file)
+	}
+
+	params := url.Values{}
+	params.Set("db", db)
+	params.Set("overwrite", strconv.FormatBool(overwrite))
+	if _, err = c.do(http.MethodPost, "/importMeta", params, b); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "database %s restored from %s\n", db, file)
+	return err
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metatool
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeExport(t *testing.T, path string, exp *meta.MetaExport) {
+	b, err := json.Marshal(exp)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, b, 0600))
+}
+
+func TestExportAndImport(t *testing.T) {
+	exp := &meta.MetaExport{
+		Version:   meta.MetaExportVersion,
+		Databases: map[string]*meta.DatabaseInfo{"db0": {Name: "db0"}},
+	}
+	var imported []byte
+	var query, user, password string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, _ = r.BasicAuth()
+		query = r.URL.RawQuery
+		switch r.URL.Path {
+		case "/exportMeta":
+			b, _ := json.Marshal(exp)
+			_, _ = w.Write(b)
+		case "/importMeta":
+			imported, _ = io.ReadAll(r.Body)
+			_, _ = w.Write([]byte(`{"OK":true}`))
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	file := filepath.Join(t.TempDir(), "meta.json")
+	out := &bytes.Buffer{}
+	require.NoError(t, Run(out, "export", "-host", server.URL, "-db", "db0", "-out", file, "-username", "admin", "-password", "pwd"))
+	assert.Equal(t, "db=db0", query)
+	assert.Equal(t, "admin", user)
+	assert.Equal(t, "pwd", password)
+	assert.Contains(t, out.String(), file)
+
+	require.NoError(t, Run(out, "export", "-host", server.URL, "-with-password"))
+	assert.Equal(t, "withPassword=true", query)
+
+	out.Reset()
+	require.NoError(t, Run(out, "import", "-host", server.URL, "-db", "db0", "-file", file, "-overwrite"))
+	assert.Equal(t, "db=db0&overwrite=true", query)
+	assert.Equal(t, "", user)
+	b, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, b, imported)
+
+	assert.EqualError(t, Run(out, "import", "-host", server.URL, "-db", "db1", "-file", file), "database db1 not found in "+file)
+	assert.Error(t, Run(out, "import", "-host", server.URL, "-file", file))
+	assert.Error(t, Run(out, "export", "-host", server.URL+"/unknown"))
+	assert.Error(t, Run(out, "unknown"))
+}
+
+func TestDiff(t *testing.T) {
+	dir := t.TempDir()
+	from := &meta.MetaExport{
+		Version:   meta.MetaExportVersion,
+		Databases: map[string]*meta.DatabaseInfo{"db0": {Name: "db0"}},
+	}
+	to := &meta.MetaExport{
+		Version:   meta.MetaExportVersion,
+		Databases: map[string]*meta.DatabaseInfo{"db1": {Name: "db1"}},
+	}
+	writeExport(t, filepath.Join(dir, "from.json"), from)
+	writeExport(t, filepath.Join(dir, "to.json"), to)
+
+	out := &bytes.Buffer{}
+	require.NoError(t, Run(out, "diff", filepath.Join(dir, "from.json"), filepath.Join(dir, "to.json")))
+	assert.Equal(t, "- database db0\n+ database db1\n", out.String())
+
+	out.Reset()
+	require.NoError(t, Run(out, "diff", filepath.Join(dir, "from.json"), filepath.Join(dir, "from.json")))
+	assert.Equal(t, "no differences\n", out.String())
+
+	to.Version = 100
+	writeExport(t, filepath.Join(dir, "to.json"), to)
+	assert.Error(t, Run(out, "diff", filepath.Join(dir, "from.json"), filepath.Join(dir, "to.json")))
+	assert.Error(t, Run(out, "diff", filepath.Join(dir, "from.json")))
+	assert.True(t, IsCommand("diff"))
+	assert.False(t, IsCommand("run"))
+}
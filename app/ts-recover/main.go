@@ -82,6 +82,9 @@ func ParseFlags(usage func(), args ...string) (recover.RecoverConfig, error) {
 	fs.StringVar(&options.RecoverMode, "recoverMode", "1", "")
 	fs.StringVar(&options.FullBackupDataPath, "fullBackupDataPath", "", "")
 	fs.StringVar(&options.IncBackupDataPath, "incBackupDataPath", "", "")
+	fs.StringVar(&options.BackupTarget, "backupTarget", "", "")
+	fs.StringVar(&options.BackupNode, "backupNode", "", "")
+	fs.StringVar(&options.BackupName, "backupName", "", "")
 	if err := fs.Parse(args); err != nil {
 		return recover.RecoverConfig{}, err
 	}
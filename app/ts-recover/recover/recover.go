@@ -29,17 +29,27 @@ import (
 
 const FullAndIncRecoverMode = "1"
 const FullRecoverMode = "2"
+const StreamRecoverMode = "3"
 
 type RecoverConfig struct {
 	RecoverMode        string
 	ConfigPath         string
 	FullBackupDataPath string
 	IncBackupDataPath  string
+
+	// BackupTarget, BackupNode and BackupName select the streamed backup set
+	// restored by StreamRecoverMode, see backup.StreamBackup
+	BackupTarget string
+	BackupNode   string
+	BackupName   string
 }
 
 type RecoverFunc func(rc *RecoverConfig, path string) error
 
 func BackupRecover(opt *RecoverConfig, tsRecover *config.TsRecover) error {
+	if opt.RecoverMode == StreamRecoverMode {
+		return recoverWithStream(tsRecover, opt)
+	}
 	if opt.FullBackupDataPath == "" {
 		return fmt.Errorf("`missing required config: fullBackupDataPath")
 	}
@@ -79,6 +89,24 @@ func recoverWithFull(tsRecover *config.TsRecover, rc *RecoverConfig) error {
 	return nil
 }
 
+// recoverWithStream restores the data files of a streamed backup set. Streamed
+// backup sets do not hold the meta data, it is restored from the meta backup.
+func recoverWithStream(tsRecover *config.TsRecover, rc *RecoverConfig) error {
+	if rc.BackupTarget == "" {
+		return fmt.Errorf("`missing required parameter: backupTarget")
+	}
+	if rc.BackupNode == "" {
+		return fmt.Errorf("`missing required parameter: backupNode")
+	}
+
+	dataPath := filepath.Join(tsRecover.Data.DataDir, config.DataDirectory)
+	if err := os.RemoveAll(dataPath); err != nil {
+		return err
+	}
+	_, err := backup.Restore(rc.BackupTarget, rc.BackupNode, rc.BackupName)
+	return err
+}
+
 func recoverWithFullAndInc(tsRecover *config.TsRecover, rc *RecoverConfig) error {
 	if err := recoverMeta(tsRecover, rc, true); err != nil {
 		return err
@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/openGemini/openGemini/lib/backup"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/stretchr/testify/assert"
@@ -193,3 +194,38 @@ func CreateFile(path, content string) {
 	fd.Write([]byte(content))
 	fd.Close()
 }
+
+func TestRecoverWithStream(t *testing.T) {
+	dir := t.TempDir()
+	tsRecover := &config.TsRecover{
+		Data: config.Store{
+			DataDir: filepath.Join(dir, "store"),
+		},
+	}
+	target := filepath.Join(dir, "target")
+	file := filepath.Join(tsRecover.Data.DataDir, config.DataDirectory, "db0", "0", "autogen", "1_0_1_1", "columnstore", "cpu", "00000001-0000-00000000.tssp")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(file), 0750))
+	assert.NoError(t, os.WriteFile(file, []byte("tssp"), 0640))
+
+	s, err := backup.NewStreamBackup(target, "1", 1, false)
+	assert.NoError(t, err)
+	assert.NoError(t, s.PutFile(file))
+	assert.NoError(t, s.Commit())
+
+	// written after the backup
+	other := filepath.Join(filepath.Dir(file), "00000002-0000-00000000.tssp")
+	assert.NoError(t, os.WriteFile(other, []byte("tssp"), 0640))
+
+	rc := &RecoverConfig{RecoverMode: StreamRecoverMode, BackupNode: "1"}
+	assert.Error(t, BackupRecover(rc, tsRecover))
+	rc = &RecoverConfig{RecoverMode: StreamRecoverMode, BackupTarget: target}
+	assert.Error(t, BackupRecover(rc, tsRecover))
+
+	rc.BackupNode = "1"
+	assert.NoError(t, BackupRecover(rc, tsRecover))
+	buf, err := os.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, "tssp", string(buf))
+	_, err = os.Stat(other)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -159,6 +159,7 @@ func NewServer(conf config.Config, info app.ServerInfo, logger *Logger.Logger) (
 
 	s.initQueryExecutor(c)
 	s.httpService.Handler.ExtSysCtrl = s.TSDBStore
+	s.httpService.Handler.LogTailer = s.TSDBStore
 
 	s.initStatisticsPusher()
 	s.httpService.Handler.StatisticsPusher = s.statisticsPusher
@@ -200,6 +201,8 @@ func newServer(info app.ServerInfo, logger *Logger.Logger, c *config.TSSql, meta
 	if c.ContinuousQuery.Enabled {
 		hostname := config.CombineDomain(c.HTTP.Domain, c.HTTP.BindAddress)
 		cqService = continuousquery.NewService(hostname, time.Duration(c.ContinuousQuery.RunInterval), c.ContinuousQuery.MaxProcessCQNumber)
+		cqService.BackfillHorizon = time.Duration(c.ContinuousQuery.BackfillHorizon)
+		cqService.BackfillThrottle = time.Duration(c.ContinuousQuery.BackfillThrottle)
 		cqService.WithLogger(logger)
 	}
 
@@ -251,6 +254,8 @@ func (s *Server) initQueryExecutor(c *config.TSSql) {
 			MetaClient: s.MetaClient,
 			NetStore:   s.TSDBStore,
 			Logger:     s.Logger.With(zap.String("shardMapper", "cluster")),
+
+			ReplicaSelector: coordinator.NewReplicaSelector(c.Coordinator),
 		},
 		MetaExecutor:            metaExecutor,
 		MaxQueryMem:             int64(c.Coordinator.MaxQueryMem),
@@ -489,6 +494,7 @@ func (s *Server) initStatisticsPusher() {
 	stat.InitExecutorStatistics(globalTags)
 	stat.NewErrnoStat().Init(globalTags)
 	stat.NewLogKeeperStatistics().Init(globalTags)
+	stat.InitTenantStatistics(globalTags)
 
 	s.statisticsPusher.Register(
 		stat.CollectHandlerStatistics,
@@ -498,6 +504,7 @@ func (s *Server) initStatisticsPusher() {
 		stat.CollectExecutorStatistics,
 		stat.NewErrnoStat().Collect,
 		stat.NewLogKeeperStatistics().Collect,
+		stat.CollectTenantStatistics,
 	)
 
 	s.statisticsPusher.RegisterOps(stat.CollectOpsHandlerStatistics)
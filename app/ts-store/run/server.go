@@ -320,6 +320,7 @@ func (s *Server) initStatisticsPusher() {
 		stat.CollectStoreQueryStatistics,
 		stat.CollectSpdyStatistics,
 		stat.NewOOOTimeDistribution().Collect,
+		stat.CollectReplicationStatistics,
 	)
 
 	s.statisticsPusher.RegisterOps(stat.CollectOpsPerfStatistics)
@@ -362,4 +363,5 @@ func (s *Server) initStatistics() {
 	stat.InitSpdyStatistics(globalTags)
 	spdyTransport.InitStatistics(spdyTransport.AppStore)
 	stat.NewOOOTimeDistribution().Init(globalTags)
+	stat.InitReplicationStatistics(globalTags)
 }
@@ -449,6 +449,21 @@ func (client *MockMetaClient) ShowCluster(nodeType string, ID uint64) (models.Ro
 func (client *MockMetaClient) ShowClusterWithCondition(nodeType string, ID uint64) (models.Rows, error) {
 	return nil, nil
 }
+func (client *MockMetaClient) DecommissionNode(nodeID uint64) error {
+	return nil
+}
+func (client *MockMetaClient) CancelDecommission(nodeID uint64) error {
+	return nil
+}
+func (client *MockMetaClient) ShowDecommissions() ([]meta2.DecommissionInfo, error) {
+	return nil, nil
+}
+func (client *MockMetaClient) CreateReplication(database, target, role string) error {
+	return nil
+}
+func (client *MockMetaClient) DropReplication(database string, promote bool) error {
+	return nil
+}
 func (client *MockMetaClient) GetAliveShards(database string, sgi *meta2.ShardGroupInfo) []int {
 	return nil
 }
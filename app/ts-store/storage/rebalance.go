@@ -0,0 +1,68 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// needTransferPt returns true if the partition moved to this node is stored on the local disk of the source node,
+// the files are streamed from the source node before the partition is loaded
+func (s *Storage) needTransferPt(ptInfo *meta.DbPtInfo) bool {
+	return config.GetHaPolicy() == config.WriteAvailableFirst && s.node != nil && ptInfo.Pti.Owner.NodeID != s.node.ID
+}
+
+// finishTransferPt removes the transfer marker after the transferred partition is loaded. The files on the source node
+// are dropped by the meta after the new owner of the partition is committed.
+func (s *Storage) finishTransferPt(db string, ptId uint32) {
+	if err := s.engine.FinishPtTransfer(db, ptId); err != nil {
+		s.log.Warn("finish pt transfer failed", zap.String("db", db), zap.Uint32("pt", ptId), zap.Error(err))
+	}
+}
+
+// dropStalePtTransfer removes the files left by a transfer of the partition which did not finish, for example the
+// move is rolled back. The partition is assigned without the transfer, the partial copy must not be loaded.
+func (s *Storage) dropStalePtTransfer(db string, ptId uint32) error {
+	src, ok := s.engine.PtTransferSource(db, ptId)
+	if !ok {
+		return nil
+	}
+	s.log.Info("drop the files of the unfinished pt transfer", zap.String("db", db), zap.Uint32("pt", ptId), zap.Uint64("src", src))
+	return s.engine.RemovePtFiles(db, ptId)
+}
+
+func (s *Storage) PtLoads() []*netstorage.PtLoad {
+	return s.engine.PtLoads()
+}
+
+func (s *Storage) PtFiles(db string, ptId uint32) ([]*netstorage.PtFileInfo, error) {
+	return s.engine.PtFiles(db, ptId)
+}
+
+func (s *Storage) ReadPtFile(db string, ptId uint32, name string, offset, size int64) ([]byte, error) {
+	return s.engine.ReadPtFile(db, ptId, name, offset, size)
+}
+
+func (s *Storage) RemovePtFiles(db string, ptId uint32) error {
+	return s.engine.RemovePtFiles(db, ptId)
+}
+
+// ShowRebalance returns the transfers of the partitions moved to this node
+func (s *Storage) ShowRebalance() []*netstorage.PtTransferInfo {
+	return s.engine.ShowRebalance()
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// SplitRowsWriter writes the rows moved by the shard split to the node owning the shard split from it
+type SplitRowsWriter interface {
+	WriteSplitRows(nodeID uint64, db, rp string, pt uint32, shardID uint64, rows []byte) (int64, error)
+}
+
+// SplitShard moves the rows of the shard out of its key range to the shards split from it. The rows are written to
+// the nodes owning the shards whose key ranges contain them, then they are removed from the shard. The queries of the
+// shard skip the moved series from the start of the split, they are read from the split shards only.
+// The rows are moved again if the split is retried, the rows whose points exist in the split shard are skipped.
+func (s *Storage) SplitShard(db, rp string, ptId uint32, shardID uint64) (int64, error) {
+	if _, loaded := s.splittingShards.LoadOrStore(shardID, struct{}{}); loaded {
+		return 0, meta.ErrShardSplitting(shardID)
+	}
+	defer s.splittingShards.Delete(shardID)
+
+	_, _, sgi := s.metaClient.ShardOwner(shardID)
+	if sgi == nil || sgi.Shard(shardID) == nil {
+		return 0, errno.NewError(errno.ShardNotFound, shardID)
+	}
+	pts, err := s.metaClient.DBPtView(db)
+	if err != nil {
+		return 0, err
+	}
+
+	sh := sgi.Shard(shardID)
+	moved := make(map[uint64]int64)
+	rows, err := s.engine.SplitShard(db, ptId, shardID, sh.Min, sh.Max, func(batch []influx.Row) error {
+		dests := make(map[*meta.ShardInfo][]influx.Row)
+		for i := range batch {
+			dest := sgi.DestShard(string(batch[i].ShardKey))
+			if dest == nil || len(dest.Owners) == 0 || int(dest.Owners[0]) >= len(pts) {
+				return fmt.Errorf("no shard to move the rows of shard key %q of shard %d", batch[i].ShardKey, shardID)
+			}
+			dests[dest] = append(dests[dest], batch[i])
+		}
+		for dest, destRows := range dests {
+			buf, err := influx.FastMarshalMultiRows(nil, destRows)
+			if err != nil {
+				return err
+			}
+			n, err := s.splitWriter.WriteSplitRows(pts[dest.Owners[0]].Owner.NodeID, db, rp, dest.Owners[0], dest.ID, buf)
+			if err != nil {
+				return err
+			}
+			moved[dest.ID] += n
+		}
+		return nil
+	})
+	for id, n := range moved {
+		s.log.Info("move rows to the split shard done", zap.String("db", db), zap.Uint64("shard", shardID),
+			zap.Uint64("splitShard", id), zap.Int64("rows", n))
+	}
+	return rows, err
+}
+
+// WriteSplitRows writes the rows moved from the shard split to the shard split from it, the shard is created if
+// it is not on the node yet. The rows whose points exist in the shard are skipped, they are never overwritten.
+func (s *Storage) WriteSplitRows(db, rp string, ptId uint32, shardID uint64, binaryRows []byte) (int64, error) {
+	rows, _, _, _, _, err := influx.FastUnmarshalMultiRows(binaryRows, nil, nil, nil, nil, nil)
+	if err != nil || len(rows) == 0 {
+		return 0, err
+	}
+	var n int64
+	err = s.Write(db, rp, rows[0].Name, ptId, shardID, func() error {
+		var err error
+		n, err = s.engine.WriteSplitRows(db, ptId, shardID, rows)
+		return err
+	})
+	return n, err
+}
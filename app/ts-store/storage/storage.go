@@ -43,6 +43,7 @@ import (
 	"github.com/openGemini/openGemini/lib/stringinterner"
 	"github.com/openGemini/openGemini/lib/syscontrol"
 	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/tail"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
@@ -50,6 +51,8 @@ import (
 	"github.com/openGemini/openGemini/services/castor"
 	"github.com/openGemini/openGemini/services/downsample"
 	"github.com/openGemini/openGemini/services/hierarchical"
+	"github.com/openGemini/openGemini/services/repair"
+	"github.com/openGemini/openGemini/services/replication"
 	"github.com/openGemini/openGemini/services/retention"
 	"go.etcd.io/etcd/raft/v3/raftpb"
 	"go.uber.org/zap"
@@ -77,9 +80,9 @@ type StoreEngine interface {
 	GetShardDownSampleLevel(db string, ptId uint32, shardID uint64) int
 	PreOffload(uint64, *meta.DbPtInfo) error
 	RollbackPreOffload(uint64, *meta.DbPtInfo) error
-	PreAssign(uint64, *meta.DbPtInfo) error
+	PreAssign(uint64, *meta.DbPtInfo, bool) error
 	Offload(uint64, *meta.DbPtInfo) error
-	Assign(uint64, *meta.DbPtInfo) error
+	Assign(uint64, *meta.DbPtInfo, bool) error
 	GetConnId() uint64
 	CheckPtsRemovedDone() error
 }
@@ -108,6 +111,12 @@ type Storage struct {
 
 	Services []Service
 
+	repairService *repair.Service
+	ptTransport   netstorage.PtFileFetcher
+	splitWriter   SplitRowsWriter
+
+	splittingShards sync.Map // the shards whose rows are being moved to the shards split from them
+
 	log     *logger.Logger
 	loadCtx *metaclient.LoadCtx
 
@@ -145,6 +154,30 @@ func (s *Storage) appendHierarchicalService(c config.HierarchicalConfig) {
 	s.Services = append(s.Services, srv)
 }
 
+func (s *Storage) appendRepairService(c config.RepairConfig) {
+	if !c.Enabled {
+		return
+	}
+
+	srv := repair.NewService(c)
+	srv.Engine = s.engine
+	srv.MetaClient = s.metaClient
+	srv.Store = netstorage.NewNetStorage(s.metaClient)
+	s.repairService = srv
+	s.Services = append(s.Services, srv)
+}
+
+func (s *Storage) appendAsyncReplicationService(c config.AsyncReplicationConfig) {
+	if !c.Enabled {
+		return
+	}
+
+	srv := replication.NewService(c)
+	srv.Engine = s.engine
+	srv.MetaClient = s.metaClient
+	s.Services = append(s.Services, srv)
+}
+
 func (s *Storage) appendDownSamplePolicyService(c retention2.Config) {
 	if !c.Enabled {
 		return
@@ -275,6 +308,8 @@ func OpenStorage(path string, node *metaclient.Node, cli *metaclient.Client, con
 		loadCtx:      &loadCtx,
 		WriteLimit:   limiter.NewFixed(conf.Data.WriteConcurrentLimit),
 		slaveStorage: netstorage.NewNetStorage(cli),
+		ptTransport:  netstorage.NewNetStorage(cli),
+		splitWriter:  netstorage.NewNetStorage(cli),
 	}
 
 	if cli != nil {
@@ -287,6 +322,8 @@ func OpenStorage(path string, node *metaclient.Node, cli *metaclient.Client, con
 	s.appendRetentionPolicyService(conf.Retention)
 	s.appendDownSamplePolicyService(conf.DownSample)
 	s.appendHierarchicalService(conf.HierarchicalStore)
+	s.appendRepairService(conf.Repair)
+	s.appendAsyncReplicationService(conf.AsyncReplication)
 	s.appendAnalysisService(conf.Analysis)
 	s.appendProactiveMgrService(conf.Data)
 
@@ -534,6 +571,44 @@ func (s *Storage) TagValuesCardinality(db string, ptIDs []uint32, tagKeys map[st
 	return s.engine.TagValuesCardinality(db, ptIDs, tagKeys, condition, tr)
 }
 
+func (s *Storage) ShowCompactions() []*netstorage.CompactionInfo {
+	return s.engine.ShowCompactions()
+}
+
+func (s *Storage) TagKeysCardinality(db string, ptIDs []uint32, measurements []string) ([]*netstorage.TagKeyCardinality, error) {
+	return s.engine.TagKeysCardinality(db, ptIDs, stringSlice2BytesSlice(measurements))
+}
+
+// TailLogs polls the live tail session of the logstream, the session is closed instead if closing is set.
+func (s *Storage) TailLogs(req *netstorage.TailLogsRequest) ([]*tail.Log, tail.Stats, error) {
+	if req.Close {
+		s.engine.CloseTailLogs(req.Session)
+		return nil, tail.Stats{}, nil
+	}
+	opt := tail.Options{BufferSize: int(req.BufferSize), Rate: int(req.Rate)}
+	return s.engine.TailLogs(req.Db, req.LogStream, req.Session, req.Condition, opt, time.Duration(req.Wait))
+}
+
+func (s *Storage) ReplicaReadStatus(db string, ptId uint32, waitApplied bool, timeout time.Duration) (uint64, uint64, error) {
+	return s.engine.ReplicaReadStatus(db, ptId, waitApplied, timeout)
+}
+
+func (s *Storage) ShardDigest(db string, ptId uint32, shardID uint64, interval int64) (*netstorage.ShardDigest, error) {
+	return s.engine.ShardDigest(db, ptId, shardID, interval)
+}
+
+func (s *Storage) RepairShard(db, rp string, ptId uint32, shardID uint64, rows []byte) (int64, error) {
+	return s.engine.RepairShard(db, rp, ptId, shardID, rows)
+}
+
+// ShowRepairs returns the repairs of the replicas found by the anti-entropy service on this node
+func (s *Storage) ShowRepairs() []*netstorage.RepairInfo {
+	if s.repairService == nil {
+		return nil
+	}
+	return s.repairService.Repairs()
+}
+
 func (s *Storage) TagKeys(db string, ptIDs []uint32, measurements []string, condition influxql.Expr, tr influxql.TimeRange) ([]string, error) {
 	ms := stringSlice2BytesSlice(measurements)
 
@@ -573,7 +648,14 @@ func (s *Storage) RollbackPreOffload(opId uint64, ptInfo *meta.DbPtInfo) error {
 	return s.engine.RollbackPreOffload(opId, ptInfo.Db, ptInfo.Pti.PtId)
 }
 
-func (s *Storage) PreAssign(opId uint64, ptInfo *meta.DbPtInfo) error {
+func (s *Storage) PreAssign(opId uint64, ptInfo *meta.DbPtInfo, transferFiles bool) error {
+	if transferFiles && s.needTransferPt(ptInfo) {
+		// the partition is not preloaded, its files are overwritten by the final transfer
+		return s.engine.TransferPt(opId, ptInfo.Db, ptInfo.Pti.PtId, ptInfo.Pti.Owner.NodeID, netstorage.PtTransferBulk, s.ptTransport)
+	}
+	if err := s.dropStalePtTransfer(ptInfo.Db, ptInfo.Pti.PtId); err != nil {
+		return err
+	}
 	return s.engine.PreAssign(opId, ptInfo.Db, ptInfo.Pti.PtId, ptInfo.Shards, ptInfo.DBBriefInfo, s.metaClient)
 }
 
@@ -581,8 +663,28 @@ func (s *Storage) Offload(opId uint64, ptInfo *meta.DbPtInfo) error {
 	return s.engine.Offload(opId, ptInfo.Db, ptInfo.Pti.PtId)
 }
 
-func (s *Storage) Assign(opId uint64, ptInfo *meta.DbPtInfo) error {
-	return s.engine.Assign(opId, ptInfo.Pti.Owner.NodeID, ptInfo.Db, ptInfo.Pti.PtId, ptInfo.Pti.Ver, ptInfo.Shards, ptInfo.DBBriefInfo, s.metaClient, s)
+func (s *Storage) Assign(opId uint64, ptInfo *meta.DbPtInfo, transferFiles bool) error {
+	db, ptId := ptInfo.Db, ptInfo.Pti.PtId
+	if !transferFiles {
+		if err := s.dropStalePtTransfer(db, ptId); err != nil {
+			return err
+		}
+		return s.engine.Assign(opId, ptInfo.Pti.Owner.NodeID, db, ptId, ptInfo.Pti.Ver, ptInfo.Shards, ptInfo.DBBriefInfo, s.metaClient, s)
+	}
+
+	src, transferred := s.engine.PtTransferSource(db, ptId)
+	if transferred {
+		if err := s.engine.TransferPt(opId, db, ptId, src, netstorage.PtTransferFinal, s.ptTransport); err != nil {
+			return err
+		}
+	}
+	if err := s.engine.Assign(opId, ptInfo.Pti.Owner.NodeID, db, ptId, ptInfo.Pti.Ver, ptInfo.Shards, ptInfo.DBBriefInfo, s.metaClient, s); err != nil {
+		return err
+	}
+	if transferred {
+		s.finishTransferPt(db, ptId)
+	}
+	return nil
 }
 
 func (s *Storage) GetConnId() uint64 {
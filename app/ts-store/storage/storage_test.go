@@ -412,3 +412,142 @@ func TestStorage_RepConfigWrite(t *testing.T) {
 		t.Fatal("TestStorage_RepConfigWrite err2")
 	}
 }
+
+type mockSplitEngine struct {
+	netstorage.Engine
+	keyRange []string
+}
+
+func (e *mockSplitEngine) SplitShard(db string, ptId uint32, shardID uint64, min, max string, fn func(rows []influx.Row) error) (int64, error) {
+	e.keyRange = append(e.keyRange, min, max)
+	rows := mockRows(3)
+	for i, key := range []string{"cpu,host=c", "cpu,host=e", "cpu,host=c"} {
+		rows[i].ShardKey = []byte(key)
+	}
+	if err := fn(rows); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+type mockSplitRowsWriter struct {
+	nodes map[uint64]uint64
+	rows  map[uint64]int
+}
+
+func (w *mockSplitRowsWriter) WriteSplitRows(nodeID uint64, _, _ string, _ uint32, shardID uint64, buf []byte) (int64, error) {
+	rows, _, _, _, _, err := influx.FastUnmarshalMultiRows(buf, nil, nil, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	w.nodes[shardID] = nodeID
+	w.rows[shardID] += len(rows)
+	return int64(len(rows)), nil
+}
+
+func TestStorage_SplitShard(t *testing.T) {
+	data := &meta.Data{
+		Databases: map[string]*meta.DatabaseInfo{"db0": {
+			Name: "db0",
+			RetentionPolicies: map[string]*meta.RetentionPolicyInfo{"rp0": {
+				Name: "rp0",
+				ShardGroups: []meta.ShardGroupInfo{{ID: 1, Shards: []meta.ShardInfo{
+					{ID: 1, Owners: []uint32{0}, Max: "cpu,host=b"},
+					{ID: 2, Owners: []uint32{1}, Min: "cpu,host=b", Max: "cpu,host=d", SplitFrom: 1},
+					{ID: 3, Owners: []uint32{0}, Min: "cpu,host=d", SplitFrom: 1},
+				}}},
+			}},
+		}},
+		PtView: map[string]meta.DBPtInfos{"db0": {
+			{PtId: 0, Owner: meta.PtOwner{NodeID: 1}},
+			{PtId: 1, Owner: meta.PtOwner{NodeID: 2}},
+		}},
+	}
+	client := metaclient.NewClient(t.TempDir(), false, 100)
+	client.SetCacheData(data)
+	eng := &mockSplitEngine{}
+	writer := &mockSplitRowsWriter{nodes: map[uint64]uint64{}, rows: map[uint64]int{}}
+	s := &Storage{
+		log:         logger.NewLogger(errno.ModuleStorageEngine),
+		metaClient:  client,
+		engine:      eng,
+		splitWriter: writer,
+	}
+
+	rows, err := s.SplitShard("db0", "rp0", 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), rows)
+	assert.Equal(t, []string{"", "cpu,host=b"}, eng.keyRange)
+	assert.Equal(t, map[uint64]uint64{2: 2, 3: 1}, writer.nodes)
+	assert.Equal(t, map[uint64]int{2: 2, 3: 1}, writer.rows)
+
+	_, err = s.SplitShard("db0", "rp0", 0, 4)
+	require.True(t, errno.Equal(err, errno.ShardNotFound))
+}
+
+type mockTransferEngine struct {
+	netstorage.Engine
+	src   uint64
+	calls []string
+}
+
+func (e *mockTransferEngine) PreAssign(uint64, string, uint32, map[uint64]*meta.ShardDurationInfo, *meta.DatabaseBriefInfo, metaclient.MetaClient) error {
+	e.calls = append(e.calls, "preassign")
+	return nil
+}
+
+func (e *mockTransferEngine) Assign(uint64, uint64, string, uint32, uint64, map[uint64]*meta.ShardDurationInfo, *meta.DatabaseBriefInfo, metaclient.MetaClient, netstorage.StorageService) error {
+	e.calls = append(e.calls, "assign")
+	return nil
+}
+
+func (e *mockTransferEngine) TransferPt(_ uint64, _ string, _ uint32, src uint64, phase string, _ netstorage.PtFileFetcher) error {
+	e.calls = append(e.calls, phase)
+	e.src = src
+	return nil
+}
+
+func (e *mockTransferEngine) PtTransferSource(string, uint32) (uint64, bool) {
+	return e.src, e.src != 0
+}
+
+func (e *mockTransferEngine) FinishPtTransfer(string, uint32) error {
+	e.calls = append(e.calls, "finish")
+	e.src = 0
+	return nil
+}
+
+func (e *mockTransferEngine) RemovePtFiles(string, uint32) error {
+	e.calls = append(e.calls, "remove")
+	e.src = 0
+	return nil
+}
+
+func TestStorage_AssignTransferPt(t *testing.T) {
+	_ = config.SetHaPolicy(config.WAFPolicy)
+	eng := &mockTransferEngine{}
+	s := &Storage{
+		log:    logger.NewLogger(errno.ModuleStorageEngine),
+		node:   &metaclient.Node{ID: 2},
+		engine: eng,
+	}
+	ptInfo := &meta.DbPtInfo{Db: "db0", Pti: &meta.PtInfo{PtId: 1, Owner: meta.PtOwner{NodeID: 1}}}
+
+	// the moves which are not started by the rebalancing load the pt from the local files
+	require.NoError(t, s.PreAssign(1, ptInfo, false))
+	require.NoError(t, s.Assign(1, ptInfo, false))
+	assert.Equal(t, []string{"preassign", "assign"}, eng.calls)
+
+	eng.calls = nil
+	require.NoError(t, s.PreAssign(2, ptInfo, true))
+	ptInfo.Pti.Owner.NodeID = 2
+	require.NoError(t, s.Assign(2, ptInfo, true))
+	assert.Equal(t, []string{netstorage.PtTransferBulk, netstorage.PtTransferFinal, "assign", "finish"}, eng.calls)
+
+	// the files of the transfer which is rolled back are not loaded by the next move
+	eng.calls = nil
+	ptInfo.Pti.Owner.NodeID = 1
+	require.NoError(t, s.PreAssign(3, ptInfo, true))
+	require.NoError(t, s.PreAssign(4, ptInfo, false))
+	assert.Equal(t, []string{netstorage.PtTransferBulk, "remove", "preassign"}, eng.calls)
+}
@@ -45,6 +45,34 @@ func NewHandler(typ uint8) RPCHandler {
 		return &CreateDataBase{}
 	case netstorage.ShowQueriesRequestMessage:
 		return &ShowQueries{}
+	case netstorage.ShowCompactionsRequestMessage:
+		return &ShowCompactions{}
+	case netstorage.ShowCardinalityTopRequestMessage:
+		return &ShowCardinalityTop{}
+	case netstorage.ReplicaReadStatusRequestMessage:
+		return &ReplicaReadStatus{}
+	case netstorage.ShardDigestRequestMessage:
+		return &ShardDigest{}
+	case netstorage.RepairShardRequestMessage:
+		return &RepairShard{}
+	case netstorage.ShowRepairsRequestMessage:
+		return &ShowRepairs{}
+	case netstorage.PtLoadsRequestMessage:
+		return &PtLoads{}
+	case netstorage.PtFilesRequestMessage:
+		return &PtFiles{}
+	case netstorage.ReadPtFileRequestMessage:
+		return &ReadPtFile{}
+	case netstorage.DropPtFilesRequestMessage:
+		return &DropPtFiles{}
+	case netstorage.ShowRebalanceRequestMessage:
+		return &ShowRebalance{}
+	case netstorage.SplitShardRequestMessage:
+		return &SplitShard{}
+	case netstorage.TailLogsRequestMessage:
+		return &TailLogs{}
+	case netstorage.WriteSplitRowsRequestMessage:
+		return &WriteSplitRows{}
 	case netstorage.KillQueryRequestMessage:
 		return &KillQuery{}
 	case netstorage.ShowTagKeysRequestMessage:
@@ -209,6 +237,244 @@ func (h *ShowQueries) SetMessage(msg codec.BinaryCodec) error {
 	return nil
 }
 
+type ShowCompactions struct {
+	BaseHandler
+
+	req *netstorage.ShowCompactionsRequest
+	rsp *netstorage.ShowCompactionsResponse
+}
+
+func (h *ShowCompactions) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.ShowCompactionsResponse{}
+	req, ok := msg.(*netstorage.ShowCompactionsRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.ShowCompactionsRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type ShowCardinalityTop struct {
+	BaseHandler
+
+	req *netstorage.ShowCardinalityTopRequest
+	rsp *netstorage.ShowCardinalityTopResponse
+}
+
+func (h *ShowCardinalityTop) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.ShowCardinalityTopResponse{}
+	req, ok := msg.(*netstorage.ShowCardinalityTopRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.ShowCardinalityTopRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type ReplicaReadStatus struct {
+	BaseHandler
+
+	req *netstorage.ReplicaReadStatusRequest
+	rsp *netstorage.ReplicaReadStatusResponse
+}
+
+func (h *ReplicaReadStatus) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.ReplicaReadStatusResponse{}
+	req, ok := msg.(*netstorage.ReplicaReadStatusRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.ReplicaReadStatusRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type ShardDigest struct {
+	BaseHandler
+
+	req *netstorage.ShardDigestRequest
+	rsp *netstorage.ShardDigestResponse
+}
+
+func (h *ShardDigest) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.ShardDigestResponse{}
+	req, ok := msg.(*netstorage.ShardDigestRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.ShardDigestRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type RepairShard struct {
+	BaseHandler
+
+	req *netstorage.RepairShardRequest
+	rsp *netstorage.RepairShardResponse
+}
+
+func (h *RepairShard) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.RepairShardResponse{}
+	req, ok := msg.(*netstorage.RepairShardRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.RepairShardRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type ShowRepairs struct {
+	BaseHandler
+
+	req *netstorage.ShowRepairsRequest
+	rsp *netstorage.ShowRepairsResponse
+}
+
+func (h *ShowRepairs) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.ShowRepairsResponse{}
+	req, ok := msg.(*netstorage.ShowRepairsRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.ShowRepairsRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type PtLoads struct {
+	BaseHandler
+
+	req *netstorage.PtLoadsRequest
+	rsp *netstorage.PtLoadsResponse
+}
+
+func (h *PtLoads) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.PtLoadsResponse{}
+	req, ok := msg.(*netstorage.PtLoadsRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.PtLoadsRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type PtFiles struct {
+	BaseHandler
+
+	req *netstorage.PtFilesRequest
+	rsp *netstorage.PtFilesResponse
+}
+
+func (h *PtFiles) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.PtFilesResponse{}
+	req, ok := msg.(*netstorage.PtFilesRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.PtFilesRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type ReadPtFile struct {
+	BaseHandler
+
+	req *netstorage.ReadPtFileRequest
+	rsp *netstorage.ReadPtFileResponse
+}
+
+func (h *ReadPtFile) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.ReadPtFileResponse{}
+	req, ok := msg.(*netstorage.ReadPtFileRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.ReadPtFileRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type DropPtFiles struct {
+	BaseHandler
+
+	req *netstorage.DropPtFilesRequest
+	rsp *netstorage.DropPtFilesResponse
+}
+
+func (h *DropPtFiles) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.DropPtFilesResponse{}
+	req, ok := msg.(*netstorage.DropPtFilesRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.DropPtFilesRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type ShowRebalance struct {
+	BaseHandler
+
+	req *netstorage.ShowRebalanceRequest
+	rsp *netstorage.ShowRebalanceResponse
+}
+
+func (h *ShowRebalance) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.ShowRebalanceResponse{}
+	req, ok := msg.(*netstorage.ShowRebalanceRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.ShowRebalanceRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type SplitShard struct {
+	BaseHandler
+
+	req *netstorage.SplitShardRequest
+	rsp *netstorage.SplitShardResponse
+}
+
+func (h *SplitShard) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.SplitShardResponse{}
+	req, ok := msg.(*netstorage.SplitShardRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.SplitShardRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type WriteSplitRows struct {
+	BaseHandler
+
+	req *netstorage.WriteSplitRowsRequest
+	rsp *netstorage.WriteSplitRowsResponse
+}
+
+func (h *WriteSplitRows) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.WriteSplitRowsResponse{}
+	req, ok := msg.(*netstorage.WriteSplitRowsRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.WriteSplitRowsRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type TailLogs struct {
+	BaseHandler
+
+	req *netstorage.TailLogsRequest
+	rsp *netstorage.TailLogsResponse
+}
+
+func (h *TailLogs) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.TailLogsResponse{}
+	req, ok := msg.(*netstorage.TailLogsRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.TailLogsRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
 type KillQuery struct {
 	BaseHandler
 
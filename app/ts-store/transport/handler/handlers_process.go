@@ -185,6 +185,95 @@ func (h *ShowQueries) Process() (codec.BinaryCodec, error) {
 
 }
 
+func (h *ShowCompactions) Process() (codec.BinaryCodec, error) {
+	h.rsp.Compactions = h.store.ShowCompactions()
+	return h.rsp, nil
+}
+
+func (h *ShowCardinalityTop) Process() (codec.BinaryCodec, error) {
+	var err error
+	h.rsp.Cardinalities, err = h.store.TagKeysCardinality(h.req.GetDb(), h.req.PtIDs, h.req.Measurements)
+	h.rsp.Err = netstorage.MarshalError(err)
+	return h.rsp, nil
+}
+
+func (h *ReplicaReadStatus) Process() (codec.BinaryCodec, error) {
+	var err error
+	h.rsp.AppliedIndex, h.rsp.CommittedIndex, err = h.store.ReplicaReadStatus(h.req.Db, h.req.PtId, h.req.WaitApplied, time.Duration(h.req.Timeout))
+	h.rsp.Err = netstorage.MarshalError(err)
+	return h.rsp, nil
+}
+
+func (h *ShardDigest) Process() (codec.BinaryCodec, error) {
+	var err error
+	h.rsp.Digest, err = h.store.ShardDigest(h.req.Db, h.req.PtId, h.req.ShardID, h.req.Interval)
+	h.rsp.Err = netstorage.MarshalError(err)
+	return h.rsp, nil
+}
+
+func (h *RepairShard) Process() (codec.BinaryCodec, error) {
+	var err error
+	h.rsp.Rows, err = h.store.RepairShard(h.req.Db, h.req.Rp, h.req.PtId, h.req.ShardID, h.req.Rows)
+	h.rsp.Err = netstorage.MarshalError(err)
+	return h.rsp, nil
+}
+
+func (h *ShowRepairs) Process() (codec.BinaryCodec, error) {
+	h.rsp.Repairs = h.store.ShowRepairs()
+	return h.rsp, nil
+}
+
+func (h *PtLoads) Process() (codec.BinaryCodec, error) {
+	h.rsp.Loads = h.store.PtLoads()
+	return h.rsp, nil
+}
+
+func (h *PtFiles) Process() (codec.BinaryCodec, error) {
+	var err error
+	h.rsp.Files, err = h.store.PtFiles(h.req.Db, h.req.PtId)
+	h.rsp.Err = netstorage.MarshalError(err)
+	return h.rsp, nil
+}
+
+func (h *ReadPtFile) Process() (codec.BinaryCodec, error) {
+	var err error
+	h.rsp.Data, err = h.store.ReadPtFile(h.req.Db, h.req.PtId, h.req.Name, h.req.Offset, h.req.Size)
+	h.rsp.Err = netstorage.MarshalError(err)
+	return h.rsp, nil
+}
+
+func (h *DropPtFiles) Process() (codec.BinaryCodec, error) {
+	err := h.store.RemovePtFiles(h.req.Db, h.req.PtId)
+	h.rsp.Err = netstorage.MarshalError(err)
+	return h.rsp, nil
+}
+
+func (h *ShowRebalance) Process() (codec.BinaryCodec, error) {
+	h.rsp.Transfers = h.store.ShowRebalance()
+	return h.rsp, nil
+}
+
+func (h *SplitShard) Process() (codec.BinaryCodec, error) {
+	var err error
+	h.rsp.Rows, err = h.store.SplitShard(h.req.Db, h.req.Rp, h.req.PtId, h.req.ShardID)
+	h.rsp.Err = netstorage.MarshalError(err)
+	return h.rsp, nil
+}
+
+func (h *WriteSplitRows) Process() (codec.BinaryCodec, error) {
+	var err error
+	h.rsp.Rows, err = h.store.WriteSplitRows(h.req.Db, h.req.Rp, h.req.PtId, h.req.ShardID, h.req.Rows)
+	h.rsp.Err = netstorage.MarshalError(err)
+	return h.rsp, nil
+}
+
+func (h *TailLogs) Process() (codec.BinaryCodec, error) {
+	var err error
+	h.rsp.Logs, h.rsp.Stats, err = h.store.TailLogs(h.req)
+	h.rsp.Err = netstorage.MarshalError(err)
+	return h.rsp, nil
+}
+
 func (h *KillQuery) Process() (codec.BinaryCodec, error) {
 	qid := h.req.GetQueryID()
 	var isExist bool
@@ -18,15 +18,21 @@ import (
 	"fmt"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/openGemini/openGemini/app/ts-store/storage"
 	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
 	internal "github.com/openGemini/openGemini/lib/netstorage/data"
 	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/tail"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.etcd.io/etcd/raft/v3/raftpb"
 )
 
@@ -170,6 +176,100 @@ func (e *MockEngine) DbPTRef(db string, ptId uint32) error {
 
 func (e *MockEngine) DbPTUnref(db string, ptId uint32) {}
 
+func (e *MockEngine) ShowCompactions() []*netstorage.CompactionInfo {
+	return []*netstorage.CompactionInfo{{ShardID: 1, Measurement: "cpu_0000", Strategy: "time-window", Level: 1, FileCount: 4}}
+}
+
+func (e *MockEngine) TagKeysCardinality(db string, ptIDs []uint32, measurements [][]byte) ([]*netstorage.TagKeyCardinality, error) {
+	if db != "db0" {
+		return nil, errno.NewError(errno.DatabaseNotFound, db)
+	}
+	return []*netstorage.TagKeyCardinality{{Measurement: string(measurements[0]), Key: "request_id", Values: 100, Series: 100}}, nil
+}
+
+func (e *MockEngine) ReplicaReadStatus(db string, ptId uint32, waitApplied bool, timeout time.Duration) (uint64, uint64, error) {
+	if ptId != 1 {
+		return 0, 0, errno.NewError(errno.PtNotReplicated, db, ptId)
+	}
+	if waitApplied {
+		return 12, 12, nil
+	}
+	return 10, 12, nil
+}
+
+func (e *MockEngine) FetchShardsNeedRepair(coldDuration time.Duration) []*meta.ShardIdentifier {
+	return nil
+}
+
+func (e *MockEngine) ShardDigest(db string, ptId uint32, shardID uint64, interval int64) (*netstorage.ShardDigest, error) {
+	if shardID != 1 {
+		return nil, errno.NewError(errno.ShardNotFound, shardID)
+	}
+	return &netstorage.ShardDigest{ShardID: shardID, Hash: 100, Measurements: []*netstorage.MeasurementDigest{
+		{Name: "cpu_0000", Series: 2, Rows: 20, Hash: 10},
+	}}, nil
+}
+
+func (e *MockEngine) ScanShardRows(db string, ptId uint32, shardID uint64, mst string, ranges []util.TimeRange,
+	fn func(rows []byte) error) (int64, error) {
+	return 0, nil
+}
+
+func (e *MockEngine) RepairShard(db, rp string, ptId uint32, shardID uint64, rows []byte) (int64, error) {
+	if shardID != 1 {
+		return 0, errno.NewError(errno.ShardNotFound, shardID)
+	}
+	return int64(len(rows)), nil
+}
+
+func (e *MockEngine) WriteSplitRows(_ string, _ uint32, _ uint64, rows []influx.Row) (int64, error) {
+	return int64(len(rows)), nil
+}
+
+func (e *MockEngine) PtLoads() []*netstorage.PtLoad {
+	return []*netstorage.PtLoad{{Db: "db0", PtId: 1, DiskBytes: 1024, Series: 10, WriteRows: 100, Queries: 5}}
+}
+
+func (e *MockEngine) PtFiles(db string, ptId uint32) ([]*netstorage.PtFileInfo, error) {
+	if ptId != 1 {
+		return nil, errno.NewError(errno.PtNotFound)
+	}
+	return []*netstorage.PtFileInfo{{Name: "data/rp0/1_0_0_0/tssp/cpu_0000/00000001-0000-00000000.tssp", Size: 1024, ModTime: 1}}, nil
+}
+
+func (e *MockEngine) ReadPtFile(db string, ptId uint32, name string, offset, size int64) ([]byte, error) {
+	return []byte("abc"), nil
+}
+
+func (e *MockEngine) RemovePtFiles(db string, ptId uint32) error {
+	return nil
+}
+
+func (e *MockEngine) PtTransferSource(db string, ptId uint32) (uint64, bool) {
+	return 0, false
+}
+
+func (e *MockEngine) FinishPtTransfer(db string, ptId uint32) error {
+	return nil
+}
+
+func (e *MockEngine) TransferPt(opId uint64, db string, ptId uint32, srcNodeId uint64, phase string, fetcher netstorage.PtFileFetcher) error {
+	return nil
+}
+
+func (e *MockEngine) ShowRebalance() []*netstorage.PtTransferInfo {
+	return []*netstorage.PtTransferInfo{{Db: "db0", PtId: 1, SrcNodeId: 2, Phase: netstorage.PtTransferBulk, State: "running"}}
+}
+
+func (e *MockEngine) TailLogs(db, logStream string, session uint64, condition string, opt tail.Options, wait time.Duration) ([]*tail.Log, tail.Stats, error) {
+	if db != "repo" {
+		return nil, tail.Stats{}, errno.NewError(errno.DatabaseNotFound, db)
+	}
+	return []*tail.Log{{Time: 1, Fields: map[string]interface{}{"status": int64(503)}}}, tail.Stats{Matched: 1, Sent: 1}, nil
+}
+
+func (e *MockEngine) CloseTailLogs(session uint64) {}
+
 type MockShowTagValuesPlan struct {
 	ExecuteFn func(tagKeys map[string][][]byte, condition influxql.Expr, tr util.TimeRange, limit int) (netstorage.TablesTagSets, error)
 	StopFn    func()
@@ -210,6 +310,244 @@ func TestProcessGetShardSplitPoints(t *testing.T) {
 	response.Err = nil
 }
 
+func TestProcessShowCompactions(t *testing.T) {
+	h := NewHandler(netstorage.ShowCompactionsRequestMessage)
+	require.NoError(t, h.SetMessage(&netstorage.ShowCompactionsRequest{}))
+
+	s := &storage.Storage{}
+	s.SetEngine(&MockEngine{})
+	h.SetStore(s)
+
+	rsp, err := h.Process()
+	require.NoError(t, err)
+	response, ok := rsp.(*netstorage.ShowCompactionsResponse)
+	require.True(t, ok)
+	require.Equal(t, 1, len(response.Compactions))
+	assert.Equal(t, "time-window", response.Compactions[0].Strategy)
+}
+
+func TestProcessShowCardinalityTop(t *testing.T) {
+	s := &storage.Storage{}
+	s.SetEngine(&MockEngine{})
+
+	process := func(db string) *netstorage.ShowCardinalityTopResponse {
+		req := &netstorage.ShowCardinalityTopRequest{}
+		req.Db = proto.String(db)
+		req.Measurements = []string{"cpu_0000"}
+		h := NewHandler(netstorage.ShowCardinalityTopRequestMessage)
+		require.NoError(t, h.SetMessage(req))
+		h.SetStore(s)
+		rsp, err := h.Process()
+		require.NoError(t, err)
+		response, ok := rsp.(*netstorage.ShowCardinalityTopResponse)
+		require.True(t, ok)
+		return response
+	}
+
+	response := process("db0")
+	require.NoError(t, response.Error())
+	require.Equal(t, 1, len(response.Cardinalities))
+	assert.Equal(t, "request_id", response.Cardinalities[0].Key)
+
+	response = process("db1")
+	require.True(t, errno.Equal(response.Error(), errno.DatabaseNotFound))
+}
+
+func TestProcessReplicaReadStatus(t *testing.T) {
+	s := &storage.Storage{}
+	s.SetEngine(&MockEngine{})
+
+	process := func(pt uint32, waitApplied bool) *netstorage.ReplicaReadStatusResponse {
+		req := &netstorage.ReplicaReadStatusRequest{Db: "db0", PtId: pt, WaitApplied: waitApplied, Timeout: int64(time.Second)}
+		h := NewHandler(netstorage.ReplicaReadStatusRequestMessage)
+		require.NoError(t, h.SetMessage(req))
+		h.SetStore(s)
+		rsp, err := h.Process()
+		require.NoError(t, err)
+		response, ok := rsp.(*netstorage.ReplicaReadStatusResponse)
+		require.True(t, ok)
+		return response
+	}
+
+	response := process(1, false)
+	require.NoError(t, response.Error())
+	assert.Equal(t, uint64(10), response.AppliedIndex)
+	assert.Equal(t, uint64(12), response.CommittedIndex)
+
+	response = process(1, true)
+	require.NoError(t, response.Error())
+	assert.Equal(t, uint64(12), response.AppliedIndex)
+
+	response = process(2, true)
+	require.True(t, errno.Equal(response.Error(), errno.PtNotReplicated))
+}
+
+func TestProcessShardDigest(t *testing.T) {
+	s := &storage.Storage{}
+	s.SetEngine(&MockEngine{})
+
+	process := func(shardID uint64) *netstorage.ShardDigestResponse {
+		h := NewHandler(netstorage.ShardDigestRequestMessage)
+		require.NoError(t, h.SetMessage(&netstorage.ShardDigestRequest{Db: "db0", PtId: 1, ShardID: shardID, Interval: int64(time.Hour)}))
+		h.SetStore(s)
+		rsp, err := h.Process()
+		require.NoError(t, err)
+		response, ok := rsp.(*netstorage.ShardDigestResponse)
+		require.True(t, ok)
+		return response
+	}
+
+	response := process(1)
+	require.NoError(t, response.Error())
+	assert.Equal(t, uint64(100), response.Digest.Hash)
+	assert.Equal(t, "cpu_0000", response.Digest.Measurements[0].Name)
+
+	response = process(2)
+	require.True(t, errno.Equal(response.Error(), errno.ShardNotFound))
+}
+
+func TestProcessRepairShard(t *testing.T) {
+	s := &storage.Storage{}
+	s.SetEngine(&MockEngine{})
+
+	h := NewHandler(netstorage.RepairShardRequestMessage)
+	require.NoError(t, h.SetMessage(&netstorage.RepairShardRequest{Db: "db0", Rp: "rp0", PtId: 1, ShardID: 1, Rows: make([]byte, 20)}))
+	h.SetStore(s)
+	rsp, err := h.Process()
+	require.NoError(t, err)
+	response, ok := rsp.(*netstorage.RepairShardResponse)
+	require.True(t, ok)
+	require.NoError(t, response.Error())
+	assert.Equal(t, int64(20), response.Rows)
+
+	// the anti-entropy service is disabled
+	h = NewHandler(netstorage.ShowRepairsRequestMessage)
+	require.NoError(t, h.SetMessage(&netstorage.ShowRepairsRequest{}))
+	h.SetStore(s)
+	rsp, err = h.Process()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(rsp.(*netstorage.ShowRepairsResponse).Repairs))
+}
+
+func TestProcessPtFiles(t *testing.T) {
+	s := &storage.Storage{}
+	s.SetEngine(&MockEngine{})
+
+	h := NewHandler(netstorage.PtLoadsRequestMessage)
+	require.NoError(t, h.SetMessage(&netstorage.PtLoadsRequest{}))
+	h.SetStore(s)
+	rsp, err := h.Process()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1024), rsp.(*netstorage.PtLoadsResponse).Loads[0].DiskBytes)
+
+	h = NewHandler(netstorage.PtFilesRequestMessage)
+	require.NoError(t, h.SetMessage(&netstorage.PtFilesRequest{Db: "db0", PtId: 1}))
+	h.SetStore(s)
+	rsp, err = h.Process()
+	require.NoError(t, err)
+	files, ok := rsp.(*netstorage.PtFilesResponse)
+	require.True(t, ok)
+	require.NoError(t, files.Error())
+	assert.Equal(t, 1, len(files.Files))
+
+	h = NewHandler(netstorage.PtFilesRequestMessage)
+	require.NoError(t, h.SetMessage(&netstorage.PtFilesRequest{Db: "db0", PtId: 2}))
+	h.SetStore(s)
+	rsp, err = h.Process()
+	require.NoError(t, err)
+	require.True(t, errno.Equal(rsp.(*netstorage.PtFilesResponse).Error(), errno.PtNotFound))
+
+	h = NewHandler(netstorage.ReadPtFileRequestMessage)
+	require.NoError(t, h.SetMessage(&netstorage.ReadPtFileRequest{Db: "db0", PtId: 1, Name: files.Files[0].Name, Size: 3}))
+	h.SetStore(s)
+	rsp, err = h.Process()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("abc"), rsp.(*netstorage.ReadPtFileResponse).Data)
+
+	h = NewHandler(netstorage.DropPtFilesRequestMessage)
+	require.NoError(t, h.SetMessage(&netstorage.DropPtFilesRequest{Db: "db0", PtId: 1}))
+	h.SetStore(s)
+	rsp, err = h.Process()
+	require.NoError(t, err)
+	require.NoError(t, rsp.(*netstorage.DropPtFilesResponse).Error())
+
+	h = NewHandler(netstorage.ShowRebalanceRequestMessage)
+	require.NoError(t, h.SetMessage(&netstorage.ShowRebalanceRequest{}))
+	h.SetStore(s)
+	rsp, err = h.Process()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), rsp.(*netstorage.ShowRebalanceResponse).Transfers[0].SrcNodeId)
+}
+
+func TestProcessSplitShard(t *testing.T) {
+	s := &storage.Storage{}
+	s.SetEngine(&MockEngine{})
+	client := metaclient.NewClient(t.TempDir(), false, 100)
+	client.SetCacheData(&meta.Data{})
+	s.SetMetaClient(client)
+
+	h := NewHandler(netstorage.SplitShardRequestMessage)
+	require.NoError(t, h.SetMessage(&netstorage.SplitShardRequest{Db: "db0", Rp: "rp0", PtId: 1, ShardID: 1}))
+	h.SetStore(s)
+	rsp, err := h.Process()
+	require.NoError(t, err)
+	response, ok := rsp.(*netstorage.SplitShardResponse)
+	require.True(t, ok)
+	require.True(t, errno.Equal(response.Error(), errno.ShardNotFound))
+	assert.Equal(t, int64(0), response.Rows)
+
+	require.Error(t, h.SetMessage(&netstorage.ShowRebalanceRequest{}))
+}
+
+func TestProcessWriteSplitRows(t *testing.T) {
+	s := &storage.Storage{}
+	s.SetEngine(&MockEngine{})
+
+	rows := []influx.Row{{Name: "cpu", Timestamp: 1, Fields: influx.Fields{{Key: "value", NumValue: 1, Type: influx.Field_Type_Float}}}}
+	buf, err := influx.FastMarshalMultiRows(nil, rows)
+	require.NoError(t, err)
+
+	h := NewHandler(netstorage.WriteSplitRowsRequestMessage)
+	require.NoError(t, h.SetMessage(&netstorage.WriteSplitRowsRequest{Db: "db0", Rp: "rp0", PtId: 1, ShardID: 2, Rows: buf}))
+	h.SetStore(s)
+	rsp, err := h.Process()
+	require.NoError(t, err)
+	response, ok := rsp.(*netstorage.WriteSplitRowsResponse)
+	require.True(t, ok)
+	require.NoError(t, response.Error())
+	assert.Equal(t, int64(1), response.Rows)
+
+	require.Error(t, h.SetMessage(&netstorage.ShowRebalanceRequest{}))
+}
+
+func TestProcessTailLogs(t *testing.T) {
+	s := &storage.Storage{}
+	s.SetEngine(&MockEngine{})
+
+	process := func(req *netstorage.TailLogsRequest) *netstorage.TailLogsResponse {
+		h := NewHandler(netstorage.TailLogsRequestMessage)
+		require.NoError(t, h.SetMessage(req))
+		h.SetStore(s)
+		rsp, err := h.Process()
+		require.NoError(t, err)
+		response, ok := rsp.(*netstorage.TailLogsResponse)
+		require.True(t, ok)
+		return response
+	}
+
+	response := process(&netstorage.TailLogsRequest{Db: "repo", LogStream: "ls", Session: 1})
+	require.NoError(t, response.Error())
+	assert.Equal(t, int64(503), response.Logs[0].Fields["status"])
+	assert.Equal(t, uint64(1), response.Stats.Sent)
+
+	response = process(&netstorage.TailLogsRequest{Db: "repo", LogStream: "ls", Session: 1, Close: true})
+	require.NoError(t, response.Error())
+	assert.Empty(t, response.Logs)
+
+	response = process(&netstorage.TailLogsRequest{Db: "other", LogStream: "ls", Session: 2})
+	require.True(t, errno.Equal(response.Error(), errno.DatabaseNotFound))
+}
+
 func TestProcessSeriesKeys(t *testing.T) {
 	db := path.Join(dataPath, "db0")
 	pts := []uint32{1}
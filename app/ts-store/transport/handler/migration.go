@@ -65,14 +65,14 @@ func (mp *MigrationProcessor) Handle(w spdy.Responser, data interface{}) error {
 	case meta2.MovePreAssign:
 		err = errno.NewError(errno.DataNoAlive)
 		if connId == aliveConnId {
-			err = mp.store.PreAssign(req.GetOpId(), ptInfo)
+			err = mp.store.PreAssign(req.GetOpId(), ptInfo, req.GetTransferFiles())
 		}
 	case meta2.MoveOffload:
 		err = mp.store.Offload(req.GetOpId(), ptInfo)
 	case meta2.MoveAssign:
 		err = errno.NewError(errno.DataNoAlive)
 		if connId == aliveConnId {
-			err = mp.store.Assign(req.GetOpId(), ptInfo)
+			err = mp.store.Assign(req.GetOpId(), ptInfo, req.GetTransferFiles())
 		}
 	default:
 		mp.log.Error("error migrate type", zap.Int32("type", req.GetMigrateType()))
@@ -109,7 +109,7 @@ func (s *MockStoreEngine) RollbackPreOffload(uint64, *meta.DbPtInfo) error {
 	return nil
 }
 
-func (s *MockStoreEngine) PreAssign(uint64, *meta.DbPtInfo) error {
+func (s *MockStoreEngine) PreAssign(uint64, *meta.DbPtInfo, bool) error {
 	return nil
 }
 
@@ -117,7 +117,7 @@ func (s *MockStoreEngine) Offload(uint64, *meta.DbPtInfo) error {
 	return nil
 }
 
-func (s *MockStoreEngine) Assign(uint64, *meta.DbPtInfo) error {
+func (s *MockStoreEngine) Assign(uint64, *meta.DbPtInfo, bool) error {
 	return nil
 }
 
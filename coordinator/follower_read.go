@@ -0,0 +1,245 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	meta "github.com/openGemini/openGemini/lib/metaclient"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+type replicaKey struct {
+	db string
+	pt uint32
+}
+
+type replica struct {
+	ptID   uint32
+	nodeID uint64
+	master bool
+}
+
+// ReplicaSelector chooses the replica which serves the reads of a partition of the databases with the replication policy.
+// The replica with the least reads in flight is chosen, and a follower which lags behind the leader is not chosen
+// until the backoff expires, the reads fall back to the other replicas or to the leader.
+// The reads in flight are counted by this ts-sql only, the reads of the other ts-sql and the load of the stores
+// are not known, so the reads are balanced over the replicas rather than by the actual load of the stores.
+type ReplicaSelector struct {
+	consistency string
+	timeout     time.Duration
+	maxLag      uint64
+	backoff     time.Duration
+
+	mu  sync.Mutex
+	seq uint64
+	// key is node id, value is the number of the partitions being read on the node by the queries of this ts-sql
+	load    map[uint64]int64
+	lagging map[replicaKey]time.Time // value is the time until which the follower is not chosen
+}
+
+func NewReplicaSelector(conf config.Coordinator) *ReplicaSelector {
+	consistency := conf.ReadConsistency
+	if consistency == "" {
+		consistency = config.ReadConsistencyLeader
+	}
+	return &ReplicaSelector{
+		consistency: consistency,
+		timeout:     time.Duration(conf.FollowerReadTimeout),
+		maxLag:      conf.FollowerReadMaxLag,
+		backoff:     time.Duration(conf.FollowerLagBackoff),
+		load:        make(map[uint64]int64),
+		lagging:     make(map[replicaKey]time.Time),
+	}
+}
+
+// Consistency returns the consistency level of a query, the default level is used if the query does not specify one.
+func (s *ReplicaSelector) Consistency(consistency string) string {
+	if consistency == "" {
+		return s.consistency
+	}
+	return consistency
+}
+
+// Select returns the partition and the node which serve the reads of the partition masterPt.
+// The node must be released by Release after the reads are finished.
+func (s *ReplicaSelector) Select(client meta.MetaClient, store netstorage.Storage, db string, masterPt uint32, consistency string) (uint32, uint64, error) {
+	replicas, err := s.replicas(client, db, masterPt)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for {
+		r := s.choose(db, replicas, consistency)
+		if r.master || s.isFresh(store, db, r, consistency) {
+			s.acquire(r.nodeID)
+			return r.ptID, r.nodeID, nil
+		}
+		s.markLagging(db, r.ptID)
+		replicas = removeReplica(replicas, r.ptID)
+	}
+}
+
+// SelectAll selects the replicas of the partitions like Select, the followers of the partitions are checked concurrently.
+func (s *ReplicaSelector) SelectAll(client meta.MetaClient, store netstorage.Storage, db string, masterPts []uint32, consistency string) ([]uint32, []uint64, error) {
+	readPts := make([]uint32, len(masterPts))
+	nodeIDs := make([]uint64, len(masterPts))
+	errs := make([]error, len(masterPts))
+	var wg sync.WaitGroup
+	for i := range masterPts {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			readPts[i], nodeIDs[i], errs[i] = s.Select(client, store, db, masterPts[i], consistency)
+		}(i)
+	}
+	wg.Wait()
+
+	var err error
+	acquired := nodeIDs[:0:0]
+	for i := range errs {
+		if errs[i] != nil {
+			err = errs[i]
+			continue
+		}
+		acquired = append(acquired, nodeIDs[i])
+	}
+	if err != nil {
+		s.Release(acquired)
+		return nil, nil, err
+	}
+	return readPts, nodeIDs, nil
+}
+
+// Release releases the nodes which are chosen by Select.
+func (s *ReplicaSelector) Release(nodeIDs []uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range nodeIDs {
+		if s.load[id] <= 1 {
+			delete(s.load, id)
+			continue
+		}
+		s.load[id]--
+	}
+}
+
+// replicas returns the leader and the online followers of the raft group of the partition, the leader is the first one.
+func (s *ReplicaSelector) replicas(client meta.MetaClient, db string, masterPt uint32) ([]replica, error) {
+	ptView, err := client.DBPtView(db)
+	if err != nil {
+		return nil, err
+	}
+	if int(masterPt) >= len(ptView) {
+		return nil, errno.NewError(errno.PtNotFound)
+	}
+	replicas := []replica{{ptID: masterPt, nodeID: ptView[masterPt].Owner.NodeID, master: true}}
+
+	groups := client.DBRepGroups(db)
+	rgID := ptView[masterPt].RGID
+	if int(rgID) >= len(groups) || groups[rgID].MasterPtID != masterPt {
+		return replicas, nil
+	}
+	for _, peer := range groups[rgID].Peers {
+		if peer.PtRole != meta2.Slave || int(peer.ID) >= len(ptView) || ptView[peer.ID].Status != meta2.Online {
+			continue
+		}
+		replicas = append(replicas, replica{ptID: peer.ID, nodeID: ptView[peer.ID].Owner.NodeID})
+	}
+	return replicas, nil
+}
+
+// choose returns the replica with the least load, the replicas with the same load are chosen in turn.
+func (s *ReplicaSelector) choose(db string, replicas []replica, consistency string) replica {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if consistency == config.ReadConsistencyLeader || len(replicas) == 1 {
+		return replicas[0]
+	}
+	now := time.Now()
+	s.seq++
+	var chosen *replica
+	for i := range replicas {
+		r := &replicas[(int(s.seq)+i)%len(replicas)]
+		key := replicaKey{db: db, pt: r.ptID}
+		if until, ok := s.lagging[key]; ok {
+			if now.Before(until) {
+				continue
+			}
+			delete(s.lagging, key)
+		}
+		if chosen == nil || s.load[r.nodeID] < s.load[chosen.nodeID] {
+			chosen = r
+		}
+	}
+	if chosen == nil {
+		return replicas[0]
+	}
+	return *chosen
+}
+
+// isFresh checks whether the follower is able to serve the reads at the consistency level. The follower obtains the
+// commit index of the leader by a read index request. The bounded-staleness reads are served after the follower
+// applies up to the commit index, and the eventual reads are served if the follower lags behind it by no more than maxLag.
+func (s *ReplicaSelector) isFresh(store netstorage.Storage, db string, r replica, consistency string) bool {
+	waitApplied := consistency == config.ReadConsistencyBoundedStaleness
+	status, err := store.ReplicaReadStatus(r.nodeID, db, r.ptID, waitApplied, s.timeout)
+	if err != nil {
+		return false
+	}
+	if waitApplied {
+		return true
+	}
+	return status.CommittedIndex <= status.AppliedIndex+s.maxLag
+}
+
+func (s *ReplicaSelector) acquire(nodeID uint64) {
+	s.mu.Lock()
+	s.load[nodeID]++
+	s.mu.Unlock()
+}
+
+func (s *ReplicaSelector) markLagging(db string, pt uint32) {
+	s.mu.Lock()
+	s.lagging[replicaKey{db: db, pt: pt}] = time.Now().Add(s.backoff)
+	s.mu.Unlock()
+}
+
+func removeReplica(replicas []replica, pt uint32) []replica {
+	for i := range replicas {
+		if replicas[i].ptID == pt {
+			return append(replicas[:i:i], replicas[i+1:]...)
+		}
+	}
+	return replicas
+}
+
+// replicaShard returns the shard of the shard group which is owned by the partition.
+func replicaShard(sg *meta2.ShardGroupInfo, pt uint32) (meta2.ShardInfo, bool) {
+	if int(pt) < len(sg.Shards) && len(sg.Shards[pt].Owners) > 0 && sg.Shards[pt].Owners[0] == pt {
+		return sg.Shards[pt], true
+	}
+	for i := range sg.Shards {
+		if len(sg.Shards[i].Owners) > 0 && sg.Shards[i].Owners[0] == pt {
+			return sg.Shards[i], true
+		}
+	}
+	return meta2.ShardInfo{}, false
+}
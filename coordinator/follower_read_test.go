@@ -0,0 +1,175 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/metaclient"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+type mockReplicaStore struct {
+	netstorage.Storage
+	status map[uint32]*netstorage.ReplicaReadStatusResponse
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (s *mockReplicaStore) ReplicaReadStatus(nodeID uint64, db string, pt uint32, waitApplied bool, timeout time.Duration) (*netstorage.ReplicaReadStatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	status, ok := s.status[pt]
+	if !ok {
+		return nil, errno.NewError(errno.FollowerReadLagging, db, pt, 0, 0)
+	}
+	return status, nil
+}
+
+type mockReplicaMetaClient struct {
+	metaclient.MetaClient
+}
+
+func (m *mockReplicaMetaClient) DBPtView(database string) (meta2.DBPtInfos, error) {
+	return meta2.DBPtInfos{
+		{PtId: 0, Owner: meta2.PtOwner{NodeID: 1}, Status: meta2.Online, RGID: 0},
+		{PtId: 1, Owner: meta2.PtOwner{NodeID: 2}, Status: meta2.Online, RGID: 0},
+		{PtId: 2, Owner: meta2.PtOwner{NodeID: 3}, Status: meta2.Online, RGID: 0},
+		{PtId: 3, Owner: meta2.PtOwner{NodeID: 4}, Status: meta2.Offline, RGID: 0},
+	}, nil
+}
+
+func (m *mockReplicaMetaClient) DBRepGroups(database string) []meta2.ReplicaGroup {
+	return []meta2.ReplicaGroup{{
+		ID:         0,
+		MasterPtID: 0,
+		Peers:      []meta2.Peer{{ID: 1, PtRole: meta2.Slave}, {ID: 2, PtRole: meta2.Slave}, {ID: 3, PtRole: meta2.Slave}},
+	}}
+}
+
+func newTestReplicaSelector(consistency string) *ReplicaSelector {
+	conf := config.NewCoordinator()
+	conf.ReadConsistency = consistency
+	conf.FollowerReadMaxLag = 10
+	conf.FollowerLagBackoff = toml.Duration(time.Hour)
+	return NewReplicaSelector(conf)
+}
+
+func TestReplicaSelector_Leader(t *testing.T) {
+	s := newTestReplicaSelector("")
+	store := &mockReplicaStore{}
+	for i := 0; i < 3; i++ {
+		pt, node, err := s.Select(&mockReplicaMetaClient{}, store, "db0", 0, s.Consistency(""))
+		require.NoError(t, err)
+		require.Equal(t, uint32(0), pt)
+		require.Equal(t, uint64(1), node)
+	}
+	require.Equal(t, 0, store.calls)
+	s.Release([]uint64{1, 1, 1})
+	require.Equal(t, 0, len(s.load))
+}
+
+func TestReplicaSelector_Eventual(t *testing.T) {
+	s := newTestReplicaSelector(config.ReadConsistencyEventual)
+	store := &mockReplicaStore{status: map[uint32]*netstorage.ReplicaReadStatusResponse{
+		1: {AppliedIndex: 100, CommittedIndex: 105},
+		2: {AppliedIndex: 100, CommittedIndex: 120},
+	}}
+
+	// the follower on node 3 lags, the reads are spread over the leader and the other follower
+	nodes := make(map[uint64]int)
+	for i := 0; i < 4; i++ {
+		pt, node, err := s.Select(&mockReplicaMetaClient{}, store, "db0", 0, s.Consistency(""))
+		require.NoError(t, err)
+		require.NotEqual(t, uint32(2), pt)
+		require.NotEqual(t, uint32(3), pt)
+		nodes[node]++
+	}
+	require.Equal(t, map[uint64]int{1: 2, 2: 2}, nodes)
+	require.Contains(t, s.lagging, replicaKey{db: "db0", pt: 2})
+
+	// the lagging follower is chosen again after the backoff expires
+	s.lagging[replicaKey{db: "db0", pt: 2}] = time.Now().Add(-time.Second)
+	store.status[2].AppliedIndex = 120
+	_, node, err := s.Select(&mockReplicaMetaClient{}, store, "db0", 0, s.Consistency(""))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), node)
+
+	s.Release([]uint64{1, 1, 2, 2, 3})
+	require.Equal(t, 0, len(s.load))
+}
+
+func TestReplicaSelector_BoundedStaleness(t *testing.T) {
+	s := newTestReplicaSelector(config.ReadConsistencyEventual)
+	store := &mockReplicaStore{}
+
+	// all the followers fail to catch up with the leader, the reads fall back to the leader
+	for i := 0; i < 3; i++ {
+		pt, node, err := s.Select(&mockReplicaMetaClient{}, store, "db0", 0, config.ReadConsistencyBoundedStaleness)
+		require.NoError(t, err)
+		require.Equal(t, uint32(0), pt)
+		require.Equal(t, uint64(1), node)
+	}
+	require.Equal(t, 2, len(s.lagging))
+	require.Equal(t, 2, store.calls)
+
+	_, _, err := s.Select(&mockReplicaMetaClient{}, store, "db0", 5, config.ReadConsistencyBoundedStaleness)
+	require.True(t, errno.Equal(err, errno.PtNotFound))
+}
+
+func TestReplicaSelector_SelectAll(t *testing.T) {
+	s := newTestReplicaSelector(config.ReadConsistencyEventual)
+	store := &mockReplicaStore{status: map[uint32]*netstorage.ReplicaReadStatusResponse{
+		1: {AppliedIndex: 100, CommittedIndex: 105},
+		2: {AppliedIndex: 100, CommittedIndex: 105},
+	}}
+
+	pts, nodes, err := s.SelectAll(&mockReplicaMetaClient{}, store, "db0", []uint32{0, 0, 0}, s.Consistency(""))
+	require.NoError(t, err)
+	require.Equal(t, 3, len(pts))
+	require.Equal(t, 3, len(nodes))
+	s.Release(nodes)
+	require.Equal(t, 0, len(s.load))
+
+	// the nodes chosen for the other partitions are released if a partition fails
+	_, _, err = s.SelectAll(&mockReplicaMetaClient{}, store, "db0", []uint32{0, 5}, s.Consistency(""))
+	require.True(t, errno.Equal(err, errno.PtNotFound))
+	require.Equal(t, 0, len(s.load))
+}
+
+func TestReplicaShard(t *testing.T) {
+	sg := &meta2.ShardGroupInfo{Shards: []meta2.ShardInfo{
+		{ID: 11, Owners: []uint32{0}},
+		{ID: 12, Owners: []uint32{2}},
+		{ID: 13, Owners: []uint32{1}},
+	}}
+	sh, ok := replicaShard(sg, 0)
+	require.True(t, ok)
+	require.Equal(t, uint64(11), sh.ID)
+	sh, ok = replicaShard(sg, 1)
+	require.True(t, ok)
+	require.Equal(t, uint64(13), sh.ID)
+	_, ok = replicaShard(sg, 3)
+	require.False(t, ok)
+}
@@ -81,6 +81,7 @@ type PWMetaClient interface {
 	DBRepGroups(database string) []meta2.ReplicaGroup
 	GetReplicaN(database string) (int, error)
 	GetSgEndTime(database string, rp string, timestamp time.Time, engineType config.EngineType) (int64, error)
+	DatabaseTenant(database string) *meta2.TenantInfo
 }
 
 // PointsWriter handles writes across multiple local and remote data nodes.
@@ -226,6 +227,10 @@ func fixFields(fields influx.Fields) (influx.Fields, error) {
 
 // RetryWritePointRows make sure sql client got the latest metadata.
 func (w *PointsWriter) RetryWritePointRows(database, retentionPolicy string, rows []influx.Row) error {
+	if err := checkTenantWrite(w.MetaClient, database, int64(len(rows))); err != nil {
+		return err
+	}
+
 	var err error
 	start := time.Now()
 
@@ -306,7 +311,7 @@ func (w *PointsWriter) writePointRows(database, retentionPolicy string, rows []i
 	atomic.AddInt64(&statistics.HandlerStat.WriteStoresDuration, time.Since(start).Nanoseconds())
 
 	if err != nil {
-		if errno.Equal(err, errno.ErrorTagArrayFormat, errno.WriteErrorArray, errno.SeriesLimited) {
+		if errno.Equal(err, errno.ErrorTagArrayFormat, errno.WriteErrorArray, errno.SeriesLimited, errno.MeasurementSeriesLimited) {
 			return netstorage.PartialWriteError{Reason: err, Dropped: dropped}
 		}
 		return err
@@ -65,6 +65,7 @@ type MockMetaClient struct {
 	DBRepGroupsFn        func(database string) []meta2.ReplicaGroup
 	GetReplicaNFn        func(database string) (int, error)
 	GetSgEndTimeFn       func(database string, rp string, timestamp time.Time, engineType config.EngineType) (int64, error)
+	DatabaseTenantFn     func(database string) *meta2.TenantInfo
 }
 
 func (mmc *MockMetaClient) Database(name string) (di *meta2.DatabaseInfo, err error) {
@@ -173,6 +174,13 @@ func (mmc *MockMetaClient) GetSgEndTime(database string, rp string, timestamp ti
 	return mmc.GetSgEndTimeFn(database, rp, timestamp, engineType)
 }
 
+func (mmc *MockMetaClient) DatabaseTenant(database string) *meta2.TenantInfo {
+	if mmc.DatabaseTenantFn == nil {
+		return nil
+	}
+	return mmc.DatabaseTenantFn(database)
+}
+
 func (mmc *MockMetaClient) GetStreamInfos() map[string]*meta2.StreamInfo {
 	infos := map[string]*meta2.StreamInfo{}
 	info := &meta2.StreamInfo{}
@@ -63,6 +63,7 @@ type RWMetaClient interface {
 	CreateMeasurement(database string, retentionPolicy string, mst string, shardKey *meta.ShardKeyInfo, numOfShards int32, indexR *influxql.IndexRelation, engineType config.EngineType,
 		colStoreInfo *meta.ColStoreInfo, schemaInfo []*proto.FieldSchema, options *meta.Options) (*meta.MeasurementInfo, error)
 	GetShardInfoByTime(database, retentionPolicy string, t time.Time, ptIdx int, nodeId uint64, engineType config.EngineType) (*meta.ShardInfo, error)
+	DatabaseTenant(database string) *meta.TenantInfo
 }
 
 // RecMsg data structure of the message of the record.
@@ -112,6 +113,9 @@ func NewRecordWriter(timeout time.Duration, ptNum, recMsgChFactor int) *RecordWr
 }
 
 func (w *RecordWriter) RetryWriteRecord(database, retentionPolicy, measurement string, rec arrow.Record) error {
+	if err := checkTenantWrite(w.MetaClient, database, rec.NumRows()); err != nil {
+		return err
+	}
 	w.recMsgCh <- &RecMsg{
 		Database:        database,
 		RetentionPolicy: retentionPolicy,
@@ -122,6 +126,9 @@ func (w *RecordWriter) RetryWriteRecord(database, retentionPolicy, measurement s
 }
 
 func (w *RecordWriter) RetryWriteLogRecord(bulk *record.BulkRecords) error {
+	if err := checkTenantWrite(w.MetaClient, bulk.Repo, int64(bulk.Rec.RowNums())); err != nil {
+		return err
+	}
 	w.recMsgCh <- &RecMsg{
 		TotalLen:        bulk.TotalLen,
 		Database:        bulk.Repo,
@@ -235,6 +235,10 @@ func (c *MockRWMetaClient) Database(_ string) (di *meta.DatabaseInfo, err error)
 	return database, nil
 }
 
+func (c *MockRWMetaClient) DatabaseTenant(_ string) *meta.TenantInfo {
+	return nil
+}
+
 func (c *MockRWMetaClient) RetentionPolicy(_, _ string) (*meta.RetentionPolicyInfo, error) {
 	if c.Rp != nil {
 		return c.Rp, nil
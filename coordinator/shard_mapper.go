@@ -55,6 +55,9 @@ type ClusterShardMapper struct {
 	Timeout time.Duration
 	meta.MetaClient
 	NetStore netstorage.Storage
+
+	// ReplicaSelector chooses the replicas which serve the reads under the replication policy, the reads are served by the leaders if it is nil
+	ReplicaSelector *ReplicaSelector
 }
 
 func (csm *ClusterShardMapper) MapShards(sources influxql.Sources, t influxql.TimeRange, opt query.SelectOptions, condition influxql.Expr) (query.ShardGroup, error) {
@@ -107,6 +110,9 @@ func (csm *ClusterShardMapper) mapMstShards(s *influxql.Measurement, csming *Clu
 			} else {
 				shs = groups[i].TargetShards(measurements[0], shardKeyInfo, condition, aliveShardIdxes)
 			}
+			if shs, err = csm.routeReadsToReplicas(s.Database, &groups[i], shs, csming, opt); err != nil {
+				return err
+			}
 
 			csm.updateShardInfosByPtID(s, g, shs, &shardInfosByPtID)
 		}
@@ -115,6 +121,71 @@ func (csm *ClusterShardMapper) mapMstShards(s *influxql.Measurement, csming *Clu
 	return nil
 }
 
+// routeReadsToReplicas replaces the shards of the leaders with the shards of the replicas chosen to serve the reads.
+// All the shards of a partition are read from the same replica in a query.
+func (csm *ClusterShardMapper) routeReadsToReplicas(db string, sg *meta2.ShardGroupInfo, shs []meta2.ShardInfo, csming *ClusterShardMapping, opt *query.SelectOptions) ([]meta2.ShardInfo, error) {
+	if csm.ReplicaSelector == nil || !config.IsReplication() {
+		return shs, nil
+	}
+	consistency := csm.ReplicaSelector.Consistency(opt.ReadConsistency)
+	if consistency == config.ReadConsistencyLeader {
+		return shs, nil
+	}
+	if err := csm.selectReadReplicas(db, shs, csming, consistency); err != nil {
+		return nil, err
+	}
+
+	for i := range shs {
+		if len(shs[i].Owners) == 0 {
+			continue
+		}
+		key := replicaKey{db: db, pt: shs[i].Owners[0]}
+		readPt := csming.readReplicas[key]
+		if readPt == key.pt {
+			continue
+		}
+		if sh, ok := replicaShard(sg, readPt); ok {
+			shs[i] = sh
+		}
+	}
+	return shs, nil
+}
+
+// selectReadReplicas selects the replicas of the partitions of the shards which are not selected in the query yet
+func (csm *ClusterShardMapper) selectReadReplicas(db string, shs []meta2.ShardInfo, csming *ClusterShardMapping, consistency string) error {
+	var masterPts []uint32
+	selecting := make(map[uint32]struct{})
+	for i := range shs {
+		if len(shs[i].Owners) == 0 {
+			continue
+		}
+		pt := shs[i].Owners[0]
+		if _, ok := csming.readReplicas[replicaKey{db: db, pt: pt}]; ok {
+			continue
+		}
+		if _, ok := selecting[pt]; !ok {
+			selecting[pt] = struct{}{}
+			masterPts = append(masterPts, pt)
+		}
+	}
+	if len(masterPts) == 0 {
+		return nil
+	}
+
+	readPts, nodeIDs, err := csm.ReplicaSelector.SelectAll(csm.MetaClient, csm.NetStore, db, masterPts, consistency)
+	if err != nil {
+		return err
+	}
+	if csming.readReplicas == nil {
+		csming.readReplicas = make(map[replicaKey]uint32)
+	}
+	for i := range masterPts {
+		csming.readReplicas[replicaKey{db: db, pt: masterPts[i]}] = readPts[i]
+	}
+	csming.readNodes = append(csming.readNodes, nodeIDs...)
+	return nil
+}
+
 func (csm *ClusterShardMapper) updateShardInfosByPtID(s *influxql.Measurement, sg meta2.ShardGroupInfo, shs []meta2.ShardInfo,
 	shardInfosByPtID *map[uint32][]executor.ShardInfo) {
 	var ptID uint32
@@ -234,6 +305,10 @@ type ClusterShardMapping struct {
 	// use for spec or full series hint query
 	seriesKey []byte
 	Logger    *logger.Logger
+
+	// the replicas which serve the reads of the partitions, and the nodes of them to be released after the query
+	readReplicas map[replicaKey]uint32
+	readNodes    []uint64
 }
 
 func NewClusterShardMapping(csm *ClusterShardMapper, tmin, tmax time.Time) *ClusterShardMapping {
@@ -697,7 +772,8 @@ func (csm *ClusterShardMapping) makeRemoteQuery(ctx context.Context, src influxq
 	if err != nil {
 		return nil, err
 	}
-	if node.SegregateStatus != meta2.Normal {
+	// the decommissioning node serves its pts until they are moved
+	if node.SegregateStatus != meta2.Normal && node.SegregateStatus != meta2.Decommissioning {
 		return nil, fmt.Errorf("makeRemoteQuery error: nodeid %d is Segerate", node.ID)
 	}
 	transport.NewNodeManager().Add(nodeID, node.TCPHost)
@@ -721,6 +797,11 @@ func (csm *ClusterShardMapping) LogicalPlanCost(m *influxql.Measurement, opt que
 // Close clears out the list of mapped shards.
 func (csm *ClusterShardMapping) Close() error {
 	csm.ShardMap = nil
+	if len(csm.readNodes) > 0 && csm.ShardMapper != nil && csm.ShardMapper.ReplicaSelector != nil {
+		csm.ShardMapper.ReplicaSelector.Release(csm.readNodes)
+	}
+	csm.readNodes = nil
+	csm.readReplicas = nil
 	return nil
 }
 
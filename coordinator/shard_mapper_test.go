@@ -397,6 +397,23 @@ func (m mocShardMapperMetaClient) ShowCluster(nodeType string, ID uint64) (model
 func (m mocShardMapperMetaClient) ShowClusterWithCondition(nodeType string, ID uint64) (models.Rows, error) {
 	return nil, nil
 }
+func (m mocShardMapperMetaClient) DecommissionNode(nodeID uint64) error {
+	return nil
+}
+func (m mocShardMapperMetaClient) CancelDecommission(nodeID uint64) error {
+	return nil
+}
+func (m mocShardMapperMetaClient) ShowDecommissions() ([]meta2.DecommissionInfo, error) {
+	return nil, nil
+}
+
+func (m mocShardMapperMetaClient) CreateReplication(database, target, role string) error {
+	return nil
+}
+
+func (m mocShardMapperMetaClient) DropReplication(database string, promote bool) error {
+	return nil
+}
 
 func (m mocShardMapperMetaClient) ShowContinuousQueries() (models.Rows, error) {
 	return nil, nil
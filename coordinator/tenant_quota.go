@@ -0,0 +1,41 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"github.com/openGemini/openGemini/lib/resourceallocator"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+type tenantMetaClient interface {
+	DatabaseTenant(database string) *meta2.TenantInfo
+}
+
+// checkTenantWrite returns an error if the rows written to the database exceed the quotas of the tenant owning
+// the database. The series and the storage bytes are checked against the usage recorded by ts-meta, so the quotas
+// may be exceeded by the rows written before the next record.
+func checkTenantWrite(client tenantMetaClient, database string, rows int64) error {
+	t := client.DatabaseTenant(database)
+	if t == nil {
+		return nil
+	}
+	statistics.TenantStat.SetUsage(t.Name, t.Series, t.StorageBytes)
+	if err := t.CheckWriteQuota(); err != nil {
+		statistics.TenantStat.AddWrite(t.Name, rows, true)
+		return err
+	}
+	return resourceallocator.AllocTenantWrite(t.Name, t.Quota.MaxIngestRate, rows)
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTenantWrite(t *testing.T) {
+	statistics.InitTenantStatistics(nil)
+	tenant := &meta2.TenantInfo{Name: "t1", Databases: []string{"db0"}, Series: 50, StorageBytes: 1024}
+	mc := &MockMetaClient{DatabaseTenantFn: func(database string) *meta2.TenantInfo {
+		if tenant.HasDatabase(database) {
+			return tenant
+		}
+		return nil
+	}}
+
+	require.NoError(t, checkTenantWrite(mc, "db1", 10))
+	require.NoError(t, checkTenantWrite(mc, "db0", 10))
+
+	tenant.Quota.MaxSeries = 50
+	err := checkTenantWrite(mc, "db0", 10)
+	assert.True(t, errno.Equal(err, errno.TenantSeriesQuotaExceeded))
+
+	tenant.Quota.MaxSeries = 0
+	tenant.Quota.MaxStorageBytes = 1000
+	err = checkTenantWrite(mc, "db0", 10)
+	assert.True(t, errno.Equal(err, errno.TenantStorageQuotaExceeded))
+
+	tenant.Quota.MaxStorageBytes = 0
+	tenant.Quota.MaxIngestRate = 10
+	require.NoError(t, checkTenantWrite(mc, "db0", 10))
+	err = checkTenantWrite(mc, "db0", 10)
+	assert.True(t, errno.Equal(err, errno.TenantIngestRateExceeded))
+
+	stat, ok := statistics.TenantStat.Get("t1")
+	require.True(t, ok)
+	assert.Equal(t, int64(20), stat.WriteRows)
+	assert.Equal(t, int64(30), stat.WriteRejected)
+	assert.Equal(t, int64(50), stat.Series)
+	assert.Equal(t, int64(1024), stat.StorageBytes)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/openGemini/openGemini/engine/immutable"
 	"github.com/openGemini/openGemini/lib/backup"
 	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/fileops"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/util"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
@@ -42,6 +43,14 @@ type Backup struct {
 	BackupLogInfo   *backup.BackupLogInfo
 	Engine          *Engine
 	IsAborted       bool
+
+	// Target streams the backup files into a content-addressed backup set
+	// instead of copying them into BackupPath, see backup.StreamBackup.
+	// The backup sets are kept per Node, as several nodes may share a Target
+	Target    string
+	Node      string
+	Retention int
+	stream    *backup.StreamBackup
 }
 
 func (s *Backup) RunBackupData() error {
@@ -65,15 +74,94 @@ func (s *Backup) RunBackupData() error {
 		}
 	}()
 
+	if s.Target != "" {
+		stream, err := backup.NewStreamBackup(s.Target, s.Node, s.time, s.IsInc)
+		if err != nil {
+			res = fmt.Sprintf("backup failed, error: %s", err.Error())
+			return err
+		}
+		s.stream = stream
+	}
+
 	for dbName, pts := range dbPtIds {
 		for _, ptId := range pts {
 			err := s.BackupPt(dbName, ptId)
 			if err != nil {
 				res = fmt.Sprintf("backup failed, error: %s", err.Error())
+				s.abortStream()
 				return err
 			}
 		}
 	}
+
+	if s.stream != nil {
+		if err := s.commitStream(); err != nil {
+			res = fmt.Sprintf("backup failed, error: %s", err.Error())
+			return err
+		}
+		res = fmt.Sprintf("backup success, uploaded: %d, deduplicated: %d", s.stream.Uploaded, s.stream.Deduplicated)
+	}
+	return nil
+}
+
+func (s *Backup) commitStream() error {
+	if err := s.stream.Commit(); err != nil {
+		s.abortStream()
+		return err
+	}
+	expired, err := backup.ExpireChains(s.Target, s.Node, s.Retention)
+	if err != nil {
+		return err
+	}
+	if len(expired) > 0 {
+		log.Info("expire backup sets", zap.Strings("manifests", expired))
+	}
+	return nil
+}
+
+func (s *Backup) abortStream() {
+	if s.stream == nil {
+		return
+	}
+	if err := s.stream.Abort(); err != nil {
+		log.Error("abort backup set failed", zap.Error(err))
+	}
+}
+
+// BackupNode returns the name of the backup sets of this node in a shared backup target
+func BackupNode() string {
+	if meta.DefaultMetaClient == nil {
+		return "0"
+	}
+	return strconv.FormatUint(meta.DefaultMetaClient.NodeID(), 10)
+}
+
+func (s *Backup) copyFile(src, dst string) error {
+	if s.stream != nil {
+		return s.stream.PutFile(src)
+	}
+	return backup.FileCopy(src, dst)
+}
+
+func (s *Backup) copyFolder(src, dst string) error {
+	if s.stream == nil {
+		return backup.FolderCopy(src, dst)
+	}
+	fds, err := fileops.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	for _, fd := range fds {
+		srcfp := filepath.Join(src, fd.Name())
+		if fd.IsDir() {
+			err = s.copyFolder(srcfp, "")
+		} else {
+			err = s.stream.PutFile(srcfp)
+		}
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -101,7 +189,8 @@ func (s *Backup) BackupPt(dbName string, ptId uint32) error {
 	shardIds := p.ShardIds(nil)
 	for _, id := range shardIds {
 		sh := p.Shard(id)
-		if s.IsInc {
+		// streamed backups are deduplicated by content, every backup set lists all files
+		if s.IsInc && s.stream == nil {
 			s.BackupLogInfo = &backup.BackupLogInfo{}
 			if err := backup.ReadBackupLogFile(filepath.Join(sh.GetDataPath(), backup.BackupLogPath, backup.FullBackupLog), s.BackupLogInfo); err != nil {
 				log.Info("backupLog file not exist", zap.Error(err))
@@ -120,7 +209,7 @@ func (s *Backup) BackupPt(dbName string, ptId uint32) error {
 	for _, ib := range p.indexBuilder {
 		indexPath := ib.Path()
 		dstPath := filepath.Join(backupPath, indexPath)
-		if err := backup.FolderCopy(indexPath, dstPath); err != nil {
+		if err := s.copyFolder(indexPath, dstPath); err != nil {
 			log.Error("backup index file error", zap.Error(err))
 			return err
 		}
@@ -149,7 +238,8 @@ func (s *Backup) FullBackup(sh Shard, dataPath, nodePath string, peersPtIDMap ma
 
 	}
 
-	if len(fileListMap) > 0 {
+	// the manifest of the backup set replaces the backup log of the shard
+	if len(fileListMap) > 0 && s.stream == nil {
 		backupLog := &backup.BackupLogInfo{
 			FullBackupTime: s.time,
 			FileListMap:    fileListMap,
@@ -230,7 +320,7 @@ func (s *Backup) FullBackupTableFile(sh Shard, t immutable.TablesStore, peersPtI
 		if s.IsAborted {
 			return nil, fmt.Errorf("backup aborted")
 		}
-		if err := copyFullTableFile(f, sh, peersPtIDMap, nodePath, outPath, &fileList); err != nil {
+		if err := copyFullTableFile(f, sh, peersPtIDMap, nodePath, outPath, &fileList, s.copyFile); err != nil {
 			return fileList, err
 		}
 	}
@@ -238,7 +328,7 @@ func (s *Backup) FullBackupTableFile(sh Shard, t immutable.TablesStore, peersPtI
 	return fileList, nil
 }
 
-func copyFullTableFile(f immutable.TSSPFile, sh Shard, peersPtIDMap map[uint32]*NodeInfo, nodePath, outPath string, fileList *[][]string) error {
+func copyFullTableFile(f immutable.TSSPFile, sh Shard, peersPtIDMap map[uint32]*NodeInfo, nodePath, outPath string, fileList *[][]string, copyFile func(src, dst string) error) error {
 	f.RefFileReader()
 	defer func() {
 		f.UnrefFileReader()
@@ -247,7 +337,7 @@ func copyFullTableFile(f immutable.TSSPFile, sh Shard, peersPtIDMap map[uint32]*
 	fileListItem := GenPeerPtFilePath(sh, peersPtIDMap, nodePath, fullPath)
 	*fileList = append(*fileList, fileListItem)
 	dstPath := filepath.Join(outPath, fullPath)
-	if err := backup.FileCopy(fullPath, dstPath); err != nil {
+	if err := copyFile(fullPath, dstPath); err != nil {
 		log.Error("backup file error", zap.Error(err))
 		return err
 	}
@@ -278,7 +368,7 @@ func (s *Backup) IncBackupTableFile(sh Shard, t immutable.TablesStore, peersPtID
 		if s.IsAborted {
 			return nil, nil, fmt.Errorf("backup aborted")
 		}
-		if err := copyIncTableFile(f, seen, sh, peersPtIDMap, nodePath, outPath, &addFileList); err != nil {
+		if err := copyIncTableFile(f, seen, sh, peersPtIDMap, nodePath, outPath, &addFileList, s.copyFile); err != nil {
 			return addFileList, deleteFileList, err
 		}
 	}
@@ -293,7 +383,7 @@ func (s *Backup) IncBackupTableFile(sh Shard, t immutable.TablesStore, peersPtID
 	return addFileList, deleteFileList, nil
 }
 
-func copyIncTableFile(f immutable.TSSPFile, seen map[string]bool, sh Shard, peersPtIDMap map[uint32]*NodeInfo, nodePath, outPath string, addFileList *[][]string) error {
+func copyIncTableFile(f immutable.TSSPFile, seen map[string]bool, sh Shard, peersPtIDMap map[uint32]*NodeInfo, nodePath, outPath string, addFileList *[][]string, copyFile func(src, dst string) error) error {
 	f.RefFileReader()
 	defer func() {
 		f.UnrefFileReader()
@@ -306,7 +396,7 @@ func copyIncTableFile(f immutable.TSSPFile, seen map[string]bool, sh Shard, peer
 	addFileListItem := GenPeerPtFilePath(sh, peersPtIDMap, nodePath, fullPath)
 	*addFileList = append(*addFileList, addFileListItem)
 	dstPath := filepath.Join(outPath, fullPath)
-	if err := backup.FileCopy(fullPath, dstPath); err != nil {
+	if err := copyFile(fullPath, dstPath); err != nil {
 		return err
 	}
 	return nil
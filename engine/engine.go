@@ -37,6 +37,7 @@ import (
 	"github.com/openGemini/openGemini/engine/immutable"
 	"github.com/openGemini/openGemini/engine/index/tsi"
 	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/encoding"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/interruptsignal"
@@ -97,6 +98,7 @@ type Engine struct {
 	metaClient    meta.MetaClient
 	fileInfos     chan []immutable.FileInfoExtend
 	backup        *Backup
+	transfers     *ptTransfers
 }
 
 const maxInt = int(^uint(0) >> 1)
@@ -136,6 +138,7 @@ func NewEngine(dataPath, walPath string, options netstorage.EngineOptions, ctx *
 		droppingMst:   make(map[string]string),
 		migratingDbPT: make(map[string]map[uint32]struct{}),
 		fileInfos:     nil,
+		transfers:     newPtTransfers(),
 	}
 
 	eng.DownSamplePolicies = make(map[string]*meta2.StoreDownSamplePolicy)
@@ -202,6 +205,7 @@ func (e *Engine) SetMetaClient(m meta.MetaClient) {
 	e.mu.Lock()
 	e.metaClient = m
 	e.mu.Unlock()
+	encoding.SetCodecsResolver(measurementCodecs(m))
 }
 
 func (e *Engine) uploadFileInfos() {
@@ -886,7 +890,11 @@ func (e *Engine) WriteRows(db, rp string, ptId uint32, shardID uint64, rows []in
 	if snp != nil {
 		sh.SetSnapShotter(snp)
 	}
-	return sh.WriteRows(rows, binaryRows)
+	if err = sh.WriteRows(rows, binaryRows); err != nil {
+		return err
+	}
+	e.addPtWriteRows(db, ptId, len(rows))
+	return nil
 }
 
 func (e *Engine) WriteRec(db, mst string, ptId uint32, shardID uint64, rec *record.Record, binaryRec []byte) error {
@@ -1337,11 +1345,13 @@ func (e *Engine) CreateLogicalPlan(ctx context.Context, db string, ptId uint32,
 		return nil, nil
 	}
 
+	e.addPtQueries(db, ptId)
 	// FIXME:context cancel func
 	return sh.CreateLogicalPlan(ctx, sources, schema)
 }
 
 func (e *Engine) ScanWithSparseIndex(ctx context.Context, db string, ptId uint32, shardIDs []uint64, schema *executor.QuerySchema) (executor.ShardsFragments, error) {
+	e.addPtQueries(db, ptId)
 	shardFrags := executor.NewShardsFragments()
 	for _, shardId := range shardIDs {
 		s, err := e.GetShard(db, ptId, shardId)
@@ -1514,6 +1524,38 @@ func (e *Engine) Statistics(buffer []byte) ([]byte, error) {
 	return buffer, nil
 }
 
+// ShowCompactions returns the running and pending compaction plans of all opened shards
+func (e *Engine) ShowCompactions() []*netstorage.CompactionInfo {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	var infos []*netstorage.CompactionInfo
+	for _, partitions := range e.DBPartitions {
+		for _, dbPTInfo := range partitions {
+			dbPTInfo.mu.RLock()
+			for _, sh := range dbPTInfo.shards {
+				if !sh.IsOpened() {
+					continue
+				}
+				for _, plan := range sh.GetTableStore().CompactionPlans() {
+					infos = append(infos, &netstorage.CompactionInfo{
+						ShardID:     sh.GetID(),
+						Measurement: plan.Measurement,
+						Strategy:    plan.Strategy,
+						Level:       plan.Level,
+						FileCount:   int64(plan.FileCount),
+						FileSize:    plan.FileSize,
+						Running:     plan.Running,
+						StartTime:   plan.StartTime,
+					})
+				}
+			}
+			dbPTInfo.mu.RUnlock()
+		}
+	}
+	return infos
+}
+
 func (s *Engine) InitLogStoreCtx(querySchema *executor.QuerySchema) (*idKeyCursorContext, error) {
 	ctx := &idKeyCursorContext{
 		decs:         immutable.NewReadContext(querySchema.Options().IsAscending()),
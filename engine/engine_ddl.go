@@ -319,6 +319,49 @@ func (e *Engine) SeriesCardinality(db string, ptIDs []uint32, namesWithVer [][]b
 	return measurementCardinalityInfos, nil
 }
 
+// TagKeysCardinality returns the cardinality of the tag keys of the measurements in the partitions.
+// The cardinality of a partition is the max one of its indexes, and the cardinalities of the partitions are summed.
+func (e *Engine) TagKeysCardinality(db string, ptIDs []uint32, namesWithVer [][]byte) ([]*netstorage.TagKeyCardinality, error) {
+	e.mu.RLock()
+	var err error
+	if ptIDs, err = e.checkAndAddRefPTSNoLock(db, ptIDs); err != nil {
+		e.mu.RUnlock()
+		return nil, err
+	}
+	defer e.unrefDBPTs(db, ptIDs)
+	pts, ok := e.DBPartitions[db]
+	e.mu.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+
+	var res []*netstorage.TagKeyCardinality
+	merged := make(map[[2]string]*netstorage.TagKeyCardinality)
+	for i := range ptIDs {
+		pt, ok := pts[ptIDs[i]]
+		if !ok {
+			continue
+		}
+		pt.mu.RLock()
+		ptCardinality, err := pt.tagKeysCardinality(namesWithVer)
+		pt.mu.RUnlock()
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range ptCardinality {
+			k := [2]string{c.Measurement, c.Key}
+			if m, ok := merged[k]; ok {
+				m.Values += c.Values
+				m.Series += c.Series
+				continue
+			}
+			merged[k] = c
+			res = append(res, c)
+		}
+	}
+	return res, nil
+}
+
 func (e *Engine) TagValuesCardinality(db string, ptIDs []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (map[string]uint64, error) {
 	e.mu.RLock()
 	var err error
@@ -0,0 +1,63 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"time"
+
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/tokenizer"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/tail"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// TailLogs polls the live tail session of the logstream, the logs are offered to the session
+// as they are written to the mutable tables of the shards of this store.
+func (e *Engine) TailLogs(db, logStream string, session uint64, condition string, opt tail.Options, wait time.Duration) ([]*tail.Log, tail.Stats, error) {
+	return tail.DefaultSessions.Poll(session, func(hub *tail.Hub) (*tail.Subscriber, error) {
+		var cond influxql.Expr
+		if condition != "" {
+			var err error
+			if cond, err = influxql.ParseExpr(condition); err != nil {
+				return nil, err
+			}
+		}
+		var option *influxql.IndexOption
+		if e.metaClient != nil {
+			if mst, err := e.metaClient.Measurement(db, logStream, logStream); err == nil && mst != nil {
+				option = tokenizer.GetFullTextOption(&mst.IndexRelation)
+			}
+		}
+		filter, err := tail.NewFilter(cond, option)
+		if err != nil {
+			return nil, err
+		}
+		return hub.Subscribe(db, logStream, filter, opt)
+	}, wait)
+}
+
+func (e *Engine) CloseTailLogs(session uint64) {
+	tail.DefaultSessions.Close(session)
+}
+
+// tailLogs builds the logs of the record written to the logstream if it is tailed,
+// the logs are built before the write because the record may be sorted in place.
+func tailLogs(db, mst string, rec *record.Record) []*tail.Log {
+	if !tail.DefaultHub.HasSubscribers(db, influx.GetOriginMstName(mst)) {
+		return nil
+	}
+	return tail.RecordToLogs(rec)
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/tail"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_TailLogs(t *testing.T) {
+	e := &Engine{}
+	defer e.CloseTailLogs(1)
+
+	rec := record.NewRecord(record.Schemas{
+		record.Field{Type: influx.Field_Type_String, Name: "content"},
+		record.Field{Type: influx.Field_Type_Int, Name: "status"},
+		record.Field{Type: influx.Field_Type_Int, Name: record.TimeField},
+	}, false)
+	rec.ColVals[0].AppendStrings("error a", "info b")
+	rec.ColVals[1].AppendIntegers(503, 200)
+	rec.ColVals[2].AppendIntegers(1, 2)
+	require.Nil(t, tailLogs("repo", "ls_0000", rec))
+
+	logs, _, err := e.TailLogs("repo", "ls", 1, "status > 500", tail.Options{}, time.Millisecond)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+
+	tail.DefaultHub.Publish("repo", "ls", tailLogs("repo", "ls_0000", rec))
+	logs, stats, err := e.TailLogs("repo", "ls", 1, "status > 500", tail.Options{}, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(logs))
+	require.Equal(t, int64(503), logs[0].Fields["status"])
+	require.Equal(t, tail.Stats{Matched: 1, Sent: 1}, stats)
+
+	_, _, err = e.TailLogs("repo", "ls", 2, "status >", tail.Options{}, time.Millisecond)
+	require.Error(t, err)
+}
@@ -0,0 +1,521 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util"
+	"go.uber.org/zap"
+)
+
+const (
+	// the marker file in the data directory of a partition which is transferred from another node,
+	// it records the source node, so the final transfer knows where to copy the remaining changes from
+	ptTransferMarker    = "TRANSFER"
+	ptTransferTmpSuffix = ".transferring"
+
+	ptTransferChunkSize  = 1024 * 1024
+	maxPtTransferHistory = 64
+
+	ptDataPrefix = "data/"
+	ptWalPrefix  = "wal/"
+)
+
+const (
+	PtTransferRunning = "running"
+	PtTransferDone    = "done"
+	PtTransferFailed  = "failed"
+)
+
+type ptTransfer struct {
+	info netstorage.PtTransferInfo
+	done bool
+	err  error
+}
+
+type ptTransfers struct {
+	mu      sync.Mutex
+	running map[string]*ptTransfer
+	history []*netstorage.PtTransferInfo
+}
+
+func newPtTransfers() *ptTransfers {
+	return &ptTransfers{running: make(map[string]*ptTransfer)}
+}
+
+func ptTransferKey(db string, ptId uint32) string {
+	return db + "/" + strconv.Itoa(int(ptId))
+}
+
+func (dbPT *DBPTInfo) addWriteRows(n int) {
+	atomic.AddInt64(&dbPT.writeRows, int64(n))
+}
+
+func (dbPT *DBPTInfo) addQueries(n int) {
+	atomic.AddInt64(&dbPT.queries, int64(n))
+}
+
+func (e *Engine) addPtWriteRows(db string, ptId uint32, n int) {
+	if dbPT := e.getDBPTInfo(db, ptId); dbPT != nil {
+		dbPT.addWriteRows(n)
+	}
+}
+
+func (e *Engine) addPtQueries(db string, ptId uint32) {
+	if dbPT := e.getDBPTInfo(db, ptId); dbPT != nil {
+		dbPT.addQueries(1)
+	}
+}
+
+func (e *Engine) ptDataPath(db string, ptId uint32) string {
+	return path.Join(e.dataPath, config.DataDirectory, db, strconv.Itoa(int(ptId)))
+}
+
+func (e *Engine) ptWalPath(db string, ptId uint32) string {
+	return path.Join(e.walPath, config.WalDirectory, db, strconv.Itoa(int(ptId)))
+}
+
+// PtLoads returns the loads of the partitions loaded on this node, the preloaded partitions are skipped
+func (e *Engine) PtLoads() []*netstorage.PtLoad {
+	e.mu.RLock()
+	var pts []*DBPTInfo
+	for db := range e.DBPartitions {
+		for _, pt := range e.DBPartitions[db] {
+			pts = append(pts, pt)
+		}
+	}
+	e.mu.RUnlock()
+
+	loads := make([]*netstorage.PtLoad, 0, len(pts))
+	for _, pt := range pts {
+		pt.mu.RLock()
+		if pt.preload {
+			pt.mu.RUnlock()
+			continue
+		}
+		load := &netstorage.PtLoad{
+			Db:        pt.database,
+			PtId:      pt.id,
+			WriteRows: uint64(atomic.LoadInt64(&pt.writeRows)),
+			Queries:   uint64(atomic.LoadInt64(&pt.queries)),
+		}
+		for _, sh := range pt.shards {
+			if sh.IsOpened() && sh.GetEngineType() == config.TSSTORE {
+				load.Series += uint64(sh.GetSeriesCount())
+			}
+		}
+		pt.mu.RUnlock()
+
+		files, err := e.PtFiles(pt.database, pt.id)
+		if err != nil {
+			e.log.Warn("list pt files failed", zap.String("db", pt.database), zap.Uint32("pt", pt.id), zap.Error(err))
+		}
+		for _, f := range files {
+			load.DiskBytes += uint64(f.Size)
+		}
+		loads = append(loads, load)
+	}
+	return loads
+}
+
+// PtFiles lists the data and the wal files of the partition, the names are prefixed with "data/" or "wal/".
+// The LOCK file and the transfer marker are skipped, they belong to the node the files are on.
+func (e *Engine) PtFiles(db string, ptId uint32) ([]*netstorage.PtFileInfo, error) {
+	var files []*netstorage.PtFileInfo
+	var err error
+	if files, err = listPtFiles(e.ptDataPath(db, ptId), ptDataPrefix, files); err != nil {
+		return nil, err
+	}
+	if files, err = listPtFiles(e.ptWalPath(db, ptId), ptWalPrefix, files); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func listPtFiles(dir, prefix string, files []*netstorage.PtFileInfo) ([]*netstorage.PtFileInfo, error) {
+	fis, err := fileops.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return files, nil
+		}
+		return nil, err
+	}
+	for _, fi := range fis {
+		name := fi.Name()
+		if fi.IsDir() {
+			if files, err = listPtFiles(path.Join(dir, name), prefix+name+"/", files); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if prefix == ptDataPrefix && (name == "LOCK" || name == ptTransferMarker) {
+			continue
+		}
+		if strings.HasSuffix(name, ptTransferTmpSuffix) {
+			continue
+		}
+		files = append(files, &netstorage.PtFileInfo{
+			Name:    prefix + name,
+			Size:    fi.Size(),
+			ModTime: fi.ModTime().UnixNano(),
+		})
+	}
+	return files, nil
+}
+
+func (e *Engine) ptFilePath(db string, ptId uint32, name string) (string, error) {
+	if path.Clean(name) != name || strings.Contains(name, "..") {
+		return "", fmt.Errorf("invalid pt file name %s", name)
+	}
+	switch {
+	case strings.HasPrefix(name, ptDataPrefix):
+		return path.Join(e.ptDataPath(db, ptId), strings.TrimPrefix(name, ptDataPrefix)), nil
+	case strings.HasPrefix(name, ptWalPrefix):
+		return path.Join(e.ptWalPath(db, ptId), strings.TrimPrefix(name, ptWalPrefix)), nil
+	default:
+		return "", fmt.Errorf("invalid pt file name %s", name)
+	}
+}
+
+// ReadPtFile reads at most size bytes of the partition file from offset, a short read means the end of the file.
+// The read bytes are throttled by the background read limiter, so the transfer does not starve the foreground IO.
+func (e *Engine) ReadPtFile(db string, ptId uint32, name string, offset, size int64) ([]byte, error) {
+	filePath, err := e.ptFilePath(db, ptId, name)
+	if err != nil {
+		return nil, err
+	}
+	if size <= 0 || size > ptTransferChunkSize {
+		size = ptTransferChunkSize
+	}
+	if err = backgroundWait(int(size)); err != nil {
+		return nil, err
+	}
+	f, err := fileops.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer util.MustClose(f)
+
+	buf := make([]byte, size)
+	n, err := f.ReadAt(buf, offset)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+// RemovePtFiles removes the files of the partition which has been moved to another node
+func (e *Engine) RemovePtFiles(db string, ptId uint32) error {
+	e.mu.RLock()
+	_, ok := e.DBPartitions[db][ptId]
+	e.mu.RUnlock()
+	if ok {
+		return fmt.Errorf("pt %d of database %s is loaded on this node, its files can not be removed", ptId, db)
+	}
+	if e.isPtTransferring(db, ptId) || !e.trySetDbPtMigrating(db, ptId) {
+		return errno.NewError(errno.PtIsAlreadyMigrating)
+	}
+	defer e.clearDbPtMigrating(db, ptId)
+	e.log.Info("remove pt files", zap.String("db", db), zap.Uint32("pt", ptId))
+	if err := fileops.RemoveAll(e.ptDataPath(db, ptId)); err != nil {
+		return err
+	}
+	return fileops.RemoveAll(e.ptWalPath(db, ptId))
+}
+
+// PtTransferSource returns the node the files of the partition are transferred from
+func (e *Engine) PtTransferSource(db string, ptId uint32) (uint64, bool) {
+	buf, err := fileops.ReadFile(path.Join(e.ptDataPath(db, ptId), ptTransferMarker))
+	if err != nil {
+		return 0, false
+	}
+	src, err := strconv.ParseUint(strings.TrimSpace(string(buf)), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return src, true
+}
+
+// FinishPtTransfer removes the transfer marker after the transferred partition is loaded
+func (e *Engine) FinishPtTransfer(db string, ptId uint32) error {
+	err := fileops.Remove(path.Join(e.ptDataPath(db, ptId), ptTransferMarker))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (e *Engine) isPtTransferring(db string, ptId uint32) bool {
+	e.transfers.mu.Lock()
+	defer e.transfers.mu.Unlock()
+	t, ok := e.transfers.running[ptTransferKey(db, ptId)]
+	return ok && !t.done
+}
+
+// TransferPt copies the files of the partition from the source node in the background.
+// It returns PtIsTransferring until the copy of the phase is finished, then the result of the copy,
+// so the migrate state machine retries the command until the files are ready.
+// The bulk phase copies the files while the partition is still written on the source node,
+// the final phase copies the remaining changes after the partition is offloaded from the source node.
+func (e *Engine) TransferPt(opId uint64, db string, ptId uint32, srcNodeId uint64, phase string, fetcher netstorage.PtFileFetcher) error {
+	key := ptTransferKey(db, ptId)
+	e.transfers.mu.Lock()
+	if t, ok := e.transfers.running[key]; ok && t.info.Phase == phase && t.info.SrcNodeId == srcNodeId {
+		if !t.done {
+			e.transfers.mu.Unlock()
+			return errno.NewError(errno.PtIsTransferring, srcNodeId)
+		}
+		delete(e.transfers.running, key)
+		e.transfers.mu.Unlock()
+		return t.err
+	} else if ok && !t.done {
+		e.transfers.mu.Unlock()
+		return errno.NewError(errno.PtIsTransferring, t.info.SrcNodeId)
+	}
+
+	now := time.Now().UnixNano()
+	t := &ptTransfer{info: netstorage.PtTransferInfo{
+		Db:         db,
+		PtId:       ptId,
+		SrcNodeId:  srcNodeId,
+		Phase:      phase,
+		State:      PtTransferRunning,
+		StartTime:  now,
+		UpdateTime: now,
+	}}
+	e.transfers.running[key] = t
+	e.transfers.mu.Unlock()
+
+	go func() {
+		start := time.Now()
+		e.log.Info("transfer pt start", zap.Uint64("opId", opId), zap.String("db", db), zap.Uint32("pt", ptId),
+			zap.Uint64("src", srcNodeId), zap.String("phase", phase))
+		err := e.transferPtFiles(t, fetcher)
+		if err != nil {
+			e.log.Error("transfer pt failed", zap.Uint64("opId", opId), zap.String("db", db), zap.Uint32("pt", ptId),
+				zap.Uint64("src", srcNodeId), zap.String("phase", phase), zap.Error(err))
+		} else {
+			e.log.Info("transfer pt success", zap.Uint64("opId", opId), zap.String("db", db), zap.Uint32("pt", ptId),
+				zap.Uint64("src", srcNodeId), zap.String("phase", phase), zap.Duration("time used", time.Since(start)))
+		}
+		e.finishTransfer(t, err)
+	}()
+	return errno.NewError(errno.PtIsTransferring, srcNodeId)
+}
+
+func (e *Engine) finishTransfer(t *ptTransfer, err error) {
+	e.transfers.mu.Lock()
+	defer e.transfers.mu.Unlock()
+	t.done = true
+	t.err = err
+	t.info.UpdateTime = time.Now().UnixNano()
+	t.info.State = PtTransferDone
+	if err != nil {
+		t.info.State = PtTransferFailed
+		t.info.Error = err.Error()
+	}
+	info := t.info
+	e.transfers.history = append(e.transfers.history, &info)
+	if len(e.transfers.history) > maxPtTransferHistory {
+		e.transfers.history = e.transfers.history[len(e.transfers.history)-maxPtTransferHistory:]
+	}
+}
+
+func (e *Engine) updateTransfer(t *ptTransfer, update func(info *netstorage.PtTransferInfo)) {
+	e.transfers.mu.Lock()
+	update(&t.info)
+	t.info.UpdateTime = time.Now().UnixNano()
+	e.transfers.mu.Unlock()
+}
+
+// transferPtFiles mirrors the files of the partition on the source node: the local files which are not on the
+// source node are removed, the files whose size or modification time differs are copied again.
+// The files removed by the compaction on the source node during the bulk phase are skipped.
+func (e *Engine) transferPtFiles(t *ptTransfer, fetcher netstorage.PtFileFetcher) error {
+	db, ptId, src := t.info.Db, t.info.PtId, t.info.SrcNodeId
+	dataPath := e.ptDataPath(db, ptId)
+	if err := fileops.MkdirAll(dataPath, 0750); err != nil {
+		return err
+	}
+	marker := []byte(strconv.FormatUint(src, 10))
+	if err := fileops.WriteFile(path.Join(dataPath, ptTransferMarker), marker, 0640); err != nil {
+		return err
+	}
+
+	remote, err := fetcher.PtFiles(src, db, ptId)
+	if err != nil {
+		return err
+	}
+	local, err := e.PtFiles(db, ptId)
+	if err != nil {
+		return err
+	}
+
+	remoteFiles := make(map[string]*netstorage.PtFileInfo, len(remote))
+	var totalBytes int64
+	for _, f := range remote {
+		remoteFiles[f.Name] = f
+		totalBytes += f.Size
+	}
+	e.updateTransfer(t, func(info *netstorage.PtTransferInfo) {
+		info.TotalFiles = int64(len(remote))
+		info.TotalBytes = totalBytes
+	})
+
+	localFiles := make(map[string]*netstorage.PtFileInfo, len(local))
+	for _, f := range local {
+		localFiles[f.Name] = f
+		if _, ok := remoteFiles[f.Name]; ok {
+			continue
+		}
+		filePath, err := e.ptFilePath(db, ptId, f.Name)
+		if err != nil {
+			return err
+		}
+		if err = fileops.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	bulk := t.info.Phase == netstorage.PtTransferBulk
+	for _, f := range remote {
+		if e.closed.Closed() {
+			return errno.NewError(errno.EngineClosed)
+		}
+		if lf, ok := localFiles[f.Name]; !ok || lf.Size != f.Size || lf.ModTime != f.ModTime {
+			if err = e.transferPtFile(t, fetcher, f); err != nil {
+				if !bulk {
+					return err
+				}
+				e.log.Warn("transfer pt file failed, skip it in the bulk phase", zap.String("db", db), zap.Uint32("pt", ptId),
+					zap.String("file", f.Name), zap.Error(err))
+			}
+		}
+		size := f.Size
+		e.updateTransfer(t, func(info *netstorage.PtTransferInfo) {
+			info.Files++
+			info.Bytes += size
+		})
+	}
+	if bulk {
+		return nil
+	}
+	return e.verifyPtFiles(db, ptId, remote)
+}
+
+// verifyPtFiles checks that the local files of the partition are the same as the files on the source node after the
+// final phase, so a partition with missing or truncated files is never loaded and the files on the source node are kept.
+func (e *Engine) verifyPtFiles(db string, ptId uint32, remote []*netstorage.PtFileInfo) error {
+	local, err := e.PtFiles(db, ptId)
+	if err != nil {
+		return err
+	}
+	localFiles := make(map[string]int64, len(local))
+	for _, f := range local {
+		localFiles[f.Name] = f.Size
+	}
+	for _, f := range remote {
+		size, ok := localFiles[f.Name]
+		if !ok {
+			return fmt.Errorf("the transferred file %s of pt %s/%d is missing", f.Name, db, ptId)
+		}
+		if size != f.Size {
+			return fmt.Errorf("the transferred file %s of pt %s/%d has %d bytes, expect %d", f.Name, db, ptId, size, f.Size)
+		}
+	}
+	if len(local) != len(remote) {
+		return fmt.Errorf("pt %s/%d has %d files after the transfer, expect %d", db, ptId, len(local), len(remote))
+	}
+	return nil
+}
+
+func (e *Engine) transferPtFile(t *ptTransfer, fetcher netstorage.PtFileFetcher, f *netstorage.PtFileInfo) error {
+	db, ptId := t.info.Db, t.info.PtId
+	filePath, err := e.ptFilePath(db, ptId, f.Name)
+	if err != nil {
+		return err
+	}
+	if err = fileops.MkdirAll(path.Dir(filePath), 0750); err != nil {
+		return err
+	}
+	tmpPath := filePath + ptTransferTmpSuffix
+	if err = e.fetchPtFile(t, fetcher, f, tmpPath); err != nil {
+		if rmErr := fileops.Remove(tmpPath); rmErr != nil && !os.IsNotExist(rmErr) {
+			e.log.Warn("remove tmp pt file failed", zap.String("file", tmpPath), zap.Error(rmErr))
+		}
+		return err
+	}
+	if err = fileops.RenameFile(tmpPath, filePath); err != nil {
+		return err
+	}
+	mtime := time.Unix(0, f.ModTime)
+	return os.Chtimes(filePath, mtime, mtime)
+}
+
+func (e *Engine) fetchPtFile(t *ptTransfer, fetcher netstorage.PtFileFetcher, f *netstorage.PtFileInfo, tmpPath string) error {
+	fd, err := fileops.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	defer util.MustClose(fd)
+
+	var offset int64
+	for offset < f.Size {
+		data, err := fetcher.ReadPtFile(t.info.SrcNodeId, t.info.Db, t.info.PtId, f.Name, offset, ptTransferChunkSize)
+		if err != nil {
+			return err
+		}
+		if _, err = fd.Write(data); err != nil {
+			return err
+		}
+		offset += int64(len(data))
+		if len(data) < ptTransferChunkSize {
+			break
+		}
+	}
+	return fd.Sync()
+}
+
+// ShowRebalance returns the running and the recently finished transfers of the partitions on this node
+func (e *Engine) ShowRebalance() []*netstorage.PtTransferInfo {
+	e.transfers.mu.Lock()
+	defer e.transfers.mu.Unlock()
+	infos := make([]*netstorage.PtTransferInfo, 0, len(e.transfers.history)+len(e.transfers.running))
+	for _, info := range e.transfers.history {
+		infoCopy := *info
+		infos = append(infos, &infoCopy)
+	}
+	for _, t := range e.transfers.running {
+		if t.done {
+			continue
+		}
+		info := t.info
+		infos = append(infos, &info)
+	}
+	return infos
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/interruptsignal"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	assert1 "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// localPtFileFetcher fetches the pt files from the engine of the source node in the same process
+type localPtFileFetcher struct {
+	src *Engine
+}
+
+func (f *localPtFileFetcher) PtFiles(nodeID uint64, db string, pt uint32) ([]*netstorage.PtFileInfo, error) {
+	return f.src.PtFiles(db, pt)
+}
+
+func (f *localPtFileFetcher) ReadPtFile(nodeID uint64, db string, pt uint32, name string, offset, size int64) ([]byte, error) {
+	return f.src.ReadPtFile(db, pt, name, offset, size)
+}
+
+func newRebalanceTestEngine(dir string) *Engine {
+	return &Engine{
+		closed:        interruptsignal.NewInterruptSignal(),
+		dataPath:      path.Join(dir, "data"),
+		walPath:       path.Join(dir, "wal"),
+		DBPartitions:  make(map[string]map[uint32]*DBPTInfo),
+		log:           logger.NewLogger(errno.ModuleStorageEngine),
+		migratingDbPT: make(map[string]map[uint32]struct{}),
+		transfers:     newPtTransfers(),
+	}
+}
+
+func writePtTestFile(t *testing.T, name string, data []byte) {
+	require.NoError(t, os.MkdirAll(path.Dir(name), 0750))
+	require.NoError(t, os.WriteFile(name, data, 0640))
+}
+
+func waitPtTransfer(t *testing.T, e *Engine, db string, ptId uint32, src uint64, phase string, fetcher netstorage.PtFileFetcher) error {
+	for i := 0; i < 100; i++ {
+		err := e.TransferPt(1, db, ptId, src, phase, fetcher)
+		if !errno.Equal(err, errno.PtIsTransferring) {
+			return err
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatal("transfer pt timeout")
+	return nil
+}
+
+func TestEngine_TransferPt(t *testing.T) {
+	dir := t.TempDir()
+	src := newRebalanceTestEngine(path.Join(dir, "src"))
+	dst := newRebalanceTestEngine(path.Join(dir, "dst"))
+	fetcher := &localPtFileFetcher{src: src}
+
+	tssp := path.Join(src.ptDataPath("db0", 1), "rp0", "1_0_0_0", "tssp", "cpu_0000", "00000001-0000-00000000.tssp")
+	wal := path.Join(src.ptWalPath("db0", 1), "rp0", "1_0_0_0", "1.wal")
+	writePtTestFile(t, tssp, make([]byte, ptTransferChunkSize+100))
+	writePtTestFile(t, wal, []byte("wal"))
+	writePtTestFile(t, path.Join(src.ptDataPath("db0", 1), "LOCK"), nil)
+
+	files, err := src.PtFiles("db0", 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(files))
+	assert1.Equal(t, "data/rp0/1_0_0_0/tssp/cpu_0000/00000001-0000-00000000.tssp", files[0].Name)
+	assert1.Equal(t, "wal/rp0/1_0_0_0/1.wal", files[1].Name)
+
+	_, err = src.ReadPtFile("db0", 1, "data/../../LOCK", 0, 10)
+	require.Error(t, err)
+
+	// a stale file on the target node is removed
+	writePtTestFile(t, path.Join(dst.ptWalPath("db0", 1), "rp0", "1_0_0_0", "0.wal"), []byte("stale"))
+	require.NoError(t, waitPtTransfer(t, dst, "db0", 1, 2, netstorage.PtTransferBulk, fetcher))
+	srcNode, ok := dst.PtTransferSource("db0", 1)
+	require.True(t, ok)
+	assert1.Equal(t, uint64(2), srcNode)
+
+	// the wal is appended on the source node before it is offloaded
+	writePtTestFile(t, wal, []byte("wal1"))
+	require.NoError(t, waitPtTransfer(t, dst, "db0", 1, 2, netstorage.PtTransferFinal, fetcher))
+
+	dstFiles, err := dst.PtFiles("db0", 1)
+	require.NoError(t, err)
+	files, err = src.PtFiles("db0", 1)
+	require.NoError(t, err)
+	assert1.Equal(t, files, dstFiles)
+	buf, err := os.ReadFile(path.Join(dst.ptWalPath("db0", 1), "rp0", "1_0_0_0", "1.wal"))
+	require.NoError(t, err)
+	assert1.Equal(t, "wal1", string(buf))
+
+	infos := dst.ShowRebalance()
+	require.Equal(t, 2, len(infos))
+	assert1.Equal(t, PtTransferDone, infos[1].State)
+	assert1.Equal(t, int64(2), infos[1].Files)
+
+	require.NoError(t, dst.FinishPtTransfer("db0", 1))
+	_, ok = dst.PtTransferSource("db0", 1)
+	assert1.False(t, ok)
+
+	require.NoError(t, src.RemovePtFiles("db0", 1))
+	files, err = src.PtFiles("db0", 1)
+	require.NoError(t, err)
+	assert1.Equal(t, 0, len(files))
+}
+
+// truncatedPtFileFetcher returns the files of the source node without their last byte
+type truncatedPtFileFetcher struct {
+	localPtFileFetcher
+}
+
+func (f *truncatedPtFileFetcher) ReadPtFile(nodeID uint64, db string, pt uint32, name string, offset, size int64) ([]byte, error) {
+	data, err := f.src.ReadPtFile(db, pt, name, offset, size)
+	if err != nil || len(data) == 0 {
+		return data, err
+	}
+	return data[:len(data)-1], nil
+}
+
+func TestEngine_TransferPtVerify(t *testing.T) {
+	dir := t.TempDir()
+	src := newRebalanceTestEngine(path.Join(dir, "src"))
+	dst := newRebalanceTestEngine(path.Join(dir, "dst"))
+	writePtTestFile(t, path.Join(src.ptWalPath("db0", 1), "rp0", "1_0_0_0", "1.wal"), []byte("wal"))
+
+	// the truncated file is only skipped in the bulk phase
+	fetcher := &truncatedPtFileFetcher{localPtFileFetcher{src: src}}
+	require.NoError(t, waitPtTransfer(t, dst, "db0", 1, 2, netstorage.PtTransferBulk, fetcher))
+	err := waitPtTransfer(t, dst, "db0", 1, 2, netstorage.PtTransferFinal, fetcher)
+	require.Error(t, err)
+	assert1.Contains(t, err.Error(), "has 2 bytes, expect 3")
+	infos := dst.ShowRebalance()
+	assert1.Equal(t, PtTransferFailed, infos[len(infos)-1].State)
+
+	require.NoError(t, waitPtTransfer(t, dst, "db0", 1, 2, netstorage.PtTransferFinal, &localPtFileFetcher{src: src}))
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/fasttime"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// the number of the rows streamed to the diverged replica in one request by the repair
+const repairBatchRows = 4096
+
+// FetchShardsNeedRepair returns the opened shards of the replicated partitions which are not written for coldDuration.
+// The shards being written are skipped, since the replicas apply the raft entries at different paces.
+func (e *Engine) FetchShardsNeedRepair(coldDuration time.Duration) []*meta2.ShardIdentifier {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	now := fasttime.UnixTimestamp()
+	var shards []*meta2.ShardIdentifier
+	for db := range e.DBPartitions {
+		for _, pt := range e.DBPartitions[db] {
+			pt.mu.RLock()
+			if pt.node == nil {
+				pt.mu.RUnlock()
+				continue
+			}
+			for _, sh := range pt.shards {
+				if !sh.IsOpened() || sh.GetEngineType() != config.TSSTORE {
+					continue
+				}
+				if sh.LastWriteTime()+uint64(coldDuration/time.Second) > now {
+					continue
+				}
+				shards = append(shards, sh.GetIdent())
+			}
+			pt.mu.RUnlock()
+		}
+	}
+	return shards
+}
+
+// ShardDigest returns the digest of the tssp files of the shard by the time ranges of the interval,
+// the memtables are flushed first.
+func (e *Engine) ShardDigest(db string, ptId uint32, shardID uint64, interval int64) (*netstorage.ShardDigest, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid digest interval %d", interval)
+	}
+	sh, err := e.refReplicaShard(db, ptId, shardID)
+	if err != nil {
+		return nil, err
+	}
+	defer e.unrefDBPT(db, ptId)
+
+	sh.ForceFlush()
+	return sh.Digest(interval)
+}
+
+// ScanShardRows reads the rows of the measurement in the time ranges from the shard, and passes them to fn
+// in batches marshalled by influx.FastMarshalMultiRows.
+// The streamed bytes are throttled by the background read limiter, so the repair does not starve the foreground IO.
+func (e *Engine) ScanShardRows(db string, ptId uint32, shardID uint64, mst string, ranges []util.TimeRange,
+	fn func(rows []byte) error) (int64, error) {
+	sh, err := e.refReplicaShard(db, ptId, shardID)
+	if err != nil {
+		return 0, err
+	}
+	defer e.unrefDBPT(db, ptId)
+
+	var rows int64
+	var buf []byte
+	err = sh.ScanRows(mst, ranges, repairBatchRows, func(batch []influx.Row) error {
+		var err error
+		if buf, err = influx.FastMarshalMultiRows(buf[:0], batch); err != nil {
+			return err
+		}
+		if err = backgroundWait(len(buf)); err != nil {
+			return err
+		}
+		if err = fn(buf); err != nil {
+			return err
+		}
+		rows += int64(len(batch))
+		return nil
+	})
+	return rows, err
+}
+
+// RepairShard writes the rows streamed from the leader into the shard of the replica. The rows are written locally
+// instead of being proposed to the raft group, so they are neither replicated to the other replicas again
+// nor applied to the leader. The rows existing on the replica are overwritten.
+func (e *Engine) RepairShard(db, rp string, ptId uint32, shardID uint64, binaryRows []byte) (int64, error) {
+	sh, err := e.refReplicaShard(db, ptId, shardID)
+	if err != nil {
+		return 0, err
+	}
+	defer e.unrefDBPT(db, ptId)
+
+	rows, _, _, _, _, err := influx.FastUnmarshalMultiRows(binaryRows, nil, nil, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	// the binary rows are written to the wal, so the repaired rows survive a restart before the flush
+	if err = sh.WriteRows(rows, binaryRows); err != nil {
+		return 0, err
+	}
+	e.addPtWriteRows(db, ptId, len(rows))
+	return int64(len(rows)), nil
+}
+
+func (e *Engine) refReplicaShard(db string, ptId uint32, shardID uint64) (Shard, error) {
+	e.mu.RLock()
+	if err := e.checkAndAddRefPTNoLock(db, ptId); err != nil {
+		e.mu.RUnlock()
+		return nil, err
+	}
+	dbPt := e.DBPartitions[db][ptId]
+	e.mu.RUnlock()
+
+	dbPt.mu.RLock()
+	sh, ok := dbPt.shards[shardID]
+	node := dbPt.node
+	dbPt.mu.RUnlock()
+	if node == nil {
+		e.unrefDBPT(db, ptId)
+		return nil, errno.NewError(errno.PtNotReplicated, db, ptId)
+	}
+	if !ok {
+		e.unrefDBPT(db, ptId)
+		return nil, errno.NewError(errno.ShardNotFound, shardID)
+	}
+	if err := sh.OpenAndEnable(e.metaClient); err != nil {
+		e.unrefDBPT(db, ptId)
+		return nil, err
+	}
+	return sh, nil
+}
+
+// backgroundWait waits for the background read limiter, n may be larger than the burst of the limiter
+func backgroundWait(n int) error {
+	burst := fileops.BackgroundReadLimiter.Burst()
+	for n > 0 {
+		size := n
+		if burst > 0 && size > burst {
+			size = burst
+		}
+		if err := fileops.BackGroundReaderWait(size); err != nil {
+			return err
+		}
+		n -= size
+	}
+	return nil
+}
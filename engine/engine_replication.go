@@ -15,6 +15,7 @@
 package engine
 
 import (
+	"context"
 	"path"
 	"strconv"
 	"time"
@@ -154,3 +155,30 @@ func (e *Engine) getRaftGroupId(dataBase string, ptId uint32) (uint32, error) {
 	}
 	return rgId, nil
 }
+
+// ReplicaReadStatus returns the applied index of the partition and the commit index of the leader for the follower reads,
+// the commit index is obtained from the leader by a read index request. If waitApplied is true, it waits until all the
+// entries up to the commit index are applied before it returns.
+func (e *Engine) ReplicaReadStatus(db string, ptId uint32, waitApplied bool, timeout time.Duration) (uint64, uint64, error) {
+	dbPt, err := e.getPartition(db, ptId, false)
+	if err != nil {
+		return 0, 0, err
+	}
+	if dbPt.node == nil {
+		return 0, 0, errno.NewError(errno.PtNotReplicated, db, ptId)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	index, err := dbPt.node.ReadIndex(ctx)
+	if err != nil {
+		return dbPt.node.AppliedIndex(), 0, errno.NewError(errno.FollowerReadLagging, db, ptId, dbPt.node.AppliedIndex(), 0)
+	}
+	if !waitApplied {
+		return dbPt.node.AppliedIndex(), index, nil
+	}
+	if err = dbPt.node.WaitApplied(ctx, index); err != nil {
+		return dbPt.node.AppliedIndex(), index, errno.NewError(errno.FollowerReadLagging, db, ptId, dbPt.node.AppliedIndex(), index)
+	}
+	return dbPt.node.AppliedIndex(), index, nil
+}
@@ -15,7 +15,9 @@
 package engine
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
@@ -152,6 +154,78 @@ func (n mockNode) RemoveCommittedDataC(dw *raftlog.DataWrapper) {
 func (n mockNode) RetCommittedDataC(dw *raftlog.DataWrapper, err error) {
 }
 
+func (n mockNode) ReadIndex(ctx context.Context) (uint64, error) {
+	return 0, nil
+}
+
+func (n mockNode) WaitApplied(ctx context.Context, index uint64) error {
+	return nil
+}
+
+func (n mockNode) AppliedIndex() uint64 {
+	return 0
+}
+
+type mockReadIndexNode struct {
+	mockNode
+	applied   uint64
+	readIndex uint64
+	readErr   error
+}
+
+func (n *mockReadIndexNode) ReadIndex(ctx context.Context) (uint64, error) {
+	return n.readIndex, n.readErr
+}
+
+func (n *mockReadIndexNode) WaitApplied(ctx context.Context, index uint64) error {
+	if n.applied < index {
+		return context.DeadlineExceeded
+	}
+	return nil
+}
+
+func (n *mockReadIndexNode) AppliedIndex() uint64 {
+	return n.applied
+}
+
+func TestReplicaReadStatus(t *testing.T) {
+	node := &mockReadIndexNode{applied: 10, readIndex: 12}
+	e := &Engine{
+		DBPartitions: map[string]map[uint32]*DBPTInfo{
+			"testDB": {
+				1: &DBPTInfo{node: node},
+				2: &DBPTInfo{},
+			},
+		},
+	}
+
+	applied, committed, err := e.ReplicaReadStatus("testDB", 1, false, time.Second)
+	assert1.NoError(t, err)
+	assert1.Equal(t, uint64(10), applied)
+	assert1.Equal(t, uint64(12), committed)
+
+	_, _, err = e.ReplicaReadStatus("testDB", 1, true, time.Second)
+	assert1.True(t, errno.Equal(err, errno.FollowerReadLagging))
+
+	node.applied = 12
+	applied, committed, err = e.ReplicaReadStatus("testDB", 1, true, time.Second)
+	assert1.NoError(t, err)
+	assert1.Equal(t, uint64(12), applied)
+	assert1.Equal(t, uint64(12), committed)
+
+	node.readErr = context.DeadlineExceeded
+	_, _, err = e.ReplicaReadStatus("testDB", 1, false, time.Second)
+	assert1.True(t, errno.Equal(err, errno.FollowerReadLagging))
+	_, _, err = e.ReplicaReadStatus("testDB", 1, true, time.Second)
+	assert1.True(t, errno.Equal(err, errno.FollowerReadLagging))
+
+	_, _, err = e.ReplicaReadStatus("testDB", 2, true, time.Second)
+	assert1.True(t, errno.Equal(err, errno.PtNotReplicated))
+
+	_, _, err = e.ReplicaReadStatus("testDB", 3, true, time.Second)
+	assert1.True(t, errno.Equal(err, errno.PtNotFound))
+}
+
 func TestSendRaftMessage_Success(t *testing.T) {
 	e := &Engine{
 		DBPartitions: map[string]map[uint32]*DBPTInfo{
@@ -0,0 +1,79 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// the number of the rows moved to the split shard in one batch
+const splitBatchRows = 4096
+
+// SplitShard moves the rows of the series whose shard keys are out of [min, max) of the shard by fn in batches,
+// fn writes the rows to the shards split from the shard.
+func (e *Engine) SplitShard(db string, ptId uint32, shardID uint64, min, max string, fn func(rows []influx.Row) error) (int64, error) {
+	sh, err := e.refShard(db, ptId, shardID)
+	if err != nil {
+		return 0, err
+	}
+	defer e.unrefDBPT(db, ptId)
+
+	var rows int64
+	err = sh.SplitKeyRange(min, max, e.metaClient, splitBatchRows, func(batch []influx.Row) error {
+		if err := fn(batch); err != nil {
+			return err
+		}
+		rows += int64(len(batch))
+		return nil
+	})
+	return rows, err
+}
+
+// WriteSplitRows writes the rows moved from the shard split to the shard split from it,
+// the rows whose points already exist in the shard are skipped.
+func (e *Engine) WriteSplitRows(db string, ptId uint32, shardID uint64, rows []influx.Row) (int64, error) {
+	sh, err := e.getShard(db, ptId, shardID)
+	if err != nil {
+		return 0, err
+	}
+	n, err := sh.WriteSplitRows(rows)
+	if err != nil {
+		return 0, err
+	}
+	e.addPtWriteRows(db, ptId, n)
+	return int64(n), nil
+}
+
+func (e *Engine) refShard(db string, ptId uint32, shardID uint64) (Shard, error) {
+	e.mu.RLock()
+	if err := e.checkAndAddRefPTNoLock(db, ptId); err != nil {
+		e.mu.RUnlock()
+		return nil, err
+	}
+	dbPt := e.DBPartitions[db][ptId]
+	e.mu.RUnlock()
+
+	sh := dbPt.Shard(shardID)
+	if sh == nil {
+		e.unrefDBPT(db, ptId)
+		return nil, errno.NewError(errno.ShardNotFound, shardID)
+	}
+	if err := sh.OpenAndEnable(e.metaClient); err != nil {
+		e.unrefDBPT(db, ptId)
+		return nil, err
+	}
+	return sh, nil
+}
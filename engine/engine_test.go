@@ -35,6 +35,7 @@ import (
 	"github.com/openGemini/openGemini/lib/bufferpool"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/cpu"
+	"github.com/openGemini/openGemini/lib/encoding"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/interruptsignal"
@@ -2099,3 +2100,38 @@ func TestEngine_WriteToRaft_Panic(t *testing.T) {
 	err := mockEngine.WriteToRaft("testdb", "", 1, tail)
 	assert2.Equal(t, err, nil)
 }
+
+type mockCodecsMetaClient struct {
+	metaclient.MetaClient
+	msts map[string]*meta.MeasurementInfo
+}
+
+func (c *mockCodecsMetaClient) Measurement(database string, rpName string, mstName string) (*meta.MeasurementInfo, error) {
+	mst, ok := c.msts[database+"."+rpName+"."+mstName]
+	if !ok {
+		return nil, meta.ErrMeasurementNotFound
+	}
+	return mst, nil
+}
+
+func TestMeasurementCodecs(t *testing.T) {
+	client := &mockCodecsMetaClient{msts: map[string]*meta.MeasurementInfo{
+		"db0.rp0.mst": {Name: "mst_0000", Options: &meta.Options{FloatCodec: "chimp", ErrorBounds: map[string]float64{"f1": 0.1}}},
+		"db1.rp0.mst": {Name: "mst_0000", Options: &meta.Options{IntCodec: "for"}},
+	}}
+	resolver := measurementCodecs(client)
+
+	// the same measurement name in other databases keeps its own codecs
+	codecs := resolver("db0", "rp0", "mst_0000")
+	require.Equal(t, "chimp", codecs.Float)
+	require.Equal(t, 0.1, codecs.ErrorBounds["f1"])
+	require.Equal(t, encoding.Codecs{Integer: "for"}, resolver("db1", "rp0", "mst_0000"))
+	require.Equal(t, encoding.Codecs{}, resolver("db2", "rp0", "mst_0000"))
+
+	// an altered measurement applies from the next lookup on
+	client.msts["db1.rp0.mst"] = &meta.MeasurementInfo{Name: "mst_0000", Options: &meta.Options{FloatCodec: "alp"}}
+	require.Equal(t, encoding.Codecs{Float: "alp"}, resolver("db1", "rp0", "mst_0000"))
+
+	// the files of an older version of the measurement keep the default codecs
+	require.Equal(t, encoding.Codecs{}, resolver("db0", "rp0", "mst_0001"))
+}
@@ -0,0 +1,230 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable
+
+import (
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// ColumnRangeStat is the pre-aggregation of a column of a series in a time range.
+// The sums of the float columns are not kept, since they depend on the order of the additions.
+type ColumnRangeStat struct {
+	Type  int
+	Count int64
+	Min   float64
+	Max   float64
+	Sum   int64 // the sum of the integer column
+}
+
+func (s *ColumnRangeStat) merge(count int64, minV, maxV float64, sum int64) {
+	if count == 0 {
+		return
+	}
+	if s.Count == 0 {
+		s.Min, s.Max = minV, maxV
+	} else {
+		s.Min, s.Max = min(s.Min, minV), max(s.Max, maxV)
+	}
+	s.Count += count
+	s.Sum += sum
+}
+
+// SeriesRangeStat is the chunk metadata of a series in a time range, including the pre-aggregations of the columns
+type SeriesRangeStat struct {
+	MinTime int64
+	MaxTime int64
+	Rows    int64
+	Columns map[string]*ColumnRangeStat
+}
+
+func (s *SeriesRangeStat) mergeTimes(minTime, maxTime, rows int64) {
+	if rows == 0 {
+		return
+	}
+	if s.Rows == 0 {
+		s.MinTime, s.MaxTime = minTime, maxTime
+	} else {
+		s.MinTime, s.MaxTime = min(s.MinTime, minTime), max(s.MaxTime, maxTime)
+	}
+	s.Rows += rows
+}
+
+func (s *SeriesRangeStat) column(name string, ty int) *ColumnRangeStat {
+	col, ok := s.Columns[name]
+	if !ok {
+		col = &ColumnRangeStat{Type: ty}
+		s.Columns[name] = col
+	}
+	return col
+}
+
+// SeriesRangeStats is the stats of the series in the time ranges, keyed by the series id and the start time of the range
+type SeriesRangeStats map[uint64]map[int64]*SeriesRangeStat
+
+func (s SeriesRangeStats) get(sid uint64, start int64) *SeriesRangeStat {
+	ranges, ok := s[sid]
+	if !ok {
+		ranges = make(map[int64]*SeriesRangeStat)
+		s[sid] = ranges
+	}
+	stat, ok := ranges[start]
+	if !ok {
+		stat = &SeriesRangeStat{Columns: make(map[string]*ColumnRangeStat)}
+		ranges[start] = stat
+	}
+	return stat
+}
+
+// RangeStart returns the start time of the time range of the interval which tm belongs to
+func RangeStart(tm, interval int64) int64 {
+	return tm - ((tm%interval)+interval)%interval
+}
+
+// CollectSeriesRangeStat merges the chunk metas of the ordered file into stats by the time ranges of the interval.
+// The rows of a series in the ordered files do not overlap, and the stats are merged without the float sums,
+// so the stats do not depend on how the files are compacted.
+// The pre-aggregations of a chunk in one time range are merged as they are, the segments of a chunk across
+// the time ranges are read and aggregated. The files are read with the low priority.
+func CollectSeriesRangeStat(f TSSPFile, interval int64, stats SeriesRangeStats) error {
+	ab := acquireTimePreAggBuilder()
+	defer ab.release()
+	decs := NewReadContext(true)
+	defer decs.Release()
+
+	var metas []ChunkMeta
+	for i := 0; i < int(f.MetaIndexItemNum()); i++ {
+		mIdx, err := f.MetaIndexAt(i)
+		if err != nil {
+			return err
+		}
+		metas, err = f.ReadChunkMetaData(i, mIdx, metas[:0], fileops.IO_PRIORITY_LOW_READ)
+		if err != nil {
+			return err
+		}
+
+		for j := range metas {
+			cm := &metas[j]
+			minTime, maxTime := cm.MinMaxTime()
+			start := RangeStart(minTime, interval)
+			if RangeStart(maxTime, interval) == start {
+				err = mergeChunkPreAgg(cm, ab, stats.get(cm.sid, start))
+			} else {
+				err = readChunkRangeStat(f, cm, interval, stats, decs)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func mergeChunkPreAgg(cm *ChunkMeta, ab PreAggBuilder, stat *SeriesRangeStat) error {
+	minTime, maxTime := cm.MinMaxTime()
+	stat.mergeTimes(minTime, maxTime, int64(cm.Rows(ab)))
+	for i := range cm.colMeta {
+		col := &cm.colMeta[i]
+		if col.IsTime() {
+			continue
+		}
+		b := acquireColumnBuilder(int(col.ty))
+		if _, err := b.unmarshal(col.preAgg); err != nil {
+			b.release()
+			return err
+		}
+		var minV, maxV interface{}
+		var sum int64
+		if col.ty != influx.Field_Type_String {
+			// only the strings are counted
+			minV, _ = b.min()
+			maxV, _ = b.max()
+			sum, _ = b.sum().(int64)
+		}
+		stat.column(col.name, int(col.ty)).merge(b.count(), preAggValue(minV), preAggValue(maxV), sum)
+		b.release()
+	}
+	return nil
+}
+
+func readChunkRangeStat(f TSSPFile, cm *ChunkMeta, interval int64, stats SeriesRangeStats, decs *ReadContext) error {
+	schema := make(record.Schemas, len(cm.colMeta))
+	for i := range cm.colMeta {
+		schema[i] = record.Field{Name: cm.colMeta[i].name, Type: int(cm.colMeta[i].ty)}
+	}
+	rec := &record.Record{}
+	for seg := 0; seg < cm.segmentCount(); seg++ {
+		rec.ResetWithSchema(schema)
+		dst, err := f.ReadAt(cm, seg, rec, decs, fileops.IO_PRIORITY_LOW_READ)
+		if err != nil {
+			return err
+		}
+		if dst == nil {
+			continue
+		}
+		times := dst.Times()
+		for i := range times {
+			stat := stats.get(cm.sid, RangeStart(times[i], interval))
+			stat.mergeTimes(times[i], times[i], 1)
+			for j := range dst.Schema[:len(dst.Schema)-1] {
+				ref := &dst.Schema[j]
+				if v, sum, ok := columnValue(dst.Column(j), ref.Type, i); ok {
+					stat.column(ref.Name, ref.Type).merge(1, v, v, sum)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// columnValue returns the i-th value of the column and its sum as the pre-aggregation does, the strings are only counted
+func columnValue(cv *record.ColVal, ty int, i int) (float64, int64, bool) {
+	if i >= cv.Len {
+		return 0, 0, false
+	}
+	switch ty {
+	case influx.Field_Type_Float:
+		v, isNil := cv.FloatValue(i)
+		return v, 0, !isNil
+	case influx.Field_Type_Int:
+		v, isNil := cv.IntegerValue(i)
+		return float64(v), v, !isNil
+	case influx.Field_Type_Boolean:
+		v, isNil := cv.BooleanValue(i)
+		if v {
+			return 1, 0, !isNil
+		}
+		return 0, 0, !isNil
+	case influx.Field_Type_String:
+		return 0, 0, !cv.IsNil(i)
+	default:
+		return 0, 0, false
+	}
+}
+
+func preAggValue(v interface{}) float64 {
+	switch v := v.(type) {
+	case float64:
+		return v
+	case int64:
+		return float64(v)
+	case bool:
+		if v {
+			return 1
+		}
+	}
+	return 0
+}
@@ -32,6 +32,7 @@ const (
 	IndexFileSuffix            string = ".idx"
 	MinMaxIndexFileSuffix      string = ".mm"
 	SetIndexFileSuffix         string = ".set"
+	NGramIndexFileSuffix       string = ".ngram"
 	BloomFilterIndexFileSuffix string = ".bf"
 	TextIndexDataFileSuffix    string = ".pos" // posting list
 	TextIndexHeadFileSuffix    string = ".bh"  // block header
@@ -60,6 +61,8 @@ func AppendSecondaryIndexSuffix(dataPath string, fieldName string, indexType ind
 		indexFileSuffix = MinMaxIndexFileSuffix
 	case index.Set:
 		indexFileSuffix = SetIndexFileSuffix
+	case index.NGram:
+		indexFileSuffix = NGramIndexFileSuffix
 	case index.BloomFilter, index.BloomFilterFullText:
 		indexFileSuffix = BloomFilterIndexFileSuffix
 	case index.Text:
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/openGemini/openGemini/engine/immutable/colstore"
+	skipindex "github.com/openGemini/openGemini/engine/index"
 	"github.com/openGemini/openGemini/lib/bufferpool"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/cpu"
@@ -693,6 +694,7 @@ type FragmentIterators struct {
 	indexFilePath       string
 	inited              bool
 	oldIndexFiles       []string
+	fieldIndexCols      map[index.IndexType][]string
 	SortKeyFileds       []record.Field
 	tcDuration          time.Duration // duration for time cluster
 	fields              record.Schemas
@@ -969,6 +971,33 @@ func (f *FragmentIterators) writePrimaryIndex() error {
 	return nil
 }
 
+// writeFieldIndex rebuilds the field indexes of the compacted records, the fragments are cut as the primary index does.
+func (f *FragmentIterators) writeFieldIndex() error {
+	if len(f.fieldIndexCols) == 0 || f.RecordResult.RowNums() == 0 {
+		return nil
+	}
+	rowsPerSegment := GenFixRowsPerSegment(f.RecordResult, f.Conf.maxRowsPerSegment)
+	for t, cols := range f.fieldIndexCols {
+		schemaIdx := make([]int, 0, len(cols))
+		for _, col := range cols {
+			if idx := f.RecordResult.Schema.FieldIndex(col); idx >= 0 {
+				schemaIdx = append(schemaIdx, idx)
+			}
+		}
+		if len(schemaIdx) == 0 {
+			continue
+		}
+		writer := skipindex.NewIndexWriter(f.builder.Path, f.builder.msName, f.builder.FileName.String(), *f.builder.lock, t, "")
+		if writer == nil {
+			continue
+		}
+		if err := writer.CreateAttachIndex(f.RecordResult, schemaIdx, rowsPerSegment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (f *FragmentIterators) writeRecord(nextFile func(fn TSSPFileName) (seq uint64, lv uint16, merge uint16, ext uint16), final bool, pkSchema record.Schemas) (*MsBuilder, error) {
 	rowsLimit := f.builder.Conf.maxRowsPerSegment * f.builder.Conf.maxSegmentLimit
 	var err error
@@ -977,6 +1006,11 @@ func (f *FragmentIterators) writeRecord(nextFile func(fn TSSPFileName) (seq uint
 		return f.builder, err
 	}
 
+	if err = f.writeFieldIndex(); err != nil {
+		f.log.Error("write field index fail", zap.String("file", f.builder.fd.Name()), zap.Error(err))
+		return f.builder, err
+	}
+
 	//final, encode meta and write meta to disk
 	if final {
 		minT, maxT := f.builder.chunkBuilder.getMinMaxTime(f.builder.timeSorted)
@@ -1312,6 +1346,7 @@ func (f *FragmentIterators) reset() {
 	f.colBuilder.resetPreAgg()
 	f.mIndex.reset()
 	f.TableData.reset()
+	f.fieldIndexCols = nil
 }
 
 type SortKeyIterator struct {
@@ -204,6 +204,7 @@ func (b *ColumnBuilder) encFloatColumn(timeCols []record.ColVal, segCols []recor
 		b.floatPreAggBuilder = acquireColumnBuilder(influx.Field_Type_Float)
 	}
 	b.floatPreAggBuilder.reset()
+	b.coder.SelectField(b.colMeta.Name())
 
 	for i := range segCols {
 		segCol := &segCols[i]
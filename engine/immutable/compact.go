@@ -171,6 +171,7 @@ func (m *MmsTables) compact(itrs *ChunkIterators, files []TSSPFile, level uint16
 	fileName := NewTSSPFileName(seq, level, 0, 0, isOrder, m.lock)
 	tableBuilder := NewMsBuilder(m.path, itrs.name, m.lock, m.Conf, itrs.maxN, fileName, *m.tier, nil, itrs.estimateSize, config.TSSTORE, m.obsOpt, m.GetShardID())
 	tableBuilder.WithLog(cLog)
+	tableBuilder.SetCodecs(m.measurementCodecs(itrs.name))
 
 	correctTimeDisorder := config.GetStoreConfig().Compact.CorrectTimeDisorder
 
@@ -0,0 +1,186 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable
+
+import (
+	"sort"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+)
+
+// CompactionPlanInfo describes a running or pending compaction plan of a shard
+type CompactionPlanInfo struct {
+	ShardID     uint64
+	Measurement string
+	Strategy    string
+	Level       uint16
+	FileCount   int
+	FileSize    int64
+	Running     bool
+	StartTime   int64
+}
+
+// CompactionDebt is the amount of work the compaction has not started yet
+type CompactionDebt struct {
+	PendingPlans int64
+	PendingFiles int64
+	PendingSize  int64
+}
+
+func (m *MmsTables) compactionStrategy() string {
+	if config.GetStoreConfig().Compact.IsTimeWindowStrategy() {
+		return config.CompactionStrategyTimeWindow
+	}
+	return config.CompactionStrategyLevel
+}
+
+func (m *MmsTables) addRunningPlan(task *CompactTask) {
+	info := &CompactionPlanInfo{
+		ShardID:     task.plan.shardId,
+		Measurement: task.plan.name,
+		Strategy:    m.compactionStrategy(),
+		Level:       task.plan.toLevel,
+		FileCount:   task.plan.Len(),
+		Running:     true,
+		StartTime:   time.Now().UnixNano(),
+	}
+	if task.full {
+		info.Strategy = "full"
+	}
+	m.runningPlans.Store(task, info)
+}
+
+func (m *MmsTables) delRunningPlan(task *CompactTask) {
+	m.runningPlans.Delete(task)
+}
+
+// CompactionPlans returns the running compaction plans followed by the pending ones
+func (m *MmsTables) CompactionPlans() []*CompactionPlanInfo {
+	var plans []*CompactionPlanInfo
+	m.runningPlans.Range(func(_, value any) bool {
+		plans = append(plans, value.(*CompactionPlanInfo))
+		return true
+	})
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].StartTime < plans[j].StartTime
+	})
+	return append(plans, m.pendingPlans()...)
+}
+
+// CompactionDebt returns the number of plans, files and bytes waiting for compaction
+func (m *MmsTables) CompactionDebt() CompactionDebt {
+	var debt CompactionDebt
+	for _, p := range m.pendingPlans() {
+		debt.PendingPlans++
+		debt.PendingFiles += int64(p.FileCount)
+		debt.PendingSize += p.FileSize
+	}
+	return debt
+}
+
+// pendingPlans computes the plans the next compaction would run, without acquiring any file
+func (m *MmsTables) pendingPlans() []*CompactionPlanInfo {
+	if m.ImmTable == nil || m.ImmTable.GetEngineType() != config.TSSTORE {
+		return nil
+	}
+
+	timeWindow := config.GetStoreConfig().Compact.IsTimeWindowStrategy()
+	window := int64(time.Duration(config.GetStoreConfig().Compact.CompactionTimeWindow))
+	shardID := m.GetShardID()
+
+	var plans []*CompactionPlanInfo
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for name, files := range m.Order {
+		if m.scheduler.IsRunning(name) {
+			continue
+		}
+
+		files.lock.RLock()
+		var groups [][]TSSPFile
+		if timeWindow {
+			groups = timeWindowGroups(files.files, window, false)
+		} else {
+			groups = levelRuns(files.files)
+		}
+
+		for _, group := range groups {
+			info := &CompactionPlanInfo{
+				ShardID:     shardID,
+				Measurement: name,
+				Strategy:    config.CompactionStrategyLevel,
+				FileCount:   len(group),
+			}
+			if timeWindow {
+				info.Strategy = config.CompactionStrategyTimeWindow
+			}
+			for _, f := range group {
+				lv, _ := f.LevelAndSequence()
+				if lv+1 > info.Level {
+					info.Level = lv + 1
+				}
+				info.FileSize += f.FileSize()
+			}
+			plans = append(plans, info)
+		}
+		files.lock.RUnlock()
+	}
+	return plans
+}
+
+// levelRuns groups the files like mmsPlan does for each level: consecutive files of the same level
+// with LeveLMinGroupFiles distinct sequences make a plan,
+// the files split by one compaction break the run
+func levelRuns(files []TSSPFile) [][]TSSPFile {
+	var runs [][]TSSPFile
+	for level := uint16(0); level < CompactLevels; level++ {
+		minN := LeveLMinGroupFiles[level]
+
+		var run []TSSPFile
+		seqs := 0
+		var lastSeq uint64
+		flush := func() {
+			if seqs >= minN {
+				runs = append(runs, run)
+			}
+			run, seqs = nil, 0
+		}
+
+		for i := 0; i < len(files); i++ {
+			lv, seq := files[i].LevelAndSequence()
+			if lv != level {
+				flush()
+				continue
+			}
+			if seqs > 0 && seq == lastSeq {
+				// the files split by one compaction are not planned, skip them all
+				for i+1 < len(files) && levelSequenceEqual(level, seq, files[i+1]) {
+					i++
+				}
+				run, seqs = nil, 0
+				continue
+			}
+			if seqs >= minN {
+				flush()
+			}
+			run = append(run, files[i])
+			seqs++
+			lastSeq = seq
+		}
+		flush()
+	}
+	return runs
+}
@@ -29,8 +29,10 @@ import (
 
 	"github.com/influxdata/influxdb/logger"
 	"github.com/openGemini/openGemini/engine/immutable/colstore"
+	"github.com/openGemini/openGemini/lib/compress"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/cpu"
+	"github.com/openGemini/openGemini/lib/encoding"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/index"
@@ -3874,3 +3876,64 @@ func TestGetLocalBloomFilterData(t *testing.T) {
 		t.Fatal("should return no such file or dir")
 	}
 }
+
+func TestRenameAndRemoveFieldIndexFiles(t *testing.T) {
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "00000001-0001-00000000")
+	fields := []string{"region", "host"}
+
+	for _, indexType := range []index.IndexType{index.Set, index.NGram} {
+		// only region is written, host is absent from the data
+		regionFile := colstore.AppendSecondaryIndexSuffix(fileName, "region", indexType, 0)
+		require.NoError(t, os.WriteFile(regionFile+tmpFileSuffix, []byte{1}, 0640))
+		require.NoError(t, renameFieldIndexFiles(fileName, map[index.IndexType][]string{indexType: fields}))
+		_, err := os.Stat(regionFile)
+		require.NoError(t, err)
+
+		require.NoError(t, RemoveFieldIndexFiles(fileName, indexType, fields))
+		_, err = os.Stat(regionFile)
+		require.True(t, os.IsNotExist(err))
+	}
+}
+
+func TestGetFieldIndexColumns(t *testing.T) {
+	require.Nil(t, GetFieldIndexColumns(nil))
+	ir := &influxql.IndexRelation{
+		Oids:      []uint32{uint32(index.BloomFilter), uint32(index.NGram)},
+		IndexList: []*influxql.IndexList{{IList: []string{"host"}}, {IList: []string{"content"}}},
+	}
+	require.Equal(t, map[index.IndexType][]string{index.NGram: {"content"}}, GetFieldIndexColumns(ir))
+}
+
+func TestMmsTables_MeasurementErrorBounds(t *testing.T) {
+	encoding.SetCodecsResolver(func(db, rp, name string) encoding.Codecs {
+		if db == "db0" && rp == "rp0" && name == "mst_0000" {
+			return encoding.Codecs{ErrorBounds: map[string]float64{"f1": 0.5}}
+		}
+		return encoding.Codecs{}
+	})
+	defer encoding.SetCodecsResolver(nil)
+
+	values := make([]float64, 1000)
+	for i := range values {
+		values[i], _ = compress.Quantize(float64(i)+0.123456789, 0.5)
+	}
+	encode := func(db string) int {
+		m := &MmsTables{}
+		m.SetDbRp(db, "rp0")
+		ctx := encoding.NewCoderContext()
+		defer ctx.Release()
+		ctx.SetCodecs(m.measurementCodecs("mst_0000"))
+		ctx.SelectField("f1")
+		out, err := encoding.EncodeFloatBlock(util.Float64Slice2byte(values), nil, ctx)
+		require.NoError(t, err)
+		var dec []byte
+		got, err := encoding.DecodeFloatBlock(out, &dec, ctx)
+		require.NoError(t, err)
+		require.Equal(t, values, got)
+		return len(out)
+	}
+
+	// the error bound only applies to the measurement of the database it is set on
+	require.Less(t, encode("db0"), encode("db1"))
+}
@@ -0,0 +1,173 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable
+
+import (
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+)
+
+// timeWindowRun is a run of consecutive files whose max time falls in the same time window
+type timeWindowRun struct {
+	window int64
+	active bool
+	files  []TSSPFile
+	// number of distinct sequences, the files generated by one compaction share the same sequence
+	seqs int
+}
+
+// groups returns the files of the run to compact together. A closed window is compacted into one file once.
+// The files of the active window are merged level by level like the level strategy does, so that a window
+// is not rewritten in full by every compaction while it is written.
+func (r *timeWindowRun) groups() [][]TSSPFile {
+	if r.active {
+		return levelRuns(r.files)
+	}
+	if r.seqs > 1 {
+		return [][]TSSPFile{r.files}
+	}
+	return nil
+}
+
+// timeWindowGroups returns the groups of files to compact of all time windows.
+// No window is active in a cold shard, so the window written last is compacted into one file as well.
+func timeWindowGroups(files []TSSPFile, window int64, cold bool) [][]TSSPFile {
+	var groups [][]TSSPFile
+	for _, run := range splitTimeWindows(files, window) {
+		if cold {
+			run.active = false
+		}
+		groups = append(groups, run.groups()...)
+	}
+	return groups
+}
+
+// splitTimeWindows splits the files sorted by sequence into runs by the time window of their max time.
+// The window of the newest file is active, all windows before it are closed and will not receive new files
+// except the out-of-order data merged into them.
+func splitTimeWindows(files []TSSPFile, window int64) []*timeWindowRun {
+	if len(files) == 0 || window <= 0 {
+		return nil
+	}
+
+	var runs []*timeWindowRun
+	var run *timeWindowRun
+	var lastSeq uint64
+	activeWindow := int64(-1 << 63)
+	for _, f := range files {
+		_, maxTime, err := f.MinMaxTime()
+		if err != nil {
+			// the file can not be planned, it breaks the run
+			run = nil
+			continue
+		}
+
+		w := floorDiv(maxTime, window)
+		if w > activeWindow {
+			activeWindow = w
+		}
+
+		_, seq := f.LevelAndSequence()
+		if run == nil || run.window != w {
+			run = &timeWindowRun{window: w}
+			runs = append(runs, run)
+		} else if seq == lastSeq {
+			run.files = append(run.files, f)
+			continue
+		}
+		run.files = append(run.files, f)
+		run.seqs++
+		lastSeq = seq
+	}
+
+	for _, r := range runs {
+		r.active = r.window == activeWindow
+	}
+	return runs
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// TimeWindowCompact compacts the files of each time window of the tsstore measurements,
+// cold is true if the shard has not been written for the full compaction cold duration
+func (m *MmsTables) TimeWindowCompact(shid uint64, cold bool) error {
+	plans := m.timeWindowPlan(cold)
+	if len(plans) == 0 {
+		return nil
+	}
+
+	taskGroups := m.buildCompactTaskGroup(plans, false, shid)
+	for _, group := range taskGroups {
+		m.scheduler.ExecuteTaskGroup(group, m.stopCompMerge)
+	}
+	return nil
+}
+
+func (m *MmsTables) timeWindowPlan(cold bool) []*CompactGroup {
+	if !m.CompactionEnabled() {
+		return nil
+	}
+	window := int64(time.Duration(config.GetStoreConfig().Compact.CompactionTimeWindow))
+
+	var plans []*CompactGroup
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for name, files := range m.ImmTable.getFiles(m, true) {
+		if m.isClosed() || m.isCompMergeStopped() {
+			break
+		}
+		if m.scheduler.IsRunning(name) {
+			continue
+		}
+
+		files.lock.RLock()
+		if atomic.LoadInt64(&files.closing) > 0 {
+			files.lock.RUnlock()
+			continue
+		}
+		for _, groupFiles := range timeWindowGroups(files.files, window, cold) {
+			group := NewCompactGroup(name, 0, 0)
+			for _, f := range groupFiles {
+				if isTmpFile(f.Path()) {
+					continue
+				}
+				lv, _ := f.LevelAndSequence()
+				group.UpdateLevel(lv + 1)
+				group.Add(f.Path())
+			}
+			if group.Len() < 2 || m.busy(group.group) || InParquetProcess(group.group...) {
+				group.release()
+				continue
+			}
+			group.dropping = &files.closing
+			plans = append(plans, group)
+		}
+		files.lock.RUnlock()
+	}
+	return plans
+}
+
+func isTmpFile(name string) bool {
+	return strings.HasSuffix(name, tmpFileSuffix)
+}
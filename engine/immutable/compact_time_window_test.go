@@ -0,0 +1,129 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type windowMockFile struct {
+	MocTsspFile
+	level   uint16
+	seq     uint64
+	maxTime int64
+}
+
+func (f *windowMockFile) LevelAndSequence() (uint16, uint64) {
+	return f.level, f.seq
+}
+
+func (f *windowMockFile) MinMaxTime() (int64, int64, error) {
+	return f.maxTime, f.maxTime, nil
+}
+
+func (f *windowMockFile) FileSize() int64 {
+	return 10
+}
+
+func newWindowMockFiles(items ...[3]int64) []TSSPFile {
+	files := make([]TSSPFile, 0, len(items))
+	for _, item := range items {
+		files = append(files, &windowMockFile{level: uint16(item[0]), seq: uint64(item[1]), maxTime: item[2]})
+	}
+	return files
+}
+
+func TestSplitTimeWindows(t *testing.T) {
+	files := newWindowMockFiles(
+		// window 0 is closed and has 2 files
+		[3]int64{1, 1, 10}, [3]int64{0, 2, 20},
+		// window 1 is closed and frozen, the two files are split by one compaction
+		[3]int64{1, 3, 110}, [3]int64{1, 3, 150},
+		// window 2 is active and has 3 sequences
+		[3]int64{0, 4, 210}, [3]int64{0, 5, 220}, [3]int64{0, 5, 230}, [3]int64{0, 6, 240},
+	)
+
+	runs := splitTimeWindows(files, 100)
+	require.Equal(t, 3, len(runs))
+
+	require.False(t, runs[0].active)
+	require.Equal(t, 2, runs[0].seqs)
+	require.Equal(t, 1, len(runs[0].groups()))
+
+	require.False(t, runs[1].active)
+	require.Equal(t, 1, runs[1].seqs)
+	require.Equal(t, 2, len(runs[1].files))
+	require.Nil(t, runs[1].groups())
+
+	require.True(t, runs[2].active)
+	require.Equal(t, 3, runs[2].seqs)
+	require.Equal(t, 4, len(runs[2].files))
+	require.Nil(t, runs[2].groups())
+
+	require.Nil(t, splitTimeWindows(files, 0))
+	require.Nil(t, splitTimeWindows(nil, 100))
+}
+
+func TestTimeWindowGroups(t *testing.T) {
+	var items [][3]int64
+	// window 0 is closed and has 2 files
+	items = append(items, [3]int64{0, 1, 10}, [3]int64{0, 2, 20})
+	// window 1 is active, its level 0 files are merged without the files compacted before
+	items = append(items, [3]int64{1, 3, 110})
+	for i := 0; i < LeveLMinGroupFiles[0]; i++ {
+		items = append(items, [3]int64{0, int64(4 + i), 120})
+	}
+	files := newWindowMockFiles(items...)
+
+	groups := timeWindowGroups(files, 100, false)
+	require.Equal(t, 2, len(groups))
+	require.Equal(t, 2, len(groups[0]))
+	require.Equal(t, LeveLMinGroupFiles[0], len(groups[1]))
+
+	// all windows of a cold shard are closed
+	groups = timeWindowGroups(files, 100, true)
+	require.Equal(t, 2, len(groups))
+	require.Equal(t, 2, len(groups[0]))
+	require.Equal(t, LeveLMinGroupFiles[0]+1, len(groups[1]))
+}
+
+func TestSplitTimeWindows_Negative(t *testing.T) {
+	runs := splitTimeWindows(newWindowMockFiles([3]int64{0, 1, -1}, [3]int64{0, 2, 0}), 100)
+	require.Equal(t, 2, len(runs))
+	require.Equal(t, int64(-1), runs[0].window)
+	require.Equal(t, int64(0), runs[1].window)
+}
+
+func TestLevelRuns(t *testing.T) {
+	var items [][3]int64
+	for i := 0; i < LeveLMinGroupFiles[0]+2; i++ {
+		items = append(items, [3]int64{0, int64(i + 1), 0})
+	}
+	// split files of one compaction break the run
+	items = append(items, [3]int64{1, 100, 0}, [3]int64{1, 100, 0})
+	for i := 0; i < LeveLMinGroupFiles[1]; i++ {
+		items = append(items, [3]int64{1, int64(200 + i), 0})
+	}
+
+	runs := levelRuns(newWindowMockFiles(items...))
+	require.Equal(t, 2, len(runs))
+	require.Equal(t, LeveLMinGroupFiles[0], len(runs[0]))
+	require.Equal(t, LeveLMinGroupFiles[1], len(runs[1]))
+	lv, seq := runs[1][0].LevelAndSequence()
+	require.Equal(t, uint16(1), lv)
+	require.Equal(t, uint64(200), seq)
+}
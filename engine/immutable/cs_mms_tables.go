@@ -32,9 +32,11 @@ import (
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/index"
 	Log "github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"go.uber.org/zap"
@@ -322,6 +324,7 @@ func (c *csImmTableImpl) NewFragmentIterators(m *MmsTables, group FilesInfo, lcL
 		if err != nil {
 			return nil, err
 		}
+		fragItrs.fieldIndexCols = GetFieldIndexColumns(&mstInfo.IndexRelation)
 		err = fragItrs.newIteratorByRow()
 		if err != nil {
 			return nil, err
@@ -385,7 +388,8 @@ func (c *csImmTableImpl) compactToLevel(m *MmsTables, group FilesInfo, full, isN
 		return err
 	}
 
-	if err = c.ReplaceFiles(m, group.name, group.oldFiles, newFiles, true, mstInfo.IndexRelation.GetBloomFilterColumns()); err != nil {
+	if err = c.ReplaceFiles(m, group.name, group.oldFiles, newFiles, true, mstInfo.IndexRelation.GetBloomFilterColumns(),
+		GetFieldIndexColumns(&mstInfo.IndexRelation)); err != nil {
 		lcLog.Error("replace compacted file error", zap.Error(err))
 		return err
 	}
@@ -437,7 +441,7 @@ func (c *csImmTableImpl) replaceIndexFiles(m *MmsTables, name string, oldIndexFi
 	return nil
 }
 
-func (c *csImmTableImpl) ReplaceFiles(m *MmsTables, name string, oldFiles, newFiles []TSSPFile, isOrder bool, iList []string) (err error) {
+func (c *csImmTableImpl) ReplaceFiles(m *MmsTables, name string, oldFiles, newFiles []TSSPFile, isOrder bool, iList []string, fieldIndexes map[index.IndexType][]string) (err error) {
 	if len(newFiles) == 0 || len(oldFiles) == 0 {
 		return nil
 	}
@@ -466,6 +470,11 @@ func (c *csImmTableImpl) ReplaceFiles(m *MmsTables, name string, oldFiles, newFi
 			m.logger.Error("rename new file fail", zap.String("name", name), zap.String("dir", shardDir), zap.Error(err))
 			return err
 		}
+		fName := newFiles[i].Path()
+		if err := renameFieldIndexFiles(fName[:len(fName)-tsspFileSuffixLen], fieldIndexes); err != nil {
+			m.logger.Error("rename new file fail", zap.String("name", name), zap.String("dir", shardDir), zap.Error(err))
+			return err
+		}
 	}
 
 	mmsTables := m.ImmTable.getFiles(m, isOrder)
@@ -491,6 +500,11 @@ func (c *csImmTableImpl) ReplaceFiles(m *MmsTables, name string, oldFiles, newFi
 				return err
 			}
 		}
+		for t, fields := range fieldIndexes {
+			if err = RemoveFieldIndexFiles(f.Path()[:len(f.Path())-tsspFileSuffixLen], t, fields); err != nil {
+				return err
+			}
+		}
 		fs.deleteFile(f)
 		if err = m.deleteFiles(f); err != nil {
 			return
@@ -533,3 +547,66 @@ func RenameIndexFiles(fname string, indexList []string) error {
 	}
 	return nil
 }
+
+func renameFieldIndexFiles(fileName string, fieldIndexes map[index.IndexType][]string) error {
+	for t, fields := range fieldIndexes {
+		if err := RenameFieldIndexFiles(fileName, t, fields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldIndexTypes are the skip indexes which write one file per field and rebuild it during the row compaction.
+var fieldIndexTypes = []index.IndexType{index.Set, index.NGram}
+
+// GetFieldIndexColumns returns the indexed fields of every field index type declared by the measurement.
+func GetFieldIndexColumns(ir *influxql.IndexRelation) map[index.IndexType][]string {
+	var res map[index.IndexType][]string
+	for _, t := range fieldIndexTypes {
+		var cols []string
+		switch t {
+		case index.Set:
+			cols = ir.GetSetColumns()
+		case index.NGram:
+			cols = ir.GetNGramColumns()
+		}
+		if len(cols) == 0 {
+			continue
+		}
+		if res == nil {
+			res = make(map[index.IndexType][]string, len(fieldIndexTypes))
+		}
+		res[t] = cols
+	}
+	return res
+}
+
+// RenameFieldIndexFiles renames the field index files of a data file, the fields absent from the data have no file.
+func RenameFieldIndexFiles(fileName string, indexType index.IndexType, fields []string) error {
+	lock := fileops.FileLockOption("")
+	for i := range fields {
+		indexFileName := colstore.AppendSecondaryIndexSuffix(fileName, fields[i], indexType, 0)
+		tmpIndexFileName := indexFileName + tmpFileSuffix
+		if _, err := fileops.Stat(tmpIndexFileName); os.IsNotExist(err) {
+			continue
+		}
+		if err := fileops.RenameFile(tmpIndexFileName, indexFileName, lock); err != nil {
+			err = errno.NewError(errno.RenameFileFailed, zap.String("old", tmpIndexFileName), zap.String("new", indexFileName), err)
+			log.Error("rename file fail", zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveFieldIndexFiles removes the field index files of a data file.
+func RemoveFieldIndexFiles(fileName string, indexType index.IndexType, fields []string) error {
+	for i := range fields {
+		indexFileName := colstore.AppendSecondaryIndexSuffix(fileName, fields[i], indexType, 0)
+		if err := fileops.Remove(indexFileName); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -173,6 +173,16 @@ func (itr *FileIterator) NextChunkMeta() bool {
 	return true
 }
 
+// nextChunkMeta moves to the next chunk of the file, it is false when all the chunks are read or on error
+func (itr *FileIterator) nextChunkMeta() bool {
+	itr.curtChunkMeta = nil
+	if !itr.NextChunkMeta() {
+		return false
+	}
+	itr.chunkUsed++
+	return true
+}
+
 type FileIterators []*FileIterator
 
 func (i FileIterators) Close() {
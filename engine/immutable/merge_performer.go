@@ -337,14 +337,19 @@ func (p *mergePerformer) HasSeries(sid uint64) bool {
 }
 
 func (p *mergePerformer) WriteOriginal(fi *FileIterator) error {
+	return writeOriginalChunk(p.sw, fi)
+}
+
+// writeOriginalChunk copies the current chunk of fi to sw as it is
+func writeOriginalChunk(sw *StreamWriteFile, fi *FileIterator) error {
 	meta := fi.GetCurtChunkMeta()
 
 	limit := uint32(fileops.DefaultBufferSize * 2)
 	offset := meta.offset
 	readSize := uint32(0)
 
-	d := p.sw.writer.DataSize() - meta.offset
-	meta.offset = p.sw.writer.DataSize()
+	d := sw.writer.DataSize() - meta.offset
+	meta.offset = sw.writer.DataSize()
 
 	var cm *ColumnMeta
 	for i := range meta.colMeta {
@@ -373,7 +378,7 @@ func (p *mergePerformer) WriteOriginal(fi *FileIterator) error {
 		}
 		offset += int64(limit)
 
-		n, err = p.sw.writer.WriteData(buf)
+		n, err = sw.writer.WriteData(buf)
 		if err != nil {
 			return err
 		}
@@ -382,7 +387,7 @@ func (p *mergePerformer) WriteOriginal(fi *FileIterator) error {
 		}
 	}
 
-	return p.sw.WriteMeta(meta)
+	return sw.WriteMeta(meta)
 }
 
 func (p *mergePerformer) Close() {
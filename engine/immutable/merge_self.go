@@ -116,6 +116,7 @@ func (m *MergeSelf) createMsBuilder(mst string, toLevel uint16, fileName TSSPFil
 	fileName.lock = m.mts.lock
 	builder := NewMsBuilder(m.mts.path, mst, m.mts.lock, m.mts.Conf,
 		0, fileName, 0, nil, 0, config.TSSTORE, nil, m.mts.shardId)
+	builder.SetCodecs(m.mts.measurementCodecs(mst))
 	return builder
 }
 
@@ -27,6 +27,7 @@ import (
 	influxLogger "github.com/influxdata/influxdb/logger"
 	"github.com/openGemini/openGemini/engine/immutable/colstore"
 	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/encoding"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/fragment"
@@ -54,6 +55,7 @@ type TablesStore interface {
 	GetPKFile(mstName string, file string) (pkInfo *colstore.PKInfo, ok bool)
 	FreeAllMemReader()
 	ReplaceFiles(name string, oldFiles, newFiles []TSSPFile, isOrder bool) error
+	TrimFiles(name string, files []TSSPFile, isOrder bool, keep func(sid uint64) bool) error
 	GetBothFilesRef(measurement string, hasTimeFilter bool, tr util.TimeRange, flushed *bool) ([]TSSPFile, []TSSPFile, bool)
 	ReplaceDownSampleFiles(mstNames []string, originFiles [][]TSSPFile, newFiles [][]TSSPFile, isOrder bool, callBack func()) error
 	NextSequence() uint64
@@ -75,6 +77,9 @@ type TablesStore interface {
 	MergeOutOfOrder(shId uint64, full bool, force bool) error
 	LevelCompact(level uint16, shid uint64) error
 	FullCompact(shid uint64) error
+	TimeWindowCompact(shid uint64, cold bool) error
+	CompactionPlans() []*CompactionPlanInfo
+	CompactionDebt() CompactionDebt
 	SetAddFunc(addFunc func(int64))
 	LoadSequencer()
 	GetRowCountsBySid(measurement string, sid uint64) (int64, error)
@@ -129,6 +134,10 @@ type MmsTables struct {
 	shardId uint64 // this is only to track MmsTables open duration
 	opId    uint64 // this is only to track MmsTables open duration
 
+	// the database and the retention policy owning the shard, the codecs of the measurements are looked up by them
+	db string
+	rp string
+
 	closed          chan struct{}
 	stopCompMerge   chan struct{}
 	Order           map[string]*TSSPFiles        // {"cpu_0001": *TSSPFiles}
@@ -141,6 +150,7 @@ type MmsTables struct {
 	mergeEn         int32
 	inCompLock      sync.RWMutex
 	inCompact       map[string]struct{}
+	runningPlans    sync.Map // *CompactTask -> *CompactionPlanInfo
 	inMerge         *MeasurementInProcess
 	inBlockCompact  *MeasurementInProcess
 	lmt             *lastMergeTime
@@ -313,6 +323,15 @@ func (m *MmsTables) GetShardID() uint64 {
 	return m.shardId
 }
 
+func (m *MmsTables) SetDbRp(db, rp string) {
+	m.db = db
+	m.rp = rp
+}
+
+func (m *MmsTables) measurementCodecs(name string) encoding.Codecs {
+	return encoding.GetMeasurementCodecs(m.db, m.rp, name)
+}
+
 func (m *MmsTables) SetIndexMergeSet(idx IndexMergeSet) {
 	m.indexMergeSet = idx
 }
@@ -974,6 +993,10 @@ func RenameTmpFilesWithPKIndex(newFiles []TSSPFile, ir *influxql.IndexRelation)
 				if oid == uint32(index.BloomFilterFullText) ||
 					oid == uint32(index.TimeCluster) {
 					continue
+				} else if oid == uint32(index.Set) || oid == uint32(index.NGram) {
+					if err := RenameFieldIndexFiles(fileName, index.IndexType(oid), ir.IndexList[i].IList); err != nil {
+						return err
+					}
 				} else if oid == uint32(index.Text) {
 					for j := 0; j < colstore.TextIndexMax; j++ {
 						newName := colstore.AppendSecondaryIndexSuffix(fileName, ir.IndexList[i].IList[0], index.IndexType(oid), j)
@@ -32,6 +32,7 @@ import (
 	"github.com/openGemini/openGemini/engine/index"
 	"github.com/openGemini/openGemini/engine/index/sparseindex"
 	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/encoding"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/fragment"
@@ -267,6 +268,11 @@ func (b *MsBuilder) WithLog(log *logger.Logger) {
 	}
 }
 
+// SetCodecs forces the codecs of the float and integer columns written by the builder
+func (b *MsBuilder) SetCodecs(codecs encoding.Codecs) {
+	b.chunkBuilder.colBuilder.coder.SetCodecs(codecs)
+}
+
 func (b *MsBuilder) SetLocalBfCount(count int64) {
 	b.localBFCount = count
 }
@@ -384,6 +390,7 @@ func switchTsspFile(msb *MsBuilder, rec, totalRec *record.Record, rowsLimit int,
 	msb.FileName.SetLevel(lv)
 
 	builder := NewMsBuilder(msb.Path, msb.Name(), msb.lock, msb.Conf, msb.MaxIds, msb.FileName, msb.tier, msb.sequencer, rec.Len(), engineType, msb.obsOpt, msb.ShardID)
+	builder.SetCodecs(msb.chunkBuilder.colBuilder.coder.GetCodecs())
 	builder.Files = append(builder.Files, msb.Files...)
 	builder.FilesInfo = append(builder.FilesInfo, msb.FilesInfo...)
 	builder.pkRec = append(builder.pkRec, msb.pkRec...)
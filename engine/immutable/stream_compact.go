@@ -374,6 +374,7 @@ func (m *MmsTables) NewStreamIterators(group FilesInfo) *StreamIterators {
 	compItrs.lock = m.lock
 	compItrs.pair.Reset(group.name)
 	compItrs.Conf = m.Conf
+	compItrs.colBuilder.coder.SetCodecs(m.measurementCodecs(group.name))
 	compItrs.itrs = compItrs.itrs[:0]
 	for _, fi := range group.compIts {
 		if m.isClosed() || m.isCompMergeStopped() {
@@ -1189,6 +1190,7 @@ func (b *ColumnBuilder) encodeTimeColumn(cols []record.ColVal, offset int64) err
 
 func (b *ColumnBuilder) encodeColumn(segCols []record.ColVal, tmCols []record.ColVal, offset int64, ref record.Field) error {
 	var err error
+	b.coder.SelectField(ref.Name)
 	for i := range segCols {
 		segCol := &segCols[i]
 
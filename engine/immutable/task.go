@@ -44,7 +44,9 @@ func (t *CompactTask) BeforeExecute() bool {
 	if !t.table.acquire(t.plan.group) {
 		return false
 	}
+	t.table.addRunningPlan(t)
 	t.OnFinish(func() {
+		t.table.delRunningPlan(t)
 		t.table.CompactDone(t.plan.group)
 		t.table.blockCompactStop(t.plan.name)
 	})
@@ -0,0 +1,126 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable
+
+import (
+	"sort"
+
+	"github.com/openGemini/openGemini/lib/util"
+	"go.uber.org/zap"
+)
+
+// TrimFiles rewrites the files of the measurement without the series for which keep returns false.
+// The files left without any series are removed, the files holding only kept series are untouched.
+func (m *MmsTables) TrimFiles(name string, files []TSSPFile, isOrder bool, keep func(sid uint64) bool) error {
+	var oldFiles, newFiles, emptyFiles []TSSPFile
+	for _, f := range files {
+		trim, err := m.needTrim(f, keep)
+		if err != nil {
+			m.removeTrimmedFiles(newFiles)
+			return err
+		}
+		if !trim {
+			continue
+		}
+
+		nf, err := m.trimFile(name, f, keep)
+		if err != nil {
+			m.removeTrimmedFiles(newFiles)
+			return err
+		}
+		if nf == nil {
+			emptyFiles = append(emptyFiles, f)
+			continue
+		}
+		oldFiles = append(oldFiles, f)
+		newFiles = append(newFiles, nf)
+	}
+
+	if err := m.ReplaceFiles(name, oldFiles, newFiles, isOrder); err != nil {
+		m.logger.Error("replace trimmed files fail", zap.String("name", name), zap.Error(err))
+		return err
+	}
+	m.deleteTrimmedFiles(name, emptyFiles, isOrder)
+	return nil
+}
+
+func (m *MmsTables) needTrim(f TSSPFile, keep func(sid uint64) bool) (bool, error) {
+	fi := NewFileIterator(f, m.logger)
+	defer fi.Close()
+
+	for fi.nextChunkMeta() {
+		if !keep(fi.GetCurtChunkMeta().sid) {
+			return true, nil
+		}
+	}
+	return false, fi.err
+}
+
+// trimFile copies the chunks of the kept series of f to a new temporary file,
+// the new file is nil if no series of f is kept
+func (m *MmsTables) trimFile(name string, f TSSPFile, keep func(sid uint64) bool) (TSSPFile, error) {
+	sw := m.NewStreamWriteFile(name)
+	if err := sw.InitMergedFile(f); err != nil {
+		return nil, err
+	}
+
+	fi := NewFileIterator(f, m.logger)
+	defer fi.Close()
+
+	for fi.nextChunkMeta() {
+		if !keep(fi.GetCurtChunkMeta().sid) {
+			continue
+		}
+		if err := writeOriginalChunk(sw, fi); err != nil {
+			sw.Close(true)
+			return nil, err
+		}
+	}
+	if fi.err != nil {
+		sw.Close(true)
+		return nil, fi.err
+	}
+
+	nf, err := sw.NewTSSPFile(true)
+	if err != nil || nf == nil {
+		sw.Close(true)
+	}
+	return nf, err
+}
+
+func (m *MmsTables) removeTrimmedFiles(files []TSSPFile) {
+	for _, f := range files {
+		util.MustRun(f.Remove)
+	}
+}
+
+func (m *MmsTables) deleteTrimmedFiles(name string, files []TSSPFile, isOrder bool) {
+	if len(files) == 0 {
+		return
+	}
+
+	tfs, ok := m.getTSSPFiles(name, isOrder)
+	if !ok {
+		return
+	}
+
+	tfs.lock.Lock()
+	defer tfs.lock.Unlock()
+	for _, f := range files {
+		tfs.deleteFile(f)
+		m.removeFile(f)
+	}
+	sort.Sort(tfs)
+}
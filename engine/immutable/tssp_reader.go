@@ -513,6 +513,46 @@ func (f *tsspFile) ReadAt(cm *ChunkMeta, segment int, dst *record.Record, decs *
 	return f.reader.ReadData(cm, segment, dst, decs, ioPriority)
 }
 
+// AppendSeriesTimes appends the times of the series in the file which are in tr to dst
+func AppendSeriesTimes(f TSSPFile, sid uint64, tr util.TimeRange, dst []int64) ([]int64, error) {
+	contains, err := f.ContainsValue(sid, tr)
+	if err != nil || !contains {
+		return dst, err
+	}
+	idx, mi, err := f.MetaIndex(sid, tr)
+	if err != nil || mi == nil {
+		return dst, err
+	}
+
+	metaCtx := NewChunkMetaContext(nil)
+	defer metaCtx.Release()
+	cm, err := f.ChunkMeta(sid, mi.offset, mi.size, mi.count, idx, metaCtx, fileops.IO_PRIORITY_LOW_READ)
+	if err != nil || cm == nil {
+		return dst, err
+	}
+
+	decs := NewReadContext(true)
+	defer decs.Release()
+	var col record.ColVal
+	var buf []byte
+	for i, seg := range cm.timeMeta().entries {
+		if !tr.Overlaps(cm.timeRange[i].minTime(), cm.timeRange[i].maxTime()) {
+			continue
+		}
+		off, size := seg.offsetSize()
+		data, err := f.ReadData(off, size, &buf, fileops.IO_PRIORITY_LOW_READ)
+		if err != nil {
+			return dst, err
+		}
+		col.Init()
+		if err = appendTimeColumnData(data, &col, decs, false); err != nil {
+			return dst, err
+		}
+		dst = append(dst, col.IntegerValues()...)
+	}
+	return dst, nil
+}
+
 func (f *tsspFile) FileStat() *Trailer {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
@@ -38,6 +38,8 @@ import (
 type SKRPNElement struct {
 	Key   string
 	Value string
+	// Analyzer tokenizes the value if the field is not analyzed by the standard gram tokenizer
+	Analyzer *tokenizer.Analyzer
 }
 
 func NewSKRPNElement(key, value string) *SKRPNElement {
@@ -103,10 +105,12 @@ func (s *MultiFieldFilterReader) getAllHashes(expr []*SKRPNElement) {
 		val := v.Value
 		hashValues := make([]uint64, 0)
 		var currTokenizer tokenizer.Tokenizer
-		if split, ok := s.splitMap[leftV]; ok {
-			currTokenizer = tokenizer.NewSimpleGramTokenizer(split, s.version, s.missSplitIndex[leftV])
-		} else {
+		if split, ok := s.splitMap[leftV]; !ok {
 			return
+		} else if v.Analyzer != nil {
+			currTokenizer = tokenizer.NewAnalyzerTokenizer(v.Analyzer, 0)
+		} else {
+			currTokenizer = tokenizer.NewSimpleGramTokenizer(split, s.version, s.missSplitIndex[leftV])
 		}
 		currTokenizer.InitInput([]byte(val))
 		for currTokenizer.Next() {
@@ -115,8 +119,26 @@ func (s *MultiFieldFilterReader) getAllHashes(expr []*SKRPNElement) {
 			}
 			hashValues = append(hashValues, currTokenizer.CurrentHash())
 		}
-		s.hashes[val] = hashValues
+		if v.Analyzer != nil {
+			s.hashes[analyzedHashKey(leftV, val)] = hashValues
+		} else {
+			s.hashes[val] = hashValues
+		}
+	}
+}
+
+// analyzedHashKey identifies the hashes of a value tokenized by an analyzer, because the same value of
+// the fields with different analyzers has different hashes.
+func analyzedHashKey(key, val string) string {
+	return key + "\x00" + val
+}
+
+func (s *MultiFieldFilterReader) getHashKey(elem *rpn.SKRPNElement) string {
+	val := elem.Value.(string)
+	if key := analyzedHashKey(elem.Key, val); len(s.hashes[key]) > 0 {
+		return key
 	}
+	return val
 }
 
 func (s *MultiFieldFilterReader) StartSpan(span *tracing.Span) {
@@ -245,15 +267,15 @@ func (s *MultilFieldVerticalFilterReader) isExist(blockId int64, elem *rpn.SKRPN
 		}
 	}
 
-	isHit := s.hitExpr(elem.Value.(string))
+	isHit := s.hitExpr(s.getHashKey(elem))
 	if s.span != nil {
 		s.span.Count(VerticalFilterReaderDuration, int64(time.Since(t)))
 	}
 	return isHit, nil
 }
 
-func (s *MultilFieldVerticalFilterReader) hitExpr(val string) bool {
-	hashValues := s.hashes[val]
+func (s *MultilFieldVerticalFilterReader) hitExpr(key string) bool {
+	hashValues := s.hashes[key]
 	if len(hashValues) == 0 {
 		return true
 	}
@@ -393,11 +415,11 @@ func (s *MultiFiledLineFilterReader) isExist(blockId int64, elem *rpn.SKRPNEleme
 		}
 		s.isCached = true
 	}
-	return s.hitExpr(elem.Value.(string)), nil
+	return s.hitExpr(s.getHashKey(elem)), nil
 }
 
-func (s *MultiFiledLineFilterReader) hitExpr(val string) bool {
-	hashValues := s.hashes[val]
+func (s *MultiFiledLineFilterReader) hitExpr(key string) bool {
+	hashValues := s.hashes[key]
 	if len(hashValues) == 0 {
 		return true
 	}
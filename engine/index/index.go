@@ -55,6 +55,9 @@ func GetSchemaIndex(schema record.Schemas, oid uint32, IList []string) []int {
 		schemaIdx, ok := schemaMap[IList[i]]
 		if ok {
 			schemaIdxes = append(schemaIdxes, schemaIdx)
+		} else if oid == uint32(indextype.NGram) {
+			// the n-gram blocks are addressed by the fragment id, so an absent field keeps its position.
+			schemaIdxes = append(schemaIdxes, -1)
 		}
 	}
 	return schemaIdxes
@@ -68,6 +71,8 @@ func NewIndexWriter(dir, msName, dataFilePath, lockPath string, indexType indext
 		return sparseindex.NewBloomFilterFullTextWriter(dir, msName, dataFilePath, lockPath, tokens)
 	case indextype.Set:
 		return sparseindex.NewSetWriter(dir, msName, dataFilePath, lockPath, tokens)
+	case indextype.NGram:
+		return sparseindex.NewNGramWriter(dir, msName, dataFilePath, lockPath, tokens)
 	case indextype.MinMax:
 		return sparseindex.NewMinMaxWriter(dir, msName, dataFilePath, lockPath, tokens)
 	case indextype.Text:
@@ -104,6 +109,12 @@ func (s *IndexWriterBuilder) NewIndexWriters(dir, msName, dataFilePath, lockPath
 		if indexRelation.Oids[i] == uint32(indextype.BloomFilterFullText) {
 			s.fullTextIdx = len(s.indexWriters)
 		}
+		if w, ok := indexWriter.(*sparseindex.NGramWriter); ok {
+			w.SetFields(indexRelation.IndexList[i].IList)
+		}
+		if w, ok := indexWriter.(*sparseindex.FullTextIdxWriter); ok {
+			w.SetAnalyzers(tokenizer.GetFullTextOption(&indexRelation).Tokenizers)
+		}
 		schemaIdx := GetSchemaIndex(schema, indexRelation.Oids[i], indexRelation.IndexList[i].IList)
 		s.schemaIdxes = append(s.schemaIdxes, schemaIdx)
 		s.indexWriters = append(s.indexWriters, indexWriter)
@@ -18,6 +18,7 @@ import (
 	"encoding/binary"
 	"hash/crc32"
 	"os"
+	"path"
 	"testing"
 
 	"github.com/influxdata/influxdb/pkg/testing/assert"
@@ -30,9 +31,11 @@ import (
 	"github.com/openGemini/openGemini/lib/logstore"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/rpn"
+	"github.com/openGemini/openGemini/lib/tokenizer"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBloomFilterFullIndexReader(t *testing.T) {
@@ -155,3 +158,60 @@ func TestReadMultiVerticalFilter(t *testing.T) {
 	ok, err := reader.MayBeInFragment(0)
 	assert.Equal(t, ok, false)
 }
+
+func TestBloomFilterFullTextIndexWithAnalyzer(t *testing.T) {
+	dir := t.TempDir()
+	msName := "logs"
+	dataFilePath := "00000001-0001-00000000"
+	analyzers := tokenizer.NewAnalyzerConfig("cjk_bigram,lowercase", map[string]string{"path": "path_hierarchy"}).String()
+	require.NoError(t, os.MkdirAll(path.Join(dir, msName), 0750))
+
+	schema := record.Schemas{
+		{Name: "content", Type: influx.Field_Type_String},
+		{Name: "path", Type: influx.Field_Type_String},
+		{Name: "time", Type: influx.Field_Type_Int},
+	}
+	rec := record.NewRecord(schema, false)
+	contents := [][2]string{{"数据库连接失败 ERROR", "/var/log/app.log"}, {"getUserName ok", "/tmp/a"}}
+	for i := range contents {
+		rec.ColVals[0].AppendString(contents[i][0])
+		rec.ColVals[1].AppendString(contents[i][1])
+		rec.ColVals[2].AppendInteger(int64(i))
+	}
+	writer := sparseindex.NewBloomFilterFullTextWriter(dir, msName, dataFilePath, "", tokenizer.CONTENT_SPLITTER)
+	writer.SetAnalyzers(analyzers)
+	require.NoError(t, writer.CreateAttachIndex(rec, []int{0, 1}, []int{1, 2}))
+	name := sparseindex.GetFullTextAttachFilePath(dir, msName, dataFilePath)
+	if _, err := os.Stat(name + ".init"); err == nil {
+		name += ".init"
+	}
+	// the name read by the line filter reader
+	require.NoError(t, os.Rename(name, path.Join(dir, msName, dataFilePath+"."+sparseindex.BloomFilterFilePrefix+sparseindex.FullTextIndex+colstore.BloomFilterIndexFileSuffix)))
+
+	ir := &influxql.IndexRelation{
+		IndexNames:   []string{index.BloomFilterFullTextIndex},
+		Oids:         []uint32{uint32(index.BloomFilterFullText)},
+		IndexList:    []*influxql.IndexList{{IList: []string{"content", "path"}}},
+		IndexOptions: []*influxql.IndexOptions{{Options: []*influxql.IndexOption{{Tokens: tokenizer.CONTENT_SPLITTER, Tokenizers: analyzers}}}},
+	}
+	dataFile := &MockTssp{path: path.Join(dir, msName, dataFilePath+".tssp")}
+	check := func(cond string, expect []bool) {
+		expr := influxql.MustParseExpr(cond)
+		option := &query.ProcessorOptions{Condition: expr, Sources: []influxql.Source{&influxql.Measurement{Name: msName, IndexRelation: ir}}}
+		reader, err := sparseindex.NewBloomFilterFullTextIndexReader(rpn.ConvertToRPNExpr(expr), schema[:2], option, false)
+		require.NoError(t, err)
+		require.NoError(t, reader.ReInit(dataFile))
+		for i := range expect {
+			ok, err := reader.MayBeInFragment(uint32(i))
+			require.NoError(t, err)
+			require.Equal(t, expect[i], ok, "%s fragment %d", cond, i)
+		}
+	}
+	check("content MATCHPHRASE '连接失败'", []bool{true, false})
+	check("content MATCHPHRASE 'error'", []bool{true, false})
+	check("content MATCHPHRASE 'getusername'", []bool{false, true})
+	check("path MATCHPHRASE '/var/log'", []bool{true, false})
+	check("path MATCHPHRASE '/tmp'", []bool{false, true})
+	// the fields are analyzed differently, the full text predicate does not prune the fragments
+	check("__log___ MATCHPHRASE 'nothing'", []bool{true, true})
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/openGemini/openGemini/lib/rpn"
 	"github.com/openGemini/openGemini/lib/tokenizer"
 	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/logparser"
 )
 
@@ -88,22 +89,37 @@ func (r *BloomFilterFullTextIndexReader) ReInit(file interface{}) (err error) {
 	}
 	splitMap := make(map[string][]byte)
 	expr := make([]*bloomfilter.SKRPNElement, 0, len(r.schema))
-	var tokensTable []byte
+	var fullTextOption *influxql.IndexOption
 	measurements := r.option.GetMeasurements()
 	if len(measurements) == 0 {
-		tokensTable = tokenizer.GetFullTextOption(nil).TokensTable
+		fullTextOption = tokenizer.GetFullTextOption(nil)
 	} else {
-		ir := measurements[0].IndexRelation
-		tokensTable = tokenizer.GetFullTextOption(ir).TokensTable
+		fullTextOption = tokenizer.GetFullTextOption(measurements[0].IndexRelation)
 		for _, v := range r.schema {
-			splitMap[v.Name] = tokensTable
+			splitMap[v.Name] = fullTextOption.TokensTable
 		}
 	}
-	splitMap[logparser.DefaultFieldForFullText] = tokensTable
+	splitMap[logparser.DefaultFieldForFullText] = fullTextOption.TokensTable
+	fields := make([]string, 0, len(r.schema))
+	for _, v := range r.schema {
+		fields = append(fields, v.Name)
+	}
 	for _, elem := range r.sk.(*SKConditionImpl).rpn {
-		if elem.RPNOp == rpn.InRange || elem.RPNOp == rpn.NotInRange {
-			expr = append(expr, bloomfilter.NewSKRPNElement(elem.Key, elem.Value.(string)))
+		if elem.RPNOp != rpn.InRange && elem.RPNOp != rpn.NotInRange {
+			continue
+		}
+		skElem := bloomfilter.NewSKRPNElement(elem.Key, elem.Value.(string))
+		if elem.Key == logparser.DefaultFieldForFullText {
+			analyzer, ok := tokenizer.GetFieldsAnalyzer(fullTextOption, fields)
+			if !ok {
+				// the fields are analyzed differently, the element keeps all the fragments.
+				continue
+			}
+			skElem.Analyzer = analyzer
+		} else {
+			skElem.Analyzer = tokenizer.GetFieldAnalyzer(fullTextOption, elem.Key)
 		}
+		expr = append(expr, skElem)
 	}
 	if f, ok := file.(*OBSFilterPath); ok {
 		fileName := BloomFilterFilePrefix + FullTextIndex + BloomFilterFileSuffix
@@ -157,6 +173,22 @@ func NewBloomFilterFullTextWriter(dir, msName, dataFilePath, lockPath string, to
 	}
 }
 
+// SetAnalyzers sets the analyzers of the full text fields, which is IndexOption.Tokenizers.
+func (f *FullTextIdxWriter) SetAnalyzers(analyzers string) {
+	f.fullTextAnalyzers = analyzers
+}
+
+func (f *FullTextIdxWriter) getFieldTokenizer(gramTokenizer tokenizer.Tokenizer, field string) tokenizer.Tokenizer {
+	if tokenizer.IsStandardAnalyzer(f.fullTextAnalyzers) {
+		return gramTokenizer
+	}
+	option := &influxql.IndexOption{Tokens: f.fullTextTokens, Tokenizers: f.fullTextAnalyzers}
+	if analyzer := tokenizer.GetFieldAnalyzer(option, field); analyzer != nil {
+		return tokenizer.NewAnalyzerTokenizer(analyzer, 0)
+	}
+	return gramTokenizer
+}
+
 func (f *FullTextIdxWriter) Open() error {
 	return nil
 }
@@ -205,11 +237,15 @@ func (f *FullTextIdxWriter) genFullTextIndexData(writeRec *record.Record, schema
 		return res
 	}
 	row, col := len(colsData), len(colsData[0])
+	tks := make([]tokenizer.Tokenizer, row)
+	for j := range tks {
+		tks[j] = f.getFieldTokenizer(tk, writeRec.Schema[schemaIdx[j]].Name)
+	}
 	for i := 0; i < col; i++ {
 		end = start + segBfSize
 		for j := 0; j < row; j++ {
 			offs, lens := colsData[j][i].GetOffsAndLens()
-			tk.ProcessTokenizerBatch(colsData[j][i].Val, res[start:end-crcSize], offs, lens)
+			tks[j].ProcessTokenizerBatch(colsData[j][i].Val, res[start:end-crcSize], offs, lens)
 		}
 		crc := crc32.Checksum(res[start:end-crcSize], logstore.Table)
 		binary.LittleEndian.PutUint32(res[end-crcSize:end], crc)
@@ -66,7 +66,8 @@ func (kc *KeyConditionImpl) convertToRPNElem(
 				kc.rpn = append(kc.rpn, &RPNElement{op: rpn.AND})
 			case influxql.OR:
 				kc.rpn = append(kc.rpn, &RPNElement{op: rpn.OR})
-			case influxql.EQ, influxql.LT, influxql.LTE, influxql.GT, influxql.GTE, influxql.NEQ, influxql.MATCHPHRASE:
+			case influxql.EQ, influxql.LT, influxql.LTE, influxql.GT, influxql.GTE, influxql.NEQ, influxql.MATCHPHRASE,
+				influxql.LIKE, influxql.EQREGEX, influxql.NEQREGEX:
 			default:
 				return errno.NewError(errno.ErrRPNOp, v)
 			}
@@ -88,10 +89,15 @@ func (kc *KeyConditionImpl) convertToRPNElem(
 			if !ok {
 				return errno.NewError(errno.ErrRPNElemOp)
 			}
+			// the primary key ranges can not be derived from a pattern.
+			if rpn.IsPatternOp(op) {
+				kc.rpn = append(kc.rpn, &RPNElement{op: rpn.AlwaysTrue})
+				continue
+			}
 			if err := kc.genRPNElementByVal(value, op, cols, idx); err != nil {
 				return err
 			}
-		case *influxql.StringLiteral, *influxql.NumberLiteral, *influxql.IntegerLiteral, *influxql.BooleanLiteral:
+		case *influxql.StringLiteral, *influxql.NumberLiteral, *influxql.IntegerLiteral, *influxql.BooleanLiteral, *influxql.RegexLiteral:
 		default:
 			return errno.NewError(errno.ErrRPNExpr, v)
 		}
@@ -519,7 +525,8 @@ func (c *SKConditionImpl) convertToRPNElem(rpnExpr *rpn.RPNExpr) error {
 				c.rpn = append(c.rpn, &rpn.SKRPNElement{RPNOp: rpn.AND})
 			case influxql.OR:
 				c.rpn = append(c.rpn, &rpn.SKRPNElement{RPNOp: rpn.OR})
-			case influxql.EQ, influxql.LT, influxql.LTE, influxql.GT, influxql.GTE, influxql.NEQ, influxql.MATCHPHRASE:
+			case influxql.EQ, influxql.LT, influxql.LTE, influxql.GT, influxql.GTE, influxql.NEQ, influxql.MATCHPHRASE,
+				influxql.LIKE, influxql.EQREGEX, influxql.NEQREGEX:
 			default:
 				return errno.NewError(errno.ErrRPNOp, v)
 			}
@@ -546,7 +553,7 @@ func (c *SKConditionImpl) convertToRPNElem(rpnExpr *rpn.RPNExpr) error {
 			if err := c.genRPNElementByVal(v.Val, value, op); err != nil {
 				return err
 			}
-		case *influxql.StringLiteral, *influxql.NumberLiteral, *influxql.IntegerLiteral, *influxql.BooleanLiteral:
+		case *influxql.StringLiteral, *influxql.NumberLiteral, *influxql.IntegerLiteral, *influxql.BooleanLiteral, *influxql.RegexLiteral:
 		default:
 			return errno.NewError(errno.ErrRPNExpr, v)
 		}
@@ -568,6 +575,9 @@ func (c *SKConditionImpl) genRPNElementByFullText(key string, value interface{},
 
 func (c *SKConditionImpl) genRPNElementByVal(key string, value interface{}, op influxql.Token) error {
 	e := &rpn.SKRPNElement{RPNOp: rpn.InRange, Key: key, Op: op}
+	if rpn.IsPatternOp(op) {
+		e.RPNOp = rpn.InPattern
+	}
 	switch val := value.(type) {
 	case *influxql.StringLiteral:
 		e.Value = val.Val
@@ -581,6 +591,9 @@ func (c *SKConditionImpl) genRPNElementByVal(key string, value interface{}, op i
 	case *influxql.BooleanLiteral:
 		e.Value = val.Val
 		e.Ty = influxql.Boolean
+	case *influxql.RegexLiteral:
+		e.Value = val.Val
+		e.Ty = influxql.String
 	default:
 		return errno.NewError(errno.ErrRPNElement, value)
 	}
@@ -598,6 +611,17 @@ func (c *SKConditionImpl) IsExist(blockId int64, reader rpn.SKBaseReader) (bool,
 				return false, err
 			}
 			c.rpnStack = append(c.rpnStack, ok)
+		case rpn.InPattern:
+			pr, ok := reader.(rpn.SKPatternReader)
+			if !ok {
+				c.rpnStack = append(c.rpnStack, true)
+				continue
+			}
+			ok, err := pr.IsPatternExist(blockId, elem)
+			if err != nil {
+				return false, err
+			}
+			c.rpnStack = append(c.rpnStack, ok)
 		case rpn.AlwaysTrue:
 			c.rpnStack = append(c.rpnStack, true)
 		case rpn.AlwaysFalse:
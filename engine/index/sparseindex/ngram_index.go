@@ -0,0 +1,490 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparseindex
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/engine/immutable/colstore"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/index"
+	"github.com/openGemini/openGemini/lib/logstore"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/rpn"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/logparser"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// The n-gram index file of a field is a sequence of fixed size blocks, one block per fragment:
+//
+//	| bloom filter of the lowercased n-grams(NGramFilterSize) | crc(4B) |
+//
+// the fixed block size makes the block of a fragment addressable in the detached files, which every flush appends to.
+const (
+	NGramSize       = 3
+	NGramFilterSize = 16 * 1024
+	NGramFilePrefix = "ngram_" // ngram_${columnName}.idx
+
+	ngramBlockSize = NGramFilterSize + crcSize
+	ngramFilterBit = NGramFilterSize * 8
+	ngramHashNum   = 3
+)
+
+const (
+	NGramIndexCheckedFragment = "ngram_index_checked_fragment"
+	NGramIndexPrunedFragment  = "ngram_index_pruned_fragment"
+)
+
+var _ = RegistrySKFileReaderCreator(uint32(index.NGram), &NGramReaderCreator{})
+
+type NGramReaderCreator struct {
+}
+
+func (index *NGramReaderCreator) CreateSKFileReader(rpnExpr *rpn.RPNExpr, schema record.Schemas, option hybridqp.Options, isCache bool) (SKFileReader, error) {
+	return NewNGramIndexReader(rpnExpr, schema, option, isCache)
+}
+
+func GetNGramFilePath(dir, msName, fieldName string) string {
+	return path.Join(dir, msName, NGramFilePrefix+fieldName+BloomFilterFileSuffix)
+}
+
+// NGramIndexReader prunes the fragments which can not contain every n-gram of the literals required by
+// the =, MATCHPHRASE, LIKE and =~ predicates. The full text predicates are checked against all the fields of the reader.
+type NGramIndexReader struct {
+	isCache bool
+	schema  record.Schemas
+	option  hybridqp.Options
+	sk      SKCondition
+	files   []*ngramFile
+	grams   map[*rpn.SKRPNElement][][]uint64
+	span    *tracing.Span
+}
+
+func NewNGramIndexReader(rpnExpr *rpn.RPNExpr, schema record.Schemas, option hybridqp.Options, isCache bool) (*NGramIndexReader, error) {
+	sk, err := NewSKCondition(rpnExpr, schema)
+	if err != nil {
+		return nil, err
+	}
+	r := &NGramIndexReader{schema: schema, option: option, isCache: isCache, sk: sk,
+		grams: make(map[*rpn.SKRPNElement][][]uint64)}
+	for _, elem := range sk.(*SKConditionImpl).rpn {
+		if elem.RPNOp == rpn.InRange || elem.RPNOp == rpn.InPattern {
+			r.grams[elem] = hashNGramLiterals(requiredLiterals(elem))
+		}
+	}
+	return r, nil
+}
+
+func (r *NGramIndexReader) MayBeInFragment(fragId uint32) (bool, error) {
+	ok, err := r.sk.IsExist(int64(fragId), r)
+	if r.span != nil {
+		r.span.Count(NGramIndexCheckedFragment, 1)
+		if err == nil && !ok {
+			r.span.Count(NGramIndexPrunedFragment, 1)
+		}
+	}
+	return ok, err
+}
+
+// IsExist implements rpn.SKBaseReader.
+func (r *NGramIndexReader) IsExist(blockId int64, elem *rpn.SKRPNElement) (bool, error) {
+	grams := r.grams[elem]
+	if len(grams) == 0 {
+		return true, nil
+	}
+	if elem.Key != logparser.DefaultFieldForFullText {
+		return r.mayContain(r.schema.FieldIndex(elem.Key), blockId, grams)
+	}
+	// the full text predicate is satisfied by any of the fields
+	for i := range r.schema {
+		ok, err := r.mayContain(i, blockId, grams)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// IsPatternExist implements rpn.SKPatternReader.
+func (r *NGramIndexReader) IsPatternExist(blockId int64, elem *rpn.SKRPNElement) (bool, error) {
+	return r.IsExist(blockId, elem)
+}
+
+func (r *NGramIndexReader) mayContain(idx int, blockId int64, grams [][]uint64) (bool, error) {
+	if idx < 0 || idx >= len(r.files) || r.files[idx] == nil {
+		return true, nil
+	}
+	filter, err := r.files[idx].readBlock(blockId)
+	if err != nil || filter == nil {
+		return true, err
+	}
+	for _, literal := range grams {
+		for _, h := range literal {
+			if !ngramFilterContains(filter, h) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+func (r *NGramIndexReader) ReInit(file interface{}) (err error) {
+	r.closeFiles()
+	switch f := file.(type) {
+	case TsspFile:
+		dataPath := f.Path()
+		idx := strings.LastIndex(dataPath, "/")
+		prefix := dataPath[:idx+1] + strings.Split(dataPath[idx+1:], ".")[0]
+		for i := range r.schema {
+			var fd fileops.File
+			fd, err = openNGramFile(colstore.AppendSecondaryIndexSuffix(prefix, r.schema[i].Name, index.NGram, 0), nil)
+			if err != nil {
+				return err
+			}
+			r.files = append(r.files, newNGramFile(fd))
+		}
+	case *OBSFilterPath:
+		for i := range r.schema {
+			var fd fileops.File
+			if f.localPath != "" {
+				fd, err = openNGramFile(GetNGramFilePath(f.localPath, "", r.schema[i].Name), nil)
+			} else {
+				fd, err = openNGramFile(NGramFilePrefix+r.schema[i].Name+BloomFilterFileSuffix, f)
+			}
+			if err != nil {
+				return err
+			}
+			r.files = append(r.files, newNGramFile(fd))
+		}
+	default:
+		return fmt.Errorf("not support file type")
+	}
+	return nil
+}
+
+func (r *NGramIndexReader) Close() error {
+	r.closeFiles()
+	return nil
+}
+
+func (r *NGramIndexReader) closeFiles() {
+	for _, f := range r.files {
+		if f != nil {
+			f.close()
+		}
+	}
+	r.files = r.files[:0]
+}
+
+func (r *NGramIndexReader) StartSpan(span *tracing.Span) {
+	if span == nil {
+		return
+	}
+	r.span = span
+	r.span.CreateCounter(NGramIndexCheckedFragment, "")
+	r.span.CreateCounter(NGramIndexPrunedFragment, "")
+}
+
+// openNGramFile opens the n-gram index file, nil is returned if the file does not exist.
+func openNGramFile(name string, obsPath *OBSFilterPath) (fileops.File, error) {
+	var fd fileops.File
+	var err error
+	if obsPath != nil {
+		fd, err = fileops.OpenObsFile(obsPath.remotePath, name, obsPath.option, true)
+	} else {
+		lock := fileops.FileLockOption("")
+		fd, err = fileops.Open(name, lock)
+	}
+	if err != nil {
+		if os.IsNotExist(err) {
+			// the files written before the index is declared have no n-gram index.
+			return nil, nil
+		}
+		return nil, err
+	}
+	return fd, nil
+}
+
+type ngramFile struct {
+	fd      fileops.File
+	size    int64
+	blockId int64
+	buf     []byte
+}
+
+func newNGramFile(fd fileops.File) *ngramFile {
+	if fd == nil {
+		return nil
+	}
+	return &ngramFile{fd: fd, size: -1, blockId: -1}
+}
+
+// readBlock returns the bloom filter of the fragment, nil is returned if the fragment is out of the file.
+func (f *ngramFile) readBlock(blockId int64) ([]byte, error) {
+	if blockId == f.blockId {
+		return f.buf[:NGramFilterSize], nil
+	}
+	if f.size < 0 {
+		size, err := f.fd.Size()
+		if err != nil {
+			return nil, err
+		}
+		f.size = size
+	}
+	offset := blockId * ngramBlockSize
+	if blockId < 0 || offset+ngramBlockSize > f.size {
+		return nil, nil
+	}
+	if cap(f.buf) < ngramBlockSize {
+		f.buf = make([]byte, ngramBlockSize)
+	}
+	f.buf = f.buf[:ngramBlockSize]
+	if _, err := f.fd.ReadAt(f.buf, offset); err != nil {
+		return nil, err
+	}
+	if crc32.Checksum(f.buf[:NGramFilterSize], logstore.Table) != binary.LittleEndian.Uint32(f.buf[NGramFilterSize:]) {
+		return nil, fmt.Errorf("ngram index block crc mismatch, file: %s, block: %d", f.fd.Name(), blockId)
+	}
+	f.blockId = blockId
+	return f.buf[:NGramFilterSize], nil
+}
+
+func (f *ngramFile) close() {
+	_ = f.fd.Close()
+}
+
+// requiredLiterals returns the literals which must be contained by a value matching the predicate.
+func requiredLiterals(elem *rpn.SKRPNElement) []string {
+	switch elem.Op {
+	case influxql.EQ:
+		if v, ok := elem.Value.(string); ok {
+			return []string{v}
+		}
+	case influxql.MATCHPHRASE:
+		// the phrase is matched by tokens, the separators between them are not required.
+		if v, ok := elem.Value.(string); ok {
+			return strings.FieldsFunc(v, func(c rune) bool {
+				return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+			})
+		}
+	case influxql.LIKE:
+		if v, ok := elem.Value.(string); ok {
+			return strings.FieldsFunc(v, func(c rune) bool {
+				return c == '%' || c == '_'
+			})
+		}
+	case influxql.EQREGEX:
+		if v, ok := elem.Value.(*regexp.Regexp); ok {
+			return RegexpRequiredLiterals(v.String())
+		}
+	}
+	return nil
+}
+
+// RegexpRequiredLiterals returns the literals which every match of the regular expression contains.
+func RegexpRequiredLiterals(expr string) []string {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	return appendRegexpLiterals(nil, re.Simplify())
+}
+
+func appendRegexpLiterals(dst []string, re *syntax.Regexp) []string {
+	switch re.Op {
+	case syntax.OpLiteral:
+		return append(dst, string(re.Rune))
+	case syntax.OpCapture, syntax.OpPlus:
+		return appendRegexpLiterals(dst, re.Sub[0])
+	case syntax.OpRepeat:
+		if re.Min > 0 {
+			return appendRegexpLiterals(dst, re.Sub[0])
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			dst = appendRegexpLiterals(dst, sub)
+		}
+	}
+	return dst
+}
+
+// hashNGramLiterals hashes the n-grams of the lowercased literals, the literals shorter than NGramSize are ignored.
+func hashNGramLiterals(literals []string) [][]uint64 {
+	var res [][]uint64
+	for _, literal := range literals {
+		v := strings.ToLower(literal)
+		if len(v) < NGramSize {
+			continue
+		}
+		hashes := make([]uint64, 0, len(v)-NGramSize+1)
+		for i := 0; i+NGramSize <= len(v); i++ {
+			hashes = append(hashes, xxhash.Sum64String(v[i:i+NGramSize]))
+		}
+		res = append(res, hashes)
+	}
+	return res
+}
+
+func ngramFilterContains(filter []byte, h uint64) bool {
+	h1, h2 := uint32(h), uint32(h>>32)
+	for i := uint32(0); i < ngramHashNum; i++ {
+		bit := (h1 + i*h2) % ngramFilterBit
+		if filter[bit>>3]&(1<<(bit&7)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func ngramFilterAdd(filter []byte, h uint64) {
+	h1, h2 := uint32(h), uint32(h>>32)
+	for i := uint32(0); i < ngramHashNum; i++ {
+		bit := (h1 + i*h2) % ngramFilterBit
+		filter[bit>>3] |= 1 << (bit & 7)
+	}
+}
+
+type NGramWriter struct {
+	*skipIndexWriter
+	fields []string
+}
+
+func NewNGramWriter(dir, msName, dataFilePath, lockPath string, token string) *NGramWriter {
+	return &NGramWriter{
+		skipIndexWriter: newSkipIndexWriter(dir, msName, dataFilePath, lockPath, token),
+	}
+}
+
+// SetFields sets the indexed fields, a negative schema index in CreateDetachIndex refers to the absent field at the same position.
+func (w *NGramWriter) SetFields(fields []string) {
+	w.fields = fields
+}
+
+func (w *NGramWriter) Open() error {
+	return nil
+}
+
+func (w *NGramWriter) Close() error {
+	return nil
+}
+
+func (w *NGramWriter) getSkipIndexFilePath(fieldName string, detached bool) string {
+	if detached {
+		return GetNGramFilePath(w.dir, w.msName, fieldName)
+	}
+	return path.Join(w.dir, w.msName, colstore.AppendSecondaryIndexSuffix(w.dataFilePath, fieldName, index.NGram, 0)+tmpFileSuffix)
+}
+
+func (w *NGramWriter) CreateAttachIndex(writeRec *record.Record, schemaIdx, rowsPerSegment []int) error {
+	for _, i := range schemaIdx {
+		if i < 0 {
+			continue
+		}
+		data := GenNGramIndexData(&writeRec.ColVals[i], rowsPerSegment, writeRec.Schema[i].Type)
+		if err := writeSkipIndexToDisk(data, w.lockPath, w.getSkipIndexFilePath(writeRec.Schema[i].Name, false)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateDetachIndex appends the blocks of every indexed field, the absent fields get the blocks keeping all the fragments.
+func (w *NGramWriter) CreateDetachIndex(writeRec *record.Record, schemaIdx, rowsPerSegment []int, dataBuf [][]byte) ([][]byte, []string) {
+	skipIndexFilePaths := make([]string, len(schemaIdx))
+	for len(dataBuf) < len(schemaIdx) {
+		dataBuf = append(dataBuf, nil)
+	}
+	for k, v := range schemaIdx {
+		var fieldName string
+		if v >= 0 {
+			fieldName = writeRec.Schema[v].Name
+			dataBuf[k] = append(dataBuf[k], GenNGramIndexData(&writeRec.ColVals[v], rowsPerSegment, writeRec.Schema[v].Type)...)
+		} else if k < len(w.fields) {
+			fieldName = w.fields[k]
+			dataBuf[k] = appendFullNGramBlocks(dataBuf[k], len(rowsPerSegment))
+		} else {
+			continue
+		}
+		skipIndexFilePaths[k] = w.getSkipIndexFilePath(fieldName, true)
+	}
+	return dataBuf, skipIndexFilePaths
+}
+
+// GenNGramIndexData generates one n-gram block per fragment of the column, the non-string columns keep all the fragments.
+func GenNGramIndexData(src *record.ColVal, rowsPerSegment []int, refType int) []byte {
+	if refType != influx.Field_Type_String && refType != influx.Field_Type_Tag {
+		return appendFullNGramBlocks(nil, len(rowsPerSegment))
+	}
+	var segCol []record.ColVal
+	segCol = src.SplitColBySize(segCol, rowsPerSegment, refType)
+	res := make([]byte, len(segCol)*ngramBlockSize)
+	var buf []byte
+	for i := range segCol {
+		filter := res[i*ngramBlockSize : i*ngramBlockSize+NGramFilterSize]
+		col := &segCol[i]
+		for j := 0; j < col.Len; j++ {
+			v, isNil := col.BytesUnsafe(j)
+			if isNil || len(v) < NGramSize {
+				continue
+			}
+			buf = appendLower(buf[:0], v)
+			for k := 0; k+NGramSize <= len(buf); k++ {
+				ngramFilterAdd(filter, xxhash.Sum64(buf[k:k+NGramSize]))
+			}
+		}
+		binary.LittleEndian.PutUint32(res[i*ngramBlockSize+NGramFilterSize:], crc32.Checksum(filter, logstore.Table))
+	}
+	return res
+}
+
+func appendFullNGramBlocks(dst []byte, count int) []byte {
+	filter := bytes.Repeat([]byte{0xff}, NGramFilterSize)
+	crc := crc32.Checksum(filter, logstore.Table)
+	for i := 0; i < count; i++ {
+		dst = append(dst, filter...)
+		dst = binary.LittleEndian.AppendUint32(dst, crc)
+	}
+	return dst
+}
+
+// appendLower appends the lowercased value, as strings.ToLower does for the literals of the queries.
+func appendLower(dst, src []byte) []byte {
+	for _, c := range src {
+		if c >= utf8.RuneSelf {
+			return append(dst, bytes.ToLower(src)...)
+		}
+	}
+	for _, c := range src {
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+	return dst
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparseindex_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/index"
+	"github.com/openGemini/openGemini/engine/index/sparseindex"
+	indextype "github.com/openGemini/openGemini/lib/index"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/rpn"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func genNGramIndexRecord() *record.Record {
+	schema := record.Schemas{
+		{Name: "content", Type: influx.Field_Type_String},
+		{Name: "time", Type: influx.Field_Type_Int},
+	}
+	rec := record.NewRecord(schema, false)
+	// fragment 0: connection refused
+	// fragment 1: read timeout
+	// fragment 2: null and short values
+	contents := []string{"GET /index.html", "Connection Refused by peer", "read TIMEOUT after 3s", "ok", "", "ab"}
+	for i, content := range contents {
+		if content == "" {
+			rec.ColVals[0].AppendStringNull()
+		} else {
+			rec.ColVals[0].AppendString(content)
+		}
+		rec.ColVals[1].AppendInteger(int64(i))
+	}
+	return rec
+}
+
+func checkNGramIndex(t *testing.T, file interface{}, schema record.Schemas, cond string, expect []bool) {
+	expr, err := influxql.ParseExpr(cond)
+	require.NoError(t, err)
+	option := &query.ProcessorOptions{Condition: expr}
+	reader, err := sparseindex.NewNGramIndexReader(rpn.ConvertToRPNExpr(option.GetCondition()), schema, option, false)
+	require.NoError(t, err)
+	require.NoError(t, reader.ReInit(file))
+	for i := range expect {
+		ok, err := reader.MayBeInFragment(uint32(i))
+		require.NoError(t, err)
+		require.Equal(t, expect[i], ok, "%s fragment %d", cond, i)
+	}
+	// the fragments out of the index are kept
+	ok, err := reader.MayBeInFragment(uint32(len(expect)))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, reader.Close())
+}
+
+func TestNGramIndexWriteAndRead(t *testing.T) {
+	dir := t.TempDir()
+	msName := "cpu"
+	dataFilePath := "00000001-0001-00000000"
+	require.NoError(t, os.MkdirAll(path.Join(dir, msName), 0750))
+
+	rec := genNGramIndexRecord()
+	writer := index.NewIndexWriter(dir, msName, dataFilePath, "", indextype.NGram, "")
+	require.NoError(t, writer.Open())
+	require.NoError(t, writer.CreateAttachIndex(rec, []int{0, -1}, []int{2, 4, 6}))
+	require.NoError(t, writer.Close())
+	name := path.Join(dir, msName, dataFilePath+".content.ngram")
+	require.NoError(t, os.Rename(name+".init", name))
+
+	schema := record.Schemas{{Name: "content", Type: influx.Field_Type_String}}
+	dataFile := &MockTssp{path: path.Join(dir, msName, dataFilePath+".tssp")}
+	check := func(cond string, expect []bool) {
+		checkNGramIndex(t, dataFile, schema, cond, expect)
+	}
+
+	check("content LIKE '%conn%refused%'", []bool{true, false, false})
+	check("content LIKE '%timeout%'", []bool{false, true, false})
+	check("content LIKE '%nothing here%'", []bool{false, false, false})
+	check("content LIKE '%ab%'", []bool{true, true, true})
+	check("content =~ /read.*timeout/", []bool{false, true, false})
+	check("content =~ /read|peer/", []bool{true, true, true})
+	check("content !~ /timeout/", []bool{true, true, true})
+	check("content MATCHPHRASE 'refused by'", []bool{true, false, false})
+	check("content = 'ok'", []bool{true, true, true})
+	check("content = 'read TIMEOUT after 3s'", []bool{false, true, false})
+	check("content LIKE '%timeout%' OR content LIKE '%index%'", []bool{true, true, false})
+	check("content LIKE '%timeout%' AND content LIKE '%index%'", []bool{false, false, false})
+
+	// the file does not exist, all the fragments are kept
+	checkNGramIndex(t, &MockTssp{path: path.Join(dir, msName, "00000002-0001-00000000.tssp")}, schema,
+		"content LIKE '%nothing here%'", []bool{true})
+}
+
+func TestNGramIndexDetached(t *testing.T) {
+	dir := t.TempDir()
+	msName := "logs"
+	require.NoError(t, os.MkdirAll(path.Join(dir, msName), 0750))
+
+	writer := sparseindex.NewNGramWriter(dir, msName, "", "", "")
+	writer.SetFields([]string{"content", "host"})
+	rec := genNGramIndexRecord()
+	// host is absent from the record, its blocks keep all the fragments
+	bufs, paths := writer.CreateDetachIndex(rec, []int{0, -1}, []int{2, 4, 6}, nil)
+	require.Equal(t, 2, len(bufs))
+	require.Equal(t, []string{sparseindex.GetNGramFilePath(dir, msName, "content"), sparseindex.GetNGramFilePath(dir, msName, "host")}, paths)
+	for i := range paths {
+		require.NoError(t, os.WriteFile(paths[i], bufs[i], 0640))
+	}
+
+	schema := record.Schemas{{Name: "content", Type: influx.Field_Type_String}, {Name: "host", Type: influx.Field_Type_String}}
+	file := sparseindex.NewOBSFilterPath("", path.Join(dir, msName), nil)
+	checkNGramIndex(t, file, schema, "content LIKE '%timeout%'", []bool{false, true, false})
+	checkNGramIndex(t, file, schema, "host LIKE '%timeout%'", []bool{true, true, true})
+	checkNGramIndex(t, sparseindex.NewOBSFilterPath(path.Join(dir, msName), "", nil), schema, "content LIKE '%refused%'", []bool{true, false, false})
+
+	// the full text predicates are satisfied by any of the fields
+	fullText := &influxql.BinaryExpr{
+		Op:  influxql.MATCHPHRASE,
+		LHS: &influxql.VarRef{Val: "__log___", Type: influxql.String},
+		RHS: &influxql.StringLiteral{Val: "*timeout*"},
+	}
+	option := &query.ProcessorOptions{Condition: fullText}
+	reader, err := sparseindex.NewNGramIndexReader(rpn.ConvertToRPNExpr(fullText), schema[:1], option, false)
+	require.NoError(t, err)
+	require.NoError(t, reader.ReInit(file))
+	for i, expect := range []bool{false, true, false} {
+		ok, err := reader.MayBeInFragment(uint32(i))
+		require.NoError(t, err)
+		require.Equal(t, expect, ok)
+	}
+	require.NoError(t, reader.Close())
+}
+
+func TestNGramIndexCorrupted(t *testing.T) {
+	dir := t.TempDir()
+	name := sparseindex.GetNGramFilePath(dir, "", "content")
+	data := sparseindex.GenNGramIndexData(&genNGramIndexRecord().ColVals[0], []int{6}, influx.Field_Type_String)
+	data[0]++
+	require.NoError(t, os.WriteFile(name, data, 0640))
+
+	expr := influxql.MustParseExpr("content LIKE '%timeout%'")
+	option := &query.ProcessorOptions{Condition: expr}
+	schema := record.Schemas{{Name: "content", Type: influx.Field_Type_String}}
+	reader, err := sparseindex.NewNGramIndexReader(rpn.ConvertToRPNExpr(expr), schema, option, false)
+	require.NoError(t, err)
+	require.NoError(t, reader.ReInit(sparseindex.NewOBSFilterPath("", dir, nil)))
+	_, err = reader.MayBeInFragment(0)
+	require.Error(t, err)
+	require.Error(t, reader.ReInit(1))
+}
+
+func TestRegexpRequiredLiterals(t *testing.T) {
+	require.Equal(t, []string{"conn", "refused"}, sparseindex.RegexpRequiredLiterals(`^conn.*(refused)+`))
+	// the case folded literals are upper cased by the parser, the n-grams are lowercased anyway
+	require.Equal(t, []string{"TIMEOUT"}, sparseindex.RegexpRequiredLiterals(`(?i)timeout\d?`))
+	require.Nil(t, sparseindex.RegexpRequiredLiterals(`a|b`))
+	require.Nil(t, sparseindex.RegexpRequiredLiterals(`(`))
+}
@@ -15,11 +15,38 @@
 package sparseindex
 
 import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
 	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/engine/immutable/colstore"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/index"
+	"github.com/openGemini/openGemini/lib/logstore"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/rpn"
 	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// The set index file of a field is a sequence of fragment blocks, one block per fragment in the data file:
+//
+//	| payload size(4B) | value count(4B) | value size(4B) | value | ... | crc(4B) |
+//
+// the payload covers the value count and the values. A value count of setOverflowCount means that the
+// fragment has more distinct values than the cardinality limit and its values are not stored.
+const (
+	setOverflowCount = math.MaxUint32
+	setBlockHeadSize = 4
 )
 
 var _ = RegistrySKFileReaderCreator(uint32(index.Set), &SetReaderCreator{})
@@ -31,13 +58,18 @@ func (index *SetReaderCreator) CreateSKFileReader(rpnExpr *rpn.RPNExpr, schema r
 	return NewSetIndexReader(rpnExpr, schema, option, isCache)
 }
 
+// SetIndexReader prunes the fragments whose distinct values can not satisfy the =, IN and != predicates.
+// IN is rewritten into OR-ed = predicates by the parser, so it is evaluated by the SKCondition.
 type SetIndexReader struct {
-	init    bool
 	isCache bool
 	schema  record.Schemas
 	option  hybridqp.Options
 	sk      SKCondition
-	span    *tracing.Span
+	// sets holds the distinct values of each fragment for every field of the schema.
+	// a nil entry means that the field has no set index file, and a nil fragment set means that it is overflowed.
+	sets [][]map[string]struct{}
+	buf  []byte
+	span *tracing.Span
 }
 
 func NewSetIndexReader(rpnExpr *rpn.RPNExpr, schema record.Schemas, option hybridqp.Options, isCache bool) (*SetIndexReader, error) {
@@ -49,18 +81,60 @@ func NewSetIndexReader(rpnExpr *rpn.RPNExpr, schema record.Schemas, option hybri
 }
 
 func (r *SetIndexReader) MayBeInFragment(fragId uint32) (bool, error) {
-	return false, nil
+	return r.sk.IsExist(int64(fragId), r)
+}
+
+// IsExist implements rpn.SKBaseReader.
+func (r *SetIndexReader) IsExist(blockId int64, elem *rpn.SKRPNElement) (bool, error) {
+	idx := r.schema.FieldIndex(elem.Key)
+	if idx < 0 || idx >= len(r.sets) || blockId >= int64(len(r.sets[idx])) {
+		return true, nil
+	}
+	values := r.sets[idx][blockId]
+	if values == nil {
+		return true, nil
+	}
+
+	var ok bool
+	r.buf, ok = appendSetValue(r.buf[:0], elem.Value, r.schema[idx].Type)
+	if !ok {
+		return true, nil
+	}
+	_, contains := values[string(r.buf)]
+	switch elem.Op {
+	case influxql.EQ:
+		return contains, nil
+	case influxql.NEQ:
+		// the null values do not match !=, so only a fragment holding nothing but the value is pruned.
+		return !(contains && len(values) == 1), nil
+	default:
+		return true, nil
+	}
 }
 
 func (r *SetIndexReader) ReInit(file interface{}) (err error) {
-	if !r.init {
-		r.init = true
-		return
+	r.sets = r.sets[:0]
+	f, ok := file.(TsspFile)
+	if !ok {
+		// the detached files have no set index, all the fragments are kept.
+		return nil
+	}
+
+	dataPath := f.Path()
+	idx := strings.LastIndex(dataPath, "/")
+	prefix := dataPath[:idx+1] + strings.Split(dataPath[idx+1:], ".")[0]
+	for i := range r.schema {
+		sets, err := readSetIndexFile(colstore.AppendSecondaryIndexSuffix(prefix, r.schema[i].Name, index.Set, 0))
+		if err != nil {
+			return err
+		}
+		r.sets = append(r.sets, sets)
 	}
-	return
+	return nil
 }
 
 func (r *SetIndexReader) Close() error {
+	r.sets = nil
 	return nil
 }
 
@@ -68,13 +142,116 @@ func (r *SetIndexReader) StartSpan(span *tracing.Span) {
 	r.span = span
 }
 
+func readSetIndexFile(fileName string) ([]map[string]struct{}, error) {
+	lock := fileops.FileLockOption("")
+	data, err := fileops.ReadFile(fileName, lock)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// the files written before the index is declared, or compacted by block, have no set index.
+			return nil, nil
+		}
+		return nil, err
+	}
+	return UnmarshalSetIndex(data)
+}
+
+// UnmarshalSetIndex decodes the fragment sets of a set index file.
+func UnmarshalSetIndex(data []byte) ([]map[string]struct{}, error) {
+	var sets []map[string]struct{}
+	for len(data) > 0 {
+		if len(data) < setBlockHeadSize {
+			return nil, fmt.Errorf("too small set index block: %d", len(data))
+		}
+		size := int(binary.LittleEndian.Uint32(data))
+		data = data[setBlockHeadSize:]
+		if size < util.Uint32SizeBytes || len(data) < size+crcSize {
+			return nil, fmt.Errorf("invalid set index block size %d, remain %d", size, len(data))
+		}
+		payload := data[:size]
+		if crc32.Checksum(payload, logstore.Table) != binary.LittleEndian.Uint32(data[size:]) {
+			return nil, fmt.Errorf("set index block crc mismatch")
+		}
+		data = data[size+crcSize:]
+
+		count := binary.LittleEndian.Uint32(payload)
+		payload = payload[util.Uint32SizeBytes:]
+		if count == setOverflowCount {
+			sets = append(sets, nil)
+			continue
+		}
+		values := make(map[string]struct{}, count)
+		for i := uint32(0); i < count; i++ {
+			if len(payload) < util.Uint32SizeBytes {
+				return nil, fmt.Errorf("too small set index value")
+			}
+			n := int(binary.LittleEndian.Uint32(payload))
+			payload = payload[util.Uint32SizeBytes:]
+			if len(payload) < n {
+				return nil, fmt.Errorf("too small set index value: %d < %d", len(payload), n)
+			}
+			values[string(payload[:n])] = struct{}{}
+			payload = payload[n:]
+		}
+		sets = append(sets, values)
+	}
+	return sets, nil
+}
+
+// appendSetValue appends the binary form of the value as it is stored for a column of the given type.
+// false is returned if the value can not be represented by the type.
+func appendSetValue(dst []byte, value interface{}, typ int) ([]byte, bool) {
+	switch typ {
+	case influx.Field_Type_String, influx.Field_Type_Tag:
+		v, ok := value.(string)
+		if !ok {
+			return dst, false
+		}
+		return append(dst, v...), true
+	case influx.Field_Type_Int:
+		switch v := value.(type) {
+		case int64:
+			return binary.LittleEndian.AppendUint64(dst, uint64(v)), true
+		case float64:
+			if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+				return dst, false
+			}
+			return binary.LittleEndian.AppendUint64(dst, uint64(int64(v))), true
+		}
+	case influx.Field_Type_Float:
+		switch v := value.(type) {
+		case float64:
+			return appendSetFloat(dst, v), true
+		case int64:
+			return appendSetFloat(dst, float64(v)), true
+		}
+	case influx.Field_Type_Boolean:
+		if v, ok := value.(bool); ok {
+			if v {
+				return append(dst, 1), true
+			}
+			return append(dst, 0), true
+		}
+	}
+	return dst, false
+}
+
+func appendSetFloat(dst []byte, v float64) []byte {
+	if v == 0 {
+		// +0 and -0 are equal
+		v = 0
+	}
+	return binary.LittleEndian.AppendUint64(dst, math.Float64bits(v))
+}
+
 type SetWriter struct {
 	*skipIndexWriter
+	maxCardinality int
 }
 
 func NewSetWriter(dir, msName, dataFilePath, lockPath string, token string) *SetWriter {
 	return &SetWriter{
-		newSkipIndexWriter(dir, msName, dataFilePath, lockPath, token),
+		skipIndexWriter: newSkipIndexWriter(dir, msName, dataFilePath, lockPath, token),
+		maxCardinality:  config.GetIndexConfig().SetIndexMaxCardinality,
 	}
 }
 
@@ -86,10 +263,103 @@ func (s *SetWriter) Close() error {
 	return nil
 }
 
+func (s *SetWriter) getSkipIndexFilePath(fieldName string) string {
+	return path.Join(s.dir, s.msName, colstore.AppendSecondaryIndexSuffix(s.dataFilePath, fieldName, index.Set, 0)+tmpFileSuffix)
+}
+
 func (s *SetWriter) CreateAttachIndex(writeRec *record.Record, schemaIdx, rowsPerSegment []int) error {
+	for _, i := range schemaIdx {
+		data := GenSetIndexData(&writeRec.ColVals[i], rowsPerSegment, writeRec.Schema[i].Type, s.maxCardinality)
+		if err := writeSkipIndexToDisk(data, s.lockPath, s.getSkipIndexFilePath(writeRec.Schema[i].Name)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// CreateDetachIndex is a no-op, the set index is only built for the attached files.
 func (s *SetWriter) CreateDetachIndex(writeRec *record.Record, schemaIdx, rowsPerSegment []int, dataBuf [][]byte) ([][]byte, []string) {
-	return nil, nil
+	return dataBuf, nil
+}
+
+// GenSetIndexData generates one set index block per fragment of the column.
+func GenSetIndexData(src *record.ColVal, rowsPerSegment []int, refType int, maxCardinality int) []byte {
+	var res []byte
+	var segCol []record.ColVal
+	segCol = src.SplitColBySize(segCol, rowsPerSegment, refType)
+
+	values := make(map[string]struct{}, maxCardinality)
+	keys := make([]string, 0, maxCardinality)
+	var buf []byte
+	for i := range segCol {
+		clear(values)
+		overflow := false
+		add := func(v []byte) {
+			if overflow {
+				return
+			}
+			if _, ok := values[string(v)]; ok {
+				return
+			}
+			if len(values) >= maxCardinality {
+				overflow = true
+				return
+			}
+			values[string(v)] = struct{}{}
+		}
+		col := &segCol[i]
+		switch refType {
+		case influx.Field_Type_String, influx.Field_Type_Tag:
+			for j := 0; j < col.Len && !overflow; j++ {
+				v, isNil := col.BytesUnsafe(j)
+				if !isNil {
+					add(v)
+				}
+			}
+		case influx.Field_Type_Int:
+			for _, v := range col.IntegerValues() {
+				buf, _ = appendSetValue(buf[:0], v, refType)
+				add(buf)
+			}
+		case influx.Field_Type_Float:
+			for _, v := range col.FloatValues() {
+				buf, _ = appendSetValue(buf[:0], v, refType)
+				add(buf)
+			}
+		case influx.Field_Type_Boolean:
+			for _, v := range col.BooleanValues() {
+				buf, _ = appendSetValue(buf[:0], v, refType)
+				add(buf)
+			}
+		default:
+			overflow = true
+		}
+
+		keys = keys[:0]
+		if !overflow {
+			for k := range values {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+		}
+		res = appendSetIndexBlock(res, keys, overflow)
+	}
+	return res
+}
+
+func appendSetIndexBlock(dst []byte, keys []string, overflow bool) []byte {
+	start := len(dst)
+	dst = binary.LittleEndian.AppendUint32(dst, 0)
+	if overflow {
+		dst = binary.LittleEndian.AppendUint32(dst, setOverflowCount)
+	} else {
+		dst = binary.LittleEndian.AppendUint32(dst, uint32(len(keys)))
+		for _, k := range keys {
+			dst = binary.LittleEndian.AppendUint32(dst, uint32(len(k)))
+			dst = append(dst, k...)
+		}
+	}
+	payload := dst[start+setBlockHeadSize:]
+	binary.LittleEndian.PutUint32(dst[start:], uint32(len(payload)))
+	return binary.LittleEndian.AppendUint32(dst, crc32.Checksum(payload, logstore.Table))
 }
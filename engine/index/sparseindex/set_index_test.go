@@ -15,6 +15,8 @@
 package sparseindex_test
 
 import (
+	"os"
+	"path"
 	"testing"
 
 	"github.com/openGemini/openGemini/engine/index"
@@ -28,6 +30,7 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSetIndexReader(t *testing.T) {
@@ -42,11 +45,11 @@ func TestSetIndexReader(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	dataFile := "00000001-0001-00000001.tssp"
-	assert.Equal(t, reader.ReInit(dataFile), nil)
+	// the index file does not exist, the fragment is kept
+	assert.Equal(t, reader.ReInit(&MockTssp{path: path.Join(t.TempDir(), "00000001-0001-00000001.tssp")}), nil)
 	ok, err := reader.MayBeInFragment(0)
 	assert.Equal(t, err, nil)
-	assert.Equal(t, ok, false)
+	assert.Equal(t, ok, true)
 	assert.Equal(t, reader.Close(), nil)
 }
 
@@ -73,3 +76,98 @@ func TestSetIndexWriter(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func genSetIndexRecord() *record.Record {
+	schema := record.Schemas{
+		{Name: "region", Type: influx.Field_Type_String},
+		{Name: "value", Type: influx.Field_Type_Int},
+		{Name: "time", Type: influx.Field_Type_Int},
+	}
+	rec := record.NewRecord(schema, false)
+	// fragment 0: region in (a, b), value in (1, 2)
+	// fragment 1: region is always c, value is null or 3
+	// fragment 2: region in (d, e, f), value in (6, 7, 8)
+	regions := []string{"a", "b", "a", "c", "c", "c", "d", "e", "f"}
+	for i, region := range regions {
+		rec.ColVals[0].AppendString(region)
+		switch {
+		case i < 3:
+			rec.ColVals[1].AppendInteger(int64(i%2 + 1))
+		case i == 3:
+			rec.ColVals[1].AppendIntegerNull()
+		case i < 6:
+			rec.ColVals[1].AppendInteger(3)
+		default:
+			rec.ColVals[1].AppendInteger(int64(i))
+		}
+		rec.ColVals[2].AppendInteger(int64(i))
+	}
+	return rec
+}
+
+func TestSetIndexWriteAndRead(t *testing.T) {
+	dir := t.TempDir()
+	msName := "cpu"
+	dataFilePath := "00000001-0001-00000000"
+	require.NoError(t, os.MkdirAll(path.Join(dir, msName), 0750))
+
+	rec := genSetIndexRecord()
+	writer := sparseindex.NewSetWriter(dir, msName, dataFilePath, "", "")
+	require.NoError(t, writer.Open())
+	// the cardinality limit is 2, the third fragment is overflowed
+	data := sparseindex.GenSetIndexData(&rec.ColVals[0], []int{3, 6, 8}, influx.Field_Type_String, 2)
+	sets, err := sparseindex.UnmarshalSetIndex(data)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(sets))
+	require.Equal(t, 2, len(sets[0]))
+	require.Equal(t, 1, len(sets[1]))
+	require.Nil(t, sets[2])
+
+	require.NoError(t, writer.CreateAttachIndex(rec, []int{0, 1}, []int{3, 6, 8}))
+	require.NoError(t, writer.Close())
+	for _, field := range []string{"region", "value"} {
+		name := path.Join(dir, msName, dataFilePath+"."+field+".set")
+		require.NoError(t, os.Rename(name+".init", name))
+	}
+
+	schema := record.Schemas{{Name: "region", Type: influx.Field_Type_String}, {Name: "value", Type: influx.Field_Type_Int}}
+	dataFile := &MockTssp{path: path.Join(dir, msName, dataFilePath+".tssp")}
+	check := func(cond string, expect []bool) {
+		expr, err := influxql.ParseExpr(cond)
+		require.NoError(t, err)
+		option := &query.ProcessorOptions{Condition: expr}
+		reader, err := sparseindex.NewSetIndexReader(rpn.ConvertToRPNExpr(option.GetCondition()), schema, option, false)
+		require.NoError(t, err)
+		require.NoError(t, reader.ReInit(dataFile))
+		for i := range expect {
+			ok, err := reader.MayBeInFragment(uint32(i))
+			require.NoError(t, err)
+			require.Equal(t, expect[i], ok, "%s fragment %d", cond, i)
+		}
+		// the fragments out of the index are kept
+		ok, err := reader.MayBeInFragment(uint32(len(expect)))
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.NoError(t, reader.Close())
+	}
+
+	check("region = 'a'", []bool{true, false, false})
+	check("region = 'z'", []bool{false, false, false})
+	check("region != 'c'", []bool{true, false, true})
+	check("region = 'b' OR region = 'c'", []bool{true, true, false})
+	check("value = 2", []bool{true, false, false})
+	check("value = 2.5", []bool{true, true, true})
+	check("value != 3", []bool{true, false, true})
+	check("region = 'c' AND value = 1", []bool{false, false, false})
+	check("region = 'a' AND value > 1", []bool{true, false, false})
+}
+
+func TestUnmarshalSetIndex_Error(t *testing.T) {
+	data := sparseindex.GenSetIndexData(&genSetIndexRecord().ColVals[0], []int{3}, influx.Field_Type_String, 8)
+	_, err := sparseindex.UnmarshalSetIndex(data[:len(data)-1])
+	require.Error(t, err)
+
+	data[len(data)-1]++
+	_, err = sparseindex.UnmarshalSetIndex(data)
+	require.Error(t, err)
+}
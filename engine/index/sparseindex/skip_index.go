@@ -157,6 +157,10 @@ func (r *SKIndexReaderImpl) getSKInfoByExpr(rpnExpr *rpn.RPNExpr, skIndexRelatio
 			}
 			// TODO: indexName is used to uniquely identify an index.
 			skInfoMap[index.BloomFilterFullTextIndex] = &SkInfo{fields: schemas, oid: uint32(index.BloomFilterFullText)}
+			// the n-gram index prunes the partial tokens only if it covers all the full text fields.
+			if ngramFields := skIndexRelation.GetNGramColumns(); containsAll(ngramFields, fields) {
+				skInfoMap[index.NGramIndex] = &SkInfo{fields: schemas, oid: uint32(index.NGram)}
+			}
 			continue
 		}
 		indexNames, ok := skFieldMap[v.Val]
@@ -178,6 +182,25 @@ func (r *SKIndexReaderImpl) getSKInfoByExpr(rpnExpr *rpn.RPNExpr, skIndexRelatio
 	return skInfoMap, nil
 }
 
+func containsAll(set, elems []string) bool {
+	if len(set) == 0 {
+		return false
+	}
+	for _, e := range elems {
+		found := false
+		for _, v := range set {
+			if v == e {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func (r *SKIndexReaderImpl) createSKFileReaders(skInfoMap map[string]*SkInfo, rpnExpr *rpn.RPNExpr, option hybridqp.Options, isCache bool) ([]SKFileReader, error) {
 	var readers []SKFileReader
 	for _, v := range skInfoMap {
@@ -261,6 +284,7 @@ type skipIndexWriter struct {
 	dir, msName            string
 	dataFilePath, lockPath string
 	fullTextTokens         string
+	fullTextAnalyzers      string
 }
 
 func newSkipIndexWriter(dir, msName, dataFilePath, lockPath string, tokens string) *skipIndexWriter {
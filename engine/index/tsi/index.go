@@ -88,6 +88,36 @@ func (t *TagSetInfo) Cut(idx int) {
 	t.Filters = t.Filters[:idx]
 }
 
+// Retain keeps the series selected by keep in their order, the tags of a simple tag set are kept as they are
+func (t *TagSetInfo) Retain(keep func(id uint64) bool) {
+	simple := len(t.TagsVec) < len(t.IDs)
+	n := 0
+	for i := range t.IDs {
+		if !keep(t.IDs[i]) {
+			continue
+		}
+		t.IDs[n] = t.IDs[i]
+		t.Filters[n] = t.Filters[i]
+		t.SeriesKeys[n] = t.SeriesKeys[i]
+		if !simple {
+			t.TagsVec[n] = t.TagsVec[i]
+		}
+		if t.RowFilters != nil && i < len(t.RowFilters.RowFilters) {
+			t.RowFilters.RowFilters[n] = t.RowFilters.RowFilters[i]
+		}
+		n++
+	}
+	t.IDs = t.IDs[:n]
+	t.Filters = t.Filters[:n]
+	t.SeriesKeys = t.SeriesKeys[:n]
+	if !simple {
+		t.TagsVec = t.TagsVec[:n]
+	}
+	if t.RowFilters != nil && n < len(t.RowFilters.RowFilters) {
+		t.RowFilters.RowFilters = t.RowFilters.RowFilters[:n]
+	}
+}
+
 func NewTagSetInfo() *TagSetInfo {
 	return setPool.getInit(32)
 }
@@ -285,6 +315,7 @@ type Index interface {
 	SearchSeriesKeys(series [][]byte, name []byte, condition influxql.Expr) ([][]byte, error)
 	SearchTagValues(name []byte, tagKeys [][]byte, condition influxql.Expr) ([][]string, error)
 	SearchTagValuesCardinality(name, tagKey []byte) (uint64, error)
+	TagKeysCardinality(name []byte) ([]TagKeyCardinality, error)
 
 	// search
 	GetPrimaryKeys(name []byte, opt *query.ProcessorOptions) ([]uint64, error)
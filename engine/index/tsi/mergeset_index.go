@@ -80,7 +80,6 @@ const (
 	SeriesNumPerTagSetForExcept = 1
 )
 
-var tagFilterKeyGen uint64
 var hitRatioStat = statistics.NewHitRatioStatistics()
 
 var (
@@ -95,12 +94,6 @@ func initQueueSize() {
 	queueSizeMask = queueSize - 1
 }
 
-func invalidateTagCache() {
-	// This function must be fast, since it is called each
-	// time new timeseries is added.
-	atomic.AddUint64(&tagFilterKeyGen, 1)
-}
-
 var kbPool bytesutil.ByteBufferPool
 
 var indexSearchPool sync.Pool
@@ -281,14 +274,21 @@ type MergeSetIndex struct {
 	StorageIndex StorageIndex
 
 	config *config.Index
+
+	// tagFilterGen is the generation of the cached tag filters, it changes when new series are flushed to the index.
+	tagFilterGen uint64
+	tagValues    *tagValueIndex
+
+	seriesCounter seriesCounter
 }
 
 func NewMergeSetIndex(opts *Options) (*MergeSetIndex, error) {
 	ms := &MergeSetIndex{
-		path:   opts.path,
-		lock:   opts.lock,
-		logger: logger.NewLogger(errno.ModuleIndex),
-		config: config.GetIndexConfig(),
+		path:      opts.path,
+		lock:      opts.lock,
+		logger:    logger.NewLogger(errno.ModuleIndex),
+		config:    config.GetIndexConfig(),
+		tagValues: newTagValueIndex(),
 	}
 
 	switch opts.engineType {
@@ -325,7 +325,7 @@ func (idx *MergeSetIndex) Open() error {
 		return fmt.Errorf("cannot open bloom filter at %q: %w", tablePath, err)
 	}
 
-	tb, err := mergeset.OpenTable(tablePath, invalidateTagCache, mergeIndexRows, idx.lock)
+	tb, err := mergeset.OpenTable(tablePath, idx.invalidateTagCache, mergeIndexRows, idx.lock)
 	if err != nil {
 		return fmt.Errorf("cannot open index:%s, err: %+v", tablePath, err)
 	}
@@ -338,6 +338,9 @@ func (idx *MergeSetIndex) Open() error {
 	if err := idx.loadDeletedTSIDs(); err != nil {
 		return err
 	}
+	if err := idx.loadSeriesCount(); err != nil {
+		return err
+	}
 	once.Do(initQueueSize)
 	idx.StorageIndex.initQueues(idx)
 	idx.run()
@@ -346,6 +349,12 @@ func (idx *MergeSetIndex) Open() error {
 	return nil
 }
 
+func (idx *MergeSetIndex) invalidateTagCache() {
+	// This function must be fast, since it is called each
+	// time new timeseries is added.
+	atomic.AddUint64(&idx.tagFilterGen, 1)
+}
+
 func (idx *MergeSetIndex) bloomFilterEnable(tablePath string) (bool, error) {
 	_, err := fileops.Stat(tablePath)
 	if err != nil && !os.IsNotExist(err) {
@@ -466,6 +475,7 @@ func (idx *MergeSetIndex) putIndexSearch(is *indexSearch) {
 	is.mp.Reset()
 	is.vrp.Reset()
 	is.idx = nil
+	is.deleted = nil
 	is.tfs = is.tfs[:0]
 	indexSearchPool.Put(is)
 }
@@ -680,6 +690,9 @@ func (idx *MergeSetIndex) createIndexesIfNotExists(vkey, vname []byte, tags []in
 	if err = idx.indexBuilder.SeriesLimited(); err != nil {
 		return 0, err
 	}
+	if err = idx.reserveSeries(vname); err != nil {
+		return 0, err
+	}
 	// add new series key to mem bf
 	idx.AddNewSeriesKey(vkey)
 
@@ -692,6 +705,9 @@ func (idx *MergeSetIndex) createIndexesIfNotExists(vkey, vname []byte, tags []in
 	}(&tsid)
 
 	tsid, err = idx.createIndexes(vkey, vname, tags, nil, false)
+	if err != nil {
+		idx.releaseSeries(vname)
+	}
 	return tsid, err
 }
 
@@ -724,6 +740,9 @@ func (idx *MergeSetIndex) createIndexesIfNotExistsWithTagArray(vkey, vname []byt
 	if err = idx.indexBuilder.SeriesLimited(); err != nil {
 		return 0, err
 	}
+	if err = idx.reserveSeries(vname); err != nil {
+		return 0, err
+	}
 	// add new series key to mem bf
 	idx.AddNewSeriesKey(combineIndexKey.B)
 
@@ -736,6 +755,9 @@ func (idx *MergeSetIndex) createIndexesIfNotExistsWithTagArray(vkey, vname []byt
 	}(&tsid)
 
 	tsid, err = idx.createIndexes(combineIndexKey.B, vname, tags, dstTagSets.tagsArray, true)
+	if err != nil {
+		idx.releaseSeries(vname)
+	}
 	return tsid, err
 }
 
@@ -800,6 +822,9 @@ func (idx *MergeSetIndex) createIndexes(seriesKey []byte, name []byte, tags []in
 	if err := idx.tb.AddItems(ii.Items); err != nil {
 		return 0, err
 	}
+	for _, item := range ii.Items {
+		idx.tagValues.addTagToTSIDs(item)
+	}
 
 	return tsid, nil
 }
@@ -1308,6 +1333,15 @@ func (idx *MergeSetIndex) SearchTagValuesCardinality(name, tagKey []byte) (uint6
 	return uint64(len(tagValueMap)), nil
 }
 
+// TagKeysCardinality returns the number of the distinct values and of the series of each tag key of the measurement,
+// the deleted series are excluded.
+func (idx *MergeSetIndex) TagKeysCardinality(name []byte) ([]TagKeyCardinality, error) {
+	is := idx.getIndexSearch()
+	defer idx.putIndexSearch(is)
+	is.setDeleted(idx.getDeletedTSIDs())
+	return is.tagKeysCardinality(name)
+}
+
 func (idx *MergeSetIndex) searchSeriesKey(dst []byte, tsid uint64) ([]byte, error) {
 	// fast path, get from cache
 	seriesKey := idx.cache.getFromSeriesKeyCache(dst, tsid)
@@ -1469,6 +1503,7 @@ func (idx *MergeSetIndex) Close() error {
 	if err := idx.cache.close(); err != nil {
 		return err
 	}
+	idx.tagValues.reset()
 
 	idx.isOpen = false
 	return nil
@@ -1482,6 +1517,7 @@ func (idx *MergeSetIndex) ClearCache() error {
 	if err := idx.cache.reset(); err != nil {
 		return err
 	}
+	idx.tagValues.reset()
 
 	return nil
 }
@@ -1549,10 +1585,11 @@ func (idx *MergeSetIndex) DeleteTSIDs(name []byte, condition influxql.Expr, tr T
 		return err
 	}
 
-	return idx.deleteTSIDs(tsids)
+	return idx.deleteTSIDs(name, tsids)
 }
 
-func (idx *MergeSetIndex) deleteTSIDs(tsids []uint64) error {
+// deleteTSIDs deletes the series of the measurement
+func (idx *MergeSetIndex) deleteTSIDs(name []byte, tsids []uint64) error {
 	ii := idxItemsPool.Get()
 	defer idxItemsPool.Put(ii)
 
@@ -1563,6 +1600,7 @@ func (idx *MergeSetIndex) deleteTSIDs(tsids []uint64) error {
 	newDeleted.AddMulti(tsids)
 	idx.deletedTSIDs.Store(newDeleted)
 	idx.deletedTSIDsLock.Unlock()
+	idx.seriesCounter.remove(name, uint64(newDeleted.Len()-curDeleted.Len()))
 
 	for _, tsid := range tsids {
 		ii.B = append(ii.B, nsPrefixDeletedTSIDs)
@@ -1576,6 +1614,10 @@ func (idx *MergeSetIndex) getDeletedTSIDs() *uint64set.Set {
 	return idx.deletedTSIDs.Load().(*uint64set.Set)
 }
 
+func (idx *MergeSetIndex) IsDeletedTSID(tsid uint64) bool {
+	return idx.getDeletedTSIDs().Has(tsid)
+}
+
 func (idx *MergeSetIndex) GetDeletePrimaryKeys(name []byte, condition influxql.Expr, tr TimeRange) ([]uint64, error) {
 	return nil, nil
 }
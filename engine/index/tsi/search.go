@@ -938,8 +938,8 @@ func (is *indexSearch) searchAllTSIDsByName(name []byte, n *influxql.BinaryExpr,
 	return is.genSeriesIDIterator(*tsids, n), nil
 }
 
-func marshalTagFilterKey(dst []byte, tf *tagFilter) []byte {
-	prefix := atomic.LoadUint64(&tagFilterKeyGen)
+func (is *indexSearch) marshalTagFilterKey(dst []byte, tf *tagFilter) []byte {
+	prefix := atomic.LoadUint64(&is.idx.tagFilterGen)
 	dst = encoding.MarshalUint64(dst, prefix)
 	dst = tf.Marshal(dst)
 	return dst
@@ -1005,7 +1005,7 @@ func (is *indexSearch) seriesByBinaryExpr(name []byte, n *influxql.BinaryExpr, t
 	kb := kbPool.Get()
 	defer kbPool.Put(kb)
 
-	kb.B = marshalTagFilterKey(kb.B[:0], tf)
+	kb.B = is.marshalTagFilterKey(kb.B[:0], tf)
 	us := encoding.GetUint64s(1)
 	defer encoding.PutUint64s(us)
 	// Fast path: get series ids from cache
@@ -1191,6 +1191,17 @@ func (is *indexSearch) searchTSIDsByTagFilter(tf *tagFilter) (*uint64set.Set, er
 		return tsids, nil
 	}
 
+	if tf.isRegexp && tf.matchCost >= reMatchCost {
+		// Regexp path - search the tsids of the tag values matched by the tag value index.
+		ok, err := is.updateTSIDsByTagValueIndex(tf, tsids)
+		if err != nil {
+			return nil, fmt.Errorf("error when searching for tsids for tagFilter by tag value index: %w; tagFilter=%s", err, tf)
+		}
+		if ok {
+			return tsids, nil
+		}
+	}
+
 	// Slow path - scan for all the rows with the given prefix.
 	// Pass nil filter to getTSIDsForTagFilterSlow, since it works faster on production workloads
 	// than non-nil filter with many entries.
@@ -1372,6 +1383,29 @@ func (is *indexSearch) updateTSIDsByOrSuffixesOfTagFilter(tf *tagFilter, tsids *
 	return nil
 }
 
+func (is *indexSearch) updateTSIDsByTagValueIndex(tf *tagFilter, tsids *uint64set.Set) (bool, error) {
+	values, ok, err := is.matchTagValues(tf)
+	if !ok || err != nil {
+		return false, err
+	}
+	kb := kbPool.Get()
+	defer kbPool.Put(kb)
+	var matched uint64set.Set
+	for _, value := range values {
+		kb.B = append(kb.B[:0], tf.prefix[:tf.keyPrefixLen]...)
+		kb.B = append(kb.B, value...)
+		kb.B = append(kb.B, tagSeparatorChar)
+		if err = is.updateTSIDsByOrSuffix(kb.B, &matched); err != nil {
+			return false, err
+		}
+	}
+	if is.deleted != nil {
+		matched.Subtract(is.deleted)
+	}
+	tsids.Union(&matched)
+	return true, nil
+}
+
 func (is *indexSearch) updateTSIDsByOrSuffix(prefix []byte, tsids *uint64set.Set) error {
 	ts := &is.ts
 	mp := &is.mp
@@ -1433,6 +1467,88 @@ func (is *indexSearch) getSeriesCount(prefix []byte) (uint64, error) {
 	return seriesCount, nil
 }
 
+// TagKeyCardinality is the number of the distinct values and of the series of a tag key in a measurement
+type TagKeyCardinality struct {
+	Key    string
+	Values uint64
+	Series uint64
+}
+
+func (is *indexSearch) tagKeysCardinality(name []byte) ([]TagKeyCardinality, error) {
+	ts := &is.ts
+	mp := &is.mp
+	mp.Reset()
+
+	prefix := kbPool.Get()
+	defer kbPool.Put(prefix)
+	compositeKey := kbPool.Get()
+	compositeKey.B = marshalCompositeNamePrefix(compositeKey.B[:0], name)
+	prefix.B = append(prefix.B[:0], nsPrefixTagToTSIDs)
+	prefix.B = marshalTagValueNoTrailingTagSeparator(prefix.B, compositeKey.B)
+	kbPool.Put(compositeKey)
+
+	var res []TagKeyCardinality
+	var prevKey, prevValue []byte
+	ts.Seek(prefix.B)
+	for ts.NextItem() {
+		item := ts.Item
+		if !bytes.HasPrefix(item, prefix.B) {
+			break
+		}
+		tail := item[len(prefix.B):]
+		n := bytes.IndexByte(tail, tagSeparatorChar)
+		if n < 0 {
+			return nil, fmt.Errorf("invalid tag->tsids line %q: cannot find tagSeparatorChar %d", item, tagSeparatorChar)
+		}
+		key, escapedKey := tail[:n+1], tail[:n]
+		tail = tail[n+1:]
+		n = bytes.IndexByte(tail, tagSeparatorChar)
+		if n < 0 {
+			return nil, fmt.Errorf("invalid tag->tsids line %q: cannot find tagSeparatorChar %d", item, tagSeparatorChar)
+		}
+		value := tail[:n]
+		tail = tail[n+1:]
+		if len(escapedKey) == 0 {
+			// the item of the measurement
+			continue
+		}
+
+		if err := mp.InitOnlyTail(item, tail); err != nil {
+			return nil, err
+		}
+		series := uint64(mp.TSIDsLen())
+		if is.deleted != nil && is.deleted.Len() > 0 {
+			mp.ParseTSIDs()
+			series = 0
+			for _, tsid := range mp.TSIDs {
+				if !is.deleted.Has(tsid) {
+					series++
+				}
+			}
+		}
+		if series == 0 {
+			continue
+		}
+
+		if len(res) == 0 || !bytes.Equal(key, prevKey) {
+			_, tagKey, err := unmarshalTagValue(nil, key)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, TagKeyCardinality{Key: string(tagKey)})
+			prevKey = append(prevKey[:0], key...)
+			prevValue = prevValue[:0]
+		} else if bytes.Equal(value, prevValue) {
+			res[len(res)-1].Series += series
+			continue
+		}
+		res[len(res)-1].Values++
+		res[len(res)-1].Series += series
+		prevValue = append(prevValue[:0], value...)
+	}
+	return res, ts.Error()
+}
+
 const maxDaysForSearch = 40
 
 func (is *indexSearch) searchTSIDsByTimeRange(name []byte) (*uint64set.Set, error) {
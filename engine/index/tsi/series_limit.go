@@ -0,0 +1,178 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tsi
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// seriesCounter counts the live series of the index and of each measurement for the series limits.
+// The counts are loaded when the index is opened, and are kept up to date as series are created and deleted.
+type seriesCounter struct {
+	mu           sync.Mutex
+	loaded       bool
+	total        uint64
+	measurements map[string]uint64
+}
+
+func (c *seriesCounter) load(total uint64, measurements map[string]uint64) {
+	c.mu.Lock()
+	c.total, c.measurements, c.loaded = total, measurements, true
+	c.mu.Unlock()
+}
+
+func (c *seriesCounter) reserve(db string, name []byte, maxSeries, maxMstSeries uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.loaded {
+		return nil
+	}
+	if maxSeries > 0 && c.total >= maxSeries {
+		return errno.NewError(errno.SeriesLimited, db, maxSeries, c.total)
+	}
+	count := c.measurements[string(name)]
+	if maxMstSeries > 0 && count >= maxMstSeries {
+		return errno.NewError(errno.MeasurementSeriesLimited, influx.GetOriginMstName(string(name)), db, maxMstSeries, count)
+	}
+	c.measurements[string(name)] = count + 1
+	c.total++
+	return nil
+}
+
+// remove uncounts n series of the measurement
+func (c *seriesCounter) remove(name []byte, n uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.loaded {
+		return
+	}
+	c.total -= min(c.total, n)
+	count, ok := c.measurements[string(name)]
+	if !ok {
+		return
+	}
+	if count <= n {
+		delete(c.measurements, string(name))
+		return
+	}
+	c.measurements[string(name)] = count - n
+}
+
+func (idx *MergeSetIndex) getSeriesLimit() (string, uint64, uint64) {
+	if idx.indexBuilder == nil || idx.indexBuilder.ident == nil {
+		return "", 0, 0
+	}
+	db := idx.indexBuilder.ident.OwnerDb
+	maxSeries, maxMstSeries := idx.config.GetSeriesLimit(db)
+	if maxSeries < 0 {
+		maxSeries = 0
+	}
+	if maxMstSeries < 0 {
+		maxMstSeries = 0
+	}
+	return db, uint64(maxSeries), uint64(maxMstSeries)
+}
+
+// loadSeriesCount counts the live series of the index when it is opened, if the series of any database are limited
+func (idx *MergeSetIndex) loadSeriesCount() error {
+	if !idx.config.SeriesLimitEnabled() {
+		return nil
+	}
+	is := idx.getIndexSearch()
+	defer idx.putIndexSearch(is)
+	is.setDeleted(idx.getDeletedTSIDs())
+
+	total, measurements, err := is.liveSeriesCount()
+	if err != nil {
+		return err
+	}
+	idx.seriesCounter.load(total, measurements)
+	return nil
+}
+
+// reserveSeries checks the series limits of the index before a new series of the measurement is created,
+// the series is counted if it is allowed, and it should be released by releaseSeries if the series fails to be created.
+func (idx *MergeSetIndex) reserveSeries(name []byte) error {
+	db, maxSeries, maxMstSeries := idx.getSeriesLimit()
+	if maxSeries == 0 && maxMstSeries == 0 {
+		return nil
+	}
+	return idx.seriesCounter.reserve(db, name, maxSeries, maxMstSeries)
+}
+
+func (idx *MergeSetIndex) releaseSeries(name []byte) {
+	_, maxSeries, maxMstSeries := idx.getSeriesLimit()
+	if maxSeries == 0 && maxMstSeries == 0 {
+		return
+	}
+	idx.seriesCounter.remove(name, 1)
+}
+
+// liveSeriesCount returns the number of the series of the index which are not deleted, and the number of them
+// in each measurement.
+func (is *indexSearch) liveSeriesCount() (uint64, map[string]uint64, error) {
+	ts := &is.ts
+	kb := &is.kb
+	kb.B = append(kb.B[:0], nsPrefixTSIDToKey)
+
+	var total uint64
+	counts := make(map[string]*uint64)
+	var keys [][]byte
+	var err error
+	ts.Seek(kb.B)
+	for ts.NextItem() {
+		item := ts.Item
+		if !bytes.HasPrefix(item, kb.B) {
+			break
+		}
+		tail := item[len(kb.B):]
+		if len(tail) < 8 {
+			return 0, nil, fmt.Errorf("invalid tsid->key line %q: too short", item)
+		}
+		if is.deleted != nil && is.deleted.Has(encoding.UnmarshalUint64(tail)) {
+			continue
+		}
+		keys, _, err = unmarshalCombineIndexKeys(keys, tail[8:])
+		if err != nil {
+			return 0, nil, err
+		}
+		name, _, err := influx.MeasurementName(keys[0])
+		if err != nil {
+			return 0, nil, err
+		}
+		total++
+		if count, ok := counts[string(name)]; ok {
+			*count++
+			continue
+		}
+		count := uint64(1)
+		counts[string(name)] = &count
+	}
+	if err = ts.Error(); err != nil {
+		return 0, nil, err
+	}
+
+	measurements := make(map[string]uint64, len(counts))
+	for name, count := range counts {
+		measurements[name] = *count
+	}
+	return total, measurements, nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tsi
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/savsgio/dictpool"
+	"github.com/stretchr/testify/require"
+)
+
+func createHostSeries(idx *MergeSetIndex, name string, from, to int) (int, error) {
+	pts := make([]influx.Row, 0, to-from)
+	for i := from; i < to; i++ {
+		pt := influx.Row{Name: name, Timestamp: time.Now().UnixNano(), Tags: influx.PointTags{
+			{Key: "host", Value: fmt.Sprintf("host-%03d", i)},
+			{Key: "region", Value: fmt.Sprintf("r%d", i%2)},
+		}}
+		sort.Sort(&pt.Tags)
+		pt.UnmarshalIndexKeys(nil)
+		pt.ShardKey = pt.IndexKey
+		pts = append(pts, pt)
+	}
+	mmPoints := &dictpool.Dict{}
+	mmPoints.Set(name, &pts)
+	err := idx.CreateIndexIfNotExists(mmPoints)
+	idx.DebugFlush()
+
+	created := 0
+	for i := range pts {
+		if pts[i].SeriesId != 0 {
+			created++
+		}
+	}
+	return created, err
+}
+
+func deleteSeriesForTest(t *testing.T, idx *MergeSetIndex, name string, condition influxql.Expr) {
+	tsids, err := idx.searchTSIDs([]byte(name), condition, DefaultTR)
+	require.NoError(t, err)
+	require.NoError(t, idx.deleteTSIDs([]byte(name), tsids))
+}
+
+func TestSeriesLimit(t *testing.T) {
+	defer config.SetIndexConfig(config.GetIndexConfig())
+	conf := *config.GetIndexConfig()
+	config.SetIndexConfig(&conf)
+	// the limits of the database override the default limits
+	conf.BloomFilterEnabled = true
+	conf.MaxMeasurementSeriesPerIndex = 5
+	conf.SeriesLimits = []config.SeriesLimit{{Database: "db0", MaxSeriesPerIndex: 40, MaxMeasurementSeriesPerIndex: 20}}
+
+	dir := t.TempDir()
+	i, idxBuilder := getTestIndexAndBuilder(dir, config.TSSTORE)
+	idx := i.(*MergeSetIndex)
+
+	created, err := createHostSeries(idx, "cpu_0000", 0, 15)
+	require.NoError(t, err)
+	require.Equal(t, 15, created)
+
+	created, err = createHostSeries(idx, "cpu_0000", 10, 30)
+	require.True(t, errno.Equal(err, errno.MeasurementSeriesLimited))
+	require.Equal(t, 10, created)
+	require.Contains(t, err.Error(), "measurement cpu in an index of database db0")
+
+	created, err = createHostSeries(idx, "mem_0000", 0, 20)
+	require.NoError(t, err)
+	require.Equal(t, 20, created)
+
+	created, err = createHostSeries(idx, "disk_0000", 0, 10)
+	require.True(t, errno.Equal(err, errno.SeriesLimited))
+	require.Equal(t, 0, created)
+	// the rejected series are not added to the bloom filter
+	pt := influx.Row{Name: "disk_0000", Tags: influx.PointTags{{Key: "host", Value: "host-000"}, {Key: "region", Value: "r0"}}}
+	pt.UnmarshalIndexKeys(nil)
+	require.False(t, idx.CheckSeriesKeyExist(pt.IndexKey))
+
+	// the series can be created again after series are deleted
+	deleteSeriesForTest(t, idx, "mem_0000", nil)
+	created, err = createHostSeries(idx, "disk_0000", 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 10, created)
+	require.NoError(t, idxBuilder.Close())
+
+	// the series are counted when the index is opened
+	i, idxBuilder = getTestIndexAndBuilder(dir, config.TSSTORE)
+	defer idxBuilder.Close()
+	idx = i.(*MergeSetIndex)
+	require.Equal(t, uint64(30), idx.seriesCounter.total)
+	require.Equal(t, map[string]uint64{"cpu_0000": 20, "disk_0000": 10}, idx.seriesCounter.measurements)
+
+	created, err = createHostSeries(idx, "cpu_0000", 30, 35)
+	require.True(t, errno.Equal(err, errno.MeasurementSeriesLimited))
+	require.Equal(t, 0, created)
+
+	conf.SeriesLimits = nil
+	created, err = createHostSeries(idx, "disk_0000", 10, 20)
+	require.True(t, errno.Equal(err, errno.MeasurementSeriesLimited))
+	require.Equal(t, 0, created)
+}
+
+func TestTagKeysCardinality(t *testing.T) {
+	i, idxBuilder := getTestIndexAndBuilder(t.TempDir(), config.TSSTORE)
+	defer idxBuilder.Close()
+	idx := i.(*MergeSetIndex)
+
+	_, err := createHostSeries(idx, "cpu_0000", 0, 100)
+	require.NoError(t, err)
+	_, err = createHostSeries(idx, "cpu_0001", 0, 10)
+	require.NoError(t, err)
+
+	cardinality, err := idx.TagKeysCardinality([]byte("cpu_0000"))
+	require.NoError(t, err)
+	require.Equal(t, []TagKeyCardinality{{Key: "host", Values: 100, Series: 100}, {Key: "region", Values: 2, Series: 100}}, cardinality)
+
+	deleteSeriesForTest(t, idx, "cpu_0000", MustParseExpr("region='r1'"))
+	cardinality, err = idx.TagKeysCardinality([]byte("cpu_0000"))
+	require.NoError(t, err)
+	require.Equal(t, []TagKeyCardinality{{Key: "host", Values: 50, Series: 50}, {Key: "region", Values: 1, Series: 50}}, cardinality)
+
+	cardinality, err = idx.TagKeysCardinality([]byte("mem_0000"))
+	require.NoError(t, err)
+	require.Equal(t, 0, len(cardinality))
+}
@@ -32,6 +32,9 @@ type tagFilter struct {
 	//  - non-regexp prefix if isRegexp.
 	prefix []byte
 
+	// keyPrefixLen is the length of the prefix of the tag key, the rest of prefix is the non-regexp prefix of the value.
+	keyPrefixLen int
+
 	// or values obtained from regexp suffix if it equals to "foo|bar|..."
 	//
 	// This array is also populated with matching Graphite metrics if key="__graphite__"
@@ -218,6 +221,7 @@ func (tf *tagFilter) Init(name, key, value []byte, isNegative, isRegexp bool) er
 	compositeKey.B = marshalCompositeTagKey(compositeKey.B[:0], name, key)
 	tf.prefix = append(tf.prefix, nsPrefixTagToTSIDs)
 	tf.prefix = marshalTagValue(tf.prefix, compositeKey.B)
+	tf.keyPrefixLen = len(tf.prefix)
 	kbPool.Put(compositeKey)
 
 	var expr []byte
@@ -0,0 +1,622 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tsi
+
+import (
+	"bytes"
+	"container/list"
+	"fmt"
+	"regexp/syntax"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+const (
+	// maxTagValuesPerKey is the max number of the values of a tag key kept by the tag value index,
+	// the regex filters of the tag keys with more values scan the mergeset instead.
+	maxTagValuesPerKey = 1 << 22
+	// maxMatchedCachePerKey is the max number of the regex filters whose matched values are cached per tag key.
+	maxMatchedCachePerKey = 64
+	// maxPendingTagValues is the max number of the values added since the last query,
+	// the values of the tag key are read again if there are more.
+	maxPendingTagValues = 1 << 16
+	// stringHeaderSize is the size of a string header counted in the memory of the values
+	stringHeaderSize = 16
+)
+
+// TagValueIndexMaxSize is the max memory of the values of the tag value indexes of all the mergeset indexes,
+// the values of the tag keys least recently queried are evicted and read again on the next query.
+var TagValueIndexMaxSize int64 = 256 * 1024 * 1024
+
+// tagValueIndex is the secondary index of the regex tag filters. It keeps the sorted distinct values of the tag keys,
+// which are read from the tag->tsids rows of the mergeset by seeking from one value to the next one.
+// The regex filters walk the sorted values as a prefix trie by the automaton of the regexp, so the values sharing a
+// prefix which can not match are skipped at once, and the tsids are searched for the matched values only.
+// The index is kept in memory only, it is not persisted with the mergeset parts. The values of a tag key are read on
+// the first regex filter of the key after a restart or an eviction, which costs one seek per distinct value.
+type tagValueIndex struct {
+	mu   sync.RWMutex
+	keys map[string]*tagValues
+
+	// loaded is the number of the loaded tag keys, the writers skip the index if it is 0.
+	loaded int64
+}
+
+// tagValues are the values of a tag key. The queries read an immutable snapshot of the values, the values added
+// since the snapshot are kept pending and merged into the next snapshot, so the writers never wait for a load.
+type tagValues struct {
+	owner *tagValueIndex
+	key   string
+
+	// loadMu serializes the loads of the snapshots, the concurrent queries share the load.
+	loadMu   sync.Mutex
+	snapshot atomic.Pointer[tagValueSnapshot]
+
+	mu      sync.Mutex
+	pending []string
+	// stale is set if too many values are added since the snapshot, the values are read again.
+	stale bool
+	// tooMany is set if the tag key has more than maxTagValuesPerKey values
+	tooMany bool
+
+	// the fields below are protected by the lock of tagValuesLRU
+	elem    *list.Element
+	size    int64
+	evicted bool
+}
+
+type tagValueSnapshot struct {
+	values []string
+	// added are the sorted distinct values added since the values are read, they are merged into the values once
+	// they grow large, so a new series does not copy all the values of the tag key.
+	added []string
+
+	// gen is the generation of the index when the values are read, the values written before the read may be
+	// invisible until the next flush of the mergeset, so the values are read again once after the generation changes.
+	gen    uint64
+	sealed bool
+
+	mu      sync.Mutex
+	matched map[string][]string
+}
+
+func (s *tagValueSnapshot) memSize() int64 {
+	size := int64(len(s.values)+len(s.added)) * stringHeaderSize
+	for _, v := range s.values {
+		size += int64(len(v))
+	}
+	for _, v := range s.added {
+		size += int64(len(v))
+	}
+	return size
+}
+
+func (s *tagValueSnapshot) getMatched(key string) ([]string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	matched, ok := s.matched[key]
+	return matched, ok
+}
+
+func (s *tagValueSnapshot) putMatched(key string, matched []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.matched == nil || len(s.matched) >= maxMatchedCachePerKey {
+		s.matched = make(map[string][]string)
+	}
+	s.matched[key] = matched
+}
+
+// tagValuesLRU bounds the memory of the values of all the tag value indexes.
+type tagValuesLRU struct {
+	mu      sync.Mutex
+	ll      *list.List
+	size    int64
+	maxSize func() int64
+}
+
+var tagValuesCache = &tagValuesLRU{ll: list.New(), maxSize: func() int64 { return atomic.LoadInt64(&TagValueIndexMaxSize) }}
+
+func (c *tagValuesLRU) touch(tv *tagValues) {
+	c.mu.Lock()
+	if tv.elem != nil {
+		c.ll.MoveToFront(tv.elem)
+	}
+	c.mu.Unlock()
+}
+
+// update updates the memory of the values of the tag key, and evicts the least recently queried tag keys.
+func (c *tagValuesLRU) update(tv *tagValues, size int64) {
+	var evicted []*tagValues
+	c.mu.Lock()
+	if tv.evicted {
+		c.mu.Unlock()
+		return
+	}
+	if tv.elem == nil {
+		tv.elem = c.ll.PushFront(tv)
+	} else {
+		c.ll.MoveToFront(tv.elem)
+	}
+	c.size += size - tv.size
+	tv.size = size
+	for c.size > c.maxSize() {
+		back := c.ll.Back()
+		if back == nil || back == tv.elem {
+			break
+		}
+		old := back.Value.(*tagValues)
+		c.removeLocked(old)
+		evicted = append(evicted, old)
+	}
+	c.mu.Unlock()
+
+	for _, old := range evicted {
+		old.owner.remove(old)
+	}
+}
+
+func (c *tagValuesLRU) remove(tv *tagValues) {
+	c.mu.Lock()
+	c.removeLocked(tv)
+	c.mu.Unlock()
+}
+
+func (c *tagValuesLRU) removeLocked(tv *tagValues) {
+	tv.evicted = true
+	if tv.elem == nil {
+		return
+	}
+	c.ll.Remove(tv.elem)
+	c.size -= tv.size
+	tv.elem, tv.size = nil, 0
+}
+
+func newTagValueIndex() *tagValueIndex {
+	return &tagValueIndex{keys: make(map[string]*tagValues)}
+}
+
+// addTagToTSIDs records the value of the tag->tsids item of a new series if the values of the tag key are loaded.
+func (ti *tagValueIndex) addTagToTSIDs(item []byte) {
+	if atomic.LoadInt64(&ti.loaded) == 0 || len(item) == 0 || item[0] != nsPrefixTagToTSIDs {
+		return
+	}
+	n := bytes.IndexByte(item[1:], tagSeparatorChar)
+	if n < 0 {
+		return
+	}
+	keyPrefix, tail := item[:n+2], item[n+2:]
+	if n = bytes.IndexByte(tail, tagSeparatorChar); n >= 0 {
+		ti.add(keyPrefix, tail[:n])
+	}
+}
+
+func (ti *tagValueIndex) add(keyPrefix, value []byte) {
+	ti.mu.RLock()
+	tv, ok := ti.keys[string(keyPrefix)]
+	ti.mu.RUnlock()
+	if !ok {
+		return
+	}
+	tv.mu.Lock()
+	switch {
+	case tv.tooMany:
+	case len(tv.pending) >= maxPendingTagValues:
+		tv.pending, tv.stale = nil, true
+	default:
+		tv.pending = append(tv.pending, string(value))
+	}
+	tv.mu.Unlock()
+}
+
+func (ti *tagValueIndex) get(keyPrefix []byte) *tagValues {
+	ti.mu.RLock()
+	tv, ok := ti.keys[string(keyPrefix)]
+	ti.mu.RUnlock()
+	if ok {
+		tagValuesCache.touch(tv)
+		return tv
+	}
+
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+	if tv, ok = ti.keys[string(keyPrefix)]; !ok {
+		tv = &tagValues{owner: ti, key: string(keyPrefix)}
+		ti.keys[tv.key] = tv
+		atomic.AddInt64(&ti.loaded, 1)
+	}
+	return tv
+}
+
+// remove removes the values of the tag key evicted from tagValuesCache
+func (ti *tagValueIndex) remove(tv *tagValues) {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+	if ti.keys[tv.key] == tv {
+		delete(ti.keys, tv.key)
+		atomic.AddInt64(&ti.loaded, -1)
+	}
+}
+
+func (ti *tagValueIndex) reset() {
+	ti.mu.Lock()
+	keys := ti.keys
+	ti.keys = make(map[string]*tagValues)
+	atomic.StoreInt64(&ti.loaded, 0)
+	ti.mu.Unlock()
+
+	for _, tv := range keys {
+		tagValuesCache.remove(tv)
+	}
+}
+
+// load returns the snapshot of the sorted values of the tag key, it returns false if the tag key has too many values.
+// The values are read from the mergeset without holding tv.mu, the values added meanwhile are kept pending.
+func (tv *tagValues) load(is *indexSearch, gen uint64) (*tagValueSnapshot, bool, error) {
+	snap := tv.snapshot.Load()
+	tv.mu.Lock()
+	tooMany, fresh := tv.tooMany, snap != nil && len(tv.pending) == 0 && !tv.stale && (snap.sealed || snap.gen == gen)
+	tv.mu.Unlock()
+	if tooMany {
+		return nil, false, nil
+	}
+	if fresh {
+		return snap, true, nil
+	}
+
+	tv.loadMu.Lock()
+	defer tv.loadMu.Unlock()
+	snap = tv.snapshot.Load()
+	tv.mu.Lock()
+	stale := tv.stale
+	tv.stale = false
+	tv.mu.Unlock()
+
+	next := &tagValueSnapshot{}
+	if snap != nil {
+		next.values, next.added, next.gen, next.sealed = snap.values, snap.added, snap.gen, snap.sealed
+	}
+	if snap == nil || stale || (!snap.sealed && snap.gen != gen) {
+		values, err := is.searchTagValuesByPrefix([]byte(tv.key), nil)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(values) > maxTagValuesPerKey {
+			tv.mu.Lock()
+			tv.tooMany, tv.pending = true, nil
+			tv.mu.Unlock()
+			tv.snapshot.Store(nil)
+			tagValuesCache.update(tv, 0)
+			return nil, false, nil
+		}
+		// the values pending before a stale read may be invisible yet, so they are read again after the next flush
+		next.values, next.added, next.gen, next.sealed = values, nil, gen, snap != nil && !stale
+	}
+
+	tv.mu.Lock()
+	pending := tv.pending
+	tv.pending = nil
+	tv.mu.Unlock()
+	if len(pending) > 0 {
+		sort.Strings(pending)
+		next.added = mergeTagValues(next.added, pending)
+		// the values are copied once after every len(values)/8 values added
+		if len(next.added)*8 > len(next.values) {
+			next.values, next.added = mergeTagValues(next.values, next.added), nil
+		}
+	}
+	tv.snapshot.Store(next)
+	tagValuesCache.update(tv, next.memSize())
+	return next, true, nil
+}
+
+// mergeTagValues merges two sorted slices of values into the sorted distinct values.
+func mergeTagValues(values, added []string) []string {
+	merged := make([]string, 0, len(values)+len(added))
+	i, j := 0, 0
+	for i < len(values) || j < len(added) {
+		var v string
+		if j == len(added) || (i < len(values) && values[i] <= added[j]) {
+			v = values[i]
+			i++
+		} else {
+			v = added[j]
+			j++
+		}
+		if len(merged) == 0 || merged[len(merged)-1] != v {
+			merged = append(merged, v)
+		}
+	}
+	return merged
+}
+
+// searchTagValuesByPrefix reads the distinct values of the tag->tsids rows with the prefix,
+// it seeks to the next value instead of reading all the rows of a value.
+func (is *indexSearch) searchTagValuesByPrefix(prefix []byte, dst []string) ([]string, error) {
+	ts := &is.ts
+	kb := &is.kb
+	ts.Seek(prefix)
+	for ts.NextItem() {
+		item := ts.Item
+		if !bytes.HasPrefix(item, prefix) {
+			break
+		}
+		tail := item[len(prefix):]
+		n := bytes.IndexByte(tail, tagSeparatorChar)
+		if n < 0 {
+			return nil, fmt.Errorf("invalid tag->tsids line %q: cannot find tagSeparatorChar=%d", item, tagSeparatorChar)
+		}
+		dst = append(dst, string(tail[:n]))
+		if len(dst) > maxTagValuesPerKey {
+			return dst, nil
+		}
+		kb.B = append(kb.B[:0], item[:len(prefix)+n+1]...)
+		kb.B[len(kb.B)-1]++
+		ts.Seek(kb.B)
+	}
+	if err := ts.Error(); err != nil {
+		return nil, fmt.Errorf("error when searching for tag values with prefix %q: %w", prefix, err)
+	}
+	if dst == nil {
+		dst = []string{}
+	}
+	return dst, nil
+}
+
+// matchTagValues returns the values matching the regex filter, ok is false if the tag value index is not usable.
+func (is *indexSearch) matchTagValues(tf *tagFilter) ([]string, bool, error) {
+	tv := is.idx.tagValues.get(tf.prefix[:tf.keyPrefixLen])
+	snap, ok, err := tv.load(is, atomic.LoadUint64(&is.idx.tagFilterGen))
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	cacheKey := string(tf.value)
+	if matched, ok := snap.getMatched(cacheKey); ok {
+		return matched, true, nil
+	}
+
+	a := newTagValueAutomaton(tf.value)
+	matched := matchSortedTagValues(tf, a, snap.values, nil)
+	if len(snap.added) > 0 {
+		matched = mergeTagValues(matched, matchSortedTagValues(tf, a, snap.added, nil))
+	}
+
+	snap.putMatched(cacheKey, matched)
+	return matched, true, nil
+}
+
+// matchSortedTagValues appends the sorted values matching the regex filter to dst, a is nil if the regexp has no automaton.
+func matchSortedTagValues(tf *tagFilter, a *tagValueAutomaton, values []string, dst []string) []string {
+	valuePrefix := string(tf.prefix[tf.keyPrefixLen:])
+	lo := sort.SearchStrings(values, valuePrefix)
+	hi := lo + sort.Search(len(values)-lo, func(i int) bool {
+		return !strings.HasPrefix(values[lo+i], valuePrefix)
+	})
+
+	check := func(v string) {
+		suffix := append([]byte(v[len(valuePrefix):]), tagSeparatorChar)
+		if ok, _ := tf.matchSuffix(suffix); ok {
+			dst = append(dst, v)
+		}
+	}
+	if a != nil {
+		a.walk(values[lo:hi], check)
+	} else {
+		for _, v := range values[lo:hi] {
+			check(v)
+		}
+	}
+	return dst
+}
+
+// tagValueAutomaton is the automaton of a regexp anchored at the beginning of the tag value.
+// It only prunes the values which can not match, the matched values are checked by the tag filter.
+type tagValueAutomaton struct {
+	prog *syntax.Prog
+
+	// visited marks the instructions added to the current state, 1 for the inexact ones and 2 for the exact ones.
+	visited []uint8
+}
+
+// automatonState is the state after reading the prefix of a value.
+type automatonState struct {
+	pcs []uint32
+	// acceptAll is set if the prefix matches, every value with the prefix matches as well.
+	acceptAll bool
+}
+
+func (s *automatonState) dead() bool {
+	return len(s.pcs) == 0 && !s.acceptAll
+}
+
+// newTagValueAutomaton compiles the regexp of the filter, it returns nil if the regexp is not anchored at the
+// beginning, since every prefix may be followed by a match then.
+func newTagValueAutomaton(expr []byte) *tagValueAutomaton {
+	sre, err := syntax.Parse(tagCharsRegexpEscaper.Replace(string(expr)), syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	prog, err := syntax.Compile(sre.Simplify())
+	if err != nil {
+		return nil
+	}
+	a := &tagValueAutomaton{prog: prog, visited: make([]uint8, len(prog.Inst))}
+	// the regexp is anchored if nothing is reachable without the beginning of the text
+	var s automatonState
+	a.clear()
+	a.add(&s, uint32(prog.Start), true, false)
+	if !s.dead() {
+		return nil
+	}
+	return a
+}
+
+func (a *tagValueAutomaton) start() automatonState {
+	var s automatonState
+	a.clear()
+	a.add(&s, uint32(a.prog.Start), true, true)
+	return s
+}
+
+func (a *tagValueAutomaton) clear() {
+	for i := range a.visited {
+		a.visited[i] = 0
+	}
+}
+
+// add adds the instruction and the instructions reached by the empty transitions to the state. The empty width
+// assertions except the beginning of the text are assumed to be satisfied, which keeps the pruning conservative.
+func (a *tagValueAutomaton) add(s *automatonState, pc uint32, exact, begin bool) {
+	mark := uint8(1)
+	if exact {
+		mark = 2
+	}
+	if a.visited[pc] >= mark {
+		return
+	}
+	first := a.visited[pc] == 0
+	a.visited[pc] = mark
+
+	inst := &a.prog.Inst[pc]
+	switch inst.Op {
+	case syntax.InstAlt, syntax.InstAltMatch:
+		a.add(s, inst.Out, exact, begin)
+		a.add(s, inst.Arg, exact, begin)
+	case syntax.InstCapture, syntax.InstNop:
+		a.add(s, inst.Out, exact, begin)
+	case syntax.InstEmptyWidth:
+		cond := syntax.EmptyOp(inst.Arg)
+		if cond&syntax.EmptyBeginText != 0 && !begin {
+			return
+		}
+		a.add(s, inst.Out, exact && cond&syntax.EmptyEndText == 0, begin)
+	case syntax.InstMatch:
+		if exact {
+			s.acceptAll = true
+		}
+		if first {
+			s.pcs = append(s.pcs, pc)
+		}
+	case syntax.InstFail:
+	default:
+		if first {
+			s.pcs = append(s.pcs, pc)
+		}
+	}
+}
+
+// step returns the state after reading the rune.
+func (a *tagValueAutomaton) step(s *automatonState, r rune) automatonState {
+	var next automatonState
+	if s.acceptAll {
+		next.acceptAll = true
+		return next
+	}
+	a.clear()
+	for _, pc := range s.pcs {
+		inst := &a.prog.Inst[pc]
+		switch inst.Op {
+		case syntax.InstRune, syntax.InstRune1, syntax.InstRuneAny:
+			if inst.Op == syntax.InstRuneAny || inst.MatchRune(r) {
+				a.add(&next, inst.Out, true, false)
+			}
+		case syntax.InstRuneAnyNotNL:
+			if r != '\n' {
+				a.add(&next, inst.Out, true, false)
+			}
+		}
+	}
+	return next
+}
+
+// canMatch reports whether the value read to the state may match.
+func (a *tagValueAutomaton) canMatch(s *automatonState) bool {
+	if s.acceptAll {
+		return true
+	}
+	for _, pc := range s.pcs {
+		if a.prog.Inst[pc].Op == syntax.InstMatch {
+			return true
+		}
+	}
+	return false
+}
+
+// walk calls fn for the sorted values which may match. The states of the common prefix of the adjacent values are
+// reused, and all the values with a prefix are skipped once the state of the prefix is dead.
+func (a *tagValueAutomaton) walk(values []string, fn func(v string)) {
+	type frame struct {
+		offset int
+		state  automatonState
+	}
+	stack := []frame{{offset: 0, state: a.start()}}
+	prev := ""
+	for i := 0; i < len(values); {
+		v := values[i]
+		lcp := commonPrefixLen(prev, v)
+		for len(stack) > 1 && stack[len(stack)-1].offset > lcp {
+			stack = stack[:len(stack)-1]
+		}
+		prev = v
+
+		top := stack[len(stack)-1]
+		dead := false
+		for off := top.offset; off < len(v) && !top.state.acceptAll; {
+			r, size := utf8.DecodeRuneInString(v[off:])
+			off += size
+			top = frame{offset: off, state: a.step(&top.state, r)}
+			stack = append(stack, top)
+			if top.state.dead() {
+				dead = true
+				break
+			}
+		}
+
+		if dead || top.state.acceptAll {
+			// the following values with the same prefix share the state
+			prefix := v[:top.offset]
+			n := i + 1 + sort.Search(len(values)-i-1, func(j int) bool {
+				return !strings.HasPrefix(values[i+1+j], prefix)
+			})
+			if !dead {
+				for _, matched := range values[i:n] {
+					fn(matched)
+				}
+			}
+			i = n
+			continue
+		}
+		if a.canMatch(&top.state) {
+			fn(v)
+		}
+		i++
+	}
+}
+
+func commonPrefixLen(a, b string) int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
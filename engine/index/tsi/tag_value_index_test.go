@@ -0,0 +1,232 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tsi
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/savsgio/dictpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagValueAutomaton(t *testing.T) {
+	var values []string
+	for _, prefix := range []string{"api", "db", "web", "web-x"} {
+		for i := 0; i < 30; i++ {
+			values = append(values, fmt.Sprintf("%s-%d", prefix, i))
+		}
+	}
+	values = append(values, "", "web", "wEb-1\nx")
+	sort.Strings(values)
+
+	for _, expr := range []string{
+		`^web-[0-9]+$`,
+		`^(db|api)-1`,
+		`^web-x-2.$`,
+		`^(?i)WEB-1`,
+		`^[a-c].*-2$`,
+		`^$`,
+		`^w.b-1$|^db-2`,
+		`^x`,
+	} {
+		a := newTagValueAutomaton([]byte(expr))
+		require.NotNil(t, a, expr)
+		var got []string
+		a.walk(values, func(v string) { got = append(got, v) })
+
+		re := regexp.MustCompile(expr)
+		var expect []string
+		for _, v := range values {
+			if re.MatchString(v) {
+				expect = append(expect, v)
+			}
+		}
+		require.Equal(t, expect, got, expr)
+	}
+
+	require.Nil(t, newTagValueAutomaton([]byte(`web-[0-9]+`)))
+	require.Nil(t, newTagValueAutomaton([]byte(`.*web`)))
+	require.Nil(t, newTagValueAutomaton([]byte(`(`)))
+}
+
+func TestTagValueAutomatonPrune(t *testing.T) {
+	values := make([]string, 0, 10000)
+	for i := 0; i < 10000; i++ {
+		values = append(values, fmt.Sprintf("host-%05d", i))
+	}
+	sort.Strings(values)
+
+	// the values out of host-0012x are skipped by the dead states without being stepped
+	a := newTagValueAutomaton([]byte(`^host-0012[0-9]$`))
+	var matched []string
+	a.walk(values, func(v string) { matched = append(matched, v) })
+	require.Equal(t, values[120:130], matched)
+}
+
+func TestMergeTagValues(t *testing.T) {
+	require.Equal(t, []string{"a", "b", "c", "d"}, mergeTagValues([]string{"a", "c"}, []string{"a", "b", "d", "d"}))
+	require.Equal(t, []string{"a"}, mergeTagValues(nil, []string{"a"}))
+}
+
+func createSeriesForTest(t *testing.T, idx *MergeSetIndex, name string, tags ...influx.PointTags) {
+	pts := make([]influx.Row, 0, len(tags))
+	for i := range tags {
+		pt := influx.Row{Name: name, Tags: tags[i], Timestamp: time.Now().UnixNano()}
+		sort.Sort(&pt.Tags)
+		pt.UnmarshalIndexKeys(nil)
+		pt.ShardKey = pt.IndexKey
+		pts = append(pts, pt)
+	}
+	mmPoints := &dictpool.Dict{}
+	mmPoints.Set(name, &pts)
+	require.NoError(t, idx.CreateIndexIfNotExists(mmPoints))
+	idx.DebugFlush()
+}
+
+func TestSearchTSIDsByTagValueIndex(t *testing.T) {
+	path := t.TempDir()
+	i, idxBuilder := getTestIndexAndBuilder(path, config.TSSTORE)
+	defer idxBuilder.Close()
+	idx := i.(*MergeSetIndex)
+
+	name := "mst_0000"
+	var tags []influx.PointTags
+	for n := 0; n < 200; n++ {
+		tags = append(tags, influx.PointTags{{Key: "host", Value: fmt.Sprintf("web-%03d", n)}, {Key: "region", Value: "r1"}})
+		tags = append(tags, influx.PointTags{{Key: "host", Value: fmt.Sprintf("db-%03d", n)}, {Key: "region", Value: "r2"}})
+	}
+	createSeriesForTest(t, idx, name, tags...)
+
+	search := func(expr string) int {
+		is := idx.getIndexSearch()
+		defer idx.putIndexSearch(is)
+		is.setDeleted(idx.getDeletedTSIDs())
+		var tf tagFilter
+		require.NoError(t, tf.Init([]byte(name), []byte("host"), []byte(expr), false, true))
+		require.Equal(t, uint64(reMatchCost), tf.matchCost)
+		tsids, err := is.searchTSIDsByTagFilter(&tf)
+		require.NoError(t, err)
+		return tsids.Len()
+	}
+
+	require.Equal(t, 10, search(`^web-0[0-9]+1$`))
+	require.Equal(t, 40, search(`^(web|db)-[0-9]+7$`))
+	require.Equal(t, 10, search(`^web-00\d+$`))
+
+	// the new series are visible to the cached values
+	createSeriesForTest(t, idx, name, influx.PointTags{{Key: "host", Value: "web-0551"}, {Key: "region", Value: "r1"}})
+	require.Equal(t, 11, search(`^web-0[0-9]+1$`))
+	createSeriesForTest(t, idx, name, influx.PointTags{{Key: "host", Value: "db-0001"}, {Key: "region", Value: "r2"}})
+	require.Equal(t, 11, search(`^web-0[0-9]+1$`))
+	require.Equal(t, 40, search(`^(web|db)-[0-9]+7$`))
+
+	// the deleted series are excluded
+	is := idx.getIndexSearch()
+	var tf tagFilter
+	require.NoError(t, tf.Init([]byte(name), []byte("host"), []byte(`^web-00\d+$`), false, true))
+	tsids, err := is.searchTSIDsByTagFilter(&tf)
+	require.NoError(t, err)
+	idx.putIndexSearch(is)
+	require.NoError(t, idx.deleteTSIDs([]byte(name), tsids.AppendTo(nil)[:3]))
+	require.Equal(t, 7, search(`^web-00\d+$`))
+
+	require.NoError(t, idx.ClearCache())
+	require.Equal(t, 11, search(`^db-0[0-9]+1$`))
+}
+
+func TestTagValueIndexEviction(t *testing.T) {
+	path := t.TempDir()
+	i, idxBuilder := getTestIndexAndBuilder(path, config.TSSTORE)
+	defer idxBuilder.Close()
+	idx := i.(*MergeSetIndex)
+
+	name := "mst_0000"
+	var tags []influx.PointTags
+	for n := 0; n < 100; n++ {
+		tags = append(tags, influx.PointTags{{Key: "host", Value: fmt.Sprintf("web-%03d", n)}, {Key: "region", Value: fmt.Sprintf("r-%03d", n)}})
+	}
+	createSeriesForTest(t, idx, name, tags...)
+
+	search := func(key, expr string) int {
+		is := idx.getIndexSearch()
+		defer idx.putIndexSearch(is)
+		var tf tagFilter
+		require.NoError(t, tf.Init([]byte(name), []byte(key), []byte(expr), false, true))
+		tsids, err := is.searchTSIDsByTagFilter(&tf)
+		require.NoError(t, err)
+		return tsids.Len()
+	}
+
+	// the values of one tag key fit in the memory
+	maxSize := TagValueIndexMaxSize
+	defer func() { TagValueIndexMaxSize = maxSize }()
+	TagValueIndexMaxSize = 100 * (stringHeaderSize + 7)
+
+	require.Equal(t, 10, search("host", `^web-0[0-9]+1$`))
+	require.Equal(t, int64(1), idx.tagValues.loaded)
+	require.Equal(t, 10, search("region", `^r-0[0-9]+1$`))
+	require.Equal(t, int64(1), idx.tagValues.loaded)
+	require.Equal(t, 10, search("host", `^web-0[0-9]+1$`))
+	require.Equal(t, int64(1), idx.tagValues.loaded)
+
+	// the values added while the snapshot is read are merged into the next snapshot
+	var tf tagFilter
+	require.NoError(t, tf.Init([]byte(name), []byte("host"), []byte(`^web`), false, true))
+	tv := idx.tagValues.get(tf.prefix[:tf.keyPrefixLen])
+	tv.mu.Lock()
+	tv.pending = append(tv.pending, "web-999")
+	tv.mu.Unlock()
+	is := idx.getIndexSearch()
+	snap, ok, err := tv.load(is, idx.tagFilterGen)
+	idx.putIndexSearch(is)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 100, len(snap.values))
+	require.Equal(t, []string{"web-999"}, snap.added)
+	require.Empty(t, tv.pending)
+	var tf9 tagFilter
+	require.NoError(t, tf9.Init([]byte(name), []byte("host"), []byte(`^web-\d+9$`), false, true))
+	is = idx.getIndexSearch()
+	matched, ok, err := is.matchTagValues(&tf9)
+	idx.putIndexSearch(is)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 11, len(matched))
+	require.Equal(t, "web-999", matched[len(matched)-1])
+
+	// the added values are merged into the values once they grow large
+	tv.mu.Lock()
+	for n := 0; n < 20; n++ {
+		tv.pending = append(tv.pending, fmt.Sprintf("web-%d", 1000+n))
+	}
+	tv.mu.Unlock()
+	is = idx.getIndexSearch()
+	snap, ok, err = tv.load(is, idx.tagFilterGen)
+	idx.putIndexSearch(is)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 121, len(snap.values))
+	require.Empty(t, snap.added)
+	require.True(t, sort.StringsAreSorted(snap.values))
+
+	idx.tagValues.reset()
+	require.Equal(t, int64(0), idx.tagValues.loaded)
+}
@@ -107,6 +107,9 @@ func (s *shard) Scan(span *tracing.Span, schema *executor.QuerySchema, callBack
 	if !ok {
 		return nil, num, fmt.Errorf("type error: expect tsi.GroupSeries: got %T", result)
 	}
+	if kr := s.keyRange.Load(); kr != nil {
+		tagSets = kr.filter(schema.Options().OptionsName(), tagSets)
+	}
 	if len(tagSets) == 0 {
 		return nil, num, nil
 	}
@@ -160,3 +160,25 @@ func TestSplitRecordByTime_EmptyStringColumn(t *testing.T) {
 	require.Equal(t, 2, order.Len())
 	require.Equal(t, 2, unOrder.Len())
 }
+
+func TestQuantizeRecord(t *testing.T) {
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_Float, Name: "a1"},
+		record.Field{Type: influx.Field_Type_Float, Name: "a2"},
+		record.Field{Type: influx.Field_Type_Int, Name: record.TimeField},
+	}
+	rec := &record.Record{}
+	rec.ResetWithSchema(schema)
+	rec.Column(0).AppendFloats(1.1, 2.26, 3.74)
+	rec.Column(1).AppendFloats(1.1, 2.26, 3.74)
+	rec.Column(2).AppendIntegers(1, 2, 3)
+
+	require.Equal(t, rec, mutable.QuantizeRecord(rec, nil))
+	require.Nil(t, mutable.QuantizeRecord(nil, map[string]float64{"a1": 0.25}))
+
+	quantized := mutable.QuantizeRecord(rec, map[string]float64{"a1": 0.25})
+	require.Equal(t, []float64{1, 2.5, 3.5}, quantized.Column(0).FloatValues())
+	require.Equal(t, []float64{1.1, 2.26, 3.74}, quantized.Column(1).FloatValues())
+	// the record of the mem table is not modified
+	require.Equal(t, []float64{1.1, 2.26, 3.74}, rec.Column(0).FloatValues())
+}
@@ -23,7 +23,9 @@ import (
 	"time"
 
 	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/compress"
 	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/encoding"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/record"
@@ -58,7 +60,7 @@ func (t *tsMemTableImpl) WriteRecordForFlush(rec *record.Record, msb *immutable.
 	return msb
 }
 
-func (t *tsMemTableImpl) FlushChunks(table *MemTable, dataPath, msName, _, _ string, lock *string, tbStore immutable.TablesStore, _ int64, fileInfos chan []immutable.FileInfoExtend) {
+func (t *tsMemTableImpl) FlushChunks(table *MemTable, dataPath, msName, db, rp string, lock *string, tbStore immutable.TablesStore, _ int64, fileInfos chan []immutable.FileInfoExtend) {
 	msInfo, ok := table.msInfoMap[msName]
 	if !ok || msInfo == nil {
 		return
@@ -76,6 +78,7 @@ func (t *tsMemTableImpl) FlushChunks(table *MemTable, dataPath, msName, _, _ str
 	var flushTime int64 = math.MinInt64
 
 	recPool := []record.Record{{}, {}}
+	codecs := encoding.GetMeasurementCodecs(db, rp, msName)
 	hasOrderFile := tbStore.GetTableFileNum(msName, true) > 0
 
 	if hasOrderFile {
@@ -99,11 +102,14 @@ func (t *tsMemTableImpl) FlushChunks(table *MemTable, dataPath, msName, _, _ str
 		}
 
 		orderRec, unOrderRec := SplitRecordByTime(rec, recPool, flushTime)
+		orderRec = QuantizeRecord(orderRec, codecs.ErrorBounds)
+		unOrderRec = QuantizeRecord(unOrderRec, codecs.ErrorBounds)
 		orderRows := orderRec.RowNums()
 		if orderRows > 0 {
 			if orderMsBuilder == nil {
 				conf := immutable.GetTsStoreConfig()
 				orderMsBuilder = createMsBuilder(tbStore, true, lock, dataPath, msName, sidLen, orderRows, conf, config.TSSTORE)
+				orderMsBuilder.SetCodecs(codecs)
 			}
 			orderMsBuilder = t.WriteRecordForFlush(orderRec, orderMsBuilder, tbStore, chunk.Sid)
 			atomic.AddInt64(&Statistics.PerfStat.FlushOrderRowsCount, int64(orderRows))
@@ -114,6 +120,7 @@ func (t *tsMemTableImpl) FlushChunks(table *MemTable, dataPath, msName, _, _ str
 			if unOrderMsBuilder == nil {
 				conf := immutable.GetTsStoreConfig()
 				unOrderMsBuilder = createMsBuilder(tbStore, false, lock, dataPath, msName, sidLen, unOrderRows, conf, config.TSSTORE)
+				unOrderMsBuilder.SetCodecs(codecs)
 			}
 			unOrderMsBuilder = t.WriteRecordForFlush(unOrderRec, unOrderMsBuilder, tbStore, chunk.Sid)
 			atomic.AddInt64(&Statistics.PerfStat.FlushUnOrderRowsCount, int64(unOrderRows))
@@ -159,6 +166,37 @@ func (t *tsMemTableImpl) finish(msb *immutable.MsBuilder, fileInfos chan []immut
 	return files
 }
 
+// QuantizeRecord rounds the values of the float fields with an error bound, see compress.Quantize.
+// The values are quantized only here, when they leave the mem table, so that the pre-aggregates
+// are computed from the quantized values and compactions store them exactly.
+// The quantized columns are copies, as the mem table may still be read while it is flushed.
+func QuantizeRecord(rec *record.Record, bounds map[string]float64) *record.Record {
+	if rec == nil || len(bounds) == 0 {
+		return rec
+	}
+	var dst *record.Record
+	for i := range rec.Schema {
+		bound, ok := bounds[rec.Schema[i].Name]
+		if !ok || rec.Schema[i].Type != influx.Field_Type_Float {
+			continue
+		}
+		if dst == nil {
+			dst = &record.Record{Schema: rec.Schema, ColVals: append([]record.ColVal(nil), rec.ColVals...)}
+		}
+		col := &dst.ColVals[i]
+		col.Val = append([]byte(nil), col.Val...)
+		values := util.Bytes2Float64Slice(col.Val)
+		for j := range values {
+			// a value that can not be quantized is kept, the block is then encoded losslessly
+			values[j], _ = compress.Quantize(values[j], bound)
+		}
+	}
+	if dst == nil {
+		return rec
+	}
+	return dst
+}
+
 func SplitRecordByTime(rec *record.Record, pool []record.Record, time int64) (*record.Record, *record.Record) {
 	times := rec.Times()
 	if time >= times[len(times)-1] {
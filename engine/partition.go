@@ -101,6 +101,10 @@ type DBPTInfo struct {
 	fileInfos           chan []immutable.FileInfoExtend
 	doingOff            bool
 	doingShardMoveN     int
+
+	// the loads accumulated since the partition is loaded, used by the rebalancing
+	writeRows int64
+	queries   int64
 }
 
 func NewDBPTInfo(db string, id uint32, dataPath, walPath string, ctx *metaclient.LoadCtx, ch chan []immutable.FileInfoExtend) *DBPTInfo {
@@ -926,6 +930,34 @@ func (dbPT *DBPTInfo) seriesCardinality(measurements [][]byte, measurementCardin
 	return measurementCardinalityInfos, nil
 }
 
+func (dbPT *DBPTInfo) tagKeysCardinality(measurements [][]byte) ([]*netstorage.TagKeyCardinality, error) {
+	var res []*netstorage.TagKeyCardinality
+	for i := range measurements {
+		keys := make(map[string]*netstorage.TagKeyCardinality)
+		for _, indexBuilder := range dbPT.indexBuilder {
+			idx, ok := indexBuilder.GetPrimaryIndex().(*tsi.MergeSetIndex)
+			if !ok {
+				continue
+			}
+			cardinality, err := idx.TagKeysCardinality(measurements[i])
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range cardinality {
+				k, ok := keys[c.Key]
+				if !ok {
+					k = &netstorage.TagKeyCardinality{Measurement: string(measurements[i]), Key: c.Key}
+					keys[c.Key] = k
+					res = append(res, k)
+				}
+				k.Values = max(k.Values, c.Values)
+				k.Series = max(k.Series, c.Series)
+			}
+		}
+	}
+	return res, nil
+}
+
 func (dbPT *DBPTInfo) seriesCardinalityWithCondition(measurements [][]byte, condition influxql.Expr,
 	measurementCardinalityInfos []meta.MeasurementCardinalityInfo, tr influxql.TimeRange) ([]meta.MeasurementCardinalityInfo, error) {
 	for i := range measurements {
@@ -15,6 +15,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -38,6 +39,9 @@ type raftNodeRequest interface {
 	AddCommittedDataC(*raftlog.DataWrapper) (chan error, error)
 	RemoveCommittedDataC(*raftlog.DataWrapper)
 	RetCommittedDataC(*raftlog.DataWrapper, error)
+	ReadIndex(ctx context.Context) (uint64, error)
+	WaitApplied(ctx context.Context, index uint64) error
+	AppliedIndex() uint64
 	Stop()
 }
 
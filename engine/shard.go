@@ -61,6 +61,7 @@ import (
 	"github.com/openGemini/openGemini/lib/syscontrol"
 	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/tail"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
@@ -192,6 +193,15 @@ type Shard interface {
 
 	// raft SnapShot
 	SetSnapShotter(snp *raftlog.SnapShotter)
+
+	// anti-entropy of the replicas, only work for tsstore
+	LastWriteTime() uint64
+	Digest(interval int64) (*netstorage.ShardDigest, error)
+	ScanRows(mst string, ranges []util.TimeRange, batchRows int, fn func(rows []influx.Row) error) error
+
+	// shard split of the range sharding, only work for tsstore
+	SplitKeyRange(min, max string, client metaclient.MetaClient, batchRows int, fn func(rows []influx.Row) error) error
+	WriteSplitRows(rows []influx.Row) (int, error)
 }
 
 type shard struct {
@@ -274,6 +284,11 @@ type shard struct {
 	fileInfos chan []immutable.FileInfoExtend
 
 	SnapShotter *raftlog.SnapShotter
+
+	// the series out of the key range are skipped by the queries while they are moved by the shard split
+	keyRange atomic.Pointer[shardKeyRange]
+	// the rows moved by the shard split are written exclusively, so they never overwrite the rows written meanwhile
+	splitMu sync.RWMutex
 }
 
 type shardDownSampleTaskInfo struct {
@@ -403,7 +418,9 @@ func NewShard(dataPath, walPath string, lockPath *string, ident *meta.ShardIdent
 			s.tier = util.Warm
 		}
 	}
-	s.immTables = immutable.NewTableStore(filePath, s.lock, &s.tier, options.CompactRecovery, conf)
+	immTables := immutable.NewTableStore(filePath, s.lock, &s.tier, options.CompactRecovery, conf)
+	immTables.SetDbRp(ident.OwnerDb, ident.Policy)
+	s.immTables = immTables
 	s.immTables.SetAddFunc(s.addRowCounts)
 	s.immTables.SetImmTableType(s.engineType)
 	return s
@@ -476,6 +493,12 @@ func (s *shard) isClosing() bool {
 }
 
 func (s *shard) WriteRows(rows []influx.Row, binaryRows []byte) error {
+	s.splitMu.RLock()
+	defer s.splitMu.RUnlock()
+	return s.writeShardRows(rows, binaryRows)
+}
+
+func (s *shard) writeShardRows(rows []influx.Row, binaryRows []byte) error {
 	if s.isClosing() {
 		return errno.NewError(errno.ErrShardClosed, s.ident.ShardID)
 	}
@@ -529,11 +552,13 @@ func (s *shard) WriteCols(mst string, cols *record.Record, binaryCols []byte) er
 	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
+	logs := tailLogs(s.ident.OwnerDb, mst, cols)
 	if err := s.storage.WriteCols(s, cols, mst, binaryCols); err != nil {
 		log.Error("write buffer failed", zap.Error(err))
 		atomic.AddInt64(&statistics.PerfStat.WriteReqErrors, 1)
 		return err
 	}
+	tail.DefaultHub.Publish(s.ident.OwnerDb, influx.GetOriginMstName(mst), logs)
 	s.addRowCounts(int64(cols.RowNums()))
 	atomic.AddInt64(&statistics.PerfStat.WriteRowsBatch, 1)
 	atomic.AddInt64(&statistics.PerfStat.WriteRowsCount, int64(cols.RowNums()))
@@ -608,7 +633,16 @@ func (s *shard) Compact() error {
 		nowTime := fasttime.UnixTimestamp()
 		lastWrite := s.LastWriteTime()
 		d := nowTime - lastWrite
-		if d >= atomic.LoadUint64(&fullCompColdDuration) {
+		cold := d >= atomic.LoadUint64(&fullCompColdDuration)
+		if s.engineType == config.TSSTORE && config.GetStoreConfig().Compact.IsTimeWindowStrategy() {
+			// the time windows still written are compacted level by level, the closed windows and
+			// all windows of a cold shard are compacted into one file each, instead of one file per shard
+			if err := s.immTables.TimeWindowCompact(id, cold); err != nil {
+				log.Error("time window compact error", zap.Uint64("shid", id), zap.Error(err))
+			}
+			return nil
+		}
+		if cold {
 			if err := s.immTables.FullCompact(id); err != nil {
 				log.Error("full compact error", zap.Uint64("shid", id), zap.Error(err))
 			}
@@ -1085,7 +1119,7 @@ func (s *shard) syncReplayWal(ctx context.Context) error {
 		func(binary []byte, rowsCtx *walRowsObjects, writeWalType WalRecordType) error {
 			err := s.writeWalBuffer(binary, rowsCtx, writeWalType)
 			// SeriesLimited error is ignored in the wal playback process
-			if errno.Equal(err, errno.SeriesLimited) {
+			if errno.Equal(err, errno.SeriesLimited, errno.MeasurementSeriesLimited) {
 				err = nil
 			}
 			return err
@@ -1098,7 +1132,7 @@ func (s *shard) syncReplayWal(ctx context.Context) error {
 
 	s.ForceFlush()
 	s.log.Info("force flush shard ok", zap.Uint64("id", s.ident.ShardID), zap.Uint64("opId", s.opId), zap.Int("wal filename number", len(walFileNames)))
-	err = s.wal.Remove(walFileNames)
+	err = s.removeWalFiles(walFileNames)
 	if err != nil {
 		return err
 	}
@@ -1353,6 +1387,7 @@ func (s *shard) OpenAndEnable(client metaclient.MetaClient) error {
 	// then add this shard to compaction worker.
 	compWorker.RegisterShard(s)
 	s.EnableDownSample()
+	s.restoreKeyRange(client)
 	s.wg.Add(1)
 	go s.Snapshot()
 	s.opened = true
@@ -1822,8 +1857,10 @@ func (s *shard) GetStatistics(buffer []byte) ([]byte, error) {
 		return buffer, nil
 	}
 	fileStat := s.immTables.GetMstFileStat()
+	debt := s.immTables.CompactionDebt()
 	s.mu.RUnlock()
 
+	buffer = s.fileStat.CollectCompactDebt(buffer, s.defaultTags, debt.PendingPlans, debt.PendingFiles, debt.PendingSize)
 	return s.fileStat.Collect(buffer, s.defaultTags, fileStat)
 }
 
@@ -0,0 +1,445 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
+	"github.com/cespare/xxhash/v2"
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// Digest returns the merkle tree of the ordered tssp files of the shard. The leaves are the digests of the
+// measurements, which are the hashes of the digests of the time ranges of the interval.
+// The series are identified by the series keys rather than the series ids, since the replicas of a partition
+// allocate the series ids independently, and the deleted series are skipped. The data in the memtables is not included.
+// The measurements with out-of-order files are pending until the files are merged into the ordered files,
+// since the rows in the out-of-order files may be duplicated with the ordered files.
+func (s *shard) Digest(interval int64) (*netstorage.ShardDigest, error) {
+	idx, err := s.primaryIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	digest := &netstorage.ShardDigest{
+		ShardID:   s.ident.ShardID,
+		StartTime: s.startTime.UnixNano(),
+		EndTime:   s.endTime.UnixNano(),
+	}
+	root := xxhash.New()
+	var buf []byte
+	pending := false
+	for _, mst := range s.tsspMeasurements() {
+		md := &netstorage.MeasurementDigest{Name: mst}
+		if s.hasOutOfOrderFiles(mst) {
+			md.Pending = true
+			pending = true
+		} else {
+			stats := make(immutable.SeriesRangeStats)
+			err = s.walkTSSPFiles(mst, true, func(f immutable.TSSPFile) error {
+				return immutable.CollectSeriesRangeStat(f, interval, stats)
+			})
+			if err != nil {
+				return nil, err
+			}
+			if err = measurementDigest(idx, interval, stats, md); err != nil {
+				return nil, err
+			}
+			if md.Series == 0 {
+				continue
+			}
+		}
+
+		digest.Measurements = append(digest.Measurements, md)
+		buf = append(buf[:0], mst...)
+		buf = encoding.MarshalUint64(buf, md.Hash)
+		if md.Pending {
+			buf = append(buf, 1)
+		}
+		_, _ = root.Write(buf)
+	}
+	digest.Hash = root.Sum64()
+
+	if pending {
+		if err = s.immTables.MergeOutOfOrder(s.ident.ShardID, true, true); err != nil {
+			s.log.Warn("merge out of order files for the digest failed", zap.Uint64("shard", s.ident.ShardID), zap.Error(err))
+		}
+	}
+	return digest, nil
+}
+
+func (s *shard) hasOutOfOrderFiles(mst string) bool {
+	n := 0
+	_ = s.walkTSSPFiles(mst, false, func(immutable.TSSPFile) error {
+		n++
+		return nil
+	})
+	return n > 0
+}
+
+// measurementDigest hashes the stats of the series into the digests of the time ranges, the series are sorted by
+// the series keys and the columns are sorted by the names
+func measurementDigest(idx *tsi.MergeSetIndex, interval int64, stats immutable.SeriesRangeStats, md *netstorage.MeasurementDigest) error {
+	type seriesKey struct {
+		key []byte
+		sid uint64
+	}
+	keys := make([]seriesKey, 0, len(stats))
+	for sid := range stats {
+		if idx.IsDeletedTSID(sid) {
+			continue
+		}
+		var key []byte
+		err := idx.GetSeries(sid, nil, nil, func(sk *influx.SeriesKey) {
+			key = marshalSeriesKey(key[:0], sk)
+		})
+		if err != nil {
+			return err
+		}
+		if len(key) == 0 {
+			continue
+		}
+		keys = append(keys, seriesKey{key: key, sid: sid})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i].key, keys[j].key) < 0
+	})
+
+	type rangeHash struct {
+		digest *netstorage.RangeDigest
+		h      *xxhash.Digest
+	}
+	ranges := make(map[int64]*rangeHash)
+	var buf []byte
+	var cols []string
+	for i := range keys {
+		for start, stat := range stats[keys[i].sid] {
+			if stat.Rows == 0 {
+				continue
+			}
+			r, ok := ranges[start]
+			if !ok {
+				r = &rangeHash{digest: &netstorage.RangeDigest{StartTime: start, EndTime: start + interval - 1}, h: xxhash.New()}
+				ranges[start] = r
+			}
+			r.digest.Series++
+			r.digest.Rows += uint64(stat.Rows)
+
+			buf = append(buf[:0], keys[i].key...)
+			buf = encoding.MarshalInt64(buf, stat.MinTime)
+			buf = encoding.MarshalInt64(buf, stat.MaxTime)
+			buf = encoding.MarshalInt64(buf, stat.Rows)
+			cols = cols[:0]
+			for name := range stat.Columns {
+				cols = append(cols, name)
+			}
+			sort.Strings(cols)
+			for _, name := range cols {
+				col := stat.Columns[name]
+				buf = append(buf, name...)
+				buf = encoding.MarshalVarInt64(buf, int64(col.Type))
+				buf = encoding.MarshalInt64(buf, col.Count)
+				buf = encoding.MarshalUint64(buf, math.Float64bits(col.Min))
+				buf = encoding.MarshalUint64(buf, math.Float64bits(col.Max))
+				buf = encoding.MarshalInt64(buf, col.Sum)
+			}
+			_, _ = r.h.Write(buf)
+		}
+	}
+
+	md.Series = uint64(len(keys))
+	md.Ranges = make([]*netstorage.RangeDigest, 0, len(ranges))
+	for _, r := range ranges {
+		r.digest.Hash = r.h.Sum64()
+		md.Ranges = append(md.Ranges, r.digest)
+		md.Rows += r.digest.Rows
+	}
+	sort.Slice(md.Ranges, func(i, j int) bool {
+		return md.Ranges[i].StartTime < md.Ranges[j].StartTime
+	})
+	h := xxhash.New()
+	for _, r := range md.Ranges {
+		buf = encoding.MarshalInt64(buf[:0], r.StartTime)
+		buf = encoding.MarshalUint64(buf, r.Hash)
+		_, _ = h.Write(buf)
+	}
+	md.Hash = h.Sum64()
+	return nil
+}
+
+func marshalSeriesKey(dst []byte, sk *influx.SeriesKey) []byte {
+	dst = append(dst, sk.Measurement...)
+	for _, kv := range sk.TagSet {
+		dst = append(dst, ',')
+		dst = append(dst, kv.Key...)
+		dst = append(dst, '=')
+		dst = append(dst, kv.Value...)
+	}
+	return dst
+}
+
+// ScanRows reads the rows of the measurement in the time ranges from the tssp files and passes them to fn in batches,
+// all the rows are read if ranges is empty. The rows of the out-of-order files are passed after the ordered files.
+func (s *shard) ScanRows(mst string, ranges []util.TimeRange, batchRows int, fn func(rows []influx.Row) error) error {
+	idx, err := s.primaryIndex()
+	if err != nil {
+		return err
+	}
+
+	p := &rowsPerformer{idx: idx, mst: mst, ranges: ranges, batchRows: batchRows, fn: fn, tags: make(map[uint64][]influx.Tag)}
+	return s.scanRows(p)
+}
+
+func (s *shard) scanRows(p *rowsPerformer) error {
+	for _, isOrder := range []bool{true, false} {
+		err := s.walkTSSPFiles(p.mst, isOrder, func(f immutable.TSSPFile) error {
+			return s.scanFile(p, f)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return p.flushRows()
+}
+
+func (s *shard) scanFile(p *rowsPerformer, f immutable.TSSPFile) error {
+	fi := immutable.NewFileIterator(f, s.log)
+	defer fi.Close()
+	itr := immutable.NewColumnIterator(fi)
+	defer itr.Close()
+	for fi.GetCurtChunkMeta() != nil {
+		if err := itr.IterCurrentChunk(p); err != nil {
+			return err
+		}
+		if !itr.NextChunkMeta() {
+			break
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return err
+	}
+	return p.flushSeries()
+}
+
+func (s *shard) primaryIndex() (*tsi.MergeSetIndex, error) {
+	if s.indexBuilder == nil {
+		return nil, fmt.Errorf("shard %d has no index", s.ident.ShardID)
+	}
+	idx, ok := s.indexBuilder.GetPrimaryIndex().(*tsi.MergeSetIndex)
+	if !ok {
+		return nil, fmt.Errorf("shard %d has no series index", s.ident.ShardID)
+	}
+	return idx, nil
+}
+
+// tsspMeasurements returns the sorted measurements which have tssp files in the shard
+func (s *shard) tsspMeasurements() []string {
+	msts := s.immTables.GetAllMstList()
+	sort.Strings(msts)
+	n := 0
+	for i := range msts {
+		if i > 0 && msts[i] == msts[n-1] {
+			continue
+		}
+		msts[n] = msts[i]
+		n++
+	}
+	return msts[:n]
+}
+
+func (s *shard) walkTSSPFiles(mst string, isOrder bool, fn func(f immutable.TSSPFile) error) error {
+	files, ok := s.immTables.GetTSSPFiles(mst, isOrder)
+	if !ok {
+		return nil
+	}
+	var err error
+	for _, f := range files.Files() {
+		if err == nil {
+			err = fn(f)
+		}
+		f.UnrefFileReader()
+		f.Unref()
+	}
+	return err
+}
+
+// rowsPerformer converts the columns of the series in the tssp files to rows
+type rowsPerformer struct {
+	idx       *tsi.MergeSetIndex
+	mst       string
+	ranges    []util.TimeRange
+	batchRows int
+	fn        func(rows []influx.Row) error
+	// selects the series to read, all the series are read if it is nil
+	filter func(sid uint64) bool
+
+	sid   uint64
+	times []int64
+	refs  []record.Field
+	cols  []record.ColVal
+	tags  map[uint64][]influx.Tag
+	rows  []influx.Row
+}
+
+func (p *rowsPerformer) Handle(col *record.ColVal, _ []int64, _ bool) error {
+	ref := &p.refs[len(p.refs)-1]
+	cv := &p.cols[len(p.cols)-1]
+	cv.AppendColVal(col, ref.Type, 0, col.Len)
+	return nil
+}
+
+func (p *rowsPerformer) HasSeries(sid uint64) bool {
+	return p.filter == nil || p.filter(sid)
+}
+
+func (p *rowsPerformer) ColumnChanged(ref *record.Field) error {
+	p.refs = append(p.refs, *ref)
+	p.cols = append(p.cols, record.ColVal{})
+	return nil
+}
+
+func (p *rowsPerformer) SeriesChanged(sid uint64, times []int64) error {
+	if err := p.flushSeries(); err != nil {
+		return err
+	}
+	p.sid = sid
+	p.times = append(p.times[:0], times...)
+	return nil
+}
+
+func (p *rowsPerformer) WriteOriginal(*immutable.FileIterator) error {
+	return nil
+}
+
+func (p *rowsPerformer) flushSeries() error {
+	defer func() {
+		p.times = p.times[:0]
+		p.refs = p.refs[:0]
+		p.cols = p.cols[:0]
+	}()
+	if len(p.times) == 0 {
+		return nil
+	}
+
+	tags, err := p.seriesTags(p.sid)
+	if err != nil {
+		return err
+	}
+	for i, tm := range p.times {
+		if !p.inRanges(tm) {
+			continue
+		}
+		row := influx.Row{Name: p.mst, Timestamp: tm, Tags: tags}
+		for j := range p.refs {
+			if p.refs[j].Name == record.TimeField {
+				continue
+			}
+			if f, ok := rowField(&p.cols[j], &p.refs[j], i); ok {
+				row.Fields = append(row.Fields, f)
+			}
+		}
+		if len(row.Fields) == 0 {
+			continue
+		}
+		sort.Sort(&row.Fields)
+		p.rows = append(p.rows, row)
+		if len(p.rows) >= p.batchRows {
+			if err = p.flushRows(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *rowsPerformer) inRanges(tm int64) bool {
+	if len(p.ranges) == 0 {
+		return true
+	}
+	for i := range p.ranges {
+		if p.ranges[i].Contains(tm, tm) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *rowsPerformer) flushRows() error {
+	if len(p.rows) == 0 {
+		return nil
+	}
+	err := p.fn(p.rows)
+	p.rows = p.rows[:0]
+	return err
+}
+
+func (p *rowsPerformer) seriesTags(sid uint64) ([]influx.Tag, error) {
+	if tags, ok := p.tags[sid]; ok {
+		return tags, nil
+	}
+	var tags influx.PointTags
+	err := p.idx.GetSeries(sid, nil, nil, func(sk *influx.SeriesKey) {
+		tags = tags[:0]
+		for _, kv := range sk.TagSet {
+			tags = append(tags, influx.Tag{Key: string(kv.Key), Value: string(kv.Value)})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(&tags)
+	p.tags[sid] = tags
+	return tags, nil
+}
+
+func rowField(cv *record.ColVal, ref *record.Field, i int) (influx.Field, bool) {
+	f := influx.Field{Key: ref.Name, Type: int32(ref.Type)}
+	if i >= cv.Len {
+		return f, false
+	}
+	var isNil bool
+	switch ref.Type {
+	case influx.Field_Type_Float:
+		f.NumValue, isNil = cv.FloatValue(i)
+	case influx.Field_Type_Int:
+		var v int64
+		v, isNil = cv.IntegerValue(i)
+		f.NumValue = float64(v)
+	case influx.Field_Type_Boolean:
+		var v bool
+		v, isNil = cv.BooleanValue(i)
+		if v {
+			f.NumValue = 1
+		}
+	case influx.Field_Type_String:
+		var v string
+		v, isNil = cv.StringValueUnsafe(i)
+		f.StrValue = strings.Clone(v)
+	default:
+		return f, false
+	}
+	return f, !isNil
+}
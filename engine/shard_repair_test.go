@@ -0,0 +1,132 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_DigestAndScanRows(t *testing.T) {
+	interval := int64(time.Hour)
+	st := time.Now().Truncate(time.Hour).Add(-4 * time.Hour)
+	rows, _, _ := GenDataRecord([]string{"cpu", "mem"}, 4, 200, time.Minute, st, true, true, false)
+	between := func(rows []influx.Row, min, max time.Duration, drop func(r *influx.Row) bool) []influx.Row {
+		var dst []influx.Row
+		for i := range rows {
+			tm := time.Duration(rows[i].Timestamp - st.UnixNano())
+			if tm >= min && tm < max && (drop == nil || !drop(&rows[i])) {
+				dst = append(dst, rows[i])
+			}
+		}
+		return dst
+	}
+
+	newShard := func(parts ...[]influx.Row) *shard {
+		sh, err := createShard(defaultDb, defaultRp, defaultPtId, t.TempDir(), config.TSSTORE)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, closeShard(sh))
+		})
+		for _, part := range parts {
+			require.NoError(t, writeData(sh, part, true))
+		}
+		return sh
+	}
+	digest := func(sh *shard) *netstorage.ShardDigest {
+		d, err := sh.Digest(interval)
+		require.NoError(t, err)
+		return d
+	}
+
+	// the chunks are split differently on the replicas, some of them are across the time ranges
+	leader := newShard(rows)
+	follower := newShard(between(rows, 0, 30*time.Minute, nil), between(rows, 30*time.Minute, 130*time.Minute, nil),
+		between(rows, 130*time.Minute, 5*time.Hour, nil))
+	d1, d2 := digest(leader), digest(follower)
+	require.Equal(t, d1.Hash, d2.Hash)
+	require.Equal(t, d1.Measurements, d2.Measurements)
+	require.Equal(t, 2, len(d1.Measurements))
+	cpu := d1.Measurements[0]
+	require.Equal(t, "cpu", cpu.Name)
+	require.Equal(t, uint64(2), cpu.Series)
+	require.Equal(t, uint64(400), cpu.Rows)
+	require.False(t, cpu.Pending)
+	require.True(t, len(cpu.Ranges) >= 4)
+	for _, r := range cpu.Ranges {
+		require.Equal(t, r.StartTime+interval-1, r.EndTime)
+	}
+
+	// a value is different on the replica, the pre-aggregation of the time range is different
+	changed := between(rows, 0, 5*time.Hour, nil)
+	for i := range changed {
+		if changed[i].Name == "cpu" && changed[i].Timestamp > cpu.Ranges[2].StartTime {
+			changed[i].Fields = append(influx.Fields{}, changed[i].Fields...)
+			changed[i].Fields[1].NumValue += 100
+			break
+		}
+	}
+	d4 := digest(newShard(changed))
+	require.Equal(t, cpu.Ranges[:2], d4.Measurements[0].Ranges[:2])
+	require.Equal(t, cpu.Ranges[2].Rows, d4.Measurements[0].Ranges[2].Rows)
+	require.NotEqual(t, cpu.Ranges[2].Hash, d4.Measurements[0].Ranges[2].Hash)
+
+	// the rows of cpu in the second time range are lost on the lagging replica
+	lost := cpu.Ranges[1]
+	isLost := func(r *influx.Row) bool {
+		return r.Name == "cpu" && r.Timestamp >= lost.StartTime && r.Timestamp <= lost.EndTime
+	}
+	lagging := newShard(between(rows, 0, 5*time.Hour, isLost))
+	d3 := digest(lagging)
+	require.NotEqual(t, d1.Hash, d3.Hash)
+	require.Equal(t, d1.Measurements[1], d3.Measurements[1])
+	require.Equal(t, len(cpu.Ranges)-1, len(d3.Measurements[0].Ranges))
+	require.Equal(t, cpu.Ranges[0], d3.Measurements[0].Ranges[0])
+	require.Equal(t, cpu.Ranges[2:], d3.Measurements[0].Ranges[1:])
+
+	// only the rows of the leader in the lost time range repair the lagging replica
+	var scanned int
+	ranges := []util.TimeRange{{Min: lost.StartTime, Max: lost.EndTime}}
+	err := leader.ScanRows("cpu", ranges, 30, func(batch []influx.Row) error {
+		require.LessOrEqual(t, len(batch), 30)
+		scanned += len(batch)
+		// the index keys are rebuilt when the rows are unmarshalled from the request
+		var pool []byte
+		for i := range batch {
+			require.True(t, isLost(&batch[i]))
+			pool = batch[i].UnmarshalIndexKeys(pool)
+		}
+		return writeData(lagging, batch, false)
+	})
+	require.NoError(t, err)
+	require.Equal(t, int(lost.Rows), scanned)
+
+	// the repaired rows are out of order, the measurement is pending until they are merged
+	lagging.ForceFlush()
+	d3 = digest(lagging)
+	require.True(t, d3.Measurements[0].Pending)
+	require.Equal(t, d1.Measurements[1], d3.Measurements[1])
+	require.Eventually(t, func() bool {
+		d3 = digest(lagging)
+		return !d3.Measurements[0].Pending
+	}, 10*time.Second, 100*time.Millisecond)
+	require.Equal(t, d1.Hash, d3.Hash)
+}
@@ -0,0 +1,361 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/lib/metaclient"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// shardKeyRange selects the series whose shard keys are in [min, max) of a range sharding shard.
+// The shard keys are built from the tags of the series in the same way as the coordinator routes the rows.
+type shardKeyRange struct {
+	min    string
+	max    string
+	ident  *meta.ShardIdentifier
+	client metaclient.MetaClient
+	idx    *tsi.MergeSetIndex
+
+	mu   sync.RWMutex
+	keys map[string][]string // measurement -> shard key tags
+	sids map[uint64]bool     // series id -> in the range
+}
+
+func newShardKeyRange(min, max string, ident *meta.ShardIdentifier, client metaclient.MetaClient, idx *tsi.MergeSetIndex) *shardKeyRange {
+	return &shardKeyRange{
+		min:    min,
+		max:    max,
+		ident:  ident,
+		client: client,
+		idx:    idx,
+		keys:   make(map[string][]string),
+		sids:   make(map[uint64]bool),
+	}
+}
+
+// contains returns whether the series is in the range, the series whose shard key can not be built are kept
+func (r *shardKeyRange) contains(mst string, sid uint64) bool {
+	r.mu.RLock()
+	in, ok := r.sids[sid]
+	r.mu.RUnlock()
+	if ok {
+		return in
+	}
+
+	in = true
+	row := &influx.Row{Name: mst}
+	err := r.idx.GetSeries(sid, nil, nil, func(sk *influx.SeriesKey) {
+		for _, kv := range sk.TagSet {
+			row.Tags = append(row.Tags, influx.Tag{Key: string(kv.Key), Value: string(kv.Value)})
+		}
+	})
+	if err == nil {
+		err = r.setShardKey(row)
+	}
+	if err == nil {
+		key := string(row.ShardKey)
+		in = key >= r.min && (r.max == "" || key < r.max)
+	}
+
+	r.mu.Lock()
+	r.sids[sid] = in
+	r.mu.Unlock()
+	return in
+}
+
+// setShardKey builds the shard key of the row by the shard key tags of the measurement
+func (r *shardKeyRange) setShardKey(row *influx.Row) error {
+	keys, err := r.shardKeys(row.Name)
+	if err != nil {
+		return err
+	}
+	sort.Sort(&row.Tags)
+	return row.UnmarshalShardKeyByTag(keys)
+}
+
+func (r *shardKeyRange) shardKeys(mst string) ([]string, error) {
+	r.mu.RLock()
+	keys, ok := r.keys[mst]
+	r.mu.RUnlock()
+	if ok {
+		return keys, nil
+	}
+
+	dbi, err := r.client.Database(r.ident.OwnerDb)
+	if err != nil {
+		return nil, err
+	}
+	if len(dbi.ShardKey.ShardKey) > 0 {
+		keys = dbi.ShardKey.ShardKey
+	} else {
+		mi, err := r.client.Measurement(r.ident.OwnerDb, r.ident.Policy, influx.GetOriginMstName(mst))
+		if err != nil {
+			return nil, err
+		}
+		if ski := mi.GetShardKey(r.ident.ShardGroupID); ski != nil {
+			keys = ski.ShardKey
+		}
+	}
+
+	r.mu.Lock()
+	r.keys[mst] = keys
+	r.mu.Unlock()
+	return keys, nil
+}
+
+// filter removes the series out of the range from the tag sets, the empty tag sets are removed
+func (r *shardKeyRange) filter(mst string, tagSets tsi.GroupSeries) tsi.GroupSeries {
+	n := 0
+	for _, tagSet := range tagSets {
+		tagSet.Retain(func(sid uint64) bool {
+			return r.contains(mst, sid)
+		})
+		if tagSet.Len() > 0 {
+			tagSets[n] = tagSet
+			n++
+		}
+	}
+	return tagSets[:n]
+}
+
+// SplitKeyRange moves the rows of the series out of [min, max) of the shard to the shards split from it by fn in
+// batches, the shard keys of the rows are set for the routing of the range sharding. The queries of the shard skip
+// the series from the start of the split, and the series are removed from the files of the shard once they are moved.
+func (s *shard) SplitKeyRange(min, max string, client metaclient.MetaClient, batchRows int, fn func(rows []influx.Row) error) error {
+	idx, err := s.primaryIndex()
+	if err != nil {
+		return err
+	}
+	kr := newShardKeyRange(min, max, s.ident, client, idx)
+	s.keyRange.Store(kr)
+
+	// the files must not be compacted between the scan and the trim of them
+	if s.immTables.CompactionEnabled() {
+		s.DisableCompAndMerge()
+		defer s.EnableCompAndMerge()
+	}
+	s.ForceFlush()
+
+	for _, mst := range s.tsspMeasurements() {
+		if err = s.splitMeasurement(mst, kr, batchRows, fn); err != nil {
+			return err
+		}
+	}
+
+	// the moved series are not in the files any more
+	s.keyRange.Store(nil)
+	return nil
+}
+
+// splitMeasurement moves the rows of the series out of the key range in the files of the measurement,
+// then rewrites the files without the moved series
+func (s *shard) splitMeasurement(mst string, kr *shardKeyRange, batchRows int, fn func(rows []influx.Row) error) error {
+	keep := func(sid uint64) bool {
+		return kr.contains(mst, sid)
+	}
+	p := &rowsPerformer{idx: kr.idx, mst: mst, batchRows: batchRows, tags: make(map[uint64][]influx.Tag)}
+	p.filter = func(sid uint64) bool {
+		return !keep(sid)
+	}
+	p.fn = func(rows []influx.Row) error {
+		for i := range rows {
+			if err := kr.setShardKey(&rows[i]); err != nil {
+				return err
+			}
+		}
+		return fn(rows)
+	}
+
+	var files [2][]immutable.TSSPFile
+	defer func() {
+		for i := range files {
+			for _, f := range files[i] {
+				f.UnrefFileReader()
+				f.Unref()
+			}
+		}
+	}()
+	for i, isOrder := range []bool{true, false} {
+		if tfs, ok := s.immTables.GetTSSPFiles(mst, isOrder); ok {
+			files[i] = append(files[i], tfs.Files()...)
+		}
+		for _, f := range files[i] {
+			if err := s.scanFile(p, f); err != nil {
+				return err
+			}
+		}
+	}
+	if err := p.flushRows(); err != nil {
+		return err
+	}
+
+	for i, isOrder := range []bool{true, false} {
+		if err := s.immTables.TrimFiles(mst, files[i], isOrder, keep); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreKeyRange makes the queries skip the moved series if the shard is reopened during the split,
+// the moved rows may be left in the files until the split is retried
+func (s *shard) restoreKeyRange(client metaclient.MetaClient) {
+	if client == nil || s.ident.ShardType != meta.RANGE {
+		return
+	}
+	_, _, sgi := client.ShardOwner(s.ident.ShardID)
+	if sgi == nil {
+		return
+	}
+	sh := sgi.Shard(s.ident.ShardID)
+	if sh == nil || !sgi.Splitting(sh.ID) {
+		return
+	}
+	idx, err := s.primaryIndex()
+	if err != nil {
+		s.log.Warn("restore key range of the shard failed", zap.Uint64("shard", s.ident.ShardID), zap.Error(err))
+		return
+	}
+	s.keyRange.Store(newShardKeyRange(sh.Min, sh.Max, s.ident, client, idx))
+}
+
+// WriteSplitRows writes the rows moved from the shard split to the shard. The rows whose points already exist in the
+// shard are skipped, the points have been written to the shard after the split or moved by a previous try.
+func (s *shard) WriteSplitRows(rows []influx.Row) (int, error) {
+	s.splitMu.Lock()
+	defer s.splitMu.Unlock()
+
+	rows, err := s.newSplitRows(rows)
+	if err != nil || len(rows) == 0 {
+		return 0, err
+	}
+	binaryRows, err := influx.FastMarshalMultiRows(nil, rows)
+	if err != nil {
+		return 0, err
+	}
+	return len(rows), s.writeShardRows(rows, binaryRows)
+}
+
+// splitSeries is a series of the rows moved to the shard
+type splitSeries struct {
+	mst    string
+	sid    uint64
+	tr     util.TimeRange
+	schema record.Schemas
+	exists map[int64]bool // time of the moved rows -> the point exists in the shard
+}
+
+func (ss *splitSeries) add(row *influx.Row) {
+	if row.Timestamp < ss.tr.Min {
+		ss.tr.Min = row.Timestamp
+	}
+	if row.Timestamp > ss.tr.Max {
+		ss.tr.Max = row.Timestamp
+	}
+	ss.exists[row.Timestamp] = false
+	for _, f := range row.Fields {
+		if ss.schema.FieldIndex(f.Key) < 0 {
+			ss.schema = append(ss.schema, record.Field{Name: f.Key, Type: int(f.Type)})
+		}
+	}
+}
+
+// newSplitRows returns the moved rows whose points are not in the shard
+func (s *shard) newSplitRows(rows []influx.Row) ([]influx.Row, error) {
+	idx, err := s.primaryIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	sids := make([]uint64, len(rows))
+	series := make(map[uint64]*splitSeries)
+	for i := range rows {
+		sids[i], err = idx.GetSeriesIdBySeriesKey(rows[i].IndexKey)
+		if err != nil {
+			return nil, err
+		}
+		if sids[i] == 0 {
+			// the series is new to the shard
+			continue
+		}
+		ss, ok := series[sids[i]]
+		if !ok {
+			ss = &splitSeries{mst: rows[i].Name, sid: sids[i], exists: make(map[int64]bool)}
+			ss.tr = util.TimeRange{Min: rows[i].Timestamp, Max: rows[i].Timestamp}
+			series[sids[i]] = ss
+		}
+		ss.add(&rows[i])
+	}
+
+	for _, ss := range series {
+		if err = s.markExistingPoints(ss); err != nil {
+			return nil, err
+		}
+	}
+
+	n := 0
+	for i := range rows {
+		if sids[i] != 0 && series[sids[i]].exists[rows[i].Timestamp] {
+			continue
+		}
+		rows[n] = rows[i]
+		n++
+	}
+	return rows[:n], nil
+}
+
+// markExistingPoints marks the times of the moved rows which already have points of the series in the shard
+func (s *shard) markExistingPoints(ss *splitSeries) error {
+	readers, memTables := s.cloneReaders(ss.mst, true, ss.tr)
+	defer unRefReaders(readers, memTables)
+
+	mark := func(times []int64) {
+		for _, t := range times {
+			if _, ok := ss.exists[t]; ok {
+				ss.exists[t] = true
+			}
+		}
+	}
+
+	var times []int64
+	var err error
+	for _, files := range []immutable.TableReaders{readers.Orders, readers.OutOfOrders} {
+		for _, f := range files {
+			f.RefFileReader()
+			times, err = immutable.AppendSeriesTimes(f, ss.sid, ss.tr, times[:0])
+			f.UnrefFileReader()
+			if err != nil {
+				return err
+			}
+			mark(times)
+		}
+	}
+
+	sort.Sort(ss.schema)
+	schema := append(ss.schema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+	if rec := memTables.Values(ss.mst, ss.sid, ss.tr, schema, true); rec != nil {
+		mark(rec.Times())
+	}
+	return nil
+}
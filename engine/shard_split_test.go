@@ -0,0 +1,146 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func newSplitTestShard(t *testing.T) *shard {
+	sh, err := createShard(defaultDb, defaultRp, defaultPtId, t.TempDir(), config.TSSTORE)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, closeShard(sh))
+	})
+	return sh
+}
+
+func scanAllRows(t *testing.T, sh *shard, mst string) []influx.Row {
+	var rows []influx.Row
+	err := sh.ScanRows(mst, []util.TimeRange{{Min: influxql.MinTime, Max: influxql.MaxTime}}, 100, func(batch []influx.Row) error {
+		rows = append(rows, batch...)
+		return nil
+	})
+	require.NoError(t, err)
+	return rows
+}
+
+func TestShard_WriteSplitRows(t *testing.T) {
+	st := time.Now().Truncate(time.Hour).Add(-time.Hour)
+	rows, _, _ := GenDataRecord([]string{"cpu"}, 2, 100, time.Second, st, true, true, false)
+	before := func(d time.Duration) func(r *influx.Row) bool {
+		return func(r *influx.Row) bool {
+			return r.Timestamp < st.Add(d).UnixNano()
+		}
+	}
+	filter := func(keep func(r *influx.Row) bool) []influx.Row {
+		var dst []influx.Row
+		for i := range rows {
+			if keep(&rows[i]) {
+				dst = append(dst, rows[i])
+			}
+		}
+		return dst
+	}
+
+	// the points written after the split are in the files and the memtable
+	sh := newSplitTestShard(t)
+	require.NoError(t, writeData(sh, filter(before(50*time.Second)), true))
+	require.NoError(t, writeData(sh, filter(func(r *influx.Row) bool {
+		return !before(50*time.Second)(r) && before(70*time.Second)(r)
+	}), false))
+
+	// the moved rows are older than the points written after the split
+	moved := make([]influx.Row, len(rows))
+	for i := range rows {
+		moved[i] = rows[i]
+		moved[i].Fields = append(influx.Fields{}, rows[i].Fields...)
+		for j := range moved[i].Fields {
+			if moved[i].Fields[j].Type == influx.Field_Type_Float {
+				moved[i].Fields[j].NumValue = -1
+			}
+		}
+	}
+	n, err := sh.WriteSplitRows(moved)
+	require.NoError(t, err)
+	require.Equal(t, len(filter(func(r *influx.Row) bool { return !before(70 * time.Second)(r) })), n)
+
+	// moved again by a retried split
+	n, err = sh.WriteSplitRows(moved)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	sh.ForceFlush()
+	scanned := scanAllRows(t, sh, "cpu")
+	require.Equal(t, len(rows), len(scanned))
+	for i := range scanned {
+		overwritten := false
+		for _, f := range scanned[i].Fields {
+			overwritten = overwritten || (f.Type == influx.Field_Type_Float && f.NumValue == -1)
+		}
+		require.Equal(t, !before(70*time.Second)(&scanned[i]), overwritten)
+	}
+}
+
+func TestShard_TrimFiles(t *testing.T) {
+	st := time.Now().Truncate(time.Hour).Add(-time.Hour)
+	rows, _, _ := GenDataRecord([]string{"cpu"}, 4, 50, time.Second, st, true, true, false)
+	sh := newSplitTestShard(t)
+	require.NoError(t, writeData(sh, rows, true))
+
+	mi, err := sh.primaryIndex()
+	require.NoError(t, err)
+	dropped, err := mi.GetSeriesIdBySeriesKey(rows[0].IndexKey)
+	require.NoError(t, err)
+	require.NotZero(t, dropped)
+	var onlyDropped []influx.Row
+	for i := range rows {
+		if string(rows[i].IndexKey) == string(rows[0].IndexKey) {
+			r := rows[i]
+			r.Timestamp += int64(time.Hour)
+			onlyDropped = append(onlyDropped, r)
+		}
+	}
+	// the file holding only the dropped series is removed
+	require.NoError(t, writeData(sh, onlyDropped, true))
+	require.Equal(t, 2, sh.immTables.GetTableFileNum("cpu", true))
+
+	tfs, ok := sh.immTables.GetTSSPFiles("cpu", true)
+	require.True(t, ok)
+	files := append([]immutable.TSSPFile{}, tfs.Files()...)
+	keep := func(sid uint64) bool {
+		return sid != dropped
+	}
+	require.NoError(t, sh.immTables.TrimFiles("cpu", files, true, keep))
+	for _, f := range files {
+		f.UnrefFileReader()
+		f.Unref()
+	}
+	require.Equal(t, 1, sh.immTables.GetTableFileNum("cpu", true))
+
+	scanned := scanAllRows(t, sh, "cpu")
+	require.Equal(t, len(rows)-len(onlyDropped), len(scanned))
+	for i := range scanned {
+		require.NotEqual(t, string(rows[0].IndexKey), string(scanned[i].UnmarshalIndexKeys(nil)))
+	}
+}
@@ -205,6 +205,8 @@ func (e *Engine) processReq(req *netstorage.SysCtrlRequest) (map[string]string,
 		}
 		e.backup.IsAborted = true
 		return nil, nil
+	case syscontrol.VerifyBackup:
+		return e.processVerifyBackup(req)
 	default:
 		return nil, fmt.Errorf("unknown sys cmd %v", req.Mod())
 	}
@@ -223,6 +225,13 @@ func (e *Engine) processBackup(req *netstorage.SysCtrlRequest) error {
 	isRemote := params[backup.IsRemote] == "true"
 	isInc := params[backup.IsInc] == "true"
 	onlyBackupMater := params[backup.OnlyBackupMaster] == "true"
+	var retention int64
+	if _, ok := params[backup.Retention]; ok {
+		var err error
+		if retention, err = syscontrol.GetIntValue(params, backup.Retention); err != nil {
+			return err
+		}
+	}
 
 	e.backup = &Backup{
 		IsInc:           isInc,
@@ -230,6 +239,9 @@ func (e *Engine) processBackup(req *netstorage.SysCtrlRequest) error {
 		BackupPath:      backupPath,
 		OnlyBackupMater: onlyBackupMater,
 		Engine:          e,
+		Target:          params[backup.Target],
+		Node:            BackupNode(),
+		Retention:       int(retention),
 	}
 
 	if err := e.backup.RunBackupData(); err != nil {
@@ -239,6 +251,23 @@ func (e *Engine) processBackup(req *netstorage.SysCtrlRequest) error {
 	return nil
 }
 
+func (e *Engine) processVerifyBackup(req *netstorage.SysCtrlRequest) (map[string]string, error) {
+	params := req.Param()
+	target := params[backup.Target]
+	if target == "" {
+		return nil, fmt.Errorf("invalid parameter %s", backup.Target)
+	}
+	res, err := backup.Verify(target, BackupNode(), params[backup.ManifestParam])
+	if err != nil {
+		log.Error("verify backup error", zap.Error(err))
+		return nil, err
+	}
+	if !res.OK() {
+		return nil, fmt.Errorf("%s", res.String())
+	}
+	return map[string]string{res.Manifest: res.String()}, nil
+}
+
 func handleFailpoint(req *netstorage.SysCtrlRequest) error {
 	switchon, err := syscontrol.GetBoolValue(req.Param(), "switchon")
 	if err != nil {
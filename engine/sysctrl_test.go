@@ -369,3 +369,20 @@ func TestUpperMemUsePct(t *testing.T) {
 		t.Error("TestUpperMemUsePct fail")
 	}
 }
+
+func TestEngine_processReq_verifyBackup(t *testing.T) {
+	log = logger.NewLogger(errno.ModuleUnknown).SetZapLogger(zap.NewNop())
+	e := Engine{
+		log: log,
+	}
+	req := &netstorage.SysCtrlRequest{}
+	req.SetMod(syscontrol.VerifyBackup)
+	_, err := e.processReq(req)
+	require.Error(t, err)
+
+	req.SetParam(map[string]string{
+		"target": t.TempDir(),
+	})
+	_, err = e.processReq(req)
+	require.Error(t, err)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/fasttime"
 	"github.com/openGemini/openGemini/engine/immutable"
 	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/lib/encoding"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/record"
@@ -60,7 +61,7 @@ func (storage *tsstoreImpl) WriteRowsToTable(s *shard, rows influx.Rows, mw *mst
 
 	// write index
 	indexErr := storage.WriteIndex(s, &rows, mw)
-	if indexErr != nil && !errno.Equal(indexErr, errno.SeriesLimited) {
+	if indexErr != nil && !errno.Equal(indexErr, errno.SeriesLimited, errno.MeasurementSeriesLimited) {
 		nodeMutableLimit.freeResource(curSize)
 		return indexErr
 	}
@@ -149,6 +150,22 @@ func (storage *tsstoreImpl) SetMstInfo(s *shard, name string, mstInfo *meta.Meas
 
 }
 
+// measurementCodecs looks up the codecs of a measurement in the meta data cached by the node
+func measurementCodecs(client metaclient.MetaClient) encoding.CodecsResolver {
+	return func(db, rp, name string) encoding.Codecs {
+		mst, err := client.Measurement(db, rp, influx.GetOriginMstName(name))
+		// the files of a dropped measurement version keep the default codecs
+		if err != nil || mst == nil || mst.Name != name || mst.Options == nil {
+			return encoding.Codecs{}
+		}
+		return encoding.Codecs{
+			Float:       mst.Options.FloatCodec,
+			Integer:     mst.Options.IntCodec,
+			ErrorBounds: mst.Options.ErrorBounds,
+		}
+	}
+}
+
 func (storage *tsstoreImpl) SetAccumulateMetaIndex(name string, detachedMetaInfo *immutable.AccumulateMetaIndex) {
 }
 
@@ -208,7 +225,7 @@ func (storage *tsstoreImpl) writeSnapshot(s *shard) {
 	s.commitSnapshot(s.snapshotTbl)
 	nodeMutableLimit.freeResource(curSize)
 
-	err = s.wal.Remove(walFiles)
+	err = s.removeWalFiles(walFiles)
 	if err != nil {
 		panic("wal remove files failed: " + err.Error())
 	}
@@ -122,6 +122,9 @@ type WAL struct {
 	walEnabled      bool
 	replayParallel  bool
 	replayBatchSize int
+
+	archiveMu  sync.Mutex
+	archiveSeq int64 // the sequence of the last archived wal file
 }
 
 func NewWAL(path string, lockPath *string, shardID uint64, walSyncInterval time.Duration, walEnabled, replayParallel bool, partitionNum int, walReplayBatchSize int) *WAL {
@@ -426,6 +429,53 @@ func (l *WAL) replayWalFile(ctx context.Context, walFileName string, callBack fu
 	}
 }
 
+// ReplayWalDir reads the rows written by line protocol in the wal files of a shard, partition by partition
+// from the oldest file to the newest. It is used by the offline tools which read the wal dir of a stopped
+// ts-store. The archived wal files are already flushed and are skipped. The rows are reused after fn returns.
+func ReplayWalDir(walPath string, fn func(rows []influx.Row) error) error {
+	dirs, err := fileops.ReadDir(walPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	var partitions []int
+	for i := range dirs {
+		idx, err := strconv.Atoi(dirs[i].Name())
+		if err == nil && dirs[i].IsDir() {
+			partitions = append(partitions, idx)
+		}
+	}
+	sort.Ints(partitions)
+
+	lockPath := ""
+	l := &WAL{
+		logPath:         walPath,
+		replayBatchSize: 256 * units.KiB,
+		log:             logger.NewLogger(errno.ModuleWal),
+		lock:            &lockPath,
+	}
+	for _, idx := range partitions {
+		var replay LogReplay
+		l.restoreLog(&LogWriter{logPath: filepath.Join(walPath, strconv.Itoa(idx))}, &replay)
+		for _, fileName := range replay.fileNames {
+			err = l.replayWalFile(context.Background(), fileName, func(wr *walRecord) error {
+				if wr.rowsObjs == nil {
+					// the records written by arrow flight belong to the column store
+					return nil
+				}
+				defer putWalRowsObjects(wr.rowsObjs)
+				return fn(wr.rowsObjs.rows)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (l *WAL) replayOnePartition(ctx context.Context, idx int, callBack func(pc *walRecord) error) error {
 	for _, fileName := range l.logReplay[idx].fileNames {
 		select {
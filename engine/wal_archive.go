@@ -0,0 +1,264 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// the directory of the wal files flushed to tssp files but not yet shipped to the replication target
+const walArchiveDir = "archive"
+
+// the databases replicated to the other cluster, key: database name
+var replicatedDatabases sync.Map
+
+// SetAsyncReplication marks whether the wal files of the database are archived for the asynchronous replication
+func (e *Engine) SetAsyncReplication(db string, enabled bool) {
+	if enabled {
+		replicatedDatabases.Store(db, struct{}{})
+		return
+	}
+	replicatedDatabases.Delete(db)
+}
+
+func asyncReplicated(db string) bool {
+	_, ok := replicatedDatabases.Load(db)
+	return ok
+}
+
+func (l *WAL) archivePath() string {
+	return filepath.Join(l.logPath, walArchiveDir)
+}
+
+// Archive moves the wal files which have been flushed to the archive directory instead of removing them.
+// The archived files are named by an increasing sequence, the replication ships them in sequence order.
+func (l *WAL) Archive(files []string) error {
+	if !l.walEnabled || len(files) == 0 {
+		return nil
+	}
+	l.archiveMu.Lock()
+	defer l.archiveMu.Unlock()
+
+	lock := fileops.FileLockOption(*l.lock)
+	dir := l.archivePath()
+	if err := fileops.MkdirAll(dir, 0750, lock); err != nil {
+		return err
+	}
+	if l.archiveSeq == 0 {
+		seqs, err := archivedSeqs(dir)
+		if err != nil {
+			return err
+		}
+		if len(seqs) > 0 {
+			l.archiveSeq = seqs[len(seqs)-1]
+		}
+	}
+
+	for _, fn := range files {
+		stat, err := fileops.Stat(fn)
+		if err == nil && stat.Size() == 0 {
+			err = fileops.Remove(fn, lock)
+		} else if err == nil {
+			l.archiveSeq = max(time.Now().UnixNano(), l.archiveSeq+1)
+			err = fileops.RenameFile(fn, filepath.Join(dir, fmt.Sprintf("%d.%s", l.archiveSeq, WALFileSuffixes)), lock)
+		}
+		if err != nil && !os.IsNotExist(err) {
+			l.log.Error("failed to archive wal file", zap.String("file", fn), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// archivedSeqs returns the sequences of the archived wal files in ascending order
+func archivedSeqs(dir string) ([]int64, error) {
+	dirs, err := fileops.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	seqs := make([]int64, 0, len(dirs))
+	for i := range dirs {
+		name, ok := strings.CutSuffix(dirs[i].Name(), "."+WALFileSuffixes)
+		if !ok {
+			continue
+		}
+		seq, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool {
+		return seqs[i] < seqs[j]
+	})
+	return seqs, nil
+}
+
+// removeWalFiles removes the wal files which have been flushed. The files are archived if the database is
+// replicated to the other cluster, or the shard has archived files, e.g. the wal replayed after restart.
+func (s *shard) removeWalFiles(files []string) error {
+	if asyncReplicated(s.ident.OwnerDb) {
+		return s.wal.Archive(files)
+	}
+	if _, err := fileops.Stat(s.wal.archivePath()); err == nil {
+		return s.wal.Archive(files)
+	}
+	return s.wal.Remove(files)
+}
+
+// ReplicationSegments returns the archived wal files of the shards waiting to be shipped to the replication target,
+// sorted by shard and sequence
+func (e *Engine) ReplicationSegments() []*netstorage.ReplicationSegment {
+	var wals []*shard
+	e.mu.RLock()
+	for db := range e.DBPartitions {
+		for _, pt := range e.DBPartitions[db] {
+			pt.mu.RLock()
+			for _, sh := range pt.shards {
+				if s, ok := sh.(*shard); ok {
+					wals = append(wals, s)
+				}
+			}
+			pt.mu.RUnlock()
+		}
+	}
+	e.mu.RUnlock()
+
+	var segments []*netstorage.ReplicationSegment
+	for _, s := range wals {
+		dir := s.wal.archivePath()
+		seqs, err := archivedSeqs(dir)
+		if err != nil {
+			e.log.Error("read wal archive failed", zap.String("path", dir), zap.Error(err))
+			continue
+		}
+		for _, seq := range seqs {
+			path := filepath.Join(dir, fmt.Sprintf("%d.%s", seq, WALFileSuffixes))
+			stat, err := fileops.Stat(path)
+			if err != nil {
+				continue
+			}
+			segments = append(segments, &netstorage.ReplicationSegment{
+				Db:      s.ident.OwnerDb,
+				Rp:      s.ident.Policy,
+				PtId:    s.ident.OwnerPt,
+				ShardID: s.ident.ShardID,
+				Seq:     seq,
+				Path:    path,
+				Size:    stat.Size(),
+			})
+		}
+	}
+	sort.SliceStable(segments, func(i, j int) bool {
+		if segments[i].ShardID != segments[j].ShardID {
+			return segments[i].ShardID < segments[j].ShardID
+		}
+		return segments[i].Seq < segments[j].Seq
+	})
+	return segments
+}
+
+// ReplayReplicationSegment reads the rows written by line protocol in the archived wal file,
+// the rows are reused after fn returns. The records written by arrow flight are not replicated,
+// it returns the number of the skipped records.
+func (e *Engine) ReplayReplicationSegment(seg *netstorage.ReplicationSegment, fn func(rows []influx.Row) error) (int64, error) {
+	s, err := e.replicationShard(seg)
+	if err != nil {
+		return 0, err
+	}
+	var skipped int64
+	err = s.wal.replayWalFile(context.Background(), seg.Path, func(wr *walRecord) error {
+		if wr.rowsObjs == nil {
+			skipped++
+			return nil
+		}
+		defer putWalRowsObjects(wr.rowsObjs)
+		return fn(wr.rowsObjs.rows)
+	})
+	return skipped, err
+}
+
+// RemoveReplicationSegment removes the archived wal file which has been shipped to the replication target
+func (e *Engine) RemoveReplicationSegment(seg *netstorage.ReplicationSegment) error {
+	s, err := e.replicationShard(seg)
+	if err != nil {
+		return err
+	}
+	err = fileops.Remove(seg.Path, fileops.FileLockOption(*s.wal.lock))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// DropReplicationArchive removes the archived wal files of the database whose replication has been dropped
+func (e *Engine) DropReplicationArchive(db string) error {
+	var wals []*WAL
+	e.mu.RLock()
+	for _, pt := range e.DBPartitions[db] {
+		pt.mu.RLock()
+		for _, sh := range pt.shards {
+			if s, ok := sh.(*shard); ok {
+				wals = append(wals, s.wal)
+			}
+		}
+		pt.mu.RUnlock()
+	}
+	e.mu.RUnlock()
+
+	for _, l := range wals {
+		l.archiveMu.Lock()
+		err := fileops.RemoveAll(l.archivePath(), fileops.FileLockOption(*l.lock))
+		l.archiveSeq = 0
+		l.archiveMu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Engine) replicationShard(seg *netstorage.ReplicationSegment) (*shard, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	pt, ok := e.DBPartitions[seg.Db][seg.PtId]
+	if !ok {
+		return nil, errno.NewError(errno.PtNotFound)
+	}
+	pt.mu.RLock()
+	defer pt.mu.RUnlock()
+	s, ok := pt.shards[seg.ShardID].(*shard)
+	if !ok {
+		return nil, errno.NewError(errno.ShardNotFound, seg.ShardID)
+	}
+	return s, nil
+}
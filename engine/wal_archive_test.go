@@ -0,0 +1,89 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	assert1 "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWAL_Archive(t *testing.T) {
+	tmpDir := t.TempDir()
+	lock := ""
+	wal := &WAL{
+		log:        logger.NewLogger(errno.ModuleWal),
+		logPath:    tmpDir,
+		walEnabled: true,
+		lock:       &lock,
+	}
+	files := []string{filepath.Join(tmpDir, "1.wal"), filepath.Join(tmpDir, "2.wal"), filepath.Join(tmpDir, "3.wal")}
+	require.NoError(t, os.WriteFile(files[0], []byte{1}, 0600))
+	require.NoError(t, os.WriteFile(files[1], nil, 0600))
+	require.NoError(t, os.WriteFile(files[2], []byte{3}, 0600))
+	require.NoError(t, wal.Archive(files))
+
+	// the empty file is removed instead of archived
+	for _, fn := range files {
+		_, err := os.Stat(fn)
+		assert1.True(t, os.IsNotExist(err))
+	}
+	seqs, err := archivedSeqs(wal.archivePath())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(seqs))
+	assert1.Less(t, seqs[0], seqs[1])
+	buf, err := os.ReadFile(filepath.Join(wal.archivePath(), fmt.Sprintf("%d.wal", seqs[1])))
+	require.NoError(t, err)
+	assert1.Equal(t, []byte{3}, buf)
+
+	// the sequence continues after the archived files when the shard is reopened
+	wal.archiveSeq = 0
+	last := seqs[1]
+	require.NoError(t, os.WriteFile(files[0], []byte{4}, 0600))
+	require.NoError(t, wal.Archive(files[:1]))
+	seqs, err = archivedSeqs(wal.archivePath())
+	require.NoError(t, err)
+	require.Equal(t, 3, len(seqs))
+	assert1.Greater(t, seqs[2], last)
+}
+
+func TestArchivedSeqs(t *testing.T) {
+	tmpDir := t.TempDir()
+	seqs, err := archivedSeqs(filepath.Join(tmpDir, "not_exists"))
+	require.NoError(t, err)
+	assert1.Nil(t, seqs)
+
+	for _, name := range []string{"20.wal", "3.wal", "x.wal", "5.tmp"} {
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, name), []byte{1}, 0600))
+	}
+	seqs, err = archivedSeqs(tmpDir)
+	require.NoError(t, err)
+	assert1.Equal(t, []int64{3, 20}, seqs)
+}
+
+func TestEngine_SetAsyncReplication(t *testing.T) {
+	e := &Engine{}
+	e.SetAsyncReplication("db0", true)
+	assert1.True(t, asyncReplicated("db0"))
+	assert1.False(t, asyncReplicated("db1"))
+	e.SetAsyncReplication("db0", false)
+	assert1.False(t, asyncReplicated("db0"))
+}
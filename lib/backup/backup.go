@@ -39,6 +39,9 @@ const (
 	IsNode           = "isNode"
 	BackupPath       = "backupPath"
 	OnlyBackupMaster = "onlyBackupMaster"
+	Target           = "target"
+	Retention        = "retention"
+	ManifestParam    = "manifest"
 )
 
 func FileCopy(src, dst string) error {
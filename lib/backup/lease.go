@@ -0,0 +1,117 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+)
+
+const (
+	LeaseDir    = "leases"
+	LeaseSuffix = ".lease"
+
+	backupLease = "backup"
+	gcLease     = "gc"
+)
+
+// A lease is held by every in-flight backup and by the object collection of
+// ExpireChains. Each side writes its own lease before looking for the leases of
+// the other side, so at least one of them sees the other: the collection is
+// skipped while a backup is in flight, and a backup waits for a running
+// collection before it deduplicates against the existing objects.
+var (
+	// LeaseExpire ignores the leases left behind by crashed processes
+	LeaseExpire = 24 * time.Hour
+
+	GCWaitTimeout  = 10 * time.Minute
+	gcWaitInterval = time.Second
+)
+
+func acquireLease(target, kind, node string) (string, error) {
+	now := time.Now().UnixNano()
+	name := fmt.Sprintf("%s-%s-%020d", kind, node, now)
+	if err := fileops.MkdirAll(TargetPath(target, LeaseDir), 0750); err != nil {
+		return "", err
+	}
+	err := fileops.WriteFile(TargetPath(target, LeaseDir, name+LeaseSuffix), []byte(strconv.FormatInt(now, 10)), 0640)
+	if err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func releaseLease(target, name string) error {
+	err := fileops.Remove(TargetPath(target, LeaseDir, name+LeaseSuffix))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// liveLeases returns the unexpired leases of the kind, except the lease named self
+func liveLeases(target, kind, self string) ([]string, error) {
+	fis, err := fileops.ReadDir(TargetPath(target, LeaseDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var leases []string
+	expire := time.Now().Add(-LeaseExpire).UnixNano()
+	for _, fi := range fis {
+		name := strings.TrimSuffix(fi.Name(), LeaseSuffix)
+		if name == self || name == fi.Name() || !strings.HasPrefix(name, kind+"-") {
+			continue
+		}
+		buf, err := fileops.ReadFile(TargetPath(target, LeaseDir, fi.Name()))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		start, err := strconv.ParseInt(string(buf), 10, 64)
+		if err != nil || start < expire {
+			continue
+		}
+		leases = append(leases, name)
+	}
+	return leases, nil
+}
+
+// waitGC waits until no object collection is running in the target
+func waitGC(target string) error {
+	deadline := time.Now().Add(GCWaitTimeout)
+	for {
+		leases, err := liveLeases(target, gcLease, "")
+		if err != nil {
+			return err
+		}
+		if len(leases) == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("wait for backup object collection timeout, leases: [%s]", strings.Join(leases, ","))
+		}
+		time.Sleep(gcWaitInterval)
+	}
+}
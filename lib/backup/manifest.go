@@ -0,0 +1,135 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+)
+
+const (
+	ManifestDir    = "manifest"
+	ObjectDir      = "objects"
+	ManifestSuffix = ".manifest.json"
+)
+
+// ManifestFile describes one file of a backup set. The content is stored once
+// in the target under ObjectDir and is addressed by its checksum.
+type ManifestFile struct {
+	Path     string `json:"path"`
+	Size     int64  `json:"size"`
+	Checksum string `json:"checksum"`
+}
+
+// Manifest lists every file of a backup set. Incremental manifests still list
+// the complete file set, so that any manifest can be restored on its own;
+// Parent only links the manifest into its backup chain for retention.
+// Manifests are stored per node, so that the chains of the nodes sharing one
+// target do not interleave, while the objects are shared by all nodes.
+type Manifest struct {
+	Name       string         `json:"name"`
+	BackupTime int64          `json:"backupTime"`
+	IsInc      bool           `json:"isInc"`
+	Parent     string         `json:"parent,omitempty"`
+	Files      []ManifestFile `json:"files"`
+}
+
+func ManifestName(backupTime int64) string {
+	return fmt.Sprintf("%020d", backupTime)
+}
+
+// TargetPath joins elem to the target root. filepath.Join is not used because
+// it would collapse the "//" of remote paths such as obs://
+func TargetPath(target string, elem ...string) string {
+	return strings.TrimRight(target, "/") + "/" + strings.Join(elem, "/")
+}
+
+func ObjectPath(target, checksum string) string {
+	if len(checksum) < 2 {
+		return TargetPath(target, ObjectDir, checksum)
+	}
+	return TargetPath(target, ObjectDir, checksum[:2], checksum)
+}
+
+func ManifestPath(target, node, name string) string {
+	return TargetPath(target, ManifestDir, node, name+ManifestSuffix)
+}
+
+func WriteManifest(target, node string, m *Manifest) error {
+	content, err := json.MarshalIndent(m, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err = fileops.MkdirAll(TargetPath(target, ManifestDir, node), 0750); err != nil {
+		return err
+	}
+	return fileops.WriteFile(ManifestPath(target, node, m.Name), content, 0640)
+}
+
+func ReadManifest(target, node, name string) (*Manifest, error) {
+	buf, err := fileops.ReadFile(ManifestPath(target, node, name))
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	if err = json.Unmarshal(buf, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ListManifests returns the manifest names of the node from the oldest to the newest
+func ListManifests(target, node string) ([]string, error) {
+	fis, err := fileops.ReadDir(TargetPath(target, ManifestDir, node))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	names := make([]string, 0, len(fis))
+	for _, fi := range fis {
+		if fi.IsDir() || !strings.HasSuffix(fi.Name(), ManifestSuffix) {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(fi.Name(), ManifestSuffix))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// ListNodes returns the nodes having at least one manifest in the target
+func ListNodes(target string) ([]string, error) {
+	fis, err := fileops.ReadDir(TargetPath(target, ManifestDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	nodes := make([]string, 0, len(fis))
+	for _, fi := range fis {
+		if fi.IsDir() {
+			nodes = append(nodes, fi.Name())
+		}
+	}
+	sort.Strings(nodes)
+	return nodes, nil
+}
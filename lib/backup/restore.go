@@ -0,0 +1,66 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/obs"
+)
+
+// Restore copies every file of a backup set of the node from the target back
+// to the path it was backed up from. An empty name restores the newest backup
+// set. Each object is checked against the checksum in the manifest while it is
+// copied, and a file is only renamed into place once its content matches.
+func Restore(target, node, name string) (*Manifest, error) {
+	m, err := readManifestOrNewest(target, node, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range m.Files {
+		if err = restoreFile(target, f); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func restoreFile(target string, f ManifestFile) error {
+	tmp := f.Path + obs.ObsFileTmpSuffix
+	checksum, size, err := streamFile(ObjectPath(target, f.Checksum), tmp)
+	if err != nil {
+		return err
+	}
+	if checksum != f.Checksum || size != f.Size {
+		_ = fileops.Remove(tmp)
+		return fmt.Errorf("restore %s: object %s is corrupt", f.Path, f.Checksum)
+	}
+	return fileops.RenameFile(tmp, f.Path)
+}
+
+func readManifestOrNewest(target, node, name string) (*Manifest, error) {
+	if name == "" {
+		names, err := ListManifests(target, node)
+		if err != nil {
+			return nil, err
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("no backup set of node %s found in %s", node, target)
+		}
+		name = names[len(names)-1]
+	}
+	return ReadManifest(target, node, name)
+}
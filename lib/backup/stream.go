@@ -0,0 +1,243 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/obs"
+)
+
+const copyBufferSize = 1024 * 1024
+
+// objectTmpDir holds the objects being uploaded. It is not a valid checksum
+// prefix, so the objects left by an aborted backup are collected like any
+// other unreferenced object.
+const objectTmpDir = "tmp"
+
+// StreamBackup streams files directly into a backup target, which is either a
+// local directory or a remote path understood by fileops (obs://...).
+// File contents are stored content-addressed, so unchanged TSSP files are
+// uploaded only once across a chain of full and incremental backups.
+// The backup holds a lease in the target until it is committed or aborted,
+// see acquireLease.
+type StreamBackup struct {
+	mu       sync.Mutex
+	target   string
+	node     string
+	lease    string
+	manifest *Manifest
+	seen     map[string]struct{}
+
+	Uploaded     int64
+	Deduplicated int64
+}
+
+// NewStreamBackup starts a backup set of the node. An incremental backup set
+// follows the newest backup set of the same node.
+func NewStreamBackup(target, node string, backupTime int64, isInc bool) (*StreamBackup, error) {
+	if target == "" {
+		return nil, fmt.Errorf("backup target is empty")
+	}
+	if node == "" {
+		return nil, fmt.Errorf("backup node is empty")
+	}
+	m := &Manifest{
+		Name:       ManifestName(backupTime),
+		BackupTime: backupTime,
+	}
+	if isInc {
+		names, err := ListManifests(target, node)
+		if err != nil {
+			return nil, err
+		}
+		// an incremental backup without any previous backup set is a full backup
+		if len(names) > 0 {
+			m.IsInc = true
+			m.Parent = names[len(names)-1]
+		}
+	}
+
+	lease, err := acquireLease(target, backupLease, node)
+	if err != nil {
+		return nil, err
+	}
+	if err = waitGC(target); err != nil {
+		_ = releaseLease(target, lease)
+		return nil, err
+	}
+	return &StreamBackup{
+		target:   target,
+		node:     node,
+		lease:    lease,
+		manifest: m,
+		seen:     make(map[string]struct{}),
+	}, nil
+}
+
+func (s *StreamBackup) Manifest() *Manifest {
+	return s.manifest
+}
+
+// PutFile adds the src file to the backup set. The file is read once: it is
+// hashed while being copied into a temporary object, which is then renamed to
+// its content address, or dropped if the target already holds the content.
+func (s *StreamBackup) PutFile(src string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.seen[src]; ok {
+		return nil
+	}
+
+	tmp := TargetPath(s.target, ObjectDir, objectTmpDir,
+		fmt.Sprintf("%s-%s-%d%s", s.node, s.manifest.Name, len(s.manifest.Files), obs.ObsFileTmpSuffix))
+	checksum, size, err := streamFile(src, tmp)
+	if err != nil {
+		return err
+	}
+
+	dst := ObjectPath(s.target, checksum)
+	if fi, err := fileops.Stat(dst); err == nil && fi.Size() == size {
+		s.Deduplicated++
+		if err = fileops.Remove(tmp); err != nil {
+			return err
+		}
+	} else {
+		if err = fileops.MkdirAll(parentPath(dst), 0750); err != nil {
+			_ = fileops.Remove(tmp)
+			return err
+		}
+		if err = fileops.RenameFile(tmp, dst); err != nil {
+			_ = fileops.Remove(tmp)
+			return err
+		}
+		s.Uploaded++
+	}
+
+	s.seen[src] = struct{}{}
+	s.manifest.Files = append(s.manifest.Files, ManifestFile{
+		Path:     src,
+		Size:     size,
+		Checksum: checksum,
+	})
+	return nil
+}
+
+// Commit writes the manifest, the backup set is not visible before that
+func (s *StreamBackup) Commit() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := WriteManifest(s.target, s.node, s.manifest); err != nil {
+		return err
+	}
+	return releaseLease(s.target, s.lease)
+}
+
+// Abort releases the lease of a backup set that is not committed. The objects
+// already uploaded are collected by a later ExpireChains.
+func (s *StreamBackup) Abort() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return releaseLease(s.target, s.lease)
+}
+
+func fileChecksum(name string) (string, int64, error) {
+	fd, err := fileops.Open(name)
+	if err != nil {
+		return "", 0, err
+	}
+	defer fd.Close()
+
+	h := sha256.New()
+	size, err := copyWithLimit(h, fd)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), size, nil
+}
+
+// streamFile copies src into dst and returns the checksum of the copied content.
+// dst is removed if the copy fails.
+func streamFile(src, dst string) (string, int64, error) {
+	fd, err := fileops.Open(src)
+	if err != nil {
+		return "", 0, err
+	}
+	defer fd.Close()
+
+	if err = fileops.MkdirAll(parentPath(dst), 0750); err != nil {
+		return "", 0, err
+	}
+	out, err := fileops.Create(dst)
+	if err != nil {
+		return "", 0, err
+	}
+	h := sha256.New()
+	size, err := copyWithLimit(io.MultiWriter(out, h), fd)
+	if err == nil {
+		err = out.Sync()
+	}
+	if e := out.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		_ = fileops.Remove(dst)
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), size, nil
+}
+
+// copyWithLimit copies src to dst through the background read limiter, so that
+// backups do not starve the foreground read path
+func copyWithLimit(dst io.Writer, src io.Reader) (int64, error) {
+	size := copyBufferSize
+	if burst := fileops.BackgroundReadLimiter.Burst(); burst > 0 && burst < size {
+		size = burst
+	}
+	buf := make([]byte, size)
+	var total int64
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			if e := fileops.BackGroundReaderWait(n); e != nil {
+				return total, e
+			}
+			if _, e := dst.Write(buf[:n]); e != nil {
+				return total, e
+			}
+			total += int64(n)
+		}
+		if err == io.EOF {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+func parentPath(p string) string {
+	for i := len(p) - 1; i >= 0; i-- {
+		if p[i] == '/' {
+			return p[:i]
+		}
+	}
+	return p
+}
@@ -0,0 +1,285 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFile(t *testing.T, name, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(name), 0750))
+	require.NoError(t, os.WriteFile(name, []byte(content), 0640))
+}
+
+const testNode = "1"
+
+func runStreamBackup(t *testing.T, target string, backupTime int64, isInc bool, files ...string) *StreamBackup {
+	return runNodeStreamBackup(t, target, testNode, backupTime, isInc, files...)
+}
+
+func runNodeStreamBackup(t *testing.T, target, node string, backupTime int64, isInc bool, files ...string) *StreamBackup {
+	s, err := NewStreamBackup(target, node, backupTime, isInc)
+	require.NoError(t, err)
+	for _, f := range files {
+		require.NoError(t, s.PutFile(f))
+	}
+	require.NoError(t, s.Commit())
+	return s
+}
+
+func TestStreamBackup_Dedup(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target")
+	f1 := filepath.Join(dir, "data", "00000001-0000-00000000.tssp")
+	f2 := filepath.Join(dir, "data", "00000002-0000-00000000.tssp")
+	writeTestFile(t, f1, "file1")
+	writeTestFile(t, f2, "file2")
+
+	full := runStreamBackup(t, target, 1, false, f1, f2, f1)
+	require.Equal(t, int64(2), full.Uploaded)
+	require.Equal(t, 2, len(full.Manifest().Files))
+
+	f3 := filepath.Join(dir, "data", "00000003-0000-00000000.tssp")
+	writeTestFile(t, f3, "file3")
+	inc := runStreamBackup(t, target, 2, true, f1, f2, f3)
+	require.True(t, inc.Manifest().IsInc)
+	require.Equal(t, ManifestName(1), inc.Manifest().Parent)
+	require.Equal(t, int64(1), inc.Uploaded)
+	require.Equal(t, int64(2), inc.Deduplicated)
+
+	names, err := ListManifests(target, testNode)
+	require.NoError(t, err)
+	require.Equal(t, []string{ManifestName(1), ManifestName(2)}, names)
+}
+
+func TestStreamBackup_IncWithoutParent(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStreamBackup(filepath.Join(dir, "target"), testNode, 1, true)
+	require.NoError(t, err)
+	require.False(t, s.Manifest().IsInc)
+	require.NoError(t, s.Abort())
+
+	_, err = NewStreamBackup("", testNode, 1, false)
+	require.Error(t, err)
+	_, err = NewStreamBackup(filepath.Join(dir, "target"), "", 1, false)
+	require.Error(t, err)
+}
+
+func TestVerify(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target")
+	f1 := filepath.Join(dir, "data", "a.tssp")
+	f2 := filepath.Join(dir, "data", "b.tssp")
+	writeTestFile(t, f1, "aaaa")
+	writeTestFile(t, f2, "bbbb")
+	s := runStreamBackup(t, target, 1, false, f1, f2)
+
+	res, err := Verify(target, testNode, "")
+	require.NoError(t, err)
+	require.True(t, res.OK())
+	require.Equal(t, 2, res.Files)
+	require.Equal(t, int64(8), res.Bytes)
+
+	files := s.Manifest().Files
+	writeTestFile(t, ObjectPath(target, files[0].Checksum), "aaab")
+	require.NoError(t, os.Remove(ObjectPath(target, files[1].Checksum)))
+	res, err = Verify(target, testNode, ManifestName(1))
+	require.NoError(t, err)
+	require.False(t, res.OK())
+	require.Equal(t, []string{f1}, res.Corrupt)
+	require.Equal(t, []string{f2}, res.Missing)
+
+	_, err = Verify(filepath.Join(dir, "empty"), testNode, "")
+	require.Error(t, err)
+}
+
+func TestExpireChains(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target")
+	f1 := filepath.Join(dir, "data", "a.tssp")
+	f2 := filepath.Join(dir, "data", "b.tssp")
+	writeTestFile(t, f1, "old")
+	writeTestFile(t, f2, "keep")
+
+	old := runStreamBackup(t, target, 1, false, f1, f2)
+	runStreamBackup(t, target, 2, true, f1, f2)
+	writeTestFile(t, f1, "new")
+	runStreamBackup(t, target, 3, false, f1, f2)
+	runStreamBackup(t, target, 4, true, f1, f2)
+
+	expired, err := ExpireChains(target, testNode, 2)
+	require.NoError(t, err)
+	require.Empty(t, expired)
+
+	expired, err = ExpireChains(target, testNode, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{ManifestName(1), ManifestName(2)}, expired)
+
+	names, err := ListManifests(target, testNode)
+	require.NoError(t, err)
+	require.Equal(t, []string{ManifestName(3), ManifestName(4)}, names)
+
+	_, err = os.Stat(ObjectPath(target, old.Manifest().Files[0].Checksum))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(ObjectPath(target, old.Manifest().Files[1].Checksum))
+	require.NoError(t, err)
+
+	res, err := Verify(target, testNode, ManifestName(4))
+	require.NoError(t, err)
+	require.True(t, res.OK())
+}
+
+func TestExpireChains_SharedTarget(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target")
+	f1 := filepath.Join(dir, "data1", "a.tssp")
+	f2 := filepath.Join(dir, "data2", "b.tssp")
+	writeTestFile(t, f1, "node1")
+	writeTestFile(t, f2, "node2")
+
+	runNodeStreamBackup(t, target, "1", 1, false, f1)
+	other := runNodeStreamBackup(t, target, "2", 2, false, f2)
+	inc := runNodeStreamBackup(t, target, "1", 3, true, f1)
+	// the parent is the newest backup set of the same node
+	require.Equal(t, ManifestName(1), inc.Manifest().Parent)
+	runNodeStreamBackup(t, target, "1", 4, false, f1)
+
+	expired, err := ExpireChains(target, "1", 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{ManifestName(1), ManifestName(3)}, expired)
+
+	names, err := ListManifests(target, "2")
+	require.NoError(t, err)
+	require.Equal(t, []string{ManifestName(2)}, names)
+	_, err = os.Stat(ObjectPath(target, other.Manifest().Files[0].Checksum))
+	require.NoError(t, err)
+}
+
+func TestExpireChains_BackupInFlight(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target")
+	f1 := filepath.Join(dir, "data", "a.tssp")
+	writeTestFile(t, f1, "old")
+	old := runStreamBackup(t, target, 1, false, f1)
+	writeTestFile(t, f1, "new")
+	runStreamBackup(t, target, 2, false, f1)
+
+	// the object uploaded by the backup in flight is not referenced by any manifest yet
+	f2 := filepath.Join(dir, "data", "b.tssp")
+	writeTestFile(t, f2, "in flight")
+	inFlight, err := NewStreamBackup(target, "2", 3, false)
+	require.NoError(t, err)
+	require.NoError(t, inFlight.PutFile(f2))
+
+	expired, err := ExpireChains(target, testNode, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{ManifestName(1)}, expired)
+	_, err = os.Stat(ObjectPath(target, old.Manifest().Files[0].Checksum))
+	require.NoError(t, err)
+	_, err = os.Stat(ObjectPath(target, inFlight.Manifest().Files[0].Checksum))
+	require.NoError(t, err)
+
+	// the next run collects the objects once no backup is in flight
+	require.NoError(t, inFlight.Commit())
+	runStreamBackup(t, target, 4, false, f1)
+	_, err = ExpireChains(target, testNode, 1)
+	require.NoError(t, err)
+	_, err = os.Stat(ObjectPath(target, old.Manifest().Files[0].Checksum))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(ObjectPath(target, inFlight.Manifest().Files[0].Checksum))
+	require.NoError(t, err)
+}
+
+func TestStreamBackup_WaitGC(t *testing.T) {
+	timeout := GCWaitTimeout
+	GCWaitTimeout = 0
+	defer func() {
+		GCWaitTimeout = timeout
+	}()
+
+	target := filepath.Join(t.TempDir(), "target")
+	lease, err := acquireLease(target, gcLease, "2")
+	require.NoError(t, err)
+	_, err = NewStreamBackup(target, testNode, 1, false)
+	require.Error(t, err)
+	leases, err := liveLeases(target, backupLease, "")
+	require.NoError(t, err)
+	require.Empty(t, leases)
+
+	require.NoError(t, releaseLease(target, lease))
+	s, err := NewStreamBackup(target, testNode, 1, false)
+	require.NoError(t, err)
+	require.NoError(t, s.Abort())
+}
+
+func TestStreamBackup_NoTmpObjects(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target")
+	f1 := filepath.Join(dir, "data", "a.tssp")
+	writeTestFile(t, f1, "aaaa")
+	runStreamBackup(t, target, 1, false, f1)
+	runStreamBackup(t, target, 2, false, f1)
+
+	fis, err := os.ReadDir(TargetPath(target, ObjectDir, objectTmpDir))
+	require.NoError(t, err)
+	require.Empty(t, fis)
+
+	s, err := NewStreamBackup(target, testNode, 3, false)
+	require.NoError(t, err)
+	require.Error(t, s.PutFile(filepath.Join(dir, "data", "missing.tssp")))
+	require.NoError(t, s.Abort())
+}
+
+func TestRestore(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target")
+	f1 := filepath.Join(dir, "data", "a.tssp")
+	f2 := filepath.Join(dir, "data", "index", "b")
+	writeTestFile(t, f1, "aaaa")
+	writeTestFile(t, f2, "bbbb")
+	s := runStreamBackup(t, target, 1, false, f1, f2)
+	writeTestFile(t, f1, "cccc")
+	runStreamBackup(t, target, 2, true, f1, f2)
+
+	require.NoError(t, os.RemoveAll(filepath.Join(dir, "data")))
+	m, err := Restore(target, testNode, ManifestName(1))
+	require.NoError(t, err)
+	require.Equal(t, ManifestName(1), m.Name)
+	for name, content := range map[string]string{f1: "aaaa", f2: "bbbb"} {
+		buf, err := os.ReadFile(name)
+		require.NoError(t, err)
+		require.Equal(t, content, string(buf))
+	}
+
+	// the newest backup set by default
+	m, err = Restore(target, testNode, "")
+	require.NoError(t, err)
+	require.Equal(t, ManifestName(2), m.Name)
+	buf, err := os.ReadFile(f1)
+	require.NoError(t, err)
+	require.Equal(t, "cccc", string(buf))
+
+	// a corrupt object is not restored
+	writeTestFile(t, ObjectPath(target, s.Manifest().Files[0].Checksum), "aaab")
+	_, err = Restore(target, testNode, ManifestName(1))
+	require.Error(t, err)
+	buf, err = os.ReadFile(f1)
+	require.NoError(t, err)
+	require.Equal(t, "cccc", string(buf))
+}
@@ -0,0 +1,180 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+)
+
+type VerifyResult struct {
+	Manifest string
+	Files    int
+	Bytes    int64
+	Missing  []string
+	Corrupt  []string
+}
+
+func (r *VerifyResult) OK() bool {
+	return len(r.Missing) == 0 && len(r.Corrupt) == 0
+}
+
+func (r *VerifyResult) String() string {
+	if r.OK() {
+		return fmt.Sprintf("backup %s verified, files: %d, bytes: %d", r.Manifest, r.Files, r.Bytes)
+	}
+	return fmt.Sprintf("backup %s verify failed, files: %d, missing: [%s], corrupt: [%s]",
+		r.Manifest, r.Files, strings.Join(r.Missing, ","), strings.Join(r.Corrupt, ","))
+}
+
+// Verify re-reads every object referenced by the manifest and checks its size
+// and checksum. An empty name verifies the newest backup set of the node.
+func Verify(target, node, name string) (*VerifyResult, error) {
+	m, err := readManifestOrNewest(target, node, name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &VerifyResult{Manifest: m.Name}
+	for _, f := range m.Files {
+		res.Files++
+		checksum, size, err := fileChecksum(ObjectPath(target, f.Checksum))
+		if err != nil {
+			if os.IsNotExist(err) {
+				res.Missing = append(res.Missing, f.Path)
+				continue
+			}
+			return nil, err
+		}
+		if checksum != f.Checksum || size != f.Size {
+			res.Corrupt = append(res.Corrupt, f.Path)
+			continue
+		}
+		res.Bytes += size
+	}
+	return res, nil
+}
+
+// ExpireChains keeps the newest keep backup chains of the node and removes the
+// older ones. A chain is a full backup set followed by its incremental sets.
+// Objects no longer referenced by the manifest of any node are removed as well,
+// unless a backup is in flight in the target.
+func ExpireChains(target, node string, keep int) ([]string, error) {
+	if keep <= 0 {
+		return nil, nil
+	}
+	names, err := ListManifests(target, node)
+	if err != nil {
+		return nil, err
+	}
+
+	manifests := make([]*Manifest, 0, len(names))
+	chainStart := make([]int, 0)
+	for i, name := range names {
+		m, err := ReadManifest(target, node, name)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, m)
+		if !m.IsInc {
+			chainStart = append(chainStart, i)
+		}
+	}
+	if len(chainStart) <= keep {
+		return nil, nil
+	}
+
+	// everything before the first kept full backup is expired
+	first := chainStart[len(chainStart)-keep]
+	expired := make([]string, 0, first)
+	for _, m := range manifests[:first] {
+		if err = fileops.Remove(ManifestPath(target, node, m.Name)); err != nil && !os.IsNotExist(err) {
+			return expired, err
+		}
+		expired = append(expired, m.Name)
+	}
+	return expired, collectObjects(target, node)
+}
+
+// collectObjects removes the objects not referenced by the manifest of any node.
+// Objects of the backups in flight are not referenced by a manifest yet, so
+// nothing is removed while another backup holds a lease.
+func collectObjects(target, node string) error {
+	lease, err := acquireLease(target, gcLease, node)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = releaseLease(target, lease)
+	}()
+
+	backups, err := liveLeases(target, backupLease, "")
+	if err != nil || len(backups) > 0 {
+		return err
+	}
+
+	nodes, err := ListNodes(target)
+	if err != nil {
+		return err
+	}
+	referenced := make(map[string]struct{})
+	for _, n := range nodes {
+		names, err := ListManifests(target, n)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			m, err := ReadManifest(target, n, name)
+			if err != nil {
+				return err
+			}
+			for _, f := range m.Files {
+				referenced[f.Checksum] = struct{}{}
+			}
+		}
+	}
+	return removeUnreferencedObjects(target, referenced)
+}
+
+func removeUnreferencedObjects(target string, referenced map[string]struct{}) error {
+	dirs, err := fileops.ReadDir(TargetPath(target, ObjectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		objects, err := fileops.ReadDir(TargetPath(target, ObjectDir, dir.Name()))
+		if err != nil {
+			return err
+		}
+		for _, obj := range objects {
+			if _, ok := referenced[obj.Name()]; ok {
+				continue
+			}
+			if err = fileops.Remove(TargetPath(target, ObjectDir, dir.Name(), obj.Name())); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
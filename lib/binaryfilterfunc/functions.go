@@ -214,6 +214,9 @@ const (
 	EQ
 	NEQ
 	MATHCHPHRASE
+	LIKE
+	EQREGEX
+	NEQREGEX
 	BOTTOM
 )
 
@@ -225,6 +228,9 @@ var operationMap = map[influxql.Token]int{
 	influxql.EQ:          EQ,
 	influxql.NEQ:         NEQ,
 	influxql.MATCHPHRASE: MATHCHPHRASE,
+	influxql.LIKE:        LIKE,
+	influxql.EQREGEX:     EQREGEX,
+	influxql.NEQREGEX:    NEQREGEX,
 }
 
 var switchOpMap = map[int]int{
@@ -261,6 +267,10 @@ func initIdxTypeFun() {
 		{GetStringEQConditionBitMap, GetFloatEQConditionBitMap, GetIntegerEQConditionBitMap, GetBooleanEQConditionBitMap},
 		{GetStringNEQConditionBitMap, GetFloatNEQConditionBitMap, GetIntegerNEQConditionBitMap, GetBooleanNEQConditionBitMap},
 		{GetStringMatchPhraseConditionBitMap, nilFunc, nilFunc, nilFunc},
+		// the LIKE pattern is compiled into a regular expression, see genRPNElementByVal
+		{GetStringRegexConditionBitMap, nilFunc, nilFunc, nilFunc},
+		{GetStringRegexConditionBitMap, nilFunc, nilFunc, nilFunc},
+		{GetStringNotRegexConditionBitMap, nilFunc, nilFunc, nilFunc},
 	}
 }
 
@@ -489,7 +499,8 @@ func (c *ConditionImpl) convertToRPNElem(rpnExpr *rpn.RPNExpr, opt hybridqp.Opti
 			case influxql.OR:
 				c.isSimpleExpr = false
 				c.rpn = append(c.rpn, &RPNElement{op: rpn.OR})
-			case influxql.EQ, influxql.LT, influxql.LTE, influxql.GT, influxql.GTE, influxql.NEQ, influxql.MATCHPHRASE:
+			case influxql.EQ, influxql.LT, influxql.LTE, influxql.GT, influxql.GTE, influxql.NEQ, influxql.MATCHPHRASE,
+				influxql.LIKE, influxql.EQREGEX, influxql.NEQREGEX:
 			default:
 				return errno.NewError(errno.ErrRPNOp, v)
 			}
@@ -515,7 +526,7 @@ func (c *ConditionImpl) convertToRPNElem(rpnExpr *rpn.RPNExpr, opt hybridqp.Opti
 			if err := c.genRPNElementByVal(value, op, idx, opt); err != nil {
 				return err
 			}
-		case *influxql.StringLiteral, *influxql.NumberLiteral, *influxql.IntegerLiteral, *influxql.BooleanLiteral:
+		case *influxql.StringLiteral, *influxql.NumberLiteral, *influxql.IntegerLiteral, *influxql.BooleanLiteral, *influxql.RegexLiteral:
 		default:
 			return errno.NewError(errno.ErrRPNExpr, v)
 		}
@@ -535,6 +546,10 @@ func (c *ConditionImpl) genRPNElementByFullText(value interface{}, op influxql.T
 		elem.rg.Opt = opt
 		elem.rg.Compare = v.Val
 		elem.rg.Function = idxTypeFun[operationMap[op]][StringFunc]
+		if analyzer := getFieldAnalyzer(opt, fields[i]); analyzer != nil {
+			elem.rg.Compare = NewAnalyzedPhrase(analyzer, v.Val)
+			elem.rg.Function = GetStringAnalyzedMatchPhraseConditionBitMap
+		}
 		c.rpn = append(c.rpn, elem)
 		if i > 0 {
 			c.rpn = append(c.rpn, &RPNElement{op: rpn.OR})
@@ -548,6 +563,25 @@ func (c *ConditionImpl) genRPNElementByVal(value interface{}, op influxql.Token,
 	elem.rg.Opt = opt
 	switch val := value.(type) {
 	case *influxql.StringLiteral:
+		elem.rg.Compare = val.Val
+		if op == influxql.LIKE {
+			re, err := LikePatternToRegexp(val.Val)
+			if err != nil {
+				return err
+			}
+			elem.rg.Compare = re
+		}
+		elem.rg.Function = idxTypeFun[operationMap[op]][StringFunc]
+		if op == influxql.MATCHPHRASE {
+			if analyzer := getFieldAnalyzer(opt, c.schema[idx].Name); analyzer != nil {
+				elem.rg.Compare = NewAnalyzedPhrase(analyzer, val.Val)
+				elem.rg.Function = GetStringAnalyzedMatchPhraseConditionBitMap
+			}
+		}
+	case *influxql.RegexLiteral:
+		if op != influxql.EQREGEX && op != influxql.NEQREGEX {
+			return errno.NewError(errno.ErrRPNElement, value)
+		}
 		elem.rg.Compare = val.Val
 		elem.rg.Function = idxTypeFun[operationMap[op]][StringFunc]
 	case *influxql.BooleanLiteral:
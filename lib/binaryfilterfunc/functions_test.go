@@ -30,6 +30,7 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRotate(t *testing.T) {
@@ -112,6 +113,72 @@ func TestGetStringMatchPhraseConditionBitMap(t *testing.T) {
 	assert.Equal(t, 1023, emptyCount)
 }
 
+func TestGetStringPatternConditionBitMap(t *testing.T) {
+	col, bitMap := prepareStringColValue(1, 8192)
+	count := func(pos []byte) int {
+		n := 0
+		for i := 0; i < 8192; i++ {
+			if !bitmap.IsNil(pos, i) {
+				n++
+			}
+		}
+		return n
+	}
+	reset := func() []byte {
+		for i := range bitMap {
+			bitMap[i] = 255
+		}
+		return bitMap
+	}
+
+	re, err := LikePatternToRegexp("%-409_")
+	require.NoError(t, err)
+	params := &TypeFunParams{col: col, compare: re, bitMap: col.Bitmap, pos: reset()}
+	assert.Equal(t, 10, count(GetStringRegexConditionBitMap(params)))
+
+	params.pos = reset()
+	assert.Equal(t, 8192-10, count(GetStringNotRegexConditionBitMap(params)))
+
+	re, err = LikePatternToRegexp("%.409%")
+	require.NoError(t, err)
+	params = &TypeFunParams{col: col, compare: re, bitMap: col.Bitmap, pos: reset()}
+	assert.Equal(t, 0, count(GetStringRegexConditionBitMap(params)))
+}
+
+func TestAnalyzedMatchPhraseCondition(t *testing.T) {
+	schema := record.Schemas{{Name: "content", Type: influx.Field_Type_String}, {Name: "host", Type: influx.Field_Type_String},
+		{Name: "time", Type: influx.Field_Type_Int}}
+	rec := record.NewRecord(schema, false)
+	for i, content := range []string{"数据库连接失败", "getUserName failed", "connection ok", "数据库"} {
+		rec.ColVals[0].AppendString(content)
+		rec.ColVals[1].AppendString("server")
+		rec.ColVals[2].AppendInteger(int64(i))
+	}
+	ir := &influxql.IndexRelation{
+		Oids:         []uint32{uint32(index.BloomFilterFullText)},
+		IndexNames:   []string{index.BloomFilterFullTextIndex},
+		IndexList:    []*influxql.IndexList{{IList: []string{"content", "host"}}},
+		IndexOptions: []*influxql.IndexOptions{{Options: []*influxql.IndexOption{{Tokenizers: "cjk_bigram,lowercase"}}}},
+	}
+	opt := &query.ProcessorOptions{Sources: []influxql.Source{&influxql.Measurement{Name: "logs", IndexRelation: ir}}}
+	check := func(cond string, expect []int64) {
+		c, err := NewCondition(nil, influxql.MustParseExpr(cond), schema, opt)
+		require.NoError(t, err)
+		filterBitmap := bitmap.NewFilterBitmap(c.NumFilter() + 1)
+		require.NoError(t, c.Filter(rec, filterBitmap))
+		var times []int64
+		for _, i := range filterBitmap.ReserveId {
+			times = append(times, rec.ColVals[2].IntegerValues()[i])
+		}
+		assert.Equal(t, expect, times, cond)
+	}
+	check("content MATCHPHRASE '连接失败'", []int64{0})
+	check("content MATCHPHRASE '数据库'", []int64{0, 3})
+	check("content MATCHPHRASE 'GETUSERNAME'", []int64{1})
+	check("content MATCHPHRASE '失败连接'", nil)
+	check("__log___ MATCHPHRASE 'connection'", []int64{2})
+}
+
 func TestRotateRewriteTimeCompareVal(t *testing.T) {
 	root := &influxql.BinaryExpr{
 		Op: influxql.AND,
@@ -0,0 +1,79 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binaryfilterfunc
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/bitmap"
+)
+
+// LikePatternToRegexp converts a LIKE pattern into an anchored regular expression,
+// '%' matches any sequence of characters and '_' matches a single character.
+func LikePatternToRegexp(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteString("(?s)^")
+	start := 0
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '%':
+			sb.WriteString(regexp.QuoteMeta(pattern[start:i]))
+			sb.WriteString(".*")
+			start = i + 1
+		case '_':
+			sb.WriteString(regexp.QuoteMeta(pattern[start:i]))
+			sb.WriteString(".")
+			start = i + 1
+		}
+	}
+	sb.WriteString(regexp.QuoteMeta(pattern[start:]))
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+func GetStringRegexConditionBitMap(params *TypeFunParams) []byte {
+	return getStringRegexConditionBitMap(params, true)
+}
+
+func GetStringNotRegexConditionBitMap(params *TypeFunParams) []byte {
+	return getStringRegexConditionBitMap(params, false)
+}
+
+func getStringRegexConditionBitMap(params *TypeFunParams, match bool) []byte {
+	var idx int
+	col, offset, pos, bitMap := params.col, params.offset, params.pos, params.bitMap
+	re := params.compare.(*regexp.Regexp)
+	var content []byte
+	for i := 0; i < col.Len; i++ {
+		idx = offset + i
+		if bitmap.IsNil(pos, idx) {
+			continue
+		}
+		if col.NilCount > 0 && bitmap.IsNil(bitMap, idx) {
+			bitmap.SetBitMap(pos, idx)
+			continue
+		}
+		if i == col.Len-1 {
+			content = col.Val[col.Offset[i]:]
+		} else {
+			content = col.Val[col.Offset[i]:col.Offset[i+1]]
+		}
+		if re.Match(content) != match {
+			bitmap.SetBitMap(pos, idx)
+		}
+	}
+	return pos
+}
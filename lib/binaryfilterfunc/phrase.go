@@ -0,0 +1,104 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binaryfilterfunc
+
+import (
+	"bytes"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/bitmap"
+	"github.com/openGemini/openGemini/lib/tokenizer"
+)
+
+// AnalyzedPhrase is the MATCHPHRASE compare value of a field analyzed by a non-standard analyzer,
+// a row matches if the tokens of the phrase are the consecutive tokens of the row.
+type AnalyzedPhrase struct {
+	analyzer *tokenizer.Analyzer
+	tokens   [][]byte
+}
+
+func NewAnalyzedPhrase(analyzer *tokenizer.Analyzer, phrase string) *AnalyzedPhrase {
+	p := &AnalyzedPhrase{analyzer: analyzer}
+	analyzer.Analyze([]byte(phrase), func(token []byte) {
+		p.tokens = append(p.tokens, append([]byte{}, token...))
+	})
+	return p
+}
+
+func (p *AnalyzedPhrase) Match(content []byte) bool {
+	if len(p.tokens) == 0 {
+		return true
+	}
+	found := false
+	window := make([][]byte, 0, len(p.tokens))
+	p.analyzer.Analyze(content, func(token []byte) {
+		if found {
+			return
+		}
+		if len(window) == len(p.tokens) {
+			copy(window, window[1:])
+			window = window[:len(window)-1]
+		}
+		window = append(window, token)
+		if len(window) < len(p.tokens) {
+			return
+		}
+		for i := range p.tokens {
+			if !bytes.Equal(window[i], p.tokens[i]) {
+				return
+			}
+		}
+		found = true
+	})
+	return found
+}
+
+// getFieldAnalyzer returns the analyzer of the full text field, nil means the standard tokenizer is used.
+func getFieldAnalyzer(opt hybridqp.Options, field string) *tokenizer.Analyzer {
+	if opt == nil {
+		return nil
+	}
+	measurements := opt.GetMeasurements()
+	if len(measurements) == 0 {
+		return nil
+	}
+	return tokenizer.GetFieldAnalyzer(tokenizer.GetFullTextOption(measurements[0].IndexRelation), field)
+}
+
+func GetStringAnalyzedMatchPhraseConditionBitMap(params *TypeFunParams) []byte {
+	var idx int
+	col, offset, pos, bitMap := params.col, params.offset, params.pos, params.bitMap
+	phrase := params.compare.(*AnalyzedPhrase)
+	var content []byte
+	for i := 0; i < col.Len; i++ {
+		idx = offset + i
+		if bitmap.IsNil(pos, idx) {
+			continue
+		}
+		if col.NilCount > 0 && bitmap.IsNil(bitMap, idx) {
+			bitmap.SetBitMap(pos, idx)
+			continue
+		}
+		if i == col.Len-1 {
+			content = col.Val[col.Offset[i]:]
+		} else {
+			content = col.Val[col.Offset[i]:col.Offset[i+1]]
+		}
+		if !phrase.Match(content) {
+			bitmap.SetBitMap(pos, idx)
+		}
+	}
+	return pos
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"github.com/openGemini/openGemini/lib/util"
+)
+
+// ALP (adaptive lossless floating point) is described in "ALP: Adaptive Lossless floating-Point Compression".
+// Decimal values are multiplied by 10^e and divided by 10^f to get integers, which are encoded by frame of reference.
+// The values that can not be restored exactly are stored as exceptions.
+const (
+	alpMaxExponent = 18
+	alpSampleSize  = 32
+
+	// values out of this range can not be converted to int64 exactly
+	alpMaxEncodable = 1 << 52
+	// 4 bytes position and 8 bytes raw value
+	alpExceptionSize = 12
+)
+
+var alpExp10 = [alpMaxExponent + 1]float64{
+	1, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9,
+	1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18,
+}
+
+var alpFrac10 = [alpMaxExponent + 1]float64{
+	1, 1e-1, 1e-2, 1e-3, 1e-4, 1e-5, 1e-6, 1e-7, 1e-8, 1e-9,
+	1e-10, 1e-11, 1e-12, 1e-13, 1e-14, 1e-15, 1e-16, 1e-17, 1e-18,
+}
+
+// alpEncodeValue converts v to an integer with the exponent e and the factor f.
+// ok is false if the integer can not be decoded to v exactly.
+func alpEncodeValue(v float64, e, f uint8) (int64, bool) {
+	scaled := v * alpExp10[e] * alpFrac10[f]
+	if math.IsNaN(scaled) || scaled > alpMaxEncodable || scaled < -alpMaxEncodable {
+		return 0, false
+	}
+	n := int64(math.Round(scaled))
+	return n, math.Float64bits(alpDecodeValue(n, e, f)) == math.Float64bits(v)
+}
+
+func alpDecodeValue(n int64, e, f uint8) float64 {
+	return float64(n) * alpExp10[f] * alpFrac10[e]
+}
+
+// AlpParams finds the exponent and factor that encode the sampled values into the smallest size.
+// ok is false if too many sampled values can not be encoded exactly.
+func AlpParams(values []float64) (e uint8, f uint8, ok bool) {
+	step := 1
+	if len(values) > alpSampleSize {
+		step = len(values) / alpSampleSize
+	}
+
+	var sample [alpSampleSize]float64
+	n := 0
+	for i := 0; i < len(values) && n < alpSampleSize; i += step {
+		sample[n] = values[i]
+		n++
+	}
+	if n == 0 {
+		return 0, 0, false
+	}
+
+	var ints [alpSampleSize]int64
+	bestSize, bestExceptions := math.MaxInt, n
+	for exp := uint8(0); exp <= alpMaxExponent; exp++ {
+		for fac := uint8(0); fac <= exp; fac++ {
+			exceptions, m := 0, 0
+			for _, v := range sample[:n] {
+				if i, encoded := alpEncodeValue(v, exp, fac); encoded {
+					ints[m] = i
+					m++
+				} else {
+					exceptions++
+				}
+			}
+
+			size := ForEncodedSize(ints[:m]) + exceptions*alpExceptionSize
+			if size < bestSize {
+				bestSize, bestExceptions = size, exceptions
+				e, f = exp, fac
+			}
+		}
+	}
+
+	// more than 1/8 of the values are exceptions, the data is not decimal
+	return e, f, bestExceptions*8 <= n
+}
+
+// AlpEncoding compresses the float64 values in the input with the exponent e and the factor f
+func AlpEncoding(in []byte, out []byte, e, f uint8) ([]byte, error) {
+	if e > alpMaxExponent || f > e {
+		return nil, fmt.Errorf("alp: invalid exponent %d and factor %d", e, f)
+	}
+
+	values := util.Bytes2Float64Slice(in)
+	ints := make([]int64, len(values))
+	var positions []uint32
+	var exceptions []uint64
+
+	var last int64
+	for i, v := range values {
+		n, ok := alpEncodeValue(v, e, f)
+		if !ok {
+			// fill the exception with the previous integer, so that it does not widen the frame
+			n = last
+			positions = append(positions, uint32(i))
+			exceptions = append(exceptions, math.Float64bits(v))
+		}
+		ints[i] = n
+		last = n
+	}
+
+	out = append(out, e, f)
+	out = ForEncoding(ints, out)
+	out = numberenc.MarshalUint32Append(out, uint32(len(positions)))
+	out = numberenc.MarshalUint32SliceAppend(out, positions)
+	out = numberenc.MarshalUint64SliceAppend(out, exceptions)
+	return out, nil
+}
+
+// AlpDecoding decompresses the data encoded by AlpEncoding, and appends the float64 values to out
+func AlpDecoding(in []byte, out []byte) ([]byte, error) {
+	if len(in) < 2 {
+		return nil, fmt.Errorf("alp: too small data for decode %d", len(in))
+	}
+	e, f := in[0], in[1]
+	if e > alpMaxExponent || f > e {
+		return nil, fmt.Errorf("alp: invalid exponent %d and factor %d", e, f)
+	}
+
+	ints, in, err := ForDecoding(in[2:], nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(in) < 4 {
+		return nil, fmt.Errorf("alp: too small data for decode exceptions %d", len(in))
+	}
+	count := int(numberenc.UnmarshalUint32(in))
+	in = in[4:]
+	if len(in) < count*alpExceptionSize {
+		return nil, fmt.Errorf("alp: too small data for decode exceptions %d < %d", len(in), count*alpExceptionSize)
+	}
+
+	pos := len(out)
+	out = util.PaddingZeroBuffer(out, len(ints)*util.Float64SizeBytes)
+	values := util.Bytes2Float64Slice(out[pos:])
+	for i, n := range ints {
+		values[i] = alpDecodeValue(n, e, f)
+	}
+
+	for i := 0; i < count; i++ {
+		idx := int(numberenc.UnmarshalUint32(in[i*4:]))
+		if idx >= len(values) {
+			return nil, fmt.Errorf("alp: invalid exception position %d", idx)
+		}
+		values[idx] = math.Float64frombits(numberenc.UnmarshalUint64(in[count*4+i*8:]))
+	}
+	return out, nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/openGemini/openGemini/lib/numberenc"
+)
+
+// forHeaderSize is the size of the header of a frame-of-reference block:
+// 4 bytes value count, 8 bytes reference value and 1 byte bit width
+const forHeaderSize = 4 + 8 + 1
+
+// bitWriter appends bits to a byte slice, the most significant bit first
+type bitWriter struct {
+	buf []byte
+	acc uint64
+	n   uint
+}
+
+func (w *bitWriter) reset(buf []byte) {
+	w.buf = buf
+	w.acc = 0
+	w.n = 0
+}
+
+func (w *bitWriter) writeBits(v uint64, nbits uint) {
+	if nbits > 32 {
+		w.writeBits(v>>32, nbits-32)
+		v &= 1<<32 - 1
+		nbits = 32
+	}
+	if nbits == 0 {
+		return
+	}
+
+	w.acc = w.acc<<nbits | (v & (1<<nbits - 1))
+	w.n += nbits
+	for w.n >= 8 {
+		w.n -= 8
+		w.buf = append(w.buf, byte(w.acc>>w.n))
+	}
+	w.acc &= 1<<w.n - 1
+}
+
+func (w *bitWriter) writeBit(bit bool) {
+	if bit {
+		w.writeBits(1, 1)
+	} else {
+		w.writeBits(0, 1)
+	}
+}
+
+// flush pads the pending bits with zero and returns the written buffer
+func (w *bitWriter) flush() []byte {
+	if w.n > 0 {
+		w.buf = append(w.buf, byte(w.acc<<(8-w.n)))
+		w.acc, w.n = 0, 0
+	}
+	return w.buf
+}
+
+// bitReader reads the bits written by bitWriter
+type bitReader struct {
+	buf []byte
+	pos int
+	acc uint64
+	n   uint
+}
+
+func (r *bitReader) reset(buf []byte) {
+	r.buf = buf
+	r.pos = 0
+	r.acc = 0
+	r.n = 0
+}
+
+func (r *bitReader) readBits(nbits uint) (uint64, error) {
+	if nbits > 32 {
+		hi, err := r.readBits(nbits - 32)
+		if err != nil {
+			return 0, err
+		}
+		lo, err := r.readBits(32)
+		return hi<<32 | lo, err
+	}
+
+	for r.n < nbits {
+		if r.pos >= len(r.buf) {
+			return 0, fmt.Errorf("too small data for decode, need %d bits", nbits)
+		}
+		r.acc = r.acc<<8 | uint64(r.buf[r.pos])
+		r.pos++
+		r.n += 8
+	}
+
+	r.n -= nbits
+	v := (r.acc >> r.n) & (1<<nbits - 1)
+	r.acc &= 1<<r.n - 1
+	return v, nil
+}
+
+func (r *bitReader) readBit() (bool, error) {
+	v, err := r.readBits(1)
+	return v == 1, err
+}
+
+// consumed returns the number of bytes read, including the partially read byte
+func (r *bitReader) consumed() int {
+	return r.pos
+}
+
+// ForEncoding encodes values with frame of reference: the minimum value is stored once,
+// and the offsets of all values from it are bit-packed with the width of the largest offset.
+func ForEncoding(values []int64, out []byte) []byte {
+	ref, width := forReference(values)
+
+	out = numberenc.MarshalUint32Append(out, uint32(len(values)))
+	out = numberenc.MarshalUint64Append(out, uint64(ref))
+	out = append(out, byte(width))
+	if width == 0 {
+		return out
+	}
+
+	w := &bitWriter{}
+	w.reset(out)
+	for _, v := range values {
+		w.writeBits(uint64(v-ref), uint(width))
+	}
+	return w.flush()
+}
+
+// ForDecoding appends the values encoded by ForEncoding to dst,
+// and returns the rest of the input that does not belong to the block
+func ForDecoding(in []byte, dst []int64) ([]int64, []byte, error) {
+	if len(in) < forHeaderSize {
+		return nil, nil, fmt.Errorf("for: too small data for decode %d", len(in))
+	}
+
+	count := int(numberenc.UnmarshalUint32(in))
+	ref := int64(numberenc.UnmarshalUint64(in[4:]))
+	width := uint(in[12])
+	in = in[forHeaderSize:]
+	if width > 64 {
+		return nil, nil, fmt.Errorf("for: invalid bit width %d", width)
+	}
+
+	if width == 0 {
+		for i := 0; i < count; i++ {
+			dst = append(dst, ref)
+		}
+		return dst, in, nil
+	}
+
+	size := (count*int(width) + 7) / 8
+	if len(in) < size {
+		return nil, nil, fmt.Errorf("for: too small data for decode %d < %d", len(in), size)
+	}
+
+	r := &bitReader{}
+	r.reset(in[:size])
+	for i := 0; i < count; i++ {
+		v, err := r.readBits(width)
+		if err != nil {
+			return nil, nil, err
+		}
+		dst = append(dst, ref+int64(v))
+	}
+	return dst, in[size:], nil
+}
+
+// ForEncodedSize returns the size of the block that ForEncoding generates for values
+func ForEncodedSize(values []int64) int {
+	_, width := forReference(values)
+	return forHeaderSize + (len(values)*width+7)/8
+}
+
+func forReference(values []int64) (int64, int) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	minV, maxV := values[0], values[0]
+	for _, v := range values[1:] {
+		if v < minV {
+			minV = v
+		} else if v > maxV {
+			maxV = v
+		}
+	}
+	return minV, bits.Len64(uint64(maxV - minV))
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/compress"
+	"github.com/openGemini/openGemini/lib/rand"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForEncoding(t *testing.T) {
+	var random []int64
+	for i := 0; i < 1000; i++ {
+		random = append(random, rand.Int63n(1<<20)-1<<19)
+	}
+
+	datas := [][]int64{
+		nil,
+		{7},
+		{5, 5, 5, 5},
+		{math.MinInt64, math.MaxInt64, 0},
+		random,
+	}
+
+	for _, values := range datas {
+		out := compress.ForEncoding(values, []byte{1, 2})
+		require.Equal(t, compress.ForEncodedSize(values), len(out)-2)
+
+		dst, rest, err := compress.ForDecoding(append(out[2:], 9), []int64{100})
+		require.NoError(t, err)
+		require.Equal(t, []byte{9}, rest)
+		require.Equal(t, append([]int64{100}, values...), dst)
+	}
+
+	out := compress.ForEncoding(random, nil)
+	_, _, err := compress.ForDecoding(out[:len(out)-1], nil)
+	require.Error(t, err)
+	_, _, err = compress.ForDecoding(out[:5], nil)
+	require.Error(t, err)
+}
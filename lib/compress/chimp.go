@@ -0,0 +1,220 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"github.com/openGemini/openGemini/lib/util"
+)
+
+// Chimp128 is described in "Chimp: Efficient Lossless Floating Point Compression for Time Series Databases".
+// Each value is XORed with the one of the previous 128 values that shares the most trailing bits with it,
+// which compresses gauges that oscillate between a few states much better than Gorilla.
+const (
+	chimpPrevValues     = 128
+	chimpPrevValuesLog2 = 7
+	chimpIndexBits      = chimpPrevValuesLog2 + 7
+	chimpThreshold      = 6 + chimpPrevValuesLog2
+
+	chimpFlagSame     = 0
+	chimpFlagTrailing = 1
+	chimpFlagLeading  = 2
+	chimpFlagNew      = 3
+)
+
+// leading zeros are rounded down to one of 8 values, so that they can be stored in 3 bits
+var chimpLeadingRound = [65]uint8{}
+var chimpLeadingCode = [65]uint8{}
+var chimpLeadingValue = [8]uint8{0, 8, 12, 16, 18, 20, 22, 24}
+
+func init() {
+	for lz := 0; lz <= 64; lz++ {
+		code := 0
+		for i := len(chimpLeadingValue) - 1; i >= 0; i-- {
+			if uint8(lz) >= chimpLeadingValue[i] {
+				code = i
+				break
+			}
+		}
+		chimpLeadingCode[lz] = uint8(code)
+		chimpLeadingRound[lz] = chimpLeadingValue[code]
+	}
+}
+
+type chimpEncoder struct {
+	w       bitWriter
+	stored  [chimpPrevValues]uint64
+	indices [1 << chimpIndexBits]int
+	index   int
+	leading uint8
+}
+
+func (enc *chimpEncoder) reset(out []byte) {
+	enc.w.reset(out)
+	enc.index = 0
+	enc.leading = 65
+	for i := range enc.indices {
+		enc.indices[i] = -chimpPrevValues - 1
+	}
+}
+
+func (enc *chimpEncoder) first(v uint64) {
+	enc.w.writeBits(v, 64)
+	enc.stored[0] = v
+	enc.indices[v&(1<<chimpIndexBits-1)] = 0
+}
+
+func (enc *chimpEncoder) write(v uint64) {
+	key := v & (1<<chimpIndexBits - 1)
+	prevIndex := enc.index % chimpPrevValues
+	xor := enc.stored[prevIndex] ^ v
+
+	if curr := enc.indices[key]; enc.index-curr < chimpPrevValues {
+		candidate := enc.stored[curr%chimpPrevValues] ^ v
+		if bits.TrailingZeros64(candidate) > chimpThreshold {
+			prevIndex = curr % chimpPrevValues
+			xor = candidate
+		}
+	}
+
+	w := &enc.w
+	switch {
+	case xor == 0:
+		w.writeBits(chimpFlagSame, 2)
+		w.writeBits(uint64(prevIndex), chimpPrevValuesLog2)
+		enc.leading = 65
+	case bits.TrailingZeros64(xor) > chimpThreshold:
+		leading := chimpLeadingRound[bits.LeadingZeros64(xor)]
+		trailing := uint8(bits.TrailingZeros64(xor))
+		significant := 64 - leading - trailing
+		w.writeBits(chimpFlagTrailing, 2)
+		w.writeBits(uint64(prevIndex), chimpPrevValuesLog2)
+		w.writeBits(uint64(chimpLeadingCode[leading]), 3)
+		w.writeBits(uint64(significant), 6)
+		w.writeBits(xor>>trailing, uint(significant))
+		enc.leading = 65
+	default:
+		leading := chimpLeadingRound[bits.LeadingZeros64(xor)]
+		if leading == enc.leading {
+			w.writeBits(chimpFlagLeading, 2)
+		} else {
+			w.writeBits(chimpFlagNew, 2)
+			w.writeBits(uint64(chimpLeadingCode[leading]), 3)
+			enc.leading = leading
+		}
+		w.writeBits(xor, uint(64-leading))
+	}
+
+	enc.index++
+	enc.indices[key] = enc.index
+	enc.stored[enc.index%chimpPrevValues] = v
+}
+
+// ChimpEncoding compresses the float64 values in the input with Chimp128
+func ChimpEncoding(in []byte, out []byte) ([]byte, error) {
+	values := util.Bytes2Uint64Slice(in)
+	out = numberenc.MarshalUint32Append(out, uint32(len(values)))
+	if len(values) == 0 {
+		return out, nil
+	}
+
+	enc := &chimpEncoder{}
+	enc.reset(out)
+	enc.first(values[0])
+	for _, v := range values[1:] {
+		enc.write(v)
+	}
+	return enc.w.flush(), nil
+}
+
+// ChimpDecoding decompresses the data encoded by ChimpEncoding, and appends the float64 values to out
+func ChimpDecoding(in []byte, out []byte) ([]byte, error) {
+	if len(in) < 4 {
+		return nil, fmt.Errorf("chimp: too small data for decode %d", len(in))
+	}
+	count := int(numberenc.UnmarshalUint32(in))
+	if count == 0 {
+		return out, nil
+	}
+
+	pos := len(out)
+	out = util.PaddingZeroBuffer(out, count*util.Float64SizeBytes)
+	values := util.Bytes2Uint64Slice(out[pos:])
+
+	var stored [chimpPrevValues]uint64
+	var leading uint8
+	r := &bitReader{}
+	r.reset(in[4:])
+
+	v, err := r.readBits(64)
+	if err != nil {
+		return nil, err
+	}
+	values[0], stored[0] = v, v
+
+	for i := 1; i < count; i++ {
+		flag, err := r.readBits(2)
+		if err != nil {
+			return nil, err
+		}
+
+		switch flag {
+		case chimpFlagSame:
+			idx, err := r.readBits(chimpPrevValuesLog2)
+			if err != nil {
+				return nil, err
+			}
+			v = stored[idx]
+		case chimpFlagTrailing:
+			header, err := r.readBits(chimpPrevValuesLog2 + 3 + 6)
+			if err != nil {
+				return nil, err
+			}
+			idx := header >> 9
+			leading = chimpLeadingValue[(header>>6)&7]
+			significant := uint8(header & 63)
+			if significant == 0 {
+				significant = 64
+			}
+			trailing := 64 - leading - significant
+			xor, err := r.readBits(uint(significant))
+			if err != nil {
+				return nil, err
+			}
+			v = stored[idx] ^ (xor << trailing)
+		default:
+			if flag == chimpFlagNew {
+				code, err := r.readBits(3)
+				if err != nil {
+					return nil, err
+				}
+				leading = chimpLeadingValue[code]
+			}
+			xor, err := r.readBits(uint(64 - leading))
+			if err != nil {
+				return nil, err
+			}
+			v = stored[(i-1)%chimpPrevValues] ^ xor
+		}
+
+		values[i] = v
+		stored[i%chimpPrevValues] = v
+	}
+
+	return out, nil
+}
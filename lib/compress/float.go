@@ -29,6 +29,9 @@ const (
 	floatCompressedGorilla    = 3
 	floatCompressedSame       = 4
 	floatCompressedRLE        = 5
+	floatCompressedChimp      = 6
+	floatCompressedALP        = 7
+	floatCompressedQuantized  = 8
 
 	// if the length of the float slice is smaller than this value, not compress it
 	floatCompressThreshold    = 4
@@ -37,14 +40,54 @@ const (
 	lessDecimalThreshold = 1000
 )
 
+// Codecs of float values that can be forced for a measurement.
+// FloatCodecAuto chooses Gorilla or Snappy for each block, as the older versions do.
+// FloatCodecAdaptive also chooses Chimp and ALP, the files written with it can not be
+// read by the versions without these codecs, so it must be enabled explicitly.
+const (
+	FloatCodecAuto     = ""
+	FloatCodecAdaptive = "adaptive"
+	FloatCodecGorilla  = "gorilla"
+	FloatCodecChimp    = "chimp"
+	FloatCodecALP      = "alp"
+	FloatCodecSnappy   = "snappy"
+)
+
+var FloatCodecs = []string{FloatCodecAdaptive, FloatCodecGorilla, FloatCodecChimp, FloatCodecALP, FloatCodecSnappy}
+
+func IsValidFloatCodec(codec string) bool {
+	if codec == FloatCodecAuto {
+		return true
+	}
+	for _, c := range FloatCodecs {
+		if c == codec {
+			return true
+		}
+	}
+	return false
+}
+
 type Float struct {
-	rle *RLE
+	rle        *RLE
+	codec      string
+	errorBound float64
 }
 
 func NewFloat() *Float {
 	return &Float{rle: NewRLE(util.Float64SizeBytes)}
 }
 
+// SetCodec forces the codec used by AdaptiveEncoding, the blocks that have few distinct values are still encoded by RLE
+func (c *Float) SetCodec(codec string) {
+	c.codec = codec
+}
+
+// SetErrorBound enables the encoding of the values quantized with bound if bound is greater than 0,
+// see Quantize
+func (c *Float) SetErrorBound(bound float64) {
+	c.errorBound = bound
+}
+
 func (c *Float) AdaptiveEncoding(in []byte, out []byte) ([]byte, error) {
 	return c.adaptiveEncoding(in, out)
 }
@@ -68,18 +111,44 @@ func (c *Float) adaptiveEncoding(in []byte, out []byte) ([]byte, error) {
 		return c.rle.Encoding(in, out)
 	}
 
-	var err error
-	func() {
-		if ctx.Snappy() || ctx.extremeDataValues {
-			out = append(out, floatCompressedSnappy<<4)
-			out, err = SnappyEncoding(in, out)
-			return
+	if c.errorBound > 0 {
+		quantized, ok := QuantizeEncoding(in, append(out, floatCompressedQuantized<<4), c.errorBound)
+		if ok && len(quantized) < len(in) {
+			return quantized, nil
 		}
+		out = out[:0]
+	}
+
+	codec := c.codec
+	switch codec {
+	case FloatCodecAuto:
+		codec = ctx.Codec()
+	case FloatCodecAdaptive:
+		codec = ctx.AdaptiveCodec(values)
+	}
 
+	var err error
+	switch codec {
+	case FloatCodecSnappy:
+		out = append(out, floatCompressedSnappy<<4)
+		out, err = SnappyEncoding(in, out)
+	case FloatCodecChimp:
+		out = append(out, floatCompressedChimp<<4)
+		out, err = ChimpEncoding(in, out)
+	case FloatCodecALP:
+		e, f := ctx.alpExponent, ctx.alpFactor
+		if !ctx.alpSampled {
+			e, f, _ = AlpParams(values)
+		}
+		out = append(out, floatCompressedALP<<4)
+		out, err = AlpEncoding(in, out, e, f)
+	default:
 		out, err = GorillaEncoding(in, out)
-		out = append(out[:1], out...)
-		out[0] = floatCompressedGorilla << 4
-	}()
+		if err == nil {
+			out = append(out[:1], out...)
+			out[0] = floatCompressedGorilla << 4
+		}
+	}
 
 	if err != nil {
 		return nil, err
@@ -113,6 +182,12 @@ func (c *Float) AdaptiveDecoding(in, out []byte) ([]byte, error) {
 		return c.rle.SameValueDecoding(in[1:], out)
 	case floatCompressedRLE:
 		return c.rle.Decoding(in[1:], out)
+	case floatCompressedChimp:
+		return ChimpDecoding(in[1:], out)
+	case floatCompressedALP:
+		return AlpDecoding(in[1:], out)
+	case floatCompressedQuantized:
+		return QuantizeDecoding(in[1:], out)
 	default:
 		return nil, errno.NewError(errno.InvalidFloatBuffer, algo)
 	}
@@ -125,6 +200,12 @@ type Context struct {
 	intOnly           bool // All values are integers
 	lessDecimal       bool
 	extremeDataValues bool //extreme data values
+
+	// the sampled values can be encoded by ALP with the exponent and factor
+	alpSampled  bool
+	alpDecimal  bool
+	alpExponent uint8
+	alpFactor   uint8
 }
 
 var contextPool sync.Pool
@@ -147,6 +228,8 @@ func (ctx *Context) reset() {
 	ctx.intOnly = true
 	ctx.lessDecimal = true
 	ctx.extremeDataValues = false
+	ctx.alpSampled = false
+	ctx.alpDecimal = false
 }
 
 func (ctx *Context) NotCompress() bool {
@@ -165,6 +248,40 @@ func (ctx *Context) Snappy() bool {
 	return !ctx.intOnly && ctx.lessDecimal
 }
 
+// ALP returns true if the values are decimals that can be restored exactly from integers,
+// the values are sampled by AdaptiveCodec
+func (ctx *Context) ALP() bool {
+	return ctx.alpDecimal
+}
+
+// Codec returns the codec chosen by the features of the values among the codecs
+// readable by the older versions
+func (ctx *Context) Codec() string {
+	if ctx.extremeDataValues || ctx.Snappy() {
+		return FloatCodecSnappy
+	}
+	return FloatCodecGorilla
+}
+
+// AdaptiveCodec returns the codec chosen by the features of the values among all codecs
+func (ctx *Context) AdaptiveCodec(values []float64) string {
+	if ctx.extremeDataValues {
+		return FloatCodecSnappy
+	}
+	if !ctx.alpSampled {
+		ctx.alpExponent, ctx.alpFactor, ctx.alpDecimal = AlpParams(values)
+		ctx.alpSampled = true
+	}
+	switch {
+	case ctx.ALP():
+		return FloatCodecALP
+	case ctx.Snappy():
+		return FloatCodecSnappy
+	default:
+		return FloatCodecChimp
+	}
+}
+
 func GenerateContext(values []float64) *Context {
 	ctx := newContext()
 	ctx.valueCount = len(values)
@@ -210,7 +327,6 @@ func GenerateContext(values []float64) *Context {
 
 	// more than 90% of the data that meets the conditions
 	ctx.lessDecimal = k > 0 && (100*lessDecimalTotal/k) > 90
-
 	return ctx
 }
 
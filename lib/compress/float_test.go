@@ -21,6 +21,7 @@ import (
 	"github.com/openGemini/openGemini/lib/compress"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/numberenc"
 	"github.com/openGemini/openGemini/lib/rand"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/stretchr/testify/require"
@@ -182,3 +183,196 @@ func BenchmarkGenerateContext(b *testing.B) {
 		}
 	}
 }
+
+func codecFloatBlockWithCodec(t *testing.T, codec string, data []float64) int {
+	values := append([]float64{}, data...)
+	float := compress.NewFloat()
+	float.SetCodec(codec)
+
+	encOut, err := float.AdaptiveEncoding(util.Float64Slice2byte(values), nil)
+	require.NoError(t, err)
+
+	decOut, err := float.AdaptiveDecoding(encOut, nil)
+	require.NoError(t, err)
+	other := util.Bytes2Float64Slice(decOut)
+	require.Equal(t, len(values), len(other))
+	for i := range values {
+		require.Equal(t, math.Float64bits(values[i]), math.Float64bits(other[i]), "codec %s, index %d", codec, i)
+	}
+	return len(encOut)
+}
+
+func TestCodecFloatBlock_Codecs(t *testing.T) {
+	datas := map[string][]float64{}
+	for i := 0; i < 1000; i++ {
+		datas["rand"] = append(datas["rand"], rand.Float64()*1000)
+		datas["decimal"] = append(datas["decimal"], float64(rand.Int63n(100000))/100)
+		datas["int"] = append(datas["int"], float64(rand.Int63n(1<<40)))
+		datas["states"] = append(datas["states"], []float64{1.1, 2.7, 3.14159, math.Pi}[rand.Intn(4)])
+		datas["special"] = append(datas["special"], []float64{math.Inf(1), math.Copysign(0, -1), 1e300, 0.1}[i%4])
+	}
+
+	for name, values := range datas {
+		for _, codec := range compress.FloatCodecs {
+			t.Run(name+"_"+codec, func(t *testing.T) {
+				codecFloatBlockWithCodec(t, codec, values)
+			})
+		}
+	}
+}
+
+func TestCodecFloatBlock_Ratio(t *testing.T) {
+	var decimal, states []float64
+	for i := 0; i < 1000; i++ {
+		decimal = append(decimal, 20+float64(rand.Int63n(1000))/100)
+		states = append(states, []float64{1.1, 2.7, 3.14159, math.Pi}[rand.Intn(4)])
+	}
+
+	gorilla := codecFloatBlockWithCodec(t, compress.FloatCodecGorilla, decimal)
+	alp := codecFloatBlockWithCodec(t, compress.FloatCodecALP, decimal)
+	require.Less(t, alp, gorilla/2)
+
+	gorilla = codecFloatBlockWithCodec(t, compress.FloatCodecGorilla, states)
+	chimp := codecFloatBlockWithCodec(t, compress.FloatCodecChimp, states)
+	require.Less(t, chimp, gorilla)
+}
+
+func TestCodecFloatBlock_DefaultFormat(t *testing.T) {
+	var decimal, states []float64
+	for i := 0; i < 1000; i++ {
+		decimal = append(decimal, 20+float64(rand.Int63n(1000))/100)
+		states = append(states, []float64{1.1, 2.7, 3.14159, math.Pi}[i%9%4]+float64(i))
+	}
+
+	float := compress.NewFloat()
+	for _, values := range [][]float64{decimal, states} {
+		out, err := float.AdaptiveEncoding(util.Float64Slice2byte(values), nil)
+		require.NoError(t, err)
+		// Chimp and ALP are not written unless the adaptive codec is enabled
+		require.Contains(t, []byte{2, 3}, out[0]>>4)
+	}
+}
+
+func TestGenerateContext_Codec(t *testing.T) {
+	var decimal, random []float64
+	for i := 0; i < 1000; i++ {
+		decimal = append(decimal, float64(rand.Int63n(100000))/1000)
+		random = append(random, rand.Float64())
+	}
+
+	// the default codec keeps the files readable by the older versions
+	ctx := compress.GenerateContext(decimal)
+	require.Equal(t, compress.FloatCodecSnappy, ctx.Codec())
+	require.Equal(t, compress.FloatCodecALP, ctx.AdaptiveCodec(decimal))
+	require.True(t, ctx.ALP())
+	ctx.Release()
+
+	ctx = compress.GenerateContext(random)
+	require.Equal(t, compress.FloatCodecGorilla, ctx.Codec())
+	require.Equal(t, compress.FloatCodecChimp, ctx.AdaptiveCodec(random))
+	require.False(t, ctx.ALP())
+	ctx.Release()
+
+	random[10] = math.NaN()
+	ctx = compress.GenerateContext(random)
+	require.Equal(t, compress.FloatCodecSnappy, ctx.Codec())
+	require.Equal(t, compress.FloatCodecSnappy, ctx.AdaptiveCodec(random))
+	ctx.Release()
+
+	require.True(t, compress.IsValidFloatCodec(compress.FloatCodecAuto))
+	require.True(t, compress.IsValidFloatCodec(compress.FloatCodecChimp))
+	require.False(t, compress.IsValidFloatCodec("xxx"))
+}
+
+func TestCodecFloatBlock_Corrupted(t *testing.T) {
+	var values []float64
+	for i := 0; i < 100; i++ {
+		values = append(values, float64(i)/10)
+	}
+	in := util.Float64Slice2byte(values)
+
+	for _, codec := range []string{compress.FloatCodecChimp, compress.FloatCodecALP} {
+		float := compress.NewFloat()
+		float.SetCodec(codec)
+		encOut, err := float.AdaptiveEncoding(in, nil)
+		require.NoError(t, err)
+
+		_, err = float.AdaptiveDecoding(encOut[:len(encOut)/2], nil)
+		require.Error(t, err, codec)
+	}
+}
+
+func TestCodecFloatBlock_ErrorBound(t *testing.T) {
+	values := make([]float64, 1000)
+	for i := range values {
+		values[i] = 20 + math.Sin(float64(i)/50)*5 + rand.Float64()*0.01
+	}
+	in := util.Float64Slice2byte(append([]float64{}, values...))
+
+	lossless := compress.NewFloat()
+	losslessOut, err := lossless.AdaptiveEncoding(in, nil)
+	require.NoError(t, err)
+
+	for _, bound := range []float64{0.001, 0.05, 1} {
+		quantized := make([]float64, len(values))
+		for i := range values {
+			var ok bool
+			quantized[i], ok = compress.Quantize(values[i], bound)
+			require.True(t, ok)
+			require.LessOrEqual(t, math.Abs(values[i]-quantized[i]), bound*(1+1e-9), "bound %v, index %d", bound, i)
+		}
+
+		float := compress.NewFloat()
+		float.SetErrorBound(bound)
+		encOut, err := float.AdaptiveEncoding(util.Float64Slice2byte(append([]float64{}, quantized...)), nil)
+		require.NoError(t, err)
+		require.Less(t, len(encOut), len(losslessOut))
+
+		// the quantized values are stored exactly, encoding them again does not add to the error
+		decOut, err := float.AdaptiveDecoding(encOut, nil)
+		require.NoError(t, err)
+		require.Equal(t, quantized, util.Bytes2Float64Slice(decOut))
+		again, err := float.AdaptiveEncoding(decOut, nil)
+		require.NoError(t, err)
+		require.Equal(t, encOut, again)
+	}
+
+	// the values not quantized with the bound are encoded losslessly
+	float := compress.NewFloat()
+	float.SetErrorBound(0.05)
+	encOut, err := float.AdaptiveEncoding(in, nil)
+	require.NoError(t, err)
+	decOut, err := float.AdaptiveDecoding(encOut, nil)
+	require.NoError(t, err)
+	require.Equal(t, values, util.Bytes2Float64Slice(decOut))
+
+	// values that can not be quantized are encoded losslessly
+	special := []float64{1, math.NaN(), 3, math.Inf(1), 5, 6, 7, 8, 9, 10}
+	_, ok := compress.Quantize(math.NaN(), 0.5)
+	require.False(t, ok)
+	_, ok = compress.Quantize(1e300, 0.5)
+	require.False(t, ok)
+	float = compress.NewFloat()
+	float.SetErrorBound(0.5)
+	encOut, err = float.AdaptiveEncoding(util.Float64Slice2byte(append([]float64{}, special...)), nil)
+	require.NoError(t, err)
+	decOut, err = float.AdaptiveDecoding(encOut, nil)
+	require.NoError(t, err)
+	other := util.Bytes2Float64Slice(decOut)
+	for i := range special {
+		require.Equal(t, math.Float64bits(special[i]), math.Float64bits(other[i]))
+	}
+}
+
+func TestQuantizeDecoding_Corrupted(t *testing.T) {
+	_, err := compress.QuantizeDecoding([]byte{1, 2, 3}, nil)
+	require.Error(t, err)
+
+	buf := numberenc.MarshalUint64Append(nil, math.Float64bits(-1))
+	_, err = compress.QuantizeDecoding(buf, nil)
+	require.Error(t, err)
+
+	buf = numberenc.MarshalUint64Append(nil, math.Float64bits(0.5))
+	_, err = compress.QuantizeDecoding(append(buf, 1, 2), nil)
+	require.Error(t, err)
+}
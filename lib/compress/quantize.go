@@ -0,0 +1,98 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"github.com/openGemini/openGemini/lib/util"
+)
+
+// quantizeMaxStep is the largest number of quantization steps that float64 can represent exactly
+const quantizeMaxStep = 1 << 52
+
+// Quantize rounds v to the nearest multiple of 2*bound. The error bound is absolute: the result
+// differs from v by at most bound, up to the rounding of float64. ok is false if v is NaN,
+// infinite or too large to be quantized with the bound.
+// The values are quantized once, when the mem table is flushed; QuantizeEncoding stores the
+// quantized values exactly, so that compactions do not add to the error and the pre-aggregates
+// computed from the quantized values agree with the values read back.
+func Quantize(v, bound float64) (float64, bool) {
+	if !(bound > 0) || math.IsInf(bound, 0) {
+		return v, false
+	}
+	step := 2 * bound
+	q := math.Round(v / step)
+	if math.IsNaN(q) || q > quantizeMaxStep || q < -quantizeMaxStep {
+		return v, false
+	}
+	return q * step, true
+}
+
+// QuantizeEncoding encodes the values quantized by Quantize with the same bound: the multiples of
+// 2*bound are delta encoded and bit-packed by frame of reference. The encoding is lossless,
+// ok is false if a value is not a quantized value, e.g. it is written before the bound is set,
+// and the caller should fall back to another encoding.
+func QuantizeEncoding(in []byte, out []byte, bound float64) ([]byte, bool) {
+	if !(bound > 0) || math.IsInf(bound, 0) {
+		return out, false
+	}
+
+	values := util.Bytes2Float64Slice(in)
+	step := 2 * bound
+	deltas := make([]int64, len(values))
+	var prev int64
+	for i, v := range values {
+		q := math.Round(v / step)
+		if math.IsNaN(q) || q > quantizeMaxStep || q < -quantizeMaxStep || q*step != v {
+			return out, false
+		}
+		n := int64(q)
+		deltas[i] = n - prev
+		prev = n
+	}
+
+	out = numberenc.MarshalUint64Append(out, math.Float64bits(bound))
+	return ForEncoding(deltas, out), true
+}
+
+// QuantizeDecoding decompresses the data encoded by QuantizeEncoding, and appends the float64 values to out
+func QuantizeDecoding(in []byte, out []byte) ([]byte, error) {
+	if len(in) < util.Float64SizeBytes {
+		return nil, fmt.Errorf("quantize: too small data for decode %d", len(in))
+	}
+	bound := math.Float64frombits(numberenc.UnmarshalUint64(in))
+	if !(bound > 0) || math.IsInf(bound, 0) {
+		return nil, fmt.Errorf("quantize: invalid error bound %v", bound)
+	}
+
+	deltas, _, err := ForDecoding(in[util.Float64SizeBytes:], nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pos := len(out)
+	out = util.PaddingZeroBuffer(out, len(deltas)*util.Float64SizeBytes)
+	values := util.Bytes2Float64Slice(out[pos:])
+	step := 2 * bound
+	var n int64
+	for i, d := range deltas {
+		n += d
+		values[i] = float64(n) * step
+	}
+	return out, nil
+}
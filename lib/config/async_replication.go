@@ -0,0 +1,86 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultAsyncReplicationRunInterval  = 10 * time.Second
+	DefaultAsyncReplicationBatchRows    = 5000
+	DefaultAsyncReplicationWriteTimeout = 30 * time.Second
+	DefaultAsyncReplicationArchiveSize  = 10 * GB
+	DefaultAsyncReplicationArchiveAge   = 7 * 24 * time.Hour
+)
+
+// AsyncReplicationConfig represents a configuration for shipping the wal of the databases to the other cluster.
+type AsyncReplicationConfig struct {
+	// If false, the wal of the primary databases is not shipped by this node
+	Enabled bool `toml:"enabled"`
+
+	// Interval time for shipping the archived wal files to the target cluster.
+	RunInterval toml.Duration `toml:"run-interval"`
+
+	// The max number of the rows sent to the target cluster in one write request.
+	BatchRows int `toml:"batch-rows"`
+
+	// Timeout of a write request sent to the target cluster.
+	WriteTimeout toml.Duration `toml:"write-timeout"`
+
+	// The user authenticated by the target cluster, the user must be an admin user if the auth of the target is enabled.
+	Username string `toml:"username"`
+	Password string `toml:"password"`
+
+	// The max size of the archived wal files of a database on this node. The oldest files are dropped without
+	// being shipped if the size is exceeded, e.g. the target cluster is down for a long time. 0 means no limit.
+	MaxArchiveSize toml.Size `toml:"max-archive-size"`
+
+	// The archived wal files older than the age are dropped without being shipped. 0 means no limit.
+	MaxArchiveAge toml.Duration `toml:"max-archive-age"`
+}
+
+func NewAsyncReplicationConfig() AsyncReplicationConfig {
+	return AsyncReplicationConfig{
+		Enabled:        true,
+		RunInterval:    toml.Duration(DefaultAsyncReplicationRunInterval),
+		BatchRows:      DefaultAsyncReplicationBatchRows,
+		WriteTimeout:   toml.Duration(DefaultAsyncReplicationWriteTimeout),
+		MaxArchiveSize: toml.Size(DefaultAsyncReplicationArchiveSize),
+		MaxArchiveAge:  toml.Duration(DefaultAsyncReplicationArchiveAge),
+	}
+}
+
+func (c AsyncReplicationConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.RunInterval <= 0 {
+		return errors.New("run-interval must be positive")
+	}
+	if c.BatchRows <= 0 {
+		return errors.New("batch-rows must be positive")
+	}
+	if c.WriteTimeout <= 0 {
+		return errors.New("write-timeout must be positive")
+	}
+	if c.MaxArchiveAge < 0 {
+		return errors.New("max-archive-age can not be negative")
+	}
+	return nil
+}
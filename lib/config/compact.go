@@ -15,6 +15,7 @@
 package config
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/influxdata/influxdb/toml"
@@ -29,6 +30,16 @@ const (
 	DefaultSnapshotThroughput           = 48 * MB
 	DefaultSnapshotThroughputBurst      = 48 * MB
 	DefaultBackGroundReadThroughput     = 64 * MB
+	DefaultCompactionTimeWindow         = 24 * time.Hour
+)
+
+// Compaction strategies of tsstore.
+// The level strategy compacts files of the same level repeatedly until they are fully compacted,
+// the time-window strategy only compacts files in the same time window, and stops compacting a window after
+// it has been closed and compacted into one file, which reduces the write amplification of append-mostly data.
+const (
+	CompactionStrategyLevel      = "level"
+	CompactionStrategyTimeWindow = "time-window"
 )
 
 type Compact struct {
@@ -44,6 +55,8 @@ type Compact struct {
 	CompactRecovery              bool          `toml:"compact-recovery"`
 	CsCompactionEnabled          bool          `toml:"column-store-compact-enabled"`
 	CorrectTimeDisorder          bool          `toml:"correct-time-disorder"`
+	CompactionStrategy           string        `toml:"compaction-strategy"`
+	CompactionTimeWindow         toml.Duration `toml:"compaction-time-window"`
 }
 
 func NewCompactConfig() Compact {
@@ -57,5 +70,25 @@ func NewCompactConfig() Compact {
 		CompactionMethod:             0, // 0:auto, 1: streaming, 2: non-streaming
 		CompactRecovery:              true,
 		CsCompactionEnabled:          false,
+		CompactionStrategy:           CompactionStrategyLevel,
+		CompactionTimeWindow:         toml.Duration(DefaultCompactionTimeWindow),
+	}
+}
+
+func (c Compact) Validate() error {
+	switch c.CompactionStrategy {
+	case "", CompactionStrategyLevel:
+	case CompactionStrategyTimeWindow:
+		if c.CompactionTimeWindow <= 0 {
+			return fmt.Errorf("compaction-time-window must be positive, got %s", time.Duration(c.CompactionTimeWindow))
+		}
+	default:
+		return fmt.Errorf("invalid compaction-strategy %q, expect %q or %q",
+			c.CompactionStrategy, CompactionStrategyLevel, CompactionStrategyTimeWindow)
 	}
+	return nil
+}
+
+func (c Compact) IsTimeWindowStrategy() bool {
+	return c.CompactionStrategy == CompactionStrategyTimeWindow
 }
@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/influxdata/influxdb/toml"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -339,3 +340,138 @@ func TestProductType(t *testing.T) {
 		t.Fatalf("GetProductType failed, expect: logkeeper, real:%d", config.GetProductType())
 	}
 }
+
+func TestCompact_Validate(t *testing.T) {
+	conf := config.NewCompactConfig()
+	require.NoError(t, conf.Validate())
+	require.False(t, conf.IsTimeWindowStrategy())
+
+	conf.CompactionStrategy = config.CompactionStrategyTimeWindow
+	require.NoError(t, conf.Validate())
+	require.True(t, conf.IsTimeWindowStrategy())
+
+	conf.CompactionTimeWindow = 0
+	require.EqualError(t, conf.Validate(), "compaction-time-window must be positive, got 0s")
+
+	conf.CompactionStrategy = "size"
+	require.EqualError(t, conf.Validate(), `invalid compaction-strategy "size", expect "level" or "time-window"`)
+}
+
+func TestIndex_GetSeriesLimit(t *testing.T) {
+	txt := `
+[index]
+  max-series-per-index = 1000
+  max-measurement-series-per-index = 100
+  [[index.series-limits]]
+    database = "db0"
+    max-measurement-series-per-index = 10
+`
+	configFile := t.TempDir() + "/store.conf"
+	require.NoError(t, os.WriteFile(configFile, []byte(txt), 0600))
+
+	conf := config.NewTSStore(false)
+	require.NoError(t, config.Parse(conf, configFile))
+
+	maxSeries, maxMstSeries := conf.Index.GetSeriesLimit("db0")
+	assert.Equal(t, 0, maxSeries)
+	assert.Equal(t, 10, maxMstSeries)
+
+	maxSeries, maxMstSeries = conf.Index.GetSeriesLimit("db1")
+	assert.Equal(t, 1000, maxSeries)
+	assert.Equal(t, 100, maxMstSeries)
+	assert.True(t, conf.Index.SeriesLimitEnabled())
+
+	conf.Index.MaxSeriesPerIndex, conf.Index.MaxMeasurementSeriesPerIndex = 0, 0
+	assert.True(t, conf.Index.SeriesLimitEnabled())
+	conf.Index.SeriesLimits = nil
+	assert.False(t, conf.Index.SeriesLimitEnabled())
+}
+
+func TestCoordinator_ReadConsistency(t *testing.T) {
+	conf := config.NewCoordinator()
+	assert.Equal(t, config.ReadConsistencyLeader, conf.ReadConsistency)
+	require.NoError(t, conf.Validate())
+
+	conf.ReadConsistency = config.ReadConsistencyBoundedStaleness
+	require.NoError(t, conf.Validate())
+
+	conf.ReadConsistency = "strong"
+	require.Error(t, conf.Validate())
+}
+
+func TestRepairConfig_Validate(t *testing.T) {
+	conf := config.NewRepairConfig()
+	require.NoError(t, conf.Validate())
+
+	conf.Enabled = true
+	require.NoError(t, conf.Validate())
+
+	conf.ConfirmRounds = 0
+	require.EqualError(t, conf.Validate(), "confirm-rounds must be positive")
+
+	conf.ConfirmRounds = 1
+	conf.DigestRange = 0
+	require.EqualError(t, conf.Validate(), "digest-range must be positive")
+
+	conf.DigestRange = toml.Duration(time.Minute)
+	conf.RunInterval = 0
+	require.EqualError(t, conf.Validate(), "run-interval must be positive")
+}
+
+func TestRebalanceConfig_Validate(t *testing.T) {
+	conf := config.NewRebalanceConfig()
+	require.NoError(t, conf.Validate())
+
+	conf.Enabled = true
+	require.NoError(t, conf.Validate())
+
+	conf.MaxConcurrentMoves = 0
+	require.EqualError(t, conf.Validate(), "max-concurrent-moves must be positive")
+
+	conf.MaxConcurrentMoves = 1
+	conf.DiskWeight, conf.SeriesWeight, conf.WriteWeight, conf.QueryWeight = 0, 0, 0, 0
+	require.EqualError(t, conf.Validate(), "at least one weight of the load must be positive")
+
+	conf.QueryWeight = -1
+	require.EqualError(t, conf.Validate(), "the weights of the load must be non-negative")
+
+	meta := config.NewMeta()
+	meta.Rebalance.Enabled = true
+	meta.Rebalance.ImbalanceThreshold = 0
+	require.EqualError(t, meta.Validate(), "imbalance-threshold must be positive")
+}
+
+func TestShardSplitConfig_Validate(t *testing.T) {
+	conf := config.NewShardSplitConfig()
+	require.NoError(t, conf.Validate())
+
+	conf.Enabled = true
+	require.NoError(t, conf.Validate())
+
+	conf.HotRounds = 0
+	require.EqualError(t, conf.Validate(), "hot-rounds must be positive")
+
+	conf.HotRounds = 1
+	conf.MaxShardsPerGroup = -1
+	require.EqualError(t, conf.Validate(), "max-shards-per-group must be non-negative")
+
+	meta := config.NewMeta()
+	meta.ShardSplit.Enabled = true
+	meta.ShardSplit.HotWriteRate = 0
+	require.EqualError(t, meta.Validate(), "hot-write-rate must be positive")
+}
+
+func TestAsyncReplicationConfig_Validate(t *testing.T) {
+	conf := config.NewAsyncReplicationConfig()
+	require.NoError(t, conf.Validate())
+
+	conf.BatchRows = 0
+	require.EqualError(t, conf.Validate(), "batch-rows must be positive")
+
+	conf.BatchRows = 1
+	conf.WriteTimeout = 0
+	require.EqualError(t, conf.Validate(), "write-timeout must be positive")
+
+	conf.Enabled = false
+	require.NoError(t, conf.Validate())
+}
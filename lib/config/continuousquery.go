@@ -31,11 +31,24 @@ type ContinuousQueryConfig struct {
 
 	// MaxProcessCQNumber is the max number of CQs to process in one run.
 	MaxProcessCQNumber int `toml:"max-process-CQ-number"`
+
+	// BackfillHorizon is how far back the windows missed by the outages of ts-sql are run.
+	// The windows older than it are skipped.
+	BackfillHorizon toml.Duration `toml:"backfill-horizon"`
+
+	// BackfillThrottle is the pause between two windows of a backfill started by BACKFILL CONTINUOUS QUERY.
+	BackfillThrottle toml.Duration `toml:"backfill-throttle"`
 }
 
 const (
 	// DefaultRunInterval is the default interval at which the CQ service will run.
 	DefaultRunInterval = time.Second
+
+	// DefaultBackfillHorizon is the default horizon of running the missed windows.
+	DefaultBackfillHorizon = time.Hour
+
+	// DefaultBackfillThrottle is the default pause between two windows of a backfill.
+	DefaultBackfillThrottle = time.Second
 )
 
 // NewContinuousQueryConfig returns a new instance of ContinuousQueryConfig with defaults.
@@ -44,6 +57,8 @@ func NewContinuousQueryConfig() ContinuousQueryConfig {
 		Enabled:            true,
 		RunInterval:        toml.Duration(DefaultRunInterval),
 		MaxProcessCQNumber: 0,
+		BackfillHorizon:    toml.Duration(DefaultBackfillHorizon),
+		BackfillThrottle:   toml.Duration(DefaultBackfillThrottle),
 	}
 }
 
@@ -55,6 +70,12 @@ func (c ContinuousQueryConfig) Validate() error {
 	if c.MaxProcessCQNumber < 0 {
 		return errors.New("continuous query max process CQ number must be greater or equal than 0")
 	}
+	if c.BackfillHorizon < 0 {
+		return errors.New("continuous query backfill horizon must be greater or equal than 0")
+	}
+	if c.BackfillThrottle < 0 {
+		return errors.New("continuous query backfill throttle must be greater or equal than 0")
+	}
 	return nil
 }
 
@@ -67,5 +88,7 @@ func (c *ContinuousQueryConfig) ShowConfigs() map[string]interface{} {
 		"continuous-query.enabled":               c.Enabled,
 		"continuous-query.run-interval":          c.RunInterval,
 		"continuous-query.max-process-CQ-number": c.MaxProcessCQNumber,
+		"continuous-query.backfill-horizon":      c.BackfillHorizon,
+		"continuous-query.backfill-throttle":     c.BackfillThrottle,
 	}
 }
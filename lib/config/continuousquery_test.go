@@ -34,4 +34,11 @@ func Test_ContinuousQueryConfig_Validate(t *testing.T) {
 	c.MaxProcessCQNumber = 0
 	require.NoError(t, c.Validate())
 
+	c.BackfillHorizon = -1
+	require.EqualError(t, c.Validate(), "continuous query backfill horizon must be greater or equal than 0")
+	c.BackfillHorizon = 0
+
+	c.BackfillThrottle = -1
+	require.EqualError(t, c.Validate(), "continuous query backfill throttle must be greater or equal than 0")
+
 }
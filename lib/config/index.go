@@ -22,6 +22,8 @@ import (
 
 const (
 	defaultTagScanPruneThreshold = 20000
+
+	DefaultSetIndexMaxCardinality = 256
 )
 
 type Index struct {
@@ -35,6 +37,48 @@ type Index struct {
 
 	CacheCompressEnable bool `toml:"cache-compress-enable"`
 	BloomFilterEnabled  bool `toml:"bloom-filter-enable"`
+
+	// SetIndexMaxCardinality is the maximum number of distinct values kept per fragment by the set skip index.
+	// A fragment exceeding it is marked as overflowed and is never pruned.
+	SetIndexMaxCardinality int `toml:"set-index-max-cardinality"`
+
+	// MaxSeriesPerIndex and MaxMeasurementSeriesPerIndex limit the series created in each index of a database
+	// and the series of each measurement in each index, 0 means unlimited. An index covers the shards of
+	// a partition in an index time range on a store, so the limits are not aggregated over the partitions,
+	// the stores or the time ranges of a database. The limits of a database in SeriesLimits override them.
+	MaxSeriesPerIndex            int           `toml:"max-series-per-index"`
+	MaxMeasurementSeriesPerIndex int           `toml:"max-measurement-series-per-index"`
+	SeriesLimits                 []SeriesLimit `toml:"series-limits"`
+}
+
+// SeriesLimit is the series limits of the indexes of a database
+type SeriesLimit struct {
+	Database                     string `toml:"database"`
+	MaxSeriesPerIndex            int    `toml:"max-series-per-index"`
+	MaxMeasurementSeriesPerIndex int    `toml:"max-measurement-series-per-index"`
+}
+
+// GetSeriesLimit returns the max number of the series of an index of the database and of each measurement in the index
+func (c *Index) GetSeriesLimit(db string) (int, int) {
+	for i := range c.SeriesLimits {
+		if c.SeriesLimits[i].Database == db {
+			return c.SeriesLimits[i].MaxSeriesPerIndex, c.SeriesLimits[i].MaxMeasurementSeriesPerIndex
+		}
+	}
+	return c.MaxSeriesPerIndex, c.MaxMeasurementSeriesPerIndex
+}
+
+// SeriesLimitEnabled returns true if the series of the indexes of any database are limited
+func (c *Index) SeriesLimitEnabled() bool {
+	if c.MaxSeriesPerIndex > 0 || c.MaxMeasurementSeriesPerIndex > 0 {
+		return true
+	}
+	for i := range c.SeriesLimits {
+		if c.SeriesLimits[i].MaxSeriesPerIndex > 0 || c.SeriesLimits[i].MaxMeasurementSeriesPerIndex > 0 {
+			return true
+		}
+	}
+	return false
 }
 
 func NewIndex() *Index {
@@ -48,6 +92,9 @@ func SetIndexConfig(conf *Index) {
 	if indexConfig.TagScanPruneThreshold == 0 {
 		indexConfig.TagScanPruneThreshold = defaultTagScanPruneThreshold
 	}
+	if indexConfig.SetIndexMaxCardinality <= 0 {
+		indexConfig.SetIndexMaxCardinality = DefaultSetIndexMaxCardinality
+	}
 
 	if conf.MemoryAllowedPercent > 0 {
 		// See: github.com/VictoriaMetrics/VictoriaMetrics/lib/memory/memory.go memory.allowedPercent
@@ -59,8 +106,9 @@ func SetIndexConfig(conf *Index) {
 func GetIndexConfig() *Index {
 	if indexConfig == nil {
 		return &Index{
-			CacheCompressEnable:   true,
-			TagScanPruneThreshold: defaultTagScanPruneThreshold,
+			CacheCompressEnable:    true,
+			TagScanPruneThreshold:  defaultTagScanPruneThreshold,
+			SetIndexMaxCardinality: DefaultSetIndexMaxCardinality,
 		}
 	}
 	return indexConfig
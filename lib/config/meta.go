@@ -191,6 +191,9 @@ type Meta struct {
 	SQLiteEnabled  bool  `toml:"sqlite-enabled"`
 	RepDisPolicy   uint8 `toml:"rep-dis-policy"`
 	SchemaCleanEn  bool  `toml:"schema-clean-enable"`
+
+	Rebalance  RebalanceConfig  `toml:"rebalance"`
+	ShardSplit ShardSplitConfig `toml:"shard-split"`
 }
 
 // NewMeta builds a new configuration with default values.
@@ -222,6 +225,8 @@ func NewMeta() *Meta {
 		NumOfShards:             DefaultNumOfShards,
 		SQLiteEnabled:           DefalutSQLiteEnabled,
 		UseIncSyncData:          true,
+		Rebalance:               NewRebalanceConfig(),
+		ShardSplit:              NewShardSplitConfig(),
 	}
 }
 
@@ -253,7 +258,10 @@ func (c *Meta) Validate() error {
 		return err
 	}
 
-	return nil
+	if err := c.Rebalance.Validate(); err != nil {
+		return err
+	}
+	return c.ShardSplit.Validate()
 }
 
 func (c *Meta) BuildRaft() *raft.Config {
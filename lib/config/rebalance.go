@@ -0,0 +1,89 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultRebalanceRunInterval        = 5 * time.Minute
+	DefaultRebalanceImbalanceThreshold = 0.2
+	DefaultRebalanceMaxConcurrentMoves = 1
+	DefaultRebalanceDiskWeight         = 0.4
+	DefaultRebalanceSeriesWeight       = 0.2
+	DefaultRebalanceWriteWeight        = 0.2
+	DefaultRebalanceQueryWeight        = 0.2
+)
+
+// RebalanceConfig represents a configuration for the load-aware rebalancing of the partitions
+// on the write-available-first clusters, whose data is stored on the local disks of the ts-store nodes.
+type RebalanceConfig struct {
+	// If false, close the rebalancing
+	Enabled bool `toml:"enabled"`
+
+	// Interval time for collecting the loads of the partitions and moving a partition if needed.
+	RunInterval toml.Duration `toml:"run-interval"`
+
+	// A partition is moved when the load of the busiest node exceeds the average load by the ratio.
+	ImbalanceThreshold float64 `toml:"imbalance-threshold"`
+
+	// The maximum number of the partitions which are moved at the same time.
+	MaxConcurrentMoves int `toml:"max-concurrent-moves"`
+
+	// The weights of the disk usage, the series count, the write rate and the query rate in the load of a partition.
+	DiskWeight   float64 `toml:"disk-weight"`
+	SeriesWeight float64 `toml:"series-weight"`
+	WriteWeight  float64 `toml:"write-weight"`
+	QueryWeight  float64 `toml:"query-weight"`
+}
+
+func NewRebalanceConfig() RebalanceConfig {
+	return RebalanceConfig{
+		Enabled:            false,
+		RunInterval:        toml.Duration(DefaultRebalanceRunInterval),
+		ImbalanceThreshold: DefaultRebalanceImbalanceThreshold,
+		MaxConcurrentMoves: DefaultRebalanceMaxConcurrentMoves,
+		DiskWeight:         DefaultRebalanceDiskWeight,
+		SeriesWeight:       DefaultRebalanceSeriesWeight,
+		WriteWeight:        DefaultRebalanceWriteWeight,
+		QueryWeight:        DefaultRebalanceQueryWeight,
+	}
+}
+
+func (c RebalanceConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.RunInterval <= 0 {
+		return errors.New("run-interval must be positive")
+	}
+	if c.ImbalanceThreshold <= 0 {
+		return errors.New("imbalance-threshold must be positive")
+	}
+	if c.MaxConcurrentMoves <= 0 {
+		return errors.New("max-concurrent-moves must be positive")
+	}
+	if c.DiskWeight < 0 || c.SeriesWeight < 0 || c.WriteWeight < 0 || c.QueryWeight < 0 {
+		return errors.New("the weights of the load must be non-negative")
+	}
+	if c.DiskWeight+c.SeriesWeight+c.WriteWeight+c.QueryWeight == 0 {
+		return errors.New("at least one weight of the load must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultRepairRunInterval   = 10 * time.Minute
+	DefaultRepairColdDuration  = time.Hour
+	DefaultRepairConfirmRounds = 2
+	DefaultRepairDigestRange   = time.Hour
+)
+
+// RepairConfig represents a configuration for the anti-entropy service of the replicated partitions.
+type RepairConfig struct {
+	// If false, close the anti-entropy service
+	Enabled bool `toml:"enabled"`
+
+	// Interval time for comparing the digests of the replicas.
+	RunInterval toml.Duration `toml:"run-interval"`
+
+	// A shard is compared after it is not written for the duration.
+	ColdDuration toml.Duration `toml:"cold-duration"`
+
+	// A divergence is repaired after it is found in the consecutive rounds,
+	// so that the replicas which are catching up with the leader are not repaired.
+	ConfirmRounds int `toml:"confirm-rounds"`
+
+	// The digest of a measurement is split into the time ranges of the duration,
+	// only the rows in the diverged time ranges are streamed to the replica.
+	DigestRange toml.Duration `toml:"digest-range"`
+}
+
+func NewRepairConfig() RepairConfig {
+	return RepairConfig{
+		Enabled:       false,
+		RunInterval:   toml.Duration(DefaultRepairRunInterval),
+		ColdDuration:  toml.Duration(DefaultRepairColdDuration),
+		ConfirmRounds: DefaultRepairConfirmRounds,
+		DigestRange:   toml.Duration(DefaultRepairDigestRange),
+	}
+}
+
+func (c RepairConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.RunInterval <= 0 {
+		return errors.New("run-interval must be positive")
+	}
+	if c.ColdDuration < 0 {
+		return errors.New("cold-duration must be non-negative")
+	}
+	if c.ConfirmRounds <= 0 {
+		return errors.New("confirm-rounds must be positive")
+	}
+	if c.DigestRange <= 0 {
+		return errors.New("digest-range must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultShardSplitRunInterval  = 30 * time.Second
+	DefaultShardSplitHotWriteRate = 100000
+	DefaultShardSplitHotRounds    = 3
+)
+
+// ShardSplitConfig represents a configuration for splitting the hot shards of the range sharding retention policies.
+type ShardSplitConfig struct {
+	// If false, close the shard split
+	Enabled bool `toml:"enabled"`
+
+	// Interval time for checking the write rates of the shards.
+	RunInterval toml.Duration `toml:"run-interval"`
+
+	// A shard is hot when its write rate exceeds the rows per second.
+	HotWriteRate int64 `toml:"hot-write-rate"`
+
+	// A hot shard is split after it is hot in the consecutive checks.
+	HotRounds int `toml:"hot-rounds"`
+
+	// The maximum number of the shards in a shard group, 0 means the number of the partitions of the database.
+	MaxShardsPerGroup int `toml:"max-shards-per-group"`
+}
+
+func NewShardSplitConfig() ShardSplitConfig {
+	return ShardSplitConfig{
+		Enabled:      false,
+		RunInterval:  toml.Duration(DefaultShardSplitRunInterval),
+		HotWriteRate: DefaultShardSplitHotWriteRate,
+		HotRounds:    DefaultShardSplitHotRounds,
+	}
+}
+
+func (c ShardSplitConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.RunInterval <= 0 {
+		return errors.New("run-interval must be positive")
+	}
+	if c.HotWriteRate <= 0 {
+		return errors.New("hot-write-rate must be positive")
+	}
+	if c.HotRounds <= 0 {
+		return errors.New("hot-rounds must be positive")
+	}
+	if c.MaxShardsPerGroup < 0 {
+		return errors.New("max-shards-per-group must be non-negative")
+	}
+	return nil
+}
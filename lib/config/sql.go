@@ -49,8 +49,30 @@ const (
 	DefaultShardTier                = "warm"
 	DefaultForceBroadcastQuery      = false
 	DefaultRetentionPolicyLimit     = 100
+
+	DefaultFollowerReadTimeout = 500 * time.Millisecond
+	DefaultFollowerReadMaxLag  = 1000
+	DefaultFollowerLagBackoff  = 10 * time.Second
 )
 
+// the consistency levels of the queries on the databases with the replication policy
+const (
+	// ReadConsistencyLeader reads from the leaders of the raft groups only
+	ReadConsistencyLeader = "leader"
+	// ReadConsistencyBoundedStaleness reads from a follower after it applies up to the commit index obtained from the leader
+	ReadConsistencyBoundedStaleness = "bounded-staleness"
+	// ReadConsistencyEventual reads from a follower whose applied index lags behind the commit index of the leader by no more than follower-read-max-lag
+	ReadConsistencyEventual = "eventual"
+)
+
+func IsValidReadConsistency(consistency string) bool {
+	switch consistency {
+	case ReadConsistencyLeader, ReadConsistencyBoundedStaleness, ReadConsistencyEventual:
+		return true
+	}
+	return false
+}
+
 /*
 	for every column in httpSpec, it means:
 	0: maxConnectionLimit
@@ -254,6 +276,13 @@ type Coordinator struct {
 	QueryLimitFlag          bool `toml:"query-limit-flag"`
 	QueryTimeCompareEnabled bool `toml:"query-time-compare-enabled"`
 	ForceBroadcastQuery     bool `toml:"force-broadcast-query"`
+
+	// ReadConsistency is the default consistency level of the queries on the databases with the replication policy,
+	// it can be overridden by the read_consistency parameter of a query.
+	ReadConsistency     string        `toml:"read-consistency"`
+	FollowerReadTimeout toml.Duration `toml:"follower-read-timeout"`
+	FollowerReadMaxLag  uint64        `toml:"follower-read-max-lag"`
+	FollowerLagBackoff  toml.Duration `toml:"follower-lag-backoff"`
 }
 
 // NewCoordinator returns an instance of Config with defaults.
@@ -273,6 +302,10 @@ func NewCoordinator() Coordinator {
 		ShardTier:                DefaultShardTier,
 		RetentionPolicyLimit:     DefaultRetentionPolicyLimit,
 		ForceBroadcastQuery:      DefaultForceBroadcastQuery,
+		ReadConsistency:          ReadConsistencyLeader,
+		FollowerReadTimeout:      toml.Duration(DefaultFollowerReadTimeout),
+		FollowerReadMaxLag:       DefaultFollowerReadMaxLag,
+		FollowerLagBackoff:       toml.Duration(DefaultFollowerLagBackoff),
 	}
 }
 
@@ -287,6 +320,12 @@ func (c Coordinator) Validate() error {
 	if c.ShardMapperTimeout < 0 {
 		return errors.New("coordinator shard-mapper-timeout can not be negative")
 	}
+	if c.ReadConsistency != "" && !IsValidReadConsistency(c.ReadConsistency) {
+		return errors.New("coordinator read-consistency must be one of leader, bounded-staleness and eventual")
+	}
+	if c.FollowerReadTimeout < 0 {
+		return errors.New("coordinator follower-read-timeout can not be negative")
+	}
 	return nil
 }
 
@@ -309,5 +348,9 @@ func (c *Coordinator) ShowConfigs() map[string]interface{} {
 		"coordinator.rp-limit":                    c.RetentionPolicyLimit,
 		"coordinator.time-range-limit":            c.TimeRangeLimit,
 		"coordinator.tag-limit":                   c.TagLimit,
+		"coordinator.read-consistency":            c.ReadConsistency,
+		"coordinator.follower-read-timeout":       c.FollowerReadTimeout,
+		"coordinator.follower-read-max-lag":       c.FollowerReadMaxLag,
+		"coordinator.follower-lag-backoff":        c.FollowerLagBackoff,
 	}
 }
@@ -84,11 +84,13 @@ type TSStore struct {
 	Gossip      *Gossip     `toml:"gossip"`
 	Spdy        Spdy        `toml:"spdy"`
 
-	HTTPD             httpdConf.Config   `toml:"http"`
-	Retention         retention.Config   `toml:"retention"`
-	DownSample        retention.Config   `toml:"downsample"`
-	HierarchicalStore HierarchicalConfig `toml:"hierarchical_storage"`
-	Stream            stream.Config      `toml:"stream"`
+	HTTPD             httpdConf.Config       `toml:"http"`
+	Retention         retention.Config       `toml:"retention"`
+	DownSample        retention.Config       `toml:"downsample"`
+	HierarchicalStore HierarchicalConfig     `toml:"hierarchical_storage"`
+	Repair            RepairConfig           `toml:"repair"`
+	AsyncReplication  AsyncReplicationConfig `toml:"async-replication"`
+	Stream            stream.Config          `toml:"stream"`
 
 	// TLS provides configuration options for all https endpoints.
 	TLS        tlsconfig.Config   `toml:"tls"`
@@ -123,6 +125,8 @@ func NewTSStore(enableGossip bool) *TSStore {
 	c.Retention = retention.NewConfig()
 	c.DownSample = retention.NewConfig()
 	c.HierarchicalStore = NewHierarchicalConfig()
+	c.Repair = NewRepairConfig()
+	c.AsyncReplication = NewAsyncReplicationConfig()
 	c.Gossip = NewGossip(enableGossip)
 
 	c.Analysis = NewCastor()
@@ -149,6 +153,8 @@ func (c *TSStore) Validate() error {
 		c.Retention,
 		c.DownSample,
 		c.HierarchicalStore,
+		c.Repair,
+		c.AsyncReplication,
 		c.TLS,
 		c.Logging,
 		c.Spdy,
@@ -444,7 +450,7 @@ func (c Store) Validate() error {
 		return err
 	}
 
-	return nil
+	return c.Compact.Validate()
 }
 
 func (c Store) ValidateEngine(engines []string) error {
@@ -0,0 +1,74 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+
+	"github.com/openGemini/openGemini/lib/compress"
+)
+
+// Codecs forces the algorithms used to encode the float and integer columns of a measurement.
+// An empty codec lets the encoder choose the algorithm adaptively.
+type Codecs struct {
+	Float   string
+	Integer string
+
+	// ErrorBounds enables the lossy encoding of the float fields, key is the field name,
+	// value is the max absolute error of the values read back. The values are quantized
+	// once when the mem table is flushed, see compress.Quantize
+	ErrorBounds map[string]float64
+}
+
+func (c Codecs) IsEmpty() bool {
+	return c.Float == "" && c.Integer == "" && len(c.ErrorBounds) == 0
+}
+
+func (c Codecs) Validate() error {
+	for field, bound := range c.ErrorBounds {
+		if !(bound > 0) || math.IsInf(bound, 0) {
+			return fmt.Errorf("invalid error bound %v of field %q, expect a positive number", bound, field)
+		}
+	}
+	if !compress.IsValidFloatCodec(c.Float) {
+		return fmt.Errorf("invalid float codec %q, expect one of %v", c.Float, compress.FloatCodecs)
+	}
+	if !IsValidIntCodec(c.Integer) {
+		return fmt.Errorf("invalid integer codec %q, expect one of %v", c.Integer, IntCodecs)
+	}
+	return nil
+}
+
+// CodecsResolver returns the codecs of the measurement of db/rp, name is the measurement name with version
+type CodecsResolver func(db, rp, name string) Codecs
+
+var codecsResolver atomic.Pointer[CodecsResolver]
+
+// SetCodecsResolver sets how the codecs of the measurements are looked up. The codecs are
+// looked up each time a file is written, so that the codecs altered in the meta data
+// apply from the next flush or compaction on
+func SetCodecsResolver(resolver CodecsResolver) {
+	codecsResolver.Store(&resolver)
+}
+
+func GetMeasurementCodecs(db, rp, name string) Codecs {
+	resolver := codecsResolver.Load()
+	if resolver == nil || *resolver == nil {
+		return Codecs{}
+	}
+	return (*resolver)(db, rp, name)
+}
@@ -249,6 +249,8 @@ type CoderContext struct {
 	stringCoder *String
 	boolCoder   *Boolean
 	buf         []byte
+	codecs      Codecs
+	errorBound  float64
 }
 
 func NewCoderContext() *CoderContext {
@@ -282,6 +284,22 @@ func (ctx *CoderContext) Release() {
 	}
 }
 
+// SetCodecs forces the codecs of the float and integer columns encoded by the context
+func (ctx *CoderContext) SetCodecs(codecs Codecs) {
+	ctx.codecs = codecs
+	ctx.errorBound = 0
+}
+
+// SelectField applies the error bound of the field to the float blocks encoded next,
+// fields without an error bound are encoded losslessly
+func (ctx *CoderContext) SelectField(name string) {
+	ctx.errorBound = ctx.codecs.ErrorBounds[name]
+}
+
+func (ctx *CoderContext) GetCodecs() Codecs {
+	return ctx.codecs
+}
+
 func (ctx *CoderContext) GetTimeCoder() *Time {
 	return ctx.timeCoder
 }
@@ -330,6 +348,7 @@ func EncodeIntegerBlock(in, out []byte, ctx *CoderContext) ([]byte, error) {
 	if ctx.intCoder == nil {
 		ctx.intCoder = GetIntCoder()
 	}
+	ctx.intCoder.SetCodec(ctx.codecs.Integer)
 	return ctx.intCoder.Encoding(in, out)
 }
 
@@ -355,6 +374,8 @@ func EncodeFloatBlock(in []byte, out []byte, ctx *CoderContext) ([]byte, error)
 	if ctx.floatCoder == nil {
 		ctx.floatCoder = GetFloatCoder()
 	}
+	ctx.floatCoder.SetCodec(ctx.codecs.Float)
+	ctx.floatCoder.SetErrorBound(ctx.errorBound)
 	return ctx.floatCoder.Encoding(in, out)
 }
 
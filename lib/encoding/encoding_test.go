@@ -17,11 +17,13 @@ package encoding
 import (
 	safeRand "crypto/rand"
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 	"testing"
 	"time"
 
+	"github.com/openGemini/openGemini/lib/compress"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/stretchr/testify/assert"
@@ -855,3 +857,125 @@ func TestGetCompressAlgo(t *testing.T) {
 	conf.StringCompressAlgo = "xxx"
 	require.Equal(t, stringCompressedSnappy, GetCompressAlgo())
 }
+
+func TestEncoding_IntBlock_Codecs(t *testing.T) {
+	counter := make([]int64, 1000)
+	random := make([]int64, 1000)
+	for i := range counter {
+		// a counter increases by about 1000 each time
+		counter[i] = int64(i*1000 + i%7)
+		random[i] = int64(i*i) - 1<<40
+	}
+	random[10] = 1 << 62
+	random[11] = -1 << 62
+
+	for _, values := range [][]int64{counter, random, {1, 3, 2}} {
+		for _, codec := range append([]string{IntCodecAuto}, IntCodecs...) {
+			ctx := NewCoderContext()
+			ctx.SetCodecs(Codecs{Integer: codec})
+
+			prefix := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+			out, err := EncodeIntegerBlock(util.Int64Slice2byte(values), nil, ctx)
+			require.NoError(t, err)
+
+			decOut := append([]byte{}, prefix...)
+			got, err := DecodeIntegerBlock(out, &decOut, ctx)
+			require.NoError(t, err)
+			require.Equal(t, values, got[1:], codec)
+			require.Equal(t, prefix, decOut[:8], codec)
+			ctx.Release()
+		}
+	}
+
+	enc := GetIntCoder()
+	defer PutDataCoder(enc)
+	// the default codec is readable by the versions without FOR
+	enc.SetCodec(IntCodecAuto)
+	out, err := enc.Encoding(util.Int64Slice2byte(counter), nil)
+	require.NoError(t, err)
+	require.NotEqual(t, intCompressedFOR, int(out[0]>>4))
+
+	enc.SetCodec(IntCodecAdaptive)
+	out, err = enc.Encoding(util.Int64Slice2byte(counter), nil)
+	require.NoError(t, err)
+	require.Equal(t, intCompressedFOR, int(out[0]>>4))
+
+	_, err = enc.Decoding(out[:len(out)-1], nil)
+	require.Error(t, err)
+}
+
+func TestEncoding_FloatBlock_Codecs(t *testing.T) {
+	values := make([]float64, 100)
+	for i := range values {
+		values[i] = float64(i%13) / 4
+	}
+
+	for _, codec := range []string{"", "gorilla", "chimp", "alp", "snappy"} {
+		ctx := NewCoderContext()
+		ctx.SetCodecs(Codecs{Float: codec})
+		out, err := EncodeFloatBlock(util.Float64Slice2byte(values), nil, ctx)
+		require.NoError(t, err)
+
+		var decOut []byte
+		got, err := DecodeFloatBlock(out, &decOut, ctx)
+		require.NoError(t, err)
+		require.Equal(t, values, got, codec)
+		ctx.Release()
+	}
+}
+
+func TestMeasurementCodecs(t *testing.T) {
+	require.NoError(t, Codecs{}.Validate())
+	require.NoError(t, Codecs{Float: "alp", Integer: "for"}.Validate())
+	require.Error(t, Codecs{Float: "xxx"}.Validate())
+	require.Error(t, Codecs{Integer: "xxx"}.Validate())
+
+	require.Equal(t, Codecs{}, GetMeasurementCodecs("db0", "rp0", "mst_0000"))
+	SetCodecsResolver(func(db, rp, name string) Codecs {
+		if db == "db0" && rp == "rp0" && name == "mst_0000" {
+			return Codecs{Float: "chimp"}
+		}
+		return Codecs{}
+	})
+	defer SetCodecsResolver(nil)
+	require.Equal(t, Codecs{Float: "chimp"}, GetMeasurementCodecs("db0", "rp0", "mst_0000"))
+	require.Equal(t, Codecs{}, GetMeasurementCodecs("db1", "rp0", "mst_0000"))
+	SetCodecsResolver(nil)
+	require.Equal(t, Codecs{}, GetMeasurementCodecs("db0", "rp0", "mst_0000"))
+
+	require.NoError(t, Codecs{ErrorBounds: map[string]float64{"temp": 0.01}}.Validate())
+	require.Error(t, Codecs{ErrorBounds: map[string]float64{"temp": 0}}.Validate())
+	require.Error(t, Codecs{ErrorBounds: map[string]float64{"temp": math.Inf(1)}}.Validate())
+}
+
+func TestEncoding_FloatBlock_ErrorBound(t *testing.T) {
+	values := make([]float64, 1000)
+	for i := range values {
+		values[i], _ = compress.Quantize(float64(i)*1.0001, 0.5)
+	}
+	in := util.Float64Slice2byte(values)
+
+	ctx := NewCoderContext()
+	defer ctx.Release()
+	ctx.SetCodecs(Codecs{ErrorBounds: map[string]float64{"temp": 0.5}})
+
+	decode := func(field string) []float64 {
+		ctx.SelectField(field)
+		buf, err := EncodeFloatBlock(in, nil, ctx)
+		require.NoError(t, err)
+		var out []byte
+		decoded, err := DecodeFloatBlock(buf, &out, ctx)
+		require.NoError(t, err)
+		require.Equal(t, len(values), len(decoded))
+		return append([]float64{}, decoded...)
+	}
+
+	lossless, err := EncodeFloatBlock(in, nil, ctx)
+	require.NoError(t, err)
+	require.Equal(t, values, decode("temp"))
+	require.Equal(t, values, decode("other"))
+	ctx.SelectField("temp")
+	quantized, err := EncodeFloatBlock(in, nil, ctx)
+	require.NoError(t, err)
+	require.Less(t, len(quantized), len(lossless))
+}
@@ -47,6 +47,14 @@ func (enc *Float) SetEncodingType(ty int) {
 	enc.encodingType = ty
 }
 
+func (enc *Float) SetCodec(codec string) {
+	enc.float.SetCodec(codec)
+}
+
+func (enc *Float) SetErrorBound(bound float64) {
+	enc.float.SetErrorBound(bound)
+}
+
 func (enc *Float) Encoding(in []byte, out []byte) ([]byte, error) {
 	if len(in) == 0 {
 		return out, nil
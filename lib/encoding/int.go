@@ -17,8 +17,10 @@ package encoding
 import (
 	"encoding/binary"
 	"fmt"
+	"math/bits"
 
 	"github.com/klauspost/compress/zstd"
+	"github.com/openGemini/openGemini/lib/compress"
 	"github.com/openGemini/openGemini/lib/numberenc"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/encoding/simple8b"
@@ -29,8 +31,35 @@ const (
 	intCompressedSimple8b   = 2
 	intCompressZSTD         = 3
 	intUncompressed         = 4
+	intCompressedFOR        = 5
 )
 
+// Codecs of integer values that can be forced for a measurement.
+// IntCodecAuto chooses the codec for each block as the older versions do.
+// IntCodecAdaptive also chooses FOR, the files written with it can not be
+// read by the versions without this codec, so it must be enabled explicitly.
+const (
+	IntCodecAuto     = ""
+	IntCodecAdaptive = "adaptive"
+	IntCodecSimple8b = "simple8b"
+	IntCodecFOR      = "for"
+	IntCodecZSTD     = "zstd"
+)
+
+var IntCodecs = []string{IntCodecAdaptive, IntCodecSimple8b, IntCodecFOR, IntCodecZSTD}
+
+func IsValidIntCodec(codec string) bool {
+	if codec == IntCodecAuto {
+		return true
+	}
+	for _, c := range IntCodecs {
+		if c == codec {
+			return true
+		}
+	}
+	return false
+}
+
 // ZigZagEncode ZigZag encoding maps signed integers to unsigned integers from: https://developers.google.com/protocol-buffers/docs/encoding
 func ZigZagEncode(v int64) uint64 {
 	return uint64(v<<1) ^ uint64(v>>63)
@@ -44,6 +73,11 @@ type Integer struct {
 	encodingType int
 	isConstDelta bool
 	isSimple8b   bool
+	codec        string
+
+	// bit width of the largest zigzag delta, and of the frame of the deltas
+	zigZagBits int
+	forBits    int
 
 	buf     *BytesBuffer
 	zstdEnc *zstd.Encoder
@@ -59,11 +93,13 @@ func (enc *Integer) reset() {
 	enc.zigZagDeltas = enc.zigZagDeltas[:0]
 	enc.isConstDelta = true
 	enc.isSimple8b = true
+	enc.zigZagBits = 0
+	enc.forBits = 0
 }
 
 func (enc *Integer) validEncodingType() bool {
 	switch enc.encodingType {
-	case intCompressedConstDelta, intCompressZSTD, intCompressedSimple8b, intUncompressed:
+	case intCompressedConstDelta, intCompressZSTD, intCompressedSimple8b, intUncompressed, intCompressedFOR:
 		return true
 	default:
 		return false
@@ -87,6 +123,8 @@ func (enc *Integer) init(arr []int64) {
 	}
 	enc.zigZagDeltas = append(enc.zigZagDeltas, zigZagEnc)
 
+	maxZigZag := zigZagEnc
+	minDelta, maxDelta := delta, delta
 	for i := 2; i < len(arr); i++ {
 		delta = arr[i] - arr[i-1]
 		zigZagEnc = ZigZagEncode(delta)
@@ -95,7 +133,43 @@ func (enc *Integer) init(arr []int64) {
 			enc.isSimple8b = false
 		}
 		enc.zigZagDeltas = append(enc.zigZagDeltas, zigZagEnc)
+
+		if zigZagEnc > maxZigZag {
+			maxZigZag = zigZagEnc
+		}
+		if delta < minDelta {
+			minDelta = delta
+		} else if delta > maxDelta {
+			maxDelta = delta
+		}
+	}
+	enc.zigZagBits = bits.Len64(maxZigZag)
+	enc.forBits = bits.Len64(uint64(maxDelta - minDelta))
+}
+
+// useFOR returns true if bit-packing the deltas with frame of reference is smaller than simple8b.
+// It is the case for counters that increase steadily, whose deltas are large but close to each other.
+func (enc *Integer) useFOR() bool {
+	switch enc.codec {
+	case IntCodecFOR:
+		return true
+	case IntCodecAdaptive:
+		return enc.forBits < enc.zigZagBits
+	default:
+		return false
+	}
+}
+
+// encodingFOR encodes the first value and the deltas, the deltas are bit-packed with frame of reference
+func (enc *Integer) encodingFOR(intArr []int64, out []byte) ([]byte, error) {
+	out = append(out, byte(enc.encodingType)<<4)
+	out = numberenc.MarshalInt64Append(out, intArr[0])
+
+	deltas := make([]int64, len(enc.zigZagDeltas)-1)
+	for i := range deltas {
+		deltas[i] = ZigZagDecode(enc.zigZagDeltas[i+1])
 	}
+	return compress.ForEncoding(deltas, out), nil
 }
 
 func (enc *Integer) encodingConstDelta(out []byte) ([]byte, error) {
@@ -180,6 +254,11 @@ func (enc *Integer) SetEncodingType(ty int) {
 	enc.encodingType = ty
 }
 
+// SetCodec forces the codec used by Encoding, the blocks with constant deltas are still encoded as ConstDelta
+func (enc *Integer) SetCodec(codec string) {
+	enc.codec = codec
+}
+
 func (enc *Integer) Encoding(in []byte, out []byte) ([]byte, error) {
 	if len(in) == 0 {
 		return out, nil
@@ -192,7 +271,10 @@ func (enc *Integer) Encoding(in []byte, out []byte) ([]byte, error) {
 	if enc.isConstDelta {
 		enc.encodingType = intCompressedConstDelta
 		out, err = enc.encodingConstDelta(out)
-	} else if enc.isSimple8b {
+	} else if len(enc.zigZagDeltas) > 0 && enc.useFOR() {
+		enc.encodingType = intCompressedFOR
+		out, err = enc.encodingFOR(intArr, out)
+	} else if enc.isSimple8b && enc.codec != IntCodecZSTD {
 		enc.encodingType = intCompressedSimple8b
 		out, err = enc.encodingSimple8b(out)
 	} else {
@@ -300,6 +382,28 @@ func (enc *Integer) decodingSimple8b() ([]byte, error) {
 	return out, nil
 }
 
+func (enc *Integer) decodingFOR() ([]byte, error) {
+	in := enc.buf.Bytes()
+	if len(in) < 8 {
+		return nil, fmt.Errorf("integer: too small data for decode %v", len(in))
+	}
+	first := numberenc.UnmarshalInt64(in)
+
+	deltas, _, err := compress.ForDecoding(in[8:], nil)
+	if err != nil {
+		return nil, err
+	}
+
+	out := growBuffer(enc.out, (len(deltas)+1)*util.Int64SizeBytes)
+	out = out[:enc.outPos+(len(deltas)+1)*util.Int64SizeBytes]
+	intArr := util.Bytes2Int64Slice(out[enc.outPos:])
+	intArr[0] = first
+	for i, delta := range deltas {
+		intArr[i+1] = intArr[i] + delta
+	}
+	return out, nil
+}
+
 func (enc *Integer) decodingZSTD() ([]byte, error) {
 	var err error
 	in := enc.buf.Bytes()
@@ -341,13 +445,7 @@ func (enc *Integer) decodeInit(in, out []byte) error {
 			return fmt.Errorf("integer: invalid compressed len, %v < %v", len(in), compLen)
 		}
 		in = in[:compLen]
-		enc.outPos = len(out)
-		if cap(out[enc.outPos:]) < srcLen {
-			n := srcLen - cap(out[enc.outPos:])
-			out = out[:cap(out[enc.outPos:])]
-			out = append(out, make([]byte, n)...)
-		}
-		out = out[:enc.outPos]
+		out = growBuffer(out, srcLen)
 
 		enc.buf.Reset(in)
 		if enc.zstdDec == nil {
@@ -378,6 +476,8 @@ func (enc *Integer) Decoding(in []byte, out []byte) ([]byte, error) {
 		return enc.decodingConstDelta()
 	} else if enc.encodingType == intCompressedSimple8b {
 		return enc.decodingSimple8b()
+	} else if enc.encodingType == intCompressedFOR {
+		return enc.decodingFOR()
 	} else {
 		return enc.decodingZSTD()
 	}
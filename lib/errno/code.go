@@ -159,6 +159,8 @@ const (
 	WrongScrollId           = 1516
 	ScrollIdIllegal         = 1517
 	SetValueFailed          = 1518
+
+	TenantQueryConcurrencyExceeded = 1519
 )
 
 // store engine error codes
@@ -200,6 +202,8 @@ const (
 	ShardCannotMove                    = 2135
 	ShardIsMoving                      = 2136
 	ShardMovingStopped                 = 2137
+	PtNotReplicated                    = 2138
+	FollowerReadLagging                = 2139
 )
 
 // merge out of order
@@ -291,6 +295,7 @@ const (
 	OpMarshalErr                       = 4055
 	SqlNodeNotFound                    = 4056
 	PtIsDoingSomeShardMove             = 4057
+	PtIsTransferring                   = 4058
 )
 
 // meta-client process
@@ -346,6 +351,11 @@ const (
 	CleanSchemaCheckErr            = 5038
 	UsedProposeId                  = 5039
 	WriteToRaftTimeoutAfterPropose = 5040
+	MeasurementSeriesLimited       = 5041
+	TenantSeriesQuotaExceeded      = 5042
+	TenantStorageQuotaExceeded     = 5043
+	TenantIngestRateExceeded       = 5044
+	TenantWriteBatchTooLarge       = 5045
 )
 
 // write interface
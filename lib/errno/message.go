@@ -78,6 +78,10 @@ var messageMap = map[Errno]*Message{
 	WriteErrorArray:                newWarnMessage("error tag array", ModuleWrite),
 	TooManyTagKeys:                 newWarnMessage("too many tag keys", ModuleWrite),
 	SeriesLimited:                  newWarnMessage("too many series in database %s. upper limit: %d; current: %d", ModuleWrite),
+	TenantSeriesQuotaExceeded:      newWarnMessage("series of tenant %s exceeds the quota. upper limit: %d; current: %d", ModuleWrite),
+	TenantStorageQuotaExceeded:     newWarnMessage("storage bytes of tenant %s exceed the quota. upper limit: %d; current: %d", ModuleWrite),
+	TenantIngestRateExceeded:       newWarnMessage("ingest rate of tenant %s exceeds the quota of %d rows per second", ModuleWrite),
+	TenantWriteBatchTooLarge:       newWarnMessage("batch of %d rows exceeds the ingest quota of tenant %s of %d rows per second, split the batch", ModuleWrite),
 	RecordWriterFatalErr:           newFatalMessage("record writer raise fatal error", ModuleWrite),
 	ArrowRecordTimeFieldErr:        newFatalMessage("the time field of arrow record should the last column", ModuleWrite),
 	ArrowFlightGetRoleErr:          newFatalMessage("arrow flight only support the ts-server or ts-data", ModuleWrite),
@@ -98,6 +102,7 @@ var messageMap = map[Errno]*Message{
 	CleanSchemaCheckErr:            newWarnMessage("Schema check err", ModuleWrite),
 	UsedProposeId:                  newWarnMessage("UsedProposeId, identity: %s, proposeId: %d", ModuleWrite),
 	WriteToRaftTimeoutAfterPropose: newWarnMessage("WriteToRaftTimeoutAfterPropose, identity: %s, proposeId: %d", ModuleWrite),
+	MeasurementSeriesLimited:       newWarnMessage("too many series of measurement %s in an index of database %s. upper limit: %d; current: %d", ModuleWrite),
 
 	// write interface error codes
 	InvalidLogDataType:              newWarnMessage("invalid log data type value", ModuleWriteInterface),
@@ -211,6 +216,8 @@ var messageMap = map[Errno]*Message{
 	ShardCannotMove:                    newFatalMessage("shard can not move %d", ModuleStorageEngine),
 	ShardIsMoving:                      newFatalMessage("shard is moving, shardID %d", ModuleStorageEngine),
 	ShardMovingStopped:                 newFatalMessage("shard moving is disabled, shardID %d", ModuleStorageEngine),
+	PtNotReplicated:                    newWarnMessage("partition %s:%d is not a replica of a raft group", ModuleStorageEngine),
+	FollowerReadLagging:                newWarnMessage("partition %s:%d lags behind the leader, applied index: %d, read index: %d", ModuleStorageEngine),
 
 	// wal error codes
 	ReadWalFileFailed:         newWarnMessage("read wal file failed", ModuleWal),
@@ -269,6 +276,8 @@ var messageMap = map[Errno]*Message{
 	ScrollIdIllegal:         newWarnMessage("scroll_id value is illegal", ModuleQueryInterface),
 	SetValueFailed:          newWarnMessage("set value failed", ModuleQueryInterface),
 
+	TenantQueryConcurrencyExceeded: newWarnMessage("too many concurrent queries of tenant %s. upper limit: %d", ModuleQueryInterface),
+
 	// meta error codes
 	InvalidTagKey:           newWarnMessage(`tag key can't be time, measurement is '%s'`, ModuleMeta),
 	ConflictWithRep:         newWarnMessage("current feature conflicts with replication", ModuleMeta),
@@ -309,6 +318,7 @@ var messageMap = map[Errno]*Message{
 	PtIsAlreadyMigrating:       newWarnMessage("pt is already migrating", ModuleHA),
 	InvalidMigrationType:       newWarnMessage("invalid migration type", ModuleHA),
 	PtIsDoingSomeShardMove:     newWarnMessage("pt is doing some shard move", ModuleHA),
+	PtIsTransferring:           newWarnMessage("pt files are transferring from node %d", ModuleHA),
 
 	InvalidName:              newWarnMessage("invalid database name", ModuleMeta),
 	DownSamplePolicyExists:   newWarnMessage("downSample policy has been existed, drop it first", ModuleMeta),
@@ -30,6 +30,7 @@ const (
 	BloomFilterFullText
 	MinMax
 	Set
+	NGram
 	IndexTypeAll
 )
 
@@ -42,6 +43,7 @@ const (
 	BloomFilterFullTextIndex = "bloomfilter_fulltext"
 	MinMaxIndex              = "minmax"
 	SetIndex                 = "set"
+	NGramIndex               = "ngram"
 )
 
 var (
@@ -54,6 +56,7 @@ var (
 		BloomFilterFullTextIndex: BloomFilterFullText,
 		MinMaxIndex:              MinMax,
 		SetIndex:                 Set,
+		NGramIndex:               NGram,
 	}
 	IndexTypeToName = map[IndexType]string{
 		MergeSet:            MergeSetIndex,
@@ -64,6 +67,7 @@ var (
 		BloomFilterFullText: BloomFilterFullTextIndex,
 		MinMax:              MinMaxIndex,
 		Set:                 SetIndex,
+		NGram:               NGramIndex,
 	}
 )
 
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/openGemini/openGemini/app/ts-meta/meta/message"
 	"github.com/openGemini/openGemini/engine/executor/spdy/transport"
@@ -491,3 +492,35 @@ func (c *ShowClusterCallback) Handle(data interface{}) error {
 	c.Data = msg.Data
 	return nil
 }
+
+type DecommissionCallback struct {
+	BaseCallback
+
+	Data []byte
+	// the command is rejected by the meta leader, it is not retried on the other meta nodes
+	ErrCommand error
+}
+
+func (c *DecommissionCallback) Handle(data interface{}) error {
+	metaMsg, err := c.Trans2MetaMsg(data)
+	if err != nil {
+		return err
+	}
+	msg, ok := metaMsg.Data().(*message.DecommissionResponse)
+	if !ok {
+		return errors.New("data is not a DecommissionResponse")
+	}
+	if strings.Contains(msg.Err, "node is not the leader") {
+		return errors.New(msg.Err)
+	}
+	if msg.ErrCode != 0 {
+		c.ErrCommand = errno.NewError(msg.ErrCode, msg.Err)
+		return nil
+	}
+	if msg.Err != "" {
+		c.ErrCommand = errCommand{msg: msg.Err}
+		return nil
+	}
+	c.Data = msg.Data
+	return nil
+}
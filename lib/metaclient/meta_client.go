@@ -233,12 +233,33 @@ type MetaClient interface {
 	CreateContinuousQuery(database, name, query string) error
 	ShowContinuousQueries() (models.Rows, error)
 	DropContinuousQuery(name string, database string) error
+	BackfillContinuousQuery(database, name string, start, end time.Time) error
+	ShowContinuousQueryBackfills() *models.Row
 	UpdateShardInfoTier(shardID uint64, tier uint64, dbName, rpName string) error
 
 	// sysctrl for admin
 	SendSysCtrlToMeta(mod string, param map[string]string) (map[string]string, error)
 	SendBackupToMeta(mod string, param map[string]string, host string) (map[string]string, error)
 
+	// decommission ts-store nodes
+	DecommissionNode(nodeID uint64) error
+	CancelDecommission(nodeID uint64) error
+	ShowDecommissions() ([]meta2.DecommissionInfo, error)
+
+	// asynchronous replication of databases between clusters
+	CreateReplication(database, target, role string) error
+	DropReplication(database string, promote bool) error
+
+	// tenants owning databases and users
+	CreateTenant(name string, quota meta2.TenantQuota, nodes []uint64) error
+	AlterTenant(name string, quota meta2.TenantQuota, nodes []uint64, setNodes bool) error
+	DropTenant(name string) error
+	UpdateTenantMember(name, database, user string, remove bool) error
+	Tenant(name string) *meta2.TenantInfo
+	DatabaseTenant(database string) *meta2.TenantInfo
+	UserTenant(user string) *meta2.TenantInfo
+	ShowTenants() models.Rows
+
 	// file infos
 	IsSQLiteEnabled() bool
 	InsertFiles([]meta2.FileInfo) error
@@ -424,6 +445,14 @@ var applyFunc = map[proto2.Command_Type]func(c *Client, op *proto2.Command) erro
 	proto2.Command_RemoveNodeCommand:                applyRemoveNode,
 	proto2.Command_UpdateReplicationCommand:         applyUpdateReplication,
 	proto2.Command_UpdateMeasurementCommand:         applyUpdateMeasurement,
+
+	proto2.Command_CreateContinuousQueryBackfillCommand: applyCreateContinuousQueryBackfill,
+	proto2.Command_UpdateContinuousQueryBackfillCommand: applyUpdateContinuousQueryBackfill,
+
+	proto2.Command_CreateTenantCommand:       applyCreateTenant,
+	proto2.Command_DropTenantCommand:         applyDropTenant,
+	proto2.Command_UpdateTenantMemberCommand: applyUpdateTenantMember,
+	proto2.Command_UpdateTenantUsageCommand:  applyUpdateTenantUsage,
 }
 
 type authRcd struct {
@@ -3646,6 +3675,14 @@ func applyDropContinuousQuery(c *Client, cmd *proto2.Command) error {
 	return nil
 }
 
+func applyCreateContinuousQueryBackfill(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyCreateContinuousQueryBackfill(c.cacheData, cmd)
+}
+
+func applyUpdateContinuousQueryBackfill(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyUpdateContinuousQueryBackfill(c.cacheData, cmd)
+}
+
 func applySetNodeSegregateStatus(c *Client, cmd *proto2.Command) error {
 	return meta2.ApplySetNodeSegregateStatus(c.cacheData, cmd)
 }
@@ -2438,6 +2438,14 @@ var newPbFunc = map[proto2.Command_Type]func() (interface{}, *proto.ExtensionDes
 	proto2.Command_RemoveNodeCommand:                newRemoveNodePb,
 	proto2.Command_UpdateReplicationCommand:         newUpdateReplicationPb,
 	proto2.Command_UpdateMeasurementCommand:         newUpdateMeasurementPb,
+
+	proto2.Command_CreateContinuousQueryBackfillCommand: newCreateContinuousQueryBackfillPb,
+	proto2.Command_UpdateContinuousQueryBackfillCommand: newUpdateContinuousQueryBackfillPb,
+
+	proto2.Command_CreateTenantCommand:       newCreateTenantPb,
+	proto2.Command_DropTenantCommand:         newDropTenantPb,
+	proto2.Command_UpdateTenantMemberCommand: newUpdateTenantMemberPb,
+	proto2.Command_UpdateTenantUsageCommand:  newUpdateTenantUsagePb,
 }
 
 func newCreateDatabasePb() (interface{}, *proto.ExtensionDesc) {
@@ -2741,6 +2749,30 @@ func newUpdateMeasurementPb() (interface{}, *proto.ExtensionDesc) {
 	return &proto2.UpdateMeasurementCommand{}, proto2.E_UpdateMeasurementCommand_Command
 }
 
+func newCreateContinuousQueryBackfillPb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.CreateContinuousQueryBackfillCommand{}, proto2.E_CreateContinuousQueryBackfillCommand_Command
+}
+
+func newUpdateContinuousQueryBackfillPb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.UpdateContinuousQueryBackfillCommand{}, proto2.E_UpdateContinuousQueryBackfillCommand_Command
+}
+
+func newCreateTenantPb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.CreateTenantCommand{Name: proto.String("t1")}, proto2.E_CreateTenantCommand_Command
+}
+
+func newDropTenantPb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.DropTenantCommand{Name: proto.String("t1")}, proto2.E_DropTenantCommand_Command
+}
+
+func newUpdateTenantMemberPb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.UpdateTenantMemberCommand{Name: proto.String("t1"), Database: proto.String("ds")}, proto2.E_UpdateTenantMemberCommand_Command
+}
+
+func newUpdateTenantUsagePb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.UpdateTenantUsageCommand{Name: proto.String("t1")}, proto2.E_UpdateTenantUsageCommand_Command
+}
+
 func BuildCmd(t proto2.Command_Type) *proto2.Command {
 	cmd1, ext := newPbFunc[t]()
 	cmd2 := &proto2.Command{Type: &t}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/influxdata/influxdb/models"
 	"github.com/openGemini/openGemini/app/ts-meta/meta/message"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
 	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
 	"go.uber.org/zap"
@@ -86,6 +87,36 @@ func (c *Client) DropContinuousQuery(name string, database string) error {
 	return c.retryUntilExec(proto2.Command_DropContinuousQueryCommand, proto2.E_DropContinuousQueryCommand_Command, cmd)
 }
 
+// BackfillContinuousQuery starts to backfill the windows of the continuous query within [start, end)
+func (c *Client) BackfillContinuousQuery(database, name string, start, end time.Time) error {
+	cmd := &proto2.CreateContinuousQueryBackfillCommand{
+		Database:  proto.String(database),
+		Name:      proto.String(name),
+		StartTime: proto.Int64(start.UnixNano()),
+		EndTime:   proto.Int64(end.UnixNano()),
+	}
+	return c.retryUntilExec(proto2.Command_CreateContinuousQueryBackfillCommand, proto2.E_CreateContinuousQueryBackfillCommand_Command, cmd)
+}
+
+// UpdateContinuousQueryBackfill reports the progress of the backfill run by the sql node host
+func (c *Client) UpdateContinuousQueryBackfill(database, name string, next time.Time, host, lastErr string) error {
+	cmd := &proto2.UpdateContinuousQueryBackfillCommand{
+		Database:  proto.String(database),
+		Name:      proto.String(name),
+		NextTime:  proto.Int64(next.UnixNano()),
+		Host:      proto.String(host),
+		LastError: proto.String(lastErr),
+	}
+	_, err := c.retryExec(proto2.Command_UpdateContinuousQueryBackfillCommand, proto2.E_UpdateContinuousQueryBackfillCommand_Command, cmd)
+	return err
+}
+
+func (c *Client) ShowContinuousQueryBackfills() *models.Row {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cacheData.ShowContinuousQueryBackfills()
+}
+
 func (c *Client) GetMaxCQChangeID() uint64 {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -133,13 +164,15 @@ func (c *Client) getCQLease(currentServer int, host string) ([]string, error) {
 	return callback.CQNames, err
 }
 
-// BatchUpdateContinuousQueryStat reports all continuous queries state
-func (c *Client) BatchUpdateContinuousQueryStat(cqStats map[string]int64) error {
+// BatchUpdateContinuousQueryStat reports the state of all continuous queries run by the sql node host
+func (c *Client) BatchUpdateContinuousQueryStat(host string, cqStats map[string]meta2.ContinuousQueryStat) error {
 	cmd := &proto2.ContinuousQueryReportCommand{}
-	for name, lastRun := range cqStats {
+	for name, stat := range cqStats {
 		cmd.CQStates = append(cmd.CQStates, &proto2.CQState{
 			Name:        proto.String(name),
-			LastRunTime: proto.Int64(lastRun),
+			LastRunTime: proto.Int64(stat.LastRunTime),
+			Host:        proto.String(host),
+			LastError:   proto.String(stat.LastError),
 		})
 	}
 	_, err := c.retryExec(proto2.Command_ContinuousQueryReportCommand, proto2.E_ContinuousQueryReportCommand_Command, cmd)
@@ -193,6 +193,6 @@ func TestClient_BatchUpdateContinuousQueryStat(t *testing.T) {
 	}
 	c.SendRPCMessage = &mockRPCMessageSender{}
 	c.SetMetaServers([]string{"127.0.0.1:8088", "127.0.0.2:8088", "127.0.0.3:8088"})
-	err := c.BatchUpdateContinuousQueryStat(map[string]int64{"cq": 123})
+	err := c.BatchUpdateContinuousQueryStat("127.0.0.1:8086", map[string]meta.ContinuousQueryStat{"cq": {LastRunTime: 123}})
 	assert.NoError(t, err)
 }
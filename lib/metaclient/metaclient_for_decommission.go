@@ -0,0 +1,97 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metaclient
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/openGemini/openGemini/app/ts-meta/meta/message"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// DecommissionNode marks the ts-store node decommissioning, the meta leader moves its pts to the other nodes
+// and removes it after the moved pts are verified
+func (c *Client) DecommissionNode(nodeID uint64) error {
+	_, err := c.retryDecommission(meta2.DecommissionStart, nodeID)
+	return err
+}
+
+// CancelDecommission marks the decommissioning ts-store node normal again
+func (c *Client) CancelDecommission(nodeID uint64) error {
+	_, err := c.retryDecommission(meta2.DecommissionCancel, nodeID)
+	return err
+}
+
+// ShowDecommissions returns the progress of the ts-store nodes decommissioned since the meta leader started
+func (c *Client) ShowDecommissions() ([]meta2.DecommissionInfo, error) {
+	b, err := c.retryDecommission(meta2.DecommissionShow, 0)
+	if err != nil {
+		return nil, err
+	}
+	var infos []meta2.DecommissionInfo
+	if err = json.Unmarshal(b, &infos); err != nil {
+		return nil, err
+	}
+	return infos, nil
+}
+
+func (c *Client) retryDecommission(cmd string, nodeID uint64) ([]byte, error) {
+	startTime := time.Now()
+	currentServer := connectedServer
+	var err error
+	var data []byte
+	for {
+		c.mu.RLock()
+		select {
+		case <-c.closing:
+			c.mu.RUnlock()
+			return nil, meta2.ErrClientClosed
+		default:
+		}
+
+		if currentServer >= len(c.metaServers) {
+			currentServer = 0
+		}
+		c.mu.RUnlock()
+		var errCmd error
+		data, errCmd, err = c.decommission(currentServer, cmd, nodeID)
+		if err == nil {
+			// the command is rejected by the meta leader
+			err = errCmd
+			break
+		}
+
+		if time.Since(startTime).Nanoseconds() > int64(len(c.metaServers))*HttpReqTimeout.Nanoseconds() {
+			c.logger.Error("decommission timeout", zap.String("cmd", cmd), zap.Uint64("node", nodeID), zap.Error(err))
+			break
+		}
+		time.Sleep(errSleep)
+
+		currentServer++
+	}
+	return data, err
+}
+
+func (c *Client) decommission(currentServer int, cmd string, nodeID uint64) ([]byte, error, error) {
+	callback := &DecommissionCallback{}
+	msg := message.NewMetaMessage(message.DecommissionRequestMessage, &message.DecommissionRequest{Cmd: cmd, NodeID: nodeID})
+	err := c.SendRPCMsg(currentServer, msg, callback)
+	if err != nil {
+		return nil, nil, err
+	}
+	return callback.Data, callback.ErrCommand, nil
+}
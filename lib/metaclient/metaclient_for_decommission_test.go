@@ -0,0 +1,73 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metaclient
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/app/ts-meta/meta/message"
+	"github.com/openGemini/openGemini/engine/executor/spdy/transport"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// mockRPCMessageSenderForDecommission answers as the meta leader on the second meta server
+type mockRPCMessageSenderForDecommission struct {
+	rsp   *message.DecommissionResponse
+	sends int
+}
+
+func (s *mockRPCMessageSenderForDecommission) SendRPCMsg(currentServer int, msg *message.MetaMessage, callback transport.Callback) error {
+	s.sends++
+	rsp := &message.DecommissionResponse{Err: "node is not the leader"}
+	if currentServer == 1 {
+		rsp = s.rsp
+	}
+	return callback.Handle(message.NewMetaMessage(message.DecommissionResponseMessage, rsp))
+}
+
+func TestClient_Decommission(t *testing.T) {
+	defer func() {
+		connectedServer = 0
+	}()
+	c := &Client{
+		metaServers: []string{"127.0.0.1:8092", "127.0.0.2:8092", "127.0.0.3:8092"},
+		changed:     make(chan chan struct{}, 1),
+		logger:      logger.NewLogger(errno.ModuleUnknown).SetZapLogger(zap.NewNop()),
+		closing:     make(chan struct{}),
+	}
+	sender := &mockRPCMessageSenderForDecommission{rsp: &message.DecommissionResponse{
+		Data: []byte(`[{"NodeID":3,"State":"moving","TotalPts":4,"MovedPts":1}]`),
+	}}
+	c.SendRPCMessage = sender
+
+	infos, err := c.ShowDecommissions()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(infos))
+	assert.Equal(t, uint64(3), infos[0].NodeID)
+	assert.Equal(t, 1, infos[0].MovedPts)
+
+	// the command rejected by the leader is not retried
+	sender.rsp = &message.DecommissionResponse{Err: "node 3 is already decommissioning"}
+	sender.sends = 0
+	assert.EqualError(t, c.DecommissionNode(3), "node 3 is already decommissioning")
+	assert.Equal(t, 2, sender.sends)
+
+	sender.rsp = &message.DecommissionResponse{ErrCode: errno.DataNodeNotFound, Err: "mock error"}
+	assert.True(t, errno.Equal(c.CancelDecommission(4), errno.DataNodeNotFound))
+}
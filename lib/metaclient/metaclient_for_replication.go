@@ -0,0 +1,66 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metaclient
+
+import (
+	"fmt"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+)
+
+// CreateReplication configures the asynchronous replication of the database with the other cluster.
+// The wal segments of the primary database are shipped to the target, the standby database only accepts
+// the writes shipped from the target until it is promoted.
+func (c *Client) CreateReplication(database, target, role string) error {
+	if role != meta2.ReplicationPrimary && role != meta2.ReplicationStandby {
+		return fmt.Errorf("invalid replication role %q", role)
+	}
+	if err := validateURL(target); err != nil {
+		return fmt.Errorf("invalid url %s", target)
+	}
+	return c.retryUntilExec(proto2.Command_CreateReplicationCommand, proto2.E_CreateReplicationCommand_Command,
+		&proto2.CreateReplicationCommand{
+			Database: proto.String(database),
+			Target:   proto.String(target),
+			Role:     proto.String(role),
+		},
+	)
+}
+
+// DropReplication removes the asynchronous replication of the database,
+// the standby database is promoted to accept the writes of the clients if promote is true
+func (c *Client) DropReplication(database string, promote bool) error {
+	return c.retryUntilExec(proto2.Command_DropReplicationCommand, proto2.E_DropReplicationCommand_Command,
+		&proto2.DropReplicationCommand{
+			Database: proto.String(database),
+			Promote:  proto.Bool(promote),
+		},
+	)
+}
+
+// UpdateReplicationCheckpoint records the last wal segment of the shard shipped to the target cluster
+func (c *Client) UpdateReplicationCheckpoint(database string, shardID uint64, seq int64) error {
+	return c.retryUntilExec(proto2.Command_UpdateReplicationCheckpointCommand, proto2.E_UpdateReplicationCheckpointCommand_Command,
+		&proto2.UpdateReplicationCheckpointCommand{
+			Database:   proto.String(database),
+			ShardID:    proto.Uint64(shardID),
+			Seq:        proto.Int64(seq),
+			UpdateTime: proto.Int64(time.Now().UnixNano()),
+		},
+	)
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metaclient
+
+import (
+	"github.com/influxdata/influxdb/models"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+)
+
+// CreateTenant creates a tenant with the quota, the pts of its databases are only placed on the nodes if any.
+func (c *Client) CreateTenant(name string, quota meta2.TenantQuota, nodes []uint64) error {
+	return c.retryUntilExec(proto2.Command_CreateTenantCommand, proto2.E_CreateTenantCommand_Command,
+		newTenantCommand(name, quota, nodes, len(nodes) > 0, false))
+}
+
+// AlterTenant updates the non-negative quotas of the tenant, and the pinned nodes if setNodes is true.
+func (c *Client) AlterTenant(name string, quota meta2.TenantQuota, nodes []uint64, setNodes bool) error {
+	return c.retryUntilExec(proto2.Command_CreateTenantCommand, proto2.E_CreateTenantCommand_Command,
+		newTenantCommand(name, quota, nodes, setNodes, true))
+}
+
+func newTenantCommand(name string, quota meta2.TenantQuota, nodes []uint64, setNodes, alter bool) *proto2.CreateTenantCommand {
+	return &proto2.CreateTenantCommand{
+		Name:                proto.String(name),
+		MaxSeries:           proto.Int64(quota.MaxSeries),
+		MaxStorageBytes:     proto.Int64(quota.MaxStorageBytes),
+		MaxIngestRate:       proto.Int64(quota.MaxIngestRate),
+		MaxQueryConcurrency: proto.Int64(quota.MaxQueryConcurrency),
+		Nodes:               nodes,
+		SetNodes:            proto.Bool(setNodes),
+		Alter:               proto.Bool(alter),
+	}
+}
+
+// DropTenant removes the tenant, the databases of the tenant must be dropped or revoked first.
+func (c *Client) DropTenant(name string) error {
+	return c.retryUntilExec(proto2.Command_DropTenantCommand, proto2.E_DropTenantCommand_Command,
+		&proto2.DropTenantCommand{Name: proto.String(name)})
+}
+
+// UpdateTenantMember adds the database or the user to the tenant, or removes it if remove is true.
+// Either the database or the user is not empty.
+func (c *Client) UpdateTenantMember(name, database, user string, remove bool) error {
+	val := &proto2.UpdateTenantMemberCommand{
+		Name:   proto.String(name),
+		Remove: proto.Bool(remove),
+	}
+	if database != "" {
+		val.Database = proto.String(database)
+	} else {
+		val.User = proto.String(user)
+	}
+	return c.retryUntilExec(proto2.Command_UpdateTenantMemberCommand, proto2.E_UpdateTenantMemberCommand_Command, val)
+}
+
+// Tenant returns a copy of the tenant, nil if the tenant does not exist.
+func (c *Client) Tenant(name string) *meta2.TenantInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return cloneTenant(c.cacheData.Tenant(name))
+}
+
+// DatabaseTenant returns a copy of the tenant owning the database, nil if the database is not owned by any tenant.
+func (c *Client) DatabaseTenant(database string) *meta2.TenantInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return cloneTenant(c.cacheData.DatabaseTenant(database))
+}
+
+// UserTenant returns a copy of the tenant of the user, nil if the user does not belong to any tenant.
+func (c *Client) UserTenant(user string) *meta2.TenantInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return cloneTenant(c.cacheData.UserTenant(user))
+}
+
+func (c *Client) ShowTenants() models.Rows {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cacheData.ShowTenants()
+}
+
+// cloneTenant copies the tenant of the cache data, the members of the cached tenant are updated in place
+func cloneTenant(t *meta2.TenantInfo) *meta2.TenantInfo {
+	if t == nil {
+		return nil
+	}
+	return t.Clone()
+}
+
+func applyCreateTenant(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyCreateTenant(c.cacheData, cmd)
+}
+
+func applyDropTenant(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyDropTenant(c.cacheData, cmd)
+}
+
+func applyUpdateTenantMember(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyUpdateTenantMember(c.cacheData, cmd)
+}
+
+func applyUpdateTenantUsage(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyUpdateTenantUsage(c.cacheData, cmd)
+}
@@ -921,6 +921,7 @@ type PtRequest struct {
 	MigrateType          *int32       `protobuf:"varint,2,req,name=MigrateType" json:"MigrateType,omitempty"`
 	OpId                 *uint64      `protobuf:"varint,3,req,name=OpId" json:"OpId,omitempty"`
 	AliveConnId          *uint64      `protobuf:"varint,4,opt,name=AliveConnId" json:"AliveConnId,omitempty"`
+	TransferFiles        *bool        `protobuf:"varint,5,opt,name=TransferFiles" json:"TransferFiles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -978,6 +979,13 @@ func (m *PtRequest) GetAliveConnId() uint64 {
 	return 0
 }
 
+func (m *PtRequest) GetTransferFiles() bool {
+	if m != nil && m.TransferFiles != nil {
+		return *m.TransferFiles
+	}
+	return false
+}
+
 type PtResponse struct {
 	Err                  *string  `protobuf:"bytes,1,opt,name=Err" json:"Err,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1420,72 +1428,73 @@ func init() {
 func init() { proto.RegisterFile("lib/netstorage/data/data.proto", fileDescriptor_2aaddb15866ce618) }
 
 var fileDescriptor_2aaddb15866ce618 = []byte{
-	// 1060 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x8e, 0x1b, 0x45,
-	0x10, 0xd6, 0xcc, 0xd8, 0x26, 0x2e, 0x67, 0x9d, 0xdd, 0xd9, 0x1f, 0x8d, 0xbc, 0x61, 0x19, 0xcd,
-	0xc9, 0x44, 0x2b, 0x5b, 0x5a, 0x09, 0x11, 0x82, 0x14, 0x11, 0x7b, 0xac, 0xc8, 0x0a, 0x06, 0xa7,
-	0xbd, 0xe2, 0x10, 0x21, 0xa4, 0xf6, 0x4e, 0xaf, 0xd3, 0xca, 0x78, 0x66, 0xe8, 0x6e, 0x87, 0xb5,
-	0xe0, 0xc0, 0x2b, 0xf0, 0x02, 0x9c, 0x10, 0x2f, 0xc2, 0x8d, 0xa7, 0x42, 0xfd, 0x33, 0x3f, 0xb6,
-	0xd7, 0x42, 0xe1, 0xc2, 0xc5, 0xea, 0xaa, 0xe9, 0xaa, 0xfa, 0xaa, 0xea, 0xab, 0x6a, 0xc3, 0x45,
-	0x4c, 0xe7, 0xfd, 0x84, 0x08, 0x2e, 0x52, 0x86, 0x17, 0xa4, 0x1f, 0x61, 0x81, 0xd5, 0x4f, 0x2f,
-	0x63, 0xa9, 0x48, 0xdd, 0x47, 0xe5, 0xb7, 0x9e, 0x54, 0x77, 0x2e, 0xa5, 0xc1, 0x4a, 0xd0, 0xb8,
-	0x1f, 0xd3, 0x5b, 0x41, 0xa2, 0x3e, 0x4d, 0x6e, 0xe3, 0xd5, 0x5d, 0x7f, 0x49, 0x04, 0xee, 0x2b,
-	0x1b, 0x75, 0xd4, 0xe6, 0xc1, 0xcf, 0x70, 0x34, 0x23, 0x8c, 0x12, 0xfe, 0x8a, 0xac, 0x39, 0x22,
-	0x3f, 0xae, 0x08, 0x17, 0x6e, 0x1b, 0xec, 0x70, 0xee, 0x59, 0xbe, 0xdd, 0x6d, 0x22, 0x3b, 0x9c,
-	0xbb, 0x27, 0x50, 0x9f, 0x8a, 0x71, 0xc8, 0x3d, 0xdb, 0x77, 0xba, 0x07, 0x48, 0x0b, 0x6e, 0x00,
-	0x0f, 0x27, 0x04, 0xf3, 0x15, 0x23, 0x4b, 0x92, 0x08, 0xee, 0x39, 0xbe, 0xd3, 0x6d, 0xa2, 0x0d,
-	0x9d, 0xfb, 0x18, 0x9a, 0x37, 0x69, 0x12, 0x51, 0x41, 0xd3, 0xc4, 0xab, 0xf9, 0x56, 0xb7, 0x89,
-	0x4a, 0x45, 0xf0, 0x1c, 0xdc, 0x6a, 0x70, 0x9e, 0xa5, 0x09, 0x27, 0xee, 0x19, 0x34, 0xb4, 0xd6,
-	0xb3, 0x94, 0x47, 0x23, 0xb9, 0x87, 0xe0, 0x8c, 0x18, 0xf3, 0x6c, 0xe5, 0x45, 0x1e, 0x83, 0x5f,
-	0xc0, 0x9d, 0xbd, 0x4d, 0x7f, 0xba, 0xc6, 0x8b, 0xff, 0x03, 0xfd, 0x0b, 0x38, 0xde, 0x88, 0x6e,
-	0xe0, 0x7b, 0xf0, 0x91, 0x51, 0x19, 0xfc, 0xb9, 0x78, 0x4f, 0x02, 0x2f, 0xe1, 0x74, 0xc8, 0x08,
-	0x16, 0x24, 0xc4, 0x02, 0x0f, 0x30, 0x27, 0xfb, 0x72, 0x68, 0x83, 0x9d, 0x09, 0xcf, 0xf6, 0xed,
-	0xee, 0x01, 0xb2, 0x33, 0xf5, 0x9d, 0x65, 0x9e, 0xa3, 0xbf, 0xb3, 0x2c, 0x78, 0x02, 0x67, 0xdb,
-	0x8e, 0x0c, 0x1c, 0x13, 0xd4, 0x2a, 0x83, 0xfe, 0x6e, 0x41, 0x7b, 0xb6, 0xe6, 0x43, 0xc1, 0xe2,
-	0x3c, 0xdc, 0x21, 0x38, 0x93, 0x34, 0x32, 0xf1, 0xe4, 0xd1, 0xfd, 0x0a, 0xea, 0x53, 0xcc, 0xf0,
-	0x52, 0x15, 0xad, 0x75, 0xf5, 0xa4, 0xb7, 0x45, 0xb3, 0xde, 0xa6, 0x87, 0x9e, 0xba, 0x3c, 0x4a,
-	0x04, 0x5b, 0x23, 0x6d, 0xd8, 0x79, 0x0a, 0x50, 0x2a, 0x65, 0x84, 0x77, 0x64, 0x9d, 0xc3, 0x78,
-	0x47, 0xd6, 0xb2, 0x2d, 0xef, 0x71, 0xbc, 0x22, 0xa6, 0x1e, 0x5a, 0x78, 0x66, 0x3f, 0xb5, 0x82,
-	0x3f, 0x2c, 0x78, 0x54, 0xb8, 0xdf, 0x4e, 0xc3, 0x36, 0x69, 0xb8, 0x21, 0x34, 0x10, 0xe1, 0xab,
-	0x58, 0x18, 0x88, 0x97, 0xfb, 0x21, 0x6a, 0x1f, 0x3d, 0x7d, 0x5d, 0x83, 0x34, 0xb6, 0x9d, 0x2f,
-	0xa0, 0x55, 0x51, 0x7f, 0x10, 0xcc, 0x0c, 0x3a, 0x2f, 0x89, 0x98, 0xbd, 0xc5, 0x2c, 0x9a, 0x65,
-	0x31, 0x15, 0xd3, 0x94, 0x26, 0x62, 0x83, 0x85, 0x83, 0xa2, 0x83, 0x03, 0xd7, 0x85, 0x9a, 0x24,
-	0x9e, 0xe9, 0xa1, 0x3a, 0x4b, 0xaa, 0x28, 0xf3, 0x71, 0xa8, 0x5a, 0x59, 0x43, 0xb9, 0x28, 0xa3,
-	0x8e, 0xa3, 0x3b, 0xc2, 0xbd, 0x9a, 0xef, 0x74, 0x1d, 0xa4, 0x85, 0xe0, 0x35, 0x9c, 0xdf, 0x1b,
-	0xd1, 0xd4, 0xc8, 0x87, 0x56, 0x45, 0x6d, 0xd8, 0x57, 0x55, 0xdd, 0xc3, 0xc0, 0xdf, 0x2c, 0x38,
-	0x08, 0x49, 0x4c, 0x04, 0xd9, 0x07, 0xbc, 0x0d, 0x36, 0xca, 0x8c, 0x89, 0x8d, 0x32, 0xc5, 0x15,
-	0x2e, 0x3c, 0x47, 0xfb, 0x98, 0x70, 0xe1, 0x76, 0xe0, 0x81, 0xc1, 0xad, 0xf1, 0xd6, 0x50, 0x21,
-	0xbb, 0x17, 0x00, 0xda, 0xfd, 0xf5, 0x3a, 0x23, 0x5e, 0xdd, 0xb7, 0xbb, 0x75, 0x54, 0xd1, 0x98,
-	0xb2, 0x44, 0x5e, 0xc3, 0xb7, 0x4c, 0x59, 0xa2, 0x20, 0x80, 0x76, 0x0e, 0x69, 0x2f, 0x89, 0xff,
-	0xb2, 0xe0, 0xc4, 0x4c, 0xdf, 0x77, 0xb2, 0x23, 0x1f, 0x38, 0xfd, 0x9f, 0x95, 0x43, 0xea, 0x28,
-	0xf6, 0x9c, 0xef, 0xb0, 0x67, 0x82, 0xb3, 0x7c, 0xb4, 0x8b, 0x09, 0x7e, 0x0c, 0xcd, 0xe1, 0xf6,
-	0x42, 0x28, 0x14, 0x32, 0xd4, 0xd7, 0x74, 0x49, 0x85, 0x57, 0xf7, 0xad, 0x6e, 0x1d, 0x69, 0x41,
-	0x56, 0x27, 0xa4, 0x3c, 0x65, 0x11, 0x61, 0x2a, 0xcb, 0x07, 0xa8, 0x90, 0x83, 0x39, 0x9c, 0x6e,
-	0x25, 0xb1, 0x2f, 0x61, 0xf7, 0x73, 0x68, 0xe8, 0x3b, 0x86, 0xee, 0x9f, 0xec, 0x00, 0x2e, 0xbc,
-	0xcc, 0x62, 0x7a, 0x43, 0x90, 0xb9, 0x1e, 0x0c, 0x00, 0xca, 0x54, 0x24, 0x47, 0x2a, 0x2b, 0xce,
-	0xd4, 0xa9, 0xaa, 0x92, 0x1d, 0x51, 0x75, 0xb1, 0x15, 0x7d, 0xd4, 0x39, 0xf8, 0x01, 0xda, 0x9b,
-	0xde, 0xff, 0x9b, 0x1f, 0xb9, 0xda, 0x4d, 0x12, 0x7a, 0xdd, 0xe6, 0x18, 0xff, 0xb6, 0xc0, 0x1b,
-	0xdd, 0xe1, 0x1b, 0x31, 0xc4, 0x2c, 0xa2, 0x09, 0x8e, 0xa9, 0x58, 0x17, 0xb5, 0xf8, 0x1e, 0x5a,
-	0x15, 0xb5, 0xa2, 0x75, 0xeb, 0xea, 0xd9, 0x4e, 0xfa, 0xfb, 0xec, 0x7b, 0x15, 0x9d, 0x9e, 0xfd,
-	0xaa, 0xbb, 0xdd, 0x91, 0xe8, 0x3c, 0x87, 0xc3, 0x6d, 0x93, 0x7f, 0xdb, 0x0b, 0xb5, 0xea, 0x5e,
-	0xf8, 0xd5, 0x82, 0xe6, 0x54, 0xe4, 0x7c, 0x3c, 0x07, 0x7b, 0xaa, 0xeb, 0xd3, 0xba, 0x6a, 0xe9,
-	0x47, 0xb7, 0x17, 0xce, 0xa7, 0x02, 0xd9, 0x53, 0xa1, 0xaa, 0x48, 0x17, 0x0c, 0x9b, 0xf1, 0xb0,
-	0xd5, 0x78, 0x54, 0x55, 0xb2, 0x8a, 0xdf, 0x66, 0xe3, 0xc8, 0xec, 0x07, 0x75, 0x96, 0x56, 0x2f,
-	0x62, 0xfa, 0x9e, 0x0c, 0xd3, 0x24, 0x19, 0x47, 0x8a, 0x87, 0x35, 0x54, 0x55, 0x05, 0x17, 0x00,
-	0x12, 0xc1, 0xde, 0xe9, 0xf9, 0xd3, 0x82, 0x87, 0xaf, 0x57, 0x84, 0xad, 0x47, 0x77, 0x64, 0x9c,
-	0xdc, 0xa6, 0x72, 0x13, 0x29, 0x79, 0x1c, 0x2a, 0xa8, 0x35, 0x94, 0x8b, 0x12, 0xc0, 0x4c, 0x2c,
-	0xf5, 0xdb, 0xd3, 0x44, 0xea, 0xac, 0x28, 0x8d, 0x05, 0x9e, 0x63, 0x4e, 0xcc, 0x1b, 0x54, 0xc8,
-	0x72, 0x44, 0x06, 0x64, 0x41, 0x93, 0x6b, 0xba, 0x24, 0x5e, 0xcd, 0xb7, 0xbb, 0x0e, 0x2a, 0x15,
-	0xd2, 0x12, 0xad, 0x92, 0x99, 0xc0, 0x22, 0x5f, 0x06, 0x85, 0x5c, 0x6c, 0xc8, 0x46, 0xb9, 0x21,
-	0x83, 0x37, 0xfa, 0x8d, 0x95, 0x60, 0x68, 0x65, 0x3c, 0x86, 0x70, 0x50, 0x85, 0xcf, 0x0d, 0x29,
-	0x3e, 0xde, 0x21, 0x45, 0xf5, 0x16, 0xda, 0xb4, 0x09, 0x2e, 0xe1, 0xf0, 0x15, 0x8d, 0x63, 0xa5,
-	0xcc, 0xbb, 0xb5, 0xb7, 0x0e, 0xc1, 0x08, 0x8e, 0x2a, 0xb7, 0xcb, 0xb7, 0x7e, 0xc4, 0xd8, 0x30,
-	0x8d, 0x88, 0xaa, 0xee, 0x01, 0xca, 0x45, 0xc9, 0xf4, 0x11, 0x63, 0x13, 0xbe, 0x30, 0xcc, 0x32,
-	0x52, 0xd0, 0x83, 0x93, 0x19, 0x59, 0x30, 0xb2, 0xc0, 0x82, 0x7c, 0x93, 0x46, 0xc5, 0xd6, 0x3d,
-	0x83, 0x86, 0x14, 0xc7, 0x91, 0x89, 0x6b, 0xa4, 0xe0, 0x53, 0x38, 0xdd, 0xba, 0xbf, 0xb7, 0xa9,
-	0x14, 0x8e, 0x11, 0xbe, 0x15, 0x13, 0xc2, 0x39, 0x5e, 0x94, 0x0b, 0xb1, 0xda, 0x2c, 0x7d, 0xbb,
-	0x6c, 0x56, 0xbe, 0x7d, 0xed, 0x72, 0xfb, 0xca, 0x3f, 0x46, 0x55, 0x37, 0x6a, 0xd1, 0x3f, 0x44,
-	0x1b, 0x3a, 0x99, 0xc5, 0x66, 0xa8, 0xf2, 0xaf, 0x9b, 0xc9, 0xda, 0xaa, 0x66, 0x3d, 0x38, 0x7e,
-	0x73, 0xd4, 0xfb, 0x72, 0xab, 0x37, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xca, 0x41, 0xf4, 0xaf,
-	0xda, 0x0a, 0x00, 0x00,
+	// 1075 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x5b, 0x8f, 0xdb, 0x44,
+	0x14, 0x96, 0xed, 0x24, 0x34, 0x27, 0x9b, 0x74, 0xd7, 0x7b, 0x91, 0x95, 0x2d, 0x8b, 0x65, 0xf1,
+	0x10, 0xaa, 0x55, 0x22, 0xad, 0x84, 0x28, 0x45, 0xaa, 0x68, 0x2e, 0x54, 0x51, 0x09, 0xa4, 0x93,
+	0x15, 0x0f, 0x15, 0x42, 0x9a, 0xac, 0x67, 0xd3, 0x51, 0x1d, 0xdb, 0xcc, 0x4c, 0xca, 0x46, 0xf0,
+	0x27, 0xf8, 0x03, 0x3c, 0xa1, 0xfe, 0x11, 0xde, 0xf8, 0x55, 0x68, 0x2e, 0xbe, 0x24, 0xd9, 0x08,
+	0x95, 0x17, 0x5e, 0xa2, 0x39, 0x5f, 0xe6, 0xdc, 0xbf, 0x73, 0xc6, 0x70, 0x11, 0xd1, 0x79, 0x2f,
+	0x26, 0x82, 0x8b, 0x84, 0xe1, 0x05, 0xe9, 0x85, 0x58, 0x60, 0xf5, 0xd3, 0x4d, 0x59, 0x22, 0x12,
+	0xf7, 0x61, 0xf1, 0x5f, 0x57, 0xc2, 0xed, 0x4b, 0xa9, 0xb0, 0x12, 0x34, 0xea, 0x45, 0xf4, 0x56,
+	0x90, 0xb0, 0x47, 0xe3, 0xdb, 0x68, 0x75, 0xd7, 0x5b, 0x12, 0x81, 0x7b, 0x4a, 0x47, 0x1d, 0xb5,
+	0x7a, 0xf0, 0x2b, 0x1c, 0xcd, 0x08, 0xa3, 0x84, 0xbf, 0x24, 0x6b, 0x8e, 0xc8, 0xcf, 0x2b, 0xc2,
+	0x85, 0xdb, 0x02, 0x7b, 0x38, 0xf7, 0x2c, 0xdf, 0xee, 0xd4, 0x91, 0x3d, 0x9c, 0xbb, 0x27, 0x50,
+	0x9d, 0x8a, 0xf1, 0x90, 0x7b, 0xb6, 0xef, 0x74, 0x9a, 0x48, 0x0b, 0x6e, 0x00, 0x07, 0x13, 0x82,
+	0xf9, 0x8a, 0x91, 0x25, 0x89, 0x05, 0xf7, 0x1c, 0xdf, 0xe9, 0xd4, 0xd1, 0x06, 0xe6, 0x3e, 0x82,
+	0xfa, 0x4d, 0x12, 0x87, 0x54, 0xd0, 0x24, 0xf6, 0x2a, 0xbe, 0xd5, 0xa9, 0xa3, 0x02, 0x08, 0x9e,
+	0x81, 0x5b, 0x76, 0xce, 0xd3, 0x24, 0xe6, 0xc4, 0x3d, 0x83, 0x9a, 0x46, 0x3d, 0x4b, 0x59, 0x34,
+	0x92, 0x7b, 0x08, 0xce, 0x88, 0x31, 0xcf, 0x56, 0x56, 0xe4, 0x31, 0xf8, 0x0d, 0xdc, 0xd9, 0x9b,
+	0xe4, 0x97, 0x6b, 0xbc, 0xf8, 0x3f, 0xa2, 0x7f, 0x0e, 0xc7, 0x1b, 0xde, 0x4d, 0xf8, 0x1e, 0x7c,
+	0x64, 0x20, 0x13, 0x7f, 0x26, 0xde, 0x93, 0xc0, 0x0b, 0x38, 0x1d, 0x30, 0x82, 0x05, 0x19, 0x62,
+	0x81, 0xfb, 0x98, 0x93, 0x7d, 0x39, 0xb4, 0xc0, 0x4e, 0x85, 0x67, 0xfb, 0x76, 0xa7, 0x89, 0xec,
+	0x54, 0xfd, 0xcf, 0x52, 0xcf, 0xd1, 0xff, 0xb3, 0x34, 0x78, 0x0c, 0x67, 0xdb, 0x86, 0x4c, 0x38,
+	0xc6, 0xa9, 0x55, 0x38, 0xfd, 0xc3, 0x82, 0xd6, 0x6c, 0xcd, 0x07, 0x82, 0x45, 0x99, 0xbb, 0x43,
+	0x70, 0x26, 0x49, 0x68, 0xfc, 0xc9, 0xa3, 0xfb, 0x35, 0x54, 0xa7, 0x98, 0xe1, 0xa5, 0x2a, 0x5a,
+	0xe3, 0xea, 0x71, 0x77, 0x8b, 0x66, 0xdd, 0x4d, 0x0b, 0x5d, 0x75, 0x79, 0x14, 0x0b, 0xb6, 0x46,
+	0x5a, 0xb1, 0xfd, 0x04, 0xa0, 0x00, 0xa5, 0x87, 0xb7, 0x64, 0x9d, 0x85, 0xf1, 0x96, 0xac, 0x65,
+	0x5b, 0xde, 0xe1, 0x68, 0x45, 0x4c, 0x3d, 0xb4, 0xf0, 0xd4, 0x7e, 0x62, 0x05, 0x7f, 0x5a, 0xf0,
+	0x30, 0x37, 0xbf, 0x9d, 0x86, 0x6d, 0xd2, 0x70, 0x87, 0x50, 0x43, 0x84, 0xaf, 0x22, 0x61, 0x42,
+	0xbc, 0xdc, 0x1f, 0xa2, 0xb6, 0xd1, 0xd5, 0xd7, 0x75, 0x90, 0x46, 0xb7, 0xfd, 0x25, 0x34, 0x4a,
+	0xf0, 0x07, 0x85, 0x99, 0x42, 0xfb, 0x05, 0x11, 0xb3, 0x37, 0x98, 0x85, 0xb3, 0x34, 0xa2, 0x62,
+	0x9a, 0xd0, 0x58, 0x6c, 0xb0, 0xb0, 0x9f, 0x77, 0xb0, 0xef, 0xba, 0x50, 0x91, 0xc4, 0x33, 0x3d,
+	0x54, 0x67, 0x49, 0x15, 0xa5, 0x3e, 0x1e, 0xaa, 0x56, 0x56, 0x50, 0x26, 0x4a, 0xaf, 0xe3, 0xf0,
+	0x8e, 0x70, 0xaf, 0xe2, 0x3b, 0x1d, 0x07, 0x69, 0x21, 0x78, 0x05, 0xe7, 0xf7, 0x7a, 0x34, 0x35,
+	0xf2, 0xa1, 0x51, 0x82, 0x0d, 0xfb, 0xca, 0xd0, 0x3d, 0x0c, 0xfc, 0xdd, 0x82, 0xe6, 0x90, 0x44,
+	0x44, 0x90, 0x7d, 0x81, 0xb7, 0xc0, 0x46, 0xa9, 0x51, 0xb1, 0x51, 0xaa, 0xb8, 0xc2, 0x85, 0xe7,
+	0x68, 0x1b, 0x13, 0x2e, 0xdc, 0x36, 0x3c, 0x30, 0x71, 0xeb, 0x78, 0x2b, 0x28, 0x97, 0xdd, 0x0b,
+	0x00, 0x6d, 0xfe, 0x7a, 0x9d, 0x12, 0xaf, 0xea, 0xdb, 0x9d, 0x2a, 0x2a, 0x21, 0xa6, 0x2c, 0xa1,
+	0x57, 0xf3, 0x2d, 0x53, 0x96, 0x30, 0x08, 0xa0, 0x95, 0x85, 0xb4, 0x97, 0xc4, 0x7f, 0x59, 0x70,
+	0x62, 0xa6, 0xef, 0x07, 0xd9, 0x91, 0x0f, 0x9c, 0xfe, 0xcf, 0x8b, 0x21, 0x75, 0x14, 0x7b, 0xce,
+	0x77, 0xd8, 0x33, 0xc1, 0x69, 0x36, 0xda, 0xf9, 0x04, 0x3f, 0x82, 0xfa, 0x60, 0x7b, 0x21, 0xe4,
+	0x80, 0x74, 0xf5, 0x2d, 0x5d, 0x52, 0xe1, 0x55, 0x7d, 0xab, 0x53, 0x45, 0x5a, 0x90, 0xd5, 0x19,
+	0x52, 0x9e, 0xb0, 0x90, 0x30, 0x95, 0xe5, 0x03, 0x94, 0xcb, 0xc1, 0x1c, 0x4e, 0xb7, 0x92, 0xd8,
+	0x97, 0xb0, 0xfb, 0x05, 0xd4, 0xf4, 0x1d, 0x43, 0xf7, 0x4f, 0x76, 0x02, 0xce, 0xad, 0xcc, 0x22,
+	0x7a, 0x43, 0x90, 0xb9, 0x1e, 0xf4, 0x01, 0x8a, 0x54, 0x24, 0x47, 0x4a, 0x2b, 0xce, 0xd4, 0xa9,
+	0x0c, 0xc9, 0x8e, 0xa8, 0xba, 0xd8, 0x8a, 0x3e, 0xea, 0x1c, 0xfc, 0x04, 0xad, 0x4d, 0xeb, 0xff,
+	0xcd, 0x8e, 0x5c, 0xed, 0x26, 0x09, 0xbd, 0x6e, 0xb3, 0x18, 0xff, 0xb6, 0xc0, 0x1b, 0xdd, 0xe1,
+	0x1b, 0x31, 0xc0, 0x2c, 0xa4, 0x31, 0x8e, 0xa8, 0x58, 0xe7, 0xb5, 0xf8, 0x11, 0x1a, 0x25, 0x58,
+	0xd1, 0xba, 0x71, 0xf5, 0x74, 0x27, 0xfd, 0x7d, 0xfa, 0xdd, 0x12, 0xa6, 0x67, 0xbf, 0x6c, 0x6e,
+	0x77, 0x24, 0xda, 0xcf, 0xe0, 0x70, 0x5b, 0xe5, 0xdf, 0xf6, 0x42, 0xa5, 0xbc, 0x17, 0xde, 0x5b,
+	0x50, 0x9f, 0x8a, 0x8c, 0x8f, 0xe7, 0x60, 0x4f, 0x75, 0x7d, 0x1a, 0x57, 0x0d, 0xfd, 0xe8, 0x76,
+	0x87, 0xf3, 0xa9, 0x40, 0xf6, 0x54, 0xa8, 0x2a, 0xd2, 0x05, 0xc3, 0x66, 0x3c, 0x6c, 0x35, 0x1e,
+	0x65, 0x48, 0x56, 0xf1, 0xfb, 0x74, 0x1c, 0x9a, 0xfd, 0xa0, 0xce, 0x52, 0xeb, 0x79, 0x44, 0xdf,
+	0x91, 0x41, 0x12, 0xc7, 0xe3, 0x50, 0xf1, 0xb0, 0x82, 0xca, 0x90, 0xfb, 0x29, 0x34, 0xaf, 0x19,
+	0x8e, 0xf9, 0x2d, 0x61, 0xdf, 0xd0, 0x88, 0x70, 0xc5, 0xc8, 0x07, 0x68, 0x13, 0x0c, 0x2e, 0x00,
+	0xa6, 0x22, 0x2b, 0xd3, 0x3d, 0x33, 0xf6, 0xde, 0x82, 0x83, 0x57, 0x2b, 0xc2, 0xd6, 0xa3, 0x3b,
+	0x32, 0x8e, 0x6f, 0x13, 0xb9, 0xaf, 0x94, 0x3c, 0x1e, 0xaa, 0x84, 0x2a, 0x28, 0x13, 0x65, 0x98,
+	0x33, 0xb1, 0xd4, 0x2f, 0x54, 0x1d, 0xa9, 0xb3, 0x22, 0x3e, 0x16, 0x78, 0x8e, 0x39, 0x31, 0x2f,
+	0x55, 0x2e, 0xcb, 0x41, 0xea, 0x93, 0x05, 0x8d, 0xaf, 0xe9, 0x92, 0x78, 0x15, 0xdf, 0xee, 0x38,
+	0xa8, 0x00, 0xa4, 0x26, 0x5a, 0xc5, 0x33, 0x81, 0x45, 0xb6, 0x32, 0x72, 0x39, 0xdf, 0xa3, 0xb5,
+	0x62, 0x8f, 0x06, 0xaf, 0xf5, 0x4b, 0x2c, 0x83, 0xa1, 0xa5, 0x21, 0x1a, 0x40, 0xb3, 0x1c, 0x3e,
+	0x37, 0xd4, 0xf9, 0x78, 0x87, 0x3a, 0xe5, 0x5b, 0x68, 0x53, 0x27, 0xb8, 0x84, 0xc3, 0x97, 0x34,
+	0x8a, 0x14, 0x98, 0xf5, 0x74, 0x6f, 0x1d, 0x82, 0x11, 0x1c, 0x95, 0x6e, 0x17, 0x5f, 0x04, 0x23,
+	0xc6, 0x06, 0x49, 0x48, 0x54, 0x75, 0x9b, 0x28, 0x13, 0xe5, 0x3c, 0x8c, 0x18, 0x9b, 0xf0, 0x85,
+	0xe1, 0x9f, 0x91, 0x82, 0x2e, 0x9c, 0xcc, 0xc8, 0x82, 0x91, 0x05, 0x16, 0xe4, 0xbb, 0x24, 0xcc,
+	0x77, 0xf3, 0x19, 0xd4, 0xa4, 0x38, 0x0e, 0x8d, 0x5f, 0x23, 0x05, 0x9f, 0xc1, 0xe9, 0xd6, 0xfd,
+	0xbd, 0x4d, 0xa5, 0x70, 0x8c, 0xf0, 0xad, 0x98, 0x10, 0xce, 0xf1, 0xa2, 0x58, 0x9b, 0xe5, 0x66,
+	0xe9, 0xdb, 0x45, 0xb3, 0xb2, 0x1d, 0x6d, 0x17, 0x3b, 0x5a, 0x7e, 0x3e, 0x95, 0xcd, 0xa8, 0xe7,
+	0xe0, 0x00, 0x6d, 0x60, 0x32, 0x8b, 0x4d, 0x57, 0xc5, 0x07, 0x9e, 0xc9, 0xda, 0x2a, 0x67, 0xdd,
+	0x3f, 0x7e, 0x7d, 0xd4, 0xfd, 0x6a, 0xab, 0x37, 0xff, 0x0c, 0x00, 0x97, 0x9d, 0x1a, 0x5f, 0x00,
+	0x0b, 0x00, 0x00,
 }
@@ -17,6 +17,7 @@ package netstorage
 import (
 	"context"
 	"sort"
+	"time"
 
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
@@ -25,6 +26,7 @@ import (
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics/opsStat"
 	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/tail"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
@@ -121,6 +123,30 @@ type Engine interface {
 	SysCtrl(req *SysCtrlRequest) (map[string]string, error)
 	Statistics(buffer []byte) ([]byte, error)
 	StatisticsOps() []opsStat.OpsStatistic
+	ShowCompactions() []*CompactionInfo
+	TagKeysCardinality(db string, ptIDs []uint32, measurements [][]byte) ([]*TagKeyCardinality, error)
+	ReplicaReadStatus(db string, ptId uint32, waitApplied bool, timeout time.Duration) (uint64, uint64, error)
+	FetchShardsNeedRepair(coldDuration time.Duration) []*meta.ShardIdentifier
+	ShardDigest(db string, ptId uint32, shardID uint64, interval int64) (*ShardDigest, error)
+	ScanShardRows(db string, ptId uint32, shardID uint64, mst string, ranges []util.TimeRange, fn func(rows []byte) error) (int64, error)
+	RepairShard(db, rp string, ptId uint32, shardID uint64, rows []byte) (int64, error)
+	PtLoads() []*PtLoad
+	PtFiles(db string, ptId uint32) ([]*PtFileInfo, error)
+	ReadPtFile(db string, ptId uint32, name string, offset, size int64) ([]byte, error)
+	RemovePtFiles(db string, ptId uint32) error
+	PtTransferSource(db string, ptId uint32) (uint64, bool)
+	FinishPtTransfer(db string, ptId uint32) error
+	TransferPt(opId uint64, db string, ptId uint32, srcNodeId uint64, phase string, fetcher PtFileFetcher) error
+	ShowRebalance() []*PtTransferInfo
+	SplitShard(db string, ptId uint32, shardID uint64, min, max string, fn func(rows []influx.Row) error) (int64, error)
+	WriteSplitRows(db string, ptId uint32, shardID uint64, rows []influx.Row) (int64, error)
+	SetAsyncReplication(db string, enabled bool)
+	ReplicationSegments() []*ReplicationSegment
+	ReplayReplicationSegment(seg *ReplicationSegment, fn func(rows []influx.Row) error) (int64, error)
+	RemoveReplicationSegment(seg *ReplicationSegment) error
+	DropReplicationArchive(db string) error
+	TailLogs(db, logStream string, session uint64, condition string, opt tail.Options, wait time.Duration) ([]*tail.Log, tail.Stats, error)
+	CloseTailLogs(session uint64)
 
 	GetShardDownSamplePolicyInfos(meta interface {
 		UpdateShardDownSampleInfo(Ident *meta.ShardIdentifier) error
@@ -148,6 +174,28 @@ type ShowTagValuesPlan interface {
 	Stop()
 }
 
+const (
+	PtTransferBulk  = "bulk"
+	PtTransferFinal = "final"
+)
+
+// PtFileFetcher fetches the files of a partition from the node the partition is moved from
+type PtFileFetcher interface {
+	PtFiles(nodeID uint64, db string, pt uint32) ([]*PtFileInfo, error)
+	ReadPtFile(nodeID uint64, db string, pt uint32, name string, offset, size int64) ([]byte, error)
+}
+
+// ReplicationSegment is an archived wal file of the shard waiting to be shipped to the replication target
+type ReplicationSegment struct {
+	Db      string
+	Rp      string
+	PtId    uint32
+	ShardID uint64
+	Seq     int64
+	Path    string
+	Size    int64
+}
+
 type StorageService interface {
 	Write(db, rp, mst string, ptId uint32, shardID uint64, writeData func() error) error
 	WriteDataFunc(db, rp string, ptId uint32, shardID uint64, rows []influx.Row, binaryRows []byte, index *raftlog.SnapShotter) error
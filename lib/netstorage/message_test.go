@@ -25,6 +25,7 @@ import (
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/netstorage"
 	netdata "github.com/openGemini/openGemini/lib/netstorage/data"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/tail"
 	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
 	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -391,6 +392,286 @@ func TestShowQueriesRequest_Marshal_Unmarshal(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestShowCompactionsResponse_Marshal_Unmarshal(t *testing.T) {
+	resp := &netstorage.ShowCompactionsResponse{
+		Compactions: []*netstorage.CompactionInfo{{
+			ShardID:     1,
+			Measurement: "cpu_0000",
+			Strategy:    "level",
+			Level:       2,
+			FileCount:   4,
+			FileSize:    1024,
+			Running:     true,
+			StartTime:   time.Now().UnixNano(),
+		}, {
+			ShardID:     2,
+			Measurement: "mem_0000",
+			Strategy:    "time-window",
+			Level:       1,
+			FileCount:   3,
+		}},
+	}
+	buf, err := resp.MarshalBinary()
+	require.NoError(t, err)
+
+	resp2 := &netstorage.ShowCompactionsResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.Equal(t, resp.Compactions, resp2.Compactions)
+
+	require.NoError(t, (&netstorage.ShowCompactionsResponse{}).UnmarshalBinary(nil))
+}
+
+func TestShowCardinalityTopResponse_Marshal_Unmarshal(t *testing.T) {
+	resp := &netstorage.ShowCardinalityTopResponse{
+		Cardinalities: []*netstorage.TagKeyCardinality{
+			{Measurement: "cpu_0000", Key: "request_id", Values: 10000, Series: 10000},
+			{Measurement: "cpu_0000", Key: "region", Values: 3, Series: 10000},
+		},
+	}
+	buf, err := resp.MarshalBinary()
+	require.NoError(t, err)
+
+	resp2 := &netstorage.ShowCardinalityTopResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.Equal(t, resp.Cardinalities, resp2.Cardinalities)
+	require.NoError(t, resp2.Error())
+
+	resp = &netstorage.ShowCardinalityTopResponse{Err: netstorage.MarshalError(errno.NewError(errno.PtNotFound))}
+	buf, err = resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 = &netstorage.ShowCardinalityTopResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.True(t, errno.Equal(resp2.Error(), errno.PtNotFound))
+}
+
+func TestReplicaReadStatus_Marshal_Unmarshal(t *testing.T) {
+	req := &netstorage.ReplicaReadStatusRequest{Db: "db0", PtId: 3, WaitApplied: true, Timeout: int64(time.Second)}
+	buf, err := req.MarshalBinary()
+	require.NoError(t, err)
+	req2 := &netstorage.ReplicaReadStatusRequest{}
+	require.NoError(t, req2.UnmarshalBinary(buf))
+	require.Equal(t, req, req2)
+
+	resp := &netstorage.ReplicaReadStatusResponse{AppliedIndex: 10, CommittedIndex: 12}
+	buf, err = resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 := &netstorage.ReplicaReadStatusResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.Equal(t, resp, resp2)
+	require.NoError(t, resp2.Error())
+
+	resp = &netstorage.ReplicaReadStatusResponse{AppliedIndex: 10, Err: netstorage.MarshalError(errno.NewError(errno.FollowerReadLagging, "db0", 3, 10, 12))}
+	buf, err = resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 = &netstorage.ReplicaReadStatusResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.True(t, errno.Equal(resp2.Error(), errno.FollowerReadLagging))
+}
+
+func TestShardDigest_Marshal_Unmarshal(t *testing.T) {
+	req := &netstorage.ShardDigestRequest{Db: "db0", PtId: 3, ShardID: 11, Interval: int64(time.Hour)}
+	buf, err := req.MarshalBinary()
+	require.NoError(t, err)
+	req2 := &netstorage.ShardDigestRequest{}
+	require.NoError(t, req2.UnmarshalBinary(buf))
+	require.Equal(t, req, req2)
+
+	resp := &netstorage.ShardDigestResponse{Digest: &netstorage.ShardDigest{
+		ShardID:   11,
+		StartTime: 1,
+		EndTime:   2,
+		Hash:      100,
+		Measurements: []*netstorage.MeasurementDigest{
+			{Name: "cpu_0000", Series: 2, Rows: 20, Hash: 10, Ranges: []*netstorage.RangeDigest{
+				{StartTime: 0, EndTime: 9, Series: 2, Rows: 15, Hash: 11},
+				{StartTime: 10, EndTime: 19, Series: 1, Rows: 5, Hash: 12},
+			}},
+			{Name: "mem_0000", Pending: true},
+		},
+	}}
+	buf, err = resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 := &netstorage.ShardDigestResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.Equal(t, resp, resp2)
+
+	resp = &netstorage.ShardDigestResponse{Err: netstorage.MarshalError(errno.NewError(errno.ShardNotFound, 11))}
+	buf, err = resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 = &netstorage.ShardDigestResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.Nil(t, resp2.Digest)
+	require.True(t, errno.Equal(resp2.Error(), errno.ShardNotFound))
+}
+
+func TestRepairShard_Marshal_Unmarshal(t *testing.T) {
+	req := &netstorage.RepairShardRequest{Db: "db0", Rp: "rp0", PtId: 3, ShardID: 11, Rows: []byte{1, 2, 3}}
+	buf, err := req.MarshalBinary()
+	require.NoError(t, err)
+	req2 := &netstorage.RepairShardRequest{}
+	require.NoError(t, req2.UnmarshalBinary(buf))
+	require.Equal(t, req, req2)
+
+	resp := &netstorage.RepairShardResponse{Rows: 20}
+	buf, err = resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 := &netstorage.RepairShardResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.Equal(t, resp, resp2)
+	require.NoError(t, resp2.Error())
+
+	repairs := &netstorage.ShowRepairsResponse{Repairs: []*netstorage.RepairInfo{{
+		Db:          "db0",
+		PtId:        3,
+		ShardID:     11,
+		Measurement: "cpu_0000",
+		PeerPtId:    4,
+		Ranges:      2,
+		State:       "failed",
+		Rows:        20,
+		DetectTime:  1,
+		UpdateTime:  2,
+		Error:       "shard not found",
+	}}}
+	buf, err = repairs.MarshalBinary()
+	require.NoError(t, err)
+	repairs2 := &netstorage.ShowRepairsResponse{}
+	require.NoError(t, repairs2.UnmarshalBinary(buf))
+	require.Equal(t, repairs, repairs2)
+}
+
+func TestPtLoads_Marshal_Unmarshal(t *testing.T) {
+	resp := &netstorage.PtLoadsResponse{Loads: []*netstorage.PtLoad{
+		{Db: "db0", PtId: 1, DiskBytes: 1024, Series: 10, WriteRows: 100, Queries: 5},
+		{Db: "db1", PtId: 2, DiskBytes: 2048, Series: 20, WriteRows: 200, Queries: 0},
+	}}
+	buf, err := resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 := &netstorage.PtLoadsResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.Equal(t, resp, resp2)
+}
+
+func TestPtFiles_Marshal_Unmarshal(t *testing.T) {
+	req := &netstorage.PtFilesRequest{Db: "db0", PtId: 3}
+	buf, err := req.MarshalBinary()
+	require.NoError(t, err)
+	req2 := &netstorage.PtFilesRequest{}
+	require.NoError(t, req2.UnmarshalBinary(buf))
+	require.Equal(t, req, req2)
+
+	resp := &netstorage.PtFilesResponse{Files: []*netstorage.PtFileInfo{
+		{Name: "data/rp0/1_0_1_1/columnstore/cpu_0000/00000001-0000-00000000.tssp", Size: 1024, ModTime: 1},
+		{Name: "wal/rp0/1_0_1_1/1.wal", Size: 10, ModTime: 2},
+	}}
+	buf, err = resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 := &netstorage.PtFilesResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.Equal(t, resp, resp2)
+	require.NoError(t, resp2.Error())
+
+	readReq := &netstorage.ReadPtFileRequest{Db: "db0", PtId: 3, Name: "wal/rp0/1_0_1_1/1.wal", Offset: 5, Size: 5}
+	buf, err = readReq.MarshalBinary()
+	require.NoError(t, err)
+	readReq2 := &netstorage.ReadPtFileRequest{}
+	require.NoError(t, readReq2.UnmarshalBinary(buf))
+	require.Equal(t, readReq, readReq2)
+
+	readResp := &netstorage.ReadPtFileResponse{Data: []byte("hello")}
+	buf, err = readResp.MarshalBinary()
+	require.NoError(t, err)
+	readResp2 := &netstorage.ReadPtFileResponse{}
+	require.NoError(t, readResp2.UnmarshalBinary(buf))
+	require.Equal(t, readResp, readResp2)
+
+	dropResp := &netstorage.DropPtFilesResponse{Err: netstorage.MarshalError(errno.NewError(errno.PtIsTransferring, 2))}
+	buf, err = dropResp.MarshalBinary()
+	require.NoError(t, err)
+	dropResp2 := &netstorage.DropPtFilesResponse{}
+	require.NoError(t, dropResp2.UnmarshalBinary(buf))
+	require.True(t, errno.Equal(dropResp2.Error(), errno.PtIsTransferring))
+}
+
+func TestShowRebalance_Marshal_Unmarshal(t *testing.T) {
+	resp := &netstorage.ShowRebalanceResponse{Transfers: []*netstorage.PtTransferInfo{{
+		Db:         "db0",
+		PtId:       3,
+		SrcNodeId:  2,
+		Phase:      "bulk",
+		State:      "running",
+		Files:      1,
+		TotalFiles: 2,
+		Bytes:      1024,
+		TotalBytes: 2048,
+		StartTime:  1,
+		UpdateTime: 2,
+	}}}
+	buf, err := resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 := &netstorage.ShowRebalanceResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.Equal(t, resp, resp2)
+}
+
+func TestSplitShard_Marshal_Unmarshal(t *testing.T) {
+	req := &netstorage.SplitShardRequest{Db: "db0", Rp: "rp0", PtId: 3, ShardID: 11}
+	buf, err := req.MarshalBinary()
+	require.NoError(t, err)
+	req2 := &netstorage.SplitShardRequest{}
+	require.NoError(t, req2.UnmarshalBinary(buf))
+	require.Equal(t, req, req2)
+
+	resp := &netstorage.SplitShardResponse{Rows: 20, Err: netstorage.MarshalError(errno.NewError(errno.ShardNotFound, 11))}
+	buf, err = resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 := &netstorage.SplitShardResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.Equal(t, resp, resp2)
+	require.True(t, errno.Equal(resp2.Error(), errno.ShardNotFound))
+}
+
+func TestWriteSplitRows_Marshal_Unmarshal(t *testing.T) {
+	req := &netstorage.WriteSplitRowsRequest{Db: "db0", Rp: "rp0", PtId: 3, ShardID: 12, Rows: []byte{1, 2, 3}}
+	buf, err := req.MarshalBinary()
+	require.NoError(t, err)
+	req2 := &netstorage.WriteSplitRowsRequest{}
+	require.NoError(t, req2.UnmarshalBinary(buf))
+	require.Equal(t, req, req2)
+
+	resp := &netstorage.WriteSplitRowsResponse{Rows: 20, Err: netstorage.MarshalError(errno.NewError(errno.ShardNotFound, 12))}
+	buf, err = resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 := &netstorage.WriteSplitRowsResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.Equal(t, resp, resp2)
+	require.True(t, errno.Equal(resp2.Error(), errno.ShardNotFound))
+}
+
+func TestTailLogs_Marshal_Unmarshal(t *testing.T) {
+	req := &netstorage.TailLogsRequest{Db: "repo", LogStream: "ls", Session: 7, Condition: "content MATCHPHRASE 'error'",
+		Rate: 100, BufferSize: 1000, Wait: int64(time.Second), Close: true}
+	buf, err := req.MarshalBinary()
+	require.NoError(t, err)
+	req2 := &netstorage.TailLogsRequest{}
+	require.NoError(t, req2.UnmarshalBinary(buf))
+	require.Equal(t, req, req2)
+
+	resp := &netstorage.TailLogsResponse{
+		Logs:  []*tail.Log{{Time: 1, Fields: map[string]interface{}{"content": "error", "status": int64(503), "latency": 1.5, "ok": false}}},
+		Stats: tail.Stats{Matched: 3, Sent: 1, Sampled: 1, Dropped: 1},
+	}
+	buf, err = resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 := &netstorage.TailLogsResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	require.Equal(t, resp, resp2)
+
+	resp.Logs[0].Fields["bad"] = []byte("x")
+	_, err = resp.MarshalBinary()
+	require.Error(t, err)
+}
+
 func TestShowQueriesResponse_Marshal_Unmarshal(t *testing.T) {
 	resp := &netstorage.ShowQueriesResponse{
 		QueryExeInfos: []*netstorage.QueryExeInfo{{
@@ -60,6 +60,48 @@ const (
 
 	RaftMessagesRequestMessage
 	RaftMessagesResponseMessage
+
+	ShowCompactionsRequestMessage
+	ShowCompactionsResponseMessage
+
+	ShowCardinalityTopRequestMessage
+	ShowCardinalityTopResponseMessage
+
+	ReplicaReadStatusRequestMessage
+	ReplicaReadStatusResponseMessage
+
+	ShardDigestRequestMessage
+	ShardDigestResponseMessage
+
+	RepairShardRequestMessage
+	RepairShardResponseMessage
+
+	ShowRepairsRequestMessage
+	ShowRepairsResponseMessage
+
+	PtLoadsRequestMessage
+	PtLoadsResponseMessage
+
+	PtFilesRequestMessage
+	PtFilesResponseMessage
+
+	ReadPtFileRequestMessage
+	ReadPtFileResponseMessage
+
+	DropPtFilesRequestMessage
+	DropPtFilesResponseMessage
+
+	ShowRebalanceRequestMessage
+	ShowRebalanceResponseMessage
+
+	SplitShardRequestMessage
+	SplitShardResponseMessage
+
+	TailLogsRequestMessage
+	TailLogsResponseMessage
+
+	WriteSplitRowsRequestMessage
+	WriteSplitRowsResponseMessage
 )
 
 var MessageBinaryCodec = make(map[uint8]func() codec.BinaryCodec, 20)
@@ -92,6 +134,34 @@ func init() {
 	MessageBinaryCodec[ShowTagKeysResponseMessage] = func() codec.BinaryCodec { return &ShowTagKeysResponse{} }
 	MessageBinaryCodec[RaftMessagesRequestMessage] = func() codec.BinaryCodec { return &RaftMessagesRequest{} }
 	MessageBinaryCodec[RaftMessagesResponseMessage] = func() codec.BinaryCodec { return &RaftMessagesResponse{} }
+	MessageBinaryCodec[ShowCompactionsRequestMessage] = func() codec.BinaryCodec { return &ShowCompactionsRequest{} }
+	MessageBinaryCodec[ShowCompactionsResponseMessage] = func() codec.BinaryCodec { return &ShowCompactionsResponse{} }
+	MessageBinaryCodec[ShowCardinalityTopRequestMessage] = func() codec.BinaryCodec { return &ShowCardinalityTopRequest{} }
+	MessageBinaryCodec[ShowCardinalityTopResponseMessage] = func() codec.BinaryCodec { return &ShowCardinalityTopResponse{} }
+	MessageBinaryCodec[ReplicaReadStatusRequestMessage] = func() codec.BinaryCodec { return &ReplicaReadStatusRequest{} }
+	MessageBinaryCodec[ReplicaReadStatusResponseMessage] = func() codec.BinaryCodec { return &ReplicaReadStatusResponse{} }
+	MessageBinaryCodec[ShardDigestRequestMessage] = func() codec.BinaryCodec { return &ShardDigestRequest{} }
+	MessageBinaryCodec[ShardDigestResponseMessage] = func() codec.BinaryCodec { return &ShardDigestResponse{} }
+	MessageBinaryCodec[RepairShardRequestMessage] = func() codec.BinaryCodec { return &RepairShardRequest{} }
+	MessageBinaryCodec[RepairShardResponseMessage] = func() codec.BinaryCodec { return &RepairShardResponse{} }
+	MessageBinaryCodec[ShowRepairsRequestMessage] = func() codec.BinaryCodec { return &ShowRepairsRequest{} }
+	MessageBinaryCodec[ShowRepairsResponseMessage] = func() codec.BinaryCodec { return &ShowRepairsResponse{} }
+	MessageBinaryCodec[PtLoadsRequestMessage] = func() codec.BinaryCodec { return &PtLoadsRequest{} }
+	MessageBinaryCodec[PtLoadsResponseMessage] = func() codec.BinaryCodec { return &PtLoadsResponse{} }
+	MessageBinaryCodec[PtFilesRequestMessage] = func() codec.BinaryCodec { return &PtFilesRequest{} }
+	MessageBinaryCodec[PtFilesResponseMessage] = func() codec.BinaryCodec { return &PtFilesResponse{} }
+	MessageBinaryCodec[ReadPtFileRequestMessage] = func() codec.BinaryCodec { return &ReadPtFileRequest{} }
+	MessageBinaryCodec[ReadPtFileResponseMessage] = func() codec.BinaryCodec { return &ReadPtFileResponse{} }
+	MessageBinaryCodec[DropPtFilesRequestMessage] = func() codec.BinaryCodec { return &DropPtFilesRequest{} }
+	MessageBinaryCodec[DropPtFilesResponseMessage] = func() codec.BinaryCodec { return &DropPtFilesResponse{} }
+	MessageBinaryCodec[ShowRebalanceRequestMessage] = func() codec.BinaryCodec { return &ShowRebalanceRequest{} }
+	MessageBinaryCodec[ShowRebalanceResponseMessage] = func() codec.BinaryCodec { return &ShowRebalanceResponse{} }
+	MessageBinaryCodec[SplitShardRequestMessage] = func() codec.BinaryCodec { return &SplitShardRequest{} }
+	MessageBinaryCodec[SplitShardResponseMessage] = func() codec.BinaryCodec { return &SplitShardResponse{} }
+	MessageBinaryCodec[TailLogsRequestMessage] = func() codec.BinaryCodec { return &TailLogsRequest{} }
+	MessageBinaryCodec[TailLogsResponseMessage] = func() codec.BinaryCodec { return &TailLogsResponse{} }
+	MessageBinaryCodec[WriteSplitRowsRequestMessage] = func() codec.BinaryCodec { return &WriteSplitRowsRequest{} }
+	MessageBinaryCodec[WriteSplitRowsResponseMessage] = func() codec.BinaryCodec { return &WriteSplitRowsResponse{} }
 
 	MessageResponseTyp = map[uint8]uint8{
 		SeriesKeysRequestMessage:               SeriesKeysResponseMessage,
@@ -106,5 +176,19 @@ func init() {
 		KillQueryRequestMessage:                KillQueryResponseMessage,
 		ShowTagKeysRequestMessage:              ShowTagKeysResponseMessage,
 		RaftMessagesRequestMessage:             RaftMessagesResponseMessage,
+		ShowCompactionsRequestMessage:          ShowCompactionsResponseMessage,
+		ShowCardinalityTopRequestMessage:       ShowCardinalityTopResponseMessage,
+		ReplicaReadStatusRequestMessage:        ReplicaReadStatusResponseMessage,
+		ShardDigestRequestMessage:              ShardDigestResponseMessage,
+		RepairShardRequestMessage:              RepairShardResponseMessage,
+		ShowRepairsRequestMessage:              ShowRepairsResponseMessage,
+		PtLoadsRequestMessage:                  PtLoadsResponseMessage,
+		PtFilesRequestMessage:                  PtFilesResponseMessage,
+		ReadPtFileRequestMessage:               ReadPtFileResponseMessage,
+		DropPtFilesRequestMessage:              DropPtFilesResponseMessage,
+		ShowRebalanceRequestMessage:            ShowRebalanceResponseMessage,
+		SplitShardRequestMessage:               SplitShardResponseMessage,
+		TailLogsRequestMessage:                 TailLogsResponseMessage,
+		WriteSplitRowsRequestMessage:           WriteSplitRowsResponseMessage,
 	}
 }
@@ -20,8 +20,10 @@ import (
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/bytesutil"
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
 	"github.com/cockroachdb/errors"
+	"github.com/openGemini/openGemini/lib/codec"
 	"github.com/openGemini/openGemini/lib/errno"
 	internal2 "github.com/openGemini/openGemini/lib/netstorage/data"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/tail"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
 	"go.etcd.io/etcd/raft/v3/raftpb"
@@ -428,6 +430,1039 @@ func (r *ShowQueriesRequest) UnmarshalBinary(buf []byte) error {
 	return nil
 }
 
+type ShowCompactionsRequest struct {
+}
+
+func (r *ShowCompactionsRequest) MarshalBinary() ([]byte, error) {
+	return nil, nil
+}
+
+func (r *ShowCompactionsRequest) UnmarshalBinary(buf []byte) error {
+	return nil
+}
+
+// CompactionInfo is a running or pending compaction plan of a shard on a store node
+type CompactionInfo struct {
+	ShardID     uint64
+	Measurement string
+	Strategy    string
+	Level       uint16
+	FileCount   int64
+	FileSize    int64
+	Running     bool
+	StartTime   int64
+}
+
+type ShowCompactionsResponse struct {
+	Compactions []*CompactionInfo
+}
+
+func (r *ShowCompactionsResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendUint32(nil, uint32(len(r.Compactions)))
+	for _, c := range r.Compactions {
+		buf = codec.AppendUint64(buf, c.ShardID)
+		buf = codec.AppendString(buf, c.Measurement)
+		buf = codec.AppendString(buf, c.Strategy)
+		buf = codec.AppendUint16(buf, c.Level)
+		buf = codec.AppendInt64(buf, c.FileCount)
+		buf = codec.AppendInt64(buf, c.FileSize)
+		buf = codec.AppendBool(buf, c.Running)
+		buf = codec.AppendInt64(buf, c.StartTime)
+	}
+	return buf, nil
+}
+
+func (r *ShowCompactionsResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	n := int(dec.Uint32())
+	r.Compactions = make([]*CompactionInfo, 0, n)
+	for i := 0; i < n; i++ {
+		r.Compactions = append(r.Compactions, &CompactionInfo{
+			ShardID:     dec.Uint64(),
+			Measurement: dec.String(),
+			Strategy:    dec.String(),
+			Level:       dec.Uint16(),
+			FileCount:   dec.Int64(),
+			FileSize:    dec.Int64(),
+			Running:     dec.Bool(),
+			StartTime:   dec.Int64(),
+		})
+	}
+	return nil
+}
+
+type ShowCardinalityTopRequest struct {
+	SeriesKeysRequest
+}
+
+// TagKeyCardinality is the number of the distinct values and of the series of a tag key of a measurement on a store node
+type TagKeyCardinality struct {
+	Measurement string
+	Key         string
+	Values      uint64
+	Series      uint64
+}
+
+type ShowCardinalityTopResponse struct {
+	Cardinalities []*TagKeyCardinality
+	Err           *string
+}
+
+func (r *ShowCardinalityTopResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendBool(nil, r.Err != nil)
+	if r.Err != nil {
+		buf = codec.AppendString(buf, *r.Err)
+	}
+	buf = codec.AppendUint32(buf, uint32(len(r.Cardinalities)))
+	for _, c := range r.Cardinalities {
+		buf = codec.AppendString(buf, c.Measurement)
+		buf = codec.AppendString(buf, c.Key)
+		buf = codec.AppendUint64(buf, c.Values)
+		buf = codec.AppendUint64(buf, c.Series)
+	}
+	return buf, nil
+}
+
+func (r *ShowCardinalityTopResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	if dec.Bool() {
+		r.Err = proto.String(dec.String())
+	}
+	n := int(dec.Uint32())
+	r.Cardinalities = make([]*TagKeyCardinality, 0, n)
+	for i := 0; i < n; i++ {
+		r.Cardinalities = append(r.Cardinalities, &TagKeyCardinality{
+			Measurement: dec.String(),
+			Key:         dec.String(),
+			Values:      dec.Uint64(),
+			Series:      dec.Uint64(),
+		})
+	}
+	return nil
+}
+
+func (r *ShowCardinalityTopResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
+// ReplicaReadStatusRequest checks whether a partition can serve the follower reads
+type ReplicaReadStatusRequest struct {
+	Db          string
+	PtId        uint32
+	WaitApplied bool
+	Timeout     int64 // nanoseconds
+}
+
+func (r *ReplicaReadStatusRequest) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendString(nil, r.Db)
+	buf = codec.AppendUint32(buf, r.PtId)
+	buf = codec.AppendBool(buf, r.WaitApplied)
+	buf = codec.AppendInt64(buf, r.Timeout)
+	return buf, nil
+}
+
+func (r *ReplicaReadStatusRequest) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	r.Db = dec.String()
+	r.PtId = dec.Uint32()
+	r.WaitApplied = dec.Bool()
+	r.Timeout = dec.Int64()
+	return nil
+}
+
+// ReplicaReadStatusResponse is the applied index of the partition and the commit index of its leader
+type ReplicaReadStatusResponse struct {
+	AppliedIndex   uint64
+	CommittedIndex uint64
+	Err            *string
+}
+
+func (r *ReplicaReadStatusResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendBool(nil, r.Err != nil)
+	if r.Err != nil {
+		buf = codec.AppendString(buf, *r.Err)
+	}
+	buf = codec.AppendUint64(buf, r.AppliedIndex)
+	buf = codec.AppendUint64(buf, r.CommittedIndex)
+	return buf, nil
+}
+
+func (r *ReplicaReadStatusResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	if dec.Bool() {
+		r.Err = proto.String(dec.String())
+	}
+	r.AppliedIndex = dec.Uint64()
+	r.CommittedIndex = dec.Uint64()
+	return nil
+}
+
+func (r *ReplicaReadStatusResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
+// ShardDigestRequest gets the digest of a shard of a replicated partition for the anti-entropy,
+// Interval is the duration of the time ranges of the digest in nanoseconds
+type ShardDigestRequest struct {
+	Db       string
+	PtId     uint32
+	ShardID  uint64
+	Interval int64
+}
+
+func (r *ShardDigestRequest) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendString(nil, r.Db)
+	buf = codec.AppendUint32(buf, r.PtId)
+	buf = codec.AppendUint64(buf, r.ShardID)
+	buf = codec.AppendInt64(buf, r.Interval)
+	return buf, nil
+}
+
+func (r *ShardDigestRequest) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	r.Db = dec.String()
+	r.PtId = dec.Uint32()
+	r.ShardID = dec.Uint64()
+	r.Interval = dec.Int64()
+	return nil
+}
+
+// RangeDigest is the hash of the chunk metadata of the series of a measurement in the time range [StartTime, EndTime],
+// including the time ranges, the numbers of rows and the pre-aggregations of the columns of the series
+type RangeDigest struct {
+	StartTime int64
+	EndTime   int64
+	Series    uint64
+	Rows      uint64
+	Hash      uint64
+}
+
+// MeasurementDigest is a leaf of the digest of a shard, it is the hash of the digests of the time ranges.
+// A measurement is pending if it has out-of-order files, it is not compared until the files are merged.
+type MeasurementDigest struct {
+	Name    string
+	Series  uint64
+	Rows    uint64
+	Hash    uint64
+	Pending bool
+	Ranges  []*RangeDigest
+}
+
+// ShardDigest is the merkle tree of the tssp files of the shard in the time range,
+// the root hash is the hash of the leaves which are sorted by measurement
+type ShardDigest struct {
+	ShardID      uint64
+	StartTime    int64
+	EndTime      int64
+	Hash         uint64
+	Measurements []*MeasurementDigest
+}
+
+type ShardDigestResponse struct {
+	Digest *ShardDigest
+	Err    *string
+}
+
+func (r *ShardDigestResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendBool(nil, r.Err != nil)
+	if r.Err != nil {
+		buf = codec.AppendString(buf, *r.Err)
+	}
+	buf = codec.AppendBool(buf, r.Digest != nil)
+	if r.Digest == nil {
+		return buf, nil
+	}
+	buf = codec.AppendUint64(buf, r.Digest.ShardID)
+	buf = codec.AppendInt64(buf, r.Digest.StartTime)
+	buf = codec.AppendInt64(buf, r.Digest.EndTime)
+	buf = codec.AppendUint64(buf, r.Digest.Hash)
+	buf = codec.AppendUint32(buf, uint32(len(r.Digest.Measurements)))
+	for _, m := range r.Digest.Measurements {
+		buf = codec.AppendString(buf, m.Name)
+		buf = codec.AppendUint64(buf, m.Series)
+		buf = codec.AppendUint64(buf, m.Rows)
+		buf = codec.AppendUint64(buf, m.Hash)
+		buf = codec.AppendBool(buf, m.Pending)
+		buf = codec.AppendUint32(buf, uint32(len(m.Ranges)))
+		for _, rd := range m.Ranges {
+			buf = codec.AppendInt64(buf, rd.StartTime)
+			buf = codec.AppendInt64(buf, rd.EndTime)
+			buf = codec.AppendUint64(buf, rd.Series)
+			buf = codec.AppendUint64(buf, rd.Rows)
+			buf = codec.AppendUint64(buf, rd.Hash)
+		}
+	}
+	return buf, nil
+}
+
+func (r *ShardDigestResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	if dec.Bool() {
+		r.Err = proto.String(dec.String())
+	}
+	if !dec.Bool() {
+		return nil
+	}
+	r.Digest = &ShardDigest{
+		ShardID:   dec.Uint64(),
+		StartTime: dec.Int64(),
+		EndTime:   dec.Int64(),
+		Hash:      dec.Uint64(),
+	}
+	n := int(dec.Uint32())
+	r.Digest.Measurements = make([]*MeasurementDigest, 0, n)
+	for i := 0; i < n; i++ {
+		m := &MeasurementDigest{
+			Name:    dec.String(),
+			Series:  dec.Uint64(),
+			Rows:    dec.Uint64(),
+			Hash:    dec.Uint64(),
+			Pending: dec.Bool(),
+		}
+		ranges := int(dec.Uint32())
+		if ranges > 0 {
+			m.Ranges = make([]*RangeDigest, 0, ranges)
+		}
+		for j := 0; j < ranges; j++ {
+			m.Ranges = append(m.Ranges, &RangeDigest{
+				StartTime: dec.Int64(),
+				EndTime:   dec.Int64(),
+				Series:    dec.Uint64(),
+				Rows:      dec.Uint64(),
+				Hash:      dec.Uint64(),
+			})
+		}
+		r.Digest.Measurements = append(r.Digest.Measurements, m)
+	}
+	return nil
+}
+
+func (r *ShardDigestResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
+// RepairShardRequest writes the rows streamed from the leader into the shard of a diverged replica.
+// The rows are written to the replica locally rather than proposed to the raft group.
+type RepairShardRequest struct {
+	Db      string
+	Rp      string
+	PtId    uint32
+	ShardID uint64
+	Rows    []byte // marshalled by influx.FastMarshalMultiRows
+}
+
+func (r *RepairShardRequest) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendString(nil, r.Db)
+	buf = codec.AppendString(buf, r.Rp)
+	buf = codec.AppendUint32(buf, r.PtId)
+	buf = codec.AppendUint64(buf, r.ShardID)
+	buf = codec.AppendBytes(buf, r.Rows)
+	return buf, nil
+}
+
+func (r *RepairShardRequest) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	r.Db = dec.String()
+	r.Rp = dec.String()
+	r.PtId = dec.Uint32()
+	r.ShardID = dec.Uint64()
+	r.Rows = dec.Bytes()
+	return nil
+}
+
+type RepairShardResponse struct {
+	Rows int64
+	Err  *string
+}
+
+func (r *RepairShardResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendBool(nil, r.Err != nil)
+	if r.Err != nil {
+		buf = codec.AppendString(buf, *r.Err)
+	}
+	buf = codec.AppendInt64(buf, r.Rows)
+	return buf, nil
+}
+
+func (r *RepairShardResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	if dec.Bool() {
+		r.Err = proto.String(dec.String())
+	}
+	r.Rows = dec.Int64()
+	return nil
+}
+
+func (r *RepairShardResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
+type ShowRepairsRequest struct {
+}
+
+func (r *ShowRepairsRequest) MarshalBinary() ([]byte, error) {
+	return nil, nil
+}
+
+func (r *ShowRepairsRequest) UnmarshalBinary(buf []byte) error {
+	return nil
+}
+
+// RepairInfo is a divergence between the replicas of a shard found by the anti-entropy on a store node
+type RepairInfo struct {
+	Db          string
+	PtId        uint32
+	ShardID     uint64
+	Measurement string
+	PeerPtId    uint32 // the replica which diverges from the leader, the rows of the leader are streamed to it
+	Ranges      uint32 // the number of the diverged time ranges
+	State       string
+	Rows        int64
+	DetectTime  int64
+	UpdateTime  int64
+	Error       string
+}
+
+type ShowRepairsResponse struct {
+	Repairs []*RepairInfo
+}
+
+func (r *ShowRepairsResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendUint32(nil, uint32(len(r.Repairs)))
+	for _, info := range r.Repairs {
+		buf = codec.AppendString(buf, info.Db)
+		buf = codec.AppendUint32(buf, info.PtId)
+		buf = codec.AppendUint64(buf, info.ShardID)
+		buf = codec.AppendString(buf, info.Measurement)
+		buf = codec.AppendUint32(buf, info.PeerPtId)
+		buf = codec.AppendUint32(buf, info.Ranges)
+		buf = codec.AppendString(buf, info.State)
+		buf = codec.AppendInt64(buf, info.Rows)
+		buf = codec.AppendInt64(buf, info.DetectTime)
+		buf = codec.AppendInt64(buf, info.UpdateTime)
+		buf = codec.AppendString(buf, info.Error)
+	}
+	return buf, nil
+}
+
+func (r *ShowRepairsResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	n := int(dec.Uint32())
+	r.Repairs = make([]*RepairInfo, 0, n)
+	for i := 0; i < n; i++ {
+		r.Repairs = append(r.Repairs, &RepairInfo{
+			Db:          dec.String(),
+			PtId:        dec.Uint32(),
+			ShardID:     dec.Uint64(),
+			Measurement: dec.String(),
+			PeerPtId:    dec.Uint32(),
+			Ranges:      dec.Uint32(),
+			State:       dec.String(),
+			Rows:        dec.Int64(),
+			DetectTime:  dec.Int64(),
+			UpdateTime:  dec.Int64(),
+			Error:       dec.String(),
+		})
+	}
+	return nil
+}
+
+type PtLoadsRequest struct {
+}
+
+func (r *PtLoadsRequest) MarshalBinary() ([]byte, error) {
+	return nil, nil
+}
+
+func (r *PtLoadsRequest) UnmarshalBinary(buf []byte) error {
+	return nil
+}
+
+// PtLoad is the load of a partition on a store node for the rebalancing,
+// WriteRows and Queries are accumulated since the partition is loaded on the node
+type PtLoad struct {
+	Db        string
+	PtId      uint32
+	DiskBytes uint64
+	Series    uint64
+	WriteRows uint64
+	Queries   uint64
+}
+
+type PtLoadsResponse struct {
+	Loads []*PtLoad
+}
+
+func (r *PtLoadsResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendUint32(nil, uint32(len(r.Loads)))
+	for _, load := range r.Loads {
+		buf = codec.AppendString(buf, load.Db)
+		buf = codec.AppendUint32(buf, load.PtId)
+		buf = codec.AppendUint64(buf, load.DiskBytes)
+		buf = codec.AppendUint64(buf, load.Series)
+		buf = codec.AppendUint64(buf, load.WriteRows)
+		buf = codec.AppendUint64(buf, load.Queries)
+	}
+	return buf, nil
+}
+
+func (r *PtLoadsResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	n := int(dec.Uint32())
+	r.Loads = make([]*PtLoad, 0, n)
+	for i := 0; i < n; i++ {
+		r.Loads = append(r.Loads, &PtLoad{
+			Db:        dec.String(),
+			PtId:      dec.Uint32(),
+			DiskBytes: dec.Uint64(),
+			Series:    dec.Uint64(),
+			WriteRows: dec.Uint64(),
+			Queries:   dec.Uint64(),
+		})
+	}
+	return nil
+}
+
+// PtFilesRequest lists the data and wal files of a partition, which are transferred to the node the partition is moved to
+type PtFilesRequest struct {
+	Db   string
+	PtId uint32
+}
+
+func (r *PtFilesRequest) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendString(nil, r.Db)
+	buf = codec.AppendUint32(buf, r.PtId)
+	return buf, nil
+}
+
+func (r *PtFilesRequest) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	r.Db = dec.String()
+	r.PtId = dec.Uint32()
+	return nil
+}
+
+// PtFileInfo is a file of a partition, Name is the path relative to the data or the wal directory of the partition
+type PtFileInfo struct {
+	Name    string
+	Size    int64
+	ModTime int64
+}
+
+type PtFilesResponse struct {
+	Files []*PtFileInfo
+	Err   *string
+}
+
+func (r *PtFilesResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendBool(nil, r.Err != nil)
+	if r.Err != nil {
+		buf = codec.AppendString(buf, *r.Err)
+	}
+	buf = codec.AppendUint32(buf, uint32(len(r.Files)))
+	for _, f := range r.Files {
+		buf = codec.AppendString(buf, f.Name)
+		buf = codec.AppendInt64(buf, f.Size)
+		buf = codec.AppendInt64(buf, f.ModTime)
+	}
+	return buf, nil
+}
+
+func (r *PtFilesResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	if dec.Bool() {
+		r.Err = proto.String(dec.String())
+	}
+	n := int(dec.Uint32())
+	r.Files = make([]*PtFileInfo, 0, n)
+	for i := 0; i < n; i++ {
+		r.Files = append(r.Files, &PtFileInfo{
+			Name:    dec.String(),
+			Size:    dec.Int64(),
+			ModTime: dec.Int64(),
+		})
+	}
+	return nil
+}
+
+func (r *PtFilesResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
+// ReadPtFileRequest reads a block of a file of a partition
+type ReadPtFileRequest struct {
+	Db     string
+	PtId   uint32
+	Name   string
+	Offset int64
+	Size   int64
+}
+
+func (r *ReadPtFileRequest) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendString(nil, r.Db)
+	buf = codec.AppendUint32(buf, r.PtId)
+	buf = codec.AppendString(buf, r.Name)
+	buf = codec.AppendInt64(buf, r.Offset)
+	buf = codec.AppendInt64(buf, r.Size)
+	return buf, nil
+}
+
+func (r *ReadPtFileRequest) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	r.Db = dec.String()
+	r.PtId = dec.Uint32()
+	r.Name = dec.String()
+	r.Offset = dec.Int64()
+	r.Size = dec.Int64()
+	return nil
+}
+
+type ReadPtFileResponse struct {
+	Data []byte
+	Err  *string
+}
+
+func (r *ReadPtFileResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendBool(nil, r.Err != nil)
+	if r.Err != nil {
+		buf = codec.AppendString(buf, *r.Err)
+	}
+	buf = codec.AppendBytes(buf, r.Data)
+	return buf, nil
+}
+
+func (r *ReadPtFileResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	if dec.Bool() {
+		r.Err = proto.String(dec.String())
+	}
+	r.Data = dec.Bytes()
+	return nil
+}
+
+func (r *ReadPtFileResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
+// DropPtFilesRequest removes the files of a partition which has been moved to another node
+type DropPtFilesRequest struct {
+	Db   string
+	PtId uint32
+}
+
+func (r *DropPtFilesRequest) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendString(nil, r.Db)
+	buf = codec.AppendUint32(buf, r.PtId)
+	return buf, nil
+}
+
+func (r *DropPtFilesRequest) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	r.Db = dec.String()
+	r.PtId = dec.Uint32()
+	return nil
+}
+
+type DropPtFilesResponse struct {
+	Err *string
+}
+
+func (r *DropPtFilesResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendBool(nil, r.Err != nil)
+	if r.Err != nil {
+		buf = codec.AppendString(buf, *r.Err)
+	}
+	return buf, nil
+}
+
+func (r *DropPtFilesResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	if dec.Bool() {
+		r.Err = proto.String(dec.String())
+	}
+	return nil
+}
+
+func (r *DropPtFilesResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
+type ShowRebalanceRequest struct {
+}
+
+func (r *ShowRebalanceRequest) MarshalBinary() ([]byte, error) {
+	return nil, nil
+}
+
+func (r *ShowRebalanceRequest) UnmarshalBinary(buf []byte) error {
+	return nil
+}
+
+// PtTransferInfo is the transfer of the files of a partition from the source node to a store node
+type PtTransferInfo struct {
+	Db         string
+	PtId       uint32
+	SrcNodeId  uint64
+	Phase      string // bulk: copy the files while the partition is written on the source node, final: copy the remaining changes
+	State      string
+	Files      int64
+	TotalFiles int64
+	Bytes      int64
+	TotalBytes int64
+	StartTime  int64
+	UpdateTime int64
+	Error      string
+}
+
+type ShowRebalanceResponse struct {
+	Transfers []*PtTransferInfo
+}
+
+func (r *ShowRebalanceResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendUint32(nil, uint32(len(r.Transfers)))
+	for _, t := range r.Transfers {
+		buf = codec.AppendString(buf, t.Db)
+		buf = codec.AppendUint32(buf, t.PtId)
+		buf = codec.AppendUint64(buf, t.SrcNodeId)
+		buf = codec.AppendString(buf, t.Phase)
+		buf = codec.AppendString(buf, t.State)
+		buf = codec.AppendInt64(buf, t.Files)
+		buf = codec.AppendInt64(buf, t.TotalFiles)
+		buf = codec.AppendInt64(buf, t.Bytes)
+		buf = codec.AppendInt64(buf, t.TotalBytes)
+		buf = codec.AppendInt64(buf, t.StartTime)
+		buf = codec.AppendInt64(buf, t.UpdateTime)
+		buf = codec.AppendString(buf, t.Error)
+	}
+	return buf, nil
+}
+
+func (r *ShowRebalanceResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	n := int(dec.Uint32())
+	r.Transfers = make([]*PtTransferInfo, 0, n)
+	for i := 0; i < n; i++ {
+		r.Transfers = append(r.Transfers, &PtTransferInfo{
+			Db:         dec.String(),
+			PtId:       dec.Uint32(),
+			SrcNodeId:  dec.Uint64(),
+			Phase:      dec.String(),
+			State:      dec.String(),
+			Files:      dec.Int64(),
+			TotalFiles: dec.Int64(),
+			Bytes:      dec.Int64(),
+			TotalBytes: dec.Int64(),
+			StartTime:  dec.Int64(),
+			UpdateTime: dec.Int64(),
+			Error:      dec.String(),
+		})
+	}
+	return nil
+}
+
+// SplitShardRequest moves the rows of the shard to the shards split from it
+type SplitShardRequest struct {
+	Db      string
+	Rp      string
+	PtId    uint32
+	ShardID uint64
+}
+
+func (r *SplitShardRequest) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendString(nil, r.Db)
+	buf = codec.AppendString(buf, r.Rp)
+	buf = codec.AppendUint32(buf, r.PtId)
+	buf = codec.AppendUint64(buf, r.ShardID)
+	return buf, nil
+}
+
+func (r *SplitShardRequest) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	r.Db = dec.String()
+	r.Rp = dec.String()
+	r.PtId = dec.Uint32()
+	r.ShardID = dec.Uint64()
+	return nil
+}
+
+type SplitShardResponse struct {
+	Rows int64
+	Err  *string
+}
+
+func (r *SplitShardResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendBool(nil, r.Err != nil)
+	if r.Err != nil {
+		buf = codec.AppendString(buf, *r.Err)
+	}
+	buf = codec.AppendInt64(buf, r.Rows)
+	return buf, nil
+}
+
+func (r *SplitShardResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	if dec.Bool() {
+		r.Err = proto.String(dec.String())
+	}
+	r.Rows = dec.Int64()
+	return nil
+}
+
+func (r *SplitShardResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
+// WriteSplitRowsRequest writes the rows moved from the shard split to the shard split from it
+type WriteSplitRowsRequest struct {
+	Db      string
+	Rp      string
+	PtId    uint32
+	ShardID uint64
+	Rows    []byte // marshalled by influx.FastMarshalMultiRows
+}
+
+func (r *WriteSplitRowsRequest) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendString(nil, r.Db)
+	buf = codec.AppendString(buf, r.Rp)
+	buf = codec.AppendUint32(buf, r.PtId)
+	buf = codec.AppendUint64(buf, r.ShardID)
+	buf = codec.AppendBytes(buf, r.Rows)
+	return buf, nil
+}
+
+func (r *WriteSplitRowsRequest) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	r.Db = dec.String()
+	r.Rp = dec.String()
+	r.PtId = dec.Uint32()
+	r.ShardID = dec.Uint64()
+	r.Rows = dec.Bytes()
+	return nil
+}
+
+type WriteSplitRowsResponse struct {
+	Rows int64 // the number of the rows written, the rows whose points exist are skipped
+	Err  *string
+}
+
+func (r *WriteSplitRowsResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendBool(nil, r.Err != nil)
+	if r.Err != nil {
+		buf = codec.AppendString(buf, *r.Err)
+	}
+	buf = codec.AppendInt64(buf, r.Rows)
+	return buf, nil
+}
+
+func (r *WriteSplitRowsResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	if dec.Bool() {
+		r.Err = proto.String(dec.String())
+	}
+	r.Rows = dec.Int64()
+	return nil
+}
+
+func (r *WriteSplitRowsResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
+// TailLogsRequest polls the live tail session of a logstream on a store, the session is subscribed on the first poll
+type TailLogsRequest struct {
+	Db         string
+	LogStream  string
+	Session    uint64
+	Condition  string
+	Rate       int64
+	BufferSize int64
+	Wait       int64 // nanoseconds
+	Close      bool
+}
+
+func (r *TailLogsRequest) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendString(nil, r.Db)
+	buf = codec.AppendString(buf, r.LogStream)
+	buf = codec.AppendUint64(buf, r.Session)
+	buf = codec.AppendString(buf, r.Condition)
+	buf = codec.AppendInt64(buf, r.Rate)
+	buf = codec.AppendInt64(buf, r.BufferSize)
+	buf = codec.AppendInt64(buf, r.Wait)
+	buf = codec.AppendBool(buf, r.Close)
+	return buf, nil
+}
+
+func (r *TailLogsRequest) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	r.Db = dec.String()
+	r.LogStream = dec.String()
+	r.Session = dec.Uint64()
+	r.Condition = dec.String()
+	r.Rate = dec.Int64()
+	r.BufferSize = dec.Int64()
+	r.Wait = dec.Int64()
+	r.Close = dec.Bool()
+	return nil
+}
+
+const (
+	tailFieldString uint8 = iota
+	tailFieldInt
+	tailFieldFloat
+	tailFieldBool
+)
+
+type TailLogsResponse struct {
+	Logs  []*tail.Log
+	Stats tail.Stats
+	Err   *string
+}
+
+func (r *TailLogsResponse) MarshalBinary() ([]byte, error) {
+	buf := codec.AppendBool(nil, r.Err != nil)
+	if r.Err != nil {
+		buf = codec.AppendString(buf, *r.Err)
+	}
+	buf = codec.AppendUint64(buf, r.Stats.Matched)
+	buf = codec.AppendUint64(buf, r.Stats.Sent)
+	buf = codec.AppendUint64(buf, r.Stats.Sampled)
+	buf = codec.AppendUint64(buf, r.Stats.Dropped)
+	buf = codec.AppendUint32(buf, uint32(len(r.Logs)))
+	for _, log := range r.Logs {
+		buf = codec.AppendInt64(buf, log.Time)
+		buf = codec.AppendUint32(buf, uint32(len(log.Fields)))
+		for name, v := range log.Fields {
+			buf = codec.AppendString(buf, name)
+			switch val := v.(type) {
+			case string:
+				buf = codec.AppendUint8(buf, tailFieldString)
+				buf = codec.AppendString(buf, val)
+			case int64:
+				buf = codec.AppendUint8(buf, tailFieldInt)
+				buf = codec.AppendInt64(buf, val)
+			case float64:
+				buf = codec.AppendUint8(buf, tailFieldFloat)
+				buf = codec.AppendFloat64(buf, val)
+			case bool:
+				buf = codec.AppendUint8(buf, tailFieldBool)
+				buf = codec.AppendBool(buf, val)
+			default:
+				return nil, fmt.Errorf("unsupported type %T of the log field %s", v, name)
+			}
+		}
+	}
+	return buf, nil
+}
+
+func (r *TailLogsResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	if dec.Bool() {
+		r.Err = proto.String(dec.String())
+	}
+	r.Stats = tail.Stats{
+		Matched: dec.Uint64(),
+		Sent:    dec.Uint64(),
+		Sampled: dec.Uint64(),
+		Dropped: dec.Uint64(),
+	}
+	n := int(dec.Uint32())
+	r.Logs = make([]*tail.Log, 0, n)
+	for i := 0; i < n; i++ {
+		log := &tail.Log{Time: dec.Int64()}
+		fields := int(dec.Uint32())
+		log.Fields = make(map[string]interface{}, fields)
+		for j := 0; j < fields; j++ {
+			name := dec.String()
+			switch typ := dec.Uint8(); typ {
+			case tailFieldString:
+				log.Fields[name] = dec.String()
+			case tailFieldInt:
+				log.Fields[name] = dec.Int64()
+			case tailFieldFloat:
+				log.Fields[name] = dec.Float64()
+			case tailFieldBool:
+				log.Fields[name] = dec.Bool()
+			default:
+				return fmt.Errorf("unknown type %d of the log field %s", typ, name)
+			}
+		}
+		r.Logs = append(r.Logs, log)
+	}
+	return nil
+}
+
+func (r *TailLogsResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
 type QueryExeInfo struct {
 	QueryID   uint64
 	PtID      uint32
@@ -70,6 +70,20 @@ type Storage interface {
 	MigratePt(nodeID uint64, data transport.Codec, cb transport.Callback) error
 
 	GetQueriesOnNode(nodeID uint64) ([]*QueryExeInfo, error)
+	GetCompactionsOnNode(nodeID uint64) ([]*CompactionInfo, error)
+	TagKeysCardinality(nodeID uint64, db string, dbPts []uint32, measurements []string) ([]*TagKeyCardinality, error)
+	ReplicaReadStatus(nodeID uint64, db string, pt uint32, waitApplied bool, timeout time.Duration) (*ReplicaReadStatusResponse, error)
+	ShardDigest(nodeID uint64, db string, pt uint32, shardID uint64, interval int64) (*ShardDigest, error)
+	RepairShard(nodeID uint64, db, rp string, pt uint32, shardID uint64, rows []byte) (int64, error)
+	GetRepairsOnNode(nodeID uint64) ([]*RepairInfo, error)
+	GetPtLoadsOnNode(nodeID uint64) ([]*PtLoad, error)
+	PtFiles(nodeID uint64, db string, pt uint32) ([]*PtFileInfo, error)
+	ReadPtFile(nodeID uint64, db string, pt uint32, name string, offset, size int64) ([]byte, error)
+	DropPtFiles(nodeID uint64, db string, pt uint32) error
+	GetRebalanceOnNode(nodeID uint64) ([]*PtTransferInfo, error)
+	SplitShard(nodeID uint64, db, rp string, pt uint32, shardID uint64) (int64, error)
+	WriteSplitRows(nodeID uint64, db, rp string, pt uint32, shardID uint64, rows []byte) (int64, error)
+	TailLogs(nodeID uint64, req *TailLogsRequest) (*TailLogsResponse, error)
 	KillQueryOnNode(nodeID, queryID uint64) error
 	SendSegregateNodeCmds(nodeIDs []uint64, address []string) (int, error)
 
@@ -506,6 +520,193 @@ func (s *NetStorage) GetQueriesOnNode(nodeID uint64) ([]*QueryExeInfo, error) {
 	return resp.QueryExeInfos, nil
 }
 
+func (s *NetStorage) GetCompactionsOnNode(nodeID uint64) ([]*CompactionInfo, error) {
+	v, err := s.ddlRequestWithNodeId(nodeID, ShowCompactionsRequestMessage, &ShowCompactionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := v.(*ShowCompactionsResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.ShowCompactionsResponse", v)
+	}
+	return resp.Compactions, nil
+}
+
+func (s *NetStorage) TagKeysCardinality(nodeID uint64, db string, dbPts []uint32, measurements []string) ([]*TagKeyCardinality, error) {
+	req := &ShowCardinalityTopRequest{}
+	req.Db = proto.String(db)
+	req.PtIDs = dbPts
+	req.Measurements = measurements
+
+	v, err := s.ddlRequestWithNodeId(nodeID, ShowCardinalityTopRequestMessage, req)
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := v.(*ShowCardinalityTopResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.ShowCardinalityTopResponse", v)
+	}
+	return resp.Cardinalities, resp.Error()
+}
+
+func (s *NetStorage) ReplicaReadStatus(nodeID uint64, db string, pt uint32, waitApplied bool, timeout time.Duration) (*ReplicaReadStatusResponse, error) {
+	req := &ReplicaReadStatusRequest{
+		Db:          db,
+		PtId:        pt,
+		WaitApplied: waitApplied,
+		Timeout:     int64(timeout),
+	}
+
+	v, err := s.ddlRequestWithNodeId(nodeID, ReplicaReadStatusRequestMessage, req)
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := v.(*ReplicaReadStatusResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.ReplicaReadStatusResponse", v)
+	}
+	return resp, resp.Error()
+}
+
+func (s *NetStorage) ShardDigest(nodeID uint64, db string, pt uint32, shardID uint64, interval int64) (*ShardDigest, error) {
+	req := &ShardDigestRequest{Db: db, PtId: pt, ShardID: shardID, Interval: interval}
+	v, err := s.ddlRequestWithNodeId(nodeID, ShardDigestRequestMessage, req)
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := v.(*ShardDigestResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.ShardDigestResponse", v)
+	}
+	return resp.Digest, resp.Error()
+}
+
+func (s *NetStorage) RepairShard(nodeID uint64, db, rp string, pt uint32, shardID uint64, rows []byte) (int64, error) {
+	req := &RepairShardRequest{Db: db, Rp: rp, PtId: pt, ShardID: shardID, Rows: rows}
+	v, err := s.ddlRequestWithNodeId(nodeID, RepairShardRequestMessage, req)
+	if err != nil {
+		return 0, err
+	}
+	resp, ok := v.(*RepairShardResponse)
+	if !ok {
+		return 0, executor.NewInvalidTypeError("*netstorage.RepairShardResponse", v)
+	}
+	return resp.Rows, resp.Error()
+}
+
+func (s *NetStorage) GetRepairsOnNode(nodeID uint64) ([]*RepairInfo, error) {
+	v, err := s.ddlRequestWithNodeId(nodeID, ShowRepairsRequestMessage, &ShowRepairsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := v.(*ShowRepairsResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.ShowRepairsResponse", v)
+	}
+	return resp.Repairs, nil
+}
+
+func (s *NetStorage) GetPtLoadsOnNode(nodeID uint64) ([]*PtLoad, error) {
+	v, err := s.ddlRequestWithNodeId(nodeID, PtLoadsRequestMessage, &PtLoadsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := v.(*PtLoadsResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.PtLoadsResponse", v)
+	}
+	return resp.Loads, nil
+}
+
+func (s *NetStorage) PtFiles(nodeID uint64, db string, pt uint32) ([]*PtFileInfo, error) {
+	req := &PtFilesRequest{Db: db, PtId: pt}
+	v, err := s.ddlRequestWithNodeId(nodeID, PtFilesRequestMessage, req)
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := v.(*PtFilesResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.PtFilesResponse", v)
+	}
+	return resp.Files, resp.Error()
+}
+
+func (s *NetStorage) ReadPtFile(nodeID uint64, db string, pt uint32, name string, offset, size int64) ([]byte, error) {
+	req := &ReadPtFileRequest{Db: db, PtId: pt, Name: name, Offset: offset, Size: size}
+	v, err := s.ddlRequestWithNodeId(nodeID, ReadPtFileRequestMessage, req)
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := v.(*ReadPtFileResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.ReadPtFileResponse", v)
+	}
+	return resp.Data, resp.Error()
+}
+
+func (s *NetStorage) DropPtFiles(nodeID uint64, db string, pt uint32) error {
+	req := &DropPtFilesRequest{Db: db, PtId: pt}
+	v, err := s.ddlRequestWithNodeId(nodeID, DropPtFilesRequestMessage, req)
+	if err != nil {
+		return err
+	}
+	resp, ok := v.(*DropPtFilesResponse)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.DropPtFilesResponse", v)
+	}
+	return resp.Error()
+}
+
+func (s *NetStorage) GetRebalanceOnNode(nodeID uint64) ([]*PtTransferInfo, error) {
+	v, err := s.ddlRequestWithNodeId(nodeID, ShowRebalanceRequestMessage, &ShowRebalanceRequest{})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := v.(*ShowRebalanceResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.ShowRebalanceResponse", v)
+	}
+	return resp.Transfers, nil
+}
+
+func (s *NetStorage) SplitShard(nodeID uint64, db, rp string, pt uint32, shardID uint64) (int64, error) {
+	req := &SplitShardRequest{Db: db, Rp: rp, PtId: pt, ShardID: shardID}
+	v, err := s.ddlRequestWithNodeId(nodeID, SplitShardRequestMessage, req)
+	if err != nil {
+		return 0, err
+	}
+	resp, ok := v.(*SplitShardResponse)
+	if !ok {
+		return 0, executor.NewInvalidTypeError("*netstorage.SplitShardResponse", v)
+	}
+	return resp.Rows, resp.Error()
+}
+
+func (s *NetStorage) WriteSplitRows(nodeID uint64, db, rp string, pt uint32, shardID uint64, rows []byte) (int64, error) {
+	req := &WriteSplitRowsRequest{Db: db, Rp: rp, PtId: pt, ShardID: shardID, Rows: rows}
+	v, err := s.ddlRequestWithNodeId(nodeID, WriteSplitRowsRequestMessage, req)
+	if err != nil {
+		return 0, err
+	}
+	resp, ok := v.(*WriteSplitRowsResponse)
+	if !ok {
+		return 0, executor.NewInvalidTypeError("*netstorage.WriteSplitRowsResponse", v)
+	}
+	return resp.Rows, resp.Error()
+}
+
+func (s *NetStorage) TailLogs(nodeID uint64, req *TailLogsRequest) (*TailLogsResponse, error) {
+	v, err := s.ddlRequestWithNodeId(nodeID, TailLogsRequestMessage, req)
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := v.(*TailLogsResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.TailLogsResponse", v)
+	}
+	return resp, resp.Error()
+}
+
 func (s *NetStorage) KillQueryOnNode(nodeID, queryID uint64) error {
 	req := &KillQueryRequest{}
 	req.QueryID = proto.Uint64(queryID)
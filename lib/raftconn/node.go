@@ -76,6 +76,12 @@ type RaftNode struct {
 	cancelFn context.CancelFunc
 
 	appliedIndex uint64
+	// applied is the index of the last entry which is written to the storage, it is used by the follower reads
+	applied atomic.Uint64
+
+	readIndexMu  sync.Mutex
+	readIndexSeq uint64
+	readIndexC   map[uint64]chan uint64 // key is the sequence of the read index request
 
 	// lock(RWMutex) is for fields which can be changed after init.
 	lock      sync.RWMutex
@@ -224,6 +230,7 @@ func (n *RaftNode) InitAndStartNode() error {
 			panic("Unable to get existing hardState")
 		}
 		n.appliedIndex = state.Commit
+		n.applied.Store(state.Commit)
 		sp, err := n.Store.Snapshot()
 		n.SnapShotter.CommittedIndex = sp.Metadata.Index
 		if err != nil {
@@ -333,6 +340,8 @@ func (n *RaftNode) serveChannels() {
 				return
 			}
 			n.waitWriteOK(applyDoneC)
+			n.applied.Store(n.appliedIndex)
+			n.notifyReadStates(rd.ReadStates)
 			n.logger.Debug("publish entries successful", zap.Duration("time used", time.Since(start)))
 			start = time.Now()
 
@@ -0,0 +1,111 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftconn
+
+import (
+	"context"
+	"time"
+
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
+	"go.etcd.io/etcd/raft/v3"
+)
+
+const waitAppliedInterval = 5 * time.Millisecond
+
+// AppliedIndex returns the index of the last entry which is written to the storage.
+func (n *RaftNode) AppliedIndex() uint64 {
+	return n.applied.Load()
+}
+
+// ReadIndex returns the commit index of the leader when the leader receives the request.
+// All the entries up to the index should be applied before a linearizable read is served by this node.
+func (n *RaftNode) ReadIndex(ctx context.Context) (uint64, error) {
+	seq, c := n.addReadIndexC()
+	defer n.removeReadIndexC(seq)
+
+	// the request is dropped by raft if there is no leader, so it waits until the context is done
+	if err := n.node.ReadIndex(ctx, encoding.MarshalUint64(nil, seq)); err != nil {
+		return 0, err
+	}
+	select {
+	case index := <-c:
+		return index, nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-n.ctx.Done():
+		return 0, n.ctx.Err()
+	}
+}
+
+// WaitApplied waits until all the entries up to the index are written to the storage.
+func (n *RaftNode) WaitApplied(ctx context.Context, index uint64) error {
+	if n.AppliedIndex() >= index {
+		return nil
+	}
+	ticker := time.NewTicker(waitAppliedInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if n.AppliedIndex() >= index {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-n.ctx.Done():
+			return n.ctx.Err()
+		}
+	}
+}
+
+func (n *RaftNode) addReadIndexC() (uint64, chan uint64) {
+	n.readIndexMu.Lock()
+	defer n.readIndexMu.Unlock()
+	if n.readIndexC == nil {
+		n.readIndexC = make(map[uint64]chan uint64)
+	}
+	n.readIndexSeq++
+	c := make(chan uint64, 1)
+	n.readIndexC[n.readIndexSeq] = c
+	return n.readIndexSeq, c
+}
+
+func (n *RaftNode) removeReadIndexC(seq uint64) {
+	n.readIndexMu.Lock()
+	delete(n.readIndexC, seq)
+	n.readIndexMu.Unlock()
+}
+
+func (n *RaftNode) notifyReadStates(states []raft.ReadState) {
+	if len(states) == 0 {
+		return
+	}
+	n.readIndexMu.Lock()
+	defer n.readIndexMu.Unlock()
+	for i := range states {
+		if len(states[i].RequestCtx) != 8 {
+			continue
+		}
+		c, ok := n.readIndexC[encoding.UnmarshalUint64(states[i].RequestCtx)]
+		if !ok {
+			// the request has timed out
+			continue
+		}
+		select {
+		case c <- states[i].Index:
+		default:
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftconn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/raft/v3"
+)
+
+type mockRaftNodeReadIndex struct {
+	raft.Node
+	n       *RaftNode
+	index   uint64
+	dropped bool
+}
+
+func (m *mockRaftNodeReadIndex) ReadIndex(ctx context.Context, rctx []byte) error {
+	if !m.dropped {
+		go m.n.notifyReadStates([]raft.ReadState{{Index: m.index, RequestCtx: rctx}})
+	}
+	return nil
+}
+
+func TestReadIndex(t *testing.T) {
+	n := &RaftNode{ctx: context.Background()}
+	mock := &mockRaftNodeReadIndex{n: n, index: 10}
+	n.node = mock
+
+	index, err := n.ReadIndex(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), index)
+	require.Equal(t, 0, len(n.readIndexC))
+
+	// no leader
+	mock.dropped = true
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = n.ReadIndex(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWaitApplied(t *testing.T) {
+	n := &RaftNode{ctx: context.Background()}
+	n.applied.Store(5)
+	require.NoError(t, n.WaitApplied(context.Background(), 5))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, n.WaitApplied(ctx, 6), context.DeadlineExceeded)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		n.applied.Store(8)
+	}()
+	require.NoError(t, n.WaitApplied(context.Background(), 8))
+	require.Equal(t, uint64(8), n.AppliedIndex())
+}
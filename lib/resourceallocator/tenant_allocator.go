@@ -0,0 +1,109 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourceallocator
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"golang.org/x/time/rate"
+)
+
+// TenantAllocator limits the ingest rate and the concurrent queries of each tenant on the ts-sql node.
+// The quotas are passed in on each allocation, so the changes of the quotas take effect immediately.
+type TenantAllocator struct {
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+	queries  map[string]int64
+}
+
+var tenantAllocator = NewTenantAllocator()
+
+func NewTenantAllocator() *TenantAllocator {
+	return &TenantAllocator{
+		limiters: make(map[string]*rate.Limiter),
+		queries:  make(map[string]int64),
+	}
+}
+
+// AllocWrite takes the rows written by the tenant from the ingest rate limit of maxRate rows per second,
+// the rate is unlimited if maxRate is not positive. A batch larger than the burst of one second is rejected,
+// it has to be split by the client.
+func (a *TenantAllocator) AllocWrite(tenant string, maxRate, rows int64) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if maxRate <= 0 {
+		delete(a.limiters, tenant)
+		return nil
+	}
+	if rows > maxRate {
+		return errno.NewError(errno.TenantWriteBatchTooLarge, rows, tenant, maxRate)
+	}
+
+	limiter, ok := a.limiters[tenant]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(maxRate), int(maxRate))
+		a.limiters[tenant] = limiter
+	} else if limiter.Burst() != int(maxRate) {
+		now := time.Now()
+		limiter.SetLimitAt(now, rate.Limit(maxRate))
+		limiter.SetBurstAt(now, int(maxRate))
+	}
+	if !limiter.AllowN(time.Now(), int(rows)) {
+		return errno.NewError(errno.TenantIngestRateExceeded, tenant, maxRate)
+	}
+	return nil
+}
+
+// AllocQuery takes a query slot of the tenant, at most maxConcurrency queries of the tenant run at the same time
+// if maxConcurrency is positive. The slot is returned by FreeQuery after the query is done.
+func (a *TenantAllocator) AllocQuery(tenant string, maxConcurrency int64) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if maxConcurrency > 0 && a.queries[tenant] >= maxConcurrency {
+		return errno.NewError(errno.TenantQueryConcurrencyExceeded, tenant, maxConcurrency)
+	}
+	a.queries[tenant]++
+	return nil
+}
+
+func (a *TenantAllocator) FreeQuery(tenant string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.queries[tenant] <= 1 {
+		delete(a.queries, tenant)
+		return
+	}
+	a.queries[tenant]--
+}
+
+func AllocTenantWrite(tenant string, maxRate, rows int64) error {
+	err := tenantAllocator.AllocWrite(tenant, maxRate, rows)
+	statistics.TenantStat.AddWrite(tenant, rows, err != nil)
+	return err
+}
+
+func AllocTenantQuery(tenant string, maxConcurrency int64) error {
+	err := tenantAllocator.AllocQuery(tenant, maxConcurrency)
+	statistics.TenantStat.AddQuery(tenant, err != nil)
+	return err
+}
+
+func FreeTenantQuery(tenant string) {
+	tenantAllocator.FreeQuery(tenant)
+	statistics.TenantStat.QueryDone(tenant)
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourceallocator
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantAllocator_AllocWrite(t *testing.T) {
+	a := NewTenantAllocator()
+	require.NoError(t, a.AllocWrite("t1", 0, 1000000))
+
+	// the batch larger than the burst is rejected without taking the rate
+	err := a.AllocWrite("t1", 100, 1000)
+	assert.True(t, errno.Equal(err, errno.TenantWriteBatchTooLarge))
+	require.NoError(t, a.AllocWrite("t1", 100, 100))
+	err = a.AllocWrite("t1", 100, 10)
+	assert.True(t, errno.Equal(err, errno.TenantIngestRateExceeded))
+	require.NoError(t, a.AllocWrite("t2", 100, 10))
+
+	// the limit is raised with the quota
+	_ = a.AllocWrite("t1", 1000000, 10)
+	assert.Equal(t, 1000000, a.limiters["t1"].Burst())
+	require.NoError(t, a.AllocWrite("t1", 0, 10))
+	assert.Equal(t, 1, len(a.limiters))
+}
+
+func TestTenantAllocator_AllocQuery(t *testing.T) {
+	statistics.InitTenantStatistics(nil)
+	require.NoError(t, AllocTenantQuery("t1", 2))
+	require.NoError(t, AllocTenantQuery("t1", 2))
+	err := AllocTenantQuery("t1", 2)
+	assert.True(t, errno.Equal(err, errno.TenantQueryConcurrencyExceeded))
+	require.NoError(t, AllocTenantQuery("t2", 0))
+
+	FreeTenantQuery("t1")
+	require.NoError(t, AllocTenantQuery("t1", 2))
+	FreeTenantQuery("t1")
+	FreeTenantQuery("t1")
+	FreeTenantQuery("t2")
+	assert.Equal(t, 0, len(tenantAllocator.queries))
+
+	stat, ok := statistics.TenantStat.Get("t1")
+	require.True(t, ok)
+	assert.Equal(t, int64(3), stat.Queries)
+	assert.Equal(t, int64(1), stat.QueryRejected)
+	assert.Equal(t, int64(0), stat.ActiveQueries)
+}
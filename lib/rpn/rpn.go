@@ -33,7 +33,8 @@ const (
 	MATCHPHRASE
 	AlwaysTrue
 	AlwaysFalse
-	UNKNOWN // unsupported type value.
+	UNKNOWN   // unsupported type value.
+	InPattern // LIKE and regex matches, only evaluated by the readers implementing SKPatternReader.
 )
 
 type RPNExpr struct {
@@ -68,7 +69,7 @@ func ConvertToRPNExpr(expr influxql.Expr) *RPNExpr {
 		rpnExpr.Val = append(rpnExpr.Val, innerExpr.Val...)
 	case *influxql.VarRef:
 		rpnExpr.Val = append(rpnExpr.Val, expr)
-	case *influxql.StringLiteral, *influxql.IntegerLiteral, *influxql.NumberLiteral, *influxql.BooleanLiteral:
+	case *influxql.StringLiteral, *influxql.IntegerLiteral, *influxql.NumberLiteral, *influxql.BooleanLiteral, *influxql.RegexLiteral:
 		rpnExpr.Val = append(rpnExpr.Val, expr)
 	default:
 	}
@@ -87,3 +88,15 @@ type SKBaseReader interface {
 	IsExist(blockId int64, elem *SKRPNElement) (bool, error)
 	StartSpan(span *tracing.Span)
 }
+
+// SKPatternReader is implemented by the readers which can evaluate the InPattern elements,
+// the other readers always keep the blocks for them.
+type SKPatternReader interface {
+	SKBaseReader
+	IsPatternExist(blockId int64, elem *SKRPNElement) (bool, error)
+}
+
+// IsPatternOp reports whether the operator matches a pattern instead of a value.
+func IsPatternOp(op influxql.Token) bool {
+	return op == influxql.LIKE || op == influxql.EQREGEX || op == influxql.NEQREGEX
+}
@@ -25,6 +25,11 @@ const (
 	FileStatisticsName      = "filestat"
 	levelFileStatisticsName = "filestat_level"
 
+	StatPendingPlans          = "PendingPlans"
+	StatPendingFiles          = "PendingFiles"
+	StatPendingSize           = "PendingSize"
+	CompactDebtStatisticsName = "compact_debt"
+
 	fileStatisticsReportRate     = 6
 	fileStatisticsReportInterval = time.Minute * 5
 )
@@ -96,6 +101,16 @@ func (s *FileStatistics) CollectLevel(buffer []byte, originTags map[string]strin
 	return buffer, nil
 }
 
+// CollectCompactDebt reports the compaction plans, files and bytes a shard has not compacted yet
+func (s *FileStatistics) CollectCompactDebt(buffer []byte, originTags map[string]string, plans, files, size int64) []byte {
+	valueMap := map[string]interface{}{
+		StatPendingPlans: plans,
+		StatPendingFiles: files,
+		StatPendingSize:  size,
+	}
+	return AddPointToBuffer(CompactDebtStatisticsName, s.merge(originTags, FileTagMap), valueMap, buffer)
+}
+
 func (s *FileStatistics) merge(originTags map[string]string, newTags map[string]string) map[string]string {
 	// Add everything in tags to the result.
 	out := make(map[string]string, len(newTags))
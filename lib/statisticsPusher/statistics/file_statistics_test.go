@@ -69,3 +69,20 @@ func TestFileStat(t *testing.T) {
 		return
 	}
 }
+
+func TestFileStatistics_CollectCompactDebt(t *testing.T) {
+	statistics.InitFileStatistics(map[string]string{"hostname": "127.0.0.1"})
+	buf := statistics.NewFileStatistics().CollectCompactDebt(nil, map[string]string{"database": "db0", "id": "1"}, 2, 9, 1024)
+
+	fields := map[string]interface{}{
+		"PendingPlans": int64(2),
+		"PendingFiles": int64(9),
+		"PendingSize":  int64(1024),
+	}
+	tags := map[string]string{
+		"hostname": "127.0.0.1",
+		"database": "db0",
+		"id":       "1",
+	}
+	assert.NoError(t, compareBuffer("compact_debt", tags, fields, bytes.TrimRight(buf, "\n")))
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"sync"
+)
+
+// ReplicationStats is the progress of shipping the wal of a primary database to the target cluster
+type ReplicationStats struct {
+	PendingSegments int64
+	PendingBytes    int64
+	LagSeconds      int64 // the age of the oldest wal segment not yet shipped
+	ShippedSegments int64
+	ShippedRows     int64
+	Errors          int64
+	DroppedSegments int64 // the wal segments dropped without being shipped by the limits of the archive
+	DroppedBytes    int64
+	SkippedRecords  int64 // the wal records written by arrow flight, which are not shipped
+}
+
+// ReplicationStatistics keeps statistics related to the asynchronous replication of the databases
+type ReplicationStatistics struct {
+	mu    sync.RWMutex
+	stats map[string]*ReplicationStats
+}
+
+const (
+	StatReplicationDatabase        = "database"
+	StatReplicationPendingSegments = "pendingSegments"
+	StatReplicationPendingBytes    = "pendingBytes"
+	StatReplicationLagSeconds      = "lagSeconds"
+	StatReplicationShippedSegments = "shippedSegments"
+	StatReplicationShippedRows     = "shippedRows"
+	StatReplicationErrors          = "errors"
+	StatReplicationDroppedSegments = "droppedSegments"
+	StatReplicationDroppedBytes    = "droppedBytes"
+	StatReplicationSkippedRecords  = "skippedRecords"
+)
+
+var ReplicationStat = NewReplicationStatistics()
+var ReplicationTagMap map[string]string
+var ReplicationStatisticsName = "replication"
+
+func NewReplicationStatistics() *ReplicationStatistics {
+	return &ReplicationStatistics{
+		stats: make(map[string]*ReplicationStats),
+	}
+}
+
+func InitReplicationStatistics(tags map[string]string) {
+	ReplicationStat = NewReplicationStatistics()
+	ReplicationTagMap = tags
+}
+
+func (rs *ReplicationStatistics) get(database string) *ReplicationStats {
+	stat, ok := rs.stats[database]
+	if !ok {
+		stat = &ReplicationStats{}
+		rs.stats[database] = stat
+	}
+	return stat
+}
+
+// SetPending sets the wal segments of the database waiting to be shipped
+func (rs *ReplicationStatistics) SetPending(database string, segments, bytes, lagSeconds int64) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	stat := rs.get(database)
+	stat.PendingSegments = segments
+	stat.PendingBytes = bytes
+	stat.LagSeconds = lagSeconds
+}
+
+func (rs *ReplicationStatistics) AddShipped(database string, segments, rows int64) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	stat := rs.get(database)
+	stat.ShippedSegments += segments
+	stat.ShippedRows += rows
+}
+
+func (rs *ReplicationStatistics) AddDropped(database string, segments, bytes int64) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	stat := rs.get(database)
+	stat.DroppedSegments += segments
+	stat.DroppedBytes += bytes
+}
+
+func (rs *ReplicationStatistics) AddSkipped(database string, records int64) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.get(database).SkippedRecords += records
+}
+
+func (rs *ReplicationStatistics) AddError(database string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.get(database).Errors++
+}
+
+// Remove removes the statistics of the database whose replication has been dropped
+func (rs *ReplicationStatistics) Remove(database string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	delete(rs.stats, database)
+}
+
+func (rs *ReplicationStatistics) Get(database string) (ReplicationStats, bool) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	stat, ok := rs.stats[database]
+	if !ok {
+		return ReplicationStats{}, false
+	}
+	return *stat, true
+}
+
+func CollectReplicationStatistics(buffer []byte) ([]byte, error) {
+	ReplicationStat.mu.RLock()
+	defer ReplicationStat.mu.RUnlock()
+	for dbName, stats := range ReplicationStat.stats {
+		tagMap := make(map[string]string)
+		AllocTagMap(tagMap, ReplicationTagMap)
+		tagMap[StatReplicationDatabase] = dbName
+		valueMap := map[string]interface{}{
+			StatReplicationPendingSegments: stats.PendingSegments,
+			StatReplicationPendingBytes:    stats.PendingBytes,
+			StatReplicationLagSeconds:      stats.LagSeconds,
+			StatReplicationShippedSegments: stats.ShippedSegments,
+			StatReplicationShippedRows:     stats.ShippedRows,
+			StatReplicationErrors:          stats.Errors,
+			StatReplicationDroppedSegments: stats.DroppedSegments,
+			StatReplicationDroppedBytes:    stats.DroppedBytes,
+			StatReplicationSkippedRecords:  stats.SkippedRecords,
+		}
+
+		buffer = AddPointToBuffer(ReplicationStatisticsName, tagMap, valueMap, buffer)
+	}
+
+	return buffer, nil
+}
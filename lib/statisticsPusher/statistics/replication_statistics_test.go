@@ -0,0 +1,60 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+)
+
+func TestReplicationStatistics(t *testing.T) {
+	tags := map[string]string{
+		"hostname": "127.0.0.1:8400",
+		"app":      "ts-store",
+		"database": "db0",
+	}
+	statistics.InitReplicationStatistics(tags)
+	stat := statistics.ReplicationStat
+	stat.SetPending("db0", 3, 1024, 60)
+	stat.AddShipped("db0", 2, 100)
+	stat.AddShipped("db0", 1, 50)
+	stat.AddError("db0")
+	stat.AddDropped("db0", 2, 512)
+	stat.AddSkipped("db0", 4)
+	statistics.NewTimestamp().Init(time.Second)
+	buf, _ := statistics.CollectReplicationStatistics(nil)
+
+	fields := map[string]interface{}{
+		"pendingSegments": int64(3),
+		"pendingBytes":    int64(1024),
+		"lagSeconds":      int64(60),
+		"shippedSegments": int64(3),
+		"shippedRows":     int64(150),
+		"errors":          int64(1),
+		"droppedSegments": int64(2),
+		"droppedBytes":    int64(512),
+		"skippedRecords":  int64(4),
+	}
+	if err := compareBuffer("replication", tags, fields, buf); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	stat.Remove("db0")
+	if _, ok := stat.Get("db0"); ok {
+		t.Fatalf("the statistics of db0 are not removed")
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"sync"
+)
+
+// TenantStats is the resource usage of a tenant on the ts-sql node
+type TenantStats struct {
+	WriteRows     int64
+	WriteRejected int64
+	Queries       int64
+	QueryRejected int64
+	ActiveQueries int64
+	Series        int64 // the series of the tenant in the cluster, recorded by ts-meta
+	StorageBytes  int64 // the storage bytes of the tenant in the cluster, recorded by ts-meta
+}
+
+// TenantStatistics keeps statistics related to the tenants
+type TenantStatistics struct {
+	mu    sync.RWMutex
+	stats map[string]*TenantStats
+}
+
+const (
+	StatTenantName          = "tenant"
+	StatTenantWriteRows     = "writeRows"
+	StatTenantWriteRejected = "writeRejected"
+	StatTenantQueries       = "queries"
+	StatTenantQueryRejected = "queryRejected"
+	StatTenantActiveQueries = "activeQueries"
+	StatTenantSeries        = "series"
+	StatTenantStorageBytes  = "storageBytes"
+)
+
+var TenantStat = NewTenantStatistics()
+var TenantTagMap map[string]string
+var TenantStatisticsName = "tenant"
+
+func NewTenantStatistics() *TenantStatistics {
+	return &TenantStatistics{
+		stats: make(map[string]*TenantStats),
+	}
+}
+
+func InitTenantStatistics(tags map[string]string) {
+	TenantStat = NewTenantStatistics()
+	TenantTagMap = tags
+}
+
+func (ts *TenantStatistics) get(tenant string) *TenantStats {
+	stat, ok := ts.stats[tenant]
+	if !ok {
+		stat = &TenantStats{}
+		ts.stats[tenant] = stat
+	}
+	return stat
+}
+
+// AddWrite records the rows written by the tenant, or rejected by the quotas of the tenant
+func (ts *TenantStatistics) AddWrite(tenant string, rows int64, rejected bool) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	stat := ts.get(tenant)
+	if rejected {
+		stat.WriteRejected += rows
+		return
+	}
+	stat.WriteRows += rows
+}
+
+// AddQuery records a query of the tenant started, or rejected by the quotas of the tenant
+func (ts *TenantStatistics) AddQuery(tenant string, rejected bool) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	stat := ts.get(tenant)
+	if rejected {
+		stat.QueryRejected++
+		return
+	}
+	stat.Queries++
+	stat.ActiveQueries++
+}
+
+func (ts *TenantStatistics) QueryDone(tenant string) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.get(tenant).ActiveQueries--
+}
+
+// SetUsage sets the series and the storage bytes of the tenant recorded in the meta data
+func (ts *TenantStatistics) SetUsage(tenant string, series, storageBytes int64) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	stat := ts.get(tenant)
+	stat.Series = series
+	stat.StorageBytes = storageBytes
+}
+
+func (ts *TenantStatistics) Get(tenant string) (TenantStats, bool) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	stat, ok := ts.stats[tenant]
+	if !ok {
+		return TenantStats{}, false
+	}
+	return *stat, true
+}
+
+func CollectTenantStatistics(buffer []byte) ([]byte, error) {
+	TenantStat.mu.RLock()
+	defer TenantStat.mu.RUnlock()
+	for name, stats := range TenantStat.stats {
+		tagMap := make(map[string]string)
+		AllocTagMap(tagMap, TenantTagMap)
+		tagMap[StatTenantName] = name
+		valueMap := map[string]interface{}{
+			StatTenantWriteRows:     stats.WriteRows,
+			StatTenantWriteRejected: stats.WriteRejected,
+			StatTenantQueries:       stats.Queries,
+			StatTenantQueryRejected: stats.QueryRejected,
+			StatTenantActiveQueries: stats.ActiveQueries,
+			StatTenantSeries:        stats.Series,
+			StatTenantStorageBytes:  stats.StorageBytes,
+		}
+
+		buffer = AddPointToBuffer(TenantStatisticsName, tagMap, valueMap, buffer)
+	}
+
+	return buffer, nil
+}
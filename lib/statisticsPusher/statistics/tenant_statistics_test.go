@@ -0,0 +1,58 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+)
+
+func TestTenantStatistics(t *testing.T) {
+	tags := map[string]string{
+		"hostname": "127.0.0.1:8086",
+		"app":      "ts-sql",
+		"tenant":   "t1",
+	}
+	statistics.InitTenantStatistics(tags)
+	stat := statistics.TenantStat
+	stat.AddWrite("t1", 100, false)
+	stat.AddWrite("t1", 10, true)
+	stat.AddQuery("t1", false)
+	stat.AddQuery("t1", false)
+	stat.AddQuery("t1", true)
+	stat.QueryDone("t1")
+	stat.SetUsage("t1", 1000, 4096)
+	statistics.NewTimestamp().Init(time.Second)
+	buf, _ := statistics.CollectTenantStatistics(nil)
+
+	fields := map[string]interface{}{
+		"writeRows":     int64(100),
+		"writeRejected": int64(10),
+		"queries":       int64(2),
+		"queryRejected": int64(1),
+		"activeQueries": int64(1),
+		"series":        int64(1000),
+		"storageBytes":  int64(4096),
+	}
+	if err := compareBuffer("tenant", tags, fields, buf); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if _, ok := stat.Get("t2"); ok {
+		t.Fatalf("unexpected statistics of t2")
+	}
+}
@@ -111,6 +111,7 @@ const (
 	ParallelQuery      = "parallelbatch"
 	Backup             = "backup"
 	AbortBackup        = "abort_backup"
+	VerifyBackup       = "verify_backup"
 )
 
 var (
@@ -367,7 +368,7 @@ func ProcessRequest(req netstorage.SysCtrlRequest, resp *strings.Builder) (err e
 		for n, s := range metaRes {
 			resp.WriteString(fmt.Sprintf("\n\t%v: %s,", n, s))
 		}
-	case DataFlush, compactionEn, compmerge, snapshot, DownSampleInOrder, verifyNode, memUsageLimit, BackgroundReadLimiter, AbortBackup:
+	case DataFlush, compactionEn, compmerge, snapshot, DownSampleInOrder, verifyNode, memUsageLimit, BackgroundReadLimiter, AbortBackup, VerifyBackup:
 		// store SysCtrl cmd
 		dataNodes, err := SysCtrl.MetaClient.DataNodes()
 		if err != nil {
@@ -0,0 +1,355 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tokenizer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"go.uber.org/zap"
+)
+
+const (
+	// StandardAnalyzer keeps the split character gram tokenizer, the index files are the same as before.
+	StandardAnalyzer = "standard"
+
+	analyzerSeparator      = ","
+	analyzerFieldSeparator = ";"
+	analyzerFieldAssign    = "="
+)
+
+// CharFilterFunc rewrites the raw value before it is tokenized, the result is appended to dst.
+type CharFilterFunc func(dst, src []byte) []byte
+
+// TokenizeFunc splits the value into tokens, splitTable is built from the split characters of the logstream.
+type TokenizeFunc func(src []byte, splitTable []byte, emit func(token []byte))
+
+// TokenFilterFunc rewrites a token, a nil result drops the token.
+type TokenFilterFunc func(token []byte) []byte
+
+var (
+	registryMu   sync.RWMutex
+	charFilters  = make(map[string]CharFilterFunc)
+	tokenizers   = make(map[string]TokenizeFunc)
+	tokenFilters = make(map[string]TokenFilterFunc)
+
+	analyzerCache       sync.Map // spec + split characters -> *Analyzer
+	analyzerConfigCache sync.Map // encoded config -> *AnalyzerConfig
+)
+
+func RegisterCharFilter(name string, f CharFilterFunc) {
+	registryMu.Lock()
+	charFilters[name] = f
+	registryMu.Unlock()
+}
+
+func RegisterTokenizer(name string, f TokenizeFunc) {
+	registryMu.Lock()
+	tokenizers[name] = f
+	registryMu.Unlock()
+}
+
+func RegisterTokenFilter(name string, f TokenFilterFunc) {
+	registryMu.Lock()
+	tokenFilters[name] = f
+	registryMu.Unlock()
+}
+
+func IsStandardAnalyzer(spec string) bool {
+	return spec == "" || spec == StandardAnalyzer
+}
+
+// Analyzer is a chain of char filters, exactly one tokenizer and token filters, e.g. "html_strip,cjk_bigram,lowercase".
+// An analyzer holds no state of the input and can be shared by goroutines.
+type Analyzer struct {
+	spec        string
+	charFilters []CharFilterFunc
+	tokenize    TokenizeFunc
+	filters     []TokenFilterFunc
+	splitTable  []byte
+}
+
+func NewAnalyzer(spec, splitChars string) (*Analyzer, error) {
+	if splitChars == "" {
+		splitChars = CONTENT_SPLITTER
+	}
+	a := &Analyzer{spec: spec}
+	a.splitTable, _ = BuildSplitTable(splitChars)
+
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	for _, name := range strings.Split(spec, analyzerSeparator) {
+		name = strings.TrimSpace(name)
+		if f, ok := charFilters[name]; ok {
+			if a.tokenize != nil {
+				return nil, fmt.Errorf("char filter %s must be placed before the tokenizer in analyzer %q", name, spec)
+			}
+			a.charFilters = append(a.charFilters, f)
+		} else if f, ok := tokenizers[name]; ok {
+			if a.tokenize != nil {
+				return nil, fmt.Errorf("more than one tokenizer in analyzer %q", spec)
+			}
+			a.tokenize = f
+		} else if f, ok := tokenFilters[name]; ok {
+			if a.tokenize == nil {
+				return nil, fmt.Errorf("token filter %s must be placed after the tokenizer in analyzer %q", name, spec)
+			}
+			a.filters = append(a.filters, f)
+		} else {
+			return nil, fmt.Errorf("unknown analyzer component %q", name)
+		}
+	}
+	if a.tokenize == nil {
+		return nil, fmt.Errorf("no tokenizer in analyzer %q", spec)
+	}
+	return a, nil
+}
+
+// GetAnalyzer returns the cached analyzer, nil means the standard gram tokenizer is used.
+func GetAnalyzer(spec, splitChars string) (*Analyzer, error) {
+	if IsStandardAnalyzer(spec) {
+		return nil, nil
+	}
+	key := spec + "\x00" + splitChars
+	if a, ok := analyzerCache.Load(key); ok {
+		return a.(*Analyzer), nil
+	}
+	a, err := NewAnalyzer(spec, splitChars)
+	if err != nil {
+		return nil, err
+	}
+	analyzerCache.Store(key, a)
+	return a, nil
+}
+
+func (a *Analyzer) String() string {
+	return a.spec
+}
+
+func (a *Analyzer) Analyze(src []byte, emit func(token []byte)) {
+	for _, f := range a.charFilters {
+		src = f(nil, src)
+	}
+	a.tokenize(src, a.splitTable, func(token []byte) {
+		for _, f := range a.filters {
+			if token = f(token); len(token) == 0 {
+				return
+			}
+		}
+		emit(token)
+	})
+}
+
+func (a *Analyzer) Tokens(src string) []string {
+	var tokens []string
+	a.Analyze([]byte(src), func(token []byte) {
+		tokens = append(tokens, string(token))
+	})
+	return tokens
+}
+
+// AnalyzerTokenizer hashes the tokens of an analyzer in the same way as SimpleTokenizer,
+// so the tokens are written into and checked against the bloom filters of the full text index.
+type AnalyzerTokenizer struct {
+	analyzer *Analyzer
+	seed     uint64
+	hashes   []uint64
+	idx      int
+}
+
+func NewAnalyzerTokenizer(analyzer *Analyzer, seed uint64) *AnalyzerTokenizer {
+	return &AnalyzerTokenizer{analyzer: analyzer, seed: seed}
+}
+
+func (t *AnalyzerTokenizer) InitInput(bytes []byte) {
+	t.hashes = t.hashes[:0]
+	t.idx = -1
+	t.analyzer.Analyze(bytes, func(token []byte) {
+		hash := t.seed
+		for _, b := range token {
+			hash ^= bits.RotateLeft64(hash, 11) ^ (uint64(b) * Prime_64)
+		}
+		t.hashes = append(t.hashes, hash)
+	})
+}
+
+func (t *AnalyzerTokenizer) Next() bool {
+	if t.idx >= len(t.hashes)-1 {
+		return false
+	}
+	t.idx++
+	return true
+}
+
+func (t *AnalyzerTokenizer) CurrentHash() uint64 {
+	return t.hashes[t.idx]
+}
+
+func (t *AnalyzerTokenizer) ProcessTokenizerBatch(input, output []byte, offsets, lens []int32) int {
+	for i := range offsets {
+		t.InitInput(input[offsets[i] : offsets[i]+lens[i]])
+		for t.Next() {
+			hash := t.CurrentHash()
+			target := uint32(hash >> 46)
+			v := table[int((hash>>28)&0x1ff)] | (table[int((hash>>37)&0x1ff)] << 32)
+			s := binary.LittleEndian.Uint64(output[target : target+8])
+			if (v & s) == v {
+				continue
+			}
+			binary.LittleEndian.PutUint64(output[target:target+8], v|s)
+		}
+	}
+	return 0
+}
+
+func (t *AnalyzerTokenizer) FreeSimpleGramTokenizer() {}
+
+// AnalyzerConfig is the analyzers of the full text fields, it is stored in IndexOption.Tokenizers as
+// "default;field1=spec1;field2=spec2", a single "standard" is the config of the logstreams created before.
+type AnalyzerConfig struct {
+	Default string
+	Fields  map[string]string
+}
+
+func NewAnalyzerConfig(defaultSpec string, fields map[string]string) *AnalyzerConfig {
+	if defaultSpec == "" {
+		defaultSpec = StandardAnalyzer
+	}
+	return &AnalyzerConfig{Default: defaultSpec, Fields: fields}
+}
+
+func ParseAnalyzerConfig(s string) (*AnalyzerConfig, error) {
+	if c, ok := analyzerConfigCache.Load(s); ok {
+		return c.(*AnalyzerConfig), nil
+	}
+	c := &AnalyzerConfig{Default: StandardAnalyzer}
+	for _, item := range strings.Split(s, analyzerFieldSeparator) {
+		if item == "" {
+			continue
+		}
+		field, spec, ok := strings.Cut(item, analyzerFieldAssign)
+		if !ok {
+			c.Default = item
+			continue
+		}
+		if field == "" || spec == "" {
+			return nil, fmt.Errorf("invalid field analyzer %q", item)
+		}
+		if c.Fields == nil {
+			c.Fields = make(map[string]string)
+		}
+		c.Fields[field] = spec
+	}
+	analyzerConfigCache.Store(s, c)
+	return c, nil
+}
+
+func (c *AnalyzerConfig) Validate() error {
+	if _, err := GetAnalyzer(c.Default, ""); err != nil {
+		return err
+	}
+	for field, spec := range c.Fields {
+		if field == "" || strings.ContainsAny(field, analyzerFieldSeparator+analyzerFieldAssign) {
+			return fmt.Errorf("invalid analyzer field name %q", field)
+		}
+		if _, err := GetAnalyzer(spec, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *AnalyzerConfig) String() string {
+	fields := make([]string, 0, len(c.Fields))
+	for field := range c.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	var sb strings.Builder
+	sb.WriteString(c.Default)
+	for _, field := range fields {
+		sb.WriteString(analyzerFieldSeparator)
+		sb.WriteString(field)
+		sb.WriteString(analyzerFieldAssign)
+		sb.WriteString(c.Fields[field])
+	}
+	return sb.String()
+}
+
+func (c *AnalyzerConfig) FieldSpec(field string) string {
+	if spec, ok := c.Fields[field]; ok {
+		return spec
+	}
+	return c.Default
+}
+
+// CommonSpec returns the analyzer shared by all the fields, the tokens of different analyzers can not be
+// checked with one hash list.
+func (c *AnalyzerConfig) CommonSpec(fields []string) (string, bool) {
+	spec := c.Default
+	for i, field := range fields {
+		if i == 0 {
+			spec = c.FieldSpec(field)
+		} else if c.FieldSpec(field) != spec {
+			return "", false
+		}
+	}
+	return spec, true
+}
+
+func GetAnalyzerConfig(option *influxql.IndexOption) *AnalyzerConfig {
+	if option == nil || IsStandardAnalyzer(option.Tokenizers) {
+		return &AnalyzerConfig{Default: StandardAnalyzer}
+	}
+	c, err := ParseAnalyzerConfig(option.Tokenizers)
+	if err != nil {
+		logger.GetLogger().Error("invalid analyzer config, use the standard analyzer", zap.String("config", option.Tokenizers), zap.Error(err))
+		return &AnalyzerConfig{Default: StandardAnalyzer}
+	}
+	return c
+}
+
+// GetFieldAnalyzer returns the analyzer of the full text field, nil means the standard gram tokenizer is used.
+func GetFieldAnalyzer(option *influxql.IndexOption, field string) *Analyzer {
+	return getSpecAnalyzer(option, GetAnalyzerConfig(option).FieldSpec(field))
+}
+
+func getSpecAnalyzer(option *influxql.IndexOption, spec string) *Analyzer {
+	if IsStandardAnalyzer(spec) {
+		return nil
+	}
+	a, err := GetAnalyzer(spec, option.Tokens)
+	if err != nil {
+		logger.GetLogger().Error("invalid analyzer, use the standard analyzer", zap.String("analyzer", spec), zap.Error(err))
+		return nil
+	}
+	return a
+}
+
+// GetFieldsAnalyzer returns the analyzer shared by the fields, ok is false if the fields are analyzed differently.
+func GetFieldsAnalyzer(option *influxql.IndexOption, fields []string) (*Analyzer, bool) {
+	spec, ok := GetAnalyzerConfig(option).CommonSpec(fields)
+	if !ok {
+		return nil, false
+	}
+	return getSpecAnalyzer(option, spec), true
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tokenizer
+
+import (
+	"bytes"
+	"unicode"
+	"unicode/utf8"
+)
+
+// the built-in analyzer components
+const (
+	CharFilterHtmlStrip = "html_strip"
+	CharFilterWidthFold = "width_fold"
+
+	TokenizerStandard      = "standard"
+	TokenizerWhitespace    = "whitespace"
+	TokenizerCJKBigram     = "cjk_bigram"
+	TokenizerPathHierarchy = "path_hierarchy"
+	TokenizerCamelCase     = "camel_case"
+
+	TokenFilterLowercase = "lowercase"
+	TokenFilterStop      = "stop"
+	TokenFilterStemmer   = "stemmer"
+)
+
+var htmlEntities = map[string]byte{"amp": '&', "lt": '<', "gt": '>', "quot": '"', "apos": '\'', "nbsp": ' '}
+
+var stopWords = map[string]struct{}{}
+
+func init() {
+	for _, w := range []string{"a", "an", "and", "are", "as", "at", "be", "but", "by", "for", "if", "in", "into", "is", "it",
+		"no", "not", "of", "on", "or", "such", "that", "the", "their", "then", "there", "these", "they", "this", "to",
+		"was", "will", "with"} {
+		stopWords[w] = struct{}{}
+	}
+
+	RegisterCharFilter(CharFilterHtmlStrip, htmlStrip)
+	RegisterCharFilter(CharFilterWidthFold, widthFold)
+
+	RegisterTokenizer(TokenizerStandard, standardTokenize)
+	RegisterTokenizer(TokenizerWhitespace, whitespaceTokenize)
+	RegisterTokenizer(TokenizerCJKBigram, cjkBigramTokenize)
+	RegisterTokenizer(TokenizerPathHierarchy, pathHierarchyTokenize)
+	RegisterTokenizer(TokenizerCamelCase, camelCaseTokenize)
+
+	RegisterTokenFilter(TokenFilterLowercase, bytes.ToLower)
+	RegisterTokenFilter(TokenFilterStop, stopFilter)
+	RegisterTokenFilter(TokenFilterStemmer, stemFilter)
+}
+
+// htmlStrip removes the tags and decodes the common entities.
+func htmlStrip(dst, src []byte) []byte {
+	for i := 0; i < len(src); i++ {
+		switch src[i] {
+		case '<':
+			if end := bytes.IndexByte(src[i:], '>'); end > 0 {
+				dst = append(dst, ' ')
+				i += end
+				continue
+			}
+		case '&':
+			if end := bytes.IndexByte(src[i:], ';'); end > 0 {
+				if c, ok := htmlEntities[string(src[i+1:i+end])]; ok {
+					dst = append(dst, c)
+					i += end
+					continue
+				}
+			}
+		}
+		dst = append(dst, src[i])
+	}
+	return dst
+}
+
+// widthFold converts the full width forms of the ASCII characters which are common in the CJK text.
+func widthFold(dst, src []byte) []byte {
+	for len(src) > 0 {
+		r, size := utf8.DecodeRune(src)
+		switch {
+		case r >= 0xFF01 && r <= 0xFF5E:
+			dst = append(dst, byte(r-0xFEE0))
+		case r == 0x3000:
+			dst = append(dst, ' ')
+		default:
+			dst = append(dst, src[:size]...)
+		}
+		src = src[size:]
+	}
+	return dst
+}
+
+func isSplitByte(splitTable []byte, b byte) bool {
+	return b < utf8.RuneSelf && splitTable[b] > 0
+}
+
+// standardTokenize splits by the split characters, each multibyte character is a token as SimpleUtf8Tokenizer does.
+func standardTokenize(src []byte, splitTable []byte, emit func(token []byte)) {
+	start := -1
+	for i := 0; i < len(src); {
+		b := src[i]
+		if b < utf8.RuneSelf {
+			if isSplitByte(splitTable, b) {
+				if start >= 0 {
+					emit(src[start:i])
+					start = -1
+				}
+			} else if start < 0 {
+				start = i
+			}
+			i++
+			continue
+		}
+		if start >= 0 {
+			emit(src[start:i])
+			start = -1
+		}
+		_, size := utf8.DecodeRune(src[i:])
+		emit(src[i : i+size])
+		i += size
+	}
+	if start >= 0 {
+		emit(src[start:])
+	}
+}
+
+func whitespaceTokenize(src []byte, _ []byte, emit func(token []byte)) {
+	for _, token := range bytes.Fields(src) {
+		emit(token)
+	}
+}
+
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
+
+// cjkBigramTokenize emits the overlapping bigrams of the CJK runs, a single CJK character is a token.
+// The other text is split by the split characters and the non-letter characters.
+func cjkBigramTokenize(src []byte, splitTable []byte, emit func(token []byte)) {
+	wordStart, cjkStart, prev := -1, -1, -1
+	flushWord := func(end int) {
+		if wordStart >= 0 {
+			emit(src[wordStart:end])
+			wordStart = -1
+		}
+	}
+	flushCJK := func(end int) {
+		if cjkStart >= 0 && prev == cjkStart {
+			emit(src[cjkStart:end])
+		}
+		cjkStart, prev = -1, -1
+	}
+	for i := 0; i < len(src); {
+		r, size := utf8.DecodeRune(src[i:])
+		switch {
+		case isCJK(r):
+			flushWord(i)
+			if cjkStart < 0 {
+				cjkStart = i
+			} else {
+				emit(src[prev : i+size])
+			}
+			prev = i
+		case r < utf8.RuneSelf && isSplitByte(splitTable, byte(r)), r >= utf8.RuneSelf && !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flushWord(i)
+			flushCJK(i)
+		default:
+			flushCJK(i)
+			if wordStart < 0 {
+				wordStart = i
+			}
+		}
+		i += size
+	}
+	flushWord(len(src))
+	flushCJK(len(src))
+}
+
+// pathHierarchyTokenize emits every prefix of a path, "/var/log/app.log" is "/var", "/var/log" and "/var/log/app.log".
+func pathHierarchyTokenize(src []byte, _ []byte, emit func(token []byte)) {
+	src = bytes.TrimRight(bytes.TrimSpace(src), "/")
+	for i := 1; i < len(src); i++ {
+		if src[i] == '/' && src[i-1] != '/' {
+			emit(src[:i])
+		}
+	}
+	if len(src) > 0 {
+		emit(src)
+	}
+}
+
+// camelCaseTokenize splits the words by the split characters and then by the case and digit changes,
+// "getHTTPResponse2xx" is "get", "HTTP", "Response", "2", "xx".
+func camelCaseTokenize(src []byte, splitTable []byte, emit func(token []byte)) {
+	standardTokenize(src, splitTable, func(word []byte) {
+		start := 0
+		for i := 1; i < len(word); i++ {
+			prev, curr := word[i-1], word[i]
+			split := false
+			switch {
+			case isLower(prev) && isUpper(curr):
+				split = true
+			case isUpper(prev) && isUpper(curr) && i+1 < len(word) && isLower(word[i+1]):
+				split = true
+			case isDigit(prev) != isDigit(curr) && (isLetter(prev) || isLetter(curr)):
+				split = true
+			}
+			if split {
+				emit(word[start:i])
+				start = i
+			}
+		}
+		emit(word[start:])
+	})
+}
+
+func isLower(b byte) bool  { return b >= 'a' && b <= 'z' }
+func isUpper(b byte) bool  { return b >= 'A' && b <= 'Z' }
+func isDigit(b byte) bool  { return b >= '0' && b <= '9' }
+func isLetter(b byte) bool { return isLower(b) || isUpper(b) }
+
+func stopFilter(token []byte) []byte {
+	if _, ok := stopWords[string(token)]; ok {
+		return nil
+	}
+	return token
+}
+
+func hasVowel(b []byte) bool {
+	return bytes.ContainsAny(b, "aeiouy")
+}
+
+// stemFilter is the plural and the -ed/-ing steps of the Porter stemmer, which is enough for the log words
+// such as "connections", "failed" and "retrying".
+func stemFilter(token []byte) []byte {
+	n := len(token)
+	switch {
+	case bytes.HasSuffix(token, []byte("sses")), bytes.HasSuffix(token, []byte("ies")):
+		token = token[:n-2]
+	case bytes.HasSuffix(token, []byte("ss")):
+	case bytes.HasSuffix(token, []byte("s")) && n > 3:
+		token = token[:n-1]
+	}
+
+	n = len(token)
+	for _, suffix := range [][]byte{[]byte("ing"), []byte("ed")} {
+		if !bytes.HasSuffix(token, suffix) || n-len(suffix) < 3 || !hasVowel(token[:n-len(suffix)]) {
+			continue
+		}
+		stem := token[:n-len(suffix)]
+		// "stopped" is "stop", but "falling" is "fall"
+		if l := len(stem); stem[l-1] == stem[l-2] && !bytes.ContainsAny(stem[l-1:], "lsz") {
+			stem = stem[:l-1]
+		}
+		return stem
+	}
+	return token
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tokenizer
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func analyze(t *testing.T, spec, src string) []string {
+	a, err := NewAnalyzer(spec, "")
+	require.NoError(t, err)
+	return a.Tokens(src)
+}
+
+func TestAnalyzerComponents(t *testing.T) {
+	assert.Equal(t, []string{"GET", "index", "html", "华", "为"}, analyze(t, "standard", "GET /index.html 华为"))
+	assert.Equal(t, []string{"a=1,b", "c"}, analyze(t, "whitespace", " a=1,b\tc "))
+	assert.Equal(t, []string{"数据", "据库", "连接", "error", "の"}, analyze(t, "cjk_bigram,lowercase", "数据库。连接 ERROR の"))
+	assert.Equal(t, []string{"/var", "/var/log", "/var/log/app.log"}, analyze(t, "path_hierarchy", "/var/log/app.log/"))
+	assert.Equal(t, []string{"get", "HTTP", "Response", "2", "xx", "user", "id"}, analyze(t, "camel_case", "getHTTPResponse2xx user_id"))
+	assert.Equal(t, []string{"connection", "refus", "fall", "stop", "retri", "class"}, analyze(t, "standard,lowercase,stop,stemmer", "The connections REFUSED falling stopped retries class"))
+	assert.Equal(t, []string{"a", "b", "c"}, analyze(t, "html_strip,standard", "<p>a &amp; b</p>c"))
+	assert.Equal(t, []string{"abc", "1"}, analyze(t, "width_fold,whitespace", "ａｂｃ　１"))
+}
+
+func TestNewAnalyzerError(t *testing.T) {
+	for _, spec := range []string{"", "lowercase", "lowercase,standard", "standard,html_strip", "standard,whitespace", "unknown"} {
+		_, err := NewAnalyzer(spec, "")
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestAnalyzerTokenizer(t *testing.T) {
+	a, err := GetAnalyzer("whitespace,lowercase", "")
+	require.NoError(t, err)
+	cached, _ := GetAnalyzer("whitespace,lowercase", "")
+	assert.True(t, a == cached)
+	standard, err := GetAnalyzer(StandardAnalyzer, "")
+	require.NoError(t, err)
+	assert.Nil(t, standard)
+
+	tk := NewAnalyzerTokenizer(a, 0)
+	tk.InitInput([]byte("Hello World"))
+	var hashes []uint64
+	for tk.Next() {
+		hashes = append(hashes, tk.CurrentHash())
+	}
+	assert.Equal(t, []uint64{Hash([]byte("hello")), Hash([]byte("world"))}, hashes)
+
+	output := make([]byte, 1<<19)
+	tk.ProcessTokenizerBatch([]byte("Hello"), output, []int32{0}, []int32{5})
+	hash := Hash([]byte("hello"))
+	target := uint32(hash >> 46)
+	v := table[int((hash>>28)&0x1ff)] | (table[int((hash>>37)&0x1ff)] << 32)
+	assert.Equal(t, v, binary.LittleEndian.Uint64(output[target:target+8]))
+}
+
+func TestAnalyzerConfig(t *testing.T) {
+	c := NewAnalyzerConfig("", map[string]string{"msg": "camel_case,lowercase", "content": "cjk_bigram"})
+	require.NoError(t, c.Validate())
+	s := c.String()
+	assert.Equal(t, "standard;content=cjk_bigram;msg=camel_case,lowercase", s)
+
+	parsed, err := ParseAnalyzerConfig(s)
+	require.NoError(t, err)
+	assert.Equal(t, "cjk_bigram", parsed.FieldSpec("content"))
+	assert.Equal(t, StandardAnalyzer, parsed.FieldSpec("host"))
+	_, ok := parsed.CommonSpec([]string{"content", "msg"})
+	assert.False(t, ok)
+	spec, ok := parsed.CommonSpec([]string{"host", "tag"})
+	assert.True(t, ok)
+	assert.Equal(t, StandardAnalyzer, spec)
+
+	_, err = ParseAnalyzerConfig("standard;=cjk_bigram")
+	assert.Error(t, err)
+	assert.Error(t, NewAnalyzerConfig("unknown", nil).Validate())
+	assert.Error(t, NewAnalyzerConfig("", map[string]string{"a=b": "standard"}).Validate())
+
+	option := &influxql.IndexOption{Tokens: CONTENT_SPLITTER, Tokenizers: s}
+	assert.Nil(t, GetFieldAnalyzer(option, "host"))
+	assert.Equal(t, "cjk_bigram", GetFieldAnalyzer(option, "content").String())
+	assert.Nil(t, GetFieldAnalyzer(&influxql.IndexOption{Tokenizers: "standard"}, "content"))
+	assert.Nil(t, GetFieldAnalyzer(&influxql.IndexOption{Tokenizers: "unknown"}, "content"))
+	_, ok = GetFieldsAnalyzer(option, []string{"content", "host"})
+	assert.False(t, ok)
+}
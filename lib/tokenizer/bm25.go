@@ -0,0 +1,112 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tokenizer
+
+import (
+	"math"
+)
+
+const (
+	// BM25K1 controls the saturation of the term frequency.
+	BM25K1 = 1.2
+	// BM25B controls the normalization by the document length.
+	BM25B = 0.75
+)
+
+// BM25Stats is the lightweight corpus statistic needed by BM25:
+// the number of documents, the total number of tokens and the document frequency of the terms.
+type BM25Stats struct {
+	DocCount   uint64
+	TokenCount uint64
+	DocFreq    map[string]uint64
+}
+
+func NewBM25Stats() *BM25Stats {
+	return &BM25Stats{DocFreq: make(map[string]uint64)}
+}
+
+// AddDoc adds a document of docLen tokens, terms are the terms that occur in the document.
+func (s *BM25Stats) AddDoc(docLen int, terms []string) {
+	s.DocCount++
+	s.TokenCount += uint64(docLen)
+	for _, term := range terms {
+		s.DocFreq[term]++
+	}
+}
+
+func (s *BM25Stats) AvgDocLen() float64 {
+	if s.DocCount == 0 {
+		return 0
+	}
+	return float64(s.TokenCount) / float64(s.DocCount)
+}
+
+// IDF is the Lucene variant of the inverse document frequency, it is never negative.
+func (s *BM25Stats) IDF(term string) float64 {
+	df := float64(s.DocFreq[term])
+	return math.Log(1 + (float64(s.DocCount)-df+0.5)/(df+0.5))
+}
+
+// BM25Score returns the contribution of a term that occurs tf times in a document of docLen tokens.
+func BM25Score(tf, docLen int, avgDocLen, idf float64) float64 {
+	if tf <= 0 {
+		return 0
+	}
+	norm := 1.0
+	if avgDocLen > 0 {
+		norm = 1 - BM25B + BM25B*float64(docLen)/avgDocLen
+	}
+	f := float64(tf)
+	return idf * f * (BM25K1 + 1) / (f + BM25K1*norm)
+}
+
+// TokenCount returns the number of tokens of content split by the split table,
+// it is the document length used by BM25.
+func TokenCount(content []byte, splitTable []byte) int {
+	n := 0
+	inToken := false
+	for _, c := range content {
+		b := int8(c)
+		if b < 0 {
+			// every multi-byte character is a token, count its leading byte
+			if c >= 0xC0 {
+				n++
+			}
+			inToken = false
+			continue
+		}
+		if splitTable[b] > 0 {
+			inToken = false
+			continue
+		}
+		if !inToken {
+			n++
+			inToken = true
+		}
+	}
+	return n
+}
+
+// TermFrequency returns the number of the occurrences of term in content,
+// the occurrences are found the same way as the highlight fragments.
+func TermFrequency(finder *SimpleTokenFinder, content, term []byte) int {
+	n := 0
+	finder.InitInput(content, term)
+	for finder.Next() {
+		n++
+	}
+	return n
+}
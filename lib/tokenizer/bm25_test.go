@@ -0,0 +1,60 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tokenizer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBM25Stats(t *testing.T) {
+	s := NewBM25Stats()
+	s.AddDoc(4, []string{"error"})
+	s.AddDoc(8, []string{"error", "timeout"})
+	s.AddDoc(6, nil)
+
+	assert.Equal(t, uint64(3), s.DocCount)
+	assert.Equal(t, uint64(18), s.TokenCount)
+	assert.Equal(t, 6.0, s.AvgDocLen())
+	assert.Equal(t, 0.0, NewBM25Stats().AvgDocLen())
+	// the rare terms weigh more
+	assert.True(t, s.IDF("timeout") > s.IDF("error"))
+	assert.True(t, s.IDF("error") > 0)
+	assert.Equal(t, math.Log(1+3.5/0.5), s.IDF("unknown"))
+}
+
+func TestBM25Score(t *testing.T) {
+	assert.Equal(t, 0.0, BM25Score(0, 10, 10, 1))
+	// the score saturates with the term frequency
+	assert.True(t, BM25Score(2, 10, 10, 1) > BM25Score(1, 10, 10, 1))
+	assert.True(t, BM25Score(2, 10, 10, 1) < 2*BM25Score(1, 10, 10, 1))
+	// the shorter documents score higher
+	assert.True(t, BM25Score(1, 5, 10, 1) > BM25Score(1, 20, 10, 1))
+	assert.Equal(t, 1.0, BM25Score(1, 10, 10, 1))
+	assert.Equal(t, 1.0, BM25Score(1, 3, 0, 1))
+}
+
+func TestTokenCountAndTermFrequency(t *testing.T) {
+	assert.Equal(t, 0, TokenCount([]byte(" ,; "), CONTENT_SPLIT_TABLE))
+	assert.Equal(t, 4, TokenCount([]byte("GET /index.html 200"), CONTENT_SPLIT_TABLE))
+	assert.Equal(t, 3, TokenCount([]byte("ab 华为"), CONTENT_SPLIT_TABLE))
+
+	finder := NewSimpleTokenFinder(CONTENT_SPLIT_TABLE)
+	assert.Equal(t, 2, TermFrequency(finder, []byte("error: disk error, errors"), []byte("error")))
+	assert.Equal(t, 0, TermFrequency(finder, []byte("errors"), []byte("error")))
+}
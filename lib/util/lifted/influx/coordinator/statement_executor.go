@@ -37,6 +37,7 @@ import (
 	"github.com/openGemini/openGemini/coordinator"
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/encoding"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/index"
 	"github.com/openGemini/openGemini/lib/logger"
@@ -249,6 +250,9 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
+		if err = e.assignTenantDatabase(ctx.ExecutionOptions.Tenant, stmt.Name); err != nil {
+			return err
+		}
 		err = e.executeCreateDatabaseStatement(stmt)
 	case *influxql.CreateMeasurementStatement:
 		if ctx.ReadOnly {
@@ -274,11 +278,16 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeDropContinuousQueryStatement(stmt)
+	case *influxql.BackfillContinuousQueryStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeBackfillContinuousQueryStatement(stmt)
 	case *influxql.CreateUserStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
-		err = e.executeCreateUserStatement(stmt)
+		err = e.executeCreateUserStatement(stmt, ctx.ExecutionOptions.Tenant)
 	case *influxql.DeleteSeriesStatement:
 		return meta2.ErrUnsupportCommand
 		_, err = e.retryExecuteStatement(stmt, ctx, seq)
@@ -394,7 +403,7 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 	case *influxql.ShowTagValuesCardinalityStatement:
 		rows, err = e.retryExecuteStatement(stmt, ctx, seq)
 	case *influxql.ShowUsersStatement:
-		rows, err = e.executeShowUsersStatement(stmt)
+		rows, err = e.executeShowUsersStatement(stmt, ctx.ExecutionOptions.Tenant)
 	case *influxql.SetPasswordUserStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
@@ -443,6 +452,40 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		err = e.executeSetConfig(stmt)
 	case *influxql.ShowClusterStatement:
 		rows, err = e.executeShowCluster(stmt)
+	case *influxql.ShowCompactionsStatement:
+		rows, err = e.executeShowCompactionsStatement()
+	case *influxql.ShowRepairsStatement:
+		rows, err = e.executeShowRepairsStatement()
+	case *influxql.ShowRebalanceStatement:
+		rows, err = e.executeShowRebalanceStatement()
+	case *influxql.DecommissionNodeStatement:
+		err = e.MetaClient.DecommissionNode(stmt.NodeID)
+	case *influxql.KillDecommissionStatement:
+		err = e.MetaClient.CancelDecommission(stmt.NodeID)
+	case *influxql.ShowDecommissionStatement:
+		rows, err = e.executeShowDecommissionStatement()
+	case *influxql.CreateReplicationStatement:
+		err = e.executeCreateReplicationStatement(stmt)
+	case *influxql.DropReplicationStatement:
+		err = e.MetaClient.DropReplication(stmt.Database, false)
+	case *influxql.ShowReplicationsStatement:
+		rows = e.executeShowReplicationsStatement()
+	case *influxql.PromoteDatabaseStatement:
+		err = e.MetaClient.DropReplication(stmt.Database, true)
+	case *influxql.ShowCardinalityTopStatement:
+		rows, err = e.executeShowCardinalityTop(stmt)
+	case *influxql.CreateTenantStatement:
+		err = e.executeCreateTenantStatement(stmt)
+	case *influxql.AlterTenantStatement:
+		err = e.executeAlterTenantStatement(stmt)
+	case *influxql.DropTenantStatement:
+		err = e.MetaClient.DropTenant(stmt.Name)
+	case *influxql.ShowTenantsStatement:
+		rows = e.MetaClient.ShowTenants()
+	case *influxql.GrantTenantStatement:
+		err = e.MetaClient.UpdateTenantMember(stmt.Tenant, stmt.Database, stmt.User, false)
+	case *influxql.RevokeTenantStatement:
+		err = e.MetaClient.UpdateTenantMember(stmt.Tenant, stmt.Database, stmt.User, true)
 	default:
 		return query.ErrInvalidQuery
 	}
@@ -673,10 +716,53 @@ func (e *StatementExecutor) executeCreateMeasurementStatement(stmt *influxql.Cre
 	if stmt.EngineType != "" && !ok {
 		return errors.New("ENGINETYPE \"" + stmt.EngineType + "\" IS NOT SUPPORTED!")
 	}
-	_, err = e.MetaClient.CreateMeasurement(stmt.Database, stmt.RetentionPolicy, stmt.Name, ski, int32(stmt.NumOfShards), indexR, engineType, colStoreInfo, schemaInfo, nil)
+	var options *meta2.Options
+	if engineType == config.TSSTORE {
+		if options, err = getMeasurementOptions(stmt.Property); err != nil {
+			return err
+		}
+	}
+	_, err = e.MetaClient.CreateMeasurement(stmt.Database, stmt.RetentionPolicy, stmt.Name, ski, int32(stmt.NumOfShards), indexR, engineType, colStoreInfo, schemaInfo, options)
 	return err
 }
 
+// getMeasurementOptions converts the properties of a tsstore measurement to its options
+func getMeasurementOptions(property [][]string) (*meta2.Options, error) {
+	if len(property) != 2 {
+		return nil, nil
+	}
+
+	options := &meta2.Options{}
+	options.InitDefault()
+	for i, key := range property[0] {
+		value := strings.ToLower(property[1][i])
+		switch lower := strings.ToLower(key); {
+		case lower == meta2.PropertyFloatCodec:
+			options.FloatCodec = value
+		case lower == meta2.PropertyIntCodec:
+			options.IntCodec = value
+		case strings.HasPrefix(lower, meta2.PropertyErrorBoundPrefix) && len(key) > len(meta2.PropertyErrorBoundPrefix):
+			bound, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid measurement property %q: %s", key, err)
+			}
+			if options.ErrorBounds == nil {
+				options.ErrorBounds = make(map[string]float64)
+			}
+			// field names are case sensitive
+			options.ErrorBounds[key[len(meta2.PropertyErrorBoundPrefix):]] = bound
+		default:
+			return nil, fmt.Errorf("unsupported measurement property %q", key)
+		}
+	}
+
+	codecs := encoding.Codecs{Float: options.FloatCodec, Integer: options.IntCodec, ErrorBounds: options.ErrorBounds}
+	if err := codecs.Validate(); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
 func (e *StatementExecutor) executeAlterShardKeyStatement(stmt *influxql.AlterShardKeyStatement) error {
 	if err := meta2.ValidShardKey(stmt.ShardKey); err != nil {
 		return err
@@ -834,6 +920,20 @@ func (e *StatementExecutor) executeDropContinuousQueryStatement(stmt *influxql.D
 	return nil
 }
 
+// executeBackfillContinuousQueryStatement starts to backfill the historical windows of a continuous query.
+// The windows are run in the background by the sql node owning the continuous query.
+func (e *StatementExecutor) executeBackfillContinuousQueryStatement(stmt *influxql.BackfillContinuousQueryStatement) error {
+	if !stmt.StartTime.Before(stmt.EndTime) {
+		return meta2.ErrInvalidBackfillTimeRange
+	}
+	if stmt.EndTime.After(time.Now()) {
+		return fmt.Errorf("backfill end time %s is later than now", stmt.EndTime.UTC().Format(time.RFC3339))
+	}
+	e.StmtExecLogger.Info("backfill continuous query", zap.String("cq name", stmt.Name), zap.String("database", stmt.Database),
+		zap.Time("start", stmt.StartTime), zap.Time("end", stmt.EndTime))
+	return e.MetaClient.BackfillContinuousQuery(stmt.Database, stmt.Name, stmt.StartTime, stmt.EndTime)
+}
+
 func (e *StatementExecutor) executeCreateSubscriptionStatement(q *influxql.CreateSubscriptionStatement) error {
 	if !config.GetSubscriptionEnable() {
 		return errors.New("subscription is not enabled")
@@ -841,9 +941,13 @@ func (e *StatementExecutor) executeCreateSubscriptionStatement(q *influxql.Creat
 	return e.MetaClient.CreateSubscription(q.Database, q.RetentionPolicy, q.Name, q.Mode, q.Destinations)
 }
 
-func (e *StatementExecutor) executeCreateUserStatement(q *influxql.CreateUserStatement) error {
+func (e *StatementExecutor) executeCreateUserStatement(q *influxql.CreateUserStatement, tenant string) error {
 	_, err := e.MetaClient.CreateUser(q.Name, q.Password, q.Admin, q.Rwuser)
-	return err
+	if err != nil || tenant == "" {
+		return err
+	}
+	// the user created by a user of the tenant belongs to the tenant
+	return e.MetaClient.UpdateTenantMember(tenant, "", q.Name, false)
 }
 
 // executeDropDatabaseStatement drops a database from the cluster.
@@ -1062,6 +1166,10 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	proxy := newRowChanProxy()
 	// omit Time field for stmt
 	stmt.OmitTime = true
+	var messages []*query.Message
+	if ctx.ErrorBound {
+		messages = e.errorBoundMessages(stmt)
+	}
 	pipelineExecutor, err := e.retryCreatePipelineExecutor(ctx, stmt, ctx.ExecutionOptions, proxy.rc)
 	if err == influxql.ErrDeclareEmptyCollection {
 		// skip empty collection err and return empty result set
@@ -1119,6 +1227,9 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 				Series:  rowsChan.Rows,
 				Partial: rowsChan.Partial,
 			}
+			if !emitted {
+				result.Messages = messages
+			}
 
 			// Send results or exit if closing.
 			if err := ctx.Send(result, seq); err != nil {
@@ -1184,12 +1295,32 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	// Always emit at least one result.
 	if !emitted {
 		return ctx.Send(&query.Result{
-			Series: make([]*models.Row, 0),
+			Series:   make([]*models.Row, 0),
+			Messages: messages,
 		}, seq)
 	}
 	return nil
 }
 
+// errorBoundMessages reports the error bounds of the lossy fields of the queried measurements
+func (e *StatementExecutor) errorBoundMessages(stmt *influxql.SelectStatement) []*query.Message {
+	var messages []*query.Message
+	for _, m := range stmt.Sources.Measurements() {
+		if m.Regex != nil {
+			continue
+		}
+		mst, err := e.MetaClient.Measurement(m.Database, m.RetentionPolicy, m.Name)
+		if err != nil || mst.Options == nil || len(mst.Options.ErrorBounds) == 0 {
+			continue
+		}
+		messages = append(messages, &query.Message{
+			Level: query.InfoLevel,
+			Text:  fmt.Sprintf("error bound of measurement %s: %s", m.Name, errorBoundsString(mst.Options.ErrorBounds)),
+		})
+	}
+	return messages
+}
+
 func (e *StatementExecutor) GetOptions(opt query.ExecutionOptions, rowsChan chan query.RowsChan) query.SelectOptions {
 	return query.SelectOptions{
 		NodeID:                  opt.NodeID,
@@ -1210,6 +1341,7 @@ func (e *StatementExecutor) GetOptions(opt query.ExecutionOptions, rowsChan chan
 		QueryID:                 opt.QueryID,
 		IncQuery:                opt.IncQuery,
 		IterID:                  opt.IterID,
+		ReadConsistency:         opt.ReadConsistency,
 	}
 }
 
@@ -1242,6 +1374,7 @@ func (e *StatementExecutor) createPipelineExecutor(ctx context.Context, stmt *in
 func (e *StatementExecutor) executeShowDatabasesStatement(q *influxql.ShowDatabasesStatement, ctx *query.ExecutionContext) (models.Rows, error) {
 	dis := e.MetaClient.Databases()
 	a := ctx.ExecutionOptions.Authorizer
+	ti := e.tenant(ctx.ExecutionOptions.Tenant)
 
 	row := &models.Row{Name: "databases", Columns: []string{"name"}}
 	if q.ShowDetail {
@@ -1251,6 +1384,9 @@ func (e *StatementExecutor) executeShowDatabasesStatement(q *influxql.ShowDataba
 
 	var tagAttr string
 	for _, di := range dis {
+		if ti != nil && !ti.HasDatabase(di.Name) {
+			continue
+		}
 		// Only include databases that the user is authorized to read or write.
 		if a.AuthorizeDatabase(originql.ReadPrivilege, di.Name) || a.AuthorizeDatabase(originql.WritePrivilege, di.Name) {
 			if !q.ShowDetail {
@@ -1520,8 +1656,8 @@ func (e *StatementExecutor) executeShowMeasurementsDetailStatement(stmt *influxq
 	for key, m := range measurements {
 		originName := m.OriginName()
 		row := &models.Row{Name: originName, Columns: []string{"Detail"}}
-		// the values has 9 rows (retention policy, shardKeys, fieldKeys etc.) at most.
-		values := make([][]interface{}, 0, 9)
+		// the values has 10 rows (retention policy, shardKeys, fieldKeys etc.) at most.
+		values := make([][]interface{}, 0, 10)
 		// key: rpName.mstName
 		policyStr := "RETENTION POLICY: " + strings.Split(key, ".")[0]
 		values = append(values, []interface{}{policyStr})
@@ -1592,6 +1728,10 @@ func (e *StatementExecutor) executeShowMeasurementsDetailStatement(stmt *influxq
 		fieldStr = "FIELD KEYS: " + blank2Nil(fieldStr)
 		values = append(values, []interface{}{fieldStr})
 
+		if m.Options != nil && len(m.Options.ErrorBounds) > 0 {
+			values = append(values, []interface{}{"ERROR BOUND: " + errorBoundsString(m.Options.ErrorBounds)})
+		}
+
 		row.Values = values
 		if err := ctx.Send(&query.Result{Series: []*models.Row{row}}, seq); err != nil {
 			return err
@@ -1604,6 +1744,19 @@ func (e *StatementExecutor) executeShowMeasurementsDetailStatement(stmt *influxq
 	return nil
 }
 
+// errorBoundsString formats the error bounds of the lossy fields as "field1(bound1), field2(bound2)"
+func errorBoundsString(bounds map[string]float64) string {
+	fields := make([]string, 0, len(bounds))
+	for field := range bounds {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for i, field := range fields {
+		fields[i] = fmt.Sprintf("%s(%s)", field, strconv.FormatFloat(bounds[field], 'g', -1, 64))
+	}
+	return strings.Join(fields, ", ")
+}
+
 func (e *StatementExecutor) executeShowMeasurementCardinalityStatement(stmt *influxql.ShowMeasurementCardinalityStatement) (models.Rows, error) {
 	if stmt.Database == "" {
 		return nil, coordinator.ErrDatabaseNameRequired
@@ -1996,6 +2149,84 @@ func (e *StatementExecutor) showSeriesCardinality(stmt *influxql.ShowSeriesCardi
 	return rows, nil
 }
 
+// DefaultCardinalityTopLimit is the number of the tag keys listed by SHOW CARDINALITY TOP without LIMIT
+const DefaultCardinalityTopLimit = 10
+
+func (e *StatementExecutor) executeShowCardinalityTop(stmt *influxql.ShowCardinalityTopStatement) (models.Rows, error) {
+	mis, err := e.MetaClient.MatchMeasurements(stmt.Database, stmt.Sources.Measurements())
+	if err != nil {
+		return nil, err
+	}
+	if len(mis) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(mis))
+	for _, m := range mis {
+		names = append(names, m.Name)
+	}
+
+	var ret []*netstorage.TagKeyCardinality
+	lock := new(sync.Mutex)
+	err = e.MetaExecutor.EachDBNodes(stmt.Database, func(nodeID uint64, pts []uint32) error {
+		cardinality, err := e.NetStorage.TagKeysCardinality(nodeID, stmt.Database, pts, names)
+		if err != nil {
+			return err
+		}
+		lock.Lock()
+		ret = append(ret, cardinality...)
+		lock.Unlock()
+		return nil
+	})
+	if err != nil {
+		e.StmtExecLogger.Error("failed to show cardinality top", zap.Error(err))
+		return nil, err
+	}
+	return models.Rows{topTagKeysCardinality(ret, stmt.Limit, stmt.Offset)}, nil
+}
+
+// topTagKeysCardinality merges the cardinality of the tag keys on the store nodes, and lists the tag keys
+// with the most distinct values first, since they multiply the series of the measurements the most.
+// The cardinality is an estimate, the values and the series on different partitions are summed.
+func topTagKeysCardinality(infos []*netstorage.TagKeyCardinality, limit, offset int) *models.Row {
+	merged := make(map[[2]string]*netstorage.TagKeyCardinality, len(infos))
+	keys := make([]*netstorage.TagKeyCardinality, 0, len(infos))
+	for _, info := range infos {
+		k := [2]string{info.Measurement, info.Key}
+		if m, ok := merged[k]; ok {
+			m.Values += info.Values
+			m.Series += info.Series
+			continue
+		}
+		c := *info
+		merged[k] = &c
+		keys = append(keys, &c)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Values != keys[j].Values {
+			return keys[i].Values > keys[j].Values
+		}
+		if keys[i].Series != keys[j].Series {
+			return keys[i].Series > keys[j].Series
+		}
+		if keys[i].Measurement != keys[j].Measurement {
+			return keys[i].Measurement < keys[j].Measurement
+		}
+		return keys[i].Key < keys[j].Key
+	})
+
+	if limit <= 0 {
+		limit = DefaultCardinalityTopLimit
+	}
+	offset = min(offset, len(keys))
+	keys = keys[offset:min(offset+limit, len(keys))]
+
+	row := &models.Row{Columns: []string{"measurement", "tag_key", "values", "series"}}
+	for _, k := range keys {
+		row.Values = append(row.Values, []interface{}{influx.GetOriginMstName(k.Measurement), k.Key, k.Values, k.Series})
+	}
+	return row
+}
+
 func (e *StatementExecutor) showSeriesCardinalityWithCondition(stmt *influxql.ShowSeriesCardinalityStatement, names []string) ([]*models.Row, error) {
 	stime := time.Now()
 	ret := make(map[string]meta2.CardinalityInfos)
@@ -2080,9 +2311,13 @@ func (e *StatementExecutor) showSeriesExactCardinality(stmt *influxql.ShowSeries
 	return rows, nil
 }
 
-func (e *StatementExecutor) executeShowUsersStatement(q *influxql.ShowUsersStatement) (models.Rows, error) {
+func (e *StatementExecutor) executeShowUsersStatement(q *influxql.ShowUsersStatement, tenant string) (models.Rows, error) {
+	ti := e.tenant(tenant)
 	row := &models.Row{Columns: []string{"user", "admin", "rwuser"}}
 	for _, ui := range e.MetaClient.Users() {
+		if ti != nil && !ti.HasUser(ui.Name) {
+			continue
+		}
 		row.Values = append(row.Values, []interface{}{ui.Name, ui.Admin, ui.Rwuser})
 	}
 	return []*models.Row{row}, nil
@@ -2140,7 +2375,187 @@ func (e *StatementExecutor) executeShowQueriesStatement() (models.Rows, error) {
 		values = append(values, cmbInfo.toOutputRow(len(row.Columns), false))
 	}
 	row.Values = values
-	return models.Rows{&row}, nil
+	rows := models.Rows{&row}
+
+	// The backfills of continuous queries are run in the background, show their progress too.
+	if backfills := e.MetaClient.ShowContinuousQueryBackfills(); backfills != nil {
+		rows = append(rows, backfills)
+	}
+	return rows, nil
+}
+
+func (e *StatementExecutor) executeShowCompactionsStatement() (models.Rows, error) {
+	nodes, err := e.MetaClient.DataNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	row := &models.Row{Columns: []string{"node_id", "shard_id", "measurement", "strategy", "level", "files", "size", "status", "start_time"}}
+	for _, node := range nodes {
+		infos, err := e.NetStorage.GetCompactionsOnNode(node.ID)
+		if err != nil {
+			// the node may be offline, the compactions on other nodes are still listed
+			e.StmtExecLogger.Warn("show compactions failed", zap.Uint64("nodeID", node.ID), zap.Error(err))
+			continue
+		}
+
+		for _, info := range infos {
+			status, startTime := "pending", ""
+			if info.Running {
+				status = "running"
+				startTime = time.Unix(0, info.StartTime).UTC().Format(time.RFC3339)
+			}
+			row.Values = append(row.Values, []interface{}{node.ID, info.ShardID, influx.GetOriginMstName(info.Measurement),
+				info.Strategy, info.Level, info.FileCount, info.FileSize, status, startTime})
+		}
+	}
+	return models.Rows{row}, nil
+}
+
+func (e *StatementExecutor) executeShowRepairsStatement() (models.Rows, error) {
+	nodes, err := e.MetaClient.DataNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	row := &models.Row{Columns: []string{"node_id", "database", "pt_id", "shard_id", "measurement", "peer_pt", "ranges",
+		"state", "rows", "detected_at", "updated_at", "error"}}
+	for _, node := range nodes {
+		repairs, err := e.NetStorage.GetRepairsOnNode(node.ID)
+		if err != nil {
+			e.StmtExecLogger.Warn("show repairs failed", zap.Uint64("nodeID", node.ID), zap.Error(err))
+			continue
+		}
+
+		for _, r := range repairs {
+			row.Values = append(row.Values, []interface{}{node.ID, r.Db, r.PtId, r.ShardID, influx.GetOriginMstName(r.Measurement),
+				r.PeerPtId, r.Ranges, r.State, r.Rows, time.Unix(0, r.DetectTime).UTC().Format(time.RFC3339),
+				time.Unix(0, r.UpdateTime).UTC().Format(time.RFC3339), r.Error})
+		}
+	}
+	return models.Rows{row}, nil
+}
+
+func (e *StatementExecutor) executeShowRebalanceStatement() (models.Rows, error) {
+	nodes, err := e.MetaClient.DataNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	row := &models.Row{Columns: []string{"node_id", "database", "pt_id", "source_node", "phase", "state", "files", "total_files",
+		"bytes", "total_bytes", "started_at", "updated_at", "error"}}
+	for _, node := range nodes {
+		transfers, err := e.NetStorage.GetRebalanceOnNode(node.ID)
+		if err != nil {
+			e.StmtExecLogger.Warn("show rebalance failed", zap.Uint64("nodeID", node.ID), zap.Error(err))
+			continue
+		}
+
+		for _, t := range transfers {
+			row.Values = append(row.Values, []interface{}{node.ID, t.Db, t.PtId, t.SrcNodeId, t.Phase, t.State, t.Files, t.TotalFiles,
+				t.Bytes, t.TotalBytes, time.Unix(0, t.StartTime).UTC().Format(time.RFC3339),
+				time.Unix(0, t.UpdateTime).UTC().Format(time.RFC3339), t.Error})
+		}
+	}
+	return models.Rows{row}, nil
+}
+
+func (e *StatementExecutor) executeShowDecommissionStatement() (models.Rows, error) {
+	infos, err := e.MetaClient.ShowDecommissions()
+	if err != nil {
+		return nil, err
+	}
+
+	row := &models.Row{Columns: []string{"node_id", "state", "moved_pts", "total_pts", "started_at", "updated_at", "error"}}
+	for _, info := range infos {
+		row.Values = append(row.Values, []interface{}{info.NodeID, info.State, info.MovedPts, info.TotalPts,
+			time.Unix(0, info.StartTime).UTC().Format(time.RFC3339), time.Unix(0, info.UpdateTime).UTC().Format(time.RFC3339), info.Error})
+	}
+	return models.Rows{row}, nil
+}
+
+func (e *StatementExecutor) executeCreateReplicationStatement(stmt *influxql.CreateReplicationStatement) error {
+	role := meta2.ReplicationPrimary
+	if stmt.Standby {
+		role = meta2.ReplicationStandby
+	}
+	return e.MetaClient.CreateReplication(stmt.Database, stmt.Target, role)
+}
+
+func (e *StatementExecutor) executeCreateTenantStatement(stmt *influxql.CreateTenantStatement) error {
+	if !meta2.ValidName(stmt.Name) {
+		return meta2.ErrInvalidName
+	}
+	return e.MetaClient.CreateTenant(stmt.Name, tenantQuota(stmt.Options), stmt.Options.Nodes)
+}
+
+func (e *StatementExecutor) executeAlterTenantStatement(stmt *influxql.AlterTenantStatement) error {
+	return e.MetaClient.AlterTenant(stmt.Name, tenantQuota(stmt.Options), stmt.Options.Nodes, stmt.Options.SetNodes)
+}
+
+// tenantQuota converts the options of the statement to the quota, the options not set are negative
+func tenantQuota(opts influxql.TenantOptions) meta2.TenantQuota {
+	return meta2.TenantQuota{
+		MaxSeries:           opts.MaxSeries,
+		MaxStorageBytes:     opts.MaxStorageBytes,
+		MaxIngestRate:       opts.MaxIngestRate,
+		MaxQueryConcurrency: opts.MaxQueryConcurrency,
+	}
+}
+
+// tenant returns the tenant the query runs in, nil if the query does not run in any tenant
+func (e *StatementExecutor) tenant(name string) *meta2.TenantInfo {
+	if name == "" {
+		return nil
+	}
+	return e.MetaClient.Tenant(name)
+}
+
+// assignTenantDatabase assigns the database created by a user of the tenant to the tenant before the database
+// is created, so that the pts of the database are placed on the pinned nodes of the tenant. An existing database
+// is only accessible to the tenant owning it.
+func (e *StatementExecutor) assignTenantDatabase(tenant, database string) error {
+	if tenant == "" {
+		return nil
+	}
+	if owner := e.MetaClient.DatabaseTenant(database); owner != nil {
+		if owner.Name != tenant {
+			return meta2.ErrOwnedByOtherTenant("database", database, owner.Name)
+		}
+		return nil
+	}
+	if dbi, _ := e.MetaClient.Database(database); dbi != nil {
+		return fmt.Errorf("database %s is not owned by tenant %s", database, tenant)
+	}
+	return e.MetaClient.UpdateTenantMember(tenant, database, "", false)
+}
+
+// executeShowReplicationsStatement lists the replicated databases, the shards which have been shipped to the target
+// and the last time of shipping
+func (e *StatementExecutor) executeShowReplicationsStatement() models.Rows {
+	var names []string
+	databases := e.MetaClient.Databases()
+	for name, dbi := range databases {
+		if dbi.Replication != nil && !dbi.MarkDeleted {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	row := &models.Row{Columns: []string{"database", "role", "target", "shards", "last_checkpoint"}}
+	for _, name := range names {
+		ri := databases[name].Replication
+		var last int64
+		for _, cp := range ri.Checkpoints {
+			last = max(last, cp.UpdateTime)
+		}
+		lastCheckpoint := ""
+		if last > 0 {
+			lastCheckpoint = time.Unix(0, last).UTC().Format(time.RFC3339)
+		}
+		row.Values = append(row.Values, []interface{}{name, ri.Role, ri.Target, len(ri.Checkpoints), lastCheckpoint})
+	}
+	return models.Rows{row}
 }
 
 func (e *StatementExecutor) getQueryExeInfoOnNode(nodeID uint64) []*netstorage.QueryExeInfo {
@@ -2332,6 +2747,10 @@ func (e *StatementExecutor) NormalizeStatement(stmt influxql.Statement, defaultD
 			if node.Database == "" {
 				node.Database = defaultDatabase
 			}
+		case *influxql.ShowCardinalityTopStatement:
+			if node.Database == "" {
+				node.Database = defaultDatabase
+			}
 		case *influxql.CreateMeasurementStatement:
 			if node.Database == "" {
 				node.Database = defaultDatabase
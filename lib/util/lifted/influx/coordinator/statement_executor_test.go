@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/influxdata/influxdb/models"
 	"github.com/openGemini/openGemini/lib/errno"
 	Logger "github.com/openGemini/openGemini/lib/logger"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
@@ -15,6 +16,7 @@ import (
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
 
@@ -44,6 +46,37 @@ func (m *MockMetaClient) CreateContinuousQuery(database, name, query string) err
 	return nil
 }
 
+func (m *MockMetaClient) ShowDecommissions() ([]meta2.DecommissionInfo, error) {
+	return []meta2.DecommissionInfo{{NodeID: 3, State: meta2.DecommissionMoving, TotalPts: 4, MovedPts: 1}}, nil
+}
+
+func (m *MockMetaClient) Databases() map[string]*meta2.DatabaseInfo {
+	return map[string]*meta2.DatabaseInfo{
+		"db0": {Name: "db0"},
+		"db1": {Name: "db1", Replication: &meta2.ReplicationInfo{Target: "http://127.0.0.2:8086", Role: meta2.ReplicationStandby}},
+		"db2": {Name: "db2", Replication: &meta2.ReplicationInfo{Target: "http://127.0.0.1:8086", Role: meta2.ReplicationPrimary,
+			Checkpoints: map[uint64]meta2.ReplicationCheckpoint{1: {Seq: 10, UpdateTime: 1}, 2: {Seq: 20, UpdateTime: 2e9}}}},
+	}
+}
+
+func (m *MockMetaClient) CreateReplication(database, target, role string) error {
+	if role != meta2.ReplicationStandby {
+		return errors.New("unexpected role")
+	}
+	return nil
+}
+
+func (m *MockMetaClient) BackfillContinuousQuery(database, name string, start, end time.Time) error {
+	if name != "cq0" {
+		return meta2.ErrContinuousQueryNotFound
+	}
+	return nil
+}
+
+func (m *MockMetaClient) ShowContinuousQueryBackfills() *models.Row {
+	return &models.Row{Name: "continuous query backfills", Columns: []string{"name"}, Values: [][]interface{}{{"cq0"}}}
+}
+
 type MockShardMapper struct {
 	query.ShardMapper
 }
@@ -203,6 +236,234 @@ func TestStatementExecutor_executeShowQueriesStatement(t *testing.T) {
 	assert.NoError(t, err)
 	// there is a one has been killed in all hosts
 	assert.Equal(t, mockInfosNum-1, len(rows[0].Values))
+	// the backfills of continuous queries
+	require.Equal(t, 2, len(rows))
+	assert.Equal(t, "continuous query backfills", rows[1].Name)
+}
+
+func TestStatementExecutor_executeBackfillContinuousQueryStatement(t *testing.T) {
+	e := newMockStatementExecutor()
+	end := time.Now().Add(-time.Hour)
+	stmt := &influxql.BackfillContinuousQueryStatement{Name: "cq0", Database: "db0", StartTime: end.Add(-time.Hour), EndTime: end}
+	require.NoError(t, e.executeBackfillContinuousQueryStatement(stmt))
+
+	stmt.Name = "cq1"
+	require.EqualError(t, e.executeBackfillContinuousQueryStatement(stmt), meta2.ErrContinuousQueryNotFound.Error())
+
+	stmt.StartTime = stmt.EndTime
+	require.EqualError(t, e.executeBackfillContinuousQueryStatement(stmt), meta2.ErrInvalidBackfillTimeRange.Error())
+
+	stmt.EndTime = time.Now().Add(time.Hour)
+	require.ErrorContains(t, e.executeBackfillContinuousQueryStatement(stmt), "is later than now")
+}
+
+func (s *mockNS) GetCompactionsOnNode(nodeID uint64) ([]*netstorage.CompactionInfo, error) {
+	if nodeID == 1 {
+		return nil, errors.New("node is offline")
+	}
+	return []*netstorage.CompactionInfo{
+		{ShardID: nodeID, Measurement: "cpu_0000", Strategy: "level", Level: 1, FileCount: 8, Running: true, StartTime: 1},
+		{ShardID: nodeID, Measurement: "mem_0000", Strategy: "level", Level: 2, FileCount: 4},
+	}, nil
+}
+
+func TestStatementExecutor_executeShowCompactionsStatement(t *testing.T) {
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: &mockNS{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	rows, err := e.executeShowCompactionsStatement()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rows))
+	assert.Equal(t, (dataNodesNum-1)*2, len(rows[0].Values))
+	assert.Equal(t, []interface{}{uint64(2), uint64(2), "cpu", "level", uint16(1), int64(8), int64(0), "running", "1970-01-01T00:00:00Z"}, rows[0].Values[0])
+	assert.Equal(t, "pending", rows[0].Values[1][7])
+}
+
+func (s *mockNS) GetRepairsOnNode(nodeID uint64) ([]*netstorage.RepairInfo, error) {
+	if nodeID == 1 {
+		return nil, errors.New("node is offline")
+	}
+	return []*netstorage.RepairInfo{
+		{Db: "db0", PtId: uint32(nodeID), ShardID: 1, Measurement: "cpu_0000", PeerPtId: 0, Ranges: 2,
+			State: "repaired", Rows: 20, DetectTime: 1, UpdateTime: 2},
+	}, nil
+}
+
+func TestStatementExecutor_executeShowRepairsStatement(t *testing.T) {
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: &mockNS{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	rows, err := e.executeShowRepairsStatement()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rows))
+	assert.Equal(t, dataNodesNum-1, len(rows[0].Values))
+	assert.Equal(t, []interface{}{uint64(2), "db0", uint32(2), uint64(1), "cpu", uint32(0), uint32(2), "repaired", int64(20),
+		"1970-01-01T00:00:00Z", "1970-01-01T00:00:00Z", ""}, rows[0].Values[0])
+}
+
+func (s *mockNS) GetRebalanceOnNode(nodeID uint64) ([]*netstorage.PtTransferInfo, error) {
+	if nodeID == 1 {
+		return nil, errors.New("node is offline")
+	}
+	return []*netstorage.PtTransferInfo{
+		{Db: "db0", PtId: uint32(nodeID), SrcNodeId: 1, Phase: netstorage.PtTransferBulk, State: "running",
+			Files: 1, TotalFiles: 2, Bytes: 100, TotalBytes: 200, StartTime: 1, UpdateTime: 2},
+	}, nil
+}
+
+func TestStatementExecutor_executeShowRebalanceStatement(t *testing.T) {
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: &mockNS{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	rows, err := e.executeShowRebalanceStatement()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rows))
+	assert.Equal(t, dataNodesNum-1, len(rows[0].Values))
+	assert.Equal(t, []interface{}{uint64(2), "db0", uint32(2), uint64(1), "bulk", "running", int64(1), int64(2), int64(100),
+		int64(200), "1970-01-01T00:00:00Z", "1970-01-01T00:00:00Z", ""}, rows[0].Values[0])
+}
+
+func TestStatementExecutor_executeShowDecommissionStatement(t *testing.T) {
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: &mockNS{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	rows, err := e.executeShowDecommissionStatement()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rows))
+	assert.Equal(t, []interface{}{uint64(3), "moving", 1, 4, "1970-01-01T00:00:00Z", "1970-01-01T00:00:00Z", ""}, rows[0].Values[0])
+}
+
+func TestStatementExecutor_executeReplicationStatements(t *testing.T) {
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	require.NoError(t, e.executeCreateReplicationStatement(&influxql.CreateReplicationStatement{Database: "db1", Target: "http://127.0.0.2:8086", Standby: true}))
+
+	rows := e.executeShowReplicationsStatement()
+	require.Equal(t, 1, len(rows))
+	assert.Equal(t, []string{"database", "role", "target", "shards", "last_checkpoint"}, rows[0].Columns)
+	assert.Equal(t, [][]interface{}{
+		{"db1", "standby", "http://127.0.0.2:8086", 0, ""},
+		{"db2", "primary", "http://127.0.0.1:8086", 2, "1970-01-01T00:00:02Z"},
+	}, rows[0].Values)
+}
+
+// mockTenantMetaClient keeps the tenants in the meta data, the databases are the ones of MockMetaClient
+type mockTenantMetaClient struct {
+	MockMetaClient
+	data *meta2.Data
+}
+
+func (m *mockTenantMetaClient) Database(name string) (*meta2.DatabaseInfo, error) {
+	if dbi, ok := m.Databases()[name]; ok {
+		return dbi, nil
+	}
+	return nil, errno.NewError(errno.DatabaseNotFound, name)
+}
+
+func (m *mockTenantMetaClient) CreateUser(name, password string, admin, rwuser bool) (meta2.User, error) {
+	m.data.Users = append(m.data.Users, meta2.UserInfo{Name: name, Admin: admin, Rwuser: rwuser})
+	return &m.data.Users[len(m.data.Users)-1], nil
+}
+
+func (m *mockTenantMetaClient) Users() []meta2.UserInfo {
+	return m.data.Users
+}
+
+func (m *mockTenantMetaClient) CreateTenant(name string, quota meta2.TenantQuota, nodes []uint64) error {
+	return m.data.CreateTenant(name, quota, nodes)
+}
+
+func (m *mockTenantMetaClient) AlterTenant(name string, quota meta2.TenantQuota, nodes []uint64, setNodes bool) error {
+	return m.data.AlterTenant(name, quota, nodes, setNodes)
+}
+
+func (m *mockTenantMetaClient) DropTenant(name string) error {
+	return m.data.DropTenant(name)
+}
+
+func (m *mockTenantMetaClient) UpdateTenantMember(name, database, user string, remove bool) error {
+	if database != "" {
+		if remove {
+			return m.data.RemoveTenantDatabase(name, database)
+		}
+		return m.data.AddTenantDatabase(name, database)
+	}
+	if remove {
+		return m.data.RemoveTenantUser(name, user)
+	}
+	return m.data.AddTenantUser(name, user)
+}
+
+func (m *mockTenantMetaClient) Tenant(name string) *meta2.TenantInfo {
+	return m.data.Tenant(name)
+}
+
+func (m *mockTenantMetaClient) DatabaseTenant(database string) *meta2.TenantInfo {
+	return m.data.DatabaseTenant(database)
+}
+
+func (m *mockTenantMetaClient) ShowTenants() models.Rows {
+	return m.data.ShowTenants()
+}
+
+func TestStatementExecutor_executeTenantStatements(t *testing.T) {
+	client := &mockTenantMetaClient{data: &meta2.Data{}}
+	e := StatementExecutor{MetaClient: client, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	ctx := &query.ExecutionContext{}
+	ctx.ExecutionOptions.Authorizer = query.OpenAuthorizer
+	exec := func(sql string) error {
+		stmt, err := influxql.ParseStatement(sql)
+		require.NoError(t, err)
+		return e.ExecuteStatement(stmt, ctx, 0)
+	}
+
+	require.NoError(t, exec("CREATE TENANT t1 WITH max_series = 100, max_query_concurrency = 2"))
+	require.NoError(t, exec("ALTER TENANT t1 WITH max_series = 200"))
+	require.NoError(t, exec("CREATE TENANT t2"))
+	require.NoError(t, exec("GRANT DATABASE db0 TO TENANT t1"))
+	assert.Equal(t, int64(200), client.data.Tenant("t1").Quota.MaxSeries)
+	assert.Equal(t, int64(2), client.data.Tenant("t1").Quota.MaxQueryConcurrency)
+
+	rows := e.MetaClient.ShowTenants()
+	require.Equal(t, 2, len(rows[0].Values))
+	assert.Equal(t, "db0", rows[0].Values[0][8])
+
+	// the users and the databases created in the tenant are owned by the tenant
+	ctx.ExecutionOptions.Tenant = "t1"
+	require.NoError(t, exec("CREATE USER u1 WITH PASSWORD 'pwd'"))
+	assert.True(t, client.data.Tenant("t1").HasUser("u1"))
+	require.NoError(t, e.assignTenantDatabase("t1", "db3"))
+	assert.True(t, client.data.Tenant("t1").HasDatabase("db3"))
+	require.NoError(t, e.assignTenantDatabase("t1", "db0"))
+	assert.EqualError(t, e.assignTenantDatabase("t1", "db1"), "database db1 is not owned by tenant t1")
+	assert.EqualError(t, e.assignTenantDatabase("t2", "db0"), "database db0 is owned by tenant t1")
+	require.NoError(t, e.assignTenantDatabase("", "db1"))
+
+	showDbs, err := e.executeShowDatabasesStatement(&influxql.ShowDatabasesStatement{}, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [][]interface{}{{"db0"}}, showDbs[0].Values)
+
+	ctx.ExecutionOptions.Tenant = ""
+	assert.Error(t, exec("DROP TENANT t1"))
+	require.NoError(t, exec("REVOKE DATABASE db0 FROM TENANT t1"))
+	require.NoError(t, exec("REVOKE DATABASE db3 FROM TENANT t1"))
+	require.NoError(t, exec("REVOKE USER u1 FROM TENANT t1"))
+	require.NoError(t, exec("DROP TENANT t1"))
+	assert.Nil(t, client.data.Tenant("t1"))
+}
+
+func TestTopTagKeysCardinality(t *testing.T) {
+	infos := []*netstorage.TagKeyCardinality{
+		{Measurement: "cpu_0000", Key: "region", Values: 3, Series: 1000},
+		{Measurement: "cpu_0000", Key: "request_id", Values: 600, Series: 1000},
+		{Measurement: "mem_0000", Key: "host", Values: 10, Series: 10},
+		{Measurement: "cpu_0000", Key: "request_id", Values: 400, Series: 500},
+		{Measurement: "cpu_0000", Key: "region", Values: 3, Series: 500},
+	}
+	row := topTagKeysCardinality(infos, 0, 0)
+	require.Equal(t, []string{"measurement", "tag_key", "values", "series"}, row.Columns)
+	require.Equal(t, [][]interface{}{
+		{"cpu", "request_id", uint64(1000), uint64(1500)},
+		{"mem", "host", uint64(10), uint64(10)},
+		{"cpu", "region", uint64(6), uint64(1500)},
+	}, row.Values)
+	// the cardinality of the store nodes is not modified by merging
+	require.Equal(t, uint64(600), infos[1].Values)
+
+	row = topTagKeysCardinality(infos, 1, 1)
+	require.Equal(t, [][]interface{}{{"mem", "host", uint64(10), uint64(10)}}, row.Values)
+	require.Equal(t, 0, len(topTagKeysCardinality(infos, 1, 5).Values))
 }
 
 func Test_combinedQueryExeInfo_getCombinedRunState(t *testing.T) {
@@ -397,3 +658,27 @@ func TestStatementExecutor_NormalizeStatement(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestGetMeasurementOptions(t *testing.T) {
+	options, err := getMeasurementOptions(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, options)
+
+	options, err = getMeasurementOptions([][]string{
+		{"FLOAT_CODEC", "error_bound.Temp", "error_bound.hum"},
+		{"ALP", "0.01", "1e-3"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "alp", options.FloatCodec)
+	assert.Equal(t, map[string]float64{"Temp": 0.01, "hum": 0.001}, options.ErrorBounds)
+	assert.Equal(t, "Temp(0.01), hum(0.001)", errorBoundsString(options.ErrorBounds))
+
+	_, err = getMeasurementOptions([][]string{{"error_bound.temp"}, {"abc"}})
+	assert.Error(t, err)
+	_, err = getMeasurementOptions([][]string{{"error_bound.temp"}, {"-1"}})
+	assert.Error(t, err)
+	_, err = getMeasurementOptions([][]string{{"error_bound."}, {"1"}})
+	assert.Error(t, err)
+	_, err = getMeasurementOptions([][]string{{"int_codec"}, {"xxx"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consume
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	DefaultPullCount = 100
+	MaxPullCount     = 1000
+
+	PullFormatJson     = "json"
+	PullFormatProtobuf = "protobuf"
+
+	ProtobufContentType = "application/x-protobuf"
+)
+
+// Field numbers of the protobuf encoded PullLogsResponse:
+//
+//	message PullLogsResponse {
+//	  int64 total_count = 1;
+//	  int64 total_size = 2;
+//	  int64 took_ms = 3;
+//	  string next_cursor = 4;
+//	  repeated google.protobuf.Struct logs = 5;
+//	}
+//
+// google.protobuf.Struct only holds double numbers, so the integer values of the logs,
+// such as the nanosecond timestamps, are encoded as decimal strings to keep their
+// precision, the same as the proto3 json mapping does for int64.
+const (
+	pullFieldTotalCount protowire.Number = iota + 1
+	pullFieldTotalSize
+	pullFieldTookMs
+	pullFieldNextCursor
+	pullFieldLogs
+)
+
+type PullCursorRequest struct {
+	From     int64
+	Shard    int
+	HasShard bool
+}
+
+type QueryPullCursorResponse struct {
+	Cursor string `json:"cursor"`
+}
+
+type PullLogsRequest struct {
+	*ConsumeLogsRequest
+	Format string
+}
+
+type PullLogsResponse struct {
+	TotalCount int                      `json:"total_count"`
+	TotalSize  int64                    `json:"total_size,omitempty"`
+	TookMs     int64                    `json:"took_ms,omitempty"`
+	NextCursor string                   `json:"next_cursor"`
+	Logs       []map[string]interface{} `json:"logs"`
+}
+
+// MarshalProto encodes the response as a PullLogsResponse protobuf message.
+func (p *PullLogsResponse) MarshalProto() ([]byte, error) {
+	var dst []byte
+	dst = protowire.AppendTag(dst, pullFieldTotalCount, protowire.VarintType)
+	dst = protowire.AppendVarint(dst, uint64(p.TotalCount))
+	dst = protowire.AppendTag(dst, pullFieldTotalSize, protowire.VarintType)
+	dst = protowire.AppendVarint(dst, uint64(p.TotalSize))
+	dst = protowire.AppendTag(dst, pullFieldTookMs, protowire.VarintType)
+	dst = protowire.AppendVarint(dst, uint64(p.TookMs))
+	dst = protowire.AppendTag(dst, pullFieldNextCursor, protowire.BytesType)
+	dst = protowire.AppendString(dst, p.NextCursor)
+	for _, log := range p.Logs {
+		s, err := newLogStruct(log)
+		if err != nil {
+			return nil, err
+		}
+		b, err := proto.Marshal(s)
+		if err != nil {
+			return nil, err
+		}
+		dst = protowire.AppendTag(dst, pullFieldLogs, protowire.BytesType)
+		dst = protowire.AppendBytes(dst, b)
+	}
+	return dst, nil
+}
+
+func newLogStruct(log map[string]interface{}) (*structpb.Struct, error) {
+	s := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(log))}
+	for k, v := range log {
+		value, err := newLogValue(v)
+		if err != nil {
+			return nil, err
+		}
+		s.Fields[k] = value
+	}
+	return s, nil
+}
+
+func newLogValue(v interface{}) (*structpb.Value, error) {
+	switch v := v.(type) {
+	case int:
+		return structpb.NewStringValue(strconv.FormatInt(int64(v), 10)), nil
+	case int32:
+		return structpb.NewStringValue(strconv.FormatInt(int64(v), 10)), nil
+	case int64:
+		return structpb.NewStringValue(strconv.FormatInt(v, 10)), nil
+	case uint32:
+		return structpb.NewStringValue(strconv.FormatUint(uint64(v), 10)), nil
+	case uint64:
+		return structpb.NewStringValue(strconv.FormatUint(v, 10)), nil
+	case map[string]interface{}:
+		s, err := newLogStruct(v)
+		if err != nil {
+			return nil, err
+		}
+		return structpb.NewStructValue(s), nil
+	case []interface{}:
+		values := make([]*structpb.Value, len(v))
+		for i := range v {
+			value, err := newLogValue(v[i])
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		return structpb.NewListValue(&structpb.ListValue{Values: values}), nil
+	default:
+		return structpb.NewValue(v)
+	}
+}
+
+// UnmarshalProto decodes a PullLogsResponse protobuf message.
+func (p *PullLogsResponse) UnmarshalProto(b []byte) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case typ == protowire.VarintType && num <= pullFieldTookMs:
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			b = b[n:]
+			switch num {
+			case pullFieldTotalCount:
+				p.TotalCount = int(v)
+			case pullFieldTotalSize:
+				p.TotalSize = int64(v)
+			default:
+				p.TookMs = int64(v)
+			}
+		case typ == protowire.BytesType && (num == pullFieldNextCursor || num == pullFieldLogs):
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			b = b[n:]
+			if num == pullFieldNextCursor {
+				p.NextCursor = string(v)
+				continue
+			}
+			s := &structpb.Struct{}
+			if err := proto.Unmarshal(v, s); err != nil {
+				return err
+			}
+			p.Logs = append(p.Logs, s.AsMap())
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			b = b[n:]
+		}
+	}
+	return nil
+}
+
+// EncodeToURLString encodes the cursor so that it can be used as a path segment.
+func (p *ConsumeCursor) EncodeToURLString() (string, error) {
+	b, err := p.EncodeToByte()
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func GetPullCursorRequest(r *http.Request, minFrom, maxFrom int64) (*PullCursorRequest, error) {
+	var err error
+	req := &PullCursorRequest{}
+	if from := r.FormValue("from"); from != "" {
+		req.From, err = strconv.ParseInt(from, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("from value is illegal")
+		}
+	}
+	if req.From < minFrom || req.From > maxFrom {
+		return nil, fmt.Errorf("the valid range for from is [%d, %d]", minFrom, maxFrom)
+	}
+	req.From = req.From * int64(1e6)
+
+	if shard := r.FormValue("shard"); shard != "" {
+		req.Shard, err = strconv.Atoi(shard)
+		if err != nil || req.Shard < 0 {
+			return nil, fmt.Errorf("shard value is illegal")
+		}
+		req.HasShard = true
+	}
+	return req, nil
+}
+
+// GetPullLogsRequest parses the pull request, the cursor is the one in the url path.
+func GetPullLogsRequest(r *http.Request, cursor string) (*PullLogsRequest, error) {
+	cursorByte, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(cursorByte) == 0 {
+		return nil, fmt.Errorf("cursor is illegal")
+	}
+	req := &PullLogsRequest{
+		ConsumeLogsRequest: &ConsumeLogsRequest{FromCursor: base64.StdEncoding.EncodeToString(cursorByte)},
+	}
+
+	req.Count = DefaultPullCount
+	if count := r.FormValue("count"); count != "" {
+		req.Count, err = strconv.ParseInt(count, 10, 64)
+		if err != nil || req.Count <= 0 || req.Count > MaxPullCount {
+			return nil, fmt.Errorf("count value is illegal")
+		}
+	}
+
+	if len(r.FormValue("query")) > MaxQueryLen {
+		return nil, fmt.Errorf("query is bigger than %d", MaxQueryLen)
+	}
+	req.Query = removeLastSelectStr(r.FormValue("query"))
+
+	switch format := strings.ToLower(r.FormValue("format")); format {
+	case "":
+		req.Format = PullFormatJson
+		if strings.Contains(r.Header.Get("Accept"), ProtobufContentType) {
+			req.Format = PullFormatProtobuf
+		}
+	case PullFormatJson, PullFormatProtobuf:
+		req.Format = format
+	default:
+		return nil, fmt.Errorf("format value is illegal")
+	}
+	return req, nil
+}
@@ -0,0 +1,110 @@
+package consume
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullCursorEncode(t *testing.T) {
+	cursor := &ConsumeCursor{TaskNum: 2, CursorID: 1, CurrTotalPtNum: 2, Time: 1000}
+	cursor.Tasks = append(cursor.Tasks, &ConsumeSegmentTask{PtID: 1, CurrTask: &ConsumeTask{MetaIndexId: 1, BlockID: 2, Timestamp: 1000, RemotePath: "shard", SgID: 1}})
+	encode, err := cursor.EncodeToURLString()
+	require.NoError(t, err)
+	assert.False(t, strings.ContainsAny(encode, "/+="))
+
+	r, _ := http.NewRequest("GET", "/repo/r/logstreams/l/cursor/"+encode+"?count=10&format=protobuf", nil)
+	req, err := GetPullLogsRequest(r, encode)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), req.Count)
+	assert.Equal(t, PullFormatProtobuf, req.Format)
+
+	decoded, err := GetConsumeCursor(req.FromCursor)
+	require.NoError(t, err)
+	assert.Equal(t, cursor.TaskNum, decoded.TaskNum)
+	assert.Equal(t, cursor.CursorID, decoded.CursorID)
+	assert.Equal(t, cursor.Time, decoded.Time)
+	require.Equal(t, 1, len(decoded.Tasks))
+	assert.Equal(t, "shard", decoded.Tasks[0].CurrTask.RemotePath)
+}
+
+func TestGetPullLogsRequest(t *testing.T) {
+	cursor, err := (&ConsumeCursor{TaskNum: 1}).EncodeToURLString()
+	require.NoError(t, err)
+
+	r, _ := http.NewRequest("GET", "/", nil)
+	r.Header.Set("Accept", ProtobufContentType)
+	req, err := GetPullLogsRequest(r, cursor)
+	require.NoError(t, err)
+	assert.Equal(t, int64(DefaultPullCount), req.Count)
+	assert.Equal(t, PullFormatProtobuf, req.Format)
+
+	r, _ = http.NewRequest("GET", "/", nil)
+	req, err = GetPullLogsRequest(r, cursor)
+	require.NoError(t, err)
+	assert.Equal(t, PullFormatJson, req.Format)
+
+	for _, query := range []string{"count=0", "count=1001", "count=a", "format=xml"} {
+		r, _ = http.NewRequest("GET", "/?"+query, nil)
+		_, err = GetPullLogsRequest(r, cursor)
+		assert.Error(t, err, query)
+	}
+	_, err = GetPullLogsRequest(r, "")
+	assert.Error(t, err)
+	_, err = GetPullLogsRequest(r, "a+b/")
+	assert.Error(t, err)
+}
+
+func TestGetPullCursorRequest(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/?from=1000&shard=1", nil)
+	req, err := GetPullCursorRequest(r, 0, 2000)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000*1e6), req.From)
+	assert.True(t, req.HasShard)
+	assert.Equal(t, 1, req.Shard)
+
+	r, _ = http.NewRequest("GET", "/", nil)
+	req, err = GetPullCursorRequest(r, 0, 2000)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), req.From)
+	assert.False(t, req.HasShard)
+
+	for _, query := range []string{"from=a", "from=3000", "shard=-1", "shard=a"} {
+		r, _ = http.NewRequest("GET", "/?"+query, nil)
+		_, err = GetPullCursorRequest(r, 0, 2000)
+		assert.Error(t, err, query)
+	}
+}
+
+func TestPullLogsResponseProto(t *testing.T) {
+	resp := &PullLogsResponse{
+		TotalCount: 2,
+		TotalSize:  100,
+		TookMs:     3,
+		NextCursor: "cursor",
+		Logs: []map[string]interface{}{
+			{"time": int64(1700000000123456789), "content": "a", "ok": true, "tags": map[string]interface{}{"n": 1}},
+			{"time": int64(2), "content": "b", "value": 1.5},
+		},
+	}
+	b, err := resp.MarshalProto()
+	require.NoError(t, err)
+
+	decoded := &PullLogsResponse{}
+	require.NoError(t, decoded.UnmarshalProto(b))
+	assert.Equal(t, resp.TotalCount, decoded.TotalCount)
+	assert.Equal(t, resp.TotalSize, decoded.TotalSize)
+	assert.Equal(t, resp.TookMs, decoded.TookMs)
+	assert.Equal(t, resp.NextCursor, decoded.NextCursor)
+	require.Equal(t, 2, len(decoded.Logs))
+	assert.Equal(t, "1700000000123456789", decoded.Logs[0]["time"])
+	assert.Equal(t, map[string]interface{}{"n": "1"}, decoded.Logs[0]["tags"])
+	assert.Equal(t, "a", decoded.Logs[0]["content"])
+	assert.Equal(t, true, decoded.Logs[0]["ok"])
+	assert.Equal(t, 1.5, decoded.Logs[1]["value"])
+
+	assert.Error(t, decoded.UnmarshalProto(b[:len(b)-1]))
+}
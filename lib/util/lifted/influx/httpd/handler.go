@@ -150,6 +150,10 @@ type Handler struct {
 		UpdateMeasurement(db, rp, mst string, options *meta2.Options) error
 		GetShardGroupByTimeRange(repoName, streamName string, min, max time.Time) ([]*meta2.ShardGroupInfo, error)
 		RevertRetentionPolicyDelete(database, name string) error
+
+		Tenant(name string) *meta2.TenantInfo
+		UserTenant(user string) *meta2.TenantInfo
+		UpdateTenantMember(name, database, user string, remove bool) error
 	}
 
 	QueryAuthorizer interface {
@@ -164,6 +168,10 @@ type Handler struct {
 		SendSysCtrlOnNode(nodID uint64, req netstorage.SysCtrlRequest) (map[string]string, error)
 	}
 
+	LogTailer interface {
+		TailLogs(nodeID uint64, req *netstorage.TailLogsRequest) (*netstorage.TailLogsResponse, error)
+	}
+
 	QueryExecutor *query.Executor
 
 	Monitor interface {
@@ -458,6 +466,14 @@ func NewHandler(c config.Config) *Handler {
 				"log-agg", // Query for Log.
 				"GET", "/repo/{repository}/logstreams/{logStream}/analytics", true, true, h.serveAnalytics,
 			},
+			Route{
+				"log-patterns", // Cluster Log into patterns.
+				"GET", "/repo/{repository}/logstreams/{logStream}/patterns", true, true, h.serveLogPatterns,
+			},
+			Route{
+				"log-tail", // Live tail for Log.
+				"GET", "/repo/{repository}/logstreams/{logStream}/tail", true, true, h.serveTailLog,
+			},
 			Route{
 				"log-cursor", // Get Cursor for Log.
 				"GET", "/repo/{repository}/logstreams/{logStream}/cursor", true, true, h.serveGetCursor,
@@ -565,7 +581,8 @@ func (h *Handler) AddRoutes(routes ...Route) {
 				handler = h.queryThrottler.Handler(handler)
 			case "/repo/{repository}/logstreams/{logStream}/logs", "/repo/{repository}/logstreams/{logStream}/consume/logs",
 				"/repo/{repository}/logstreams/{logStream}/context", "/repo/{repository}/logstreams/{logStream}/histogram",
-				"/repo/{repository}/logstreams/{logStream}/analytics", "/repo/{repository}/logstreams/{logStream}/logbycursor":
+				"/repo/{repository}/logstreams/{logStream}/analytics", "/repo/{repository}/logstreams/{logStream}/logbycursor",
+				"/repo/{repository}/logstreams/{logStream}/patterns":
 				handler = h.queryThrottler.Handler(handler)
 			default:
 			}
@@ -725,7 +742,7 @@ func (h *Handler) parseQueryParams(r *http.Request) (map[string]interface{}, err
 	return params, nil
 }
 
-func (h *Handler) checkAuthorization(user meta2.User, query *influxql.Query, database string) error {
+func (h *Handler) checkAuthorization(r *http.Request, user meta2.User, query *influxql.Query, database string) error {
 	// Check authorization.
 	if !h.Config.AuthEnabled {
 		return nil
@@ -746,6 +763,14 @@ func (h *Handler) checkAuthorization(user meta2.User, query *influxql.Query, dat
 		h.Logger.Error("query error! authorizing query", zap.Error(err), zap.String("db", database), zap.String("userID", userID))
 		return err
 	}
+	// the databases of the other tenants are not accessible even to the admin users of a tenant
+	if t := h.requestTenant(r); t != nil {
+		if err := t.AuthorizeQuery(database, query); err != nil {
+			h.Logger.Error("query error! authorizing query in tenant", zap.Error(err), zap.String("db", database),
+				zap.String("userID", userID), zap.String("tenant", t.Name))
+			return err
+		}
+	}
 	h.Logger.Info("login success", zap.String("userID", userID))
 	return nil
 }
@@ -1076,12 +1101,19 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 	}
 
 	// Check authorization.
-	err = h.checkAuthorization(user, q, db)
+	err = h.checkAuthorization(r, user, q, db)
 	if err != nil {
 		h.httpError(rw, "error authorizing query: "+err.Error(), http.StatusForbidden)
 		return
 	}
 
+	freeTenantQuery, err := h.allocTenantQuery(r)
+	if err != nil {
+		h.httpError(rw, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+	defer freeTenantQuery()
+
 	// Parse chunk size. Use default if not provided or unparsable.
 	chunked, chunkSize, innerChunkSize, err := h.parseChunkSize(r)
 	if err != nil {
@@ -1090,6 +1122,12 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 	// Parse whether this is an async command.
 	async := r.FormValue("async") == "true"
 
+	readConsistency := r.FormValue("read_consistency")
+	if readConsistency != "" && !config2.IsValidReadConsistency(readConsistency) {
+		h.httpError(rw, fmt.Sprintf("invalid read_consistency %q, expected leader, bounded-staleness or eventual", readConsistency), http.StatusBadRequest)
+		return
+	}
+
 	opts := query.ExecutionOptions{
 		Database:        db,
 		RetentionPolicy: r.FormValue("rp"),
@@ -1101,6 +1139,9 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 		ParallelQuery:   atomic.LoadInt32(&syscontrol.ParallelQueryInBatch) == 1,
 		Quiet:           true,
 		Authorizer:      h.getAuthorizer(user),
+		ErrorBound:      r.FormValue("error_bound") == "true",
+		ReadConsistency: readConsistency,
+		Tenant:          tenantOf(r),
 	}
 
 	// Make sure if the client disconnects we signal the query to abort
@@ -1253,6 +1294,15 @@ func (h *Handler) logRowsIfNecessary(rows []influx.Row, ReqBuf []byte) {
 	}
 }
 
+// isReplicationWrite returns whether the write is shipped by the asynchronous replication of the primary cluster.
+// The replication header is only trusted from an admin user if the auth is enabled.
+func (h *Handler) isReplicationWrite(r *http.Request, user meta2.User) bool {
+	if r.Header.Get(meta2.ReplicationHeader) == "" {
+		return false
+	}
+	return !h.Config.AuthEnabled || (user != nil && user.AuthorizeUnrestricted())
+}
+
 // serveWrite receives incoming series data in line protocol format and writes it to the database.
 func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.User) {
 	atomic.AddInt64(&statistics.HandlerStat.WriteRequests, 1)
@@ -1286,7 +1336,8 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 		return
 	}
 
-	if _, err := h.MetaClient.Database(database); err != nil {
+	dbi, err := h.MetaClient.Database(database)
+	if err != nil {
 		err := errno.NewError(errno.HttpDatabaseNotFound)
 		h.Logger.Error("serveWrite", zap.Error(err), zap.String("db", database))
 		h.httpError(w, fmt.Sprintf("database not found: %q", database), http.StatusNotFound)
@@ -1310,6 +1361,20 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 			atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
 			return
 		}
+
+		if err := h.authorizeTenantDatabase(r, database); err != nil {
+			h.httpError(w, err.Error(), http.StatusForbidden)
+			h.Logger.Error("write error:database is not owned by the tenant", zap.Error(err), zap.String("db", database), zap.String("user", user.ID()))
+			atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+			return
+		}
+	}
+
+	// the standby database only accepts the writes shipped from the primary cluster until it is promoted
+	if dbi.Replication.IsStandby() && !h.isReplicationWrite(r, user) {
+		h.httpError(w, fmt.Sprintf("database %q is a replication standby", database), http.StatusForbidden)
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return
 	}
 
 	body := r.Body
@@ -1441,6 +1506,18 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 			h.Logger.Error("write Partial Write error:WritePointsWithContext", zap.Error(werr.Reason), zap.String("db", database))
 			atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
 			return
+		} else if isTenantQuotaError(err) {
+			atomic.AddInt64(&statistics.HandlerStat.PointsWrittenFail, int64(numPtsInsert))
+			h.httpError(w, err.Error(), http.StatusTooManyRequests)
+			h.Logger.Error("write error:tenant quota exceeded", zap.Error(err), zap.String("db", database))
+			atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+			return
+		} else if errno.Equal(err, errno.TenantWriteBatchTooLarge) {
+			atomic.AddInt64(&statistics.HandlerStat.PointsWrittenFail, int64(numPtsInsert))
+			h.httpError(w, err.Error(), http.StatusRequestEntityTooLarge)
+			h.Logger.Error("write error:batch exceeds the tenant ingest quota", zap.Error(err), zap.String("db", database))
+			atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+			return
 		} else if errno.Equal(err, errno.MeasurementNameTooLong) {
 			atomic.AddInt64(&statistics.HandlerStat.PointsWrittenFail, int64(numPtsParse))
 			h.httpError(w, werr.Error(), http.StatusBadRequest)
@@ -1601,6 +1678,12 @@ func (h *Handler) serveOTLP(w http.ResponseWriter, r *http.Request, user meta2.U
 			atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
 			return nil, "", err
 		}
+
+		if err := h.authorizeTenantDatabase(r, database); err != nil {
+			h.httpError(w, err.Error(), http.StatusForbidden)
+			atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+			return nil, "", err
+		}
 	}
 
 	body := r.Body
@@ -1916,6 +1999,7 @@ func authenticate(inner func(http.ResponseWriter, *http.Request, meta2.User), h
 			return
 		}
 		var user meta2.User
+		var tenantClaim string
 
 		// TODO corylanou: never allow this in the future without users
 		if requireAuthentication && h.MetaClient.AdminUserExists() {
@@ -2006,10 +2090,25 @@ func authenticate(inner func(http.ResponseWriter, *http.Request, meta2.User), h
 					h.httpError(w, meta2.ErrUserNotFound.Error(), http.StatusUnauthorized)
 					return
 				}
+
+				// Get the optional tenant from the token.
+				if v, ok := claims[TenantClaim]; ok {
+					if tenantClaim, ok = v.(string); !ok {
+						h.httpError(w, "tenant in token must be a string", http.StatusUnauthorized)
+						return
+					}
+				}
 			default:
 				h.httpError(w, "unsupported authentication", http.StatusUnauthorized)
 			}
 
+			tenant, err := h.resolveTenant(user, tenantClaim)
+			if err != nil {
+				atomic.AddInt64(&statistics.HandlerStat.AuthenticationFailures, 1)
+				h.httpError(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			r = withTenant(r, tenant)
 		}
 		inner(w, r, user)
 	})
@@ -25,6 +25,7 @@ import (
 	"math"
 	"net"
 	"net/http"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -52,6 +53,8 @@ import (
 	"github.com/openGemini/openGemini/lib/tokenizer"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/pipeline"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/tail"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
@@ -162,6 +165,11 @@ func validateLogstreamOptions(opt *meta2.Options) error {
 		opt.TagsSplit = tokenizer.TAGS_SPLITTER_BEFORE
 	}
 
+	if len(opt.Pipeline) > 0 {
+		if _, err := pipeline.Compile(opt.Pipeline); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -235,6 +243,10 @@ func (h *Handler) serveCreateRepository(w http.ResponseWriter, r *http.Request,
 	}
 	options.Endpoint = host
 	logger.GetLogger().Info("serveCreateRepository", zap.String("repository", repository))
+	if err = h.assignTenantDatabase(r, repository); err != nil {
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusForbidden)
+		return
+	}
 	if _, err = h.MetaClient.CreateDatabase(repository, false, 1, options); err != nil {
 		logger.GetLogger().Error("serveCreateRepository, CreateLogRepository", zap.Error(err))
 		h.httpError(w, err.Error(), http.StatusInternalServerError)
@@ -252,6 +264,10 @@ func (h *Handler) serveDeleteRepository(w http.ResponseWriter, r *http.Request,
 		return
 	}
 	logger.GetLogger().Info("serveDeleteRepository", zap.String("repository", repository))
+	if err := h.authorizeTenantDatabase(r, repository); err != nil {
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusForbidden)
+		return
+	}
 	err := h.MetaClient.MarkDatabaseDelete(repository)
 	if err != nil {
 		logger.GetLogger().Error("serveDeleteRepository, DeleteLogRepository", zap.Error(err))
@@ -266,7 +282,11 @@ func (h *Handler) serveListRepository(w http.ResponseWriter, r *http.Request, us
 	repositories := h.MetaClient.Databases()
 	repoList := []string{}
 	h.Logger.Info(fmt.Sprintf("serveListRepository all len %v", len(repositories)))
+	tenant := h.requestTenant(r)
 	for i := range repositories {
+		if tenant != nil && !tenant.HasDatabase(repositories[i].Name) {
+			continue
+		}
 		if !repositories[i].MarkDeleted {
 			repoList = append(repoList, repositories[i].Name)
 		}
@@ -332,7 +352,41 @@ func (h *Handler) serveUpdateRepository(w http.ResponseWriter, r *http.Request,
 	h.writeHeader(w, http.StatusOK)
 }
 
-func (h *Handler) getDefaultSchemaForLog(opt *meta2.Options) (*meta2.ColStoreInfo, []*proto2.FieldSchema, *influxql.IndexRelation, *meta2.ShardKeyInfo, int32) {
+// createLogstreamOptions is the body of the create logstream request, the n-gram fields and the analyzers
+// are only used to build the index relation.
+type createLogstreamOptions struct {
+	*meta2.Options
+	NGramFields []string `json:"ngram_fields,omitempty"`
+	// Analyzer is the default analyzer of the full text fields, e.g. "cjk_bigram,lowercase"
+	Analyzer string `json:"analyzer,omitempty"`
+	// Analyzers are the analyzers of the specified fields
+	Analyzers map[string]string `json:"analyzers,omitempty"`
+}
+
+// getAnalyzers validates the analyzers and encodes them as the tokenizers of the full text index option.
+func (o *createLogstreamOptions) getAnalyzers() (string, error) {
+	c := tokenizer.NewAnalyzerConfig(o.Analyzer, o.Analyzers)
+	if err := c.Validate(); err != nil {
+		return "", err
+	}
+	return c.String(), nil
+}
+
+func validateNGramFields(fields []string) error {
+	exists := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		if field == "" || field == "time" {
+			return fmt.Errorf("invalid ngram field: %q", field)
+		}
+		if _, ok := exists[field]; ok {
+			return fmt.Errorf("duplicate ngram field: %s", field)
+		}
+		exists[field] = struct{}{}
+	}
+	return nil
+}
+
+func (h *Handler) getDefaultSchemaForLog(opt *meta2.Options, ngramFields []string, analyzers string) (*meta2.ColStoreInfo, []*proto2.FieldSchema, *influxql.IndexRelation, *meta2.ShardKeyInfo, int32) {
 	colStoreInfo := meta2.NewColStoreInfo([]string{"time"}, []string{"time"}, nil, 0, "block")
 	indexR := &influxql.IndexRelation{
 		Rid:        0,
@@ -340,9 +394,15 @@ func (h *Handler) getDefaultSchemaForLog(opt *meta2.Options) (*meta2.ColStoreInf
 		IndexNames: []string{index.BloomFilterFullTextIndex},
 		IndexList:  []*influxql.IndexList{{IList: []string{}}},
 		IndexOptions: []*influxql.IndexOptions{{Options: []*influxql.IndexOption{
-			{Tokens: opt.SplitChar, Tokenizers: "standard"},
+			{Tokens: opt.SplitChar, Tokenizers: analyzers},
 		}}},
 	}
+	if len(ngramFields) > 0 {
+		indexR.Oids = append(indexR.Oids, uint32(index.NGram))
+		indexR.IndexNames = append(indexR.IndexNames, index.NGramIndex)
+		indexR.IndexList = append(indexR.IndexList, &influxql.IndexList{IList: ngramFields})
+		indexR.IndexOptions = append(indexR.IndexOptions, &influxql.IndexOptions{})
+	}
 	ski := &meta2.ShardKeyInfo{Type: "hash"}
 	return colStoreInfo, nil, indexR, ski, 0
 }
@@ -358,14 +418,26 @@ func (h *Handler) serveCreateLogstream(w http.ResponseWriter, r *http.Request, u
 	}
 	options := &meta2.Options{}
 	options.InitDefault()
+	body := &createLogstreamOptions{Options: options}
 	dec := json2.NewDecoder(r.Body)
-	if err := dec.Decode(options); err != nil {
+	if err := dec.Decode(body); err != nil {
 		logger.GetLogger().Error("serveCreateLogstream, decode CreateLogStreamOptions", zap.Error(err))
 		if err != nil && err.Error() != "EOF" {
 			h.httpErrorRsp(w, ErrorResponse("parse body error: "+err.Error(), LogReqErr), http.StatusBadRequest)
 			return
 		}
 	}
+	if err := validateNGramFields(body.NGramFields); err != nil {
+		logger.GetLogger().Error("serveCreateLogstream failed", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+	analyzers, err := body.getAnalyzers()
+	if err != nil {
+		logger.GetLogger().Error("serveCreateLogstream failed", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
 	if err := validateLogstreamOptions(options); err != nil {
 		logger.GetLogger().Error("serveCreateLogstream failed", zap.Error(err))
 		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusInternalServerError)
@@ -382,7 +454,7 @@ func (h *Handler) serveCreateLogstream(w http.ResponseWriter, r *http.Request, u
 		return
 	}
 	// crete measurement
-	colStoreInfo, schemaInfo, indexRelation, ski, numOfShards := h.getDefaultSchemaForLog(options)
+	colStoreInfo, schemaInfo, indexRelation, ski, numOfShards := h.getDefaultSchemaForLog(options, body.NGramFields, analyzers)
 	if _, err := h.MetaClient.CreateMeasurement(repository, logStream, logStream, ski, numOfShards, indexRelation, config.COLUMNSTORE, colStoreInfo, schemaInfo, options); err != nil {
 		logger.GetLogger().Error("create logStream failed", zap.String("name", logStream), zap.Error(err))
 		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusInternalServerError)
@@ -531,7 +603,7 @@ const (
 	Time          = "time"
 	FailTag       = "__fail_tag__"
 	FailLog       = "__fail_log__"
-	RetryTag      = "__retry_tag__"
+	RetryTag      = tail.RetryTag
 	FailLogTag    = "failLog"
 	ExpiredLogTag = "expiredLog"
 	BigLogTag     = "bigLog"
@@ -544,6 +616,7 @@ const (
 	ObjectError
 	ContentFieldError
 	NoContentError
+	PipelineError
 )
 
 var (
@@ -603,6 +676,7 @@ type LogWriteRequest struct {
 	logTagsKey     map[string]bool
 	logSchema      record.Schemas
 	mstSchema      *meta2.CleanSchema
+	pipeline       *pipeline.Pipeline
 }
 
 type JsonMapping struct {
@@ -775,6 +849,11 @@ func getTimestamp(i interface{}, req *LogWriteRequest) (int64, error) {
 		return 0, errno.NewError(errno.ErrParseTimestamp)
 	}
 
+	return checkTimestamp(unixTimestamp, req)
+}
+
+// checkTimestamp checks the range of the timestamp in nanoseconds, the expired logs are tagged by ExpiredLogTag.
+func checkTimestamp(unixTimestamp int64, req *LogWriteRequest) (int64, error) {
 	if unixTimestamp < MinUnixTimestampNs || unixTimestamp > MaxUnixTimestampNs {
 		return 0, errno.NewError(errno.ErrParseTimestamp)
 	}
@@ -927,6 +1006,7 @@ type PrintFailLog struct {
 	alreadyPrintObjectError    bool
 	alreadyPrintFieldError     bool
 	alreadyPrintNoContentError bool
+	alreadyPrintPipelineError  bool
 }
 
 func getPrintFailLog() *PrintFailLog {
@@ -1220,42 +1300,103 @@ func (h *Handler) parseJsonArray(body io.ReadCloser, req *LogWriteRequest, rows,
 	}
 
 	for _, jsonMap := range jsonArray {
-		unixTimestamp, err := getTimestamp(jsonMap, req)
-		if err != nil {
-			if req.failTag != ExpiredLogTag {
-				h.printFailLog(TimestampError, req, jsonMap, nil)
-			}
-			appendFailRow(failRows, req, jsonMap)
+		h.appendJsonMap(jsonMap, jsonMap, 0, req, rows, failRows, pf)
+	}
+	swapTimeColumnToEnd(rows, failRows)
+
+	return totalLen
+}
+
+// parseJsonLines parses the json lines by maps, it is used instead of parseJson when the logstream has an ingest pipeline.
+func (h *Handler) parseJsonLines(scanner *bufio.Scanner, req *LogWriteRequest, rows, failRows *record.Record) int64 {
+	var totalLen int64
+	pf := getParseField(len(req.logTags) + logSchema.Len())
+	rows.ReserveSchemaAndColVal(req.logSchema.Len())
+	copy(rows.Schema, req.logSchema)
+
+	for scanner.Scan() {
+		b := scanner.Bytes()
+		if len(b) == 0 {
 			continue
 		}
 
-		pf.contentCnt = 0
-		err = visitJsonMap(jsonMap, req, rows, pf)
-		if err != nil {
-			h.printFailLog(ContentFieldError, req, jsonMap, err)
-			clearFailRow(rows, pf.rowCnt+1)
-			resetSchemaNil(pf.schemasNil)
-			appendFailRow(failRows, req, jsonMap)
+		totalLen += int64(len(b)) + NewlineLen
+		if len(b) > MaxContentLen {
+			appendBigLog(failRows, req, b)
 			continue
 		}
 
-		if pf.contentCnt == 0 {
-			h.printFailLog(NoContentError, req, jsonMap, err)
-			appendFailRow(failRows, req, jsonMap)
+		var jsonMap map[string]interface{}
+		if err := sonic.Unmarshal(b, &jsonMap); err != nil {
+			h.printFailLog(ParseError, req, b, err)
+			appendFailRow(failRows, req, b)
 			continue
 		}
-
-		rows.ColVals[0].AppendBoolean(req.retry)
-		appendLogTags(rows, req)
-		appendRowAll(rows, pf, unixTimestamp)
-		getMinMaxTime(req, unixTimestamp)
-		pf.rowCnt++
+		h.appendJsonMap(jsonMap, b, 0, req, rows, failRows, pf)
 	}
 	swapTimeColumnToEnd(rows, failRows)
 
 	return totalLen
 }
 
+// appendJsonMap runs the ingest pipeline on the log and appends it to the rows, line is the original log written to
+// the fail logs. The time of the log is taken from the pipeline, then t, and then the timestamp field of the log.
+func (h *Handler) appendJsonMap(jsonMap map[string]interface{}, line interface{}, t int64, req *LogWriteRequest, rows, failRows *record.Record, pf *ParseField) {
+	var err error
+	if req.pipeline != nil {
+		var pipelineTime int64
+		jsonMap, pipelineTime, err = req.pipeline.Process(jsonMap)
+		if err != nil {
+			h.printFailLog(PipelineError, req, line, err)
+			appendFailRow(failRows, req, line)
+			return
+		}
+		if jsonMap == nil {
+			// dropped by the pipeline
+			return
+		}
+		if pipelineTime != 0 {
+			t = pipelineTime
+		}
+	}
+
+	var unixTimestamp int64
+	if t != 0 {
+		unixTimestamp, err = checkTimestamp(t, req)
+	} else {
+		unixTimestamp, err = getTimestamp(jsonMap, req)
+	}
+	if err != nil {
+		if req.failTag != ExpiredLogTag {
+			h.printFailLog(TimestampError, req, line, nil)
+		}
+		appendFailRow(failRows, req, line)
+		return
+	}
+
+	pf.contentCnt = 0
+	err = visitJsonMap(jsonMap, req, rows, pf)
+	if err != nil {
+		h.printFailLog(ContentFieldError, req, line, err)
+		clearFailRow(rows, pf.rowCnt+1)
+		resetSchemaNil(pf.schemasNil)
+		appendFailRow(failRows, req, line)
+		return
+	}
+
+	if pf.contentCnt == 0 {
+		h.printFailLog(NoContentError, req, line, err)
+		appendFailRow(failRows, req, line)
+		return
+	}
+
+	rows.ColVals[0].AppendBoolean(req.retry)
+	appendLogTags(rows, req)
+	appendRowAll(rows, pf, unixTimestamp)
+	getMinMaxTime(req, unixTimestamp)
+	pf.rowCnt++
+}
+
 func (h *Handler) printFailLog(failLogType FailLogType, req *LogWriteRequest, line interface{}, err error) {
 	str := Interface2str(line)
 
@@ -1290,6 +1431,12 @@ func (h *Handler) printFailLog(failLogType FailLogType, req *LogWriteRequest, li
 				zap.String("logstream", req.logStream), zap.String("line", str))
 			req.printFailLog.alreadyPrintNoContentError = true
 		}
+	case PipelineError:
+		if !req.printFailLog.alreadyPrintPipelineError {
+			h.Logger.Error("ingest pipeline err", zap.Error(err), zap.String("repository", req.repository),
+				zap.String("logstream", req.logStream), zap.String("line", str))
+			req.printFailLog.alreadyPrintPipelineError = true
+		}
 	default:
 		break
 	}
@@ -1674,6 +1821,11 @@ func (h *Handler) serveRecord(w http.ResponseWriter, r *http.Request, user meta2
 		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
 		return
 	}
+	if err = h.authorizeTenantDatabase(r, req.repository); err != nil {
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusForbidden)
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return
+	}
 
 	logInfo, err := h.validateRetentionPolicy(req.repository, req.logStream)
 	if err != nil {
@@ -1684,6 +1836,13 @@ func (h *Handler) serveRecord(w http.ResponseWriter, r *http.Request, user meta2
 		return
 	}
 	req.mstSchema = logInfo.Measurements[req.logStream+MstSuffix].Schema
+	req.pipeline, err = getLogPipeline(logInfo.Measurements[req.logStream+MstSuffix])
+	if err != nil {
+		h.Logger.Error("serveRecord getLogPipeline fail", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return
+	}
 	logInfo.Measurements[req.logStream+MstSuffix].SchemaLock.RLock()
 	logTagsMap, err := parseLogTags(req)
 	logInfo.Measurements[req.logStream+MstSuffix].SchemaLock.RUnlock()
@@ -1734,7 +1893,9 @@ func (h *Handler) serveRecord(w http.ResponseWriter, r *http.Request, user meta2
 		req.expiredTime = req.requestTime - logInfo.Duration.Nanoseconds()
 	}
 	req.printFailLog = getPrintFailLog()
-	if req.dataType == JSON {
+	if req.dataType == JSON && req.pipeline != nil {
+		totalLen = h.parseJsonLines(scanner, req, rows, failRows)
+	} else if req.dataType == JSON {
 		totalLen = h.parseJson(scanner, req, rows, failRows)
 	} else {
 		totalLen = h.parseJsonArray(r.Body, req, rows, failRows)
@@ -1758,6 +1919,12 @@ func (h *Handler) serveRecord(w http.ResponseWriter, r *http.Request, user meta2
 	bulk, failBulk := getBulkRecords(rows, failRows, req, totalLen, logInfo.ShardGroupDuration)
 	if rows.RowNums() > 0 {
 		err = h.RecordWriter.RetryWriteLogRecord(bulk)
+		if isTenantQuotaError(err) {
+			h.Logger.Error("serve records", zap.Error(err))
+			h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusTooManyRequests)
+			atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+			return
+		}
 		if err != nil {
 			h.Logger.Error("serve records", zap.Error(err))
 			h.httpErrorRsp(w, ErrorResponse("write log error", LogReqErr), http.StatusBadRequest)
@@ -1850,6 +2017,11 @@ func (h *Handler) serveUpload(w http.ResponseWriter, r *http.Request, user meta2
 		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
 		return
 	}
+	if err := h.authorizeTenantDatabase(r, repository); err != nil {
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusForbidden)
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return
+	}
 
 	scanner := bufio.NewScanner(r.Body)
 	scanBuf := byteBufferPool.Get()
@@ -1880,6 +2052,18 @@ func (h *Handler) serveUpload(w http.ResponseWriter, r *http.Request, user meta2
 		baseTime = timeStart - curTime
 	}
 	t := curTime + baseTime
+	logPipeline, err := getLogPipeline(logInfo.Measurements[logStream+MstSuffix])
+	if err != nil {
+		h.Logger.Error("serveUpload getLogPipeline fail", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return
+	}
+	if logPipeline != nil {
+		record.LogStoreRecordPool.PutBigRecord(rows)
+		h.uploadWithPipeline(w, scanner, logInfo, logPipeline, repository, logStream, tagsStr, curTime, baseTime)
+		return
+	}
 	groupId := int(t / dur)
 	for scanner.Scan() {
 		curTime = getLogTimestamp(curTime)
@@ -1924,6 +2108,91 @@ func (h *Handler) serveUpload(w http.ResponseWriter, r *http.Request, user meta2
 	}
 }
 
+// getLogPipeline returns the compiled ingest pipeline of the logstream, nil means the logstream has none.
+func getLogPipeline(msti *meta2.MeasurementInfo) (*pipeline.Pipeline, error) {
+	if msti == nil || msti.Options == nil {
+		return nil, nil
+	}
+	return pipeline.Get(msti.Options.Pipeline)
+}
+
+// uploadWithPipeline uploads the lines through the ingest pipeline of the logstream, every line is the content of a log
+// and the fields extracted by the pipeline are written as the other columns.
+func (h *Handler) uploadWithPipeline(w http.ResponseWriter, scanner *bufio.Scanner, logInfo *meta2.RetentionPolicyInfo, logPipeline *pipeline.Pipeline,
+	repository, logStream, tags string, curTime, baseTime int64) {
+	req := &LogWriteRequest{
+		repository:     repository,
+		logStream:      logStream,
+		failTag:        FailLogTag,
+		timeMultiplier: 1e6,
+		mapping:        &JsonMapping{timestamp: Time, discardFields: make(map[string]bool)},
+		printFailLog:   getPrintFailLog(),
+		mstSchema:      logInfo.Measurements[logStream+MstSuffix].Schema,
+		pipeline:       logPipeline,
+		requestTime:    time.Now().UnixNano(),
+	}
+	req.logSchema = append(req.logSchema, logSchema...)
+	if logInfo.Duration != 0 {
+		req.expiredTime = req.requestTime - logInfo.Duration.Nanoseconds()
+	}
+
+	var rows, failRows *record.Record
+	var pf *ParseField
+	var totalLen int64
+	lineCount := 0
+	reset := func() {
+		rows = record.LogStoreRecordPool.Get()
+		rows.ReserveSchemaAndColVal(req.logSchema.Len())
+		copy(rows.Schema, req.logSchema)
+		failRows = record.GetRecordFromPool(record.LogStoreFailRecordPool, failLogSchema)
+		pf = getParseField(logSchema.Len())
+		req.minTime, req.maxTime = 0, 0
+		totalLen, lineCount = 0, 0
+	}
+	flush := func() bool {
+		swapTimeColumnToEnd(rows, failRows)
+		bulk, failBulk := getBulkRecords(rows, failRows, req, totalLen, logInfo.ShardGroupDuration)
+		if rows.RowNums() > 0 {
+			if err := h.RecordWriter.RetryWriteLogRecord(bulk); err != nil {
+				h.Logger.Error("serve upload", zap.Error(err))
+				h.httpErrorRsp(w, ErrorResponse("upload log error", LogReqErr), http.StatusBadRequest)
+				atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+				return false
+			}
+		} else {
+			record.LogStoreRecordPool.PutBigRecord(rows)
+		}
+		if failRows.RowNums() > 0 {
+			if err := h.RecordWriter.RetryWriteLogRecord(failBulk); err != nil {
+				h.Logger.Error("serve upload", zap.Error(err))
+				h.httpErrorRsp(w, ErrorResponse("upload fail log error", LogReqErr), http.StatusBadRequest)
+				atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+				return false
+			}
+		} else {
+			record.LogStoreFailRecordPool.PutBigRecord(failRows)
+		}
+		return true
+	}
+
+	reset()
+	for scanner.Scan() {
+		if lineCount > LogMax {
+			if !flush() {
+				return
+			}
+			reset()
+		}
+		line := scanner.Bytes()
+		totalLen += int64(len(line)) + NewlineLen
+		lineCount++
+		curTime = getLogTimestamp(curTime)
+		jsonMap := map[string]interface{}{Content: string(line), Tags: tags}
+		h.appendJsonMap(jsonMap, line, curTime+baseTime, req, rows, failRows, pf)
+	}
+	flush()
+}
+
 // Query parameter
 const (
 	EmptyValue    = ""
@@ -1958,6 +2227,7 @@ const (
 	Timestamp  = "timestamp"
 	Cursor     = "cursor"
 	IsOverflow = "is_overflow"
+	Score      = "score"
 )
 
 func TransYaccSyntaxErr(errorInfo string) string {
@@ -2337,7 +2607,7 @@ func (h *Handler) serveLogQuery(w http.ResponseWriter, r *http.Request, param *Q
 	sanitize(r)
 
 	// Check authorization.
-	err = h.checkAuthorization(user, q, info.database)
+	err = h.checkAuthorization(r, user, q, info.database)
 	if err != nil {
 		return nil, nil, nil, http.StatusForbidden, fmt.Errorf("error authorizing query: " + err.Error())
 	}
@@ -2522,6 +2792,7 @@ func getQueryLogRequest(r *http.Request) (*QueryLogRequest, error) {
 	if err != nil {
 		return nil, err
 	}
+	queryLogRequest.Query, queryLogRequest.OrderByScore = removeScoreOrder(queryLogRequest.Query)
 	if !queryLogRequest.Sql {
 		queryLogRequest.Query = removeLastSelectStr(queryLogRequest.Query)
 	}
@@ -2581,6 +2852,22 @@ func removeLastSelectStr(queryStr string) string {
 	return queryStr
 }
 
+var scoreOrderRegexp = regexp.MustCompile(`(?i)\s+order\s+by\s+_score(\s+desc)?\b`)
+
+// removeScoreOrder removes "ORDER BY _score" from the last select statement of the query,
+// the logs are ranked by relevance in the handler instead of the query engine.
+func removeScoreOrder(queryStr string) (string, bool) {
+	start := strings.LastIndex(queryStr, "|") + 1
+	if !strings.HasPrefix(removePreSpace(strings.ToLower(queryStr[start:])), Select) {
+		return queryStr, false
+	}
+	loc := scoreOrderRegexp.FindStringIndex(queryStr[start:])
+	if loc == nil {
+		return queryStr, false
+	}
+	return queryStr[:start+loc[0]] + queryStr[start+loc[1]:], true
+}
+
 func getQueryQueryLogRequest(r *http.Request, queryLogRequest *QueryLogRequest) (*QueryLogRequest, error) {
 	if len(r.FormValue(Query)) > MaxQueryLen {
 		return nil, errno.NewError(errno.TooLongQuery, MaxQueryLen)
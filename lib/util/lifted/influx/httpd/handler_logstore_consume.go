@@ -59,7 +59,7 @@ const (
 func (h *Handler) serveQueryLogByCursor(w http.ResponseWriter, r *http.Request, user meta2.User) {
 }
 
-func (h *Handler) getRequestInfo(r *http.Request) (*consume.ConsumeInfo, error) {
+func (h *Handler) getRequestInfo(r *http.Request, queryLogRequest *consume.ConsumeLogsRequest) (*consume.ConsumeInfo, error) {
 	repository := mux.Vars(r)[Repository]
 	logStream := mux.Vars(r)[LogStream]
 	if err := h.ValidateAndCheckLogStreamExists(repository, logStream); err != nil {
@@ -71,10 +71,6 @@ func (h *Handler) getRequestInfo(r *http.Request) (*consume.ConsumeInfo, error)
 		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
 		return nil, err
 	}
-	queryLogRequest, err := consume.GetQueryConsumeLogsRequest(r)
-	if err != nil {
-		return nil, err
-	}
 	mst, err := h.MetaClient.Measurement(repository, logStream, logStream)
 	if err != nil {
 		return nil, err
@@ -94,8 +90,9 @@ func (h *Handler) getRequestInfo(r *http.Request) (*consume.ConsumeInfo, error)
 	}, nil
 }
 
-func (h *Handler) getConsumeInfo(w http.ResponseWriter, r *http.Request, user meta2.User, t time.Time, info *measurementInfo) (*consume.ConsumeInfo, *immutable.FilterOptions, error) {
-	consumeInfo, err := h.getRequestInfo(r)
+func (h *Handler) getConsumeInfo(w http.ResponseWriter, r *http.Request, user meta2.User, t time.Time, info *measurementInfo,
+	queryLogRequest *consume.ConsumeLogsRequest) (*consume.ConsumeInfo, *immutable.FilterOptions, error) {
+	consumeInfo, err := h.getRequestInfo(r, queryLogRequest)
 	if err != nil {
 		h.Logger.Error("query log scan request error! ", zap.Error(err), zap.Any("r", r))
 		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
@@ -184,7 +181,13 @@ func (h *Handler) serveConsumeLogs(w http.ResponseWriter, r *http.Request, user
 	t := time.Now()
 	repository := mux.Vars(r)[Repository]
 	logStream := mux.Vars(r)[LogStream]
-	consumeInfo, filterOpt, err := h.getConsumeInfo(w, r, user, t, &measurementInfo{database: repository, name: logStream, retentionPolicy: logStream})
+	queryLogRequest, err := consume.GetQueryConsumeLogsRequest(r)
+	if err != nil {
+		h.Logger.Error("query log scan request error! ", zap.Error(err), zap.Any("r", r))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+	consumeInfo, filterOpt, err := h.getConsumeInfo(w, r, user, t, &measurementInfo{database: repository, name: logStream, retentionPolicy: logStream}, queryLogRequest)
 	if err != nil {
 		h.Logger.Error("query log scan request error! ", zap.Error(err), zap.Any("r", r))
 		return
@@ -214,11 +217,71 @@ func (h *Handler) serveConsumeLogs(w http.ResponseWriter, r *http.Request, user
 		}
 	}
 
+	data, size, isComplete, ok := h.consumeNextBatch(w, t, consumeInfo, filterOpt)
+	if !ok {
+		return
+	}
+
+	cursorEncode, err := consumeInfo.GetFromCursor().EncodeToByte()
+	if err != nil {
+		h.Logger.Error("cursor encode is error ", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse("cursor encode is error ", LogReqErr), http.StatusBadRequest)
+		return
+	}
+	var endCursorEncode []byte
+	if consumeInfo.GetEndCursor() != nil {
+		endCursorEncode, err = consumeInfo.GetEndCursor().EncodeToByte()
+		if err != nil {
+			h.Logger.Error("cursor encode is error ", zap.Error(err))
+			h.httpErrorRsp(w, ErrorResponse("cursor encode is error ", LogReqErr), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if isComplete {
+		cursorEncode = []byte{}
+		endCursorEncode = []byte{}
+	}
+	maxLogTime := int64(0)
+	if len(data) != 0 {
+		maxLogTime = data[len(data)-1]["time"].(int64) / int64(1e6)
+	}
+	resp := consume.QueryConsumeLogsResponse{
+		TotalCount: len(data),
+		TotalSize:  size,
+		Logs:       data,
+		MaxLogTime: maxLogTime,
+		IsComplete: isComplete,
+		TookMs:     time.Since(t).Milliseconds(),
+		FromCursor: base64.StdEncoding.EncodeToString(cursorEncode),
+		EndCursor:  base64.StdEncoding.EncodeToString(endCursorEncode),
+	}
+
+	results, err := sonic.Marshal(&resp)
+	if err != nil {
+		h.Logger.Error("consume logs get results request error! ", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("X-Content-Length", strconv.Itoa(len(results)))
+	w.WriteHeader(http.StatusOK)
+	addLogQueryStatistics(consumeInfo.GetRepository(), consumeInfo.GetLogstream())
+	_, err = w.Write(results)
+	if err != nil {
+		h.Logger.Error("query log marshal res fail! ", zap.Error(err))
+	}
+	return
+}
+
+// consumeNextBatch reads the next batch of logs from the task selected in the from cursor and moves the cursor forward.
+// The error response has been written to w when ok is false.
+func (h *Handler) consumeNextBatch(w http.ResponseWriter, t time.Time, consumeInfo *consume.ConsumeInfo, filterOpt *immutable.FilterOptions) (
+	data []map[string]interface{}, size int64, isComplete bool, ok bool) {
 	taskID, isNeedConsume, err := h.getConsumeCursor(w, consumeInfo, t)
 	if err != nil {
 		errInfo := fmt.Sprintf("consume request error! repo: %s, logstream: %s, info:%s", consumeInfo.GetRepository(), consumeInfo.GetLogstream(), consumeInfo.GetQueryLogRequest().FromCursor)
 		h.Logger.Error(errInfo, zap.Error(errno.NewError(errno.RecoverPanic, err)))
-		return
+		return nil, 0, false, false
 	}
 	if !isNeedConsume {
 		taskID = rand.Intn(len(consumeInfo.GetFromCursor().Tasks))
@@ -230,9 +293,8 @@ func (h *Handler) serveConsumeLogs(w http.ResponseWriter, r *http.Request, user
 	}
 
 	isEnd := false
-	var size, metaIndexId int64
+	var metaIndexId int64
 	var blockId uint64
-	var data []map[string]interface{}
 	currTask := consumeInfo.GetFromCursor().Tasks[taskID].CurrTask
 	if currTask.RemotePath == "" {
 		data = nil
@@ -243,12 +305,12 @@ func (h *Handler) serveConsumeLogs(w http.ResponseWriter, r *http.Request, user
 		reader, err := immutable.NewSegmentSequenceReader(obsPath, taskID, uint64(math.Ceil(float64(consumeInfo.GetQueryLogRequest().Count)/float64(BlockSize))), consumeInfo, consumeInfo.GetMst().GetRecordSchema(), filterOpt)
 		if err != nil {
 			h.getErrConsumeResponse(consumeInfo, t, w, err)
-			return
+			return nil, 0, false, false
 		}
 		data, isEnd, size, blockId, metaIndexId, err = reader.ConsumeDateByShard()
 		if err != nil {
 			h.getErrConsumeResponse(consumeInfo, t, w, err)
-			return
+			return nil, 0, false, false
 		}
 		consumeInfo.GetFromCursor().Tasks[taskID].CurrTask.MetaIndexId = int(metaIndexId)
 		consumeInfo.GetFromCursor().Tasks[taskID].CurrTask.BlockID = blockId
@@ -265,7 +327,7 @@ func (h *Handler) serveConsumeLogs(w http.ResponseWriter, r *http.Request, user
 		isUpdateCursor, err = h.updateCursorTask(consumeInfo.GetFromCursor().Tasks[taskID], consumeInfo)
 		if err != nil {
 			h.getErrConsumeResponse(consumeInfo, t, w, err)
-			return
+			return nil, 0, false, false
 		}
 	}
 
@@ -273,7 +335,7 @@ func (h *Handler) serveConsumeLogs(w http.ResponseWriter, r *http.Request, user
 		err = h.updateCursorByPtNum(consumeInfo.GetFromCursor(), consumeInfo.GetEndCursor(), consumeInfo, consumeInfo.GetMstName(), consumeInfo.GetSgs(), consumeInfo.GetPt())
 		if err != nil {
 			h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
-			return
+			return nil, 0, false, false
 		}
 	}
 	if isFinish {
@@ -283,62 +345,14 @@ func (h *Handler) serveConsumeLogs(w http.ResponseWriter, r *http.Request, user
 		}
 	}
 
-	isComplete := consumeInfo.GetEndCursor() != nil && len(consumeInfo.GetEndCursor().Tasks) == 0 && len(consumeInfo.FromCursor.Tasks) == 0
+	isComplete = consumeInfo.GetEndCursor() != nil && len(consumeInfo.GetEndCursor().Tasks) == 0 && len(consumeInfo.FromCursor.Tasks) == 0
 
 	// todo: preTaskNeedConsume
 	if len(data) != 0 && !isFinish && !isUpdateCursor {
 		consumeInfo.GetFromCursor().Tasks[taskID].CurrTask.Timestamp = data[len(data)-1]["time"].(int64)
 	}
 
-	cursorEncode, err := consumeInfo.GetFromCursor().EncodeToByte()
-	if err != nil {
-		h.Logger.Error("cursor encode is error ", zap.Error(err))
-		h.httpErrorRsp(w, ErrorResponse("cursor encode is error ", LogReqErr), http.StatusBadRequest)
-		return
-	}
-	var endCursorEncode []byte
-	if consumeInfo.GetEndCursor() != nil {
-		endCursorEncode, err = consumeInfo.GetEndCursor().EncodeToByte()
-		if err != nil {
-			h.Logger.Error("cursor encode is error ", zap.Error(err))
-			h.httpErrorRsp(w, ErrorResponse("cursor encode is error ", LogReqErr), http.StatusBadRequest)
-			return
-		}
-	}
-
-	if isComplete {
-		cursorEncode = []byte{}
-		endCursorEncode = []byte{}
-	}
-	maxLogTime := int64(0)
-	if len(data) != 0 {
-		maxLogTime = data[len(data)-1]["time"].(int64) / int64(1e6)
-	}
-	resp := consume.QueryConsumeLogsResponse{
-		TotalCount: len(data),
-		TotalSize:  size,
-		Logs:       data,
-		MaxLogTime: maxLogTime,
-		IsComplete: isComplete,
-		TookMs:     time.Since(t).Milliseconds(),
-		FromCursor: base64.StdEncoding.EncodeToString(cursorEncode),
-		EndCursor:  base64.StdEncoding.EncodeToString(endCursorEncode),
-	}
-
-	results, err := sonic.Marshal(&resp)
-	if err != nil {
-		h.Logger.Error("consume logs get results request error! ", zap.Error(err))
-		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
-		return
-	}
-	w.Header().Set("X-Content-Length", strconv.Itoa(len(results)))
-	w.WriteHeader(http.StatusOK)
-	addLogQueryStatistics(consumeInfo.GetRepository(), consumeInfo.GetLogstream())
-	_, err = w.Write(results)
-	if err != nil {
-		h.Logger.Error("query log marshal res fail! ", zap.Error(err))
-	}
-	return
+	return data, size, isComplete, true
 }
 
 func (h *Handler) getConsumeCursor(w http.ResponseWriter, consumeInfo *consume.ConsumeInfo, t time.Time) (taskID int, isNeedConsume bool, err error) {
@@ -1005,9 +1019,175 @@ func getQueryLogContextRequest(r *http.Request) (*QueryLogRequest, error) {
 	return queryLogRequest, nil
 }
 
-func (h *Handler) serveGetCursor(w http.ResponseWriter, r *http.Request, user meta2.User) {}
+// serveGetCursor returns the cursor of the logstream at the from time, the logs after the cursor are pulled by servePullLog.
+// The cursor covers all the pts of the repository, or only the pts of one shard if shard is given.
+func (h *Handler) serveGetCursor(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	repository := mux.Vars(r)[Repository]
+	logStream := mux.Vars(r)[LogStream]
+	if err := h.ValidateAndCheckLogStreamExists(repository, logStream); err != nil {
+		h.Logger.Error("get cursor request error! ", zap.Error(err), zap.Any("r", r))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return
+	}
+	cursorRequest, err := consume.GetPullCursorRequest(r, MinFromValue, MaxToValue/int64(1e6))
+	if err != nil {
+		h.Logger.Error("get cursor request error! ", zap.Error(err), zap.Any("r", r))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+	db, err := h.MetaClient.Database(repository)
+	if err != nil {
+		h.Logger.Error("get cursor request error! ", zap.Error(err), zap.Any("r", r))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+	pts, err := h.MetaClient.DBPtView(repository)
+	if err != nil {
+		h.Logger.Error("get cursor request error! ", zap.Error(err), zap.Any("r", r))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+	mst, err := h.MetaClient.Measurement(repository, logStream, logStream)
+	if err != nil {
+		h.Logger.Error("get cursor request error! ", zap.Error(err), zap.Any("r", r))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+
+	cursor := &consume.ConsumeCursor{
+		Time:           cursorRequest.From,
+		TaskNum:        1,
+		CurrTotalPtNum: len(pts),
+	}
+	if cursorRequest.HasShard {
+		if cursorRequest.Shard >= len(pts) {
+			h.httpErrorRsp(w, ErrorResponse(fmt.Sprintf("the valid range for shard is [0, %d)", len(pts)), LogReqErr), http.StatusBadRequest)
+			return
+		}
+		cursor.TaskNum = len(pts)
+		cursor.CursorID = cursorRequest.Shard
+	}
+	sgs, err := h.MetaClient.GetShardGroupByTimeRange(repository, logStream, time.Unix(0, cursorRequest.From), time.Unix(0, MaxToValue))
+	if err != nil {
+		h.Logger.Error("get cursor request error! ", zap.Error(err), zap.Any("r", r))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+	consumeInfo := &consume.ConsumeInfo{
+		Option:     db.Options,
+		Repository: repository,
+		LogStream:  logStream,
+		MstName:    mst.Name,
+	}
+	if err = h.updateCursorByPtNum(cursor, nil, consumeInfo, mst.Name, sgs, pts); err != nil {
+		h.Logger.Error("get cursor err ", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse("get cursor err", LogReqErr), http.StatusBadRequest)
+		return
+	}
+	sort.Slice(cursor.Tasks, func(i, j int) bool {
+		return cursor.Tasks[i].PtID < cursor.Tasks[j].PtID
+	})
 
-func (h *Handler) servePullLog(w http.ResponseWriter, r *http.Request, user meta2.User) {}
+	cursorEncode, err := cursor.EncodeToURLString()
+	if err != nil {
+		h.Logger.Error("cursor encode is error ", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse("cursor encode is error ", LogReqErr), http.StatusBadRequest)
+		return
+	}
+	results, err := json2.Marshal(consume.QueryPullCursorResponse{Cursor: cursorEncode})
+	if err != nil {
+		h.Logger.Error("get cursor request error! ", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	addLogQueryStatistics(repository, logStream)
+	_, err = w.Write(results)
+	if err != nil {
+		h.Logger.Error("get cursor write res fail! ", zap.Error(err))
+	}
+}
+
+// servePullLog pulls the next batch of logs after the cursor in the path, and returns the cursor of the next batch.
+// The batch is encoded as json by default, or as protobuf if it is required by the format parameter or the Accept header.
+func (h *Handler) servePullLog(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	t := time.Now()
+	repository := mux.Vars(r)[Repository]
+	logStream := mux.Vars(r)[LogStream]
+	pullRequest, err := consume.GetPullLogsRequest(r, mux.Vars(r)[Cursor])
+	if err != nil {
+		h.Logger.Error("pull log request error! ", zap.Error(err), zap.Any("r", r))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+	consumeInfo, filterOpt, err := h.getConsumeInfo(w, r, user, t, &measurementInfo{database: repository, name: logStream, retentionPolicy: logStream}, pullRequest.ConsumeLogsRequest)
+	if err != nil {
+		h.Logger.Error("pull log request error! ", zap.Error(err), zap.Any("r", r))
+		return
+	}
+	if consumeInfo == nil {
+		return
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			errInfo := fmt.Sprintf("pull log request error! repo: %s, logstream: %s, info:%s", repository, logStream, mux.Vars(r)[Cursor])
+			h.Logger.Error(errInfo, zap.Error(errno.NewError(errno.RecoverPanic, e)))
+			h.httpErrorRsp(w, ErrorResponse("pull log request error! ", LogReqErr), http.StatusBadRequest)
+			return
+		}
+	}()
+
+	if len(consumeInfo.GetFromCursor().Tasks) == 0 {
+		err = h.updateCursorByPtNum(consumeInfo.GetFromCursor(), nil, consumeInfo, consumeInfo.GetMstName(), consumeInfo.GetSgs(), consumeInfo.GetPt())
+		if err != nil {
+			h.getErrConsumeResponse(consumeInfo, t, w, err)
+			return
+		}
+	}
+
+	resp := &consume.PullLogsResponse{Logs: make([]map[string]interface{}, 0)}
+	if len(consumeInfo.GetFromCursor().Tasks) != 0 {
+		data, size, _, ok := h.consumeNextBatch(w, t, consumeInfo, filterOpt)
+		if !ok {
+			return
+		}
+		if len(data) != 0 {
+			resp.Logs = data
+		}
+		resp.TotalCount = len(data)
+		resp.TotalSize = size
+	}
+	resp.NextCursor, err = consumeInfo.GetFromCursor().EncodeToURLString()
+	if err != nil {
+		h.Logger.Error("cursor encode is error ", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse("cursor encode is error ", LogReqErr), http.StatusBadRequest)
+		return
+	}
+	resp.TookMs = time.Since(t).Milliseconds()
+
+	var results []byte
+	if pullRequest.Format == consume.PullFormatProtobuf {
+		w.Header().Set("Content-Type", consume.ProtobufContentType)
+		results, err = resp.MarshalProto()
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+		results, err = sonic.Marshal(resp)
+	}
+	if err != nil {
+		h.Logger.Error("pull log marshal res fail! ", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("X-Content-Length", strconv.Itoa(len(results)))
+	w.WriteHeader(http.StatusOK)
+	addLogQueryStatistics(repository, logStream)
+	_, err = w.Write(results)
+	if err != nil {
+		h.Logger.Error("pull log write res fail! ", zap.Error(err))
+	}
+}
 
 func getQueryConsumeCursorsRequest(r *http.Request) (*consume.ConsumeCursorsRequest, error) {
 	var err error
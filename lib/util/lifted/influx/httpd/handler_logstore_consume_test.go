@@ -0,0 +1,146 @@
+package httpd
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/influxdata/influxdb/services/httpd"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/metaclient"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/consume"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMetaClient4Pull struct {
+	*metaclient.Client
+}
+
+func (m *mockMetaClient4Pull) Database(name string) (*meta.DatabaseInfo, error) {
+	if name != "repo0" {
+		return nil, errno.NewError(errno.DatabaseNotFound, name)
+	}
+	return &meta.DatabaseInfo{
+		Name:              name,
+		RetentionPolicies: map[string]*meta.RetentionPolicyInfo{"stream0": {Name: "stream0"}},
+	}, nil
+}
+
+func (m *mockMetaClient4Pull) DBPtView(database string) (meta.DBPtInfos, error) {
+	return meta.DBPtInfos{{PtId: 0}, {PtId: 1}}, nil
+}
+
+func (m *mockMetaClient4Pull) Measurement(database string, rpName string, mstName string) (*meta.MeasurementInfo, error) {
+	return &meta.MeasurementInfo{Name: mstName + "_0000"}, nil
+}
+
+func (m *mockMetaClient4Pull) GetShardGroupByTimeRange(repoName, streamName string, min, max time.Time) ([]*meta.ShardGroupInfo, error) {
+	return nil, nil
+}
+
+func newPullHandler() *Handler {
+	return &Handler{
+		MetaClient:     &mockMetaClient4Pull{},
+		requestTracker: httpd.NewRequestTracker(),
+		Logger:         logger.NewLogger(errno.ModuleHTTP),
+	}
+}
+
+func newPullRequest(target string, vars map[string]string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	return mux.SetURLVars(req, vars)
+}
+
+func getPullCursor(t *testing.T, h *Handler, target string) string {
+	w := httptest.NewRecorder()
+	h.serveGetCursor(w, newPullRequest(target, map[string]string{Repository: "repo0", LogStream: "stream0"}), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	resp := &consume.QueryPullCursorResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	require.NotEmpty(t, resp.Cursor)
+	return resp.Cursor
+}
+
+// decodePullCursor converts the cursor of the pull api to the one of the consume api.
+func decodePullCursor(t *testing.T, cursor string) string {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func TestHandler_ServeGetCursor(t *testing.T) {
+	h := newPullHandler()
+
+	cursor := getPullCursor(t, h, "/repo/repo0/logstreams/stream0/cursor?from=1000")
+	c, err := consume.GetConsumeCursor(decodePullCursor(t, cursor))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000*1e6), c.Time)
+	assert.Equal(t, 1, c.TaskNum)
+	assert.Equal(t, 2, c.CurrTotalPtNum)
+
+	cursor = getPullCursor(t, h, "/repo/repo0/logstreams/stream0/cursor?from=1000&shard=1")
+	c, err = consume.GetConsumeCursor(decodePullCursor(t, cursor))
+	require.NoError(t, err)
+	assert.Equal(t, 2, c.TaskNum)
+	assert.Equal(t, 1, c.CursorID)
+
+	for _, tc := range []struct {
+		target string
+		vars   map[string]string
+	}{
+		{"/repo/repo1/logstreams/stream0/cursor?from=1000", map[string]string{Repository: "repo1", LogStream: "stream0"}},
+		{"/repo/repo0/logstreams/stream1/cursor?from=1000", map[string]string{Repository: "repo0", LogStream: "stream1"}},
+		{"/repo/repo0/logstreams/stream0/cursor?from=-1", map[string]string{Repository: "repo0", LogStream: "stream0"}},
+		{"/repo/repo0/logstreams/stream0/cursor?from=1000&shard=2", map[string]string{Repository: "repo0", LogStream: "stream0"}},
+	} {
+		w := httptest.NewRecorder()
+		h.serveGetCursor(w, newPullRequest(tc.target, tc.vars), nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code, tc.target)
+	}
+}
+
+func TestHandler_ServePullLog(t *testing.T) {
+	h := newPullHandler()
+	cursor := getPullCursor(t, h, "/repo/repo0/logstreams/stream0/cursor?from=1000")
+	vars := map[string]string{Repository: "repo0", LogStream: "stream0", Cursor: cursor}
+
+	w := httptest.NewRecorder()
+	h.servePullLog(w, newPullRequest("/repo/repo0/logstreams/stream0/cursor/"+cursor+"?count=10", vars), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	resp := &consume.PullLogsResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Equal(t, 0, resp.TotalCount)
+	assert.NotEmpty(t, resp.NextCursor)
+
+	w = httptest.NewRecorder()
+	req := newPullRequest("/repo/repo0/logstreams/stream0/cursor/"+cursor, vars)
+	req.Header.Set("Accept", consume.ProtobufContentType)
+	h.servePullLog(w, req, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, consume.ProtobufContentType, w.Header().Get("Content-Type"))
+	resp = &consume.PullLogsResponse{}
+	require.NoError(t, resp.UnmarshalProto(w.Body.Bytes()))
+	assert.NotEmpty(t, resp.NextCursor)
+
+	for _, target := range []string{
+		"/repo/repo0/logstreams/stream0/cursor/" + cursor + "?count=0",
+		"/repo/repo0/logstreams/stream0/cursor/" + cursor + "?format=xml",
+	} {
+		w = httptest.NewRecorder()
+		h.servePullLog(w, newPullRequest(target, vars), nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code, target)
+	}
+
+	w = httptest.NewRecorder()
+	h.servePullLog(w, newPullRequest("/repo/repo0/logstreams/stream0/cursor/a+b", map[string]string{Repository: "repo0", LogStream: "stream0", Cursor: "a+b"}), nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/pattern"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// serveLogPatterns clusters the logs of the time range into templates, and compares them with the ones of the baseline range if it is given.
+func (h *Handler) serveLogPatterns(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	t := time.Now()
+	repository := mux.Vars(r)[Repository]
+	logStream := mux.Vars(r)[LogStream]
+	if err := h.ValidateAndCheckLogStreamExists(repository, logStream); err != nil {
+		h.Logger.Error("log patterns request error! ", zap.Error(err), zap.Any("r", r))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return
+	}
+	req, err := pattern.GetPatternsRequest(r, MinFromValue, MaxToValue/int64(1e6))
+	if err != nil {
+		h.Logger.Error("log patterns request error! ", zap.Error(err), zap.Any("r", r))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+
+	info := &measurementInfo{name: logStream, database: repository, retentionPolicy: logStream}
+	drain := pattern.NewDrain(pattern.DefaultDepth, req.Similarity)
+	resp := &pattern.PatternsResponse{}
+	resp.Logs, err = h.clusterLogs(w, r, user, info, drain, req, req.From, req.To, pattern.CurrentWindow)
+	if err == nil && req.HasBaseline {
+		resp.BaselineLogs, err = h.clusterLogs(w, r, user, info, drain, req, req.BaselineFrom, req.BaselineTo, pattern.BaselineWindow)
+	}
+	if err != nil {
+		h.Logger.Error("log patterns query error! ", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+	resp.Patterns = pattern.BuildPatterns(drain.Clusters(), req)
+	resp.TookMs = time.Since(t).Milliseconds()
+
+	b, err := json2.Marshal(resp)
+	if err != nil {
+		h.Logger.Error("log patterns marshal res fail! ", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set(XContentLength, strconv.Itoa(len(b)))
+	h.writeHeader(w, http.StatusOK)
+	addLogQueryStatistics(repository, logStream)
+	_, err = w.Write(b)
+	if err != nil {
+		h.Logger.Error("log patterns write res fail! ", zap.Error(err))
+	}
+}
+
+// clusterLogs queries the latest logs of the time range through the query-log path, and adds the field of the logs to the drain.
+// At most req.MaxLogs logs are matched, it returns the number of them, including the logs without the field which are not clustered.
+func (h *Handler) clusterLogs(w http.ResponseWriter, r *http.Request, user meta2.User, info *measurementInfo, drain *pattern.Drain,
+	req *pattern.PatternsRequest, from, to int64, window pattern.Window) (int64, error) {
+	para := &QueryParam{
+		Query:     removeLastSelectStr(req.Query),
+		TimeRange: TimeRange{start: from, end: to},
+		SeqID:     -1,
+		Limit:     req.MaxLogs,
+	}
+	para.QueryID = strconv.FormatInt(time.Now().UnixNano(), 10)
+	sgs, err := h.MetaClient.GetShardGroupByTimeRange(info.database, info.retentionPolicy, time.Unix(0, from), time.Unix(0, to))
+	if err != nil {
+		if QuerySkippingError(err.Error()) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var count int64
+	keysMap := map[string]bool{}
+	for i := len(sgs) - 1; i >= 0 && count < int64(req.MaxLogs); i-- {
+		currPara := para.deepCopy()
+		currPara.Limit = req.MaxLogs - int(count)
+		if sgs[i].StartTime.UnixNano() > currPara.TimeRange.start {
+			currPara.TimeRange.start = sgs[i].StartTime.UnixNano()
+		}
+		if sgs[i].EndTime.UnixNano() < currPara.TimeRange.end {
+			currPara.TimeRange.end = sgs[i].EndTime.UnixNano()
+		}
+		resp, logCond, _, _, err := h.serveLogQuery(w, r, currPara, user, info)
+		if err != nil {
+			if QuerySkippingError(err.Error()) {
+				continue
+			}
+			return count, err
+		}
+		_, logs, err := h.getQueryLogResult(resp, logCond, currPara, keysMap)
+		if err != nil {
+			return count, err
+		}
+		for _, log := range logs {
+			count++
+			content, _ := log[Content].(map[string]interface{})
+			value, ok := content[req.Field]
+			if !ok || value == nil {
+				continue
+			}
+			drain.Add(convertToString(value), window)
+		}
+	}
+	return count, nil
+}
@@ -90,6 +90,8 @@ type QueryLogRequest struct {
 	Sql        bool   `json:"sql,omitempty"`
 	IsTruncate bool   `json:"is_truncate,omitempty"`
 	Pretty     bool   `json:"pretty,omitempty"`
+	// OrderByScore ranks the logs by the BM25 relevance instead of the time
+	OrderByScore bool `json:"order_by_score,omitempty"`
 }
 
 type QueryLogResponse struct {
@@ -117,6 +119,7 @@ type QueryParam struct {
 	Ascending        bool
 	Explain          bool
 	Highlight        bool
+	OrderByScore     bool
 	IncQuery         bool
 	Truncate         bool
 	Pretty           bool
@@ -138,17 +141,18 @@ func NewQueryPara(queryPara interface{}) *QueryParam {
 	switch q := queryPara.(type) {
 	case *QueryLogRequest:
 		queryParam = QueryParam{
-			Explain:   q.Explain,
-			Query:     q.Query,
-			Ascending: !q.Reverse,
-			Highlight: q.Highlight,
-			Timeout:   q.Timeout,
-			TimeRange: TimeRange{start: q.From * 1e6, end: q.To * 1e6},
-			Scroll:    q.Scroll,
-			Scroll_id: q.Scroll_id,
-			Limit:     q.Limit,
-			Truncate:  q.IsTruncate,
-			Pretty:    q.Pretty,
+			Explain:      q.Explain,
+			Query:        q.Query,
+			Ascending:    !q.Reverse,
+			Highlight:    q.Highlight,
+			OrderByScore: q.OrderByScore,
+			Timeout:      q.Timeout,
+			TimeRange:    TimeRange{start: q.From * 1e6, end: q.To * 1e6},
+			Scroll:       q.Scroll,
+			Scroll_id:    q.Scroll_id,
+			Limit:        q.Limit,
+			Truncate:     q.IsTruncate,
+			Pretty:       q.Pretty,
 		}
 	case *QueryAggRequest:
 		queryParam = QueryParam{
@@ -253,6 +257,7 @@ func (para *QueryParam) deepCopy() *QueryParam {
 		Ascending:        para.Ascending,
 		Explain:          para.Explain,
 		Highlight:        para.Highlight,
+		OrderByScore:     para.OrderByScore,
 		IncQuery:         para.IncQuery,
 		IterID:           para.IterID,
 		Timeout:          para.Timeout,
@@ -300,6 +305,7 @@ func (h *Handler) serveQueryLog(w http.ResponseWriter, r *http.Request, user met
 	var count int64
 	var logs []map[string]interface{}
 	keysMap := map[string]bool{}
+	highlightWords := map[string]map[string]bool{}
 	sgs, err := h.MetaClient.GetShardGroupByTimeRange(repository, logStream, time.Unix(0, para.TimeRange.start), time.Unix(0, para.TimeRange.end))
 	tm := time.Now()
 	isFinish := true
@@ -311,6 +317,10 @@ func (h *Handler) serveQueryLog(w http.ResponseWriter, r *http.Request, user met
 		}
 		currTm := time.Now()
 		currPara := para.deepCopy()
+		if para.OrderByScore {
+			// the logs are ranked among the candidates of the shard groups
+			currPara.Limit = MaxLogLimit
+		}
 		if sgs[i].StartTime.UnixNano() > currPara.TimeRange.start {
 			currPara.TimeRange.start = sgs[i].StartTime.UnixNano()
 		}
@@ -341,12 +351,17 @@ func (h *Handler) serveQueryLog(w http.ResponseWriter, r *http.Request, user met
 		}
 		count += currCount
 		logs = append(logs, currLog...)
-		if count >= int64(para.Limit) {
+		if para.OrderByScore {
+			if logCond != nil {
+				highlightWords = getHighlightWords(&(logCond.Statements[0].(*influxql.LogPipeStatement).Cond), highlightWords)
+			}
+		} else if count >= int64(para.Limit) {
 			logs = logs[0:para.Limit]
 			count = int64(para.Limit)
 			break
 		}
-		if int(time.Since(tm).Milliseconds()+time.Since(currTm).Milliseconds()) >= queryLogRequest.Timeout && j != len(sgs)-1 {
+		if (int(time.Since(tm).Milliseconds()+time.Since(currTm).Milliseconds()) >= queryLogRequest.Timeout ||
+			(para.OrderByScore && count >= MaxLogLimit)) && j != len(sgs)-1 {
 			isFinish = false
 			if queryLogRequest.Reverse {
 				sgStartTime = sgs[i].StartTime.UnixNano()
@@ -356,12 +371,19 @@ func (h *Handler) serveQueryLog(w http.ResponseWriter, r *http.Request, user met
 			break
 		}
 	}
+	if para.OrderByScore {
+		rankLogsByScore(logs, highlightWords)
+		if len(logs) > para.Limit {
+			logs = logs[0:para.Limit]
+			count = int64(para.Limit)
+		}
+	}
 	var scrollIDString string
 	var progress string
 	var completeProgress float64
 	var cursorTime int64
 	// read finished
-	if isFinish && (logs == nil || len(logs) == 0 || len(logs) < para.Limit) {
+	if isFinish && (para.OrderByScore || logs == nil || len(logs) == 0 || len(logs) < para.Limit) {
 		scrollIDString = EmptyValue
 		progress = Complete
 		completeProgress = 1
@@ -479,6 +501,54 @@ func getHighlightWords(expr *influxql.Expr, words map[string]map[string]bool) ma
 	return words
 }
 
+// rankLogsByScore sets the BM25 score of the query words to the logs and sorts the logs by the score.
+// The words are matched the same way as the highlight, the statistic of the corpus is counted over the returned logs,
+// the storage keeps no term statistic of the logstreams. So the IDF only weighs the words of an OR query against each other,
+// while the logs of an AND query are ranked by the term frequencies normalized by the log lengths.
+func rankLogsByScore(logs []map[string]interface{}, words map[string]map[string]bool) {
+	finder := tokenizer.NewSimpleTokenFinder(tokenizer.CONTENT_SPLIT_TABLE)
+	stats := tokenizer.NewBM25Stats()
+	docLens := make([]int, len(logs))
+	tfs := make([]map[string]int, len(logs))
+	for i := range logs {
+		content, _ := logs[i][Content].(map[string]interface{})
+		values := make(map[string][]byte, len(content))
+		for k, v := range content {
+			values[k] = []byte(convertToString(v))
+			docLens[i] += tokenizer.TokenCount(values[k], tokenizer.CONTENT_SPLIT_TABLE)
+		}
+
+		tfs[i] = make(map[string]int, len(words))
+		terms := make([]string, 0, len(words))
+		for word, fields := range words {
+			tf := 0
+			for k, v := range values {
+				if fields[logparser.DefaultFieldForFullText] || fields[k] {
+					tf += tokenizer.TermFrequency(finder, v, []byte(word))
+				}
+			}
+			if tf > 0 {
+				tfs[i][word] = tf
+				terms = append(terms, word)
+			}
+		}
+		stats.AddDoc(docLens[i], terms)
+	}
+
+	avgDocLen := stats.AvgDocLen()
+	for i := range logs {
+		score := 0.0
+		for word, tf := range tfs[i] {
+			score += tokenizer.BM25Score(tf, docLens[i], avgDocLen, stats.IDF(word))
+		}
+		logs[i][Score] = score
+	}
+	// the logs with the same score keep their time order
+	sort.SliceStable(logs, func(i, j int) bool {
+		return logs[i][Score].(float64) > logs[j][Score].(float64)
+	})
+}
+
 func (h *Handler) getHighlightFragments(slog map[string]interface{}, highlightWords map[string]map[string]bool, fieldScopes []marshalFieldScope) map[string]interface{} {
 	highlight := map[string]interface{}{}
 
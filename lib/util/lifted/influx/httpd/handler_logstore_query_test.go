@@ -164,3 +164,53 @@ func TestExtractFieldFragments(t *testing.T) {
 	}
 	assert.Equal(t, 3, len(fragments))
 }
+
+func TestRemoveScoreOrder(t *testing.T) {
+	query, ok := removeScoreOrder("error | select * ORDER BY _score DESC limit 10")
+	assert.True(t, ok)
+	assert.Equal(t, "error | select * limit 10", query)
+	query, ok = removeScoreOrder("select count(*) order by _score")
+	assert.True(t, ok)
+	assert.Equal(t, "select count(*)", query)
+	// ORDER BY _score in the full text words is kept
+	query, ok = removeScoreOrder("content: 'order by _score'")
+	assert.False(t, ok)
+	assert.Equal(t, "content: 'order by _score'", query)
+	_, ok = removeScoreOrder("error | select * order by time")
+	assert.False(t, ok)
+}
+
+func TestRankLogsByScore(t *testing.T) {
+	newLog := func(id int, content, host string) map[string]interface{} {
+		return map[string]interface{}{Cursor: id, Content: map[string]interface{}{"content": content, "host": host, "code": int64(500)}}
+	}
+	logs := []map[string]interface{}{
+		newLog(0, "GET /index.html", "error"),
+		newLog(1, "connection error, retry after error", "host1"),
+		newLog(2, "error: disk full while writing the wal file of the shard", "host2"),
+		newLog(3, "disk error", "host3"),
+		newLog(4, "nothing", "host4"),
+	}
+	words := map[string]map[string]bool{
+		"error": {logparser.DefaultFieldForFullText: true},
+		"disk":  {"content": true},
+	}
+	rankLogsByScore(logs, words)
+
+	var ids []int
+	for _, log := range logs {
+		ids = append(ids, log[Cursor].(int))
+	}
+	// the rare word and the short documents weigh more, the logs with the same score keep their order
+	assert.Equal(t, []int{3, 2, 1, 0, 4}, ids)
+	assert.Equal(t, 0.0, logs[4][Score])
+	for i := 1; i < len(logs); i++ {
+		assert.True(t, logs[i-1][Score].(float64) >= logs[i][Score].(float64))
+	}
+
+	// the words of the host field do not match the content
+	logs = []map[string]interface{}{newLog(0, "disk", "host1"), newLog(1, "error", "disk")}
+	rankLogsByScore(logs, map[string]map[string]bool{"disk": {"host": true}})
+	assert.Equal(t, 1, logs[0][Cursor])
+	assert.Equal(t, 0.0, logs[1][Score])
+}
@@ -0,0 +1,334 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/tail"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/logparser"
+	"go.uber.org/zap"
+)
+
+const (
+	// TailHeartbeatInterval is the interval of the stats events, they also keep the idle connections alive
+	TailHeartbeatInterval = 10 * time.Second
+	// TailPollWait is the max time a poll of the stores waits for the logs
+	TailPollWait = time.Second
+	// TailRefreshInterval is the interval to refresh the stores owning the partitions of the repository
+	TailRefreshInterval = 10 * time.Second
+	// tailRetryInterval is the interval to poll a store again after an error
+	tailRetryInterval = time.Second
+	// tailBatchSize is the max number of the logs written between two flushes
+	tailBatchSize = 100
+
+	TailRate       = "rate"
+	TailBufferSize = "buffer_size"
+)
+
+// serveTailLog streams the logs written to the logstream by Server-Sent Events, the logs are filtered by the query.
+// Every store owning the partitions of the repository filters the logs as they land in its mutable tables,
+// and buffers them in a session polled by this node. The subscribers are rate limited and sampled both
+// on the stores and on this node, so that a slow client never blocks the writes.
+func (h *Handler) serveTailLog(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	repository := mux.Vars(r)[Repository]
+	logStream := mux.Vars(r)[LogStream]
+	if err := h.ValidateAndCheckLogStreamExists(repository, logStream); err != nil {
+		h.Logger.Error("live tail request error! ", zap.Error(err), zap.Any("r", r))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok || h.LogTailer == nil {
+		h.httpErrorRsp(w, ErrorResponse("live tail is not supported by the connection", LogReqErr), http.StatusInternalServerError)
+		return
+	}
+	opt, err := getTailOptions(r)
+	if err != nil {
+		h.Logger.Error("live tail request error! ", zap.Error(err), zap.Any("r", r))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+	cond, err := parseTailCondition(r.FormValue(Query))
+	if err != nil {
+		h.Logger.Error("live tail query error! ", zap.Error(err), zap.Any("r", r))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		return
+	}
+
+	// the subscriber of this node limits the live tails and the rate of the logs merged from the stores
+	sub, err := tail.DefaultHub.Subscribe(repository, logStream, nil, opt)
+	if err != nil {
+		h.Logger.Error("live tail subscribe error! ", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusTooManyRequests)
+		return
+	}
+	defer tail.DefaultHub.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	h.writeHeader(w, http.StatusOK)
+	flusher.Flush()
+	addLogQueryStatistics(repository, logStream)
+
+	lt := newLogTail(h, repository, logStream, cond, sub, opt)
+	ctx, cancel := context.WithCancel(r.Context())
+	go lt.run(ctx)
+	err = streamTail(ctx, w, flusher, lt.sub.Logs(), lt.Stats, TailHeartbeatInterval)
+	cancel()
+	lt.wait()
+	h.Logger.Info("live tail closed", zap.Error(err), zap.String("repository", repository), zap.String("logStream", logStream))
+}
+
+func getTailOptions(r *http.Request) (tail.Options, error) {
+	opt := tail.Options{BufferSize: tail.DefaultBufferSize, Rate: tail.DefaultRate}
+	if rate := r.FormValue(TailRate); rate != "" {
+		n, err := strconv.Atoi(rate)
+		if err != nil || n <= 0 || n > tail.MaxRate {
+			return opt, fmt.Errorf("the valid range for %s is [1, %d]", TailRate, tail.MaxRate)
+		}
+		opt.Rate = n
+	}
+	if size := r.FormValue(TailBufferSize); size != "" {
+		n, err := strconv.Atoi(size)
+		if err != nil || n <= 0 || n > tail.MaxBufferSize {
+			return opt, fmt.Errorf("the valid range for %s is [1, %d]", TailBufferSize, tail.MaxBufferSize)
+		}
+		opt.BufferSize = n
+	}
+	return opt, nil
+}
+
+// parseTailCondition parses the filter of the query by the logparser, the select statement of the query is not supported.
+// The condition is sent to the stores as a string, it is checked here to reject the unsupported conditions early.
+func parseTailCondition(query string) (string, error) {
+	if len(query) > MaxQueryLen {
+		return "", errno.NewError(errno.TooLongQuery, MaxQueryLen)
+	}
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return "", nil
+	}
+	if removeLastSelectStr(query) != query {
+		return "", fmt.Errorf("live tail only supports the filter of the query")
+	}
+	parser := logparser.NewYyParser(logparser.NewScanner(strings.NewReader(query)))
+	parser.ParseTokens()
+	q, err := parser.GetQuery()
+	if err != nil {
+		return "", fmt.Errorf("error parsing query: " + TransYaccSyntaxErr(err.Error()))
+	}
+	stmt := q.Statements[0].(*influxql.LogPipeStatement)
+	if stmt.Unnest != nil {
+		return "", fmt.Errorf("live tail only supports the filter of the query")
+	}
+	if stmt.Cond == nil {
+		return "", nil
+	}
+	if _, err = tail.NewFilter(stmt.Cond, nil); err != nil {
+		return "", err
+	}
+	return stmt.Cond.String(), nil
+}
+
+// logTail polls the live tail sessions of the logstream on the stores, and offers the logs to the subscriber
+type logTail struct {
+	h          *Handler
+	repository string
+	logStream  string
+	req        netstorage.TailLogsRequest
+	sub        *tail.Subscriber
+
+	mu      sync.Mutex
+	pollers map[uint64]context.CancelFunc
+	// the cumulative stats of the sessions on the stores
+	stats map[uint64]tail.Stats
+	wg    sync.WaitGroup
+}
+
+func newLogTail(h *Handler, repository, logStream, cond string, sub *tail.Subscriber, opt tail.Options) *logTail {
+	return &logTail{
+		h:          h,
+		repository: repository,
+		logStream:  logStream,
+		req: netstorage.TailLogsRequest{
+			Db:         repository,
+			LogStream:  logStream,
+			Session:    rand.Uint64(),
+			Condition:  cond,
+			Rate:       int64(opt.Rate),
+			BufferSize: int64(opt.BufferSize),
+			Wait:       int64(TailPollWait),
+		},
+		sub:     sub,
+		pollers: make(map[uint64]context.CancelFunc),
+		stats:   make(map[uint64]tail.Stats),
+	}
+}
+
+// run polls the stores owning the partitions of the repository until ctx is done,
+// the stores are refreshed periodically as the partitions may be moved.
+func (t *logTail) run(ctx context.Context) {
+	ticker := time.NewTicker(TailRefreshInterval)
+	defer ticker.Stop()
+	for {
+		t.refresh(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t *logTail) refresh(ctx context.Context) {
+	pts, err := t.h.MetaClient.DBPtView(t.repository)
+	if err != nil {
+		t.h.Logger.Error("live tail get partitions error", zap.Error(err), zap.String("repository", t.repository))
+		return
+	}
+	nodes := make(map[uint64]struct{})
+	for _, pt := range pts {
+		nodes[pt.Owner.NodeID] = struct{}{}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for nodeID, cancel := range t.pollers {
+		if _, ok := nodes[nodeID]; !ok {
+			cancel()
+			delete(t.pollers, nodeID)
+		}
+	}
+	for nodeID := range nodes {
+		if _, ok := t.pollers[nodeID]; ok || ctx.Err() != nil {
+			continue
+		}
+		pollCtx, cancel := context.WithCancel(ctx)
+		t.pollers[nodeID] = cancel
+		t.wg.Add(1)
+		go t.poll(pollCtx, nodeID)
+	}
+}
+
+func (t *logTail) poll(ctx context.Context, nodeID uint64) {
+	defer t.wg.Done()
+	req := t.req
+	for ctx.Err() == nil {
+		resp, err := t.h.LogTailer.TailLogs(nodeID, &req)
+		if err != nil {
+			t.h.Logger.Error("live tail poll error", zap.Error(err), zap.Uint64("node", nodeID),
+				zap.String("repository", t.repository), zap.String("logStream", t.logStream))
+			select {
+			case <-ctx.Done():
+			case <-time.After(tailRetryInterval):
+			}
+			continue
+		}
+		t.mu.Lock()
+		t.stats[nodeID] = resp.Stats
+		t.mu.Unlock()
+		t.sub.Offer(resp.Logs)
+	}
+
+	req.Close = true
+	if _, err := t.h.LogTailer.TailLogs(nodeID, &req); err != nil {
+		t.h.Logger.Warn("live tail close session error", zap.Error(err), zap.Uint64("node", nodeID))
+	}
+}
+
+func (t *logTail) wait() {
+	t.wg.Wait()
+}
+
+// Stats returns the logs matched on the stores, and the logs sampled out or dropped on the stores and on this node
+func (t *logTail) Stats() tail.Stats {
+	stats := t.sub.Stats()
+	stats.Matched = 0
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, s := range t.stats {
+		stats.Matched += s.Matched
+		stats.Sampled += s.Sampled
+		stats.Dropped += s.Dropped
+	}
+	return stats
+}
+
+// streamTail writes the logs of the subscriber as "log" events and the counters as "stats" events until ctx is done.
+func streamTail(ctx context.Context, w io.Writer, flusher http.Flusher, logs <-chan *tail.Log, stats func() tail.Stats, heartbeat time.Duration) error {
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := writeTailEvent(w, "stats", stats()); err != nil {
+				return err
+			}
+		case log := <-logs:
+			if err := writeTailEvent(w, "log", tailLogResponse(log)); err != nil {
+				return err
+			}
+			for i := 1; i < tailBatchSize && len(logs) > 0; i++ {
+				if err := writeTailEvent(w, "log", tailLogResponse(<-logs)); err != nil {
+					return err
+				}
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+func writeTailEvent(w io.Writer, event string, data interface{}) error {
+	b, err := json2.Marshal(data)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, 0, len(event)+len(b)+16)
+	buf = append(buf, "event: "...)
+	buf = append(buf, event...)
+	buf = append(buf, "\ndata: "...)
+	buf = append(buf, b...)
+	buf = append(buf, "\n\n"...)
+	_, err = w.Write(buf)
+	return err
+}
+
+// tailLogResponse converts the log to the format of the logs returned by the query.
+func tailLogResponse(log *tail.Log) map[string]interface{} {
+	return map[string]interface{}{
+		Timestamp: log.Time / int64(time.Millisecond),
+		Content:   log.Fields,
+	}
+}
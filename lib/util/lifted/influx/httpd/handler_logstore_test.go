@@ -2,9 +2,11 @@ package httpd
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
@@ -14,7 +16,10 @@ import (
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/tokenizer"
 	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/pipeline"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/tail"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/logparser"
@@ -133,6 +138,58 @@ func TestParseJsonFailLog(t *testing.T) {
 
 }
 
+func TestParseJsonLinesPipeline(t *testing.T) {
+	h := &Handler{
+		Logger: logger.NewLogger(errno.ModuleLogStore),
+	}
+	var err error
+	req := mockLogWriteRequest(`{"timestamp":"time"}`)
+	req.failTag = FailLogTag
+	req.logSchema = append(record.Schemas{}, logSchema...)
+	req.pipeline, err = pipeline.Compile([]byte(`{"processors": [
+		{"type": "grok", "field": "content", "patterns": ["^%{LOGLEVEL:level} %{GREEDYDATA:msg}$"]},
+		{"type": "drop", "if": "level = 'DEBUG'"},
+		{"type": "kv", "field": "msg"},
+		{"type": "remove", "fields": ["msg"]}
+	]}`))
+	assert.NoError(t, err)
+
+	lines := []string{
+		`{"time":1719862212771, "content":"WARN user=bob code=7"}`,
+		`{"time":1719862212772, "content":"DEBUG user=amy"}`,
+		`{"time":1719862212773, "content":"garbage"}`,
+		`not json`,
+	}
+	scanner := bufio.NewScanner(strings.NewReader(strings.Join(lines, "\n")))
+	scanBuf := byteBufferPool.Get()
+	defer byteBufferPool.Put(scanBuf)
+	scanner.Buffer(scanBuf, ScannerBufferSize)
+
+	rows := &record.Record{}
+	failRows := record.NewRecord(failLogSchema, false)
+	_ = h.parseJsonLines(scanner, req, rows, failRows)
+	assert.Equal(t, 1, rows.RowNums())
+	assert.Equal(t, "WARN", rows.ColVals[rows.FieldIndexs("level")].StringValues(nil)[0])
+	assert.Equal(t, "bob", rows.ColVals[rows.FieldIndexs("user")].StringValues(nil)[0])
+	assert.Equal(t, "7", rows.ColVals[rows.FieldIndexs("code")].StringValues(nil)[0])
+	assert.Equal(t, -1, rows.FieldIndexs("msg"))
+	assert.Equal(t, int64(1719862212771000000), rows.ColVals[rows.ColNums()-1].IntegerValues()[0])
+	assert.Equal(t, []string{lines[2], lines[3]}, failRows.ColVals[0].StringValues(nil))
+	assert.True(t, req.printFailLog.alreadyPrintPipelineError)
+	assert.True(t, req.printFailLog.alreadyPrintParseError)
+}
+
+func TestValidateLogstreamPipeline(t *testing.T) {
+	opt := &meta.Options{Ttl: 1, Pipeline: []byte(`{"processors": [{"type": "drop", "if": "level = 'debug'"}]}`)}
+	assert.NoError(t, validateLogstreamOptions(opt))
+	opt.Pipeline = []byte(`{"processors": [{"type": "grok", "field": "content"}]}`)
+	assert.Error(t, validateLogstreamOptions(opt))
+
+	p, err := getLogPipeline(&meta.MeasurementInfo{Options: &meta.Options{}})
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+}
+
 func TestParseLogTags(t *testing.T) {
 	logTags := `{"tag1":"this is tag1","tag2":"this is tag2","tag3":1715065030012,"tag4":{"ss":"this is string"}}`
 	req := &LogWriteRequest{logTagString: &logTags, mstSchema: &meta.CleanSchema{}}
@@ -587,3 +644,68 @@ func TestSetRecordFloat64(t *testing.T) {
 	assert.Equal(t, true, ok)
 	assert.Equal(t, 22, len(val))
 }
+
+func TestGetDefaultSchemaForLog(t *testing.T) {
+	h := &Handler{}
+	body := &createLogstreamOptions{Options: &meta.Options{}}
+	assert.NoError(t, json.Unmarshal([]byte(`{"ttl": 1, "ngram_fields": ["content", "host"]}`), body))
+	assert.Equal(t, int64(1), body.Ttl)
+	assert.NoError(t, validateNGramFields(body.NGramFields))
+
+	_, _, indexR, _, _ := h.getDefaultSchemaForLog(body.Options, body.NGramFields, tokenizer.StandardAnalyzer)
+	assert.Equal(t, []string{"content", "host"}, indexR.GetNGramColumns())
+	assert.Equal(t, len(indexR.Oids), len(indexR.IndexOptions))
+
+	_, _, indexR, _, _ = h.getDefaultSchemaForLog(body.Options, nil, tokenizer.StandardAnalyzer)
+	assert.Equal(t, 0, len(indexR.GetNGramColumns()))
+
+	body = &createLogstreamOptions{Options: &meta.Options{}}
+	assert.NoError(t, json.Unmarshal([]byte(`{"analyzer": "cjk_bigram,lowercase", "analyzers": {"path": "path_hierarchy"}}`), body))
+	analyzers, err := body.getAnalyzers()
+	assert.NoError(t, err)
+	_, _, indexR, _, _ = h.getDefaultSchemaForLog(body.Options, nil, analyzers)
+	assert.Equal(t, "cjk_bigram,lowercase;path=path_hierarchy", tokenizer.GetFullTextOption(indexR).Tokenizers)
+	body.Analyzer = "lowercase"
+	_, err = body.getAnalyzers()
+	assert.Error(t, err)
+
+	assert.Error(t, validateNGramFields([]string{"content", "content"}))
+	assert.Error(t, validateNGramFields([]string{"time"}))
+	assert.Error(t, validateNGramFields([]string{""}))
+}
+
+func TestStreamTail(t *testing.T) {
+	cond, err := parseTailCondition("error AND status > 500")
+	assert.NoError(t, err)
+	_, err = parseTailCondition("error | select count(*)")
+	assert.Error(t, err)
+	_, err = parseTailCondition(strings.Repeat("a", MaxQueryLen+1))
+	assert.Error(t, err)
+
+	// the condition is parsed again by the stores
+	expr, err := influxql.ParseExpr(cond)
+	assert.NoError(t, err)
+	filter, err := tail.NewFilter(expr, nil)
+	assert.NoError(t, err)
+
+	hub := tail.NewHub()
+	sub, err := hub.Subscribe("repo", "ls", filter, tail.Options{})
+	assert.NoError(t, err)
+	hub.Publish("repo", "ls", []*tail.Log{
+		{Time: 2 * int64(time.Millisecond), Fields: map[string]interface{}{"content": "error b", "status": int64(503)}},
+		{Time: 3 * int64(time.Millisecond), Fields: map[string]interface{}{"content": "info c", "status": int64(503)}},
+	})
+
+	w := httptest.NewRecorder()
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+	err = streamTail(ctx, w, w, sub.Logs(), sub.Stats, 20*time.Millisecond)
+	assert.Equal(t, context.Canceled, err)
+	body := w.Body.String()
+	assert.Contains(t, body, "event: log\ndata: {\"content\":{\"content\":\"error b\",\"status\":503},\"timestamp\":2}\n\n")
+	assert.NotContains(t, body, "info c")
+	assert.Contains(t, body, "event: stats\ndata: {\"matched\":1,\"sent\":1,\"sampled\":0,\"dropped\":0}\n\n")
+}
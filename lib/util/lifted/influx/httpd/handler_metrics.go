@@ -135,7 +135,7 @@ func getMetrics(h *Handler, r *http.Request, user meta.User, tableName string) (
 	var qDuration *statistics.SQLSlowQueryStatistics
 
 	// Check authorization.
-	err = h.checkAuthorization(user, q, db)
+	err = h.checkAuthorization(r, user, q, db)
 	if err != nil {
 		return nil, fmt.Errorf("error authorizing query: " + err.Error())
 	}
@@ -97,6 +97,11 @@ func (h *Handler) servePromWriteBase(w http.ResponseWriter, r *http.Request, use
 			h.httpError(w, fmt.Sprintf("%q user is not authorized to write to database %q", user.ID(), db), http.StatusForbidden)
 			return
 		}
+
+		if err := h.authorizeTenantDatabase(r, db); err != nil {
+			h.httpError(w, err.Error(), http.StatusForbidden)
+			return
+		}
 	}
 
 	body := r.Body
@@ -505,7 +510,7 @@ func (h *Handler) servePromBaseQuery(w http.ResponseWriter, r *http.Request, use
 	}
 
 	// Check authorization.
-	err = h.checkAuthorization(user, q, db)
+	err = h.checkAuthorization(r, user, q, db)
 	if err != nil {
 		respondError(w, &apiError{errorForbidden, fmt.Errorf("error authorizing query: %w", err)}, nil)
 		return
@@ -778,7 +783,7 @@ func (h *Handler) servePromBaseMetaQuery(w http.ResponseWriter, r *http.Request,
 	}
 
 	// Check authorization.
-	err := h.checkAuthorization(user, q, db)
+	err := h.checkAuthorization(r, user, q, db)
 	if err != nil {
 		respondError(w, &apiError{errorForbidden, fmt.Errorf("error authorizing query: %w", err)}, nil)
 		return
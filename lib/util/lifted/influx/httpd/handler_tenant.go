@@ -0,0 +1,124 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/resourceallocator"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// TenantClaim is the optional claim of the JWT token naming the tenant of the request
+const TenantClaim = "tenant"
+
+type tenantKey struct{}
+
+// resolveTenant returns the tenant the request of the user runs in. A user of a tenant always runs in its tenant,
+// the tenant of the token must be the same if any. An admin user without a tenant may run in any existing tenant
+// named by the token.
+func (h *Handler) resolveTenant(user meta2.User, claim string) (string, error) {
+	if user == nil {
+		return "", nil
+	}
+	tenant := ""
+	if t := h.MetaClient.UserTenant(user.ID()); t != nil {
+		tenant = t.Name
+	}
+	if claim == "" || claim == tenant {
+		return tenant, nil
+	}
+	if tenant != "" {
+		return "", fmt.Errorf("user %s does not belong to tenant %s", user.ID(), claim)
+	}
+	if !user.AuthorizeUnrestricted() {
+		return "", fmt.Errorf("only admin users can run in tenant %s", claim)
+	}
+	if h.MetaClient.Tenant(claim) == nil {
+		return "", meta2.ErrTenantNotFound
+	}
+	return claim, nil
+}
+
+func withTenant(r *http.Request, tenant string) *http.Request {
+	if tenant == "" {
+		return r
+	}
+	return r.WithContext(context.WithValue(r.Context(), tenantKey{}, tenant))
+}
+
+// tenantOf returns the tenant the request runs in, empty if the request does not run in any tenant
+func tenantOf(r *http.Request) string {
+	tenant, _ := r.Context().Value(tenantKey{}).(string)
+	return tenant
+}
+
+// requestTenant returns the tenant the request runs in, nil if the request does not run in any tenant
+func (h *Handler) requestTenant(r *http.Request) *meta2.TenantInfo {
+	tenant := tenantOf(r)
+	if tenant == "" {
+		return nil
+	}
+	t := h.MetaClient.Tenant(tenant)
+	if t == nil {
+		// the tenant has been dropped, the request can not access any database
+		return &meta2.TenantInfo{Name: tenant}
+	}
+	return t
+}
+
+// authorizeTenantDatabase returns an error if the request runs in a tenant not owning the database
+func (h *Handler) authorizeTenantDatabase(r *http.Request, database string) error {
+	t := h.requestTenant(r)
+	if t == nil || t.HasDatabase(database) {
+		return nil
+	}
+	return fmt.Errorf("database %s is not owned by tenant %s", database, t.Name)
+}
+
+// assignTenantDatabase assigns the logstore repository created in the tenant to the tenant
+// before it is created, so that its pts are placed on the pinned nodes of the tenant.
+func (h *Handler) assignTenantDatabase(r *http.Request, database string) error {
+	t := h.requestTenant(r)
+	if t == nil || t.HasDatabase(database) {
+		return nil
+	}
+	if dbi, _ := h.MetaClient.Database(database); dbi != nil {
+		return fmt.Errorf("database %s is not owned by tenant %s", database, t.Name)
+	}
+	return h.MetaClient.UpdateTenantMember(t.Name, database, "", false)
+}
+
+// allocTenantQuery takes a query slot of the tenant the request runs in, the returned function frees the slot
+func (h *Handler) allocTenantQuery(r *http.Request) (func(), error) {
+	t := h.requestTenant(r)
+	if t == nil {
+		return func() {}, nil
+	}
+	if err := resourceallocator.AllocTenantQuery(t.Name, t.Quota.MaxQueryConcurrency); err != nil {
+		return nil, err
+	}
+	return func() {
+		resourceallocator.FreeTenantQuery(t.Name)
+	}, nil
+}
+
+func isTenantQuotaError(err error) bool {
+	return errno.Equal(err, errno.TenantSeriesQuotaExceeded) || errno.Equal(err, errno.TenantStorageQuotaExceeded) ||
+		errno.Equal(err, errno.TenantIngestRateExceeded)
+}
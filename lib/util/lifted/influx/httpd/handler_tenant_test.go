@@ -0,0 +1,171 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/influxdata/influxdb/services/httpd"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/metaclient"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMetaClient4Tenant struct {
+	*metaclient.Client
+	data *meta.Data
+}
+
+func newMockMetaClient4Tenant() *mockMetaClient4Tenant {
+	data := &meta.Data{
+		Users: []meta.UserInfo{{Name: "admin", Admin: true}, {Name: "u1"}, {Name: "u2"}},
+		Tenants: map[string]*meta.TenantInfo{
+			"t1": {Name: "t1", Databases: []string{"db0"}, Users: []string{"u1"}},
+			"t2": {Name: "t2", Databases: []string{"db1"}},
+		},
+	}
+	return &mockMetaClient4Tenant{data: data}
+}
+
+func (m *mockMetaClient4Tenant) AdminUserExists() bool {
+	return true
+}
+
+func (m *mockMetaClient4Tenant) User(name string) (meta.User, error) {
+	if u := m.data.User(name); u != nil {
+		return u, nil
+	}
+	return nil, meta.ErrUserNotFound
+}
+
+func (m *mockMetaClient4Tenant) Tenant(name string) *meta.TenantInfo {
+	return m.data.Tenant(name)
+}
+
+func (m *mockMetaClient4Tenant) UserTenant(user string) *meta.TenantInfo {
+	return m.data.UserTenant(user)
+}
+
+func (m *mockMetaClient4Tenant) Database(name string) (*meta.DatabaseInfo, error) {
+	return &meta.DatabaseInfo{Name: name}, nil
+}
+
+func TestHandler_ResolveTenant(t *testing.T) {
+	h := &Handler{MetaClient: newMockMetaClient4Tenant()}
+	admin, _ := h.MetaClient.User("admin")
+	u1, _ := h.MetaClient.User("u1")
+	u2, _ := h.MetaClient.User("u2")
+
+	tests := []struct {
+		user   meta.User
+		claim  string
+		tenant string
+		err    string
+	}{
+		{user: nil, tenant: ""},
+		{user: u1, tenant: "t1"},
+		{user: u1, claim: "t1", tenant: "t1"},
+		{user: u1, claim: "t2", err: "user u1 does not belong to tenant t2"},
+		{user: u2, tenant: ""},
+		{user: u2, claim: "t2", err: "only admin users can run in tenant t2"},
+		{user: admin, claim: "t2", tenant: "t2"},
+		{user: admin, claim: "t3", err: meta.ErrTenantNotFound.Error()},
+	}
+	for _, tt := range tests {
+		tenant, err := h.resolveTenant(tt.user, tt.claim)
+		if tt.err != "" {
+			assert.EqualError(t, err, tt.err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tt.tenant, tenant)
+	}
+}
+
+func TestHandler_AuthenticateTenant(t *testing.T) {
+	h := &Handler{
+		MetaClient: newMockMetaClient4Tenant(),
+		Config:     &config.Config{AuthEnabled: true, SharedSecret: "secret"},
+		Logger:     logger.NewLogger(errno.ModuleHTTP),
+	}
+	newRequest := func(username, tenant string) *http.Request {
+		claims := jwt.MapClaims{"username": username, "exp": float64(time.Now().Add(time.Hour).Unix())}
+		if tenant != "" {
+			claims[TenantClaim] = tenant
+		}
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodGet, "/query", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		return req
+	}
+
+	var got string
+	handler := authenticate(func(w http.ResponseWriter, r *http.Request, user meta.User) {
+		got = tenantOf(r)
+	}, h, true)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest("u1", ""))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "t1", got)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest("admin", "t2"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "t2", got)
+
+	got = ""
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest("u1", "t2"))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, "", got)
+}
+
+func TestHandler_ServeWrite_Tenant(t *testing.T) {
+	h := Handler{
+		requestTracker:  httpd.NewRequestTracker(),
+		Logger:          logger.NewLogger(errno.ModuleHTTP),
+		MetaClient:      newMockMetaClient4Tenant(),
+		WriteAuthorizer: &mockWriteAuthorizer{},
+		Config:          &config.Config{AuthEnabled: true},
+	}
+	user, _ := h.MetaClient.User("u1")
+
+	w := httptest.NewRecorder()
+	req := withTenant(httptest.NewRequest(http.MethodPost, "/write?db=db1", nil), "t1")
+	h.serveWrite(w, req, user)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "database db1 is not owned by tenant t1")
+
+	require.NoError(t, h.authorizeTenantDatabase(withTenant(req, "t2"), "db1"))
+	require.NoError(t, h.authorizeTenantDatabase(httptest.NewRequest(http.MethodPost, "/write?db=db1", nil), "db1"))
+	// the requests of a dropped tenant can not access any database
+	assert.Error(t, h.authorizeTenantDatabase(withTenant(req, "t3"), "db0"))
+}
+
+type mockWriteAuthorizer struct{}
+
+func (a *mockWriteAuthorizer) AuthorizeWrite(username, database string) error {
+	return nil
+}
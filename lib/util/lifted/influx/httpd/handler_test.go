@@ -460,6 +460,45 @@ func TestHandler_ServeWrite_ReadOnly(t *testing.T) {
 	})
 }
 
+type mockMetaClient4Replication struct {
+	*metaclient.Client
+}
+
+func (mockMetaClient4Replication) Database(name string) (*meta.DatabaseInfo, error) {
+	return &meta.DatabaseInfo{Name: name, Replication: &meta.ReplicationInfo{Target: "http://127.0.0.1:8086", Role: meta.ReplicationStandby}}, nil
+}
+
+func TestHandler_ServeWrite_ReplicationStandby(t *testing.T) {
+	h := Handler{
+		requestTracker: httpd.NewRequestTracker(),
+		Logger:         logger.NewLogger(errno.ModuleHTTP),
+		MetaClient:     &mockMetaClient4Replication{},
+		Config:         &config.Config{},
+	}
+
+	var user meta.User
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/write?db=db0", nil)
+	h.serveWrite(w, req, user)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "replication standby")
+}
+
+func TestHandler_IsReplicationWrite(t *testing.T) {
+	h := Handler{Config: &config.Config{}}
+	req := httptest.NewRequest(http.MethodPost, "/write?db=db0", nil)
+	assert.False(t, h.isReplicationWrite(req, nil))
+
+	req.Header.Set(meta.ReplicationHeader, "primary")
+	assert.True(t, h.isReplicationWrite(req, nil))
+
+	// only the admin users ship the writes to the standby if the auth is enabled
+	h.Config.AuthEnabled = true
+	assert.False(t, h.isReplicationWrite(req, nil))
+	assert.False(t, h.isReplicationWrite(req, &meta.UserInfo{Name: "user0"}))
+	assert.True(t, h.isReplicationWrite(req, &meta.UserInfo{Name: "admin", Admin: true}))
+}
+
 func TestTransYaccSyntaxErr(t *testing.T) {
 	testStr := [][2]string{
 		{"unexpected COMMA", "unexpected COMMA"},
@@ -0,0 +1,218 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pattern
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// Wildcard is the placeholder of the variable tokens in the templates.
+	Wildcard = "<*>"
+
+	DefaultDepth       = 4
+	DefaultSimilarity  = 0.5
+	DefaultMaxChildren = 100
+	DefaultMaxClusters = 10000
+)
+
+// Window is the time window a log line belongs to, the baseline window is only used by the comparison.
+type Window int
+
+const (
+	CurrentWindow Window = iota
+	BaselineWindow
+)
+
+// Cluster is a template of the log lines, the variable tokens are replaced by Wildcard.
+type Cluster struct {
+	tokens        []string
+	Count         int64
+	BaselineCount int64
+	Sample        string
+}
+
+func (c *Cluster) Template() string {
+	return strings.Join(c.tokens, " ")
+}
+
+// similarity returns the ratio of the tokens equal to the template and the number of the wildcards.
+func (c *Cluster) similarity(tokens []string) (float64, int) {
+	same, wildcards := 0, 0
+	for i, token := range c.tokens {
+		if token == Wildcard {
+			wildcards++
+			continue
+		}
+		if token == tokens[i] {
+			same++
+		}
+	}
+	return float64(same) / float64(len(tokens)), wildcards
+}
+
+func (c *Cluster) merge(tokens []string) {
+	for i := range c.tokens {
+		if c.tokens[i] != tokens[i] {
+			c.tokens[i] = Wildcard
+		}
+	}
+}
+
+type node struct {
+	children map[string]*node
+	clusters []*Cluster
+}
+
+func newNode() *node {
+	return &node{children: make(map[string]*node)}
+}
+
+// Drain clusters the log lines into templates by a fixed depth parse tree, the lines are grouped
+// by their token count and their leading tokens first, and then matched against the templates of the leaf.
+// See "Drain: An Online Log Parsing Approach with Fixed Depth Tree" for details.
+type Drain struct {
+	depth       int
+	similarity  float64
+	maxChildren int
+	maxClusters int
+
+	root     *node
+	clusters []*Cluster
+}
+
+func NewDrain(depth int, similarity float64) *Drain {
+	if depth < 3 {
+		depth = DefaultDepth
+	}
+	if similarity <= 0 || similarity > 1 {
+		similarity = DefaultSimilarity
+	}
+	return &Drain{
+		depth:       depth,
+		similarity:  similarity,
+		maxChildren: DefaultMaxChildren,
+		maxClusters: DefaultMaxClusters,
+		root:        newNode(),
+	}
+}
+
+// Tokenize splits the line by the white spaces, the tokens that look like variables are replaced by Wildcard.
+func Tokenize(line string) []string {
+	tokens := strings.Fields(line)
+	for i, token := range tokens {
+		if isVariable(token) {
+			tokens[i] = Wildcard
+		}
+	}
+	return tokens
+}
+
+// isVariable reports whether the token is a number, an ip, an uuid, a hex or any other token with digits.
+func isVariable(token string) bool {
+	if _, err := strconv.ParseFloat(token, 64); err == nil {
+		return true
+	}
+	for _, c := range token {
+		if unicode.IsDigit(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add clusters the line of the window, it returns nil if the line is empty or the clusters are full.
+func (d *Drain) Add(line string, window Window) *Cluster {
+	tokens := Tokenize(line)
+	if len(tokens) == 0 {
+		return nil
+	}
+	leaf := d.leaf(tokens)
+	cluster := d.match(leaf.clusters, tokens)
+	if cluster == nil {
+		if len(d.clusters) >= d.maxClusters {
+			return nil
+		}
+		cluster = &Cluster{tokens: tokens, Sample: line}
+		leaf.clusters = append(leaf.clusters, cluster)
+		d.clusters = append(d.clusters, cluster)
+	} else {
+		cluster.merge(tokens)
+	}
+	if window == BaselineWindow {
+		cluster.BaselineCount++
+	} else {
+		cluster.Count++
+		if cluster.Count == 1 {
+			// the sample comes from the current window if possible
+			cluster.Sample = line
+		}
+	}
+	return cluster
+}
+
+// leaf walks down the tree by the token count and the leading tokens, the missing nodes are created.
+func (d *Drain) leaf(tokens []string) *node {
+	n := d.child(d.root, strconv.Itoa(len(tokens)))
+	for i := 0; i < d.depth-2 && i < len(tokens); i++ {
+		key := tokens[i]
+		if _, ok := n.children[key]; !ok && len(n.children) >= d.maxChildren {
+			key = Wildcard
+		}
+		n = d.child(n, key)
+	}
+	return n
+}
+
+func (d *Drain) child(n *node, key string) *node {
+	c, ok := n.children[key]
+	if !ok {
+		c = newNode()
+		n.children[key] = c
+	}
+	return c
+}
+
+// match returns the most similar cluster whose similarity reaches the threshold,
+// the cluster with fewer wildcards wins a tie.
+func (d *Drain) match(clusters []*Cluster, tokens []string) *Cluster {
+	var best *Cluster
+	bestSim, bestWildcards := -1.0, 0
+	for _, c := range clusters {
+		sim, wildcards := c.similarity(tokens)
+		if sim > bestSim || (sim == bestSim && wildcards < bestWildcards) {
+			best, bestSim, bestWildcards = c, sim, wildcards
+		}
+	}
+	if best == nil || bestSim < d.similarity {
+		return nil
+	}
+	return best
+}
+
+// Clusters returns the clusters ordered by the count of the current window.
+func (d *Drain) Clusters() []*Cluster {
+	clusters := make([]*Cluster, len(d.clusters))
+	copy(clusters, d.clusters)
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusters[i].Count > clusters[j].Count
+	})
+	return clusters
+}
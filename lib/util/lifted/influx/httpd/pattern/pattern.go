@@ -0,0 +1,190 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pattern
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+const (
+	DefaultField   = "content"
+	DefaultLimit   = 50
+	MaxLimit       = 1000
+	DefaultMaxLogs = 10000
+	MaxMaxLogs     = 100000
+	MaxQueryLen    = 2048
+
+	// GrowthThreshold is the ratio of the rates of the two windows that makes a pattern growing or shrinking.
+	GrowthThreshold = 1.5
+)
+
+// Status of a pattern compared with the baseline window.
+const (
+	StatusNew       = "new"
+	StatusGrowing   = "growing"
+	StatusStable    = "stable"
+	StatusShrinking = "shrinking"
+	StatusGone      = "gone"
+)
+
+var statusOrder = map[string]int{StatusNew: 0, StatusGrowing: 1, StatusStable: 2, StatusShrinking: 3, StatusGone: 4}
+
+type PatternsRequest struct {
+	From         int64
+	To           int64
+	BaselineFrom int64
+	BaselineTo   int64
+	HasBaseline  bool
+	Query        string
+	Field        string
+	Limit        int
+	MaxLogs      int
+	Similarity   float64
+}
+
+type Pattern struct {
+	Pattern       string  `json:"pattern"`
+	Count         int64   `json:"count"`
+	Sample        string  `json:"sample"`
+	BaselineCount int64   `json:"baseline_count,omitempty"`
+	Growth        float64 `json:"growth,omitempty"`
+	Status        string  `json:"status,omitempty"`
+}
+
+// PatternsResponse is the result of the patterns request. Logs is the number of the matched logs of the window,
+// the logs without the clustered field are counted by it but by none of the patterns.
+type PatternsResponse struct {
+	Logs         int64     `json:"logs"`
+	BaselineLogs int64     `json:"baseline_logs,omitempty"`
+	Patterns     []Pattern `json:"patterns"`
+	TookMs       int64     `json:"took_ms,omitempty"`
+}
+
+func parseTimeRange(r *http.Request, fromKey, toKey string, minFrom, maxTo int64) (int64, int64, error) {
+	from, err := strconv.ParseInt(r.FormValue(fromKey), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s value is illegal", fromKey)
+	}
+	to, err := strconv.ParseInt(r.FormValue(toKey), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s value is illegal", toKey)
+	}
+	if from < minFrom || to > maxTo {
+		return 0, 0, fmt.Errorf("the valid range for %s and %s is [%d, %d]", fromKey, toKey, minFrom, maxTo)
+	}
+	if from >= to {
+		return 0, 0, fmt.Errorf("%s value must be lower than %s value", fromKey, toKey)
+	}
+	return from * int64(1e6), to * int64(1e6), nil
+}
+
+// GetPatternsRequest parses the patterns request, the time ranges are in milliseconds.
+// The patterns of [from, to) are compared with the ones of [baseline_from, baseline_to) if the baseline is given.
+func GetPatternsRequest(r *http.Request, minFrom, maxTo int64) (*PatternsRequest, error) {
+	var err error
+	req := &PatternsRequest{Field: DefaultField, Limit: DefaultLimit, MaxLogs: DefaultMaxLogs, Similarity: DefaultSimilarity}
+	req.From, req.To, err = parseTimeRange(r, "from", "to", minFrom, maxTo)
+	if err != nil {
+		return nil, err
+	}
+	if r.FormValue("baseline_from") != "" || r.FormValue("baseline_to") != "" {
+		req.BaselineFrom, req.BaselineTo, err = parseTimeRange(r, "baseline_from", "baseline_to", minFrom, maxTo)
+		if err != nil {
+			return nil, err
+		}
+		req.HasBaseline = true
+	}
+
+	if len(r.FormValue("query")) > MaxQueryLen {
+		return nil, fmt.Errorf("query is bigger than %d", MaxQueryLen)
+	}
+	req.Query = r.FormValue("query")
+	if field := r.FormValue("field"); field != "" {
+		req.Field = field
+	}
+	if limit := r.FormValue("limit"); limit != "" {
+		req.Limit, err = strconv.Atoi(limit)
+		if err != nil || req.Limit <= 0 || req.Limit > MaxLimit {
+			return nil, fmt.Errorf("the valid range for limit is [1, %d]", MaxLimit)
+		}
+	}
+	if maxLogs := r.FormValue("max_logs"); maxLogs != "" {
+		req.MaxLogs, err = strconv.Atoi(maxLogs)
+		if err != nil || req.MaxLogs <= 0 || req.MaxLogs > MaxMaxLogs {
+			return nil, fmt.Errorf("the valid range for max_logs is [1, %d]", MaxMaxLogs)
+		}
+	}
+	if similarity := r.FormValue("similarity"); similarity != "" {
+		req.Similarity, err = strconv.ParseFloat(similarity, 64)
+		if err != nil || req.Similarity <= 0 || req.Similarity > 1 {
+			return nil, fmt.Errorf("the valid range for similarity is (0, 1]")
+		}
+	}
+	return req, nil
+}
+
+// BuildPatterns converts the clusters into at most limit patterns. Without the baseline, the patterns are ordered by count.
+// With the baseline, the rates of the two windows are compared, the new and growing patterns come first.
+func BuildPatterns(clusters []*Cluster, req *PatternsRequest) []Pattern {
+	patterns := make([]Pattern, 0, len(clusters))
+	for _, c := range clusters {
+		p := Pattern{Pattern: c.Template(), Count: c.Count, Sample: c.Sample}
+		if req.HasBaseline {
+			p.BaselineCount = c.BaselineCount
+			p.Growth, p.Status = compare(c.Count, req.To-req.From, c.BaselineCount, req.BaselineTo-req.BaselineFrom)
+		} else if c.Count == 0 {
+			continue
+		}
+		patterns = append(patterns, p)
+	}
+
+	sort.SliceStable(patterns, func(i, j int) bool {
+		if req.HasBaseline && patterns[i].Status != patterns[j].Status {
+			return statusOrder[patterns[i].Status] < statusOrder[patterns[j].Status]
+		}
+		if req.HasBaseline && patterns[i].Growth != patterns[j].Growth {
+			return patterns[i].Growth > patterns[j].Growth
+		}
+		return patterns[i].Count > patterns[j].Count
+	})
+	if len(patterns) > req.Limit {
+		patterns = patterns[:req.Limit]
+	}
+	return patterns
+}
+
+// compare returns the ratio of the rates of the current window and the baseline window, and the status of the pattern.
+func compare(count, duration, baselineCount, baselineDuration int64) (float64, string) {
+	if baselineCount == 0 {
+		return 0, StatusNew
+	}
+	if count == 0 {
+		return 0, StatusGone
+	}
+	growth := float64(count) * float64(baselineDuration) / (float64(baselineCount) * float64(duration))
+	switch {
+	case growth >= GrowthThreshold:
+		return growth, StatusGrowing
+	case growth <= 1/GrowthThreshold:
+		return growth, StatusShrinking
+	default:
+		return growth, StatusStable
+	}
+}
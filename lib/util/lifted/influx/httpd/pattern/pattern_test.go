@@ -0,0 +1,134 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pattern
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	assert.Equal(t, []string{"user", Wildcard, "login", "from", Wildcard}, Tokenize("user u123 login  from 10.0.0.1"))
+	assert.Equal(t, []string{"took", Wildcard, "ms", Wildcard}, Tokenize("took 3.5 ms 0x1f"))
+	assert.Empty(t, Tokenize("  "))
+}
+
+func TestDrain(t *testing.T) {
+	d := NewDrain(0, 0)
+	lines := []string{
+		"connected to db-1 in 3ms",
+		"connected to db-2 in 5ms",
+		"login failed for alice",
+		"login failed for bob",
+		"login failed for carol",
+		"disk /dev/sda1 is full",
+		"connected to cache in 1ms",
+	}
+	for _, line := range lines {
+		require.NotNil(t, d.Add(line, CurrentWindow))
+	}
+	assert.Nil(t, d.Add(" ", CurrentWindow))
+
+	clusters := d.Clusters()
+	templates := map[string]int64{}
+	for _, c := range clusters {
+		templates[c.Template()] = c.Count
+	}
+	assert.Equal(t, map[string]int64{
+		"connected to <*> in <*>": 3,
+		"login failed for <*>":    3,
+		"disk <*> is full":        1,
+	}, templates)
+	assert.Equal(t, int64(3), clusters[0].Count)
+	assert.Equal(t, "connected to db-1 in 3ms", clusters[0].Sample)
+
+	// the lines of different lengths never share a template
+	d = NewDrain(DefaultDepth, 0.9)
+	d.Add("a b c", CurrentWindow)
+	d.Add("a b c d", CurrentWindow)
+	d.Add("a b x", CurrentWindow)
+	assert.Equal(t, 3, len(d.Clusters()))
+}
+
+func TestBuildPatterns(t *testing.T) {
+	d := NewDrain(DefaultDepth, DefaultSimilarity)
+	for i := 0; i < 2; i++ {
+		d.Add("timeout calling svc-a", BaselineWindow)
+		d.Add("cache miss key k1", BaselineWindow)
+		d.Add("cache miss key k2", BaselineWindow)
+		d.Add("cache miss key k3", BaselineWindow)
+		d.Add("gc paused", BaselineWindow)
+	}
+	for i := 0; i < 3; i++ {
+		d.Add("timeout calling svc-a", CurrentWindow)
+		d.Add("cache miss key k4", CurrentWindow)
+	}
+	d.Add("panic in handler", CurrentWindow)
+
+	req := &PatternsRequest{From: 0, To: 10, BaselineFrom: 10, BaselineTo: 30, HasBaseline: true, Limit: 10}
+	patterns := BuildPatterns(d.Clusters(), req)
+	require.Equal(t, 4, len(patterns))
+	assert.Equal(t, Pattern{Pattern: "panic in handler", Count: 1, Sample: "panic in handler", Status: StatusNew}, patterns[0])
+	// the baseline window is twice as long as the current window
+	assert.Equal(t, Pattern{Pattern: "timeout calling svc-a", Count: 3, Sample: "timeout calling svc-a", BaselineCount: 2, Growth: 3, Status: StatusGrowing}, patterns[1])
+	assert.Equal(t, Pattern{Pattern: "cache miss key <*>", Count: 3, Sample: "cache miss key k4", BaselineCount: 6, Growth: 1, Status: StatusStable}, patterns[2])
+	assert.Equal(t, Pattern{Pattern: "gc paused", Sample: "gc paused", BaselineCount: 2, Status: StatusGone}, patterns[3])
+
+	req = &PatternsRequest{From: 0, To: 10, Limit: 2}
+	patterns = BuildPatterns(d.Clusters(), req)
+	require.Equal(t, 2, len(patterns))
+	assert.Equal(t, int64(3), patterns[0].Count)
+	assert.Equal(t, "", patterns[0].Status)
+}
+
+func TestGetPatternsRequest(t *testing.T) {
+	newRequest := func(values url.Values) *http.Request {
+		return &http.Request{Form: values}
+	}
+	req, err := GetPatternsRequest(newRequest(url.Values{"from": {"1"}, "to": {"2"}}), 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, &PatternsRequest{From: 1e6, To: 2e6, Field: DefaultField, Limit: DefaultLimit, MaxLogs: DefaultMaxLogs, Similarity: DefaultSimilarity}, req)
+
+	req, err = GetPatternsRequest(newRequest(url.Values{"from": {"10"}, "to": {"20"}, "baseline_from": {"0"}, "baseline_to": {"10"},
+		"query": {"error"}, "field": {"msg"}, "limit": {"5"}, "max_logs": {"100"}, "similarity": {"0.8"}}), 0, 100)
+	require.NoError(t, err)
+	assert.True(t, req.HasBaseline)
+	assert.Equal(t, int64(0), req.BaselineFrom)
+	assert.Equal(t, int64(10e6), req.BaselineTo)
+	assert.Equal(t, "error", req.Query)
+	assert.Equal(t, "msg", req.Field)
+	assert.Equal(t, 5, req.Limit)
+	assert.Equal(t, 100, req.MaxLogs)
+	assert.Equal(t, 0.8, req.Similarity)
+
+	for _, values := range []url.Values{
+		{"from": {"1"}},
+		{"from": {"2"}, "to": {"1"}},
+		{"from": {"1"}, "to": {"200"}},
+		{"from": {"1"}, "to": {"2"}, "baseline_from": {"1"}},
+		{"from": {"1"}, "to": {"2"}, "limit": {"0"}},
+		{"from": {"1"}, "to": {"2"}, "max_logs": {"x"}},
+		{"from": {"1"}, "to": {"2"}, "similarity": {"2"}},
+	} {
+		_, err = GetPatternsRequest(newRequest(values), 0, 100)
+		assert.Error(t, err, values.Encode())
+	}
+}
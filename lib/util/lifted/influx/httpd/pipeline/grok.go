@@ -0,0 +1,177 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxGrokDepth limits the nesting of the grok patterns, it also breaks the recursive definitions.
+const maxGrokDepth = 16
+
+// grokPatterns is the built-in grok pattern library, a subset of the logstash patterns rewritten for RE2.
+var grokPatterns = map[string]string{
+	"USERNAME":          `[a-zA-Z0-9._-]+`,
+	"USER":              `%{USERNAME}`,
+	"INT":               `(?:[+-]?(?:[0-9]+))`,
+	"BASE10NUM":         `(?:[+-]?(?:[0-9]+(?:\.[0-9]+)?|\.[0-9]+))`,
+	"NUMBER":            `(?:%{BASE10NUM})`,
+	"POSINT":            `\b(?:[1-9][0-9]*)\b`,
+	"NONNEGINT":         `\b(?:[0-9]+)\b`,
+	"WORD":              `\b\w+\b`,
+	"NOTSPACE":          `\S+`,
+	"SPACE":             `\s*`,
+	"DATA":              `.*?`,
+	"GREEDYDATA":        `.*`,
+	"QS":                `%{QUOTEDSTRING}`,
+	"QUOTEDSTRING":      `(?:"(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*')`,
+	"UUID":              `[A-Fa-f0-9]{8}-(?:[A-Fa-f0-9]{4}-){3}[A-Fa-f0-9]{12}`,
+	"IPV4":              `(?:(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)`,
+	"IPV6":              `(?:[0-9A-Fa-f]{0,4}:){2,7}[0-9A-Fa-f]{0,4}`,
+	"IP":                `(?:%{IPV6}|%{IPV4})`,
+	"HOSTNAME":          `\b(?:[0-9A-Za-z][0-9A-Za-z-]{0,62})(?:\.(?:[0-9A-Za-z][0-9A-Za-z-]{0,62}))*\b`,
+	"IPORHOST":          `(?:%{IP}|%{HOSTNAME})`,
+	"HOSTPORT":          `%{IPORHOST}:%{POSINT}`,
+	"URIPATH":           `(?:/[A-Za-z0-9$.+!*'(){},~:;=@#%&_\-]*)+`,
+	"URIPARAM":          `\?[A-Za-z0-9$.+!*'|(){},~@#%&/=:;_?\-\[\]<>]*`,
+	"URIPATHPARAM":      `%{URIPATH}(?:%{URIPARAM})?`,
+	"URI":               `[A-Za-z][A-Za-z0-9+\-.]*://(?:%{USER}(?::[^@]*)?@)?(?:%{IPORHOST})?(?::%{POSINT})?(?:%{URIPATHPARAM})?`,
+	"MONTH":             `\b(?:[Jj]an(?:uary)?|[Ff]eb(?:ruary)?|[Mm]ar(?:ch)?|[Aa]pr(?:il)?|[Mm]ay|[Jj]un(?:e)?|[Jj]ul(?:y)?|[Aa]ug(?:ust)?|[Ss]ep(?:tember)?|[Oo]ct(?:ober)?|[Nn]ov(?:ember)?|[Dd]ec(?:ember)?)\b`,
+	"MONTHNUM":          `(?:0?[1-9]|1[0-2])`,
+	"MONTHDAY":          `(?:(?:0[1-9])|(?:[12][0-9])|(?:3[01])|[1-9])`,
+	"DAY":               `(?:Mon(?:day)?|Tue(?:sday)?|Wed(?:nesday)?|Thu(?:rsday)?|Fri(?:day)?|Sat(?:urday)?|Sun(?:day)?)`,
+	"YEAR":              `(?:\d\d){1,2}`,
+	"HOUR":              `(?:2[0123]|[01]?[0-9])`,
+	"MINUTE":            `(?:[0-5][0-9])`,
+	"SECOND":            `(?:(?:[0-5]?[0-9]|60)(?:[:.,][0-9]+)?)`,
+	"TIME":              `%{HOUR}:%{MINUTE}(?::%{SECOND})`,
+	"ISO8601_TIMEZONE":  `(?:Z|[+-]%{HOUR}(?::?%{MINUTE}))`,
+	"TIMESTAMP_ISO8601": `%{YEAR}-%{MONTHNUM}-%{MONTHDAY}[T ]%{HOUR}:?%{MINUTE}(?::?%{SECOND})?%{ISO8601_TIMEZONE}?`,
+	"HTTPDATE":          `%{MONTHDAY}/%{MONTH}/%{YEAR}:%{TIME} %{INT}`,
+	"SYSLOGTIMESTAMP":   `%{MONTH} +%{MONTHDAY} %{TIME}`,
+	"LOGLEVEL":          `(?:[Tt]race|TRACE|[Dd]ebug|DEBUG|[Nn]otice|NOTICE|[Ii]nfo|INFO|[Ww]arn(?:ing)?|WARN(?:ING)?|[Ee]rr(?:or)?|ERR(?:OR)?|[Cc]rit(?:ical)?|CRIT(?:ICAL)?|[Ff]atal|FATAL|[Ss]evere|SEVERE|[Ee]merg(?:ency)?|EMERG(?:ENCY)?)`,
+	"COMMONAPACHELOG":   `%{IPORHOST:clientip} %{USER:ident} %{USER:auth} \[%{HTTPDATE:timestamp}\] "(?:%{WORD:verb} %{NOTSPACE:request}(?: HTTP/%{NUMBER:httpversion})?|%{DATA:rawrequest})" %{NUMBER:response:int} (?:%{NUMBER:bytes:int}|-)`,
+	"COMBINEDAPACHELOG": `%{COMMONAPACHELOG} %{QS:referrer} %{QS:agent}`,
+}
+
+// grokRef matches %{SYNTAX}, %{SYNTAX:SEMANTIC} and %{SYNTAX:SEMANTIC:TYPE}, the type is int or float.
+var grokRef = regexp.MustCompile(`%\{(\w+)(?::([\w.@-]+))?(?::(int|float))?\}`)
+
+// grokField is a named capture of a grok expression.
+type grokField struct {
+	name string
+	typ  string
+}
+
+// grokExpr is a compiled grok expression, the named captures get generated group names
+// because the field names may contain characters the regexp does not allow.
+type grokExpr struct {
+	re     *regexp.Regexp
+	fields map[string]grokField
+}
+
+type grokCompiler struct {
+	patterns map[string]string
+	fields   map[string]grokField
+}
+
+// compileGrok expands the grok references of expr by the built-in library and the custom definitions.
+func compileGrok(expr string, definitions map[string]string) (*grokExpr, error) {
+	c := &grokCompiler{patterns: grokPatterns, fields: make(map[string]grokField)}
+	if len(definitions) > 0 {
+		c.patterns = make(map[string]string, len(grokPatterns)+len(definitions))
+		for name, p := range grokPatterns {
+			c.patterns[name] = p
+		}
+		for name, p := range definitions {
+			c.patterns[name] = p
+		}
+	}
+	s, err := c.expand(expr, 0)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid grok pattern %q: %v", expr, err)
+	}
+	return &grokExpr{re: re, fields: c.fields}, nil
+}
+
+func (c *grokCompiler) expand(expr string, depth int) (string, error) {
+	if depth > maxGrokDepth {
+		return "", fmt.Errorf("grok pattern %q is nested too deep", expr)
+	}
+	var err error
+	s := grokRef.ReplaceAllStringFunc(expr, func(ref string) string {
+		if err != nil {
+			return ""
+		}
+		m := grokRef.FindStringSubmatch(ref)
+		p, ok := c.patterns[m[1]]
+		if !ok {
+			err = fmt.Errorf("unknown grok pattern %s", m[1])
+			return ""
+		}
+		var sub string
+		sub, err = c.expand(p, depth+1)
+		if err != nil {
+			return ""
+		}
+		if m[2] == "" {
+			return "(?:" + sub + ")"
+		}
+		group := "g" + strconv.Itoa(len(c.fields))
+		c.fields[group] = grokField{name: m[2], typ: m[3]}
+		return "(?P<" + group + ">" + sub + ")"
+	})
+	return s, err
+}
+
+// match returns the non-empty named captures of the value, the typed captures are converted to numbers.
+func (g *grokExpr) match(value string) (map[string]interface{}, bool) {
+	m := g.re.FindStringSubmatch(value)
+	if m == nil {
+		return nil, false
+	}
+	fields := make(map[string]interface{}, len(g.fields))
+	for i, group := range g.re.SubexpNames() {
+		f, ok := g.fields[group]
+		if !ok || m[i] == "" {
+			continue
+		}
+		fields[f.name] = convertValue(m[i], f.typ)
+	}
+	return fields, true
+}
+
+func convertValue(s, typ string) interface{} {
+	switch typ {
+	case "int":
+		if v, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64); err == nil {
+			return float64(v)
+		}
+	case "float":
+		if v, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+			return v
+		}
+	}
+	return s
+}
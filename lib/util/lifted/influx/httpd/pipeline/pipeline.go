@@ -0,0 +1,224 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+const (
+	MaxProcessors = 64
+	// maxCachedPipelines bounds the compiled pipelines kept by Get, the cache is reset once it is full.
+	maxCachedPipelines = 1024
+)
+
+// Processor types
+const (
+	Grok      = "grok"
+	Regex     = "regex"
+	JSON      = "json"
+	KV        = "kv"
+	Timestamp = "timestamp"
+	Rename    = "rename"
+	Remove    = "remove"
+	Drop      = "drop"
+)
+
+// Config is the ingest pipeline of a logstream, the processors run in order before the records are built, e.g.
+//
+//	{"processors": [
+//	  {"type": "grok", "field": "content", "patterns": ["%{IP:client} %{WORD:method} %{URIPATHPARAM:request}"]},
+//	  {"type": "kv", "field": "request", "field_split": "&", "value_split": "=", "prefix": "arg."},
+//	  {"type": "timestamp", "field": "ts", "formats": ["2006-01-02T15:04:05Z07:00", "unix_ms"]},
+//	  {"type": "rename", "field": "client", "target_field": "client_ip"},
+//	  {"type": "remove", "fields": ["ts"]},
+//	  {"type": "drop", "if": "level = 'debug'"}
+//	]}
+type Config struct {
+	Processors []ProcessorConfig `json:"processors"`
+}
+
+type ProcessorConfig struct {
+	Type string `json:"type"`
+	// Field is the source field of grok, regex, json, kv, timestamp and rename
+	Field string `json:"field,omitempty"`
+	// TargetField is the new name of rename, or the prefix of the fields parsed by json
+	TargetField string `json:"target_field,omitempty"`
+	// Fields are the fields dropped by remove
+	Fields []string `json:"fields,omitempty"`
+
+	// Patterns are tried in order by grok, the first match wins
+	Patterns           []string          `json:"patterns,omitempty"`
+	PatternDefinitions map[string]string `json:"pattern_definitions,omitempty"`
+	// Pattern is the regular expression of regex, the named groups become fields
+	Pattern string `json:"pattern,omitempty"`
+
+	FieldSplit string `json:"field_split,omitempty"`
+	ValueSplit string `json:"value_split,omitempty"`
+	Prefix     string `json:"prefix,omitempty"`
+
+	// Formats are the Go time layouts or unix, unix_ms, unix_us, unix_ns tried in order by timestamp
+	Formats  []string `json:"formats,omitempty"`
+	Timezone string   `json:"timezone,omitempty"`
+
+	// If is an InfluxQL condition, the processor runs only if the log matches it
+	If            string `json:"if,omitempty"`
+	IgnoreMissing bool   `json:"ignore_missing,omitempty"`
+	IgnoreFailure bool   `json:"ignore_failure,omitempty"`
+}
+
+// processor changes the fields of a log in place, a timestamp processor returns the time of the log in nanoseconds.
+type processor interface {
+	process(fields map[string]interface{}) (int64, error)
+}
+
+type step struct {
+	processor
+	typ           string
+	cond          influxql.Expr
+	ignoreFailure bool
+}
+
+// Pipeline is a compiled ingest pipeline, it is safe for concurrent use.
+type Pipeline struct {
+	steps []step
+}
+
+// Compile parses and validates the pipeline configured by the logstream options.
+func Compile(raw []byte) (*Pipeline, error) {
+	conf := &Config{}
+	if err := json.Unmarshal(raw, conf); err != nil {
+		return nil, fmt.Errorf("invalid pipeline: %v", err)
+	}
+	if len(conf.Processors) > MaxProcessors {
+		return nil, fmt.Errorf("the number of the pipeline processors exceeds %d", MaxProcessors)
+	}
+	p := &Pipeline{steps: make([]step, 0, len(conf.Processors))}
+	for i := range conf.Processors {
+		s, err := newStep(&conf.Processors[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid pipeline processor %d: %v", i, err)
+		}
+		p.steps = append(p.steps, s)
+	}
+	return p, nil
+}
+
+func newStep(c *ProcessorConfig) (step, error) {
+	s := step{typ: c.Type, ignoreFailure: c.IgnoreFailure}
+	if c.If != "" {
+		cond, err := influxql.ParseExpr(c.If)
+		if err != nil {
+			return s, fmt.Errorf("invalid condition %q: %v", c.If, err)
+		}
+		s.cond = cond
+	}
+
+	var err error
+	switch c.Type {
+	case Grok:
+		s.processor, err = newGrokProcessor(c)
+	case Regex:
+		s.processor, err = newRegexProcessor(c)
+	case JSON:
+		s.processor, err = newJsonProcessor(c)
+	case KV:
+		s.processor, err = newKvProcessor(c)
+	case Timestamp:
+		s.processor, err = newTimestampProcessor(c)
+	case Rename:
+		s.processor, err = newRenameProcessor(c)
+	case Remove:
+		s.processor, err = newRemoveProcessor(c)
+	case Drop:
+		if s.cond == nil {
+			err = fmt.Errorf("drop needs an if condition")
+		}
+	default:
+		err = fmt.Errorf("unknown processor type %q", c.Type)
+	}
+	return s, err
+}
+
+// Process runs the pipeline on a copy of the fields, the input is never changed so that it can be kept by the fail logs.
+// It returns nil fields if the log is dropped, and the time parsed by the last timestamp processor, 0 means no time was parsed.
+func (p *Pipeline) Process(fields map[string]interface{}) (map[string]interface{}, int64, error) {
+	out := make(map[string]interface{}, len(fields))
+	for k, v := range fields {
+		out[k] = v
+	}
+
+	var t int64
+	for i := range p.steps {
+		s := &p.steps[i]
+		if s.cond != nil {
+			eval := influxql.ValuerEval{Valuer: influxql.MapValuer(out)}
+			if !eval.EvalBool(s.cond) {
+				continue
+			}
+		}
+		if s.typ == Drop {
+			return nil, 0, nil
+		}
+		pt, err := s.process(out)
+		if err != nil {
+			if s.ignoreFailure {
+				continue
+			}
+			return nil, 0, fmt.Errorf("%s processor: %v", s.typ, err)
+		}
+		if pt != 0 {
+			t = pt
+		}
+	}
+	return out, t, nil
+}
+
+var cache = struct {
+	sync.RWMutex
+	pipelines map[string]*Pipeline
+}{pipelines: make(map[string]*Pipeline)}
+
+// Get returns the compiled pipeline of the raw config, the pipelines are cached by their config.
+func Get(raw []byte) (*Pipeline, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	key := string(raw)
+	cache.RLock()
+	p, ok := cache.pipelines[key]
+	cache.RUnlock()
+	if ok {
+		return p, nil
+	}
+
+	p, err := Compile(raw)
+	if err != nil {
+		return nil, err
+	}
+	cache.Lock()
+	if len(cache.pipelines) >= maxCachedPipelines {
+		cache.pipelines = make(map[string]*Pipeline)
+	}
+	cache.pipelines[key] = p
+	cache.Unlock()
+	return p, nil
+}
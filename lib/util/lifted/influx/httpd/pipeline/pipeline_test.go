@@ -0,0 +1,160 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func process(t *testing.T, conf string, fields map[string]interface{}) (map[string]interface{}, int64, error) {
+	p, err := Compile([]byte(conf))
+	require.NoError(t, err)
+	return p.Process(fields)
+}
+
+func TestGrok(t *testing.T) {
+	conf := `{"processors": [{"type": "grok", "field": "content", "patterns": ["%{COMMONAPACHELOG}"]}]}`
+	line := `127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /apache_pb.gif HTTP/1.0" 200 2326`
+	in := map[string]interface{}{"content": line}
+	out, ts, err := process(t, conf, in)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), ts)
+	assert.Equal(t, "127.0.0.1", out["clientip"])
+	assert.Equal(t, "frank", out["auth"])
+	assert.Equal(t, "10/Oct/2000:13:55:36 -0700", out["timestamp"])
+	assert.Equal(t, "GET", out["verb"])
+	assert.Equal(t, "/apache_pb.gif", out["request"])
+	assert.Equal(t, float64(200), out["response"])
+	assert.Equal(t, float64(2326), out["bytes"])
+	assert.Equal(t, line, out["content"])
+	assert.Len(t, in, 1)
+
+	conf = `{"processors": [{"type": "grok", "field": "content", "patterns": ["^%{NUMBER:a}$", "^%{LEVEL:level} %{GREEDYDATA:msg.text}$"],
+		"pattern_definitions": {"LEVEL": "%{LOGLEVEL}|UNKNOWN"}}]}`
+	out, _, err = process(t, conf, map[string]interface{}{"content": "ERROR disk is full"})
+	require.NoError(t, err)
+	assert.Equal(t, "ERROR", out["level"])
+	assert.Equal(t, "disk is full", out["msg.text"])
+	assert.Nil(t, out["a"])
+
+	_, _, err = process(t, conf, map[string]interface{}{"content": ""})
+	assert.Error(t, err)
+}
+
+func TestRegexJsonKv(t *testing.T) {
+	conf := `{"processors": [
+		{"type": "regex", "field": "content", "pattern": "^(?P<level>\\w+) (?P<body>.*)$"},
+		{"type": "json", "field": "body", "target_field": "body"},
+		{"type": "kv", "field": "body.args", "field_split": "&", "prefix": "arg."},
+		{"type": "remove", "fields": ["body", "body.args"]}
+	]}`
+	out, _, err := process(t, conf, map[string]interface{}{
+		"content": `INFO {"user": {"id": 7, "name": "bob"}, "tags": ["a", "b"], "args": "q=\"x y\"&page=2&bad"}`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"content":        out["content"],
+		"level":          "INFO",
+		"body.user.id":   float64(7),
+		"body.user.name": "bob",
+		"body.tags":      `["a","b"]`,
+		"arg.q":          "x y",
+		"arg.page":       "2",
+	}, out)
+
+	_, _, err = process(t, conf, map[string]interface{}{"content": `INFO {bad json`})
+	assert.Error(t, err)
+}
+
+func TestTimestampRenameDrop(t *testing.T) {
+	conf := `{"processors": [
+		{"type": "drop", "if": "level = 'debug' OR status >= 500"},
+		{"type": "timestamp", "field": "ts", "formats": ["2006-01-02 15:04:05", "unix_ms"], "timezone": "Asia/Shanghai"},
+		{"type": "rename", "field": "host", "target_field": "hostname", "if": "host =~ /^web/"},
+		{"type": "rename", "field": "missing", "target_field": "other", "ignore_missing": true}
+	]}`
+	out, ts, err := process(t, conf, map[string]interface{}{"level": "info", "status": float64(200), "ts": "2024-01-02 08:00:00", "host": "web-1"})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC).UnixNano(), ts)
+	assert.Equal(t, "web-1", out["hostname"])
+	assert.NotContains(t, out, "host")
+
+	out, ts, err = process(t, conf, map[string]interface{}{"ts": float64(1700000000123), "host": "db-1"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1700000000123)*1e6, ts)
+	assert.Equal(t, "db-1", out["host"])
+
+	for _, fields := range []map[string]interface{}{{"level": "debug"}, {"status": float64(503)}} {
+		out, _, err = process(t, conf, fields)
+		require.NoError(t, err)
+		assert.Nil(t, out)
+	}
+
+	_, _, err = process(t, conf, map[string]interface{}{"ts": "yesterday"})
+	assert.Error(t, err)
+	_, _, err = process(t, conf, map[string]interface{}{"level": "info"})
+	assert.Error(t, err)
+
+	conf = `{"processors": [{"type": "timestamp", "field": "ts", "formats": ["unix_ns"], "ignore_failure": true}]}`
+	_, ts, err = process(t, conf, map[string]interface{}{"ts": "1700000000123456789"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1700000000123456789), ts)
+	_, ts, err = process(t, conf, map[string]interface{}{"ts": "bad"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), ts)
+}
+
+func TestCompileError(t *testing.T) {
+	for _, conf := range []string{
+		`{"processors": [{"type": "unknown"}]}`,
+		`{"processors": [{"type": "grok", "field": "content"}]}`,
+		`{"processors": [{"type": "grok", "field": "content", "patterns": ["%{NOPE:x}"]}]}`,
+		`{"processors": [{"type": "grok", "field": "content", "patterns": ["%{A}"], "pattern_definitions": {"A": "%{A}"}}]}`,
+		`{"processors": [{"type": "regex", "field": "content", "pattern": "(\\w+)"}]}`,
+		`{"processors": [{"type": "regex", "pattern": "(?P<a>\\w+)"}]}`,
+		`{"processors": [{"type": "kv", "field": "content", "field_split": "=", "value_split": "="}]}`,
+		`{"processors": [{"type": "timestamp", "field": "ts"}]}`,
+		`{"processors": [{"type": "timestamp", "field": "ts", "formats": ["unix"], "timezone": "Nowhere/City"}]}`,
+		`{"processors": [{"type": "rename", "field": "a"}]}`,
+		`{"processors": [{"type": "remove"}]}`,
+		`{"processors": [{"type": "drop"}]}`,
+		`{"processors": [{"type": "drop", "if": "a = "}]}`,
+		`{"processors": {}}`,
+	} {
+		_, err := Compile([]byte(conf))
+		assert.Error(t, err, conf)
+	}
+}
+
+func TestGet(t *testing.T) {
+	p, err := Get(nil)
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	conf := []byte(`{"processors": [{"type": "remove", "fields": ["a"]}]}`)
+	p, err = Get(conf)
+	require.NoError(t, err)
+	cached, _ := Get(conf)
+	assert.True(t, p == cached)
+
+	_, err = Get([]byte(`{`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,382 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+)
+
+const (
+	UnixS  = "unix"
+	UnixMs = "unix_ms"
+	UnixUs = "unix_us"
+	UnixNs = "unix_ns"
+
+	DefaultFieldSplit = " "
+	DefaultValueSplit = "="
+)
+
+var errNoMatch = fmt.Errorf("no pattern matched")
+
+// source is the field read by a processor.
+type source struct {
+	field         string
+	ignoreMissing bool
+}
+
+func newSource(c *ProcessorConfig) (source, error) {
+	if c.Field == "" {
+		return source{}, fmt.Errorf("%s needs a field", c.Type)
+	}
+	return source{field: c.Field, ignoreMissing: c.IgnoreMissing}, nil
+}
+
+// get returns the value of the field, ok is false if the field is missing and it is ignored.
+func (s source) get(fields map[string]interface{}) (interface{}, bool, error) {
+	v, ok := fields[s.field]
+	if !ok || v == nil {
+		if s.ignoreMissing {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("field %s is missing", s.field)
+	}
+	return v, true, nil
+}
+
+func (s source) getString(fields map[string]interface{}) (string, bool, error) {
+	v, ok, err := s.get(fields)
+	if !ok {
+		return "", ok, err
+	}
+	str, isStr := v.(string)
+	if !isStr {
+		return "", false, fmt.Errorf("field %s is not a string", s.field)
+	}
+	return str, true, nil
+}
+
+type grokProcessor struct {
+	source
+	exprs []*grokExpr
+}
+
+func newGrokProcessor(c *ProcessorConfig) (processor, error) {
+	src, err := newSource(c)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.Patterns) == 0 {
+		return nil, fmt.Errorf("grok needs patterns")
+	}
+	p := &grokProcessor{source: src}
+	for _, pattern := range c.Patterns {
+		expr, err := compileGrok(pattern, c.PatternDefinitions)
+		if err != nil {
+			return nil, err
+		}
+		p.exprs = append(p.exprs, expr)
+	}
+	return p, nil
+}
+
+func (p *grokProcessor) process(fields map[string]interface{}) (int64, error) {
+	value, ok, err := p.getString(fields)
+	if !ok {
+		return 0, err
+	}
+	for _, expr := range p.exprs {
+		if matched, ok := expr.match(value); ok {
+			for k, v := range matched {
+				fields[k] = v
+			}
+			return 0, nil
+		}
+	}
+	return 0, errNoMatch
+}
+
+type regexProcessor struct {
+	source
+	re *regexp.Regexp
+}
+
+func newRegexProcessor(c *ProcessorConfig) (processor, error) {
+	src, err := newSource(c)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(c.Pattern)
+	if err != nil {
+		return nil, err
+	}
+	named := false
+	for _, name := range re.SubexpNames() {
+		named = named || name != ""
+	}
+	if !named {
+		return nil, fmt.Errorf("regex %q has no named group", c.Pattern)
+	}
+	return &regexProcessor{source: src, re: re}, nil
+}
+
+func (p *regexProcessor) process(fields map[string]interface{}) (int64, error) {
+	value, ok, err := p.getString(fields)
+	if !ok {
+		return 0, err
+	}
+	m := p.re.FindStringSubmatch(value)
+	if m == nil {
+		return 0, errNoMatch
+	}
+	for i, name := range p.re.SubexpNames() {
+		if name != "" && m[i] != "" {
+			fields[name] = m[i]
+		}
+	}
+	return 0, nil
+}
+
+// jsonProcessor parses a JSON object, the nested objects are flattened by joining the keys with dots.
+type jsonProcessor struct {
+	source
+	prefix string
+}
+
+func newJsonProcessor(c *ProcessorConfig) (processor, error) {
+	src, err := newSource(c)
+	if err != nil {
+		return nil, err
+	}
+	p := &jsonProcessor{source: src}
+	if c.TargetField != "" {
+		p.prefix = c.TargetField + "."
+	}
+	return p, nil
+}
+
+func (p *jsonProcessor) process(fields map[string]interface{}) (int64, error) {
+	value, ok, err := p.getString(fields)
+	if !ok {
+		return 0, err
+	}
+	var obj map[string]interface{}
+	if err = sonic.UnmarshalString(value, &obj); err != nil {
+		return 0, err
+	}
+	flatten(fields, p.prefix, obj)
+	return 0, nil
+}
+
+func flatten(dst map[string]interface{}, prefix string, obj map[string]interface{}) {
+	for k, v := range obj {
+		switch val := v.(type) {
+		case map[string]interface{}:
+			flatten(dst, prefix+k+".", val)
+		case []interface{}:
+			s, err := sonic.MarshalString(val)
+			if err != nil {
+				s = fmt.Sprint(val)
+			}
+			dst[prefix+k] = s
+		case nil:
+		default:
+			dst[prefix+k] = val
+		}
+	}
+}
+
+type kvProcessor struct {
+	source
+	fieldSplit string
+	valueSplit string
+	prefix     string
+}
+
+func newKvProcessor(c *ProcessorConfig) (processor, error) {
+	src, err := newSource(c)
+	if err != nil {
+		return nil, err
+	}
+	p := &kvProcessor{source: src, fieldSplit: c.FieldSplit, valueSplit: c.ValueSplit, prefix: c.Prefix}
+	if p.fieldSplit == "" {
+		p.fieldSplit = DefaultFieldSplit
+	}
+	if p.valueSplit == "" {
+		p.valueSplit = DefaultValueSplit
+	}
+	if p.fieldSplit == p.valueSplit {
+		return nil, fmt.Errorf("field_split and value_split must be different")
+	}
+	return p, nil
+}
+
+// process parses the key value pairs, the pairs without the value split are skipped and the quotes of the values are trimmed.
+func (p *kvProcessor) process(fields map[string]interface{}) (int64, error) {
+	value, ok, err := p.getString(fields)
+	if !ok {
+		return 0, err
+	}
+	for _, pair := range strings.Split(value, p.fieldSplit) {
+		k, v, found := strings.Cut(pair, p.valueSplit)
+		k = strings.TrimSpace(k)
+		if !found || k == "" {
+			continue
+		}
+		v = strings.TrimSpace(v)
+		if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+			v = v[1 : len(v)-1]
+		}
+		fields[p.prefix+k] = v
+	}
+	return 0, nil
+}
+
+type timestampProcessor struct {
+	source
+	formats  []string
+	location *time.Location
+}
+
+func newTimestampProcessor(c *ProcessorConfig) (processor, error) {
+	src, err := newSource(c)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.Formats) == 0 {
+		return nil, fmt.Errorf("timestamp needs formats")
+	}
+	p := &timestampProcessor{source: src, formats: c.Formats, location: time.UTC}
+	if c.Timezone != "" {
+		p.location, err = time.LoadLocation(c.Timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func (p *timestampProcessor) process(fields map[string]interface{}) (int64, error) {
+	value, ok, err := p.get(fields)
+	if !ok {
+		return 0, err
+	}
+	for _, format := range p.formats {
+		if t, ok := p.parse(value, format); ok && t != 0 {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("field %s does not match the time formats", p.field)
+}
+
+func (p *timestampProcessor) parse(value interface{}, format string) (int64, bool) {
+	var multiplier int64
+	switch format {
+	case UnixS:
+		multiplier = 1e9
+	case UnixMs:
+		multiplier = 1e6
+	case UnixUs:
+		multiplier = 1e3
+	case UnixNs:
+		multiplier = 1
+	default:
+		s, ok := value.(string)
+		if !ok {
+			return 0, false
+		}
+		t, err := time.ParseInLocation(format, s, p.location)
+		if err != nil {
+			return 0, false
+		}
+		return t.UnixNano(), true
+	}
+
+	var f float64
+	switch v := value.(type) {
+	case float64:
+		f = v
+	case string:
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return i * multiplier, true
+		}
+		var err error
+		if f, err = strconv.ParseFloat(v, 64); err != nil {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	if f == math.Trunc(f) {
+		// keep the integral timestamps exact
+		return int64(f) * multiplier, true
+	}
+	return int64(f * float64(multiplier)), true
+}
+
+type renameProcessor struct {
+	source
+	target string
+}
+
+func newRenameProcessor(c *ProcessorConfig) (processor, error) {
+	src, err := newSource(c)
+	if err != nil {
+		return nil, err
+	}
+	if c.TargetField == "" {
+		return nil, fmt.Errorf("rename needs a target_field")
+	}
+	return &renameProcessor{source: src, target: c.TargetField}, nil
+}
+
+func (p *renameProcessor) process(fields map[string]interface{}) (int64, error) {
+	value, ok, err := p.get(fields)
+	if !ok {
+		return 0, err
+	}
+	delete(fields, p.field)
+	fields[p.target] = value
+	return 0, nil
+}
+
+type removeProcessor struct {
+	fields []string
+}
+
+func newRemoveProcessor(c *ProcessorConfig) (processor, error) {
+	if len(c.Fields) == 0 {
+		return nil, fmt.Errorf("remove needs fields")
+	}
+	return &removeProcessor{fields: c.Fields}, nil
+}
+
+func (p *removeProcessor) process(fields map[string]interface{}) (int64, error) {
+	for _, field := range p.fields {
+		delete(fields, field)
+	}
+	return 0, nil
+}
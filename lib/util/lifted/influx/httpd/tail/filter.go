@@ -0,0 +1,316 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tail
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/binaryfilterfunc"
+	"github.com/openGemini/openGemini/lib/tokenizer"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/logparser"
+)
+
+// matcher reports whether a log matches a part of the condition.
+type matcher func(fields map[string]interface{}) bool
+
+// Filter is the row level counterpart of the logparser condition of a query,
+// it is evaluated on the logs as they are written instead of the stored segments.
+type Filter struct {
+	match matcher
+}
+
+// NewFilter compiles the condition, a nil condition matches every log.
+// The full text option of the logstream decides the split table and the analyzers of MATCHPHRASE.
+func NewFilter(cond influxql.Expr, option *influxql.IndexOption) (*Filter, error) {
+	if cond == nil {
+		return &Filter{match: func(map[string]interface{}) bool { return true }}, nil
+	}
+	if option == nil {
+		option = tokenizer.GetFullTextOption(nil)
+	}
+	c := &compiler{option: option, splitTable: option.TokensTable}
+	if c.splitTable == nil {
+		c.splitTable = tokenizer.CONTENT_SPLIT_TABLE
+	}
+	m, err := c.compile(cond)
+	if err != nil {
+		return nil, err
+	}
+	return &Filter{match: m}, nil
+}
+
+func (f *Filter) Match(fields map[string]interface{}) bool {
+	return f.match(fields)
+}
+
+type compiler struct {
+	option     *influxql.IndexOption
+	splitTable []byte
+}
+
+func (c *compiler) compile(expr influxql.Expr) (matcher, error) {
+	switch e := expr.(type) {
+	case *influxql.ParenExpr:
+		return c.compile(e.Expr)
+	case *influxql.BinaryExpr:
+		switch e.Op {
+		case influxql.AND, influxql.OR:
+			lhs, err := c.compile(e.LHS)
+			if err != nil {
+				return nil, err
+			}
+			rhs, err := c.compile(e.RHS)
+			if err != nil {
+				return nil, err
+			}
+			if e.Op == influxql.AND {
+				return func(fields map[string]interface{}) bool { return lhs(fields) && rhs(fields) }, nil
+			}
+			return func(fields map[string]interface{}) bool { return lhs(fields) || rhs(fields) }, nil
+		}
+		ref, ok := e.LHS.(*influxql.VarRef)
+		if !ok {
+			return nil, fmt.Errorf("unsupported tail condition %s", e.String())
+		}
+		return c.compileField(ref.Val, e)
+	default:
+		return nil, fmt.Errorf("unsupported tail condition %s", expr.String())
+	}
+}
+
+// compileField compiles the comparison of a field, the full text field matches if any string field matches.
+func (c *compiler) compileField(field string, e *influxql.BinaryExpr) (matcher, error) {
+	var valueMatch func(v interface{}) bool
+	switch e.Op {
+	case influxql.MATCHPHRASE:
+		lit, ok := e.RHS.(*influxql.StringLiteral)
+		if !ok {
+			return nil, fmt.Errorf("unsupported tail condition %s", e.String())
+		}
+		phrase := c.phraseMatcher(field, lit.Val)
+		valueMatch = func(v interface{}) bool {
+			s, ok := toString(v)
+			return ok && phrase(util.Str2bytes(s))
+		}
+	case influxql.LIKE:
+		lit, ok := e.RHS.(*influxql.StringLiteral)
+		if !ok {
+			return nil, fmt.Errorf("unsupported tail condition %s", e.String())
+		}
+		re, err := binaryfilterfunc.LikePatternToRegexp(lit.Val)
+		if err != nil {
+			return nil, err
+		}
+		valueMatch = regexMatcher(re, true)
+	case influxql.EQREGEX, influxql.NEQREGEX:
+		lit, ok := e.RHS.(*influxql.RegexLiteral)
+		if !ok {
+			return nil, fmt.Errorf("unsupported tail condition %s", e.String())
+		}
+		valueMatch = regexMatcher(lit.Val, e.Op == influxql.EQREGEX)
+	case influxql.EQ, influxql.NEQ, influxql.LT, influxql.LTE, influxql.GT, influxql.GTE:
+		lit, err := literal(e.RHS)
+		if err != nil {
+			return nil, err
+		}
+		op := e.Op
+		valueMatch = func(v interface{}) bool { return compare(v, lit, op) }
+	default:
+		return nil, fmt.Errorf("unsupported tail condition %s", e.String())
+	}
+
+	if field == logparser.DefaultFieldForFullText {
+		return func(fields map[string]interface{}) bool {
+			for _, v := range fields {
+				if _, ok := v.(string); ok && valueMatch(v) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+	return func(fields map[string]interface{}) bool {
+		v, ok := fields[field]
+		return ok && v != nil && valueMatch(v)
+	}, nil
+}
+
+// phraseMatcher matches the phrase the same way as the MATCHPHRASE of a query, a trailing * matches the token prefix.
+func (c *compiler) phraseMatcher(field, phrase string) func(content []byte) bool {
+	if field != logparser.DefaultFieldForFullText {
+		if analyzer := tokenizer.GetFieldAnalyzer(c.option, field); analyzer != nil {
+			analyzed := binaryfilterfunc.NewAnalyzedPhrase(analyzer, phrase)
+			return analyzed.Match
+		}
+	}
+	splitTable := c.splitTable
+	if prefix := strings.TrimSuffix(phrase, "*"); prefix != phrase && prefix != "" {
+		goal := []byte(prefix)
+		return func(content []byte) bool {
+			return hasTokenPrefix(content, goal, splitTable)
+		}
+	}
+	goal := []byte(phrase)
+	return func(content []byte) bool {
+		finder := tokenizer.NewSimpleTokenFinder(splitTable)
+		finder.InitInput(content, goal)
+		return finder.Next()
+	}
+}
+
+func hasTokenPrefix(content, prefix, splitTable []byte) bool {
+	from := 0
+	for {
+		i := bytes.Index(content[from:], prefix)
+		if i < 0 {
+			return false
+		}
+		i += from
+		if i == 0 || isSplit(content[i-1], splitTable) || isSplit(content[i], splitTable) {
+			return true
+		}
+		from = i + 1
+	}
+}
+
+func isSplit(c byte, splitTable []byte) bool {
+	return c < 0x80 && splitTable[c] > 0
+}
+
+func regexMatcher(re *regexp.Regexp, equal bool) func(v interface{}) bool {
+	return func(v interface{}) bool {
+		s, ok := toString(v)
+		return ok && re.MatchString(s) == equal
+	}
+}
+
+func literal(expr influxql.Expr) (interface{}, error) {
+	switch lit := expr.(type) {
+	case *influxql.StringLiteral:
+		return lit.Val, nil
+	case *influxql.NumberLiteral:
+		return lit.Val, nil
+	case *influxql.IntegerLiteral:
+		return lit.Val, nil
+	case *influxql.BooleanLiteral:
+		return lit.Val, nil
+	default:
+		return nil, fmt.Errorf("unsupported tail condition value %s", expr.String())
+	}
+}
+
+// compare compares the value of the log with the literal, the string literals are compared as numbers with the number fields.
+// The integers are compared exactly with the integer literals, and as floats with the float literals.
+func compare(v, lit interface{}, op influxql.Token) bool {
+	switch val := v.(type) {
+	case int64:
+		switch l := lit.(type) {
+		case int64:
+			return compareOrdered(val, l, op)
+		case string:
+			if i, err := strconv.ParseInt(l, 10, 64); err == nil {
+				return compareOrdered(val, i, op)
+			}
+		}
+		return compare(float64(val), lit, op)
+	case float64:
+		var f float64
+		switch l := lit.(type) {
+		case float64:
+			f = l
+		case int64:
+			f = float64(l)
+		case string:
+			var err error
+			if f, err = strconv.ParseFloat(l, 64); err != nil {
+				return false
+			}
+		default:
+			return false
+		}
+		return compareOrdered(val, f, op)
+	case bool:
+		var b bool
+		switch l := lit.(type) {
+		case bool:
+			b = l
+		case string:
+			var err error
+			if b, err = strconv.ParseBool(l); err != nil {
+				return false
+			}
+		default:
+			return false
+		}
+		switch op {
+		case influxql.EQ:
+			return val == b
+		case influxql.NEQ:
+			return val != b
+		default:
+			return false
+		}
+	case string:
+		l, ok := lit.(string)
+		if !ok {
+			l, _ = toString(lit)
+		}
+		return compareOrdered(val, l, op)
+	default:
+		return false
+	}
+}
+
+func compareOrdered[T int64 | float64 | string](a, b T, op influxql.Token) bool {
+	switch op {
+	case influxql.EQ:
+		return a == b
+	case influxql.NEQ:
+		return a != b
+	case influxql.LT:
+		return a < b
+	case influxql.LTE:
+		return a <= b
+	case influxql.GT:
+		return a > b
+	case influxql.GTE:
+		return a >= b
+	default:
+		return false
+	}
+}
+
+func toString(v interface{}) (string, bool) {
+	switch val := v.(type) {
+	case string:
+		return val, true
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), true
+	case int64:
+		return strconv.FormatInt(val, 10), true
+	case bool:
+		return strconv.FormatBool(val), true
+	default:
+		return "", false
+	}
+}
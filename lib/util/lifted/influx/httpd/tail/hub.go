@@ -0,0 +1,273 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tail
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	DefaultBufferSize = 1000
+	MaxBufferSize     = 10000
+	// DefaultRate is the max number of the logs sent to a subscriber per second
+	DefaultRate = 1000
+	MaxRate     = 10000
+
+	MaxSubscribersPerStream = 64
+	MaxSubscribers          = 1024
+)
+
+var (
+	ErrTooManySubscribers          = errors.New("too many live tail subscribers")
+	ErrTooManyLogStreamSubscribers = errors.New("too many live tail subscribers of the logstream")
+)
+
+// Log is a log written to a logstream, the time is in nanoseconds.
+// The fields keep the types of the columns: string, int64, float64 and bool.
+type Log struct {
+	Time   int64
+	Fields map[string]interface{}
+}
+
+type Options struct {
+	BufferSize int
+	Rate       int
+}
+
+// Stats are the counters of a subscriber, the matched logs are either sent, sampled out or dropped.
+type Stats struct {
+	Matched uint64 `json:"matched"`
+	Sent    uint64 `json:"sent"`
+	Sampled uint64 `json:"sampled"`
+	Dropped uint64 `json:"dropped"`
+}
+
+// Subscriber receives the matched logs of a logstream by a bounded buffer. A log is dropped instead of blocking the
+// writer when the buffer is full or the rate limit is reached, and the logs are sampled once the buffer fills up.
+type Subscriber struct {
+	key    string
+	filter *Filter
+	logs   chan *Log
+
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+	seq    uint64
+
+	matched uint64
+	sent    uint64
+	sampled uint64
+	dropped uint64
+}
+
+// Logs returns the buffer of the subscriber.
+func (s *Subscriber) Logs() <-chan *Log {
+	return s.logs
+}
+
+func (s *Subscriber) Stats() Stats {
+	return Stats{
+		Matched: atomic.LoadUint64(&s.matched),
+		Sent:    atomic.LoadUint64(&s.sent),
+		Sampled: atomic.LoadUint64(&s.sampled),
+		Dropped: atomic.LoadUint64(&s.dropped),
+	}
+}
+
+// NewSubscriber returns a subscriber out of any hub, a nil filter matches every log.
+func NewSubscriber(filter *Filter, opt Options) *Subscriber {
+	if filter == nil {
+		filter, _ = NewFilter(nil, nil)
+	}
+	if opt.BufferSize <= 0 {
+		opt.BufferSize = DefaultBufferSize
+	}
+	if opt.Rate <= 0 {
+		opt.Rate = DefaultRate
+	}
+	return &Subscriber{
+		filter: filter,
+		logs:   make(chan *Log, opt.BufferSize),
+		rate:   float64(opt.Rate),
+		tokens: float64(opt.Rate),
+		last:   time.Now(),
+	}
+}
+
+// Offer offers the logs to the subscriber, it never blocks.
+func (s *Subscriber) Offer(logs []*Log) {
+	now := time.Now()
+	for _, log := range logs {
+		s.offer(log, now)
+	}
+}
+
+// Next waits for the buffered logs until the wait elapses, and returns all of them without waiting for more.
+func (s *Subscriber) Next(wait time.Duration) []*Log {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	var logs []*Log
+	select {
+	case log := <-s.logs:
+		logs = append(logs, log)
+	case <-timer.C:
+		return nil
+	}
+	for {
+		select {
+		case log := <-s.logs:
+			logs = append(logs, log)
+		default:
+			return logs
+		}
+	}
+}
+
+func (s *Subscriber) offer(log *Log, now time.Time) {
+	if !s.filter.Match(log.Fields) {
+		return
+	}
+	atomic.AddUint64(&s.matched, 1)
+
+	s.mu.Lock()
+	s.tokens += now.Sub(s.last).Seconds() * s.rate
+	if s.tokens > s.rate {
+		s.tokens = s.rate
+	}
+	s.last = now
+	if s.tokens < 1 {
+		s.mu.Unlock()
+		atomic.AddUint64(&s.dropped, 1)
+		return
+	}
+	s.seq++
+	keep := s.seq%sampleInterval(len(s.logs), cap(s.logs)) == 0
+	if keep {
+		s.tokens--
+	}
+	s.mu.Unlock()
+
+	if !keep {
+		atomic.AddUint64(&s.sampled, 1)
+		return
+	}
+	select {
+	case s.logs <- log:
+		atomic.AddUint64(&s.sent, 1)
+	default:
+		atomic.AddUint64(&s.dropped, 1)
+	}
+}
+
+// sampleInterval keeps every log while the buffer is less than half full,
+// then one of 2, 4 and 8 logs as the buffer fills up.
+func sampleInterval(n, size int) uint64 {
+	switch {
+	case n*8 >= size*7:
+		return 8
+	case n*4 >= size*3:
+		return 4
+	case n*2 >= size:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// Hub dispatches the written logs to the live tail subscribers of the logstreams.
+type Hub struct {
+	mu      sync.RWMutex
+	streams map[string]map[*Subscriber]struct{}
+	total   int
+}
+
+var DefaultHub = NewHub()
+
+func NewHub() *Hub {
+	return &Hub{streams: make(map[string]map[*Subscriber]struct{})}
+}
+
+func streamKey(repository, logStream string) string {
+	return repository + "/" + logStream
+}
+
+func (h *Hub) Subscribe(repository, logStream string, filter *Filter, opt Options) (*Subscriber, error) {
+	key := streamKey(repository, logStream)
+	s := NewSubscriber(filter, opt)
+	s.key = key
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.total >= MaxSubscribers {
+		return nil, ErrTooManySubscribers
+	}
+	subscribers, ok := h.streams[key]
+	if !ok {
+		subscribers = make(map[*Subscriber]struct{})
+		h.streams[key] = subscribers
+	}
+	if len(subscribers) >= MaxSubscribersPerStream {
+		return nil, ErrTooManyLogStreamSubscribers
+	}
+	subscribers[s] = struct{}{}
+	h.total++
+	return s, nil
+}
+
+func (h *Hub) Unsubscribe(s *Subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	subscribers, ok := h.streams[s.key]
+	if !ok {
+		return
+	}
+	if _, ok = subscribers[s]; !ok {
+		return
+	}
+	delete(subscribers, s)
+	h.total--
+	if len(subscribers) == 0 {
+		delete(h.streams, s.key)
+	}
+}
+
+// HasSubscribers reports whether the logstream is tailed, the writers skip building the logs if it is not.
+func (h *Hub) HasSubscribers(repository, logStream string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.streams[streamKey(repository, logStream)]) > 0
+}
+
+// Publish offers the logs to the subscribers of the logstream, it never blocks on a slow subscriber.
+func (h *Hub) Publish(repository, logStream string, logs []*Log) {
+	if len(logs) == 0 {
+		return
+	}
+	now := time.Now()
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for s := range h.streams[streamKey(repository, logStream)] {
+		for _, log := range logs {
+			s.offer(log, now)
+		}
+	}
+}
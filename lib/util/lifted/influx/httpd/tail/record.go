@@ -0,0 +1,66 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tail
+
+import (
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// RetryTag marks the logs written again by the clients, it is not a field of the logs
+const RetryTag = "__retry_tag__"
+
+// RecordToLogs converts the rows of the record written to a logstream to the logs.
+func RecordToLogs(rec *record.Record) []*Log {
+	rows := rec.RowNums()
+	logs := make([]*Log, rows)
+	for i := range logs {
+		logs[i] = &Log{Fields: make(map[string]interface{}, rec.ColNums())}
+	}
+	for c := range rec.Schema {
+		name := rec.Schema[c].Name
+		if name == RetryTag {
+			continue
+		}
+		col := &rec.ColVals[c]
+		for i := 0; i < rows && i < col.Len; i++ {
+			if name == record.TimeField {
+				logs[i].Time, _ = col.IntegerValue(i)
+				continue
+			}
+			switch rec.Schema[c].Type {
+			case influx.Field_Type_String:
+				if v, isNil := col.StringValue(i); !isNil {
+					logs[i].Fields[name] = string(v)
+				}
+			case influx.Field_Type_Float:
+				if v, isNil := col.FloatValue(i); !isNil {
+					logs[i].Fields[name] = v
+				}
+			case influx.Field_Type_Boolean:
+				if v, isNil := col.BooleanValue(i); !isNil {
+					logs[i].Fields[name] = v
+				}
+			case influx.Field_Type_Int:
+				if v, isNil := col.IntegerValue(i); !isNil {
+					logs[i].Fields[name] = v
+				}
+			}
+		}
+	}
+	return logs
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tail
+
+import (
+	"sync"
+	"time"
+)
+
+// SessionExpire closes the sessions which are not polled, such as the sessions of a crashed ts-sql.
+const SessionExpire = time.Minute
+
+// Sessions are the subscribers of a store polled by the live tails of the ts-sql nodes.
+type Sessions struct {
+	hub    *Hub
+	expire time.Duration
+
+	mu       sync.Mutex
+	sessions map[uint64]*session
+}
+
+type session struct {
+	sub   *Subscriber
+	timer *time.Timer
+}
+
+var DefaultSessions = NewSessions(DefaultHub, SessionExpire)
+
+func NewSessions(hub *Hub, expire time.Duration) *Sessions {
+	return &Sessions{hub: hub, expire: expire, sessions: make(map[uint64]*session)}
+}
+
+// Poll returns the logs of the session buffered in the wait, the session is subscribed on the first poll.
+// A store restarted or a session expired is subscribed again, the logs written in the meantime are lost.
+func (s *Sessions) Poll(id uint64, subscribe func(hub *Hub) (*Subscriber, error), wait time.Duration) ([]*Log, Stats, error) {
+	s.mu.Lock()
+	ss, ok := s.sessions[id]
+	if ok {
+		ss.timer.Reset(s.expire)
+	} else {
+		sub, err := subscribe(s.hub)
+		if err != nil {
+			s.mu.Unlock()
+			return nil, Stats{}, err
+		}
+		ss = &session{sub: sub}
+		ss.timer = time.AfterFunc(s.expire, func() {
+			s.close(id, ss)
+		})
+		s.sessions[id] = ss
+	}
+	s.mu.Unlock()
+
+	logs := ss.sub.Next(wait)
+	return logs, ss.sub.Stats(), nil
+}
+
+func (s *Sessions) Close(id uint64) {
+	s.close(id, nil)
+}
+
+// close closes the session of the id, or only the session ss if it is not nil
+func (s *Sessions) close(id uint64, ss *session) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cur, ok := s.sessions[id]
+	if !ok || (ss != nil && cur != ss) {
+		return
+	}
+	cur.timer.Stop()
+	delete(s.sessions, id)
+	s.hub.Unsubscribe(cur.sub)
+}
+
+func (s *Sessions) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.sessions)
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tail
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/logparser"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parse(t *testing.T, query string) influxql.Expr {
+	parser := logparser.NewYyParser(logparser.NewScanner(strings.NewReader(query)))
+	parser.ParseTokens()
+	q, err := parser.GetQuery()
+	require.NoError(t, err)
+	return q.Statements[0].(*influxql.LogPipeStatement).Cond
+}
+
+func TestFilter(t *testing.T) {
+	log := map[string]interface{}{
+		"content": "connection refused by db-1.local",
+		"host":    "web-01",
+		"status":  float64(503),
+		"bytes":   int64(2048),
+		"ok":      false,
+	}
+	for query, expect := range map[string]bool{
+		`refused`:                                true,
+		`refuse`:                                 false,
+		`"connection refused"`:                   true,
+		`"refused connection"`:                   false,
+		`host:web*`:                              true,
+		`host:db*`:                               false,
+		`content:db*`:                            true,
+		`status:503`:                             true,
+		`status > 500 AND host:web*`:             true,
+		`status < 500 OR refused`:                true,
+		`status >= 600`:                          false,
+		`missing:x OR (ok:false AND status:503)`: true,
+		`bytes:2048`:                             true,
+		`bytes > 1024 AND bytes < 2048.5`:        true,
+		`bytes >= 4096`:                          false,
+	} {
+		cond := parse(t, query)
+		f, err := NewFilter(cond, nil)
+		require.NoError(t, err, query)
+		assert.Equal(t, expect, f.Match(log), query)
+
+		// the condition is sent to the stores as a string
+		f, err = NewFilter(influxql.MustParseExpr(cond.String()), nil)
+		require.NoError(t, err, query)
+		assert.Equal(t, expect, f.Match(log), query)
+	}
+
+	f, err := NewFilter(nil, nil)
+	require.NoError(t, err)
+	assert.True(t, f.Match(log))
+
+	_, err = NewFilter(&influxql.Call{Name: "now"}, nil)
+	assert.Error(t, err)
+	_, err = NewFilter(influxql.MustParseExpr(`host =~ /web/ AND content != 'x'`), nil)
+	assert.NoError(t, err)
+	_, err = NewFilter(influxql.MustParseExpr(`1 = host`), nil)
+	assert.Error(t, err)
+}
+
+func TestHub(t *testing.T) {
+	h := NewHub()
+	all, _ := NewFilter(nil, nil)
+	errorFilter, err := NewFilter(parse(t, "error"), nil)
+	require.NoError(t, err)
+
+	s1, err := h.Subscribe("repo", "ls", all, Options{BufferSize: 8, Rate: 1000})
+	require.NoError(t, err)
+	s2, err := h.Subscribe("repo", "ls", errorFilter, Options{})
+	require.NoError(t, err)
+	assert.True(t, h.HasSubscribers("repo", "ls"))
+	assert.False(t, h.HasSubscribers("repo", "other"))
+
+	logs := make([]*Log, 0, 20)
+	for i := 0; i < 20; i++ {
+		content := "info"
+		if i%5 == 0 {
+			content = "error"
+		}
+		logs = append(logs, &Log{Time: int64(i), Fields: map[string]interface{}{"content": content}})
+	}
+	h.Publish("repo", "ls", logs)
+	h.Publish("repo", "other", logs)
+
+	// the buffer of s1 is sampled and then dropped as it fills up
+	stats := s1.Stats()
+	assert.Equal(t, uint64(20), stats.Matched)
+	assert.Equal(t, uint64(8), stats.Sent)
+	assert.Equal(t, stats.Matched, stats.Sent+stats.Sampled+stats.Dropped)
+	assert.True(t, stats.Sampled > 0)
+	assert.Equal(t, Stats{Matched: 4, Sent: 4}, s2.Stats())
+	assert.Equal(t, int64(0), (<-s2.Logs()).Time)
+	assert.Equal(t, int64(5), (<-s2.Logs()).Time)
+
+	h.Unsubscribe(s1)
+	h.Unsubscribe(s1)
+	h.Unsubscribe(s2)
+	assert.False(t, h.HasSubscribers("repo", "ls"))
+	assert.Equal(t, 0, h.total)
+}
+
+func TestSubscriberRateLimit(t *testing.T) {
+	h := NewHub()
+	all, _ := NewFilter(nil, nil)
+	s, err := h.Subscribe("repo", "ls", all, Options{BufferSize: 100, Rate: 2})
+	require.NoError(t, err)
+
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		s.offer(&Log{Fields: map[string]interface{}{}}, now)
+	}
+	assert.Equal(t, Stats{Matched: 5, Sent: 2, Dropped: 3}, s.Stats())
+	s.offer(&Log{Fields: map[string]interface{}{}}, now.Add(time.Second))
+	assert.Equal(t, uint64(3), s.Stats().Sent)
+}
+
+func TestHubLimit(t *testing.T) {
+	h := NewHub()
+	all, _ := NewFilter(nil, nil)
+	for i := 0; i < MaxSubscribersPerStream; i++ {
+		_, err := h.Subscribe("repo", "ls", all, Options{BufferSize: 1})
+		require.NoError(t, err)
+	}
+	_, err := h.Subscribe("repo", "ls", all, Options{BufferSize: 1})
+	assert.Equal(t, ErrTooManyLogStreamSubscribers, err)
+
+	h.total = MaxSubscribers
+	_, err = h.Subscribe("repo", "other", all, Options{BufferSize: 1})
+	assert.Equal(t, ErrTooManySubscribers, err)
+}
+
+func TestRecordToLogs(t *testing.T) {
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_String, Name: "content"},
+		record.Field{Type: influx.Field_Type_Float, Name: "latency"},
+		record.Field{Type: influx.Field_Type_Int, Name: "status"},
+		record.Field{Type: influx.Field_Type_Boolean, Name: RetryTag},
+		record.Field{Type: influx.Field_Type_Int, Name: record.TimeField},
+	}
+	rec := record.NewRecord(schema, false)
+	rec.ColVals[0].AppendString("error a")
+	rec.ColVals[0].AppendStringNull()
+	rec.ColVals[1].AppendFloatNull()
+	rec.ColVals[1].AppendFloat(1.5)
+	rec.ColVals[2].AppendIntegerNull()
+	rec.ColVals[2].AppendInteger(503)
+	rec.ColVals[3].AppendBooleans(true, true)
+	rec.ColVals[4].AppendIntegers(int64(time.Second), 2*int64(time.Second))
+
+	logs := RecordToLogs(rec)
+	assert.Equal(t, 2, len(logs))
+	assert.Equal(t, int64(time.Second), logs[0].Time)
+	assert.Equal(t, map[string]interface{}{"content": "error a"}, logs[0].Fields)
+	assert.Equal(t, map[string]interface{}{"latency": 1.5, "status": int64(503)}, logs[1].Fields)
+}
+
+func TestSessions(t *testing.T) {
+	h := NewHub()
+	s := NewSessions(h, 100*time.Millisecond)
+	subscribes := 0
+	subscribe := func(hub *Hub) (*Subscriber, error) {
+		subscribes++
+		return hub.Subscribe("repo", "ls", nil, Options{})
+	}
+
+	logs, _, err := s.Poll(1, subscribe, time.Millisecond)
+	require.NoError(t, err)
+	assert.Empty(t, logs)
+	assert.True(t, h.HasSubscribers("repo", "ls"))
+
+	h.Publish("repo", "ls", []*Log{{Time: 1}, {Time: 2}})
+	logs, stats, err := s.Poll(1, subscribe, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(logs))
+	assert.Equal(t, Stats{Matched: 2, Sent: 2}, stats)
+	assert.Equal(t, 1, subscribes)
+
+	s.Close(1)
+	assert.False(t, h.HasSubscribers("repo", "ls"))
+
+	// the session is closed if it is not polled
+	_, _, err = s.Poll(2, subscribe, time.Millisecond)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return s.Len() == 0 }, time.Second, 10*time.Millisecond)
+	assert.False(t, h.HasSubscribers("repo", "ls"))
+	assert.Equal(t, 2, subscribes)
+
+	_, _, err = s.Poll(3, func(hub *Hub) (*Subscriber, error) { return nil, ErrTooManySubscribers }, time.Millisecond)
+	assert.Equal(t, ErrTooManySubscribers, err)
+	assert.Equal(t, 0, s.Len())
+}
@@ -273,6 +273,7 @@ func (*Query) node()     {}
 func (Statements) node() {}
 
 func (*AlterRetentionPolicyStatement) node()       {}
+func (*BackfillContinuousQueryStatement) node()    {}
 func (*CreateContinuousQueryStatement) node()      {}
 func (*CreateDatabaseStatement) node()             {}
 func (*CreateMeasurementStatement) node()          {}
@@ -295,6 +296,8 @@ func (*ExplainStatement) node()                    {}
 func (*GrantStatement) node()                      {}
 func (*GrantAdminStatement) node()                 {}
 func (*KillQueryStatement) node()                  {}
+func (*DecommissionNodeStatement) node()           {}
+func (*KillDecommissionStatement) node()           {}
 func (*RevokeStatement) node()                     {}
 func (*RevokeAdminStatement) node()                {}
 func (*SelectStatement) node()                     {}
@@ -309,11 +312,26 @@ func (*ShowMeasurementCardinalityStatement) node() {}
 func (*ShowMeasurementsStatement) node()           {}
 func (*ShowMeasurementsDetailStatement) node()     {}
 func (*ShowQueriesStatement) node()                {}
+func (*ShowCompactionsStatement) node()            {}
+func (*ShowRepairsStatement) node()                {}
+func (*ShowRebalanceStatement) node()              {}
+func (*ShowDecommissionStatement) node()           {}
 func (*ShowSeriesStatement) node()                 {}
 func (*ShowSeriesCardinalityStatement) node()      {}
+func (*ShowCardinalityTopStatement) node()         {}
 func (*ShowShardGroupsStatement) node()            {}
 func (*ShowShardsStatement) node()                 {}
 func (*ShowStatsStatement) node()                  {}
+func (*CreateReplicationStatement) node()          {}
+func (*DropReplicationStatement) node()            {}
+func (*ShowReplicationsStatement) node()           {}
+func (*PromoteDatabaseStatement) node()            {}
+func (*CreateTenantStatement) node()               {}
+func (*AlterTenantStatement) node()                {}
+func (*DropTenantStatement) node()                 {}
+func (*ShowTenantsStatement) node()                {}
+func (*GrantTenantStatement) node()                {}
+func (*RevokeTenantStatement) node()               {}
 func (*ShowSubscriptionsStatement) node()          {}
 func (*ShowDiagnosticsStatement) node()            {}
 func (*ShowTagKeyCardinalityStatement) node()      {}
@@ -443,6 +461,7 @@ type ExecutionPrivilege struct {
 type ExecutionPrivileges []ExecutionPrivilege
 
 func (*AlterRetentionPolicyStatement) stmt()       {}
+func (*BackfillContinuousQueryStatement) stmt()    {}
 func (*CreateContinuousQueryStatement) stmt()      {}
 func (*CreateDatabaseStatement) stmt()             {}
 func (*CreateMeasurementStatement) stmt()          {}
@@ -463,6 +482,8 @@ func (*ExplainStatement) stmt()                    {}
 func (*GrantStatement) stmt()                      {}
 func (*GrantAdminStatement) stmt()                 {}
 func (*KillQueryStatement) stmt()                  {}
+func (*DecommissionNodeStatement) stmt()           {}
+func (*KillDecommissionStatement) stmt()           {}
 func (*ShowContinuousQueriesStatement) stmt()      {}
 func (*ShowGrantsForUserStatement) stmt()          {}
 func (*ShowDatabasesStatement) stmt()              {}
@@ -472,13 +493,28 @@ func (*ShowMeasurementCardinalityStatement) stmt() {}
 func (*ShowMeasurementsStatement) stmt()           {}
 func (*ShowMeasurementsDetailStatement) stmt()     {}
 func (*ShowQueriesStatement) stmt()                {}
+func (*ShowCompactionsStatement) stmt()            {}
+func (*ShowRepairsStatement) stmt()                {}
+func (*ShowRebalanceStatement) stmt()              {}
+func (*ShowDecommissionStatement) stmt()           {}
 func (*ShowRetentionPoliciesStatement) stmt()      {}
 func (*ShowSeriesStatement) stmt()                 {}
 func (*ShowSeriesCardinalityStatement) stmt()      {}
+func (*ShowCardinalityTopStatement) stmt()         {}
 func (*ShowShardGroupsStatement) stmt()            {}
 func (*ShowShardsStatement) stmt()                 {}
 func (*ShowStatsStatement) stmt()                  {}
 func (*DropShardStatement) stmt()                  {}
+func (*CreateReplicationStatement) stmt()          {}
+func (*DropReplicationStatement) stmt()            {}
+func (*ShowReplicationsStatement) stmt()           {}
+func (*PromoteDatabaseStatement) stmt()            {}
+func (*CreateTenantStatement) stmt()               {}
+func (*AlterTenantStatement) stmt()                {}
+func (*DropTenantStatement) stmt()                 {}
+func (*ShowTenantsStatement) stmt()                {}
+func (*GrantTenantStatement) stmt()                {}
+func (*RevokeTenantStatement) stmt()               {}
 func (*ShowSubscriptionsStatement) stmt()          {}
 func (*ShowDiagnosticsStatement) stmt()            {}
 func (*ShowTagKeyCardinalityStatement) stmt()      {}
@@ -1176,6 +1212,38 @@ func (s *KillQueryStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
 	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
 }
 
+// DecommissionNodeStatement represents a command for decommissioning a ts-store node.
+type DecommissionNodeStatement struct {
+	// The node to decommission.
+	NodeID uint64
+}
+
+// String returns a string representation of the decommission node statement.
+func (s *DecommissionNodeStatement) String() string {
+	return "DECOMMISSION NODE " + strconv.FormatUint(s.NodeID, 10)
+}
+
+// RequiredPrivileges returns the privilege required to execute a DecommissionNodeStatement.
+func (s *DecommissionNodeStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+// KillDecommissionStatement represents a command for canceling the decommission of a ts-store node.
+type KillDecommissionStatement struct {
+	// The node whose decommission is canceled.
+	NodeID uint64
+}
+
+// String returns a string representation of the kill decommission statement.
+func (s *KillDecommissionStatement) String() string {
+	return "KILL DECOMMISSION NODE " + strconv.FormatUint(s.NodeID, 10)
+}
+
+// RequiredPrivileges returns the privilege required to execute a KillDecommissionStatement.
+func (s *KillDecommissionStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
 // SetPasswordUserStatement represents a command for changing user password.
 type SetPasswordUserStatement struct {
 	// Plain-text password.
@@ -3507,6 +3575,50 @@ func (s *ShowSeriesCardinalityStatement) DefaultDatabase() string {
 	return s.Database
 }
 
+// ShowCardinalityTopStatement represents a command for listing the tag keys contributing the most series.
+type ShowCardinalityTopStatement struct {
+	// Database to query. If blank, use the default database.
+	Database string
+
+	// Measurement(s) the tag keys are listed for.
+	Sources Sources
+
+	Limit, Offset int
+}
+
+// String returns a string representation of the show cardinality top statement.
+func (s *ShowCardinalityTopStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("SHOW CARDINALITY TOP")
+
+	if s.Database != "" {
+		_, _ = buf.WriteString(" ON ")
+		_, _ = buf.WriteString(QuoteIdent(s.Database))
+	}
+	if s.Sources != nil {
+		_, _ = buf.WriteString(" FROM ")
+		_, _ = buf.WriteString(s.Sources.String())
+	}
+	if s.Limit > 0 {
+		_, _ = fmt.Fprintf(&buf, " LIMIT %d", s.Limit)
+	}
+	if s.Offset > 0 {
+		_, _ = buf.WriteString(" OFFSET ")
+		_, _ = buf.WriteString(strconv.Itoa(s.Offset))
+	}
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a ShowCardinalityTopStatement.
+func (s *ShowCardinalityTopStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: ReadPrivilege}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *ShowCardinalityTopStatement) DefaultDatabase() string {
+	return s.Database
+}
+
 // ShowContinuousQueriesStatement represents a command for listing continuous queries.
 type ShowContinuousQueriesStatement struct{}
 
@@ -3656,6 +3768,40 @@ func (s *DropContinuousQueryStatement) DefaultDatabase() string {
 	return s.Database
 }
 
+// BackfillContinuousQueryStatement represents a command for running a continuous query over the historical
+// time range [StartTime, EndTime).
+type BackfillContinuousQueryStatement struct {
+	Name      string
+	Database  string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// String returns a string representation of the statement.
+func (s *BackfillContinuousQueryStatement) String() string {
+	return fmt.Sprintf("BACKFILL CONTINUOUS QUERY %s ON %s FROM %s TO %s", QuoteIdent(s.Name), QuoteIdent(s.Database),
+		QuoteString(s.StartTime.UTC().Format(time.RFC3339Nano)), QuoteString(s.EndTime.UTC().Format(time.RFC3339Nano)))
+}
+
+// RequiredPrivileges returns the privilege(s) required to execute a BackfillContinuousQueryStatement
+func (s *BackfillContinuousQueryStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: WritePrivilege}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *BackfillContinuousQueryStatement) DefaultDatabase() string {
+	return s.Database
+}
+
+// parseBackfillTime parses the time string of BACKFILL CONTINUOUS QUERY, which is a date or a datetime in UTC.
+func parseBackfillTime(s string) (time.Time, error) {
+	t, err := (&StringLiteral{Val: s}).ToTimeLiteral(time.UTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid backfill time %s: %s", QuoteString(s), err)
+	}
+	return t.Val, nil
+}
+
 // ShowMeasurementCardinalityStatement represents a command for listing measurement cardinality.
 type ShowMeasurementCardinalityStatement struct {
 	Exact         bool // If false then cardinality estimation will be used.
@@ -3864,6 +4010,58 @@ func (s *ShowQueriesStatement) RequiredPrivileges() (ExecutionPrivileges, error)
 	return ExecutionPrivileges{{Admin: false, Name: "", Rwuser: true, Privilege: ReadPrivilege}}, nil
 }
 
+// ShowCompactionsStatement represents a command for listing running and pending compaction plans.
+type ShowCompactionsStatement struct{}
+
+// String returns a string representation of the show compactions statement.
+func (s *ShowCompactionsStatement) String() string {
+	return "SHOW COMPACTIONS"
+}
+
+// RequiredPrivileges returns the privilege required to execute a ShowCompactionsStatement.
+func (s *ShowCompactionsStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// ShowRepairsStatement represents a command for listing the divergences and the repairs of the replicas.
+type ShowRepairsStatement struct{}
+
+// String returns a string representation of the show repairs statement.
+func (s *ShowRepairsStatement) String() string {
+	return "SHOW REPAIRS"
+}
+
+// RequiredPrivileges returns the privilege required to execute a ShowRepairsStatement.
+func (s *ShowRepairsStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// ShowRebalanceStatement represents a command for listing the progress of the partitions moved by the rebalancing.
+type ShowRebalanceStatement struct{}
+
+// String returns a string representation of the show rebalance statement.
+func (s *ShowRebalanceStatement) String() string {
+	return "SHOW REBALANCE"
+}
+
+// RequiredPrivileges returns the privilege required to execute a ShowRebalanceStatement.
+func (s *ShowRebalanceStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// ShowDecommissionStatement represents a command for listing the progress of the decommissioned ts-store nodes.
+type ShowDecommissionStatement struct{}
+
+// String returns a string representation of the show decommission statement.
+func (s *ShowDecommissionStatement) String() string {
+	return "SHOW DECOMMISSION"
+}
+
+// RequiredPrivileges returns the privilege required to execute a ShowDecommissionStatement.
+func (s *ShowDecommissionStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
 // ShowRetentionPoliciesStatement represents a command for listing retention policies.
 type ShowRetentionPoliciesStatement struct {
 	// Name of the database to list policies for.
@@ -4067,6 +4265,287 @@ func (s *ShowSubscriptionsStatement) RequiredPrivileges() (ExecutionPrivileges,
 	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
 }
 
+// CreateReplicationStatement represents a command to replicate a database asynchronously between clusters.
+type CreateReplicationStatement struct {
+	Database string
+	// The http address of the other cluster.
+	Target string
+	// The database of this cluster is the standby replicated from the target if Standby is true,
+	// otherwise it is the primary replicated to the target.
+	Standby bool
+}
+
+// String returns a string representation of the CreateReplicationStatement.
+func (s *CreateReplicationStatement) String() string {
+	direction := "TO"
+	if s.Standby {
+		direction = "FROM"
+	}
+	return fmt.Sprintf("CREATE REPLICATION ON %s %s %s", QuoteIdent(s.Database), direction, QuoteString(s.Target))
+}
+
+// RequiredPrivileges returns the privilege required to execute a CreateReplicationStatement.
+func (s *CreateReplicationStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *CreateReplicationStatement) DefaultDatabase() string {
+	return s.Database
+}
+
+// DropReplicationStatement represents a command to remove the asynchronous replication of a database.
+type DropReplicationStatement struct {
+	Database string
+}
+
+// String returns a string representation of the DropReplicationStatement.
+func (s *DropReplicationStatement) String() string {
+	return fmt.Sprintf("DROP REPLICATION ON %s", QuoteIdent(s.Database))
+}
+
+// RequiredPrivileges returns the privilege required to execute a DropReplicationStatement.
+func (s *DropReplicationStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *DropReplicationStatement) DefaultDatabase() string {
+	return s.Database
+}
+
+// ShowReplicationsStatement represents a command to show the asynchronous replications of the databases.
+type ShowReplicationsStatement struct{}
+
+// String returns a string representation of the ShowReplicationsStatement.
+func (s *ShowReplicationsStatement) String() string {
+	return "SHOW REPLICATIONS"
+}
+
+// RequiredPrivileges returns the privilege required to execute a ShowReplicationsStatement.
+func (s *ShowReplicationsStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// PromoteDatabaseStatement represents a command to promote the standby database to accept the writes of the clients
+// when the primary cluster fails.
+type PromoteDatabaseStatement struct {
+	Database string
+}
+
+// String returns a string representation of the PromoteDatabaseStatement.
+func (s *PromoteDatabaseStatement) String() string {
+	return fmt.Sprintf("PROMOTE DATABASE %s", QuoteIdent(s.Database))
+}
+
+// RequiredPrivileges returns the privilege required to execute a PromoteDatabaseStatement.
+func (s *PromoteDatabaseStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *PromoteDatabaseStatement) DefaultDatabase() string {
+	return s.Database
+}
+
+// TenantOptions are the quotas and the pinned ts-store nodes of a tenant set by CREATE TENANT and ALTER TENANT.
+// The negative quotas are not set, 0 means unlimited.
+type TenantOptions struct {
+	MaxSeries           int64
+	MaxStorageBytes     int64
+	MaxIngestRate       int64
+	MaxQueryConcurrency int64
+	Nodes               []uint64
+	SetNodes            bool
+}
+
+// NewTenantOptions returns the options without any quota or node set.
+func NewTenantOptions() TenantOptions {
+	return TenantOptions{MaxSeries: -1, MaxStorageBytes: -1, MaxIngestRate: -1, MaxQueryConcurrency: -1}
+}
+
+// Set sets an option by name, the quotas are integers and the nodes are a string of comma separated node ids.
+func (o *TenantOptions) Set(name string, val interface{}) error {
+	var quota *int64
+	switch strings.ToLower(name) {
+	case "max_series":
+		quota = &o.MaxSeries
+	case "max_storage_bytes":
+		quota = &o.MaxStorageBytes
+	case "max_ingest_rate":
+		quota = &o.MaxIngestRate
+	case "max_query_concurrency":
+		quota = &o.MaxQueryConcurrency
+	case "nodes":
+		s, ok := val.(string)
+		if !ok {
+			return fmt.Errorf("tenant option %s must be a string", name)
+		}
+		nodes, err := parseTenantNodes(s)
+		if err != nil {
+			return err
+		}
+		o.Nodes, o.SetNodes = nodes, true
+		return nil
+	default:
+		return fmt.Errorf("unknown tenant option %s", name)
+	}
+
+	n, ok := val.(int64)
+	if !ok || n < 0 {
+		return fmt.Errorf("tenant option %s must be an integer greater or equal than 0", name)
+	}
+	*quota = n
+	return nil
+}
+
+func parseTenantNodes(s string) ([]uint64, error) {
+	var nodes []uint64
+	for _, id := range strings.Split(s, ",") {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		n, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tenant node %s", QuoteString(id))
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes, nil
+}
+
+// String returns the WITH clause of the options, empty if no option is set.
+func (o *TenantOptions) String() string {
+	var opts []string
+	for _, q := range []struct {
+		name string
+		val  int64
+	}{
+		{"max_series", o.MaxSeries},
+		{"max_storage_bytes", o.MaxStorageBytes},
+		{"max_ingest_rate", o.MaxIngestRate},
+		{"max_query_concurrency", o.MaxQueryConcurrency},
+	} {
+		if q.val >= 0 {
+			opts = append(opts, fmt.Sprintf("%s = %d", q.name, q.val))
+		}
+	}
+	if o.SetNodes {
+		nodes := make([]string, len(o.Nodes))
+		for i, n := range o.Nodes {
+			nodes[i] = strconv.FormatUint(n, 10)
+		}
+		opts = append(opts, fmt.Sprintf("nodes = %s", QuoteString(strings.Join(nodes, ","))))
+	}
+	if len(opts) == 0 {
+		return ""
+	}
+	return " WITH " + strings.Join(opts, ", ")
+}
+
+// CreateTenantStatement represents a command for creating a tenant.
+type CreateTenantStatement struct {
+	Name    string
+	Options TenantOptions
+}
+
+// String returns a string representation of the CreateTenantStatement.
+func (s *CreateTenantStatement) String() string {
+	return fmt.Sprintf("CREATE TENANT %s%s", QuoteIdent(s.Name), s.Options.String())
+}
+
+// RequiredPrivileges returns the privilege required to execute a CreateTenantStatement.
+func (s *CreateTenantStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// AlterTenantStatement represents a command for changing the quotas or the pinned nodes of a tenant.
+type AlterTenantStatement struct {
+	Name    string
+	Options TenantOptions
+}
+
+// String returns a string representation of the AlterTenantStatement.
+func (s *AlterTenantStatement) String() string {
+	return fmt.Sprintf("ALTER TENANT %s%s", QuoteIdent(s.Name), s.Options.String())
+}
+
+// RequiredPrivileges returns the privilege required to execute an AlterTenantStatement.
+func (s *AlterTenantStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// DropTenantStatement represents a command for dropping a tenant which owns no database.
+type DropTenantStatement struct {
+	Name string
+}
+
+// String returns a string representation of the DropTenantStatement.
+func (s *DropTenantStatement) String() string {
+	return fmt.Sprintf("DROP TENANT %s", QuoteIdent(s.Name))
+}
+
+// RequiredPrivileges returns the privilege required to execute a DropTenantStatement.
+func (s *DropTenantStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// ShowTenantsStatement represents a command for listing the tenants with their quotas and usage.
+type ShowTenantsStatement struct{}
+
+// String returns a string representation of the ShowTenantsStatement.
+func (s *ShowTenantsStatement) String() string {
+	return "SHOW TENANTS"
+}
+
+// RequiredPrivileges returns the privilege required to execute a ShowTenantsStatement.
+func (s *ShowTenantsStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// GrantTenantStatement represents a command for assigning a database (or a logstore repository) or a user
+// to a tenant. One of Database and User is set.
+type GrantTenantStatement struct {
+	Tenant   string
+	Database string
+	User     string
+}
+
+// String returns a string representation of the GrantTenantStatement.
+func (s *GrantTenantStatement) String() string {
+	if s.User != "" {
+		return fmt.Sprintf("GRANT USER %s TO TENANT %s", QuoteIdent(s.User), QuoteIdent(s.Tenant))
+	}
+	return fmt.Sprintf("GRANT DATABASE %s TO TENANT %s", QuoteIdent(s.Database), QuoteIdent(s.Tenant))
+}
+
+// RequiredPrivileges returns the privilege required to execute a GrantTenantStatement.
+func (s *GrantTenantStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// RevokeTenantStatement represents a command for removing a database or a user from a tenant.
+// One of Database and User is set.
+type RevokeTenantStatement struct {
+	Tenant   string
+	Database string
+	User     string
+}
+
+// String returns a string representation of the RevokeTenantStatement.
+func (s *RevokeTenantStatement) String() string {
+	if s.User != "" {
+		return fmt.Sprintf("REVOKE USER %s FROM TENANT %s", QuoteIdent(s.User), QuoteIdent(s.Tenant))
+	}
+	return fmt.Sprintf("REVOKE DATABASE %s FROM TENANT %s", QuoteIdent(s.Database), QuoteIdent(s.Tenant))
+}
+
+// RequiredPrivileges returns the privilege required to execute a RevokeTenantStatement.
+func (s *RevokeTenantStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
 // ShowTagKeysStatement represents a command for listing tag keys.
 type ShowTagKeysStatement struct {
 	// Database to query. If blank, use the default database.
@@ -4737,6 +5216,30 @@ func (ir *IndexRelation) GetBloomFilterColumns() []string {
 	return nil
 }
 
+func (ir *IndexRelation) GetSetColumns() []string {
+	if ir == nil {
+		return nil
+	}
+	for i := range ir.Oids {
+		if ir.Oids[i] == uint32(index.Set) {
+			return ir.IndexList[i].IList
+		}
+	}
+	return nil
+}
+
+func (ir *IndexRelation) GetNGramColumns() []string {
+	if ir == nil {
+		return nil
+	}
+	for i := range ir.Oids {
+		if ir.Oids[i] == uint32(index.NGram) {
+			return ir.IndexList[i].IList
+		}
+	}
+	return nil
+}
+
 func (ir *IndexRelation) GetFullTextColumns() []string {
 	if ir == nil || len(ir.Oids) == 0 {
 		return nil
@@ -5561,6 +6064,9 @@ func Walk(v Visitor, node Node) {
 		Walk(v, n.Sources)
 		Walk(v, n.Condition)
 
+	case *ShowCardinalityTopStatement:
+		Walk(v, n.Sources)
+
 	case *ShowMeasurementCardinalityStatement:
 		Walk(v, n.Sources)
 		Walk(v, n.Condition)
@@ -147,6 +147,24 @@ func init() {
 		show.Handle(QUERIES, func(p *Parser) (Statement, error) {
 			return p.parseShowQueriesStatement()
 		})
+		show.Handle(COMPACTIONS, func(p *Parser) (Statement, error) {
+			return &ShowCompactionsStatement{}, nil
+		})
+		show.Handle(REPAIRS, func(p *Parser) (Statement, error) {
+			return &ShowRepairsStatement{}, nil
+		})
+		show.Handle(REBALANCE, func(p *Parser) (Statement, error) {
+			return &ShowRebalanceStatement{}, nil
+		})
+		show.Handle(DECOMMISSION, func(p *Parser) (Statement, error) {
+			return &ShowDecommissionStatement{}, nil
+		})
+		show.Handle(REPLICATIONS, func(p *Parser) (Statement, error) {
+			return &ShowReplicationsStatement{}, nil
+		})
+		show.Handle(CARDINALITY, func(p *Parser) (Statement, error) {
+			return p.parseShowCardinalityTopStatement()
+		})
 		show.Handle(CLUSTER, func(p *Parser) (Statement, error) {
 			return p.parseShowClusterStatement()
 		})
@@ -182,6 +200,9 @@ func init() {
 		show.Handle(USERS, func(p *Parser) (Statement, error) {
 			return p.parseShowUsersStatement()
 		})
+		show.Handle(TENANTS, func(p *Parser) (Statement, error) {
+			return &ShowTenantsStatement{}, nil
+		})
 	})
 	Language.Group(CREATE).With(func(create *ParseTree) {
 		create.Group(CONTINUOUS).Handle(QUERY, func(p *Parser) (Statement, error) {
@@ -199,9 +220,18 @@ func init() {
 		create.Handle(SUBSCRIPTION, func(p *Parser) (Statement, error) {
 			return p.parseCreateSubscriptionStatement()
 		})
+		create.Handle(REPLICATION, func(p *Parser) (Statement, error) {
+			return p.parseCreateReplicationStatement()
+		})
 		create.Handle(MEASUREMENT, func(p *Parser) (Statement, error) {
 			return p.parseCreateMeasurementStatement()
 		})
+		create.Handle(TENANT, func(p *Parser) (Statement, error) {
+			return p.parseCreateTenantStatement()
+		})
+	})
+	Language.Group(BACKFILL, CONTINUOUS).Handle(QUERY, func(p *Parser) (Statement, error) {
+		return p.parseBackfillContinuousQueryStatement()
 	})
 	Language.Group(DROP).With(func(drop *ParseTree) {
 		drop.Group(CONTINUOUS).Handle(QUERY, func(p *Parser) (Statement, error) {
@@ -225,9 +255,23 @@ func init() {
 		drop.Handle(SUBSCRIPTION, func(p *Parser) (Statement, error) {
 			return p.parseDropSubscriptionStatement()
 		})
+		drop.Handle(REPLICATION, func(p *Parser) (Statement, error) {
+			db, err := p.parseReplicationDatabase()
+			if err != nil {
+				return nil, err
+			}
+			return &DropReplicationStatement{Database: db}, nil
+		})
 		drop.Handle(USER, func(p *Parser) (Statement, error) {
 			return p.parseDropUserStatement()
 		})
+		drop.Handle(TENANT, func(p *Parser) (Statement, error) {
+			name, err := p.ParseIdent()
+			if err != nil {
+				return nil, err
+			}
+			return &DropTenantStatement{Name: name}, nil
+		})
 	})
 	Language.Handle(EXPLAIN, func(p *Parser) (Statement, error) {
 		return p.parseExplainStatement()
@@ -245,6 +289,9 @@ func init() {
 		alter.Handle(MEASUREMENT, func(p *Parser) (Statement, error) {
 			return p.parseAlterShardKeyStatement()
 		})
+		alter.Handle(TENANT, func(p *Parser) (Statement, error) {
+			return p.parseAlterTenantStatement()
+		})
 	})
 	//Language.Group(ALTER, RETENTION).Handle(POLICY, func(p *Parser) (Statement, error) {
 	//	return p.parseAlterRetentionPolicyStatement()
@@ -255,6 +302,27 @@ func init() {
 	Language.Group(KILL).Handle(QUERY, func(p *Parser) (Statement, error) {
 		return p.parseKillQueryStatement()
 	})
+	Language.Group(KILL).Handle(DECOMMISSION, func(p *Parser) (Statement, error) {
+		nodeID, err := p.parseDecommissionNode()
+		if err != nil {
+			return nil, err
+		}
+		return &KillDecommissionStatement{NodeID: nodeID}, nil
+	})
+	Language.Handle(DECOMMISSION, func(p *Parser) (Statement, error) {
+		nodeID, err := p.parseDecommissionNode()
+		if err != nil {
+			return nil, err
+		}
+		return &DecommissionNodeStatement{NodeID: nodeID}, nil
+	})
+	Language.Group(PROMOTE).Handle(DATABASE, func(p *Parser) (Statement, error) {
+		db, err := p.ParseIdent()
+		if err != nil {
+			return nil, err
+		}
+		return &PromoteDatabaseStatement{Database: db}, nil
+	})
 
 	Language.Group(PREPARE).With(func(prepare *ParseTree) {
 		prepare.Handle(SNAPSHOT, func(p *Parser) (Statement, error) {
@@ -204,6 +204,45 @@ func (p *Parser) parseKillQueryStatement() (*KillQueryStatement, error) {
 	return &KillQueryStatement{QueryID: qid, Host: host}, nil
 }
 
+// parseDecommissionNode parses the "NODE <id>" of the decommission statements.
+// This function assumes the DECOMMISSION token has already been consumed.
+func (p *Parser) parseDecommissionNode() (uint64, error) {
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != IDENT || !strings.EqualFold(lit, "NODE") {
+		return 0, newParseError(tokstr(tok, lit), []string{"NODE"}, pos)
+	}
+	return p.ParseUInt64()
+}
+
+// parseCreateReplicationStatement parses a string and returns a CreateReplicationStatement.
+// This function assumes the "CREATE REPLICATION" tokens have already been consumed.
+func (p *Parser) parseCreateReplicationStatement() (*CreateReplicationStatement, error) {
+	db, err := p.parseReplicationDatabase()
+	if err != nil {
+		return nil, err
+	}
+	stmt := &CreateReplicationStatement{Database: db}
+
+	// Expect one of "TO FROM" keywords.
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok == FROM {
+		stmt.Standby = true
+	} else if tok != TO {
+		return nil, newParseError(tokstr(tok, lit), []string{"TO", "FROM"}, pos)
+	}
+
+	if stmt.Target, err = p.parseString(); err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseReplicationDatabase parses the "ON <database>" of the replication statements.
+func (p *Parser) parseReplicationDatabase() (string, error) {
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != ON {
+		return "", newParseError(tokstr(tok, lit), []string{"ON"}, pos)
+	}
+	return p.ParseIdent()
+}
+
 // parseCreateSubscriptionStatement parses a string and returns a CreateSubscriptionStatement.
 // This function assumes the "CREATE SUBSCRIPTION" tokens have already been consumed.
 func (p *Parser) parseCreateSubscriptionStatement() (*CreateSubscriptionStatement, error) {
@@ -636,6 +675,18 @@ func (p *Parser) parseStringList() ([]string, error) {
 // parseRevokeStatement parses a string and returns a revoke statement.
 // This function assumes the REVOKE token has already been consumed.
 func (p *Parser) parseRevokeStatement() (Statement, error) {
+	// Check for removing a database or a user from a tenant.
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == DATABASE || tok == USER {
+		stmt := &RevokeTenantStatement{}
+		var err error
+		stmt.Tenant, err = p.parseTenantMember(tok, FROM, &stmt.Database, &stmt.User)
+		if err != nil {
+			return nil, err
+		}
+		return stmt, nil
+	}
+	p.Unscan()
+
 	// Parse the privilege to be revoked.
 	priv, err := p.parsePrivilege()
 	if err != nil {
@@ -715,6 +766,18 @@ func (p *Parser) parseRevokeAdminStatement() (*RevokeAdminStatement, error) {
 // parseGrantStatement parses a string and returns a grant statement.
 // This function assumes the GRANT token has already been consumed.
 func (p *Parser) parseGrantStatement() (Statement, error) {
+	// Check for assigning a database or a user to a tenant.
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == DATABASE || tok == USER {
+		stmt := &GrantTenantStatement{}
+		var err error
+		stmt.Tenant, err = p.parseTenantMember(tok, TO, &stmt.Database, &stmt.User)
+		if err != nil {
+			return nil, err
+		}
+		return stmt, nil
+	}
+	p.Unscan()
+
 	// Parse the privilege to be granted.
 	priv, err := p.parsePrivilege()
 	if err != nil {
@@ -1177,6 +1240,44 @@ func (p *Parser) parseShowMeasurementCardinalityStatement(exact bool) (Statement
 	return stmt, nil
 }
 
+// parseShowCardinalityTopStatement parses a string and returns a ShowCardinalityTopStatement.
+// This function assumes the "SHOW CARDINALITY" tokens have already been consumed.
+func (p *Parser) parseShowCardinalityTopStatement() (Statement, error) {
+	stmt := &ShowCardinalityTopStatement{}
+
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != IDENT || !strings.EqualFold(lit, "TOP") {
+		return nil, newParseError(tokstr(tok, lit), []string{"TOP"}, pos)
+	}
+
+	// Parse optional ON clause.
+	var err error
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == ON {
+		if stmt.Database, err = p.ParseIdent(); err != nil {
+			return nil, err
+		}
+	} else {
+		p.Unscan()
+	}
+
+	// Parse optional FROM.
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == FROM {
+		if stmt.Sources, err = p.parseSources(false); err != nil {
+			return nil, err
+		}
+	} else {
+		p.Unscan()
+	}
+
+	// Parse limit & offset: "LIMIT <n>", "OFFSET <n>".
+	if stmt.Limit, err = p.ParseOptionalTokenAndInt(LIMIT); err != nil {
+		return nil, err
+	} else if stmt.Offset, err = p.ParseOptionalTokenAndInt(OFFSET); err != nil {
+		return nil, err
+	}
+
+	return stmt, nil
+}
+
 // parseShowMeasurementsStatement parses a string and returns a Statement.
 // This function assumes the "SHOW MEASUREMENTS" tokens have already been consumed.
 func (p *Parser) parseShowMeasurementsStatement() (*ShowMeasurementsStatement, error) {
@@ -2264,6 +2365,150 @@ func (p *Parser) parseDropContinuousQueryStatement() (*DropContinuousQueryStatem
 	return stmt, nil
 }
 
+// parseTenantMember parses "<name> TO|FROM TENANT <tenant>" of GRANT and REVOKE, the name is set to the database
+// or the user by the kind token, which has already been consumed.
+func (p *Parser) parseTenantMember(kind, direction Token, database, user *string) (string, error) {
+	name, err := p.ParseIdent()
+	if err != nil {
+		return "", err
+	}
+	if kind == DATABASE {
+		*database = name
+	} else {
+		*user = name
+	}
+
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != direction {
+		return "", newParseError(tokstr(tok, lit), []string{tokens[direction]}, pos)
+	}
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != TENANT {
+		return "", newParseError(tokstr(tok, lit), []string{"TENANT"}, pos)
+	}
+	return p.ParseIdent()
+}
+
+// parseCreateTenantStatement parses a string and returns a CreateTenantStatement.
+// This function assumes the "CREATE TENANT" tokens have already been consumed.
+func (p *Parser) parseCreateTenantStatement() (*CreateTenantStatement, error) {
+	name, err := p.ParseIdent()
+	if err != nil {
+		return nil, err
+	}
+	stmt := &CreateTenantStatement{Name: name, Options: NewTenantOptions()}
+
+	// The options are optional.
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok != WITH {
+		p.Unscan()
+		return stmt, nil
+	}
+	if err = p.parseTenantOptions(&stmt.Options); err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseAlterTenantStatement parses a string and returns an AlterTenantStatement.
+// This function assumes the "ALTER TENANT" tokens have already been consumed.
+func (p *Parser) parseAlterTenantStatement() (*AlterTenantStatement, error) {
+	name, err := p.ParseIdent()
+	if err != nil {
+		return nil, err
+	}
+	stmt := &AlterTenantStatement{Name: name, Options: NewTenantOptions()}
+
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != WITH {
+		return nil, newParseError(tokstr(tok, lit), []string{"WITH"}, pos)
+	}
+	if err = p.parseTenantOptions(&stmt.Options); err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseTenantOptions parses the "<option> = <value>" list separated by commas.
+func (p *Parser) parseTenantOptions(opts *TenantOptions) error {
+	for {
+		name, err := p.ParseIdent()
+		if err != nil {
+			return err
+		}
+		if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != EQ {
+			return newParseError(tokstr(tok, lit), []string{"="}, pos)
+		}
+
+		var val interface{}
+		tok, pos, lit := p.ScanIgnoreWhitespace()
+		switch tok {
+		case INTEGER:
+			n, err := strconv.ParseInt(lit, 10, 64)
+			if err != nil {
+				return &ParseError{Message: err.Error(), Pos: pos}
+			}
+			val = n
+		case STRING:
+			val = lit
+		default:
+			return newParseError(tokstr(tok, lit), []string{"integer", "string"}, pos)
+		}
+		if err = opts.Set(name, val); err != nil {
+			return &ParseError{Message: err.Error(), Pos: pos}
+		}
+
+		if tok, _, _ = p.ScanIgnoreWhitespace(); tok != COMMA {
+			p.Unscan()
+			return nil
+		}
+	}
+}
+
+// parseBackfillContinuousQueryStatement parses a string and returns a BackfillContinuousQueryStatement.
+// This function assumes the "BACKFILL CONTINUOUS QUERY" tokens have already been consumed.
+func (p *Parser) parseBackfillContinuousQueryStatement() (*BackfillContinuousQueryStatement, error) {
+	stmt := &BackfillContinuousQueryStatement{}
+
+	// Read the name of the query to backfill.
+	ident, err := p.ParseIdent()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Name = ident
+
+	// Expect an "ON" keyword.
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != ON {
+		return nil, newParseError(tokstr(tok, lit), []string{"ON"}, pos)
+	}
+
+	// Read the name of the database the query belongs to.
+	if ident, err = p.ParseIdent(); err != nil {
+		return nil, err
+	}
+	stmt.Database = ident
+
+	// Read the time range "FROM <start> TO <end>".
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != FROM {
+		return nil, newParseError(tokstr(tok, lit), []string{"FROM"}, pos)
+	}
+	if stmt.StartTime, err = p.parseBackfillTime(); err != nil {
+		return nil, err
+	}
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != TO {
+		return nil, newParseError(tokstr(tok, lit), []string{"TO"}, pos)
+	}
+	if stmt.EndTime, err = p.parseBackfillTime(); err != nil {
+		return nil, err
+	}
+
+	return stmt, nil
+}
+
+func (p *Parser) parseBackfillTime() (time.Time, error) {
+	lit, err := p.parseString()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parseBackfillTime(lit)
+}
+
 // parseFields parses a list of one or more fields.
 func (p *Parser) parseFields() (Fields, error) {
 	var fields Fields
@@ -101,13 +101,14 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
     indexOption         *IndexOption
     databasePolicy      DatabasePolicy
     cmOption            *CreateMeasurementStatementOption
+    tenantOptions       *TenantOptions
 }
 
 %token <str>    FROM MEASUREMENT INTO ON SELECT WHERE AS GROUP BY ORDER LIMIT OFFSET SLIMIT SOFFSET SHOW CREATE FULL PRIVILEGES OUTER JOIN
                 TO IN NOT EXISTS REVOKE FILL DELETE WITH ENGINETYPE COLUMNSTORE TSSTORE ALL ANY PASSWORD NAME REPLICANUM ALTER USER USERS
                 DATABASES DATABASE MEASUREMENTS RETENTION POLICIES POLICY DURATION DEFAULT SHARD INDEX GRANT HOT WARM TYPE SET FOR GRANTS
                 REPLICATION SERIES DROP CASE WHEN THEN ELSE BEGIN END TRUE FALSE TAG ATTRIBUTE FIELD KEYS VALUES KEY EXPLAIN ANALYZE EXACT CARDINALITY SHARDKEY
-                PRIMARYKEY SORTKEY PROPERTY COMPACT
+                PRIMARYKEY SORTKEY PROPERTY COMPACT COMPACTIONS REPAIRS REBALANCE DECOMMISSION REPLICATIONS PROMOTE BACKFILL TENANT TENANTS
                 CONTINUOUS DIAGNOSTICS QUERIES QUERIE SHARDS STATS SUBSCRIPTIONS SUBSCRIPTION GROUPS INDEXTYPE INDEXLIST SEGMENT KILL
                 EVERY RESAMPLE
                 DOWNSAMPLE DOWNSAMPLES SAMPLEINTERVAL TIMEINTERVAL STREAM DELAY STREAMS
@@ -143,10 +144,16 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
                                     SHOW_GRANTS_FOR_USER_STATEMENT SHOW_MEASUREMENT_CARDINALITY_STATEMENT SHOW_SERIES_CARDINALITY_STATEMENT SHOW_SHARDS_STATEMENT
                                     ALTER_SHARD_KEY_STATEMENT SHOW_SHARD_GROUPS_STATEMENT DROP_MEASUREMENT_STATEMENT
                                     CREATE_CONTINUOUS_QUERY_STATEMENT SHOW_CONTINUOUS_QUERIES_STATEMENT DROP_CONTINUOUS_QUERY_STATEMENT
+                                    BACKFILL_CONTINUOUS_QUERY_STATEMENT
                                     CREATE_DOWNSAMPLE_STATEMENT DOWNSAMPLE_INTERVALS DROP_DOWNSAMPLE_STATEMENT SHOW_DOWNSAMPLE_STATEMENT
                                     CREATE_STREAM_STATEMENT SHOW_STREAM_STATEMENT DROP_STREAM_STATEMENT COLUMN_LISTS SHOW_MEASUREMENT_KEYS_STATEMENT
                                     SHOW_QUERIES_STATEMENT KILL_QUERY_STATEMENT SHOW_CONFIGS_STATEMENT SET_CONFIG_STATEMENT SHOW_CLUSTER_STATEMENT
+                                    SHOW_COMPACTIONS_STATEMENT SHOW_REPAIRS_STATEMENT SHOW_REBALANCE_STATEMENT SHOW_CARDINALITY_TOP_STATEMENT
+                                    DECOMMISSION_NODE_STATEMENT KILL_DECOMMISSION_STATEMENT SHOW_DECOMMISSION_STATEMENT
+                                    CREATE_REPLICATION_STATEMENT DROP_REPLICATION_STATEMENT SHOW_REPLICATIONS_STATEMENT PROMOTE_DATABASE_STATEMENT
                                     CREATE_SUBSCRIPTION_STATEMENT SHOW_SUBSCRIPTION_STATEMENT DROP_SUBSCRIPTION_STATEMENT
+                                    CREATE_TENANT_STATEMENT ALTER_TENANT_STATEMENT DROP_TENANT_STATEMENT SHOW_TENANTS_STATEMENT
+                                    GRANT_TENANT_STATEMENT REVOKE_TENANT_STATEMENT
 %type <fields>                      COLUMN_CLAUSES IDENTS
 %type <field>                       COLUMN_CLAUSE
 %type <stmts>                       ALL_QUERIES ALL_QUERY
@@ -179,6 +186,7 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 
 %type <databasePolicy>              DATABASE_POLICY
 %type <cmOption>                    CMOPTIONS_TS CMOPTIONS_CS
+%type <tenantOptions>               TENANT_OPTIONS TENANT_OPTION_LIST
 %type <str>                         CMOPTION_ENGINETYPE_TS CMOPTION_ENGINETYPE_CS
 
 %%
@@ -367,6 +375,10 @@ STATEMENT:
     	$$ = $1
     }
     |DROP_CONTINUOUS_QUERY_STATEMENT
+    {
+        $$ = $1
+    }
+    |BACKFILL_CONTINUOUS_QUERY_STATEMENT
     {
     	$$ = $1
     }
@@ -430,6 +442,74 @@ STATEMENT:
     {
     	$$ = $1
     }
+    |SHOW_COMPACTIONS_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SHOW_REPAIRS_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SHOW_REBALANCE_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SHOW_DECOMMISSION_STATEMENT
+    {
+    	$$ = $1
+    }
+    |DECOMMISSION_NODE_STATEMENT
+    {
+    	$$ = $1
+    }
+    |KILL_DECOMMISSION_STATEMENT
+    {
+    	$$ = $1
+    }
+    |CREATE_REPLICATION_STATEMENT
+    {
+    	$$ = $1
+    }
+    |DROP_REPLICATION_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SHOW_REPLICATIONS_STATEMENT
+    {
+    	$$ = $1
+    }
+    |PROMOTE_DATABASE_STATEMENT
+    {
+    	$$ = $1
+    }
+    |CREATE_TENANT_STATEMENT
+    {
+    	$$ = $1
+    }
+    |ALTER_TENANT_STATEMENT
+    {
+    	$$ = $1
+    }
+    |DROP_TENANT_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SHOW_TENANTS_STATEMENT
+    {
+    	$$ = $1
+    }
+    |GRANT_TENANT_STATEMENT
+    {
+    	$$ = $1
+    }
+    |REVOKE_TENANT_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SHOW_CARDINALITY_TOP_STATEMENT
+    {
+    	$$ = $1
+    }
 
 SELECT_STATEMENT:
     SELECT COLUMN_CLAUSES INTO_CLAUSE FROM_CLAUSE WHERE_CLAUSE GROUP_BY_CLAUSE EXCEPT_CLAUSE FILL_CLAUSE ORDER_CLAUSES OPTION_CLAUSES TIME_ZONE
@@ -2537,7 +2617,7 @@ CMOPTIONS_TS:
         option.EngineType = "tsstore"
         $$ = option
     }
-    | WITH CMOPTION_ENGINETYPE_TS CMOPTION_INDEXTYPE_TS CMOPTION_SHARDKEY CMOPTION_SHARDNUM TYPE_CLAUSE
+    | WITH CMOPTION_ENGINETYPE_TS CMOPTION_INDEXTYPE_TS CMOPTION_SHARDKEY CMOPTION_SHARDNUM TYPE_CLAUSE CMOPTION_PROPERTIES
     {
         option := &CreateMeasurementStatementOption{}
         if $3 != nil {
@@ -2550,6 +2630,9 @@ CMOPTIONS_TS:
         option.NumOfShards = $5
         option.Type = $6
         option.EngineType = $2
+        if $7 != nil {
+            option.Property = $7
+        }
         $$ = option
     }
 
@@ -2616,6 +2699,8 @@ CMOPTION_INDEXTYPE_CS:
         validIndexType := map[string]struct{}{}
         validIndexType["bloomfilter"] = struct{}{}
         validIndexType["minmax"] = struct{}{}
+        validIndexType["set"] = struct{}{}
+        validIndexType["ngram"] = struct{}{}
         validIndexType["text"] = struct{}{}
         if $2 == nil {
             $$ = nil
@@ -2643,6 +2728,8 @@ CMOPTION_INDEXTYPE_CS:
         validIndexType := map[string]struct{}{}
         validIndexType["bloomfilter"] = struct{}{}
         validIndexType["minmax"] = struct{}{}
+        validIndexType["set"] = struct{}{}
+        validIndexType["ngram"] = struct{}{}
         if $6 == nil {
             $$ = indextype
         } else {
@@ -2839,6 +2926,14 @@ INDEX_TYPE:
             lists: [][]string{$3},
         }
     }
+    |
+    SET INDEXLIST INDEX_LIST
+    {
+        $$ = &IndexType{
+            types: []string{"set"},
+            lists: [][]string{$3},
+        }
+    }
 
 INDEX_TYPES:
     INDEX_TYPE INDEX_TYPES
@@ -3185,6 +3280,21 @@ DROP_CONTINUOUS_QUERY_STATEMENT:
     	    Database: $6,
     	}
     }
+BACKFILL_CONTINUOUS_QUERY_STATEMENT:
+    BACKFILL CONTINUOUS QUERY IDENT ON IDENT FROM STRING TO STRING
+    {
+        stmt := &BackfillContinuousQueryStatement{
+            Name: $4,
+            Database: $6,
+        }
+        var err error
+        if stmt.StartTime, err = parseBackfillTime($8); err != nil {
+            yylex.Error(err.Error())
+        } else if stmt.EndTime, err = parseBackfillTime($10); err != nil {
+            yylex.Error(err.Error())
+        }
+        $$ = stmt
+    }
 CREATE_DOWNSAMPLE_STATEMENT:
     CREATE DOWNSAMPLE ON IDENT LPAREN COLUMN_CLAUSES RPAREN WITH DOWNSAMPLE_INTERVALS
     {
@@ -3334,12 +3444,189 @@ SHOW_QUERIES_STATEMENT:
     {
         $$ = &ShowQueriesStatement{}
     }
+SHOW_COMPACTIONS_STATEMENT:
+    SHOW COMPACTIONS
+    {
+        $$ = &ShowCompactionsStatement{}
+    }
+SHOW_REPAIRS_STATEMENT:
+    SHOW REPAIRS
+    {
+        $$ = &ShowRepairsStatement{}
+    }
+SHOW_REBALANCE_STATEMENT:
+    SHOW REBALANCE
+    {
+        $$ = &ShowRebalanceStatement{}
+    }
+SHOW_DECOMMISSION_STATEMENT:
+    SHOW DECOMMISSION
+    {
+        $$ = &ShowDecommissionStatement{}
+    }
+SHOW_CARDINALITY_TOP_STATEMENT:
+    SHOW CARDINALITY IDENT ON_DATABASE LIMIT_OFFSET_OPTION
+    {
+        if strings.ToUpper($3) != "TOP" {
+            yylex.Error("expected TOP after SHOW CARDINALITY")
+        }
+        stmt := &ShowCardinalityTopStatement{}
+        stmt.Database = $4
+        stmt.Limit = $5[0]
+        stmt.Offset = $5[1]
+        $$ = stmt
+    }
+    |SHOW CARDINALITY IDENT ON_DATABASE FROM_CLAUSE LIMIT_OFFSET_OPTION
+    {
+        if strings.ToUpper($3) != "TOP" {
+            yylex.Error("expected TOP after SHOW CARDINALITY")
+        }
+        stmt := &ShowCardinalityTopStatement{}
+        stmt.Database = $4
+        stmt.Sources = $5
+        stmt.Limit = $6[0]
+        stmt.Offset = $6[1]
+        $$ = stmt
+    }
 KILL_QUERY_STATEMENT:
     KILL QUERY INTEGER
     {
         $$ = &KillQueryStatement{QueryID: uint64($3)}
     }
 
+DECOMMISSION_NODE_STATEMENT:
+    DECOMMISSION IDENT INTEGER
+    {
+        if strings.ToUpper($2) != "NODE" {
+            yylex.Error("expected NODE after DECOMMISSION")
+        }
+        $$ = &DecommissionNodeStatement{NodeID: uint64($3)}
+    }
+
+KILL_DECOMMISSION_STATEMENT:
+    KILL DECOMMISSION IDENT INTEGER
+    {
+        if strings.ToUpper($3) != "NODE" {
+            yylex.Error("expected NODE after KILL DECOMMISSION")
+        }
+        $$ = &KillDecommissionStatement{NodeID: uint64($4)}
+    }
+
+CREATE_REPLICATION_STATEMENT:
+    CREATE REPLICATION ON STRING_TYPE TO STRING_TYPE
+    {
+        $$ = &CreateReplicationStatement{Database: $4, Target: $6}
+    }
+    |CREATE REPLICATION ON STRING_TYPE FROM STRING_TYPE
+    {
+        $$ = &CreateReplicationStatement{Database: $4, Target: $6, Standby: true}
+    }
+
+DROP_REPLICATION_STATEMENT:
+    DROP REPLICATION ON STRING_TYPE
+    {
+        $$ = &DropReplicationStatement{Database: $4}
+    }
+
+SHOW_REPLICATIONS_STATEMENT:
+    SHOW REPLICATIONS
+    {
+        $$ = &ShowReplicationsStatement{}
+    }
+
+PROMOTE_DATABASE_STATEMENT:
+    PROMOTE DATABASE STRING_TYPE
+    {
+        $$ = &PromoteDatabaseStatement{Database: $3}
+    }
+
+CREATE_TENANT_STATEMENT:
+    CREATE TENANT IDENT TENANT_OPTIONS
+    {
+        $$ = &CreateTenantStatement{Name: $3, Options: *$4}
+    }
+
+ALTER_TENANT_STATEMENT:
+    ALTER TENANT IDENT WITH TENANT_OPTION_LIST
+    {
+        $$ = &AlterTenantStatement{Name: $3, Options: *$5}
+    }
+
+DROP_TENANT_STATEMENT:
+    DROP TENANT IDENT
+    {
+        $$ = &DropTenantStatement{Name: $3}
+    }
+
+SHOW_TENANTS_STATEMENT:
+    SHOW TENANTS
+    {
+        $$ = &ShowTenantsStatement{}
+    }
+
+GRANT_TENANT_STATEMENT:
+    GRANT DATABASE IDENT TO TENANT IDENT
+    {
+        $$ = &GrantTenantStatement{Tenant: $6, Database: $3}
+    }
+    |GRANT USER IDENT TO TENANT IDENT
+    {
+        $$ = &GrantTenantStatement{Tenant: $6, User: $3}
+    }
+
+REVOKE_TENANT_STATEMENT:
+    REVOKE DATABASE IDENT FROM TENANT IDENT
+    {
+        $$ = &RevokeTenantStatement{Tenant: $6, Database: $3}
+    }
+    |REVOKE USER IDENT FROM TENANT IDENT
+    {
+        $$ = &RevokeTenantStatement{Tenant: $6, User: $3}
+    }
+
+TENANT_OPTIONS:
+    WITH TENANT_OPTION_LIST
+    {
+        $$ = $2
+    }
+    |
+    {
+        opts := NewTenantOptions()
+        $$ = &opts
+    }
+
+TENANT_OPTION_LIST:
+    IDENT EQ INTEGER
+    {
+        opts := NewTenantOptions()
+        if err := opts.Set($1, $3); err != nil {
+            yylex.Error(err.Error())
+        }
+        $$ = &opts
+    }
+    |IDENT EQ STRING
+    {
+        opts := NewTenantOptions()
+        if err := opts.Set($1, $3); err != nil {
+            yylex.Error(err.Error())
+        }
+        $$ = &opts
+    }
+    |TENANT_OPTION_LIST COMMA IDENT EQ INTEGER
+    {
+        if err := $1.Set($3, $5); err != nil {
+            yylex.Error(err.Error())
+        }
+        $$ = $1
+    }
+    |TENANT_OPTION_LIST COMMA IDENT EQ STRING
+    {
+        if err := $1.Set($3, $5); err != nil {
+            yylex.Error(err.Error())
+        }
+        $$ = $1
+    }
+
 ALL_DESTINATION:
     STRING_TYPE
     {
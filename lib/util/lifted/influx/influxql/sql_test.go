@@ -18,8 +18,10 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/require"
 )
 
 var cases []string
@@ -180,6 +182,45 @@ func init() {
 		"SHOW CLUSTER WHERE nodeType = data",
 		"SHOW CLUSTER WHERE nodeType = data AND nodeID = 2",
 		"SHOW CLUSTER WHERE nodeID = 2 AND nodeType = \"data\"",
+
+		// show compactions
+		"SHOW COMPACTIONS",
+
+		// show repairs
+		"SHOW REPAIRS",
+
+		// show rebalance
+		"SHOW REBALANCE",
+
+		// decommission node
+		"DECOMMISSION NODE 3",
+		"KILL DECOMMISSION NODE 3",
+		"SHOW DECOMMISSION",
+
+		// asynchronous replication
+		"CREATE REPLICATION ON db0 TO 'http://127.0.0.1:8086'",
+		"CREATE REPLICATION ON db0 FROM 'http://127.0.0.1:8086'",
+		"DROP REPLICATION ON db0",
+		"SHOW REPLICATIONS",
+		"PROMOTE DATABASE db0",
+
+		// tenant
+		"CREATE TENANT t0",
+		"CREATE TENANT t0 WITH max_series = 100000, max_query_concurrency = 8, nodes = '1,2'",
+		"ALTER TENANT t0 WITH max_ingest_rate = 5000",
+		"DROP TENANT t0",
+		"SHOW TENANTS",
+		"GRANT DATABASE db0 TO TENANT t0",
+		"REVOKE USER u0 FROM TENANT t0",
+
+		// backfill continuous query
+		"BACKFILL CONTINUOUS QUERY cq0 ON db0 FROM '2024-01-01T00:00:00Z' TO '2024-01-02T00:00:00Z'",
+		"BACKFILL CONTINUOUS QUERY cq0 ON db0 FROM '2024-01-01' TO '2024-01-02 12:00:00'",
+
+		// show cardinality top
+		"SHOW CARDINALITY TOP",
+		"SHOW CARDINALITY TOP ON db0 FROM cpu, mem LIMIT 5",
+		"SHOW CARDINALITY TOP FROM /cpu.*/ LIMIT 10 OFFSET 2",
 	}
 
 	benchCases = []string{
@@ -352,6 +393,10 @@ func TestSingleParser(t *testing.T) {
 		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore indextype bloomfilter indexlist tag1 compact row",
 		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore indextype timecluster(1m) minmax indexlist field1",
 		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore indextype timecluster(1m) bloomfilter indexlist field1 minmax INDEXLIST field1",
+		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore indextype set indexlist tag1",
+		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore indextype timecluster(1m) set indexlist tag1 minmax INDEXLIST field1",
+		"create measurement db0.rp0.mst0 (tag1 tag, field1 string field) with ENGINETYPE = columnstore indextype ngram indexlist field1",
+		"create measurement db0.rp0.mst0 (tag1 tag, field1 string field) with ENGINETYPE = columnstore indextype timecluster(1m) ngram indexlist field1",
 		"create measurement mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore SHARDKEY tag1 SHARDS AUTO type hash",
 		"create measurement mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore SHARDKEY tag1 SHARDS 10 type hash",
 		"create measurement mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = tsstore SHARDKEY tag1 SHARDS 10 type hash",
@@ -388,6 +433,8 @@ func TestSingleParserError(t *testing.T) {
 		"show sortkey1 from mst",
 		"show index from mst",
 		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = tsstore indextype bloomfilter indexlist tag1",
+		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = tsstore indextype set indexlist tag1",
+		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore indextype set indexlist tag11",
 		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = tsstore indextype field1 indexlist tag1",
 		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore indextype field indexlist tag1",
 		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore indextype text indexlist tag1",
@@ -425,6 +472,8 @@ func TestSingleParserError(t *testing.T) {
 		"syntax error: unexpected INDEX",
 		"Invalid index type for TSSTORE",
 		"Invalid index type for TSSTORE",
+		"Invalid indexlist",
+		"Invalid index type for TSSTORE",
 		"Invalid index type for COLUMNSTORE",
 		"Invalid index type for COLUMNSTORE",
 		"Invalid indexlist",
@@ -477,3 +526,126 @@ func BenchmarkPreviousParser(b *testing.B) {
 		}
 	}
 }
+
+func TestShowCardinalityTopStatement(t *testing.T) {
+	parse := func(sql string) (influxql.Statement, error) {
+		p := &influxql.YyParser{Query: influxql.Query{}}
+		p.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		p.ParseTokens()
+		q, err := p.GetQuery()
+		if err != nil {
+			return nil, err
+		}
+		return q.Statements[0], nil
+	}
+
+	stmt, err := parse("SHOW CARDINALITY top ON db0 FROM cpu LIMIT 5 OFFSET 1")
+	require.NoError(t, err)
+	top, ok := stmt.(*influxql.ShowCardinalityTopStatement)
+	require.True(t, ok)
+	require.Equal(t, "db0", top.Database)
+	require.Equal(t, 5, top.Limit)
+	require.Equal(t, 1, top.Offset)
+	require.Equal(t, "SHOW CARDINALITY TOP ON db0 FROM cpu LIMIT 5 OFFSET 1", top.String())
+
+	_, err = parse("SHOW CARDINALITY bottom")
+	require.Error(t, err)
+}
+
+func TestBackfillContinuousQueryStatement(t *testing.T) {
+	parse := func(sql string) (influxql.Statement, error) {
+		p := &influxql.YyParser{Query: influxql.Query{}}
+		p.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		p.ParseTokens()
+		q, err := p.GetQuery()
+		if err != nil {
+			return nil, err
+		}
+		return q.Statements[0], nil
+	}
+
+	sql := "BACKFILL CONTINUOUS QUERY cq0 ON db0 FROM '2024-01-01' TO '2024-01-02T12:00:00Z'"
+	stmt, err := parse(sql)
+	require.NoError(t, err)
+	backfill, ok := stmt.(*influxql.BackfillContinuousQueryStatement)
+	require.True(t, ok)
+	require.Equal(t, "cq0", backfill.Name)
+	require.Equal(t, "db0", backfill.Database)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), backfill.StartTime)
+	require.Equal(t, time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC), backfill.EndTime)
+	require.Equal(t, "BACKFILL CONTINUOUS QUERY cq0 ON db0 FROM '2024-01-01T00:00:00Z' TO '2024-01-02T12:00:00Z'", backfill.String())
+
+	// the hand-written parser gets the same statement
+	q, err := influxql.NewParser(strings.NewReader(sql)).ParseQuery()
+	require.NoError(t, err)
+	require.Equal(t, backfill, q.Statements[0])
+
+	_, err = parse("BACKFILL CONTINUOUS QUERY cq0 ON db0 FROM 'yesterday' TO '2024-01-02'")
+	require.EqualError(t, err, "invalid backfill time 'yesterday': invalid timestamp string")
+
+	_, err = parse("BACKFILL CONTINUOUS QUERY cq0 ON db0 FROM '2024-01-01'")
+	require.Error(t, err)
+}
+
+func TestTenantStatements(t *testing.T) {
+	parse := func(sql string) (influxql.Statement, error) {
+		p := &influxql.YyParser{Query: influxql.Query{}}
+		p.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		p.ParseTokens()
+		q, err := p.GetQuery()
+		if err != nil {
+			return nil, err
+		}
+		return q.Statements[0], nil
+	}
+
+	for _, tt := range []struct {
+		sql  string
+		want influxql.Statement
+	}{
+		{
+			sql: "CREATE TENANT t0",
+			want: &influxql.CreateTenantStatement{Name: "t0", Options: influxql.TenantOptions{
+				MaxSeries: -1, MaxStorageBytes: -1, MaxIngestRate: -1, MaxQueryConcurrency: -1}},
+		},
+		{
+			sql: "CREATE TENANT t0 WITH max_series = 100, max_storage_bytes = 2048, max_ingest_rate = 10, max_query_concurrency = 2, nodes = '2, 1'",
+			want: &influxql.CreateTenantStatement{Name: "t0", Options: influxql.TenantOptions{
+				MaxSeries: 100, MaxStorageBytes: 2048, MaxIngestRate: 10, MaxQueryConcurrency: 2, Nodes: []uint64{2, 1}, SetNodes: true}},
+		},
+		{
+			sql: "ALTER TENANT t0 WITH max_series = 0, nodes = ''",
+			want: &influxql.AlterTenantStatement{Name: "t0", Options: influxql.TenantOptions{
+				MaxSeries: 0, MaxStorageBytes: -1, MaxIngestRate: -1, MaxQueryConcurrency: -1, SetNodes: true}},
+		},
+		{sql: "DROP TENANT t0", want: &influxql.DropTenantStatement{Name: "t0"}},
+		{sql: "SHOW TENANTS", want: &influxql.ShowTenantsStatement{}},
+		{sql: "GRANT DATABASE db0 TO TENANT t0", want: &influxql.GrantTenantStatement{Tenant: "t0", Database: "db0"}},
+		{sql: "GRANT USER u0 TO TENANT t0", want: &influxql.GrantTenantStatement{Tenant: "t0", User: "u0"}},
+		{sql: "REVOKE DATABASE db0 FROM TENANT t0", want: &influxql.RevokeTenantStatement{Tenant: "t0", Database: "db0"}},
+		{sql: "REVOKE USER u0 FROM TENANT t0", want: &influxql.RevokeTenantStatement{Tenant: "t0", User: "u0"}},
+	} {
+		stmt, err := parse(tt.sql)
+		require.NoError(t, err, tt.sql)
+		require.Equal(t, tt.want, stmt, tt.sql)
+
+		// the hand-written parser gets the same statement
+		q, err := influxql.NewParser(strings.NewReader(tt.sql)).ParseQuery()
+		require.NoError(t, err, tt.sql)
+		require.Equal(t, tt.want, q.Statements[0], tt.sql)
+
+		// the string representation is parsed to the same statement
+		stmt, err = parse(stmt.String())
+		require.NoError(t, err, tt.sql)
+		require.Equal(t, tt.want, stmt, tt.sql)
+	}
+
+	_, err := parse("CREATE TENANT t0 WITH max_cpu = 1")
+	require.EqualError(t, err, "unknown tenant option max_cpu")
+	_, err = parse("CREATE TENANT t0 WITH nodes = 1")
+	require.EqualError(t, err, "tenant option nodes must be a string")
+	_, err = parse("CREATE TENANT t0 WITH nodes = 'a'")
+	require.EqualError(t, err, "invalid tenant node 'a'")
+	_, err = parse("ALTER TENANT t0")
+	require.Error(t, err)
+}
@@ -337,6 +337,15 @@ var tokens = [...]string{
 	REPLICAS:       "REPLICAS",
 	DETAIL:         "DETAIL",
 	COMPACT:        "COMPACT",
+	COMPACTIONS:    "COMPACTIONS",
+	REPAIRS:        "REPAIRS",
+	REBALANCE:      "REBALANCE",
+	DECOMMISSION:   "DECOMMISSION",
+	REPLICATIONS:   "REPLICATIONS",
+	PROMOTE:        "PROMOTE",
+	BACKFILL:       "BACKFILL",
+	TENANT:         "TENANT",
+	TENANTS:        "TENANTS",
 	AUTO:           "AUTO",
 	EXCEPT:         "EXCEPT",
 }
@@ -106,6 +106,7 @@ type yySymType struct {
 	indexOption      *IndexOption
 	databasePolicy   DatabasePolicy
 	cmOption         *CreateMeasurementStatementOption
+	tenantOptions    *TenantOptions
 }
 
 const FROM = 57346
@@ -190,80 +191,89 @@ const PRIMARYKEY = 57424
 const SORTKEY = 57425
 const PROPERTY = 57426
 const COMPACT = 57427
-const CONTINUOUS = 57428
-const DIAGNOSTICS = 57429
-const QUERIES = 57430
-const QUERIE = 57431
-const SHARDS = 57432
-const STATS = 57433
-const SUBSCRIPTIONS = 57434
-const SUBSCRIPTION = 57435
-const GROUPS = 57436
-const INDEXTYPE = 57437
-const INDEXLIST = 57438
-const SEGMENT = 57439
-const KILL = 57440
-const EVERY = 57441
-const RESAMPLE = 57442
-const DOWNSAMPLE = 57443
-const DOWNSAMPLES = 57444
-const SAMPLEINTERVAL = 57445
-const TIMEINTERVAL = 57446
-const STREAM = 57447
-const DELAY = 57448
-const STREAMS = 57449
-const QUERY = 57450
-const PARTITION = 57451
-const TOKEN = 57452
-const TOKENIZERS = 57453
-const MATCH = 57454
-const LIKE = 57455
-const MATCHPHRASE = 57456
-const CONFIG = 57457
-const CONFIGS = 57458
-const CLUSTER = 57459
-const REPLICAS = 57460
-const DETAIL = 57461
-const DESTINATIONS = 57462
-const SCHEMA = 57463
-const INDEXES = 57464
-const AUTO = 57465
-const EXCEPT = 57466
-const DESC = 57467
-const ASC = 57468
-const COMMA = 57469
-const SEMICOLON = 57470
-const LPAREN = 57471
-const RPAREN = 57472
-const REGEX = 57473
-const EQ = 57474
-const NEQ = 57475
-const LT = 57476
-const LTE = 57477
-const GT = 57478
-const GTE = 57479
-const DOT = 57480
-const DOUBLECOLON = 57481
-const NEQREGEX = 57482
-const EQREGEX = 57483
-const IDENT = 57484
-const INTEGER = 57485
-const DURATIONVAL = 57486
-const STRING = 57487
-const NUMBER = 57488
-const HINT = 57489
-const BOUNDPARAM = 57490
-const AND = 57491
-const OR = 57492
-const ADD = 57493
-const SUB = 57494
-const BITWISE_OR = 57495
-const BITWISE_XOR = 57496
-const MUL = 57497
-const DIV = 57498
-const MOD = 57499
-const BITWISE_AND = 57500
-const UMINUS = 57501
+const COMPACTIONS = 57428
+const REPAIRS = 57429
+const REBALANCE = 57430
+const DECOMMISSION = 57431
+const REPLICATIONS = 57432
+const PROMOTE = 57433
+const BACKFILL = 57434
+const TENANT = 57435
+const TENANTS = 57436
+const CONTINUOUS = 57437
+const DIAGNOSTICS = 57438
+const QUERIES = 57439
+const QUERIE = 57440
+const SHARDS = 57441
+const STATS = 57442
+const SUBSCRIPTIONS = 57443
+const SUBSCRIPTION = 57444
+const GROUPS = 57445
+const INDEXTYPE = 57446
+const INDEXLIST = 57447
+const SEGMENT = 57448
+const KILL = 57449
+const EVERY = 57450
+const RESAMPLE = 57451
+const DOWNSAMPLE = 57452
+const DOWNSAMPLES = 57453
+const SAMPLEINTERVAL = 57454
+const TIMEINTERVAL = 57455
+const STREAM = 57456
+const DELAY = 57457
+const STREAMS = 57458
+const QUERY = 57459
+const PARTITION = 57460
+const TOKEN = 57461
+const TOKENIZERS = 57462
+const MATCH = 57463
+const LIKE = 57464
+const MATCHPHRASE = 57465
+const CONFIG = 57466
+const CONFIGS = 57467
+const CLUSTER = 57468
+const REPLICAS = 57469
+const DETAIL = 57470
+const DESTINATIONS = 57471
+const SCHEMA = 57472
+const INDEXES = 57473
+const AUTO = 57474
+const EXCEPT = 57475
+const DESC = 57476
+const ASC = 57477
+const COMMA = 57478
+const SEMICOLON = 57479
+const LPAREN = 57480
+const RPAREN = 57481
+const REGEX = 57482
+const EQ = 57483
+const NEQ = 57484
+const LT = 57485
+const LTE = 57486
+const GT = 57487
+const GTE = 57488
+const DOT = 57489
+const DOUBLECOLON = 57490
+const NEQREGEX = 57491
+const EQREGEX = 57492
+const IDENT = 57493
+const INTEGER = 57494
+const DURATIONVAL = 57495
+const STRING = 57496
+const NUMBER = 57497
+const HINT = 57498
+const BOUNDPARAM = 57499
+const AND = 57500
+const OR = 57501
+const ADD = 57502
+const SUB = 57503
+const BITWISE_OR = 57504
+const BITWISE_XOR = 57505
+const MUL = 57506
+const DIV = 57507
+const MOD = 57508
+const BITWISE_AND = 57509
+const UMINUS = 57510
 
 var yyToknames = [...]string{
 	"$end",
@@ -351,6 +361,15 @@ var yyToknames = [...]string{
 	"SORTKEY",
 	"PROPERTY",
 	"COMPACT",
+	"COMPACTIONS",
+	"REPAIRS",
+	"REBALANCE",
+	"DECOMMISSION",
+	"REPLICATIONS",
+	"PROMOTE",
+	"BACKFILL",
+	"TENANT",
+	"TENANTS",
 	"CONTINUOUS",
 	"DIAGNOSTICS",
 	"QUERIES",
@@ -433,313 +452,342 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line sql.y:3505
+//line sql.y:3792
 
 //line yacctab:1
 var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 71,
-	4, 93,
-	-2, 140,
-	-1, 473,
-	113, 157,
-	132, 157,
-	133, 157,
-	134, 157,
-	135, 157,
-	136, 157,
-	137, 157,
-	140, 157,
-	141, 157,
-	-2, 146,
+	-1, 92,
+	4, 111,
+	-2, 158,
+	-1, 548,
+	122, 175,
+	141, 175,
+	142, 175,
+	143, 175,
+	144, 175,
+	145, 175,
+	146, 175,
+	149, 175,
+	150, 175,
+	-2, 164,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 1169
+const yyLast = 1286
 
 var yyAct = [...]int16{
-	499, 906, 932, 514, 876, 778, 696, 426, 805, 897,
-	717, 264, 395, 513, 795, 746, 649, 700, 710, 837,
-	4, 555, 633, 637, 495, 723, 776, 237, 556, 386,
-	75, 424, 139, 207, 508, 445, 497, 324, 247, 233,
-	2, 235, 71, 174, 281, 231, 154, 321, 724, 725,
-	81, 856, 726, 676, 138, 888, 85, 86, 727, 857,
-	505, 179, 351, 352, 675, 163, 164, 168, 165, 161,
-	162, 166, 167, 163, 164, 168, 165, 161, 162, 166,
-	167, 161, 162, 166, 167, 634, 89, 907, 149, 393,
-	635, 610, 715, 574, 473, 214, 942, 500, 215, 567,
-	215, 904, 614, 615, 155, 890, 872, 169, 89, 173,
-	501, 351, 352, 351, 352, 157, 76, 283, 89, 578,
-	214, 271, 208, 215, 272, 213, 216, 880, 652, 77,
-	83, 80, 84, 82, 847, 88, 227, 160, 229, 78,
-	846, 793, 74, 163, 164, 168, 165, 161, 162, 166,
-	167, 792, 236, 773, 89, 183, 730, 681, 680, 679,
-	219, 206, 678, 204, 214, 205, 59, 215, 208, 259,
-	89, 230, 248, 351, 352, 214, 612, 450, 215, 613,
-	551, 449, 218, 870, 208, 250, 874, 548, 549, 781,
-	859, 735, 273, 274, 275, 276, 277, 278, 279, 280,
-	318, 268, 266, 89, 267, 292, 875, 734, 248, 263,
-	206, 563, 294, 282, 205, 59, 299, 208, 554, 290,
-	291, 81, 509, 510, 286, 781, 287, 85, 86, 565,
-	512, 511, 552, 177, 437, 650, 651, 316, 298, 355,
-	356, 262, 295, 654, 653, 222, 536, 301, 302, 303,
-	535, 337, 310, 414, 936, 334, 315, 413, 780, 309,
-	335, 877, 384, 308, 146, 144, 806, 354, 871, 938,
-	353, 748, 711, 557, 639, 803, 350, 349, 163, 164,
-	168, 165, 161, 162, 166, 167, 770, 76, 769, 89,
-	761, 720, 719, 706, 784, 285, 385, 665, 664, 627,
-	77, 83, 80, 84, 82, 370, 88, 626, 609, 607,
-	78, 175, 400, 74, 606, 604, 602, 589, 588, 587,
-	391, 564, 389, 416, 362, 363, 364, 365, 366, 367,
-	448, 399, 369, 368, 403, 405, 582, 458, 580, 566,
-	553, 538, 506, 170, 463, 464, 490, 489, 421, 711,
-	486, 485, 172, 171, 466, 460, 402, 404, 406, 398,
-	478, 479, 401, 383, 451, 415, 382, 409, 423, 411,
-	420, 147, 145, 381, 418, 378, 419, 377, 476, 471,
-	472, 376, 248, 248, 373, 371, 342, 341, 340, 338,
-	333, 465, 248, 467, 332, 331, 326, 494, 319, 317,
-	480, 313, 296, 288, 520, 261, 223, 221, 217, 203,
-	201, 622, 519, 620, 586, 524, 159, 170, 526, 663,
-	540, 454, 504, 590, 507, 503, 172, 171, 539, 576,
-	455, 537, 585, 547, 522, 523, 462, 525, 452, 412,
-	339, 330, 833, 832, 534, 689, 493, 448, 492, 575,
-	422, 543, 545, 546, 89, 810, 521, 550, 809, 572,
-	943, 87, 573, 70, 530, 469, 533, 921, 909, 908,
-	529, 903, 532, 542, 544, 562, 889, 863, 584, 541,
-	849, 807, 571, 802, 577, 841, 579, 801, 799, 798,
-	712, 708, 707, 694, 611, 595, 597, 581, 598, 470,
-	456, 390, 211, 935, 884, 603, 855, 750, 617, 601,
-	695, 621, 353, 618, 592, 596, 623, 594, 844, 477,
-	474, 360, 359, 641, 616, 357, 329, 718, 645, 346,
-	70, 937, 348, 922, 643, 644, 899, 677, 646, 636,
-	647, 625, 852, 666, 819, 800, 662, 738, 739, 178,
-	59, 674, 737, 640, 642, 670, 619, 672, 673, 600,
-	60, 61, 599, 591, 158, 660, 661, 387, 794, 325,
-	66, 322, 63, 181, 668, 669, 438, 671, 774, 698,
-	224, 150, 64, 209, 655, 210, 699, 659, 152, 928,
-	850, 703, 789, 693, 688, 65, 667, 842, 677, 68,
-	713, 714, 209, 841, 62, 209, 686, 196, 228, 197,
-	691, 325, 838, 931, 926, 918, 323, 709, 209, 67,
-	902, 59, 777, 181, 212, 181, 483, 311, 312, 306,
-	307, 722, 417, 788, 716, 410, 81, 704, 733, 347,
-	69, 721, 85, 86, 193, 194, 345, 741, 742, 408,
-	314, 300, 775, 732, 740, 821, 728, 209, 323, 151,
-	743, 186, 187, 188, 755, 744, 760, 749, 190, 754,
-	191, 236, 758, 759, 765, 756, 767, 768, 658, 648,
-	763, 764, 745, 766, 528, 180, 269, 690, 270, 439,
-	731, 148, 757, 729, 783, 304, 305, 184, 185, 325,
-	762, 796, 76, 881, 89, 3, 771, 624, 787, 392,
-	289, 177, 834, 882, 782, 77, 83, 80, 84, 82,
-	72, 88, 260, 433, 436, 78, 434, 435, 74, 192,
-	718, 772, 697, 797, 804, 683, 248, 561, 791, 560,
-	559, 558, 249, 220, 816, 131, 202, 812, 182, 143,
-	701, 702, 140, 808, 441, 786, 785, 811, 818, 814,
-	570, 815, 826, 827, 883, 141, 820, 829, 830, 825,
-	831, 822, 823, 140, 828, 136, 153, 817, 140, 656,
-	790, 129, 753, 684, 126, 840, 128, 293, 657, 824,
-	142, 130, 209, 583, 527, 444, 397, 848, 372, 839,
-	531, 127, 843, 327, 496, 407, 358, 209, 845, 209,
-	475, 251, 851, 605, 374, 487, 484, 468, 854, 853,
-	257, 836, 861, 255, 858, 252, 132, 835, 253, 868,
-	860, 375, 869, 137, 813, 862, 867, 256, 864, 631,
-	632, 133, 134, 515, 516, 135, 736, 878, 873, 502,
-	502, 140, 796, 796, 879, 865, 866, 396, 517, 265,
-	396, 388, 593, 887, 892, 140, 885, 886, 81, 141,
-	141, 896, 891, 156, 85, 86, 141, 894, 895, 200,
-	898, 59, 380, 705, 181, 379, 482, 461, 459, 457,
-	905, 453, 440, 344, 343, 336, 297, 893, 912, 913,
-	910, 258, 254, 226, 915, 911, 898, 919, 914, 920,
-	225, 199, 209, 198, 209, 923, 156, 518, 394, 608,
-	491, 488, 140, 927, 929, 99, 195, 934, 121, 569,
-	209, 189, 568, 443, 76, 442, 89, 939, 934, 941,
-	940, 447, 446, 692, 687, 685, 779, 77, 83, 80,
-	84, 82, 113, 88, 924, 925, 933, 78, 916, 900,
-	74, 917, 94, 90, 120, 91, 92, 118, 901, 119,
-	930, 101, 96, 628, 629, 747, 425, 242, 241, 98,
-	630, 93, 498, 638, 284, 361, 176, 79, 81, 246,
-	245, 95, 238, 97, 85, 86, 232, 234, 1, 73,
-	55, 112, 109, 110, 111, 116, 102, 54, 105, 122,
-	100, 53, 106, 58, 81, 57, 125, 56, 429, 430,
-	85, 86, 103, 52, 123, 51, 50, 104, 124, 427,
-	431, 433, 436, 328, 434, 435, 107, 108, 209, 81,
-	428, 114, 115, 49, 48, 85, 86, 47, 46, 45,
-	44, 43, 42, 209, 76, 41, 89, 40, 39, 38,
-	37, 432, 117, 243, 36, 244, 35, 77, 83, 80,
-	84, 82, 34, 88, 33, 32, 31, 78, 59, 30,
-	239, 502, 89, 29, 28, 27, 26, 25, 60, 61,
-	24, 21, 20, 240, 83, 80, 84, 82, 66, 88,
-	63, 22, 19, 78, 23, 481, 18, 89, 17, 16,
-	64, 14, 15, 13, 12, 751, 752, 682, 77, 83,
-	80, 84, 82, 65, 88, 7, 11, 68, 78, 10,
-	9, 8, 62, 320, 6, 5, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 67, 0, 0,
+	580, 1011, 595, 1020, 976, 1001, 872, 497, 786, 999,
+	319, 902, 594, 891, 810, 738, 803, 793, 171, 4,
+	933, 639, 726, 576, 817, 839, 283, 870, 96, 640,
+	628, 495, 249, 453, 589, 516, 578, 380, 531, 293,
+	92, 279, 2, 195, 215, 377, 281, 336, 277, 204,
+	205, 209, 206, 202, 203, 207, 208, 460, 411, 412,
+	462, 766, 220, 202, 203, 207, 208, 110, 204, 205,
+	209, 206, 202, 203, 207, 208, 818, 819, 581, 956,
+	820, 257, 808, 282, 629, 110, 821, 957, 548, 630,
+	990, 582, 248, 765, 430, 110, 247, 256, 1040, 250,
+	257, 411, 412, 120, 988, 186, 662, 411, 412, 250,
+	948, 196, 949, 422, 423, 424, 425, 426, 427, 900,
+	256, 429, 428, 257, 658, 790, 210, 791, 214, 326,
+	141, 110, 327, 702, 198, 706, 707, 651, 521, 1012,
+	115, 111, 520, 112, 113, 250, 1009, 110, 992, 122,
+	411, 412, 741, 255, 248, 981, 260, 119, 247, 114,
+	972, 250, 944, 943, 888, 201, 271, 887, 867, 116,
+	275, 118, 824, 771, 770, 769, 263, 224, 131, 140,
+	137, 138, 139, 144, 127, 128, 129, 130, 132, 276,
+	768, 635, 133, 123, 317, 126, 246, 121, 970, 134,
+	632, 633, 294, 77, 256, 310, 974, 257, 959, 124,
+	181, 876, 829, 341, 125, 342, 184, 256, 704, 183,
+	257, 705, 296, 135, 136, 828, 975, 875, 142, 143,
+	649, 328, 329, 330, 331, 332, 333, 334, 335, 349,
+	323, 373, 322, 354, 77, 347, 321, 294, 647, 145,
+	876, 638, 337, 636, 177, 508, 451, 316, 102, 392,
+	180, 345, 346, 179, 106, 107, 875, 314, 739, 740,
+	586, 590, 591, 402, 266, 1044, 743, 742, 615, 593,
+	592, 396, 614, 371, 350, 415, 416, 218, 481, 356,
+	357, 358, 480, 340, 365, 390, 364, 977, 370, 391,
+	363, 903, 971, 102, 374, 874, 715, 841, 804, 106,
+	107, 641, 414, 449, 413, 204, 205, 209, 206, 202,
+	203, 207, 208, 728, 410, 409, 182, 899, 864, 863,
+	854, 648, 813, 97, 812, 110, 799, 452, 789, 754,
+	753, 720, 719, 708, 879, 701, 98, 104, 101, 105,
+	103, 700, 109, 713, 102, 699, 99, 697, 696, 95,
+	106, 107, 204, 205, 209, 206, 202, 203, 207, 208,
+	178, 695, 466, 458, 216, 470, 472, 489, 97, 694,
+	110, 692, 690, 677, 676, 675, 519, 804, 666, 488,
+	664, 98, 104, 101, 105, 103, 533, 109, 211, 650,
+	637, 99, 617, 587, 538, 539, 568, 213, 212, 567,
+	562, 752, 561, 532, 541, 467, 535, 465, 468, 522,
+	553, 554, 494, 476, 450, 478, 483, 448, 445, 97,
+	485, 110, 486, 444, 441, 438, 437, 551, 546, 547,
+	294, 294, 98, 104, 101, 105, 103, 93, 109, 434,
+	294, 431, 99, 401, 540, 95, 542, 400, 399, 397,
+	389, 555, 388, 575, 387, 382, 375, 372, 368, 351,
+	599, 343, 559, 315, 312, 585, 309, 308, 303, 302,
+	297, 603, 273, 267, 265, 261, 619, 601, 602, 588,
+	604, 259, 584, 627, 245, 243, 241, 613, 192, 626,
+	211, 674, 525, 200, 622, 624, 625, 678, 660, 213,
+	212, 526, 616, 537, 523, 170, 479, 398, 519, 673,
+	659, 386, 1033, 631, 929, 928, 889, 598, 634, 779,
+	669, 670, 672, 605, 573, 572, 493, 110, 608, 907,
+	611, 108, 906, 618, 1046, 1029, 646, 620, 668, 656,
+	1014, 1013, 657, 655, 661, 91, 663, 544, 1008, 991,
+	963, 946, 937, 904, 898, 897, 665, 895, 894, 683,
+	102, 805, 686, 801, 703, 800, 106, 107, 784, 685,
+	691, 545, 527, 689, 457, 413, 1043, 985, 955, 253,
+	682, 843, 680, 785, 714, 941, 716, 711, 684, 552,
+	549, 730, 420, 419, 417, 385, 734, 811, 718, 709,
+	408, 406, 732, 733, 91, 1032, 1030, 1004, 736, 731,
+	767, 755, 951, 915, 751, 896, 831, 832, 454, 763,
+	749, 750, 729, 759, 830, 761, 762, 710, 712, 757,
+	758, 671, 760, 381, 688, 97, 338, 110, 687, 679,
+	199, 890, 222, 219, 509, 378, 187, 191, 98, 104,
+	101, 105, 103, 868, 109, 735, 313, 268, 99, 252,
+	788, 95, 1036, 262, 792, 288, 287, 947, 783, 796,
+	884, 939, 938, 937, 778, 190, 237, 767, 806, 807,
+	776, 251, 274, 781, 934, 381, 238, 189, 571, 379,
+	175, 570, 565, 564, 802, 1042, 3, 1003, 1026, 1007,
+	222, 318, 102, 871, 251, 558, 484, 251, 106, 107,
+	254, 477, 809, 816, 475, 510, 407, 369, 797, 77,
+	883, 366, 367, 815, 355, 834, 835, 405, 917, 251,
+	353, 174, 833, 188, 222, 231, 869, 232, 836, 822,
+	826, 379, 825, 837, 853, 361, 362, 838, 234, 235,
+	851, 852, 858, 849, 860, 861, 842, 850, 856, 857,
+	289, 859, 290, 221, 848, 855, 847, 747, 251, 827,
+	737, 607, 359, 360, 324, 878, 325, 285, 176, 110,
+	227, 228, 229, 780, 892, 862, 823, 865, 194, 185,
+	286, 104, 101, 105, 103, 877, 109, 381, 982, 717,
+	99, 882, 459, 344, 218, 930, 225, 226, 504, 507,
+	983, 505, 506, 311, 233, 893, 886, 294, 811, 866,
+	787, 773, 645, 644, 643, 642, 912, 295, 456, 264,
+	909, 244, 223, 193, 794, 795, 905, 512, 881, 880,
+	908, 172, 654, 911, 922, 923, 913, 172, 172, 925,
+	926, 921, 927, 918, 919, 173, 924, 984, 920, 916,
+	885, 846, 469, 471, 473, 774, 901, 746, 745, 667,
+	936, 482, 606, 515, 610, 474, 487, 348, 464, 433,
+	490, 432, 394, 935, 945, 383, 577, 418, 940, 914,
+	550, 298, 942, 530, 435, 953, 693, 563, 950, 560,
+	440, 932, 439, 952, 954, 299, 543, 961, 300, 306,
+	910, 436, 304, 529, 968, 931, 764, 969, 251, 724,
+	725, 967, 463, 964, 596, 172, 305, 965, 966, 491,
+	492, 962, 455, 978, 979, 491, 492, 251, 973, 251,
+	102, 892, 892, 172, 320, 980, 106, 107, 681, 173,
+	242, 77, 463, 994, 986, 987, 989, 172, 798, 958,
+	998, 993, 173, 222, 960, 197, 996, 997, 173, 102,
+	1000, 574, 557, 995, 600, 106, 107, 443, 536, 534,
+	442, 528, 609, 1010, 612, 524, 583, 583, 511, 1017,
+	1018, 621, 623, 404, 1022, 1015, 1016, 403, 1000, 1023,
+	1019, 395, 1027, 352, 307, 1028, 301, 272, 270, 1031,
+	269, 258, 240, 239, 197, 97, 597, 110, 461, 814,
+	1034, 1035, 1037, 1022, 1039, 698, 1038, 569, 98, 104,
+	101, 105, 103, 566, 109, 1045, 172, 447, 99, 446,
+	236, 95, 161, 230, 556, 653, 110, 652, 393, 514,
+	513, 518, 517, 251, 77, 251, 782, 98, 104, 101,
+	105, 103, 777, 109, 78, 79, 149, 99, 775, 873,
+	1002, 1021, 168, 1024, 84, 251, 81, 1005, 159, 500,
+	501, 156, 1025, 158, 1006, 1041, 82, 117, 160, 840,
+	498, 502, 504, 507, 496, 505, 506, 166, 157, 83,
+	723, 499, 148, 86, 579, 146, 727, 147, 80, 339,
+	421, 217, 100, 292, 744, 291, 284, 748, 278, 280,
+	1, 153, 503, 85, 721, 722, 756, 94, 75, 74,
+	167, 73, 162, 72, 71, 89, 70, 90, 87, 169,
+	56, 55, 54, 69, 68, 67, 66, 163, 164, 63,
+	77, 165, 65, 88, 154, 64, 150, 76, 62, 61,
+	78, 79, 60, 155, 59, 58, 57, 53, 52, 51,
+	84, 151, 81, 384, 50, 152, 49, 48, 47, 46,
+	45, 44, 82, 43, 282, 42, 41, 40, 39, 38,
+	37, 36, 251, 35, 34, 83, 33, 32, 31, 86,
+	30, 29, 28, 27, 80, 26, 25, 24, 21, 20,
+	22, 251, 19, 23, 18, 17, 16, 14, 15, 85,
+	13, 12, 772, 7, 11, 10, 9, 8, 376, 6,
+	5, 89, 0, 90, 87, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 583, 0, 0, 0, 0, 88,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 69,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 844, 845,
 }
 
 var yyPact = [...]int16{
-	1070, -1000, 402, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1152, -1000, 477, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 573,
-	920, 923, 740, 861, 744, 230, 229, 613, 544, 480,
-	1070, 867, 805, 437, 277, 127, 925, 288, 925, -1000,
-	-1000, 169, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	430, 566, 701, 618, -1000, 587, 927, 594, 671, 565,
-	922, 513, 521, 906, 904, -1000, -1000, -1000, 870, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 268, 698,
-	267, 72, 477, 495, -22, -22, 266, 861, 695, 265,
-	102, 264, 472, 903, 896, -22, 516, -22, 860, -1000,
-	23, 951, 694, 72, 804, 895, 816, 894, 873, -1000,
-	664, 263, 98, -1000, 918, 848, 23, 910, 805, 615,
-	-21, 925, 925, 925, 925, 925, 925, 925, 925, -86,
-	-13, 153, 261, -1000, 644, 647, 647, 951, -1000, 756,
-	877, 260, 889, 861, 571, 877, 877, 616, 550, 121,
-	877, 548, 259, 570, 877, 72, -1000, -1000, 257, -22,
-	256, 540, 254, 772, 397, 303, 253, -1000, -1000, -1000,
-	252, 248, 805, 910, -1000, -1000, 888, -1000, 860, -1000,
-	247, -1000, -1000, 302, 246, 245, 244, -1000, 887, 886,
-	-1000, -1000, 519, 512, -1000, -1000, 542, -87, -1000, 951,
-	214, 396, 779, 393, 392, -1000, -1000, 192, -78, 243,
-	767, 242, 807, 239, 235, 233, 878, 231, 224, -1000,
-	221, -22, -1000, 860, 443, 849, -1000, 918, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -74, -74, -74, -1000, -1000,
-	-74, -1000, 371, -1000, -1000, -1000, -1000, -1000, -1000, 925,
-	643, -1000, 24, 913, 844, 765, -1000, 217, 860, 844,
-	877, 861, 861, 774, 569, 877, 555, 877, 301, 115,
-	847, 552, 877, -1000, 877, 861, -1000, -1000, -1000, 318,
-	498, -1000, 980, 91, 458, 617, 885, 717, 764, -22,
-	39, 300, 884, 292, 370, 882, -22, -1000, 881, 213,
-	880, 298, -1000, -22, -22, 23, 212, 23, 794, 335,
-	369, 951, 951, -86, -36, 391, 785, 873, 390, -22,
-	-22, 976, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 879, 545, 792, 209, 208, -1000, 791, 917, 205,
-	204, -1000, 916, 316, 314, 848, 775, -45, -45, 860,
-	-1000, -8, 200, 925, 90, 829, 846, 912, -1000, 844,
-	829, 861, 860, 848, 860, 844, 763, 608, 877, 769,
-	877, 861, 108, 293, 199, 844, 829, 877, 861, 861,
-	860, 848, 45, -1000, -1000, 980, -1000, 36, 89, 198,
-	75, -1000, 131, 692, 691, 690, 688, 628, 68, 179,
-	197, -46, -1000, -1000, 728, -1000, -22, 332, 22, 291,
-	-23, -1000, -23, 196, 805, 194, 762, 873, 294, 177,
-	-1000, 176, 175, -1000, 285, -1000, 436, -1000, 23, 852,
-	-1000, -1000, -1000, -1000, 158, 386, 366, 873, 435, 432,
-	-1000, 951, 174, 131, 173, 789, -1000, 172, 167, 915,
-	-1000, 166, -54, 33, 443, 844, 384, -1000, 429, 274,
-	382, 272, -1000, -1000, 848, -1000, 639, -78, 860, 165,
-	157, 323, 323, -1000, 823, -58, -58, 132, 90, 829,
-	-1000, 860, 848, 848, 829, 844, 829, 603, 103, 748,
-	757, 602, 861, 860, 848, 281, 156, 155, -1000, 829,
-	-1000, 861, 860, 848, 860, 848, 848, 829, -85, -96,
-	-1000, -1000, -1000, -1000, -1000, 410, -1000, -1000, 18, 15,
-	14, 13, -1000, -1000, -1000, -1000, 686, 752, 511, 499,
-	313, -1000, -1000, -1000, -1000, 614, -23, -1000, -1000, -1000,
-	493, 363, 381, 683, 473, -22, 715, -1000, -1000, -1000,
-	-22, 23, 876, 151, 362, 361, 207, -1000, 360, -22,
-	-22, -38, 980, 471, -1000, 150, -1000, -1000, 149, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 775, 829, -94, -45,
-	622, 12, 619, 443, -1000, 844, -1000, -1000, -1000, -1000,
-	-1000, 64, 48, 831, -1000, -1000, -1000, -1000, 425, 422,
-	-1000, -1000, 848, 829, 829, -1000, 829, -1000, 103, 860,
-	129, 129, 378, 323, 323, 751, 593, 588, 103, 860,
-	848, 848, 829, 148, -1000, -1000, -1000, 860, 848, 848,
-	829, 848, 829, 829, -1000, 146, 144, 131, -1000, -1000,
-	-1000, -1000, 681, 9, 543, 541, 116, 541, 152, 722,
-	-1000, -1000, 641, 534, 749, 805, -1000, 7, -3, 448,
-	-22, -1000, -1000, -1000, -1000, 951, -1000, -1000, -1000, 359,
-	358, 418, -1000, 357, 353, -1000, -1000, -1000, 133, -1000,
-	-1000, 844, 124, 351, -1000, -1000, -1000, -94, -1000, -1000,
-	328, -1000, 775, 829, 817, -1000, -58, 132, -1000, -1000,
-	829, -1000, -1000, -1000, 860, 844, -1000, 417, -1000, -1000,
-	129, -1000, -1000, 579, 103, 103, 860, 848, 829, 829,
-	-1000, -1000, 848, 829, 829, -1000, 829, -1000, -1000, 311,
-	310, -1000, -1000, 652, 806, 800, 522, 131, -1000, 116,
-	507, 501, 522, -1000, 389, -1000, -1000, 873, -4, -10,
-	683, 350, 487, -1000, 715, -1000, 415, -87, -1000, -1000,
-	130, -1000, -1000, -1000, 829, -1000, 377, -1000, -1000, -1000,
-	-93, 844, -1000, 47, -1000, -1000, -1000, 844, 829, 129,
-	347, 103, 860, 860, 848, 829, -1000, -1000, 829, -1000,
-	-1000, -1000, 40, 126, -37, -1000, -1000, 674, 63, 410,
-	-1000, 119, 119, 674, -17, 635, 655, -1000, -1000, 733,
-	375, -22, -22, -1000, 124, -90, 346, -39, 829, -1000,
-	829, -1000, -1000, -1000, 860, 848, 848, 829, -1000, -1000,
-	-1000, -1000, 672, -1000, -1000, -1000, -1000, 409, -1000, 538,
-	341, -1000, -43, 683, -57, -1000, -1000, -1000, 339, -1000,
-	338, 124, -1000, 848, 829, 829, -1000, -1000, 672, 119,
-	532, -1000, 119, 116, -1000, -1000, 337, 406, -1000, -1000,
-	-1000, 829, -1000, -1000, -1000, -1000, 530, -1000, 119, -1000,
-	-1000, 485, -57, -1000, 528, -1000, -22, -1000, 374, -1000,
-	-1000, 112, -1000, 404, 137, -57, -1000, -22, -47, 330,
-	-1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 291, 98, 1071,
+	1047, 963, 695, 219, 175, 721, 619, 602, 568, 347,
+	799, 1152, 969, 887, 514, 355, 155, 240, 362, 240,
+	-1000, -1000, 223, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 525, 645, 795, 737, -1000, 716, 1049, 671, 766,
+	679, 1046, 583, 599, 1016, 1015, -1000, -1000, -1000, -1000,
+	-1000, 345, -1000, -1000, -1000, -1000, 951, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 344, 793, 343, 7,
+	552, 582, -31, 1014, 340, -31, 334, 963, 791, 333,
+	122, 332, 550, 1013, 1011, -31, 1010, 331, 591, -31,
+	950, -1000, -55, 649, 789, 7, 329, 894, 1009, 328,
+	327, 915, 1007, 326, 325, 953, -1000, 765, 323, 549,
+	115, 322, 105, -31, -1000, 1042, 943, -55, 1018, 887,
+	713, -22, 240, 240, 240, 240, 240, 240, 240, 240,
+	-92, 507, 142, 320, -1000, 747, 750, 750, 649, -1000,
+	856, 966, 318, 1006, 963, 654, 966, 966, 703, 676,
+	149, 966, 652, 317, 647, 966, 7, -1000, -1000, 316,
+	-31, 966, 315, 624, 314, 864, 467, 374, 313, -1000,
+	-1000, -1000, 311, 309, 887, 1018, -1000, -1000, -31, 861,
+	1004, -1000, 950, -1000, 308, -1000, -1000, 370, 307, 306,
+	302, -1000, -31, -1000, 1000, 996, -1000, -1000, 601, 590,
+	-1000, -1000, 1056, -100, -1000, 649, 260, 466, 870, 465,
+	464, -1000, -1000, -28, -111, 300, 860, 858, 298, 897,
+	285, 284, 888, 886, 283, 983, 282, 277, 1045, 1043,
+	-1000, 276, -31, 273, -1000, 104, -1000, -1000, 950, 495,
+	930, -1000, 1042, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-101, -101, -101, -1000, -1000, -101, -1000, 445, -1000, -1000,
+	-1000, -1000, -1000, -1000, 240, 746, -1000, -8, 1023, 919,
+	857, -1000, 266, 950, 919, 966, 963, 963, 854, 644,
+	966, 641, 966, 369, 141, 949, 636, 966, -1000, 966,
+	963, -1000, -1000, -1000, 931, 395, 572, -1000, 1051, 103,
+	527, 653, 991, 810, 852, -31, -9, 367, 988, 364,
+	443, 984, 899, -1000, 262, -31, -1000, 982, 265, 981,
+	366, -1000, -1000, -31, -31, -55, 263, -55, 893, 418,
+	442, 649, 649, -92, -51, 462, 875, 953, 461, -31,
+	-31, 916, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 975, 634, 262, 885, 261, 259, -1000, 883, 610,
+	609, 1039, 258, 255, -1000, 1033, 608, 605, 394, 393,
+	974, -1000, 943, 867, -73, -73, 950, -1000, 202, 252,
+	240, 130, 925, 922, 1021, -1000, 919, 925, 963, 950,
+	943, 950, 919, 851, 705, 966, 853, 966, 963, 131,
+	365, 251, 919, 925, 966, 963, 963, 950, 943, -1000,
+	925, -68, -68, 49, -1000, -1000, 1051, -1000, 38, 101,
+	249, 99, -1000, 160, 786, 785, 784, 783, 736, 96,
+	180, 248, -17, -1000, -1000, 820, -1000, -31, 413, 53,
+	361, -45, -1000, -45, 239, 887, 237, 848, 953, -31,
+	-31, 505, 391, 372, 234, -1000, 233, 232, -1000, 360,
+	-1000, 513, -1000, -55, 948, -1000, -1000, -1000, -1000, 195,
+	460, 440, 953, 512, 508, -1000, 649, 231, 160, 505,
+	230, 882, -1000, 228, 220, 207, 206, 1031, -1000, 204,
+	200, 194, -21, 66, 192, 495, 919, 459, -1000, 502,
+	205, 456, 158, -1000, -1000, 943, -1000, 741, -111, 950,
+	191, 190, 397, 397, -1000, 913, 172, 130, 925, -1000,
+	950, 943, 943, 925, 919, 925, 704, 127, 847, 846,
+	701, 963, 950, 943, 264, 189, 188, -1000, 925, -1000,
+	963, 950, 943, 950, 943, 943, 925, -1000, 911, -1000,
+	-1000, -1000, -65, -97, -1000, -1000, -1000, -1000, -1000, 484,
+	-1000, -1000, 37, 22, 21, 20, -1000, -1000, -1000, -1000,
+	782, 844, 586, 580, 388, -1000, -1000, -1000, -1000, 720,
+	-45, -1000, -1000, -1000, 569, 439, 455, 781, 555, -1000,
+	-1000, 187, -27, -31, 809, -1000, -1000, -1000, -31, -55,
+	961, 185, 436, 434, 236, -1000, 432, -31, -31, -57,
+	1051, 551, -1000, 183, -1000, -1000, -1000, -1000, 181, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1025, 867,
+	925, -75, -73, 725, 19, 681, 495, -1000, 919, -1000,
+	-1000, -1000, -1000, -1000, 73, 60, -1000, 498, 492, -1000,
+	-1000, 943, 925, 925, -1000, 925, -1000, 127, 950, 156,
+	156, 453, 397, 397, 840, 700, 698, 127, 950, 943,
+	943, 925, 179, -1000, -1000, -1000, 950, 943, 943, 925,
+	943, 925, 925, -1000, -68, 178, 177, 160, -1000, -1000,
+	-1000, -1000, 779, 15, 628, 632, 154, 632, 193, 815,
+	-1000, -1000, 744, 622, 839, 887, -1000, 14, 11, 385,
+	-1000, -1000, 522, -31, -1000, -1000, -1000, -1000, 649, -1000,
+	-1000, -1000, 429, 428, 489, -1000, 426, 425, -1000, -1000,
+	-1000, 176, -1000, -1000, -35, 919, 150, 424, -1000, -1000,
+	-1000, -75, -1000, -1000, 403, -1000, 867, 925, 903, -1000,
+	172, -1000, -1000, 925, -1000, -1000, -1000, 950, 919, -1000,
+	487, -1000, -1000, 156, -1000, -1000, 662, 127, 127, 950,
+	943, 925, 925, -1000, -1000, 943, 925, 925, -1000, 925,
+	-1000, -1000, -1000, 384, 383, -1000, -1000, 755, 904, 890,
+	595, 160, -1000, 154, 578, 577, 576, 595, -1000, 457,
+	-1000, -1000, 953, 10, 9, 781, 422, 565, -1000, -42,
+	809, -1000, 486, -100, -1000, -1000, 157, -1000, -1000, -1000,
+	881, 925, -1000, 450, -1000, -1000, -1000, -74, 919, -1000,
+	56, -1000, -1000, 919, 925, 156, 421, 127, 950, 950,
+	943, 925, -1000, -1000, 925, -1000, -1000, -1000, 46, 151,
+	8, -1000, -1000, 772, 74, 484, -1000, 146, 146, 146,
+	772, 2, 740, 762, -1000, -1000, 836, 449, -1000, -1000,
+	-31, -31, -1000, -50, 150, -64, 420, -5, 925, -1000,
+	925, -1000, -1000, -1000, 950, 943, 943, 925, -1000, -1000,
+	-1000, -1000, 767, 623, -1000, -1000, -1000, 481, -1000, -1000,
+	627, 419, -1000, -7, 781, -14, -1000, -1000, -1000, -1000,
+	412, -1000, 411, 150, -1000, 943, 925, 925, -1000, -1000,
+	767, -1000, -1000, -31, 146, 625, -1000, 146, 154, -1000,
+	-1000, 406, 480, -1000, -1000, -1000, 925, -1000, -1000, -1000,
+	-1000, 479, 381, -1000, 623, -1000, 146, -1000, -1000, 559,
+	-14, -1000, -31, -54, 620, -1000, 448, -1000, -1000, -1000,
+	-1000, -1000, 124, -14, -1000, 405, -1000,
 }
 
 var yyPgo = [...]int16{
-	0, 705, 1135, 1134, 1133, 1131, 20, 1130, 1129, 1126,
-	1125, 1117, 1114, 1113, 1112, 1111, 1109, 1108, 1106, 1104,
-	1102, 1101, 1092, 1091, 1090, 1087, 1086, 16, 1085, 1084,
-	1083, 1079, 1076, 1075, 1074, 1072, 1066, 1064, 1060, 1059,
-	1058, 1057, 1055, 1052, 1051, 1050, 6, 1049, 1048, 1047,
-	1044, 1043, 1033, 1026, 1025, 1023, 1017, 1015, 1013, 1011,
-	1007, 1000, 42, 18, 999, 998, 40, 54, 45, 39,
-	46, 997, 33, 996, 41, 34, 32, 992, 990, 27,
-	989, 987, 30, 38, 15, 986, 43, 985, 984, 23,
-	12, 983, 11, 29, 36, 982, 13, 3, 980, 24,
-	25, 9, 7, 976, 31, 461, 975, 61, 10, 28,
-	0, 972, 17, 970, 21, 26, 4, 968, 961, 14,
-	959, 958, 2, 956, 955, 954, 8, 946, 5, 945,
-	944, 943, 1, 22, 19, 37, 942, 941, 35, 47,
-	935, 933, 932, 929,
+	0, 706, 1240, 1239, 1238, 1237, 19, 1236, 1235, 1234,
+	1233, 1232, 1231, 1230, 1228, 1227, 1226, 1225, 1224, 1223,
+	1222, 1220, 1219, 1218, 1217, 1216, 1215, 15, 1213, 1212,
+	1211, 1210, 1208, 1207, 1206, 1204, 1203, 1201, 1200, 1199,
+	1198, 1197, 1196, 1195, 1193, 1191, 1190, 8, 1189, 1188,
+	1187, 1186, 1184, 1183, 1179, 1178, 1177, 1176, 1175, 1174,
+	1172, 1169, 1168, 1167, 1165, 1162, 1159, 1156, 1155, 1154,
+	1153, 1152, 1151, 1150, 1146, 1144, 1143, 1141, 1139, 1138,
+	40, 16, 1137, 1130, 42, 515, 48, 41, 43, 1129,
+	32, 1128, 46, 34, 18, 1126, 1125, 26, 1123, 1122,
+	28, 39, 25, 1121, 44, 1120, 1119, 22, 60, 1116,
+	10, 33, 36, 1114, 12, 2, 1110, 23, 24, 9,
+	7, 1104, 31, 541, 1099, 62, 14, 29, 0, 1097,
+	17, 1095, 21, 27, 4, 1094, 1092, 13, 1087, 1083,
+	3, 1081, 1080, 5, 11, 1079, 6, 1078, 1072, 1066,
+	1, 30, 20, 37, 1062, 1061, 35, 45, 1060, 1059,
+	1058, 38, 1057, 1055,
 }
 
 var yyR1 = [...]uint8{
-	0, 65, 66, 66, 66, 1, 1, 1, 1, 1,
+	0, 83, 84, 84, 84, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	6, 6, 6, 62, 62, 64, 64, 64, 64, 64,
-	64, 86, 86, 85, 63, 63, 82, 82, 82, 82,
-	82, 82, 82, 82, 82, 82, 82, 82, 82, 82,
-	82, 82, 70, 70, 67, 68, 68, 68, 68, 68,
-	68, 68, 71, 69, 69, 69, 73, 74, 74, 74,
-	74, 74, 72, 72, 72, 92, 92, 93, 93, 94,
-	94, 110, 110, 95, 95, 95, 95, 95, 95, 95,
-	95, 126, 126, 99, 99, 100, 100, 100, 100, 76,
-	76, 78, 78, 77, 77, 79, 79, 79, 79, 79,
-	79, 79, 79, 79, 79, 80, 83, 83, 87, 87,
-	87, 87, 87, 87, 87, 87, 87, 105, 81, 81,
-	81, 81, 81, 81, 81, 81, 81, 81, 88, 88,
-	88, 90, 90, 89, 89, 91, 91, 91, 96, 133,
-	133, 97, 97, 97, 97, 98, 98, 98, 98, 2,
-	2, 3, 3, 139, 139, 139, 139, 139, 135, 135,
-	4, 104, 104, 103, 103, 103, 103, 103, 103, 103,
-	7, 7, 8, 8, 75, 75, 75, 75, 9, 9,
-	10, 10, 5, 5, 5, 11, 11, 101, 101, 102,
-	102, 102, 102, 12, 12, 13, 15, 14, 14, 16,
-	16, 17, 18, 20, 20, 20, 22, 22, 21, 21,
-	21, 23, 23, 19, 24, 24, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 53, 53, 53, 53, 53,
-	107, 107, 25, 25, 26, 26, 27, 27, 27, 27,
-	27, 84, 84, 106, 28, 28, 29, 29, 29, 29,
-	30, 30, 30, 30, 31, 31, 31, 31, 32, 32,
-	140, 140, 141, 129, 129, 130, 130, 130, 115, 115,
-	134, 134, 134, 142, 142, 143, 120, 120, 121, 121,
-	125, 125, 113, 113, 52, 52, 138, 138, 136, 136,
-	137, 137, 137, 127, 127, 128, 128, 116, 116, 108,
-	108, 117, 118, 122, 122, 124, 123, 123, 123, 114,
-	114, 109, 33, 34, 35, 36, 36, 36, 36, 37,
-	37, 37, 37, 38, 38, 39, 39, 40, 41, 41,
-	42, 131, 131, 131, 131, 43, 44, 45, 45, 45,
-	47, 47, 47, 47, 48, 48, 46, 132, 132, 49,
-	49, 50, 50, 51, 54, 55, 119, 119, 112, 112,
-	59, 59, 60, 61, 61, 61, 61, 56, 57, 57,
-	57, 57, 57, 58, 58, 58, 58, 58,
+	1, 1, 1, 1, 1, 1, 1, 1, 6, 6,
+	6, 80, 80, 82, 82, 82, 82, 82, 82, 104,
+	104, 103, 81, 81, 100, 100, 100, 100, 100, 100,
+	100, 100, 100, 100, 100, 100, 100, 100, 100, 100,
+	88, 88, 85, 86, 86, 86, 86, 86, 86, 86,
+	89, 87, 87, 87, 91, 92, 92, 92, 92, 92,
+	90, 90, 90, 110, 110, 111, 111, 112, 112, 128,
+	128, 113, 113, 113, 113, 113, 113, 113, 113, 144,
+	144, 117, 117, 118, 118, 118, 118, 94, 94, 96,
+	96, 95, 95, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 98, 101, 101, 105, 105, 105, 105,
+	105, 105, 105, 105, 105, 123, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 106, 106, 106, 108,
+	108, 107, 107, 109, 109, 109, 114, 151, 151, 115,
+	115, 115, 115, 116, 116, 116, 116, 2, 2, 3,
+	3, 157, 157, 157, 157, 157, 153, 153, 4, 122,
+	122, 121, 121, 121, 121, 121, 121, 121, 7, 7,
+	8, 8, 93, 93, 93, 93, 9, 9, 10, 10,
+	5, 5, 5, 11, 11, 119, 119, 120, 120, 120,
+	120, 12, 12, 13, 15, 14, 14, 16, 16, 17,
+	18, 20, 20, 20, 22, 22, 21, 21, 21, 23,
+	23, 19, 24, 24, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 54, 54, 54, 54, 54, 125, 125,
+	25, 25, 26, 26, 27, 27, 27, 27, 27, 102,
+	102, 124, 28, 28, 29, 29, 29, 29, 30, 30,
+	30, 30, 31, 31, 31, 31, 32, 32, 158, 158,
+	159, 147, 147, 148, 148, 148, 133, 133, 152, 152,
+	152, 162, 162, 163, 138, 138, 139, 139, 143, 143,
+	131, 131, 53, 53, 156, 156, 154, 154, 155, 155,
+	155, 145, 145, 145, 146, 146, 134, 134, 126, 126,
+	135, 136, 140, 140, 142, 141, 141, 141, 132, 132,
+	127, 33, 34, 35, 36, 36, 36, 36, 37, 37,
+	37, 37, 38, 38, 39, 39, 40, 41, 41, 42,
+	149, 149, 149, 149, 43, 44, 45, 46, 46, 46,
+	48, 48, 48, 48, 49, 49, 47, 150, 150, 50,
+	50, 51, 51, 52, 55, 60, 61, 62, 66, 63,
+	63, 56, 64, 65, 67, 67, 68, 69, 70, 74,
+	75, 76, 77, 78, 78, 79, 79, 160, 160, 161,
+	161, 161, 161, 137, 137, 130, 130, 71, 71, 72,
+	73, 73, 73, 73, 57, 58, 58, 58, 58, 58,
+	59, 59, 59, 59, 59,
 }
 
 var yyR2 = [...]int8{
@@ -749,141 +797,156 @@ var yyR2 = [...]int8{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	11, 12, 9, 1, 3, 1, 3, 3, 1, 3,
-	3, 1, 2, 4, 1, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 4, 3, 2, 1, 1,
-	5, 6, 2, 0, 2, 1, 3, 1, 3, 3,
-	5, 1, 6, 3, 5, 3, 1, 5, 4, 4,
-	3, 1, 1, 1, 1, 3, 0, 2, 0, 1,
-	3, 1, 1, 1, 3, 4, 6, 7, 1, 3,
-	1, 4, 0, 4, 0, 1, 1, 1, 2, 2,
-	0, 1, 3, 1, 3, 1, 3, 5, 5, 4,
-	6, 6, 5, 6, 6, 3, 1, 3, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
-	1, 3, 0, 1, 3, 1, 2, 2, 2, 1,
-	1, 4, 2, 2, 0, 4, 2, 2, 0, 2,
-	3, 5, 4, 2, 1, 3, 3, 0, 3, 3,
-	2, 1, 2, 1, 2, 2, 2, 2, 1, 2,
-	9, 6, 7, 4, 2, 2, 2, 2, 5, 3,
-	7, 8, 6, 9, 9, 5, 4, 1, 2, 3,
-	3, 3, 3, 7, 6, 2, 3, 4, 3, 3,
-	2, 7, 6, 6, 7, 6, 5, 4, 6, 7,
-	6, 5, 4, 3, 8, 7, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 4, 8, 7, 7, 6,
-	2, 0, 7, 6, 11, 10, 2, 2, 4, 2,
-	2, 1, 3, 1, 3, 2, 10, 9, 9, 8,
-	13, 12, 12, 11, 10, 9, 9, 8, 5, 5,
-	0, 6, 10, 0, 2, 0, 2, 6, 0, 2,
-	0, 2, 2, 0, 3, 3, 0, 1, 0, 1,
-	0, 1, 0, 2, 2, 0, 2, 1, 2, 2,
-	2, 3, 2, 3, 3, 2, 0, 1, 3, 2,
-	0, 2, 2, 3, 1, 2, 3, 3, 0, 1,
-	3, 1, 3, 6, 4, 9, 8, 8, 7, 9,
-	8, 8, 7, 2, 4, 7, 3, 3, 3, 5,
-	10, 3, 3, 5, 0, 3, 6, 9, 11, 7,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 11, 12,
+	9, 1, 3, 1, 3, 3, 1, 3, 3, 1,
+	2, 4, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 4, 3, 2, 1, 1, 5, 6,
+	2, 0, 2, 1, 3, 1, 3, 3, 5, 1,
+	6, 3, 5, 3, 1, 5, 4, 4, 3, 1,
+	1, 1, 1, 3, 0, 2, 0, 1, 3, 1,
+	1, 1, 3, 4, 6, 7, 1, 3, 1, 4,
+	0, 4, 0, 1, 1, 1, 2, 2, 0, 1,
+	3, 1, 3, 1, 3, 5, 5, 4, 6, 6,
+	5, 6, 6, 3, 1, 3, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 1,
+	1, 1, 1, 1, 3, 1, 1, 1, 1, 3,
+	0, 1, 3, 1, 2, 2, 2, 1, 1, 4,
+	2, 2, 0, 4, 2, 2, 0, 2, 3, 5,
+	4, 2, 1, 3, 3, 0, 3, 3, 2, 1,
+	2, 1, 2, 2, 2, 2, 1, 2, 9, 6,
+	7, 4, 2, 2, 2, 2, 5, 3, 7, 8,
+	6, 9, 9, 5, 4, 1, 2, 3, 3, 3,
+	3, 7, 6, 2, 3, 4, 3, 3, 2, 7,
+	6, 6, 7, 6, 5, 4, 6, 7, 6, 5,
+	4, 3, 8, 7, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 4, 8, 7, 7, 6, 2, 0,
+	7, 6, 11, 10, 2, 2, 4, 2, 2, 1,
+	3, 1, 3, 2, 10, 9, 9, 8, 13, 12,
+	12, 11, 10, 9, 9, 8, 5, 5, 0, 7,
+	10, 0, 2, 0, 2, 6, 0, 2, 0, 2,
+	2, 0, 3, 3, 0, 1, 0, 1, 0, 1,
+	0, 2, 2, 0, 2, 1, 2, 2, 2, 3,
+	2, 3, 3, 3, 2, 0, 1, 3, 2, 0,
+	2, 2, 3, 1, 2, 3, 3, 0, 1, 3,
+	1, 3, 6, 4, 9, 8, 8, 7, 9, 8,
+	8, 7, 2, 4, 7, 3, 3, 3, 5, 10,
+	3, 3, 5, 0, 3, 6, 10, 9, 11, 7,
 	4, 6, 2, 4, 2, 4, 10, 1, 3, 8,
-	6, 2, 4, 3, 2, 3, 1, 3, 1, 1,
-	10, 8, 2, 3, 5, 7, 5, 2, 6, 6,
-	6, 6, 6, 2, 6, 6, 10, 10,
+	6, 2, 4, 3, 2, 2, 2, 2, 2, 5,
+	6, 3, 3, 4, 6, 6, 4, 2, 3, 4,
+	5, 3, 2, 6, 6, 6, 6, 2, 0, 3,
+	3, 5, 5, 1, 3, 1, 1, 10, 8, 2,
+	3, 5, 7, 5, 2, 6, 6, 6, 6, 6,
+	2, 6, 6, 10, 10,
 }
 
 var yyChk = [...]int16{
-	-1000, -65, -66, -1, -6, -2, -3, -10, -5, -7,
+	-1000, -83, -84, -1, -6, -2, -3, -10, -5, -7,
 	-8, -9, -12, -13, -15, -14, -16, -17, -18, -20,
 	-22, -23, -21, -19, -24, -25, -26, -28, -29, -30,
 	-31, -32, -33, -34, -35, -36, -37, -38, -39, -40,
-	-41, -42, -43, -44, -45, -47, -48, -49, -50, -51,
-	-53, -54, -55, -59, -60, -61, -56, -57, -58, 8,
-	18, 19, 62, 30, 40, 53, 28, 77, 57, 98,
-	128, -62, 147, -64, 155, -82, 129, 142, 152, -81,
-	144, 63, 146, 143, 145, 69, 70, -105, 148, 131,
-	43, 45, 46, 61, 42, 71, -111, 73, 59, 5,
-	90, 51, 86, 102, 107, 88, 92, 116, 117, 82,
-	83, 84, 81, 32, 121, 122, 85, 142, 44, 46,
-	41, 5, 86, 101, 105, 93, 44, 61, 46, 41,
-	51, 5, 86, 101, 102, 105, 35, 93, -67, -76,
-	4, 9, 46, 5, 35, 142, 35, 142, 78, -6,
-	37, 115, 108, -1, -70, -76, 6, -62, 127, 139,
-	10, 155, 156, 151, 152, 154, 157, 158, 153, -82,
-	129, 139, 138, -82, -86, 142, -85, 64, 119, -107,
-	119, 7, 47, -107, 79, 80, 74, 75, 76, 4,
-	74, 76, 58, 79, 80, 4, 94, 88, 7, 7,
-	9, 142, 48, 142, -74, 142, 138, -72, 145, -105,
-	108, 7, 129, -110, 142, 145, -110, 142, -67, -76,
-	48, 142, 143, 142, 108, 7, 7, -110, 92, -110,
-	-76, -68, -73, -69, -71, -74, 129, -79, -77, 129,
-	142, 27, 26, 112, 114, -78, -80, -83, -82, 48,
-	-74, 7, 21, 24, 7, 7, 21, 4, 7, -6,
-	58, 142, 143, -67, -92, 11, -68, -70, -62, 71,
-	73, 142, 145, -82, -82, -82, -82, -82, -82, -82,
-	-82, 130, -62, 130, -88, 142, 71, 73, 142, 66,
-	-86, -86, -79, 31, -76, -107, 142, 7, -67, -76,
-	80, -107, -107, -107, 79, 80, 79, 80, 142, 138,
-	-107, 79, 80, 142, 80, -107, -74, 142, -110, 142,
-	-4, -139, 31, 118, -135, 71, 142, 31, -52, 129,
-	138, 142, 142, 142, -62, -70, 7, -76, 142, 138,
-	142, 142, 142, 7, 7, 127, 10, 127, 20, -66,
-	-69, 149, 150, -82, -79, 25, 26, 129, 27, 129,
-	129, -87, 132, 133, 134, 135, 136, 137, 141, 140,
-	113, 142, 31, 142, 7, 24, 142, 142, 142, 7,
-	4, 142, 142, 142, -110, -76, -93, 124, 12, -67,
-	130, -82, 66, 65, 5, -90, 13, 31, 142, -76,
-	-90, -107, -67, -76, -67, -76, -67, 31, 80, -107,
-	80, -107, 138, 142, 138, -67, -90, 80, -107, -107,
-	-67, -76, 132, -139, -104, -103, -102, 49, 60, 38,
-	39, 50, 81, 51, 54, 55, 52, 143, 118, 72,
-	7, 37, -140, -141, 31, -138, -136, -137, -110, 142,
-	138, -72, 138, 7, 129, 138, 130, 7, -110, 7,
-	142, 7, 138, -110, -110, -68, 142, -68, 23, 130,
-	130, -79, -79, 130, 129, 25, -6, 129, -110, -110,
-	-83, 129, 7, 81, 24, 142, 142, 24, 4, 142,
-	142, 4, 132, 132, -92, -99, 29, -94, -95, -110,
-	142, 155, -105, -94, -76, 68, 142, -82, -75, 132,
-	133, 141, 140, -96, -97, 14, 15, 12, 5, -90,
-	-97, -67, -76, -76, -92, -76, -90, 31, 76, -107,
-	-67, 31, -107, -67, -76, 142, 138, 138, 142, -90,
-	-97, -107, -67, -76, -67, -76, -76, -92, 142, 143,
-	-104, 144, 143, 142, 143, -114, -109, 142, 49, 49,
-	49, 49, -135, 143, 142, 50, 142, 145, -142, -143,
-	32, -138, 127, 130, 71, -110, 138, -72, 142, -72,
-	142, -62, 142, 31, -6, 138, 120, 142, 142, 142,
-	138, 127, -68, 10, -62, -6, 129, 130, -6, 127,
-	127, -79, 142, -114, 142, 24, 142, 142, 4, 142,
-	145, -110, 143, 146, 69, 70, -93, -90, 129, 127,
-	139, 129, 139, -92, 68, -76, 142, 142, -105, -105,
-	-98, 16, 17, -133, 143, 148, -133, -89, -91, 142,
-	-75, -97, -76, -92, -92, -97, -90, -96, 76, -27,
-	132, 133, 25, 141, 140, -67, 31, 31, 76, -67,
-	-76, -76, -92, 138, 142, 142, -97, -67, -76, -76,
-	-92, -76, -92, -92, -97, 149, 149, 127, 144, 144,
-	144, 144, -11, 49, 31, -129, 95, -130, 95, 132,
-	73, -72, -131, 100, 130, 129, -46, 49, 106, -110,
-	-112, 35, 36, -110, -68, 7, 142, 130, 130, -6,
-	-63, 142, 130, -110, -110, 130, -104, -108, 56, 142,
-	142, -99, -96, -100, 142, 143, 146, 152, -94, 71,
-	144, 71, -93, -90, 143, 143, 15, 127, 125, 126,
-	-92, -97, -97, -96, -27, -76, -84, -106, 142, -84,
-	129, -105, -105, 31, 76, 76, -27, -76, -92, -92,
-	-97, 142, -76, -92, -92, -97, -92, -97, -97, 142,
-	142, -109, 50, 144, 35, 109, -115, 81, -128, -127,
-	142, 73, -115, -128, 142, 34, 33, 67, 99, 58,
-	31, -62, 144, 144, 120, -119, -110, -79, 130, 130,
-	127, 130, 130, 142, -90, -126, 142, 130, -100, 130,
-	127, -99, -96, 17, -133, -89, -97, -76, -90, 127,
-	-84, 76, -27, -27, -76, -92, -97, -97, -92, -97,
-	-97, -97, 132, 132, 60, 21, 21, -134, 90, -114,
-	-128, 96, 96, -134, 129, -6, 144, 144, -46, 130,
-	103, -112, 127, -63, -96, 129, 144, 152, -90, 143,
-	-90, -97, -84, 130, -27, -76, -76, -92, -97, -97,
-	143, 142, 143, -108, 123, 143, -116, 142, -116, -108,
-	144, 68, 58, 31, 129, -119, -119, -126, 145, 130,
-	144, -96, -97, -76, -92, -92, -97, -101, -102, 127,
-	-120, -117, 82, 130, 144, -46, -132, 144, 130, 130,
-	-126, -92, -97, -97, -101, -116, -121, -118, 83, -116,
-	-128, 130, 127, -97, -125, -124, 84, -116, 104, -132,
-	-113, 85, -122, -123, -110, 129, 142, 127, 132, -132,
-	-122, -110, 143, 130,
+	-41, -42, -43, -44, -45, -46, -48, -49, -50, -51,
+	-52, -54, -55, -56, -71, -72, -73, -57, -58, -59,
+	-60, -61, -62, -66, -64, -65, -67, -68, -69, -70,
+	-74, -75, -76, -77, -78, -79, -63, 8, 18, 19,
+	62, 30, 40, 53, 28, 77, 57, 92, 107, 89,
+	91, 137, -80, 156, -82, 164, -100, 138, 151, 161,
+	-99, 153, 63, 155, 152, 154, 69, 70, -123, 157,
+	140, 43, 45, 46, 61, 42, 71, -129, 73, 59,
+	5, 99, 51, 95, 111, 116, 97, 86, 87, 88,
+	89, 80, 90, 94, 101, 125, 126, 82, 83, 84,
+	81, 32, 130, 131, 85, 151, 44, 46, 41, 5,
+	95, 110, 114, 60, 93, 102, 44, 61, 46, 41,
+	51, 5, 95, 110, 111, 114, 60, 93, 35, 102,
+	-85, -94, 4, 9, 46, 5, 93, 35, 151, 44,
+	41, 35, 151, 44, 41, 78, -6, 37, 124, 95,
+	117, 89, 151, 44, -1, -88, -94, 6, -80, 136,
+	148, 10, 164, 165, 160, 161, 163, 166, 167, 162,
+	-100, 138, 148, 147, -100, -104, 151, -103, 64, 128,
+	-125, 128, 7, 47, -125, 79, 80, 74, 75, 76,
+	4, 74, 76, 58, 79, 80, 4, 103, 97, 7,
+	7, 151, 9, 151, 48, 151, -92, 151, 147, -90,
+	154, -123, 117, 7, 138, -128, 151, 154, 7, 151,
+	-128, 151, -85, -94, 48, 151, 152, 151, 117, 7,
+	7, -128, 7, 151, 101, -128, -94, -86, -91, -87,
+	-89, -92, 138, -97, -95, 138, 151, 27, 26, 121,
+	123, -96, -98, -101, -100, 48, -92, 151, 7, 21,
+	24, 7, 151, 151, 7, 21, 4, 7, 151, 151,
+	-6, 58, 151, 117, 152, 151, 152, -128, -85, -110,
+	11, -86, -88, -80, 71, 73, 151, 154, -100, -100,
+	-100, -100, -100, -100, -100, -100, 139, -80, 139, -106,
+	151, 71, 73, 151, 66, -104, -104, -97, 31, -94,
+	-125, 151, 7, -85, -94, 80, -125, -125, -125, 79,
+	80, 79, 80, 151, 147, -125, 79, 80, 151, 80,
+	-125, -92, 151, -128, -125, 151, -4, -157, 31, 127,
+	-153, 71, 151, 31, -53, 138, 147, 151, 151, 151,
+	-80, -88, -128, -160, 31, 7, -94, 151, 147, 151,
+	151, 151, -128, 7, 7, 136, 10, 136, 20, -84,
+	-87, 158, 159, -100, -97, 25, 26, 138, 27, 138,
+	138, -105, 141, 142, 143, 144, 145, 146, 150, 149,
+	122, 151, 31, 31, 151, 7, 24, 151, 151, 24,
+	24, 151, 7, 4, 151, 151, 4, 4, 151, -128,
+	151, 152, -94, -111, 133, 12, -85, 139, -100, 66,
+	65, 5, -108, 13, 31, 151, -94, -108, -125, -85,
+	-94, -85, -94, -85, 31, 80, -125, 80, -125, 147,
+	151, 147, -85, -108, 80, -125, -125, -85, -94, -115,
+	-85, 14, 15, 141, -157, -122, -121, -120, 49, 60,
+	38, 39, 50, 81, 51, 54, 55, 52, 152, 127,
+	72, 7, 37, -158, -159, 31, -156, -154, -155, -128,
+	151, 147, -90, 147, 7, 138, 147, 139, 7, 24,
+	4, -161, 151, -128, 7, 151, 7, 147, -128, -128,
+	-86, 151, -86, 23, 139, 139, -97, -97, 139, 138,
+	25, -6, 138, -128, -128, -101, 138, 7, 81, -161,
+	24, 151, 151, 24, 93, 93, 4, 151, 151, 4,
+	93, 93, 141, 141, 7, -110, -117, 29, -112, -113,
+	-128, 151, 164, -123, -112, -94, 68, 151, -100, -93,
+	141, 142, 150, 149, -114, -115, 12, 5, -108, -115,
+	-85, -94, -94, -110, -94, -108, 31, 76, -125, -85,
+	31, -125, -85, -94, 151, 147, 147, 151, -108, -115,
+	-125, -85, -94, -85, -94, -94, -110, -115, -151, 152,
+	157, -151, 151, 152, -122, 153, 152, 151, 152, -132,
+	-127, 151, 49, 49, 49, 49, -153, 152, 151, 50,
+	151, 154, -162, -163, 32, -156, 136, 139, 71, -128,
+	147, -90, 151, -90, 151, -80, 151, 31, -6, -128,
+	-128, 136, 141, 147, 129, 151, 151, 151, 147, 136,
+	-86, 10, -80, -6, 138, 139, -6, 136, 136, -97,
+	151, -132, 151, 24, 151, 151, 151, 151, 4, 151,
+	151, 151, 154, -128, 152, 155, 69, 70, 151, -111,
+	-108, 138, 136, 148, 138, 148, -110, 68, -94, 151,
+	151, -123, -123, -116, 16, 17, -107, -109, 151, -93,
+	-115, -94, -110, -110, -115, -108, -114, 76, -27, 141,
+	142, 25, 150, 149, -85, 31, 31, 76, -85, -94,
+	-94, -110, 147, 151, 151, -115, -85, -94, -94, -110,
+	-94, -110, -110, -115, 15, 158, 158, 136, 153, 153,
+	153, 153, -11, 49, 31, -147, 104, -148, 104, 141,
+	73, -90, -149, 109, 139, 138, -47, 49, 115, 151,
+	152, 154, -128, -130, 35, 36, -128, -86, 7, 151,
+	139, 139, -6, -81, 151, 139, -128, -128, 139, -122,
+	-126, 56, 151, 151, 4, -117, -114, -118, 151, 152,
+	155, 161, -112, 71, 153, 71, -111, -108, 152, 152,
+	136, 134, 135, -110, -115, -115, -114, -27, -94, -102,
+	-124, 151, -102, 138, -123, -123, 31, 76, 76, -27,
+	-94, -110, -110, -115, 151, -94, -110, -110, -115, -110,
+	-115, -115, -151, 151, 151, -127, 50, 153, 35, 118,
+	-133, 81, -146, -145, 151, 73, 57, -133, -146, 151,
+	34, 33, 67, 108, 58, 31, -80, 153, 153, 141,
+	129, -137, -128, -97, 139, 139, 136, 139, 139, 151,
+	154, -108, -144, 151, 139, -118, 139, 136, -117, -114,
+	17, -107, -115, -94, -108, 136, -102, 76, -27, -27,
+	-94, -110, -115, -115, -110, -115, -115, -115, 141, 141,
+	60, 21, 21, -152, 99, -132, -146, 105, 105, 105,
+	-152, 138, -6, 153, 153, -47, 139, 112, 152, 154,
+	-130, 136, -81, 24, -114, 138, 153, 161, -108, 152,
+	-108, -115, -102, 139, -27, -94, -94, -110, -115, -115,
+	152, 151, 152, -126, 132, 152, -134, 151, -134, -134,
+	-126, 153, 68, 58, 31, 138, -137, -137, 154, -144,
+	154, 139, 153, -114, -115, -94, -110, -110, -115, -119,
+	-120, -143, -142, 84, 136, -138, -135, 82, 139, 153,
+	-47, -150, 153, 139, 139, -144, -110, -115, -115, -119,
+	-140, -141, -128, -134, -139, -136, 83, -134, -146, 139,
+	136, -115, 136, 141, -143, -134, 113, -150, -140, -128,
+	152, -131, 85, 138, 151, -150, 139,
 }
 
 var yyDef = [...]int16{
@@ -892,96 +955,106 @@ var yyDef = [...]int16{
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
 	31, 32, 33, 34, 35, 36, 37, 38, 39, 40,
 	41, 42, 43, 44, 45, 46, 47, 48, 49, 50,
-	51, 52, 53, 54, 55, 56, 57, 58, 59, 0,
-	0, 0, 0, 140, 0, 0, 0, 0, 0, 0,
-	3, -2, 0, 63, 65, 68, 0, 168, 0, 88,
-	89, 0, 170, 171, 172, 173, 174, 175, 177, 167,
-	199, 281, 0, 281, 245, 0, 0, 0, 0, 0,
-	373, 0, 0, 394, 401, 404, 412, 417, 423, 266,
-	267, 268, 269, 270, 271, 272, 273, 274, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 140, 0, 0,
-	0, 0, 0, 0, 392, 0, 0, 0, 140, 250,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 295,
-	0, 0, 0, 4, 0, 116, 0, 93, 0, 0,
+	51, 52, 53, 54, 55, 56, 57, 58, 59, 60,
+	61, 62, 63, 64, 65, 66, 67, 68, 69, 70,
+	71, 72, 73, 74, 75, 76, 77, 0, 0, 0,
+	0, 158, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 3, -2, 0, 81, 83, 86, 0, 186, 0,
+	106, 107, 0, 188, 189, 190, 191, 192, 193, 195,
+	185, 217, 299, 0, 299, 263, 0, 0, 0, 0,
+	0, 392, 0, 0, 414, 421, 424, 425, 426, 427,
+	428, 0, 437, 442, 459, 464, 470, 284, 285, 286,
+	287, 288, 289, 290, 291, 292, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 158, 0, 0,
+	0, 0, 0, 0, 412, 0, 0, 0, 0, 0,
+	158, 268, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 313, 0, 0, 0,
+	0, 0, 0, 0, 4, 0, 134, 0, 111, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 105, 0, 0, 89, 0, 218,
+	158, 299, 0, 247, 158, 0, 299, 299, 299, 0,
+	0, 299, 0, 0, 0, 299, 0, 396, 404, 0,
+	0, 299, 0, 225, 0, 0, 353, 130, 0, 129,
+	131, 132, 0, 0, 0, 111, 139, 140, 0, 448,
+	0, 264, 158, 266, 0, 281, 381, 397, 0, 0,
+	0, 423, 0, 441, 460, 0, 267, 112, 113, 115,
+	119, 124, 0, 157, 163, 0, 186, 0, 0, 0,
+	0, 161, 159, 0, 174, 0, 395, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 87, 0, 0, 71, 0, 200, 140,
-	281, 0, 229, 140, 0, 281, 281, 281, 0, 0,
-	281, 0, 0, 0, 281, 0, 377, 385, 0, 0,
-	0, 207, 0, 0, 335, 112, 0, 111, 113, 114,
-	0, 0, 0, 93, 121, 122, 0, 246, 140, 248,
-	0, 263, 362, 378, 0, 0, 0, 403, 413, 0,
-	249, 94, 95, 97, 101, 106, 0, 139, 145, 0,
-	168, 0, 0, 0, 0, 143, 141, 0, 156, 0,
-	376, 0, 0, 0, 0, 0, 0, 0, 0, 294,
-	0, 0, 405, 140, 118, 0, 92, 0, 64, 66,
-	67, 69, 70, 76, 77, 78, 79, 80, 81, 82,
-	83, 84, 0, 86, 169, 178, 179, 180, 176, 0,
-	0, 72, 0, 0, 182, 223, 280, 0, 140, 182,
-	281, 140, 140, 0, 0, 281, 0, 281, 275, 0,
-	182, 0, 281, 364, 281, 140, 374, 395, 402, 0,
-	207, 202, 0, 0, 204, 0, 0, 0, 310, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 0,
-	0, 390, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 159, 160, 161, 162, 163, 164, 165,
-	166, 0, 0, 0, 0, 0, 257, 0, 0, 0,
-	0, 262, 0, 0, 0, 116, 134, 0, 0, 140,
-	85, 0, 0, 0, 0, 194, 0, 0, 228, 182,
-	194, 140, 140, 116, 140, 182, 0, 0, 281, 0,
-	281, 140, 0, 0, 0, 182, 194, 281, 140, 140,
-	140, 116, 0, 201, 210, 211, 213, 0, 0, 0,
-	0, 218, 0, 0, 0, 0, 0, 203, 0, 0,
-	0, 0, 308, 309, 323, 334, 337, 0, 0, 112,
-	0, 110, 0, 0, 0, 0, 0, 0, 0, 0,
-	379, 0, 0, 414, 416, 96, 99, 98, 0, 103,
-	105, 142, 144, -2, 0, 0, 0, 0, 0, 0,
-	155, 0, 0, 0, 0, 0, 256, 0, 0, 0,
-	261, 0, 0, 0, 118, 182, 0, 117, 119, 123,
-	121, 128, 130, 115, 116, 90, 0, 73, 140, 0,
-	0, 0, 0, 221, 198, 0, 0, 0, 0, 194,
-	244, 140, 116, 116, 194, 182, 194, 0, 0, 0,
-	0, 0, 140, 140, 116, 0, 0, 0, 279, 194,
-	283, 140, 140, 116, 140, 116, 116, 194, 424, 425,
-	212, 214, 215, 216, 217, 219, 359, 361, 0, 0,
-	0, 0, 205, 206, 208, 209, 0, 232, 313, 315,
-	0, 336, 338, 339, 340, 342, 0, 109, 112, 108,
-	384, 0, 0, 0, 400, 0, 0, 252, 386, 391,
-	0, 0, 0, 0, 0, 0, 0, 149, 0, 0,
-	0, 0, 0, 350, 253, 0, 255, 258, 0, 260,
-	363, 418, 419, 420, 421, 422, 134, 194, 0, 0,
-	0, 0, 0, 118, 91, 182, 224, 225, 226, 227,
-	188, 0, 0, 192, 189, 190, 193, 181, 183, 185,
-	222, 243, 116, 194, 194, 372, 194, 265, 0, 140,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
-	116, 116, 194, 0, 277, 278, 282, 140, 116, 116,
-	194, 116, 194, 194, 368, 0, 0, 0, 239, 240,
-	241, 242, 230, 0, 0, 318, 346, 318, 346, 0,
-	341, 107, 0, 0, 0, 0, 389, 0, 0, 0,
-	0, 408, 409, 415, 100, 0, 104, 147, 148, 0,
-	0, 74, 152, 0, 0, 157, 251, 375, 0, 254,
-	259, 182, 132, 0, 135, 136, 137, 0, 120, 124,
-	0, 129, 134, 194, 196, 197, 0, 0, 186, 187,
-	194, 370, 371, 264, 140, 182, 286, 291, 293, 287,
-	0, 289, 290, 0, 0, 0, 140, 116, 194, 194,
-	299, 276, 116, 194, 194, 307, 194, 366, 367, 0,
-	0, 360, 231, 0, 0, 0, 320, 0, 314, 346,
-	0, 0, 320, 316, 0, 324, 325, 0, 0, 0,
-	0, 0, 0, 399, 0, 411, 406, 102, 150, 151,
-	0, 153, 154, 349, 194, 62, 0, 133, 138, 125,
-	0, 182, 220, 0, 191, 184, 369, 182, 194, 0,
-	0, 0, 140, 140, 116, 194, 297, 298, 194, 305,
-	306, 365, 0, 0, 0, 233, 234, 350, 0, 319,
-	345, 0, 0, 350, 0, 0, 381, 382, 387, 0,
-	0, 0, 0, 75, 132, 0, 0, 0, 194, 195,
-	194, 285, 292, 288, 140, 116, 116, 194, 296, 304,
-	427, 426, 236, 311, 321, 322, 343, 347, 344, 326,
-	0, 380, 0, 0, 0, 410, 407, 60, 0, 126,
-	0, 132, 284, 116, 194, 194, 303, 235, 237, 0,
-	328, 327, 0, 346, 383, 388, 0, 397, 131, 127,
-	61, 194, 301, 302, 238, 348, 330, 329, 0, 351,
-	317, 0, 0, 300, 332, 331, 358, 352, 0, 398,
-	312, 0, 355, 354, 0, 0, 333, 358, 0, 0,
-	353, 356, 357, 396,
+	312, 0, 0, 0, 431, 0, 432, 438, 158, 136,
+	0, 110, 0, 82, 84, 85, 87, 88, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 0, 104, 187,
+	196, 197, 198, 194, 0, 0, 90, 0, 0, 200,
+	241, 298, 0, 158, 200, 299, 158, 158, 0, 0,
+	299, 0, 299, 293, 0, 200, 0, 299, 383, 299,
+	158, 393, 415, 422, 212, 0, 225, 220, 0, 0,
+	222, 0, 0, 0, 328, 0, 0, 0, 0, 0,
+	0, 0, 0, 439, 0, 0, 265, 0, 0, 0,
+	410, 413, 436, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 0, 0, 0, 0, 0, 0, 275, 0, 0,
+	0, 0, 0, 0, 280, 0, 0, 0, 0, 0,
+	0, 433, 134, 152, 0, 0, 158, 103, 0, 0,
+	0, 0, 212, 0, 0, 246, 200, 212, 158, 158,
+	134, 158, 200, 0, 0, 299, 0, 299, 158, 0,
+	0, 0, 200, 212, 299, 158, 158, 158, 134, 429,
+	212, 0, 0, 0, 219, 228, 229, 231, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 0, 221, 0,
+	0, 0, 0, 326, 327, 341, 352, 355, 0, 0,
+	130, 0, 128, 0, 0, 0, 0, 0, 0, 0,
+	0, 447, 0, 0, 0, 398, 0, 0, 461, 463,
+	114, 117, 116, 0, 121, 123, 160, 162, -2, 0,
+	0, 0, 0, 0, 0, 173, 0, 0, 0, 440,
+	0, 0, 274, 0, 0, 0, 0, 0, 279, 0,
+	0, 0, 0, 0, 0, 136, 200, 0, 135, 137,
+	141, 139, 146, 148, 133, 134, 108, 0, 91, 158,
+	0, 0, 0, 0, 239, 216, 0, 0, 212, 262,
+	158, 134, 134, 212, 200, 212, 0, 0, 0, 0,
+	0, 158, 158, 134, 0, 0, 0, 297, 212, 301,
+	158, 158, 134, 158, 134, 134, 212, 430, 210, 207,
+	208, 211, 471, 472, 230, 232, 233, 234, 235, 237,
+	378, 380, 0, 0, 0, 0, 223, 224, 226, 227,
+	0, 250, 331, 333, 0, 354, 356, 357, 358, 360,
+	0, 127, 130, 126, 403, 0, 0, 0, 420, 434,
+	435, 0, 0, 0, 0, 270, 405, 411, 0, 0,
+	0, 0, 0, 0, 0, 167, 0, 0, 0, 0,
+	0, 369, 271, 0, 273, 443, 444, 276, 0, 278,
+	445, 446, 382, 465, 466, 467, 468, 469, 0, 152,
+	212, 0, 0, 0, 0, 0, 136, 109, 200, 242,
+	243, 244, 245, 206, 0, 0, 199, 201, 203, 240,
+	261, 134, 212, 212, 391, 212, 283, 0, 158, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 158, 134,
+	134, 212, 0, 295, 296, 300, 158, 134, 134, 212,
+	134, 212, 212, 387, 0, 0, 0, 0, 257, 258,
+	259, 260, 248, 0, 0, 336, 365, 336, 365, 0,
+	359, 125, 0, 0, 0, 0, 409, 0, 0, 0,
+	449, 450, 0, 0, 455, 456, 462, 118, 0, 122,
+	165, 166, 0, 0, 92, 170, 0, 0, 175, 269,
+	394, 0, 272, 277, 0, 200, 150, 0, 153, 154,
+	155, 0, 138, 142, 0, 147, 152, 212, 214, 215,
+	0, 204, 205, 212, 389, 390, 282, 158, 200, 304,
+	309, 311, 305, 0, 307, 308, 0, 0, 0, 158,
+	134, 212, 212, 317, 294, 134, 212, 212, 325, 212,
+	385, 386, 209, 0, 0, 379, 249, 0, 0, 0,
+	338, 0, 332, 365, 0, 0, 0, 338, 334, 0,
+	342, 343, 0, 0, 0, 0, 0, 0, 419, 0,
+	0, 458, 453, 120, 168, 169, 0, 171, 172, 368,
+	0, 212, 80, 0, 151, 156, 143, 0, 200, 238,
+	0, 202, 388, 200, 212, 0, 0, 0, 158, 158,
+	134, 212, 315, 316, 212, 323, 324, 384, 0, 0,
+	0, 251, 252, 369, 0, 337, 364, 0, 0, 0,
+	369, 0, 0, 400, 401, 407, 0, 0, 451, 452,
+	0, 0, 93, 0, 150, 0, 0, 0, 212, 213,
+	212, 303, 310, 306, 158, 134, 134, 212, 314, 322,
+	474, 473, 254, 348, 339, 340, 361, 366, 362, 363,
+	344, 0, 399, 0, 0, 0, 457, 454, 406, 78,
+	0, 144, 0, 150, 302, 134, 212, 212, 321, 253,
+	255, 329, 349, 377, 0, 346, 345, 0, 365, 402,
+	408, 0, 417, 149, 145, 79, 212, 319, 320, 256,
+	374, 373, 0, 367, 348, 347, 0, 370, 335, 0,
+	0, 318, 377, 0, 350, 371, 0, 418, 372, 375,
+	376, 330, 0, 0, 351, 0, 416,
 }
 
 var yyTok1 = [...]int8{
@@ -1004,7 +1077,8 @@ var yyTok2 = [...]uint8{
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
 	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
-	152, 153, 154, 155, 156, 157, 158, 159,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168,
 }
 
 var yyTok3 = [...]int8{
@@ -1350,19 +1424,19 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:188
+//line sql.y:196
 		{
 			setParseTree(yylex, yyDollar[1].stmts)
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:194
+//line sql.y:202
 		{
 			yyVAL.stmts = []Statement{yyDollar[1].stmt}
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:198
+//line sql.y:206
 		{
 			if len(yyDollar[1].stmts) >= 1 {
 				yyVAL.stmts = yyDollar[1].stmts
@@ -1372,343 +1446,451 @@ yydefault:
 		}
 	case 4:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:206
+//line sql.y:214
 		{
 			yyVAL.stmts = append(yyDollar[1].stmts, yyDollar[3].stmt)
 		}
 	case 5:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:214
+//line sql.y:222
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:218
+//line sql.y:226
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:222
+//line sql.y:230
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:226
+//line sql.y:234
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 9:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:230
+//line sql.y:238
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:234
+//line sql.y:242
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:238
+//line sql.y:246
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:242
+//line sql.y:250
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:246
+//line sql.y:254
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:250
+//line sql.y:258
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:254
+//line sql.y:262
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:258
+//line sql.y:266
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:262
+//line sql.y:270
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:266
+//line sql.y:274
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:270
+//line sql.y:278
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:274
+//line sql.y:282
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:278
+//line sql.y:286
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:282
+//line sql.y:290
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:286
+//line sql.y:294
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:290
+//line sql.y:298
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:294
+//line sql.y:302
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:298
+//line sql.y:306
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:302
+//line sql.y:310
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:306
+//line sql.y:314
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:310
+//line sql.y:318
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:314
+//line sql.y:322
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:318
+//line sql.y:326
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:322
+//line sql.y:330
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:326
+//line sql.y:334
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:330
+//line sql.y:338
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:334
+//line sql.y:342
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:338
+//line sql.y:346
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:342
+//line sql.y:350
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:346
+//line sql.y:354
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:350
+//line sql.y:358
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:354
+//line sql.y:362
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:358
+//line sql.y:366
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:362
+//line sql.y:370
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:366
+//line sql.y:374
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:370
+//line sql.y:378
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:374
+//line sql.y:382
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:378
+//line sql.y:386
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:382
+//line sql.y:390
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:386
+//line sql.y:394
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:390
+//line sql.y:398
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:394
+//line sql.y:402
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:398
+//line sql.y:406
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:402
+//line sql.y:410
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:406
+//line sql.y:414
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:410
+//line sql.y:418
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:414
+//line sql.y:422
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:418
+//line sql.y:426
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:422
+//line sql.y:430
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:426
+//line sql.y:434
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:430
+//line sql.y:438
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 60:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:442
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 61:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:446
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 62:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:450
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 63:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:454
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 64:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:458
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 65:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:462
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 66:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:466
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 67:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:470
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 68:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:474
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 69:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:478
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 70:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:482
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 71:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:486
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 72:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:490
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 73:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:494
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 74:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:498
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 75:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:502
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 76:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:506
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 77:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:510
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 78:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:436
+//line sql.y:516
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1749,9 +1931,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 61:
+	case 79:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:477
+//line sql.y:557
 		{
 			stmt := &SelectStatement{}
 			stmt.Hints = yyDollar[2].hints
@@ -1793,9 +1975,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 62:
+	case 80:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:519
+//line sql.y:599
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1823,147 +2005,147 @@ yydefault:
 			stmt.Location = yyDollar[9].location
 			yyVAL.stmt = stmt
 		}
-	case 63:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:550
+//line sql.y:630
 		{
 			yyVAL.fields = []*Field{yyDollar[1].field}
 		}
-	case 64:
+	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:554
+//line sql.y:634
 		{
 			yyVAL.fields = append([]*Field{yyDollar[1].field}, yyDollar[3].fields...)
 		}
-	case 65:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:560
+//line sql.y:640
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 66:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:564
+//line sql.y:644
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: TAG}}
 		}
-	case 67:
+	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:568
+//line sql.y:648
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: FIELD}}
 		}
-	case 68:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:572
+//line sql.y:652
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr}
 		}
-	case 69:
+	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:576
+//line sql.y:656
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 70:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:580
+//line sql.y:660
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 71:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:586
+//line sql.y:666
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 72:
+	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:590
+//line sql.y:670
 		{
 			c := yyDollar[1].expr.(*CaseWhenExpr)
 			c.Conditions = append(c.Conditions, yyDollar[2].expr.(*CaseWhenExpr).Conditions...)
 			c.Assigners = append(c.Assigners, yyDollar[2].expr.(*CaseWhenExpr).Assigners...)
 			yyVAL.expr = c
 		}
-	case 73:
+	case 91:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:599
+//line sql.y:679
 		{
 			c := &CaseWhenExpr{}
 			c.Conditions = []Expr{yyDollar[2].expr}
 			c.Assigners = []Expr{yyDollar[4].expr}
 			yyVAL.expr = c
 		}
-	case 74:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:608
+//line sql.y:688
 		{
 			yyVAL.fields = []*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}
 		}
-	case 75:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:612
+//line sql.y:692
 		{
 			yyVAL.fields = append([]*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}, yyDollar[3].fields...)
 		}
-	case 76:
+	case 94:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:618
+//line sql.y:698
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MUL), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 77:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:622
+//line sql.y:702
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(DIV), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 78:
+	case 96:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:626
+//line sql.y:706
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(ADD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 79:
+	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:630
+//line sql.y:710
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(SUB), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 80:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:634
+//line sql.y:714
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_XOR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 81:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:638
+//line sql.y:718
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MOD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 82:
+	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:642
+//line sql.y:722
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_AND), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 83:
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:646
+//line sql.y:726
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_OR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 84:
+	case 102:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:650
+//line sql.y:730
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 85:
+	case 103:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:654
+//line sql.y:734
 		{
 			if strings.ToLower(yyDollar[1].str) == "cast" {
 				if len(yyDollar[3].fields) != 1 {
@@ -1994,16 +2176,16 @@ yydefault:
 				yyVAL.expr = cols
 			}
 		}
-	case 86:
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:685
+//line sql.y:765
 		{
 			cols := &Call{Name: strings.ToLower(yyDollar[1].str)}
 			yyVAL.expr = cols
 		}
-	case 87:
+	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:690
+//line sql.y:770
 		{
 			switch s := yyDollar[2].expr.(type) {
 			case *NumberLiteral:
@@ -2017,98 +2199,98 @@ yydefault:
 			}
 
 		}
-	case 88:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:704
+//line sql.y:784
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 89:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:708
+//line sql.y:788
 		{
 			yyVAL.expr = &DurationLiteral{Val: yyDollar[1].tdur}
 		}
-	case 90:
+	case 108:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:712
+//line sql.y:792
 		{
 			c := yyDollar[2].expr.(*CaseWhenExpr)
 			c.Assigners = append(c.Assigners, yyDollar[4].expr)
 			yyVAL.expr = c
 		}
-	case 91:
+	case 109:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:718
+//line sql.y:798
 		{
 			yyVAL.expr = &VarRef{}
 		}
-	case 92:
+	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:724
+//line sql.y:804
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 93:
+	case 111:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:728
+//line sql.y:808
 		{
 			yyVAL.sources = nil
 		}
-	case 94:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:734
+//line sql.y:814
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 95:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:740
+//line sql.y:820
 		{
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 96:
+	case 114:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:744
+//line sql.y:824
 		{
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[3].sources...)
 		}
-	case 97:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:748
+//line sql.y:828
 		{
 			yyVAL.sources = yyDollar[1].sources
 
 		}
-	case 98:
+	case 116:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:753
+//line sql.y:833
 		{
 			yyVAL.sources = append(yyDollar[1].sources, yyDollar[3].sources...)
 		}
-	case 99:
+	case 117:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:757
+//line sql.y:837
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 100:
+	case 118:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:762
+//line sql.y:842
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[5].sources...)
 		}
-	case 101:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:767
+//line sql.y:847
 		{
 			yyVAL.sources = []Source{yyDollar[1].source}
 		}
-	case 102:
+	case 120:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:773
+//line sql.y:853
 		{
 			join := &Join{}
 			if len(yyDollar[1].sources) != 1 || len(yyDollar[4].sources) != 1 {
@@ -2119,9 +2301,9 @@ yydefault:
 			join.Condition = yyDollar[6].expr
 			yyVAL.source = join
 		}
-	case 103:
+	case 121:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:786
+//line sql.y:866
 		{
 			all_subquerys := []Source{}
 			for _, temp_stmt := range yyDollar[2].stmts {
@@ -2134,9 +2316,9 @@ yydefault:
 			}
 			yyVAL.sources = all_subquerys
 		}
-	case 104:
+	case 122:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:799
+//line sql.y:879
 		{
 			if len(yyDollar[2].stmts) != 1 {
 				yylex.Error("expexted SelectStatement length")
@@ -2153,72 +2335,72 @@ yydefault:
 			all_subquerys = append(all_subquerys, build_SubQuery)
 			yyVAL.sources = all_subquerys
 		}
-	case 105:
+	case 123:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:816
+//line sql.y:896
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 106:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:822
+//line sql.y:902
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 107:
+	case 125:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:828
+//line sql.y:908
 		{
 			mst := yyDollar[5].ment
 			mst.Database = yyDollar[1].str
 			mst.RetentionPolicy = yyDollar[3].str
 			yyVAL.ment = mst
 		}
-	case 108:
+	case 126:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:835
+//line sql.y:915
 		{
 			mst := yyDollar[4].ment
 			mst.RetentionPolicy = yyDollar[2].str
 			yyVAL.ment = mst
 		}
-	case 109:
+	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:841
+//line sql.y:921
 		{
 			mst := yyDollar[4].ment
 			mst.Database = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 110:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:847
+//line sql.y:927
 		{
 			mst := yyDollar[3].ment
 			mst.RetentionPolicy = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 111:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:853
+//line sql.y:933
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 112:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:859
+//line sql.y:939
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 113:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:863
+//line sql.y:943
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 114:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:867
+//line sql.y:947
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2227,69 +2409,69 @@ yydefault:
 
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 115:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:878
+//line sql.y:958
 		{
 			yyVAL.dimens = yyDollar[3].dimens
 		}
-	case 116:
+	case 134:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:882
+//line sql.y:962
 		{
 			yyVAL.dimens = nil
 		}
-	case 117:
+	case 135:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:888
+//line sql.y:968
 		{
 			yyVAL.dimens = yyDollar[2].dimens
 		}
-	case 118:
+	case 136:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:892
+//line sql.y:972
 		{
 			yyVAL.dimens = nil
 		}
-	case 119:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:898
+//line sql.y:978
 		{
 			yyVAL.dimens = []*Dimension{yyDollar[1].dimen}
 		}
-	case 120:
+	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:902
+//line sql.y:982
 		{
 			yyVAL.dimens = append([]*Dimension{yyDollar[1].dimen}, yyDollar[3].dimens...)
 		}
-	case 121:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:908
+//line sql.y:988
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 122:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:912
+//line sql.y:992
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 123:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:918
+//line sql.y:998
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 124:
+	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:922
+//line sql.y:1002
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 125:
+	case 143:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:926
+//line sql.y:1006
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2297,9 +2479,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}}}}
 		}
-	case 126:
+	case 144:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:934
+//line sql.y:1014
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2307,9 +2489,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: yyDollar[5].tdur}}}}
 		}
-	case 127:
+	case 145:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:942
+//line sql.y:1022
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2317,21 +2499,21 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: time.Duration(-yyDollar[6].tdur)}}}}
 		}
-	case 128:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:950
+//line sql.y:1030
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 129:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:954
+//line sql.y:1034
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 130:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:958
+//line sql.y:1038
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2339,9 +2521,9 @@ yydefault:
 			}
 			yyVAL.dimen = &Dimension{Expr: &RegexLiteral{Val: re}}
 		}
-	case 131:
+	case 149:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:969
+//line sql.y:1049
 		{
 			if strings.ToLower(yyDollar[1].str) != "tz" {
 				yylex.Error("Expect tz")
@@ -2352,45 +2534,45 @@ yydefault:
 			}
 			yyVAL.location = loc
 		}
-	case 132:
+	case 150:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:980
+//line sql.y:1060
 		{
 			yyVAL.location = nil
 		}
-	case 133:
+	case 151:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:986
+//line sql.y:1066
 		{
 			yyVAL.inter = yyDollar[3].inter
 		}
-	case 134:
+	case 152:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:990
+//line sql.y:1070
 		{
 			yyVAL.inter = "null"
 		}
-	case 135:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:996
+//line sql.y:1076
 		{
 			yyVAL.inter = yyDollar[1].str
 		}
-	case 136:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1000
+//line sql.y:1080
 		{
 			yyVAL.inter = yyDollar[1].int64
 		}
-	case 137:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1004
+//line sql.y:1084
 		{
 			yyVAL.inter = yyDollar[1].float64
 		}
-	case 138:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1008
+//line sql.y:1088
 		{
 			switch s := yyDollar[2].inter.(type) {
 			case int64:
@@ -2401,57 +2583,57 @@ yydefault:
 				yyVAL.inter = yyDollar[2].inter
 			}
 		}
-	case 139:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1021
+//line sql.y:1101
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 140:
+	case 158:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1025
+//line sql.y:1105
 		{
 			yyVAL.expr = nil
 		}
-	case 141:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1031
+//line sql.y:1111
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 142:
+	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1035
+//line sql.y:1115
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 143:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1041
+//line sql.y:1121
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 144:
+	case 162:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1045
+//line sql.y:1125
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 145:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1051
+//line sql.y:1131
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 146:
+	case 164:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1055
+//line sql.y:1135
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 147:
+	case 165:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1059
+//line sql.y:1139
 		{
 			ident := &VarRef{Val: yyDollar[1].str}
 			var expr, e Expr
@@ -2465,39 +2647,39 @@ yydefault:
 			}
 			yyVAL.expr = e
 		}
-	case 148:
+	case 166:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1073
+//line sql.y:1153
 		{
 			yyVAL.expr = &InCondition{Stmt: yyDollar[4].stmt.(*SelectStatement), Column: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 149:
+	case 167:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1077
+//line sql.y:1157
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 150:
+	case 168:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1081
+//line sql.y:1161
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 151:
+	case 169:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1085
+//line sql.y:1165
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 152:
+	case 170:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1089
+//line sql.y:1169
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 153:
+	case 171:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1093
+//line sql.y:1173
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2505,9 +2687,9 @@ yydefault:
 				Op:  MATCH,
 			}
 		}
-	case 154:
+	case 172:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1101
+//line sql.y:1181
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2515,9 +2697,9 @@ yydefault:
 				Op:  MATCHPHRASE,
 			}
 		}
-	case 155:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1111
+//line sql.y:1191
 		{
 			if yyDollar[2].int == NEQREGEX {
 				switch yyDollar[3].expr.(type) {
@@ -2528,123 +2710,123 @@ yydefault:
 			}
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 156:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1124
+//line sql.y:1204
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 157:
+	case 175:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1128
+//line sql.y:1208
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 158:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1134
+//line sql.y:1214
 		{
 			yyVAL.int = EQ
 		}
-	case 159:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1138
+//line sql.y:1218
 		{
 			yyVAL.int = NEQ
 		}
-	case 160:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1142
+//line sql.y:1222
 		{
 			yyVAL.int = LT
 		}
-	case 161:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1146
+//line sql.y:1226
 		{
 			yyVAL.int = LTE
 		}
-	case 162:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1150
+//line sql.y:1230
 		{
 			yyVAL.int = GT
 		}
-	case 163:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1154
+//line sql.y:1234
 		{
 			yyVAL.int = GTE
 		}
-	case 164:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1158
+//line sql.y:1238
 		{
 			yyVAL.int = EQREGEX
 		}
-	case 165:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1162
+//line sql.y:1242
 		{
 			yyVAL.int = NEQREGEX
 		}
-	case 166:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1166
+//line sql.y:1246
 		{
 			yyVAL.int = LIKE
 		}
-	case 167:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1172
+//line sql.y:1252
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 168:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1178
+//line sql.y:1258
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str}
 		}
-	case 169:
+	case 187:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1182
+//line sql.y:1262
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str, Type: yyDollar[3].dataType}
 		}
-	case 170:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1186
+//line sql.y:1266
 		{
 			yyVAL.expr = &NumberLiteral{Val: yyDollar[1].float64}
 		}
-	case 171:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1190
+//line sql.y:1270
 		{
 			yyVAL.expr = &IntegerLiteral{Val: yyDollar[1].int64}
 		}
-	case 172:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1194
+//line sql.y:1274
 		{
 			yyVAL.expr = &StringLiteral{Val: yyDollar[1].str}
 		}
-	case 173:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1198
+//line sql.y:1278
 		{
 			yyVAL.expr = &BooleanLiteral{Val: true}
 		}
-	case 174:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1202
+//line sql.y:1282
 		{
 			yyVAL.expr = &BooleanLiteral{Val: false}
 		}
-	case 175:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1206
+//line sql.y:1286
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2652,21 +2834,21 @@ yydefault:
 			}
 			yyVAL.expr = &RegexLiteral{Val: re}
 		}
-	case 176:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1214
+//line sql.y:1294
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str + "." + yyDollar[3].str, Type: Tag}
 		}
-	case 177:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1218
+//line sql.y:1298
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 178:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1224
+//line sql.y:1304
 		{
 			switch strings.ToLower(yyDollar[1].str) {
 			case "float":
@@ -2687,75 +2869,75 @@ yydefault:
 				yylex.Error("wrong field dataType")
 			}
 		}
-	case 179:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1245
+//line sql.y:1325
 		{
 			yyVAL.dataType = Tag
 		}
-	case 180:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1249
+//line sql.y:1329
 		{
 			yyVAL.dataType = AnyField
 		}
-	case 181:
+	case 199:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1255
+//line sql.y:1335
 		{
 			yyVAL.sortfs = yyDollar[3].sortfs
 		}
-	case 182:
+	case 200:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1259
+//line sql.y:1339
 		{
 			yyVAL.sortfs = nil
 		}
-	case 183:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1265
+//line sql.y:1345
 		{
 			yyVAL.sortfs = []*SortField{yyDollar[1].sortf}
 		}
-	case 184:
+	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1269
+//line sql.y:1349
 		{
 			yyVAL.sortfs = append([]*SortField{yyDollar[1].sortf}, yyDollar[3].sortfs...)
 		}
-	case 185:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1275
+//line sql.y:1355
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 186:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1279
+//line sql.y:1359
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: false}
 		}
-	case 187:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1283
+//line sql.y:1363
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 188:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1289
+//line sql.y:1369
 		{
 			yyVAL.intSlice = append(yyDollar[1].intSlice, yyDollar[2].intSlice...)
 		}
-	case 189:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1295
+//line sql.y:1375
 		{
 			yyVAL.int64 = yyDollar[1].int64
 		}
-	case 190:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1300
+//line sql.y:1380
 		{
 			if n, ok := yyDollar[1].expr.(*IntegerLiteral); ok {
 				yyVAL.int64 = n.Val
@@ -2763,69 +2945,69 @@ yydefault:
 				yylex.Error("unsupported type, expect integer type")
 			}
 		}
-	case 191:
+	case 209:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1310
+//line sql.y:1390
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 192:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1314
+//line sql.y:1394
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 193:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1318
+//line sql.y:1398
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 194:
+	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1322
+//line sql.y:1402
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 195:
+	case 213:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1328
+//line sql.y:1408
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 196:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1332
+//line sql.y:1412
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 197:
+	case 215:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1336
+//line sql.y:1416
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 198:
+	case 216:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1340
+//line sql.y:1420
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 199:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1346
+//line sql.y:1426
 		{
 			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: false}
 		}
-	case 200:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1350
+//line sql.y:1430
 		{
 			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: true}
 		}
-	case 201:
+	case 219:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1356
+//line sql.y:1436
 		{
 			sms := yyDollar[4].stmt
 
@@ -2833,9 +3015,9 @@ yydefault:
 			sms.(*CreateDatabaseStatement).DatabaseAttr = yyDollar[5].databasePolicy
 			yyVAL.stmt = sms
 		}
-	case 202:
+	case 220:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1364
+//line sql.y:1444
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = false
@@ -2843,54 +3025,54 @@ yydefault:
 			stmt.DatabaseAttr = yyDollar[4].databasePolicy
 			yyVAL.stmt = stmt
 		}
-	case 203:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1374
+//line sql.y:1454
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: false}
 		}
-	case 204:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1379
+//line sql.y:1459
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: yyDollar[1].bool}
 		}
-	case 205:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1384
+//line sql.y:1464
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: yyDollar[3].bool}
 		}
-	case 206:
+	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1389
+//line sql.y:1469
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[3].int64), EnableTagArray: yyDollar[1].bool}
 		}
-	case 207:
+	case 225:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1393
+//line sql.y:1473
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: false}
 		}
-	case 208:
+	case 226:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1399
+//line sql.y:1479
 		{
 			if strings.ToLower(yyDollar[3].str) != "array" {
 				yylex.Error("unsupport type")
 			}
 			yyVAL.bool = true
 		}
-	case 209:
+	case 227:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1406
+//line sql.y:1486
 		{
 			yyVAL.bool = false
 		}
-	case 210:
+	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1413
+//line sql.y:1493
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = true
@@ -2929,15 +3111,15 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 211:
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1456
+//line sql.y:1536
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 212:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1460
+//line sql.y:1540
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -3010,56 +3192,56 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 213:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1535
+//line sql.y:1615
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 214:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1539
+//line sql.y:1619
 		{
 			duration := yyDollar[2].tdur
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyDuration: &duration}
 		}
-	case 215:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1544
+//line sql.y:1624
 		{
 			replicaN := int(yyDollar[2].int64)
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, Replication: &replicaN}
 		}
-	case 216:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1549
+//line sql.y:1629
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyName: yyDollar[2].str}
 		}
-	case 217:
+	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1553
+//line sql.y:1633
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, ReplicaNum: uint32(yyDollar[2].int64)}
 		}
-	case 218:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1557
+//line sql.y:1637
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: true}
 		}
-	case 219:
+	case 237:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1561
+//line sql.y:1641
 		{
 			if len(yyDollar[2].strSlice) == 0 {
 				yylex.Error("ShardKey should not be nil")
 			}
 			yyVAL.durations = &Durations{ShardKey: yyDollar[2].strSlice, ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: false}
 		}
-	case 220:
+	case 238:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1572
+//line sql.y:1652
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3070,9 +3252,9 @@ yydefault:
 			sms.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 221:
+	case 239:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1583
+//line sql.y:1663
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3082,38 +3264,38 @@ yydefault:
 			sms.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 222:
+	case 240:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1595
+//line sql.y:1675
 		{
 			sms := &ShowMeasurementsDetailStatement{}
 			sms.Database = yyDollar[4].str
 			sms.Source = yyDollar[7].ment
 			yyVAL.stmt = sms
 		}
-	case 223:
+	case 241:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1602
+//line sql.y:1682
 		{
 			sms := &ShowMeasurementsDetailStatement{}
 			sms.Database = yyDollar[4].str
 			yyVAL.stmt = sms
 		}
-	case 224:
+	case 242:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1611
+//line sql.y:1691
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 225:
+	case 243:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1615
+//line sql.y:1695
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 226:
+	case 244:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1619
+//line sql.y:1699
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3121,9 +3303,9 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 227:
+	case 245:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1627
+//line sql.y:1707
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3131,32 +3313,32 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 228:
+	case 246:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1639
+//line sql.y:1719
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{
 				Database: yyDollar[5].str,
 			}
 		}
-	case 229:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1645
+//line sql.y:1725
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{}
 		}
-	case 230:
+	case 248:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1652
+//line sql.y:1732
 		{
 			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 231:
+	case 249:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1659
+//line sql.y:1739
 		{
 			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
@@ -3164,18 +3346,18 @@ yydefault:
 			stmt.Default = true
 			yyVAL.stmt = stmt
 		}
-	case 232:
+	case 250:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1669
+//line sql.y:1749
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 233:
+	case 251:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1676
+//line sql.y:1756
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3183,9 +3365,9 @@ yydefault:
 			stmt.Admin = true
 			yyVAL.stmt = stmt
 		}
-	case 234:
+	case 252:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1684
+//line sql.y:1764
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3193,9 +3375,9 @@ yydefault:
 			stmt.Rwuser = true
 			yyVAL.stmt = stmt
 		}
-	case 235:
+	case 253:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1695
+//line sql.y:1775
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
@@ -3227,24 +3409,24 @@ yydefault:
 
 			yyVAL.stmt = stmt
 		}
-	case 236:
+	case 254:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1727
+//line sql.y:1807
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
 			stmt.Replication = int(yyDollar[4].int64)
 			yyVAL.stmt = stmt
 		}
-	case 237:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1737
+//line sql.y:1817
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 238:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1741
+//line sql.y:1821
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -3279,33 +3461,33 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 239:
+	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1779
+//line sql.y:1859
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: yyDollar[3].tdur, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 240:
+	case 258:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1783
+//line sql.y:1863
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: yyDollar[3].tdur, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 241:
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1787
+//line sql.y:1867
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: yyDollar[3].tdur, IndexGroupDuration: -1}
 		}
-	case 242:
+	case 260:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1791
+//line sql.y:1871
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: yyDollar[3].tdur}
 		}
-	case 243:
+	case 261:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1799
+//line sql.y:1879
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3316,9 +3498,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 244:
+	case 262:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1810
+//line sql.y:1890
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3328,57 +3510,57 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 245:
+	case 263:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1822
+//line sql.y:1902
 		{
 			yyVAL.stmt = &ShowUsersStatement{}
 		}
-	case 246:
+	case 264:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1828
+//line sql.y:1908
 		{
 			stmt := &DropDatabaseStatement{}
 			stmt.Name = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 247:
+	case 265:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1836
+//line sql.y:1916
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Sources = yyDollar[3].sources
 			stmt.Condition = yyDollar[4].expr
 			yyVAL.stmt = stmt
 		}
-	case 248:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1843
+//line sql.y:1923
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 249:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1851
+//line sql.y:1931
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Sources = yyDollar[2].sources
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 250:
+	case 268:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1858
+//line sql.y:1938
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Condition = yyDollar[2].expr
 			yyVAL.stmt = stmt
 		}
-	case 251:
+	case 269:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1867
+//line sql.y:1947
 		{
 			stmt := &AlterRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
@@ -3412,18 +3594,18 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 252:
+	case 270:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1905
+//line sql.y:1985
 		{
 			stmt := &DropRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 253:
+	case 271:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1914
+//line sql.y:1994
 		{
 			stmt := &GrantStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3431,9 +3613,9 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 254:
+	case 272:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1922
+//line sql.y:2002
 		{
 			stmt := &GrantStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3441,9 +3623,9 @@ yydefault:
 			stmt.User = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 255:
+	case 273:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1930
+//line sql.y:2010
 		{
 			stmt := &GrantStatement{}
 			switch strings.ToLower(yyDollar[2].str) {
@@ -3458,21 +3640,21 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 256:
+	case 274:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1947
+//line sql.y:2027
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[5].str}
 		}
-	case 257:
+	case 275:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1951
+//line sql.y:2031
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[4].str}
 		}
-	case 258:
+	case 276:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1957
+//line sql.y:2037
 		{
 			stmt := &RevokeStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3480,9 +3662,9 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 259:
+	case 277:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1965
+//line sql.y:2045
 		{
 			stmt := &RevokeStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3490,9 +3672,9 @@ yydefault:
 			stmt.User = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 260:
+	case 278:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1973
+//line sql.y:2053
 		{
 			stmt := &RevokeStatement{}
 			switch strings.ToLower(yyDollar[2].str) {
@@ -3507,27 +3689,27 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 261:
+	case 279:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1990
+//line sql.y:2070
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[5].str}
 		}
-	case 262:
+	case 280:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1994
+//line sql.y:2074
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[4].str}
 		}
-	case 263:
+	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2000
+//line sql.y:2080
 		{
 			yyVAL.stmt = &DropUserStatement{Name: yyDollar[3].str}
 		}
-	case 264:
+	case 282:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2006
+//line sql.y:2086
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3541,9 +3723,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 265:
+	case 283:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2020
+//line sql.y:2100
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3555,72 +3737,72 @@ yydefault:
 			stmt.SOffset = yyDollar[7].intSlice[3]
 			yyVAL.stmt = stmt
 		}
-	case 266:
+	case 284:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2034
+//line sql.y:2114
 		{
 			yyVAL.str = "PRIMARYKEY"
 		}
-	case 267:
+	case 285:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2038
+//line sql.y:2118
 		{
 			yyVAL.str = "SORTKEY"
 		}
-	case 268:
+	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2042
+//line sql.y:2122
 		{
 			yyVAL.str = "PROPERTY"
 		}
-	case 269:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2046
+//line sql.y:2126
 		{
 			yyVAL.str = "SHARDKEY"
 		}
-	case 270:
+	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2050
+//line sql.y:2130
 		{
 			yyVAL.str = "ENGINETYPE"
 		}
-	case 271:
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2054
+//line sql.y:2134
 		{
 			yyVAL.str = "SCHEMA"
 		}
-	case 272:
+	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2058
+//line sql.y:2138
 		{
 			yyVAL.str = "INDEXES"
 		}
-	case 273:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2062
+//line sql.y:2142
 		{
 			yyVAL.str = "COMPACT"
 		}
-	case 274:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2066
+//line sql.y:2146
 		{
 			yylex.Error("SHOW command error, only support PRIMARYKEY, SORTKEY, SHARDKEY, ENGINETYPE, INDEXES, SCHEMA, COMPACT")
 		}
-	case 275:
+	case 293:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2072
+//line sql.y:2152
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 276:
+	case 294:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2079
+//line sql.y:2159
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3629,9 +3811,9 @@ yydefault:
 			stmt.Measurement = yyDollar[8].str
 			yyVAL.stmt = stmt
 		}
-	case 277:
+	case 295:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2088
+//line sql.y:2168
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3639,9 +3821,9 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 278:
+	case 296:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2096
+//line sql.y:2176
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3649,30 +3831,30 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 279:
+	case 297:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2104
+//line sql.y:2184
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 280:
+	case 298:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2113
+//line sql.y:2193
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 281:
+	case 299:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2117
+//line sql.y:2197
 		{
 			yyVAL.str = ""
 		}
-	case 282:
+	case 300:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2123
+//line sql.y:2203
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3682,9 +3864,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 283:
+	case 301:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2133
+//line sql.y:2213
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3693,9 +3875,9 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 284:
+	case 302:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2145
+//line sql.y:2225
 		{
 			stmt := yyDollar[8].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3708,9 +3890,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 285:
+	case 303:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2158
+//line sql.y:2238
 		{
 			stmt := yyDollar[7].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3721,36 +3903,36 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 286:
+	case 304:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2171
+//line sql.y:2251
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 287:
+	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2178
+//line sql.y:2258
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 288:
+	case 306:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2185
+//line sql.y:2265
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = IN
 			stmt.TagKeyExpr = yyDollar[3].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 289:
+	case 307:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2192
+//line sql.y:2272
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQREGEX
@@ -3761,9 +3943,9 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 290:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2203
+//line sql.y:2283
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQREGEX
@@ -3774,47 +3956,47 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 291:
+	case 309:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2217
+//line sql.y:2297
 		{
 			temp := []string{yyDollar[1].str}
 			yyVAL.expr = &ListLiteral{Vals: temp}
 		}
-	case 292:
+	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2222
+//line sql.y:2302
 		{
 			yyDollar[3].expr.(*ListLiteral).Vals = append(yyDollar[3].expr.(*ListLiteral).Vals, yyDollar[1].str)
 			yyVAL.expr = yyDollar[3].expr
 		}
-	case 293:
+	case 311:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2229
+//line sql.y:2309
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 294:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2237
+//line sql.y:2317
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[3].stmt.(*SelectStatement)
 			stmt.Analyze = true
 			yyVAL.stmt = stmt
 		}
-	case 295:
+	case 313:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2244
+//line sql.y:2324
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[2].stmt.(*SelectStatement)
 			stmt.Analyze = false
 			yyVAL.stmt = stmt
 		}
-	case 296:
+	case 314:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2254
+//line sql.y:2334
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3826,9 +4008,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 297:
+	case 315:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2266
+//line sql.y:2346
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3839,9 +4021,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 298:
+	case 316:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2277
+//line sql.y:2357
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3853,9 +4035,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 299:
+	case 317:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2289
+//line sql.y:2369
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3866,9 +4048,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 300:
+	case 318:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line sql.y:2305
+//line sql.y:2385
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3885,9 +4067,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 301:
+	case 319:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2322
+//line sql.y:2402
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3902,9 +4084,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 302:
+	case 320:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2337
+//line sql.y:2417
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3921,9 +4103,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 303:
+	case 321:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2354
+//line sql.y:2434
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3938,9 +4120,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 304:
+	case 322:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2372
+//line sql.y:2452
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3952,9 +4134,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 305:
+	case 323:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2384
+//line sql.y:2464
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3965,9 +4147,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 306:
+	case 324:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2395
+//line sql.y:2475
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3979,9 +4161,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 307:
+	case 325:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2407
+//line sql.y:2487
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3992,9 +4174,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 308:
+	case 326:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2421
+//line sql.y:2501
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4017,9 +4199,9 @@ yydefault:
 
 			yyVAL.stmt = stmt
 		}
-	case 309:
+	case 327:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2444
+//line sql.y:2524
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4108,18 +4290,18 @@ yydefault:
 			stmt.CompactType = yyDollar[5].cmOption.CompactType
 			yyVAL.stmt = stmt
 		}
-	case 310:
+	case 328:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2534
+//line sql.y:2614
 		{
 			option := &CreateMeasurementStatementOption{}
 			option.Type = "hash"
 			option.EngineType = "tsstore"
 			yyVAL.cmOption = option
 		}
-	case 311:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2541
+	case 329:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2621
 		{
 			option := &CreateMeasurementStatementOption{}
 			if yyDollar[3].indexType != nil {
@@ -4132,11 +4314,14 @@ yydefault:
 			option.NumOfShards = yyDollar[5].int64
 			option.Type = yyDollar[6].str
 			option.EngineType = yyDollar[2].str
+			if yyDollar[7].strSlices != nil {
+				option.Property = yyDollar[7].strSlices
+			}
 			yyVAL.cmOption = option
 		}
-	case 312:
+	case 330:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2558
+//line sql.y:2641
 		{
 			option := &CreateMeasurementStatementOption{}
 			if yyDollar[3].indexType != nil {
@@ -4167,15 +4352,15 @@ yydefault:
 			option.CompactType = yyDollar[10].str
 			yyVAL.cmOption = option
 		}
-	case 313:
+	case 331:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2590
+//line sql.y:2673
 		{
 			yyVAL.indexType = nil
 		}
-	case 314:
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2594
+//line sql.y:2677
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["text"] = struct{}{}
@@ -4191,19 +4376,21 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 315:
+	case 333:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2611
+//line sql.y:2694
 		{
 			yyVAL.indexType = nil
 		}
-	case 316:
+	case 334:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2615
+//line sql.y:2698
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["bloomfilter"] = struct{}{}
 			validIndexType["minmax"] = struct{}{}
+			validIndexType["set"] = struct{}{}
+			validIndexType["ngram"] = struct{}{}
 			validIndexType["text"] = struct{}{}
 			if yyDollar[2].indexType == nil {
 				yyVAL.indexType = nil
@@ -4216,9 +4403,9 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 317:
+	case 335:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2632
+//line sql.y:2717
 		{
 			indexType := strings.ToLower(yyDollar[2].str)
 			if indexType != "timecluster" {
@@ -4233,6 +4420,8 @@ yydefault:
 			validIndexType := map[string]struct{}{}
 			validIndexType["bloomfilter"] = struct{}{}
 			validIndexType["minmax"] = struct{}{}
+			validIndexType["set"] = struct{}{}
+			validIndexType["ngram"] = struct{}{}
 			if yyDollar[6].indexType == nil {
 				yyVAL.indexType = indextype
 			} else {
@@ -4246,104 +4435,104 @@ yydefault:
 				yyVAL.indexType = indextype
 			}
 		}
-	case 318:
+	case 336:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2661
+//line sql.y:2748
 		{
 			yyVAL.strSlice = nil
 		}
-	case 319:
+	case 337:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2665
+//line sql.y:2752
 		{
 			shardKey := yyDollar[2].strSlice
 			sort.Strings(shardKey)
 			yyVAL.strSlice = shardKey
 		}
-	case 320:
+	case 338:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2672
+//line sql.y:2759
 		{
 			yyVAL.int64 = 0
 		}
-	case 321:
+	case 339:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2676
+//line sql.y:2763
 		{
 			yyVAL.int64 = -1
 		}
-	case 322:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2680
+//line sql.y:2767
 		{
 			if yyDollar[2].int64 == 0 {
 				yylex.Error("syntax error: NUM OF SHARDS SHOULD LARGER THAN 0")
 			}
 			yyVAL.int64 = yyDollar[2].int64
 		}
-	case 323:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2688
+//line sql.y:2775
 		{
 			yyVAL.str = "tsstore" // default engine type
 		}
-	case 324:
+	case 342:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2692
+//line sql.y:2779
 		{
 			yyVAL.str = "tsstore"
 		}
-	case 325:
+	case 343:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2698
+//line sql.y:2785
 		{
 			yyVAL.str = "columnstore"
 		}
-	case 326:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2703
+//line sql.y:2790
 		{
 			yyVAL.strSlice = nil
 		}
-	case 327:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2706
+//line sql.y:2793
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 328:
+	case 346:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2711
+//line sql.y:2798
 		{
 			yyVAL.strSlice = nil
 		}
-	case 329:
+	case 347:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2714
+//line sql.y:2801
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 330:
+	case 348:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2719
+//line sql.y:2806
 		{
 			yyVAL.strSlices = nil
 		}
-	case 331:
+	case 349:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2722
+//line sql.y:2809
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 332:
+	case 350:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2727
+//line sql.y:2814
 		{
 			yyVAL.str = "row"
 		}
-	case 333:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2731
+//line sql.y:2818
 		{
 			compactionType := strings.ToLower(yyDollar[2].str)
 			if compactionType != "row" && compactionType != "block" {
@@ -4352,9 +4541,9 @@ yydefault:
 			}
 			yyVAL.str = compactionType
 		}
-	case 334:
+	case 352:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2742
+//line sql.y:2829
 		{
 			stmt := &CreateMeasurementStatement{
 				Tags:   make(map[string]int32),
@@ -4383,40 +4572,40 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 335:
+	case 353:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2771
+//line sql.y:2858
 		{
 			yyVAL.stmt = nil
 		}
-	case 336:
+	case 354:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2777
+//line sql.y:2864
 		{
 			fields := []*fieldList{yyDollar[1].fieldOption}
 			yyVAL.fieldOptions = append(fields, yyDollar[2].fieldOptions...)
 		}
-	case 337:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2783
+//line sql.y:2870
 		{
 			yyVAL.fieldOptions = []*fieldList{yyDollar[1].fieldOption}
 		}
-	case 338:
+	case 356:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2789
+//line sql.y:2876
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 339:
+	case 357:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2794
+//line sql.y:2881
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 340:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2800
+//line sql.y:2887
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4424,9 +4613,9 @@ yydefault:
 				tagOrField: "tag",
 			}
 		}
-	case 341:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2809
+//line sql.y:2896
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4434,9 +4623,9 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 342:
+	case 360:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2818
+//line sql.y:2905
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4444,27 +4633,36 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 343:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2828
+//line sql.y:2915
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{yyDollar[1].str},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 344:
+	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2836
+//line sql.y:2923
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{"field"},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 345:
+	case 363:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2931
+		{
+			yyVAL.indexType = &IndexType{
+				types: []string{"set"},
+				lists: [][]string{yyDollar[3].strSlice},
+			}
+		}
+	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2845
+//line sql.y:2940
 		{
 			indextype := yyDollar[1].indexType
 			if yyDollar[2].indexType != nil {
@@ -4473,28 +4671,28 @@ yydefault:
 			}
 			yyVAL.indexType = indextype
 		}
-	case 346:
+	case 365:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2854
+//line sql.y:2949
 		{
 			yyVAL.indexType = nil
 		}
-	case 347:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2860
+//line sql.y:2955
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 348:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2864
+//line sql.y:2959
 		{
 
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 349:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2871
+//line sql.y:2966
 		{
 			shardType := strings.ToLower(yyDollar[2].str)
 			if shardType != "hash" && shardType != "range" {
@@ -4503,27 +4701,27 @@ yydefault:
 			}
 			yyVAL.str = shardType
 		}
-	case 350:
+	case 369:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2880
+//line sql.y:2975
 		{
 			yyVAL.str = "hash"
 		}
-	case 351:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2886
+//line sql.y:2981
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 352:
+	case 371:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2892
+//line sql.y:2987
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 353:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2898
+//line sql.y:2993
 		{
 			m := yyDollar[1].strSlices
 			if yyDollar[3].strSlices != nil {
@@ -4532,82 +4730,82 @@ yydefault:
 			}
 			yyVAL.strSlices = m
 		}
-	case 354:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2908
+//line sql.y:3003
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 355:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2914
+//line sql.y:3009
 		{
 			yyVAL.strSlices = yyDollar[2].strSlices
 		}
-	case 356:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2920
+//line sql.y:3015
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {yyDollar[3].str}}
 		}
-	case 357:
+	case 376:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2924
+//line sql.y:3019
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {fmt.Sprintf("%d", yyDollar[3].int64)}}
 		}
-	case 358:
+	case 377:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2928
+//line sql.y:3023
 		{
 			yyVAL.strSlices = nil
 		}
-	case 359:
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2934
+//line sql.y:3029
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 360:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2938
+//line sql.y:3033
 		{
 			yyVAL.strSlice = append(yyDollar[1].strSlice, yyDollar[3].str)
 		}
-	case 361:
+	case 380:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2943
+//line sql.y:3038
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 362:
+	case 381:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2949
+//line sql.y:3044
 		{
 			stmt := &DropShardStatement{}
 			stmt.ID = uint64(yyDollar[3].int64)
 			yyVAL.stmt = stmt
 		}
-	case 363:
+	case 382:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2957
+//line sql.y:3052
 		{
 			stmt := &SetPasswordUserStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 364:
+	case 383:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2968
+//line sql.y:3063
 		{
 			stmt := &ShowGrantsForUserStatement{}
 			stmt.Name = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 365:
+	case 384:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2976
+//line sql.y:3071
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4619,9 +4817,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 366:
+	case 385:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2988
+//line sql.y:3083
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4632,9 +4830,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 367:
+	case 386:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2999
+//line sql.y:3094
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4646,9 +4844,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 368:
+	case 387:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3011
+//line sql.y:3106
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4659,9 +4857,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 369:
+	case 388:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3025
+//line sql.y:3120
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4673,9 +4871,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 370:
+	case 389:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3037
+//line sql.y:3132
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4686,9 +4884,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 371:
+	case 390:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3048
+//line sql.y:3143
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4700,9 +4898,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 372:
+	case 391:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3060
+//line sql.y:3155
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4713,23 +4911,23 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 373:
+	case 392:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3074
+//line sql.y:3169
 		{
 			stmt := &ShowShardsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 374:
+	case 393:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3079
+//line sql.y:3174
 		{
 			stmt := &ShowShardsStatement{mstInfo: yyDollar[4].ment}
 			yyVAL.stmt = stmt
 		}
-	case 375:
+	case 394:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3087
+//line sql.y:3182
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4740,9 +4938,9 @@ yydefault:
 			stmt.Type = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 376:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3098
+//line sql.y:3193
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4751,34 +4949,34 @@ yydefault:
 			stmt.Type = "hash"
 			yyVAL.stmt = stmt
 		}
-	case 377:
+	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3112
+//line sql.y:3207
 		{
 			stmt := &ShowShardGroupsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 378:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3119
+//line sql.y:3214
 		{
 			stmt := &DropMeasurementStatement{}
 			stmt.Name = yyDollar[3].str
 			stmt.RpName = ""
 			yyVAL.stmt = stmt
 		}
-	case 379:
+	case 398:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3126
+//line sql.y:3221
 		{
 			stmt := &DropMeasurementStatement{}
 			stmt.Name = yyDollar[5].str
 			stmt.RpName = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 380:
+	case 399:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3136
+//line sql.y:3231
 		{
 			stmt := &CreateContinuousQueryStatement{
 				Name:     yyDollar[4].str,
@@ -4791,64 +4989,80 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 381:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3151
+//line sql.y:3246
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 			}
 		}
-	case 382:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3157
+//line sql.y:3252
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleFor: yyDollar[3].tdur,
 			}
 		}
-	case 383:
+	case 402:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3163
+//line sql.y:3258
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 				ResampleFor:   yyDollar[5].tdur,
 			}
 		}
-	case 384:
+	case 403:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3170
+//line sql.y:3265
 		{
 			yyVAL.cqsp = nil
 		}
-	case 385:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3176
+//line sql.y:3271
 		{
 			yyVAL.stmt = &ShowContinuousQueriesStatement{}
 		}
-	case 386:
+	case 405:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3182
+//line sql.y:3277
 		{
 			yyVAL.stmt = &DropContinuousQueryStatement{
 				Name:     yyDollar[4].str,
 				Database: yyDollar[6].str,
 			}
 		}
-	case 387:
+	case 406:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:3285
+		{
+			stmt := &BackfillContinuousQueryStatement{
+				Name:     yyDollar[4].str,
+				Database: yyDollar[6].str,
+			}
+			var err error
+			if stmt.StartTime, err = parseBackfillTime(yyDollar[8].str); err != nil {
+				yylex.Error(err.Error())
+			} else if stmt.EndTime, err = parseBackfillTime(yyDollar[10].str); err != nil {
+				yylex.Error(err.Error())
+			}
+			yyVAL.stmt = stmt
+		}
+	case 407:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3190
+//line sql.y:3300
 		{
 			stmt := yyDollar[9].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[4].str
 			stmt.Ops = yyDollar[6].fields
 			yyVAL.stmt = stmt
 		}
-	case 388:
+	case 408:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:3197
+//line sql.y:3307
 		{
 			stmt := yyDollar[11].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[6].str
@@ -4856,65 +5070,65 @@ yydefault:
 			stmt.Ops = yyDollar[8].fields
 			yyVAL.stmt = stmt
 		}
-	case 389:
+	case 409:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3205
+//line sql.y:3315
 		{
 			stmt := yyDollar[7].stmt.(*CreateDownSampleStatement)
 			stmt.Ops = yyDollar[4].fields
 			yyVAL.stmt = stmt
 		}
-	case 390:
+	case 410:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3213
+//line sql.y:3323
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				RpName: yyDollar[4].str,
 			}
 		}
-	case 391:
+	case 411:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3219
+//line sql.y:3329
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName: yyDollar[4].str,
 				RpName: yyDollar[6].str,
 			}
 		}
-	case 392:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3226
+//line sql.y:3336
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DropAll: true,
 			}
 		}
-	case 393:
+	case 413:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3232
+//line sql.y:3342
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName:  yyDollar[4].str,
 				DropAll: true,
 			}
 		}
-	case 394:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3241
+//line sql.y:3351
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{}
 		}
-	case 395:
+	case 415:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3245
+//line sql.y:3355
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{
 				DbName: yyDollar[4].str,
 			}
 		}
-	case 396:
+	case 416:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3253
+//line sql.y:3363
 		{
 			yyVAL.stmt = &CreateDownSampleStatement{
 				Duration:       yyDollar[2].tdur,
@@ -4922,21 +5136,21 @@ yydefault:
 				TimeInterval:   yyDollar[9].tdurs,
 			}
 		}
-	case 397:
+	case 417:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3263
+//line sql.y:3373
 		{
 			yyVAL.tdurs = []time.Duration{yyDollar[1].tdur}
 		}
-	case 398:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3267
+//line sql.y:3377
 		{
 			yyVAL.tdurs = append([]time.Duration{yyDollar[1].tdur}, yyDollar[3].tdurs...)
 		}
-	case 399:
+	case 419:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3274
+//line sql.y:3384
 		{
 			stmt := &CreateStreamStatement{
 				Name:  yyDollar[3].str,
@@ -4958,9 +5172,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 400:
+	case 420:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3296
+//line sql.y:3406
 		{
 			stmt := &CreateStreamStatement{
 				Name:  yyDollar[3].str,
@@ -4981,112 +5195,310 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 401:
+	case 421:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3319
+//line sql.y:3429
 		{
 			yyVAL.stmt = &ShowStreamsStatement{}
 		}
-	case 402:
+	case 422:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3323
+//line sql.y:3433
 		{
 			yyVAL.stmt = &ShowStreamsStatement{Database: yyDollar[4].str}
 		}
-	case 403:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3329
+//line sql.y:3439
 		{
 			yyVAL.stmt = &DropStreamsStatement{Name: yyDollar[3].str}
 		}
-	case 404:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3334
+//line sql.y:3444
 		{
 			yyVAL.stmt = &ShowQueriesStatement{}
 		}
-	case 405:
+	case 425:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3449
+		{
+			yyVAL.stmt = &ShowCompactionsStatement{}
+		}
+	case 426:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3454
+		{
+			yyVAL.stmt = &ShowRepairsStatement{}
+		}
+	case 427:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3459
+		{
+			yyVAL.stmt = &ShowRebalanceStatement{}
+		}
+	case 428:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3464
+		{
+			yyVAL.stmt = &ShowDecommissionStatement{}
+		}
+	case 429:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3469
+		{
+			if strings.ToUpper(yyDollar[3].str) != "TOP" {
+				yylex.Error("expected TOP after SHOW CARDINALITY")
+			}
+			stmt := &ShowCardinalityTopStatement{}
+			stmt.Database = yyDollar[4].str
+			stmt.Limit = yyDollar[5].intSlice[0]
+			stmt.Offset = yyDollar[5].intSlice[1]
+			yyVAL.stmt = stmt
+		}
+	case 430:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3480
+		{
+			if strings.ToUpper(yyDollar[3].str) != "TOP" {
+				yylex.Error("expected TOP after SHOW CARDINALITY")
+			}
+			stmt := &ShowCardinalityTopStatement{}
+			stmt.Database = yyDollar[4].str
+			stmt.Sources = yyDollar[5].sources
+			stmt.Limit = yyDollar[6].intSlice[0]
+			stmt.Offset = yyDollar[6].intSlice[1]
+			yyVAL.stmt = stmt
+		}
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3339
+//line sql.y:3493
 		{
 			yyVAL.stmt = &KillQueryStatement{QueryID: uint64(yyDollar[3].int64)}
 		}
-	case 406:
+	case 432:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3499
+		{
+			if strings.ToUpper(yyDollar[2].str) != "NODE" {
+				yylex.Error("expected NODE after DECOMMISSION")
+			}
+			yyVAL.stmt = &DecommissionNodeStatement{NodeID: uint64(yyDollar[3].int64)}
+		}
+	case 433:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3508
+		{
+			if strings.ToUpper(yyDollar[3].str) != "NODE" {
+				yylex.Error("expected NODE after KILL DECOMMISSION")
+			}
+			yyVAL.stmt = &KillDecommissionStatement{NodeID: uint64(yyDollar[4].int64)}
+		}
+	case 434:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3517
+		{
+			yyVAL.stmt = &CreateReplicationStatement{Database: yyDollar[4].str, Target: yyDollar[6].str}
+		}
+	case 435:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3521
+		{
+			yyVAL.stmt = &CreateReplicationStatement{Database: yyDollar[4].str, Target: yyDollar[6].str, Standby: true}
+		}
+	case 436:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3527
+		{
+			yyVAL.stmt = &DropReplicationStatement{Database: yyDollar[4].str}
+		}
+	case 437:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3533
+		{
+			yyVAL.stmt = &ShowReplicationsStatement{}
+		}
+	case 438:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3539
+		{
+			yyVAL.stmt = &PromoteDatabaseStatement{Database: yyDollar[3].str}
+		}
+	case 439:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3545
+		{
+			yyVAL.stmt = &CreateTenantStatement{Name: yyDollar[3].str, Options: *yyDollar[4].tenantOptions}
+		}
+	case 440:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3551
+		{
+			yyVAL.stmt = &AlterTenantStatement{Name: yyDollar[3].str, Options: *yyDollar[5].tenantOptions}
+		}
+	case 441:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3557
+		{
+			yyVAL.stmt = &DropTenantStatement{Name: yyDollar[3].str}
+		}
+	case 442:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3563
+		{
+			yyVAL.stmt = &ShowTenantsStatement{}
+		}
+	case 443:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3569
+		{
+			yyVAL.stmt = &GrantTenantStatement{Tenant: yyDollar[6].str, Database: yyDollar[3].str}
+		}
+	case 444:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3573
+		{
+			yyVAL.stmt = &GrantTenantStatement{Tenant: yyDollar[6].str, User: yyDollar[3].str}
+		}
+	case 445:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3579
+		{
+			yyVAL.stmt = &RevokeTenantStatement{Tenant: yyDollar[6].str, Database: yyDollar[3].str}
+		}
+	case 446:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3583
+		{
+			yyVAL.stmt = &RevokeTenantStatement{Tenant: yyDollar[6].str, User: yyDollar[3].str}
+		}
+	case 447:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3589
+		{
+			yyVAL.tenantOptions = yyDollar[2].tenantOptions
+		}
+	case 448:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3593
+		{
+			opts := NewTenantOptions()
+			yyVAL.tenantOptions = &opts
+		}
+	case 449:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3600
+		{
+			opts := NewTenantOptions()
+			if err := opts.Set(yyDollar[1].str, yyDollar[3].int64); err != nil {
+				yylex.Error(err.Error())
+			}
+			yyVAL.tenantOptions = &opts
+		}
+	case 450:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3608
+		{
+			opts := NewTenantOptions()
+			if err := opts.Set(yyDollar[1].str, yyDollar[3].str); err != nil {
+				yylex.Error(err.Error())
+			}
+			yyVAL.tenantOptions = &opts
+		}
+	case 451:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3616
+		{
+			if err := yyDollar[1].tenantOptions.Set(yyDollar[3].str, yyDollar[5].int64); err != nil {
+				yylex.Error(err.Error())
+			}
+			yyVAL.tenantOptions = yyDollar[1].tenantOptions
+		}
+	case 452:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3623
+		{
+			if err := yyDollar[1].tenantOptions.Set(yyDollar[3].str, yyDollar[5].str); err != nil {
+				yylex.Error(err.Error())
+			}
+			yyVAL.tenantOptions = yyDollar[1].tenantOptions
+		}
+	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3345
+//line sql.y:3632
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 407:
+	case 454:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3349
+//line sql.y:3636
 		{
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 408:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3355
+//line sql.y:3642
 		{
 			yyVAL.str = "ALL"
 		}
-	case 409:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3359
+//line sql.y:3646
 		{
 			yyVAL.str = "ANY"
 		}
-	case 410:
+	case 457:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3365
+//line sql.y:3652
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str, Destinations: yyDollar[10].strSlice, Mode: yyDollar[9].str}
 		}
-	case 411:
+	case 458:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3369
+//line sql.y:3656
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: "", Destinations: yyDollar[8].strSlice, Mode: yyDollar[7].str}
 		}
-	case 412:
+	case 459:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3375
+//line sql.y:3662
 		{
 			yyVAL.stmt = &ShowSubscriptionsStatement{}
 		}
-	case 413:
+	case 460:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3381
+//line sql.y:3668
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: "", RetentionPolicy: ""}
 		}
-	case 414:
+	case 461:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3385
+//line sql.y:3672
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 415:
+	case 462:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3389
+//line sql.y:3676
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str}
 		}
-	case 416:
+	case 463:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3393
+//line sql.y:3680
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 417:
+	case 464:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3399
+//line sql.y:3686
 		{
 			stmt := &ShowConfigsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 418:
+	case 465:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3406
+//line sql.y:3693
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5094,9 +5506,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 419:
+	case 466:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3414
+//line sql.y:3701
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5104,9 +5516,9 @@ yydefault:
 			stmt.Value = yyDollar[6].int64
 			yyVAL.stmt = stmt
 		}
-	case 420:
+	case 467:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3422
+//line sql.y:3709
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5114,9 +5526,9 @@ yydefault:
 			stmt.Value = yyDollar[6].float64
 			yyVAL.stmt = stmt
 		}
-	case 421:
+	case 468:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3430
+//line sql.y:3717
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5124,9 +5536,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 422:
+	case 469:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3438
+//line sql.y:3725
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5134,17 +5546,17 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 423:
+	case 470:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3448
+//line sql.y:3735
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
 			yyVAL.stmt = stmt
 		}
-	case 424:
+	case 471:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3454
+//line sql.y:3741
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
@@ -5155,9 +5567,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 425:
+	case 472:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3465
+//line sql.y:3752
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5167,9 +5579,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 426:
+	case 473:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3475
+//line sql.y:3762
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5184,9 +5596,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 427:
+	case 474:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3490
+//line sql.y:3777
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodetype" {
@@ -293,6 +293,71 @@ func ApplyReSharding(data *Data, cmd *proto2.Command) error {
 	return data.ReSharding(info)
 }
 
+func ApplySplitShard(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_SplitShardCommand_Command)
+	v, ok := ext.(*proto2.SplitShardCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a SplitShardCommand", ext))
+	}
+	return data.SplitShard(v.GetDatabase(), v.GetRpName(), v.GetShardGroupID(), v.GetShardID(), v.GetSplitKey(), v.GetPtID())
+}
+
+func ApplySplitShardDone(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_SplitShardDoneCommand_Command)
+	v, ok := ext.(*proto2.SplitShardDoneCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a SplitShardDoneCommand", ext))
+	}
+	return data.SplitShardDone(v.GetDatabase(), v.GetRpName(), v.GetShardGroupID(), v.GetShardID())
+}
+
+func ApplyCreateReplication(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_CreateReplicationCommand_Command)
+	v, ok := ext.(*proto2.CreateReplicationCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a CreateReplicationCommand", ext))
+	}
+	return data.CreateReplication(v.GetDatabase(), v.GetTarget(), v.GetRole())
+}
+
+func ApplyDropReplication(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_DropReplicationCommand_Command)
+	v, ok := ext.(*proto2.DropReplicationCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a DropReplicationCommand", ext))
+	}
+	return data.DropReplication(v.GetDatabase(), v.GetPromote())
+}
+
+func ApplyUpdateReplicationCheckpoint(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_UpdateReplicationCheckpointCommand_Command)
+	v, ok := ext.(*proto2.UpdateReplicationCheckpointCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a UpdateReplicationCheckpointCommand", ext))
+	}
+	return data.UpdateReplicationCheckpoint(v.GetDatabase(), v.GetShardID(), v.GetSeq(), v.GetUpdateTime())
+}
+
+func ApplyRestoreDatabase(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_RestoreDatabaseCommand_Command)
+	v, ok := ext.(*proto2.RestoreDatabaseCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a RestoreDatabaseCommand", ext))
+	}
+	dbi := &DatabaseInfo{}
+	dbi.unmarshal(v.GetDatabase())
+	users := make([]UserInfo, len(v.GetUsers()))
+	for i := range v.GetUsers() {
+		users[i].unmarshal(v.GetUsers()[i])
+	}
+	streams := make([]*StreamInfo, len(v.GetStreams()))
+	for i := range v.GetStreams() {
+		streams[i] = &StreamInfo{}
+		streams[i].Unmarshal(v.GetStreams()[i])
+	}
+	return data.RestoreDatabase(dbi, users, streams, v.GetOverwrite())
+}
+
 func ApplyUpdateSchema(data *Data, cmd *proto2.Command) error {
 	ext, _ := proto.GetExtension(cmd, proto2.E_UpdateSchemaCommand_Command)
 	v, ok := ext.(*proto2.UpdateSchemaCommand)
@@ -481,6 +546,26 @@ func ApplyContinuousQueryReport(data *Data, cmd *proto2.Command) error {
 	return data.BatchUpdateContinuousQueryStat(v.GetCQStates())
 }
 
+func ApplyCreateContinuousQueryBackfill(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_CreateContinuousQueryBackfillCommand_Command)
+	v, ok := ext.(*proto2.CreateContinuousQueryBackfillCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a CreateContinuousQueryBackfillCommand", ext))
+	}
+
+	return data.CreateContinuousQueryBackfill(v.GetDatabase(), v.GetName(), v.GetStartTime(), v.GetEndTime())
+}
+
+func ApplyUpdateContinuousQueryBackfill(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_UpdateContinuousQueryBackfillCommand_Command)
+	v, ok := ext.(*proto2.UpdateContinuousQueryBackfillCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a UpdateContinuousQueryBackfillCommand", ext))
+	}
+
+	return data.UpdateContinuousQueryBackfill(v.GetDatabase(), v.GetName(), v.GetNextTime(), v.GetHost(), v.GetLastError())
+}
+
 func ApplySetNodeSegregateStatus(data *Data, cmd *proto2.Command) error {
 	ext, _ := proto.GetExtension(cmd, proto2.E_SetNodeSegregateStatusCommand_Command)
 	v, ok := ext.(*proto2.SetNodeSegregateStatusCommand)
@@ -525,3 +610,66 @@ func ApplyUpdateMeasurement(data *Data, cmd *proto2.Command) error {
 	}
 	return data.UpdateMeasurement(v.GetDb(), v.GetRp(), v.GetMst(), v.GetOptions())
 }
+
+func ApplyCreateTenant(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_CreateTenantCommand_Command)
+	v, ok := ext.(*proto2.CreateTenantCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a CreateTenantCommand", ext))
+	}
+	quota := TenantQuota{
+		MaxSeries:           v.GetMaxSeries(),
+		MaxStorageBytes:     v.GetMaxStorageBytes(),
+		MaxIngestRate:       v.GetMaxIngestRate(),
+		MaxQueryConcurrency: v.GetMaxQueryConcurrency(),
+	}
+	if v.GetAlter() {
+		return data.AlterTenant(v.GetName(), quota, v.GetNodes(), v.GetSetNodes())
+	}
+	return data.CreateTenant(v.GetName(), quota, v.GetNodes())
+}
+
+func ApplyDropTenant(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_DropTenantCommand_Command)
+	v, ok := ext.(*proto2.DropTenantCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a DropTenantCommand", ext))
+	}
+	return data.DropTenant(v.GetName())
+}
+
+func ApplyUpdateTenantMember(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_UpdateTenantMemberCommand_Command)
+	v, ok := ext.(*proto2.UpdateTenantMemberCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a UpdateTenantMemberCommand", ext))
+	}
+	if v.Database != nil {
+		if v.GetRemove() {
+			return data.RemoveTenantDatabase(v.GetName(), v.GetDatabase())
+		}
+		return data.AddTenantDatabase(v.GetName(), v.GetDatabase())
+	}
+	if v.GetRemove() {
+		return data.RemoveTenantUser(v.GetName(), v.GetUser())
+	}
+	return data.AddTenantUser(v.GetName(), v.GetUser())
+}
+
+func ApplyUpdateTenantUsage(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_UpdateTenantUsageCommand_Command)
+	v, ok := ext.(*proto2.UpdateTenantUsageCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a UpdateTenantUsageCommand", ext))
+	}
+	return data.UpdateTenantUsage(v.GetName(), v.GetSeries(), v.GetStorageBytes())
+}
+
+func ApplyUpdateDecommission(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_UpdateDecommissionCommand_Command)
+	v, ok := ext.(*proto2.UpdateDecommissionCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a UpdateDecommissionCommand", ext))
+	}
+	return data.UpdateDecommission(v.GetInfo())
+}
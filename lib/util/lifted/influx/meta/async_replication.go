@@ -0,0 +1,196 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+)
+
+// the roles of a database in the asynchronous replication between clusters
+const (
+	// the wal segments of the primary database are shipped to the target cluster
+	ReplicationPrimary = "primary"
+	// the standby database only accepts the writes shipped from the primary cluster until it is promoted
+	ReplicationStandby = "standby"
+)
+
+// ReplicationHeader is the http header of the writes shipped by the asynchronous replication,
+// the value is the host name of the source ts-store
+const ReplicationHeader = "X-Opengemini-Replication"
+
+var ErrReplicationNotFound = errors.New("replication not found")
+
+func ErrReplicationExists(db string) error {
+	return fmt.Errorf("replication of database %q already exists", db)
+}
+
+func ErrNotReplicationStandby(db string) error {
+	return fmt.Errorf("database %q is not a replication standby", db)
+}
+
+// ReplicationInfo is the asynchronous replication of a database to or from the other cluster
+type ReplicationInfo struct {
+	// the http address of the other cluster, e.g. http://192.168.0.1:8086
+	Target string
+	Role   string
+	// the last wal segment shipped of each shard, the shipping of the shard is resumed after it.
+	// The replicas of a partition share the checkpoint of the shard owned by the smallest pt of the replica group.
+	Checkpoints map[uint64]ReplicationCheckpoint // key: shard id
+}
+
+type ReplicationCheckpoint struct {
+	Seq        int64
+	UpdateTime int64
+}
+
+func (ri *ReplicationInfo) IsPrimary() bool {
+	return ri != nil && ri.Role == ReplicationPrimary
+}
+
+func (ri *ReplicationInfo) IsStandby() bool {
+	return ri != nil && ri.Role == ReplicationStandby
+}
+
+// Checkpoint returns the sequence of the last wal segment of the shard shipped to the target
+func (ri *ReplicationInfo) Checkpoint(shardID uint64) int64 {
+	if ri == nil {
+		return 0
+	}
+	return ri.Checkpoints[shardID].Seq
+}
+
+func (ri *ReplicationInfo) clone() *ReplicationInfo {
+	if ri == nil {
+		return nil
+	}
+	other := *ri
+	if ri.Checkpoints != nil {
+		other.Checkpoints = make(map[uint64]ReplicationCheckpoint, len(ri.Checkpoints))
+		for id, cp := range ri.Checkpoints {
+			other.Checkpoints[id] = cp
+		}
+	}
+	return &other
+}
+
+func (ri *ReplicationInfo) marshal() *proto2.ReplicationInfo {
+	pb := &proto2.ReplicationInfo{
+		Target: proto.String(ri.Target),
+		Role:   proto.String(ri.Role),
+	}
+	ids := make([]uint64, 0, len(ri.Checkpoints))
+	for id := range ri.Checkpoints {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	for _, id := range ids {
+		cp := ri.Checkpoints[id]
+		pb.Checkpoints = append(pb.Checkpoints, &proto2.ReplicationCheckpoint{
+			ShardID:    proto.Uint64(id),
+			Seq:        proto.Int64(cp.Seq),
+			UpdateTime: proto.Int64(cp.UpdateTime),
+		})
+	}
+	return pb
+}
+
+func (ri *ReplicationInfo) unmarshal(pb *proto2.ReplicationInfo) {
+	ri.Target = pb.GetTarget()
+	ri.Role = pb.GetRole()
+	if len(pb.GetCheckpoints()) > 0 {
+		ri.Checkpoints = make(map[uint64]ReplicationCheckpoint, len(pb.GetCheckpoints()))
+		for _, cp := range pb.GetCheckpoints() {
+			ri.Checkpoints[cp.GetShardID()] = ReplicationCheckpoint{Seq: cp.GetSeq(), UpdateTime: cp.GetUpdateTime()}
+		}
+	}
+}
+
+// CreateReplication configures the asynchronous replication of the database with the other cluster
+func (data *Data) CreateReplication(database, target, role string) error {
+	dbi, err := data.GetDatabase(database)
+	if err != nil {
+		return err
+	}
+	if role != ReplicationPrimary && role != ReplicationStandby {
+		return fmt.Errorf("invalid replication role %q", role)
+	}
+	if dbi.Replication != nil {
+		if dbi.Replication.Target == target && dbi.Replication.Role == role {
+			return nil
+		}
+		return ErrReplicationExists(database)
+	}
+	dbi.Replication = &ReplicationInfo{Target: target, Role: role}
+	return nil
+}
+
+// DropReplication removes the asynchronous replication of the database. The standby database is promoted
+// to accept the writes of the clients if promote is true.
+func (data *Data) DropReplication(database string, promote bool) error {
+	dbi, err := data.GetDatabase(database)
+	if err != nil {
+		return err
+	}
+	if promote && !dbi.Replication.IsStandby() {
+		return ErrNotReplicationStandby(database)
+	}
+	if dbi.Replication == nil {
+		return ErrReplicationNotFound
+	}
+	dbi.Replication = nil
+	return nil
+}
+
+// UpdateReplicationCheckpoint records the last wal segment of the shard shipped to the target,
+// the checkpoints of the shards which have been deleted are removed
+func (data *Data) UpdateReplicationCheckpoint(database string, shardID uint64, seq, updateTime int64) error {
+	dbi, err := data.GetDatabase(database)
+	if err != nil {
+		return err
+	}
+	ri := dbi.Replication
+	if !ri.IsPrimary() {
+		return ErrReplicationNotFound
+	}
+	if ri.Checkpoints == nil {
+		ri.Checkpoints = make(map[uint64]ReplicationCheckpoint)
+	}
+	if cp, ok := ri.Checkpoints[shardID]; ok && cp.Seq >= seq {
+		return nil
+	}
+	ri.Checkpoints[shardID] = ReplicationCheckpoint{Seq: seq, UpdateTime: updateTime}
+
+	shards := make(map[uint64]struct{})
+	dbi.WalkRetentionPolicy(func(rp *RetentionPolicyInfo) {
+		for i := range rp.ShardGroups {
+			for j := range rp.ShardGroups[i].Shards {
+				shards[rp.ShardGroups[i].Shards[j].ID] = struct{}{}
+			}
+		}
+	})
+	for id := range ri.Checkpoints {
+		if _, ok := shards[id]; !ok {
+			delete(ri.Checkpoints, id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util"
+	assert2 "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Data_CreateReplication(t *testing.T) {
+	data := initData()
+	require.NoError(t, data.CreateDatabase("foo", nil, nil, false, 1, nil))
+	require.NoError(t, data.CreateReplication("foo", "http://127.0.0.2:8086", ReplicationPrimary))
+	// the same replication is created again
+	require.NoError(t, data.CreateReplication("foo", "http://127.0.0.2:8086", ReplicationPrimary))
+	assert2.EqualError(t, data.CreateReplication("foo", "http://127.0.0.3:8086", ReplicationPrimary), ErrReplicationExists("foo").Error())
+	assert2.Error(t, data.CreateReplication("foo", "http://127.0.0.2:8086", "unknown"))
+	assert2.Error(t, data.CreateReplication("db_not_exist", "http://127.0.0.2:8086", ReplicationPrimary))
+	assert2.True(t, data.Database("foo").Replication.IsPrimary())
+	assert2.False(t, data.Database("foo").Replication.IsStandby())
+
+	// the primary database can not be promoted
+	assert2.EqualError(t, data.DropReplication("foo", true), ErrNotReplicationStandby("foo").Error())
+	require.NoError(t, data.DropReplication("foo", false))
+	assert2.Nil(t, data.Database("foo").Replication)
+	assert2.Equal(t, ErrReplicationNotFound, data.DropReplication("foo", false))
+
+	require.NoError(t, data.CreateReplication("foo", "http://127.0.0.2:8086", ReplicationStandby))
+	require.NoError(t, data.DropReplication("foo", true))
+	assert2.Nil(t, data.Database("foo").Replication)
+}
+
+func Test_Data_UpdateReplicationCheckpoint(t *testing.T) {
+	data := initData()
+	data.CreateDBPtView("foo")
+	require.NoError(t, generateMeasurement(data, "foo", "bar", "cpu"))
+	require.NoError(t, data.CreateShardGroup("foo", "bar", time.Unix(0, 0), util.Hot, config.TSSTORE, 0))
+	assert2.Equal(t, ErrReplicationNotFound, data.UpdateReplicationCheckpoint("foo", 1, 10, 1))
+
+	require.NoError(t, data.CreateReplication("foo", "http://127.0.0.2:8086", ReplicationPrimary))
+	require.NoError(t, data.UpdateReplicationCheckpoint("foo", 1, 10, 1))
+	// the checkpoint never goes back
+	require.NoError(t, data.UpdateReplicationCheckpoint("foo", 1, 5, 2))
+	ri := data.Database("foo").Replication
+	assert2.Equal(t, map[uint64]ReplicationCheckpoint{1: {Seq: 10, UpdateTime: 1}}, ri.Checkpoints)
+	assert2.Equal(t, int64(10), ri.Checkpoint(1))
+	assert2.Equal(t, int64(0), ri.Checkpoint(2))
+
+	// the checkpoint of the shard which does not exist is removed
+	require.NoError(t, data.UpdateReplicationCheckpoint("foo", 100, 20, 3))
+	assert2.Equal(t, map[uint64]ReplicationCheckpoint{1: {Seq: 10, UpdateTime: 1}}, ri.Checkpoints)
+
+	// the replication survives the marshal of the data
+	pb := data.Marshal(false)
+	other := &Data{}
+	other.Unmarshal(pb)
+	assert2.Equal(t, ri, other.Database("foo").Replication)
+	assert2.Equal(t, ri, data.Clone().Database("foo").Replication)
+}
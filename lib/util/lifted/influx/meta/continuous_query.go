@@ -15,6 +15,7 @@
 package meta
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 	"time"
@@ -68,6 +69,7 @@ func (data *Data) BatchUpdateContinuousQueryStat(cqStates []*proto2.CQState) err
 	for _, dbi := range data.Databases {
 		for _, cqStat := range cqStates {
 			if cqi, ok := dbi.ContinuousQueries[cqStat.GetName()]; ok {
+				cqi.UpdateContinuousQueryOwner(cqStat.GetHost(), cqStat.GetLastError(), cqStat.GetLastRunTime())
 				cqi.UpdateContinuousQueryStat(cqStat.GetLastRunTime())
 			}
 		}
@@ -75,14 +77,97 @@ func (data *Data) BatchUpdateContinuousQueryStat(cqStates []*proto2.CQState) err
 	return nil
 }
 
+// CreateContinuousQueryBackfill starts to backfill the windows of the continuous query within [start, end).
+// Only one backfill of a continuous query can be in progress.
+func (data *Data) CreateContinuousQueryBackfill(dbName, cqName string, start, end int64) error {
+	if start >= end {
+		return ErrInvalidBackfillTimeRange
+	}
+	dbi, err := data.GetDatabase(dbName)
+	if err != nil {
+		return err
+	}
+	cqi, ok := dbi.ContinuousQueries[cqName]
+	if !ok {
+		return ErrContinuousQueryNotFound
+	}
+	if cqi.Backfill != nil {
+		return ErrContinuousQueryBackfillExists
+	}
+	cqi.Backfill = &ContinuousQueryBackfillInfo{
+		StartTime: time.Unix(0, start),
+		EndTime:   time.Unix(0, end),
+		NextTime:  time.Unix(0, start),
+	}
+	return nil
+}
+
+// UpdateContinuousQueryBackfill records the progress of the backfill reported by the sql node running it.
+// The backfill is removed once all its windows are completed.
+func (data *Data) UpdateContinuousQueryBackfill(dbName, cqName string, next int64, host, lastErr string) error {
+	dbi, err := data.GetDatabase(dbName)
+	if err != nil {
+		return err
+	}
+	cqi, ok := dbi.ContinuousQueries[cqName]
+	if !ok {
+		return ErrContinuousQueryNotFound
+	}
+	if cqi.Backfill == nil {
+		// the backfill has been completed
+		return nil
+	}
+	// the backfill is replaced rather than updated in place, so the readers of the cached data see a consistent state
+	backfill := *cqi.Backfill
+	backfill.update(next, host, lastErr)
+	cqi.Backfill = &backfill
+	if backfill.Done() {
+		cqi.Backfill = nil
+	}
+	return nil
+}
+
+// ShowContinuousQueryBackfills shows the backfills in progress, it returns nil if there is no backfill.
+func (data *Data) ShowContinuousQueryBackfills() *models.Row {
+	row := &models.Row{
+		Name:    "continuous query backfills",
+		Columns: []string{"name", "database", "start", "end", "next", "progress", "host", "last_error"},
+	}
+	data.WalkDatabases(func(dbi *DatabaseInfo) {
+		dbi.WalkContinuousQuery(func(cq *ContinuousQueryInfo) {
+			b := cq.Backfill
+			if b == nil {
+				return
+			}
+			row.Values = append(row.Values, []interface{}{cq.Name, dbi.Name,
+				b.StartTime.UTC().Format(time.RFC3339), b.EndTime.UTC().Format(time.RFC3339),
+				b.NextTime.UTC().Format(time.RFC3339), fmt.Sprintf("%.2f%%", b.Progress()*100), b.Host, b.LastError})
+		})
+	})
+	if len(row.Values) == 0 {
+		return nil
+	}
+
+	sort.Slice(row.Values, func(i, j int) bool {
+		return row.Values[i][0].(string) < row.Values[j][0].(string)
+	})
+	return row
+}
+
 // ShowContinuousQueries shows all continuous queries group by db.
 func (data *Data) ShowContinuousQueries() (models.Rows, error) {
 	var rows []*models.Row
+	now := time.Now()
 
 	data.WalkDatabases(func(dbi *DatabaseInfo) {
-		row := &models.Row{Name: dbi.Name, Columns: []string{"name", "query"}}
+		row := &models.Row{Name: dbi.Name, Columns: []string{"name", "query", "owner", "last_run", "lag", "last_error"}}
 		dbi.WalkContinuousQuery(func(cq *ContinuousQueryInfo) {
-			row.Values = append(row.Values, []interface{}{cq.Name, cq.Query})
+			var lastRun, lag string
+			if cq.HasRun() {
+				lastRun = cq.LastRunTime.UTC().Format(time.RFC3339)
+				lag = now.Sub(cq.LastRunTime).Truncate(time.Second).String()
+			}
+			row.Values = append(row.Values, []interface{}{cq.Name, cq.Query, cq.Owner, lastRun, lag, cq.LastError})
 		})
 
 		sort.Slice(row.Values, func(i, j int) bool {
@@ -124,6 +209,12 @@ func (data *Data) DropContinuousQuery(cqName string, database string) (bool, err
 	return changed, nil
 }
 
+// ContinuousQueryStat is the state of a continuous query reported by the sql node running it.
+type ContinuousQueryStat struct {
+	LastRunTime int64
+	LastError   string
+}
+
 // ContinuousQueryInfo represents metadata about a continuous query.
 type ContinuousQueryInfo struct {
 	// Name of the continuous query to be created.
@@ -132,8 +223,18 @@ type ContinuousQueryInfo struct {
 	// String corresponding to continuous query statement
 	Query string
 
-	// Last successful run time
+	// Last successful run time, it is the end of the last completed window.
+	// The new owner of the continuous query resumes from it.
 	LastRunTime time.Time
+
+	// The sql node that runs the continuous query
+	Owner string
+
+	// The error of the last run, empty if the last run succeeded
+	LastError string
+
+	// The backfill in progress, nil if the continuous query is not being backfilled
+	Backfill *ContinuousQueryBackfillInfo
 }
 
 // Marshal serializes to a protobuf representation.
@@ -142,6 +243,11 @@ func (cqi *ContinuousQueryInfo) Marshal() *proto2.ContinuousQueryInfo {
 		Name:        proto.String(cqi.Name),
 		Query:       proto.String(cqi.Query),
 		LastRunTime: proto.Int64(cqi.LastRunTime.UnixNano()),
+		Owner:       proto.String(cqi.Owner),
+		LastError:   proto.String(cqi.LastError),
+	}
+	if cqi.Backfill != nil {
+		pb.Backfill = cqi.Backfill.Marshal()
 	}
 
 	return pb
@@ -152,14 +258,105 @@ func (cqi *ContinuousQueryInfo) unmarshal(pb *proto2.ContinuousQueryInfo) {
 	cqi.Name = pb.GetName()
 	cqi.Query = pb.GetQuery()
 	cqi.LastRunTime = time.Unix(0, pb.GetLastRunTime())
+	cqi.Owner = pb.GetOwner()
+	cqi.LastError = pb.GetLastError()
+	cqi.Backfill = nil
+	if pb.GetBackfill() != nil {
+		cqi.Backfill = &ContinuousQueryBackfillInfo{}
+		cqi.Backfill.unmarshal(pb.GetBackfill())
+	}
 }
 
 // Clone returns a deep copy of cqi.
 func (cqi ContinuousQueryInfo) Clone() *ContinuousQueryInfo {
 	other := cqi
+	if cqi.Backfill != nil {
+		backfill := *cqi.Backfill
+		other.Backfill = &backfill
+	}
 	return &other
 }
 
+// UpdateContinuousQueryStat updates the last run time, which never goes back, so a late report of
+// the previous owner does not make the windows be run again.
 func (cqi *ContinuousQueryInfo) UpdateContinuousQueryStat(lastRun int64) {
-	cqi.LastRunTime = time.Unix(0, lastRun)
+	if lastRun > cqi.LastRunTime.UnixNano() {
+		cqi.LastRunTime = time.Unix(0, lastRun)
+	}
+}
+
+// UpdateContinuousQueryOwner records the host running the continuous query and its last error. A report older than
+// the last run, e.g. the late report of the previous owner, does not reset them.
+func (cqi *ContinuousQueryInfo) UpdateContinuousQueryOwner(host, lastErr string, lastRun int64) {
+	if host == "" || lastRun < cqi.LastRunTime.UnixNano() {
+		return
+	}
+	cqi.Owner = host
+	cqi.LastError = lastErr
+}
+
+// HasRun returns whether the continuous query has completed any window
+func (cqi *ContinuousQueryInfo) HasRun() bool {
+	return !cqi.LastRunTime.IsZero() && cqi.LastRunTime.UnixNano() > 0
+}
+
+// ContinuousQueryBackfillInfo represents the progress of backfilling the historical windows of a continuous query.
+type ContinuousQueryBackfillInfo struct {
+	// The time range [StartTime, EndTime) to backfill
+	StartTime time.Time
+	EndTime   time.Time
+
+	// All windows before NextTime have been completed, the backfill resumes from it.
+	NextTime time.Time
+
+	// The sql node that runs the backfill
+	Host string
+
+	// The error of the last window, empty if the last window succeeded
+	LastError string
+}
+
+// Marshal serializes to a protobuf representation.
+func (bi *ContinuousQueryBackfillInfo) Marshal() *proto2.ContinuousQueryBackfillInfo {
+	return &proto2.ContinuousQueryBackfillInfo{
+		StartTime: proto.Int64(bi.StartTime.UnixNano()),
+		EndTime:   proto.Int64(bi.EndTime.UnixNano()),
+		NextTime:  proto.Int64(bi.NextTime.UnixNano()),
+		Host:      proto.String(bi.Host),
+		LastError: proto.String(bi.LastError),
+	}
+}
+
+// unmarshal deserializes from a protobuf representation.
+func (bi *ContinuousQueryBackfillInfo) unmarshal(pb *proto2.ContinuousQueryBackfillInfo) {
+	bi.StartTime = time.Unix(0, pb.GetStartTime())
+	bi.EndTime = time.Unix(0, pb.GetEndTime())
+	bi.NextTime = time.Unix(0, pb.GetNextTime())
+	bi.Host = pb.GetHost()
+	bi.LastError = pb.GetLastError()
+}
+
+// update records the progress, NextTime never goes back, so a late report of the previous host is ignored.
+func (bi *ContinuousQueryBackfillInfo) update(next int64, host, lastErr string) {
+	if next > bi.NextTime.UnixNano() {
+		bi.NextTime = time.Unix(0, next)
+	}
+	if host != "" {
+		bi.Host = host
+	}
+	bi.LastError = lastErr
+}
+
+// Done returns whether all windows of the backfill have been completed.
+func (bi *ContinuousQueryBackfillInfo) Done() bool {
+	return !bi.NextTime.Before(bi.EndTime)
+}
+
+// Progress returns the completed fraction of the backfill time range.
+func (bi *ContinuousQueryBackfillInfo) Progress() float64 {
+	total := bi.EndTime.Sub(bi.StartTime)
+	if total <= 0 || bi.Done() {
+		return 1
+	}
+	return float64(bi.NextTime.Sub(bi.StartTime)) / float64(total)
 }
@@ -193,7 +193,9 @@ type Data struct {
 	Databases     map[string]*DatabaseInfo
 	Streams       map[string]*StreamInfo
 	Users         []UserInfo
+	Tenants       map[string]*TenantInfo
 	MigrateEvents map[string]*MigrateEventInfo
+	Decommissions map[uint64]*DecommissionInfo
 
 	// Query ID range segment allocated by all sql nodes
 	QueryIDInit map[SQLHost]uint64 // {"127.0.0.1:8086": 0, "127.0.0.2:8086": 10w, "127.0.0.3:8086": 20w}, span is QueryIDSpan
@@ -386,6 +388,14 @@ func init() {
 		proto2.Command_RemoveNodeCommand:                {},
 		proto2.Command_UpdateReplicationCommand:         {},
 		proto2.Command_UpdateMeasurementCommand:         {},
+
+		proto2.Command_CreateContinuousQueryBackfillCommand: {},
+		proto2.Command_UpdateContinuousQueryBackfillCommand: {},
+
+		proto2.Command_CreateTenantCommand:       {},
+		proto2.Command_DropTenantCommand:         {},
+		proto2.Command_UpdateTenantMemberCommand: {},
+		proto2.Command_UpdateTenantUsageCommand:  {},
 	}
 }
 
@@ -1486,7 +1496,7 @@ func (data *Data) GetWriteNodeNum() uint32 {
 func (data *Data) GetWriteNode() []DataNode {
 	var writeNodes []DataNode
 	for i := 0; i < len(data.DataNodes); i++ {
-		if IsNodeWriter(data.DataNodes[i].Role) {
+		if IsNodeWriter(data.DataNodes[i].Role) && data.DataNodes[i].SegregateStatus != Decommissioning {
 			writeNodes = append(writeNodes, data.DataNodes[i])
 		}
 	}
@@ -1507,7 +1517,7 @@ func (data *Data) GetAliveWriteNode() []DataNode {
 
 // assign pts to all datanodes, because move db pt is not permitted in write-available-first policy and replication policy
 func assignPtForWAF(data *Data, name string) error {
-	writeNodes := data.GetWriteNode()
+	writeNodes := data.PinnedDataNodes(name, data.GetWriteNode())
 	if len(writeNodes) == 0 {
 		return errno.NewError(errno.DataNoAlive)
 	}
@@ -1523,7 +1533,7 @@ func assignPtForWAF(data *Data, name string) error {
 
 // assign pts to alive datanodes in shared-storage policy due to pts can balance by background balancer
 func assignPtForSS(data *Data, name string) error {
-	aliveDataNodes := data.GetAliveWriteNode()
+	aliveDataNodes := data.PinnedDataNodes(name, data.GetAliveWriteNode())
 	if len(aliveDataNodes) == 0 {
 		return errno.NewError(errno.DataNoAlive)
 	}
@@ -1689,6 +1699,10 @@ func (data *Data) DropDatabase(name string) {
 		delete(data.Users[i].Privileges, name)
 	}
 
+	if t := data.DatabaseTenant(name); t != nil {
+		t.Databases = removeSorted(t.Databases, name)
+	}
+
 	if data.PtView != nil {
 		delete(data.PtView, name)
 	}
@@ -2483,7 +2497,7 @@ func (data *Data) expandDBPtView(database string, ptNum uint32, newNode *DataNod
 
 	for ptId := oldDBPtNums; ptId < ptNum; ptId++ {
 		// set pt status offline, and set it online when assigned successfully
-		data.updatePtStatus(database, ptId, newNode.ID, Offline)
+		data.updatePtStatus(database, ptId, data.expandPtOwner(database, newNode.ID), Offline)
 	}
 
 	if replicaN > 1 {
@@ -2623,7 +2637,9 @@ func (data *Data) pruneShardGroups(id uint64) error {
 					pos := sort.Search(len(rp.ShardGroups[idx].Shards), func(i int) bool {
 						return rp.ShardGroups[idx].Shards[i].ID >= id
 					})
-					rp.ShardGroups[idx].Shards[pos].MarkDelete = true
+					if pos < len(rp.ShardGroups[idx].Shards) && rp.ShardGroups[idx].Shards[pos].ID == id {
+						rp.ShardGroups[idx].Shards[pos].MarkDelete = true
+					}
 				}
 
 				if !rp.ShardGroups[idx].DeletedAt.IsZero() && rp.ShardGroups[idx].canDelete() {
@@ -2798,6 +2814,9 @@ func (data *Data) DropUser(name string) error {
 	for i := range data.Users {
 		if data.Users[i].Name == name {
 			data.Users = append(data.Users[:i], data.Users[i+1:]...)
+			if t := data.UserTenant(name); t != nil {
+				t.Users = removeSorted(t.Users, name)
+			}
 			return nil
 		}
 	}
@@ -2904,6 +2923,8 @@ func (data *Data) Clone() *Data {
 	other.Databases = data.CloneDatabases()
 	other.Streams = data.CloneStreams()
 	other.Users = data.CloneUsers()
+	other.Tenants = data.CloneTenants()
+	other.Decommissions = data.CloneDecommissions()
 	other.PtView = data.CloneDBPtView()
 	other.MigrateEvents = data.CloneMigrateEvents()
 
@@ -3000,6 +3021,8 @@ func (data *Data) MarshalBase(snapshot bool) *proto2.Data {
 	for i := range data.Users {
 		pb.Users[i] = data.Users[i].marshal()
 	}
+	pb.Tenants = data.marshalTenants()
+	pb.Decommissions = data.marshalDecommissions()
 
 	pb.QueryIDInit = make(map[string]uint64, len(data.QueryIDInit))
 	for host := range data.QueryIDInit {
@@ -3098,6 +3121,17 @@ func (data *Data) Unmarshal(pb *proto2.Data) {
 		data.Users[i].unmarshal(x)
 	}
 
+	data.Tenants = nil
+	if tenants := pb.GetTenants(); len(tenants) > 0 {
+		data.Tenants = make(map[string]*TenantInfo, len(tenants))
+		for _, x := range tenants {
+			t := &TenantInfo{}
+			t.unmarshal(x)
+			data.Tenants[t.Name] = t
+		}
+	}
+	data.unmarshalDecommissions(pb.GetDecommissions())
+
 	data.MigrateEvents = make(map[string]*MigrateEventInfo, len(pb.GetMigrateEvents()))
 	for _, me := range pb.GetMigrateEvents() {
 		mei := &MigrateEventInfo{}
@@ -3681,6 +3715,7 @@ func (data *Data) MarshalUsers() *proto2.Data {
 	for i := range data.Users {
 		pb.Users[i] = data.Users[i].marshal()
 	}
+	pb.Tenants = data.marshalTenants()
 	return pb
 }
 
@@ -4153,5 +4188,6 @@ func LoadDurationOrDefault(duration *time.Duration, existDuration *time.Duration
 }
 
 func inShardGroup(group *ShardGroupInfo, shardID uint64) bool {
-	return shardID >= group.Shards[0].ID && shardID <= group.Shards[len(group.Shards)-1].ID
+	// the ids of the shards split in the group are not continuous
+	return group.Shard(shardID) != nil
 }
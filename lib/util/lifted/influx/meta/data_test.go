@@ -16,6 +16,7 @@ package meta
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -32,6 +33,7 @@ import (
 	"github.com/openGemini/openGemini/lib/errno"
 	logger1 "github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
 	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
@@ -221,11 +223,11 @@ func Test_Data_ReSharding(t *testing.T) {
 	}
 
 	shardgroups, err := data.ShardGroups("foo", "bar")
-	shards1 := []ShardInfo{{1, []uint32{0}, "", "", util.Hot, 1, 0, 0, false, false}}
+	shards1 := []ShardInfo{{1, []uint32{0}, "", "", util.Hot, 1, 0, 0, false, false, 0, false}}
 	sg1 := ShardGroupInfo{1, sg0.StartTime, sg0.EndTime,
 		sg0.DeletedAt, shards1, sg0.TruncatedAt, config.TSSTORE, 0}
-	shards2 := []ShardInfo{{2, []uint32{0}, "", "cpu,hostname=host_5", util.Hot, 3, 0, 0, false, false},
-		{3, []uint32{1}, "cpu,hostname=host_5", "", util.Hot, 4, 0, 0, false, false}}
+	shards2 := []ShardInfo{{2, []uint32{0}, "", "cpu,hostname=host_5", util.Hot, 3, 0, 0, false, false, 0, false},
+		{3, []uint32{1}, "cpu,hostname=host_5", "", util.Hot, 4, 0, 0, false, false, 0, false}}
 	sg2 := ShardGroupInfo{2, time.Unix(0, splitTime.UnixNano()+1).UTC(), sg0.EndTime,
 		sg0.DeletedAt, shards2, sg0.TruncatedAt, config.TSSTORE, 0}
 	expSgs := []ShardGroupInfo{sg1, sg2}
@@ -1286,6 +1288,225 @@ func TestData_CqReport(t *testing.T) {
 	}
 	err = data.BatchUpdateContinuousQueryStat(cqStat) // No cq1
 	assert2.NoError(t, err)
+
+	cqStat = []*proto2.CQState{
+		{
+			Name:        proto.String("cq0"),
+			LastRunTime: proto.Int64(ts.UnixNano()),
+			Host:        proto.String("127.0.0.1:8086"),
+			LastError:   proto.String("mock error"),
+		},
+	}
+	assert2.NoError(t, data.BatchUpdateContinuousQueryStat(cqStat))
+	cqi := data.Databases["db0"].ContinuousQueries["cq0"]
+	assert2.Equal(t, "127.0.0.1:8086", cqi.Owner)
+	assert2.Equal(t, "mock error", cqi.LastError)
+
+	// the late report of the previous owner does not move the last run time back or reset the owner
+	cqStat = []*proto2.CQState{
+		{
+			Name:        proto.String("cq0"),
+			LastRunTime: proto.Int64(ts.Add(-time.Hour).UnixNano()),
+			Host:        proto.String("127.0.0.2:8086"),
+		},
+	}
+	assert2.NoError(t, data.BatchUpdateContinuousQueryStat(cqStat))
+	assert2.True(t, ts.Equal(cqi.LastRunTime))
+	assert2.Equal(t, "127.0.0.1:8086", cqi.Owner)
+	assert2.Equal(t, "mock error", cqi.LastError)
+
+	other := &ContinuousQueryInfo{}
+	other.unmarshal(cqi.Marshal())
+	assert2.Equal(t, cqi.Owner, other.Owner)
+	assert2.Equal(t, cqi.LastError, other.LastError)
+
+	rows, err := data.ShowContinuousQueries()
+	assert2.NoError(t, err)
+	assert2.Equal(t, []string{"name", "query", "owner", "last_run", "lag", "last_error"}, rows[0].Columns)
+	assert2.Equal(t, "127.0.0.1:8086", rows[0].Values[0][2])
+	assert2.Equal(t, ts.UTC().Format(time.RFC3339), rows[0].Values[0][3])
+	assert2.Equal(t, "mock error", rows[0].Values[0][5])
+}
+
+func TestData_CqBackfill(t *testing.T) {
+	data := &Data{
+		Databases: map[string]*DatabaseInfo{
+			"db0": {
+				Name: "db0",
+				ContinuousQueries: map[string]*ContinuousQueryInfo{
+					"cq0": {
+						Name:  "cq0",
+						Query: `CREATE CONTINUOUS QUERY "cq0" ON "db0" BEGIN SELECT max("passengers") INTO "max_passengers" FROM "bus_data" GROUP BY time(1h) END`,
+					},
+				},
+			},
+		},
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(4 * time.Hour)
+
+	assert2.Equal(t, ErrInvalidBackfillTimeRange, data.CreateContinuousQueryBackfill("db0", "cq0", end.UnixNano(), start.UnixNano()))
+	assert2.Equal(t, ErrContinuousQueryNotFound, data.CreateContinuousQueryBackfill("db0", "cq1", start.UnixNano(), end.UnixNano()))
+	assert2.Nil(t, data.ShowContinuousQueryBackfills())
+
+	assert2.NoError(t, data.CreateContinuousQueryBackfill("db0", "cq0", start.UnixNano(), end.UnixNano()))
+	assert2.Equal(t, ErrContinuousQueryBackfillExists, data.CreateContinuousQueryBackfill("db0", "cq0", start.UnixNano(), end.UnixNano()))
+
+	// one window is done, and the next one failed
+	assert2.NoError(t, data.UpdateContinuousQueryBackfill("db0", "cq0", start.Add(time.Hour).UnixNano(), "127.0.0.1:8086", "mock error"))
+	// the late report of the previous host does not move the progress back
+	assert2.NoError(t, data.UpdateContinuousQueryBackfill("db0", "cq0", start.UnixNano(), "", "mock error"))
+	cqi := data.Databases["db0"].ContinuousQueries["cq0"]
+	assert2.True(t, start.Add(time.Hour).Equal(cqi.Backfill.NextTime))
+	assert2.Equal(t, 0.25, cqi.Backfill.Progress())
+
+	other := &ContinuousQueryInfo{}
+	other.unmarshal(cqi.Marshal())
+	assert2.Equal(t, *cqi.Backfill, *other.Backfill)
+	assert2.NotSame(t, cqi.Backfill, cqi.Clone().Backfill)
+
+	row := data.ShowContinuousQueryBackfills()
+	assert2.Equal(t, []string{"name", "database", "start", "end", "next", "progress", "host", "last_error"}, row.Columns)
+	assert2.Equal(t, []interface{}{"cq0", "db0", "2024-01-01T00:00:00Z", "2024-01-01T04:00:00Z", "2024-01-01T01:00:00Z",
+		"25.00%", "127.0.0.1:8086", "mock error"}, row.Values[0])
+
+	// the backfill is removed once completed
+	assert2.NoError(t, data.UpdateContinuousQueryBackfill("db0", "cq0", end.UnixNano(), "127.0.0.1:8086", ""))
+	assert2.Nil(t, cqi.Backfill)
+	assert2.Nil(t, data.ShowContinuousQueryBackfills())
+	assert2.NoError(t, data.UpdateContinuousQueryBackfill("db0", "cq0", end.UnixNano(), "127.0.0.1:8086", ""))
+}
+
+func TestData_Tenant(t *testing.T) {
+	DataLogger = logger.New(os.Stderr)
+	data := &Data{PtNumPerNode: 1}
+	for i := 1; i <= 3; i++ {
+		_, err := data.CreateDataNode(fmt.Sprintf("127.0.0.%d:8400", i), fmt.Sprintf("127.0.0.%d:8401", i), "", "")
+		require.NoError(t, err)
+	}
+	require.NoError(t, data.CreateUser("u0", "hash", false, false))
+	require.NoError(t, data.CreateUser("u1", "hash", false, false))
+
+	quota := TenantQuota{MaxSeries: 10, MaxStorageBytes: -1, MaxIngestRate: 100, MaxQueryConcurrency: 2}
+	require.Equal(t, ErrTenantNameRequired, data.CreateTenant("", quota, nil))
+	require.EqualError(t, data.CreateTenant("t0", quota, []uint64{9}), "data node not found: 9")
+	require.NoError(t, data.CreateTenant("t0", quota, []uint64{3, 2, 3}))
+	require.Equal(t, ErrTenantExists, data.CreateTenant("t0", quota, nil))
+	require.NoError(t, data.CreateTenant("t1", TenantQuota{}, nil))
+	t0 := data.Tenant("t0")
+	require.Equal(t, []uint64{2, 3}, t0.Nodes)
+	require.Equal(t, TenantQuota{MaxSeries: 10, MaxIngestRate: 100, MaxQueryConcurrency: 2}, t0.Quota)
+
+	// only the quotas set by the update are changed
+	update := NewTenantQuotaUpdate()
+	update.MaxStorageBytes = 1024
+	require.NoError(t, data.AlterTenant("t0", update, nil, false))
+	require.Equal(t, TenantQuota{MaxSeries: 10, MaxStorageBytes: 1024, MaxIngestRate: 100, MaxQueryConcurrency: 2}, t0.Quota)
+	require.Equal(t, []uint64{2, 3}, t0.Nodes)
+	require.Equal(t, ErrTenantNotFound, data.AlterTenant("t2", update, nil, false))
+
+	// the database is assigned before it is created, so its pts are placed on the pinned nodes
+	require.NoError(t, data.AddTenantDatabase("t0", "db0"))
+	require.Equal(t, ErrOwnedByOtherTenant("database", "db0", "t0"), data.AddTenantDatabase("t1", "db0"))
+	data.Databases = map[string]*DatabaseInfo{"db0": {Name: "db0", ReplicaN: 1}, "db1": {Name: "db1", ReplicaN: 1}}
+	_, err := data.CreateDBPtView("db0")
+	require.NoError(t, err)
+	for _, pt := range data.PtView["db0"] {
+		require.True(t, t0.HasNode(pt.Owner.NodeID))
+	}
+	_, err = data.CreateDBPtView("db1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), data.PtView["db1"][0].Owner.NodeID)
+
+	// the pt added for the new node is placed on the pinned node owning the fewest pts
+	owners := map[uint64]int{}
+	for _, pt := range data.PtView["db0"] {
+		owners[pt.Owner.NodeID]++
+	}
+	fewest := uint64(2)
+	if owners[3] < owners[2] {
+		fewest = 3
+	}
+	_, err = data.CreateDataNode("127.0.0.4:8400", "127.0.0.4:8401", "", "")
+	require.NoError(t, err)
+	require.Equal(t, fewest, data.PtView["db0"][3].Owner.NodeID)
+	require.Equal(t, uint64(4), data.PtView["db1"][3].Owner.NodeID)
+	for i := range data.DataNodes {
+		data.DataNodes[i].Status = serf.StatusAlive
+	}
+	for i := 0; i < 3; i++ {
+		data.PtView["db0"][i].Status = Online
+	}
+	pts := data.GetPinnedFailedPtInfos(4, Offline)
+	require.Equal(t, 1, len(pts))
+	require.Equal(t, "db0", pts[0].Db)
+	require.Equal(t, uint32(3), pts[0].Pti.PtId)
+	require.True(t, data.NodeAllowed("db0", 2))
+	require.False(t, data.NodeAllowed("db0", 4))
+	require.True(t, data.NodeAllowed("db1", 4))
+
+	require.NoError(t, data.AddTenantUser("t0", "u0"))
+	require.Equal(t, ErrUserNotFound, data.AddTenantUser("t0", "u2"))
+	require.Equal(t, ErrOwnedByOtherTenant("user", "u0", "t0"), data.AddTenantUser("t1", "u0"))
+	require.Same(t, t0, data.UserTenant("u0"))
+	require.Same(t, t0, data.DatabaseTenant("db0"))
+	require.Nil(t, data.DatabaseTenant("db1"))
+
+	require.NoError(t, data.UpdateTenantUsage("t0", 10, 100))
+	require.True(t, errno.Equal(t0.CheckWriteQuota(), errno.TenantSeriesQuotaExceeded))
+	require.NoError(t, data.UpdateTenantUsage("t0", 9, 1024))
+	require.True(t, errno.Equal(t0.CheckWriteQuota(), errno.TenantStorageQuotaExceeded))
+	require.NoError(t, data.UpdateTenantUsage("t0", 9, 100))
+	require.NoError(t, t0.CheckWriteQuota())
+
+	other := &Data{}
+	other.Unmarshal(data.Marshal(false))
+	require.Equal(t, data.Tenants, other.Tenants)
+	require.NotSame(t, t0, data.Clone().Tenants["t0"])
+
+	rows := data.ShowTenants()
+	require.Equal(t, []interface{}{"t0", int64(10), int64(9), int64(1024), int64(100), int64(100), int64(2), "2,3", "db0", "u0"},
+		rows[0].Values[0])
+	require.Equal(t, "t1", rows[0].Values[1][0])
+
+	// the memberships are removed with the database and the user
+	require.Equal(t, ErrTenantNotEmpty, data.DropTenant("t0"))
+	data.DropDatabase("db0")
+	require.NoError(t, data.DropUser("u0"))
+	require.Empty(t, t0.Databases)
+	require.Empty(t, t0.Users)
+	require.NoError(t, data.DropTenant("t0"))
+	require.Equal(t, ErrTenantNotFound, data.DropTenant("t0"))
+}
+
+func TestTenantInfo_AuthorizeQuery(t *testing.T) {
+	tenant := &TenantInfo{Name: "t0", Databases: []string{"db0"}}
+	for _, tt := range []struct {
+		sql      string
+		database string
+		err      string
+	}{
+		{sql: "SELECT * FROM cpu", database: "db0"},
+		{sql: "SELECT * FROM db0..cpu, db1..cpu", database: "db0", err: "database db1 is not owned by tenant t0"},
+		{sql: "SELECT * FROM cpu", database: "db1", err: "database db1 is not owned by tenant t0"},
+		{sql: "SHOW MEASUREMENTS ON db1", database: "db0", err: "database db1 is not owned by tenant t0"},
+		{sql: "SHOW DATABASES"},
+		{sql: "CREATE DATABASE db2"},
+		{sql: "DROP DATABASE db0"},
+		{sql: "DROP DATABASE db1", err: "database db1 is not owned by tenant t0"},
+		{sql: "CREATE USER u0 WITH PASSWORD 'pwd'"},
+		{sql: "SHOW TENANTS", err: "can not be executed in tenant t0"},
+		{sql: "GRANT DATABASE db1 TO TENANT t0", err: "can not be executed in tenant t0"},
+	} {
+		q, err := influxql.ParseQuery(tt.sql)
+		require.NoError(t, err, tt.sql)
+		err = tenant.AuthorizeQuery(tt.database, q)
+		if tt.err == "" {
+			require.NoError(t, err, tt.sql)
+		} else {
+			require.ErrorContains(t, err, tt.err, tt.sql)
+		}
+	}
 }
 
 func PrintMemUsage() {
@@ -3091,3 +3312,35 @@ func TestUpdatePtViewStatusForRep1(t *testing.T) {
 	assert2.Equal(t, SubHealth, data.ReplicaGroups["db0"][0].Status)
 	data.updatePtViewStatus(3, Offline)
 }
+
+func TestMeasurementOptions_ErrorBounds(t *testing.T) {
+	opt := &Options{FloatCodec: "alp", ErrorBounds: map[string]float64{"temp": 0.01, "hum": 0.5}}
+	buf, err := proto.Marshal(opt.Marshal())
+	require.NoError(t, err)
+
+	pb := &proto2.Options{}
+	require.NoError(t, proto.Unmarshal(buf, pb))
+	other := &Options{}
+	other.Unmarshal(pb)
+	require.Equal(t, opt.FloatCodec, other.FloatCodec)
+	require.Equal(t, opt.ErrorBounds, other.ErrorBounds)
+
+	msti := &MeasurementInfo{Options: opt, ShardKeys: []ShardKeyInfo{}}
+	clone := msti.clone()
+	clone.Options.ErrorBounds["temp"] = 1
+	require.Equal(t, 0.01, msti.Options.ErrorBounds["temp"])
+}
+
+func TestMeasurementOptions_Pipeline(t *testing.T) {
+	opt := &Options{}
+	require.NoError(t, json.Unmarshal([]byte(`{"ttl":3,"pipeline":{"processors":[{"type":"remove","fields":["a"]}]}}`), opt))
+	require.Equal(t, `{"processors":[{"type":"remove","fields":["a"]}]}`, string(opt.Pipeline))
+
+	buf, err := proto.Marshal(opt.Marshal())
+	require.NoError(t, err)
+	pb := &proto2.Options{}
+	require.NoError(t, proto.Unmarshal(buf, pb))
+	other := &Options{}
+	other.Unmarshal(pb)
+	require.Equal(t, opt.Pipeline, other.Pipeline)
+}
@@ -28,6 +28,7 @@ type DatabaseInfo struct {
 	ReplicaN               int
 	ContinuousQueries      map[string]*ContinuousQueryInfo // {"cqName": *ContinuousQueryInfo}
 	Options                *obs.ObsOptions
+	Replication            *ReplicationInfo
 }
 
 func NewDatabase(name string) *DatabaseInfo {
@@ -117,6 +118,7 @@ func (di DatabaseInfo) clone() *DatabaseInfo {
 		options := *di.Options
 		other.Options = &options
 	}
+	other.Replication = di.Replication.clone()
 
 	return &other
 }
@@ -150,6 +152,9 @@ func (di DatabaseInfo) marshal(snapshot bool) *proto2.DatabaseInfo {
 	if di.Options != nil {
 		pb.Options = MarshalObsOptions(di.Options)
 	}
+	if di.Replication != nil {
+		pb.Replication = di.Replication.marshal()
+	}
 
 	return pb
 }
@@ -189,6 +194,10 @@ func (di *DatabaseInfo) unmarshal(pb *proto2.DatabaseInfo) {
 	if pb.GetOptions() != nil {
 		di.Options = UnmarshalObsOptions(pb.GetOptions())
 	}
+	if pb.GetReplication() != nil {
+		di.Replication = &ReplicationInfo{}
+		di.Replication.unmarshal(pb.GetReplication())
+	}
 }
 
 type PtOwner struct {
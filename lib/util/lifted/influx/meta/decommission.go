@@ -0,0 +1,154 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+)
+
+// the commands of decommissioning a ts-store node sent to the meta leader
+const (
+	DecommissionStart  = "start"
+	DecommissionCancel = "cancel"
+	DecommissionShow   = "show"
+)
+
+// the states of decommissioning a ts-store node
+const (
+	DecommissionMoving    = "moving"
+	DecommissionVerifying = "verifying"
+	DecommissionDone      = "done"
+	DecommissionCanceled  = "canceled"
+)
+
+// DecommissionInfo is the progress of decommissioning a ts-store node, the node is removed from the meta data
+// after all of its pts are moved to the other nodes and verified. The progress is kept in the meta data,
+// so a new meta leader verifies the pts moved by the old one.
+type DecommissionInfo struct {
+	NodeID     uint64
+	State      string
+	TotalPts   int
+	MovedPts   int
+	StartTime  int64
+	UpdateTime int64
+	Error      string
+
+	// the pts moved off the node, they are verified on their new owners before the node is removed
+	Moved []DecommissionPt `json:"-"`
+}
+
+type DecommissionPt struct {
+	Db   string
+	PtId uint32
+}
+
+// AddMoved records the pt moved off the node, it returns false if the pt is already recorded
+func (d *DecommissionInfo) AddMoved(db string, ptId uint32) bool {
+	for _, pt := range d.Moved {
+		if pt.Db == db && pt.PtId == ptId {
+			return false
+		}
+	}
+	d.Moved = append(d.Moved, DecommissionPt{Db: db, PtId: ptId})
+	return true
+}
+
+func (d *DecommissionInfo) Clone() *DecommissionInfo {
+	other := *d
+	other.Moved = append([]DecommissionPt(nil), d.Moved...)
+	return &other
+}
+
+func (d *DecommissionInfo) Marshal() *proto2.DecommissionInfo {
+	pb := &proto2.DecommissionInfo{
+		NodeID:     proto.Uint64(d.NodeID),
+		State:      proto.String(d.State),
+		TotalPts:   proto.Int64(int64(d.TotalPts)),
+		MovedPts:   proto.Int64(int64(d.MovedPts)),
+		StartTime:  proto.Int64(d.StartTime),
+		UpdateTime: proto.Int64(d.UpdateTime),
+		Error:      proto.String(d.Error),
+	}
+	pb.Moved = make([]*proto2.DecommissionPt, len(d.Moved))
+	for i := range d.Moved {
+		pb.Moved[i] = &proto2.DecommissionPt{Db: proto.String(d.Moved[i].Db), PtId: proto.Uint32(d.Moved[i].PtId)}
+	}
+	return pb
+}
+
+func (d *DecommissionInfo) Unmarshal(pb *proto2.DecommissionInfo) {
+	d.NodeID = pb.GetNodeID()
+	d.State = pb.GetState()
+	d.TotalPts = int(pb.GetTotalPts())
+	d.MovedPts = int(pb.GetMovedPts())
+	d.StartTime = pb.GetStartTime()
+	d.UpdateTime = pb.GetUpdateTime()
+	d.Error = pb.GetError()
+	d.Moved = make([]DecommissionPt, len(pb.GetMoved()))
+	for i, pt := range pb.GetMoved() {
+		d.Moved[i] = DecommissionPt{Db: pt.GetDb(), PtId: pt.GetPtId()}
+	}
+}
+
+func (data *Data) marshalDecommissions() []*proto2.DecommissionInfo {
+	if len(data.Decommissions) == 0 {
+		return nil
+	}
+	infos := make([]*proto2.DecommissionInfo, 0, len(data.Decommissions))
+	for nodeId := range data.Decommissions {
+		infos = append(infos, data.Decommissions[nodeId].Marshal())
+	}
+	return infos
+}
+
+func (data *Data) unmarshalDecommissions(pb []*proto2.DecommissionInfo) {
+	data.Decommissions = nil
+	if len(pb) == 0 {
+		return
+	}
+	data.Decommissions = make(map[uint64]*DecommissionInfo, len(pb))
+	for _, x := range pb {
+		info := &DecommissionInfo{}
+		info.Unmarshal(x)
+		data.Decommissions[info.NodeID] = info
+	}
+}
+
+// CloneDecommissions returns a copy of the decommissions.
+func (data *Data) CloneDecommissions() map[uint64]*DecommissionInfo {
+	if data.Decommissions == nil {
+		return nil
+	}
+	infos := make(map[uint64]*DecommissionInfo, len(data.Decommissions))
+	for nodeId := range data.Decommissions {
+		infos[nodeId] = data.Decommissions[nodeId].Clone()
+	}
+	return infos
+}
+
+// UpdateDecommission sets the progress of decommissioning the node, the progress of the last decommission is replaced.
+func (data *Data) UpdateDecommission(pb *proto2.DecommissionInfo) error {
+	info := &DecommissionInfo{}
+	info.Unmarshal(pb)
+	if info.NodeID == 0 {
+		return ErrNodeIDRequired
+	}
+	if data.Decommissions == nil {
+		data.Decommissions = make(map[uint64]*DecommissionInfo)
+	}
+	data.Decommissions[info.NodeID] = info
+	return nil
+}
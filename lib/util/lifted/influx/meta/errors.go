@@ -158,6 +158,12 @@ var (
 
 	// ErrContinuosQueryConflict is returned when creating an already existing continuous query.
 	ErrContinuosQueryConflict = errors.New("continuous query conflicts with an existing continuous query")
+
+	// ErrContinuousQueryBackfillExists is returned when backfilling a continuous query which is being backfilled.
+	ErrContinuousQueryBackfillExists = errors.New("continuous query backfill is already in progress")
+
+	// ErrInvalidBackfillTimeRange is returned when the start of a backfill is not before the end.
+	ErrInvalidBackfillTimeRange = errors.New("backfill start time must be before the end time")
 )
 
 var (
@@ -173,6 +179,33 @@ func ErrInvalidSubscriptionURL(url string) error {
 	return fmt.Errorf("invalid subscription URL: %s", url)
 }
 
+var (
+	// ErrTenantNameRequired is returned when creating a tenant without a name.
+	ErrTenantNameRequired = errors.New("tenant name required")
+
+	// ErrTenantExists is returned when creating an already existing tenant.
+	ErrTenantExists = errors.New("tenant already exists")
+
+	// ErrTenantNotFound is returned when mutating a tenant that doesn't exist.
+	ErrTenantNotFound = errors.New("tenant not found")
+
+	// ErrTenantNotEmpty is returned when dropping a tenant which still owns databases.
+	ErrTenantNotEmpty = errors.New("tenant still owns databases")
+
+	// ErrInvalidTenantQuota is returned when a quota of a tenant is negative.
+	ErrInvalidTenantQuota = errors.New("tenant quota must be greater or equal than 0")
+)
+
+// ErrTenantNodeNotFound is returned when pinning a tenant to a node which is not a data node.
+func ErrTenantNodeNotFound(id uint64) error {
+	return fmt.Errorf("data node not found: %d", id)
+}
+
+// ErrOwnedByOtherTenant is returned when assigning a database or a user owned by another tenant.
+func ErrOwnedByOtherTenant(kind, name, tenant string) error {
+	return fmt.Errorf("%s %s is owned by tenant %s", kind, name, tenant)
+}
+
 var (
 	// ErrUserExists is returned when creating an already existing GetUser.
 	ErrUserExists = errors.New("user already exists")
@@ -16,6 +16,7 @@ package meta
 
 import (
 	"bytes"
+	"encoding/json"
 	"sort"
 	"sync"
 	"time"
@@ -73,6 +74,14 @@ func TransSplitChar(splitChar string) string {
 	return buf.String()
 }
 
+// Properties of a tsstore measurement, which are set by "CREATE MEASUREMENT ... WITH PROPERTY key=value"
+const (
+	PropertyFloatCodec = "float_codec"
+	PropertyIntCodec   = "int_codec"
+	// PropertyErrorBoundPrefix enables the lossy encoding of a float field, e.g. 'error_bound.temperature'='0.01'
+	PropertyErrorBoundPrefix = "error_bound."
+)
+
 type Options struct {
 	CaseInSensitive bool   `json:"case_insensitive"`
 	AppendMeta      bool   `json:"append_meta"`
@@ -82,6 +91,12 @@ type Options struct {
 	SplitChar       string `json:"split_char"`
 	TagsSplit       string `json:"tag_split_char"`
 	Ttl             int64  `json:"ttl"`
+	FloatCodec      string `json:"float_codec"`
+	IntCodec        string `json:"int_codec"`
+	// ErrorBounds is the max absolute error of the lossy float fields
+	ErrorBounds map[string]float64 `json:"error_bounds,omitempty"`
+	// Pipeline is the ingest pipeline of a logstream, it is kept as the raw JSON config
+	Pipeline json.RawMessage `json:"pipeline,omitempty"`
 }
 
 func (mo *Options) InitDefault() {
@@ -108,6 +123,10 @@ func (mo *Options) Marshal() *proto2.Options {
 		SplitChar:       proto.String(mo.SplitChar),
 		TagsSplit:       proto.String(mo.TagsSplit),
 		Ttl:             proto.Int64(mo.Ttl),
+		FloatCodec:      proto.String(mo.FloatCodec),
+		IntCodec:        proto.String(mo.IntCodec),
+		ErrorBounds:     mo.ErrorBounds,
+		Pipeline:        mo.Pipeline,
 	}
 }
 
@@ -120,6 +139,10 @@ func (mo *Options) Unmarshal(pb *proto2.Options) {
 	mo.TagsSplit = pb.GetTagsSplit()
 	mo.AppendMeta = pb.GetAppendMeta()
 	mo.Ttl = pb.GetTtl()
+	mo.FloatCodec = pb.GetFloatCodec()
+	mo.IntCodec = pb.GetIntCodec()
+	mo.ErrorBounds = pb.GetErrorBounds()
+	mo.Pipeline = pb.GetPipeline()
 }
 
 func (mo *Options) GetSplitChar() string {
@@ -458,6 +481,12 @@ func (msti *MeasurementInfo) clone() *MeasurementInfo {
 	}
 	if msti.Options != nil {
 		options := *msti.Options
+		if msti.Options.ErrorBounds != nil {
+			options.ErrorBounds = make(map[string]float64, len(msti.Options.ErrorBounds))
+			for field, bound := range msti.Options.ErrorBounds {
+				options.ErrorBounds[field] = bound
+			}
+		}
 		other.Options = &options
 	}
 	if msti.ObsOptions != nil {
@@ -585,6 +614,15 @@ func (msti *MeasurementInfo) CompatibleForLogkeeperColstore() {
 	if tagsSplitChar == "" {
 		tagsSplitChar = tokenizer.TAGS_SPLITTER_BEFORE
 	}
+	// the analyzers of the fields are configured when the logstream is created
+	analyzers := tokenizer.StandardAnalyzer
+	for i, oid := range msti.IndexRelation.Oids {
+		if oid == uint32(index.BloomFilterFullText) && i < len(msti.IndexRelation.IndexOptions) {
+			if opts := msti.IndexRelation.IndexOptions[i]; opts != nil && len(opts.Options) > 0 && opts.Options[0].Tokenizers != "" {
+				analyzers = opts.Options[0].Tokenizers
+			}
+		}
+	}
 	msti.IndexRelation.IndexOptions = make([]*influxql.IndexOptions, len(msti.IndexRelation.Oids))
 	for i, oid := range msti.IndexRelation.Oids {
 		if oid != uint32(index.BloomFilterFullText) {
@@ -593,7 +631,7 @@ func (msti *MeasurementInfo) CompatibleForLogkeeperColstore() {
 		msti.IndexRelation.IndexList[i] = &influxql.IndexList{IList: IList}
 		msti.IndexRelation.IndexOptions[i] = &influxql.IndexOptions{
 			Options: []*influxql.IndexOption{
-				{Tokens: contentSplit, TokensTable: splitTable, Tokenizers: "standard"},
+				{Tokens: contentSplit, TokensTable: splitTable, Tokenizers: analyzers},
 			}}
 	}
 }
@@ -0,0 +1,564 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/errno"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// MetaExportVersion is the version of the format of the exported meta data
+const MetaExportVersion = 1
+
+// MetaExport is the meta data exported to a readable file. It is used to diff two states of the meta data,
+// and to restore the meta data of a database after a mistake without restoring the whole meta node.
+type MetaExport struct {
+	Version    int
+	ExportTime time.Time
+	ClusterID  uint64
+	Term       uint64
+	Index      uint64
+
+	Databases map[string]*DatabaseInfo
+	Users     []UserInfo
+	Streams   map[string]*StreamInfo
+}
+
+// Export exports the meta data of the databases, all the databases are exported if databases is empty.
+// Only the users which have privileges on the databases are exported with the given databases.
+func (data *Data) Export(databases ...string) (*MetaExport, error) {
+	exp := &MetaExport{
+		Version:    MetaExportVersion,
+		ExportTime: time.Now().UTC(),
+		ClusterID:  data.ClusterID,
+		Term:       data.Term,
+		Index:      data.Index,
+		Databases:  make(map[string]*DatabaseInfo),
+		Streams:    make(map[string]*StreamInfo),
+	}
+
+	if len(databases) == 0 {
+		for name := range data.Databases {
+			if !data.Databases[name].MarkDeleted {
+				databases = append(databases, name)
+			}
+		}
+		for i := range data.Users {
+			exp.Users = append(exp.Users, cloneUser(&data.Users[i]))
+		}
+	} else {
+		for i := range data.Users {
+			for _, db := range databases {
+				if _, ok := data.Users[i].Privileges[db]; ok {
+					exp.Users = append(exp.Users, cloneUser(&data.Users[i]))
+					break
+				}
+			}
+		}
+	}
+
+	for _, name := range databases {
+		dbi, err := data.GetDatabase(name)
+		if err != nil {
+			return nil, err
+		}
+		exp.Databases[name] = dbi.clone()
+	}
+	for name, si := range data.Streams {
+		if _, ok := exp.Databases[si.SrcMst.Database]; ok {
+			exp.Streams[name] = cloneStream(si)
+		}
+	}
+	return exp, nil
+}
+
+// RedactPasswords clears the password hashes of the exported users. The users created by restoring
+// the redacted export have no password until it is set again.
+func (exp *MetaExport) RedactPasswords() {
+	for i := range exp.Users {
+		exp.Users[i].Hash = ""
+	}
+}
+
+func cloneUser(u *UserInfo) UserInfo {
+	other := UserInfo{}
+	other.unmarshal(u.marshal())
+	return other
+}
+
+func cloneStream(si *StreamInfo) *StreamInfo {
+	other := &StreamInfo{}
+	other.Unmarshal(si.Marshal())
+	return other
+}
+
+// RestoreCommand returns the command restoring the meta data of the exported database,
+// including the retention policies, shard groups, continuous queries, downsample policies, the streams
+// reading from the database and the privileges of the users on the database.
+func (exp *MetaExport) RestoreCommand(database string, overwrite bool) (*proto2.Command, error) {
+	dbi, ok := exp.Databases[database]
+	if !ok {
+		return nil, errno.NewError(errno.DatabaseNotFound, database)
+	}
+	if dbi.Name != database {
+		return nil, fmt.Errorf("database name %q mismatch with %q", dbi.Name, database)
+	}
+
+	val := &proto2.RestoreDatabaseCommand{
+		Database:  dbi.marshal(false),
+		Overwrite: proto.Bool(overwrite),
+	}
+	for i := range exp.Users {
+		if _, ok := exp.Users[i].Privileges[database]; ok {
+			val.Users = append(val.Users, exp.Users[i].marshal())
+		}
+	}
+	names := make([]string, 0, len(exp.Streams))
+	for name, si := range exp.Streams {
+		if si.SrcMst != nil && si.SrcMst.Database == database {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		val.Streams = append(val.Streams, exp.Streams[name].Marshal())
+	}
+
+	t := proto2.Command_RestoreDatabaseCommand
+	cmd := &proto2.Command{Type: &t}
+	if err := proto.SetExtension(cmd, proto2.E_RestoreDatabaseCommand_Command, val); err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
+
+// RestoreDatabase restores the meta data of the database exported before. The existing database is replaced
+// only if overwrite is true. The users are created if they do not exist, and only their privileges on
+// the database are restored.
+func (data *Data) RestoreDatabase(dbi *DatabaseInfo, users []UserInfo, streams []*StreamInfo, overwrite bool) error {
+	if dbi.Name == "" {
+		return ErrDatabaseNameRequired
+	}
+	if old := data.Database(dbi.Name); old != nil {
+		if old.MarkDeleted {
+			return errno.NewError(errno.DatabaseIsBeingDelete, dbi.Name)
+		}
+		if !overwrite {
+			return ErrDatabaseExists
+		}
+	}
+	for _, si := range streams {
+		if old := data.Streams[si.Name]; old != nil && !overwrite && !old.Equal(si) {
+			return errno.NewError(errno.StreamHasExist)
+		}
+	}
+
+	// the pt view of the database which does not exist is created by the leader before restore
+	if err := data.checkRestoreDatabase(dbi); err != nil {
+		return err
+	}
+
+	dbi.MarkDeleted = false
+	if err := data.SetDatabase(dbi); err != nil {
+		return err
+	}
+	data.adjustMaxIDs(dbi)
+	data.MaxCQChangeID++
+	data.MaxSubscriptionID++
+
+	for i := range users {
+		privilege, ok := users[i].Privileges[dbi.Name]
+		if !ok {
+			continue
+		}
+		u := data.GetUser(users[i].Name)
+		if u == nil {
+			data.Users = append(data.Users, UserInfo{Name: users[i].Name, Hash: users[i].Hash, Rwuser: users[i].Rwuser})
+			u = &data.Users[len(data.Users)-1]
+		}
+		if u.Privileges == nil {
+			u.Privileges = make(map[string]originql.Privilege)
+		}
+		u.Privileges[dbi.Name] = privilege
+	}
+
+	if len(streams) > 0 && data.Streams == nil {
+		data.Streams = make(map[string]*StreamInfo)
+	}
+	for _, si := range streams {
+		data.Streams[si.Name] = si
+		data.MaxStreamID = max(data.MaxStreamID, si.ID+1)
+	}
+	return nil
+}
+
+// checkRestoreDatabase checks that the shards and the indexes of the database restored are not used by the other
+// databases, and they are owned by the pts of the database
+func (data *Data) checkRestoreDatabase(dbi *DatabaseInfo) error {
+	shards := make(map[uint64]string)
+	indexes := make(map[uint64]string)
+	for name, other := range data.Databases {
+		if name == dbi.Name {
+			continue
+		}
+		other.WalkRetentionPolicy(func(rp *RetentionPolicyInfo) {
+			for i := range rp.ShardGroups {
+				for j := range rp.ShardGroups[i].Shards {
+					shards[rp.ShardGroups[i].Shards[j].ID] = name
+				}
+			}
+			for i := range rp.IndexGroups {
+				for j := range rp.IndexGroups[i].Indexes {
+					indexes[rp.IndexGroups[i].Indexes[j].ID] = name
+				}
+			}
+		})
+	}
+
+	ptNum := uint32(len(data.PtView[dbi.Name]))
+	checkOwners := func(owners []uint32) error {
+		for _, ptId := range owners {
+			if ptId >= ptNum {
+				return errno.NewError(errno.PtNotFound)
+			}
+		}
+		return nil
+	}
+
+	var err error
+	dbi.WalkRetentionPolicy(func(rp *RetentionPolicyInfo) {
+		for i := 0; i < len(rp.ShardGroups) && err == nil; i++ {
+			for _, sh := range rp.ShardGroups[i].Shards {
+				if name, ok := shards[sh.ID]; ok {
+					err = fmt.Errorf("shard %d is used by database %q", sh.ID, name)
+				} else if !sh.MarkDelete {
+					err = checkOwners(sh.Owners)
+				}
+				if err != nil {
+					break
+				}
+			}
+		}
+		for i := 0; i < len(rp.IndexGroups) && err == nil; i++ {
+			for _, idx := range rp.IndexGroups[i].Indexes {
+				if name, ok := indexes[idx.ID]; ok {
+					err = fmt.Errorf("index %d is used by database %q", idx.ID, name)
+				} else if !idx.MarkDelete {
+					err = checkOwners(idx.Owners)
+				}
+				if err != nil {
+					break
+				}
+			}
+		}
+	})
+	return err
+}
+
+// adjustMaxIDs makes sure the ids allocated later are not used by the database restored
+func (data *Data) adjustMaxIDs(dbi *DatabaseInfo) {
+	dbi.WalkRetentionPolicy(func(rp *RetentionPolicyInfo) {
+		for i := range rp.ShardGroups {
+			data.MaxShardGroupID = max(data.MaxShardGroupID, rp.ShardGroups[i].ID)
+			for j := range rp.ShardGroups[i].Shards {
+				data.MaxShardID = max(data.MaxShardID, rp.ShardGroups[i].Shards[j].ID)
+			}
+		}
+		for i := range rp.IndexGroups {
+			data.MaxIndexGroupID = max(data.MaxIndexGroupID, rp.IndexGroups[i].ID)
+			for j := range rp.IndexGroups[i].Indexes {
+				data.MaxIndexID = max(data.MaxIndexID, rp.IndexGroups[i].Indexes[j].ID)
+			}
+		}
+		for _, msti := range rp.Measurements {
+			data.MaxMstID = max(data.MaxMstID, msti.ID+1)
+		}
+		if rp.DownSamplePolicyInfo != nil {
+			data.MaxDownSampleID = max(data.MaxDownSampleID, rp.DownSamplePolicyInfo.TaskID+1)
+		}
+	})
+}
+
+// the operations of the difference between two states of the meta data
+const (
+	MetaDiffAdded   = "+"
+	MetaDiffRemoved = "-"
+	MetaDiffChanged = "~"
+)
+
+// MetaDiff is a difference of the schema between two states of the meta data
+type MetaDiff struct {
+	Op     string
+	Kind   string // database, retention policy, measurement, field, shard group, continuous query, etc.
+	Name   string // the full name of the object, e.g. db0.rp0.cpu
+	Detail string // the attributes changed
+}
+
+func (d MetaDiff) String() string {
+	if d.Detail == "" {
+		return fmt.Sprintf("%s %s %s", d.Op, d.Kind, d.Name)
+	}
+	return fmt.Sprintf("%s %s %s: %s", d.Op, d.Kind, d.Name, d.Detail)
+}
+
+type metaDiffer struct {
+	diffs []MetaDiff
+}
+
+func (md *metaDiffer) add(op, kind, name, detail string) {
+	md.diffs = append(md.diffs, MetaDiff{Op: op, Kind: kind, Name: name, Detail: detail})
+}
+
+// attrs records the attributes of an object which are different between two states
+type attrs []string
+
+func (a *attrs) compare(name string, from, to interface{}) {
+	if !reflect.DeepEqual(from, to) {
+		*a = append(*a, fmt.Sprintf("%s %v -> %v", name, from, to))
+	}
+}
+
+func (a attrs) String() string {
+	return strings.Join(a, ", ")
+}
+
+// walkKeys calls fn with the sorted union of the keys of from and to, and whether the key exists in them
+func walkKeys[K cmp.Ordered, T any](from, to map[K]T, fn func(key K, inFrom, inTo bool)) {
+	keys := make([]K, 0, len(from)+len(to))
+	for k := range from {
+		keys = append(keys, k)
+	}
+	for k := range to {
+		if _, ok := from[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		_, inFrom := from[k]
+		_, inTo := to[k]
+		fn(k, inFrom, inTo)
+	}
+}
+
+// DiffMetaExport returns the differences of the schema from one exported state of the meta data to another,
+// e.g. the meta data exported before and after a mistake
+func DiffMetaExport(from, to *MetaExport) []MetaDiff {
+	md := &metaDiffer{}
+	walkKeys(from.Databases, to.Databases, func(name string, inFrom, inTo bool) {
+		switch {
+		case !inTo:
+			md.add(MetaDiffRemoved, "database", name, "")
+		case !inFrom:
+			md.add(MetaDiffAdded, "database", name, "")
+		default:
+			md.diffDatabase(from.Databases[name], to.Databases[name])
+		}
+	})
+
+	fromUsers, toUsers := usersByName(from.Users), usersByName(to.Users)
+	walkKeys(fromUsers, toUsers, func(name string, inFrom, inTo bool) {
+		switch {
+		case !inTo:
+			md.add(MetaDiffRemoved, "user", name, "")
+		case !inFrom:
+			md.add(MetaDiffAdded, "user", name, "")
+		default:
+			var a attrs
+			a.compare("admin", fromUsers[name].Admin, toUsers[name].Admin)
+			a.compare("privileges", privilegesString(fromUsers[name]), privilegesString(toUsers[name]))
+			if fromUsers[name].Hash != toUsers[name].Hash {
+				a = append(a, "password changed")
+			}
+			if len(a) > 0 {
+				md.add(MetaDiffChanged, "user", name, a.String())
+			}
+		}
+	})
+
+	walkKeys(from.Streams, to.Streams, func(name string, inFrom, inTo bool) {
+		switch {
+		case !inTo:
+			md.add(MetaDiffRemoved, "stream", name, "")
+		case !inFrom:
+			md.add(MetaDiffAdded, "stream", name, "")
+		case !from.Streams[name].Equal(to.Streams[name]):
+			md.add(MetaDiffChanged, "stream", name, "")
+		}
+	})
+	return md.diffs
+}
+
+func (md *metaDiffer) diffDatabase(from, to *DatabaseInfo) {
+	var a attrs
+	a.compare("default_rp", from.DefaultRetentionPolicy, to.DefaultRetentionPolicy)
+	a.compare("replicaN", from.ReplicaN, to.ReplicaN)
+	a.compare("shard_key", from.ShardKey.ShardKey, to.ShardKey.ShardKey)
+	a.compare("tag_array", from.EnableTagArray, to.EnableTagArray)
+	if len(a) > 0 {
+		md.add(MetaDiffChanged, "database", from.Name, a.String())
+	}
+
+	walkKeys(from.RetentionPolicies, to.RetentionPolicies, func(name string, inFrom, inTo bool) {
+		path := from.Name + "." + name
+		switch {
+		case !inTo:
+			md.add(MetaDiffRemoved, "retention policy", path, "")
+		case !inFrom:
+			md.add(MetaDiffAdded, "retention policy", path, "")
+		default:
+			md.diffRetentionPolicy(path, from.RetentionPolicies[name], to.RetentionPolicies[name])
+		}
+	})
+
+	walkKeys(from.ContinuousQueries, to.ContinuousQueries, func(name string, inFrom, inTo bool) {
+		path := from.Name + "." + name
+		switch {
+		case !inTo:
+			md.add(MetaDiffRemoved, "continuous query", path, "")
+		case !inFrom:
+			md.add(MetaDiffAdded, "continuous query", path, "")
+		case from.ContinuousQueries[name].Query != to.ContinuousQueries[name].Query:
+			md.add(MetaDiffChanged, "continuous query", path, to.ContinuousQueries[name].Query)
+		}
+	})
+}
+
+func (md *metaDiffer) diffRetentionPolicy(path string, from, to *RetentionPolicyInfo) {
+	var a attrs
+	a.compare("duration", from.Duration, to.Duration)
+	a.compare("shard_duration", from.ShardGroupDuration, to.ShardGroupDuration)
+	a.compare("index_duration", from.IndexGroupDuration, to.IndexGroupDuration)
+	a.compare("hot_duration", from.HotDuration, to.HotDuration)
+	a.compare("warm_duration", from.WarmDuration, to.WarmDuration)
+	a.compare("replicaN", from.ReplicaN, to.ReplicaN)
+	if len(a) > 0 {
+		md.add(MetaDiffChanged, "retention policy", path, a.String())
+	}
+
+	switch {
+	case from.DownSamplePolicyInfo == nil && to.DownSamplePolicyInfo != nil:
+		md.add(MetaDiffAdded, "downsample policy", path, "")
+	case from.DownSamplePolicyInfo != nil && to.DownSamplePolicyInfo == nil:
+		md.add(MetaDiffRemoved, "downsample policy", path, "")
+	case from.DownSamplePolicyInfo != nil && !from.DownSamplePolicyInfo.Equal(to.DownSamplePolicyInfo, false):
+		md.add(MetaDiffChanged, "downsample policy", path, "")
+	}
+
+	fromMsts, toMsts := measurementsByName(from), measurementsByName(to)
+	walkKeys(fromMsts, toMsts, func(name string, inFrom, inTo bool) {
+		mstPath := path + "." + name
+		switch {
+		case !inTo:
+			md.add(MetaDiffRemoved, "measurement", mstPath, "")
+		case !inFrom:
+			md.add(MetaDiffAdded, "measurement", mstPath, "")
+		default:
+			md.diffSchema(mstPath, fromMsts[name], toMsts[name])
+		}
+	})
+
+	fromSgs, toSgs := shardGroupsByID(from), shardGroupsByID(to)
+	walkKeys(fromSgs, toSgs, func(id uint64, inFrom, inTo bool) {
+		sgPath := fmt.Sprintf("%s.%d", path, id)
+		switch {
+		case !inTo:
+			md.add(MetaDiffRemoved, "shard group", sgPath, "")
+		case !inFrom:
+			sg := toSgs[id]
+			md.add(MetaDiffAdded, "shard group", sgPath, fmt.Sprintf("%s - %s, %d shards",
+				sg.StartTime.UTC().Format(time.RFC3339), sg.EndTime.UTC().Format(time.RFC3339), len(sg.Shards)))
+		default:
+			var a attrs
+			a.compare("shards", len(fromSgs[id].Shards), len(toSgs[id].Shards))
+			a.compare("deleted", fromSgs[id].Deleted(), toSgs[id].Deleted())
+			if len(a) > 0 {
+				md.add(MetaDiffChanged, "shard group", sgPath, a.String())
+			}
+		}
+	})
+}
+
+func (md *metaDiffer) diffSchema(path string, from, to *MeasurementInfo) {
+	var fromSchema, toSchema CleanSchema
+	if from.Schema != nil {
+		fromSchema = *from.Schema
+	}
+	if to.Schema != nil {
+		toSchema = *to.Schema
+	}
+	walkKeys(fromSchema, toSchema, func(name string, inFrom, inTo bool) {
+		fieldPath := path + "." + name
+		switch {
+		case !inTo:
+			md.add(MetaDiffRemoved, "field", fieldPath, "")
+		case !inFrom:
+			md.add(MetaDiffAdded, "field", fieldPath, influx.FieldTypeString(int32(toSchema[name].Typ)))
+		case fromSchema[name].Typ != toSchema[name].Typ:
+			md.add(MetaDiffChanged, "field", fieldPath, fmt.Sprintf("type %s -> %s",
+				influx.FieldTypeString(int32(fromSchema[name].Typ)), influx.FieldTypeString(int32(toSchema[name].Typ))))
+		}
+	})
+}
+
+func usersByName(users []UserInfo) map[string]*UserInfo {
+	m := make(map[string]*UserInfo, len(users))
+	for i := range users {
+		m[users[i].Name] = &users[i]
+	}
+	return m
+}
+
+func privilegesString(u *UserInfo) string {
+	dbs := make([]string, 0, len(u.Privileges))
+	for db := range u.Privileges {
+		dbs = append(dbs, db)
+	}
+	sort.Strings(dbs)
+	for i, db := range dbs {
+		dbs[i] = db + ":" + u.Privileges[db].String()
+	}
+	return "[" + strings.Join(dbs, " ") + "]"
+}
+
+// measurementsByName returns the measurements which are not deleted by the origin name
+func measurementsByName(rp *RetentionPolicyInfo) map[string]*MeasurementInfo {
+	m := make(map[string]*MeasurementInfo, len(rp.Measurements))
+	for _, msti := range rp.Measurements {
+		if !msti.MarkDeleted {
+			m[influx.GetOriginMstName(msti.Name)] = msti
+		}
+	}
+	return m
+}
+
+func shardGroupsByID(rp *RetentionPolicyInfo) map[uint64]*ShardGroupInfo {
+	m := make(map[uint64]*ShardGroupInfo, len(rp.ShardGroups))
+	for i := range rp.ShardGroups {
+		m[rp.ShardGroups[i].ID] = &rp.ShardGroups[i]
+	}
+	return m
+}
@@ -0,0 +1,137 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	assert2 "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func initExportData(t *testing.T) *Data {
+	data := initData()
+	_, err := data.CreateDBPtView("foo")
+	require.NoError(t, err)
+	require.NoError(t, generateMeasurement(data, "foo", "bar", "cpu"))
+	require.NoError(t, data.CreateShardGroup("foo", "bar", time.Unix(0, 0), util.Hot, config.TSSTORE, 0))
+	require.NoError(t, data.CreateContinuousQuery("foo", "cq0", `CREATE CONTINUOUS QUERY cq0 ON foo BEGIN SELECT mean(value) INTO cpu_1h FROM cpu GROUP BY time(1h) END`))
+	require.NoError(t, data.CreateUser("u1", "hash1", false, false))
+	require.NoError(t, data.SetPrivilege("u1", "foo", originql.ReadPrivilege))
+	require.NoError(t, data.CreateUser("u2", "hash2", false, false))
+	return data
+}
+
+func Test_Data_ExportAndRestoreDatabase(t *testing.T) {
+	data := initExportData(t)
+	_, err := data.Export("db_not_exist")
+	assert2.True(t, errno.Equal(err, errno.DatabaseNotFound))
+
+	exp, err := data.Export("foo")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(exp.Users))
+	assert2.Equal(t, "u1", exp.Users[0].Name)
+	all, err := data.Export()
+	require.NoError(t, err)
+	assert2.Equal(t, 2, len(all.Users))
+
+	// the exported file is json
+	b, err := json.Marshal(exp)
+	require.NoError(t, err)
+	exp = &MetaExport{}
+	require.NoError(t, json.Unmarshal(b, exp))
+
+	_, err = exp.RestoreCommand("db_not_exist", false)
+	assert2.True(t, errno.Equal(err, errno.DatabaseNotFound))
+	cmd, err := exp.RestoreCommand("foo", false)
+	require.NoError(t, err)
+	assert2.Equal(t, ErrDatabaseExists, ApplyRestoreDatabase(data, cmd))
+
+	// restore the database dropped by mistake
+	sg := data.Database("foo").RetentionPolicy("bar").ShardGroups[0]
+	data.DropDatabase("foo")
+	assert2.True(t, errno.Equal(ApplyRestoreDatabase(data, cmd), errno.PtNotFound))
+	_, err = data.CreateDBPtView("foo")
+	require.NoError(t, err)
+	data.MaxShardID, data.MaxShardGroupID = 0, 0
+	require.NoError(t, ApplyRestoreDatabase(data, cmd))
+
+	dbi := data.Database("foo")
+	require.NotNil(t, dbi)
+	assert2.Equal(t, sg.Shards, dbi.RetentionPolicy("bar").ShardGroups[0].Shards)
+	assert2.Equal(t, "cq0", dbi.ContinuousQueries["cq0"].Name)
+	assert2.Equal(t, originql.ReadPrivilege, data.GetUser("u1").Privileges["foo"])
+	assert2.Equal(t, sg.ID, data.MaxShardGroupID)
+	assert2.Equal(t, sg.Shards[len(sg.Shards)-1].ID, data.MaxShardID)
+	assert2.Equal(t, "cpu", dbi.RetentionPolicy("bar").Measurements["cpu_0000"].OriginName())
+
+	// the database is replaced with overwrite
+	cmd, err = exp.RestoreCommand("foo", true)
+	require.NoError(t, err)
+	require.NoError(t, ApplyRestoreDatabase(data, cmd))
+}
+
+func Test_Data_RestoreDatabase_Conflict(t *testing.T) {
+	data := initExportData(t)
+	exp, err := data.Export("foo")
+	require.NoError(t, err)
+	dbi := exp.Databases["foo"]
+
+	// the shards are used by the other database
+	dbi.Name = "foo2"
+	_, err = data.CreateDBPtView("foo2")
+	require.NoError(t, err)
+	assert2.EqualError(t, data.RestoreDatabase(dbi, nil, nil, false), `shard 1 is used by database "foo"`)
+	assert2.Equal(t, ErrDatabaseNameRequired, data.RestoreDatabase(&DatabaseInfo{}, nil, nil, false))
+}
+
+func Test_DiffMetaExport(t *testing.T) {
+	data := initExportData(t)
+	from, err := data.Export()
+	require.NoError(t, err)
+
+	require.NoError(t, data.CreateMeasurement("foo", "bar", "mem", nil, 0, nil, 0, nil, nil, nil))
+	msti, err := data.Measurement("foo", "bar", "cpu")
+	require.NoError(t, err)
+	(*msti.Schema)["value"] = SchemaVal{Typ: influx.Field_Type_Float}
+	_, err = data.DropContinuousQuery("cq0", "foo")
+	require.NoError(t, err)
+	require.NoError(t, data.DropUser("u2"))
+	require.NoError(t, data.SetPrivilege("u1", "foo", originql.AllPrivileges))
+	require.NoError(t, data.CreateDatabase("foo2", nil, nil, false, 1, nil))
+	to, err := data.Export()
+	require.NoError(t, err)
+
+	var diffs []string
+	for _, d := range DiffMetaExport(from, to) {
+		diffs = append(diffs, d.String())
+	}
+	assert2.Equal(t, []string{
+		"+ field foo.bar.cpu.value: float",
+		"+ measurement foo.bar.mem",
+		"- continuous query foo.cq0",
+		"+ database foo2",
+		"~ user u1: privileges [foo:READ] -> [foo:ALL PRIVILEGES]",
+		"- user u2",
+	}, diffs)
+	assert2.Empty(t, DiffMetaExport(to, to))
+}
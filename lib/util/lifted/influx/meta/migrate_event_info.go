@@ -15,6 +15,9 @@ type MigrateEventInfo struct {
 	src         uint64
 	dest        uint64
 	aliveConnId uint64
+
+	// the files of the pt are transferred from the source node, only the rebalancing moves transfer the files
+	transferFiles bool
 }
 
 func NewMigrateEventInfo(eventId string, eventType int, pt *DbPtInfo, dest uint64, aliveConnId uint64) *MigrateEventInfo {
@@ -75,17 +78,22 @@ func (m *MigrateEventInfo) GetAliveConnId() uint64 {
 	return m.aliveConnId
 }
 
+func (m *MigrateEventInfo) IsTransferFiles() bool {
+	return m.transferFiles
+}
+
 func (m *MigrateEventInfo) marshal() *metaProto.MigrateEventInfo {
 	pb := &metaProto.MigrateEventInfo{
-		EventId:     proto.String(m.eventId),
-		EventType:   proto.Int(m.eventType),
-		OpId:        proto.Uint64(m.opId),
-		Pti:         m.pt.Marshal(),
-		CurrState:   proto.Int(m.currState),
-		PreState:    proto.Int(m.preState),
-		Dest:        proto.Uint64(m.dest),
-		Src:         proto.Uint64(m.src),
-		AliveConnId: proto.Uint64(m.aliveConnId),
+		EventId:       proto.String(m.eventId),
+		EventType:     proto.Int(m.eventType),
+		OpId:          proto.Uint64(m.opId),
+		Pti:           m.pt.Marshal(),
+		CurrState:     proto.Int(m.currState),
+		PreState:      proto.Int(m.preState),
+		Dest:          proto.Uint64(m.dest),
+		Src:           proto.Uint64(m.src),
+		AliveConnId:   proto.Uint64(m.aliveConnId),
+		TransferFiles: proto.Bool(m.transferFiles),
 	}
 	return pb
 }
@@ -101,6 +109,7 @@ func (m *MigrateEventInfo) unmarshal(pb *metaProto.MigrateEventInfo) {
 	m.src = pb.GetSrc()
 	m.dest = pb.GetDest()
 	m.aliveConnId = pb.GetAliveConnId()
+	m.transferFiles = pb.GetTransferFiles()
 }
 
 func (m *MigrateEventInfo) Clone() *MigrateEventInfo {
@@ -53,6 +53,8 @@ const (
 	Normal uint64 = iota
 	Segregating
 	Segregated
+	// Decommissioning the node serves its pts until they are moved to the other nodes, no new pts are placed on it
+	Decommissioning
 )
 
 // NodeInfo represents information about a single node in the cluster.
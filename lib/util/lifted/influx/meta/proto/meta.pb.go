@@ -99,6 +99,19 @@ const (
 	Command_UpdateMeasurementCommand              Command_Type = 101
 	Command_UpdateMetaNodeStatusCommand           Command_Type = 102
 	Command_ShowClusterCommand                    Command_Type = 103
+	Command_SplitShardCommand                     Command_Type = 104
+	Command_SplitShardDoneCommand                 Command_Type = 105
+	Command_CreateReplicationCommand              Command_Type = 106
+	Command_DropReplicationCommand                Command_Type = 107
+	Command_UpdateReplicationCheckpointCommand    Command_Type = 108
+	Command_RestoreDatabaseCommand                Command_Type = 109
+	Command_CreateContinuousQueryBackfillCommand  Command_Type = 110
+	Command_UpdateContinuousQueryBackfillCommand  Command_Type = 111
+	Command_CreateTenantCommand                   Command_Type = 112
+	Command_DropTenantCommand                     Command_Type = 113
+	Command_UpdateTenantMemberCommand             Command_Type = 114
+	Command_UpdateTenantUsageCommand              Command_Type = 115
+	Command_UpdateDecommissionCommand             Command_Type = 116
 )
 
 var Command_Type_name = map[int32]string{
@@ -178,6 +191,19 @@ var Command_Type_name = map[int32]string{
 	101: "UpdateMeasurementCommand",
 	102: "UpdateMetaNodeStatusCommand",
 	103: "ShowClusterCommand",
+	104: "SplitShardCommand",
+	105: "SplitShardDoneCommand",
+	106: "CreateReplicationCommand",
+	107: "DropReplicationCommand",
+	108: "UpdateReplicationCheckpointCommand",
+	109: "RestoreDatabaseCommand",
+	110: "CreateContinuousQueryBackfillCommand",
+	111: "UpdateContinuousQueryBackfillCommand",
+	112: "CreateTenantCommand",
+	113: "DropTenantCommand",
+	114: "UpdateTenantMemberCommand",
+	115: "UpdateTenantUsageCommand",
+	116: "UpdateDecommissionCommand",
 }
 
 var Command_Type_value = map[string]int32{
@@ -257,6 +283,19 @@ var Command_Type_value = map[string]int32{
 	"UpdateMeasurementCommand":              101,
 	"UpdateMetaNodeStatusCommand":           102,
 	"ShowClusterCommand":                    103,
+	"SplitShardCommand":                     104,
+	"SplitShardDoneCommand":                 105,
+	"CreateReplicationCommand":              106,
+	"DropReplicationCommand":                107,
+	"UpdateReplicationCheckpointCommand":    108,
+	"RestoreDatabaseCommand":                109,
+	"CreateContinuousQueryBackfillCommand":  110,
+	"UpdateContinuousQueryBackfillCommand":  111,
+	"CreateTenantCommand":                   112,
+	"DropTenantCommand":                     113,
+	"UpdateTenantMemberCommand":             114,
+	"UpdateTenantUsageCommand":              115,
+	"UpdateDecommissionCommand":             116,
 }
 
 func (x Command_Type) Enum() *Command_Type {
@@ -279,7 +318,7 @@ func (x *Command_Type) UnmarshalJSON(data []byte) error {
 }
 
 func (Command_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{35, 0}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{41, 0}
 }
 
 type Data struct {
@@ -315,6 +354,8 @@ type Data struct {
 	IsSQLiteEnabled      *bool                    `protobuf:"varint,32,opt,name=IsSQLiteEnabled" json:"IsSQLiteEnabled,omitempty"`
 	SqlNodes             []*DataNode              `protobuf:"bytes,33,rep,name=SqlNodes" json:"SqlNodes,omitempty"`
 	MaxMstID             *uint64                  `protobuf:"varint,34,opt,name=MaxMstID" json:"MaxMstID,omitempty"`
+	Tenants              []*TenantInfo            `protobuf:"bytes,35,rep,name=Tenants" json:"Tenants,omitempty"`
+	Decommissions        []*DecommissionInfo      `protobuf:"bytes,36,rep,name=Decommissions" json:"Decommissions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -568,6 +609,20 @@ func (m *Data) GetMaxMstID() uint64 {
 	return 0
 }
 
+func (m *Data) GetTenants() []*TenantInfo {
+	if m != nil {
+		return m.Tenants
+	}
+	return nil
+}
+
+func (m *Data) GetDecommissions() []*DecommissionInfo {
+	if m != nil {
+		return m.Decommissions
+	}
+	return nil
+}
+
 type Replications struct {
 	Groups               []*ReplicaGroup `protobuf:"bytes,1,rep,name=Groups" json:"Groups,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -1042,6 +1097,7 @@ type DatabaseInfo struct {
 	EnableTagArray         *bool                  `protobuf:"varint,7,opt,name=EnableTagArray" json:"EnableTagArray,omitempty"`
 	ReplicaN               *int64                 `protobuf:"varint,8,opt,name=ReplicaN" json:"ReplicaN,omitempty"`
 	Options                *ObsOptions            `protobuf:"bytes,21,opt,name=Options" json:"Options,omitempty"`
+	Replication            *ReplicationInfo       `protobuf:"bytes,22,opt,name=Replication" json:"Replication,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}               `json:"-"`
 	XXX_unrecognized       []byte                 `json:"-"`
 	XXX_sizecache          int32                  `json:"-"`
@@ -1134,6 +1190,121 @@ func (m *DatabaseInfo) GetOptions() *ObsOptions {
 	return nil
 }
 
+func (m *DatabaseInfo) GetReplication() *ReplicationInfo {
+	if m != nil {
+		return m.Replication
+	}
+	return nil
+}
+
+type ReplicationInfo struct {
+	Target               *string                  `protobuf:"bytes,1,req,name=Target" json:"Target,omitempty"`
+	Role                 *string                  `protobuf:"bytes,2,req,name=Role" json:"Role,omitempty"`
+	Checkpoints          []*ReplicationCheckpoint `protobuf:"bytes,3,rep,name=Checkpoints" json:"Checkpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ReplicationInfo) Reset()         { *m = ReplicationInfo{} }
+func (m *ReplicationInfo) String() string { return proto.CompactTextString(m) }
+func (*ReplicationInfo) ProtoMessage()    {}
+func (*ReplicationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{10}
+}
+func (m *ReplicationInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicationInfo.Unmarshal(m, b)
+}
+func (m *ReplicationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicationInfo.Marshal(b, m, deterministic)
+}
+func (m *ReplicationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationInfo.Merge(m, src)
+}
+func (m *ReplicationInfo) XXX_Size() int {
+	return xxx_messageInfo_ReplicationInfo.Size(m)
+}
+func (m *ReplicationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationInfo proto.InternalMessageInfo
+
+func (m *ReplicationInfo) GetTarget() string {
+	if m != nil && m.Target != nil {
+		return *m.Target
+	}
+	return ""
+}
+
+func (m *ReplicationInfo) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return ""
+}
+
+func (m *ReplicationInfo) GetCheckpoints() []*ReplicationCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+type ReplicationCheckpoint struct {
+	ShardID              *uint64  `protobuf:"varint,1,req,name=ShardID" json:"ShardID,omitempty"`
+	Seq                  *int64   `protobuf:"varint,2,req,name=Seq" json:"Seq,omitempty"`
+	UpdateTime           *int64   `protobuf:"varint,3,req,name=UpdateTime" json:"UpdateTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicationCheckpoint) Reset()         { *m = ReplicationCheckpoint{} }
+func (m *ReplicationCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*ReplicationCheckpoint) ProtoMessage()    {}
+func (*ReplicationCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{11}
+}
+func (m *ReplicationCheckpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicationCheckpoint.Unmarshal(m, b)
+}
+func (m *ReplicationCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicationCheckpoint.Marshal(b, m, deterministic)
+}
+func (m *ReplicationCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationCheckpoint.Merge(m, src)
+}
+func (m *ReplicationCheckpoint) XXX_Size() int {
+	return xxx_messageInfo_ReplicationCheckpoint.Size(m)
+}
+func (m *ReplicationCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationCheckpoint proto.InternalMessageInfo
+
+func (m *ReplicationCheckpoint) GetShardID() uint64 {
+	if m != nil && m.ShardID != nil {
+		return *m.ShardID
+	}
+	return 0
+}
+
+func (m *ReplicationCheckpoint) GetSeq() int64 {
+	if m != nil && m.Seq != nil {
+		return *m.Seq
+	}
+	return 0
+}
+
+func (m *ReplicationCheckpoint) GetUpdateTime() int64 {
+	if m != nil && m.UpdateTime != nil {
+		return *m.UpdateTime
+	}
+	return 0
+}
+
 type RetentionPolicySpec struct {
 	Name                 *string  `protobuf:"bytes,1,opt,name=Name" json:"Name,omitempty"`
 	Duration             *int64   `protobuf:"varint,2,opt,name=Duration" json:"Duration,omitempty"`
@@ -1149,7 +1320,7 @@ func (m *RetentionPolicySpec) Reset()         { *m = RetentionPolicySpec{} }
 func (m *RetentionPolicySpec) String() string { return proto.CompactTextString(m) }
 func (*RetentionPolicySpec) ProtoMessage()    {}
 func (*RetentionPolicySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{10}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{12}
 }
 func (m *RetentionPolicySpec) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RetentionPolicySpec.Unmarshal(m, b)
@@ -1227,7 +1398,7 @@ func (m *MeasurementInfo) Reset()         { *m = MeasurementInfo{} }
 func (m *MeasurementInfo) String() string { return proto.CompactTextString(m) }
 func (*MeasurementInfo) ProtoMessage()    {}
 func (*MeasurementInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{11}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{13}
 }
 func (m *MeasurementInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementInfo.Unmarshal(m, b)
@@ -1350,7 +1521,7 @@ func (m *SchemaVal) Reset()         { *m = SchemaVal{} }
 func (m *SchemaVal) String() string { return proto.CompactTextString(m) }
 func (*SchemaVal) ProtoMessage()    {}
 func (*SchemaVal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{12}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{14}
 }
 func (m *SchemaVal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SchemaVal.Unmarshal(m, b)
@@ -1408,7 +1579,7 @@ func (m *RetentionPolicyInfo) Reset()         { *m = RetentionPolicyInfo{} }
 func (m *RetentionPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*RetentionPolicyInfo) ProtoMessage()    {}
 func (*RetentionPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{13}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{15}
 }
 func (m *RetentionPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RetentionPolicyInfo.Unmarshal(m, b)
@@ -1527,19 +1698,22 @@ func (m *RetentionPolicyInfo) GetDownSamplePolicyInfo() *DownSamplePolicyInfo {
 }
 
 type ContinuousQueryInfo struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
-	Query                *string  `protobuf:"bytes,2,req,name=Query" json:"Query,omitempty"`
-	LastRunTime          *int64   `protobuf:"varint,3,opt,name=LastRunTime" json:"LastRunTime,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name                 *string                      `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Query                *string                      `protobuf:"bytes,2,req,name=Query" json:"Query,omitempty"`
+	LastRunTime          *int64                       `protobuf:"varint,3,opt,name=LastRunTime" json:"LastRunTime,omitempty"`
+	Owner                *string                      `protobuf:"bytes,4,opt,name=Owner" json:"Owner,omitempty"`
+	LastError            *string                      `protobuf:"bytes,5,opt,name=LastError" json:"LastError,omitempty"`
+	Backfill             *ContinuousQueryBackfillInfo `protobuf:"bytes,6,opt,name=Backfill" json:"Backfill,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
 func (m *ContinuousQueryInfo) Reset()         { *m = ContinuousQueryInfo{} }
 func (m *ContinuousQueryInfo) String() string { return proto.CompactTextString(m) }
 func (*ContinuousQueryInfo) ProtoMessage()    {}
 func (*ContinuousQueryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{14}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{16}
 }
 func (m *ContinuousQueryInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContinuousQueryInfo.Unmarshal(m, b)
@@ -1580,6 +1754,97 @@ func (m *ContinuousQueryInfo) GetLastRunTime() int64 {
 	return 0
 }
 
+func (m *ContinuousQueryInfo) GetOwner() string {
+	if m != nil && m.Owner != nil {
+		return *m.Owner
+	}
+	return ""
+}
+
+func (m *ContinuousQueryInfo) GetLastError() string {
+	if m != nil && m.LastError != nil {
+		return *m.LastError
+	}
+	return ""
+}
+
+func (m *ContinuousQueryInfo) GetBackfill() *ContinuousQueryBackfillInfo {
+	if m != nil {
+		return m.Backfill
+	}
+	return nil
+}
+
+type ContinuousQueryBackfillInfo struct {
+	StartTime            *int64   `protobuf:"varint,1,req,name=StartTime" json:"StartTime,omitempty"`
+	EndTime              *int64   `protobuf:"varint,2,req,name=EndTime" json:"EndTime,omitempty"`
+	NextTime             *int64   `protobuf:"varint,3,req,name=NextTime" json:"NextTime,omitempty"`
+	Host                 *string  `protobuf:"bytes,4,opt,name=Host" json:"Host,omitempty"`
+	LastError            *string  `protobuf:"bytes,5,opt,name=LastError" json:"LastError,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContinuousQueryBackfillInfo) Reset()         { *m = ContinuousQueryBackfillInfo{} }
+func (m *ContinuousQueryBackfillInfo) String() string { return proto.CompactTextString(m) }
+func (*ContinuousQueryBackfillInfo) ProtoMessage()    {}
+func (*ContinuousQueryBackfillInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{17}
+}
+func (m *ContinuousQueryBackfillInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContinuousQueryBackfillInfo.Unmarshal(m, b)
+}
+func (m *ContinuousQueryBackfillInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContinuousQueryBackfillInfo.Marshal(b, m, deterministic)
+}
+func (m *ContinuousQueryBackfillInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousQueryBackfillInfo.Merge(m, src)
+}
+func (m *ContinuousQueryBackfillInfo) XXX_Size() int {
+	return xxx_messageInfo_ContinuousQueryBackfillInfo.Size(m)
+}
+func (m *ContinuousQueryBackfillInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousQueryBackfillInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousQueryBackfillInfo proto.InternalMessageInfo
+
+func (m *ContinuousQueryBackfillInfo) GetStartTime() int64 {
+	if m != nil && m.StartTime != nil {
+		return *m.StartTime
+	}
+	return 0
+}
+
+func (m *ContinuousQueryBackfillInfo) GetEndTime() int64 {
+	if m != nil && m.EndTime != nil {
+		return *m.EndTime
+	}
+	return 0
+}
+
+func (m *ContinuousQueryBackfillInfo) GetNextTime() int64 {
+	if m != nil && m.NextTime != nil {
+		return *m.NextTime
+	}
+	return 0
+}
+
+func (m *ContinuousQueryBackfillInfo) GetHost() string {
+	if m != nil && m.Host != nil {
+		return *m.Host
+	}
+	return ""
+}
+
+func (m *ContinuousQueryBackfillInfo) GetLastError() string {
+	if m != nil && m.LastError != nil {
+		return *m.LastError
+	}
+	return ""
+}
+
 type ShardGroupInfo struct {
 	ID                   *uint64      `protobuf:"varint,1,req,name=ID" json:"ID,omitempty"`
 	StartTime            *int64       `protobuf:"varint,2,req,name=StartTime" json:"StartTime,omitempty"`
@@ -1598,7 +1863,7 @@ func (m *ShardGroupInfo) Reset()         { *m = ShardGroupInfo{} }
 func (m *ShardGroupInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardGroupInfo) ProtoMessage()    {}
 func (*ShardGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{15}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{18}
 }
 func (m *ShardGroupInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardGroupInfo.Unmarshal(m, b)
@@ -1685,6 +1950,8 @@ type ShardInfo struct {
 	DownSampleID         *uint64  `protobuf:"varint,8,opt,name=DownSampleID" json:"DownSampleID,omitempty"`
 	ReadOnly             *bool    `protobuf:"varint,9,opt,name=ReadOnly" json:"ReadOnly,omitempty"`
 	MarkDelete           *bool    `protobuf:"varint,10,opt,name=MarkDelete" json:"MarkDelete,omitempty"`
+	SplitFrom            *uint64  `protobuf:"varint,11,opt,name=SplitFrom" json:"SplitFrom,omitempty"`
+	Trimmed              *bool    `protobuf:"varint,12,opt,name=Trimmed" json:"Trimmed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1694,7 +1961,7 @@ func (m *ShardInfo) Reset()         { *m = ShardInfo{} }
 func (m *ShardInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardInfo) ProtoMessage()    {}
 func (*ShardInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{16}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{19}
 }
 func (m *ShardInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardInfo.Unmarshal(m, b)
@@ -1785,6 +2052,20 @@ func (m *ShardInfo) GetMarkDelete() bool {
 	return false
 }
 
+func (m *ShardInfo) GetSplitFrom() uint64 {
+	if m != nil && m.SplitFrom != nil {
+		return *m.SplitFrom
+	}
+	return 0
+}
+
+func (m *ShardInfo) GetTrimmed() bool {
+	if m != nil && m.Trimmed != nil {
+		return *m.Trimmed
+	}
+	return false
+}
+
 type ShardKeyInfo struct {
 	ShardKey             []string `protobuf:"bytes,1,rep,name=ShardKey" json:"ShardKey,omitempty"`
 	Type                 *string  `protobuf:"bytes,2,opt,name=Type" json:"Type,omitempty"`
@@ -1798,7 +2079,7 @@ func (m *ShardKeyInfo) Reset()         { *m = ShardKeyInfo{} }
 func (m *ShardKeyInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardKeyInfo) ProtoMessage()    {}
 func (*ShardKeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{17}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{20}
 }
 func (m *ShardKeyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardKeyInfo.Unmarshal(m, b)
@@ -1850,7 +2131,7 @@ func (m *Idxes) Reset()         { *m = Idxes{} }
 func (m *Idxes) String() string { return proto.CompactTextString(m) }
 func (*Idxes) ProtoMessage()    {}
 func (*Idxes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{18}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{21}
 }
 func (m *Idxes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Idxes.Unmarshal(m, b)
@@ -1890,7 +2171,7 @@ func (m *SubscriptionInfo) Reset()         { *m = SubscriptionInfo{} }
 func (m *SubscriptionInfo) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionInfo) ProtoMessage()    {}
 func (*SubscriptionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{19}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{22}
 }
 func (m *SubscriptionInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscriptionInfo.Unmarshal(m, b)
@@ -1942,7 +2223,7 @@ func (m *ShardOwner) Reset()         { *m = ShardOwner{} }
 func (m *ShardOwner) String() string { return proto.CompactTextString(m) }
 func (*ShardOwner) ProtoMessage()    {}
 func (*ShardOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{20}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{23}
 }
 func (m *ShardOwner) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardOwner.Unmarshal(m, b)
@@ -1984,7 +2265,7 @@ func (m *UserInfo) Reset()         { *m = UserInfo{} }
 func (m *UserInfo) String() string { return proto.CompactTextString(m) }
 func (*UserInfo) ProtoMessage()    {}
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{21}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{24}
 }
 func (m *UserInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserInfo.Unmarshal(m, b)
@@ -2039,134 +2320,384 @@ func (m *UserInfo) GetPrivileges() []*UserPrivilege {
 	return nil
 }
 
-type UserPrivilege struct {
-	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
-	Privilege            *int32   `protobuf:"varint,2,req,name=Privilege" json:"Privilege,omitempty"`
+type TenantInfo struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	MaxSeries            *int64   `protobuf:"varint,2,opt,name=MaxSeries" json:"MaxSeries,omitempty"`
+	MaxStorageBytes      *int64   `protobuf:"varint,3,opt,name=MaxStorageBytes" json:"MaxStorageBytes,omitempty"`
+	MaxIngestRate        *int64   `protobuf:"varint,4,opt,name=MaxIngestRate" json:"MaxIngestRate,omitempty"`
+	MaxQueryConcurrency  *int64   `protobuf:"varint,5,opt,name=MaxQueryConcurrency" json:"MaxQueryConcurrency,omitempty"`
+	Nodes                []uint64 `protobuf:"varint,6,rep,name=Nodes" json:"Nodes,omitempty"`
+	Databases            []string `protobuf:"bytes,7,rep,name=Databases" json:"Databases,omitempty"`
+	Users                []string `protobuf:"bytes,8,rep,name=Users" json:"Users,omitempty"`
+	Series               *int64   `protobuf:"varint,9,opt,name=Series" json:"Series,omitempty"`
+	StorageBytes         *int64   `protobuf:"varint,10,opt,name=StorageBytes" json:"StorageBytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UserPrivilege) Reset()         { *m = UserPrivilege{} }
-func (m *UserPrivilege) String() string { return proto.CompactTextString(m) }
-func (*UserPrivilege) ProtoMessage()    {}
-func (*UserPrivilege) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{22}
+func (m *TenantInfo) Reset()         { *m = TenantInfo{} }
+func (m *TenantInfo) String() string { return proto.CompactTextString(m) }
+func (*TenantInfo) ProtoMessage()    {}
+func (*TenantInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{25}
 }
-func (m *UserPrivilege) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserPrivilege.Unmarshal(m, b)
+func (m *TenantInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TenantInfo.Unmarshal(m, b)
 }
-func (m *UserPrivilege) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserPrivilege.Marshal(b, m, deterministic)
+func (m *TenantInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TenantInfo.Marshal(b, m, deterministic)
 }
-func (m *UserPrivilege) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserPrivilege.Merge(m, src)
+func (m *TenantInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TenantInfo.Merge(m, src)
 }
-func (m *UserPrivilege) XXX_Size() int {
-	return xxx_messageInfo_UserPrivilege.Size(m)
+func (m *TenantInfo) XXX_Size() int {
+	return xxx_messageInfo_TenantInfo.Size(m)
 }
-func (m *UserPrivilege) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserPrivilege.DiscardUnknown(m)
+func (m *TenantInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TenantInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserPrivilege proto.InternalMessageInfo
+var xxx_messageInfo_TenantInfo proto.InternalMessageInfo
 
-func (m *UserPrivilege) GetDatabase() string {
-	if m != nil && m.Database != nil {
-		return *m.Database
+func (m *TenantInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
 	return ""
 }
 
-func (m *UserPrivilege) GetPrivilege() int32 {
-	if m != nil && m.Privilege != nil {
-		return *m.Privilege
+func (m *TenantInfo) GetMaxSeries() int64 {
+	if m != nil && m.MaxSeries != nil {
+		return *m.MaxSeries
 	}
 	return 0
 }
 
-type IndexRelation struct {
-	Rid                  *uint32         `protobuf:"varint,1,req,name=Rid" json:"Rid,omitempty"`
-	Oid                  []uint32        `protobuf:"varint,2,rep,name=Oid" json:"Oid,omitempty"`
-	IndexName            []string        `protobuf:"bytes,3,rep,name=IndexName" json:"IndexName,omitempty"`
-	IndexLists           []*IndexList    `protobuf:"bytes,4,rep,name=IndexLists" json:"IndexLists,omitempty"`
-	IndexOptions         []*IndexOptions `protobuf:"bytes,5,rep,name=IndexOptions" json:"IndexOptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *TenantInfo) GetMaxStorageBytes() int64 {
+	if m != nil && m.MaxStorageBytes != nil {
+		return *m.MaxStorageBytes
+	}
+	return 0
 }
 
-func (m *IndexRelation) Reset()         { *m = IndexRelation{} }
-func (m *IndexRelation) String() string { return proto.CompactTextString(m) }
-func (*IndexRelation) ProtoMessage()    {}
-func (*IndexRelation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{23}
-}
-func (m *IndexRelation) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IndexRelation.Unmarshal(m, b)
-}
-func (m *IndexRelation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IndexRelation.Marshal(b, m, deterministic)
-}
-func (m *IndexRelation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexRelation.Merge(m, src)
-}
-func (m *IndexRelation) XXX_Size() int {
-	return xxx_messageInfo_IndexRelation.Size(m)
-}
-func (m *IndexRelation) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexRelation.DiscardUnknown(m)
+func (m *TenantInfo) GetMaxIngestRate() int64 {
+	if m != nil && m.MaxIngestRate != nil {
+		return *m.MaxIngestRate
+	}
+	return 0
 }
 
-var xxx_messageInfo_IndexRelation proto.InternalMessageInfo
-
-func (m *IndexRelation) GetRid() uint32 {
-	if m != nil && m.Rid != nil {
-		return *m.Rid
+func (m *TenantInfo) GetMaxQueryConcurrency() int64 {
+	if m != nil && m.MaxQueryConcurrency != nil {
+		return *m.MaxQueryConcurrency
 	}
 	return 0
 }
 
-func (m *IndexRelation) GetOid() []uint32 {
+func (m *TenantInfo) GetNodes() []uint64 {
 	if m != nil {
-		return m.Oid
+		return m.Nodes
 	}
 	return nil
 }
 
-func (m *IndexRelation) GetIndexName() []string {
+func (m *TenantInfo) GetDatabases() []string {
 	if m != nil {
-		return m.IndexName
+		return m.Databases
 	}
 	return nil
 }
 
-func (m *IndexRelation) GetIndexLists() []*IndexList {
+func (m *TenantInfo) GetUsers() []string {
 	if m != nil {
-		return m.IndexLists
+		return m.Users
 	}
 	return nil
 }
 
-func (m *IndexRelation) GetIndexOptions() []*IndexOptions {
-	if m != nil {
-		return m.IndexOptions
+func (m *TenantInfo) GetSeries() int64 {
+	if m != nil && m.Series != nil {
+		return *m.Series
 	}
-	return nil
+	return 0
 }
 
-type IndexList struct {
-	IList                []string `protobuf:"bytes,1,rep,name=IList" json:"IList,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *TenantInfo) GetStorageBytes() int64 {
+	if m != nil && m.StorageBytes != nil {
+		return *m.StorageBytes
+	}
+	return 0
 }
 
-func (m *IndexList) Reset()         { *m = IndexList{} }
+type DecommissionInfo struct {
+	NodeID               *uint64           `protobuf:"varint,1,req,name=NodeID" json:"NodeID,omitempty"`
+	State                *string           `protobuf:"bytes,2,opt,name=State" json:"State,omitempty"`
+	TotalPts             *int64            `protobuf:"varint,3,opt,name=TotalPts" json:"TotalPts,omitempty"`
+	MovedPts             *int64            `protobuf:"varint,4,opt,name=MovedPts" json:"MovedPts,omitempty"`
+	StartTime            *int64            `protobuf:"varint,5,opt,name=StartTime" json:"StartTime,omitempty"`
+	UpdateTime           *int64            `protobuf:"varint,6,opt,name=UpdateTime" json:"UpdateTime,omitempty"`
+	Error                *string           `protobuf:"bytes,7,opt,name=Error" json:"Error,omitempty"`
+	Moved                []*DecommissionPt `protobuf:"bytes,8,rep,name=Moved" json:"Moved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DecommissionInfo) Reset()         { *m = DecommissionInfo{} }
+func (m *DecommissionInfo) String() string { return proto.CompactTextString(m) }
+func (*DecommissionInfo) ProtoMessage()    {}
+func (*DecommissionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{26}
+}
+func (m *DecommissionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecommissionInfo.Unmarshal(m, b)
+}
+func (m *DecommissionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecommissionInfo.Marshal(b, m, deterministic)
+}
+func (m *DecommissionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionInfo.Merge(m, src)
+}
+func (m *DecommissionInfo) XXX_Size() int {
+	return xxx_messageInfo_DecommissionInfo.Size(m)
+}
+func (m *DecommissionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecommissionInfo proto.InternalMessageInfo
+
+func (m *DecommissionInfo) GetNodeID() uint64 {
+	if m != nil && m.NodeID != nil {
+		return *m.NodeID
+	}
+	return 0
+}
+
+func (m *DecommissionInfo) GetState() string {
+	if m != nil && m.State != nil {
+		return *m.State
+	}
+	return ""
+}
+
+func (m *DecommissionInfo) GetTotalPts() int64 {
+	if m != nil && m.TotalPts != nil {
+		return *m.TotalPts
+	}
+	return 0
+}
+
+func (m *DecommissionInfo) GetMovedPts() int64 {
+	if m != nil && m.MovedPts != nil {
+		return *m.MovedPts
+	}
+	return 0
+}
+
+func (m *DecommissionInfo) GetStartTime() int64 {
+	if m != nil && m.StartTime != nil {
+		return *m.StartTime
+	}
+	return 0
+}
+
+func (m *DecommissionInfo) GetUpdateTime() int64 {
+	if m != nil && m.UpdateTime != nil {
+		return *m.UpdateTime
+	}
+	return 0
+}
+
+func (m *DecommissionInfo) GetError() string {
+	if m != nil && m.Error != nil {
+		return *m.Error
+	}
+	return ""
+}
+
+func (m *DecommissionInfo) GetMoved() []*DecommissionPt {
+	if m != nil {
+		return m.Moved
+	}
+	return nil
+}
+
+type DecommissionPt struct {
+	Db                   *string  `protobuf:"bytes,1,req,name=Db" json:"Db,omitempty"`
+	PtId                 *uint32  `protobuf:"varint,2,req,name=PtId" json:"PtId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecommissionPt) Reset()         { *m = DecommissionPt{} }
+func (m *DecommissionPt) String() string { return proto.CompactTextString(m) }
+func (*DecommissionPt) ProtoMessage()    {}
+func (*DecommissionPt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{27}
+}
+func (m *DecommissionPt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecommissionPt.Unmarshal(m, b)
+}
+func (m *DecommissionPt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecommissionPt.Marshal(b, m, deterministic)
+}
+func (m *DecommissionPt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionPt.Merge(m, src)
+}
+func (m *DecommissionPt) XXX_Size() int {
+	return xxx_messageInfo_DecommissionPt.Size(m)
+}
+func (m *DecommissionPt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionPt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecommissionPt proto.InternalMessageInfo
+
+func (m *DecommissionPt) GetDb() string {
+	if m != nil && m.Db != nil {
+		return *m.Db
+	}
+	return ""
+}
+
+func (m *DecommissionPt) GetPtId() uint32 {
+	if m != nil && m.PtId != nil {
+		return *m.PtId
+	}
+	return 0
+}
+
+type UserPrivilege struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Privilege            *int32   `protobuf:"varint,2,req,name=Privilege" json:"Privilege,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserPrivilege) Reset()         { *m = UserPrivilege{} }
+func (m *UserPrivilege) String() string { return proto.CompactTextString(m) }
+func (*UserPrivilege) ProtoMessage()    {}
+func (*UserPrivilege) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{28}
+}
+func (m *UserPrivilege) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserPrivilege.Unmarshal(m, b)
+}
+func (m *UserPrivilege) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserPrivilege.Marshal(b, m, deterministic)
+}
+func (m *UserPrivilege) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserPrivilege.Merge(m, src)
+}
+func (m *UserPrivilege) XXX_Size() int {
+	return xxx_messageInfo_UserPrivilege.Size(m)
+}
+func (m *UserPrivilege) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserPrivilege.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserPrivilege proto.InternalMessageInfo
+
+func (m *UserPrivilege) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *UserPrivilege) GetPrivilege() int32 {
+	if m != nil && m.Privilege != nil {
+		return *m.Privilege
+	}
+	return 0
+}
+
+type IndexRelation struct {
+	Rid                  *uint32         `protobuf:"varint,1,req,name=Rid" json:"Rid,omitempty"`
+	Oid                  []uint32        `protobuf:"varint,2,rep,name=Oid" json:"Oid,omitempty"`
+	IndexName            []string        `protobuf:"bytes,3,rep,name=IndexName" json:"IndexName,omitempty"`
+	IndexLists           []*IndexList    `protobuf:"bytes,4,rep,name=IndexLists" json:"IndexLists,omitempty"`
+	IndexOptions         []*IndexOptions `protobuf:"bytes,5,rep,name=IndexOptions" json:"IndexOptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *IndexRelation) Reset()         { *m = IndexRelation{} }
+func (m *IndexRelation) String() string { return proto.CompactTextString(m) }
+func (*IndexRelation) ProtoMessage()    {}
+func (*IndexRelation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{29}
+}
+func (m *IndexRelation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexRelation.Unmarshal(m, b)
+}
+func (m *IndexRelation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexRelation.Marshal(b, m, deterministic)
+}
+func (m *IndexRelation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexRelation.Merge(m, src)
+}
+func (m *IndexRelation) XXX_Size() int {
+	return xxx_messageInfo_IndexRelation.Size(m)
+}
+func (m *IndexRelation) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexRelation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexRelation proto.InternalMessageInfo
+
+func (m *IndexRelation) GetRid() uint32 {
+	if m != nil && m.Rid != nil {
+		return *m.Rid
+	}
+	return 0
+}
+
+func (m *IndexRelation) GetOid() []uint32 {
+	if m != nil {
+		return m.Oid
+	}
+	return nil
+}
+
+func (m *IndexRelation) GetIndexName() []string {
+	if m != nil {
+		return m.IndexName
+	}
+	return nil
+}
+
+func (m *IndexRelation) GetIndexLists() []*IndexList {
+	if m != nil {
+		return m.IndexLists
+	}
+	return nil
+}
+
+func (m *IndexRelation) GetIndexOptions() []*IndexOptions {
+	if m != nil {
+		return m.IndexOptions
+	}
+	return nil
+}
+
+type IndexList struct {
+	IList                []string `protobuf:"bytes,1,rep,name=IList" json:"IList,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexList) Reset()         { *m = IndexList{} }
 func (m *IndexList) String() string { return proto.CompactTextString(m) }
 func (*IndexList) ProtoMessage()    {}
 func (*IndexList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{24}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{30}
 }
 func (m *IndexList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexList.Unmarshal(m, b)
@@ -2204,7 +2735,7 @@ func (m *RpMeasurementsFieldsInfo) Reset()         { *m = RpMeasurementsFieldsIn
 func (m *RpMeasurementsFieldsInfo) String() string { return proto.CompactTextString(m) }
 func (*RpMeasurementsFieldsInfo) ProtoMessage()    {}
 func (*RpMeasurementsFieldsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{25}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{31}
 }
 func (m *RpMeasurementsFieldsInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RpMeasurementsFieldsInfo.Unmarshal(m, b)
@@ -2243,7 +2774,7 @@ func (m *MeasurementFieldsInfo) Reset()         { *m = MeasurementFieldsInfo{} }
 func (m *MeasurementFieldsInfo) String() string { return proto.CompactTextString(m) }
 func (*MeasurementFieldsInfo) ProtoMessage()    {}
 func (*MeasurementFieldsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{26}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{32}
 }
 func (m *MeasurementFieldsInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementFieldsInfo.Unmarshal(m, b)
@@ -2289,7 +2820,7 @@ func (m *MeasurementTypeFields) Reset()         { *m = MeasurementTypeFields{} }
 func (m *MeasurementTypeFields) String() string { return proto.CompactTextString(m) }
 func (*MeasurementTypeFields) ProtoMessage()    {}
 func (*MeasurementTypeFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{27}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{33}
 }
 func (m *MeasurementTypeFields) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementTypeFields.Unmarshal(m, b)
@@ -2341,7 +2872,7 @@ func (m *StreamInfo) Reset()         { *m = StreamInfo{} }
 func (m *StreamInfo) String() string { return proto.CompactTextString(m) }
 func (*StreamInfo) ProtoMessage()    {}
 func (*StreamInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{28}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{34}
 }
 func (m *StreamInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamInfo.Unmarshal(m, b)
@@ -2428,7 +2959,7 @@ func (m *StreamInfos) Reset()         { *m = StreamInfos{} }
 func (m *StreamInfos) String() string { return proto.CompactTextString(m) }
 func (*StreamInfos) ProtoMessage()    {}
 func (*StreamInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{29}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{35}
 }
 func (m *StreamInfos) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamInfos.Unmarshal(m, b)
@@ -2468,7 +2999,7 @@ func (m *StreamMeasurementInfo) Reset()         { *m = StreamMeasurementInfo{} }
 func (m *StreamMeasurementInfo) String() string { return proto.CompactTextString(m) }
 func (*StreamMeasurementInfo) ProtoMessage()    {}
 func (*StreamMeasurementInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{30}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{36}
 }
 func (m *StreamMeasurementInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamMeasurementInfo.Unmarshal(m, b)
@@ -2522,7 +3053,7 @@ func (m *StreamCall) Reset()         { *m = StreamCall{} }
 func (m *StreamCall) String() string { return proto.CompactTextString(m) }
 func (*StreamCall) ProtoMessage()    {}
 func (*StreamCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{31}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{37}
 }
 func (m *StreamCall) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamCall.Unmarshal(m, b)
@@ -2579,7 +3110,7 @@ func (m *ColStoreInfo) Reset()         { *m = ColStoreInfo{} }
 func (m *ColStoreInfo) String() string { return proto.CompactTextString(m) }
 func (*ColStoreInfo) ProtoMessage()    {}
 func (*ColStoreInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{32}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{38}
 }
 func (m *ColStoreInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ColStoreInfo.Unmarshal(m, b)
@@ -2654,7 +3185,7 @@ func (m *IndexOption) Reset()         { *m = IndexOption{} }
 func (m *IndexOption) String() string { return proto.CompactTextString(m) }
 func (*IndexOption) ProtoMessage()    {}
 func (*IndexOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{33}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{39}
 }
 func (m *IndexOption) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexOption.Unmarshal(m, b)
@@ -2706,7 +3237,7 @@ func (m *IndexOptions) Reset()         { *m = IndexOptions{} }
 func (m *IndexOptions) String() string { return proto.CompactTextString(m) }
 func (*IndexOptions) ProtoMessage()    {}
 func (*IndexOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{34}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{40}
 }
 func (m *IndexOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexOptions.Unmarshal(m, b)
@@ -2745,7 +3276,7 @@ func (m *Command) Reset()         { *m = Command{} }
 func (m *Command) String() string { return proto.CompactTextString(m) }
 func (*Command) ProtoMessage()    {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{35}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{41}
 }
 
 var extRange_Command = []proto.ExtensionRange{
@@ -2797,7 +3328,7 @@ func (m *CreateDatabaseCommand) Reset()         { *m = CreateDatabaseCommand{} }
 func (m *CreateDatabaseCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabaseCommand) ProtoMessage()    {}
 func (*CreateDatabaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{36}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{42}
 }
 func (m *CreateDatabaseCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDatabaseCommand.Unmarshal(m, b)
@@ -2879,7 +3410,7 @@ func (m *DropDatabaseCommand) Reset()         { *m = DropDatabaseCommand{} }
 func (m *DropDatabaseCommand) String() string { return proto.CompactTextString(m) }
 func (*DropDatabaseCommand) ProtoMessage()    {}
 func (*DropDatabaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{37}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{43}
 }
 func (m *DropDatabaseCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropDatabaseCommand.Unmarshal(m, b)
@@ -2928,7 +3459,7 @@ func (m *CreateRetentionPolicyCommand) Reset()         { *m = CreateRetentionPol
 func (m *CreateRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateRetentionPolicyCommand) ProtoMessage()    {}
 func (*CreateRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{38}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{44}
 }
 func (m *CreateRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRetentionPolicyCommand.Unmarshal(m, b)
@@ -2990,7 +3521,7 @@ func (m *DropRetentionPolicyCommand) Reset()         { *m = DropRetentionPolicyC
 func (m *DropRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*DropRetentionPolicyCommand) ProtoMessage()    {}
 func (*DropRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{39}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{45}
 }
 func (m *DropRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropRetentionPolicyCommand.Unmarshal(m, b)
@@ -3045,7 +3576,7 @@ func (m *SetDefaultRetentionPolicyCommand) Reset()         { *m = SetDefaultRete
 func (m *SetDefaultRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*SetDefaultRetentionPolicyCommand) ProtoMessage()    {}
 func (*SetDefaultRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{40}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{46}
 }
 func (m *SetDefaultRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetDefaultRetentionPolicyCommand.Unmarshal(m, b)
@@ -3108,7 +3639,7 @@ func (m *UpdateRetentionPolicyCommand) Reset()         { *m = UpdateRetentionPol
 func (m *UpdateRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateRetentionPolicyCommand) ProtoMessage()    {}
 func (*UpdateRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{41}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{47}
 }
 func (m *UpdateRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateRetentionPolicyCommand.Unmarshal(m, b)
@@ -3223,7 +3754,7 @@ func (m *CreateShardGroupCommand) Reset()         { *m = CreateShardGroupCommand
 func (m *CreateShardGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateShardGroupCommand) ProtoMessage()    {}
 func (*CreateShardGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{42}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{48}
 }
 func (m *CreateShardGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateShardGroupCommand.Unmarshal(m, b)
@@ -3309,7 +3840,7 @@ func (m *DeleteShardGroupCommand) Reset()         { *m = DeleteShardGroupCommand
 func (m *DeleteShardGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteShardGroupCommand) ProtoMessage()    {}
 func (*DeleteShardGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{43}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{49}
 }
 func (m *DeleteShardGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteShardGroupCommand.Unmarshal(m, b)
@@ -3387,7 +3918,7 @@ func (m *CreateUserCommand) Reset()         { *m = CreateUserCommand{} }
 func (m *CreateUserCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateUserCommand) ProtoMessage()    {}
 func (*CreateUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{44}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{50}
 }
 func (m *CreateUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateUserCommand.Unmarshal(m, b)
@@ -3455,7 +3986,7 @@ func (m *DropUserCommand) Reset()         { *m = DropUserCommand{} }
 func (m *DropUserCommand) String() string { return proto.CompactTextString(m) }
 func (*DropUserCommand) ProtoMessage()    {}
 func (*DropUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{45}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{51}
 }
 func (m *DropUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropUserCommand.Unmarshal(m, b)
@@ -3503,7 +4034,7 @@ func (m *UpdateUserCommand) Reset()         { *m = UpdateUserCommand{} }
 func (m *UpdateUserCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateUserCommand) ProtoMessage()    {}
 func (*UpdateUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{46}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{52}
 }
 func (m *UpdateUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateUserCommand.Unmarshal(m, b)
@@ -3559,7 +4090,7 @@ func (m *SetPrivilegeCommand) Reset()         { *m = SetPrivilegeCommand{} }
 func (m *SetPrivilegeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetPrivilegeCommand) ProtoMessage()    {}
 func (*SetPrivilegeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{47}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{53}
 }
 func (m *SetPrivilegeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetPrivilegeCommand.Unmarshal(m, b)
@@ -3620,7 +4151,7 @@ func (m *SetDataCommand) Reset()         { *m = SetDataCommand{} }
 func (m *SetDataCommand) String() string { return proto.CompactTextString(m) }
 func (*SetDataCommand) ProtoMessage()    {}
 func (*SetDataCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{48}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{54}
 }
 func (m *SetDataCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetDataCommand.Unmarshal(m, b)
@@ -3668,7 +4199,7 @@ func (m *SetAdminPrivilegeCommand) Reset()         { *m = SetAdminPrivilegeComma
 func (m *SetAdminPrivilegeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetAdminPrivilegeCommand) ProtoMessage()    {}
 func (*SetAdminPrivilegeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{49}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{55}
 }
 func (m *SetAdminPrivilegeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAdminPrivilegeCommand.Unmarshal(m, b)
@@ -3726,7 +4257,7 @@ func (m *CreateSubscriptionCommand) Reset()         { *m = CreateSubscriptionCom
 func (m *CreateSubscriptionCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateSubscriptionCommand) ProtoMessage()    {}
 func (*CreateSubscriptionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{50}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{56}
 }
 func (m *CreateSubscriptionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSubscriptionCommand.Unmarshal(m, b)
@@ -3803,7 +4334,7 @@ func (m *DropSubscriptionCommand) Reset()         { *m = DropSubscriptionCommand
 func (m *DropSubscriptionCommand) String() string { return proto.CompactTextString(m) }
 func (*DropSubscriptionCommand) ProtoMessage()    {}
 func (*DropSubscriptionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{51}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{57}
 }
 func (m *DropSubscriptionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropSubscriptionCommand.Unmarshal(m, b)
@@ -3867,7 +4398,7 @@ func (m *CreateMetaNodeCommand) Reset()         { *m = CreateMetaNodeCommand{} }
 func (m *CreateMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateMetaNodeCommand) ProtoMessage()    {}
 func (*CreateMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{52}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{58}
 }
 func (m *CreateMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateMetaNodeCommand.Unmarshal(m, b)
@@ -3938,7 +4469,7 @@ func (m *CreateDataNodeCommand) Reset()         { *m = CreateDataNodeCommand{} }
 func (m *CreateDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDataNodeCommand) ProtoMessage()    {}
 func (*CreateDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{53}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{59}
 }
 func (m *CreateDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDataNodeCommand.Unmarshal(m, b)
@@ -4008,7 +4539,7 @@ func (m *DataNodeEvent) Reset()         { *m = DataNodeEvent{} }
 func (m *DataNodeEvent) String() string { return proto.CompactTextString(m) }
 func (*DataNodeEvent) ProtoMessage()    {}
 func (*DataNodeEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{54}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{60}
 }
 func (m *DataNodeEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataNodeEvent.Unmarshal(m, b)
@@ -4060,7 +4591,7 @@ func (m *DeleteMetaNodeCommand) Reset()         { *m = DeleteMetaNodeCommand{} }
 func (m *DeleteMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteMetaNodeCommand) ProtoMessage()    {}
 func (*DeleteMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{55}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{61}
 }
 func (m *DeleteMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteMetaNodeCommand.Unmarshal(m, b)
@@ -4107,7 +4638,7 @@ func (m *DeleteDataNodeCommand) Reset()         { *m = DeleteDataNodeCommand{} }
 func (m *DeleteDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteDataNodeCommand) ProtoMessage()    {}
 func (*DeleteDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{56}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{62}
 }
 func (m *DeleteDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteDataNodeCommand.Unmarshal(m, b)
@@ -4156,7 +4687,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{57}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{63}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response.Unmarshal(m, b)
@@ -4213,7 +4744,7 @@ func (m *SetMetaNodeCommand) Reset()         { *m = SetMetaNodeCommand{} }
 func (m *SetMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetMetaNodeCommand) ProtoMessage()    {}
 func (*SetMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{58}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{64}
 }
 func (m *SetMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMetaNodeCommand.Unmarshal(m, b)
@@ -4281,7 +4812,7 @@ func (m *DropShardCommand) Reset()         { *m = DropShardCommand{} }
 func (m *DropShardCommand) String() string { return proto.CompactTextString(m) }
 func (*DropShardCommand) ProtoMessage()    {}
 func (*DropShardCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{59}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{65}
 }
 func (m *DropShardCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropShardCommand.Unmarshal(m, b)
@@ -4328,7 +4859,7 @@ func (m *MarkDatabaseDeleteCommand) Reset()         { *m = MarkDatabaseDeleteCom
 func (m *MarkDatabaseDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkDatabaseDeleteCommand) ProtoMessage()    {}
 func (*MarkDatabaseDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{60}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{66}
 }
 func (m *MarkDatabaseDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkDatabaseDeleteCommand.Unmarshal(m, b)
@@ -4378,7 +4909,7 @@ func (m *UpdateShardOwnerCommand) Reset()         { *m = UpdateShardOwnerCommand
 func (m *UpdateShardOwnerCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateShardOwnerCommand) ProtoMessage()    {}
 func (*UpdateShardOwnerCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{61}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{67}
 }
 func (m *UpdateShardOwnerCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateShardOwnerCommand.Unmarshal(m, b)
@@ -4447,7 +4978,7 @@ func (m *MarkRetentionPolicyDeleteCommand) Reset()         { *m = MarkRetentionP
 func (m *MarkRetentionPolicyDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkRetentionPolicyDeleteCommand) ProtoMessage()    {}
 func (*MarkRetentionPolicyDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{62}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{68}
 }
 func (m *MarkRetentionPolicyDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkRetentionPolicyDeleteCommand.Unmarshal(m, b)
@@ -4510,7 +5041,7 @@ func (m *CreateMeasurementCommand) Reset()         { *m = CreateMeasurementComma
 func (m *CreateMeasurementCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateMeasurementCommand) ProtoMessage()    {}
 func (*CreateMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{63}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{69}
 }
 func (m *CreateMeasurementCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateMeasurementCommand.Unmarshal(m, b)
@@ -4623,7 +5154,7 @@ func (m *AlterShardKeyCmd) Reset()         { *m = AlterShardKeyCmd{} }
 func (m *AlterShardKeyCmd) String() string { return proto.CompactTextString(m) }
 func (*AlterShardKeyCmd) ProtoMessage()    {}
 func (*AlterShardKeyCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{64}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{70}
 }
 func (m *AlterShardKeyCmd) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AlterShardKeyCmd.Unmarshal(m, b)
@@ -4695,7 +5226,7 @@ func (m *UpdateDbPtStatusCommand) Reset()         { *m = UpdateDbPtStatusCommand
 func (m *UpdateDbPtStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateDbPtStatusCommand) ProtoMessage()    {}
 func (*UpdateDbPtStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{65}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{71}
 }
 func (m *UpdateDbPtStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateDbPtStatusCommand.Unmarshal(m, b)
@@ -4774,7 +5305,7 @@ func (m *ReShardingCommand) Reset()         { *m = ReShardingCommand{} }
 func (m *ReShardingCommand) String() string { return proto.CompactTextString(m) }
 func (*ReShardingCommand) ProtoMessage()    {}
 func (*ReShardingCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{66}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{72}
 }
 func (m *ReShardingCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReShardingCommand.Unmarshal(m, b)
@@ -4838,3574 +5369,4497 @@ var E_ReShardingCommand_Command = &proto.ExtensionDesc{
 	Filename:      "meta.proto",
 }
 
-type UpdateSchemaCommand struct {
-	Database             *string        `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
-	RpName               *string        `protobuf:"bytes,2,req,name=RpName" json:"RpName,omitempty"`
-	Measurement          *string        `protobuf:"bytes,3,req,name=Measurement" json:"Measurement,omitempty"`
-	FieldToCreate        []*FieldSchema `protobuf:"bytes,4,rep,name=FieldToCreate" json:"FieldToCreate,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type SplitShardCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	RpName               *string  `protobuf:"bytes,2,req,name=RpName" json:"RpName,omitempty"`
+	ShardGroupID         *uint64  `protobuf:"varint,3,req,name=ShardGroupID" json:"ShardGroupID,omitempty"`
+	ShardID              *uint64  `protobuf:"varint,4,req,name=ShardID" json:"ShardID,omitempty"`
+	SplitKey             *string  `protobuf:"bytes,5,req,name=SplitKey" json:"SplitKey,omitempty"`
+	PtID                 *uint32  `protobuf:"varint,6,req,name=PtID" json:"PtID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateSchemaCommand) Reset()         { *m = UpdateSchemaCommand{} }
-func (m *UpdateSchemaCommand) String() string { return proto.CompactTextString(m) }
-func (*UpdateSchemaCommand) ProtoMessage()    {}
-func (*UpdateSchemaCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{67}
+func (m *SplitShardCommand) Reset()         { *m = SplitShardCommand{} }
+func (m *SplitShardCommand) String() string { return proto.CompactTextString(m) }
+func (*SplitShardCommand) ProtoMessage()    {}
+func (*SplitShardCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{73}
 }
-func (m *UpdateSchemaCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateSchemaCommand.Unmarshal(m, b)
+func (m *SplitShardCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SplitShardCommand.Unmarshal(m, b)
 }
-func (m *UpdateSchemaCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateSchemaCommand.Marshal(b, m, deterministic)
+func (m *SplitShardCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SplitShardCommand.Marshal(b, m, deterministic)
 }
-func (m *UpdateSchemaCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateSchemaCommand.Merge(m, src)
+func (m *SplitShardCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitShardCommand.Merge(m, src)
 }
-func (m *UpdateSchemaCommand) XXX_Size() int {
-	return xxx_messageInfo_UpdateSchemaCommand.Size(m)
+func (m *SplitShardCommand) XXX_Size() int {
+	return xxx_messageInfo_SplitShardCommand.Size(m)
 }
-func (m *UpdateSchemaCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateSchemaCommand.DiscardUnknown(m)
+func (m *SplitShardCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitShardCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateSchemaCommand proto.InternalMessageInfo
+var xxx_messageInfo_SplitShardCommand proto.InternalMessageInfo
 
-func (m *UpdateSchemaCommand) GetDatabase() string {
+func (m *SplitShardCommand) GetDatabase() string {
 	if m != nil && m.Database != nil {
 		return *m.Database
 	}
 	return ""
 }
 
-func (m *UpdateSchemaCommand) GetRpName() string {
+func (m *SplitShardCommand) GetRpName() string {
 	if m != nil && m.RpName != nil {
 		return *m.RpName
 	}
 	return ""
 }
 
-func (m *UpdateSchemaCommand) GetMeasurement() string {
-	if m != nil && m.Measurement != nil {
-		return *m.Measurement
+func (m *SplitShardCommand) GetShardGroupID() uint64 {
+	if m != nil && m.ShardGroupID != nil {
+		return *m.ShardGroupID
+	}
+	return 0
+}
+
+func (m *SplitShardCommand) GetShardID() uint64 {
+	if m != nil && m.ShardID != nil {
+		return *m.ShardID
+	}
+	return 0
+}
+
+func (m *SplitShardCommand) GetSplitKey() string {
+	if m != nil && m.SplitKey != nil {
+		return *m.SplitKey
 	}
 	return ""
 }
 
-func (m *UpdateSchemaCommand) GetFieldToCreate() []*FieldSchema {
-	if m != nil {
-		return m.FieldToCreate
+func (m *SplitShardCommand) GetPtID() uint32 {
+	if m != nil && m.PtID != nil {
+		return *m.PtID
 	}
-	return nil
+	return 0
 }
 
-var E_UpdateSchemaCommand_Command = &proto.ExtensionDesc{
+var E_SplitShardCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*UpdateSchemaCommand)(nil),
-	Field:         153,
-	Name:          "proto.UpdateSchemaCommand.command",
-	Tag:           "bytes,153,opt,name=command",
+	ExtensionType: (*SplitShardCommand)(nil),
+	Field:         201,
+	Name:          "proto.SplitShardCommand.command",
+	Tag:           "bytes,201,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type FieldSchema struct {
-	FieldName            *string  `protobuf:"bytes,1,req,name=FieldName" json:"FieldName,omitempty"`
-	FieldType            *int32   `protobuf:"varint,2,req,name=FieldType" json:"FieldType,omitempty"`
-	EndTime              *int32   `protobuf:"varint,3,opt,name=EndTime" json:"EndTime,omitempty"`
+type SplitShardDoneCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	RpName               *string  `protobuf:"bytes,2,req,name=RpName" json:"RpName,omitempty"`
+	ShardGroupID         *uint64  `protobuf:"varint,3,req,name=ShardGroupID" json:"ShardGroupID,omitempty"`
+	ShardID              *uint64  `protobuf:"varint,4,req,name=ShardID" json:"ShardID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FieldSchema) Reset()         { *m = FieldSchema{} }
-func (m *FieldSchema) String() string { return proto.CompactTextString(m) }
-func (*FieldSchema) ProtoMessage()    {}
-func (*FieldSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{68}
+func (m *SplitShardDoneCommand) Reset()         { *m = SplitShardDoneCommand{} }
+func (m *SplitShardDoneCommand) String() string { return proto.CompactTextString(m) }
+func (*SplitShardDoneCommand) ProtoMessage()    {}
+func (*SplitShardDoneCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{74}
 }
-func (m *FieldSchema) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FieldSchema.Unmarshal(m, b)
+func (m *SplitShardDoneCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SplitShardDoneCommand.Unmarshal(m, b)
 }
-func (m *FieldSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FieldSchema.Marshal(b, m, deterministic)
+func (m *SplitShardDoneCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SplitShardDoneCommand.Marshal(b, m, deterministic)
 }
-func (m *FieldSchema) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FieldSchema.Merge(m, src)
+func (m *SplitShardDoneCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitShardDoneCommand.Merge(m, src)
 }
-func (m *FieldSchema) XXX_Size() int {
-	return xxx_messageInfo_FieldSchema.Size(m)
+func (m *SplitShardDoneCommand) XXX_Size() int {
+	return xxx_messageInfo_SplitShardDoneCommand.Size(m)
 }
-func (m *FieldSchema) XXX_DiscardUnknown() {
-	xxx_messageInfo_FieldSchema.DiscardUnknown(m)
+func (m *SplitShardDoneCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitShardDoneCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FieldSchema proto.InternalMessageInfo
+var xxx_messageInfo_SplitShardDoneCommand proto.InternalMessageInfo
 
-func (m *FieldSchema) GetFieldName() string {
-	if m != nil && m.FieldName != nil {
-		return *m.FieldName
+func (m *SplitShardDoneCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
 	}
 	return ""
 }
 
-func (m *FieldSchema) GetFieldType() int32 {
-	if m != nil && m.FieldType != nil {
-		return *m.FieldType
+func (m *SplitShardDoneCommand) GetRpName() string {
+	if m != nil && m.RpName != nil {
+		return *m.RpName
+	}
+	return ""
+}
+
+func (m *SplitShardDoneCommand) GetShardGroupID() uint64 {
+	if m != nil && m.ShardGroupID != nil {
+		return *m.ShardGroupID
 	}
 	return 0
 }
 
-func (m *FieldSchema) GetEndTime() int32 {
-	if m != nil && m.EndTime != nil {
-		return *m.EndTime
+func (m *SplitShardDoneCommand) GetShardID() uint64 {
+	if m != nil && m.ShardID != nil {
+		return *m.ShardID
 	}
 	return 0
 }
 
-type IndexInfo struct {
-	ID                   *uint64  `protobuf:"varint,1,req,name=ID" json:"ID,omitempty"`
-	OwnerIDs             []uint32 `protobuf:"varint,2,rep,name=OwnerIDs" json:"OwnerIDs,omitempty"`
-	MarkDelete           *bool    `protobuf:"varint,3,opt,name=MarkDelete" json:"MarkDelete,omitempty"`
+var E_SplitShardDoneCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*SplitShardDoneCommand)(nil),
+	Field:         202,
+	Name:          "proto.SplitShardDoneCommand.command",
+	Tag:           "bytes,202,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type CreateReplicationCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Target               *string  `protobuf:"bytes,2,req,name=Target" json:"Target,omitempty"`
+	Role                 *string  `protobuf:"bytes,3,req,name=Role" json:"Role,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IndexInfo) Reset()         { *m = IndexInfo{} }
-func (m *IndexInfo) String() string { return proto.CompactTextString(m) }
-func (*IndexInfo) ProtoMessage()    {}
-func (*IndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{69}
+func (m *CreateReplicationCommand) Reset()         { *m = CreateReplicationCommand{} }
+func (m *CreateReplicationCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateReplicationCommand) ProtoMessage()    {}
+func (*CreateReplicationCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{75}
 }
-func (m *IndexInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IndexInfo.Unmarshal(m, b)
+func (m *CreateReplicationCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateReplicationCommand.Unmarshal(m, b)
 }
-func (m *IndexInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IndexInfo.Marshal(b, m, deterministic)
+func (m *CreateReplicationCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateReplicationCommand.Marshal(b, m, deterministic)
 }
-func (m *IndexInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexInfo.Merge(m, src)
+func (m *CreateReplicationCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateReplicationCommand.Merge(m, src)
 }
-func (m *IndexInfo) XXX_Size() int {
-	return xxx_messageInfo_IndexInfo.Size(m)
+func (m *CreateReplicationCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateReplicationCommand.Size(m)
 }
-func (m *IndexInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexInfo.DiscardUnknown(m)
+func (m *CreateReplicationCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateReplicationCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IndexInfo proto.InternalMessageInfo
+var xxx_messageInfo_CreateReplicationCommand proto.InternalMessageInfo
 
-func (m *IndexInfo) GetID() uint64 {
-	if m != nil && m.ID != nil {
-		return *m.ID
+func (m *CreateReplicationCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
 	}
-	return 0
+	return ""
 }
 
-func (m *IndexInfo) GetOwnerIDs() []uint32 {
-	if m != nil {
-		return m.OwnerIDs
+func (m *CreateReplicationCommand) GetTarget() string {
+	if m != nil && m.Target != nil {
+		return *m.Target
 	}
-	return nil
+	return ""
 }
 
-func (m *IndexInfo) GetMarkDelete() bool {
-	if m != nil && m.MarkDelete != nil {
-		return *m.MarkDelete
+func (m *CreateReplicationCommand) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
 	}
-	return false
+	return ""
 }
 
-type IndexGroupInfo struct {
-	ID                   *uint64      `protobuf:"varint,1,req,name=ID" json:"ID,omitempty"`
-	StartTime            *int64       `protobuf:"varint,2,req,name=StartTime" json:"StartTime,omitempty"`
-	EndTime              *int64       `protobuf:"varint,3,req,name=EndTime" json:"EndTime,omitempty"`
-	DeletedAt            *int64       `protobuf:"varint,4,req,name=DeletedAt" json:"DeletedAt,omitempty"`
-	Indexes              []*IndexInfo `protobuf:"bytes,5,rep,name=Indexes" json:"Indexes,omitempty"`
-	EngineType           *uint32      `protobuf:"varint,6,opt,name=EngineType" json:"EngineType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+var E_CreateReplicationCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*CreateReplicationCommand)(nil),
+	Field:         203,
+	Name:          "proto.CreateReplicationCommand.command",
+	Tag:           "bytes,203,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-func (m *IndexGroupInfo) Reset()         { *m = IndexGroupInfo{} }
-func (m *IndexGroupInfo) String() string { return proto.CompactTextString(m) }
-func (*IndexGroupInfo) ProtoMessage()    {}
-func (*IndexGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{70}
+type DropReplicationCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Promote              *bool    `protobuf:"varint,2,req,name=Promote" json:"Promote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *IndexGroupInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IndexGroupInfo.Unmarshal(m, b)
+
+func (m *DropReplicationCommand) Reset()         { *m = DropReplicationCommand{} }
+func (m *DropReplicationCommand) String() string { return proto.CompactTextString(m) }
+func (*DropReplicationCommand) ProtoMessage()    {}
+func (*DropReplicationCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{76}
 }
-func (m *IndexGroupInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IndexGroupInfo.Marshal(b, m, deterministic)
+func (m *DropReplicationCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropReplicationCommand.Unmarshal(m, b)
 }
-func (m *IndexGroupInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexGroupInfo.Merge(m, src)
+func (m *DropReplicationCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropReplicationCommand.Marshal(b, m, deterministic)
 }
-func (m *IndexGroupInfo) XXX_Size() int {
-	return xxx_messageInfo_IndexGroupInfo.Size(m)
+func (m *DropReplicationCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropReplicationCommand.Merge(m, src)
 }
-func (m *IndexGroupInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexGroupInfo.DiscardUnknown(m)
+func (m *DropReplicationCommand) XXX_Size() int {
+	return xxx_messageInfo_DropReplicationCommand.Size(m)
+}
+func (m *DropReplicationCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropReplicationCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IndexGroupInfo proto.InternalMessageInfo
+var xxx_messageInfo_DropReplicationCommand proto.InternalMessageInfo
 
-func (m *IndexGroupInfo) GetID() uint64 {
-	if m != nil && m.ID != nil {
-		return *m.ID
+func (m *DropReplicationCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
 	}
-	return 0
+	return ""
 }
 
-func (m *IndexGroupInfo) GetStartTime() int64 {
-	if m != nil && m.StartTime != nil {
-		return *m.StartTime
+func (m *DropReplicationCommand) GetPromote() bool {
+	if m != nil && m.Promote != nil {
+		return *m.Promote
 	}
-	return 0
+	return false
 }
 
-func (m *IndexGroupInfo) GetEndTime() int64 {
-	if m != nil && m.EndTime != nil {
-		return *m.EndTime
-	}
-	return 0
+var E_DropReplicationCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DropReplicationCommand)(nil),
+	Field:         204,
+	Name:          "proto.DropReplicationCommand.command",
+	Tag:           "bytes,204,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-func (m *IndexGroupInfo) GetDeletedAt() int64 {
-	if m != nil && m.DeletedAt != nil {
-		return *m.DeletedAt
-	}
-	return 0
+type UpdateReplicationCheckpointCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	ShardID              *uint64  `protobuf:"varint,2,req,name=ShardID" json:"ShardID,omitempty"`
+	Seq                  *int64   `protobuf:"varint,3,req,name=Seq" json:"Seq,omitempty"`
+	UpdateTime           *int64   `protobuf:"varint,4,req,name=UpdateTime" json:"UpdateTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IndexGroupInfo) GetIndexes() []*IndexInfo {
-	if m != nil {
-		return m.Indexes
-	}
-	return nil
+func (m *UpdateReplicationCheckpointCommand) Reset()         { *m = UpdateReplicationCheckpointCommand{} }
+func (m *UpdateReplicationCheckpointCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdateReplicationCheckpointCommand) ProtoMessage()    {}
+func (*UpdateReplicationCheckpointCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{77}
 }
-
-func (m *IndexGroupInfo) GetEngineType() uint32 {
-	if m != nil && m.EngineType != nil {
-		return *m.EngineType
-	}
-	return 0
-}
-
-type ShardStatus struct {
-	ShardID              *uint64  `protobuf:"varint,1,req,name=ShardID" json:"ShardID,omitempty"`
-	ShardSize            *uint64  `protobuf:"varint,2,req,name=ShardSize" json:"ShardSize,omitempty"`
-	SeriesCount          *int32   `protobuf:"varint,3,req,name=SeriesCount" json:"SeriesCount,omitempty"`
-	MaxTime              *int64   `protobuf:"varint,4,req,name=MaxTime" json:"MaxTime,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *ShardStatus) Reset()         { *m = ShardStatus{} }
-func (m *ShardStatus) String() string { return proto.CompactTextString(m) }
-func (*ShardStatus) ProtoMessage()    {}
-func (*ShardStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{71}
+func (m *UpdateReplicationCheckpointCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateReplicationCheckpointCommand.Unmarshal(m, b)
 }
-func (m *ShardStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShardStatus.Unmarshal(m, b)
-}
-func (m *ShardStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShardStatus.Marshal(b, m, deterministic)
+func (m *UpdateReplicationCheckpointCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateReplicationCheckpointCommand.Marshal(b, m, deterministic)
 }
-func (m *ShardStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShardStatus.Merge(m, src)
+func (m *UpdateReplicationCheckpointCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateReplicationCheckpointCommand.Merge(m, src)
 }
-func (m *ShardStatus) XXX_Size() int {
-	return xxx_messageInfo_ShardStatus.Size(m)
+func (m *UpdateReplicationCheckpointCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdateReplicationCheckpointCommand.Size(m)
 }
-func (m *ShardStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShardStatus.DiscardUnknown(m)
+func (m *UpdateReplicationCheckpointCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateReplicationCheckpointCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShardStatus proto.InternalMessageInfo
+var xxx_messageInfo_UpdateReplicationCheckpointCommand proto.InternalMessageInfo
 
-func (m *ShardStatus) GetShardID() uint64 {
+func (m *UpdateReplicationCheckpointCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *UpdateReplicationCheckpointCommand) GetShardID() uint64 {
 	if m != nil && m.ShardID != nil {
 		return *m.ShardID
 	}
 	return 0
 }
 
-func (m *ShardStatus) GetShardSize() uint64 {
-	if m != nil && m.ShardSize != nil {
-		return *m.ShardSize
+func (m *UpdateReplicationCheckpointCommand) GetSeq() int64 {
+	if m != nil && m.Seq != nil {
+		return *m.Seq
 	}
 	return 0
 }
 
-func (m *ShardStatus) GetSeriesCount() int32 {
-	if m != nil && m.SeriesCount != nil {
-		return *m.SeriesCount
+func (m *UpdateReplicationCheckpointCommand) GetUpdateTime() int64 {
+	if m != nil && m.UpdateTime != nil {
+		return *m.UpdateTime
 	}
 	return 0
 }
 
-func (m *ShardStatus) GetMaxTime() int64 {
-	if m != nil && m.MaxTime != nil {
-		return *m.MaxTime
-	}
-	return 0
+var E_UpdateReplicationCheckpointCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*UpdateReplicationCheckpointCommand)(nil),
+	Field:         205,
+	Name:          "proto.UpdateReplicationCheckpointCommand.command",
+	Tag:           "bytes,205,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-type RpShardStatus struct {
-	RpName               *string      `protobuf:"bytes,1,req,name=RpName" json:"RpName,omitempty"`
-	ShardStats           *ShardStatus `protobuf:"bytes,2,req,name=ShardStats" json:"ShardStats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type RestoreDatabaseCommand struct {
+	Database             *DatabaseInfo `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Users                []*UserInfo   `protobuf:"bytes,2,rep,name=Users" json:"Users,omitempty"`
+	Streams              []*StreamInfo `protobuf:"bytes,3,rep,name=Streams" json:"Streams,omitempty"`
+	Overwrite            *bool         `protobuf:"varint,4,req,name=Overwrite" json:"Overwrite,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *RpShardStatus) Reset()         { *m = RpShardStatus{} }
-func (m *RpShardStatus) String() string { return proto.CompactTextString(m) }
-func (*RpShardStatus) ProtoMessage()    {}
-func (*RpShardStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{72}
+func (m *RestoreDatabaseCommand) Reset()         { *m = RestoreDatabaseCommand{} }
+func (m *RestoreDatabaseCommand) String() string { return proto.CompactTextString(m) }
+func (*RestoreDatabaseCommand) ProtoMessage()    {}
+func (*RestoreDatabaseCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{78}
 }
-func (m *RpShardStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RpShardStatus.Unmarshal(m, b)
+func (m *RestoreDatabaseCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreDatabaseCommand.Unmarshal(m, b)
 }
-func (m *RpShardStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RpShardStatus.Marshal(b, m, deterministic)
+func (m *RestoreDatabaseCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreDatabaseCommand.Marshal(b, m, deterministic)
 }
-func (m *RpShardStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RpShardStatus.Merge(m, src)
+func (m *RestoreDatabaseCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreDatabaseCommand.Merge(m, src)
 }
-func (m *RpShardStatus) XXX_Size() int {
-	return xxx_messageInfo_RpShardStatus.Size(m)
+func (m *RestoreDatabaseCommand) XXX_Size() int {
+	return xxx_messageInfo_RestoreDatabaseCommand.Size(m)
 }
-func (m *RpShardStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_RpShardStatus.DiscardUnknown(m)
+func (m *RestoreDatabaseCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreDatabaseCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RpShardStatus proto.InternalMessageInfo
+var xxx_messageInfo_RestoreDatabaseCommand proto.InternalMessageInfo
 
-func (m *RpShardStatus) GetRpName() string {
-	if m != nil && m.RpName != nil {
-		return *m.RpName
+func (m *RestoreDatabaseCommand) GetDatabase() *DatabaseInfo {
+	if m != nil {
+		return m.Database
 	}
-	return ""
+	return nil
 }
 
-func (m *RpShardStatus) GetShardStats() *ShardStatus {
+func (m *RestoreDatabaseCommand) GetUsers() []*UserInfo {
 	if m != nil {
-		return m.ShardStats
+		return m.Users
 	}
 	return nil
 }
 
-type DBPtStatus struct {
-	DB                   *string          `protobuf:"bytes,1,req,name=DB" json:"DB,omitempty"`
-	PtID                 *uint32          `protobuf:"varint,2,req,name=PtID" json:"PtID,omitempty"`
-	RpStats              []*RpShardStatus `protobuf:"bytes,3,rep,name=RpStats" json:"RpStats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *RestoreDatabaseCommand) GetStreams() []*StreamInfo {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
 }
 
-func (m *DBPtStatus) Reset()         { *m = DBPtStatus{} }
-func (m *DBPtStatus) String() string { return proto.CompactTextString(m) }
-func (*DBPtStatus) ProtoMessage()    {}
-func (*DBPtStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{73}
+func (m *RestoreDatabaseCommand) GetOverwrite() bool {
+	if m != nil && m.Overwrite != nil {
+		return *m.Overwrite
+	}
+	return false
 }
-func (m *DBPtStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DBPtStatus.Unmarshal(m, b)
+
+var E_RestoreDatabaseCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*RestoreDatabaseCommand)(nil),
+	Field:         206,
+	Name:          "proto.RestoreDatabaseCommand.command",
+	Tag:           "bytes,206,opt,name=command",
+	Filename:      "meta.proto",
 }
-func (m *DBPtStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DBPtStatus.Marshal(b, m, deterministic)
+
+type UpdateSchemaCommand struct {
+	Database             *string        `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	RpName               *string        `protobuf:"bytes,2,req,name=RpName" json:"RpName,omitempty"`
+	Measurement          *string        `protobuf:"bytes,3,req,name=Measurement" json:"Measurement,omitempty"`
+	FieldToCreate        []*FieldSchema `protobuf:"bytes,4,rep,name=FieldToCreate" json:"FieldToCreate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
-func (m *DBPtStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DBPtStatus.Merge(m, src)
+
+func (m *UpdateSchemaCommand) Reset()         { *m = UpdateSchemaCommand{} }
+func (m *UpdateSchemaCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdateSchemaCommand) ProtoMessage()    {}
+func (*UpdateSchemaCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{79}
 }
-func (m *DBPtStatus) XXX_Size() int {
-	return xxx_messageInfo_DBPtStatus.Size(m)
+func (m *UpdateSchemaCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSchemaCommand.Unmarshal(m, b)
 }
-func (m *DBPtStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_DBPtStatus.DiscardUnknown(m)
+func (m *UpdateSchemaCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSchemaCommand.Marshal(b, m, deterministic)
+}
+func (m *UpdateSchemaCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSchemaCommand.Merge(m, src)
+}
+func (m *UpdateSchemaCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdateSchemaCommand.Size(m)
+}
+func (m *UpdateSchemaCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSchemaCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DBPtStatus proto.InternalMessageInfo
+var xxx_messageInfo_UpdateSchemaCommand proto.InternalMessageInfo
 
-func (m *DBPtStatus) GetDB() string {
-	if m != nil && m.DB != nil {
-		return *m.DB
+func (m *UpdateSchemaCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
 	}
 	return ""
 }
 
-func (m *DBPtStatus) GetPtID() uint32 {
-	if m != nil && m.PtID != nil {
-		return *m.PtID
+func (m *UpdateSchemaCommand) GetRpName() string {
+	if m != nil && m.RpName != nil {
+		return *m.RpName
 	}
-	return 0
+	return ""
 }
 
-func (m *DBPtStatus) GetRpStats() []*RpShardStatus {
+func (m *UpdateSchemaCommand) GetMeasurement() string {
+	if m != nil && m.Measurement != nil {
+		return *m.Measurement
+	}
+	return ""
+}
+
+func (m *UpdateSchemaCommand) GetFieldToCreate() []*FieldSchema {
 	if m != nil {
-		return m.RpStats
+		return m.FieldToCreate
 	}
 	return nil
 }
 
-type ReportShardsLoadCommand struct {
-	DBPTStat             []*DBPtStatus `protobuf:"bytes,1,rep,name=DBPTStat" json:"DBPTStat,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+var E_UpdateSchemaCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*UpdateSchemaCommand)(nil),
+	Field:         153,
+	Name:          "proto.UpdateSchemaCommand.command",
+	Tag:           "bytes,153,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-func (m *ReportShardsLoadCommand) Reset()         { *m = ReportShardsLoadCommand{} }
-func (m *ReportShardsLoadCommand) String() string { return proto.CompactTextString(m) }
-func (*ReportShardsLoadCommand) ProtoMessage()    {}
-func (*ReportShardsLoadCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{74}
+type FieldSchema struct {
+	FieldName            *string  `protobuf:"bytes,1,req,name=FieldName" json:"FieldName,omitempty"`
+	FieldType            *int32   `protobuf:"varint,2,req,name=FieldType" json:"FieldType,omitempty"`
+	EndTime              *int32   `protobuf:"varint,3,opt,name=EndTime" json:"EndTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ReportShardsLoadCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReportShardsLoadCommand.Unmarshal(m, b)
+
+func (m *FieldSchema) Reset()         { *m = FieldSchema{} }
+func (m *FieldSchema) String() string { return proto.CompactTextString(m) }
+func (*FieldSchema) ProtoMessage()    {}
+func (*FieldSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{80}
 }
-func (m *ReportShardsLoadCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReportShardsLoadCommand.Marshal(b, m, deterministic)
+func (m *FieldSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldSchema.Unmarshal(m, b)
 }
-func (m *ReportShardsLoadCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReportShardsLoadCommand.Merge(m, src)
+func (m *FieldSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldSchema.Marshal(b, m, deterministic)
 }
-func (m *ReportShardsLoadCommand) XXX_Size() int {
-	return xxx_messageInfo_ReportShardsLoadCommand.Size(m)
+func (m *FieldSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldSchema.Merge(m, src)
 }
-func (m *ReportShardsLoadCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReportShardsLoadCommand.DiscardUnknown(m)
+func (m *FieldSchema) XXX_Size() int {
+	return xxx_messageInfo_FieldSchema.Size(m)
+}
+func (m *FieldSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldSchema.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReportShardsLoadCommand proto.InternalMessageInfo
+var xxx_messageInfo_FieldSchema proto.InternalMessageInfo
 
-func (m *ReportShardsLoadCommand) GetDBPTStat() []*DBPtStatus {
-	if m != nil {
-		return m.DBPTStat
+func (m *FieldSchema) GetFieldName() string {
+	if m != nil && m.FieldName != nil {
+		return *m.FieldName
 	}
-	return nil
-}
-
-var E_ReportShardsLoadCommand_Command = &proto.ExtensionDesc{
-	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*ReportShardsLoadCommand)(nil),
-	Field:         154,
-	Name:          "proto.ReportShardsLoadCommand.command",
-	Tag:           "bytes,154,opt,name=command",
-	Filename:      "meta.proto",
+	return ""
 }
 
-type DownSamplePolicyInfo struct {
-	Calls                []*DownSampleOperators `protobuf:"bytes,1,rep,name=Calls" json:"Calls,omitempty"`
-	DownSamplePolicies   []*DownSamplePolicy    `protobuf:"bytes,2,rep,name=DownSamplePolicies" json:"DownSamplePolicies,omitempty"`
-	Duration             *int64                 `protobuf:"varint,3,req,name=Duration" json:"Duration,omitempty"`
-	TaskID               *uint64                `protobuf:"varint,4,opt,name=TaskID" json:"TaskID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+func (m *FieldSchema) GetFieldType() int32 {
+	if m != nil && m.FieldType != nil {
+		return *m.FieldType
+	}
+	return 0
 }
 
-func (m *DownSamplePolicyInfo) Reset()         { *m = DownSamplePolicyInfo{} }
-func (m *DownSamplePolicyInfo) String() string { return proto.CompactTextString(m) }
-func (*DownSamplePolicyInfo) ProtoMessage()    {}
-func (*DownSamplePolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{75}
+func (m *FieldSchema) GetEndTime() int32 {
+	if m != nil && m.EndTime != nil {
+		return *m.EndTime
+	}
+	return 0
 }
-func (m *DownSamplePolicyInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DownSamplePolicyInfo.Unmarshal(m, b)
+
+type IndexInfo struct {
+	ID                   *uint64  `protobuf:"varint,1,req,name=ID" json:"ID,omitempty"`
+	OwnerIDs             []uint32 `protobuf:"varint,2,rep,name=OwnerIDs" json:"OwnerIDs,omitempty"`
+	MarkDelete           *bool    `protobuf:"varint,3,opt,name=MarkDelete" json:"MarkDelete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *DownSamplePolicyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DownSamplePolicyInfo.Marshal(b, m, deterministic)
+
+func (m *IndexInfo) Reset()         { *m = IndexInfo{} }
+func (m *IndexInfo) String() string { return proto.CompactTextString(m) }
+func (*IndexInfo) ProtoMessage()    {}
+func (*IndexInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{81}
 }
-func (m *DownSamplePolicyInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DownSamplePolicyInfo.Merge(m, src)
+func (m *IndexInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexInfo.Unmarshal(m, b)
 }
-func (m *DownSamplePolicyInfo) XXX_Size() int {
-	return xxx_messageInfo_DownSamplePolicyInfo.Size(m)
+func (m *IndexInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexInfo.Marshal(b, m, deterministic)
 }
-func (m *DownSamplePolicyInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_DownSamplePolicyInfo.DiscardUnknown(m)
+func (m *IndexInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexInfo.Merge(m, src)
+}
+func (m *IndexInfo) XXX_Size() int {
+	return xxx_messageInfo_IndexInfo.Size(m)
+}
+func (m *IndexInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DownSamplePolicyInfo proto.InternalMessageInfo
+var xxx_messageInfo_IndexInfo proto.InternalMessageInfo
 
-func (m *DownSamplePolicyInfo) GetCalls() []*DownSampleOperators {
-	if m != nil {
-		return m.Calls
+func (m *IndexInfo) GetID() uint64 {
+	if m != nil && m.ID != nil {
+		return *m.ID
 	}
-	return nil
+	return 0
 }
 
-func (m *DownSamplePolicyInfo) GetDownSamplePolicies() []*DownSamplePolicy {
+func (m *IndexInfo) GetOwnerIDs() []uint32 {
 	if m != nil {
-		return m.DownSamplePolicies
+		return m.OwnerIDs
 	}
 	return nil
 }
 
-func (m *DownSamplePolicyInfo) GetDuration() int64 {
-	if m != nil && m.Duration != nil {
-		return *m.Duration
-	}
-	return 0
-}
-
-func (m *DownSamplePolicyInfo) GetTaskID() uint64 {
-	if m != nil && m.TaskID != nil {
-		return *m.TaskID
+func (m *IndexInfo) GetMarkDelete() bool {
+	if m != nil && m.MarkDelete != nil {
+		return *m.MarkDelete
 	}
-	return 0
+	return false
 }
 
-type DownSamplePolicy struct {
-	SampleInterval       *int64   `protobuf:"varint,1,req,name=SampleInterval" json:"SampleInterval,omitempty"`
-	TimeInterval         *int64   `protobuf:"varint,2,req,name=TimeInterval" json:"TimeInterval,omitempty"`
-	WaterMark            *int64   `protobuf:"varint,3,req,name=WaterMark" json:"WaterMark,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type IndexGroupInfo struct {
+	ID                   *uint64      `protobuf:"varint,1,req,name=ID" json:"ID,omitempty"`
+	StartTime            *int64       `protobuf:"varint,2,req,name=StartTime" json:"StartTime,omitempty"`
+	EndTime              *int64       `protobuf:"varint,3,req,name=EndTime" json:"EndTime,omitempty"`
+	DeletedAt            *int64       `protobuf:"varint,4,req,name=DeletedAt" json:"DeletedAt,omitempty"`
+	Indexes              []*IndexInfo `protobuf:"bytes,5,rep,name=Indexes" json:"Indexes,omitempty"`
+	EngineType           *uint32      `protobuf:"varint,6,opt,name=EngineType" json:"EngineType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *DownSamplePolicy) Reset()         { *m = DownSamplePolicy{} }
-func (m *DownSamplePolicy) String() string { return proto.CompactTextString(m) }
-func (*DownSamplePolicy) ProtoMessage()    {}
-func (*DownSamplePolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{76}
+func (m *IndexGroupInfo) Reset()         { *m = IndexGroupInfo{} }
+func (m *IndexGroupInfo) String() string { return proto.CompactTextString(m) }
+func (*IndexGroupInfo) ProtoMessage()    {}
+func (*IndexGroupInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{82}
 }
-func (m *DownSamplePolicy) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DownSamplePolicy.Unmarshal(m, b)
+func (m *IndexGroupInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexGroupInfo.Unmarshal(m, b)
 }
-func (m *DownSamplePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DownSamplePolicy.Marshal(b, m, deterministic)
+func (m *IndexGroupInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexGroupInfo.Marshal(b, m, deterministic)
 }
-func (m *DownSamplePolicy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DownSamplePolicy.Merge(m, src)
+func (m *IndexGroupInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexGroupInfo.Merge(m, src)
 }
-func (m *DownSamplePolicy) XXX_Size() int {
-	return xxx_messageInfo_DownSamplePolicy.Size(m)
+func (m *IndexGroupInfo) XXX_Size() int {
+	return xxx_messageInfo_IndexGroupInfo.Size(m)
 }
-func (m *DownSamplePolicy) XXX_DiscardUnknown() {
-	xxx_messageInfo_DownSamplePolicy.DiscardUnknown(m)
+func (m *IndexGroupInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexGroupInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DownSamplePolicy proto.InternalMessageInfo
+var xxx_messageInfo_IndexGroupInfo proto.InternalMessageInfo
 
-func (m *DownSamplePolicy) GetSampleInterval() int64 {
-	if m != nil && m.SampleInterval != nil {
-		return *m.SampleInterval
+func (m *IndexGroupInfo) GetID() uint64 {
+	if m != nil && m.ID != nil {
+		return *m.ID
 	}
 	return 0
 }
 
-func (m *DownSamplePolicy) GetTimeInterval() int64 {
-	if m != nil && m.TimeInterval != nil {
-		return *m.TimeInterval
+func (m *IndexGroupInfo) GetStartTime() int64 {
+	if m != nil && m.StartTime != nil {
+		return *m.StartTime
 	}
 	return 0
 }
 
-func (m *DownSamplePolicy) GetWaterMark() int64 {
-	if m != nil && m.WaterMark != nil {
-		return *m.WaterMark
+func (m *IndexGroupInfo) GetEndTime() int64 {
+	if m != nil && m.EndTime != nil {
+		return *m.EndTime
 	}
 	return 0
 }
 
-type DownSampleOperators struct {
-	AggOps               []string `protobuf:"bytes,1,rep,name=AggOps" json:"AggOps,omitempty"`
-	DataType             *int64   `protobuf:"varint,2,req,name=DataType" json:"DataType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *DownSampleOperators) Reset()         { *m = DownSampleOperators{} }
-func (m *DownSampleOperators) String() string { return proto.CompactTextString(m) }
-func (*DownSampleOperators) ProtoMessage()    {}
-func (*DownSampleOperators) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{77}
-}
-func (m *DownSampleOperators) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DownSampleOperators.Unmarshal(m, b)
-}
-func (m *DownSampleOperators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DownSampleOperators.Marshal(b, m, deterministic)
-}
-func (m *DownSampleOperators) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DownSampleOperators.Merge(m, src)
-}
-func (m *DownSampleOperators) XXX_Size() int {
-	return xxx_messageInfo_DownSampleOperators.Size(m)
-}
-func (m *DownSampleOperators) XXX_DiscardUnknown() {
-	xxx_messageInfo_DownSampleOperators.DiscardUnknown(m)
+func (m *IndexGroupInfo) GetDeletedAt() int64 {
+	if m != nil && m.DeletedAt != nil {
+		return *m.DeletedAt
+	}
+	return 0
 }
 
-var xxx_messageInfo_DownSampleOperators proto.InternalMessageInfo
-
-func (m *DownSampleOperators) GetAggOps() []string {
+func (m *IndexGroupInfo) GetIndexes() []*IndexInfo {
 	if m != nil {
-		return m.AggOps
+		return m.Indexes
 	}
 	return nil
 }
 
-func (m *DownSampleOperators) GetDataType() int64 {
-	if m != nil && m.DataType != nil {
-		return *m.DataType
+func (m *IndexGroupInfo) GetEngineType() uint32 {
+	if m != nil && m.EngineType != nil {
+		return *m.EngineType
 	}
 	return 0
 }
 
-type DownSamplePolicyInfoWithDbRp struct {
-	Info                 *DownSamplePolicyInfo `protobuf:"bytes,1,req,name=Info" json:"Info,omitempty"`
-	DbName               *string               `protobuf:"bytes,2,req,name=DbName" json:"DbName,omitempty"`
-	RpName               *string               `protobuf:"bytes,3,req,name=RpName" json:"RpName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type ShardStatus struct {
+	ShardID              *uint64  `protobuf:"varint,1,req,name=ShardID" json:"ShardID,omitempty"`
+	ShardSize            *uint64  `protobuf:"varint,2,req,name=ShardSize" json:"ShardSize,omitempty"`
+	SeriesCount          *int32   `protobuf:"varint,3,req,name=SeriesCount" json:"SeriesCount,omitempty"`
+	MaxTime              *int64   `protobuf:"varint,4,req,name=MaxTime" json:"MaxTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DownSamplePolicyInfoWithDbRp) Reset()         { *m = DownSamplePolicyInfoWithDbRp{} }
-func (m *DownSamplePolicyInfoWithDbRp) String() string { return proto.CompactTextString(m) }
-func (*DownSamplePolicyInfoWithDbRp) ProtoMessage()    {}
-func (*DownSamplePolicyInfoWithDbRp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{78}
+func (m *ShardStatus) Reset()         { *m = ShardStatus{} }
+func (m *ShardStatus) String() string { return proto.CompactTextString(m) }
+func (*ShardStatus) ProtoMessage()    {}
+func (*ShardStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{83}
 }
-func (m *DownSamplePolicyInfoWithDbRp) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DownSamplePolicyInfoWithDbRp.Unmarshal(m, b)
+func (m *ShardStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardStatus.Unmarshal(m, b)
 }
-func (m *DownSamplePolicyInfoWithDbRp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DownSamplePolicyInfoWithDbRp.Marshal(b, m, deterministic)
+func (m *ShardStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardStatus.Marshal(b, m, deterministic)
 }
-func (m *DownSamplePolicyInfoWithDbRp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DownSamplePolicyInfoWithDbRp.Merge(m, src)
+func (m *ShardStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardStatus.Merge(m, src)
 }
-func (m *DownSamplePolicyInfoWithDbRp) XXX_Size() int {
-	return xxx_messageInfo_DownSamplePolicyInfoWithDbRp.Size(m)
+func (m *ShardStatus) XXX_Size() int {
+	return xxx_messageInfo_ShardStatus.Size(m)
 }
-func (m *DownSamplePolicyInfoWithDbRp) XXX_DiscardUnknown() {
-	xxx_messageInfo_DownSamplePolicyInfoWithDbRp.DiscardUnknown(m)
+func (m *ShardStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DownSamplePolicyInfoWithDbRp proto.InternalMessageInfo
+var xxx_messageInfo_ShardStatus proto.InternalMessageInfo
 
-func (m *DownSamplePolicyInfoWithDbRp) GetInfo() *DownSamplePolicyInfo {
-	if m != nil {
-		return m.Info
+func (m *ShardStatus) GetShardID() uint64 {
+	if m != nil && m.ShardID != nil {
+		return *m.ShardID
 	}
-	return nil
+	return 0
 }
 
-func (m *DownSamplePolicyInfoWithDbRp) GetDbName() string {
-	if m != nil && m.DbName != nil {
-		return *m.DbName
+func (m *ShardStatus) GetShardSize() uint64 {
+	if m != nil && m.ShardSize != nil {
+		return *m.ShardSize
 	}
-	return ""
+	return 0
 }
 
-func (m *DownSamplePolicyInfoWithDbRp) GetRpName() string {
-	if m != nil && m.RpName != nil {
-		return *m.RpName
+func (m *ShardStatus) GetSeriesCount() int32 {
+	if m != nil && m.SeriesCount != nil {
+		return *m.SeriesCount
 	}
-	return ""
+	return 0
 }
 
-type DownSamplePoliciesInfoWithDbRp struct {
-	Infos                []*DownSamplePolicyInfoWithDbRp `protobuf:"bytes,1,rep,name=Infos" json:"Infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+func (m *ShardStatus) GetMaxTime() int64 {
+	if m != nil && m.MaxTime != nil {
+		return *m.MaxTime
+	}
+	return 0
 }
 
-func (m *DownSamplePoliciesInfoWithDbRp) Reset()         { *m = DownSamplePoliciesInfoWithDbRp{} }
-func (m *DownSamplePoliciesInfoWithDbRp) String() string { return proto.CompactTextString(m) }
-func (*DownSamplePoliciesInfoWithDbRp) ProtoMessage()    {}
-func (*DownSamplePoliciesInfoWithDbRp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{79}
+type RpShardStatus struct {
+	RpName               *string      `protobuf:"bytes,1,req,name=RpName" json:"RpName,omitempty"`
+	ShardStats           *ShardStatus `protobuf:"bytes,2,req,name=ShardStats" json:"ShardStats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
-func (m *DownSamplePoliciesInfoWithDbRp) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DownSamplePoliciesInfoWithDbRp.Unmarshal(m, b)
+
+func (m *RpShardStatus) Reset()         { *m = RpShardStatus{} }
+func (m *RpShardStatus) String() string { return proto.CompactTextString(m) }
+func (*RpShardStatus) ProtoMessage()    {}
+func (*RpShardStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{84}
 }
-func (m *DownSamplePoliciesInfoWithDbRp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DownSamplePoliciesInfoWithDbRp.Marshal(b, m, deterministic)
+func (m *RpShardStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RpShardStatus.Unmarshal(m, b)
 }
-func (m *DownSamplePoliciesInfoWithDbRp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DownSamplePoliciesInfoWithDbRp.Merge(m, src)
+func (m *RpShardStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RpShardStatus.Marshal(b, m, deterministic)
 }
-func (m *DownSamplePoliciesInfoWithDbRp) XXX_Size() int {
-	return xxx_messageInfo_DownSamplePoliciesInfoWithDbRp.Size(m)
+func (m *RpShardStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RpShardStatus.Merge(m, src)
 }
-func (m *DownSamplePoliciesInfoWithDbRp) XXX_DiscardUnknown() {
-	xxx_messageInfo_DownSamplePoliciesInfoWithDbRp.DiscardUnknown(m)
+func (m *RpShardStatus) XXX_Size() int {
+	return xxx_messageInfo_RpShardStatus.Size(m)
+}
+func (m *RpShardStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RpShardStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DownSamplePoliciesInfoWithDbRp proto.InternalMessageInfo
+var xxx_messageInfo_RpShardStatus proto.InternalMessageInfo
 
-func (m *DownSamplePoliciesInfoWithDbRp) GetInfos() []*DownSamplePolicyInfoWithDbRp {
+func (m *RpShardStatus) GetRpName() string {
+	if m != nil && m.RpName != nil {
+		return *m.RpName
+	}
+	return ""
+}
+
+func (m *RpShardStatus) GetShardStats() *ShardStatus {
 	if m != nil {
-		return m.Infos
+		return m.ShardStats
 	}
 	return nil
 }
 
-type ShardDownSampleUpdateInfos struct {
-	Infos                []*ShardDownSampleUpdateInfo `protobuf:"bytes,1,rep,name=Infos" json:"Infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+type DBPtStatus struct {
+	DB                   *string          `protobuf:"bytes,1,req,name=DB" json:"DB,omitempty"`
+	PtID                 *uint32          `protobuf:"varint,2,req,name=PtID" json:"PtID,omitempty"`
+	RpStats              []*RpShardStatus `protobuf:"bytes,3,rep,name=RpStats" json:"RpStats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *ShardDownSampleUpdateInfos) Reset()         { *m = ShardDownSampleUpdateInfos{} }
-func (m *ShardDownSampleUpdateInfos) String() string { return proto.CompactTextString(m) }
-func (*ShardDownSampleUpdateInfos) ProtoMessage()    {}
-func (*ShardDownSampleUpdateInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{80}
+func (m *DBPtStatus) Reset()         { *m = DBPtStatus{} }
+func (m *DBPtStatus) String() string { return proto.CompactTextString(m) }
+func (*DBPtStatus) ProtoMessage()    {}
+func (*DBPtStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{85}
 }
-func (m *ShardDownSampleUpdateInfos) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShardDownSampleUpdateInfos.Unmarshal(m, b)
+func (m *DBPtStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DBPtStatus.Unmarshal(m, b)
 }
-func (m *ShardDownSampleUpdateInfos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShardDownSampleUpdateInfos.Marshal(b, m, deterministic)
+func (m *DBPtStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DBPtStatus.Marshal(b, m, deterministic)
 }
-func (m *ShardDownSampleUpdateInfos) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShardDownSampleUpdateInfos.Merge(m, src)
+func (m *DBPtStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBPtStatus.Merge(m, src)
 }
-func (m *ShardDownSampleUpdateInfos) XXX_Size() int {
-	return xxx_messageInfo_ShardDownSampleUpdateInfos.Size(m)
+func (m *DBPtStatus) XXX_Size() int {
+	return xxx_messageInfo_DBPtStatus.Size(m)
 }
-func (m *ShardDownSampleUpdateInfos) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShardDownSampleUpdateInfos.DiscardUnknown(m)
+func (m *DBPtStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBPtStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShardDownSampleUpdateInfos proto.InternalMessageInfo
+var xxx_messageInfo_DBPtStatus proto.InternalMessageInfo
 
-func (m *ShardDownSampleUpdateInfos) GetInfos() []*ShardDownSampleUpdateInfo {
+func (m *DBPtStatus) GetDB() string {
+	if m != nil && m.DB != nil {
+		return *m.DB
+	}
+	return ""
+}
+
+func (m *DBPtStatus) GetPtID() uint32 {
+	if m != nil && m.PtID != nil {
+		return *m.PtID
+	}
+	return 0
+}
+
+func (m *DBPtStatus) GetRpStats() []*RpShardStatus {
 	if m != nil {
-		return m.Infos
+		return m.RpStats
 	}
 	return nil
 }
 
-type ShardDownSampleUpdateInfo struct {
-	Ident                *ShardIdentifier `protobuf:"bytes,1,req,name=Ident" json:"Ident,omitempty"`
-	DownSampleLvl        *int64           `protobuf:"varint,2,req,name=DownSampleLvl" json:"DownSampleLvl,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type ReportShardsLoadCommand struct {
+	DBPTStat             []*DBPtStatus `protobuf:"bytes,1,rep,name=DBPTStat" json:"DBPTStat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ShardDownSampleUpdateInfo) Reset()         { *m = ShardDownSampleUpdateInfo{} }
-func (m *ShardDownSampleUpdateInfo) String() string { return proto.CompactTextString(m) }
-func (*ShardDownSampleUpdateInfo) ProtoMessage()    {}
-func (*ShardDownSampleUpdateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{81}
+func (m *ReportShardsLoadCommand) Reset()         { *m = ReportShardsLoadCommand{} }
+func (m *ReportShardsLoadCommand) String() string { return proto.CompactTextString(m) }
+func (*ReportShardsLoadCommand) ProtoMessage()    {}
+func (*ReportShardsLoadCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{86}
 }
-func (m *ShardDownSampleUpdateInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShardDownSampleUpdateInfo.Unmarshal(m, b)
+func (m *ReportShardsLoadCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportShardsLoadCommand.Unmarshal(m, b)
 }
-func (m *ShardDownSampleUpdateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShardDownSampleUpdateInfo.Marshal(b, m, deterministic)
+func (m *ReportShardsLoadCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportShardsLoadCommand.Marshal(b, m, deterministic)
 }
-func (m *ShardDownSampleUpdateInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShardDownSampleUpdateInfo.Merge(m, src)
+func (m *ReportShardsLoadCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportShardsLoadCommand.Merge(m, src)
 }
-func (m *ShardDownSampleUpdateInfo) XXX_Size() int {
-	return xxx_messageInfo_ShardDownSampleUpdateInfo.Size(m)
+func (m *ReportShardsLoadCommand) XXX_Size() int {
+	return xxx_messageInfo_ReportShardsLoadCommand.Size(m)
 }
-func (m *ShardDownSampleUpdateInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShardDownSampleUpdateInfo.DiscardUnknown(m)
+func (m *ReportShardsLoadCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportShardsLoadCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShardDownSampleUpdateInfo proto.InternalMessageInfo
+var xxx_messageInfo_ReportShardsLoadCommand proto.InternalMessageInfo
 
-func (m *ShardDownSampleUpdateInfo) GetIdent() *ShardIdentifier {
+func (m *ReportShardsLoadCommand) GetDBPTStat() []*DBPtStatus {
 	if m != nil {
-		return m.Ident
+		return m.DBPTStat
 	}
 	return nil
 }
 
-func (m *ShardDownSampleUpdateInfo) GetDownSampleLvl() int64 {
-	if m != nil && m.DownSampleLvl != nil {
-		return *m.DownSampleLvl
-	}
-	return 0
+var E_ReportShardsLoadCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*ReportShardsLoadCommand)(nil),
+	Field:         154,
+	Name:          "proto.ReportShardsLoadCommand.command",
+	Tag:           "bytes,154,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-type PruneGroupsCommand struct {
-	ShardGroup           *bool    `protobuf:"varint,1,req,name=ShardGroup" json:"ShardGroup,omitempty"`
-	ID                   *uint64  `protobuf:"varint,2,opt,name=ID" json:"ID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type DownSamplePolicyInfo struct {
+	Calls                []*DownSampleOperators `protobuf:"bytes,1,rep,name=Calls" json:"Calls,omitempty"`
+	DownSamplePolicies   []*DownSamplePolicy    `protobuf:"bytes,2,rep,name=DownSamplePolicies" json:"DownSamplePolicies,omitempty"`
+	Duration             *int64                 `protobuf:"varint,3,req,name=Duration" json:"Duration,omitempty"`
+	TaskID               *uint64                `protobuf:"varint,4,opt,name=TaskID" json:"TaskID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *PruneGroupsCommand) Reset()         { *m = PruneGroupsCommand{} }
-func (m *PruneGroupsCommand) String() string { return proto.CompactTextString(m) }
-func (*PruneGroupsCommand) ProtoMessage()    {}
-func (*PruneGroupsCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{82}
+func (m *DownSamplePolicyInfo) Reset()         { *m = DownSamplePolicyInfo{} }
+func (m *DownSamplePolicyInfo) String() string { return proto.CompactTextString(m) }
+func (*DownSamplePolicyInfo) ProtoMessage()    {}
+func (*DownSamplePolicyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{87}
 }
-func (m *PruneGroupsCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PruneGroupsCommand.Unmarshal(m, b)
+func (m *DownSamplePolicyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownSamplePolicyInfo.Unmarshal(m, b)
 }
-func (m *PruneGroupsCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PruneGroupsCommand.Marshal(b, m, deterministic)
+func (m *DownSamplePolicyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownSamplePolicyInfo.Marshal(b, m, deterministic)
 }
-func (m *PruneGroupsCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PruneGroupsCommand.Merge(m, src)
+func (m *DownSamplePolicyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownSamplePolicyInfo.Merge(m, src)
 }
-func (m *PruneGroupsCommand) XXX_Size() int {
-	return xxx_messageInfo_PruneGroupsCommand.Size(m)
+func (m *DownSamplePolicyInfo) XXX_Size() int {
+	return xxx_messageInfo_DownSamplePolicyInfo.Size(m)
 }
-func (m *PruneGroupsCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_PruneGroupsCommand.DiscardUnknown(m)
+func (m *DownSamplePolicyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownSamplePolicyInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PruneGroupsCommand proto.InternalMessageInfo
+var xxx_messageInfo_DownSamplePolicyInfo proto.InternalMessageInfo
 
-func (m *PruneGroupsCommand) GetShardGroup() bool {
-	if m != nil && m.ShardGroup != nil {
-		return *m.ShardGroup
+func (m *DownSamplePolicyInfo) GetCalls() []*DownSampleOperators {
+	if m != nil {
+		return m.Calls
 	}
-	return false
+	return nil
 }
 
-func (m *PruneGroupsCommand) GetID() uint64 {
-	if m != nil && m.ID != nil {
-		return *m.ID
+func (m *DownSamplePolicyInfo) GetDownSamplePolicies() []*DownSamplePolicy {
+	if m != nil {
+		return m.DownSamplePolicies
+	}
+	return nil
+}
+
+func (m *DownSamplePolicyInfo) GetDuration() int64 {
+	if m != nil && m.Duration != nil {
+		return *m.Duration
 	}
 	return 0
 }
 
-var E_PruneGroupsCommand_Command = &proto.ExtensionDesc{
-	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*PruneGroupsCommand)(nil),
-	Field:         157,
-	Name:          "proto.PruneGroupsCommand.command",
-	Tag:           "bytes,157,opt,name=command",
-	Filename:      "meta.proto",
+func (m *DownSamplePolicyInfo) GetTaskID() uint64 {
+	if m != nil && m.TaskID != nil {
+		return *m.TaskID
+	}
+	return 0
 }
 
-type MarkMeasurementDeleteCommand struct {
-	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
-	Policy               *string  `protobuf:"bytes,2,req,name=Policy" json:"Policy,omitempty"`
-	Measurement          *string  `protobuf:"bytes,3,req,name=Measurement" json:"Measurement,omitempty"`
+type DownSamplePolicy struct {
+	SampleInterval       *int64   `protobuf:"varint,1,req,name=SampleInterval" json:"SampleInterval,omitempty"`
+	TimeInterval         *int64   `protobuf:"varint,2,req,name=TimeInterval" json:"TimeInterval,omitempty"`
+	WaterMark            *int64   `protobuf:"varint,3,req,name=WaterMark" json:"WaterMark,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MarkMeasurementDeleteCommand) Reset()         { *m = MarkMeasurementDeleteCommand{} }
-func (m *MarkMeasurementDeleteCommand) String() string { return proto.CompactTextString(m) }
-func (*MarkMeasurementDeleteCommand) ProtoMessage()    {}
-func (*MarkMeasurementDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{83}
+func (m *DownSamplePolicy) Reset()         { *m = DownSamplePolicy{} }
+func (m *DownSamplePolicy) String() string { return proto.CompactTextString(m) }
+func (*DownSamplePolicy) ProtoMessage()    {}
+func (*DownSamplePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{88}
 }
-func (m *MarkMeasurementDeleteCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MarkMeasurementDeleteCommand.Unmarshal(m, b)
+func (m *DownSamplePolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownSamplePolicy.Unmarshal(m, b)
 }
-func (m *MarkMeasurementDeleteCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MarkMeasurementDeleteCommand.Marshal(b, m, deterministic)
+func (m *DownSamplePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownSamplePolicy.Marshal(b, m, deterministic)
 }
-func (m *MarkMeasurementDeleteCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MarkMeasurementDeleteCommand.Merge(m, src)
+func (m *DownSamplePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownSamplePolicy.Merge(m, src)
 }
-func (m *MarkMeasurementDeleteCommand) XXX_Size() int {
-	return xxx_messageInfo_MarkMeasurementDeleteCommand.Size(m)
+func (m *DownSamplePolicy) XXX_Size() int {
+	return xxx_messageInfo_DownSamplePolicy.Size(m)
 }
-func (m *MarkMeasurementDeleteCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_MarkMeasurementDeleteCommand.DiscardUnknown(m)
+func (m *DownSamplePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownSamplePolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MarkMeasurementDeleteCommand proto.InternalMessageInfo
+var xxx_messageInfo_DownSamplePolicy proto.InternalMessageInfo
 
-func (m *MarkMeasurementDeleteCommand) GetDatabase() string {
-	if m != nil && m.Database != nil {
-		return *m.Database
+func (m *DownSamplePolicy) GetSampleInterval() int64 {
+	if m != nil && m.SampleInterval != nil {
+		return *m.SampleInterval
 	}
-	return ""
+	return 0
 }
 
-func (m *MarkMeasurementDeleteCommand) GetPolicy() string {
-	if m != nil && m.Policy != nil {
-		return *m.Policy
+func (m *DownSamplePolicy) GetTimeInterval() int64 {
+	if m != nil && m.TimeInterval != nil {
+		return *m.TimeInterval
 	}
-	return ""
+	return 0
 }
 
-func (m *MarkMeasurementDeleteCommand) GetMeasurement() string {
-	if m != nil && m.Measurement != nil {
-		return *m.Measurement
+func (m *DownSamplePolicy) GetWaterMark() int64 {
+	if m != nil && m.WaterMark != nil {
+		return *m.WaterMark
 	}
-	return ""
-}
-
-var E_MarkMeasurementDeleteCommand_Command = &proto.ExtensionDesc{
-	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*MarkMeasurementDeleteCommand)(nil),
-	Field:         158,
-	Name:          "proto.MarkMeasurementDeleteCommand.command",
-	Tag:           "bytes,158,opt,name=command",
-	Filename:      "meta.proto",
+	return 0
 }
 
-type DropMeasurementCommand struct {
-	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
-	Policy               *string  `protobuf:"bytes,2,req,name=Policy" json:"Policy,omitempty"`
-	Measurement          *string  `protobuf:"bytes,3,req,name=Measurement" json:"Measurement,omitempty"`
+type DownSampleOperators struct {
+	AggOps               []string `protobuf:"bytes,1,rep,name=AggOps" json:"AggOps,omitempty"`
+	DataType             *int64   `protobuf:"varint,2,req,name=DataType" json:"DataType,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropMeasurementCommand) Reset()         { *m = DropMeasurementCommand{} }
-func (m *DropMeasurementCommand) String() string { return proto.CompactTextString(m) }
-func (*DropMeasurementCommand) ProtoMessage()    {}
-func (*DropMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{84}
+func (m *DownSampleOperators) Reset()         { *m = DownSampleOperators{} }
+func (m *DownSampleOperators) String() string { return proto.CompactTextString(m) }
+func (*DownSampleOperators) ProtoMessage()    {}
+func (*DownSampleOperators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{89}
 }
-func (m *DropMeasurementCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropMeasurementCommand.Unmarshal(m, b)
+func (m *DownSampleOperators) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownSampleOperators.Unmarshal(m, b)
 }
-func (m *DropMeasurementCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropMeasurementCommand.Marshal(b, m, deterministic)
+func (m *DownSampleOperators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownSampleOperators.Marshal(b, m, deterministic)
 }
-func (m *DropMeasurementCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropMeasurementCommand.Merge(m, src)
+func (m *DownSampleOperators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownSampleOperators.Merge(m, src)
 }
-func (m *DropMeasurementCommand) XXX_Size() int {
-	return xxx_messageInfo_DropMeasurementCommand.Size(m)
+func (m *DownSampleOperators) XXX_Size() int {
+	return xxx_messageInfo_DownSampleOperators.Size(m)
 }
-func (m *DropMeasurementCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropMeasurementCommand.DiscardUnknown(m)
+func (m *DownSampleOperators) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownSampleOperators.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropMeasurementCommand proto.InternalMessageInfo
-
-func (m *DropMeasurementCommand) GetDatabase() string {
-	if m != nil && m.Database != nil {
-		return *m.Database
-	}
-	return ""
-}
+var xxx_messageInfo_DownSampleOperators proto.InternalMessageInfo
 
-func (m *DropMeasurementCommand) GetPolicy() string {
-	if m != nil && m.Policy != nil {
-		return *m.Policy
+func (m *DownSampleOperators) GetAggOps() []string {
+	if m != nil {
+		return m.AggOps
 	}
-	return ""
+	return nil
 }
 
-func (m *DropMeasurementCommand) GetMeasurement() string {
-	if m != nil && m.Measurement != nil {
-		return *m.Measurement
+func (m *DownSampleOperators) GetDataType() int64 {
+	if m != nil && m.DataType != nil {
+		return *m.DataType
 	}
-	return ""
-}
-
-var E_DropMeasurementCommand_Command = &proto.ExtensionDesc{
-	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*DropMeasurementCommand)(nil),
-	Field:         159,
-	Name:          "proto.DropMeasurementCommand.command",
-	Tag:           "bytes,159,opt,name=command",
-	Filename:      "meta.proto",
+	return 0
 }
 
-type NodeStartInfo struct {
-	DataIndex            *uint64              `protobuf:"varint,1,req,name=DataIndex" json:"DataIndex,omitempty"`
-	NodeID               *uint64              `protobuf:"varint,2,req,name=NodeID" json:"NodeID,omitempty"`
-	PtIds                []uint32             `protobuf:"varint,3,rep,name=PtIds" json:"PtIds,omitempty"`
-	Durations            []*ShardDurationInfo `protobuf:"bytes,4,rep,name=Durations" json:"Durations,omitempty"`
-	LTime                *uint64              `protobuf:"varint,5,req,name=LTime" json:"LTime,omitempty"`
-	DBBriefInfo          []*DatabaseBriefInfo `protobuf:"bytes,6,rep,name=DBBriefInfo" json:"DBBriefInfo,omitempty"`
-	ConnId               *uint64              `protobuf:"varint,7,opt,name=connId" json:"connId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type DownSamplePolicyInfoWithDbRp struct {
+	Info                 *DownSamplePolicyInfo `protobuf:"bytes,1,req,name=Info" json:"Info,omitempty"`
+	DbName               *string               `protobuf:"bytes,2,req,name=DbName" json:"DbName,omitempty"`
+	RpName               *string               `protobuf:"bytes,3,req,name=RpName" json:"RpName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *NodeStartInfo) Reset()         { *m = NodeStartInfo{} }
-func (m *NodeStartInfo) String() string { return proto.CompactTextString(m) }
-func (*NodeStartInfo) ProtoMessage()    {}
-func (*NodeStartInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{85}
-}
-func (m *NodeStartInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeStartInfo.Unmarshal(m, b)
+func (m *DownSamplePolicyInfoWithDbRp) Reset()         { *m = DownSamplePolicyInfoWithDbRp{} }
+func (m *DownSamplePolicyInfoWithDbRp) String() string { return proto.CompactTextString(m) }
+func (*DownSamplePolicyInfoWithDbRp) ProtoMessage()    {}
+func (*DownSamplePolicyInfoWithDbRp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{90}
 }
-func (m *NodeStartInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeStartInfo.Marshal(b, m, deterministic)
+func (m *DownSamplePolicyInfoWithDbRp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownSamplePolicyInfoWithDbRp.Unmarshal(m, b)
 }
-func (m *NodeStartInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeStartInfo.Merge(m, src)
+func (m *DownSamplePolicyInfoWithDbRp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownSamplePolicyInfoWithDbRp.Marshal(b, m, deterministic)
 }
-func (m *NodeStartInfo) XXX_Size() int {
-	return xxx_messageInfo_NodeStartInfo.Size(m)
+func (m *DownSamplePolicyInfoWithDbRp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownSamplePolicyInfoWithDbRp.Merge(m, src)
 }
-func (m *NodeStartInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeStartInfo.DiscardUnknown(m)
+func (m *DownSamplePolicyInfoWithDbRp) XXX_Size() int {
+	return xxx_messageInfo_DownSamplePolicyInfoWithDbRp.Size(m)
 }
-
-var xxx_messageInfo_NodeStartInfo proto.InternalMessageInfo
-
-func (m *NodeStartInfo) GetDataIndex() uint64 {
-	if m != nil && m.DataIndex != nil {
-		return *m.DataIndex
-	}
-	return 0
+func (m *DownSamplePolicyInfoWithDbRp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownSamplePolicyInfoWithDbRp.DiscardUnknown(m)
 }
 
-func (m *NodeStartInfo) GetNodeID() uint64 {
-	if m != nil && m.NodeID != nil {
-		return *m.NodeID
-	}
-	return 0
-}
+var xxx_messageInfo_DownSamplePolicyInfoWithDbRp proto.InternalMessageInfo
 
-func (m *NodeStartInfo) GetPtIds() []uint32 {
+func (m *DownSamplePolicyInfoWithDbRp) GetInfo() *DownSamplePolicyInfo {
 	if m != nil {
-		return m.PtIds
+		return m.Info
 	}
 	return nil
 }
 
-func (m *NodeStartInfo) GetDurations() []*ShardDurationInfo {
-	if m != nil {
-		return m.Durations
+func (m *DownSamplePolicyInfoWithDbRp) GetDbName() string {
+	if m != nil && m.DbName != nil {
+		return *m.DbName
 	}
-	return nil
+	return ""
 }
 
-func (m *NodeStartInfo) GetLTime() uint64 {
-	if m != nil && m.LTime != nil {
-		return *m.LTime
+func (m *DownSamplePolicyInfoWithDbRp) GetRpName() string {
+	if m != nil && m.RpName != nil {
+		return *m.RpName
 	}
-	return 0
+	return ""
 }
 
-func (m *NodeStartInfo) GetDBBriefInfo() []*DatabaseBriefInfo {
-	if m != nil {
-		return m.DBBriefInfo
-	}
-	return nil
+type DownSamplePoliciesInfoWithDbRp struct {
+	Infos                []*DownSamplePolicyInfoWithDbRp `protobuf:"bytes,1,rep,name=Infos" json:"Infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func (m *NodeStartInfo) GetConnId() uint64 {
-	if m != nil && m.ConnId != nil {
-		return *m.ConnId
-	}
-	return 0
+func (m *DownSamplePoliciesInfoWithDbRp) Reset()         { *m = DownSamplePoliciesInfoWithDbRp{} }
+func (m *DownSamplePoliciesInfoWithDbRp) String() string { return proto.CompactTextString(m) }
+func (*DownSamplePoliciesInfoWithDbRp) ProtoMessage()    {}
+func (*DownSamplePoliciesInfoWithDbRp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{91}
 }
-
-type TimeRangeCommand struct {
+func (m *DownSamplePoliciesInfoWithDbRp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownSamplePoliciesInfoWithDbRp.Unmarshal(m, b)
+}
+func (m *DownSamplePoliciesInfoWithDbRp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownSamplePoliciesInfoWithDbRp.Marshal(b, m, deterministic)
+}
+func (m *DownSamplePoliciesInfoWithDbRp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownSamplePoliciesInfoWithDbRp.Merge(m, src)
+}
+func (m *DownSamplePoliciesInfoWithDbRp) XXX_Size() int {
+	return xxx_messageInfo_DownSamplePoliciesInfoWithDbRp.Size(m)
+}
+func (m *DownSamplePoliciesInfoWithDbRp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownSamplePoliciesInfoWithDbRp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownSamplePoliciesInfoWithDbRp proto.InternalMessageInfo
+
+func (m *DownSamplePoliciesInfoWithDbRp) GetInfos() []*DownSamplePolicyInfoWithDbRp {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type ShardDownSampleUpdateInfos struct {
+	Infos                []*ShardDownSampleUpdateInfo `protobuf:"bytes,1,rep,name=Infos" json:"Infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ShardDownSampleUpdateInfos) Reset()         { *m = ShardDownSampleUpdateInfos{} }
+func (m *ShardDownSampleUpdateInfos) String() string { return proto.CompactTextString(m) }
+func (*ShardDownSampleUpdateInfos) ProtoMessage()    {}
+func (*ShardDownSampleUpdateInfos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{92}
+}
+func (m *ShardDownSampleUpdateInfos) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardDownSampleUpdateInfos.Unmarshal(m, b)
+}
+func (m *ShardDownSampleUpdateInfos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardDownSampleUpdateInfos.Marshal(b, m, deterministic)
+}
+func (m *ShardDownSampleUpdateInfos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardDownSampleUpdateInfos.Merge(m, src)
+}
+func (m *ShardDownSampleUpdateInfos) XXX_Size() int {
+	return xxx_messageInfo_ShardDownSampleUpdateInfos.Size(m)
+}
+func (m *ShardDownSampleUpdateInfos) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardDownSampleUpdateInfos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardDownSampleUpdateInfos proto.InternalMessageInfo
+
+func (m *ShardDownSampleUpdateInfos) GetInfos() []*ShardDownSampleUpdateInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type ShardDownSampleUpdateInfo struct {
+	Ident                *ShardIdentifier `protobuf:"bytes,1,req,name=Ident" json:"Ident,omitempty"`
+	DownSampleLvl        *int64           `protobuf:"varint,2,req,name=DownSampleLvl" json:"DownSampleLvl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ShardDownSampleUpdateInfo) Reset()         { *m = ShardDownSampleUpdateInfo{} }
+func (m *ShardDownSampleUpdateInfo) String() string { return proto.CompactTextString(m) }
+func (*ShardDownSampleUpdateInfo) ProtoMessage()    {}
+func (*ShardDownSampleUpdateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{93}
+}
+func (m *ShardDownSampleUpdateInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardDownSampleUpdateInfo.Unmarshal(m, b)
+}
+func (m *ShardDownSampleUpdateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardDownSampleUpdateInfo.Marshal(b, m, deterministic)
+}
+func (m *ShardDownSampleUpdateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardDownSampleUpdateInfo.Merge(m, src)
+}
+func (m *ShardDownSampleUpdateInfo) XXX_Size() int {
+	return xxx_messageInfo_ShardDownSampleUpdateInfo.Size(m)
+}
+func (m *ShardDownSampleUpdateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardDownSampleUpdateInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardDownSampleUpdateInfo proto.InternalMessageInfo
+
+func (m *ShardDownSampleUpdateInfo) GetIdent() *ShardIdentifier {
+	if m != nil {
+		return m.Ident
+	}
+	return nil
+}
+
+func (m *ShardDownSampleUpdateInfo) GetDownSampleLvl() int64 {
+	if m != nil && m.DownSampleLvl != nil {
+		return *m.DownSampleLvl
+	}
+	return 0
+}
+
+type PruneGroupsCommand struct {
+	ShardGroup           *bool    `protobuf:"varint,1,req,name=ShardGroup" json:"ShardGroup,omitempty"`
+	ID                   *uint64  `protobuf:"varint,2,opt,name=ID" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PruneGroupsCommand) Reset()         { *m = PruneGroupsCommand{} }
+func (m *PruneGroupsCommand) String() string { return proto.CompactTextString(m) }
+func (*PruneGroupsCommand) ProtoMessage()    {}
+func (*PruneGroupsCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{94}
+}
+func (m *PruneGroupsCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PruneGroupsCommand.Unmarshal(m, b)
+}
+func (m *PruneGroupsCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PruneGroupsCommand.Marshal(b, m, deterministic)
+}
+func (m *PruneGroupsCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneGroupsCommand.Merge(m, src)
+}
+func (m *PruneGroupsCommand) XXX_Size() int {
+	return xxx_messageInfo_PruneGroupsCommand.Size(m)
+}
+func (m *PruneGroupsCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneGroupsCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruneGroupsCommand proto.InternalMessageInfo
+
+func (m *PruneGroupsCommand) GetShardGroup() bool {
+	if m != nil && m.ShardGroup != nil {
+		return *m.ShardGroup
+	}
+	return false
+}
+
+func (m *PruneGroupsCommand) GetID() uint64 {
+	if m != nil && m.ID != nil {
+		return *m.ID
+	}
+	return 0
+}
+
+var E_PruneGroupsCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*PruneGroupsCommand)(nil),
+	Field:         157,
+	Name:          "proto.PruneGroupsCommand.command",
+	Tag:           "bytes,157,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type MarkMeasurementDeleteCommand struct {
 	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
 	Policy               *string  `protobuf:"bytes,2,req,name=Policy" json:"Policy,omitempty"`
-	ShardID              *uint64  `protobuf:"varint,3,req,name=ShardID" json:"ShardID,omitempty"`
+	Measurement          *string  `protobuf:"bytes,3,req,name=Measurement" json:"Measurement,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TimeRangeCommand) Reset()         { *m = TimeRangeCommand{} }
-func (m *TimeRangeCommand) String() string { return proto.CompactTextString(m) }
-func (*TimeRangeCommand) ProtoMessage()    {}
-func (*TimeRangeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{86}
+func (m *MarkMeasurementDeleteCommand) Reset()         { *m = MarkMeasurementDeleteCommand{} }
+func (m *MarkMeasurementDeleteCommand) String() string { return proto.CompactTextString(m) }
+func (*MarkMeasurementDeleteCommand) ProtoMessage()    {}
+func (*MarkMeasurementDeleteCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{95}
+}
+func (m *MarkMeasurementDeleteCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkMeasurementDeleteCommand.Unmarshal(m, b)
+}
+func (m *MarkMeasurementDeleteCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkMeasurementDeleteCommand.Marshal(b, m, deterministic)
+}
+func (m *MarkMeasurementDeleteCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkMeasurementDeleteCommand.Merge(m, src)
+}
+func (m *MarkMeasurementDeleteCommand) XXX_Size() int {
+	return xxx_messageInfo_MarkMeasurementDeleteCommand.Size(m)
+}
+func (m *MarkMeasurementDeleteCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkMeasurementDeleteCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkMeasurementDeleteCommand proto.InternalMessageInfo
+
+func (m *MarkMeasurementDeleteCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *MarkMeasurementDeleteCommand) GetPolicy() string {
+	if m != nil && m.Policy != nil {
+		return *m.Policy
+	}
+	return ""
+}
+
+func (m *MarkMeasurementDeleteCommand) GetMeasurement() string {
+	if m != nil && m.Measurement != nil {
+		return *m.Measurement
+	}
+	return ""
+}
+
+var E_MarkMeasurementDeleteCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*MarkMeasurementDeleteCommand)(nil),
+	Field:         158,
+	Name:          "proto.MarkMeasurementDeleteCommand.command",
+	Tag:           "bytes,158,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type DropMeasurementCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Policy               *string  `protobuf:"bytes,2,req,name=Policy" json:"Policy,omitempty"`
+	Measurement          *string  `protobuf:"bytes,3,req,name=Measurement" json:"Measurement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropMeasurementCommand) Reset()         { *m = DropMeasurementCommand{} }
+func (m *DropMeasurementCommand) String() string { return proto.CompactTextString(m) }
+func (*DropMeasurementCommand) ProtoMessage()    {}
+func (*DropMeasurementCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{96}
+}
+func (m *DropMeasurementCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropMeasurementCommand.Unmarshal(m, b)
+}
+func (m *DropMeasurementCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropMeasurementCommand.Marshal(b, m, deterministic)
+}
+func (m *DropMeasurementCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropMeasurementCommand.Merge(m, src)
+}
+func (m *DropMeasurementCommand) XXX_Size() int {
+	return xxx_messageInfo_DropMeasurementCommand.Size(m)
+}
+func (m *DropMeasurementCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropMeasurementCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropMeasurementCommand proto.InternalMessageInfo
+
+func (m *DropMeasurementCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *DropMeasurementCommand) GetPolicy() string {
+	if m != nil && m.Policy != nil {
+		return *m.Policy
+	}
+	return ""
+}
+
+func (m *DropMeasurementCommand) GetMeasurement() string {
+	if m != nil && m.Measurement != nil {
+		return *m.Measurement
+	}
+	return ""
+}
+
+var E_DropMeasurementCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DropMeasurementCommand)(nil),
+	Field:         159,
+	Name:          "proto.DropMeasurementCommand.command",
+	Tag:           "bytes,159,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type NodeStartInfo struct {
+	DataIndex            *uint64              `protobuf:"varint,1,req,name=DataIndex" json:"DataIndex,omitempty"`
+	NodeID               *uint64              `protobuf:"varint,2,req,name=NodeID" json:"NodeID,omitempty"`
+	PtIds                []uint32             `protobuf:"varint,3,rep,name=PtIds" json:"PtIds,omitempty"`
+	Durations            []*ShardDurationInfo `protobuf:"bytes,4,rep,name=Durations" json:"Durations,omitempty"`
+	LTime                *uint64              `protobuf:"varint,5,req,name=LTime" json:"LTime,omitempty"`
+	DBBriefInfo          []*DatabaseBriefInfo `protobuf:"bytes,6,rep,name=DBBriefInfo" json:"DBBriefInfo,omitempty"`
+	ConnId               *uint64              `protobuf:"varint,7,opt,name=connId" json:"connId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *NodeStartInfo) Reset()         { *m = NodeStartInfo{} }
+func (m *NodeStartInfo) String() string { return proto.CompactTextString(m) }
+func (*NodeStartInfo) ProtoMessage()    {}
+func (*NodeStartInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{97}
+}
+func (m *NodeStartInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeStartInfo.Unmarshal(m, b)
+}
+func (m *NodeStartInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeStartInfo.Marshal(b, m, deterministic)
+}
+func (m *NodeStartInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeStartInfo.Merge(m, src)
+}
+func (m *NodeStartInfo) XXX_Size() int {
+	return xxx_messageInfo_NodeStartInfo.Size(m)
+}
+func (m *NodeStartInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeStartInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeStartInfo proto.InternalMessageInfo
+
+func (m *NodeStartInfo) GetDataIndex() uint64 {
+	if m != nil && m.DataIndex != nil {
+		return *m.DataIndex
+	}
+	return 0
+}
+
+func (m *NodeStartInfo) GetNodeID() uint64 {
+	if m != nil && m.NodeID != nil {
+		return *m.NodeID
+	}
+	return 0
+}
+
+func (m *NodeStartInfo) GetPtIds() []uint32 {
+	if m != nil {
+		return m.PtIds
+	}
+	return nil
+}
+
+func (m *NodeStartInfo) GetDurations() []*ShardDurationInfo {
+	if m != nil {
+		return m.Durations
+	}
+	return nil
+}
+
+func (m *NodeStartInfo) GetLTime() uint64 {
+	if m != nil && m.LTime != nil {
+		return *m.LTime
+	}
+	return 0
+}
+
+func (m *NodeStartInfo) GetDBBriefInfo() []*DatabaseBriefInfo {
+	if m != nil {
+		return m.DBBriefInfo
+	}
+	return nil
+}
+
+func (m *NodeStartInfo) GetConnId() uint64 {
+	if m != nil && m.ConnId != nil {
+		return *m.ConnId
+	}
+	return 0
+}
+
+type TimeRangeCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Policy               *string  `protobuf:"bytes,2,req,name=Policy" json:"Policy,omitempty"`
+	ShardID              *uint64  `protobuf:"varint,3,req,name=ShardID" json:"ShardID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimeRangeCommand) Reset()         { *m = TimeRangeCommand{} }
+func (m *TimeRangeCommand) String() string { return proto.CompactTextString(m) }
+func (*TimeRangeCommand) ProtoMessage()    {}
+func (*TimeRangeCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{98}
+}
+func (m *TimeRangeCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeRangeCommand.Unmarshal(m, b)
+}
+func (m *TimeRangeCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeRangeCommand.Marshal(b, m, deterministic)
+}
+func (m *TimeRangeCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeRangeCommand.Merge(m, src)
+}
+func (m *TimeRangeCommand) XXX_Size() int {
+	return xxx_messageInfo_TimeRangeCommand.Size(m)
+}
+func (m *TimeRangeCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeRangeCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeRangeCommand proto.InternalMessageInfo
+
+func (m *TimeRangeCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *TimeRangeCommand) GetPolicy() string {
+	if m != nil && m.Policy != nil {
+		return *m.Policy
+	}
+	return ""
+}
+
+func (m *TimeRangeCommand) GetShardID() uint64 {
+	if m != nil && m.ShardID != nil {
+		return *m.ShardID
+	}
+	return 0
+}
+
+var E_TimeRangeCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*TimeRangeCommand)(nil),
+	Field:         160,
+	Name:          "proto.TimeRangeCommand.command",
+	Tag:           "bytes,160,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type ShardDurationCommand struct {
+	Index                *uint64  `protobuf:"varint,1,req,name=Index" json:"Index,omitempty"`
+	Pts                  []uint32 `protobuf:"varint,2,rep,name=Pts" json:"Pts,omitempty"`
+	NodeId               *uint64  `protobuf:"varint,3,opt,name=nodeId" json:"nodeId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardDurationCommand) Reset()         { *m = ShardDurationCommand{} }
+func (m *ShardDurationCommand) String() string { return proto.CompactTextString(m) }
+func (*ShardDurationCommand) ProtoMessage()    {}
+func (*ShardDurationCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{99}
+}
+func (m *ShardDurationCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardDurationCommand.Unmarshal(m, b)
+}
+func (m *ShardDurationCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardDurationCommand.Marshal(b, m, deterministic)
+}
+func (m *ShardDurationCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardDurationCommand.Merge(m, src)
+}
+func (m *ShardDurationCommand) XXX_Size() int {
+	return xxx_messageInfo_ShardDurationCommand.Size(m)
+}
+func (m *ShardDurationCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardDurationCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardDurationCommand proto.InternalMessageInfo
+
+func (m *ShardDurationCommand) GetIndex() uint64 {
+	if m != nil && m.Index != nil {
+		return *m.Index
+	}
+	return 0
+}
+
+func (m *ShardDurationCommand) GetPts() []uint32 {
+	if m != nil {
+		return m.Pts
+	}
+	return nil
+}
+
+func (m *ShardDurationCommand) GetNodeId() uint64 {
+	if m != nil && m.NodeId != nil {
+		return *m.NodeId
+	}
+	return 0
+}
+
+var E_ShardDurationCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*ShardDurationCommand)(nil),
+	Field:         161,
+	Name:          "proto.ShardDurationCommand.command",
+	Tag:           "bytes,161,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type DurationDescriptor struct {
+	TierType             *uint64  `protobuf:"varint,1,req,name=TierType" json:"TierType,omitempty"`
+	TierDuration         *int64   `protobuf:"varint,2,req,name=TierDuration" json:"TierDuration,omitempty"`
+	Duration             *int64   `protobuf:"varint,3,req,name=Duration" json:"Duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DurationDescriptor) Reset()         { *m = DurationDescriptor{} }
+func (m *DurationDescriptor) String() string { return proto.CompactTextString(m) }
+func (*DurationDescriptor) ProtoMessage()    {}
+func (*DurationDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{100}
+}
+func (m *DurationDescriptor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DurationDescriptor.Unmarshal(m, b)
+}
+func (m *DurationDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DurationDescriptor.Marshal(b, m, deterministic)
+}
+func (m *DurationDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurationDescriptor.Merge(m, src)
+}
+func (m *DurationDescriptor) XXX_Size() int {
+	return xxx_messageInfo_DurationDescriptor.Size(m)
+}
+func (m *DurationDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurationDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurationDescriptor proto.InternalMessageInfo
+
+func (m *DurationDescriptor) GetTierType() uint64 {
+	if m != nil && m.TierType != nil {
+		return *m.TierType
+	}
+	return 0
+}
+
+func (m *DurationDescriptor) GetTierDuration() int64 {
+	if m != nil && m.TierDuration != nil {
+		return *m.TierDuration
+	}
+	return 0
+}
+
+func (m *DurationDescriptor) GetDuration() int64 {
+	if m != nil && m.Duration != nil {
+		return *m.Duration
+	}
+	return 0
+}
+
+type ShardIdentifier struct {
+	ShardID              *uint64  `protobuf:"varint,1,req,name=ShardID" json:"ShardID,omitempty"`
+	ShardGroupID         *uint64  `protobuf:"varint,2,req,name=ShardGroupID" json:"ShardGroupID,omitempty"`
+	OwnerDb              *string  `protobuf:"bytes,3,req,name=OwnerDb" json:"OwnerDb,omitempty"`
+	OwnerPt              *uint32  `protobuf:"varint,4,req,name=OwnerPt" json:"OwnerPt,omitempty"`
+	Policy               *string  `protobuf:"bytes,5,req,name=Policy" json:"Policy,omitempty"`
+	ShardType            *string  `protobuf:"bytes,6,req,name=ShardType" json:"ShardType,omitempty"`
+	DownSampleLevel      *int64   `protobuf:"varint,7,opt,name=DownSampleLevel" json:"DownSampleLevel,omitempty"`
+	DownSampleID         *uint64  `protobuf:"varint,8,opt,name=DownSampleID" json:"DownSampleID,omitempty"`
+	ReadOnly             *bool    `protobuf:"varint,9,opt,name=ReadOnly" json:"ReadOnly,omitempty"`
+	EngineType           *uint32  `protobuf:"varint,10,opt,name=EngineType" json:"EngineType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardIdentifier) Reset()         { *m = ShardIdentifier{} }
+func (m *ShardIdentifier) String() string { return proto.CompactTextString(m) }
+func (*ShardIdentifier) ProtoMessage()    {}
+func (*ShardIdentifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{101}
+}
+func (m *ShardIdentifier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardIdentifier.Unmarshal(m, b)
+}
+func (m *ShardIdentifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardIdentifier.Marshal(b, m, deterministic)
+}
+func (m *ShardIdentifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardIdentifier.Merge(m, src)
+}
+func (m *ShardIdentifier) XXX_Size() int {
+	return xxx_messageInfo_ShardIdentifier.Size(m)
+}
+func (m *ShardIdentifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardIdentifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardIdentifier proto.InternalMessageInfo
+
+func (m *ShardIdentifier) GetShardID() uint64 {
+	if m != nil && m.ShardID != nil {
+		return *m.ShardID
+	}
+	return 0
+}
+
+func (m *ShardIdentifier) GetShardGroupID() uint64 {
+	if m != nil && m.ShardGroupID != nil {
+		return *m.ShardGroupID
+	}
+	return 0
+}
+
+func (m *ShardIdentifier) GetOwnerDb() string {
+	if m != nil && m.OwnerDb != nil {
+		return *m.OwnerDb
+	}
+	return ""
+}
+
+func (m *ShardIdentifier) GetOwnerPt() uint32 {
+	if m != nil && m.OwnerPt != nil {
+		return *m.OwnerPt
+	}
+	return 0
+}
+
+func (m *ShardIdentifier) GetPolicy() string {
+	if m != nil && m.Policy != nil {
+		return *m.Policy
+	}
+	return ""
+}
+
+func (m *ShardIdentifier) GetShardType() string {
+	if m != nil && m.ShardType != nil {
+		return *m.ShardType
+	}
+	return ""
+}
+
+func (m *ShardIdentifier) GetDownSampleLevel() int64 {
+	if m != nil && m.DownSampleLevel != nil {
+		return *m.DownSampleLevel
+	}
+	return 0
+}
+
+func (m *ShardIdentifier) GetDownSampleID() uint64 {
+	if m != nil && m.DownSampleID != nil {
+		return *m.DownSampleID
+	}
+	return 0
+}
+
+func (m *ShardIdentifier) GetReadOnly() bool {
+	if m != nil && m.ReadOnly != nil {
+		return *m.ReadOnly
+	}
+	return false
+}
+
+func (m *ShardIdentifier) GetEngineType() uint32 {
+	if m != nil && m.EngineType != nil {
+		return *m.EngineType
+	}
+	return 0
+}
+
+type TimeRangeInfo struct {
+	StartTime            *int64   `protobuf:"varint,1,req,name=StartTime" json:"StartTime,omitempty"`
+	EndTime              *int64   `protobuf:"varint,2,req,name=EndTime" json:"EndTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimeRangeInfo) Reset()         { *m = TimeRangeInfo{} }
+func (m *TimeRangeInfo) String() string { return proto.CompactTextString(m) }
+func (*TimeRangeInfo) ProtoMessage()    {}
+func (*TimeRangeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{102}
+}
+func (m *TimeRangeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeRangeInfo.Unmarshal(m, b)
+}
+func (m *TimeRangeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeRangeInfo.Marshal(b, m, deterministic)
+}
+func (m *TimeRangeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeRangeInfo.Merge(m, src)
+}
+func (m *TimeRangeInfo) XXX_Size() int {
+	return xxx_messageInfo_TimeRangeInfo.Size(m)
+}
+func (m *TimeRangeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeRangeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeRangeInfo proto.InternalMessageInfo
+
+func (m *TimeRangeInfo) GetStartTime() int64 {
+	if m != nil && m.StartTime != nil {
+		return *m.StartTime
+	}
+	return 0
+}
+
+func (m *TimeRangeInfo) GetEndTime() int64 {
+	if m != nil && m.EndTime != nil {
+		return *m.EndTime
+	}
+	return 0
+}
+
+type IndexDescriptor struct {
+	IndexID              *uint64        `protobuf:"varint,1,req,name=IndexID" json:"IndexID,omitempty"`
+	IndexGroupID         *uint64        `protobuf:"varint,2,req,name=IndexGroupID" json:"IndexGroupID,omitempty"`
+	TimeRange            *TimeRangeInfo `protobuf:"bytes,3,req,name=TimeRange" json:"TimeRange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *IndexDescriptor) Reset()         { *m = IndexDescriptor{} }
+func (m *IndexDescriptor) String() string { return proto.CompactTextString(m) }
+func (*IndexDescriptor) ProtoMessage()    {}
+func (*IndexDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{103}
+}
+func (m *IndexDescriptor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexDescriptor.Unmarshal(m, b)
+}
+func (m *IndexDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexDescriptor.Marshal(b, m, deterministic)
+}
+func (m *IndexDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexDescriptor.Merge(m, src)
+}
+func (m *IndexDescriptor) XXX_Size() int {
+	return xxx_messageInfo_IndexDescriptor.Size(m)
+}
+func (m *IndexDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexDescriptor proto.InternalMessageInfo
+
+func (m *IndexDescriptor) GetIndexID() uint64 {
+	if m != nil && m.IndexID != nil {
+		return *m.IndexID
+	}
+	return 0
+}
+
+func (m *IndexDescriptor) GetIndexGroupID() uint64 {
+	if m != nil && m.IndexGroupID != nil {
+		return *m.IndexGroupID
+	}
+	return 0
+}
+
+func (m *IndexDescriptor) GetTimeRange() *TimeRangeInfo {
+	if m != nil {
+		return m.TimeRange
+	}
+	return nil
+}
+
+type ShardDurationInfo struct {
+	Ident                *ShardIdentifier    `protobuf:"bytes,1,opt,name=Ident" json:"Ident,omitempty"`
+	DurationInfo         *DurationDescriptor `protobuf:"bytes,2,req,name=DurationInfo" json:"DurationInfo,omitempty"`
+	MeasurementInfo      []*MeasurementInfo  `protobuf:"bytes,3,rep,name=MeasurementInfo" json:"MeasurementInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ShardDurationInfo) Reset()         { *m = ShardDurationInfo{} }
+func (m *ShardDurationInfo) String() string { return proto.CompactTextString(m) }
+func (*ShardDurationInfo) ProtoMessage()    {}
+func (*ShardDurationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{104}
 }
-func (m *TimeRangeCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TimeRangeCommand.Unmarshal(m, b)
+func (m *ShardDurationInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardDurationInfo.Unmarshal(m, b)
 }
-func (m *TimeRangeCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TimeRangeCommand.Marshal(b, m, deterministic)
+func (m *ShardDurationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardDurationInfo.Marshal(b, m, deterministic)
 }
-func (m *TimeRangeCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TimeRangeCommand.Merge(m, src)
+func (m *ShardDurationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardDurationInfo.Merge(m, src)
 }
-func (m *TimeRangeCommand) XXX_Size() int {
-	return xxx_messageInfo_TimeRangeCommand.Size(m)
+func (m *ShardDurationInfo) XXX_Size() int {
+	return xxx_messageInfo_ShardDurationInfo.Size(m)
 }
-func (m *TimeRangeCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_TimeRangeCommand.DiscardUnknown(m)
+func (m *ShardDurationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardDurationInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TimeRangeCommand proto.InternalMessageInfo
+var xxx_messageInfo_ShardDurationInfo proto.InternalMessageInfo
 
-func (m *TimeRangeCommand) GetDatabase() string {
-	if m != nil && m.Database != nil {
-		return *m.Database
+func (m *ShardDurationInfo) GetIdent() *ShardIdentifier {
+	if m != nil {
+		return m.Ident
 	}
-	return ""
+	return nil
 }
 
-func (m *TimeRangeCommand) GetPolicy() string {
-	if m != nil && m.Policy != nil {
-		return *m.Policy
+func (m *ShardDurationInfo) GetDurationInfo() *DurationDescriptor {
+	if m != nil {
+		return m.DurationInfo
 	}
-	return ""
+	return nil
 }
 
-func (m *TimeRangeCommand) GetShardID() uint64 {
-	if m != nil && m.ShardID != nil {
-		return *m.ShardID
+func (m *ShardDurationInfo) GetMeasurementInfo() []*MeasurementInfo {
+	if m != nil {
+		return m.MeasurementInfo
 	}
-	return 0
-}
-
-var E_TimeRangeCommand_Command = &proto.ExtensionDesc{
-	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*TimeRangeCommand)(nil),
-	Field:         160,
-	Name:          "proto.TimeRangeCommand.command",
-	Tag:           "bytes,160,opt,name=command",
-	Filename:      "meta.proto",
+	return nil
 }
 
-type ShardDurationCommand struct {
-	Index                *uint64  `protobuf:"varint,1,req,name=Index" json:"Index,omitempty"`
-	Pts                  []uint32 `protobuf:"varint,2,rep,name=Pts" json:"Pts,omitempty"`
-	NodeId               *uint64  `protobuf:"varint,3,opt,name=nodeId" json:"nodeId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ShardTimeRangeInfo struct {
+	TimeRange            *TimeRangeInfo     `protobuf:"bytes,1,req,name=TimeRange" json:"TimeRange,omitempty"`
+	OwnerIndex           *IndexDescriptor   `protobuf:"bytes,2,req,name=OwnerIndex" json:"OwnerIndex,omitempty"`
+	ShardDuration        *ShardDurationInfo `protobuf:"bytes,3,req,name=ShardDuration" json:"ShardDuration,omitempty"`
+	ShardType            *string            `protobuf:"bytes,4,opt,name=ShardType" json:"ShardType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *ShardDurationCommand) Reset()         { *m = ShardDurationCommand{} }
-func (m *ShardDurationCommand) String() string { return proto.CompactTextString(m) }
-func (*ShardDurationCommand) ProtoMessage()    {}
-func (*ShardDurationCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{87}
+func (m *ShardTimeRangeInfo) Reset()         { *m = ShardTimeRangeInfo{} }
+func (m *ShardTimeRangeInfo) String() string { return proto.CompactTextString(m) }
+func (*ShardTimeRangeInfo) ProtoMessage()    {}
+func (*ShardTimeRangeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{105}
 }
-func (m *ShardDurationCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShardDurationCommand.Unmarshal(m, b)
+func (m *ShardTimeRangeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardTimeRangeInfo.Unmarshal(m, b)
 }
-func (m *ShardDurationCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShardDurationCommand.Marshal(b, m, deterministic)
+func (m *ShardTimeRangeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardTimeRangeInfo.Marshal(b, m, deterministic)
 }
-func (m *ShardDurationCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShardDurationCommand.Merge(m, src)
+func (m *ShardTimeRangeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardTimeRangeInfo.Merge(m, src)
 }
-func (m *ShardDurationCommand) XXX_Size() int {
-	return xxx_messageInfo_ShardDurationCommand.Size(m)
+func (m *ShardTimeRangeInfo) XXX_Size() int {
+	return xxx_messageInfo_ShardTimeRangeInfo.Size(m)
 }
-func (m *ShardDurationCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShardDurationCommand.DiscardUnknown(m)
+func (m *ShardTimeRangeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardTimeRangeInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShardDurationCommand proto.InternalMessageInfo
+var xxx_messageInfo_ShardTimeRangeInfo proto.InternalMessageInfo
 
-func (m *ShardDurationCommand) GetIndex() uint64 {
-	if m != nil && m.Index != nil {
-		return *m.Index
+func (m *ShardTimeRangeInfo) GetTimeRange() *TimeRangeInfo {
+	if m != nil {
+		return m.TimeRange
 	}
-	return 0
+	return nil
 }
 
-func (m *ShardDurationCommand) GetPts() []uint32 {
+func (m *ShardTimeRangeInfo) GetOwnerIndex() *IndexDescriptor {
 	if m != nil {
-		return m.Pts
+		return m.OwnerIndex
 	}
 	return nil
 }
 
-func (m *ShardDurationCommand) GetNodeId() uint64 {
-	if m != nil && m.NodeId != nil {
-		return *m.NodeId
+func (m *ShardTimeRangeInfo) GetShardDuration() *ShardDurationInfo {
+	if m != nil {
+		return m.ShardDuration
 	}
-	return 0
+	return nil
 }
 
-var E_ShardDurationCommand_Command = &proto.ExtensionDesc{
-	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*ShardDurationCommand)(nil),
-	Field:         161,
-	Name:          "proto.ShardDurationCommand.command",
-	Tag:           "bytes,161,opt,name=command",
-	Filename:      "meta.proto",
+func (m *ShardTimeRangeInfo) GetShardType() string {
+	if m != nil && m.ShardType != nil {
+		return *m.ShardType
+	}
+	return ""
 }
 
-type DurationDescriptor struct {
-	TierType             *uint64  `protobuf:"varint,1,req,name=TierType" json:"TierType,omitempty"`
-	TierDuration         *int64   `protobuf:"varint,2,req,name=TierDuration" json:"TierDuration,omitempty"`
-	Duration             *int64   `protobuf:"varint,3,req,name=Duration" json:"Duration,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ShardDurationResponse struct {
+	DataIndex            *uint64              `protobuf:"varint,1,req,name=DataIndex" json:"DataIndex,omitempty"`
+	Durations            []*ShardDurationInfo `protobuf:"bytes,2,rep,name=Durations" json:"Durations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *DurationDescriptor) Reset()         { *m = DurationDescriptor{} }
-func (m *DurationDescriptor) String() string { return proto.CompactTextString(m) }
-func (*DurationDescriptor) ProtoMessage()    {}
-func (*DurationDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{88}
+func (m *ShardDurationResponse) Reset()         { *m = ShardDurationResponse{} }
+func (m *ShardDurationResponse) String() string { return proto.CompactTextString(m) }
+func (*ShardDurationResponse) ProtoMessage()    {}
+func (*ShardDurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{106}
 }
-func (m *DurationDescriptor) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DurationDescriptor.Unmarshal(m, b)
+func (m *ShardDurationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardDurationResponse.Unmarshal(m, b)
 }
-func (m *DurationDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DurationDescriptor.Marshal(b, m, deterministic)
+func (m *ShardDurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardDurationResponse.Marshal(b, m, deterministic)
 }
-func (m *DurationDescriptor) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DurationDescriptor.Merge(m, src)
+func (m *ShardDurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardDurationResponse.Merge(m, src)
 }
-func (m *DurationDescriptor) XXX_Size() int {
-	return xxx_messageInfo_DurationDescriptor.Size(m)
+func (m *ShardDurationResponse) XXX_Size() int {
+	return xxx_messageInfo_ShardDurationResponse.Size(m)
 }
-func (m *DurationDescriptor) XXX_DiscardUnknown() {
-	xxx_messageInfo_DurationDescriptor.DiscardUnknown(m)
+func (m *ShardDurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardDurationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DurationDescriptor proto.InternalMessageInfo
-
-func (m *DurationDescriptor) GetTierType() uint64 {
-	if m != nil && m.TierType != nil {
-		return *m.TierType
-	}
-	return 0
-}
+var xxx_messageInfo_ShardDurationResponse proto.InternalMessageInfo
 
-func (m *DurationDescriptor) GetTierDuration() int64 {
-	if m != nil && m.TierDuration != nil {
-		return *m.TierDuration
+func (m *ShardDurationResponse) GetDataIndex() uint64 {
+	if m != nil && m.DataIndex != nil {
+		return *m.DataIndex
 	}
 	return 0
 }
 
-func (m *DurationDescriptor) GetDuration() int64 {
-	if m != nil && m.Duration != nil {
-		return *m.Duration
+func (m *ShardDurationResponse) GetDurations() []*ShardDurationInfo {
+	if m != nil {
+		return m.Durations
 	}
-	return 0
+	return nil
 }
 
-type ShardIdentifier struct {
-	ShardID              *uint64  `protobuf:"varint,1,req,name=ShardID" json:"ShardID,omitempty"`
-	ShardGroupID         *uint64  `protobuf:"varint,2,req,name=ShardGroupID" json:"ShardGroupID,omitempty"`
-	OwnerDb              *string  `protobuf:"bytes,3,req,name=OwnerDb" json:"OwnerDb,omitempty"`
-	OwnerPt              *uint32  `protobuf:"varint,4,req,name=OwnerPt" json:"OwnerPt,omitempty"`
-	Policy               *string  `protobuf:"bytes,5,req,name=Policy" json:"Policy,omitempty"`
-	ShardType            *string  `protobuf:"bytes,6,req,name=ShardType" json:"ShardType,omitempty"`
-	DownSampleLevel      *int64   `protobuf:"varint,7,opt,name=DownSampleLevel" json:"DownSampleLevel,omitempty"`
-	DownSampleID         *uint64  `protobuf:"varint,8,opt,name=DownSampleID" json:"DownSampleID,omitempty"`
-	ReadOnly             *bool    `protobuf:"varint,9,opt,name=ReadOnly" json:"ReadOnly,omitempty"`
-	EngineType           *uint32  `protobuf:"varint,10,opt,name=EngineType" json:"EngineType,omitempty"`
+type DeleteIndexGroupCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Policy               *string  `protobuf:"bytes,2,req,name=Policy" json:"Policy,omitempty"`
+	IndexGroupID         *uint64  `protobuf:"varint,3,req,name=IndexGroupID" json:"IndexGroupID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShardIdentifier) Reset()         { *m = ShardIdentifier{} }
-func (m *ShardIdentifier) String() string { return proto.CompactTextString(m) }
-func (*ShardIdentifier) ProtoMessage()    {}
-func (*ShardIdentifier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{89}
-}
-func (m *ShardIdentifier) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShardIdentifier.Unmarshal(m, b)
-}
-func (m *ShardIdentifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShardIdentifier.Marshal(b, m, deterministic)
-}
-func (m *ShardIdentifier) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShardIdentifier.Merge(m, src)
-}
-func (m *ShardIdentifier) XXX_Size() int {
-	return xxx_messageInfo_ShardIdentifier.Size(m)
+func (m *DeleteIndexGroupCommand) Reset()         { *m = DeleteIndexGroupCommand{} }
+func (m *DeleteIndexGroupCommand) String() string { return proto.CompactTextString(m) }
+func (*DeleteIndexGroupCommand) ProtoMessage()    {}
+func (*DeleteIndexGroupCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{107}
 }
-func (m *ShardIdentifier) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShardIdentifier.DiscardUnknown(m)
+func (m *DeleteIndexGroupCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteIndexGroupCommand.Unmarshal(m, b)
 }
-
-var xxx_messageInfo_ShardIdentifier proto.InternalMessageInfo
-
-func (m *ShardIdentifier) GetShardID() uint64 {
-	if m != nil && m.ShardID != nil {
-		return *m.ShardID
-	}
-	return 0
+func (m *DeleteIndexGroupCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteIndexGroupCommand.Marshal(b, m, deterministic)
 }
-
-func (m *ShardIdentifier) GetShardGroupID() uint64 {
-	if m != nil && m.ShardGroupID != nil {
-		return *m.ShardGroupID
-	}
-	return 0
+func (m *DeleteIndexGroupCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteIndexGroupCommand.Merge(m, src)
 }
-
-func (m *ShardIdentifier) GetOwnerDb() string {
-	if m != nil && m.OwnerDb != nil {
-		return *m.OwnerDb
-	}
-	return ""
+func (m *DeleteIndexGroupCommand) XXX_Size() int {
+	return xxx_messageInfo_DeleteIndexGroupCommand.Size(m)
 }
-
-func (m *ShardIdentifier) GetOwnerPt() uint32 {
-	if m != nil && m.OwnerPt != nil {
-		return *m.OwnerPt
-	}
-	return 0
+func (m *DeleteIndexGroupCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteIndexGroupCommand.DiscardUnknown(m)
 }
 
-func (m *ShardIdentifier) GetPolicy() string {
-	if m != nil && m.Policy != nil {
-		return *m.Policy
-	}
-	return ""
-}
+var xxx_messageInfo_DeleteIndexGroupCommand proto.InternalMessageInfo
 
-func (m *ShardIdentifier) GetShardType() string {
-	if m != nil && m.ShardType != nil {
-		return *m.ShardType
+func (m *DeleteIndexGroupCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
 	}
 	return ""
 }
 
-func (m *ShardIdentifier) GetDownSampleLevel() int64 {
-	if m != nil && m.DownSampleLevel != nil {
-		return *m.DownSampleLevel
+func (m *DeleteIndexGroupCommand) GetPolicy() string {
+	if m != nil && m.Policy != nil {
+		return *m.Policy
 	}
-	return 0
+	return ""
 }
 
-func (m *ShardIdentifier) GetDownSampleID() uint64 {
-	if m != nil && m.DownSampleID != nil {
-		return *m.DownSampleID
+func (m *DeleteIndexGroupCommand) GetIndexGroupID() uint64 {
+	if m != nil && m.IndexGroupID != nil {
+		return *m.IndexGroupID
 	}
 	return 0
 }
 
-func (m *ShardIdentifier) GetReadOnly() bool {
-	if m != nil && m.ReadOnly != nil {
-		return *m.ReadOnly
-	}
-	return false
-}
-
-func (m *ShardIdentifier) GetEngineType() uint32 {
-	if m != nil && m.EngineType != nil {
-		return *m.EngineType
-	}
-	return 0
+var E_DeleteIndexGroupCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DeleteIndexGroupCommand)(nil),
+	Field:         162,
+	Name:          "proto.DeleteIndexGroupCommand.command",
+	Tag:           "bytes,162,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-type TimeRangeInfo struct {
-	StartTime            *int64   `protobuf:"varint,1,req,name=StartTime" json:"StartTime,omitempty"`
-	EndTime              *int64   `protobuf:"varint,2,req,name=EndTime" json:"EndTime,omitempty"`
+type UpdateShardInfoTierCommand struct {
+	ShardID              *uint64  `protobuf:"varint,1,req,name=ShardID" json:"ShardID,omitempty"`
+	Tier                 *uint64  `protobuf:"varint,2,req,name=Tier" json:"Tier,omitempty"`
+	DbName               *string  `protobuf:"bytes,3,req,name=DbName" json:"DbName,omitempty"`
+	RpName               *string  `protobuf:"bytes,4,req,name=RpName" json:"RpName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TimeRangeInfo) Reset()         { *m = TimeRangeInfo{} }
-func (m *TimeRangeInfo) String() string { return proto.CompactTextString(m) }
-func (*TimeRangeInfo) ProtoMessage()    {}
-func (*TimeRangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{90}
+func (m *UpdateShardInfoTierCommand) Reset()         { *m = UpdateShardInfoTierCommand{} }
+func (m *UpdateShardInfoTierCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdateShardInfoTierCommand) ProtoMessage()    {}
+func (*UpdateShardInfoTierCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{108}
 }
-func (m *TimeRangeInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TimeRangeInfo.Unmarshal(m, b)
+func (m *UpdateShardInfoTierCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateShardInfoTierCommand.Unmarshal(m, b)
 }
-func (m *TimeRangeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TimeRangeInfo.Marshal(b, m, deterministic)
+func (m *UpdateShardInfoTierCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateShardInfoTierCommand.Marshal(b, m, deterministic)
 }
-func (m *TimeRangeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TimeRangeInfo.Merge(m, src)
+func (m *UpdateShardInfoTierCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateShardInfoTierCommand.Merge(m, src)
 }
-func (m *TimeRangeInfo) XXX_Size() int {
-	return xxx_messageInfo_TimeRangeInfo.Size(m)
+func (m *UpdateShardInfoTierCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdateShardInfoTierCommand.Size(m)
 }
-func (m *TimeRangeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_TimeRangeInfo.DiscardUnknown(m)
+func (m *UpdateShardInfoTierCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateShardInfoTierCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TimeRangeInfo proto.InternalMessageInfo
+var xxx_messageInfo_UpdateShardInfoTierCommand proto.InternalMessageInfo
 
-func (m *TimeRangeInfo) GetStartTime() int64 {
-	if m != nil && m.StartTime != nil {
-		return *m.StartTime
+func (m *UpdateShardInfoTierCommand) GetShardID() uint64 {
+	if m != nil && m.ShardID != nil {
+		return *m.ShardID
 	}
 	return 0
 }
 
-func (m *TimeRangeInfo) GetEndTime() int64 {
-	if m != nil && m.EndTime != nil {
-		return *m.EndTime
+func (m *UpdateShardInfoTierCommand) GetTier() uint64 {
+	if m != nil && m.Tier != nil {
+		return *m.Tier
 	}
 	return 0
 }
 
-type IndexDescriptor struct {
-	IndexID              *uint64        `protobuf:"varint,1,req,name=IndexID" json:"IndexID,omitempty"`
-	IndexGroupID         *uint64        `protobuf:"varint,2,req,name=IndexGroupID" json:"IndexGroupID,omitempty"`
-	TimeRange            *TimeRangeInfo `protobuf:"bytes,3,req,name=TimeRange" json:"TimeRange,omitempty"`
+func (m *UpdateShardInfoTierCommand) GetDbName() string {
+	if m != nil && m.DbName != nil {
+		return *m.DbName
+	}
+	return ""
+}
+
+func (m *UpdateShardInfoTierCommand) GetRpName() string {
+	if m != nil && m.RpName != nil {
+		return *m.RpName
+	}
+	return ""
+}
+
+var E_UpdateShardInfoTierCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*UpdateShardInfoTierCommand)(nil),
+	Field:         163,
+	Name:          "proto.UpdateShardInfoTierCommand.command",
+	Tag:           "bytes,163,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type CardinalityInfo struct {
+	TimeRange            *TimeRangeInfo `protobuf:"bytes,1,req,name=TimeRange" json:"TimeRange,omitempty"`
+	Cardinality          *uint64        `protobuf:"varint,2,req,name=Cardinality" json:"Cardinality,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *IndexDescriptor) Reset()         { *m = IndexDescriptor{} }
-func (m *IndexDescriptor) String() string { return proto.CompactTextString(m) }
-func (*IndexDescriptor) ProtoMessage()    {}
-func (*IndexDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{91}
-}
-func (m *IndexDescriptor) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IndexDescriptor.Unmarshal(m, b)
+func (m *CardinalityInfo) Reset()         { *m = CardinalityInfo{} }
+func (m *CardinalityInfo) String() string { return proto.CompactTextString(m) }
+func (*CardinalityInfo) ProtoMessage()    {}
+func (*CardinalityInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{109}
 }
-func (m *IndexDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IndexDescriptor.Marshal(b, m, deterministic)
+func (m *CardinalityInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CardinalityInfo.Unmarshal(m, b)
 }
-func (m *IndexDescriptor) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexDescriptor.Merge(m, src)
+func (m *CardinalityInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CardinalityInfo.Marshal(b, m, deterministic)
 }
-func (m *IndexDescriptor) XXX_Size() int {
-	return xxx_messageInfo_IndexDescriptor.Size(m)
+func (m *CardinalityInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardinalityInfo.Merge(m, src)
 }
-func (m *IndexDescriptor) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexDescriptor.DiscardUnknown(m)
+func (m *CardinalityInfo) XXX_Size() int {
+	return xxx_messageInfo_CardinalityInfo.Size(m)
 }
-
-var xxx_messageInfo_IndexDescriptor proto.InternalMessageInfo
-
-func (m *IndexDescriptor) GetIndexID() uint64 {
-	if m != nil && m.IndexID != nil {
-		return *m.IndexID
-	}
-	return 0
+func (m *CardinalityInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CardinalityInfo.DiscardUnknown(m)
 }
 
-func (m *IndexDescriptor) GetIndexGroupID() uint64 {
-	if m != nil && m.IndexGroupID != nil {
-		return *m.IndexGroupID
-	}
-	return 0
-}
+var xxx_messageInfo_CardinalityInfo proto.InternalMessageInfo
 
-func (m *IndexDescriptor) GetTimeRange() *TimeRangeInfo {
+func (m *CardinalityInfo) GetTimeRange() *TimeRangeInfo {
 	if m != nil {
 		return m.TimeRange
 	}
 	return nil
 }
 
-type ShardDurationInfo struct {
-	Ident                *ShardIdentifier    `protobuf:"bytes,1,opt,name=Ident" json:"Ident,omitempty"`
-	DurationInfo         *DurationDescriptor `protobuf:"bytes,2,req,name=DurationInfo" json:"DurationInfo,omitempty"`
-	MeasurementInfo      []*MeasurementInfo  `protobuf:"bytes,3,rep,name=MeasurementInfo" json:"MeasurementInfo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *CardinalityInfo) GetCardinality() uint64 {
+	if m != nil && m.Cardinality != nil {
+		return *m.Cardinality
+	}
+	return 0
 }
 
-func (m *ShardDurationInfo) Reset()         { *m = ShardDurationInfo{} }
-func (m *ShardDurationInfo) String() string { return proto.CompactTextString(m) }
-func (*ShardDurationInfo) ProtoMessage()    {}
-func (*ShardDurationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{92}
+type MeasurementCardinalityInfo struct {
+	Name                 *string            `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Cardinality          []*CardinalityInfo `protobuf:"bytes,2,rep,name=Cardinality" json:"Cardinality,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
-func (m *ShardDurationInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShardDurationInfo.Unmarshal(m, b)
+
+func (m *MeasurementCardinalityInfo) Reset()         { *m = MeasurementCardinalityInfo{} }
+func (m *MeasurementCardinalityInfo) String() string { return proto.CompactTextString(m) }
+func (*MeasurementCardinalityInfo) ProtoMessage()    {}
+func (*MeasurementCardinalityInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{110}
 }
-func (m *ShardDurationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShardDurationInfo.Marshal(b, m, deterministic)
+func (m *MeasurementCardinalityInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeasurementCardinalityInfo.Unmarshal(m, b)
 }
-func (m *ShardDurationInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShardDurationInfo.Merge(m, src)
+func (m *MeasurementCardinalityInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeasurementCardinalityInfo.Marshal(b, m, deterministic)
 }
-func (m *ShardDurationInfo) XXX_Size() int {
-	return xxx_messageInfo_ShardDurationInfo.Size(m)
+func (m *MeasurementCardinalityInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeasurementCardinalityInfo.Merge(m, src)
 }
-func (m *ShardDurationInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShardDurationInfo.DiscardUnknown(m)
+func (m *MeasurementCardinalityInfo) XXX_Size() int {
+	return xxx_messageInfo_MeasurementCardinalityInfo.Size(m)
 }
-
-var xxx_messageInfo_ShardDurationInfo proto.InternalMessageInfo
-
-func (m *ShardDurationInfo) GetIdent() *ShardIdentifier {
-	if m != nil {
-		return m.Ident
-	}
-	return nil
+func (m *MeasurementCardinalityInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeasurementCardinalityInfo.DiscardUnknown(m)
 }
 
-func (m *ShardDurationInfo) GetDurationInfo() *DurationDescriptor {
-	if m != nil {
-		return m.DurationInfo
+var xxx_messageInfo_MeasurementCardinalityInfo proto.InternalMessageInfo
+
+func (m *MeasurementCardinalityInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *ShardDurationInfo) GetMeasurementInfo() []*MeasurementInfo {
+func (m *MeasurementCardinalityInfo) GetCardinality() []*CardinalityInfo {
 	if m != nil {
-		return m.MeasurementInfo
+		return m.Cardinality
 	}
 	return nil
 }
 
-type ShardTimeRangeInfo struct {
-	TimeRange            *TimeRangeInfo     `protobuf:"bytes,1,req,name=TimeRange" json:"TimeRange,omitempty"`
-	OwnerIndex           *IndexDescriptor   `protobuf:"bytes,2,req,name=OwnerIndex" json:"OwnerIndex,omitempty"`
-	ShardDuration        *ShardDurationInfo `protobuf:"bytes,3,req,name=ShardDuration" json:"ShardDuration,omitempty"`
-	ShardType            *string            `protobuf:"bytes,4,opt,name=ShardType" json:"ShardType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type CardinalityResponse struct {
+	Infos                []*MeasurementCardinalityInfo `protobuf:"bytes,1,rep,name=Infos" json:"Infos,omitempty"`
+	Err                  *string                       `protobuf:"bytes,2,opt,name=Err" json:"Err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-func (m *ShardTimeRangeInfo) Reset()         { *m = ShardTimeRangeInfo{} }
-func (m *ShardTimeRangeInfo) String() string { return proto.CompactTextString(m) }
-func (*ShardTimeRangeInfo) ProtoMessage()    {}
-func (*ShardTimeRangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{93}
-}
-func (m *ShardTimeRangeInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShardTimeRangeInfo.Unmarshal(m, b)
+func (m *CardinalityResponse) Reset()         { *m = CardinalityResponse{} }
+func (m *CardinalityResponse) String() string { return proto.CompactTextString(m) }
+func (*CardinalityResponse) ProtoMessage()    {}
+func (*CardinalityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{111}
 }
-func (m *ShardTimeRangeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShardTimeRangeInfo.Marshal(b, m, deterministic)
+func (m *CardinalityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CardinalityResponse.Unmarshal(m, b)
 }
-func (m *ShardTimeRangeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShardTimeRangeInfo.Merge(m, src)
+func (m *CardinalityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CardinalityResponse.Marshal(b, m, deterministic)
 }
-func (m *ShardTimeRangeInfo) XXX_Size() int {
-	return xxx_messageInfo_ShardTimeRangeInfo.Size(m)
+func (m *CardinalityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardinalityResponse.Merge(m, src)
 }
-func (m *ShardTimeRangeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShardTimeRangeInfo.DiscardUnknown(m)
+func (m *CardinalityResponse) XXX_Size() int {
+	return xxx_messageInfo_CardinalityResponse.Size(m)
 }
-
-var xxx_messageInfo_ShardTimeRangeInfo proto.InternalMessageInfo
-
-func (m *ShardTimeRangeInfo) GetTimeRange() *TimeRangeInfo {
-	if m != nil {
-		return m.TimeRange
-	}
-	return nil
+func (m *CardinalityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CardinalityResponse.DiscardUnknown(m)
 }
 
-func (m *ShardTimeRangeInfo) GetOwnerIndex() *IndexDescriptor {
-	if m != nil {
-		return m.OwnerIndex
-	}
-	return nil
-}
+var xxx_messageInfo_CardinalityResponse proto.InternalMessageInfo
 
-func (m *ShardTimeRangeInfo) GetShardDuration() *ShardDurationInfo {
+func (m *CardinalityResponse) GetInfos() []*MeasurementCardinalityInfo {
 	if m != nil {
-		return m.ShardDuration
+		return m.Infos
 	}
 	return nil
 }
 
-func (m *ShardTimeRangeInfo) GetShardType() string {
-	if m != nil && m.ShardType != nil {
-		return *m.ShardType
+func (m *CardinalityResponse) GetErr() string {
+	if m != nil && m.Err != nil {
+		return *m.Err
 	}
 	return ""
 }
 
-type ShardDurationResponse struct {
-	DataIndex            *uint64              `protobuf:"varint,1,req,name=DataIndex" json:"DataIndex,omitempty"`
-	Durations            []*ShardDurationInfo `protobuf:"bytes,2,rep,name=Durations" json:"Durations,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type UpdateNodeStatusCommand struct {
+	ID                   *uint64  `protobuf:"varint,1,req,name=ID" json:"ID,omitempty"`
+	Status               *int32   `protobuf:"varint,2,req,name=Status" json:"Status,omitempty"`
+	Ltime                *uint64  `protobuf:"varint,3,req,name=Ltime" json:"Ltime,omitempty"`
+	GossipAddr           *string  `protobuf:"bytes,4,req,name=GossipAddr" json:"GossipAddr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShardDurationResponse) Reset()         { *m = ShardDurationResponse{} }
-func (m *ShardDurationResponse) String() string { return proto.CompactTextString(m) }
-func (*ShardDurationResponse) ProtoMessage()    {}
-func (*ShardDurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{94}
+func (m *UpdateNodeStatusCommand) Reset()         { *m = UpdateNodeStatusCommand{} }
+func (m *UpdateNodeStatusCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdateNodeStatusCommand) ProtoMessage()    {}
+func (*UpdateNodeStatusCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{112}
 }
-func (m *ShardDurationResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShardDurationResponse.Unmarshal(m, b)
+func (m *UpdateNodeStatusCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateNodeStatusCommand.Unmarshal(m, b)
 }
-func (m *ShardDurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShardDurationResponse.Marshal(b, m, deterministic)
+func (m *UpdateNodeStatusCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateNodeStatusCommand.Marshal(b, m, deterministic)
 }
-func (m *ShardDurationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShardDurationResponse.Merge(m, src)
+func (m *UpdateNodeStatusCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNodeStatusCommand.Merge(m, src)
 }
-func (m *ShardDurationResponse) XXX_Size() int {
-	return xxx_messageInfo_ShardDurationResponse.Size(m)
+func (m *UpdateNodeStatusCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdateNodeStatusCommand.Size(m)
 }
-func (m *ShardDurationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShardDurationResponse.DiscardUnknown(m)
+func (m *UpdateNodeStatusCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNodeStatusCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShardDurationResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateNodeStatusCommand proto.InternalMessageInfo
 
-func (m *ShardDurationResponse) GetDataIndex() uint64 {
-	if m != nil && m.DataIndex != nil {
-		return *m.DataIndex
+func (m *UpdateNodeStatusCommand) GetID() uint64 {
+	if m != nil && m.ID != nil {
+		return *m.ID
 	}
 	return 0
 }
 
-func (m *ShardDurationResponse) GetDurations() []*ShardDurationInfo {
-	if m != nil {
-		return m.Durations
+func (m *UpdateNodeStatusCommand) GetStatus() int32 {
+	if m != nil && m.Status != nil {
+		return *m.Status
 	}
-	return nil
+	return 0
 }
 
-type DeleteIndexGroupCommand struct {
-	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
-	Policy               *string  `protobuf:"bytes,2,req,name=Policy" json:"Policy,omitempty"`
-	IndexGroupID         *uint64  `protobuf:"varint,3,req,name=IndexGroupID" json:"IndexGroupID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *UpdateNodeStatusCommand) GetLtime() uint64 {
+	if m != nil && m.Ltime != nil {
+		return *m.Ltime
+	}
+	return 0
 }
 
-func (m *DeleteIndexGroupCommand) Reset()         { *m = DeleteIndexGroupCommand{} }
-func (m *DeleteIndexGroupCommand) String() string { return proto.CompactTextString(m) }
-func (*DeleteIndexGroupCommand) ProtoMessage()    {}
-func (*DeleteIndexGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{95}
+func (m *UpdateNodeStatusCommand) GetGossipAddr() string {
+	if m != nil && m.GossipAddr != nil {
+		return *m.GossipAddr
+	}
+	return ""
 }
-func (m *DeleteIndexGroupCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteIndexGroupCommand.Unmarshal(m, b)
+
+var E_UpdateNodeStatusCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*UpdateNodeStatusCommand)(nil),
+	Field:         164,
+	Name:          "proto.UpdateNodeStatusCommand.command",
+	Tag:           "bytes,164,opt,name=command",
+	Filename:      "meta.proto",
 }
-func (m *DeleteIndexGroupCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteIndexGroupCommand.Marshal(b, m, deterministic)
+
+type DbPt struct {
+	Db                   *string                       `protobuf:"bytes,1,req,name=Db" json:"Db,omitempty"`
+	Pt                   *PtInfo                       `protobuf:"bytes,2,req,name=Pt" json:"Pt,omitempty"`
+	Shards               map[uint64]*ShardDurationInfo `protobuf:"bytes,3,rep,name=Shards" json:"Shards,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DBBriefInfo          *DatabaseBriefInfo            `protobuf:"bytes,4,opt,name=DBBriefInfo" json:"DBBriefInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
-func (m *DeleteIndexGroupCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteIndexGroupCommand.Merge(m, src)
+
+func (m *DbPt) Reset()         { *m = DbPt{} }
+func (m *DbPt) String() string { return proto.CompactTextString(m) }
+func (*DbPt) ProtoMessage()    {}
+func (*DbPt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{113}
 }
-func (m *DeleteIndexGroupCommand) XXX_Size() int {
-	return xxx_messageInfo_DeleteIndexGroupCommand.Size(m)
+func (m *DbPt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DbPt.Unmarshal(m, b)
 }
-func (m *DeleteIndexGroupCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteIndexGroupCommand.DiscardUnknown(m)
+func (m *DbPt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DbPt.Marshal(b, m, deterministic)
+}
+func (m *DbPt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DbPt.Merge(m, src)
+}
+func (m *DbPt) XXX_Size() int {
+	return xxx_messageInfo_DbPt.Size(m)
+}
+func (m *DbPt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DbPt.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteIndexGroupCommand proto.InternalMessageInfo
+var xxx_messageInfo_DbPt proto.InternalMessageInfo
 
-func (m *DeleteIndexGroupCommand) GetDatabase() string {
-	if m != nil && m.Database != nil {
-		return *m.Database
+func (m *DbPt) GetDb() string {
+	if m != nil && m.Db != nil {
+		return *m.Db
 	}
 	return ""
 }
 
-func (m *DeleteIndexGroupCommand) GetPolicy() string {
-	if m != nil && m.Policy != nil {
-		return *m.Policy
+func (m *DbPt) GetPt() *PtInfo {
+	if m != nil {
+		return m.Pt
 	}
-	return ""
+	return nil
 }
 
-func (m *DeleteIndexGroupCommand) GetIndexGroupID() uint64 {
-	if m != nil && m.IndexGroupID != nil {
-		return *m.IndexGroupID
+func (m *DbPt) GetShards() map[uint64]*ShardDurationInfo {
+	if m != nil {
+		return m.Shards
 	}
-	return 0
-}
-
-var E_DeleteIndexGroupCommand_Command = &proto.ExtensionDesc{
-	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*DeleteIndexGroupCommand)(nil),
-	Field:         162,
-	Name:          "proto.DeleteIndexGroupCommand.command",
-	Tag:           "bytes,162,opt,name=command",
-	Filename:      "meta.proto",
+	return nil
 }
 
-type UpdateShardInfoTierCommand struct {
-	ShardID              *uint64  `protobuf:"varint,1,req,name=ShardID" json:"ShardID,omitempty"`
-	Tier                 *uint64  `protobuf:"varint,2,req,name=Tier" json:"Tier,omitempty"`
-	DbName               *string  `protobuf:"bytes,3,req,name=DbName" json:"DbName,omitempty"`
-	RpName               *string  `protobuf:"bytes,4,req,name=RpName" json:"RpName,omitempty"`
+func (m *DbPt) GetDBBriefInfo() *DatabaseBriefInfo {
+	if m != nil {
+		return m.DBBriefInfo
+	}
+	return nil
+}
+
+type MigrateEventInfo struct {
+	EventId              *string  `protobuf:"bytes,1,opt,name=eventId" json:"eventId,omitempty"`
+	EventType            *int32   `protobuf:"varint,2,opt,name=eventType" json:"eventType,omitempty"`
+	OpId                 *uint64  `protobuf:"varint,3,opt,name=opId" json:"opId,omitempty"`
+	Pti                  *DbPt    `protobuf:"bytes,4,opt,name=pti" json:"pti,omitempty"`
+	CurrState            *int32   `protobuf:"varint,5,opt,name=currState" json:"currState,omitempty"`
+	PreState             *int32   `protobuf:"varint,6,opt,name=preState" json:"preState,omitempty"`
+	Src                  *uint64  `protobuf:"varint,7,opt,name=src" json:"src,omitempty"`
+	Dest                 *uint64  `protobuf:"varint,8,opt,name=dest" json:"dest,omitempty"`
+	CheckConflict        *bool    `protobuf:"varint,9,opt,name=checkConflict" json:"checkConflict,omitempty"`
+	AliveConnId          *uint64  `protobuf:"varint,10,opt,name=aliveConnId" json:"aliveConnId,omitempty"`
+	TransferFiles        *bool    `protobuf:"varint,11,opt,name=transferFiles" json:"transferFiles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateShardInfoTierCommand) Reset()         { *m = UpdateShardInfoTierCommand{} }
-func (m *UpdateShardInfoTierCommand) String() string { return proto.CompactTextString(m) }
-func (*UpdateShardInfoTierCommand) ProtoMessage()    {}
-func (*UpdateShardInfoTierCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{96}
+func (m *MigrateEventInfo) Reset()         { *m = MigrateEventInfo{} }
+func (m *MigrateEventInfo) String() string { return proto.CompactTextString(m) }
+func (*MigrateEventInfo) ProtoMessage()    {}
+func (*MigrateEventInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{114}
 }
-func (m *UpdateShardInfoTierCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateShardInfoTierCommand.Unmarshal(m, b)
+func (m *MigrateEventInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateEventInfo.Unmarshal(m, b)
 }
-func (m *UpdateShardInfoTierCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateShardInfoTierCommand.Marshal(b, m, deterministic)
+func (m *MigrateEventInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateEventInfo.Marshal(b, m, deterministic)
 }
-func (m *UpdateShardInfoTierCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateShardInfoTierCommand.Merge(m, src)
+func (m *MigrateEventInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateEventInfo.Merge(m, src)
 }
-func (m *UpdateShardInfoTierCommand) XXX_Size() int {
-	return xxx_messageInfo_UpdateShardInfoTierCommand.Size(m)
+func (m *MigrateEventInfo) XXX_Size() int {
+	return xxx_messageInfo_MigrateEventInfo.Size(m)
 }
-func (m *UpdateShardInfoTierCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateShardInfoTierCommand.DiscardUnknown(m)
+func (m *MigrateEventInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateEventInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateShardInfoTierCommand proto.InternalMessageInfo
+var xxx_messageInfo_MigrateEventInfo proto.InternalMessageInfo
 
-func (m *UpdateShardInfoTierCommand) GetShardID() uint64 {
-	if m != nil && m.ShardID != nil {
-		return *m.ShardID
+func (m *MigrateEventInfo) GetEventId() string {
+	if m != nil && m.EventId != nil {
+		return *m.EventId
 	}
-	return 0
+	return ""
 }
 
-func (m *UpdateShardInfoTierCommand) GetTier() uint64 {
-	if m != nil && m.Tier != nil {
-		return *m.Tier
+func (m *MigrateEventInfo) GetEventType() int32 {
+	if m != nil && m.EventType != nil {
+		return *m.EventType
 	}
 	return 0
 }
 
-func (m *UpdateShardInfoTierCommand) GetDbName() string {
-	if m != nil && m.DbName != nil {
-		return *m.DbName
+func (m *MigrateEventInfo) GetOpId() uint64 {
+	if m != nil && m.OpId != nil {
+		return *m.OpId
 	}
-	return ""
+	return 0
 }
 
-func (m *UpdateShardInfoTierCommand) GetRpName() string {
-	if m != nil && m.RpName != nil {
-		return *m.RpName
+func (m *MigrateEventInfo) GetPti() *DbPt {
+	if m != nil {
+		return m.Pti
 	}
-	return ""
+	return nil
 }
 
-var E_UpdateShardInfoTierCommand_Command = &proto.ExtensionDesc{
-	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*UpdateShardInfoTierCommand)(nil),
-	Field:         163,
-	Name:          "proto.UpdateShardInfoTierCommand.command",
-	Tag:           "bytes,163,opt,name=command",
-	Filename:      "meta.proto",
+func (m *MigrateEventInfo) GetCurrState() int32 {
+	if m != nil && m.CurrState != nil {
+		return *m.CurrState
+	}
+	return 0
 }
 
-type CardinalityInfo struct {
-	TimeRange            *TimeRangeInfo `protobuf:"bytes,1,req,name=TimeRange" json:"TimeRange,omitempty"`
-	Cardinality          *uint64        `protobuf:"varint,2,req,name=Cardinality" json:"Cardinality,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *MigrateEventInfo) GetPreState() int32 {
+	if m != nil && m.PreState != nil {
+		return *m.PreState
+	}
+	return 0
 }
 
-func (m *CardinalityInfo) Reset()         { *m = CardinalityInfo{} }
-func (m *CardinalityInfo) String() string { return proto.CompactTextString(m) }
-func (*CardinalityInfo) ProtoMessage()    {}
-func (*CardinalityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{97}
-}
-func (m *CardinalityInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CardinalityInfo.Unmarshal(m, b)
-}
-func (m *CardinalityInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CardinalityInfo.Marshal(b, m, deterministic)
-}
-func (m *CardinalityInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardinalityInfo.Merge(m, src)
-}
-func (m *CardinalityInfo) XXX_Size() int {
-	return xxx_messageInfo_CardinalityInfo.Size(m)
-}
-func (m *CardinalityInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_CardinalityInfo.DiscardUnknown(m)
+func (m *MigrateEventInfo) GetSrc() uint64 {
+	if m != nil && m.Src != nil {
+		return *m.Src
+	}
+	return 0
 }
 
-var xxx_messageInfo_CardinalityInfo proto.InternalMessageInfo
+func (m *MigrateEventInfo) GetDest() uint64 {
+	if m != nil && m.Dest != nil {
+		return *m.Dest
+	}
+	return 0
+}
 
-func (m *CardinalityInfo) GetTimeRange() *TimeRangeInfo {
-	if m != nil {
-		return m.TimeRange
+func (m *MigrateEventInfo) GetCheckConflict() bool {
+	if m != nil && m.CheckConflict != nil {
+		return *m.CheckConflict
 	}
-	return nil
+	return false
 }
 
-func (m *CardinalityInfo) GetCardinality() uint64 {
-	if m != nil && m.Cardinality != nil {
-		return *m.Cardinality
+func (m *MigrateEventInfo) GetAliveConnId() uint64 {
+	if m != nil && m.AliveConnId != nil {
+		return *m.AliveConnId
 	}
 	return 0
 }
 
-type MeasurementCardinalityInfo struct {
-	Name                 *string            `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
-	Cardinality          []*CardinalityInfo `protobuf:"bytes,2,rep,name=Cardinality" json:"Cardinality,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *MigrateEventInfo) GetTransferFiles() bool {
+	if m != nil && m.TransferFiles != nil {
+		return *m.TransferFiles
+	}
+	return false
 }
 
-func (m *MeasurementCardinalityInfo) Reset()         { *m = MeasurementCardinalityInfo{} }
-func (m *MeasurementCardinalityInfo) String() string { return proto.CompactTextString(m) }
-func (*MeasurementCardinalityInfo) ProtoMessage()    {}
-func (*MeasurementCardinalityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{98}
+type CreateEventCommand struct {
+	EventInfo            *MigrateEventInfo `protobuf:"bytes,1,req,name=eventInfo" json:"eventInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *MeasurementCardinalityInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MeasurementCardinalityInfo.Unmarshal(m, b)
+
+func (m *CreateEventCommand) Reset()         { *m = CreateEventCommand{} }
+func (m *CreateEventCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateEventCommand) ProtoMessage()    {}
+func (*CreateEventCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{115}
 }
-func (m *MeasurementCardinalityInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MeasurementCardinalityInfo.Marshal(b, m, deterministic)
+func (m *CreateEventCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateEventCommand.Unmarshal(m, b)
 }
-func (m *MeasurementCardinalityInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MeasurementCardinalityInfo.Merge(m, src)
+func (m *CreateEventCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateEventCommand.Marshal(b, m, deterministic)
 }
-func (m *MeasurementCardinalityInfo) XXX_Size() int {
-	return xxx_messageInfo_MeasurementCardinalityInfo.Size(m)
+func (m *CreateEventCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateEventCommand.Merge(m, src)
 }
-func (m *MeasurementCardinalityInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_MeasurementCardinalityInfo.DiscardUnknown(m)
+func (m *CreateEventCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateEventCommand.Size(m)
 }
-
-var xxx_messageInfo_MeasurementCardinalityInfo proto.InternalMessageInfo
-
-func (m *MeasurementCardinalityInfo) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
-	}
-	return ""
+func (m *CreateEventCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateEventCommand.DiscardUnknown(m)
 }
 
-func (m *MeasurementCardinalityInfo) GetCardinality() []*CardinalityInfo {
+var xxx_messageInfo_CreateEventCommand proto.InternalMessageInfo
+
+func (m *CreateEventCommand) GetEventInfo() *MigrateEventInfo {
 	if m != nil {
-		return m.Cardinality
+		return m.EventInfo
 	}
 	return nil
 }
 
-type CardinalityResponse struct {
-	Infos                []*MeasurementCardinalityInfo `protobuf:"bytes,1,rep,name=Infos" json:"Infos,omitempty"`
-	Err                  *string                       `protobuf:"bytes,2,opt,name=Err" json:"Err,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+var E_CreateEventCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*CreateEventCommand)(nil),
+	Field:         165,
+	Name:          "proto.CreateEventCommand.command",
+	Tag:           "bytes,165,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-func (m *CardinalityResponse) Reset()         { *m = CardinalityResponse{} }
-func (m *CardinalityResponse) String() string { return proto.CompactTextString(m) }
-func (*CardinalityResponse) ProtoMessage()    {}
-func (*CardinalityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{99}
+type UpdateEventCommand struct {
+	EventInfo            *MigrateEventInfo `protobuf:"bytes,1,req,name=eventInfo" json:"eventInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *CardinalityResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CardinalityResponse.Unmarshal(m, b)
+
+func (m *UpdateEventCommand) Reset()         { *m = UpdateEventCommand{} }
+func (m *UpdateEventCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdateEventCommand) ProtoMessage()    {}
+func (*UpdateEventCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{116}
 }
-func (m *CardinalityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CardinalityResponse.Marshal(b, m, deterministic)
+func (m *UpdateEventCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateEventCommand.Unmarshal(m, b)
 }
-func (m *CardinalityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardinalityResponse.Merge(m, src)
+func (m *UpdateEventCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateEventCommand.Marshal(b, m, deterministic)
 }
-func (m *CardinalityResponse) XXX_Size() int {
-	return xxx_messageInfo_CardinalityResponse.Size(m)
+func (m *UpdateEventCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateEventCommand.Merge(m, src)
 }
-func (m *CardinalityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CardinalityResponse.DiscardUnknown(m)
+func (m *UpdateEventCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdateEventCommand.Size(m)
+}
+func (m *UpdateEventCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateEventCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CardinalityResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateEventCommand proto.InternalMessageInfo
 
-func (m *CardinalityResponse) GetInfos() []*MeasurementCardinalityInfo {
+func (m *UpdateEventCommand) GetEventInfo() *MigrateEventInfo {
 	if m != nil {
-		return m.Infos
+		return m.EventInfo
 	}
 	return nil
 }
 
-func (m *CardinalityResponse) GetErr() string {
-	if m != nil && m.Err != nil {
-		return *m.Err
-	}
-	return ""
+var E_UpdateEventCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*UpdateEventCommand)(nil),
+	Field:         166,
+	Name:          "proto.UpdateEventCommand.command",
+	Tag:           "bytes,166,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-type UpdateNodeStatusCommand struct {
-	ID                   *uint64  `protobuf:"varint,1,req,name=ID" json:"ID,omitempty"`
-	Status               *int32   `protobuf:"varint,2,req,name=Status" json:"Status,omitempty"`
-	Ltime                *uint64  `protobuf:"varint,3,req,name=Ltime" json:"Ltime,omitempty"`
-	GossipAddr           *string  `protobuf:"bytes,4,req,name=GossipAddr" json:"GossipAddr,omitempty"`
+type UpdatePtInfoCommand struct {
+	Db                   *string  `protobuf:"bytes,1,req,name=Db" json:"Db,omitempty"`
+	Pt                   *PtInfo  `protobuf:"bytes,2,req,name=Pt" json:"Pt,omitempty"`
+	OwnerNode            *uint64  `protobuf:"varint,3,opt,name=OwnerNode" json:"OwnerNode,omitempty"`
+	Status               *uint32  `protobuf:"varint,4,opt,name=Status" json:"Status,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateNodeStatusCommand) Reset()         { *m = UpdateNodeStatusCommand{} }
-func (m *UpdateNodeStatusCommand) String() string { return proto.CompactTextString(m) }
-func (*UpdateNodeStatusCommand) ProtoMessage()    {}
-func (*UpdateNodeStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{100}
+func (m *UpdatePtInfoCommand) Reset()         { *m = UpdatePtInfoCommand{} }
+func (m *UpdatePtInfoCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdatePtInfoCommand) ProtoMessage()    {}
+func (*UpdatePtInfoCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{117}
 }
-func (m *UpdateNodeStatusCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateNodeStatusCommand.Unmarshal(m, b)
+func (m *UpdatePtInfoCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdatePtInfoCommand.Unmarshal(m, b)
 }
-func (m *UpdateNodeStatusCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateNodeStatusCommand.Marshal(b, m, deterministic)
+func (m *UpdatePtInfoCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdatePtInfoCommand.Marshal(b, m, deterministic)
 }
-func (m *UpdateNodeStatusCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateNodeStatusCommand.Merge(m, src)
+func (m *UpdatePtInfoCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePtInfoCommand.Merge(m, src)
 }
-func (m *UpdateNodeStatusCommand) XXX_Size() int {
-	return xxx_messageInfo_UpdateNodeStatusCommand.Size(m)
+func (m *UpdatePtInfoCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdatePtInfoCommand.Size(m)
 }
-func (m *UpdateNodeStatusCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateNodeStatusCommand.DiscardUnknown(m)
+func (m *UpdatePtInfoCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePtInfoCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateNodeStatusCommand proto.InternalMessageInfo
+var xxx_messageInfo_UpdatePtInfoCommand proto.InternalMessageInfo
 
-func (m *UpdateNodeStatusCommand) GetID() uint64 {
-	if m != nil && m.ID != nil {
-		return *m.ID
+func (m *UpdatePtInfoCommand) GetDb() string {
+	if m != nil && m.Db != nil {
+		return *m.Db
 	}
-	return 0
+	return ""
 }
 
-func (m *UpdateNodeStatusCommand) GetStatus() int32 {
-	if m != nil && m.Status != nil {
-		return *m.Status
+func (m *UpdatePtInfoCommand) GetPt() *PtInfo {
+	if m != nil {
+		return m.Pt
 	}
-	return 0
+	return nil
 }
 
-func (m *UpdateNodeStatusCommand) GetLtime() uint64 {
-	if m != nil && m.Ltime != nil {
-		return *m.Ltime
+func (m *UpdatePtInfoCommand) GetOwnerNode() uint64 {
+	if m != nil && m.OwnerNode != nil {
+		return *m.OwnerNode
 	}
 	return 0
 }
 
-func (m *UpdateNodeStatusCommand) GetGossipAddr() string {
-	if m != nil && m.GossipAddr != nil {
-		return *m.GossipAddr
+func (m *UpdatePtInfoCommand) GetStatus() uint32 {
+	if m != nil && m.Status != nil {
+		return *m.Status
 	}
-	return ""
+	return 0
 }
 
-var E_UpdateNodeStatusCommand_Command = &proto.ExtensionDesc{
+var E_UpdatePtInfoCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*UpdateNodeStatusCommand)(nil),
-	Field:         164,
-	Name:          "proto.UpdateNodeStatusCommand.command",
-	Tag:           "bytes,164,opt,name=command",
+	ExtensionType: (*UpdatePtInfoCommand)(nil),
+	Field:         167,
+	Name:          "proto.UpdatePtInfoCommand.command",
+	Tag:           "bytes,167,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type DbPt struct {
-	Db                   *string                       `protobuf:"bytes,1,req,name=Db" json:"Db,omitempty"`
-	Pt                   *PtInfo                       `protobuf:"bytes,2,req,name=Pt" json:"Pt,omitempty"`
-	Shards               map[uint64]*ShardDurationInfo `protobuf:"bytes,3,rep,name=Shards" json:"Shards,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	DBBriefInfo          *DatabaseBriefInfo            `protobuf:"bytes,4,opt,name=DBBriefInfo" json:"DBBriefInfo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+type RemoveEventCommand struct {
+	EventId              *string  `protobuf:"bytes,1,req,name=eventId" json:"eventId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DbPt) Reset()         { *m = DbPt{} }
-func (m *DbPt) String() string { return proto.CompactTextString(m) }
-func (*DbPt) ProtoMessage()    {}
-func (*DbPt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{101}
+func (m *RemoveEventCommand) Reset()         { *m = RemoveEventCommand{} }
+func (m *RemoveEventCommand) String() string { return proto.CompactTextString(m) }
+func (*RemoveEventCommand) ProtoMessage()    {}
+func (*RemoveEventCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{118}
 }
-func (m *DbPt) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DbPt.Unmarshal(m, b)
+func (m *RemoveEventCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveEventCommand.Unmarshal(m, b)
 }
-func (m *DbPt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DbPt.Marshal(b, m, deterministic)
+func (m *RemoveEventCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveEventCommand.Marshal(b, m, deterministic)
 }
-func (m *DbPt) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DbPt.Merge(m, src)
+func (m *RemoveEventCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveEventCommand.Merge(m, src)
 }
-func (m *DbPt) XXX_Size() int {
-	return xxx_messageInfo_DbPt.Size(m)
+func (m *RemoveEventCommand) XXX_Size() int {
+	return xxx_messageInfo_RemoveEventCommand.Size(m)
 }
-func (m *DbPt) XXX_DiscardUnknown() {
-	xxx_messageInfo_DbPt.DiscardUnknown(m)
+func (m *RemoveEventCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveEventCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DbPt proto.InternalMessageInfo
+var xxx_messageInfo_RemoveEventCommand proto.InternalMessageInfo
 
-func (m *DbPt) GetDb() string {
-	if m != nil && m.Db != nil {
-		return *m.Db
+func (m *RemoveEventCommand) GetEventId() string {
+	if m != nil && m.EventId != nil {
+		return *m.EventId
 	}
 	return ""
 }
 
-func (m *DbPt) GetPt() *PtInfo {
-	if m != nil {
-		return m.Pt
-	}
-	return nil
-}
-
-func (m *DbPt) GetShards() map[uint64]*ShardDurationInfo {
-	if m != nil {
-		return m.Shards
-	}
-	return nil
-}
-
-func (m *DbPt) GetDBBriefInfo() *DatabaseBriefInfo {
-	if m != nil {
-		return m.DBBriefInfo
-	}
-	return nil
+var E_RemoveEventCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*RemoveEventCommand)(nil),
+	Field:         168,
+	Name:          "proto.RemoveEventCommand.command",
+	Tag:           "bytes,168,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-type MigrateEventInfo struct {
-	EventId              *string  `protobuf:"bytes,1,opt,name=eventId" json:"eventId,omitempty"`
-	EventType            *int32   `protobuf:"varint,2,opt,name=eventType" json:"eventType,omitempty"`
-	OpId                 *uint64  `protobuf:"varint,3,opt,name=opId" json:"opId,omitempty"`
-	Pti                  *DbPt    `protobuf:"bytes,4,opt,name=pti" json:"pti,omitempty"`
-	CurrState            *int32   `protobuf:"varint,5,opt,name=currState" json:"currState,omitempty"`
-	PreState             *int32   `protobuf:"varint,6,opt,name=preState" json:"preState,omitempty"`
-	Src                  *uint64  `protobuf:"varint,7,opt,name=src" json:"src,omitempty"`
-	Dest                 *uint64  `protobuf:"varint,8,opt,name=dest" json:"dest,omitempty"`
-	CheckConflict        *bool    `protobuf:"varint,9,opt,name=checkConflict" json:"checkConflict,omitempty"`
-	AliveConnId          *uint64  `protobuf:"varint,10,opt,name=aliveConnId" json:"aliveConnId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CreateDownSamplePolicyCommand struct {
+	DownSamplePolicyInfo *DownSamplePolicyInfo `protobuf:"bytes,1,req,name=DownSamplePolicyInfo" json:"DownSamplePolicyInfo,omitempty"`
+	Database             *string               `protobuf:"bytes,2,req,name=Database" json:"Database,omitempty"`
+	Name                 *string               `protobuf:"bytes,3,req,name=name" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *MigrateEventInfo) Reset()         { *m = MigrateEventInfo{} }
-func (m *MigrateEventInfo) String() string { return proto.CompactTextString(m) }
-func (*MigrateEventInfo) ProtoMessage()    {}
-func (*MigrateEventInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{102}
+func (m *CreateDownSamplePolicyCommand) Reset()         { *m = CreateDownSamplePolicyCommand{} }
+func (m *CreateDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateDownSamplePolicyCommand) ProtoMessage()    {}
+func (*CreateDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{119}
 }
-func (m *MigrateEventInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MigrateEventInfo.Unmarshal(m, b)
+func (m *CreateDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDownSamplePolicyCommand.Unmarshal(m, b)
 }
-func (m *MigrateEventInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MigrateEventInfo.Marshal(b, m, deterministic)
+func (m *CreateDownSamplePolicyCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDownSamplePolicyCommand.Marshal(b, m, deterministic)
 }
-func (m *MigrateEventInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MigrateEventInfo.Merge(m, src)
+func (m *CreateDownSamplePolicyCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDownSamplePolicyCommand.Merge(m, src)
 }
-func (m *MigrateEventInfo) XXX_Size() int {
-	return xxx_messageInfo_MigrateEventInfo.Size(m)
+func (m *CreateDownSamplePolicyCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateDownSamplePolicyCommand.Size(m)
 }
-func (m *MigrateEventInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_MigrateEventInfo.DiscardUnknown(m)
+func (m *CreateDownSamplePolicyCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDownSamplePolicyCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MigrateEventInfo proto.InternalMessageInfo
+var xxx_messageInfo_CreateDownSamplePolicyCommand proto.InternalMessageInfo
 
-func (m *MigrateEventInfo) GetEventId() string {
-	if m != nil && m.EventId != nil {
-		return *m.EventId
+func (m *CreateDownSamplePolicyCommand) GetDownSamplePolicyInfo() *DownSamplePolicyInfo {
+	if m != nil {
+		return m.DownSamplePolicyInfo
+	}
+	return nil
+}
+
+func (m *CreateDownSamplePolicyCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
 	}
 	return ""
 }
 
-func (m *MigrateEventInfo) GetEventType() int32 {
-	if m != nil && m.EventType != nil {
-		return *m.EventType
+func (m *CreateDownSamplePolicyCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return 0
+	return ""
+}
+
+var E_CreateDownSamplePolicyCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*CreateDownSamplePolicyCommand)(nil),
+	Field:         169,
+	Name:          "proto.CreateDownSamplePolicyCommand.command",
+	Tag:           "bytes,169,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-func (m *MigrateEventInfo) GetOpId() uint64 {
-	if m != nil && m.OpId != nil {
-		return *m.OpId
-	}
-	return 0
+type DropDownSamplePolicyCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	RpName               *string  `protobuf:"bytes,2,req,name=rpName" json:"rpName,omitempty"`
+	DropAll              *bool    `protobuf:"varint,3,opt,name=dropAll" json:"dropAll,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MigrateEventInfo) GetPti() *DbPt {
-	if m != nil {
-		return m.Pti
-	}
-	return nil
+func (m *DropDownSamplePolicyCommand) Reset()         { *m = DropDownSamplePolicyCommand{} }
+func (m *DropDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
+func (*DropDownSamplePolicyCommand) ProtoMessage()    {}
+func (*DropDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{120}
 }
-
-func (m *MigrateEventInfo) GetCurrState() int32 {
-	if m != nil && m.CurrState != nil {
-		return *m.CurrState
-	}
-	return 0
+func (m *DropDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDownSamplePolicyCommand.Unmarshal(m, b)
 }
-
-func (m *MigrateEventInfo) GetPreState() int32 {
-	if m != nil && m.PreState != nil {
-		return *m.PreState
-	}
-	return 0
+func (m *DropDownSamplePolicyCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDownSamplePolicyCommand.Marshal(b, m, deterministic)
+}
+func (m *DropDownSamplePolicyCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDownSamplePolicyCommand.Merge(m, src)
+}
+func (m *DropDownSamplePolicyCommand) XXX_Size() int {
+	return xxx_messageInfo_DropDownSamplePolicyCommand.Size(m)
+}
+func (m *DropDownSamplePolicyCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDownSamplePolicyCommand.DiscardUnknown(m)
 }
 
-func (m *MigrateEventInfo) GetSrc() uint64 {
-	if m != nil && m.Src != nil {
-		return *m.Src
+var xxx_messageInfo_DropDownSamplePolicyCommand proto.InternalMessageInfo
+
+func (m *DropDownSamplePolicyCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
 	}
-	return 0
+	return ""
 }
 
-func (m *MigrateEventInfo) GetDest() uint64 {
-	if m != nil && m.Dest != nil {
-		return *m.Dest
+func (m *DropDownSamplePolicyCommand) GetRpName() string {
+	if m != nil && m.RpName != nil {
+		return *m.RpName
 	}
-	return 0
+	return ""
 }
 
-func (m *MigrateEventInfo) GetCheckConflict() bool {
-	if m != nil && m.CheckConflict != nil {
-		return *m.CheckConflict
+func (m *DropDownSamplePolicyCommand) GetDropAll() bool {
+	if m != nil && m.DropAll != nil {
+		return *m.DropAll
 	}
 	return false
 }
 
-func (m *MigrateEventInfo) GetAliveConnId() uint64 {
-	if m != nil && m.AliveConnId != nil {
-		return *m.AliveConnId
-	}
-	return 0
+var E_DropDownSamplePolicyCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DropDownSamplePolicyCommand)(nil),
+	Field:         170,
+	Name:          "proto.DropDownSamplePolicyCommand.command",
+	Tag:           "bytes,170,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-type CreateEventCommand struct {
-	EventInfo            *MigrateEventInfo `protobuf:"bytes,1,req,name=eventInfo" json:"eventInfo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type GetDownSamplePolicyCommand struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateEventCommand) Reset()         { *m = CreateEventCommand{} }
-func (m *CreateEventCommand) String() string { return proto.CompactTextString(m) }
-func (*CreateEventCommand) ProtoMessage()    {}
-func (*CreateEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{103}
+func (m *GetDownSamplePolicyCommand) Reset()         { *m = GetDownSamplePolicyCommand{} }
+func (m *GetDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
+func (*GetDownSamplePolicyCommand) ProtoMessage()    {}
+func (*GetDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{121}
 }
-func (m *CreateEventCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateEventCommand.Unmarshal(m, b)
+func (m *GetDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDownSamplePolicyCommand.Unmarshal(m, b)
 }
-func (m *CreateEventCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateEventCommand.Marshal(b, m, deterministic)
+func (m *GetDownSamplePolicyCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDownSamplePolicyCommand.Marshal(b, m, deterministic)
 }
-func (m *CreateEventCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateEventCommand.Merge(m, src)
+func (m *GetDownSamplePolicyCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDownSamplePolicyCommand.Merge(m, src)
 }
-func (m *CreateEventCommand) XXX_Size() int {
-	return xxx_messageInfo_CreateEventCommand.Size(m)
+func (m *GetDownSamplePolicyCommand) XXX_Size() int {
+	return xxx_messageInfo_GetDownSamplePolicyCommand.Size(m)
 }
-func (m *CreateEventCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateEventCommand.DiscardUnknown(m)
+func (m *GetDownSamplePolicyCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDownSamplePolicyCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateEventCommand proto.InternalMessageInfo
-
-func (m *CreateEventCommand) GetEventInfo() *MigrateEventInfo {
-	if m != nil {
-		return m.EventInfo
-	}
-	return nil
-}
+var xxx_messageInfo_GetDownSamplePolicyCommand proto.InternalMessageInfo
 
-var E_CreateEventCommand_Command = &proto.ExtensionDesc{
+var E_GetDownSamplePolicyCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*CreateEventCommand)(nil),
-	Field:         165,
-	Name:          "proto.CreateEventCommand.command",
-	Tag:           "bytes,165,opt,name=command",
+	ExtensionType: (*GetDownSamplePolicyCommand)(nil),
+	Field:         171,
+	Name:          "proto.GetDownSamplePolicyCommand.command",
+	Tag:           "bytes,171,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type UpdateEventCommand struct {
-	EventInfo            *MigrateEventInfo `protobuf:"bytes,1,req,name=eventInfo" json:"eventInfo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type CreateDbPtViewCommand struct {
+	DbName               *string  `protobuf:"bytes,1,req,name=DbName" json:"DbName,omitempty"`
+	ReplicaNum           *uint32  `protobuf:"varint,2,opt,name=replicaNum" json:"replicaNum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateEventCommand) Reset()         { *m = UpdateEventCommand{} }
-func (m *UpdateEventCommand) String() string { return proto.CompactTextString(m) }
-func (*UpdateEventCommand) ProtoMessage()    {}
-func (*UpdateEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{104}
+func (m *CreateDbPtViewCommand) Reset()         { *m = CreateDbPtViewCommand{} }
+func (m *CreateDbPtViewCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateDbPtViewCommand) ProtoMessage()    {}
+func (*CreateDbPtViewCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{122}
 }
-func (m *UpdateEventCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateEventCommand.Unmarshal(m, b)
+func (m *CreateDbPtViewCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDbPtViewCommand.Unmarshal(m, b)
 }
-func (m *UpdateEventCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateEventCommand.Marshal(b, m, deterministic)
+func (m *CreateDbPtViewCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDbPtViewCommand.Marshal(b, m, deterministic)
 }
-func (m *UpdateEventCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateEventCommand.Merge(m, src)
+func (m *CreateDbPtViewCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDbPtViewCommand.Merge(m, src)
 }
-func (m *UpdateEventCommand) XXX_Size() int {
-	return xxx_messageInfo_UpdateEventCommand.Size(m)
+func (m *CreateDbPtViewCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateDbPtViewCommand.Size(m)
 }
-func (m *UpdateEventCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateEventCommand.DiscardUnknown(m)
+func (m *CreateDbPtViewCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDbPtViewCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateEventCommand proto.InternalMessageInfo
+var xxx_messageInfo_CreateDbPtViewCommand proto.InternalMessageInfo
 
-func (m *UpdateEventCommand) GetEventInfo() *MigrateEventInfo {
-	if m != nil {
-		return m.EventInfo
+func (m *CreateDbPtViewCommand) GetDbName() string {
+	if m != nil && m.DbName != nil {
+		return *m.DbName
 	}
-	return nil
+	return ""
 }
 
-var E_UpdateEventCommand_Command = &proto.ExtensionDesc{
+func (m *CreateDbPtViewCommand) GetReplicaNum() uint32 {
+	if m != nil && m.ReplicaNum != nil {
+		return *m.ReplicaNum
+	}
+	return 0
+}
+
+var E_CreateDbPtViewCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*UpdateEventCommand)(nil),
-	Field:         166,
-	Name:          "proto.UpdateEventCommand.command",
-	Tag:           "bytes,166,opt,name=command",
+	ExtensionType: (*CreateDbPtViewCommand)(nil),
+	Field:         172,
+	Name:          "proto.CreateDbPtViewCommand.command",
+	Tag:           "bytes,172,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type UpdatePtInfoCommand struct {
-	Db                   *string  `protobuf:"bytes,1,req,name=Db" json:"Db,omitempty"`
-	Pt                   *PtInfo  `protobuf:"bytes,2,req,name=Pt" json:"Pt,omitempty"`
-	OwnerNode            *uint64  `protobuf:"varint,3,opt,name=OwnerNode" json:"OwnerNode,omitempty"`
-	Status               *uint32  `protobuf:"varint,4,opt,name=Status" json:"Status,omitempty"`
+type GetMeasurementInfoWithinSameRpCommand struct {
+	DbName               *string  `protobuf:"bytes,1,req,name=DbName" json:"DbName,omitempty"`
+	RpName               *string  `protobuf:"bytes,2,req,name=RpName" json:"RpName,omitempty"`
+	DataTypes            []int64  `protobuf:"varint,3,rep,name=DataTypes" json:"DataTypes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdatePtInfoCommand) Reset()         { *m = UpdatePtInfoCommand{} }
-func (m *UpdatePtInfoCommand) String() string { return proto.CompactTextString(m) }
-func (*UpdatePtInfoCommand) ProtoMessage()    {}
-func (*UpdatePtInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{105}
+func (m *GetMeasurementInfoWithinSameRpCommand) Reset()         { *m = GetMeasurementInfoWithinSameRpCommand{} }
+func (m *GetMeasurementInfoWithinSameRpCommand) String() string { return proto.CompactTextString(m) }
+func (*GetMeasurementInfoWithinSameRpCommand) ProtoMessage()    {}
+func (*GetMeasurementInfoWithinSameRpCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{123}
 }
-func (m *UpdatePtInfoCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdatePtInfoCommand.Unmarshal(m, b)
+func (m *GetMeasurementInfoWithinSameRpCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.Unmarshal(m, b)
 }
-func (m *UpdatePtInfoCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdatePtInfoCommand.Marshal(b, m, deterministic)
+func (m *GetMeasurementInfoWithinSameRpCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.Marshal(b, m, deterministic)
 }
-func (m *UpdatePtInfoCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdatePtInfoCommand.Merge(m, src)
+func (m *GetMeasurementInfoWithinSameRpCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.Merge(m, src)
 }
-func (m *UpdatePtInfoCommand) XXX_Size() int {
-	return xxx_messageInfo_UpdatePtInfoCommand.Size(m)
+func (m *GetMeasurementInfoWithinSameRpCommand) XXX_Size() int {
+	return xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.Size(m)
 }
-func (m *UpdatePtInfoCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdatePtInfoCommand.DiscardUnknown(m)
+func (m *GetMeasurementInfoWithinSameRpCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdatePtInfoCommand proto.InternalMessageInfo
-
-func (m *UpdatePtInfoCommand) GetDb() string {
-	if m != nil && m.Db != nil {
-		return *m.Db
-	}
-	return ""
-}
+var xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand proto.InternalMessageInfo
 
-func (m *UpdatePtInfoCommand) GetPt() *PtInfo {
-	if m != nil {
-		return m.Pt
+func (m *GetMeasurementInfoWithinSameRpCommand) GetDbName() string {
+	if m != nil && m.DbName != nil {
+		return *m.DbName
 	}
-	return nil
+	return ""
 }
 
-func (m *UpdatePtInfoCommand) GetOwnerNode() uint64 {
-	if m != nil && m.OwnerNode != nil {
-		return *m.OwnerNode
+func (m *GetMeasurementInfoWithinSameRpCommand) GetRpName() string {
+	if m != nil && m.RpName != nil {
+		return *m.RpName
 	}
-	return 0
+	return ""
 }
 
-func (m *UpdatePtInfoCommand) GetStatus() uint32 {
-	if m != nil && m.Status != nil {
-		return *m.Status
+func (m *GetMeasurementInfoWithinSameRpCommand) GetDataTypes() []int64 {
+	if m != nil {
+		return m.DataTypes
 	}
-	return 0
+	return nil
 }
 
-var E_UpdatePtInfoCommand_Command = &proto.ExtensionDesc{
+var E_GetMeasurementInfoWithinSameRpCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*UpdatePtInfoCommand)(nil),
-	Field:         167,
-	Name:          "proto.UpdatePtInfoCommand.command",
-	Tag:           "bytes,167,opt,name=command",
+	ExtensionType: (*GetMeasurementInfoWithinSameRpCommand)(nil),
+	Field:         173,
+	Name:          "proto.GetMeasurementInfoWithinSameRpCommand.command",
+	Tag:           "bytes,173,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type RemoveEventCommand struct {
-	EventId              *string  `protobuf:"bytes,1,req,name=eventId" json:"eventId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type UpdateShardDownSampleInfoCommand struct {
+	Ident                *ShardIdentifier `protobuf:"bytes,1,req,name=Ident" json:"Ident,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *RemoveEventCommand) Reset()         { *m = RemoveEventCommand{} }
-func (m *RemoveEventCommand) String() string { return proto.CompactTextString(m) }
-func (*RemoveEventCommand) ProtoMessage()    {}
-func (*RemoveEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{106}
+func (m *UpdateShardDownSampleInfoCommand) Reset()         { *m = UpdateShardDownSampleInfoCommand{} }
+func (m *UpdateShardDownSampleInfoCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdateShardDownSampleInfoCommand) ProtoMessage()    {}
+func (*UpdateShardDownSampleInfoCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{124}
 }
-func (m *RemoveEventCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemoveEventCommand.Unmarshal(m, b)
+func (m *UpdateShardDownSampleInfoCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateShardDownSampleInfoCommand.Unmarshal(m, b)
 }
-func (m *RemoveEventCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemoveEventCommand.Marshal(b, m, deterministic)
+func (m *UpdateShardDownSampleInfoCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateShardDownSampleInfoCommand.Marshal(b, m, deterministic)
 }
-func (m *RemoveEventCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveEventCommand.Merge(m, src)
+func (m *UpdateShardDownSampleInfoCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateShardDownSampleInfoCommand.Merge(m, src)
 }
-func (m *RemoveEventCommand) XXX_Size() int {
-	return xxx_messageInfo_RemoveEventCommand.Size(m)
+func (m *UpdateShardDownSampleInfoCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdateShardDownSampleInfoCommand.Size(m)
 }
-func (m *RemoveEventCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveEventCommand.DiscardUnknown(m)
+func (m *UpdateShardDownSampleInfoCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateShardDownSampleInfoCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveEventCommand proto.InternalMessageInfo
+var xxx_messageInfo_UpdateShardDownSampleInfoCommand proto.InternalMessageInfo
 
-func (m *RemoveEventCommand) GetEventId() string {
-	if m != nil && m.EventId != nil {
-		return *m.EventId
+func (m *UpdateShardDownSampleInfoCommand) GetIdent() *ShardIdentifier {
+	if m != nil {
+		return m.Ident
 	}
-	return ""
+	return nil
 }
 
-var E_RemoveEventCommand_Command = &proto.ExtensionDesc{
+var E_UpdateShardDownSampleInfoCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*RemoveEventCommand)(nil),
-	Field:         168,
-	Name:          "proto.RemoveEventCommand.command",
-	Tag:           "bytes,168,opt,name=command",
+	ExtensionType: (*UpdateShardDownSampleInfoCommand)(nil),
+	Field:         174,
+	Name:          "proto.UpdateShardDownSampleInfoCommand.command",
+	Tag:           "bytes,174,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type CreateDownSamplePolicyCommand struct {
-	DownSamplePolicyInfo *DownSamplePolicyInfo `protobuf:"bytes,1,req,name=DownSamplePolicyInfo" json:"DownSamplePolicyInfo,omitempty"`
-	Database             *string               `protobuf:"bytes,2,req,name=Database" json:"Database,omitempty"`
-	Name                 *string               `protobuf:"bytes,3,req,name=name" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type MarkTakeoverCommand struct {
+	Enable               *bool    `protobuf:"varint,1,req,name=enable" json:"enable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateDownSamplePolicyCommand) Reset()         { *m = CreateDownSamplePolicyCommand{} }
-func (m *CreateDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
-func (*CreateDownSamplePolicyCommand) ProtoMessage()    {}
-func (*CreateDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{107}
-}
-func (m *CreateDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateDownSamplePolicyCommand.Unmarshal(m, b)
+func (m *MarkTakeoverCommand) Reset()         { *m = MarkTakeoverCommand{} }
+func (m *MarkTakeoverCommand) String() string { return proto.CompactTextString(m) }
+func (*MarkTakeoverCommand) ProtoMessage()    {}
+func (*MarkTakeoverCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{125}
 }
-func (m *CreateDownSamplePolicyCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateDownSamplePolicyCommand.Marshal(b, m, deterministic)
+func (m *MarkTakeoverCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkTakeoverCommand.Unmarshal(m, b)
 }
-func (m *CreateDownSamplePolicyCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateDownSamplePolicyCommand.Merge(m, src)
+func (m *MarkTakeoverCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkTakeoverCommand.Marshal(b, m, deterministic)
 }
-func (m *CreateDownSamplePolicyCommand) XXX_Size() int {
-	return xxx_messageInfo_CreateDownSamplePolicyCommand.Size(m)
+func (m *MarkTakeoverCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkTakeoverCommand.Merge(m, src)
 }
-func (m *CreateDownSamplePolicyCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateDownSamplePolicyCommand.DiscardUnknown(m)
+func (m *MarkTakeoverCommand) XXX_Size() int {
+	return xxx_messageInfo_MarkTakeoverCommand.Size(m)
 }
-
-var xxx_messageInfo_CreateDownSamplePolicyCommand proto.InternalMessageInfo
-
-func (m *CreateDownSamplePolicyCommand) GetDownSamplePolicyInfo() *DownSamplePolicyInfo {
-	if m != nil {
-		return m.DownSamplePolicyInfo
-	}
-	return nil
+func (m *MarkTakeoverCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkTakeoverCommand.DiscardUnknown(m)
 }
 
-func (m *CreateDownSamplePolicyCommand) GetDatabase() string {
-	if m != nil && m.Database != nil {
-		return *m.Database
-	}
-	return ""
-}
+var xxx_messageInfo_MarkTakeoverCommand proto.InternalMessageInfo
 
-func (m *CreateDownSamplePolicyCommand) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
+func (m *MarkTakeoverCommand) GetEnable() bool {
+	if m != nil && m.Enable != nil {
+		return *m.Enable
 	}
-	return ""
+	return false
 }
 
-var E_CreateDownSamplePolicyCommand_Command = &proto.ExtensionDesc{
+var E_MarkTakeoverCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*CreateDownSamplePolicyCommand)(nil),
-	Field:         169,
-	Name:          "proto.CreateDownSamplePolicyCommand.command",
-	Tag:           "bytes,169,opt,name=command",
+	ExtensionType: (*MarkTakeoverCommand)(nil),
+	Field:         175,
+	Name:          "proto.MarkTakeoverCommand.command",
+	Tag:           "bytes,175,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type DropDownSamplePolicyCommand struct {
-	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
-	RpName               *string  `protobuf:"bytes,2,req,name=rpName" json:"rpName,omitempty"`
-	DropAll              *bool    `protobuf:"varint,3,opt,name=dropAll" json:"dropAll,omitempty"`
+type MarkBalancerCommand struct {
+	Enable               *bool    `protobuf:"varint,1,req,name=enable" json:"enable,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropDownSamplePolicyCommand) Reset()         { *m = DropDownSamplePolicyCommand{} }
-func (m *DropDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
-func (*DropDownSamplePolicyCommand) ProtoMessage()    {}
-func (*DropDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{108}
+func (m *MarkBalancerCommand) Reset()         { *m = MarkBalancerCommand{} }
+func (m *MarkBalancerCommand) String() string { return proto.CompactTextString(m) }
+func (*MarkBalancerCommand) ProtoMessage()    {}
+func (*MarkBalancerCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{126}
 }
-func (m *DropDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropDownSamplePolicyCommand.Unmarshal(m, b)
+func (m *MarkBalancerCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkBalancerCommand.Unmarshal(m, b)
 }
-func (m *DropDownSamplePolicyCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropDownSamplePolicyCommand.Marshal(b, m, deterministic)
+func (m *MarkBalancerCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkBalancerCommand.Marshal(b, m, deterministic)
 }
-func (m *DropDownSamplePolicyCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropDownSamplePolicyCommand.Merge(m, src)
+func (m *MarkBalancerCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkBalancerCommand.Merge(m, src)
 }
-func (m *DropDownSamplePolicyCommand) XXX_Size() int {
-	return xxx_messageInfo_DropDownSamplePolicyCommand.Size(m)
+func (m *MarkBalancerCommand) XXX_Size() int {
+	return xxx_messageInfo_MarkBalancerCommand.Size(m)
 }
-func (m *DropDownSamplePolicyCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropDownSamplePolicyCommand.DiscardUnknown(m)
+func (m *MarkBalancerCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkBalancerCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropDownSamplePolicyCommand proto.InternalMessageInfo
+var xxx_messageInfo_MarkBalancerCommand proto.InternalMessageInfo
 
-func (m *DropDownSamplePolicyCommand) GetDatabase() string {
-	if m != nil && m.Database != nil {
-		return *m.Database
+func (m *MarkBalancerCommand) GetEnable() bool {
+	if m != nil && m.Enable != nil {
+		return *m.Enable
 	}
-	return ""
+	return false
 }
 
-func (m *DropDownSamplePolicyCommand) GetRpName() string {
-	if m != nil && m.RpName != nil {
-		return *m.RpName
-	}
-	return ""
+var E_MarkBalancerCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*MarkBalancerCommand)(nil),
+	Field:         176,
+	Name:          "proto.MarkBalancerCommand.command",
+	Tag:           "bytes,176,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type CreateStreamCommand struct {
+	StreamInfo           *StreamInfo `protobuf:"bytes,1,req,name=StreamInfo" json:"StreamInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *CreateStreamCommand) Reset()         { *m = CreateStreamCommand{} }
+func (m *CreateStreamCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateStreamCommand) ProtoMessage()    {}
+func (*CreateStreamCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{127}
+}
+func (m *CreateStreamCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateStreamCommand.Unmarshal(m, b)
+}
+func (m *CreateStreamCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateStreamCommand.Marshal(b, m, deterministic)
+}
+func (m *CreateStreamCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStreamCommand.Merge(m, src)
+}
+func (m *CreateStreamCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateStreamCommand.Size(m)
+}
+func (m *CreateStreamCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStreamCommand.DiscardUnknown(m)
 }
 
-func (m *DropDownSamplePolicyCommand) GetDropAll() bool {
-	if m != nil && m.DropAll != nil {
-		return *m.DropAll
+var xxx_messageInfo_CreateStreamCommand proto.InternalMessageInfo
+
+func (m *CreateStreamCommand) GetStreamInfo() *StreamInfo {
+	if m != nil {
+		return m.StreamInfo
 	}
-	return false
+	return nil
 }
 
-var E_DropDownSamplePolicyCommand_Command = &proto.ExtensionDesc{
+var E_CreateStreamCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*DropDownSamplePolicyCommand)(nil),
-	Field:         170,
-	Name:          "proto.DropDownSamplePolicyCommand.command",
-	Tag:           "bytes,170,opt,name=command",
+	ExtensionType: (*CreateStreamCommand)(nil),
+	Field:         177,
+	Name:          "proto.CreateStreamCommand.command",
+	Tag:           "bytes,177,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type GetDownSamplePolicyCommand struct {
+type DropStreamCommand struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetDownSamplePolicyCommand) Reset()         { *m = GetDownSamplePolicyCommand{} }
-func (m *GetDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
-func (*GetDownSamplePolicyCommand) ProtoMessage()    {}
-func (*GetDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{109}
+func (m *DropStreamCommand) Reset()         { *m = DropStreamCommand{} }
+func (m *DropStreamCommand) String() string { return proto.CompactTextString(m) }
+func (*DropStreamCommand) ProtoMessage()    {}
+func (*DropStreamCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{128}
 }
-func (m *GetDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDownSamplePolicyCommand.Unmarshal(m, b)
+func (m *DropStreamCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropStreamCommand.Unmarshal(m, b)
 }
-func (m *GetDownSamplePolicyCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDownSamplePolicyCommand.Marshal(b, m, deterministic)
+func (m *DropStreamCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropStreamCommand.Marshal(b, m, deterministic)
 }
-func (m *GetDownSamplePolicyCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDownSamplePolicyCommand.Merge(m, src)
+func (m *DropStreamCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropStreamCommand.Merge(m, src)
 }
-func (m *GetDownSamplePolicyCommand) XXX_Size() int {
-	return xxx_messageInfo_GetDownSamplePolicyCommand.Size(m)
+func (m *DropStreamCommand) XXX_Size() int {
+	return xxx_messageInfo_DropStreamCommand.Size(m)
 }
-func (m *GetDownSamplePolicyCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDownSamplePolicyCommand.DiscardUnknown(m)
+func (m *DropStreamCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropStreamCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDownSamplePolicyCommand proto.InternalMessageInfo
+var xxx_messageInfo_DropStreamCommand proto.InternalMessageInfo
 
-var E_GetDownSamplePolicyCommand_Command = &proto.ExtensionDesc{
+func (m *DropStreamCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+var E_DropStreamCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*GetDownSamplePolicyCommand)(nil),
-	Field:         171,
-	Name:          "proto.GetDownSamplePolicyCommand.command",
-	Tag:           "bytes,171,opt,name=command",
+	ExtensionType: (*DropStreamCommand)(nil),
+	Field:         178,
+	Name:          "proto.DropStreamCommand.command",
+	Tag:           "bytes,178,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type CreateDbPtViewCommand struct {
+type GetMeasurementInfoStoreCommand struct {
 	DbName               *string  `protobuf:"bytes,1,req,name=DbName" json:"DbName,omitempty"`
-	ReplicaNum           *uint32  `protobuf:"varint,2,opt,name=replicaNum" json:"replicaNum,omitempty"`
+	RpName               *string  `protobuf:"bytes,2,req,name=RpName" json:"RpName,omitempty"`
+	MstName              *string  `protobuf:"bytes,3,req,name=MstName" json:"MstName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateDbPtViewCommand) Reset()         { *m = CreateDbPtViewCommand{} }
-func (m *CreateDbPtViewCommand) String() string { return proto.CompactTextString(m) }
-func (*CreateDbPtViewCommand) ProtoMessage()    {}
-func (*CreateDbPtViewCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{110}
+func (m *GetMeasurementInfoStoreCommand) Reset()         { *m = GetMeasurementInfoStoreCommand{} }
+func (m *GetMeasurementInfoStoreCommand) String() string { return proto.CompactTextString(m) }
+func (*GetMeasurementInfoStoreCommand) ProtoMessage()    {}
+func (*GetMeasurementInfoStoreCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{129}
 }
-func (m *CreateDbPtViewCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateDbPtViewCommand.Unmarshal(m, b)
+func (m *GetMeasurementInfoStoreCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMeasurementInfoStoreCommand.Unmarshal(m, b)
 }
-func (m *CreateDbPtViewCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateDbPtViewCommand.Marshal(b, m, deterministic)
+func (m *GetMeasurementInfoStoreCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMeasurementInfoStoreCommand.Marshal(b, m, deterministic)
 }
-func (m *CreateDbPtViewCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateDbPtViewCommand.Merge(m, src)
+func (m *GetMeasurementInfoStoreCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMeasurementInfoStoreCommand.Merge(m, src)
 }
-func (m *CreateDbPtViewCommand) XXX_Size() int {
-	return xxx_messageInfo_CreateDbPtViewCommand.Size(m)
+func (m *GetMeasurementInfoStoreCommand) XXX_Size() int {
+	return xxx_messageInfo_GetMeasurementInfoStoreCommand.Size(m)
 }
-func (m *CreateDbPtViewCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateDbPtViewCommand.DiscardUnknown(m)
+func (m *GetMeasurementInfoStoreCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMeasurementInfoStoreCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateDbPtViewCommand proto.InternalMessageInfo
+var xxx_messageInfo_GetMeasurementInfoStoreCommand proto.InternalMessageInfo
 
-func (m *CreateDbPtViewCommand) GetDbName() string {
+func (m *GetMeasurementInfoStoreCommand) GetDbName() string {
 	if m != nil && m.DbName != nil {
 		return *m.DbName
 	}
 	return ""
 }
 
-func (m *CreateDbPtViewCommand) GetReplicaNum() uint32 {
-	if m != nil && m.ReplicaNum != nil {
-		return *m.ReplicaNum
+func (m *GetMeasurementInfoStoreCommand) GetRpName() string {
+	if m != nil && m.RpName != nil {
+		return *m.RpName
 	}
-	return 0
+	return ""
 }
 
-var E_CreateDbPtViewCommand_Command = &proto.ExtensionDesc{
+func (m *GetMeasurementInfoStoreCommand) GetMstName() string {
+	if m != nil && m.MstName != nil {
+		return *m.MstName
+	}
+	return ""
+}
+
+var E_GetMeasurementInfoStoreCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*CreateDbPtViewCommand)(nil),
-	Field:         172,
-	Name:          "proto.CreateDbPtViewCommand.command",
-	Tag:           "bytes,172,opt,name=command",
+	ExtensionType: (*GetMeasurementInfoStoreCommand)(nil),
+	Field:         179,
+	Name:          "proto.GetMeasurementInfoStoreCommand.command",
+	Tag:           "bytes,179,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type GetMeasurementInfoWithinSameRpCommand struct {
-	DbName               *string  `protobuf:"bytes,1,req,name=DbName" json:"DbName,omitempty"`
-	RpName               *string  `protobuf:"bytes,2,req,name=RpName" json:"RpName,omitempty"`
-	DataTypes            []int64  `protobuf:"varint,3,rep,name=DataTypes" json:"DataTypes,omitempty"`
+type VerifyDataNodeCommand struct {
+	NodeID               *uint64  `protobuf:"varint,1,req,name=NodeID" json:"NodeID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMeasurementInfoWithinSameRpCommand) Reset()         { *m = GetMeasurementInfoWithinSameRpCommand{} }
-func (m *GetMeasurementInfoWithinSameRpCommand) String() string { return proto.CompactTextString(m) }
-func (*GetMeasurementInfoWithinSameRpCommand) ProtoMessage()    {}
-func (*GetMeasurementInfoWithinSameRpCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{111}
+func (m *VerifyDataNodeCommand) Reset()         { *m = VerifyDataNodeCommand{} }
+func (m *VerifyDataNodeCommand) String() string { return proto.CompactTextString(m) }
+func (*VerifyDataNodeCommand) ProtoMessage()    {}
+func (*VerifyDataNodeCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{130}
 }
-func (m *GetMeasurementInfoWithinSameRpCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.Unmarshal(m, b)
+func (m *VerifyDataNodeCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyDataNodeCommand.Unmarshal(m, b)
 }
-func (m *GetMeasurementInfoWithinSameRpCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.Marshal(b, m, deterministic)
+func (m *VerifyDataNodeCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyDataNodeCommand.Marshal(b, m, deterministic)
 }
-func (m *GetMeasurementInfoWithinSameRpCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.Merge(m, src)
+func (m *VerifyDataNodeCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyDataNodeCommand.Merge(m, src)
 }
-func (m *GetMeasurementInfoWithinSameRpCommand) XXX_Size() int {
-	return xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.Size(m)
+func (m *VerifyDataNodeCommand) XXX_Size() int {
+	return xxx_messageInfo_VerifyDataNodeCommand.Size(m)
 }
-func (m *GetMeasurementInfoWithinSameRpCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.DiscardUnknown(m)
+func (m *VerifyDataNodeCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyDataNodeCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand proto.InternalMessageInfo
+var xxx_messageInfo_VerifyDataNodeCommand proto.InternalMessageInfo
 
-func (m *GetMeasurementInfoWithinSameRpCommand) GetDbName() string {
-	if m != nil && m.DbName != nil {
-		return *m.DbName
+func (m *VerifyDataNodeCommand) GetNodeID() uint64 {
+	if m != nil && m.NodeID != nil {
+		return *m.NodeID
 	}
-	return ""
+	return 0
 }
 
-func (m *GetMeasurementInfoWithinSameRpCommand) GetRpName() string {
-	if m != nil && m.RpName != nil {
-		return *m.RpName
-	}
-	return ""
+var E_VerifyDataNodeCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*VerifyDataNodeCommand)(nil),
+	Field:         180,
+	Name:          "proto.VerifyDataNodeCommand.command",
+	Tag:           "bytes,180,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-func (m *GetMeasurementInfoWithinSameRpCommand) GetDataTypes() []int64 {
-	if m != nil {
-		return m.DataTypes
-	}
-	return nil
+type ExpandGroupsCommand struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-var E_GetMeasurementInfoWithinSameRpCommand_Command = &proto.ExtensionDesc{
-	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*GetMeasurementInfoWithinSameRpCommand)(nil),
-	Field:         173,
-	Name:          "proto.GetMeasurementInfoWithinSameRpCommand.command",
-	Tag:           "bytes,173,opt,name=command",
-	Filename:      "meta.proto",
+func (m *ExpandGroupsCommand) Reset()         { *m = ExpandGroupsCommand{} }
+func (m *ExpandGroupsCommand) String() string { return proto.CompactTextString(m) }
+func (*ExpandGroupsCommand) ProtoMessage()    {}
+func (*ExpandGroupsCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{131}
+}
+func (m *ExpandGroupsCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExpandGroupsCommand.Unmarshal(m, b)
+}
+func (m *ExpandGroupsCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExpandGroupsCommand.Marshal(b, m, deterministic)
+}
+func (m *ExpandGroupsCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpandGroupsCommand.Merge(m, src)
+}
+func (m *ExpandGroupsCommand) XXX_Size() int {
+	return xxx_messageInfo_ExpandGroupsCommand.Size(m)
+}
+func (m *ExpandGroupsCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpandGroupsCommand.DiscardUnknown(m)
 }
 
-type UpdateShardDownSampleInfoCommand struct {
-	Ident                *ShardIdentifier `protobuf:"bytes,1,req,name=Ident" json:"Ident,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+var xxx_messageInfo_ExpandGroupsCommand proto.InternalMessageInfo
+
+var E_ExpandGroupsCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*ExpandGroupsCommand)(nil),
+	Field:         181,
+	Name:          "proto.ExpandGroupsCommand.command",
+	Tag:           "bytes,181,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-func (m *UpdateShardDownSampleInfoCommand) Reset()         { *m = UpdateShardDownSampleInfoCommand{} }
-func (m *UpdateShardDownSampleInfoCommand) String() string { return proto.CompactTextString(m) }
-func (*UpdateShardDownSampleInfoCommand) ProtoMessage()    {}
-func (*UpdateShardDownSampleInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{112}
+type UpdatePtVersionCommand struct {
+	Db                   *string  `protobuf:"bytes,1,req,name=Db" json:"Db,omitempty"`
+	Pt                   *uint32  `protobuf:"varint,2,req,name=Pt" json:"Pt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *UpdateShardDownSampleInfoCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateShardDownSampleInfoCommand.Unmarshal(m, b)
+
+func (m *UpdatePtVersionCommand) Reset()         { *m = UpdatePtVersionCommand{} }
+func (m *UpdatePtVersionCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdatePtVersionCommand) ProtoMessage()    {}
+func (*UpdatePtVersionCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{132}
 }
-func (m *UpdateShardDownSampleInfoCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateShardDownSampleInfoCommand.Marshal(b, m, deterministic)
+func (m *UpdatePtVersionCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdatePtVersionCommand.Unmarshal(m, b)
 }
-func (m *UpdateShardDownSampleInfoCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateShardDownSampleInfoCommand.Merge(m, src)
+func (m *UpdatePtVersionCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdatePtVersionCommand.Marshal(b, m, deterministic)
 }
-func (m *UpdateShardDownSampleInfoCommand) XXX_Size() int {
-	return xxx_messageInfo_UpdateShardDownSampleInfoCommand.Size(m)
+func (m *UpdatePtVersionCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePtVersionCommand.Merge(m, src)
 }
-func (m *UpdateShardDownSampleInfoCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateShardDownSampleInfoCommand.DiscardUnknown(m)
+func (m *UpdatePtVersionCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdatePtVersionCommand.Size(m)
+}
+func (m *UpdatePtVersionCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePtVersionCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateShardDownSampleInfoCommand proto.InternalMessageInfo
+var xxx_messageInfo_UpdatePtVersionCommand proto.InternalMessageInfo
 
-func (m *UpdateShardDownSampleInfoCommand) GetIdent() *ShardIdentifier {
-	if m != nil {
-		return m.Ident
+func (m *UpdatePtVersionCommand) GetDb() string {
+	if m != nil && m.Db != nil {
+		return *m.Db
 	}
-	return nil
+	return ""
 }
 
-var E_UpdateShardDownSampleInfoCommand_Command = &proto.ExtensionDesc{
+func (m *UpdatePtVersionCommand) GetPt() uint32 {
+	if m != nil && m.Pt != nil {
+		return *m.Pt
+	}
+	return 0
+}
+
+var E_UpdatePtVersionCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*UpdateShardDownSampleInfoCommand)(nil),
-	Field:         174,
-	Name:          "proto.UpdateShardDownSampleInfoCommand.command",
-	Tag:           "bytes,174,opt,name=command",
+	ExtensionType: (*UpdatePtVersionCommand)(nil),
+	Field:         182,
+	Name:          "proto.UpdatePtVersionCommand.command",
+	Tag:           "bytes,182,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type MarkTakeoverCommand struct {
-	Enable               *bool    `protobuf:"varint,1,req,name=enable" json:"enable,omitempty"`
+type GetMeasurementsInfoCommand struct {
+	DbName               *string  `protobuf:"bytes,1,req,name=DbName" json:"DbName,omitempty"`
+	RpName               *string  `protobuf:"bytes,2,req,name=RpName" json:"RpName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MarkTakeoverCommand) Reset()         { *m = MarkTakeoverCommand{} }
-func (m *MarkTakeoverCommand) String() string { return proto.CompactTextString(m) }
-func (*MarkTakeoverCommand) ProtoMessage()    {}
-func (*MarkTakeoverCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{113}
+func (m *GetMeasurementsInfoCommand) Reset()         { *m = GetMeasurementsInfoCommand{} }
+func (m *GetMeasurementsInfoCommand) String() string { return proto.CompactTextString(m) }
+func (*GetMeasurementsInfoCommand) ProtoMessage()    {}
+func (*GetMeasurementsInfoCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{133}
 }
-func (m *MarkTakeoverCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MarkTakeoverCommand.Unmarshal(m, b)
+func (m *GetMeasurementsInfoCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMeasurementsInfoCommand.Unmarshal(m, b)
 }
-func (m *MarkTakeoverCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MarkTakeoverCommand.Marshal(b, m, deterministic)
+func (m *GetMeasurementsInfoCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMeasurementsInfoCommand.Marshal(b, m, deterministic)
 }
-func (m *MarkTakeoverCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MarkTakeoverCommand.Merge(m, src)
+func (m *GetMeasurementsInfoCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMeasurementsInfoCommand.Merge(m, src)
 }
-func (m *MarkTakeoverCommand) XXX_Size() int {
-	return xxx_messageInfo_MarkTakeoverCommand.Size(m)
+func (m *GetMeasurementsInfoCommand) XXX_Size() int {
+	return xxx_messageInfo_GetMeasurementsInfoCommand.Size(m)
 }
-func (m *MarkTakeoverCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_MarkTakeoverCommand.DiscardUnknown(m)
+func (m *GetMeasurementsInfoCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMeasurementsInfoCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MarkTakeoverCommand proto.InternalMessageInfo
+var xxx_messageInfo_GetMeasurementsInfoCommand proto.InternalMessageInfo
 
-func (m *MarkTakeoverCommand) GetEnable() bool {
-	if m != nil && m.Enable != nil {
-		return *m.Enable
+func (m *GetMeasurementsInfoCommand) GetDbName() string {
+	if m != nil && m.DbName != nil {
+		return *m.DbName
 	}
-	return false
+	return ""
 }
 
-var E_MarkTakeoverCommand_Command = &proto.ExtensionDesc{
+func (m *GetMeasurementsInfoCommand) GetRpName() string {
+	if m != nil && m.RpName != nil {
+		return *m.RpName
+	}
+	return ""
+}
+
+var E_GetMeasurementsInfoCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*MarkTakeoverCommand)(nil),
-	Field:         175,
-	Name:          "proto.MarkTakeoverCommand.command",
-	Tag:           "bytes,175,opt,name=command",
+	ExtensionType: (*GetMeasurementsInfoCommand)(nil),
+	Field:         183,
+	Name:          "proto.GetMeasurementsInfoCommand.command",
+	Tag:           "bytes,183,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type MarkBalancerCommand struct {
-	Enable               *bool    `protobuf:"varint,1,req,name=enable" json:"enable,omitempty"`
+type DatabaseBriefInfo struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	EnableTagArray       *bool    `protobuf:"varint,2,req,name=EnableTagArray" json:"EnableTagArray,omitempty"`
+	Replicas             *int32   `protobuf:"varint,3,opt,name=replicas" json:"replicas,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MarkBalancerCommand) Reset()         { *m = MarkBalancerCommand{} }
-func (m *MarkBalancerCommand) String() string { return proto.CompactTextString(m) }
-func (*MarkBalancerCommand) ProtoMessage()    {}
-func (*MarkBalancerCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{114}
+func (m *DatabaseBriefInfo) Reset()         { *m = DatabaseBriefInfo{} }
+func (m *DatabaseBriefInfo) String() string { return proto.CompactTextString(m) }
+func (*DatabaseBriefInfo) ProtoMessage()    {}
+func (*DatabaseBriefInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{134}
 }
-func (m *MarkBalancerCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MarkBalancerCommand.Unmarshal(m, b)
+func (m *DatabaseBriefInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseBriefInfo.Unmarshal(m, b)
 }
-func (m *MarkBalancerCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MarkBalancerCommand.Marshal(b, m, deterministic)
+func (m *DatabaseBriefInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseBriefInfo.Marshal(b, m, deterministic)
 }
-func (m *MarkBalancerCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MarkBalancerCommand.Merge(m, src)
+func (m *DatabaseBriefInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseBriefInfo.Merge(m, src)
 }
-func (m *MarkBalancerCommand) XXX_Size() int {
-	return xxx_messageInfo_MarkBalancerCommand.Size(m)
+func (m *DatabaseBriefInfo) XXX_Size() int {
+	return xxx_messageInfo_DatabaseBriefInfo.Size(m)
 }
-func (m *MarkBalancerCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_MarkBalancerCommand.DiscardUnknown(m)
+func (m *DatabaseBriefInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseBriefInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MarkBalancerCommand proto.InternalMessageInfo
+var xxx_messageInfo_DatabaseBriefInfo proto.InternalMessageInfo
 
-func (m *MarkBalancerCommand) GetEnable() bool {
-	if m != nil && m.Enable != nil {
-		return *m.Enable
+func (m *DatabaseBriefInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseBriefInfo) GetEnableTagArray() bool {
+	if m != nil && m.EnableTagArray != nil {
+		return *m.EnableTagArray
 	}
 	return false
 }
 
-var E_MarkBalancerCommand_Command = &proto.ExtensionDesc{
-	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*MarkBalancerCommand)(nil),
-	Field:         176,
-	Name:          "proto.MarkBalancerCommand.command",
-	Tag:           "bytes,176,opt,name=command",
-	Filename:      "meta.proto",
+func (m *DatabaseBriefInfo) GetReplicas() int32 {
+	if m != nil && m.Replicas != nil {
+		return *m.Replicas
+	}
+	return 0
 }
 
-type CreateStreamCommand struct {
-	StreamInfo           *StreamInfo `protobuf:"bytes,1,req,name=StreamInfo" json:"StreamInfo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type MeasurementsInfo struct {
+	MeasurementsInfo     []*MeasurementInfo `protobuf:"bytes,1,rep,name=MeasurementsInfo" json:"MeasurementsInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *CreateStreamCommand) Reset()         { *m = CreateStreamCommand{} }
-func (m *CreateStreamCommand) String() string { return proto.CompactTextString(m) }
-func (*CreateStreamCommand) ProtoMessage()    {}
-func (*CreateStreamCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{115}
+func (m *MeasurementsInfo) Reset()         { *m = MeasurementsInfo{} }
+func (m *MeasurementsInfo) String() string { return proto.CompactTextString(m) }
+func (*MeasurementsInfo) ProtoMessage()    {}
+func (*MeasurementsInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{135}
 }
-func (m *CreateStreamCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateStreamCommand.Unmarshal(m, b)
+func (m *MeasurementsInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeasurementsInfo.Unmarshal(m, b)
 }
-func (m *CreateStreamCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateStreamCommand.Marshal(b, m, deterministic)
+func (m *MeasurementsInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeasurementsInfo.Marshal(b, m, deterministic)
 }
-func (m *CreateStreamCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateStreamCommand.Merge(m, src)
+func (m *MeasurementsInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeasurementsInfo.Merge(m, src)
 }
-func (m *CreateStreamCommand) XXX_Size() int {
-	return xxx_messageInfo_CreateStreamCommand.Size(m)
+func (m *MeasurementsInfo) XXX_Size() int {
+	return xxx_messageInfo_MeasurementsInfo.Size(m)
 }
-func (m *CreateStreamCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateStreamCommand.DiscardUnknown(m)
+func (m *MeasurementsInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeasurementsInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateStreamCommand proto.InternalMessageInfo
+var xxx_messageInfo_MeasurementsInfo proto.InternalMessageInfo
 
-func (m *CreateStreamCommand) GetStreamInfo() *StreamInfo {
+func (m *MeasurementsInfo) GetMeasurementsInfo() []*MeasurementInfo {
 	if m != nil {
-		return m.StreamInfo
+		return m.MeasurementsInfo
 	}
 	return nil
 }
 
-var E_CreateStreamCommand_Command = &proto.ExtensionDesc{
-	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*CreateStreamCommand)(nil),
-	Field:         177,
-	Name:          "proto.CreateStreamCommand.command",
-	Tag:           "bytes,177,opt,name=command",
-	Filename:      "meta.proto",
-}
-
-type DropStreamCommand struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+type RegisterQueryIDOffsetCommand struct {
+	Host                 *string  `protobuf:"bytes,1,req,name=Host" json:"Host,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropStreamCommand) Reset()         { *m = DropStreamCommand{} }
-func (m *DropStreamCommand) String() string { return proto.CompactTextString(m) }
-func (*DropStreamCommand) ProtoMessage()    {}
-func (*DropStreamCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{116}
-}
-func (m *DropStreamCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropStreamCommand.Unmarshal(m, b)
+func (m *RegisterQueryIDOffsetCommand) Reset()         { *m = RegisterQueryIDOffsetCommand{} }
+func (m *RegisterQueryIDOffsetCommand) String() string { return proto.CompactTextString(m) }
+func (*RegisterQueryIDOffsetCommand) ProtoMessage()    {}
+func (*RegisterQueryIDOffsetCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{136}
 }
-func (m *DropStreamCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropStreamCommand.Marshal(b, m, deterministic)
+func (m *RegisterQueryIDOffsetCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterQueryIDOffsetCommand.Unmarshal(m, b)
 }
-func (m *DropStreamCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropStreamCommand.Merge(m, src)
+func (m *RegisterQueryIDOffsetCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterQueryIDOffsetCommand.Marshal(b, m, deterministic)
 }
-func (m *DropStreamCommand) XXX_Size() int {
-	return xxx_messageInfo_DropStreamCommand.Size(m)
+func (m *RegisterQueryIDOffsetCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterQueryIDOffsetCommand.Merge(m, src)
 }
-func (m *DropStreamCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropStreamCommand.DiscardUnknown(m)
+func (m *RegisterQueryIDOffsetCommand) XXX_Size() int {
+	return xxx_messageInfo_RegisterQueryIDOffsetCommand.Size(m)
+}
+func (m *RegisterQueryIDOffsetCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterQueryIDOffsetCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropStreamCommand proto.InternalMessageInfo
+var xxx_messageInfo_RegisterQueryIDOffsetCommand proto.InternalMessageInfo
 
-func (m *DropStreamCommand) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
+func (m *RegisterQueryIDOffsetCommand) GetHost() string {
+	if m != nil && m.Host != nil {
+		return *m.Host
 	}
 	return ""
 }
 
-var E_DropStreamCommand_Command = &proto.ExtensionDesc{
+var E_RegisterQueryIDOffsetCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*DropStreamCommand)(nil),
-	Field:         178,
-	Name:          "proto.DropStreamCommand.command",
-	Tag:           "bytes,178,opt,name=command",
+	ExtensionType: (*RegisterQueryIDOffsetCommand)(nil),
+	Field:         184,
+	Name:          "proto.RegisterQueryIDOffsetCommand.command",
+	Tag:           "bytes,184,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type GetMeasurementInfoStoreCommand struct {
-	DbName               *string  `protobuf:"bytes,1,req,name=DbName" json:"DbName,omitempty"`
-	RpName               *string  `protobuf:"bytes,2,req,name=RpName" json:"RpName,omitempty"`
-	MstName              *string  `protobuf:"bytes,3,req,name=MstName" json:"MstName,omitempty"`
+type CreateContinuousQueryCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Name                 *string  `protobuf:"bytes,2,req,name=Name" json:"Name,omitempty"`
+	Query                *string  `protobuf:"bytes,3,req,name=Query" json:"Query,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMeasurementInfoStoreCommand) Reset()         { *m = GetMeasurementInfoStoreCommand{} }
-func (m *GetMeasurementInfoStoreCommand) String() string { return proto.CompactTextString(m) }
-func (*GetMeasurementInfoStoreCommand) ProtoMessage()    {}
-func (*GetMeasurementInfoStoreCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{117}
+func (m *CreateContinuousQueryCommand) Reset()         { *m = CreateContinuousQueryCommand{} }
+func (m *CreateContinuousQueryCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateContinuousQueryCommand) ProtoMessage()    {}
+func (*CreateContinuousQueryCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{137}
 }
-func (m *GetMeasurementInfoStoreCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMeasurementInfoStoreCommand.Unmarshal(m, b)
+func (m *CreateContinuousQueryCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateContinuousQueryCommand.Unmarshal(m, b)
 }
-func (m *GetMeasurementInfoStoreCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMeasurementInfoStoreCommand.Marshal(b, m, deterministic)
+func (m *CreateContinuousQueryCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateContinuousQueryCommand.Marshal(b, m, deterministic)
 }
-func (m *GetMeasurementInfoStoreCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMeasurementInfoStoreCommand.Merge(m, src)
+func (m *CreateContinuousQueryCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateContinuousQueryCommand.Merge(m, src)
 }
-func (m *GetMeasurementInfoStoreCommand) XXX_Size() int {
-	return xxx_messageInfo_GetMeasurementInfoStoreCommand.Size(m)
+func (m *CreateContinuousQueryCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateContinuousQueryCommand.Size(m)
 }
-func (m *GetMeasurementInfoStoreCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMeasurementInfoStoreCommand.DiscardUnknown(m)
+func (m *CreateContinuousQueryCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateContinuousQueryCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMeasurementInfoStoreCommand proto.InternalMessageInfo
+var xxx_messageInfo_CreateContinuousQueryCommand proto.InternalMessageInfo
 
-func (m *GetMeasurementInfoStoreCommand) GetDbName() string {
-	if m != nil && m.DbName != nil {
-		return *m.DbName
+func (m *CreateContinuousQueryCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
 	}
 	return ""
 }
 
-func (m *GetMeasurementInfoStoreCommand) GetRpName() string {
-	if m != nil && m.RpName != nil {
-		return *m.RpName
+func (m *CreateContinuousQueryCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
 	return ""
 }
 
-func (m *GetMeasurementInfoStoreCommand) GetMstName() string {
-	if m != nil && m.MstName != nil {
-		return *m.MstName
+func (m *CreateContinuousQueryCommand) GetQuery() string {
+	if m != nil && m.Query != nil {
+		return *m.Query
 	}
 	return ""
 }
 
-var E_GetMeasurementInfoStoreCommand_Command = &proto.ExtensionDesc{
+var E_CreateContinuousQueryCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*GetMeasurementInfoStoreCommand)(nil),
-	Field:         179,
-	Name:          "proto.GetMeasurementInfoStoreCommand.command",
-	Tag:           "bytes,179,opt,name=command",
+	ExtensionType: (*CreateContinuousQueryCommand)(nil),
+	Field:         185,
+	Name:          "proto.CreateContinuousQueryCommand.command",
+	Tag:           "bytes,185,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type VerifyDataNodeCommand struct {
-	NodeID               *uint64  `protobuf:"varint,1,req,name=NodeID" json:"NodeID,omitempty"`
+type Sql2MetaHeartbeatCommand struct {
+	Host                 *string  `protobuf:"bytes,1,req,name=Host" json:"Host,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VerifyDataNodeCommand) Reset()         { *m = VerifyDataNodeCommand{} }
-func (m *VerifyDataNodeCommand) String() string { return proto.CompactTextString(m) }
-func (*VerifyDataNodeCommand) ProtoMessage()    {}
-func (*VerifyDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{118}
+func (m *Sql2MetaHeartbeatCommand) Reset()         { *m = Sql2MetaHeartbeatCommand{} }
+func (m *Sql2MetaHeartbeatCommand) String() string { return proto.CompactTextString(m) }
+func (*Sql2MetaHeartbeatCommand) ProtoMessage()    {}
+func (*Sql2MetaHeartbeatCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{138}
 }
-func (m *VerifyDataNodeCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VerifyDataNodeCommand.Unmarshal(m, b)
+func (m *Sql2MetaHeartbeatCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Sql2MetaHeartbeatCommand.Unmarshal(m, b)
 }
-func (m *VerifyDataNodeCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VerifyDataNodeCommand.Marshal(b, m, deterministic)
+func (m *Sql2MetaHeartbeatCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Sql2MetaHeartbeatCommand.Marshal(b, m, deterministic)
 }
-func (m *VerifyDataNodeCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VerifyDataNodeCommand.Merge(m, src)
+func (m *Sql2MetaHeartbeatCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sql2MetaHeartbeatCommand.Merge(m, src)
 }
-func (m *VerifyDataNodeCommand) XXX_Size() int {
-	return xxx_messageInfo_VerifyDataNodeCommand.Size(m)
+func (m *Sql2MetaHeartbeatCommand) XXX_Size() int {
+	return xxx_messageInfo_Sql2MetaHeartbeatCommand.Size(m)
 }
-func (m *VerifyDataNodeCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_VerifyDataNodeCommand.DiscardUnknown(m)
+func (m *Sql2MetaHeartbeatCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sql2MetaHeartbeatCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VerifyDataNodeCommand proto.InternalMessageInfo
+var xxx_messageInfo_Sql2MetaHeartbeatCommand proto.InternalMessageInfo
 
-func (m *VerifyDataNodeCommand) GetNodeID() uint64 {
-	if m != nil && m.NodeID != nil {
-		return *m.NodeID
+func (m *Sql2MetaHeartbeatCommand) GetHost() string {
+	if m != nil && m.Host != nil {
+		return *m.Host
 	}
-	return 0
+	return ""
 }
 
-var E_VerifyDataNodeCommand_Command = &proto.ExtensionDesc{
+var E_Sql2MetaHeartbeatCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*VerifyDataNodeCommand)(nil),
-	Field:         180,
-	Name:          "proto.VerifyDataNodeCommand.command",
-	Tag:           "bytes,180,opt,name=command",
+	ExtensionType: (*Sql2MetaHeartbeatCommand)(nil),
+	Field:         186,
+	Name:          "proto.Sql2MetaHeartbeatCommand.command",
+	Tag:           "bytes,186,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type ExpandGroupsCommand struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ContinuousQueryReportCommand struct {
+	CQStates             []*CQState `protobuf:"bytes,1,rep,name=CQStates" json:"CQStates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *ExpandGroupsCommand) Reset()         { *m = ExpandGroupsCommand{} }
-func (m *ExpandGroupsCommand) String() string { return proto.CompactTextString(m) }
-func (*ExpandGroupsCommand) ProtoMessage()    {}
-func (*ExpandGroupsCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{119}
+func (m *ContinuousQueryReportCommand) Reset()         { *m = ContinuousQueryReportCommand{} }
+func (m *ContinuousQueryReportCommand) String() string { return proto.CompactTextString(m) }
+func (*ContinuousQueryReportCommand) ProtoMessage()    {}
+func (*ContinuousQueryReportCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{139}
 }
-func (m *ExpandGroupsCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ExpandGroupsCommand.Unmarshal(m, b)
+func (m *ContinuousQueryReportCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContinuousQueryReportCommand.Unmarshal(m, b)
 }
-func (m *ExpandGroupsCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ExpandGroupsCommand.Marshal(b, m, deterministic)
+func (m *ContinuousQueryReportCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContinuousQueryReportCommand.Marshal(b, m, deterministic)
 }
-func (m *ExpandGroupsCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExpandGroupsCommand.Merge(m, src)
+func (m *ContinuousQueryReportCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousQueryReportCommand.Merge(m, src)
 }
-func (m *ExpandGroupsCommand) XXX_Size() int {
-	return xxx_messageInfo_ExpandGroupsCommand.Size(m)
+func (m *ContinuousQueryReportCommand) XXX_Size() int {
+	return xxx_messageInfo_ContinuousQueryReportCommand.Size(m)
 }
-func (m *ExpandGroupsCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExpandGroupsCommand.DiscardUnknown(m)
+func (m *ContinuousQueryReportCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousQueryReportCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExpandGroupsCommand proto.InternalMessageInfo
+var xxx_messageInfo_ContinuousQueryReportCommand proto.InternalMessageInfo
 
-var E_ExpandGroupsCommand_Command = &proto.ExtensionDesc{
+func (m *ContinuousQueryReportCommand) GetCQStates() []*CQState {
+	if m != nil {
+		return m.CQStates
+	}
+	return nil
+}
+
+var E_ContinuousQueryReportCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*ExpandGroupsCommand)(nil),
-	Field:         181,
-	Name:          "proto.ExpandGroupsCommand.command",
-	Tag:           "bytes,181,opt,name=command",
+	ExtensionType: (*ContinuousQueryReportCommand)(nil),
+	Field:         187,
+	Name:          "proto.ContinuousQueryReportCommand.command",
+	Tag:           "bytes,187,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type UpdatePtVersionCommand struct {
-	Db                   *string  `protobuf:"bytes,1,req,name=Db" json:"Db,omitempty"`
-	Pt                   *uint32  `protobuf:"varint,2,req,name=Pt" json:"Pt,omitempty"`
+type CQState struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	LastRunTime          *int64   `protobuf:"varint,2,req,name=LastRunTime" json:"LastRunTime,omitempty"`
+	Host                 *string  `protobuf:"bytes,3,opt,name=Host" json:"Host,omitempty"`
+	LastError            *string  `protobuf:"bytes,4,opt,name=LastError" json:"LastError,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdatePtVersionCommand) Reset()         { *m = UpdatePtVersionCommand{} }
-func (m *UpdatePtVersionCommand) String() string { return proto.CompactTextString(m) }
-func (*UpdatePtVersionCommand) ProtoMessage()    {}
-func (*UpdatePtVersionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{120}
+func (m *CQState) Reset()         { *m = CQState{} }
+func (m *CQState) String() string { return proto.CompactTextString(m) }
+func (*CQState) ProtoMessage()    {}
+func (*CQState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{140}
 }
-func (m *UpdatePtVersionCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdatePtVersionCommand.Unmarshal(m, b)
+func (m *CQState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CQState.Unmarshal(m, b)
 }
-func (m *UpdatePtVersionCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdatePtVersionCommand.Marshal(b, m, deterministic)
+func (m *CQState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CQState.Marshal(b, m, deterministic)
 }
-func (m *UpdatePtVersionCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdatePtVersionCommand.Merge(m, src)
+func (m *CQState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CQState.Merge(m, src)
 }
-func (m *UpdatePtVersionCommand) XXX_Size() int {
-	return xxx_messageInfo_UpdatePtVersionCommand.Size(m)
+func (m *CQState) XXX_Size() int {
+	return xxx_messageInfo_CQState.Size(m)
 }
-func (m *UpdatePtVersionCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdatePtVersionCommand.DiscardUnknown(m)
+func (m *CQState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CQState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdatePtVersionCommand proto.InternalMessageInfo
+var xxx_messageInfo_CQState proto.InternalMessageInfo
 
-func (m *UpdatePtVersionCommand) GetDb() string {
-	if m != nil && m.Db != nil {
-		return *m.Db
+func (m *CQState) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
 	return ""
 }
 
-func (m *UpdatePtVersionCommand) GetPt() uint32 {
-	if m != nil && m.Pt != nil {
-		return *m.Pt
+func (m *CQState) GetLastRunTime() int64 {
+	if m != nil && m.LastRunTime != nil {
+		return *m.LastRunTime
 	}
 	return 0
 }
 
-var E_UpdatePtVersionCommand_Command = &proto.ExtensionDesc{
-	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*UpdatePtVersionCommand)(nil),
-	Field:         182,
-	Name:          "proto.UpdatePtVersionCommand.command",
-	Tag:           "bytes,182,opt,name=command",
-	Filename:      "meta.proto",
+func (m *CQState) GetHost() string {
+	if m != nil && m.Host != nil {
+		return *m.Host
+	}
+	return ""
 }
 
-type GetMeasurementsInfoCommand struct {
-	DbName               *string  `protobuf:"bytes,1,req,name=DbName" json:"DbName,omitempty"`
-	RpName               *string  `protobuf:"bytes,2,req,name=RpName" json:"RpName,omitempty"`
+func (m *CQState) GetLastError() string {
+	if m != nil && m.LastError != nil {
+		return *m.LastError
+	}
+	return ""
+}
+
+type GetContinuousQueryLeaseCommand struct {
+	Host                 *string  `protobuf:"bytes,1,req,name=Host" json:"Host,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMeasurementsInfoCommand) Reset()         { *m = GetMeasurementsInfoCommand{} }
-func (m *GetMeasurementsInfoCommand) String() string { return proto.CompactTextString(m) }
-func (*GetMeasurementsInfoCommand) ProtoMessage()    {}
-func (*GetMeasurementsInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{121}
+func (m *GetContinuousQueryLeaseCommand) Reset()         { *m = GetContinuousQueryLeaseCommand{} }
+func (m *GetContinuousQueryLeaseCommand) String() string { return proto.CompactTextString(m) }
+func (*GetContinuousQueryLeaseCommand) ProtoMessage()    {}
+func (*GetContinuousQueryLeaseCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{141}
 }
-func (m *GetMeasurementsInfoCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMeasurementsInfoCommand.Unmarshal(m, b)
+func (m *GetContinuousQueryLeaseCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContinuousQueryLeaseCommand.Unmarshal(m, b)
 }
-func (m *GetMeasurementsInfoCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMeasurementsInfoCommand.Marshal(b, m, deterministic)
+func (m *GetContinuousQueryLeaseCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContinuousQueryLeaseCommand.Marshal(b, m, deterministic)
 }
-func (m *GetMeasurementsInfoCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMeasurementsInfoCommand.Merge(m, src)
+func (m *GetContinuousQueryLeaseCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContinuousQueryLeaseCommand.Merge(m, src)
 }
-func (m *GetMeasurementsInfoCommand) XXX_Size() int {
-	return xxx_messageInfo_GetMeasurementsInfoCommand.Size(m)
+func (m *GetContinuousQueryLeaseCommand) XXX_Size() int {
+	return xxx_messageInfo_GetContinuousQueryLeaseCommand.Size(m)
 }
-func (m *GetMeasurementsInfoCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMeasurementsInfoCommand.DiscardUnknown(m)
+func (m *GetContinuousQueryLeaseCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContinuousQueryLeaseCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMeasurementsInfoCommand proto.InternalMessageInfo
-
-func (m *GetMeasurementsInfoCommand) GetDbName() string {
-	if m != nil && m.DbName != nil {
-		return *m.DbName
-	}
-	return ""
-}
+var xxx_messageInfo_GetContinuousQueryLeaseCommand proto.InternalMessageInfo
 
-func (m *GetMeasurementsInfoCommand) GetRpName() string {
-	if m != nil && m.RpName != nil {
-		return *m.RpName
+func (m *GetContinuousQueryLeaseCommand) GetHost() string {
+	if m != nil && m.Host != nil {
+		return *m.Host
 	}
 	return ""
 }
 
-var E_GetMeasurementsInfoCommand_Command = &proto.ExtensionDesc{
+var E_GetContinuousQueryLeaseCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*GetMeasurementsInfoCommand)(nil),
-	Field:         183,
-	Name:          "proto.GetMeasurementsInfoCommand.command",
-	Tag:           "bytes,183,opt,name=command",
+	ExtensionType: (*GetContinuousQueryLeaseCommand)(nil),
+	Field:         188,
+	Name:          "proto.GetContinuousQueryLeaseCommand.command",
+	Tag:           "bytes,188,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type DatabaseBriefInfo struct {
+type DropContinuousQueryCommand struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
-	EnableTagArray       *bool    `protobuf:"varint,2,req,name=EnableTagArray" json:"EnableTagArray,omitempty"`
-	Replicas             *int32   `protobuf:"varint,3,opt,name=replicas" json:"replicas,omitempty"`
+	Database             *string  `protobuf:"bytes,2,req,name=Database" json:"Database,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DatabaseBriefInfo) Reset()         { *m = DatabaseBriefInfo{} }
-func (m *DatabaseBriefInfo) String() string { return proto.CompactTextString(m) }
-func (*DatabaseBriefInfo) ProtoMessage()    {}
-func (*DatabaseBriefInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{122}
+func (m *DropContinuousQueryCommand) Reset()         { *m = DropContinuousQueryCommand{} }
+func (m *DropContinuousQueryCommand) String() string { return proto.CompactTextString(m) }
+func (*DropContinuousQueryCommand) ProtoMessage()    {}
+func (*DropContinuousQueryCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{142}
 }
-func (m *DatabaseBriefInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DatabaseBriefInfo.Unmarshal(m, b)
+func (m *DropContinuousQueryCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropContinuousQueryCommand.Unmarshal(m, b)
 }
-func (m *DatabaseBriefInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DatabaseBriefInfo.Marshal(b, m, deterministic)
+func (m *DropContinuousQueryCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropContinuousQueryCommand.Marshal(b, m, deterministic)
 }
-func (m *DatabaseBriefInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DatabaseBriefInfo.Merge(m, src)
+func (m *DropContinuousQueryCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropContinuousQueryCommand.Merge(m, src)
 }
-func (m *DatabaseBriefInfo) XXX_Size() int {
-	return xxx_messageInfo_DatabaseBriefInfo.Size(m)
+func (m *DropContinuousQueryCommand) XXX_Size() int {
+	return xxx_messageInfo_DropContinuousQueryCommand.Size(m)
 }
-func (m *DatabaseBriefInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_DatabaseBriefInfo.DiscardUnknown(m)
+func (m *DropContinuousQueryCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropContinuousQueryCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DatabaseBriefInfo proto.InternalMessageInfo
+var xxx_messageInfo_DropContinuousQueryCommand proto.InternalMessageInfo
 
-func (m *DatabaseBriefInfo) GetName() string {
+func (m *DropContinuousQueryCommand) GetName() string {
 	if m != nil && m.Name != nil {
 		return *m.Name
 	}
 	return ""
 }
 
-func (m *DatabaseBriefInfo) GetEnableTagArray() bool {
-	if m != nil && m.EnableTagArray != nil {
-		return *m.EnableTagArray
+func (m *DropContinuousQueryCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
 	}
-	return false
+	return ""
 }
 
-func (m *DatabaseBriefInfo) GetReplicas() int32 {
-	if m != nil && m.Replicas != nil {
-		return *m.Replicas
-	}
-	return 0
+var E_DropContinuousQueryCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DropContinuousQueryCommand)(nil),
+	Field:         189,
+	Name:          "proto.DropContinuousQueryCommand.command",
+	Tag:           "bytes,189,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-type MeasurementsInfo struct {
-	MeasurementsInfo     []*MeasurementInfo `protobuf:"bytes,1,rep,name=MeasurementsInfo" json:"MeasurementsInfo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type CreateContinuousQueryBackfillCommand struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Name                 *string  `protobuf:"bytes,2,req,name=Name" json:"Name,omitempty"`
+	StartTime            *int64   `protobuf:"varint,3,req,name=StartTime" json:"StartTime,omitempty"`
+	EndTime              *int64   `protobuf:"varint,4,req,name=EndTime" json:"EndTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MeasurementsInfo) Reset()         { *m = MeasurementsInfo{} }
-func (m *MeasurementsInfo) String() string { return proto.CompactTextString(m) }
-func (*MeasurementsInfo) ProtoMessage()    {}
-func (*MeasurementsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{123}
+func (m *CreateContinuousQueryBackfillCommand) Reset()         { *m = CreateContinuousQueryBackfillCommand{} }
+func (m *CreateContinuousQueryBackfillCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateContinuousQueryBackfillCommand) ProtoMessage()    {}
+func (*CreateContinuousQueryBackfillCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{143}
 }
-func (m *MeasurementsInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MeasurementsInfo.Unmarshal(m, b)
+func (m *CreateContinuousQueryBackfillCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateContinuousQueryBackfillCommand.Unmarshal(m, b)
 }
-func (m *MeasurementsInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MeasurementsInfo.Marshal(b, m, deterministic)
+func (m *CreateContinuousQueryBackfillCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateContinuousQueryBackfillCommand.Marshal(b, m, deterministic)
 }
-func (m *MeasurementsInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MeasurementsInfo.Merge(m, src)
+func (m *CreateContinuousQueryBackfillCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateContinuousQueryBackfillCommand.Merge(m, src)
 }
-func (m *MeasurementsInfo) XXX_Size() int {
-	return xxx_messageInfo_MeasurementsInfo.Size(m)
+func (m *CreateContinuousQueryBackfillCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateContinuousQueryBackfillCommand.Size(m)
 }
-func (m *MeasurementsInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_MeasurementsInfo.DiscardUnknown(m)
+func (m *CreateContinuousQueryBackfillCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateContinuousQueryBackfillCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MeasurementsInfo proto.InternalMessageInfo
+var xxx_messageInfo_CreateContinuousQueryBackfillCommand proto.InternalMessageInfo
 
-func (m *MeasurementsInfo) GetMeasurementsInfo() []*MeasurementInfo {
-	if m != nil {
-		return m.MeasurementsInfo
+func (m *CreateContinuousQueryBackfillCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
 	}
-	return nil
+	return ""
 }
 
-type RegisterQueryIDOffsetCommand struct {
-	Host                 *string  `protobuf:"bytes,1,req,name=Host" json:"Host,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CreateContinuousQueryBackfillCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
 }
 
-func (m *RegisterQueryIDOffsetCommand) Reset()         { *m = RegisterQueryIDOffsetCommand{} }
-func (m *RegisterQueryIDOffsetCommand) String() string { return proto.CompactTextString(m) }
-func (*RegisterQueryIDOffsetCommand) ProtoMessage()    {}
-func (*RegisterQueryIDOffsetCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{124}
-}
-func (m *RegisterQueryIDOffsetCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RegisterQueryIDOffsetCommand.Unmarshal(m, b)
-}
-func (m *RegisterQueryIDOffsetCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RegisterQueryIDOffsetCommand.Marshal(b, m, deterministic)
-}
-func (m *RegisterQueryIDOffsetCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterQueryIDOffsetCommand.Merge(m, src)
-}
-func (m *RegisterQueryIDOffsetCommand) XXX_Size() int {
-	return xxx_messageInfo_RegisterQueryIDOffsetCommand.Size(m)
-}
-func (m *RegisterQueryIDOffsetCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterQueryIDOffsetCommand.DiscardUnknown(m)
+func (m *CreateContinuousQueryBackfillCommand) GetStartTime() int64 {
+	if m != nil && m.StartTime != nil {
+		return *m.StartTime
+	}
+	return 0
 }
 
-var xxx_messageInfo_RegisterQueryIDOffsetCommand proto.InternalMessageInfo
-
-func (m *RegisterQueryIDOffsetCommand) GetHost() string {
-	if m != nil && m.Host != nil {
-		return *m.Host
+func (m *CreateContinuousQueryBackfillCommand) GetEndTime() int64 {
+	if m != nil && m.EndTime != nil {
+		return *m.EndTime
 	}
-	return ""
+	return 0
 }
 
-var E_RegisterQueryIDOffsetCommand_Command = &proto.ExtensionDesc{
+var E_CreateContinuousQueryBackfillCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*RegisterQueryIDOffsetCommand)(nil),
-	Field:         184,
-	Name:          "proto.RegisterQueryIDOffsetCommand.command",
-	Tag:           "bytes,184,opt,name=command",
+	ExtensionType: (*CreateContinuousQueryBackfillCommand)(nil),
+	Field:         207,
+	Name:          "proto.CreateContinuousQueryBackfillCommand.command",
+	Tag:           "bytes,207,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type CreateContinuousQueryCommand struct {
+type UpdateContinuousQueryBackfillCommand struct {
 	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
 	Name                 *string  `protobuf:"bytes,2,req,name=Name" json:"Name,omitempty"`
-	Query                *string  `protobuf:"bytes,3,req,name=Query" json:"Query,omitempty"`
+	NextTime             *int64   `protobuf:"varint,3,req,name=NextTime" json:"NextTime,omitempty"`
+	Host                 *string  `protobuf:"bytes,4,opt,name=Host" json:"Host,omitempty"`
+	LastError            *string  `protobuf:"bytes,5,opt,name=LastError" json:"LastError,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateContinuousQueryCommand) Reset()         { *m = CreateContinuousQueryCommand{} }
-func (m *CreateContinuousQueryCommand) String() string { return proto.CompactTextString(m) }
-func (*CreateContinuousQueryCommand) ProtoMessage()    {}
-func (*CreateContinuousQueryCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{125}
+func (m *UpdateContinuousQueryBackfillCommand) Reset()         { *m = UpdateContinuousQueryBackfillCommand{} }
+func (m *UpdateContinuousQueryBackfillCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdateContinuousQueryBackfillCommand) ProtoMessage()    {}
+func (*UpdateContinuousQueryBackfillCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{144}
 }
-func (m *CreateContinuousQueryCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateContinuousQueryCommand.Unmarshal(m, b)
+func (m *UpdateContinuousQueryBackfillCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateContinuousQueryBackfillCommand.Unmarshal(m, b)
 }
-func (m *CreateContinuousQueryCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateContinuousQueryCommand.Marshal(b, m, deterministic)
+func (m *UpdateContinuousQueryBackfillCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateContinuousQueryBackfillCommand.Marshal(b, m, deterministic)
 }
-func (m *CreateContinuousQueryCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateContinuousQueryCommand.Merge(m, src)
+func (m *UpdateContinuousQueryBackfillCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateContinuousQueryBackfillCommand.Merge(m, src)
 }
-func (m *CreateContinuousQueryCommand) XXX_Size() int {
-	return xxx_messageInfo_CreateContinuousQueryCommand.Size(m)
+func (m *UpdateContinuousQueryBackfillCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdateContinuousQueryBackfillCommand.Size(m)
 }
-func (m *CreateContinuousQueryCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateContinuousQueryCommand.DiscardUnknown(m)
+func (m *UpdateContinuousQueryBackfillCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateContinuousQueryBackfillCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateContinuousQueryCommand proto.InternalMessageInfo
+var xxx_messageInfo_UpdateContinuousQueryBackfillCommand proto.InternalMessageInfo
 
-func (m *CreateContinuousQueryCommand) GetDatabase() string {
+func (m *UpdateContinuousQueryBackfillCommand) GetDatabase() string {
 	if m != nil && m.Database != nil {
 		return *m.Database
 	}
 	return ""
 }
 
-func (m *CreateContinuousQueryCommand) GetName() string {
+func (m *UpdateContinuousQueryBackfillCommand) GetName() string {
 	if m != nil && m.Name != nil {
 		return *m.Name
 	}
 	return ""
 }
 
-func (m *CreateContinuousQueryCommand) GetQuery() string {
-	if m != nil && m.Query != nil {
-		return *m.Query
+func (m *UpdateContinuousQueryBackfillCommand) GetNextTime() int64 {
+	if m != nil && m.NextTime != nil {
+		return *m.NextTime
+	}
+	return 0
+}
+
+func (m *UpdateContinuousQueryBackfillCommand) GetHost() string {
+	if m != nil && m.Host != nil {
+		return *m.Host
+	}
+	return ""
+}
+
+func (m *UpdateContinuousQueryBackfillCommand) GetLastError() string {
+	if m != nil && m.LastError != nil {
+		return *m.LastError
 	}
 	return ""
 }
 
-var E_CreateContinuousQueryCommand_Command = &proto.ExtensionDesc{
+var E_UpdateContinuousQueryBackfillCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*CreateContinuousQueryCommand)(nil),
-	Field:         185,
-	Name:          "proto.CreateContinuousQueryCommand.command",
-	Tag:           "bytes,185,opt,name=command",
+	ExtensionType: (*UpdateContinuousQueryBackfillCommand)(nil),
+	Field:         208,
+	Name:          "proto.UpdateContinuousQueryBackfillCommand.command",
+	Tag:           "bytes,208,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type Sql2MetaHeartbeatCommand struct {
-	Host                 *string  `protobuf:"bytes,1,req,name=Host" json:"Host,omitempty"`
+type CreateTenantCommand struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	MaxSeries            *int64   `protobuf:"varint,2,req,name=MaxSeries" json:"MaxSeries,omitempty"`
+	MaxStorageBytes      *int64   `protobuf:"varint,3,req,name=MaxStorageBytes" json:"MaxStorageBytes,omitempty"`
+	MaxIngestRate        *int64   `protobuf:"varint,4,req,name=MaxIngestRate" json:"MaxIngestRate,omitempty"`
+	MaxQueryConcurrency  *int64   `protobuf:"varint,5,req,name=MaxQueryConcurrency" json:"MaxQueryConcurrency,omitempty"`
+	Nodes                []uint64 `protobuf:"varint,6,rep,name=Nodes" json:"Nodes,omitempty"`
+	SetNodes             *bool    `protobuf:"varint,7,opt,name=SetNodes" json:"SetNodes,omitempty"`
+	Alter                *bool    `protobuf:"varint,8,opt,name=Alter" json:"Alter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Sql2MetaHeartbeatCommand) Reset()         { *m = Sql2MetaHeartbeatCommand{} }
-func (m *Sql2MetaHeartbeatCommand) String() string { return proto.CompactTextString(m) }
-func (*Sql2MetaHeartbeatCommand) ProtoMessage()    {}
-func (*Sql2MetaHeartbeatCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{126}
+func (m *CreateTenantCommand) Reset()         { *m = CreateTenantCommand{} }
+func (m *CreateTenantCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateTenantCommand) ProtoMessage()    {}
+func (*CreateTenantCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{145}
 }
-func (m *Sql2MetaHeartbeatCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Sql2MetaHeartbeatCommand.Unmarshal(m, b)
+func (m *CreateTenantCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTenantCommand.Unmarshal(m, b)
 }
-func (m *Sql2MetaHeartbeatCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Sql2MetaHeartbeatCommand.Marshal(b, m, deterministic)
+func (m *CreateTenantCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTenantCommand.Marshal(b, m, deterministic)
 }
-func (m *Sql2MetaHeartbeatCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Sql2MetaHeartbeatCommand.Merge(m, src)
+func (m *CreateTenantCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTenantCommand.Merge(m, src)
 }
-func (m *Sql2MetaHeartbeatCommand) XXX_Size() int {
-	return xxx_messageInfo_Sql2MetaHeartbeatCommand.Size(m)
+func (m *CreateTenantCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateTenantCommand.Size(m)
 }
-func (m *Sql2MetaHeartbeatCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_Sql2MetaHeartbeatCommand.DiscardUnknown(m)
+func (m *CreateTenantCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTenantCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Sql2MetaHeartbeatCommand proto.InternalMessageInfo
+var xxx_messageInfo_CreateTenantCommand proto.InternalMessageInfo
 
-func (m *Sql2MetaHeartbeatCommand) GetHost() string {
-	if m != nil && m.Host != nil {
-		return *m.Host
+func (m *CreateTenantCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
 	return ""
 }
 
-var E_Sql2MetaHeartbeatCommand_Command = &proto.ExtensionDesc{
-	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*Sql2MetaHeartbeatCommand)(nil),
-	Field:         186,
-	Name:          "proto.Sql2MetaHeartbeatCommand.command",
-	Tag:           "bytes,186,opt,name=command",
-	Filename:      "meta.proto",
+func (m *CreateTenantCommand) GetMaxSeries() int64 {
+	if m != nil && m.MaxSeries != nil {
+		return *m.MaxSeries
+	}
+	return 0
 }
 
-type ContinuousQueryReportCommand struct {
-	CQStates             []*CQState `protobuf:"bytes,1,rep,name=CQStates" json:"CQStates,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *CreateTenantCommand) GetMaxStorageBytes() int64 {
+	if m != nil && m.MaxStorageBytes != nil {
+		return *m.MaxStorageBytes
+	}
+	return 0
 }
 
-func (m *ContinuousQueryReportCommand) Reset()         { *m = ContinuousQueryReportCommand{} }
-func (m *ContinuousQueryReportCommand) String() string { return proto.CompactTextString(m) }
-func (*ContinuousQueryReportCommand) ProtoMessage()    {}
-func (*ContinuousQueryReportCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{127}
-}
-func (m *ContinuousQueryReportCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ContinuousQueryReportCommand.Unmarshal(m, b)
-}
-func (m *ContinuousQueryReportCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ContinuousQueryReportCommand.Marshal(b, m, deterministic)
-}
-func (m *ContinuousQueryReportCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContinuousQueryReportCommand.Merge(m, src)
-}
-func (m *ContinuousQueryReportCommand) XXX_Size() int {
-	return xxx_messageInfo_ContinuousQueryReportCommand.Size(m)
-}
-func (m *ContinuousQueryReportCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContinuousQueryReportCommand.DiscardUnknown(m)
+func (m *CreateTenantCommand) GetMaxIngestRate() int64 {
+	if m != nil && m.MaxIngestRate != nil {
+		return *m.MaxIngestRate
+	}
+	return 0
 }
 
-var xxx_messageInfo_ContinuousQueryReportCommand proto.InternalMessageInfo
+func (m *CreateTenantCommand) GetMaxQueryConcurrency() int64 {
+	if m != nil && m.MaxQueryConcurrency != nil {
+		return *m.MaxQueryConcurrency
+	}
+	return 0
+}
 
-func (m *ContinuousQueryReportCommand) GetCQStates() []*CQState {
+func (m *CreateTenantCommand) GetNodes() []uint64 {
 	if m != nil {
-		return m.CQStates
+		return m.Nodes
 	}
 	return nil
 }
 
-var E_ContinuousQueryReportCommand_Command = &proto.ExtensionDesc{
+func (m *CreateTenantCommand) GetSetNodes() bool {
+	if m != nil && m.SetNodes != nil {
+		return *m.SetNodes
+	}
+	return false
+}
+
+func (m *CreateTenantCommand) GetAlter() bool {
+	if m != nil && m.Alter != nil {
+		return *m.Alter
+	}
+	return false
+}
+
+var E_CreateTenantCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*ContinuousQueryReportCommand)(nil),
-	Field:         187,
-	Name:          "proto.ContinuousQueryReportCommand.command",
-	Tag:           "bytes,187,opt,name=command",
+	ExtensionType: (*CreateTenantCommand)(nil),
+	Field:         209,
+	Name:          "proto.CreateTenantCommand.command",
+	Tag:           "bytes,209,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type CQState struct {
+type DropTenantCommand struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
-	LastRunTime          *int64   `protobuf:"varint,2,req,name=LastRunTime" json:"LastRunTime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CQState) Reset()         { *m = CQState{} }
-func (m *CQState) String() string { return proto.CompactTextString(m) }
-func (*CQState) ProtoMessage()    {}
-func (*CQState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{128}
+func (m *DropTenantCommand) Reset()         { *m = DropTenantCommand{} }
+func (m *DropTenantCommand) String() string { return proto.CompactTextString(m) }
+func (*DropTenantCommand) ProtoMessage()    {}
+func (*DropTenantCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{146}
 }
-func (m *CQState) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CQState.Unmarshal(m, b)
+func (m *DropTenantCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropTenantCommand.Unmarshal(m, b)
 }
-func (m *CQState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CQState.Marshal(b, m, deterministic)
+func (m *DropTenantCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropTenantCommand.Marshal(b, m, deterministic)
 }
-func (m *CQState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CQState.Merge(m, src)
+func (m *DropTenantCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropTenantCommand.Merge(m, src)
 }
-func (m *CQState) XXX_Size() int {
-	return xxx_messageInfo_CQState.Size(m)
+func (m *DropTenantCommand) XXX_Size() int {
+	return xxx_messageInfo_DropTenantCommand.Size(m)
 }
-func (m *CQState) XXX_DiscardUnknown() {
-	xxx_messageInfo_CQState.DiscardUnknown(m)
+func (m *DropTenantCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropTenantCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CQState proto.InternalMessageInfo
+var xxx_messageInfo_DropTenantCommand proto.InternalMessageInfo
 
-func (m *CQState) GetName() string {
+func (m *DropTenantCommand) GetName() string {
 	if m != nil && m.Name != nil {
 		return *m.Name
 	}
 	return ""
 }
 
-func (m *CQState) GetLastRunTime() int64 {
-	if m != nil && m.LastRunTime != nil {
-		return *m.LastRunTime
-	}
-	return 0
+var E_DropTenantCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DropTenantCommand)(nil),
+	Field:         210,
+	Name:          "proto.DropTenantCommand.command",
+	Tag:           "bytes,210,opt,name=command",
+	Filename:      "meta.proto",
 }
 
-type GetContinuousQueryLeaseCommand struct {
-	Host                 *string  `protobuf:"bytes,1,req,name=Host" json:"Host,omitempty"`
+type UpdateTenantMemberCommand struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Database             *string  `protobuf:"bytes,2,opt,name=Database" json:"Database,omitempty"`
+	User                 *string  `protobuf:"bytes,3,opt,name=User" json:"User,omitempty"`
+	Remove               *bool    `protobuf:"varint,4,opt,name=Remove" json:"Remove,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetContinuousQueryLeaseCommand) Reset()         { *m = GetContinuousQueryLeaseCommand{} }
-func (m *GetContinuousQueryLeaseCommand) String() string { return proto.CompactTextString(m) }
-func (*GetContinuousQueryLeaseCommand) ProtoMessage()    {}
-func (*GetContinuousQueryLeaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{129}
+func (m *UpdateTenantMemberCommand) Reset()         { *m = UpdateTenantMemberCommand{} }
+func (m *UpdateTenantMemberCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdateTenantMemberCommand) ProtoMessage()    {}
+func (*UpdateTenantMemberCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{147}
 }
-func (m *GetContinuousQueryLeaseCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContinuousQueryLeaseCommand.Unmarshal(m, b)
+func (m *UpdateTenantMemberCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTenantMemberCommand.Unmarshal(m, b)
 }
-func (m *GetContinuousQueryLeaseCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContinuousQueryLeaseCommand.Marshal(b, m, deterministic)
+func (m *UpdateTenantMemberCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTenantMemberCommand.Marshal(b, m, deterministic)
 }
-func (m *GetContinuousQueryLeaseCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContinuousQueryLeaseCommand.Merge(m, src)
+func (m *UpdateTenantMemberCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTenantMemberCommand.Merge(m, src)
 }
-func (m *GetContinuousQueryLeaseCommand) XXX_Size() int {
-	return xxx_messageInfo_GetContinuousQueryLeaseCommand.Size(m)
+func (m *UpdateTenantMemberCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdateTenantMemberCommand.Size(m)
 }
-func (m *GetContinuousQueryLeaseCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContinuousQueryLeaseCommand.DiscardUnknown(m)
+func (m *UpdateTenantMemberCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTenantMemberCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetContinuousQueryLeaseCommand proto.InternalMessageInfo
+var xxx_messageInfo_UpdateTenantMemberCommand proto.InternalMessageInfo
 
-func (m *GetContinuousQueryLeaseCommand) GetHost() string {
-	if m != nil && m.Host != nil {
-		return *m.Host
+func (m *UpdateTenantMemberCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
 	return ""
 }
 
-var E_GetContinuousQueryLeaseCommand_Command = &proto.ExtensionDesc{
+func (m *UpdateTenantMemberCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *UpdateTenantMemberCommand) GetUser() string {
+	if m != nil && m.User != nil {
+		return *m.User
+	}
+	return ""
+}
+
+func (m *UpdateTenantMemberCommand) GetRemove() bool {
+	if m != nil && m.Remove != nil {
+		return *m.Remove
+	}
+	return false
+}
+
+var E_UpdateTenantMemberCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*GetContinuousQueryLeaseCommand)(nil),
-	Field:         188,
-	Name:          "proto.GetContinuousQueryLeaseCommand.command",
-	Tag:           "bytes,188,opt,name=command",
+	ExtensionType: (*UpdateTenantMemberCommand)(nil),
+	Field:         211,
+	Name:          "proto.UpdateTenantMemberCommand.command",
+	Tag:           "bytes,211,opt,name=command",
 	Filename:      "meta.proto",
 }
 
-type DropContinuousQueryCommand struct {
+type UpdateTenantUsageCommand struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
-	Database             *string  `protobuf:"bytes,2,req,name=Database" json:"Database,omitempty"`
+	Series               *int64   `protobuf:"varint,2,req,name=Series" json:"Series,omitempty"`
+	StorageBytes         *int64   `protobuf:"varint,3,req,name=StorageBytes" json:"StorageBytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropContinuousQueryCommand) Reset()         { *m = DropContinuousQueryCommand{} }
-func (m *DropContinuousQueryCommand) String() string { return proto.CompactTextString(m) }
-func (*DropContinuousQueryCommand) ProtoMessage()    {}
-func (*DropContinuousQueryCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{130}
+func (m *UpdateTenantUsageCommand) Reset()         { *m = UpdateTenantUsageCommand{} }
+func (m *UpdateTenantUsageCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdateTenantUsageCommand) ProtoMessage()    {}
+func (*UpdateTenantUsageCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{148}
 }
-func (m *DropContinuousQueryCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropContinuousQueryCommand.Unmarshal(m, b)
+func (m *UpdateTenantUsageCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTenantUsageCommand.Unmarshal(m, b)
 }
-func (m *DropContinuousQueryCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropContinuousQueryCommand.Marshal(b, m, deterministic)
+func (m *UpdateTenantUsageCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTenantUsageCommand.Marshal(b, m, deterministic)
 }
-func (m *DropContinuousQueryCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropContinuousQueryCommand.Merge(m, src)
+func (m *UpdateTenantUsageCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTenantUsageCommand.Merge(m, src)
 }
-func (m *DropContinuousQueryCommand) XXX_Size() int {
-	return xxx_messageInfo_DropContinuousQueryCommand.Size(m)
+func (m *UpdateTenantUsageCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdateTenantUsageCommand.Size(m)
 }
-func (m *DropContinuousQueryCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropContinuousQueryCommand.DiscardUnknown(m)
+func (m *UpdateTenantUsageCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTenantUsageCommand.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropContinuousQueryCommand proto.InternalMessageInfo
+var xxx_messageInfo_UpdateTenantUsageCommand proto.InternalMessageInfo
 
-func (m *DropContinuousQueryCommand) GetName() string {
+func (m *UpdateTenantUsageCommand) GetName() string {
 	if m != nil && m.Name != nil {
 		return *m.Name
 	}
 	return ""
 }
 
-func (m *DropContinuousQueryCommand) GetDatabase() string {
-	if m != nil && m.Database != nil {
-		return *m.Database
+func (m *UpdateTenantUsageCommand) GetSeries() int64 {
+	if m != nil && m.Series != nil {
+		return *m.Series
 	}
-	return ""
+	return 0
 }
 
-var E_DropContinuousQueryCommand_Command = &proto.ExtensionDesc{
+func (m *UpdateTenantUsageCommand) GetStorageBytes() int64 {
+	if m != nil && m.StorageBytes != nil {
+		return *m.StorageBytes
+	}
+	return 0
+}
+
+var E_UpdateTenantUsageCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
-	ExtensionType: (*DropContinuousQueryCommand)(nil),
-	Field:         189,
-	Name:          "proto.DropContinuousQueryCommand.command",
-	Tag:           "bytes,189,opt,name=command",
+	ExtensionType: (*UpdateTenantUsageCommand)(nil),
+	Field:         212,
+	Name:          "proto.UpdateTenantUsageCommand.command",
+	Tag:           "bytes,212,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type UpdateDecommissionCommand struct {
+	Info                 *DecommissionInfo `protobuf:"bytes,1,req,name=Info" json:"Info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateDecommissionCommand) Reset()         { *m = UpdateDecommissionCommand{} }
+func (m *UpdateDecommissionCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdateDecommissionCommand) ProtoMessage()    {}
+func (*UpdateDecommissionCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{149}
+}
+func (m *UpdateDecommissionCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDecommissionCommand.Unmarshal(m, b)
+}
+func (m *UpdateDecommissionCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDecommissionCommand.Marshal(b, m, deterministic)
+}
+func (m *UpdateDecommissionCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDecommissionCommand.Merge(m, src)
+}
+func (m *UpdateDecommissionCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdateDecommissionCommand.Size(m)
+}
+func (m *UpdateDecommissionCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDecommissionCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDecommissionCommand proto.InternalMessageInfo
+
+func (m *UpdateDecommissionCommand) GetInfo() *DecommissionInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+var E_UpdateDecommissionCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*UpdateDecommissionCommand)(nil),
+	Field:         213,
+	Name:          "proto.UpdateDecommissionCommand.command",
+	Tag:           "bytes,213,opt,name=command",
 	Filename:      "meta.proto",
 }
 
@@ -8419,7 +9873,7 @@ func (m *NotifyCQLeaseChangedCommand) Reset()         { *m = NotifyCQLeaseChange
 func (m *NotifyCQLeaseChangedCommand) String() string { return proto.CompactTextString(m) }
 func (*NotifyCQLeaseChangedCommand) ProtoMessage()    {}
 func (*NotifyCQLeaseChangedCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{131}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{150}
 }
 func (m *NotifyCQLeaseChangedCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotifyCQLeaseChangedCommand.Unmarshal(m, b)
@@ -8460,7 +9914,7 @@ func (m *SetNodeSegregateStatusCommand) Reset()         { *m = SetNodeSegregateS
 func (m *SetNodeSegregateStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*SetNodeSegregateStatusCommand) ProtoMessage()    {}
 func (*SetNodeSegregateStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{132}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{151}
 }
 func (m *SetNodeSegregateStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetNodeSegregateStatusCommand.Unmarshal(m, b)
@@ -8514,7 +9968,7 @@ func (m *RemoveNodeCommand) Reset()         { *m = RemoveNodeCommand{} }
 func (m *RemoveNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*RemoveNodeCommand) ProtoMessage()    {}
 func (*RemoveNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{133}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{152}
 }
 func (m *RemoveNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveNodeCommand.Unmarshal(m, b)
@@ -8564,7 +10018,7 @@ func (m *UpdateReplicationCommand) Reset()         { *m = UpdateReplicationComma
 func (m *UpdateReplicationCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateReplicationCommand) ProtoMessage()    {}
 func (*UpdateReplicationCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{134}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{153}
 }
 func (m *UpdateReplicationCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateReplicationCommand.Unmarshal(m, b)
@@ -8637,7 +10091,7 @@ func (m *ObsOptions) Reset()         { *m = ObsOptions{} }
 func (m *ObsOptions) String() string { return proto.CompactTextString(m) }
 func (*ObsOptions) ProtoMessage()    {}
 func (*ObsOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{135}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{154}
 }
 func (m *ObsOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObsOptions.Unmarshal(m, b)
@@ -8700,24 +10154,28 @@ func (m *ObsOptions) GetBasePath() string {
 }
 
 type Options struct {
-	CaseInSensitive      *bool    `protobuf:"varint,1,opt,name=CaseInSensitive" json:"CaseInSensitive,omitempty"`
-	AppendMeta           *bool    `protobuf:"varint,2,opt,name=AppendMeta" json:"AppendMeta,omitempty"`
-	WriteThreshold       *int32   `protobuf:"varint,3,opt,name=WriteThreshold" json:"WriteThreshold,omitempty"`
-	ReadThreshold        *int32   `protobuf:"varint,4,opt,name=ReadThreshold" json:"ReadThreshold,omitempty"`
-	StorageCapacity      *int32   `protobuf:"varint,5,opt,name=StorageCapacity" json:"StorageCapacity,omitempty"`
-	SplitChar            *string  `protobuf:"bytes,6,opt,name=SplitChar" json:"SplitChar,omitempty"`
-	Ttl                  *int64   `protobuf:"varint,7,opt,name=Ttl" json:"Ttl,omitempty"`
-	TagsSplit            *string  `protobuf:"bytes,10,opt,name=TagsSplit" json:"TagsSplit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CaseInSensitive      *bool              `protobuf:"varint,1,opt,name=CaseInSensitive" json:"CaseInSensitive,omitempty"`
+	AppendMeta           *bool              `protobuf:"varint,2,opt,name=AppendMeta" json:"AppendMeta,omitempty"`
+	WriteThreshold       *int32             `protobuf:"varint,3,opt,name=WriteThreshold" json:"WriteThreshold,omitempty"`
+	ReadThreshold        *int32             `protobuf:"varint,4,opt,name=ReadThreshold" json:"ReadThreshold,omitempty"`
+	StorageCapacity      *int32             `protobuf:"varint,5,opt,name=StorageCapacity" json:"StorageCapacity,omitempty"`
+	SplitChar            *string            `protobuf:"bytes,6,opt,name=SplitChar" json:"SplitChar,omitempty"`
+	Ttl                  *int64             `protobuf:"varint,7,opt,name=Ttl" json:"Ttl,omitempty"`
+	TagsSplit            *string            `protobuf:"bytes,10,opt,name=TagsSplit" json:"TagsSplit,omitempty"`
+	FloatCodec           *string            `protobuf:"bytes,11,opt,name=FloatCodec" json:"FloatCodec,omitempty"`
+	IntCodec             *string            `protobuf:"bytes,12,opt,name=IntCodec" json:"IntCodec,omitempty"`
+	ErrorBounds          map[string]float64 `protobuf:"bytes,13,rep,name=ErrorBounds" json:"ErrorBounds,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Pipeline             []byte             `protobuf:"bytes,14,opt,name=Pipeline" json:"Pipeline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *Options) Reset()         { *m = Options{} }
 func (m *Options) String() string { return proto.CompactTextString(m) }
 func (*Options) ProtoMessage()    {}
 func (*Options) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{136}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{155}
 }
 func (m *Options) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Options.Unmarshal(m, b)
@@ -8793,6 +10251,34 @@ func (m *Options) GetTagsSplit() string {
 	return ""
 }
 
+func (m *Options) GetFloatCodec() string {
+	if m != nil && m.FloatCodec != nil {
+		return *m.FloatCodec
+	}
+	return ""
+}
+
+func (m *Options) GetIntCodec() string {
+	if m != nil && m.IntCodec != nil {
+		return *m.IntCodec
+	}
+	return ""
+}
+
+func (m *Options) GetErrorBounds() map[string]float64 {
+	if m != nil {
+		return m.ErrorBounds
+	}
+	return nil
+}
+
+func (m *Options) GetPipeline() []byte {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
 type UpdateMeasurementCommand struct {
 	Db                   *string  `protobuf:"bytes,1,req,name=Db" json:"Db,omitempty"`
 	Rp                   *string  `protobuf:"bytes,2,req,name=Rp" json:"Rp,omitempty"`
@@ -8807,7 +10293,7 @@ func (m *UpdateMeasurementCommand) Reset()         { *m = UpdateMeasurementComma
 func (m *UpdateMeasurementCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateMeasurementCommand) ProtoMessage()    {}
 func (*UpdateMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{137}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{156}
 }
 func (m *UpdateMeasurementCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateMeasurementCommand.Unmarshal(m, b)
@@ -8879,7 +10365,7 @@ func (m *DataOps) Reset()         { *m = DataOps{} }
 func (m *DataOps) String() string { return proto.CompactTextString(m) }
 func (*DataOps) ProtoMessage()    {}
 func (*DataOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{138}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{157}
 }
 func (m *DataOps) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataOps.Unmarshal(m, b)
@@ -8946,7 +10432,7 @@ func (m *CreateSqlNodeCommand) Reset()         { *m = CreateSqlNodeCommand{} }
 func (m *CreateSqlNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateSqlNodeCommand) ProtoMessage()    {}
 func (*CreateSqlNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{139}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{158}
 }
 func (m *CreateSqlNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSqlNodeCommand.Unmarshal(m, b)
@@ -9003,7 +10489,7 @@ func (m *UpdateSqlNodeStatusCommand) Reset()         { *m = UpdateSqlNodeStatusC
 func (m *UpdateSqlNodeStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateSqlNodeStatusCommand) ProtoMessage()    {}
 func (*UpdateSqlNodeStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{140}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{159}
 }
 func (m *UpdateSqlNodeStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateSqlNodeStatusCommand.Unmarshal(m, b)
@@ -9073,7 +10559,7 @@ func (m *UpdateNodeTmpIndexCommand) Reset()         { *m = UpdateNodeTmpIndexCom
 func (m *UpdateNodeTmpIndexCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateNodeTmpIndexCommand) ProtoMessage()    {}
 func (*UpdateNodeTmpIndexCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{141}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{160}
 }
 func (m *UpdateNodeTmpIndexCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNodeTmpIndexCommand.Unmarshal(m, b)
@@ -9145,7 +10631,7 @@ func (m *FileInfo) Reset()         { *m = FileInfo{} }
 func (m *FileInfo) String() string { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()    {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{142}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{161}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileInfo.Unmarshal(m, b)
@@ -9260,7 +10746,7 @@ func (m *InsertFilesCommand) Reset()         { *m = InsertFilesCommand{} }
 func (m *InsertFilesCommand) String() string { return proto.CompactTextString(m) }
 func (*InsertFilesCommand) ProtoMessage()    {}
 func (*InsertFilesCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{143}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{162}
 }
 func (m *InsertFilesCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InsertFilesCommand.Unmarshal(m, b)
@@ -9308,7 +10794,7 @@ func (m *ShowClusterCommand) Reset()         { *m = ShowClusterCommand{} }
 func (m *ShowClusterCommand) String() string { return proto.CompactTextString(m) }
 func (*ShowClusterCommand) ProtoMessage()    {}
 func (*ShowClusterCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{144}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{163}
 }
 func (m *ShowClusterCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShowClusterCommand.Unmarshal(m, b)
@@ -9366,7 +10852,7 @@ func (m *NodeRow) Reset()         { *m = NodeRow{} }
 func (m *NodeRow) String() string { return proto.CompactTextString(m) }
 func (*NodeRow) ProtoMessage()    {}
 func (*NodeRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{145}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{164}
 }
 func (m *NodeRow) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeRow.Unmarshal(m, b)
@@ -9439,7 +10925,7 @@ func (m *EventRow) Reset()         { *m = EventRow{} }
 func (m *EventRow) String() string { return proto.CompactTextString(m) }
 func (*EventRow) ProtoMessage()    {}
 func (*EventRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{146}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{165}
 }
 func (m *EventRow) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EventRow.Unmarshal(m, b)
@@ -9527,7 +11013,7 @@ func (m *ShowClusterInfo) Reset()         { *m = ShowClusterInfo{} }
 func (m *ShowClusterInfo) String() string { return proto.CompactTextString(m) }
 func (*ShowClusterInfo) ProtoMessage()    {}
 func (*ShowClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{147}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{166}
 }
 func (m *ShowClusterInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShowClusterInfo.Unmarshal(m, b)
@@ -9576,6 +11062,8 @@ func init() {
 	proto.RegisterType((*NodeInfo)(nil), "proto.NodeInfo")
 	proto.RegisterType((*DataNode)(nil), "proto.DataNode")
 	proto.RegisterType((*DatabaseInfo)(nil), "proto.DatabaseInfo")
+	proto.RegisterType((*ReplicationInfo)(nil), "proto.ReplicationInfo")
+	proto.RegisterType((*ReplicationCheckpoint)(nil), "proto.ReplicationCheckpoint")
 	proto.RegisterType((*RetentionPolicySpec)(nil), "proto.RetentionPolicySpec")
 	proto.RegisterType((*MeasurementInfo)(nil), "proto.MeasurementInfo")
 	proto.RegisterMapType((map[string]int32)(nil), "proto.MeasurementInfo.SchemaEntry")
@@ -9585,6 +11073,7 @@ func init() {
 	proto.RegisterType((*RetentionPolicyInfo)(nil), "proto.RetentionPolicyInfo")
 	proto.RegisterMapType((map[string]uint32)(nil), "proto.RetentionPolicyInfo.MstVersionsEntry")
 	proto.RegisterType((*ContinuousQueryInfo)(nil), "proto.ContinuousQueryInfo")
+	proto.RegisterType((*ContinuousQueryBackfillInfo)(nil), "proto.ContinuousQueryBackfillInfo")
 	proto.RegisterType((*ShardGroupInfo)(nil), "proto.ShardGroupInfo")
 	proto.RegisterType((*ShardInfo)(nil), "proto.ShardInfo")
 	proto.RegisterType((*ShardKeyInfo)(nil), "proto.ShardKeyInfo")
@@ -9592,6 +11081,9 @@ func init() {
 	proto.RegisterType((*SubscriptionInfo)(nil), "proto.SubscriptionInfo")
 	proto.RegisterType((*ShardOwner)(nil), "proto.ShardOwner")
 	proto.RegisterType((*UserInfo)(nil), "proto.UserInfo")
+	proto.RegisterType((*TenantInfo)(nil), "proto.TenantInfo")
+	proto.RegisterType((*DecommissionInfo)(nil), "proto.DecommissionInfo")
+	proto.RegisterType((*DecommissionPt)(nil), "proto.DecommissionPt")
 	proto.RegisterType((*UserPrivilege)(nil), "proto.UserPrivilege")
 	proto.RegisterType((*IndexRelation)(nil), "proto.IndexRelation")
 	proto.RegisterType((*IndexList)(nil), "proto.IndexList")
@@ -9666,6 +11158,18 @@ func init() {
 	proto.RegisterType((*UpdateDbPtStatusCommand)(nil), "proto.UpdateDbPtStatusCommand")
 	proto.RegisterExtension(E_ReShardingCommand_Command)
 	proto.RegisterType((*ReShardingCommand)(nil), "proto.ReShardingCommand")
+	proto.RegisterExtension(E_SplitShardCommand_Command)
+	proto.RegisterType((*SplitShardCommand)(nil), "proto.SplitShardCommand")
+	proto.RegisterExtension(E_SplitShardDoneCommand_Command)
+	proto.RegisterType((*SplitShardDoneCommand)(nil), "proto.SplitShardDoneCommand")
+	proto.RegisterExtension(E_CreateReplicationCommand_Command)
+	proto.RegisterType((*CreateReplicationCommand)(nil), "proto.CreateReplicationCommand")
+	proto.RegisterExtension(E_DropReplicationCommand_Command)
+	proto.RegisterType((*DropReplicationCommand)(nil), "proto.DropReplicationCommand")
+	proto.RegisterExtension(E_UpdateReplicationCheckpointCommand_Command)
+	proto.RegisterType((*UpdateReplicationCheckpointCommand)(nil), "proto.UpdateReplicationCheckpointCommand")
+	proto.RegisterExtension(E_RestoreDatabaseCommand_Command)
+	proto.RegisterType((*RestoreDatabaseCommand)(nil), "proto.RestoreDatabaseCommand")
 	proto.RegisterExtension(E_UpdateSchemaCommand_Command)
 	proto.RegisterType((*UpdateSchemaCommand)(nil), "proto.UpdateSchemaCommand")
 	proto.RegisterType((*FieldSchema)(nil), "proto.FieldSchema")
@@ -9766,6 +11270,20 @@ func init() {
 	proto.RegisterType((*GetContinuousQueryLeaseCommand)(nil), "proto.GetContinuousQueryLeaseCommand")
 	proto.RegisterExtension(E_DropContinuousQueryCommand_Command)
 	proto.RegisterType((*DropContinuousQueryCommand)(nil), "proto.DropContinuousQueryCommand")
+	proto.RegisterExtension(E_CreateContinuousQueryBackfillCommand_Command)
+	proto.RegisterType((*CreateContinuousQueryBackfillCommand)(nil), "proto.CreateContinuousQueryBackfillCommand")
+	proto.RegisterExtension(E_UpdateContinuousQueryBackfillCommand_Command)
+	proto.RegisterType((*UpdateContinuousQueryBackfillCommand)(nil), "proto.UpdateContinuousQueryBackfillCommand")
+	proto.RegisterExtension(E_CreateTenantCommand_Command)
+	proto.RegisterType((*CreateTenantCommand)(nil), "proto.CreateTenantCommand")
+	proto.RegisterExtension(E_DropTenantCommand_Command)
+	proto.RegisterType((*DropTenantCommand)(nil), "proto.DropTenantCommand")
+	proto.RegisterExtension(E_UpdateTenantMemberCommand_Command)
+	proto.RegisterType((*UpdateTenantMemberCommand)(nil), "proto.UpdateTenantMemberCommand")
+	proto.RegisterExtension(E_UpdateTenantUsageCommand_Command)
+	proto.RegisterType((*UpdateTenantUsageCommand)(nil), "proto.UpdateTenantUsageCommand")
+	proto.RegisterExtension(E_UpdateDecommissionCommand_Command)
+	proto.RegisterType((*UpdateDecommissionCommand)(nil), "proto.UpdateDecommissionCommand")
 	proto.RegisterExtension(E_NotifyCQLeaseChangedCommand_Command)
 	proto.RegisterType((*NotifyCQLeaseChangedCommand)(nil), "proto.NotifyCQLeaseChangedCommand")
 	proto.RegisterExtension(E_SetNodeSegregateStatusCommand_Command)
@@ -9776,6 +11294,7 @@ func init() {
 	proto.RegisterType((*UpdateReplicationCommand)(nil), "proto.UpdateReplicationCommand")
 	proto.RegisterType((*ObsOptions)(nil), "proto.ObsOptions")
 	proto.RegisterType((*Options)(nil), "proto.Options")
+	proto.RegisterMapType((map[string]float64)(nil), "proto.Options.ErrorBoundsEntry")
 	proto.RegisterExtension(E_UpdateMeasurementCommand_Command)
 	proto.RegisterType((*UpdateMeasurementCommand)(nil), "proto.UpdateMeasurementCommand")
 	proto.RegisterType((*DataOps)(nil), "proto.DataOps")
@@ -9798,464 +11317,528 @@ func init() {
 func init() { proto.RegisterFile("meta.proto", fileDescriptor_3b5ea8fe65782bcc) }
 
 var fileDescriptor_3b5ea8fe65782bcc = []byte{
-	// 7335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0x6b, 0x8c, 0x5c, 0xc9,
-	0x55, 0xb0, 0x6e, 0x3f, 0x66, 0x7a, 0x6a, 0xdc, 0xe3, 0x71, 0xf9, 0xb1, 0xed, 0x59, 0xdb, 0x3b,
-	0xbe, 0xf1, 0xee, 0x3a, 0xbb, 0x59, 0x6f, 0x76, 0x94, 0xec, 0x6e, 0x36, 0xc9, 0x26, 0x9e, 0x69,
-	0x3f, 0x3a, 0xeb, 0xf1, 0xb4, 0x6f, 0xcf, 0xda, 0xdf, 0x97, 0x0d, 0x61, 0xef, 0x4c, 0x97, 0x67,
-	0x6e, 0xa6, 0xa7, 0xbb, 0xf7, 0xde, 0x3b, 0xb6, 0xc7, 0x0a, 0xca, 0x26, 0x91, 0x12, 0x85, 0x08,
-	0x21, 0x84, 0xc8, 0x4b, 0x10, 0x20, 0x24, 0x01, 0x02, 0x01, 0x12, 0x12, 0xf2, 0x60, 0x13, 0xc8,
-	0x53, 0x11, 0x42, 0xfc, 0x03, 0xf1, 0x0b, 0x89, 0x5f, 0x48, 0x08, 0x10, 0xfc, 0xe1, 0x21, 0x81,
-	0x84, 0xce, 0xa9, 0xf7, 0xbd, 0x75, 0xef, 0xd8, 0x16, 0xce, 0xaf, 0xbe, 0x75, 0x4e, 0x3d, 0x4e,
-	0x9d, 0x3a, 0x75, 0xea, 0xd4, 0xa9, 0x53, 0xd5, 0x84, 0x6c, 0xb3, 0x34, 0x3c, 0x33, 0x8e, 0x47,
-	0xe9, 0x88, 0xd6, 0xf1, 0xc7, 0xff, 0xcf, 0x69, 0x52, 0x6b, 0x87, 0x69, 0x48, 0x29, 0xa9, 0xad,
-	0xb2, 0x78, 0xbb, 0xe5, 0xcd, 0x57, 0x4e, 0xd7, 0x02, 0xfc, 0xa6, 0x87, 0x48, 0xbd, 0x33, 0xec,
-	0xb3, 0x5b, 0xad, 0x0a, 0x02, 0x79, 0x82, 0x1e, 0x23, 0x53, 0x4b, 0x83, 0x9d, 0x24, 0x65, 0x71,
-	0xa7, 0xdd, 0xaa, 0x22, 0x46, 0x03, 0xe8, 0xc3, 0xa4, 0x7e, 0x79, 0xd4, 0x67, 0x49, 0xab, 0x36,
-	0x5f, 0x3d, 0x3d, 0xbd, 0xb0, 0x9f, 0x37, 0x77, 0x06, 0x60, 0x9d, 0xe1, 0xf5, 0x51, 0xc0, 0xb1,
-	0xf4, 0x29, 0x32, 0x05, 0xcd, 0xae, 0x85, 0x09, 0x4b, 0x5a, 0x75, 0xcc, 0x7a, 0x50, 0x64, 0x95,
-	0x70, 0xcc, 0xae, 0x73, 0x41, 0xcd, 0x2f, 0x26, 0x2c, 0x4e, 0x5a, 0x13, 0x56, 0xcd, 0x00, 0xe3,
-	0x35, 0x23, 0x16, 0xc8, 0x5b, 0x0e, 0x6f, 0x61, 0x7b, 0xed, 0xd6, 0x24, 0x27, 0x4f, 0x01, 0xe8,
-	0x69, 0xb2, 0x7f, 0x39, 0xbc, 0xd5, 0xdb, 0x0c, 0xe3, 0xfe, 0x85, 0x78, 0xb4, 0x33, 0xee, 0xb4,
-	0x5b, 0x0d, 0xcc, 0x93, 0x05, 0xd3, 0x13, 0x84, 0x48, 0x50, 0xa7, 0xdd, 0x9a, 0xc2, 0x4c, 0x06,
-	0x84, 0x3e, 0xc1, 0x7b, 0xc0, 0x3b, 0x4b, 0x2c, 0x92, 0x24, 0x3c, 0xd0, 0x39, 0x20, 0xfb, 0x32,
-	0x93, 0xd9, 0xa7, 0xdd, 0xbc, 0xd1, 0x39, 0xa8, 0x4f, 0xf6, 0x09, 0x9e, 0x76, 0xd3, 0xcb, 0x3b,
-	0xdb, 0xad, 0x99, 0xf9, 0xca, 0xe9, 0x66, 0x60, 0xc1, 0xe8, 0x93, 0x64, 0xa2, 0x9b, 0x5e, 0x8d,
-	0xd8, 0xcd, 0xd6, 0x7e, 0xac, 0xef, 0x01, 0xa3, 0xf9, 0x33, 0x1c, 0x73, 0x6e, 0x98, 0xc6, 0xbb,
-	0x81, 0xc8, 0x06, 0x95, 0x62, 0xc9, 0x2e, 0x8b, 0xa1, 0x95, 0xd6, 0xec, 0xbc, 0x07, 0x95, 0x9a,
-	0x30, 0xc1, 0x20, 0x1c, 0x69, 0xc9, 0xa0, 0x03, 0x8a, 0x41, 0x26, 0x58, 0x30, 0x08, 0x41, 0x9d,
-	0x76, 0x8b, 0x2a, 0x06, 0x09, 0x08, 0xb4, 0xb6, 0x1c, 0xde, 0x3a, 0x77, 0x83, 0x0d, 0xd3, 0x95,
-	0x71, 0xa7, 0xdf, 0x3a, 0x38, 0xef, 0x9d, 0xae, 0x05, 0x16, 0x0c, 0x5a, 0x5b, 0x0d, 0xb7, 0xd8,
-	0xca, 0x0d, 0x16, 0x9f, 0x1b, 0x86, 0x6b, 0x03, 0xd6, 0x6f, 0x1d, 0x9a, 0xf7, 0x4e, 0x37, 0x82,
-	0x2c, 0x98, 0xbe, 0x9d, 0x34, 0x97, 0xa3, 0x8d, 0x38, 0x4c, 0x19, 0x96, 0x4e, 0x5a, 0x87, 0xad,
-	0x3e, 0x9b, 0x38, 0xe4, 0xa5, 0x9d, 0x1b, 0x1a, 0x5a, 0x0c, 0x07, 0xe1, 0x70, 0x5d, 0x37, 0x74,
-	0x84, 0x37, 0x94, 0x01, 0x0b, 0x06, 0xb4, 0x47, 0x37, 0x87, 0xbd, 0x70, 0x7b, 0x3c, 0x00, 0x29,
-	0x7a, 0x00, 0x29, 0xcf, 0x82, 0xe9, 0xe3, 0x64, 0xb2, 0x97, 0xc6, 0x2c, 0xdc, 0x4e, 0x5a, 0x2d,
-	0x24, 0xe6, 0x80, 0x20, 0x86, 0x43, 0x91, 0x0c, 0x99, 0x83, 0xce, 0x93, 0x69, 0x10, 0x1e, 0x8e,
-	0x69, 0xb7, 0x8e, 0x62, 0x95, 0x26, 0x48, 0x08, 0xee, 0xd2, 0x68, 0x38, 0xec, 0xf4, 0x5b, 0x73,
-	0x88, 0xd7, 0x00, 0xfa, 0x3c, 0x99, 0xbe, 0xb2, 0xc3, 0xe2, 0xdd, 0x4e, 0xbb, 0x33, 0x8c, 0xd2,
-	0xd6, 0x83, 0xd8, 0xe0, 0x31, 0x73, 0xc4, 0x0d, 0x34, 0x1f, 0x76, 0xb3, 0x00, 0x6d, 0x93, 0x66,
-	0xc0, 0xc6, 0x83, 0x68, 0x3d, 0xc4, 0xf1, 0x4b, 0x5a, 0xc7, 0xb0, 0x86, 0x13, 0x66, 0x0d, 0x56,
-	0x06, 0x5e, 0x87, 0x5d, 0x88, 0xbe, 0x81, 0x1c, 0x00, 0x92, 0x77, 0xd6, 0x92, 0xf5, 0x38, 0x1a,
-	0xa7, 0xd1, 0x68, 0xd8, 0x69, 0xb7, 0x8e, 0x23, 0xad, 0x79, 0x04, 0x3d, 0x45, 0x9a, 0xd0, 0x81,
-	0x2b, 0x4b, 0x9b, 0xe1, 0x70, 0x03, 0x18, 0x79, 0x02, 0x73, 0xda, 0x40, 0xe0, 0xcc, 0xe5, 0x9d,
-	0xed, 0x95, 0xeb, 0x38, 0xb1, 0x92, 0xd6, 0x43, 0xf3, 0xde, 0xe9, 0x7a, 0x60, 0x82, 0x60, 0x48,
-	0x3a, 0x49, 0xef, 0xca, 0xa5, 0x28, 0x65, 0x72, 0xf0, 0xe6, 0xf9, 0xe0, 0x65, 0xc0, 0xf4, 0x71,
-	0xd2, 0xe8, 0xbd, 0x32, 0xe0, 0x93, 0xec, 0xa4, 0x7b, 0x4e, 0xaa, 0x0c, 0x74, 0x8e, 0x34, 0x96,
-	0xc3, 0x5b, 0xcb, 0x49, 0xda, 0x69, 0xb7, 0x7c, 0xa4, 0x4c, 0xa5, 0xe7, 0xde, 0x45, 0xa6, 0x8d,
-	0x19, 0x44, 0x67, 0x49, 0x75, 0x8b, 0xed, 0xb6, 0xbc, 0x79, 0xef, 0xf4, 0x54, 0x00, 0x9f, 0xa0,
-	0x8d, 0x6e, 0x84, 0x83, 0x1d, 0xd6, 0xaa, 0xcc, 0x7b, 0x66, 0x33, 0x8b, 0x5d, 0x2e, 0x7f, 0x1c,
-	0xfb, 0x5c, 0xe5, 0x59, 0x6f, 0xee, 0x79, 0x32, 0x9b, 0x1d, 0x1b, 0x47, 0x85, 0x87, 0xcc, 0x0a,
-	0x6b, 0x66, 0xf9, 0x17, 0x09, 0xcd, 0x8f, 0x8c, 0xa3, 0x86, 0xd7, 0xdb, 0x24, 0x49, 0x7d, 0x2a,
-	0xca, 0xc2, 0x98, 0x24, 0x46, 0xb5, 0xfe, 0x5b, 0xc9, 0x3e, 0x13, 0x45, 0x1f, 0x27, 0x13, 0x42,
-	0x34, 0x3c, 0x4b, 0x1f, 0x9b, 0x6d, 0x07, 0x22, 0x8b, 0xff, 0x31, 0x4f, 0x95, 0x46, 0x08, 0x9d,
-	0x21, 0x95, 0x4e, 0x1b, 0x57, 0x8f, 0x66, 0x50, 0xe9, 0xb4, 0x39, 0x73, 0xc5, 0x22, 0x51, 0x41,
-	0xa8, 0x4a, 0xd3, 0x93, 0xa4, 0xde, 0x65, 0xa0, 0xc9, 0xab, 0xd8, 0xd0, 0xb4, 0x68, 0x08, 0x60,
-	0x01, 0xc7, 0xd0, 0x23, 0x64, 0xa2, 0x97, 0x86, 0xe9, 0x0e, 0xac, 0x23, 0x50, 0x58, 0xa4, 0xd4,
-	0x32, 0x55, 0xd7, 0xcb, 0x94, 0xff, 0x18, 0xa9, 0x41, 0xa1, 0x1c, 0x09, 0x94, 0xd4, 0x82, 0xd1,
-	0x80, 0x89, 0xe6, 0xf1, 0xdb, 0x3f, 0x49, 0x26, 0xbb, 0xe9, 0xca, 0xcd, 0x21, 0x8b, 0xa1, 0x09,
-	0xb1, 0x4a, 0xf0, 0x35, 0x4f, 0xa4, 0xfc, 0x57, 0x3d, 0xd0, 0xab, 0x30, 0x88, 0xf4, 0x14, 0xa9,
-	0x63, 0x5e, 0xcc, 0x31, 0xbd, 0x30, 0x23, 0x09, 0xe5, 0x35, 0x04, 0x75, 0x55, 0x91, 0xa0, 0xb5,
-	0x92, 0xa5, 0xb5, 0x9b, 0x76, 0xfa, 0xb8, 0x46, 0x36, 0x03, 0xfc, 0x86, 0x51, 0xbb, 0xca, 0xe2,
-	0x56, 0x0d, 0xc7, 0x18, 0x3e, 0x91, 0xca, 0x0b, 0x9d, 0x76, 0xab, 0x8e, 0xca, 0x18, 0xbf, 0xfd,
-	0x27, 0x48, 0x43, 0x0a, 0x12, 0x3d, 0x49, 0x6a, 0xed, 0xb5, 0x6e, 0x2a, 0x06, 0xa5, 0xa9, 0x48,
-	0x40, 0x29, 0x43, 0x94, 0xff, 0x2f, 0x1e, 0x69, 0xc8, 0x45, 0xc4, 0xe0, 0x42, 0x4d, 0x72, 0xe1,
-	0xe2, 0x28, 0x49, 0x91, 0xb6, 0xa9, 0x00, 0xbf, 0x69, 0x8b, 0x4c, 0x06, 0xdd, 0xa5, 0xb3, 0xfd,
-	0x7e, 0x8c, 0xcd, 0x4e, 0x05, 0x32, 0x09, 0x98, 0xd5, 0xa5, 0x2e, 0x16, 0xa8, 0x72, 0x8c, 0x48,
-	0x66, 0x46, 0xa4, 0xaa, 0x7a, 0x79, 0x88, 0xd4, 0x2f, 0xad, 0x46, 0xdb, 0xac, 0x35, 0xc1, 0x8d,
-	0x04, 0x4c, 0xc0, 0xe2, 0x70, 0x61, 0x94, 0x24, 0xd1, 0x18, 0x1b, 0x99, 0xc4, 0xb6, 0x0d, 0x08,
-	0x4c, 0xe9, 0x1e, 0xdb, 0x88, 0xd9, 0x46, 0x98, 0x32, 0x51, 0x6d, 0x83, 0x6b, 0xd9, 0x0c, 0x58,
-	0x8d, 0x22, 0x41, 0x72, 0xf8, 0x28, 0xee, 0x90, 0x86, 0x9c, 0xcf, 0xf4, 0x21, 0x52, 0xb9, 0x1c,
-	0x89, 0x01, 0xca, 0xad, 0xa8, 0x95, 0xcb, 0x11, 0x10, 0x8e, 0x3a, 0xb4, 0x2d, 0x66, 0x96, 0x48,
-	0x81, 0xde, 0x39, 0x3b, 0x88, 0x6e, 0x30, 0x81, 0xac, 0x72, 0x8d, 0x6c, 0x80, 0x80, 0x95, 0x67,
-	0x6f, 0xe3, 0x58, 0x4d, 0x05, 0x95, 0xb3, 0xb7, 0xfd, 0xaf, 0x56, 0xc9, 0x3e, 0xd3, 0x3a, 0x01,
-	0xda, 0x2e, 0x87, 0xdb, 0x0c, 0x5b, 0x9f, 0x0a, 0xf0, 0x9b, 0x3e, 0x4d, 0x8e, 0xb4, 0xd9, 0xf5,
-	0x70, 0x67, 0x90, 0x06, 0x2c, 0x65, 0x43, 0x98, 0x5b, 0xdd, 0xd1, 0x20, 0x5a, 0xdf, 0x15, 0x23,
-	0x50, 0x80, 0xa5, 0x17, 0xc9, 0x01, 0x1b, 0x14, 0x31, 0x39, 0x41, 0xe6, 0xd4, 0x4c, 0xb4, 0x8a,
-	0x60, 0x0f, 0xf3, 0x85, 0xa0, 0xa6, 0xa5, 0xd1, 0x30, 0x8d, 0x86, 0x3b, 0xa3, 0x9d, 0x04, 0x34,
-	0x4f, 0xa4, 0xcc, 0x31, 0x59, 0x93, 0x8d, 0x17, 0x35, 0xe5, 0x0a, 0xf1, 0x45, 0x2b, 0xde, 0x6a,
-	0xb3, 0x01, 0x4b, 0x59, 0x1f, 0x65, 0xa5, 0x11, 0x98, 0x20, 0xfa, 0x24, 0x69, 0xa0, 0x92, 0x7e,
-	0x81, 0xed, 0xb6, 0x26, 0x2c, 0xb5, 0x23, 0xc1, 0x58, 0xb7, 0xca, 0x44, 0x1f, 0x21, 0x33, 0x5c,
-	0x59, 0xaf, 0x86, 0x1b, 0x67, 0xe3, 0x38, 0xdc, 0x6d, 0x4d, 0x62, 0xad, 0x19, 0x28, 0xe8, 0x0f,
-	0xa1, 0x5f, 0x2e, 0xa3, 0x64, 0x54, 0x03, 0x95, 0x86, 0x85, 0x77, 0x05, 0xd7, 0x18, 0xb0, 0x02,
-	0x3c, 0x63, 0xe1, 0x5d, 0x59, 0x4b, 0x04, 0x22, 0x90, 0x39, 0xfc, 0xaf, 0x7b, 0xe4, 0x60, 0x86,
-	0x71, 0xbd, 0x31, 0x5b, 0x37, 0xc6, 0xce, 0x53, 0x63, 0x37, 0x47, 0x1a, 0xed, 0x9d, 0x18, 0xf5,
-	0x21, 0x0a, 0x4b, 0x35, 0x50, 0x69, 0x7a, 0x86, 0x50, 0x6d, 0x1f, 0xaa, 0x5c, 0x55, 0xcc, 0xe5,
-	0xc0, 0x58, 0x1d, 0xa8, 0xe1, 0xdc, 0xd6, 0x1d, 0xf0, 0xc9, 0xbe, 0x6b, 0x61, 0xbc, 0xad, 0x6a,
-	0xa9, 0x63, 0x2d, 0x16, 0xcc, 0xff, 0x87, 0x09, 0xb2, 0x7f, 0x99, 0x85, 0xc9, 0x4e, 0xcc, 0xb6,
-	0x85, 0x51, 0xe3, 0x94, 0xb7, 0xa7, 0xc8, 0x94, 0x64, 0x2e, 0x28, 0xa0, 0x6a, 0xd1, 0x10, 0xe8,
-	0x5c, 0xf4, 0x39, 0x32, 0xd1, 0x5b, 0xdf, 0x64, 0xdb, 0xa1, 0x90, 0x2f, 0x5f, 0x1a, 0x51, 0x76,
-	0x73, 0x67, 0x78, 0x26, 0x61, 0x43, 0xf2, 0x44, 0x56, 0x24, 0x6a, 0x79, 0x91, 0x78, 0x8e, 0x34,
-	0x23, 0x30, 0x01, 0x03, 0x36, 0xd0, 0xbd, 0x9b, 0x5e, 0x38, 0x24, 0x1a, 0xe9, 0x98, 0xb8, 0xc0,
-	0xce, 0x0a, 0x6a, 0xe3, 0xdc, 0x70, 0x23, 0x1a, 0xb2, 0xd5, 0xdd, 0x31, 0x43, 0x81, 0x6a, 0x06,
-	0x06, 0x84, 0x3e, 0x43, 0xf6, 0x2d, 0x8d, 0x06, 0xbd, 0x74, 0x14, 0xe3, 0x04, 0x44, 0xd9, 0xd1,
-	0xfd, 0x35, 0x51, 0x81, 0x95, 0x91, 0x3e, 0x45, 0x88, 0x16, 0x0e, 0x14, 0x28, 0xa7, 0xd4, 0x18,
-	0x99, 0xe8, 0x79, 0x42, 0xb8, 0xad, 0xdf, 0xbf, 0xc5, 0x92, 0xd6, 0x14, 0x72, 0xea, 0x91, 0x22,
-	0x4e, 0xa9, 0x8c, 0x9c, 0x5b, 0x46, 0x49, 0xb4, 0x5e, 0x86, 0x51, 0x6a, 0xda, 0x38, 0x04, 0x6d,
-	0x9c, 0x2c, 0x58, 0xa8, 0xee, 0x69, 0x54, 0x44, 0x15, 0xdc, 0xac, 0x64, 0xe4, 0x5c, 0x2e, 0x40,
-	0x59, 0x21, 0xa7, 0x2f, 0x91, 0x03, 0x7c, 0x7c, 0x5e, 0x4c, 0xd8, 0xf9, 0x51, 0xbc, 0x34, 0x60,
-	0xe1, 0xb0, 0x75, 0x04, 0x49, 0x7e, 0xa2, 0x74, 0x70, 0x8d, 0xfc, 0x9c, 0xf2, 0x7c, 0x3d, 0x73,
-	0x6f, 0x21, 0xd3, 0x86, 0x24, 0xec, 0x65, 0xba, 0xd4, 0x4d, 0xd3, 0xe5, 0x05, 0xb2, 0x3f, 0xc3,
-	0x1a, 0xb3, 0x78, 0x8d, 0x17, 0xf7, 0x6d, 0xbb, 0x65, 0x9f, 0x14, 0x14, 0x28, 0x63, 0x56, 0x76,
-	0x95, 0x1c, 0x71, 0x13, 0xed, 0x20, 0xe9, 0x11, 0xbb, 0xce, 0x59, 0x39, 0x23, 0xb0, 0xfc, 0xd5,
-	0x70, 0x60, 0x1a, 0x42, 0xcf, 0x90, 0x29, 0x05, 0x87, 0xaa, 0x56, 0x77, 0xc7, 0x38, 0xc3, 0xea,
-	0x01, 0x7c, 0xc2, 0x92, 0x78, 0x6e, 0xd8, 0xc7, 0x25, 0x8e, 0xf7, 0x4f, 0x26, 0xfd, 0x7f, 0xaf,
-	0xe7, 0x54, 0x4b, 0xe1, 0x34, 0xb5, 0x55, 0x4b, 0xe5, 0x8e, 0x54, 0x4b, 0xe5, 0x8e, 0x54, 0x4b,
-	0xc5, 0x52, 0x2d, 0xcf, 0x91, 0x7d, 0xc6, 0x48, 0xcb, 0xbd, 0xf5, 0x11, 0xb7, 0x10, 0x04, 0x56,
-	0x5e, 0xba, 0x4c, 0xa6, 0x97, 0x93, 0xf4, 0x2a, 0x8b, 0x13, 0x94, 0xb9, 0x19, 0x2c, 0xfa, 0x78,
-	0xf1, 0xe2, 0x73, 0xc6, 0xc8, 0x2d, 0xb6, 0x1c, 0x06, 0x84, 0x3e, 0x43, 0xa6, 0x35, 0xf1, 0x72,
-	0xdb, 0x7e, 0xd8, 0xd4, 0x4d, 0x7c, 0x2b, 0x09, 0x84, 0x98, 0x39, 0x61, 0xaf, 0x67, 0xee, 0x24,
-	0x92, 0xd6, 0xa4, 0xb5, 0xd7, 0xb3, 0x76, 0x19, 0xb8, 0xd7, 0xb3, 0x72, 0x67, 0x55, 0x54, 0x23,
-	0xaf, 0xa2, 0xe6, 0xc9, 0xf4, 0xc5, 0x51, 0xaa, 0x38, 0x3d, 0x85, 0x9c, 0x36, 0x41, 0x39, 0x0d,
-	0x4d, 0x30, 0x8b, 0x05, 0x83, 0x61, 0xd3, 0x1b, 0x62, 0x95, 0x73, 0x9a, 0x0f, 0x5b, 0x1e, 0x03,
-	0xfc, 0xd0, 0xd0, 0xa4, 0xb5, 0xcf, 0xe2, 0x87, 0xb1, 0xb5, 0x46, 0x7e, 0x18, 0x39, 0xe9, 0x0a,
-	0x39, 0xa4, 0x37, 0x9e, 0x9a, 0xfd, 0xad, 0x26, 0xca, 0xf6, 0x83, 0x72, 0xeb, 0xe1, 0xc8, 0x12,
-	0x38, 0x0b, 0xc2, 0x8e, 0x24, 0x3b, 0x74, 0x7b, 0x4d, 0xeb, 0xa6, 0x39, 0x63, 0x42, 0x72, 0xd0,
-	0x61, 0x41, 0x38, 0xe5, 0xfe, 0x10, 0xa9, 0x63, 0x06, 0x61, 0xfd, 0xf0, 0x04, 0x0c, 0xc0, 0xa5,
-	0x30, 0x49, 0x83, 0x9d, 0x21, 0xce, 0x2b, 0xbe, 0x8a, 0x9a, 0x20, 0xff, 0xbf, 0x3d, 0x32, 0x63,
-	0xcb, 0x48, 0xce, 0xb2, 0x3d, 0x46, 0xa6, 0x7a, 0x69, 0x18, 0xa7, 0x62, 0x6a, 0x02, 0xdb, 0x35,
-	0xc0, 0x9c, 0xb6, 0x7c, 0x26, 0xc9, 0x24, 0x94, 0x13, 0x82, 0x70, 0x36, 0x15, 0xc6, 0xac, 0x06,
-	0xd0, 0xd3, 0x64, 0x42, 0x68, 0x69, 0x3e, 0x75, 0x66, 0x4d, 0x81, 0x45, 0x9e, 0x0a, 0x3c, 0x74,
-	0x62, 0x35, 0xde, 0x19, 0xae, 0x87, 0xbc, 0xa6, 0x09, 0xde, 0x09, 0x03, 0x94, 0x59, 0xce, 0x26,
-	0x73, 0xcb, 0x59, 0x8b, 0x4c, 0xde, 0xe0, 0x83, 0xd0, 0xda, 0x87, 0x48, 0x99, 0xf4, 0x3f, 0x59,
-	0x11, 0xcb, 0xba, 0xb3, 0xe7, 0x27, 0x48, 0x03, 0xb7, 0x1e, 0x9d, 0x36, 0x5f, 0xf2, 0x9b, 0x8b,
-	0x95, 0x96, 0x17, 0x28, 0x18, 0x8c, 0xe5, 0x72, 0xc4, 0x35, 0xc8, 0x54, 0x00, 0x9f, 0x08, 0x09,
-	0x6f, 0x61, 0x6f, 0x01, 0x12, 0xde, 0xc2, 0x9d, 0x54, 0xc4, 0x62, 0xb5, 0x93, 0x8a, 0x18, 0x5a,
-	0xff, 0xd2, 0x9f, 0xc3, 0xad, 0x79, 0x99, 0x84, 0x45, 0x4c, 0x4b, 0xd2, 0x25, 0x76, 0x83, 0x0d,
-	0xd0, 0xa8, 0xaf, 0x06, 0x59, 0x30, 0xcc, 0x1c, 0xcb, 0x79, 0xc2, 0xcd, 0x7a, 0x0b, 0xc6, 0x15,
-	0x58, 0xd8, 0x5f, 0x19, 0x0e, 0x76, 0x5b, 0x53, 0x38, 0x3d, 0x55, 0x9a, 0xbb, 0x95, 0xe4, 0x54,
-	0xc5, 0x95, 0xb2, 0x11, 0x18, 0x10, 0x3f, 0x20, 0xfb, 0x4c, 0xbb, 0x06, 0xea, 0x52, 0x16, 0x28,
-	0xec, 0x91, 0xa6, 0x0c, 0x63, 0x13, 0xfa, 0x08, 0x9c, 0xaf, 0x70, 0x1b, 0x0f, 0x79, 0x4e, 0x49,
-	0xad, 0xb7, 0xa1, 0xec, 0x7d, 0xfc, 0xf6, 0x8f, 0x92, 0x3a, 0x5f, 0xab, 0x67, 0x49, 0xb5, 0xd3,
-	0xbf, 0x85, 0xf5, 0xd4, 0x03, 0xf8, 0xf4, 0xdf, 0x4b, 0x66, 0xb3, 0xfa, 0xc6, 0x29, 0xe7, 0x94,
-	0xd4, 0x96, 0x47, 0x7d, 0x26, 0xb7, 0x59, 0xf0, 0x8d, 0xac, 0x60, 0x49, 0x1a, 0x0d, 0xf9, 0x0e,
-	0x1b, 0xad, 0xad, 0xa9, 0xc0, 0x82, 0xf9, 0xa7, 0x84, 0x95, 0x51, 0xbe, 0x27, 0xfd, 0x84, 0x47,
-	0x1a, 0xd2, 0xd1, 0x59, 0xd4, 0xfc, 0xc5, 0x30, 0xd9, 0x54, 0xbb, 0xbc, 0x30, 0xd9, 0x84, 0xa9,
-	0x77, 0xb6, 0xbf, 0x2d, 0xe4, 0xa0, 0x11, 0xf0, 0x04, 0x34, 0x11, 0xdc, 0x84, 0xba, 0x84, 0xed,
-	0x26, 0x52, 0xf4, 0x4d, 0x84, 0x74, 0xe3, 0xe8, 0x46, 0x34, 0x60, 0x1b, 0xca, 0x25, 0x7b, 0xc8,
-	0xf0, 0xb1, 0x2a, 0x64, 0x60, 0xe4, 0xf3, 0x3b, 0xa4, 0x69, 0x21, 0x71, 0x9d, 0x13, 0x5b, 0x24,
-	0x41, 0xa0, 0x4a, 0xc3, 0xc4, 0x53, 0x19, 0x91, 0xd2, 0x7a, 0xa0, 0x01, 0xfe, 0x6b, 0x1e, 0x69,
-	0x5a, 0xc6, 0x21, 0x8c, 0x46, 0x10, 0xf5, 0xc5, 0x8e, 0x1e, 0x3e, 0x01, 0xb2, 0x12, 0xf5, 0xb9,
-	0xcc, 0x07, 0xf0, 0x09, 0x75, 0x62, 0x21, 0xe4, 0x08, 0x67, 0xb0, 0x06, 0xd0, 0x37, 0x12, 0x82,
-	0x89, 0x4b, 0x51, 0x92, 0xca, 0x3d, 0xd0, 0xac, 0xa9, 0x71, 0x01, 0x11, 0x18, 0x79, 0xc0, 0xc2,
-	0xc4, 0x94, 0x34, 0xbc, 0x6c, 0xdf, 0xb4, 0x89, 0x0a, 0xac, 0x8c, 0xfe, 0x49, 0x41, 0x08, 0x54,
-	0x83, 0x9e, 0x73, 0xf8, 0x10, 0x12, 0xc9, 0x13, 0x7e, 0x9f, 0xb4, 0x82, 0xb1, 0xb9, 0xe2, 0x9e,
-	0x8f, 0xd8, 0xa0, 0x9f, 0xe0, 0xa0, 0x5e, 0x24, 0xb3, 0x99, 0xc5, 0x59, 0xfa, 0x61, 0x8e, 0xe5,
-	0xd7, 0x6e, 0x5d, 0x2e, 0xc8, 0x95, 0xf2, 0x47, 0xe4, 0xb0, 0x33, 0x2b, 0xcc, 0xee, 0xe5, 0x24,
-	0x35, 0x44, 0x47, 0x26, 0xe9, 0xdb, 0x08, 0x81, 0xb9, 0xc1, 0xf3, 0x8a, 0x4d, 0x84, 0xa3, 0x59,
-	0x9d, 0x27, 0x30, 0xf2, 0xfb, 0x4b, 0x56, 0x83, 0x1a, 0x01, 0xa2, 0x26, 0xaa, 0xe4, 0x6c, 0x10,
-	0x29, 0x63, 0x5a, 0x82, 0x06, 0xc1, 0x6f, 0xff, 0xe3, 0x15, 0x42, 0xb4, 0xdf, 0xd4, 0x29, 0xe3,
-	0x5c, 0x0b, 0x56, 0x94, 0x16, 0x7c, 0x13, 0x99, 0xe8, 0xc5, 0xeb, 0xcb, 0xe8, 0xaa, 0xa8, 0x18,
-	0x14, 0xf3, 0x6a, 0xb2, 0xa6, 0x8e, 0xc8, 0x0b, 0xa5, 0xda, 0x2c, 0x81, 0x52, 0xb5, 0x3b, 0x29,
-	0xc5, 0xf3, 0x82, 0x58, 0x77, 0x86, 0x29, 0x8b, 0x6f, 0x84, 0x03, 0xd4, 0x98, 0xd5, 0x40, 0xa5,
-	0x61, 0xb0, 0xdb, 0x6c, 0x10, 0xee, 0xa2, 0xce, 0xac, 0x06, 0x3c, 0x01, 0x3d, 0x68, 0x47, 0xdb,
-	0xdc, 0x76, 0x99, 0x0a, 0xf0, 0x9b, 0x3e, 0x4a, 0xea, 0x4b, 0xe1, 0x60, 0x00, 0x1b, 0x90, 0xbc,
-	0xbf, 0x18, 0x30, 0x01, 0xc7, 0xfb, 0x4f, 0x93, 0x69, 0xcd, 0x0c, 0x2c, 0x67, 0x4a, 0x84, 0xc3,
-	0xcf, 0xcc, 0xf1, 0xfe, 0x2b, 0xe4, 0xb0, 0xb3, 0x1f, 0x85, 0x26, 0xa9, 0x9c, 0xaa, 0x95, 0xcc,
-	0x54, 0x3d, 0x4d, 0xf6, 0x67, 0xdd, 0x17, 0x7c, 0x35, 0xc9, 0x82, 0xfd, 0x4b, 0x72, 0xdc, 0x80,
-	0x72, 0x68, 0x07, 0x7e, 0x65, 0x3b, 0x08, 0x3b, 0x44, 0xea, 0x38, 0xf0, 0xd2, 0x04, 0xc0, 0x04,
-	0x6a, 0xa7, 0x41, 0x14, 0x26, 0xa2, 0x5e, 0x9e, 0xf0, 0xff, 0xd1, 0xb3, 0x77, 0x78, 0xb0, 0x1c,
-	0x74, 0xe3, 0x68, 0x3b, 0x8c, 0x77, 0xb5, 0x82, 0x37, 0x20, 0x20, 0xd4, 0xbd, 0x51, 0x9c, 0x02,
-	0xb2, 0x82, 0x48, 0x99, 0x84, 0xe5, 0xb9, 0x1b, 0x8f, 0xc6, 0x2c, 0x4e, 0xb1, 0x28, 0xd7, 0x0d,
-	0x26, 0x88, 0x9e, 0x22, 0x4d, 0x99, 0xbc, 0x8a, 0x86, 0x4e, 0x0d, 0xf3, 0xd8, 0x40, 0xfa, 0x46,
-	0x72, 0x10, 0xcc, 0x06, 0x71, 0xf4, 0x92, 0xd9, 0xb3, 0xbb, 0x50, 0xf4, 0x11, 0x32, 0xb3, 0x34,
-	0xda, 0x1e, 0x87, 0xeb, 0x90, 0x52, 0x3b, 0xd9, 0x7a, 0x90, 0x81, 0xfa, 0x37, 0x85, 0x41, 0xc8,
-	0x55, 0x08, 0x4c, 0x97, 0xd5, 0xd1, 0x16, 0x1b, 0x26, 0xc2, 0x08, 0x13, 0x29, 0x60, 0x01, 0x7e,
-	0x45, 0xb7, 0x59, 0x9c, 0x88, 0xb5, 0xcc, 0x80, 0x14, 0x11, 0x58, 0x2d, 0x24, 0xd0, 0x7f, 0xd6,
-	0x56, 0x72, 0xf4, 0xb4, 0x2d, 0x5f, 0x34, 0xaf, 0xed, 0xa4, 0x80, 0xfd, 0xf8, 0x00, 0x99, 0x5c,
-	0x1a, 0x6d, 0x6f, 0x87, 0xc3, 0x3e, 0x7d, 0x94, 0xd4, 0x52, 0xe8, 0x1c, 0x8c, 0xf5, 0x8c, 0xb1,
-	0x09, 0x47, 0xec, 0x19, 0xe8, 0x61, 0x80, 0x19, 0xfc, 0x8f, 0x1e, 0xe0, 0x13, 0x9e, 0x1e, 0x25,
-	0x87, 0x97, 0x62, 0x16, 0xa6, 0x4c, 0xca, 0x99, 0xc8, 0x3c, 0x5b, 0xa5, 0x0f, 0x90, 0x83, 0xed,
-	0x78, 0x34, 0xce, 0x22, 0x6a, 0x74, 0x9e, 0x1c, 0xe3, 0x65, 0x32, 0x82, 0x27, 0x73, 0xd4, 0xe9,
-	0x09, 0x32, 0x07, 0x45, 0x0b, 0xf0, 0x13, 0xf4, 0x14, 0x99, 0xef, 0xb1, 0xd4, 0xed, 0x76, 0x93,
-	0xb9, 0x26, 0xa1, 0x9d, 0x17, 0xc7, 0xfd, 0xe2, 0x76, 0x1a, 0xf4, 0x41, 0xf2, 0x00, 0xa7, 0x44,
-	0xdb, 0xa5, 0x12, 0x39, 0x05, 0x48, 0x6e, 0xa0, 0xe4, 0x91, 0x84, 0x1e, 0x26, 0x07, 0x78, 0x49,
-	0x58, 0x2b, 0x25, 0xb8, 0x49, 0x0f, 0x92, 0xfd, 0x40, 0xb8, 0x09, 0x9c, 0x81, 0xbc, 0x9c, 0x0e,
-	0x13, 0xbc, 0x1f, 0xf8, 0xd3, 0x63, 0xa9, 0x5a, 0x2d, 0x25, 0x62, 0x96, 0x52, 0x32, 0x03, 0xbd,
-	0x0b, 0xd3, 0x50, 0xc2, 0x0e, 0xd0, 0x63, 0xa4, 0xd5, 0x63, 0x29, 0xae, 0xf7, 0xb9, 0x12, 0x94,
-	0x1e, 0x27, 0x47, 0x45, 0x3f, 0x0c, 0xc3, 0x46, 0xa2, 0x0f, 0x63, 0x4f, 0xe2, 0xd1, 0xd8, 0x85,
-	0x3c, 0xa2, 0x47, 0x50, 0x1e, 0x55, 0x4a, 0x54, 0xcb, 0x1e, 0x5c, 0x13, 0x75, 0x14, 0x50, 0xbc,
-	0x4f, 0x59, 0xd4, 0x1c, 0xa0, 0x38, 0xdf, 0xb2, 0x15, 0x3e, 0xa8, 0x51, 0xd9, 0x52, 0xc7, 0xe8,
-	0x11, 0x42, 0x7b, 0x2c, 0xcd, 0x16, 0x39, 0x4e, 0x0f, 0x91, 0x59, 0xa4, 0x1d, 0xc6, 0x40, 0x42,
-	0x4f, 0x40, 0x87, 0xd1, 0x80, 0x14, 0xb2, 0xc5, 0x2b, 0x95, 0xe8, 0x87, 0xa0, 0xc3, 0x9c, 0x3a,
-	0x6d, 0x88, 0x49, 0xe4, 0xeb, 0x40, 0x78, 0xa0, 0x6c, 0x46, 0x28, 0xec, 0x2a, 0x1e, 0x05, 0x86,
-	0x4b, 0xb6, 0x28, 0xbd, 0x2b, 0xb1, 0x4f, 0x01, 0x55, 0x67, 0x07, 0x29, 0x8b, 0xa5, 0x5d, 0xba,
-	0xb4, 0xdd, 0x9f, 0x5d, 0x80, 0x81, 0x0e, 0x78, 0x93, 0xd1, 0x70, 0x43, 0x66, 0x7e, 0x13, 0x0c,
-	0xb4, 0xa0, 0x06, 0x7d, 0x12, 0x12, 0xf1, 0x66, 0x40, 0x04, 0x6c, 0x3c, 0x8a, 0x53, 0xbe, 0xfd,
-	0x90, 0x88, 0xa7, 0x81, 0x19, 0xdd, 0x78, 0x67, 0xc8, 0xf8, 0x6e, 0x51, 0xc2, 0xdf, 0x02, 0x12,
-	0x0d, 0xa4, 0x1b, 0x24, 0xd9, 0x64, 0x3f, 0x47, 0xe7, 0xc8, 0x11, 0x60, 0x97, 0x83, 0xe8, 0xb7,
-	0x02, 0xd1, 0xa0, 0x3a, 0x82, 0x70, 0xa8, 0x65, 0xe7, 0x6d, 0xb4, 0x45, 0x0e, 0x61, 0xf3, 0x52,
-	0x95, 0x48, 0xcc, 0xdb, 0xf5, 0x04, 0xd0, 0x3b, 0x57, 0x89, 0x7c, 0x1e, 0xa6, 0xa8, 0xc1, 0x62,
-	0x50, 0x25, 0xb0, 0xdf, 0x90, 0xf8, 0x77, 0xe8, 0x21, 0x80, 0xe1, 0xe4, 0x8e, 0x7f, 0x89, 0x7c,
-	0x27, 0xf4, 0x8f, 0x33, 0x17, 0xcf, 0x72, 0x25, 0xfc, 0x2c, 0xc0, 0x79, 0x21, 0x0b, 0xbe, 0xa8,
-	0x39, 0xc8, 0x0f, 0x49, 0x24, 0x62, 0x09, 0x0a, 0x04, 0x6c, 0x7b, 0x74, 0xc3, 0x2e, 0xd0, 0xa6,
-	0x27, 0xc9, 0x71, 0x21, 0xb9, 0x99, 0xcd, 0xb2, 0xcc, 0x72, 0x8e, 0x3e, 0x44, 0x1e, 0x44, 0xf5,
-	0x54, 0x90, 0xe1, 0x3c, 0xf4, 0xf0, 0x02, 0x4b, 0x8b, 0xf0, 0x17, 0x8c, 0xd9, 0xb1, 0xc6, 0x0f,
-	0x16, 0x25, 0xea, 0x22, 0x7d, 0x3d, 0x79, 0xf8, 0x02, 0x08, 0xb3, 0xb5, 0x62, 0x5f, 0x8b, 0xd2,
-	0xcd, 0x08, 0xea, 0x62, 0x81, 0xe2, 0x63, 0x07, 0xa4, 0xd1, 0xe0, 0xa3, 0xb1, 0xa7, 0x32, 0xfa,
-	0xf9, 0x2e, 0x60, 0x00, 0x0c, 0xfc, 0x6a, 0xb8, 0xc5, 0x46, 0x37, 0x34, 0x9b, 0x5f, 0x90, 0x08,
-	0x79, 0xe4, 0x2d, 0x11, 0x97, 0x00, 0x21, 0x54, 0x02, 0x5f, 0xca, 0x05, 0x62, 0x19, 0x84, 0x14,
-	0x27, 0x94, 0x05, 0xbe, 0x4c, 0x7d, 0x72, 0x22, 0x4f, 0x32, 0x2e, 0xda, 0x32, 0xcf, 0x0a, 0xf4,
-	0xf8, 0x2a, 0x8b, 0xa3, 0xeb, 0xbb, 0xd9, 0xe9, 0xdb, 0x85, 0xe6, 0xce, 0xdd, 0x1a, 0x87, 0xc3,
-	0xbe, 0x2d, 0xb2, 0x57, 0x40, 0x20, 0xe5, 0xd0, 0x09, 0xef, 0x84, 0xc4, 0x05, 0x50, 0x1f, 0x70,
-	0x78, 0x71, 0x31, 0x8e, 0xd8, 0x75, 0xb3, 0xc3, 0x3d, 0xc1, 0x7c, 0xd3, 0xb2, 0x36, 0xf1, 0xab,
-	0x30, 0x13, 0x02, 0xb6, 0x11, 0xc1, 0x1a, 0x28, 0x4e, 0x62, 0x57, 0xae, 0x5f, 0x4f, 0x98, 0x12,
-	0x81, 0x17, 0xf5, 0x2a, 0x93, 0xf1, 0x6b, 0xc8, 0x1c, 0x57, 0x51, 0xa7, 0xbe, 0x32, 0x58, 0x00,
-	0x9d, 0x73, 0x91, 0x85, 0x71, 0xba, 0xc6, 0x42, 0x55, 0xfe, 0x1a, 0x96, 0xb7, 0x4b, 0xf2, 0xb9,
-	0x2a, 0x73, 0xfc, 0x3f, 0xc1, 0xb2, 0x4c, 0xa6, 0x4b, 0xcc, 0x58, 0xeb, 0xfe, 0xbf, 0x5c, 0xc9,
-	0x0a, 0x68, 0x78, 0x37, 0x48, 0xe1, 0xe5, 0x51, 0x1a, 0x5d, 0xdf, 0x5d, 0xba, 0xc2, 0x4b, 0xe2,
-	0x19, 0xba, 0xd2, 0x74, 0x2f, 0x81, 0x24, 0xf7, 0x58, 0x8a, 0x93, 0xc8, 0x3e, 0x46, 0x93, 0x59,
-	0xde, 0xc3, 0xd5, 0x0e, 0x4c, 0x02, 0x73, 0x48, 0x7e, 0x06, 0xba, 0x27, 0x97, 0x3f, 0x75, 0x26,
-	0x2c, 0xb1, 0xef, 0x05, 0x0d, 0xaa, 0xe7, 0xe7, 0xea, 0xf6, 0x18, 0xe7, 0xb8, 0x44, 0xff, 0x2c,
-	0x68, 0x05, 0x21, 0x3e, 0xfc, 0x6c, 0x5d, 0x62, 0x5e, 0x36, 0x26, 0x3e, 0xc7, 0xd8, 0xd4, 0x84,
-	0x30, 0x25, 0x3b, 0xc3, 0x84, 0xc5, 0xe9, 0xf9, 0x68, 0xc0, 0x14, 0x7c, 0x4d, 0x93, 0xe3, 0xd0,
-	0x4d, 0x0c, 0xf8, 0x20, 0xb1, 0x5c, 0xb4, 0xec, 0x6a, 0xaf, 0xe3, 0xfa, 0xb0, 0x39, 0xba, 0x29,
-	0xec, 0x1e, 0x09, 0xdf, 0x78, 0xac, 0xd1, 0xe8, 0xcf, 0xbe, 0xfa, 0xea, 0xab, 0xaf, 0x56, 0xfc,
-	0xbf, 0xae, 0x14, 0xd8, 0x22, 0x4e, 0x53, 0xb9, 0x9d, 0x37, 0x87, 0xb9, 0x63, 0xb9, 0xec, 0x68,
-	0x2e, 0x5b, 0x04, 0x0c, 0x39, 0xe9, 0xa7, 0xdd, 0xd9, 0x46, 0xfb, 0xac, 0x19, 0x18, 0x10, 0xfa,
-	0x30, 0xa9, 0xf6, 0xb6, 0x22, 0xdc, 0x97, 0x17, 0x1c, 0xe2, 0x00, 0xde, 0x71, 0x84, 0x56, 0x77,
-	0x1e, 0xa1, 0xdd, 0xcd, 0x31, 0xd9, 0xc2, 0x79, 0x32, 0xb9, 0x2e, 0x18, 0x30, 0x63, 0x5b, 0x72,
-	0xad, 0x0d, 0x2c, 0x2c, 0xf7, 0x49, 0x4e, 0xa6, 0x05, 0xb2, 0xb0, 0x3f, 0x72, 0xda, 0x71, 0x2e,
-	0xa6, 0x2e, 0xb4, 0x8b, 0x9b, 0xdc, 0xb4, 0x98, 0xeb, 0xa8, 0x50, 0x37, 0xf8, 0xcf, 0x5e, 0xb9,
-	0x81, 0x58, 0xea, 0x91, 0x70, 0x8e, 0x6b, 0xe5, 0x6e, 0xc7, 0x15, 0x1d, 0x8a, 0xdc, 0xba, 0xec,
-	0x0a, 0x67, 0x8b, 0x06, 0x2c, 0x2c, 0x17, 0x77, 0x33, 0xc2, 0x6e, 0xbe, 0xce, 0xe2, 0xac, 0xbb,
-	0x17, 0xba, 0xbf, 0x9f, 0xf6, 0xca, 0xcc, 0xdd, 0xd2, 0xde, 0xca, 0x41, 0xa8, 0x18, 0x83, 0xf0,
-	0x42, 0x31, 0x75, 0xef, 0x43, 0xea, 0x4e, 0x1a, 0x83, 0xb0, 0x17, 0x6d, 0x5f, 0xf0, 0xf6, 0x36,
-	0xb5, 0xef, 0x9a, 0xc2, 0x2b, 0xc5, 0x14, 0x6e, 0x21, 0x85, 0x8f, 0xca, 0x99, 0xb2, 0x47, 0xcb,
-	0x9a, 0xce, 0x6f, 0x54, 0xcb, 0x8d, 0xfd, 0xbb, 0xa5, 0x11, 0x76, 0xa1, 0x97, 0xd9, 0x4d, 0xe1,
-	0x83, 0xc2, 0xb0, 0x09, 0x91, 0xb4, 0xce, 0x7d, 0x6a, 0x99, 0x23, 0x65, 0xf3, 0x1c, 0xa7, 0x9e,
-	0x39, 0x22, 0x76, 0x9f, 0x09, 0x4d, 0x14, 0x1e, 0x37, 0xe3, 0xa1, 0xc7, 0x16, 0x13, 0x0c, 0x40,
-	0xe7, 0x2c, 0x1e, 0x7a, 0x28, 0x50, 0xfe, 0xd0, 0xc3, 0xdb, 0xfb, 0xd0, 0xc3, 0xbb, 0xe3, 0x43,
-	0x0f, 0xcf, 0x7d, 0xe8, 0x51, 0x26, 0xfd, 0x03, 0x4b, 0xfa, 0xcb, 0xc6, 0x43, 0x8f, 0xdc, 0x2f,
-	0x54, 0x0a, 0x37, 0x61, 0xa5, 0x83, 0x76, 0x84, 0x4c, 0x58, 0x51, 0x18, 0x13, 0x7a, 0xea, 0x82,
-	0x95, 0x9b, 0xa4, 0xe1, 0xf6, 0x58, 0x9c, 0x13, 0x68, 0x00, 0x9e, 0x30, 0x40, 0x33, 0xe8, 0x28,
-	0xaf, 0xf1, 0x58, 0x52, 0x05, 0xc8, 0x78, 0xf7, 0xeb, 0x2e, 0xef, 0xbe, 0x30, 0x62, 0x90, 0x3f,
-	0xcd, 0x40, 0x26, 0x17, 0x2e, 0x16, 0x33, 0x65, 0x1b, 0x99, 0x72, 0xc2, 0x52, 0x09, 0xb9, 0xae,
-	0x6a, 0x7e, 0xfc, 0x97, 0x57, 0xb8, 0xef, 0xbc, 0x27, 0x7e, 0xf8, 0xc2, 0xbb, 0x2e, 0x63, 0x3f,
-	0x79, 0x7c, 0xaf, 0x05, 0xb3, 0xcf, 0x4f, 0xb8, 0x44, 0x1a, 0xe7, 0x27, 0x27, 0x08, 0xe1, 0x09,
-	0x75, 0xe6, 0x51, 0x0f, 0x0c, 0x48, 0x59, 0xdf, 0x87, 0x56, 0xdf, 0x0b, 0xba, 0xa5, 0xfb, 0xfe,
-	0x65, 0xcf, 0xb1, 0xad, 0xbe, 0x3f, 0xde, 0xf1, 0x85, 0xc5, 0x62, 0xaa, 0x5f, 0x41, 0xaa, 0x5b,
-	0xd6, 0x88, 0x19, 0x04, 0x69, 0x7a, 0x37, 0x72, 0xdb, 0x7d, 0xe7, 0xb2, 0xf8, 0xce, 0xe2, 0xa6,
-	0x62, 0x6c, 0xea, 0x88, 0xa1, 0x91, 0x9d, 0x0d, 0x7d, 0xc0, 0xe1, 0x42, 0xb8, 0x53, 0xbe, 0x94,
-	0xf5, 0x34, 0xb1, 0x7a, 0x9a, 0x6b, 0x42, 0x13, 0xf0, 0x15, 0xcf, 0xe9, 0xad, 0x00, 0x89, 0x84,
-	0xfc, 0x43, 0x4d, 0x87, 0x4a, 0x97, 0x7a, 0x23, 0xad, 0x83, 0x83, 0x6a, 0xe6, 0xe0, 0xa0, 0xcc,
-	0x8e, 0x48, 0x2d, 0x3b, 0xc2, 0x41, 0x92, 0xa6, 0x39, 0xce, 0xfa, 0x51, 0xe8, 0x43, 0x3c, 0x34,
-	0x5e, 0xc4, 0x96, 0x4d, 0x1b, 0x81, 0xa4, 0x01, 0x22, 0x16, 0xde, 0x51, 0xdc, 0xf0, 0x0e, 0x36,
-	0x7c, 0xd8, 0x58, 0x99, 0x74, 0xc5, 0xba, 0xcd, 0x4f, 0x7a, 0xc5, 0x8e, 0x9a, 0x52, 0x66, 0x29,
-	0xe1, 0xad, 0x18, 0xc2, 0xbb, 0xd0, 0x29, 0xa6, 0xe7, 0x06, 0xd2, 0xf3, 0x90, 0xa6, 0xc7, 0xd9,
-	0xa6, 0xa5, 0x57, 0x8a, 0x9d, 0x44, 0xf7, 0xcf, 0x9b, 0xac, 0x8e, 0xd1, 0x6a, 0x25, 0xc7, 0x68,
-	0xf5, 0xfc, 0x31, 0xda, 0xc2, 0xbb, 0x8a, 0xbb, 0xbe, 0x8b, 0x5d, 0x9f, 0xb7, 0x35, 0x6a, 0xbe,
-	0x53, 0xba, 0xef, 0xdf, 0xf1, 0x0a, 0x3d, 0x60, 0xf7, 0xaf, 0xe7, 0x65, 0x7a, 0xf1, 0xb6, 0xad,
-	0x17, 0xdd, 0xa4, 0x69, 0xfa, 0x7f, 0xe0, 0x15, 0x38, 0xe9, 0x80, 0xd2, 0x8b, 0xab, 0xab, 0x5d,
-	0x8c, 0xc9, 0x14, 0x22, 0x25, 0xd3, 0x66, 0x4c, 0x28, 0x67, 0x7e, 0x26, 0x26, 0x14, 0x31, 0xbc,
-	0x7b, 0x32, 0x89, 0xb1, 0x99, 0x40, 0x20, 0x5f, 0x25, 0xf0, 0xbb, 0x6c, 0x23, 0xf1, 0x7e, 0xc7,
-	0x46, 0x22, 0x43, 0xa2, 0xee, 0xc5, 0xb7, 0xbc, 0x02, 0x7f, 0xe2, 0x5e, 0xbd, 0x28, 0xa1, 0x35,
-	0x13, 0x47, 0x2a, 0x02, 0x3c, 0xa7, 0x65, 0x80, 0x67, 0x19, 0xed, 0x3f, 0x57, 0xb0, 0x09, 0x72,
-	0xd2, 0x7e, 0x8d, 0x34, 0x25, 0x0e, 0x5d, 0x4d, 0x2a, 0x08, 0x17, 0xc8, 0xdd, 0x27, 0x82, 0x70,
-	0x8f, 0x91, 0x29, 0x44, 0x1a, 0x47, 0x61, 0x1a, 0xa0, 0xc3, 0x6a, 0xab, 0x46, 0x58, 0xad, 0x3f,
-	0x2a, 0xf0, 0x96, 0x66, 0x23, 0x04, 0xca, 0x7a, 0xf2, 0x01, 0xab, 0x27, 0xce, 0xea, 0x74, 0x4f,
-	0xc6, 0x05, 0x3e, 0xd8, 0x5c, 0x83, 0x17, 0x8a, 0x1b, 0x7c, 0xd5, 0x73, 0xb4, 0x58, 0xc8, 0xbb,
-	0xf3, 0x60, 0x14, 0x27, 0xe3, 0xd1, 0x30, 0xc1, 0xf1, 0x59, 0x79, 0x01, 0x1b, 0x69, 0x04, 0x95,
-	0x95, 0x17, 0x80, 0x29, 0xe7, 0xe2, 0x78, 0x14, 0x8b, 0x43, 0x10, 0x9e, 0xd0, 0xd7, 0x94, 0xf8,
-	0x91, 0x3e, 0x4f, 0xf8, 0xdf, 0xf5, 0x5c, 0x3e, 0xe2, 0x9f, 0xca, 0x14, 0x28, 0x59, 0x90, 0x3e,
-	0xc8, 0x79, 0x71, 0x54, 0x2b, 0xe2, 0x42, 0xd6, 0x5f, 0xcf, 0xfb, 0xb2, 0x73, 0x5c, 0x2f, 0x59,
-	0xac, 0x3f, 0xc4, 0x5b, 0x7a, 0xc0, 0xd4, 0x1a, 0x46, 0x55, 0xba, 0x9d, 0xf7, 0x97, 0x78, 0xc7,
-	0x9d, 0x06, 0x4a, 0xc9, 0x96, 0xf1, 0xc3, 0x9e, 0xa5, 0x6c, 0x0b, 0xeb, 0xd5, 0xad, 0xff, 0xc4,
-	0x2b, 0xf4, 0xbe, 0xe3, 0xd9, 0x1e, 0x8f, 0x1e, 0xc4, 0xf6, 0xab, 0x81, 0x4c, 0x02, 0x86, 0x07,
-	0xbb, 0xf4, 0xc5, 0xcc, 0x91, 0x49, 0x30, 0xe0, 0xda, 0x6b, 0x62, 0x23, 0x86, 0x86, 0x2d, 0x4f,
-	0xa1, 0x61, 0x37, 0x46, 0x38, 0x1f, 0x5a, 0x91, 0x2a, 0x5b, 0x33, 0x3f, 0xea, 0x59, 0x7a, 0xb7,
-	0x80, 0x4a, 0xdd, 0x95, 0x2f, 0x7a, 0x7b, 0x9f, 0x15, 0xdc, 0xf5, 0xee, 0x37, 0x28, 0xa6, 0xef,
-	0xe3, 0x9e, 0xb5, 0xfd, 0xdd, 0xab, 0x69, 0x4d, 0xe8, 0xdf, 0x56, 0x8b, 0x8f, 0x2b, 0x90, 0x81,
-	0x8b, 0xc6, 0x98, 0x8b, 0x94, 0xc1, 0xc0, 0x8a, 0xc9, 0x40, 0x45, 0x74, 0xd5, 0x58, 0x11, 0xef,
-	0xd0, 0x91, 0x75, 0x8a, 0x54, 0x3a, 0x41, 0x69, 0x78, 0x70, 0xa5, 0x13, 0xdc, 0xbf, 0x98, 0xe0,
-	0x05, 0x42, 0xf8, 0x19, 0x0b, 0x16, 0x6b, 0x58, 0x47, 0x9f, 0x78, 0x46, 0xcd, 0xb1, 0x81, 0x91,
-	0xcb, 0x0c, 0xc9, 0x9d, 0x2a, 0x0f, 0xc9, 0xbd, 0xe3, 0xb0, 0xdf, 0x32, 0xdb, 0xe5, 0x57, 0x3c,
-	0xcb, 0x6e, 0x2b, 0x1a, 0x34, 0x3d, 0xb4, 0xdf, 0xf3, 0xf2, 0x67, 0x4d, 0x3f, 0xc5, 0x21, 0x2d,
-	0x53, 0x48, 0x9f, 0xb0, 0x15, 0x52, 0x96, 0x4a, 0xdd, 0x87, 0xbf, 0x50, 0x2a, 0xa1, 0xbd, 0xd6,
-	0x4d, 0x2d, 0xd7, 0x2e, 0x9e, 0x91, 0x87, 0xc9, 0x96, 0x0e, 0x90, 0xe2, 0x29, 0x15, 0x38, 0xd5,
-	0x17, 0xf1, 0x21, 0x22, 0x05, 0x0a, 0xb3, 0xbd, 0x28, 0x3a, 0x52, 0x69, 0x2f, 0x42, 0xba, 0xbb,
-	0x2a, 0x82, 0x66, 0x2b, 0xdd, 0x55, 0xbd, 0xa2, 0xd4, 0x8d, 0x15, 0xa5, 0x4c, 0x29, 0x7c, 0xd2,
-	0xa5, 0x14, 0x72, 0x74, 0xea, 0xce, 0xfc, 0xab, 0xe7, 0x38, 0xe6, 0xdb, 0x6b, 0x6b, 0xee, 0x1c,
-	0x95, 0x3b, 0xdc, 0x9a, 0xf7, 0xc6, 0x83, 0x88, 0x87, 0x44, 0x8a, 0xd0, 0x46, 0x05, 0xa0, 0xf3,
-	0x22, 0x20, 0x77, 0x71, 0xb4, 0x33, 0xec, 0x4b, 0x3b, 0xda, 0x04, 0x2d, 0x2c, 0x15, 0x77, 0xfc,
-	0x53, 0x9e, 0xb5, 0xfb, 0xcb, 0xf5, 0x49, 0x77, 0xf9, 0x9f, 0x3c, 0xe7, 0x11, 0xe6, 0x3d, 0x75,
-	0x7a, 0x9e, 0x4c, 0x1b, 0xe2, 0x2e, 0x06, 0xd2, 0x04, 0xd1, 0x67, 0x49, 0x13, 0x27, 0xeb, 0xea,
-	0x88, 0xcf, 0x0e, 0x11, 0xe5, 0xe5, 0x9a, 0xc8, 0x76, 0xc6, 0x85, 0x73, 0xc5, 0x9d, 0xfd, 0xb4,
-	0x67, 0x6d, 0x1c, 0x1d, 0xbd, 0xd1, 0xdd, 0x5d, 0x27, 0xd3, 0x46, 0x23, 0x30, 0x04, 0x98, 0x34,
-	0xe6, 0x9b, 0x06, 0x28, 0xac, 0x32, 0xfa, 0xea, 0x81, 0x06, 0xd8, 0x31, 0xab, 0x56, 0xa8, 0xf9,
-	0x35, 0x11, 0x5d, 0xe6, 0x0c, 0x07, 0x9d, 0xcb, 0x86, 0x83, 0x1a, 0xa1, 0xa0, 0x76, 0x38, 0x65,
-	0x35, 0x17, 0x4e, 0xf9, 0x7d, 0x8f, 0xcc, 0xd8, 0xb1, 0xc7, 0x3f, 0xa5, 0x38, 0xdb, 0xc7, 0x44,
-	0xac, 0x29, 0xcb, 0x06, 0xda, 0xaa, 0x7e, 0x06, 0x32, 0xc3, 0x5e, 0x4b, 0x80, 0xff, 0x41, 0x4f,
-	0x48, 0xb6, 0xb8, 0x33, 0xa6, 0x0c, 0x07, 0xd9, 0x0d, 0x99, 0x54, 0x1e, 0xbd, 0x5e, 0x74, 0x9b,
-	0x09, 0x55, 0xa1, 0x01, 0x38, 0x41, 0xf0, 0xe6, 0xd3, 0xd2, 0x68, 0x47, 0x48, 0x5b, 0x3d, 0x30,
-	0x41, 0x18, 0x43, 0x17, 0xde, 0x32, 0xa6, 0x97, 0x4c, 0xfa, 0x2f, 0x91, 0x66, 0x30, 0x36, 0x89,
-	0xd0, 0x22, 0xed, 0x59, 0x22, 0xbd, 0x20, 0x22, 0x3e, 0x21, 0x5b, 0x22, 0x8e, 0x1b, 0xa8, 0xa9,
-	0x50, 0x79, 0xf9, 0xc0, 0xc8, 0xe5, 0xbf, 0x4c, 0x48, 0x7b, 0x51, 0xea, 0x18, 0xa1, 0xd4, 0x3c,
-	0xa5, 0xd4, 0xf8, 0x45, 0x43, 0x79, 0xcf, 0x12, 0xbf, 0xe9, 0x19, 0x32, 0x19, 0x8c, 0x79, 0x13,
-	0x55, 0x2b, 0x96, 0xd3, 0x22, 0x32, 0x90, 0x99, 0xfc, 0x5f, 0xf6, 0xc8, 0x03, 0x66, 0x78, 0xc1,
-	0xa5, 0x51, 0xa8, 0xac, 0x4e, 0x7e, 0x1d, 0x71, 0x15, 0x32, 0x66, 0x22, 0xd0, 0x34, 0x51, 0x81,
-	0xca, 0x52, 0xa6, 0x3d, 0x3f, 0x63, 0x6b, 0xcf, 0x82, 0x06, 0xf5, 0xdc, 0xfa, 0x91, 0xe7, 0x0e,
-	0x7d, 0xa7, 0x6f, 0x94, 0x91, 0x74, 0x9e, 0x75, 0xaf, 0x4d, 0xe7, 0x5d, 0x19, 0xb3, 0x38, 0x4c,
-	0x47, 0x71, 0x22, 0x42, 0xea, 0xe8, 0x05, 0x42, 0x33, 0x35, 0x45, 0x4c, 0xc6, 0x3a, 0x3e, 0x50,
-	0x10, 0x42, 0x1f, 0x38, 0x8a, 0x58, 0x1e, 0xfd, 0x6a, 0xe6, 0x26, 0x87, 0x5e, 0x9e, 0xf8, 0x0d,
-	0x4f, 0x91, 0xf2, 0xdf, 0x4f, 0x66, 0xb3, 0x75, 0xd3, 0x47, 0xc8, 0x8c, 0x3c, 0xbc, 0x17, 0x81,
-	0x85, 0xdc, 0xc8, 0xcd, 0x40, 0x41, 0xef, 0x83, 0x80, 0xa9, 0x5c, 0x7c, 0x06, 0x5a, 0x30, 0x10,
-	0xeb, 0x6b, 0x61, 0xca, 0x62, 0x98, 0xd8, 0xd2, 0x8d, 0xad, 0x00, 0x7e, 0x87, 0x1c, 0x74, 0x30,
-	0x06, 0x88, 0x3d, 0xbb, 0xb1, 0xb1, 0x32, 0x56, 0xe1, 0x99, 0x3c, 0x25, 0xf5, 0xb4, 0xb1, 0x2f,
-	0x55, 0x69, 0xff, 0x03, 0xe4, 0x98, 0x6b, 0x3c, 0xae, 0x45, 0xe9, 0x66, 0x7b, 0x2d, 0x18, 0xd3,
-	0x27, 0x49, 0x0d, 0xad, 0x29, 0xee, 0x33, 0x2b, 0xbd, 0x9a, 0x80, 0x19, 0x0d, 0x7b, 0xbd, 0x52,
-	0x60, 0xaf, 0x57, 0xcd, 0xd9, 0xe3, 0xbf, 0x44, 0x4e, 0xe4, 0xc7, 0xc4, 0x22, 0xe1, 0x2d, 0x76,
-	0x30, 0xdb, 0xeb, 0x4a, 0x68, 0x90, 0x65, 0x64, 0x74, 0xdb, 0x2a, 0x99, 0xcb, 0x04, 0x56, 0x70,
-	0xcd, 0xcf, 0xa3, 0x30, 0x9f, 0xb6, 0x2b, 0x9e, 0x37, 0xe7, 0xac, 0xab, 0x84, 0xac, 0x75, 0x44,
-	0x8e, 0x16, 0xe6, 0xa1, 0x6f, 0x20, 0xf5, 0x4e, 0x1f, 0x96, 0x36, 0xce, 0xb1, 0x23, 0xd6, 0x6d,
-	0x03, 0x40, 0x44, 0xd7, 0x23, 0x16, 0x07, 0x3c, 0x13, 0x3d, 0x45, 0x9a, 0x46, 0xbc, 0xfd, 0x0d,
-	0x29, 0x0c, 0x36, 0xd0, 0xff, 0x79, 0xcf, 0x15, 0x11, 0x04, 0x5a, 0x54, 0x1b, 0x0b, 0x62, 0x57,
-	0x6d, 0x40, 0x54, 0x7c, 0xad, 0xb8, 0x7e, 0x56, 0xb6, 0x8d, 0xfd, 0x35, 0x7b, 0x1b, 0x9b, 0x6f,
-	0x4c, 0x4f, 0xe1, 0x1f, 0x7a, 0xe5, 0x61, 0x48, 0xf7, 0x74, 0x4c, 0xb1, 0xa7, 0x59, 0xb0, 0x70,
-	0xb9, 0x98, 0xf8, 0xcf, 0x7a, 0xd6, 0xc1, 0x53, 0x19, 0x71, 0xba, 0x1b, 0xdf, 0xf4, 0x8a, 0x62,
-	0xa5, 0xee, 0x53, 0x07, 0x4a, 0xfc, 0x81, 0xbf, 0xce, 0x3b, 0x70, 0xdc, 0xd8, 0xda, 0x97, 0xed,
-	0x09, 0xfe, 0xc7, 0x23, 0x4d, 0x11, 0x24, 0x11, 0xf3, 0x68, 0xe0, 0x63, 0xfc, 0xed, 0x12, 0xee,
-	0x35, 0xe1, 0x2b, 0xa4, 0x06, 0x18, 0x97, 0x10, 0x4c, 0x5b, 0xba, 0x0d, 0xb6, 0x72, 0x37, 0xed,
-	0xf4, 0xf9, 0x82, 0xd2, 0x0c, 0x78, 0x82, 0x3e, 0x4d, 0xa6, 0xa4, 0xfa, 0x93, 0x11, 0xf6, 0x2d,
-	0x6b, 0x66, 0x08, 0xa4, 0x78, 0xce, 0x45, 0x66, 0xd5, 0x0e, 0xae, 0xba, 0x79, 0x6f, 0xfc, 0x39,
-	0x32, 0x6d, 0x44, 0xf8, 0x88, 0x3b, 0x63, 0xad, 0xcc, 0xcb, 0x30, 0x0a, 0x1f, 0x98, 0x99, 0x81,
-	0xee, 0x75, 0xfe, 0x7a, 0xc6, 0x24, 0x57, 0xbe, 0x3c, 0xe5, 0x7f, 0xde, 0xcb, 0x87, 0xb2, 0xdd,
-	0xd3, 0xa0, 0x19, 0x66, 0x45, 0xd5, 0x32, 0x2b, 0xca, 0xb6, 0x3d, 0xbf, 0x61, 0x6f, 0x7b, 0xb2,
-	0x84, 0xe8, 0x61, 0xfa, 0xac, 0xe7, 0x8e, 0xad, 0xd3, 0xfe, 0x2d, 0xcf, 0x7c, 0x86, 0x67, 0x96,
-	0x54, 0xbb, 0xa9, 0xb4, 0xf7, 0xe0, 0x13, 0xc8, 0x1e, 0xf2, 0x3d, 0x10, 0x77, 0x84, 0x89, 0x54,
-	0x99, 0x2f, 0xf0, 0x37, 0x3d, 0xeb, 0x0a, 0x99, 0xab, 0x79, 0xd3, 0x17, 0x48, 0x25, 0xae, 0xcd,
-	0xb8, 0xfb, 0x79, 0x14, 0x03, 0x23, 0x57, 0x23, 0x16, 0xaf, 0xca, 0x48, 0xe0, 0x5a, 0xa0, 0xd2,
-	0x7c, 0xe9, 0x32, 0x42, 0x92, 0xd5, 0xd2, 0x65, 0x04, 0x4b, 0x97, 0x2c, 0xa7, 0xfe, 0x0f, 0x2a,
-	0xea, 0xfe, 0xa8, 0xd4, 0x84, 0x25, 0xb6, 0x5d, 0x76, 0x83, 0x54, 0x71, 0x6c, 0x90, 0xa4, 0xe3,
-	0xa8, 0xbd, 0x26, 0xe6, 0x9c, 0x4c, 0x2a, 0x4c, 0x37, 0x15, 0xdb, 0x43, 0x99, 0x34, 0xc4, 0xa1,
-	0x9e, 0x3d, 0x3b, 0xe6, 0x87, 0xc1, 0xdc, 0x28, 0x45, 0x4b, 0x5f, 0x01, 0xdc, 0x37, 0xa6, 0xbc,
-	0xfb, 0x74, 0x63, 0xca, 0xb0, 0x8e, 0x49, 0xce, 0x3a, 0xbe, 0x40, 0x9a, 0x4a, 0xea, 0xe4, 0xf4,
-	0xd7, 0x06, 0xbd, 0x57, 0x62, 0xd0, 0x57, 0x2c, 0x83, 0xde, 0xff, 0xb0, 0x47, 0xf6, 0xa3, 0xf0,
-	0x19, 0xc3, 0x6f, 0x5c, 0x19, 0xf3, 0xec, 0x2b, 0x63, 0xbe, 0x08, 0x32, 0xcf, 0x0c, 0x87, 0xf5,
-	0x86, 0xd0, 0x02, 0x3f, 0x7e, 0x47, 0xd2, 0xc4, 0x2d, 0x8e, 0x43, 0xd9, 0x89, 0xc2, 0x15, 0x87,
-	0x4a, 0xc2, 0x8e, 0xe5, 0x40, 0x4e, 0xb3, 0x98, 0xeb, 0xa8, 0xb7, 0xf7, 0x3a, 0xfa, 0x76, 0xb2,
-	0xcf, 0x2c, 0x2d, 0xac, 0x70, 0xb9, 0x9c, 0xe5, 0xa5, 0x3c, 0xb0, 0xb2, 0xd3, 0x77, 0xe6, 0xae,
-	0xe6, 0x0b, 0x23, 0xbb, 0xe8, 0x9e, 0x6d, 0x36, 0xbb, 0xff, 0x77, 0x9e, 0x88, 0xef, 0xb0, 0x47,
-	0xc6, 0xe2, 0x87, 0x77, 0x47, 0xfc, 0xa0, 0x4f, 0x13, 0xc2, 0x77, 0x7b, 0xea, 0xa9, 0x2e, 0x4d,
-	0x47, 0x66, 0xb4, 0x02, 0x23, 0x27, 0x7d, 0x9e, 0x34, 0x2d, 0x36, 0x0a, 0xfe, 0x17, 0x2b, 0x6f,
-	0x3b, 0xbb, 0x2d, 0xfe, 0xfc, 0x91, 0x0c, 0x0d, 0xf0, 0xb7, 0xc9, 0x61, 0x2b, 0xbb, 0xf2, 0xe9,
-	0x97, 0xaf, 0x3d, 0xd6, 0x6a, 0x52, 0xb9, 0xe3, 0xd5, 0xc4, 0x7f, 0xcd, 0x2b, 0x0c, 0x3f, 0xbe,
-	0xd7, 0x38, 0x08, 0x4b, 0x78, 0xab, 0x79, 0xe1, 0x2d, 0xdb, 0xe7, 0x7c, 0xce, 0x73, 0x84, 0x32,
-	0xe4, 0x28, 0xb3, 0xbc, 0xe0, 0x25, 0x01, 0xd2, 0x25, 0x3a, 0x4f, 0xde, 0xe2, 0xac, 0x18, 0xb7,
-	0x38, 0xef, 0xd6, 0x05, 0x7e, 0xa9, 0xb8, 0x1f, 0xbf, 0xe5, 0x59, 0x31, 0x60, 0xc5, 0x24, 0x5a,
-	0x51, 0x0e, 0x4b, 0xe8, 0x18, 0x0a, 0x07, 0x51, 0xba, 0x7b, 0xcf, 0x52, 0x3d, 0x4f, 0xa6, 0x8d,
-	0x6a, 0x44, 0xff, 0x4c, 0x90, 0xff, 0x3e, 0x32, 0x67, 0x5a, 0x3d, 0x99, 0x36, 0x5d, 0x07, 0xb5,
-	0xcf, 0x66, 0xeb, 0x34, 0xa7, 0x6c, 0xa6, 0x02, 0xbb, 0xad, 0x97, 0xc9, 0x41, 0x23, 0xa9, 0x64,
-	0xf9, 0x19, 0x7b, 0x47, 0x70, 0x32, 0x3f, 0xfb, 0xb3, 0xb5, 0xf2, 0xfc, 0xb0, 0x78, 0x9f, 0x8b,
-	0xe5, 0x31, 0x16, 0x7c, 0x82, 0x56, 0x2b, 0x0a, 0x81, 0xcf, 0x39, 0x64, 0xec, 0x07, 0x87, 0xea,
-	0xd6, 0x53, 0x3c, 0xa9, 0x79, 0x66, 0x98, 0xe6, 0x9f, 0xe2, 0xa9, 0x65, 0x9f, 0xe2, 0x29, 0x13,
-	0xe3, 0xcf, 0xbb, 0x9c, 0x9d, 0x39, 0xfa, 0xf4, 0xd8, 0xff, 0x87, 0xc7, 0x1f, 0x2b, 0x42, 0x0f,
-	0xc5, 0x9a, 0xf2, 0x50, 0xac, 0xd1, 0xe3, 0xa4, 0xd2, 0x4d, 0x85, 0x6e, 0xca, 0x3c, 0x61, 0x54,
-	0xe9, 0xa6, 0xf4, 0x49, 0x75, 0xe7, 0xba, 0x6a, 0xef, 0xc7, 0xd7, 0xba, 0x29, 0x9f, 0xf7, 0x89,
-	0x7c, 0x85, 0x84, 0x5f, 0xbd, 0xce, 0x98, 0x89, 0x35, 0xcb, 0x35, 0x59, 0x6e, 0x26, 0xce, 0xf5,
-	0x84, 0xaf, 0xa8, 0xf0, 0x3d, 0x8a, 0x33, 0xf6, 0xdb, 0x11, 0xc5, 0xfa, 0xc7, 0xb8, 0x11, 0xff,
-	0x85, 0x0a, 0x99, 0xcd, 0xbe, 0x41, 0x07, 0xd3, 0x96, 0x61, 0xa2, 0x2f, 0x6e, 0x74, 0xc9, 0x24,
-	0x28, 0x41, 0x66, 0x9c, 0xfd, 0x7a, 0xa7, 0xeb, 0x81, 0x06, 0x80, 0xec, 0x8e, 0xc6, 0xca, 0x8c,
-	0xc3, 0x6f, 0x7a, 0x9c, 0x54, 0xc7, 0xa9, 0xf4, 0xbf, 0x4f, 0x1b, 0xfc, 0x09, 0x00, 0x0e, 0x15,
-	0xae, 0xef, 0xc4, 0x31, 0x8c, 0x0b, 0x0f, 0x45, 0xab, 0x07, 0x1a, 0x00, 0x1a, 0x70, 0x1c, 0x33,
-	0x8e, 0xe4, 0x57, 0xd1, 0x54, 0x1a, 0xfa, 0x9f, 0xc4, 0xeb, 0xc2, 0x64, 0x86, 0x4f, 0x68, 0xbe,
-	0xcf, 0x92, 0x54, 0xd8, 0x21, 0xf8, 0x0d, 0x1b, 0xcf, 0xf5, 0x4d, 0xb6, 0xbe, 0xb5, 0x34, 0x1a,
-	0x5e, 0x1f, 0x44, 0xeb, 0xa9, 0x30, 0x42, 0x6c, 0x20, 0x4c, 0xda, 0x50, 0xbd, 0x9f, 0xd4, 0x47,
-	0x53, 0xa4, 0x16, 0x98, 0x20, 0xff, 0x97, 0x3c, 0xd7, 0x65, 0x0e, 0xfa, 0x66, 0xc1, 0x0f, 0xc3,
-	0x77, 0x50, 0xf8, 0xb2, 0x9f, 0xce, 0x59, 0xb6, 0x43, 0xfd, 0x82, 0xbd, 0x43, 0xcd, 0xb7, 0xa9,
-	0xa5, 0x16, 0x68, 0xca, 0x5f, 0x24, 0xb9, 0x0f, 0x34, 0x7d, 0xd1, 0xa6, 0x29, 0xdf, 0xa6, 0x75,
-	0x8e, 0xe3, 0xba, 0xc4, 0x72, 0xb7, 0x13, 0xeb, 0x18, 0x99, 0xc2, 0x15, 0x1f, 0x9f, 0x7b, 0xe4,
-	0xe2, 0xa4, 0x01, 0xd6, 0x93, 0x5e, 0x9e, 0x7e, 0xb8, 0xac, 0xcc, 0x31, 0xfe, 0xdb, 0x2e, 0xc7,
-	0xb8, 0x45, 0xa2, 0xee, 0x43, 0xea, 0xba, 0x6e, 0x63, 0x4f, 0x8a, 0x8a, 0x31, 0x29, 0xca, 0x38,
-	0xf7, 0x3b, 0x36, 0xe7, 0xf2, 0xd5, 0xea, 0x56, 0xff, 0xcd, 0xdb, 0xe3, 0x36, 0x4f, 0xe1, 0x73,
-	0x1a, 0x77, 0xe0, 0xb3, 0x72, 0x3b, 0x23, 0xcb, 0x02, 0x80, 0x28, 0xa9, 0x0d, 0x8d, 0xb3, 0x34,
-	0xf8, 0x5e, 0x58, 0x29, 0xee, 0xe8, 0xef, 0xf2, 0x8e, 0x9e, 0xb2, 0xe3, 0x4c, 0xdc, 0x1d, 0xd1,
-	0x7d, 0xfe, 0xb6, 0x57, 0x7a, 0x3d, 0x69, 0x2f, 0x0b, 0x28, 0xb6, 0x4e, 0x5e, 0x78, 0x0a, 0xc6,
-	0xa9, 0x1f, 0x8f, 0xc6, 0x67, 0x07, 0x03, 0x71, 0x6a, 0x20, 0x93, 0x65, 0x21, 0xbd, 0x5f, 0xe2,
-	0xe4, 0xfb, 0x66, 0xe0, 0xfe, 0x5e, 0xc4, 0xbf, 0xaf, 0xec, 0xe6, 0x54, 0x99, 0x71, 0xf2, 0x7b,
-	0xb6, 0x71, 0x52, 0x5c, 0x89, 0x6e, 0xeb, 0x53, 0x5e, 0xc1, 0x35, 0x2c, 0xc3, 0x68, 0xf2, 0x2c,
-	0xa3, 0xe9, 0x04, 0x21, 0xb1, 0xbe, 0xb3, 0xc1, 0x5f, 0x42, 0x31, 0x20, 0x65, 0x71, 0x2f, 0xbf,
-	0xef, 0xb9, 0x62, 0x86, 0xec, 0x76, 0x35, 0x69, 0x7f, 0xe5, 0xdd, 0xe1, 0x35, 0xb0, 0x42, 0x52,
-	0x8b, 0xce, 0xd0, 0x84, 0xc5, 0x0d, 0x4b, 0x0b, 0x5f, 0x60, 0xab, 0x81, 0x06, 0x2c, 0x5c, 0x2b,
-	0xee, 0xc0, 0x97, 0x79, 0x07, 0xde, 0xa0, 0x19, 0xbc, 0x37, 0x75, 0xba, 0x43, 0x9f, 0xf7, 0xf6,
-	0xbe, 0xac, 0x76, 0x77, 0xee, 0xcf, 0xb2, 0x60, 0x88, 0x3f, 0xb0, 0x83, 0x21, 0xf6, 0x6a, 0xd8,
-	0xd4, 0x52, 0xae, 0xcb, 0x72, 0xc0, 0x4c, 0x86, 0xd7, 0x69, 0x84, 0xa3, 0x54, 0xa4, 0xca, 0x74,
-	0xe3, 0x1f, 0xda, 0xba, 0xd1, 0x51, 0x6b, 0xae, 0xd5, 0xcc, 0x4d, 0xbc, 0x7b, 0x69, 0xf5, 0x8f,
-	0xf2, 0xad, 0x66, 0x6a, 0xd5, 0xad, 0xfe, 0xa2, 0xe7, 0xbc, 0xe7, 0x47, 0x9f, 0x32, 0xdf, 0x5e,
-	0x10, 0x43, 0xe1, 0x78, 0x64, 0xc0, 0xc8, 0x54, 0x46, 0xd1, 0x57, 0x6c, 0x8a, 0x1c, 0x0d, 0x6a,
-	0x8a, 0x06, 0x8e, 0xfb, 0x85, 0xce, 0xa0, 0xa3, 0x92, 0x93, 0xe9, 0xaf, 0xda, 0x27, 0xd3, 0xb9,
-	0xfa, 0x74, 0x6b, 0xaf, 0x79, 0x7b, 0xdd, 0x5b, 0xbc, 0xeb, 0xc9, 0x65, 0x3c, 0xaa, 0x51, 0xb5,
-	0x1e, 0xd5, 0x58, 0xe8, 0x16, 0x53, 0xfc, 0xc7, 0x9c, 0xe2, 0x87, 0x0b, 0x27, 0x96, 0x49, 0x92,
-	0x26, 0xff, 0x56, 0xc1, 0x8d, 0xca, 0xa2, 0x67, 0x63, 0xca, 0x94, 0xd3, 0xd7, 0x6c, 0xe5, 0xe4,
-	0xac, 0x57, 0xb7, 0xfc, 0x1e, 0xe7, 0x85, 0xcd, 0x32, 0x21, 0xf8, 0xba, 0x2d, 0x04, 0x8e, 0xd2,
-	0xba, 0xf6, 0x0f, 0x79, 0x45, 0xd7, 0x3e, 0x73, 0xf6, 0xce, 0x8c, 0xb2, 0x77, 0x9a, 0x60, 0xe0,
-	0x94, 0x79, 0xc9, 0xff, 0xc4, 0xf6, 0x92, 0xbb, 0x1b, 0xd0, 0x44, 0x7c, 0xc6, 0x2b, 0xbb, 0x44,
-	0x7a, 0xb7, 0x72, 0x51, 0xb6, 0x6e, 0x7d, 0x23, 0xb7, 0x6e, 0x15, 0x34, 0xaa, 0x89, 0xdb, 0x22,
-	0x07, 0x72, 0xbb, 0x1a, 0xe7, 0x16, 0x37, 0x7f, 0x37, 0x90, 0x47, 0x88, 0x3b, 0x9e, 0xd7, 0x14,
-	0x8b, 0x58, 0x22, 0x42, 0x0d, 0x54, 0xda, 0xbf, 0x6a, 0x3d, 0x45, 0xc3, 0xdf, 0x8e, 0x59, 0xcc,
-	0xc3, 0xc4, 0xa6, 0xb7, 0xc8, 0xe5, 0x95, 0xcb, 0x0f, 0xc3, 0x5c, 0x7a, 0x0d, 0xd7, 0x8a, 0x92,
-	0x15, 0x4f, 0xd5, 0x96, 0x9d, 0xe3, 0x7c, 0xd3, 0x3e, 0xc7, 0x29, 0xab, 0x5a, 0x73, 0xf2, 0x6b,
-	0x5e, 0xf9, 0x4d, 0xdf, 0xbb, 0xbe, 0xfa, 0xa5, 0x1e, 0x38, 0xab, 0x1a, 0x0f, 0x9c, 0x95, 0x91,
-	0xfd, 0x2d, 0xcf, 0x71, 0xeb, 0xcf, 0x4d, 0x8c, 0x26, 0xfb, 0x76, 0xf1, 0xed, 0x63, 0x27, 0xdb,
-	0x4a, 0x62, 0xca, 0xbe, 0x6d, 0xc7, 0x94, 0x15, 0x55, 0x6b, 0xcd, 0x8c, 0xd2, 0xcb, 0xcd, 0xf4,
-	0x31, 0xd2, 0x58, 0xba, 0x82, 0xbb, 0x49, 0xe9, 0x09, 0x51, 0x6d, 0x72, 0x70, 0xa0, 0xf0, 0x65,
-	0x8c, 0xf9, 0xd3, 0x0c, 0x63, 0x4a, 0x9a, 0xd4, 0xc4, 0xbd, 0x83, 0x4c, 0x8a, 0xba, 0x9d, 0xf3,
-	0x21, 0xf3, 0xd0, 0x1c, 0x77, 0x68, 0x5b, 0x0f, 0xcd, 0x7d, 0xc4, 0xdb, 0xeb, 0x62, 0xb6, 0x93,
-	0xc1, 0x25, 0xda, 0xfd, 0xb5, 0x9c, 0x76, 0x2f, 0xa9, 0xdc, 0x56, 0x40, 0xc5, 0xb7, 0xbf, 0xef,
-	0xf6, 0xe6, 0x41, 0x99, 0x02, 0xfa, 0x8e, 0x97, 0xbb, 0xd9, 0xb9, 0x97, 0xfc, 0x0d, 0x4a, 0x6f,
-	0x9e, 0x97, 0x6d, 0x09, 0xbe, 0x6b, 0x6f, 0x09, 0x4a, 0x6a, 0xd1, 0xad, 0x7d, 0xce, 0xdb, 0xe3,
-	0x1e, 0x3b, 0xa8, 0xdd, 0x84, 0x6f, 0x5d, 0x41, 0xe0, 0x6a, 0x81, 0x48, 0xc1, 0x72, 0xcc, 0x4f,
-	0xbd, 0xb8, 0xf7, 0xb8, 0x16, 0xc8, 0x64, 0xd9, 0xa6, 0xeb, 0xcf, 0xec, 0x4d, 0x57, 0x69, 0xcb,
-	0xe6, 0x85, 0xa1, 0xfc, 0x45, 0x7a, 0xb3, 0x7d, 0xcf, 0x6e, 0xbf, 0xc4, 0x80, 0xf9, 0xf3, 0x6c,
-	0x68, 0x5d, 0xa6, 0x56, 0xdd, 0xe6, 0xdf, 0x7b, 0xc5, 0xd7, 0xf4, 0x41, 0x1a, 0xfa, 0x19, 0xcd,
-	0x25, 0xd3, 0x62, 0x1b, 0xc3, 0x3d, 0xd7, 0x7d, 0xb1, 0x7e, 0x1a, 0x10, 0x28, 0xbb, 0xcd, 0x9f,
-	0x67, 0xef, 0x8b, 0x8b, 0xe9, 0x2a, 0xad, 0x9f, 0x6b, 0xaf, 0x15, 0x3d, 0xd7, 0x5e, 0xa6, 0x6e,
-	0xbe, 0x67, 0xab, 0x9b, 0x22, 0xea, 0xad, 0x73, 0x50, 0xf3, 0x19, 0x5e, 0x3c, 0x8e, 0xe2, 0x8f,
-	0xfe, 0x7b, 0x7c, 0x1f, 0x2a, 0x1f, 0xfb, 0x3f, 0x41, 0xc8, 0xe2, 0xce, 0xfa, 0x16, 0x4b, 0x85,
-	0x4e, 0xc6, 0x77, 0x91, 0x34, 0x04, 0x6f, 0x77, 0x6c, 0x89, 0xfb, 0xb8, 0x95, 0xb3, 0x5b, 0x90,
-	0xee, 0x6d, 0xc9, 0xe7, 0xbc, 0x7b, 0x5b, 0xd0, 0xe7, 0x73, 0xc3, 0xfe, 0x78, 0x14, 0x0d, 0x53,
-	0x11, 0xfe, 0xa9, 0xd2, 0x80, 0x5b, 0x0c, 0x13, 0xd6, 0x0d, 0xd3, 0x4d, 0xf4, 0x98, 0x4d, 0x05,
-	0x2a, 0xed, 0x7f, 0xba, 0x42, 0xcc, 0x28, 0xdf, 0x25, 0x7c, 0x0d, 0xbc, 0xc7, 0x86, 0x49, 0x94,
-	0x46, 0x37, 0x98, 0xa0, 0x32, 0x0b, 0x06, 0x6a, 0xcf, 0x8e, 0xc7, 0x6c, 0xd8, 0x07, 0x65, 0x8b,
-	0xd4, 0x36, 0x02, 0x03, 0x02, 0x2b, 0xf7, 0xb5, 0x38, 0x4a, 0xd9, 0xea, 0x66, 0xcc, 0x92, 0xcd,
-	0xd1, 0xa0, 0x2f, 0xd6, 0xe5, 0x0c, 0x94, 0x9e, 0x22, 0xcd, 0x80, 0x85, 0x7d, 0x9d, 0xad, 0x86,
-	0xd9, 0x6c, 0x20, 0xbe, 0xaf, 0x9e, 0x8e, 0xe2, 0x70, 0x83, 0x2d, 0x85, 0xe3, 0x70, 0x3d, 0x4a,
-	0x77, 0x85, 0x57, 0x30, 0x0b, 0x56, 0x21, 0xa3, 0x4b, 0x9b, 0x61, 0x2c, 0xba, 0xaa, 0x01, 0xf8,
-	0x1c, 0x6e, 0x2a, 0x4f, 0x2e, 0xe1, 0x13, 0x6f, 0xcc, 0x86, 0x1b, 0x09, 0x66, 0x11, 0x97, 0x69,
-	0x34, 0xc0, 0xff, 0xbe, 0x57, 0xfc, 0x70, 0x83, 0xcb, 0x98, 0x0b, 0xc6, 0x42, 0x71, 0x55, 0x82,
-	0x31, 0x3e, 0x52, 0x99, 0xa4, 0xea, 0xd9, 0xca, 0x24, 0x35, 0xc3, 0xad, 0x6b, 0xd6, 0x13, 0xfc,
-	0xb9, 0xf7, 0x0b, 0x4a, 0x24, 0xf0, 0xfb, 0x2e, 0x09, 0x2c, 0x0b, 0x98, 0xf8, 0x55, 0x8f, 0x4c,
-	0x82, 0x1e, 0x5d, 0x19, 0x63, 0xac, 0xdd, 0xca, 0x58, 0x04, 0x48, 0x55, 0x56, 0xc6, 0x20, 0x18,
-	0x43, 0x76, 0x53, 0x9e, 0xb5, 0xe1, 0x7d, 0x6e, 0x99, 0xce, 0xff, 0xdf, 0x05, 0x7f, 0x82, 0x2b,
-	0xf3, 0x7f, 0x17, 0x27, 0x08, 0xb9, 0xc0, 0xd2, 0x95, 0x31, 0x77, 0xc7, 0xf2, 0xd1, 0x33, 0x20,
-	0xea, 0xda, 0x61, 0xdd, 0x76, 0xf5, 0xaa, 0x6b, 0x87, 0xb0, 0x50, 0x38, 0x9f, 0xdb, 0x28, 0xbd,
-	0xdb, 0x62, 0x9f, 0x02, 0x88, 0xc9, 0x62, 0x9c, 0x02, 0x94, 0x04, 0x09, 0xfc, 0xc0, 0x0e, 0x12,
-	0x70, 0x35, 0xed, 0x3c, 0xc9, 0x72, 0xbc, 0xf8, 0xf1, 0x7f, 0x7c, 0x94, 0x91, 0xed, 0x44, 0xc9,
-	0x9a, 0xf7, 0x43, 0xe7, 0x49, 0x96, 0x83, 0x44, 0xdd, 0x95, 0x2f, 0x79, 0x25, 0xaf, 0x9e, 0xa8,
-	0xfb, 0x64, 0xfc, 0xa5, 0x68, 0x7e, 0x9f, 0xcc, 0xfd, 0x87, 0x49, 0x3a, 0x36, 0xbd, 0x6a, 0xc6,
-	0xa6, 0x97, 0xdd, 0xa3, 0xf9, 0x91, 0x7d, 0x8f, 0xa6, 0x90, 0x0a, 0x4d, 0xec, 0x5f, 0x56, 0x48,
-	0xe3, 0x7c, 0xc4, 0x7d, 0x1c, 0x20, 0x08, 0x09, 0x7b, 0x65, 0x87, 0x0d, 0xd7, 0x99, 0x38, 0xd8,
-	0x50, 0x69, 0xa0, 0x71, 0x80, 0xd1, 0x08, 0xe2, 0x55, 0x5f, 0x4c, 0x00, 0x74, 0x9b, 0xc5, 0x1b,
-	0x4c, 0x28, 0x7f, 0x9e, 0x40, 0x77, 0xc4, 0xad, 0x94, 0x0d, 0x53, 0xe9, 0x20, 0xe6, 0x29, 0xcc,
-	0x8d, 0x7f, 0x9b, 0x52, 0xe7, 0x37, 0xae, 0x30, 0x01, 0x9a, 0x3a, 0x11, 0xa7, 0x94, 0x13, 0x08,
-	0x97, 0x49, 0xd0, 0x19, 0x7d, 0x15, 0x09, 0xcc, 0x75, 0x89, 0x06, 0xe0, 0xd9, 0x05, 0xca, 0x14,
-	0x60, 0xf9, 0x5b, 0xff, 0x1a, 0x00, 0xb5, 0x6e, 0x47, 0xdc, 0x7a, 0xe3, 0x4f, 0x16, 0xc8, 0x24,
-	0x62, 0x44, 0x2c, 0x2e, 0x11, 0x18, 0x9e, 0xc4, 0xdd, 0xcd, 0xe8, 0x26, 0x0f, 0xe2, 0xe5, 0x4f,
-	0x13, 0xa8, 0x34, 0x4c, 0xd2, 0xeb, 0xd1, 0x80, 0xf5, 0xa2, 0xdb, 0x6c, 0x71, 0x17, 0x2c, 0xd6,
-	0x7d, 0x7c, 0x92, 0x5a, 0x40, 0xff, 0x63, 0x9e, 0xeb, 0x61, 0x1a, 0xfa, 0x04, 0x99, 0x92, 0x4c,
-	0x96, 0xa6, 0xee, 0x7e, 0x15, 0x68, 0x3e, 0x10, 0x87, 0x98, 0x2a, 0x47, 0x99, 0x47, 0xfb, 0xc7,
-	0xb6, 0x47, 0x3b, 0xdf, 0x96, 0x1e, 0xda, 0x8f, 0x78, 0xae, 0xd7, 0x6c, 0x50, 0x13, 0x81, 0x44,
-	0xc8, 0xc0, 0x9b, 0xa9, 0x40, 0xa5, 0xb3, 0x4f, 0x65, 0x96, 0x11, 0xf2, 0x93, 0xcc, 0x8d, 0xb4,
-	0x5c, 0x43, 0x96, 0xfb, 0x68, 0x12, 0xff, 0x43, 0x67, 0x74, 0x13, 0x06, 0x2d, 0x55, 0x0f, 0x27,
-	0x88, 0x18, 0x12, 0x05, 0x30, 0xcc, 0x33, 0xb1, 0x2b, 0x16, 0xe6, 0xd9, 0x1c, 0x69, 0x6c, 0x8e,
-	0x2c, 0x77, 0x89, 0x4a, 0xab, 0xf0, 0xa5, 0xb6, 0x78, 0x69, 0x41, 0xa4, 0xac, 0x7e, 0xd6, 0xed,
-	0x7e, 0xfa, 0x7f, 0xe3, 0x91, 0x06, 0x1e, 0x03, 0x00, 0x49, 0xf2, 0xd8, 0x4c, 0xfc, 0x85, 0x19,
-	0x1e, 0x9b, 0x65, 0x0e, 0xda, 0x30, 0x46, 0x47, 0x1f, 0xb4, 0xcd, 0x90, 0x4a, 0x5f, 0x06, 0x02,
-	0x55, 0xfa, 0x6b, 0x50, 0xc3, 0x38, 0xed, 0xf4, 0x45, 0x00, 0x10, 0x7e, 0x43, 0x0d, 0x49, 0xbc,
-	0x2e, 0x26, 0x30, 0x8f, 0x55, 0xd3, 0x00, 0x94, 0xec, 0x24, 0x15, 0x58, 0xfe, 0x66, 0xb2, 0x06,
-	0xd8, 0xa7, 0x72, 0xfc, 0x4f, 0x50, 0x0a, 0x4e, 0xe5, 0x1a, 0xbc, 0x63, 0x32, 0xed, 0xbf, 0x4c,
-	0xf6, 0x1b, 0x23, 0x21, 0xff, 0x8c, 0x66, 0x88, 0x7f, 0x6c, 0x64, 0x6f, 0xb1, 0xc4, 0x80, 0x04,
-	0x1c, 0x49, 0x1f, 0x25, 0x13, 0x8c, 0xff, 0x41, 0x56, 0xc5, 0x12, 0x4f, 0xc9, 0xa5, 0x40, 0xa0,
-	0x17, 0xc9, 0xbb, 0x1b, 0x67, 0xce, 0x3c, 0x89, 0xc8, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x59,
-	0xa9, 0xf9, 0x7d, 0x19, 0x6e, 0x00, 0x00,
+	// 8357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0x7b, 0x8c, 0x24, 0xc7,
+	0x59, 0xb8, 0xba, 0x67, 0x66, 0x77, 0xb6, 0x76, 0xf7, 0x6e, 0xaf, 0xef, 0xe1, 0xf1, 0xf9, 0x7c,
+	0x5e, 0xb7, 0xcf, 0xf6, 0xc5, 0x4e, 0xce, 0xf1, 0xca, 0xb1, 0x1d, 0x27, 0x71, 0x72, 0xbb, 0x73,
+	0x8f, 0x8d, 0x6f, 0x6f, 0xc7, 0xb5, 0xeb, 0xbb, 0xdf, 0x2f, 0xce, 0x2f, 0x3f, 0xf7, 0xed, 0xd4,
+	0xee, 0xb6, 0x77, 0x66, 0x7a, 0xae, 0xbb, 0xf7, 0x6e, 0xd7, 0x4a, 0x14, 0x27, 0x11, 0x41, 0x10,
+	0x21, 0x84, 0x50, 0x9e, 0x82, 0x00, 0x79, 0x01, 0x81, 0x04, 0x02, 0x79, 0xe3, 0x04, 0xe2, 0x3c,
+	0x14, 0x92, 0x90, 0x40, 0x20, 0x11, 0x7f, 0x21, 0x81, 0x10, 0x48, 0x28, 0x20, 0x90, 0x10, 0x28,
+	0x28, 0x48, 0xe8, 0xfb, 0xaa, 0xaa, 0xab, 0xaa, 0xbb, 0xba, 0x77, 0xd7, 0xe4, 0xfc, 0xd7, 0x74,
+	0x7d, 0x5f, 0x3d, 0xbe, 0x7a, 0x7d, 0xf5, 0xd5, 0xf7, 0xa8, 0x21, 0xa4, 0xcf, 0xd2, 0xe0, 0xd4,
+	0x30, 0x8e, 0xd2, 0xc8, 0x6b, 0xe0, 0x8f, 0xff, 0xf7, 0x13, 0xa4, 0xde, 0x0e, 0xd2, 0xc0, 0xf3,
+	0x48, 0x7d, 0x99, 0xc5, 0xfd, 0x96, 0x33, 0xed, 0x9e, 0xac, 0x53, 0xfc, 0xf6, 0x0e, 0x91, 0xc6,
+	0xfc, 0xa0, 0xcb, 0xb6, 0x5a, 0x2e, 0x02, 0x79, 0xc2, 0x3b, 0x46, 0xc6, 0xe6, 0x7a, 0x9b, 0x49,
+	0xca, 0xe2, 0xf9, 0x76, 0xab, 0x86, 0x18, 0x05, 0xf0, 0xee, 0x24, 0x8d, 0x8b, 0x51, 0x97, 0x25,
+	0xad, 0xfa, 0x74, 0xed, 0xe4, 0xf8, 0xcc, 0x7e, 0xde, 0xdc, 0x29, 0x80, 0xcd, 0x0f, 0x56, 0x23,
+	0xca, 0xb1, 0xde, 0xfd, 0x64, 0x0c, 0x9a, 0xbd, 0x12, 0x24, 0x2c, 0x69, 0x35, 0x30, 0xeb, 0x41,
+	0x91, 0x55, 0xc2, 0x31, 0xbb, 0xca, 0x05, 0x35, 0x3f, 0x91, 0xb0, 0x38, 0x69, 0x8d, 0x18, 0x35,
+	0x03, 0x8c, 0xd7, 0x8c, 0x58, 0x20, 0x6f, 0x21, 0xd8, 0xc2, 0xf6, 0xda, 0xad, 0x51, 0x4e, 0x5e,
+	0x06, 0xf0, 0x4e, 0x92, 0xfd, 0x0b, 0xc1, 0xd6, 0xd2, 0x7a, 0x10, 0x77, 0xcf, 0xc5, 0xd1, 0xe6,
+	0x70, 0xbe, 0xdd, 0x6a, 0x62, 0x9e, 0x3c, 0xd8, 0x3b, 0x4e, 0x88, 0x04, 0xcd, 0xb7, 0x5b, 0x63,
+	0x98, 0x49, 0x83, 0x78, 0x2f, 0xe3, 0x3d, 0xe0, 0x9d, 0x25, 0x06, 0x49, 0x12, 0x4e, 0x55, 0x0e,
+	0xc8, 0xbe, 0xc0, 0x64, 0xf6, 0x71, 0xfb, 0xd8, 0xa8, 0x1c, 0x9e, 0x4f, 0x26, 0xc4, 0x98, 0x76,
+	0xd2, 0x8b, 0x9b, 0xfd, 0xd6, 0xbe, 0x69, 0xf7, 0xe4, 0x24, 0x35, 0x60, 0xde, 0x7d, 0x64, 0xa4,
+	0x93, 0x5e, 0x0a, 0xd9, 0xf5, 0xd6, 0x7e, 0xac, 0xef, 0x26, 0xad, 0xf9, 0x53, 0x1c, 0x73, 0x66,
+	0x90, 0xc6, 0xdb, 0x54, 0x64, 0x83, 0x4a, 0xb1, 0x64, 0x87, 0xc5, 0xd0, 0x4a, 0x6b, 0x6a, 0xda,
+	0x81, 0x4a, 0x75, 0x98, 0x18, 0x20, 0x9c, 0x69, 0x39, 0x40, 0x07, 0xb2, 0x01, 0xd2, 0xc1, 0x62,
+	0x80, 0x10, 0x34, 0xdf, 0x6e, 0x79, 0xd9, 0x00, 0x09, 0x08, 0xb4, 0xb6, 0x10, 0x6c, 0x9d, 0xb9,
+	0xc6, 0x06, 0xe9, 0xe2, 0x70, 0xbe, 0xdb, 0x3a, 0x38, 0xed, 0x9c, 0xac, 0x53, 0x03, 0x06, 0xad,
+	0x2d, 0x07, 0x1b, 0x6c, 0xf1, 0x1a, 0x8b, 0xcf, 0x0c, 0x82, 0x2b, 0x3d, 0xd6, 0x6d, 0x1d, 0x9a,
+	0x76, 0x4e, 0x36, 0x69, 0x1e, 0xec, 0xbd, 0x86, 0x4c, 0x2e, 0x84, 0x6b, 0x71, 0x90, 0x32, 0x2c,
+	0x9d, 0xb4, 0x0e, 0x1b, 0x7d, 0xd6, 0x71, 0x38, 0x96, 0x66, 0x6e, 0x68, 0x68, 0x36, 0xe8, 0x05,
+	0x83, 0x15, 0xd5, 0xd0, 0x11, 0xde, 0x50, 0x0e, 0x2c, 0x06, 0xa0, 0x1d, 0x5d, 0x1f, 0x2c, 0x05,
+	0xfd, 0x61, 0x0f, 0x56, 0xd1, 0x4d, 0x48, 0x79, 0x1e, 0xec, 0xdd, 0x4b, 0x46, 0x97, 0xd2, 0x98,
+	0x05, 0xfd, 0xa4, 0xd5, 0x42, 0x62, 0x0e, 0x08, 0x62, 0x38, 0x14, 0xc9, 0x90, 0x39, 0xbc, 0x69,
+	0x32, 0x0e, 0x8b, 0x87, 0x63, 0xda, 0xad, 0x9b, 0xb1, 0x4a, 0x1d, 0x24, 0x16, 0xee, 0x5c, 0x34,
+	0x18, 0xcc, 0x77, 0x5b, 0x47, 0x11, 0xaf, 0x00, 0xde, 0xa3, 0x64, 0xfc, 0xf1, 0x4d, 0x16, 0x6f,
+	0xcf, 0xb7, 0xe7, 0x07, 0x61, 0xda, 0xba, 0x05, 0x1b, 0x3c, 0xa6, 0xcf, 0xb8, 0x86, 0xe6, 0xd3,
+	0xae, 0x17, 0xf0, 0xda, 0x64, 0x92, 0xb2, 0x61, 0x2f, 0x5c, 0x09, 0x70, 0xfe, 0x92, 0xd6, 0x31,
+	0xac, 0xe1, 0xb8, 0x5e, 0x83, 0x91, 0x81, 0xd7, 0x61, 0x16, 0xf2, 0x5e, 0x4a, 0x0e, 0x00, 0xc9,
+	0x9b, 0x57, 0x92, 0x95, 0x38, 0x1c, 0xa6, 0x61, 0x34, 0x98, 0x6f, 0xb7, 0x6e, 0x45, 0x5a, 0x8b,
+	0x08, 0xef, 0x04, 0x99, 0x84, 0x0e, 0x3c, 0x3e, 0xb7, 0x1e, 0x0c, 0xd6, 0x60, 0x20, 0x8f, 0x63,
+	0x4e, 0x13, 0x08, 0x23, 0x73, 0x71, 0xb3, 0xbf, 0xb8, 0x8a, 0x1b, 0x2b, 0x69, 0xdd, 0x36, 0xed,
+	0x9c, 0x6c, 0x50, 0x1d, 0x04, 0x53, 0x32, 0x9f, 0x2c, 0x3d, 0x7e, 0x21, 0x4c, 0x99, 0x9c, 0xbc,
+	0x69, 0x3e, 0x79, 0x39, 0xb0, 0x77, 0x2f, 0x69, 0x2e, 0x5d, 0xed, 0xf1, 0x4d, 0x76, 0xbb, 0x7d,
+	0x4f, 0x66, 0x19, 0xbc, 0xa3, 0xa4, 0xb9, 0x10, 0x6c, 0x2d, 0x24, 0xe9, 0x7c, 0xbb, 0xe5, 0x23,
+	0x65, 0x59, 0x1a, 0xe6, 0x76, 0x99, 0x0d, 0x02, 0x58, 0x68, 0x77, 0x18, 0x73, 0xcb, 0xa1, 0x7c,
+	0x6e, 0x45, 0x0e, 0x58, 0x9b, 0x6d, 0xb6, 0x12, 0xf5, 0xfb, 0x61, 0x92, 0x84, 0xd1, 0x20, 0x69,
+	0x9d, 0x30, 0xf7, 0xa3, 0x86, 0xe3, 0x6b, 0xd3, 0xc8, 0x7d, 0xf4, 0xf5, 0x64, 0x5c, 0xdb, 0xad,
+	0xde, 0x14, 0xa9, 0x6d, 0xb0, 0xed, 0x96, 0x33, 0xed, 0x9c, 0x1c, 0xa3, 0xf0, 0x09, 0x9c, 0xef,
+	0x5a, 0xd0, 0xdb, 0x64, 0x2d, 0x77, 0xda, 0xd1, 0xbb, 0x34, 0xdb, 0xe1, 0x84, 0x70, 0xec, 0x23,
+	0xee, 0xc3, 0xce, 0xd1, 0x47, 0xc9, 0x54, 0x7e, 0x1d, 0x58, 0x2a, 0x3c, 0xa4, 0x57, 0x58, 0xd7,
+	0xcb, 0x3f, 0x41, 0xbc, 0xe2, 0x2a, 0xb0, 0xd4, 0xf0, 0x12, 0x93, 0x24, 0xc9, 0xbb, 0x45, 0x59,
+	0x98, 0xff, 0x44, 0xab, 0xd6, 0x7f, 0x15, 0x99, 0xd0, 0x51, 0xde, 0xbd, 0x64, 0x44, 0x2c, 0x43,
+	0xc7, 0xe0, 0xfd, 0x7a, 0xdb, 0x54, 0x64, 0xf1, 0x7f, 0xce, 0xc9, 0x4a, 0x23, 0xc4, 0xdb, 0x47,
+	0xdc, 0xf9, 0x36, 0x9e, 0x54, 0x93, 0xd4, 0x9d, 0x6f, 0xf3, 0x89, 0x14, 0x07, 0x92, 0x8b, 0xd0,
+	0x2c, 0xed, 0xdd, 0x4e, 0x1a, 0x1d, 0x06, 0xa7, 0x46, 0x0d, 0x1b, 0x1a, 0x17, 0x0d, 0x01, 0x8c,
+	0x72, 0x8c, 0x77, 0x84, 0x8c, 0x2c, 0xa5, 0x41, 0xba, 0x09, 0x67, 0x16, 0x14, 0x16, 0xa9, 0xec,
+	0x48, 0x6c, 0xa8, 0x23, 0xd1, 0xbf, 0x87, 0xd4, 0xa1, 0x50, 0x81, 0x04, 0x8f, 0xd4, 0x69, 0xd4,
+	0x63, 0xa2, 0x79, 0xfc, 0xf6, 0x6f, 0x27, 0xa3, 0x9d, 0x74, 0xf1, 0xfa, 0x80, 0xc5, 0xd0, 0x84,
+	0x38, 0x91, 0xf8, 0xf9, 0x2a, 0x52, 0xfe, 0xb3, 0x0e, 0x19, 0xe1, 0x93, 0xe8, 0x9d, 0x20, 0x0d,
+	0xcc, 0x8b, 0x39, 0xc6, 0x67, 0xf6, 0x49, 0x42, 0x79, 0x0d, 0xb4, 0x91, 0x55, 0x24, 0x68, 0x75,
+	0xf3, 0xb4, 0x76, 0xd2, 0xf9, 0x2e, 0x9e, 0xc7, 0x93, 0x14, 0xbf, 0x61, 0xd6, 0x2e, 0xb1, 0xb8,
+	0x55, 0xc7, 0x39, 0x86, 0x4f, 0xa4, 0xf2, 0xdc, 0x7c, 0xbb, 0xd5, 0x40, 0xc6, 0x8f, 0xdf, 0xfe,
+	0xcb, 0x48, 0x53, 0x2e, 0x24, 0xef, 0x76, 0x52, 0x6f, 0x5f, 0xe9, 0xa4, 0x62, 0x52, 0x26, 0x33,
+	0x12, 0x00, 0x49, 0x11, 0xe5, 0xff, 0x8b, 0x43, 0x9a, 0xf2, 0xc0, 0xd2, 0x46, 0xa1, 0x2e, 0x47,
+	0xe1, 0x7c, 0x94, 0xa4, 0x48, 0xdb, 0x18, 0xc5, 0x6f, 0xaf, 0x45, 0x46, 0x69, 0x67, 0xee, 0x74,
+	0xb7, 0x1b, 0x63, 0xb3, 0x63, 0x54, 0x26, 0x01, 0xb3, 0x3c, 0xd7, 0xc1, 0x02, 0x35, 0x8e, 0x11,
+	0xc9, 0xdc, 0x8c, 0xd4, 0xb2, 0x5e, 0x1e, 0x22, 0x8d, 0x0b, 0xcb, 0x61, 0x9f, 0xb5, 0x46, 0xb8,
+	0x40, 0x82, 0x09, 0x38, 0x88, 0xce, 0x45, 0x49, 0x12, 0x0e, 0xb1, 0x91, 0x51, 0x6c, 0x5b, 0x83,
+	0x00, 0xfb, 0x58, 0x62, 0x6b, 0x31, 0x5b, 0x0b, 0x52, 0x26, 0xaa, 0x6d, 0x72, 0x8e, 0x9e, 0x03,
+	0x67, 0xb3, 0x48, 0x90, 0x1c, 0x3e, 0x8b, 0x9b, 0xa4, 0x29, 0x79, 0x87, 0x77, 0x1b, 0x71, 0x2f,
+	0x86, 0x62, 0x82, 0x0a, 0xa7, 0xb7, 0x7b, 0x31, 0x04, 0xc2, 0x91, 0x5f, 0xb7, 0xc5, 0xce, 0x12,
+	0x29, 0xe0, 0x71, 0xa7, 0x7b, 0xe1, 0x35, 0x26, 0x90, 0x35, 0xce, 0xfd, 0x35, 0x10, 0x0c, 0xe5,
+	0xe9, 0x67, 0x70, 0xae, 0xc6, 0xa8, 0x7b, 0xfa, 0x19, 0xff, 0x47, 0x35, 0x32, 0xa1, 0x4b, 0x42,
+	0x40, 0xdb, 0xc5, 0xa0, 0xcf, 0xb0, 0xf5, 0x31, 0x8a, 0xdf, 0xde, 0x83, 0xe4, 0x48, 0x9b, 0xad,
+	0x06, 0x9b, 0xbd, 0x94, 0xb2, 0x94, 0x0d, 0x60, 0x6f, 0x75, 0xa2, 0x5e, 0xb8, 0xb2, 0x2d, 0x66,
+	0xa0, 0x04, 0xeb, 0x9d, 0x27, 0x07, 0x4c, 0x50, 0xc8, 0xe4, 0x06, 0x39, 0x9a, 0xed, 0x44, 0xa3,
+	0x08, 0xf6, 0xb0, 0x58, 0x08, 0x6a, 0x9a, 0x8b, 0x06, 0x69, 0x38, 0xd8, 0x8c, 0x36, 0x13, 0xe0,
+	0x3c, 0x61, 0x26, 0xfa, 0xc9, 0x9a, 0x4c, 0xbc, 0xa8, 0xa9, 0x50, 0x88, 0x1f, 0x90, 0xf1, 0x46,
+	0x9b, 0xf5, 0x58, 0xca, 0xba, 0xb8, 0x56, 0x9a, 0x54, 0x07, 0x79, 0xf7, 0x91, 0x26, 0x1e, 0x08,
+	0x8f, 0xb1, 0xed, 0xd6, 0x88, 0xc1, 0x76, 0x24, 0x18, 0xeb, 0xce, 0x32, 0x79, 0x77, 0x91, 0x7d,
+	0xfc, 0x60, 0x58, 0x0e, 0xd6, 0x4e, 0xc7, 0x71, 0xb0, 0xdd, 0x1a, 0xc5, 0x5a, 0x73, 0x50, 0xe0,
+	0x1f, 0x82, 0xbf, 0x5c, 0xc4, 0x95, 0x51, 0xa3, 0x59, 0x1a, 0x0e, 0x82, 0x45, 0x3c, 0xcf, 0x40,
+	0xe2, 0x70, 0xb4, 0x83, 0x60, 0xf1, 0x4a, 0x22, 0x10, 0x54, 0xe6, 0xf0, 0x1e, 0x26, 0xe3, 0x1a,
+	0x9b, 0x43, 0x09, 0x63, 0x7c, 0xe6, 0x48, 0x91, 0x37, 0x22, 0x9d, 0x7a, 0x56, 0xff, 0x2d, 0x64,
+	0x7f, 0x0e, 0x0f, 0x6b, 0x69, 0x39, 0x88, 0xd7, 0x58, 0x2a, 0xa6, 0x5c, 0xa4, 0x0c, 0x56, 0x23,
+	0x16, 0x29, 0x48, 0x07, 0x73, 0xeb, 0x6c, 0x65, 0x63, 0x18, 0x85, 0x83, 0x54, 0x4e, 0xe5, 0xb1,
+	0x62, 0xc3, 0x2a, 0x13, 0xd5, 0x0b, 0xf8, 0x2b, 0xe4, 0xb0, 0x35, 0x17, 0xec, 0x51, 0x29, 0x02,
+	0xf3, 0x6d, 0x2e, 0x93, 0xc0, 0x5d, 0x96, 0xd8, 0x55, 0xa4, 0xa2, 0x46, 0xe1, 0x13, 0xf6, 0xe1,
+	0x13, 0xc3, 0x6e, 0x90, 0x32, 0xdc, 0xa2, 0x35, 0x44, 0x68, 0x10, 0xff, 0x33, 0x0e, 0x39, 0x98,
+	0x5b, 0x56, 0x4b, 0x43, 0xb6, 0xa2, 0xad, 0x6c, 0x27, 0x5b, 0xd9, 0x47, 0x49, 0xb3, 0xbd, 0x19,
+	0xf3, 0x61, 0x74, 0xf9, 0x94, 0xc8, 0xb4, 0x77, 0x8a, 0x78, 0x4a, 0x52, 0xcf, 0x72, 0xd5, 0x30,
+	0x97, 0x05, 0x63, 0x4c, 0x6f, 0x1d, 0x39, 0x9f, 0x9a, 0x5e, 0x9f, 0x4c, 0x5c, 0x0e, 0xe2, 0x7e,
+	0x56, 0x4b, 0x03, 0x6b, 0x31, 0x60, 0xfe, 0x3f, 0x8e, 0x90, 0xfd, 0x0b, 0x2c, 0x48, 0x36, 0x63,
+	0xd6, 0x17, 0xe2, 0xa5, 0x75, 0x37, 0xde, 0x4f, 0xc6, 0xe4, 0xd2, 0x03, 0xf6, 0x5c, 0x2b, 0x5b,
+	0xa0, 0x2a, 0x97, 0xf7, 0x08, 0x19, 0x59, 0x5a, 0x59, 0x67, 0xfd, 0x40, 0x4c, 0x99, 0x2f, 0xc5,
+	0x59, 0xb3, 0xb9, 0x53, 0x3c, 0x93, 0x90, 0xe6, 0x79, 0x22, 0xbf, 0x61, 0xea, 0xc5, 0x0d, 0xf3,
+	0x08, 0x99, 0x0c, 0x41, 0x18, 0xa7, 0xac, 0xa7, 0x7a, 0x37, 0x3e, 0x73, 0x48, 0x34, 0x32, 0xaf,
+	0xe3, 0xa8, 0x99, 0x15, 0x26, 0xf3, 0xcc, 0x60, 0x2d, 0x1c, 0xb0, 0xe5, 0xed, 0x21, 0xc3, 0xed,
+	0x36, 0x49, 0x35, 0x88, 0xf7, 0x10, 0x99, 0x98, 0x8b, 0x7a, 0x4b, 0x69, 0x14, 0x23, 0x7b, 0xc2,
+	0x9d, 0xa5, 0xfa, 0xab, 0xa3, 0xa8, 0x91, 0xd1, 0xbb, 0x9f, 0x10, 0xb5, 0x75, 0x5a, 0xcd, 0xb2,
+	0x3d, 0xa5, 0x65, 0xf2, 0xce, 0x12, 0xc2, 0x57, 0x5d, 0x77, 0x8b, 0x25, 0xad, 0x31, 0x1c, 0xa9,
+	0xbb, 0xca, 0x46, 0x2a, 0xcb, 0xc8, 0x47, 0x4b, 0x2b, 0x89, 0x72, 0xe4, 0x20, 0x4c, 0x75, 0x69,
+	0x93, 0xa0, 0xb4, 0x99, 0x07, 0x8b, 0x83, 0x6d, 0x7c, 0xda, 0x11, 0x07, 0xdb, 0xc9, 0x3c, 0x17,
+	0x90, 0xc7, 0x73, 0x81, 0x05, 0x3c, 0x49, 0x0e, 0xf0, 0xf9, 0x79, 0x22, 0x61, 0x67, 0xa3, 0x78,
+	0xae, 0xc7, 0x02, 0x60, 0x04, 0x40, 0xf2, 0xcb, 0x2a, 0x27, 0x57, 0xcb, 0xcf, 0x29, 0x2f, 0xd6,
+	0x73, 0xf4, 0x95, 0x64, 0x5c, 0x5b, 0x09, 0x3b, 0x09, 0x76, 0x0d, 0x5d, 0xb0, 0x7b, 0x8c, 0xec,
+	0xcf, 0x0d, 0x8d, 0x5e, 0xbc, 0xce, 0x8b, 0xfb, 0xa6, 0x54, 0x37, 0x21, 0x17, 0x0a, 0x94, 0xd1,
+	0x2b, 0xbb, 0x44, 0x8e, 0xd8, 0x89, 0xb6, 0x90, 0x74, 0x97, 0x59, 0xe7, 0x94, 0xdc, 0x11, 0x58,
+	0xfe, 0x52, 0xd0, 0xd3, 0xc5, 0xc4, 0x87, 0xc8, 0x58, 0x06, 0x87, 0xaa, 0x96, 0xb7, 0x87, 0xb8,
+	0xc3, 0x1a, 0x14, 0x3e, 0x81, 0x19, 0x9d, 0x19, 0x74, 0x91, 0xbb, 0xf0, 0xfe, 0xc9, 0xa4, 0xff,
+	0x1f, 0x8d, 0x02, 0x6b, 0x29, 0xdd, 0xa6, 0x26, 0x6b, 0x71, 0x77, 0xc5, 0x5a, 0xdc, 0x5d, 0xb1,
+	0x16, 0xd7, 0x60, 0x2d, 0x8f, 0x90, 0x09, 0x6d, 0xa6, 0xa5, 0x96, 0xe3, 0x88, 0x7d, 0x11, 0x50,
+	0x23, 0xaf, 0xb7, 0x40, 0xc6, 0x17, 0x92, 0xf4, 0x12, 0x8b, 0xf9, 0x7d, 0x62, 0x1f, 0x16, 0xbd,
+	0xb7, 0xfc, 0x68, 0x3e, 0xa5, 0xe5, 0x16, 0x97, 0x3f, 0x0d, 0xe2, 0x3d, 0x44, 0xc6, 0x15, 0xf1,
+	0x52, 0x81, 0x72, 0x58, 0xe7, 0x4d, 0x88, 0xe1, 0xc7, 0x92, 0x96, 0x13, 0x6e, 0x36, 0xfa, 0x9d,
+	0x2e, 0x69, 0x8d, 0x1a, 0x37, 0x1b, 0x1d, 0xc7, 0x6f, 0x36, 0x46, 0xee, 0x3c, 0x8b, 0x6a, 0x16,
+	0x59, 0xd4, 0x34, 0x19, 0x3f, 0x1f, 0xa5, 0xd9, 0x48, 0x8f, 0xe1, 0x48, 0xeb, 0xa0, 0x02, 0x87,
+	0x26, 0x98, 0xc5, 0x80, 0xc1, 0xb4, 0x29, 0xd5, 0x44, 0x96, 0x73, 0x9c, 0x4f, 0x5b, 0x11, 0x03,
+	0xe3, 0xa1, 0xa0, 0x49, 0x6b, 0xc2, 0x18, 0x0f, 0x85, 0xe1, 0xe3, 0xa1, 0xe5, 0xf4, 0x16, 0xc9,
+	0x21, 0xa5, 0x02, 0x50, 0xc3, 0xdf, 0x9a, 0xc4, 0xb5, 0x7d, 0x8b, 0xbc, 0x98, 0x59, 0xb2, 0x50,
+	0x6b, 0x41, 0xb8, 0xaf, 0xe5, 0xa7, 0x6e, 0xa7, 0x6d, 0x3d, 0xa9, 0xef, 0x98, 0x1f, 0x38, 0xe4,
+	0xa0, 0x45, 0xc0, 0xb2, 0x2e, 0xfc, 0x43, 0xa4, 0x81, 0x19, 0x84, 0xe4, 0xc0, 0x13, 0x30, 0x03,
+	0x17, 0x82, 0x24, 0xa5, 0x9b, 0x03, 0x71, 0x6c, 0xc3, 0x01, 0xa8, 0x83, 0xa0, 0x1c, 0xbf, 0x99,
+	0x70, 0xe9, 0x94, 0x27, 0x40, 0x5d, 0x01, 0x99, 0xce, 0xc4, 0x71, 0x24, 0x25, 0x7b, 0x05, 0xf0,
+	0x1e, 0x25, 0xcd, 0xd9, 0x60, 0x65, 0x63, 0x35, 0xec, 0xf5, 0x84, 0xac, 0xe6, 0xdb, 0xc5, 0x41,
+	0x99, 0x8b, 0x8b, 0x6e, 0x32, 0xe5, 0x7f, 0xd8, 0x21, 0xb7, 0x54, 0xe4, 0x84, 0xd6, 0x97, 0xd2,
+	0x20, 0x4e, 0x97, 0x43, 0xd1, 0xc9, 0x1a, 0x55, 0x00, 0x93, 0x51, 0x00, 0x4e, 0x26, 0x61, 0xc3,
+	0x5e, 0x64, 0x5b, 0xa9, 0x26, 0xa1, 0x64, 0xe9, 0xec, 0xf6, 0xc2, 0xbb, 0x89, 0xdf, 0xd5, 0xbd,
+	0xf4, 0x7f, 0xe2, 0x90, 0x7d, 0xe6, 0xf6, 0x29, 0x5c, 0x89, 0x0c, 0x42, 0xdd, 0x0a, 0x42, 0x6b,
+	0x26, 0xa1, 0xc7, 0xc8, 0x98, 0xd8, 0x23, 0xa7, 0x53, 0x71, 0x0b, 0x52, 0x00, 0xef, 0x24, 0x19,
+	0x11, 0x07, 0x18, 0xe7, 0x2a, 0x53, 0xfa, 0x5e, 0xc6, 0xa1, 0x14, 0x78, 0x98, 0xde, 0xe5, 0x78,
+	0x73, 0xb0, 0x12, 0xf0, 0x9a, 0x46, 0xf8, 0xf4, 0x6a, 0xa0, 0xdc, 0x49, 0x3f, 0x5a, 0x38, 0xe9,
+	0x5b, 0x64, 0xf4, 0x1a, 0x5f, 0x9f, 0xad, 0x09, 0x44, 0xca, 0xa4, 0xff, 0x3d, 0x97, 0x8c, 0x65,
+	0x2d, 0x16, 0x7a, 0x7e, 0x9c, 0x34, 0x71, 0xa5, 0xcc, 0xb7, 0xb9, 0x34, 0x34, 0x39, 0xeb, 0xb6,
+	0x1c, 0x9a, 0xc1, 0x60, 0x99, 0x2f, 0x84, 0x9c, 0xb9, 0x8e, 0x51, 0xf8, 0x44, 0x48, 0xb0, 0xd5,
+	0xaa, 0x0b, 0x48, 0xb0, 0x85, 0x57, 0xf0, 0x90, 0xc5, 0xd9, 0x15, 0x3c, 0x64, 0x78, 0x6d, 0x94,
+	0x4a, 0x47, 0x7e, 0x0d, 0x94, 0x49, 0x38, 0xdf, 0xd5, 0x26, 0xbb, 0xc0, 0xae, 0xb1, 0x1e, 0xde,
+	0x06, 0x6b, 0x34, 0x0f, 0x06, 0xa6, 0x62, 0x68, 0xf8, 0xf8, 0x7d, 0xd0, 0x80, 0x71, 0xde, 0x1e,
+	0x74, 0x17, 0x07, 0xbd, 0xed, 0xd6, 0x18, 0x72, 0xae, 0x2c, 0xcd, 0x75, 0x9f, 0x92, 0x8b, 0xa1,
+	0x10, 0xd1, 0xa4, 0x1a, 0x04, 0x67, 0x7d, 0xd8, 0x0b, 0xd3, 0xb3, 0x71, 0xd4, 0x17, 0x62, 0x84,
+	0x02, 0xe0, 0xc5, 0x37, 0x0e, 0xfb, 0x7d, 0xd6, 0xc5, 0x11, 0x6d, 0x52, 0x99, 0xf4, 0x29, 0x99,
+	0xd0, 0x45, 0x45, 0xa0, 0x41, 0xa6, 0xf1, 0x52, 0x3e, 0xa6, 0xdd, 0x6e, 0x60, 0x6c, 0xb6, 0x87,
+	0x9c, 0x27, 0x8c, 0x51, 0xfc, 0x06, 0xd8, 0xd2, 0x5a, 0x76, 0xc1, 0xc4, 0x6f, 0xff, 0x66, 0xd2,
+	0xe0, 0xe2, 0xcf, 0x14, 0xa9, 0xcd, 0x77, 0xb7, 0xb0, 0x9e, 0x06, 0x85, 0x4f, 0xff, 0x4d, 0x64,
+	0x2a, 0xcf, 0xc2, 0xad, 0x9c, 0xc3, 0x23, 0xf5, 0x85, 0xa8, 0x9b, 0x5d, 0x39, 0xe0, 0x1b, 0x87,
+	0x90, 0x25, 0x69, 0x38, 0xe0, 0x2a, 0x1d, 0x14, 0x60, 0xc7, 0xa8, 0x01, 0xf3, 0x4f, 0x08, 0xc1,
+	0xad, 0x5a, 0x09, 0xf2, 0x1e, 0x87, 0x34, 0xa5, 0x16, 0xbf, 0xac, 0xf9, 0xf3, 0x41, 0xb2, 0x9e,
+	0xa9, 0x15, 0x82, 0x64, 0x1d, 0x98, 0xd2, 0xe9, 0x6e, 0x5f, 0xac, 0x9f, 0x26, 0xe5, 0x09, 0x68,
+	0x82, 0x5e, 0x87, 0xba, 0x84, 0x38, 0x2c, 0x52, 0xde, 0x03, 0x84, 0x74, 0xe2, 0xf0, 0x5a, 0xd8,
+	0x63, 0x6b, 0x99, 0xbd, 0xe1, 0x90, 0x66, 0x40, 0xc8, 0x90, 0x54, 0xcb, 0xe7, 0x7f, 0xc3, 0x25,
+	0x44, 0xe9, 0xfb, 0xac, 0xa4, 0x71, 0xa5, 0xed, 0x12, 0xbf, 0xf7, 0xf2, 0x8b, 0x8a, 0x02, 0x48,
+	0x6b, 0x43, 0x1a, 0xc5, 0xc1, 0x1a, 0x9b, 0xdd, 0x4e, 0xf1, 0x96, 0x0d, 0x79, 0xf2, 0x60, 0xa1,
+	0x2a, 0x9d, 0x1f, 0xac, 0xb1, 0x24, 0xa5, 0x41, 0xca, 0x90, 0xfe, 0x1a, 0x35, 0x81, 0xde, 0xcb,
+	0xc9, 0xc1, 0x85, 0x60, 0x0b, 0xb9, 0xe1, 0x5c, 0x34, 0x58, 0xd9, 0x8c, 0x63, 0x36, 0x58, 0xd9,
+	0x16, 0x97, 0x16, 0x1b, 0x0a, 0x86, 0x89, 0x6b, 0x43, 0xe1, 0xcc, 0xaf, 0x4b, 0xeb, 0xcb, 0x31,
+	0xdd, 0xfa, 0x32, 0x8a, 0x13, 0xa7, 0x00, 0x50, 0x86, 0x1b, 0x5a, 0x9a, 0x88, 0xe1, 0x09, 0xd4,
+	0xc9, 0xf0, 0x6e, 0x8e, 0x61, 0x73, 0x22, 0x05, 0xeb, 0xc0, 0xe8, 0x20, 0xe1, 0x37, 0x28, 0x1d,
+	0xe6, 0xff, 0xd8, 0x21, 0x53, 0x79, 0x2d, 0x68, 0xd9, 0x72, 0x80, 0xe6, 0x97, 0x52, 0x18, 0x02,
+	0xbe, 0xb0, 0x79, 0x02, 0x76, 0xc2, 0x72, 0x94, 0x06, 0xbd, 0x4e, 0x2a, 0xc7, 0x30, 0x4b, 0x03,
+	0x6e, 0x21, 0xba, 0xc6, 0xba, 0x80, 0xe3, 0xe3, 0x96, 0xa5, 0x4d, 0xfe, 0xcb, 0x07, 0x4a, 0x01,
+	0x72, 0x57, 0x56, 0xce, 0x1c, 0x35, 0x08, 0xd0, 0xc2, 0x59, 0xff, 0x28, 0xa7, 0x05, 0x13, 0xde,
+	0xbd, 0xa4, 0x81, 0xf5, 0xb7, 0x9a, 0x86, 0xe0, 0xa0, 0xf7, 0xb0, 0x93, 0x52, 0x9e, 0xc7, 0x7f,
+	0x80, 0xec, 0x33, 0x11, 0xc0, 0x28, 0xdb, 0x57, 0xc4, 0x2a, 0x72, 0xdb, 0x57, 0x32, 0xdd, 0x9d,
+	0xab, 0x74, 0x77, 0xfe, 0x3c, 0x99, 0x34, 0xd6, 0x25, 0x4a, 0xad, 0x62, 0x86, 0x44, 0xd1, 0x2c,
+	0x0d, 0x7d, 0xcc, 0x32, 0x62, 0x2d, 0x0d, 0xaa, 0x00, 0xfe, 0x73, 0x0e, 0x99, 0x34, 0xae, 0x7a,
+	0xc0, 0x08, 0x68, 0xd8, 0x15, 0xda, 0x4b, 0xf8, 0x04, 0xc8, 0x62, 0xd8, 0xe5, 0x6c, 0x9a, 0xc2,
+	0x27, 0xd4, 0x89, 0x85, 0x70, 0xc5, 0xf3, 0xbd, 0xad, 0x00, 0xde, 0xcb, 0x09, 0xc1, 0xc4, 0x85,
+	0x30, 0x49, 0xa5, 0xbe, 0x67, 0x4a, 0x97, 0x9f, 0x00, 0x41, 0xb5, 0x3c, 0x70, 0x5f, 0xc4, 0x94,
+	0xbc, 0x46, 0x99, 0x36, 0x3f, 0x1d, 0x45, 0x8d, 0x8c, 0xfe, 0xed, 0x64, 0x2c, 0xab, 0x06, 0x2d,
+	0x92, 0xf0, 0x21, 0x98, 0x21, 0x4f, 0xf8, 0x5d, 0xd2, 0xa2, 0x43, 0x5d, 0x7e, 0x3e, 0x1b, 0xb2,
+	0x5e, 0x37, 0xc1, 0x55, 0x76, 0x9e, 0x4c, 0xe5, 0x44, 0x6d, 0xa9, 0x73, 0x3e, 0x56, 0x94, 0xc4,
+	0x55, 0x39, 0x5a, 0x28, 0xe5, 0x47, 0xe4, 0xb0, 0x35, 0x2b, 0xb0, 0xf3, 0x85, 0x24, 0xd5, 0x58,
+	0x83, 0x4c, 0x7a, 0xaf, 0x26, 0x04, 0xd8, 0x32, 0xcf, 0xdb, 0x72, 0xcb, 0x9a, 0x55, 0x79, 0xa8,
+	0x96, 0xdf, 0x9f, 0x33, 0x1a, 0x54, 0x08, 0xd8, 0x39, 0xa2, 0x4a, 0x3e, 0x0c, 0x22, 0xa5, 0x9d,
+	0x08, 0x70, 0xe8, 0xe1, 0xb7, 0xff, 0x2e, 0x97, 0x10, 0x65, 0x8f, 0xb2, 0xf2, 0x30, 0x7e, 0x70,
+	0xbb, 0xd9, 0xc1, 0xfd, 0x00, 0x19, 0x59, 0x8a, 0x57, 0x16, 0x50, 0x2d, 0xeb, 0x6a, 0x14, 0xf3,
+	0x6a, 0xf2, 0x17, 0x17, 0x91, 0x17, 0x4a, 0xb5, 0x59, 0xb2, 0x90, 0x70, 0x69, 0x65, 0xc7, 0x52,
+	0x3c, 0x2f, 0x2c, 0xeb, 0xf9, 0x41, 0xca, 0xe2, 0x6b, 0x41, 0x0f, 0x0f, 0xf9, 0x1a, 0xcd, 0xd2,
+	0x30, 0xd9, 0x6d, 0xd6, 0x0b, 0xb6, 0xf1, 0x98, 0xaf, 0x51, 0x9e, 0x80, 0x1e, 0xb4, 0xc3, 0xbe,
+	0x64, 0x5b, 0xf8, 0xed, 0xdd, 0x4d, 0x1a, 0x73, 0x41, 0xaf, 0x97, 0x88, 0x0d, 0x69, 0xda, 0xe1,
+	0x00, 0x43, 0x39, 0xde, 0x7f, 0x90, 0x8c, 0xab, 0xc1, 0xc0, 0x72, 0xfa, 0x8a, 0xb0, 0xd8, 0xef,
+	0x38, 0xde, 0xbf, 0x4a, 0x0e, 0x5b, 0xfb, 0x51, 0x7a, 0xc1, 0x94, 0x5b, 0xd5, 0xcd, 0x6d, 0xd5,
+	0x93, 0x64, 0x7f, 0xee, 0xfa, 0x26, 0x04, 0xa0, 0x3c, 0xd8, 0xbf, 0x20, 0xe7, 0x0d, 0x28, 0x87,
+	0x76, 0xe0, 0x57, 0xb6, 0x83, 0xb0, 0x43, 0xa4, 0x81, 0x13, 0x2f, 0xe5, 0x79, 0x4c, 0xe0, 0xc1,
+	0xd8, 0x0b, 0x83, 0x44, 0xd4, 0xcb, 0x13, 0xfe, 0x3f, 0x39, 0xa6, 0xbe, 0x06, 0x38, 0x5f, 0x27,
+	0x0e, 0xfb, 0x41, 0xbc, 0xad, 0x64, 0x0b, 0x0d, 0x82, 0x8a, 0xbf, 0x28, 0x4e, 0x01, 0xe9, 0x22,
+	0x52, 0x26, 0x41, 0xa2, 0xec, 0xc4, 0xd1, 0x90, 0xc5, 0x29, 0x16, 0xe5, 0xbc, 0x41, 0x07, 0xc1,
+	0x61, 0x26, 0x93, 0x97, 0xf0, 0xda, 0x52, 0xc7, 0x3c, 0x26, 0x10, 0x0e, 0x33, 0xe0, 0xb1, 0xc2,
+	0xa4, 0x9d, 0xd3, 0xc0, 0xd9, 0x50, 0xa0, 0xcf, 0x9d, 0x8b, 0xfa, 0xc3, 0x60, 0x05, 0x52, 0x99,
+	0x5e, 0xaa, 0x41, 0x73, 0x50, 0xff, 0x3a, 0x19, 0xd7, 0x58, 0x08, 0x2a, 0x52, 0xa3, 0x0d, 0x36,
+	0x48, 0xc4, 0x95, 0x4a, 0xa4, 0x60, 0x08, 0xf0, 0x2b, 0x7c, 0x06, 0x0e, 0x3b, 0x7e, 0xda, 0x68,
+	0x90, 0x32, 0x02, 0x6b, 0xa5, 0x04, 0xfa, 0x0f, 0x9b, 0x4c, 0xce, 0x3b, 0x69, 0xae, 0x2f, 0xaf,
+	0xc8, 0xed, 0xe4, 0x02, 0xfb, 0xe1, 0x21, 0x32, 0x3a, 0x17, 0xf5, 0xfb, 0xc1, 0xa0, 0xeb, 0xdd,
+	0x4d, 0xea, 0x29, 0x74, 0x0e, 0xe6, 0x7a, 0x9f, 0xa6, 0x52, 0x43, 0xec, 0x29, 0xe8, 0x21, 0xc5,
+	0x0c, 0xfe, 0x7b, 0x0f, 0xf1, 0x0d, 0xef, 0xdd, 0x4c, 0x0e, 0xcf, 0xc5, 0x2c, 0x48, 0x99, 0x5c,
+	0x67, 0x22, 0xf3, 0x54, 0xcd, 0xbb, 0x89, 0x1c, 0x6c, 0xc7, 0xd1, 0x30, 0x8f, 0xa8, 0x7b, 0xd3,
+	0xe4, 0x18, 0x2f, 0x93, 0x5b, 0x78, 0x32, 0x47, 0xc3, 0x3b, 0x4e, 0x8e, 0x42, 0xd1, 0x12, 0xfc,
+	0x88, 0x77, 0x82, 0x4c, 0x2f, 0xb1, 0xd4, 0x6e, 0x62, 0x90, 0xb9, 0x46, 0xa1, 0x1d, 0x7e, 0xa0,
+	0x96, 0xe4, 0x68, 0x7a, 0xb7, 0x90, 0x9b, 0x38, 0x25, 0xea, 0x2a, 0x25, 0x91, 0x63, 0x80, 0xe4,
+	0x32, 0x75, 0x11, 0x49, 0xbc, 0xc3, 0xe4, 0x00, 0x2f, 0x09, 0x67, 0xa5, 0x04, 0x4f, 0x7a, 0x07,
+	0xc9, 0x7e, 0x20, 0x5c, 0x07, 0xee, 0x83, 0xbc, 0x9c, 0x0e, 0x1d, 0xbc, 0x1f, 0xc6, 0x67, 0x89,
+	0xa5, 0xd9, 0x69, 0x29, 0x11, 0x53, 0x9e, 0x47, 0xf6, 0x41, 0xef, 0x82, 0x34, 0x90, 0xb0, 0x03,
+	0xde, 0x31, 0xd2, 0x5a, 0x62, 0x29, 0x8a, 0x9a, 0x85, 0x12, 0x9e, 0x77, 0x2b, 0xb9, 0x59, 0xf4,
+	0x43, 0x93, 0xa9, 0x25, 0xfa, 0x30, 0xf6, 0x24, 0x8e, 0x86, 0x36, 0xe4, 0x11, 0x35, 0x83, 0xd2,
+	0x05, 0x44, 0xa2, 0x5a, 0xe6, 0xe4, 0xea, 0xa8, 0x9b, 0x01, 0xc5, 0xfb, 0x94, 0x47, 0x1d, 0x05,
+	0x14, 0x1f, 0xb7, 0x7c, 0x85, 0xb7, 0x28, 0x54, 0xbe, 0xd4, 0x31, 0xef, 0x08, 0xf1, 0x96, 0x58,
+	0x9a, 0x2f, 0x72, 0xab, 0x77, 0x88, 0x4c, 0x21, 0xed, 0x30, 0x07, 0x12, 0x7a, 0x1c, 0x3a, 0x8c,
+	0x77, 0x1e, 0xb1, 0xb6, 0x78, 0xa5, 0x12, 0x7d, 0x1b, 0x74, 0x98, 0x53, 0xa7, 0xee, 0x00, 0x12,
+	0x79, 0x07, 0x2c, 0x1e, 0x28, 0x9b, 0x5b, 0x14, 0x66, 0x15, 0x77, 0xc3, 0x80, 0xcb, 0x61, 0xc9,
+	0xf8, 0xae, 0xc4, 0xde, 0x0f, 0x54, 0x9d, 0xee, 0xa5, 0x2c, 0x96, 0x57, 0xa2, 0xb9, 0x7e, 0x77,
+	0x6a, 0x06, 0x26, 0x9a, 0xf2, 0x26, 0xc3, 0xc1, 0x9a, 0xcc, 0xfc, 0x00, 0x4c, 0xb4, 0xa0, 0x06,
+	0x35, 0x8c, 0x12, 0xf1, 0x0a, 0x40, 0x50, 0x36, 0x8c, 0xe2, 0x14, 0xcb, 0x24, 0x12, 0xf1, 0x20,
+	0x0c, 0x46, 0x27, 0xde, 0x1c, 0x30, 0xae, 0xfb, 0x91, 0xf0, 0x57, 0xc2, 0x8a, 0x06, 0xd2, 0x35,
+	0x92, 0x4c, 0xb2, 0x1f, 0xf1, 0x8e, 0x92, 0x23, 0x30, 0x5c, 0x16, 0xa2, 0x5f, 0x05, 0x44, 0x03,
+	0xeb, 0xa0, 0xe0, 0xfd, 0x20, 0xa1, 0xaf, 0xf6, 0x5a, 0xe4, 0x10, 0x36, 0x2f, 0x59, 0x89, 0xc4,
+	0xbc, 0x46, 0x6d, 0x00, 0xa5, 0x87, 0x92, 0xc8, 0x47, 0x61, 0x8b, 0x6a, 0x43, 0x0c, 0xac, 0x04,
+	0xae, 0xc8, 0x12, 0xff, 0x5a, 0x35, 0x05, 0x30, 0x9d, 0xdc, 0xc8, 0x29, 0x91, 0xaf, 0x83, 0xfe,
+	0xf1, 0xc1, 0x45, 0x1f, 0x19, 0x09, 0x3f, 0x0d, 0x70, 0x5e, 0xc8, 0x80, 0xcf, 0xaa, 0x11, 0xe4,
+	0x06, 0x61, 0x89, 0x98, 0x83, 0x02, 0x94, 0xf5, 0xa3, 0x6b, 0x66, 0x01, 0xb0, 0xbd, 0xdf, 0x2a,
+	0x56, 0x6e, 0x4e, 0xf5, 0x25, 0xb3, 0x9c, 0xf1, 0x6e, 0x23, 0xb7, 0x20, 0x7b, 0x2a, 0xc9, 0x70,
+	0x16, 0x7a, 0x78, 0x8e, 0xa5, 0x65, 0xf8, 0x73, 0xda, 0xee, 0xb8, 0xc2, 0x9d, 0x28, 0x24, 0xea,
+	0xbc, 0xf7, 0x12, 0x72, 0xe7, 0x39, 0x96, 0x6a, 0x93, 0x00, 0x54, 0x5f, 0x0e, 0xd3, 0xf5, 0x10,
+	0xea, 0x62, 0x34, 0x1b, 0xc7, 0x79, 0x58, 0x8d, 0xda, 0x38, 0xaa, 0xd6, 0xf4, 0x7e, 0xbe, 0x1e,
+	0x06, 0x00, 0x26, 0x1e, 0x5c, 0x93, 0xa2, 0x6b, 0x6a, 0x98, 0x1f, 0x93, 0x08, 0xe9, 0x4a, 0x24,
+	0x11, 0x17, 0x00, 0x21, 0x58, 0x02, 0x3f, 0xca, 0x05, 0x62, 0x01, 0x16, 0x29, 0x6e, 0x28, 0x03,
+	0x0c, 0xe6, 0xa8, 0xe3, 0x45, 0x92, 0xf1, 0xd0, 0x96, 0x79, 0x16, 0xa1, 0xc7, 0x97, 0x58, 0x1c,
+	0xae, 0x6e, 0xe7, 0xb7, 0x6f, 0x07, 0x9a, 0x3b, 0xb3, 0x35, 0x0c, 0x06, 0x5d, 0x73, 0xc9, 0x3e,
+	0x0e, 0x0b, 0x52, 0x4e, 0x9d, 0xd0, 0x35, 0x4a, 0x1c, 0x85, 0xfa, 0x60, 0x84, 0x67, 0x67, 0xe3,
+	0x90, 0xad, 0xea, 0x1d, 0x5e, 0x12, 0x83, 0xaf, 0x4b, 0xd6, 0x3a, 0x7e, 0x19, 0x76, 0x02, 0x65,
+	0x6b, 0x21, 0x9c, 0x81, 0xc2, 0xeb, 0x64, 0x71, 0x75, 0x35, 0x61, 0xd9, 0x12, 0x78, 0x42, 0x9d,
+	0x32, 0x39, 0x65, 0x9e, 0xcc, 0x71, 0x09, 0x79, 0xea, 0xd5, 0xde, 0x0c, 0xf0, 0x9c, 0xf3, 0x2c,
+	0x88, 0xd3, 0x2b, 0x2c, 0xc8, 0xca, 0x5f, 0xc6, 0xf2, 0x66, 0x49, 0xbe, 0x57, 0x65, 0x8e, 0xff,
+	0x23, 0x86, 0x2c, 0x97, 0xe9, 0x02, 0xd3, 0xce, 0xba, 0xff, 0x2b, 0x4f, 0xb2, 0x12, 0x1a, 0xde,
+	0x00, 0xab, 0xf0, 0x62, 0x94, 0x86, 0xab, 0xdb, 0x73, 0x8f, 0xf3, 0x92, 0xe8, 0x9b, 0x94, 0x71,
+	0xba, 0x27, 0x61, 0x25, 0x2f, 0xb1, 0x14, 0x37, 0x91, 0xe9, 0x32, 0x20, 0xb3, 0xbc, 0x91, 0xb3,
+	0x1d, 0xd8, 0x04, 0xfa, 0x94, 0xfc, 0x3f, 0xe8, 0x9e, 0x3c, 0xfe, 0x94, 0x7d, 0x55, 0x60, 0xdf,
+	0x04, 0x1c, 0x54, 0xed, 0xcf, 0xe5, 0xfe, 0x10, 0xf7, 0xb8, 0x44, 0xff, 0x7f, 0xe0, 0x0a, 0x62,
+	0xf9, 0x70, 0x9f, 0x25, 0x89, 0x79, 0x4a, 0xdb, 0xf8, 0x1c, 0x63, 0x52, 0x13, 0xc0, 0x96, 0x9c,
+	0x1f, 0x24, 0x2c, 0x4e, 0xcf, 0x86, 0x3d, 0x96, 0xc1, 0xaf, 0x28, 0x72, 0x2c, 0xbc, 0x09, 0xfc,
+	0x1b, 0x6e, 0x91, 0xd8, 0x34, 0x28, 0x56, 0xbb, 0x8a, 0xe7, 0xc3, 0x7a, 0x74, 0x5d, 0xc8, 0x3d,
+	0x12, 0xbe, 0x06, 0x9d, 0x47, 0xf5, 0x96, 0x71, 0x40, 0xac, 0xc3, 0xd2, 0x52, 0xe0, 0x76, 0x34,
+	0xc8, 0x3a, 0x10, 0x2a, 0xce, 0x6e, 0x19, 0x97, 0xa7, 0x25, 0x03, 0xb5, 0xe0, 0x36, 0xbc, 0xbb,
+	0x88, 0x5f, 0x1c, 0xd1, 0xcc, 0x62, 0x2d, 0xf3, 0xf5, 0xa0, 0x0e, 0xca, 0x12, 0xd8, 0x3b, 0x79,
+	0xe1, 0xa7, 0xef, 0x9d, 0x24, 0x27, 0xac, 0xcb, 0x52, 0xea, 0x98, 0x65, 0xce, 0x01, 0xe4, 0xe4,
+	0xad, 0xed, 0x90, 0x33, 0x52, 0x7b, 0x9d, 0xab, 0x8c, 0x24, 0x62, 0x28, 0xf7, 0xba, 0x09, 0xbe,
+	0xaa, 0xe6, 0x9e, 0x23, 0x16, 0x58, 0xff, 0x8a, 0x1a, 0xd2, 0x58, 0xcd, 0x14, 0x47, 0x3f, 0x91,
+	0x04, 0xea, 0xbc, 0x48, 0x54, 0x61, 0x5d, 0xb7, 0x20, 0xd1, 0xe9, 0x3d, 0xcd, 0x66, 0x77, 0xea,
+	0xd9, 0x67, 0x9f, 0x7d, 0xd6, 0xf5, 0x7f, 0xe0, 0x96, 0xc8, 0x86, 0xd6, 0xab, 0x4b, 0xbb, 0x78,
+	0x3d, 0xe1, 0x66, 0xbb, 0x2a, 0xb7, 0x90, 0x7c, 0x11, 0x10, 0xac, 0xa5, 0x15, 0x6c, 0xb3, 0x8f,
+	0xf2, 0xf2, 0x24, 0xd5, 0x20, 0xde, 0x9d, 0xa4, 0xb6, 0xb4, 0x11, 0xa2, 0xaa, 0xa6, 0xc4, 0x44,
+	0x0e, 0x78, 0x8b, 0xfb, 0x46, 0xc3, 0xea, 0xbe, 0xb1, 0x17, 0x17, 0x8d, 0x99, 0xb3, 0x64, 0x74,
+	0x45, 0x0c, 0xc0, 0x3e, 0x53, 0xb2, 0x6e, 0xad, 0x4d, 0x3b, 0xda, 0xbd, 0xd5, 0x3a, 0x68, 0x54,
+	0x16, 0xf6, 0x23, 0xab, 0x5c, 0x6d, 0x1b, 0xd4, 0x99, 0x76, 0x79, 0x93, 0xeb, 0xc6, 0xe0, 0x5a,
+	0x2a, 0x54, 0x0d, 0xfe, 0xb3, 0x53, 0x2d, 0xb0, 0x57, 0x6a, 0x88, 0xac, 0xf3, 0xea, 0xee, 0x75,
+	0x5e, 0xd1, 0x26, 0xc1, 0xa5, 0xfd, 0x8e, 0xd0, 0xbb, 0x2a, 0xc0, 0xcc, 0x42, 0x79, 0x37, 0x43,
+	0xec, 0xe6, 0x1d, 0xc6, 0xc8, 0xda, 0x7b, 0xa1, 0xfa, 0xfb, 0x7e, 0xa7, 0xea, 0xfa, 0x51, 0xd9,
+	0x5b, 0x39, 0x09, 0xae, 0x36, 0x09, 0x8f, 0x95, 0x53, 0xf7, 0x34, 0x52, 0x77, 0xbb, 0x36, 0x09,
+	0x3b, 0xd1, 0xf6, 0x51, 0x67, 0xe7, 0xab, 0xcf, 0x9e, 0x29, 0x7c, 0xbc, 0x9c, 0xc2, 0x0d, 0xa4,
+	0xf0, 0x6e, 0xb9, 0x53, 0x76, 0x68, 0x59, 0xd1, 0xf9, 0xb9, 0x5a, 0xf5, 0xe5, 0x6b, 0xaf, 0x34,
+	0x82, 0x56, 0xe0, 0x22, 0xbb, 0x2e, 0x74, 0x82, 0xe8, 0xb2, 0x27, 0x92, 0x86, 0x55, 0xbd, 0x9e,
+	0x73, 0xd8, 0xd1, 0xad, 0xe4, 0x8d, 0x9c, 0x03, 0x8e, 0xdd, 0xe2, 0x3e, 0x52, 0xea, 0xcc, 0x83,
+	0x26, 0xe5, 0x0d, 0x26, 0x06, 0x00, 0xed, 0x3b, 0x4d, 0xaa, 0x83, 0x8a, 0x26, 0x65, 0x67, 0x67,
+	0x93, 0xb2, 0xb3, 0x6b, 0x93, 0xb2, 0x63, 0x37, 0x29, 0x57, 0xad, 0xfe, 0x9e, 0xb1, 0xfa, 0xab,
+	0xe6, 0x43, 0xcd, 0xdc, 0x2f, 0xb8, 0xa5, 0x97, 0xe2, 0xca, 0x49, 0x3b, 0x42, 0x46, 0x0c, 0x0f,
+	0xc0, 0x11, 0xb5, 0x75, 0xe1, 0xd6, 0x91, 0xa4, 0x41, 0x7f, 0x28, 0x4c, 0x8d, 0x0a, 0x00, 0x58,
+	0x6c, 0x06, 0x6d, 0x6d, 0x75, 0x1e, 0x33, 0x91, 0x01, 0x72, 0x06, 0xc2, 0x86, 0xcd, 0x40, 0x28,
+	0x84, 0x4a, 0x1c, 0x9f, 0x49, 0x2a, 0x93, 0x33, 0xe7, 0xcb, 0x07, 0xa5, 0x3f, 0xed, 0x68, 0xee,
+	0xe7, 0x25, 0x5d, 0x55, 0xe3, 0xf1, 0x63, 0xa7, 0x54, 0x0f, 0xf0, 0x82, 0xc6, 0xc3, 0x27, 0x13,
+	0xaa, 0xa2, 0x2c, 0x8e, 0xc5, 0x80, 0x99, 0x26, 0x58, 0xbe, 0x22, 0x15, 0x00, 0x46, 0x85, 0x27,
+	0x32, 0xb3, 0x69, 0x83, 0x6a, 0x90, 0xaa, 0xbe, 0x0f, 0x8c, 0xbe, 0x97, 0x74, 0x4b, 0xf5, 0xfd,
+	0x13, 0x8e, 0x45, 0xcd, 0x71, 0x63, 0x0c, 0x65, 0x33, 0xb3, 0xe5, 0x54, 0x5f, 0x45, 0xaa, 0x5b,
+	0xc6, 0x8c, 0x69, 0x04, 0x29, 0x7a, 0xd7, 0x0a, 0xea, 0x17, 0xeb, 0xb1, 0xf8, 0xba, 0xf2, 0xa6,
+	0x62, 0xc3, 0x71, 0x32, 0x57, 0x99, 0x6a, 0xe8, 0xad, 0x16, 0x95, 0xce, 0x6e, 0xc7, 0xa5, 0xaa,
+	0xa7, 0x89, 0xd1, 0xd3, 0x42, 0x13, 0x8a, 0x80, 0x4f, 0x39, 0x56, 0xed, 0x11, 0xac, 0x48, 0xc8,
+	0x3f, 0x50, 0x74, 0x64, 0xe9, 0x4a, 0xed, 0xb0, 0x61, 0xc8, 0xa9, 0xe5, 0x0c, 0x39, 0x55, 0x72,
+	0x44, 0x6a, 0xc8, 0x11, 0x16, 0x92, 0x14, 0xcd, 0x71, 0x5e, 0xaf, 0xe5, 0xdd, 0xc6, 0x43, 0xc0,
+	0x84, 0x5f, 0xf3, 0xb8, 0x16, 0x30, 0x41, 0x11, 0x31, 0xf3, 0xda, 0xf2, 0x86, 0x37, 0xa7, 0x1d,
+	0xcd, 0x02, 0x66, 0x56, 0xac, 0xda, 0x7c, 0xaf, 0x53, 0xae, 0x38, 0xab, 0x1c, 0xac, 0x6c, 0xf1,
+	0xba, 0xda, 0xe2, 0x9d, 0x99, 0x2f, 0xa7, 0xe7, 0x1a, 0xd2, 0x73, 0x9b, 0xa2, 0xc7, 0xda, 0xa6,
+	0xc1, 0x57, 0xca, 0x95, 0x76, 0x37, 0x4e, 0xbb, 0x9f, 0x59, 0xd4, 0xeb, 0x15, 0x16, 0xf5, 0x46,
+	0xd1, 0xa2, 0x3e, 0xf3, 0xfa, 0xf2, 0xae, 0x6f, 0x63, 0xd7, 0xa7, 0x4d, 0x8e, 0x5a, 0xec, 0x94,
+	0xea, 0xfb, 0x97, 0x9c, 0x52, 0x8d, 0xe4, 0x8d, 0xeb, 0x79, 0x15, 0x5f, 0x7c, 0xc6, 0xe4, 0x8b,
+	0x76, 0xd2, 0x14, 0xfd, 0x5f, 0x75, 0x4a, 0x94, 0xa6, 0x40, 0xe9, 0xf9, 0xe5, 0xe5, 0x0e, 0xc6,
+	0x03, 0x88, 0x25, 0x25, 0xd3, 0x7a, 0x3c, 0x02, 0x1f, 0xfc, 0x5c, 0x3c, 0x02, 0x62, 0x78, 0xf7,
+	0x64, 0x12, 0x46, 0x83, 0x02, 0x81, 0xfc, 0x94, 0xc0, 0xef, 0xaa, 0x8b, 0xc4, 0x9b, 0x2d, 0x17,
+	0x89, 0x1c, 0x89, 0xaa, 0x17, 0x5f, 0x70, 0x4a, 0xf4, 0xbb, 0x3b, 0xf5, 0xa2, 0x82, 0xd6, 0x5c,
+	0x0c, 0x83, 0x08, 0x2e, 0x18, 0x97, 0xc1, 0x05, 0x55, 0xb4, 0xbf, 0xa5, 0xe4, 0x12, 0x64, 0xa5,
+	0xfd, 0x32, 0x99, 0x94, 0x38, 0x54, 0xfd, 0x65, 0x2e, 0x54, 0x40, 0xee, 0x84, 0x72, 0xa1, 0x42,
+	0xa4, 0x66, 0x9a, 0x54, 0x00, 0x15, 0xd2, 0x51, 0xd3, 0x42, 0x3a, 0xc0, 0xd6, 0x6a, 0xd5, 0x5e,
+	0xe7, 0x9d, 0x8c, 0xaa, 0x7a, 0xf2, 0x56, 0xa3, 0x27, 0xd6, 0xea, 0x54, 0x4f, 0x86, 0x25, 0x3a,
+	0xf1, 0x42, 0x83, 0xe7, 0xca, 0x1b, 0x7c, 0xd6, 0xb1, 0xb4, 0x58, 0x3a, 0x76, 0x67, 0x41, 0x28,
+	0x4e, 0x86, 0xd1, 0x20, 0xc1, 0xf9, 0x59, 0x7c, 0x0c, 0x1b, 0x69, 0x52, 0x77, 0xf1, 0x31, 0xe5,
+	0x76, 0xe0, 0xea, 0x6e, 0x07, 0x59, 0x38, 0x2e, 0xf7, 0xee, 0xe1, 0x09, 0xff, 0xcb, 0x8e, 0x4d,
+	0x67, 0xff, 0xa2, 0x6c, 0x81, 0x8a, 0x03, 0xe9, 0x6d, 0x7c, 0x2c, 0x6e, 0x56, 0x8c, 0xb8, 0x74,
+	0xe8, 0x57, 0x8b, 0xb6, 0x85, 0xc2, 0xa8, 0x57, 0x1c, 0xd6, 0x6f, 0xe7, 0x2d, 0xdd, 0xa4, 0x73,
+	0x0d, 0xad, 0x2a, 0xd5, 0xce, 0x9b, 0x2b, 0xac, 0x15, 0x56, 0x01, 0xa5, 0xe2, 0xca, 0xf8, 0x0e,
+	0xc7, 0x60, 0xb6, 0xa5, 0xf5, 0xaa, 0xd6, 0xbf, 0xe9, 0x94, 0x5a, 0x43, 0x54, 0x90, 0x45, 0x57,
+	0xb8, 0x32, 0xca, 0x24, 0x60, 0x30, 0xa7, 0xf0, 0x0e, 0xa9, 0x51, 0x99, 0x04, 0x01, 0xae, 0x7d,
+	0x45, 0x5c, 0xc4, 0x50, 0xb0, 0xe5, 0x29, 0x80, 0xd3, 0x21, 0xc2, 0xf9, 0xd4, 0x8a, 0x54, 0xd5,
+	0x99, 0xf9, 0xb3, 0x8e, 0xc1, 0x77, 0x4b, 0xa8, 0x54, 0x5d, 0xf9, 0x98, 0xb3, 0xb3, 0xed, 0x66,
+	0xcf, 0xb7, 0x5f, 0x5a, 0x4e, 0xdf, 0xbb, 0x1c, 0xe3, 0xfa, 0xbb, 0x53, 0xd3, 0x8a, 0xd0, 0xbf,
+	0xae, 0x95, 0x9b, 0x8f, 0x70, 0x00, 0x67, 0xb5, 0x39, 0x17, 0x29, 0x6d, 0x00, 0x5d, 0x7d, 0x00,
+	0x33, 0xa2, 0x6b, 0xda, 0x89, 0xb8, 0x4b, 0x45, 0xd6, 0x09, 0xe2, 0xce, 0xd3, 0xca, 0xe0, 0x0b,
+	0x77, 0x9e, 0xde, 0xb8, 0x88, 0x8b, 0x19, 0x42, 0xb8, 0xcd, 0x0b, 0x8b, 0x35, 0x0d, 0x53, 0x34,
+	0xfa, 0x0c, 0x70, 0x2c, 0xd5, 0x72, 0xe9, 0x01, 0x0f, 0x63, 0xd5, 0x01, 0x0f, 0xbb, 0x0e, 0xaa,
+	0xa8, 0x92, 0x5d, 0xde, 0xed, 0x18, 0x72, 0x5b, 0xd9, 0xa4, 0xa9, 0xa9, 0xfd, 0x8a, 0x53, 0xb4,
+	0xfd, 0xbd, 0x88, 0x53, 0x5a, 0xc5, 0x90, 0xde, 0x63, 0x32, 0xa4, 0x3c, 0x95, 0xaa, 0x0f, 0xdf,
+	0xca, 0x58, 0x02, 0xd8, 0xae, 0x0c, 0x55, 0x3b, 0x0f, 0xfe, 0x4a, 0x36, 0x94, 0x73, 0x1c, 0x4f,
+	0x65, 0x4e, 0x73, 0x5d, 0xe1, 0xaf, 0x23, 0x52, 0xe8, 0x53, 0x36, 0x2b, 0x3a, 0xe2, 0xb6, 0x67,
+	0x21, 0xdd, 0x59, 0x16, 0x21, 0x09, 0x6e, 0x67, 0x59, 0x9d, 0x28, 0x0d, 0xed, 0x44, 0xa9, 0x62,
+	0x0a, 0xef, 0xb5, 0x31, 0x85, 0x02, 0x9d, 0xaa, 0x33, 0xff, 0xea, 0x58, 0xcc, 0xae, 0x3b, 0x5d,
+	0xcd, 0xad, 0xb3, 0xb2, 0xcb, 0xab, 0x39, 0x1a, 0x16, 0x50, 0x72, 0x10, 0xde, 0xd1, 0x19, 0x00,
+	0x34, 0x40, 0x98, 0x7b, 0x36, 0xda, 0x1c, 0x74, 0xa5, 0x1c, 0xad, 0x83, 0x66, 0xe6, 0xca, 0x3b,
+	0xfe, 0x3e, 0xc7, 0xb8, 0xfd, 0x15, 0xfa, 0xa4, 0xba, 0xfc, 0x5f, 0x8e, 0xc5, 0xea, 0x71, 0xc3,
+	0xba, 0xac, 0x45, 0xe2, 0xd5, 0xcd, 0x48, 0x3c, 0x70, 0x12, 0x06, 0x32, 0xc0, 0x1b, 0xa7, 0xc1,
+	0x5b, 0x94, 0x69, 0xe1, 0x5b, 0xc8, 0x3d, 0xa5, 0xb9, 0x6f, 0x61, 0xbb, 0xaa, 0xf3, 0x7f, 0x6a,
+	0x76, 0xbe, 0xd0, 0x3b, 0xd5, 0xf9, 0xef, 0x3b, 0x25, 0xb6, 0x9d, 0x17, 0x7f, 0x00, 0xaa, 0x64,
+	0xb2, 0x6f, 0x99, 0x32, 0x99, 0x95, 0x62, 0xd5, 0xa9, 0x4f, 0x3a, 0xe5, 0x56, 0xa9, 0x9d, 0xfa,
+	0x25, 0x62, 0x35, 0x5d, 0x6b, 0xac, 0x66, 0x4d, 0xc5, 0x6a, 0x56, 0xb1, 0xc1, 0x6f, 0xdb, 0xd8,
+	0x60, 0x91, 0x14, 0x45, 0xf0, 0xfb, 0x9c, 0x32, 0x43, 0x59, 0x25, 0xb9, 0x2d, 0x32, 0xda, 0x89,
+	0xa3, 0x7e, 0x94, 0x32, 0x71, 0xb1, 0x96, 0xc9, 0xaa, 0xdb, 0xd9, 0x77, 0x38, 0x71, 0xb7, 0x1a,
+	0x7a, 0xf2, 0x72, 0xd2, 0xfe, 0xce, 0xd9, 0x8d, 0x9d, 0x6e, 0x27, 0x32, 0xe5, 0x8c, 0xbb, 0xd6,
+	0xe0, 0xd3, 0x5a, 0x59, 0xf0, 0x69, 0x3d, 0x1f, 0x7c, 0x3a, 0xb3, 0x5c, 0xde, 0xb1, 0x3f, 0xe3,
+	0x1d, 0x7b, 0x49, 0x4e, 0x41, 0x5b, 0x4e, 0xb4, 0xea, 0xe4, 0xcf, 0xb8, 0x65, 0x46, 0x46, 0x88,
+	0x56, 0x36, 0x3a, 0x56, 0xf2, 0xc0, 0x8d, 0xea, 0x6d, 0xf6, 0xbe, 0x8d, 0x5b, 0xf9, 0xbe, 0x8d,
+	0xf6, 0xea, 0x48, 0x6d, 0xc7, 0x57, 0x47, 0x8e, 0x91, 0x31, 0x78, 0x44, 0xe5, 0x7a, 0x1c, 0xa6,
+	0x7c, 0x50, 0x9a, 0x54, 0x01, 0xaa, 0x26, 0xfb, 0xbb, 0xe6, 0x64, 0xdb, 0xfb, 0xa8, 0xc6, 0xe1,
+	0x47, 0x8e, 0xd5, 0xbb, 0xe6, 0x05, 0xf1, 0x02, 0xd0, 0xf0, 0xab, 0x93, 0x5f, 0x6c, 0x1d, 0x1d,
+	0xe4, 0x3d, 0x4c, 0x26, 0x51, 0x6e, 0x59, 0x8e, 0xf8, 0x0e, 0x69, 0xd5, 0x4b, 0x65, 0x1a, 0x33,
+	0xe3, 0xcc, 0x99, 0xf2, 0x1e, 0xbf, 0xdf, 0x31, 0x74, 0x68, 0x96, 0xde, 0xa8, 0xee, 0xae, 0x90,
+	0x71, 0xad, 0x11, 0x18, 0x65, 0x4c, 0x6a, 0xa2, 0x87, 0x02, 0x64, 0xd8, 0xec, 0xfe, 0xdb, 0xa0,
+	0x0a, 0x60, 0x46, 0x00, 0x19, 0x31, 0x8d, 0x97, 0x85, 0xe3, 0xb3, 0x35, 0xb8, 0xe6, 0x68, 0x3e,
+	0xb8, 0x46, 0x0b, 0xac, 0x31, 0x83, 0x53, 0x6a, 0xf9, 0xe0, 0x14, 0xff, 0x79, 0x87, 0xec, 0x33,
+	0x83, 0xdc, 0x5e, 0xa4, 0xa8, 0xa5, 0x7b, 0x44, 0xe4, 0x0e, 0xcb, 0x87, 0x2d, 0x65, 0xfd, 0xa4,
+	0x32, 0xc3, 0x4e, 0xd2, 0xb0, 0xff, 0x36, 0x47, 0x1c, 0xf2, 0xe2, 0xe9, 0x86, 0xf2, 0x40, 0x75,
+	0x69, 0xdc, 0x58, 0x0a, 0x9f, 0x61, 0x82, 0x8f, 0x28, 0x00, 0xca, 0x0a, 0x18, 0xc8, 0x30, 0x17,
+	0x6d, 0x8a, 0xd5, 0xd6, 0xa0, 0x3a, 0x08, 0x6a, 0x5e, 0x08, 0xb6, 0x34, 0xb6, 0x22, 0x93, 0xfe,
+	0x93, 0x64, 0x92, 0x0e, 0x75, 0x22, 0xd4, 0x92, 0x76, 0x8c, 0x25, 0x3d, 0x43, 0x48, 0x96, 0x2d,
+	0x11, 0x96, 0x57, 0x4f, 0x97, 0x2d, 0x79, 0x79, 0xaa, 0xe5, 0xf2, 0x9f, 0x22, 0x04, 0xde, 0xe5,
+	0x10, 0x35, 0x73, 0xf9, 0xce, 0xc9, 0xe4, 0x3b, 0x79, 0xae, 0xab, 0x98, 0x81, 0xb6, 0x77, 0x8a,
+	0x8c, 0xd2, 0x21, 0x6f, 0xa2, 0x66, 0x44, 0xb8, 0x18, 0x44, 0x52, 0x99, 0xc9, 0xff, 0x65, 0x87,
+	0xdc, 0xa4, 0x7b, 0xbe, 0x5d, 0x88, 0x82, 0x4c, 0x8a, 0xe1, 0xaf, 0x82, 0x2c, 0x43, 0xc6, 0x9c,
+	0x73, 0xb4, 0x22, 0x8a, 0x66, 0x59, 0xaa, 0x04, 0xc9, 0x0f, 0x98, 0x82, 0x64, 0x49, 0x83, 0x6a,
+	0x6f, 0x7d, 0xdd, 0xb1, 0xc7, 0x58, 0x7a, 0x2f, 0x97, 0x4e, 0xde, 0x8e, 0xf1, 0xbc, 0x84, 0xca,
+	0xbb, 0x38, 0x64, 0x71, 0x90, 0x46, 0x71, 0x22, 0xbc, 0xbd, 0xbd, 0x73, 0xc4, 0xcb, 0xd5, 0xc4,
+	0xa3, 0x74, 0x8c, 0xc7, 0x79, 0x72, 0x4d, 0x51, 0x4b, 0x11, 0xc3, 0xb8, 0x59, 0xcb, 0x85, 0x0c,
+	0x2b, 0x49, 0x9d, 0x3f, 0xb4, 0x22, 0x52, 0xfe, 0x9b, 0xc9, 0x54, 0xbe, 0x6e, 0xf0, 0x68, 0x90,
+	0x7e, 0x65, 0xc2, 0xe7, 0x9d, 0xdf, 0xf7, 0x73, 0x50, 0x10, 0x87, 0x60, 0x81, 0x65, 0xb9, 0xf8,
+	0x0e, 0x34, 0x60, 0xb0, 0xac, 0x2f, 0x07, 0x29, 0x8b, 0x61, 0x63, 0x4b, 0x8b, 0x5e, 0x06, 0xf0,
+	0xe7, 0xc9, 0x41, 0xcb, 0xc0, 0x00, 0xb1, 0xa7, 0xd7, 0xd6, 0x16, 0x87, 0x59, 0xe4, 0x00, 0x4f,
+	0x49, 0x3e, 0xad, 0xa9, 0xe8, 0xb2, 0xb4, 0xff, 0x56, 0x72, 0xcc, 0x36, 0x1f, 0xe0, 0x48, 0xd7,
+	0xbe, 0x42, 0x87, 0xde, 0x7d, 0xa4, 0x0e, 0x69, 0x71, 0xc8, 0x55, 0xc6, 0xc0, 0xd6, 0x65, 0xe0,
+	0x8f, 0x50, 0x5d, 0xb8, 0x25, 0xaa, 0x8b, 0x9a, 0xbe, 0x7b, 0xfc, 0x27, 0xc9, 0xf1, 0xe2, 0x9c,
+	0x18, 0x24, 0xbc, 0xd2, 0xf4, 0xb3, 0xbe, 0xa3, 0x82, 0x06, 0x59, 0x46, 0x3a, 0x5e, 0x2f, 0x93,
+	0xa3, 0x39, 0x9f, 0x3f, 0xce, 0xf9, 0x11, 0xeb, 0x3d, 0x68, 0x56, 0x3c, 0xad, 0xef, 0x59, 0x5b,
+	0x09, 0x59, 0x6b, 0x44, 0x6e, 0x2e, 0xcd, 0xe3, 0xbd, 0x14, 0x02, 0xf2, 0xe0, 0x68, 0xe3, 0x23,
+	0x76, 0x44, 0xaf, 0x14, 0x11, 0xe1, 0x6a, 0x08, 0x2f, 0xfe, 0xe0, 0x37, 0x38, 0xd3, 0x6b, 0xd1,
+	0x8b, 0xd7, 0xe4, 0x62, 0x30, 0x81, 0xfe, 0xcf, 0x3b, 0x36, 0x67, 0x55, 0xe0, 0xa2, 0x4a, 0x86,
+	0x16, 0x0a, 0x46, 0x0d, 0x92, 0x85, 0x7e, 0x88, 0x77, 0x0e, 0xaa, 0x34, 0x7a, 0xbf, 0x6a, 0x6a,
+	0xf4, 0x8a, 0x8d, 0xa9, 0x2d, 0xfc, 0x35, 0xa7, 0xda, 0x43, 0xf6, 0x05, 0x59, 0x6c, 0x77, 0x14,
+	0x0b, 0x66, 0x2e, 0x96, 0x13, 0xff, 0x41, 0xc7, 0xb0, 0xc1, 0x57, 0x11, 0xa7, 0xba, 0xf1, 0x79,
+	0xa7, 0xcc, 0x8d, 0xf7, 0x06, 0x75, 0xa0, 0x42, 0x1e, 0xfb, 0xb5, 0xa2, 0xf0, 0x5d, 0xa5, 0x1e,
+	0xf9, 0x6f, 0x87, 0x4c, 0x0a, 0xff, 0xbd, 0x38, 0x95, 0x01, 0xd3, 0x40, 0x21, 0x57, 0x20, 0xf3,
+	0x13, 0x52, 0x01, 0xb4, 0x58, 0x3c, 0x37, 0x1f, 0x8b, 0x07, 0xe1, 0x68, 0xfc, 0x40, 0x99, 0xa4,
+	0x3c, 0xe1, 0x3d, 0x48, 0xc6, 0x24, 0xfb, 0x93, 0xc1, 0x5f, 0x2d, 0x63, 0x67, 0x08, 0xa4, 0x78,
+	0xc1, 0x51, 0x66, 0x55, 0xba, 0xfe, 0x86, 0xfe, 0x7c, 0xd3, 0x23, 0x64, 0x5c, 0x73, 0x3e, 0x6d,
+	0x8d, 0x18, 0xf5, 0xc9, 0x51, 0xcd, 0xf0, 0x54, 0xcf, 0x0c, 0x74, 0xaf, 0xf0, 0x07, 0xf3, 0x46,
+	0x39, 0xf3, 0xe5, 0x29, 0xff, 0x23, 0x4e, 0xd1, 0xcb, 0xfa, 0x05, 0x4d, 0x9a, 0x26, 0x56, 0xd4,
+	0xcc, 0x4b, 0x67, 0x85, 0x06, 0xe8, 0xd7, 0x4d, 0x0d, 0x50, 0x9e, 0x10, 0x35, 0x4d, 0x1f, 0x74,
+	0xec, 0x6e, 0xdf, 0x4a, 0xd5, 0xef, 0xe8, 0x2f, 0x6f, 0x4e, 0x91, 0x5a, 0x27, 0x95, 0xf2, 0x1e,
+	0x7c, 0x02, 0xd9, 0x03, 0xae, 0x0e, 0xe2, 0x36, 0x01, 0x91, 0xaa, 0x32, 0x8b, 0xfc, 0x86, 0x63,
+	0xbc, 0x55, 0x60, 0x6b, 0x5e, 0x37, 0x8b, 0x78, 0x12, 0xd7, 0x66, 0xdc, 0x12, 0x17, 0xc5, 0x18,
+	0x8b, 0x19, 0xb2, 0x78, 0x59, 0x06, 0xa9, 0xd4, 0x69, 0x96, 0xe6, 0x47, 0x97, 0x16, 0x2d, 0x93,
+	0x1d, 0x5d, 0x0a, 0x56, 0x75, 0x9c, 0xfa, 0x5f, 0x75, 0xc9, 0xfe, 0x1c, 0x27, 0xac, 0x90, 0xed,
+	0xf2, 0x7a, 0x03, 0xd7, 0xae, 0x37, 0x40, 0xd1, 0xb8, 0x7d, 0x45, 0xec, 0x39, 0x99, 0xcc, 0x30,
+	0x9d, 0x54, 0x68, 0xca, 0x64, 0x52, 0x5b, 0x0e, 0x8d, 0xbc, 0x1b, 0x0d, 0xd6, 0x2d, 0x84, 0x52,
+	0x40, 0x29, 0x80, 0x3d, 0xfe, 0xdc, 0xb9, 0x41, 0xf1, 0xe7, 0x9a, 0x74, 0x4c, 0x0a, 0xd2, 0xf1,
+	0x39, 0x32, 0x99, 0xad, 0xba, 0xff, 0xcd, 0x7b, 0x09, 0xfe, 0x3b, 0x1c, 0x50, 0xef, 0x76, 0xd9,
+	0x96, 0x36, 0xfd, 0x5a, 0x00, 0xbe, 0x63, 0x06, 0xe0, 0xfb, 0x22, 0xfe, 0x29, 0x37, 0x1d, 0x3a,
+	0xcc, 0x9b, 0x21, 0x63, 0x19, 0x69, 0x22, 0xc0, 0xf0, 0x50, 0x7e, 0xa3, 0x70, 0xc6, 0x91, 0x25,
+	0xe1, 0xc6, 0x72, 0xa0, 0xc0, 0x59, 0xf4, 0x73, 0xd4, 0xd9, 0xf9, 0x1c, 0x7d, 0x0d, 0x99, 0xd0,
+	0x4b, 0x0b, 0x29, 0x5c, 0x1e, 0x67, 0xc5, 0x55, 0x4e, 0x8d, 0xec, 0xde, 0xeb, 0x0a, 0x6f, 0x40,
+	0x09, 0x21, 0xbb, 0xec, 0x41, 0x97, 0x7c, 0x76, 0xff, 0x6f, 0x1c, 0xe1, 0xea, 0x66, 0xce, 0x8c,
+	0x31, 0x1e, 0xce, 0xae, 0xc6, 0xc3, 0x7b, 0x90, 0x10, 0x7e, 0xdb, 0xcb, 0x5e, 0xe7, 0x55, 0x74,
+	0xe4, 0x66, 0x8b, 0x6a, 0x39, 0xbd, 0x47, 0xc9, 0xa4, 0x31, 0x8c, 0x62, 0xfc, 0xcb, 0x99, 0xb7,
+	0x99, 0xdd, 0x5c, 0xfe, 0xfc, 0x99, 0x0c, 0x05, 0xf0, 0xfb, 0xe4, 0xb0, 0x91, 0x3d, 0x33, 0x6f,
+	0x56, 0x9f, 0x3d, 0xc6, 0x69, 0xe2, 0xee, 0xfa, 0x34, 0xf1, 0x9f, 0xcb, 0x5c, 0xc2, 0x0a, 0x91,
+	0x31, 0x2f, 0xd4, 0x25, 0xcc, 0x58, 0xbc, 0xb5, 0xe2, 0xe2, 0xad, 0xba, 0xe7, 0x7c, 0xc8, 0xb1,
+	0x78, 0x75, 0x15, 0x28, 0x33, 0x0c, 0x82, 0x15, 0xb1, 0x3b, 0x15, 0x3c, 0x4f, 0xbe, 0x89, 0xe1,
+	0x6a, 0x6f, 0x62, 0xec, 0xd5, 0x1a, 0x78, 0xa1, 0xbc, 0x1f, 0x1f, 0x76, 0x0c, 0x77, 0xd8, 0x72,
+	0x12, 0x0d, 0x87, 0xaf, 0x39, 0xd4, 0x91, 0x07, 0xbd, 0x30, 0xdd, 0x7e, 0xc1, 0xab, 0x7a, 0x9a,
+	0x8c, 0x6b, 0xd5, 0x88, 0xfe, 0xe9, 0x20, 0xff, 0x69, 0x72, 0x54, 0x97, 0x7a, 0x72, 0x6d, 0xda,
+	0x7c, 0x56, 0x1e, 0xce, 0xd7, 0xa9, 0x6f, 0xd9, 0x5c, 0x05, 0x66, 0x5b, 0x4f, 0x91, 0x83, 0x5a,
+	0x32, 0x5b, 0xcb, 0x0f, 0x99, 0x37, 0x82, 0xdb, 0x8b, 0xbb, 0x3f, 0x5f, 0x2b, 0xcf, 0x0f, 0x87,
+	0xf7, 0x99, 0x58, 0x5a, 0xf4, 0xe1, 0xd3, 0x7f, 0x3e, 0xb3, 0xff, 0x14, 0x42, 0x2d, 0x0a, 0x0a,
+	0x19, 0xf3, 0xdd, 0xcf, 0x86, 0xf1, 0x22, 0x66, 0xaa, 0xbb, 0x4f, 0xa4, 0xc5, 0x17, 0x31, 0xeb,
+	0xf9, 0x17, 0x31, 0xab, 0x96, 0xf1, 0x47, 0x6c, 0x76, 0x9f, 0x02, 0x7d, 0x6a, 0xee, 0xff, 0xd3,
+	0xe1, 0x6f, 0x86, 0x16, 0x5e, 0x35, 0xb8, 0x95, 0xb8, 0x9d, 0x54, 0xf0, 0xa6, 0xdc, 0x4b, 0xa2,
+	0x6e, 0x27, 0x85, 0xc7, 0xab, 0x85, 0xb5, 0xb0, 0x66, 0xde, 0xc7, 0xaf, 0x74, 0x52, 0xbe, 0xef,
+	0x13, 0xf9, 0xdc, 0x1d, 0x26, 0xf2, 0x62, 0x62, 0xdd, 0x30, 0x54, 0x54, 0x8b, 0x89, 0x47, 0x97,
+	0xc8, 0xb8, 0x56, 0xa5, 0xe5, 0xe1, 0xb3, 0x53, 0xe6, 0x23, 0x65, 0xe5, 0xfc, 0x47, 0x7b, 0x7a,
+	0xe9, 0xdb, 0x2e, 0x99, 0xca, 0x3f, 0x3b, 0x0d, 0xdb, 0x96, 0x61, 0xa2, 0x2b, 0x82, 0x8d, 0x65,
+	0x12, 0x98, 0x20, 0xd3, 0xdc, 0x60, 0x40, 0xd5, 0xa7, 0x00, 0xb0, 0x76, 0xa3, 0x61, 0x26, 0xc6,
+	0xe1, 0xb7, 0x77, 0x2b, 0xa9, 0x0d, 0x53, 0x69, 0x8a, 0x1c, 0xd7, 0xc6, 0x87, 0x02, 0x1c, 0x2a,
+	0x84, 0x67, 0x3e, 0xf8, 0x5b, 0x19, 0x0d, 0x5e, 0x61, 0x06, 0x00, 0x0e, 0x38, 0x8c, 0x19, 0x47,
+	0xf2, 0x28, 0xe9, 0x2c, 0x0d, 0xfd, 0x4f, 0xe2, 0x15, 0x21, 0x32, 0xc3, 0x27, 0x34, 0xdf, 0x65,
+	0x49, 0x2a, 0xe4, 0x10, 0xfc, 0x86, 0x8b, 0xe7, 0x0a, 0x68, 0xbe, 0xe7, 0xa2, 0xc1, 0x6a, 0x2f,
+	0x5c, 0x49, 0x85, 0x10, 0x62, 0x02, 0x61, 0xd3, 0x06, 0xd9, 0x33, 0xa6, 0x5d, 0x14, 0x45, 0xea,
+	0x54, 0x07, 0x41, 0x3d, 0x69, 0x1c, 0x0c, 0x92, 0x55, 0x16, 0x63, 0x30, 0x12, 0xfa, 0x21, 0x35,
+	0xa9, 0x09, 0xf4, 0x7f, 0xc9, 0xb1, 0x45, 0x23, 0x7a, 0xaf, 0x10, 0xa3, 0xa6, 0x69, 0x18, 0x4a,
+	0x9f, 0xfc, 0x56, 0x39, 0xab, 0xee, 0xb1, 0x1f, 0x35, 0xef, 0xb1, 0xc5, 0x36, 0xd5, 0xda, 0x06,
+	0x9a, 0x8a, 0x91, 0x90, 0x37, 0x80, 0xa6, 0x8f, 0x99, 0x34, 0x15, 0xdb, 0x34, 0x0c, 0xdf, 0xb6,
+	0x28, 0xcc, 0xbd, 0x6e, 0x3f, 0x30, 0x0c, 0x80, 0x5c, 0x00, 0x3b, 0x5b, 0x2c, 0x3a, 0x05, 0x30,
+	0xde, 0xdf, 0x75, 0xd4, 0x2b, 0xc3, 0x55, 0xea, 0xf3, 0xdf, 0xb4, 0xa9, 0xcf, 0x0d, 0x12, 0x55,
+	0x1f, 0x52, 0x5b, 0xbc, 0xa8, 0xb9, 0x75, 0x5c, 0x6d, 0xeb, 0x54, 0x8d, 0xdc, 0x6f, 0x99, 0x23,
+	0x57, 0xac, 0x56, 0xb5, 0xfa, 0x6f, 0xce, 0x0e, 0xe1, 0xa8, 0xa5, 0xaf, 0xbb, 0xed, 0x42, 0xb3,
+	0x65, 0x2d, 0x58, 0xe9, 0x31, 0xe9, 0x91, 0xfa, 0x40, 0x73, 0x3e, 0x80, 0xef, 0x99, 0xc5, 0xf2,
+	0x8e, 0xfe, 0x36, 0xef, 0xe8, 0x09, 0xd3, 0x31, 0xcf, 0xde, 0x11, 0xd5, 0xe7, 0x2f, 0x3a, 0x95,
+	0xf1, 0xb5, 0x3b, 0xc9, 0x49, 0xb1, 0x61, 0x9f, 0xe1, 0x29, 0x98, 0xa7, 0x6e, 0x1c, 0x0d, 0x4f,
+	0xf7, 0x7a, 0xc2, 0xb6, 0x20, 0x93, 0x55, 0x31, 0x10, 0x1f, 0x77, 0x8c, 0x47, 0xe0, 0x2a, 0x68,
+	0x52, 0xc4, 0x3f, 0x5d, 0x15, 0xfa, 0x5b, 0x25, 0xc2, 0xfc, 0x8e, 0x29, 0xc2, 0x94, 0x57, 0x62,
+	0x18, 0x52, 0xed, 0x71, 0xc4, 0x9a, 0x68, 0xe5, 0x18, 0xa2, 0xd5, 0x71, 0x42, 0x62, 0x15, 0xe4,
+	0xc6, 0x1f, 0xe6, 0xd3, 0x20, 0x55, 0x46, 0xe9, 0xdf, 0x75, 0x6c, 0x4e, 0x96, 0x66, 0xbb, 0x8a,
+	0xb4, 0xef, 0x3a, 0xbb, 0x8c, 0x63, 0x2e, 0x25, 0xb5, 0xcc, 0xd2, 0x26, 0xe4, 0x72, 0x38, 0x80,
+	0xf8, 0x31, 0x5c, 0xa3, 0x0a, 0x30, 0x73, 0xb9, 0xbc, 0x03, 0x9f, 0xe0, 0x1d, 0x78, 0xa9, 0x1a,
+	0xe0, 0x9d, 0xa9, 0x53, 0x1d, 0xfa, 0x88, 0xb3, 0x73, 0xb4, 0xf5, 0xde, 0x94, 0xa4, 0x55, 0xde,
+	0x63, 0x9f, 0x34, 0xbd, 0xc7, 0x76, 0x6a, 0x58, 0xe7, 0x52, 0xb6, 0x68, 0x6f, 0x18, 0x4c, 0x86,
+	0xf1, 0x87, 0x42, 0x9d, 0x2a, 0x52, 0x55, 0xbc, 0xf1, 0xf7, 0x4c, 0xde, 0x68, 0xa9, 0xb5, 0xd0,
+	0x6a, 0x2e, 0x94, 0xfc, 0x85, 0xb4, 0xfa, 0xfb, 0xc5, 0x56, 0x73, 0xb5, 0xaa, 0x56, 0x7f, 0xd1,
+	0xb1, 0x06, 0xaa, 0xc3, 0x63, 0xbd, 0xca, 0xac, 0x2c, 0xa6, 0xc2, 0x62, 0x6f, 0xd6, 0x32, 0x55,
+	0x51, 0xf4, 0x29, 0x93, 0x22, 0x4b, 0x83, 0x8a, 0xa2, 0x9e, 0x25, 0x40, 0xde, 0xea, 0xa5, 0x59,
+	0xe1, 0xcd, 0xf2, 0x07, 0xa6, 0x37, 0x4b, 0xa1, 0x3e, 0xd5, 0xda, 0x73, 0xce, 0x4e, 0x81, 0xf7,
+	0x7b, 0xde, 0x5c, 0xda, 0xab, 0x50, 0x35, 0xe3, 0x55, 0xa8, 0x99, 0x4e, 0x39, 0xc5, 0x7f, 0xc8,
+	0x29, 0xbe, 0xb3, 0x74, 0x63, 0xe9, 0x24, 0x29, 0xf2, 0xb7, 0x4a, 0x9e, 0x04, 0x28, 0x7b, 0x63,
+	0xad, 0x8a, 0x39, 0x7d, 0xda, 0x64, 0x4e, 0xd6, 0x7a, 0x55, 0xcb, 0x6f, 0xb4, 0xbe, 0x38, 0x50,
+	0xb5, 0x08, 0x3e, 0x63, 0x2e, 0x02, 0x4b, 0x69, 0x55, 0xfb, 0xdb, 0x9d, 0xb2, 0x77, 0x0b, 0x0a,
+	0xf2, 0xce, 0xbe, 0x4c, 0xde, 0x01, 0x87, 0xb7, 0x4a, 0x5d, 0xfa, 0x67, 0x4d, 0x5d, 0xba, 0xbd,
+	0x01, 0x45, 0xc4, 0x07, 0x9c, 0xaa, 0x57, 0x10, 0xf6, 0xba, 0x2e, 0xaa, 0xce, 0xad, 0xcf, 0x15,
+	0xce, 0xad, 0x92, 0x46, 0x15, 0x71, 0x1b, 0xe4, 0x40, 0xe1, 0xee, 0x63, 0xbd, 0x08, 0x17, 0x83,
+	0xa9, 0xb9, 0xe7, 0x8f, 0xe5, 0x2d, 0x7c, 0x71, 0x88, 0x25, 0xc2, 0x21, 0x21, 0x4b, 0xfb, 0x97,
+	0xc8, 0x54, 0x9e, 0x20, 0x6f, 0xb6, 0x08, 0x13, 0x57, 0xe3, 0x32, 0xc5, 0x58, 0x21, 0x3f, 0x4c,
+	0x73, 0xe5, 0x3b, 0x12, 0x46, 0x58, 0x81, 0x78, 0x99, 0xb5, 0xca, 0xda, 0xf3, 0x79, 0xd3, 0xda,
+	0x53, 0x55, 0xb5, 0x1a, 0xc9, 0x4f, 0x3b, 0xd5, 0x4f, 0x55, 0xec, 0x39, 0x56, 0x36, 0x7b, 0x6e,
+	0xb7, 0xa6, 0x3d, 0xb7, 0x5b, 0x45, 0xf6, 0x17, 0x1c, 0x4b, 0x98, 0xb4, 0x9d, 0x18, 0x45, 0xf6,
+	0x33, 0xe5, 0xcf, 0x67, 0x58, 0x87, 0xad, 0xc2, 0xfb, 0xec, 0x8b, 0xa6, 0xf7, 0x59, 0x59, 0xb5,
+	0xc6, 0xce, 0xa8, 0x7c, 0x9d, 0xc3, 0xbb, 0x87, 0x34, 0xe7, 0x1e, 0xc7, 0x3b, 0xa7, 0xd4, 0x97,
+	0x64, 0x6d, 0x72, 0x30, 0xcd, 0xf0, 0x55, 0x03, 0xf3, 0x47, 0xb9, 0x81, 0xa9, 0x68, 0x52, 0x11,
+	0x77, 0x95, 0x8c, 0x8a, 0xba, 0xad, 0xfb, 0x21, 0xf7, 0xec, 0x31, 0x57, 0x7b, 0xeb, 0xa0, 0x6c,
+	0xf4, 0x6a, 0x65, 0xcf, 0x01, 0xd7, 0xf3, 0xcf, 0x01, 0xbf, 0xd3, 0xd9, 0xe9, 0x2d, 0x12, 0xeb,
+	0x94, 0x54, 0x9c, 0x07, 0xcf, 0x15, 0xce, 0x83, 0x8a, 0xca, 0x4d, 0x96, 0x55, 0xfe, 0xe0, 0xc9,
+	0x5e, 0x83, 0xbb, 0xaa, 0x58, 0xd6, 0x97, 0x9c, 0x42, 0xf0, 0xfc, 0x4e, 0x2b, 0xf6, 0x1f, 0x9c,
+	0xdd, 0x3d, 0xbe, 0xb1, 0xe7, 0x0d, 0x67, 0xd8, 0x38, 0x6a, 0x15, 0x36, 0x8e, 0xba, 0x61, 0xe3,
+	0x98, 0xb9, 0x54, 0xde, 0xbd, 0xef, 0xf1, 0xee, 0xdd, 0x5b, 0xb5, 0x25, 0x73, 0x64, 0xab, 0x8e,
+	0xfe, 0xc4, 0xd9, 0xdd, 0xdb, 0x21, 0x7b, 0xee, 0xe8, 0x4f, 0xf5, 0x11, 0xeb, 0xaa, 0xee, 0xff,
+	0xb9, 0xd9, 0xfd, 0xdd, 0x74, 0x46, 0x8b, 0xbe, 0x70, 0xad, 0x0f, 0xa2, 0xec, 0xe6, 0x19, 0x5d,
+	0x77, 0x17, 0xcf, 0xe8, 0xba, 0xbb, 0x7c, 0x46, 0xd7, 0xdd, 0xc3, 0x33, 0xba, 0xee, 0xde, 0x9e,
+	0xd1, 0x05, 0xc7, 0x6b, 0xfe, 0x2a, 0x50, 0x22, 0xfe, 0x59, 0x26, 0x4b, 0xf3, 0x67, 0x18, 0x53,
+	0x16, 0x8b, 0x47, 0xef, 0x79, 0xa2, 0x4a, 0x2e, 0xfa, 0x0b, 0x9b, 0x70, 0x6c, 0x8c, 0x5c, 0x41,
+	0x38, 0xde, 0x71, 0x5c, 0xab, 0x84, 0xe3, 0xef, 0x17, 0x85, 0xe3, 0x92, 0xd6, 0xbe, 0xe6, 0x54,
+	0xbc, 0x54, 0xb3, 0x0b, 0x66, 0xe2, 0xe4, 0x17, 0x34, 0xc6, 0x9d, 0x0b, 0xb6, 0x0a, 0xdf, 0x28,
+	0x2b, 0xa1, 0xe6, 0x26, 0x8b, 0x46, 0xc7, 0x54, 0x55, 0x04, 0xd6, 0x5f, 0x9a, 0x11, 0x58, 0xa5,
+	0xf4, 0xa9, 0x6e, 0x7c, 0xd6, 0x29, 0x7f, 0x51, 0xc7, 0xda, 0x0b, 0xf5, 0xe2, 0xb1, 0x2b, 0xfe,
+	0x85, 0xca, 0xfe, 0xe2, 0x31, 0x5f, 0x8b, 0x06, 0xac, 0xea, 0x98, 0xfd, 0x2b, 0xf3, 0x98, 0x2d,
+	0x23, 0x49, 0x11, 0xfe, 0x6e, 0xa7, 0xe2, 0xb1, 0x1f, 0xef, 0x5e, 0x52, 0xb7, 0x28, 0x22, 0x0b,
+	0xff, 0x39, 0x87, 0x99, 0xaa, 0x06, 0xf4, 0x07, 0xb6, 0x01, 0xb5, 0x34, 0xa8, 0xaf, 0xc2, 0xaa,
+	0x57, 0xb3, 0xaa, 0xb4, 0x41, 0x5f, 0x36, 0xb5, 0x41, 0x15, 0xb5, 0xa8, 0xd6, 0x3e, 0xe4, 0xec,
+	0xf0, 0x06, 0x17, 0xcc, 0x57, 0x82, 0x00, 0x94, 0x35, 0xea, 0x54, 0xa4, 0x80, 0xf3, 0x73, 0xb7,
+	0x08, 0x6e, 0x5e, 0xac, 0x53, 0x99, 0xac, 0xd2, 0xb7, 0xfd, 0xb1, 0xa9, 0x6f, 0xab, 0x6c, 0x59,
+	0x0f, 0xae, 0x2f, 0x3e, 0x02, 0xa6, 0xb7, 0xef, 0x98, 0xed, 0x57, 0x6c, 0xcf, 0x3f, 0xc9, 0x87,
+	0xa1, 0xe4, 0x6a, 0x55, 0x6d, 0xfe, 0xad, 0x53, 0xfe, 0xc4, 0x18, 0xec, 0xc4, 0x6e, 0xee, 0x68,
+	0x91, 0x69, 0xa1, 0xc1, 0xe2, 0xa6, 0x4d, 0xf9, 0xfa, 0xb4, 0x06, 0x81, 0xb2, 0x7d, 0xfe, 0x37,
+	0x7a, 0x5d, 0xf1, 0x88, 0x53, 0x96, 0x56, 0x7f, 0xab, 0x57, 0x2f, 0xfb, 0x5b, 0xbd, 0xaa, 0x2d,
+	0xf0, 0x15, 0xdb, 0x16, 0xa8, 0x0a, 0x26, 0xf8, 0xa0, 0xa3, 0xff, 0x6b, 0x10, 0x3f, 0xcb, 0xf9,
+	0x1f, 0x41, 0x3a, 0x5c, 0x05, 0x29, 0x92, 0xd0, 0xa7, 0xd9, 0xcd, 0x95, 0x0d, 0x96, 0x8a, 0x43,
+	0x13, 0xdf, 0x74, 0x55, 0x10, 0x8c, 0x84, 0xde, 0x10, 0xbc, 0xc7, 0x3d, 0xbd, 0x01, 0xe9, 0xa5,
+	0x0d, 0xf9, 0xb7, 0x6b, 0x4b, 0x1b, 0xd0, 0xe7, 0x33, 0x83, 0x2e, 0x3a, 0xfb, 0x8b, 0x93, 0x32,
+	0x4b, 0x03, 0x6e, 0x36, 0x48, 0x58, 0x27, 0x48, 0xd7, 0xd1, 0xa4, 0x32, 0x46, 0xb3, 0xb4, 0xff,
+	0xef, 0x35, 0xa2, 0x47, 0xc4, 0xcd, 0xa1, 0x7b, 0xff, 0x12, 0x1b, 0x24, 0x61, 0x1a, 0x5e, 0x63,
+	0x82, 0xca, 0x3c, 0x18, 0xa8, 0x3d, 0x3d, 0x1c, 0xb2, 0x41, 0x17, 0xe4, 0x6c, 0xa4, 0xb6, 0x49,
+	0x35, 0x08, 0x5c, 0xda, 0x2e, 0xc7, 0x61, 0xca, 0x96, 0xd7, 0x63, 0x96, 0xac, 0x47, 0xbd, 0xae,
+	0xb8, 0x92, 0xe5, 0xa0, 0x70, 0xec, 0x51, 0x16, 0x74, 0x55, 0xb6, 0x3a, 0x66, 0x33, 0x81, 0x40,
+	0x97, 0xe0, 0x51, 0x73, 0xc1, 0x30, 0x58, 0x01, 0x7b, 0x28, 0x37, 0x1b, 0xe5, 0xc1, 0x59, 0x78,
+	0xd5, 0xdc, 0x7a, 0x10, 0x8b, 0xae, 0x2a, 0x00, 0xfe, 0x31, 0x4f, 0x2a, 0x5d, 0x5b, 0xe0, 0x13,
+	0xf2, 0x2f, 0x07, 0x6b, 0x09, 0x66, 0x11, 0x81, 0xe7, 0x0a, 0x00, 0xbd, 0x3c, 0xdb, 0x8b, 0xe0,
+	0xfe, 0xd0, 0x65, 0x2b, 0x22, 0x0a, 0x5d, 0x83, 0x88, 0x37, 0xa0, 0x39, 0x76, 0x82, 0x8f, 0xab,
+	0x4c, 0x7b, 0xa7, 0xc9, 0x38, 0x4a, 0x29, 0x22, 0x94, 0x6b, 0x72, 0xba, 0xa6, 0xad, 0x1b, 0x31,
+	0xe0, 0xa7, 0xb4, 0x1c, 0xe2, 0xcf, 0x6f, 0x34, 0x08, 0x54, 0xdf, 0x09, 0x87, 0xac, 0x17, 0x0e,
+	0x58, 0x6b, 0xdf, 0xb4, 0x73, 0x72, 0x82, 0x66, 0x69, 0xf8, 0xfb, 0x95, 0x7c, 0xe1, 0x9d, 0xfe,
+	0x7e, 0xc5, 0xd1, 0x6d, 0x80, 0xcf, 0x3b, 0xe5, 0xef, 0xe9, 0xd9, 0x54, 0x14, 0x74, 0x28, 0x04,
+	0x39, 0x97, 0x0e, 0xa1, 0x21, 0xf9, 0xc8, 0x36, 0xfc, 0xdd, 0x45, 0x92, 0xea, 0x51, 0x97, 0x75,
+	0xe3, 0x5f, 0x20, 0x0b, 0xcf, 0x98, 0x55, 0x6c, 0xae, 0xe7, 0x6d, 0x9b, 0xab, 0xca, 0x59, 0xf0,
+	0x57, 0x1c, 0x32, 0x0a, 0xc7, 0x33, 0x38, 0x02, 0x43, 0x24, 0xfa, 0x50, 0x38, 0x07, 0xbb, 0x8b,
+	0x43, 0x18, 0xbc, 0x01, 0xbb, 0x2e, 0xfd, 0x4c, 0xf0, 0x59, 0x27, 0x99, 0x2e, 0xfe, 0xbd, 0x6b,
+	0x2d, 0xfb, 0xcf, 0x02, 0x05, 0x44, 0x5b, 0x34, 0x4b, 0x17, 0x87, 0xdc, 0x14, 0xc9, 0x17, 0xa6,
+	0x06, 0xc9, 0x5e, 0x1f, 0x69, 0x4c, 0x3b, 0xd6, 0xd7, 0x47, 0xe0, 0x32, 0x63, 0x7d, 0x05, 0xb1,
+	0x32, 0xc4, 0xdd, 0xb4, 0x80, 0x0b, 0x3e, 0xa0, 0x20, 0x55, 0x0e, 0x72, 0x5f, 0x35, 0x1d, 0xe4,
+	0x6c, 0x4d, 0x5b, 0xbd, 0x38, 0x2c, 0x0f, 0x31, 0xfe, 0x94, 0xcd, 0xf8, 0xf9, 0x4e, 0x54, 0xdc,
+	0xcb, 0xbe, 0x66, 0xf5, 0xe2, 0xb0, 0x90, 0xa8, 0xba, 0xf2, 0x71, 0xa7, 0xe2, 0x31, 0xca, 0x2c,
+	0x92, 0x8d, 0xff, 0x1d, 0x17, 0x7e, 0x97, 0xfc, 0x3f, 0xb8, 0x0a, 0x51, 0xad, 0xe9, 0x21, 0xaa,
+	0x55, 0xb2, 0xc7, 0xd7, 0x6d, 0xb2, 0x87, 0x85, 0x0a, 0x45, 0xec, 0x77, 0x5c, 0xd2, 0x04, 0xc3,
+	0xb1, 0x34, 0xb3, 0x25, 0xec, 0xea, 0x26, 0x1b, 0xac, 0x30, 0x61, 0xd4, 0xcf, 0xd2, 0x40, 0x63,
+	0x0f, 0x3d, 0xf1, 0xc4, 0x5f, 0x27, 0x61, 0x02, 0xa0, 0x7d, 0x16, 0xaf, 0x31, 0x71, 0xae, 0xf1,
+	0x04, 0x50, 0xce, 0xb6, 0x52, 0x36, 0x48, 0xa5, 0xd9, 0x93, 0xa7, 0x30, 0x37, 0xfe, 0x4b, 0x70,
+	0x03, 0x2b, 0xe7, 0x09, 0x38, 0x84, 0x12, 0xe1, 0xa1, 0x33, 0x82, 0x70, 0x99, 0x04, 0x76, 0xd8,
+	0xcd, 0xa2, 0x60, 0x38, 0x9b, 0x54, 0x00, 0xc0, 0xae, 0xe0, 0x9a, 0x02, 0x2c, 0xff, 0xbb, 0x49,
+	0x05, 0x80, 0x5a, 0xfb, 0x21, 0xd7, 0x49, 0xf0, 0x97, 0xcb, 0x64, 0x12, 0x31, 0x22, 0x0e, 0x85,
+	0x08, 0x0c, 0x4f, 0xa2, 0xce, 0x2e, 0xba, 0xce, 0x03, 0x58, 0xf8, 0x0b, 0x65, 0x59, 0x1a, 0x36,
+	0xe9, 0x6a, 0xd8, 0x63, 0x10, 0xeb, 0xc2, 0xa5, 0xd5, 0x09, 0xbe, 0x49, 0x0d, 0x20, 0xfc, 0xc5,
+	0xae, 0xe5, 0xbd, 0x50, 0xf8, 0xd3, 0x72, 0x39, 0xc8, 0x52, 0x81, 0xb3, 0x3f, 0x0b, 0xb2, 0xea,
+	0x09, 0x07, 0x9e, 0x2c, 0x47, 0x95, 0x9d, 0xf6, 0x1b, 0xa6, 0x9d, 0xb6, 0xd8, 0x96, 0x9a, 0xda,
+	0x77, 0x3a, 0xb6, 0x47, 0x46, 0x91, 0x13, 0xc1, 0x8a, 0x90, 0x4e, 0xa7, 0x63, 0x34, 0x4b, 0xe7,
+	0xff, 0xc1, 0xa0, 0x8a, 0x90, 0x6f, 0x9a, 0x84, 0x14, 0x1b, 0x32, 0x8c, 0x22, 0xa3, 0xb0, 0x08,
+	0x69, 0x74, 0x1d, 0x26, 0x2d, 0xcd, 0xde, 0x4f, 0x13, 0xfe, 0x93, 0x19, 0x40, 0x93, 0x3c, 0x85,
+	0xae, 0x97, 0xa7, 0x80, 0xe6, 0xf5, 0xc8, 0x30, 0x02, 0x64, 0xe9, 0xcc, 0x75, 0x57, 0x46, 0xb3,
+	0x8a, 0x94, 0xd1, 0xcf, 0x86, 0xd9, 0x4f, 0xff, 0x87, 0x0e, 0x69, 0xa2, 0x71, 0x1b, 0x48, 0x92,
+	0x2e, 0x23, 0xe2, 0x1f, 0xfb, 0xe1, 0x3b, 0xef, 0x64, 0x02, 0xa5, 0x15, 0x00, 0x86, 0xa9, 0x2b,
+	0x9d, 0x60, 0xdd, 0x2e, 0xfe, 0xf1, 0xc8, 0x10, 0xcc, 0xed, 0xdc, 0xf9, 0x15, 0xbf, 0xa1, 0x86,
+	0x24, 0x5e, 0x11, 0x1b, 0x98, 0xfb, 0x69, 0x2b, 0x00, 0x60, 0xbb, 0x49, 0x2a, 0xb0, 0xfc, 0xdf,
+	0x97, 0x14, 0xc0, 0xf4, 0x48, 0xe1, 0xff, 0xc3, 0x5b, 0xe2, 0x91, 0xd2, 0xe4, 0x1d, 0x93, 0x69,
+	0xff, 0x29, 0xb2, 0x5f, 0x9b, 0x09, 0xf9, 0x7f, 0xc8, 0x03, 0xbc, 0x59, 0x9b, 0x8a, 0x43, 0x31,
+	0x21, 0x94, 0x23, 0xbd, 0xbb, 0xc9, 0x08, 0xe3, 0xff, 0x07, 0x6f, 0x46, 0x4d, 0xca, 0x51, 0xa2,
+	0x02, 0x3d, 0x4b, 0xde, 0xd0, 0x3c, 0x75, 0xea, 0x3e, 0x44, 0xfe, 0xcf, 0x00, 0xc2, 0xd0, 0x65,
+	0xd0, 0x08, 0x81, 0x00, 0x00,
 }
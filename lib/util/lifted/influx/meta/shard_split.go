@@ -0,0 +1,153 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"go.uber.org/zap"
+)
+
+func ErrShardSplitting(id uint64) error {
+	return fmt.Errorf("shard %d is splitting", id)
+}
+
+func ErrInvalidSplitKey(id uint64, splitKey string) error {
+	return fmt.Errorf("split key %q is out of the range of shard %d", splitKey, id)
+}
+
+// SplitShard splits the shard of the range sharding shard group at splitKey. The shard keeps [Min, splitKey),
+// a new shard owned by ptID takes [splitKey, Max). The writes of [splitKey, Max) are routed to the new shard
+// once the command is applied, the rows written before are moved to the new shard by the store owning the shard.
+func (data *Data) SplitShard(database, policy string, sgID, shardID uint64, splitKey string, ptID uint32) error {
+	rp, err := data.RetentionPolicy(database, policy)
+	if err != nil {
+		return err
+	}
+	if rp.shardingType() != RANGE {
+		return ErrShardingTypeNotEqual(policy, rp.shardingType(), RANGE)
+	}
+	if int(ptID) >= len(data.PtView[database]) {
+		return errno.NewError(errno.PtNotFound)
+	}
+
+	sg := rp.shardGroupByID(sgID)
+	if sg == nil || !sg.DeletedAt.IsZero() {
+		return ErrShardGroupNotFound
+	}
+	sh := sg.Shard(shardID)
+	if sh == nil || sh.MarkDelete {
+		return errno.NewError(errno.ShardNotFound, shardID)
+	}
+	if sh.SplitFrom != 0 || sg.Splitting(shardID) {
+		return ErrShardSplitting(shardID)
+	}
+	if splitKey <= sh.Min || (sh.Max != "" && splitKey >= sh.Max) {
+		return ErrInvalidSplitKey(shardID, splitKey)
+	}
+	indexID, ok := rp.ptIndexID(sh.IndexID, ptID)
+	if !ok {
+		return ErrIndexGroupNotFound
+	}
+
+	data.MaxShardID++
+	child := ShardInfo{
+		ID:        data.MaxShardID,
+		Owners:    []uint32{ptID},
+		Min:       splitKey,
+		Max:       sh.Max,
+		Tier:      sh.Tier,
+		IndexID:   indexID,
+		SplitFrom: sh.ID,
+	}
+	sh.Max = splitKey
+	sh.Trimmed = true
+	// the shards of a group are sorted by the id
+	sg.Shards = append(sg.Shards, child)
+	DataLogger.Info("split shard", zap.String("db", database), zap.String("rp", policy), zap.Uint64("sg", sgID),
+		zap.Uint64("shard", shardID), zap.Uint64("newShard", child.ID), zap.Uint32("pt", ptID), zap.String("splitKey", splitKey))
+	return nil
+}
+
+// SplitShardDone marks the rows of the shard split from the other shard have been moved.
+func (data *Data) SplitShardDone(database, policy string, sgID, shardID uint64) error {
+	rp, err := data.RetentionPolicy(database, policy)
+	if err != nil {
+		return err
+	}
+	sg := rp.shardGroupByID(sgID)
+	if sg == nil {
+		return ErrShardGroupNotFound
+	}
+	sh := sg.Shard(shardID)
+	if sh == nil {
+		return errno.NewError(errno.ShardNotFound, shardID)
+	}
+	sh.SplitFrom = 0
+	return nil
+}
+
+// RangeSharding returns whether the rows of the retention policy are routed to the shards by the range of the shard keys
+func (rpi *RetentionPolicyInfo) RangeSharding() bool {
+	return rpi.shardingType() == RANGE
+}
+
+func (rpi *RetentionPolicyInfo) shardGroupByID(id uint64) *ShardGroupInfo {
+	for i := range rpi.ShardGroups {
+		if rpi.ShardGroups[i].ID == id {
+			return &rpi.ShardGroups[i]
+		}
+	}
+	return nil
+}
+
+// ptIndexID returns the index of the pt in the index group which the index belongs to
+func (rpi *RetentionPolicyInfo) ptIndexID(indexID uint64, ptID uint32) (uint64, bool) {
+	for i := range rpi.IndexGroups {
+		indexes := rpi.IndexGroups[i].Indexes
+		for j := range indexes {
+			if indexes[j].ID != indexID {
+				continue
+			}
+			if int(ptID) >= len(indexes) {
+				return 0, false
+			}
+			return indexes[ptID].ID, true
+		}
+	}
+	return 0, false
+}
+
+// Splitting returns whether the rows of the shard are being moved to the shards split from it.
+func (sgi *ShardGroupInfo) Splitting(id uint64) bool {
+	for i := range sgi.Shards {
+		if sgi.Shards[i].SplitFrom == id {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitShards returns the shards which the rows of the shard are being moved to.
+func (sgi *ShardGroupInfo) SplitShards(id uint64) []ShardInfo {
+	var shards []ShardInfo
+	for i := range sgi.Shards {
+		if sgi.Shards[i].SplitFrom == id {
+			shards = append(shards, sgi.Shards[i])
+		}
+	}
+	return shards
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	assert2 "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Data_SplitShard(t *testing.T) {
+	data := initData()
+	data.CreateDBPtView("foo")
+	require.NoError(t, generateMeasurement(data, "foo", "bar", "cpu"))
+	require.NoError(t, data.CreateShardGroup("foo", "bar", time.Unix(0, 0), util.Hot, config.TSSTORE, 0))
+
+	require.NoError(t, data.SplitShard("foo", "bar", 1, 1, "cpu,hostname=host_5", 1))
+	rp, err := data.RetentionPolicy("foo", "bar")
+	require.NoError(t, err)
+	sg := &rp.ShardGroups[0]
+	require.Equal(t, 2, len(sg.Shards))
+	assert2.Equal(t, ShardInfo{ID: 1, Owners: []uint32{0}, Max: "cpu,hostname=host_5", Tier: util.Hot, IndexID: 1, Trimmed: true}, sg.Shards[0])
+	assert2.Equal(t, ShardInfo{ID: 2, Owners: []uint32{1}, Min: "cpu,hostname=host_5", Tier: util.Hot, IndexID: 2, SplitFrom: 1}, sg.Shards[1])
+	assert2.Equal(t, uint64(2), sg.DestShard("cpu,hostname=host_7").ID)
+	assert2.Equal(t, uint64(1), sg.DestShard("cpu,hostname=host_1").ID)
+	assert2.True(t, sg.Splitting(1))
+	assert2.Equal(t, []ShardInfo{sg.Shards[1]}, sg.SplitShards(1))
+
+	// the shards being split can not be split again
+	assert2.EqualError(t, data.SplitShard("foo", "bar", 1, 1, "cpu,hostname=host_3", 1), ErrShardSplitting(1).Error())
+	assert2.EqualError(t, data.SplitShard("foo", "bar", 1, 2, "cpu,hostname=host_7", 0), ErrShardSplitting(2).Error())
+
+	require.NoError(t, data.SplitShardDone("foo", "bar", 1, 2))
+	assert2.False(t, sg.Splitting(1))
+	assert2.EqualError(t, data.SplitShard("foo", "bar", 1, 2, "cpu,hostname=host_5", 0), ErrInvalidSplitKey(2, "cpu,hostname=host_5").Error())
+	assert2.EqualError(t, data.SplitShard("foo", "bar", 1, 1, "cpu,hostname=host_7", 1), ErrInvalidSplitKey(1, "cpu,hostname=host_7").Error())
+	assert2.True(t, errno.Equal(data.SplitShard("foo", "bar", 1, 3, "cpu,hostname=host_7", 1), errno.ShardNotFound))
+	assert2.True(t, errno.Equal(data.SplitShard("foo", "bar", 1, 2, "cpu,hostname=host_7", 2), errno.PtNotFound))
+	assert2.Equal(t, ErrShardGroupNotFound, data.SplitShard("foo", "bar", 2, 2, "cpu,hostname=host_7", 0))
+
+	require.NoError(t, data.SplitShard("foo", "bar", 1, 2, "cpu,hostname=host_7", 0))
+	assert2.Equal(t, "cpu,hostname=host_7", sg.Shards[1].Max)
+	assert2.Equal(t, ShardInfo{ID: 3, Owners: []uint32{0}, Min: "cpu,hostname=host_7", Tier: util.Hot, IndexID: 1, SplitFrom: 2}, sg.Shards[2])
+
+	// the split shards survive the marshal of the data
+	pb := data.Marshal(false)
+	other := &Data{}
+	other.Unmarshal(pb)
+	assert2.Equal(t, sg.Shards, other.Databases["foo"].RetentionPolicies["bar"].ShardGroups[0].Shards)
+}
+
+func Test_Data_SplitShard_HashSharding(t *testing.T) {
+	data := initData()
+	data.CreateDBPtView("foo")
+	require.NoError(t, data.CreateDatabase("foo", nil, nil, false, 1, nil))
+	rp := NewRetentionPolicyInfo("bar")
+	rp.ShardGroupDuration = 24 * time.Hour
+	require.NoError(t, data.CreateRetentionPolicy("foo", rp, false))
+	require.NoError(t, data.CreateMeasurement("foo", "bar", "cpu",
+		&proto2.ShardKeyInfo{ShardKey: []string{"hostname"}, Type: proto.String(influxql.HASH)}, 0, nil, 0, nil, nil, nil))
+	require.NoError(t, data.CreateShardGroup("foo", "bar", time.Unix(0, 0), util.Hot, config.TSSTORE, 0))
+
+	assert2.Error(t, data.SplitShard("foo", "bar", 1, 1, "cpu,hostname=host_5", 1))
+}
+
+func TestShardGroupInfo_TargetShards_Split(t *testing.T) {
+	sg := ShardGroupInfo{ID: 1, Shards: []ShardInfo{
+		{ID: 1, Owners: []uint32{0}, Max: "cpu,hostname=host_5", Trimmed: true},
+		{ID: 2, Owners: []uint32{1}, Min: "cpu,hostname=host_5", SplitFrom: 1},
+	}}
+	schema := NewCleanSchema(0)
+	schema.SetTyp("hostname", influx.Field_Type_Tag)
+	mst := &MeasurementInfo{Name: "cpu", Schema: &schema}
+	ski := &ShardKeyInfo{ShardKey: []string{"hostname"}, Type: influxql.RANGE}
+
+	cond, err := influxql.ParseExpr("hostname = 'host_7'")
+	require.NoError(t, err)
+	// the rows of the new shard are read from it only, the queries of the shard split from skip them
+	shards := sg.TargetShards(mst, ski, cond, []int{0, 1})
+	require.Equal(t, 1, len(shards))
+	assert2.Equal(t, uint64(2), shards[0].ID)
+
+	sg.Shards[1].SplitFrom = 0
+	shards = sg.TargetShards(mst, ski, cond, []int{0, 1})
+	require.Equal(t, 1, len(shards))
+	assert2.Equal(t, uint64(2), shards[0].ID)
+}
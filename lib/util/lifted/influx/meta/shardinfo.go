@@ -440,6 +440,8 @@ type ShardInfo struct {
 	DownSampleLevel int64
 	ReadOnly        bool
 	MarkDelete      bool
+	SplitFrom       uint64 // the shard whose rows in [Min, Max) are being moved to this shard
+	Trimmed         bool   // the rows out of [Min, Max) are moved to the shards split from this shard
 }
 
 func (si ShardInfo) Contain(shardKey string) bool {
@@ -484,6 +486,8 @@ func (si ShardInfo) marshal() *proto2.ShardInfo {
 		DownSampleID:    proto.Uint64(si.DownSampleID),
 		ReadOnly:        proto.Bool(si.ReadOnly),
 		MarkDelete:      proto.Bool(si.MarkDelete),
+		SplitFrom:       proto.Uint64(si.SplitFrom),
+		Trimmed:         proto.Bool(si.Trimmed),
 	}
 	pb.OwnerIDs = make([]uint32, len(si.Owners))
 	copy(pb.OwnerIDs, si.Owners)
@@ -512,6 +516,8 @@ func (si *ShardInfo) unmarshal(pb *proto2.ShardInfo) {
 	si.DownSampleID = pb.GetDownSampleID()
 	si.ReadOnly = pb.GetReadOnly()
 	si.MarkDelete = pb.GetMarkDelete()
+	si.SplitFrom = pb.GetSplitFrom()
+	si.Trimmed = pb.GetTrimmed()
 
 	si.Owners = make([]uint32, len(pb.GetOwnerIDs()))
 	for i, x := range pb.GetOwnerIDs() {
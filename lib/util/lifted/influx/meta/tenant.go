@@ -0,0 +1,514 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/influxdb/models"
+	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+)
+
+// TenantQuota is the resource limits of a tenant, 0 means unlimited
+type TenantQuota struct {
+	MaxSeries           int64
+	MaxStorageBytes     int64
+	MaxIngestRate       int64 // rows per second
+	MaxQueryConcurrency int64
+}
+
+// NewTenantQuotaUpdate returns a quota update which changes nothing, the negative quotas of an update are left unchanged
+func NewTenantQuotaUpdate() TenantQuota {
+	return TenantQuota{MaxSeries: -1, MaxStorageBytes: -1, MaxIngestRate: -1, MaxQueryConcurrency: -1}
+}
+
+func (q *TenantQuota) merge(update TenantQuota) {
+	if update.MaxSeries >= 0 {
+		q.MaxSeries = update.MaxSeries
+	}
+	if update.MaxStorageBytes >= 0 {
+		q.MaxStorageBytes = update.MaxStorageBytes
+	}
+	if update.MaxIngestRate >= 0 {
+		q.MaxIngestRate = update.MaxIngestRate
+	}
+	if update.MaxQueryConcurrency >= 0 {
+		q.MaxQueryConcurrency = update.MaxQueryConcurrency
+	}
+}
+
+// TenantInfo is a namespace of databases and users sharing the quota and the ts-store nodes of a tenant
+type TenantInfo struct {
+	Name  string
+	Quota TenantQuota
+
+	// the pts of the databases are only placed on these nodes, empty means all nodes
+	Nodes     []uint64
+	Databases []string // sorted
+	Users     []string // sorted
+
+	// the usage collected by the meta leader
+	Series       int64
+	StorageBytes int64
+}
+
+// HasDatabase returns true if the database (or the logstore repository) is owned by the tenant.
+func (t *TenantInfo) HasDatabase(name string) bool {
+	return containsSorted(t.Databases, name)
+}
+
+// HasUser returns true if the user belongs to the tenant.
+func (t *TenantInfo) HasUser(name string) bool {
+	return containsSorted(t.Users, name)
+}
+
+// HasNode returns true if the tenant is pinned to the node.
+func (t *TenantInfo) HasNode(id uint64) bool {
+	for _, n := range t.Nodes {
+		if n == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Pinned returns true if the pts of the tenant are only placed on its nodes.
+func (t *TenantInfo) Pinned() bool {
+	return len(t.Nodes) > 0
+}
+
+// CheckWriteQuota returns an error if the series or the storage of the tenant exceed the quota.
+func (t *TenantInfo) CheckWriteQuota() error {
+	if t.Quota.MaxSeries > 0 && t.Series >= t.Quota.MaxSeries {
+		return errno.NewError(errno.TenantSeriesQuotaExceeded, t.Name, t.Quota.MaxSeries, t.Series)
+	}
+	if t.Quota.MaxStorageBytes > 0 && t.StorageBytes >= t.Quota.MaxStorageBytes {
+		return errno.NewError(errno.TenantStorageQuotaExceeded, t.Name, t.Quota.MaxStorageBytes, t.StorageBytes)
+	}
+	return nil
+}
+
+// AuthorizeQuery returns an error if the query accesses a database not owned by the tenant,
+// or manages the tenants. It is checked in addition to the privileges of the user.
+func (t *TenantInfo) AuthorizeQuery(database string, query *influxql.Query) error {
+	for _, stmt := range query.Statements {
+		switch s := stmt.(type) {
+		case *influxql.CreateTenantStatement, *influxql.AlterTenantStatement, *influxql.DropTenantStatement,
+			*influxql.ShowTenantsStatement, *influxql.GrantTenantStatement, *influxql.RevokeTenantStatement:
+			return &ErrAuthorize{
+				Query:    query,
+				Database: database,
+				Message:  fmt.Sprintf("statement '%s', can not be executed in tenant %s", stmt, t.Name),
+			}
+		case *influxql.CreateDatabaseStatement:
+			// the database created in a tenant is assigned to the tenant
+			continue
+		case *influxql.DropDatabaseStatement:
+			if err := t.authorizeDatabase(s.Name, stmt, database, query); err != nil {
+				return err
+			}
+			continue
+		}
+
+		privs, err := stmt.RequiredPrivileges()
+		if err != nil {
+			return err
+		}
+		for _, p := range privs {
+			db := p.Name
+			if db == "" {
+				if p.Admin || originql.Privilege(p.Privilege) == originql.NoPrivileges {
+					continue
+				}
+				db = database
+			}
+			if err = t.authorizeDatabase(db, stmt, database, query); err != nil {
+				return err
+			}
+		}
+
+		if s, ok := stmt.(influxql.HasDefaultDatabase); ok {
+			db := s.DefaultDatabase()
+			if db == "" {
+				db = database
+			}
+			if db == "" {
+				continue
+			}
+			if err = t.authorizeDatabase(db, stmt, database, query); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (t *TenantInfo) authorizeDatabase(db string, stmt influxql.Statement, database string, query *influxql.Query) error {
+	if t.HasDatabase(db) {
+		return nil
+	}
+	return &ErrAuthorize{
+		Query:    query,
+		Database: database,
+		Message:  fmt.Sprintf("statement '%s', database %s is not owned by tenant %s", stmt, db, t.Name),
+	}
+}
+
+// Clone returns a deep copy of the tenant.
+func (t *TenantInfo) Clone() *TenantInfo {
+	other := *t
+	other.Nodes = append([]uint64(nil), t.Nodes...)
+	other.Databases = append([]string(nil), t.Databases...)
+	other.Users = append([]string(nil), t.Users...)
+	return &other
+}
+
+func (t *TenantInfo) marshal() *proto2.TenantInfo {
+	return &proto2.TenantInfo{
+		Name:                proto.String(t.Name),
+		MaxSeries:           proto.Int64(t.Quota.MaxSeries),
+		MaxStorageBytes:     proto.Int64(t.Quota.MaxStorageBytes),
+		MaxIngestRate:       proto.Int64(t.Quota.MaxIngestRate),
+		MaxQueryConcurrency: proto.Int64(t.Quota.MaxQueryConcurrency),
+		Nodes:               t.Nodes,
+		Databases:           t.Databases,
+		Users:               t.Users,
+		Series:              proto.Int64(t.Series),
+		StorageBytes:        proto.Int64(t.StorageBytes),
+	}
+}
+
+func (t *TenantInfo) unmarshal(pb *proto2.TenantInfo) {
+	t.Name = pb.GetName()
+	t.Quota = TenantQuota{
+		MaxSeries:           pb.GetMaxSeries(),
+		MaxStorageBytes:     pb.GetMaxStorageBytes(),
+		MaxIngestRate:       pb.GetMaxIngestRate(),
+		MaxQueryConcurrency: pb.GetMaxQueryConcurrency(),
+	}
+	t.Nodes = pb.GetNodes()
+	t.Databases = pb.GetDatabases()
+	t.Users = pb.GetUsers()
+	t.Series = pb.GetSeries()
+	t.StorageBytes = pb.GetStorageBytes()
+}
+
+func containsSorted(a []string, s string) bool {
+	i := sort.SearchStrings(a, s)
+	return i < len(a) && a[i] == s
+}
+
+func insertSorted(a []string, s string) []string {
+	i := sort.SearchStrings(a, s)
+	if i < len(a) && a[i] == s {
+		return a
+	}
+	a = append(a, "")
+	copy(a[i+1:], a[i:])
+	a[i] = s
+	return a
+}
+
+func removeSorted(a []string, s string) []string {
+	i := sort.SearchStrings(a, s)
+	if i < len(a) && a[i] == s {
+		return append(a[:i], a[i+1:]...)
+	}
+	return a
+}
+
+func (data *Data) marshalTenants() []*proto2.TenantInfo {
+	if len(data.Tenants) == 0 {
+		return nil
+	}
+	tenants := make([]*proto2.TenantInfo, 0, len(data.Tenants))
+	for name := range data.Tenants {
+		tenants = append(tenants, data.Tenants[name].marshal())
+	}
+	return tenants
+}
+
+// Tenant returns the tenant by name, nil if the tenant does not exist.
+func (data *Data) Tenant(name string) *TenantInfo {
+	return data.Tenants[name]
+}
+
+// DatabaseTenant returns the tenant owning the database, nil if the database is not owned by any tenant.
+func (data *Data) DatabaseTenant(database string) *TenantInfo {
+	for _, t := range data.Tenants {
+		if t.HasDatabase(database) {
+			return t
+		}
+	}
+	return nil
+}
+
+// UserTenant returns the tenant of the user, nil if the user does not belong to any tenant.
+func (data *Data) UserTenant(user string) *TenantInfo {
+	for _, t := range data.Tenants {
+		if t.HasUser(user) {
+			return t
+		}
+	}
+	return nil
+}
+
+// CloneTenants returns a copy of the tenants.
+func (data *Data) CloneTenants() map[string]*TenantInfo {
+	if data.Tenants == nil {
+		return nil
+	}
+	tenants := make(map[string]*TenantInfo, len(data.Tenants))
+	for name := range data.Tenants {
+		tenants[name] = data.Tenants[name].Clone()
+	}
+	return tenants
+}
+
+// CreateTenant creates a tenant, the negative quotas are treated as unlimited.
+func (data *Data) CreateTenant(name string, quota TenantQuota, nodes []uint64) error {
+	if name == "" {
+		return ErrTenantNameRequired
+	}
+	if data.Tenants[name] != nil {
+		return ErrTenantExists
+	}
+	nodes, err := data.checkTenantNodes(nodes)
+	if err != nil {
+		return err
+	}
+
+	t := &TenantInfo{Name: name, Nodes: nodes}
+	t.Quota.merge(quota)
+	if data.Tenants == nil {
+		data.Tenants = make(map[string]*TenantInfo)
+	}
+	data.Tenants[name] = t
+	return nil
+}
+
+// AlterTenant updates the quotas set by the update, and the pinned nodes if setNodes is true.
+// The pts already placed are not moved here, the rebalancer moves them to the pinned nodes when it is enabled.
+func (data *Data) AlterTenant(name string, update TenantQuota, nodes []uint64, setNodes bool) error {
+	t := data.Tenants[name]
+	if t == nil {
+		return ErrTenantNotFound
+	}
+	if setNodes {
+		var err error
+		if nodes, err = data.checkTenantNodes(nodes); err != nil {
+			return err
+		}
+		t.Nodes = nodes
+	}
+	t.Quota.merge(update)
+	return nil
+}
+
+// DropTenant removes a tenant which does not own any database.
+func (data *Data) DropTenant(name string) error {
+	t := data.Tenants[name]
+	if t == nil {
+		return ErrTenantNotFound
+	}
+	if len(t.Databases) > 0 {
+		return ErrTenantNotEmpty
+	}
+	delete(data.Tenants, name)
+	return nil
+}
+
+// AddTenantDatabase assigns a database to the tenant. The database does not need to exist,
+// so that the pts of a database created in a pinned tenant are placed on the nodes of the tenant.
+func (data *Data) AddTenantDatabase(name, database string) error {
+	t := data.Tenants[name]
+	if t == nil {
+		return ErrTenantNotFound
+	}
+	if database == "" {
+		return ErrDatabaseNameRequired
+	}
+	if owner := data.DatabaseTenant(database); owner != nil && owner != t {
+		return ErrOwnedByOtherTenant("database", database, owner.Name)
+	}
+	t.Databases = insertSorted(t.Databases, database)
+	return nil
+}
+
+// RemoveTenantDatabase removes a database from the tenant, the database is kept.
+func (data *Data) RemoveTenantDatabase(name, database string) error {
+	t := data.Tenants[name]
+	if t == nil {
+		return ErrTenantNotFound
+	}
+	t.Databases = removeSorted(t.Databases, database)
+	return nil
+}
+
+// AddTenantUser adds an existing user to the tenant, a user belongs to one tenant at most.
+func (data *Data) AddTenantUser(name, user string) error {
+	t := data.Tenants[name]
+	if t == nil {
+		return ErrTenantNotFound
+	}
+	if data.GetUser(user) == nil {
+		return ErrUserNotFound
+	}
+	if owner := data.UserTenant(user); owner != nil && owner != t {
+		return ErrOwnedByOtherTenant("user", user, owner.Name)
+	}
+	t.Users = insertSorted(t.Users, user)
+	return nil
+}
+
+// RemoveTenantUser removes a user from the tenant, the user is kept.
+func (data *Data) RemoveTenantUser(name, user string) error {
+	t := data.Tenants[name]
+	if t == nil {
+		return ErrTenantNotFound
+	}
+	t.Users = removeSorted(t.Users, user)
+	return nil
+}
+
+// UpdateTenantUsage sets the series and the storage bytes used by the tenant.
+func (data *Data) UpdateTenantUsage(name string, series, storageBytes int64) error {
+	t := data.Tenants[name]
+	if t == nil {
+		return ErrTenantNotFound
+	}
+	t.Series = series
+	t.StorageBytes = storageBytes
+	return nil
+}
+
+// ShowTenants returns the tenants ordered by name.
+func (data *Data) ShowTenants() models.Rows {
+	row := &models.Row{Name: "tenants", Columns: []string{"name", "max_series", "series", "max_storage_bytes",
+		"storage_bytes", "max_ingest_rate", "max_query_concurrency", "nodes", "databases", "users"}}
+	names := make([]string, 0, len(data.Tenants))
+	for name := range data.Tenants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		t := data.Tenants[name]
+		row.Values = append(row.Values, []interface{}{t.Name, t.Quota.MaxSeries, t.Series, t.Quota.MaxStorageBytes,
+			t.StorageBytes, t.Quota.MaxIngestRate, t.Quota.MaxQueryConcurrency, joinUint64(t.Nodes),
+			strings.Join(t.Databases, ","), strings.Join(t.Users, ",")})
+	}
+	return []*models.Row{row}
+}
+
+// checkTenantNodes returns the sorted and deduplicated nodes, all of them must be data nodes.
+func (data *Data) checkTenantNodes(nodes []uint64) ([]uint64, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	res := make([]uint64, 0, len(nodes))
+	for _, id := range nodes {
+		if data.DataNode(id) == nil {
+			return nil, ErrTenantNodeNotFound(id)
+		}
+		res = append(res, id)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	j := 0
+	for i := range res {
+		if i == 0 || res[i] != res[j-1] {
+			res[j] = res[i]
+			j++
+		}
+	}
+	return res[:j], nil
+}
+
+// PinnedDataNodes returns the nodes which the pts of the database can be placed on,
+// the nodes are filtered by the tenant of the database if the tenant is pinned.
+func (data *Data) PinnedDataNodes(database string, nodes []DataNode) []DataNode {
+	t := data.DatabaseTenant(database)
+	if t == nil || !t.Pinned() {
+		return nodes
+	}
+	pinned := make([]DataNode, 0, len(t.Nodes))
+	for i := range nodes {
+		if t.HasNode(nodes[i].ID) {
+			pinned = append(pinned, nodes[i])
+		}
+	}
+	return pinned
+}
+
+// NodeAllowed returns true if the pts of the database can be placed on the node.
+func (data *Data) NodeAllowed(database string, id uint64) bool {
+	t := data.DatabaseTenant(database)
+	return t == nil || !t.Pinned() || t.HasNode(id)
+}
+
+// expandPtOwner returns the node owning a pt added to the database when the new node joins. The pts of a database
+// pinned to other nodes are added to the pinned node owning the fewest pts of the database.
+func (data *Data) expandPtOwner(database string, newNode uint64) uint64 {
+	t := data.DatabaseTenant(database)
+	if t == nil || !t.Pinned() || t.HasNode(newNode) {
+		return newNode
+	}
+	ptNums := make(map[uint64]int, len(t.Nodes))
+	for _, pt := range data.PtView[database] {
+		ptNums[pt.Owner.NodeID]++
+	}
+	owner, minNum := newNode, -1
+	for _, id := range t.Nodes {
+		if data.DataNode(id) == nil {
+			continue
+		}
+		if minNum < 0 || ptNums[id] < minNum {
+			owner, minNum = id, ptNums[id]
+		}
+	}
+	return owner
+}
+
+// GetPinnedFailedPtInfos returns the pts with the status of the pinned databases owned by the other nodes,
+// they are added to the pinned nodes when the new node joins.
+func (data *Data) GetPinnedFailedPtInfos(id uint64, status PtStatus) []*DbPtInfo {
+	var resPtInfos []*DbPtInfo
+	for _, t := range data.Tenants {
+		if !t.Pinned() || t.HasNode(id) {
+			continue
+		}
+		for _, db := range t.Databases {
+			if data.Databases[db] == nil || data.Database(db).MarkDeleted {
+				continue
+			}
+			dbInfo := data.GetDBBriefInfo(db)
+			dbInfo.Replicas = data.Databases[db].ReplicaN
+			for i := range data.PtView[db] {
+				pt := data.PtView[db][i]
+				if pt.Owner.NodeID == id || pt.Status != status || !data.DataNodeAlive(pt.Owner.NodeID) {
+					continue
+				}
+				shards := data.GetShardDurationsByDbPtForRetention(db, pt.PtId)
+				resPtInfos = append(resPtInfos, &DbPtInfo{Db: db, Pti: &pt, Shards: shards, DBBriefInfo: dbInfo})
+			}
+		}
+	}
+	return resPtInfos
+}
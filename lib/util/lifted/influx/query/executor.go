@@ -214,6 +214,16 @@ type ExecutionOptions struct {
 
 	// IterID indicates the number of iteration in incremental query, starting from 0.
 	IterID int32
+
+	// ErrorBound indicates whether the error bounds of the lossy fields are returned with the results.
+	ErrorBound bool
+
+	// ReadConsistency overrides the default consistency level of the reads on the replicated databases.
+	ReadConsistency string
+
+	// Tenant is the tenant of the user running the query, the databases and the users created by the query
+	// are owned by the tenant. Empty if the user does not belong to any tenant.
+	Tenant string
 }
 
 func NewExecutionOptions(db, rp string, nodeID uint64, chunkSize, innerChunkSize int, chunked, readOnly, quiet, parallelQuery bool) *ExecutionOptions {
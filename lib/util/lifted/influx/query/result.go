@@ -21,6 +21,9 @@ import (
 const (
 	// WarningLevel is the message level for a warning.
 	WarningLevel = "warning"
+
+	// InfoLevel is the message level for an information.
+	InfoLevel = "info"
 )
 
 // TagSet is a fundamental concept within the query system. It represents a composite series,
@@ -71,6 +71,9 @@ type SelectOptions struct {
 	IncQuery bool
 	QueryID  string
 	IterID   int32
+
+	// ReadConsistency is the consistency level of the reads on the replicated databases, the default level is used if it is empty.
+	ReadConsistency string
 }
 
 type LogicalPlanCreator interface {
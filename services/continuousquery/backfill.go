@@ -0,0 +1,157 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package continuousquery
+
+import (
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// DefaultBackfillRetryInterval is the time to wait before a failed window of a backfill is run again.
+const DefaultBackfillRetryInterval = time.Minute
+
+// backfillKey identifies a backfill of a continuous query
+type backfillKey struct {
+	name       string
+	start, end int64
+}
+
+func newBackfillKey(name string, backfill *meta.ContinuousQueryBackfillInfo) backfillKey {
+	return backfillKey{name: name, start: backfill.StartTime.UnixNano(), end: backfill.EndTime.UnixNano()}
+}
+
+// scheduleBackfills starts the backfills of the continuous queries owned by this node,
+// and stops the running backfills whose continuous queries are no longer owned.
+func (s *Service) scheduleBackfills() {
+	owned := make(map[string]*ContinuousQuery, len(s.ContinuousQueries))
+	for _, cq := range s.ContinuousQueries {
+		owned[cq.name] = cq
+	}
+
+	s.backfillsLock.Lock()
+	defer s.backfillsLock.Unlock()
+	for name, stop := range s.backfills {
+		if _, ok := owned[name]; !ok {
+			close(stop)
+			delete(s.backfills, name)
+		}
+	}
+	if len(owned) == 0 {
+		return
+	}
+
+	dbs := s.MetaClient.Databases()
+	for name, cq := range owned {
+		if _, ok := s.backfills[name]; ok {
+			continue
+		}
+		dbi, ok := dbs[cq.database]
+		if !ok {
+			continue
+		}
+		cqi, ok := dbi.ContinuousQueries[name]
+		if !ok || cqi.Backfill == nil {
+			s.clearBackfillProgress(name)
+			continue
+		}
+
+		// the progress in meta may be behind the windows completed by this node, until the reports are synchronized
+		backfill := *cqi.Backfill
+		if next, ok := s.backfillProgress[newBackfillKey(name, &backfill)]; ok && next.After(backfill.NextTime) {
+			backfill.NextTime = next
+		}
+		if backfill.Done() {
+			continue
+		}
+
+		// the backfill runs a copy of the continuous query, since the time range of the statement is changed for each window
+		backfillCQ := NewContinuousQuery(dbi.DefaultRetentionPolicy, cqi.Query)
+		if backfillCQ == nil {
+			continue
+		}
+		stop := make(chan struct{})
+		s.backfills[name] = stop
+		s.wg.Add(1)
+		go s.runBackfill(backfillCQ, backfill, stop)
+	}
+}
+
+func (s *Service) clearBackfillProgress(name string) {
+	for key := range s.backfillProgress {
+		if key.name == name {
+			delete(s.backfillProgress, key)
+		}
+	}
+}
+
+func (s *Service) setBackfillProgress(key backfillKey, next time.Time) {
+	s.backfillsLock.Lock()
+	s.backfillProgress[key] = next
+	s.backfillsLock.Unlock()
+}
+
+// runBackfill runs the windows of the backfill one by one from its NextTime, pausing BackfillThrottle between
+// two windows. The progress is reported after each window, so the backfill is resumed from the first window
+// not completed if it fails or the continuous query is moved to another node.
+func (s *Service) runBackfill(cq *ContinuousQuery, backfill meta.ContinuousQueryBackfillInfo, stop chan struct{}) {
+	defer func() {
+		s.backfillsLock.Lock()
+		if s.backfills[cq.name] == stop {
+			delete(s.backfills, cq.name)
+		}
+		s.backfillsLock.Unlock()
+		s.wg.Done()
+	}()
+
+	s.logger.Info("start to backfill continuous query", zap.String("name", cq.name), zap.Time("start", backfill.StartTime),
+		zap.Time("end", backfill.EndTime), zap.Time("next", backfill.NextTime))
+
+	key := newBackfillKey(cq.name, &backfill)
+	next := backfill.NextTime
+	for next.Before(backfill.EndTime) {
+		// the windows are aligned to the GROUP BY time interval and offset of the continuous query,
+		// runWindow runs the window containing next
+		end, err := s.runWindow(cq, next.Add(cq.resampleEvery))
+		wait := s.BackfillThrottle
+		lastErr := ""
+		if err == nil {
+			next = end
+			s.setBackfillProgress(key, next)
+		} else {
+			s.logger.Error("backfill continuous query failed", zap.String("name", cq.name), zap.Time("window", next), zap.Error(err))
+			wait = s.backfillRetryInterval
+			lastErr = err.Error()
+		}
+
+		if err = s.MetaClient.UpdateContinuousQueryBackfill(cq.database, cq.name, next, s.hostname, lastErr); err != nil {
+			s.logger.Error("report continuous query backfill progress failed", zap.String("name", cq.name), zap.Error(err))
+		}
+		if !next.Before(backfill.EndTime) {
+			break
+		}
+
+		select {
+		case <-s.closing:
+			return
+		case <-stop:
+			s.logger.Info("stop backfilling continuous query", zap.String("name", cq.name), zap.Time("next", next))
+			return
+		case <-time.After(wait):
+		}
+	}
+	s.logger.Info("backfill continuous query completed", zap.String("name", cq.name), zap.Time("end", backfill.EndTime))
+}
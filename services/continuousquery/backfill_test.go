@@ -0,0 +1,108 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package continuousquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_RunBackfill(t *testing.T) {
+	s, cq := NewContinuousQueryService()
+	mc := s.MetaClient.(*MockMetaClient)
+	e := &windowQueryExecutor{failures: 1}
+	s.QueryExecutor = e
+	s.backfillRetryInterval = 0
+
+	start := time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)
+	backfill := meta.ContinuousQueryBackfillInfo{StartTime: start, EndTime: end, NextTime: start}
+	stop := make(chan struct{})
+	s.backfills[cq.name] = stop
+	s.wg.Add(1)
+	s.runBackfill(cq, backfill, stop)
+
+	// the first window failed and is run again
+	require.Equal(t, 4, len(e.windows))
+	assert.Equal(t, e.windows[0], e.windows[1])
+	for i, w := range e.windows[1:] {
+		assert.Equal(t, time.Date(2024, 1, 1, i, 0, 0, 0, time.UTC), w[0].UTC())
+		assert.Equal(t, time.Hour, w[1].Sub(w[0]))
+	}
+
+	reports := mc.getBackfillReports()
+	require.Equal(t, 4, len(reports))
+	assert.Equal(t, backfillReport{next: start, host: "127.0.0.1:8086", lastErr: "mock error"}, reports[0])
+	for i, r := range reports[1:] {
+		assert.Equal(t, backfillReport{next: time.Date(2024, 1, 1, i+1, 0, 0, 0, time.UTC), host: "127.0.0.1:8086"}, r)
+	}
+	assert.Equal(t, 0, len(s.backfills))
+	assert.Equal(t, end, s.backfillProgress[newBackfillKey(cq.name, &backfill)])
+}
+
+func TestService_ScheduleBackfills(t *testing.T) {
+	s, cq := NewContinuousQueryService()
+	mc := s.MetaClient.(*MockMetaClient)
+	e := &windowQueryExecutor{}
+	s.QueryExecutor = e
+	s.BackfillThrottle = time.Hour
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)
+	cqi := mc.Databases()["db1"].ContinuousQueries[cq.name]
+	cqi.Backfill = &meta.ContinuousQueryBackfillInfo{StartTime: start, EndTime: end, NextTime: start}
+
+	// the backfill is not run unless the continuous query is owned
+	s.scheduleBackfills()
+	assert.Equal(t, 0, len(s.backfills))
+
+	s.ContinuousQueries = []*ContinuousQuery{cq}
+	s.scheduleBackfills()
+	assert.Eventually(t, func() bool {
+		return len(mc.getBackfillReports()) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+	// the running backfill is not started again
+	s.scheduleBackfills()
+
+	// the backfill is stopped when the lease of the continuous query is lost
+	s.ContinuousQueries = nil
+	s.scheduleBackfills()
+	s.wg.Wait()
+	assert.Equal(t, 1, len(e.windows))
+	assert.Equal(t, 0, len(s.backfills))
+
+	// the backfill resumes from the windows completed, though meta is not updated
+	s.BackfillThrottle = 0
+	s.ContinuousQueries = []*ContinuousQuery{cq}
+	s.scheduleBackfills()
+	s.wg.Wait()
+	require.Equal(t, 3, len(e.windows))
+	assert.Equal(t, time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC), e.windows[1][0].UTC())
+	reports := mc.getBackfillReports()
+	assert.Equal(t, end, reports[len(reports)-1].next)
+
+	// the completed backfill is not run again
+	s.scheduleBackfills()
+	assert.Equal(t, 0, len(s.backfills))
+	assert.Equal(t, 3, len(e.windows))
+
+	cqi.Backfill = nil
+	s.scheduleBackfills()
+	assert.Equal(t, 0, len(s.backfillProgress))
+}
@@ -34,8 +34,10 @@ type ContinuousQuery struct {
 	resampleFor   time.Duration // Maximum duration to resample previous queries.
 	groupByOffset time.Duration
 
-	lastRun time.Time                 // The time that the cq runs successfully.
-	source  *influxql.SelectStatement // The select into clause.
+	lastRun   time.Time                 // The time that the cq runs successfully.
+	lastError string                    // The error of the last run.
+	changed   bool                      // The state is changed since the last report.
+	source    *influxql.SelectStatement // The select into clause.
 
 	reportInterval time.Duration // The report interval of uploading the continuous query's last run time.
 }
@@ -70,6 +72,10 @@ func NewContinuousQuery(rp string, query string) *ContinuousQuery {
 		logger.GetLogger().Warn("continuous query statement get group by interval error", zap.Error(err))
 		return nil
 	}
+	if interval <= 0 {
+		logger.GetLogger().Warn("continuous query statement without group by time", zap.String("query", query))
+		return nil
+	}
 	resampleFor := interval
 	// check interval and ResampleFor/ResampleEvery
 	if q.ResampleFor != 0 && q.ResampleEvery != 0 && q.ResampleEvery > interval {
@@ -116,6 +122,17 @@ func (cq *ContinuousQuery) setIntoRP(rp string) {
 	cq.source.Target.Measurement.RetentionPolicy = rp
 }
 
+func (cq *ContinuousQuery) setLastError(err error) {
+	lastError := ""
+	if err != nil {
+		lastError = err.Error()
+	}
+	if lastError != cq.lastError {
+		cq.lastError = lastError
+		cq.changed = true
+	}
+}
+
 // shouldRunContinuousQuery returns true and next run time if the CQ should run.
 func (cq *ContinuousQuery) shouldRunContinuousQuery(now time.Time) (bool, time.Time) {
 	if cq.hasRun {
@@ -138,6 +155,8 @@ func getContinuousQueries(dst []*ContinuousQuery, dbs map[string]*meta.DatabaseI
 			}
 			cq := NewContinuousQuery(dbi.DefaultRetentionPolicy, cqi.Query)
 			if cq != nil {
+				// report the new owner as soon as the lease is got
+				cq.changed = true
 				dst = append(dst, cq)
 			}
 		}
@@ -42,7 +42,8 @@ type MetaClient interface {
 	GetMaxCQChangeID() uint64
 	Databases() map[string]*meta.DatabaseInfo
 	GetCqLease(host string) ([]string, error)
-	BatchUpdateContinuousQueryStat(cqStats map[string]int64) error
+	BatchUpdateContinuousQueryStat(host string, cqStats map[string]meta.ContinuousQueryStat) error
+	UpdateContinuousQueryBackfill(database, name string, next time.Time, host, lastErr string) error
 }
 
 type QueryExecutor interface {
@@ -73,6 +74,17 @@ type Service struct {
 	maxProcessCQNumber int
 	ContinuousQueries  []*ContinuousQuery
 
+	// BackfillHorizon is how far back the windows missed by outages are run, the older windows are skipped
+	BackfillHorizon time.Duration
+
+	// BackfillThrottle is the pause between two windows of a backfill started by BACKFILL CONTINUOUS QUERY
+	BackfillThrottle      time.Duration
+	backfillRetryInterval time.Duration
+
+	backfillsLock    sync.Mutex
+	backfills        map[string]chan struct{}  // running backfills, closed to stop the backfill. e.g.{"cq_name1": stop}
+	backfillProgress map[backfillKey]time.Time // the windows completed by this node
+
 	maxCQChangedID uint64 // cache maxCQChangedID to check cq is changed
 	metaChangedCh  chan struct{}
 	cqLeaseChanged chan struct{} // last cq has changed, notify sql node to get cq lease
@@ -90,6 +102,10 @@ func NewService(hostname string, interval time.Duration, number int) *Service {
 
 		maxProcessCQNumber: number,
 		cqLeaseChanged:     make(chan struct{}),
+
+		backfillRetryInterval: DefaultBackfillRetryInterval,
+		backfills:             map[string]chan struct{}{},
+		backfillProgress:      map[backfillKey]time.Time{},
 	}
 	s.base.Init("continuousQuery", interval, s.handle)
 	return s
@@ -172,10 +188,34 @@ func (s *Service) getContinuousQueries() []*ContinuousQuery {
 			s.reportInterval = continuousQueries[i].reportInterval
 		}
 	}
+	s.resumeLastRuns(dbs, continuousQueries)
 	s.logger.Debug("get newly continuous query lease", zap.Int("CQ numbers", len(continuousQueries)))
 	return continuousQueries
 }
 
+// resumeLastRuns continues the continuous queries from the last completed windows persisted in meta,
+// which are reported by the previous owners of the leases.
+func (s *Service) resumeLastRuns(dbs map[string]*meta.DatabaseInfo, cqs []*ContinuousQuery) {
+	s.lastRunsLock.Lock()
+	defer s.lastRunsLock.Unlock()
+	for _, cq := range cqs {
+		dbi, ok := dbs[cq.database]
+		if !ok {
+			continue
+		}
+		cqi, ok := dbi.ContinuousQueries[cq.name]
+		if !ok || !cqi.HasRun() {
+			continue
+		}
+		if lastRun, ok := s.lastRuns[cq.name]; ok && !lastRun.Before(cqi.LastRunTime) {
+			continue
+		}
+		s.logger.Info("resume continuous query from the last completed window", zap.String("name", cq.name),
+			zap.Time("last run", cqi.LastRunTime))
+		s.lastRuns[cq.name] = cqi.LastRunTime
+	}
+}
+
 func (s *Service) handle() {
 	if syscontrol.IsReadonly() {
 		return
@@ -196,6 +236,7 @@ func (s *Service) handle() {
 		s.logger.Info("continuous query lease changed")
 		s.ContinuousQueries = s.getContinuousQueries()
 	}
+	s.scheduleBackfills()
 
 	if len(s.ContinuousQueries) == 0 {
 		return
@@ -213,6 +254,9 @@ func (s *Service) handle() {
 			tokens <- struct{}{}
 			ok, err := s.ExecuteContinuousQuery(cq, now)
 			s.logger.Debug("try to execute continuous query", zap.String("query", cq.source.String()), zap.Bool("ok", ok), zap.Error(err))
+			if err != nil {
+				s.logger.Error("execute continuous query failed", zap.String("name", cq.name), zap.Error(err))
+			}
 			<-tokens
 		}(cq)
 	}
@@ -222,6 +266,7 @@ func (s *Service) handle() {
 }
 
 // ExecuteContinuousQuery may execute a single CQ. This will return false if there were no errors and the CQ was not run.
+// All the windows missed since the last run are run one by one, but not earlier than the BackfillHorizon.
 func (s *Service) ExecuteContinuousQuery(cq *ContinuousQuery, now time.Time) (bool, error) {
 	// check time zone, if not set, use UTC.
 	if cq.source.Location != nil {
@@ -237,27 +282,57 @@ func (s *Service) ExecuteContinuousQuery(cq *ContinuousQuery, now time.Time) (bo
 	if !ok {
 		return false, nil
 	}
+	if cq.hasRun {
+		s.skipExpiredWindows(cq, now)
+		_, nextRun = cq.shouldRunContinuousQuery(now)
+	}
 
+	for ok {
+		end, err := s.runWindow(cq, nextRun)
+		if err != nil {
+			cq.setLastError(err)
+			return false, err
+		}
+
+		// update cq.lastRun and s.lastRuns
+		cq.lastRun = end
+		cq.hasRun = true
+		cq.setLastError(nil)
+		cq.changed = true
+		s.lastRunsLock.Lock()
+		s.lastRuns[cq.name] = cq.lastRun
+		s.lastRunsLock.Unlock()
+
+		ok, nextRun = cq.shouldRunContinuousQuery(now)
+	}
+	return true, nil
+}
+
+// skipExpiredWindows moves the last run of cq forward if the missed windows are older than the BackfillHorizon.
+// The latest window is always run.
+func (s *Service) skipExpiredWindows(cq *ContinuousQuery, now time.Time) {
+	oldest := now.Add(-s.BackfillHorizon - cq.groupByOffset).Truncate(cq.resampleEvery).Add(cq.groupByOffset - cq.resampleEvery)
+	if !cq.lastRun.Before(oldest) {
+		return
+	}
+	s.logger.Warn("skip the continuous query windows beyond the backfill horizon", zap.String("name", cq.name),
+		zap.Time("last run", cq.lastRun), zap.Time("resume", oldest), zap.Duration("horizon", s.BackfillHorizon))
+	cq.lastRun = oldest
+}
+
+// runWindow runs the last window of cq completed at nextRun, and returns the end of the window.
+func (s *Service) runWindow(cq *ContinuousQuery, nextRun time.Time) (time.Time, error) {
 	// Calculate and set the time range for the query.
-	// startTime should be earlier than current time.
-	startTime := nextRun.Add(-cq.resampleEvery - cq.groupByOffset - 1).Truncate(cq.resampleEvery).Add(cq.groupByOffset)
-	endTime := startTime.Add(cq.resampleEvery - cq.groupByOffset).Truncate(cq.resampleEvery).Add(cq.groupByOffset)
+	// endTime should not be later than nextRun, so the window is completed.
+	endTime := nextRun.Add(-cq.groupByOffset).Truncate(cq.resampleEvery).Add(cq.groupByOffset)
+	startTime := endTime.Add(-cq.resampleEvery)
 	if err := cq.source.SetTimeRange(startTime, endTime); err != nil {
-		return false, fmt.Errorf("unable to set time range: %s", err)
+		return endTime, fmt.Errorf("unable to set time range: %s", err)
 	}
 
 	// execute the query and write the results
 	res := s.runContinuousQueryAndWriteResult(cq)
-	if res.Err != nil {
-		return false, res.Err
-	}
-
-	// update cq.lastRun and s.lastRuns
-	cq.lastRun = now.Truncate(cq.resampleEvery)
-	s.lastRunsLock.Lock()
-	s.lastRuns[cq.name] = cq.lastRun
-	s.lastRunsLock.Unlock()
-	return true, nil
+	return endTime, res.Err
 }
 
 // runContinuousQueryAndWriteResult will run the query and write the results.
@@ -302,21 +377,33 @@ func (s *Service) runContinuousQueryAndWriteResult(cq *ContinuousQuery) *query.R
 	return res
 }
 
+// tryReportLastRunTime reports the state of the continuous queries. The state is reported as soon as a window
+// is completed or the error is changed, so the new owner resumes from the last completed window.
 func (s *Service) tryReportLastRunTime() {
-	if time.Since(s.lastReportTime) < s.reportInterval {
+	changed := false
+	for _, cq := range s.ContinuousQueries {
+		changed = changed || cq.changed
+	}
+	if !changed && time.Since(s.lastReportTime) < s.reportInterval {
 		return
 	}
 
-	var cqStat = make(map[string]int64, len(s.ContinuousQueries))
+	var cqStat = make(map[string]meta.ContinuousQueryStat, len(s.ContinuousQueries))
 
 	for _, cq := range s.ContinuousQueries {
-		cqStat[cq.name] = cq.lastRun.UnixNano()
+		cqStat[cq.name] = meta.ContinuousQueryStat{
+			LastRunTime: cq.lastRun.UnixNano(),
+			LastError:   cq.lastError,
+		}
 	}
-	err := s.MetaClient.BatchUpdateContinuousQueryStat(cqStat)
+	err := s.MetaClient.BatchUpdateContinuousQueryStat(s.hostname, cqStat)
 	if err != nil {
 		s.logger.Error("batch update continuous queries stat err", zap.Error(err))
 		return
 	}
+	for _, cq := range s.ContinuousQueries {
+		cq.changed = false
+	}
 	s.lastReportTime = time.Now()
 }
 
@@ -17,6 +17,7 @@ package continuousquery
 import (
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -40,6 +41,12 @@ type MockMetaClient struct {
 	GetMaxCQChangeIDFn               func() uint64
 	BatchUpdateContinuousQueryStatFn func() error
 
+	reportedHost  string
+	reportedStats map[string]meta.ContinuousQueryStat
+
+	backfillMu      sync.Mutex
+	backfillReports []backfillReport
+
 	changed chan chan struct{}
 	MetaClient
 }
@@ -66,10 +73,32 @@ func (mc *MockMetaClient) GetMaxCQChangeID() uint64 {
 	return mc.GetMaxCQChangeIDFn()
 }
 
-func (mc *MockMetaClient) BatchUpdateContinuousQueryStat(cqStats map[string]int64) error {
+func (mc *MockMetaClient) BatchUpdateContinuousQueryStat(host string, cqStats map[string]meta.ContinuousQueryStat) error {
+	mc.reportedHost = host
+	mc.reportedStats = cqStats
 	return mc.BatchUpdateContinuousQueryStatFn()
 }
 
+// backfillReport is the progress of a backfill reported to meta
+type backfillReport struct {
+	next    time.Time
+	host    string
+	lastErr string
+}
+
+func (mc *MockMetaClient) UpdateContinuousQueryBackfill(database, name string, next time.Time, host, lastErr string) error {
+	mc.backfillMu.Lock()
+	defer mc.backfillMu.Unlock()
+	mc.backfillReports = append(mc.backfillReports, backfillReport{next: next, host: host, lastErr: lastErr})
+	return nil
+}
+
+func (mc *MockMetaClient) getBackfillReports() []backfillReport {
+	mc.backfillMu.Lock()
+	defer mc.backfillMu.Unlock()
+	return append([]backfillReport{}, mc.backfillReports...)
+}
+
 // mockQueryExecutor is a mock query executor
 type mockQueryExecutor struct {
 	ExecuteQueryFn func(results chan *query.Result)
@@ -81,6 +110,29 @@ func (e *mockQueryExecutor) ExecuteQuery(q *influxql.Query, opt query.ExecutionO
 	return res
 }
 
+// windowQueryExecutor records the time ranges of the executed continuous queries
+type windowQueryExecutor struct {
+	mu       sync.Mutex
+	windows  [][2]time.Time
+	err      error
+	failures int // the number of the first windows failed
+}
+
+func (e *windowQueryExecutor) ExecuteQuery(q *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *query.Result {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	_, tr, _ := influxql.ConditionExpr(q.Statements[0].(*influxql.SelectStatement).Condition, nil)
+	e.windows = append(e.windows, [2]time.Time{tr.Min, tr.Max.Add(1)})
+	err := e.err
+	if e.failures > 0 {
+		e.failures--
+		err = fmt.Errorf("mock error")
+	}
+	res := make(chan *query.Result, 1)
+	res <- &query.Result{Err: err}
+	return res
+}
+
 // NewTestService returns a new *Service with default mock object members.
 func NewTestService() *Service {
 	s := NewService("127.0.0.1:8086", config.DefaultRunInterval, 1)
@@ -387,3 +439,145 @@ func TestService_NewContinuousQuery(t *testing.T) {
 	cq = NewContinuousQuery(db4.DefaultRetentionPolicy, cq4.Query)
 	assert.NotNil(t, cq)
 }
+
+func TestService_ExecuteContinuousQuery_Backfill(t *testing.T) {
+	s, cq := NewContinuousQueryService()
+	e := &windowQueryExecutor{}
+	s.QueryExecutor = e
+	s.BackfillHorizon = 24 * time.Hour
+
+	now := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+	// the windows from 7:00 are missed
+	s.lastRuns[cq.name] = time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	ok, err := s.ExecuteContinuousQuery(cq, now)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(e.windows))
+	for i, w := range e.windows {
+		assert.Equal(t, time.Date(2024, 1, 1, 7+i, 0, 0, 0, time.UTC), w[0].UTC())
+		assert.Equal(t, time.Hour, w[1].Sub(w[0]))
+	}
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), s.lastRuns[cq.name])
+	assert.True(t, cq.changed)
+
+	// every window is run exactly once
+	e.windows = nil
+	ok, err = s.ExecuteContinuousQuery(cq, now.Add(20*time.Minute))
+	assert.False(t, ok)
+	assert.NoError(t, err)
+	ok, err = s.ExecuteContinuousQuery(cq, now.Add(40*time.Minute))
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(e.windows))
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), e.windows[0][0].UTC())
+
+	// the windows are aligned with the offset of group by time
+	cq = NewContinuousQuery("default", `CREATE CONTINUOUS QUERY "cq2" ON "db1" BEGIN SELECT count(value) INTO "count_value" FROM "test" GROUP BY time(1h, 15m) END`)
+	e.windows = nil
+	s.lastRuns[cq.name] = time.Date(2024, 1, 1, 8, 15, 0, 0, time.UTC)
+	ok, err = s.ExecuteContinuousQuery(cq, now)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(e.windows))
+	assert.Equal(t, [2]time.Time{time.Date(2024, 1, 1, 9, 15, 0, 0, time.UTC), time.Date(2024, 1, 1, 10, 15, 0, 0, time.UTC)},
+		[2]time.Time{e.windows[1][0].UTC(), e.windows[1][1].UTC()})
+}
+
+func TestService_ExecuteContinuousQuery_BackfillHorizon(t *testing.T) {
+	s, cq := NewContinuousQueryService()
+	e := &windowQueryExecutor{}
+	s.QueryExecutor = e
+	s.BackfillHorizon = 2 * time.Hour
+
+	now := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+	s.lastRuns[cq.name] = time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	ok, err := s.ExecuteContinuousQuery(cq, now)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	// the windows older than the horizon are skipped
+	assert.Equal(t, 3, len(e.windows))
+	assert.Equal(t, time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC), e.windows[0][0].UTC())
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), s.lastRuns[cq.name])
+
+	// only the latest window is run without backfill
+	e.windows = nil
+	s.BackfillHorizon = 0
+	s.lastRuns[cq.name] = time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	ok, err = s.ExecuteContinuousQuery(cq, now)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(e.windows))
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), s.lastRuns[cq.name])
+}
+
+func TestService_ExecuteContinuousQuery_LastError(t *testing.T) {
+	s, cq := NewContinuousQueryService()
+	e := &windowQueryExecutor{err: fmt.Errorf("mock error")}
+	s.QueryExecutor = e
+	s.BackfillHorizon = 24 * time.Hour
+
+	now := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+	lastRun := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	s.lastRuns[cq.name] = lastRun
+	ok, err := s.ExecuteContinuousQuery(cq, now)
+	assert.False(t, ok)
+	assert.EqualError(t, err, "mock error")
+	assert.Equal(t, "mock error", cq.lastError)
+	// the failed window is retried in the next run
+	assert.Equal(t, lastRun, s.lastRuns[cq.name])
+
+	e.err = nil
+	e.windows = nil
+	_, err = s.ExecuteContinuousQuery(cq, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "", cq.lastError)
+	assert.Equal(t, time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC), e.windows[0][0].UTC())
+}
+
+func TestService_ResumeFromMeta(t *testing.T) {
+	lastRun := time.Now().Truncate(time.Hour).Add(-time.Hour)
+	dbs := map[string]*meta.DatabaseInfo{
+		"db0": {
+			Name: "db0",
+			ContinuousQueries: map[string]*meta.ContinuousQueryInfo{
+				"cq0": {
+					Name:        "cq0",
+					Query:       `CREATE CONTINUOUS QUERY cq0 ON db0 BEGIN SELECT count(v0) INTO mst FROM m0 GROUP BY time(1h) END`,
+					LastRunTime: lastRun,
+				},
+				"cq1": {
+					Name:  "cq1",
+					Query: `CREATE CONTINUOUS QUERY cq1 ON db0 BEGIN SELECT count(v0) INTO mst FROM m0 GROUP BY time(1h) END`,
+				},
+			},
+		},
+	}
+	s := NewTestService()
+	mc := &MockMetaClient{
+		DatabasesFn: func() map[string]*meta.DatabaseInfo {
+			return dbs
+		},
+		GetCqLeaseFn: func() ([]string, error) {
+			return []string{"cq0", "cq1"}, nil
+		},
+		BatchUpdateContinuousQueryStatFn: func() error {
+			return nil
+		},
+		changed: make(chan chan struct{}, 10),
+	}
+	s.MetaClient = mc
+	e := &windowQueryExecutor{}
+	s.QueryExecutor = e
+
+	s.handle()
+	// cq0 continues from the window completed by the previous owner, and cq1 runs for the first time
+	assert.Equal(t, 2, len(e.windows))
+	assert.Equal(t, lastRun.Add(time.Hour), s.lastRuns["cq0"])
+	assert.Equal(t, "127.0.0.1:8086", mc.reportedHost)
+	assert.Equal(t, lastRun.Add(time.Hour).UnixNano(), mc.reportedStats["cq0"].LastRunTime)
+	assert.Equal(t, "", mc.reportedStats["cq0"].LastError)
+
+	// the local last run is newer than the one in meta
+	s.resumeLastRuns(dbs, s.ContinuousQueries)
+	assert.Equal(t, lastRun.Add(time.Hour), s.lastRuns["cq0"])
+}
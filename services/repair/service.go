@@ -0,0 +1,452 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repair
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/services"
+	"go.uber.org/zap"
+)
+
+const (
+	StateDetected  = "detected"
+	StateRepairing = "repairing"
+	StateRepaired  = "repaired"
+	StateFailed    = "failed"
+	// the divergence disappears without repair, e.g. the replica has caught up with the leader
+	StateConverged = "converged"
+)
+
+// the number of the repairs kept for SHOW REPAIRS
+const maxRepairHistory = 1000
+
+type metaClient interface {
+	IsMasterPt(ptId uint32, database string) bool
+	DBPtView(database string) (meta.DBPtInfos, error)
+	DBRepGroups(database string) []meta.ReplicaGroup
+	ShardOwner(shardID uint64) (database, policy string, sgi *meta.ShardGroupInfo)
+}
+
+type engine interface {
+	FetchShardsNeedRepair(coldDuration time.Duration) []*meta.ShardIdentifier
+	ShardDigest(db string, ptId uint32, shardID uint64, interval int64) (*netstorage.ShardDigest, error)
+	ScanShardRows(db string, ptId uint32, shardID uint64, mst string, ranges []util.TimeRange, fn func(rows []byte) error) (int64, error)
+}
+
+type store interface {
+	ShardDigest(nodeID uint64, db string, pt uint32, shardID uint64, interval int64) (*netstorage.ShardDigest, error)
+	RepairShard(nodeID uint64, db, rp string, pt uint32, shardID uint64, rows []byte) (int64, error)
+}
+
+type repairKey struct {
+	db      string
+	shardID uint64
+	mst     string
+	peer    uint32
+}
+
+// divergence is a measurement whose digests are different on the leader and on a follower
+type divergence struct {
+	rounds int
+	seen   uint64
+	hashes [2]uint64 // the digests of the leader and of the follower
+	info   *netstorage.RepairInfo
+}
+
+type peer struct {
+	ptID    uint32
+	nodeID  uint64
+	shardID uint64
+}
+
+// Service is the anti-entropy of the replicated partitions. The leader of a partition compares the digests of the
+// cold shards with the followers periodically. If a measurement diverges with the same digests in the consecutive
+// rounds, the rows of the leader in the diverged time ranges are streamed to the diverged follower only.
+// The leader is always the source of the repair, so the rows deleted on the leader are not resurrected.
+type Service struct {
+	MetaClient metaClient
+	Engine     engine
+	Store      store
+
+	Logger *logger.Logger
+
+	Config *config.RepairConfig
+
+	base services.Base
+
+	mu          sync.Mutex
+	round       uint64
+	divergences map[repairKey]*divergence
+	// the digests of the repaired divergences, they are kept until the replicas converge
+	repaired map[repairKey][2]uint64
+	history  []*netstorage.RepairInfo
+}
+
+func NewService(c config.RepairConfig) *Service {
+	s := &Service{
+		Logger:      logger.NewLogger(errno.ModuleHA),
+		Config:      &c,
+		divergences: make(map[repairKey]*divergence),
+		repaired:    make(map[repairKey][2]uint64),
+	}
+	s.base.Init("repair", time.Duration(c.RunInterval), s.handle)
+	return s
+}
+
+func (s *Service) Open() error {
+	s.Logger.Info("service open", zap.Duration("RunInterval", time.Duration(s.Config.RunInterval)),
+		zap.Duration("ColdDuration", time.Duration(s.Config.ColdDuration)))
+	return s.base.Open()
+}
+
+func (s *Service) Close() error {
+	return s.base.Close()
+}
+
+// Repairs returns the divergences being repaired and the latest finished repairs
+func (s *Service) Repairs() []*netstorage.RepairInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	repairs := make([]*netstorage.RepairInfo, 0, len(s.history))
+	for _, info := range s.history {
+		c := *info
+		repairs = append(repairs, &c)
+	}
+	return repairs
+}
+
+func (s *Service) handle() {
+	s.mu.Lock()
+	s.round++
+	s.mu.Unlock()
+
+	for _, sh := range s.Engine.FetchShardsNeedRepair(time.Duration(s.Config.ColdDuration)) {
+		if !s.MetaClient.IsMasterPt(sh.OwnerPt, sh.OwnerDb) {
+			continue
+		}
+		if err := s.checkShard(sh); err != nil {
+			s.Logger.Warn("check shard failed", zap.String("db", sh.OwnerDb), zap.Uint32("pt", sh.OwnerPt),
+				zap.Uint64("shard", sh.ShardID), zap.Error(err))
+		}
+	}
+	s.dropConverged()
+}
+
+func (s *Service) checkShard(sh *meta.ShardIdentifier) error {
+	peers, err := s.peers(sh)
+	if err != nil || len(peers) == 0 {
+		return err
+	}
+	interval := int64(s.Config.DigestRange)
+	local, err := s.Engine.ShardDigest(sh.OwnerDb, sh.OwnerPt, sh.ShardID, interval)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range peers {
+		remote, err := s.Store.ShardDigest(p.nodeID, sh.OwnerDb, p.ptID, p.shardID, interval)
+		if err != nil {
+			// the follower is compared in the next round
+			s.Logger.Warn("get shard digest failed", zap.String("db", sh.OwnerDb), zap.Uint32("pt", p.ptID),
+				zap.Uint64("shard", p.shardID), zap.Error(err))
+			continue
+		}
+		var diffs []measurementDiff
+		var pending map[string]bool
+		if remote.Hash != local.Hash {
+			diffs = diffDigests(local, remote)
+			pending = pendingMeasurements(local, remote)
+		}
+		s.dropRepaired(sh.OwnerDb, sh.ShardID, p.ptID, diffs, pending)
+		for _, d := range diffs {
+			key := repairKey{db: sh.OwnerDb, shardID: sh.ShardID, mst: d.Name, peer: p.ptID}
+			if info := s.detect(key, d, sh.OwnerPt); info != nil {
+				s.repair(sh, p, d.Ranges, info)
+			}
+		}
+	}
+	return nil
+}
+
+// peers returns the online followers of the partition and their shards in the shard group of the shard
+func (s *Service) peers(sh *meta.ShardIdentifier) ([]peer, error) {
+	ptView, err := s.MetaClient.DBPtView(sh.OwnerDb)
+	if err != nil {
+		return nil, err
+	}
+	_, _, sgi := s.MetaClient.ShardOwner(sh.ShardID)
+	if sgi == nil {
+		return nil, errno.NewError(errno.ShardMetaNotFound, sh.ShardID)
+	}
+
+	var peers []peer
+	for _, rg := range s.MetaClient.DBRepGroups(sh.OwnerDb) {
+		if rg.MasterPtID != sh.OwnerPt {
+			continue
+		}
+		for _, p := range rg.Peers {
+			if int(p.ID) >= len(ptView) || ptView[p.ID].Status != meta.Online {
+				continue
+			}
+			shardID, ok := ownedShard(sgi, p.ID)
+			if !ok {
+				continue
+			}
+			peers = append(peers, peer{ptID: p.ID, nodeID: ptView[p.ID].Owner.NodeID, shardID: shardID})
+		}
+	}
+	return peers, nil
+}
+
+// ownedShard returns the shard of the shard group which is owned by the partition
+func ownedShard(sgi *meta.ShardGroupInfo, pt uint32) (uint64, bool) {
+	if int(pt) < len(sgi.Shards) && len(sgi.Shards[pt].Owners) > 0 && sgi.Shards[pt].Owners[0] == pt {
+		return sgi.Shards[pt].ID, true
+	}
+	for i := range sgi.Shards {
+		if len(sgi.Shards[i].Owners) > 0 && sgi.Shards[i].Owners[0] == pt {
+			return sgi.Shards[i].ID, true
+		}
+	}
+	return 0, false
+}
+
+type measurementDiff struct {
+	Name       string
+	LocalHash  uint64
+	RemoteHash uint64
+	Ranges     []util.TimeRange
+}
+
+// diffDigests returns the measurements whose leaves are different in the digests and their diverged time ranges.
+// The measurements pending on either replica are skipped.
+func diffDigests(local, remote *netstorage.ShardDigest) []measurementDiff {
+	remoteMsts := make(map[string]*netstorage.MeasurementDigest, len(remote.Measurements))
+	for _, m := range remote.Measurements {
+		remoteMsts[m.Name] = m
+	}
+
+	var diffs []measurementDiff
+	for _, m := range local.Measurements {
+		r, ok := remoteMsts[m.Name]
+		delete(remoteMsts, m.Name)
+		if m.Pending || (ok && (r.Pending || r.Hash == m.Hash)) {
+			continue
+		}
+		d := measurementDiff{Name: m.Name, LocalHash: m.Hash}
+		if ok {
+			d.RemoteHash = r.Hash
+			d.Ranges = diffRanges(m.Ranges, r.Ranges)
+		} else {
+			d.Ranges = diffRanges(m.Ranges, nil)
+		}
+		diffs = append(diffs, d)
+	}
+	for _, r := range remote.Measurements {
+		if _, ok := remoteMsts[r.Name]; ok && !r.Pending {
+			diffs = append(diffs, measurementDiff{Name: r.Name, RemoteHash: r.Hash, Ranges: diffRanges(nil, r.Ranges)})
+		}
+	}
+	return diffs
+}
+
+// pendingMeasurements returns the measurements pending on either replica, they are compared in the next rounds
+func pendingMeasurements(local, remote *netstorage.ShardDigest) map[string]bool {
+	pending := make(map[string]bool)
+	for _, d := range [2]*netstorage.ShardDigest{local, remote} {
+		for _, m := range d.Measurements {
+			if m.Pending {
+				pending[m.Name] = true
+			}
+		}
+	}
+	return pending
+}
+
+// diffRanges returns the time ranges whose digests are different, the ranges are sorted by the start time
+func diffRanges(local, remote []*netstorage.RangeDigest) []util.TimeRange {
+	var ranges []util.TimeRange
+	i, j := 0, 0
+	for i < len(local) || j < len(remote) {
+		switch {
+		case j == len(remote) || (i < len(local) && local[i].StartTime < remote[j].StartTime):
+			ranges = append(ranges, util.TimeRange{Min: local[i].StartTime, Max: local[i].EndTime})
+			i++
+		case i == len(local) || remote[j].StartTime < local[i].StartTime:
+			ranges = append(ranges, util.TimeRange{Min: remote[j].StartTime, Max: remote[j].EndTime})
+			j++
+		default:
+			if local[i].Hash != remote[j].Hash {
+				ranges = append(ranges, util.TimeRange{Min: local[i].StartTime, Max: local[i].EndTime})
+			}
+			i++
+			j++
+		}
+	}
+	return ranges
+}
+
+// detect records the divergence, and returns the repair if the divergence is confirmed.
+// A divergence which remains with the same digests after it is repaired is not repaired again, since the rows on
+// the follower which are not on the leader can not be removed by streaming the rows of the leader.
+func (s *Service) detect(key repairKey, d measurementDiff, leader uint32) *netstorage.RepairInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UnixNano()
+	hashes := [2]uint64{d.LocalHash, d.RemoteHash}
+	dv, ok := s.divergences[key]
+	if !ok {
+		info := &netstorage.RepairInfo{
+			Db:          key.db,
+			PtId:        leader,
+			ShardID:     key.shardID,
+			Measurement: key.mst,
+			PeerPtId:    key.peer,
+			State:       StateDetected,
+			DetectTime:  now,
+			UpdateTime:  now,
+		}
+		dv = &divergence{info: info, hashes: hashes}
+		s.divergences[key] = dv
+		s.appendHistory(info)
+	}
+	if dv.hashes != hashes {
+		// the replicas are still changing, the divergence is confirmed again
+		dv.hashes = hashes
+		dv.rounds = 0
+		dv.info.State = StateDetected
+		dv.info.Error = ""
+	}
+	dv.rounds++
+	dv.seen = s.round
+	dv.info.Ranges = uint32(len(d.Ranges))
+	if dv.rounds < s.Config.ConfirmRounds {
+		return nil
+	}
+	if repaired, ok := s.repaired[key]; ok && repaired == hashes {
+		// the divergence is kept, so that it is not detected again in the next rounds
+		if dv.info.State != StateFailed {
+			dv.info.State = StateFailed
+			dv.info.Error = "the divergence remains after the repair, the follower has rows which are not on the leader"
+			dv.info.UpdateTime = now
+		}
+		return nil
+	}
+
+	delete(s.divergences, key)
+	s.repaired[key] = hashes
+	dv.info.State = StateRepairing
+	dv.info.UpdateTime = now
+	c := *dv.info
+	return &c
+}
+
+// dropRepaired drops the digests of the repaired divergences of the follower which are not found any more.
+// The measurements pending on either replica are not compared, so their repairs and divergences are kept,
+// e.g. the repaired rows are written into the out-of-order files of the follower, which are pending until they are merged.
+func (s *Service) dropRepaired(db string, shardID uint64, peer uint32, diffs []measurementDiff, pending map[string]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, dv := range s.divergences {
+		if key.db == db && key.shardID == shardID && key.peer == peer && pending[key.mst] {
+			dv.seen = s.round
+		}
+	}
+	for key := range s.repaired {
+		if key.db != db || key.shardID != shardID || key.peer != peer || pending[key.mst] {
+			continue
+		}
+		found := false
+		for i := range diffs {
+			if diffs[i].Name == key.mst {
+				found = true
+				break
+			}
+		}
+		if !found {
+			delete(s.repaired, key)
+		}
+	}
+}
+
+func (s *Service) repair(sh *meta.ShardIdentifier, p peer, ranges []util.TimeRange, info *netstorage.RepairInfo) {
+	s.Logger.Info("repair replica", zap.String("db", info.Db), zap.Uint32("pt", info.PtId), zap.Uint64("shard", info.ShardID),
+		zap.String("measurement", info.Measurement), zap.Uint32("peer", info.PeerPtId), zap.Int("ranges", len(ranges)))
+
+	rows, err := s.Engine.ScanShardRows(sh.OwnerDb, sh.OwnerPt, sh.ShardID, info.Measurement, ranges, func(rows []byte) error {
+		_, err := s.Store.RepairShard(p.nodeID, sh.OwnerDb, sh.Policy, p.ptID, p.shardID, rows)
+		return err
+	})
+
+	info.Rows = rows
+	info.State = StateRepaired
+	if err != nil {
+		info.State = StateFailed
+		info.Error = err.Error()
+		s.Logger.Error("repair replica failed", zap.String("db", info.Db), zap.Uint64("shard", info.ShardID),
+			zap.String("measurement", info.Measurement), zap.Error(err))
+	}
+	s.finish(info)
+}
+
+// dropConverged drops the divergences which are not found in this round
+func (s *Service) dropConverged() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, dv := range s.divergences {
+		if dv.seen == s.round {
+			continue
+		}
+		delete(s.divergences, key)
+		c := *dv.info
+		c.State = StateConverged
+		s.finishNoLock(&c)
+	}
+}
+
+func (s *Service) finish(info *netstorage.RepairInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.finishNoLock(info)
+}
+
+func (s *Service) finishNoLock(info *netstorage.RepairInfo) {
+	info.UpdateTime = time.Now().UnixNano()
+	for i := range s.history {
+		h := s.history[i]
+		if h.Db == info.Db && h.ShardID == info.ShardID && h.Measurement == info.Measurement &&
+			h.PeerPtId == info.PeerPtId && h.DetectTime == info.DetectTime {
+			s.history[i] = info
+			return
+		}
+	}
+	s.appendHistory(info)
+}
+
+func (s *Service) appendHistory(info *netstorage.RepairInfo) {
+	s.history = append(s.history, info)
+	if len(s.history) > maxRepairHistory {
+		s.history = append(s.history[:0], s.history[len(s.history)-maxRepairHistory:]...)
+	}
+}
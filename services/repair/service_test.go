@@ -0,0 +1,295 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repair
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMetaClient struct{}
+
+func (m *mockMetaClient) IsMasterPt(ptId uint32, database string) bool {
+	return ptId == 0
+}
+
+func (m *mockMetaClient) DBPtView(database string) (meta.DBPtInfos, error) {
+	return meta.DBPtInfos{
+		{PtId: 0, Owner: meta.PtOwner{NodeID: 1}, Status: meta.Online},
+		{PtId: 1, Owner: meta.PtOwner{NodeID: 2}, Status: meta.Online},
+		{PtId: 2, Owner: meta.PtOwner{NodeID: 3}, Status: meta.Offline},
+	}, nil
+}
+
+func (m *mockMetaClient) DBRepGroups(database string) []meta.ReplicaGroup {
+	return []meta.ReplicaGroup{{
+		MasterPtID: 0,
+		Peers:      []meta.Peer{{ID: 1, PtRole: meta.Slave}, {ID: 2, PtRole: meta.Slave}},
+	}}
+}
+
+func (m *mockMetaClient) ShardOwner(shardID uint64) (string, string, *meta.ShardGroupInfo) {
+	return "db0", "rp0", &meta.ShardGroupInfo{Shards: []meta.ShardInfo{
+		{ID: 11, Owners: []uint32{0}},
+		{ID: 12, Owners: []uint32{1}},
+		{ID: 13, Owners: []uint32{2}},
+	}}
+}
+
+type mockEngine struct {
+	digest  *netstorage.ShardDigest
+	scanned map[string][]util.TimeRange
+}
+
+func (e *mockEngine) FetchShardsNeedRepair(coldDuration time.Duration) []*meta.ShardIdentifier {
+	return []*meta.ShardIdentifier{
+		{ShardID: 11, OwnerDb: "db0", Policy: "rp0", OwnerPt: 0},
+		{ShardID: 12, OwnerDb: "db0", Policy: "rp0", OwnerPt: 1},
+	}
+}
+
+func (e *mockEngine) ShardDigest(db string, ptId uint32, shardID uint64, interval int64) (*netstorage.ShardDigest, error) {
+	return e.digest, nil
+}
+
+func (e *mockEngine) ScanShardRows(db string, ptId uint32, shardID uint64, mst string, ranges []util.TimeRange,
+	fn func(rows []byte) error) (int64, error) {
+	if e.scanned == nil {
+		e.scanned = make(map[string][]util.TimeRange)
+	}
+	e.scanned[mst] = append(e.scanned[mst], ranges...)
+	return 10, fn([]byte(mst))
+}
+
+type mockStore struct {
+	digest  *netstorage.ShardDigest
+	repairs []string
+	nodes   []uint64
+}
+
+func (s *mockStore) ShardDigest(nodeID uint64, db string, pt uint32, shardID uint64, interval int64) (*netstorage.ShardDigest, error) {
+	s.nodes = append(s.nodes, nodeID)
+	return s.digest, nil
+}
+
+func (s *mockStore) RepairShard(nodeID uint64, db, rp string, pt uint32, shardID uint64, rows []byte) (int64, error) {
+	if nodeID != 2 || pt != 1 || shardID != 12 {
+		return 0, errors.New("unexpected shard")
+	}
+	s.repairs = append(s.repairs, string(rows))
+	if string(rows) == "mem" {
+		return 0, errors.New("write rows failed")
+	}
+	return 10, nil
+}
+
+func newMeasurement(name string, ranges ...*netstorage.RangeDigest) *netstorage.MeasurementDigest {
+	m := &netstorage.MeasurementDigest{Name: name, Ranges: ranges}
+	for _, r := range ranges {
+		m.Hash = m.Hash*31 + r.Hash
+		m.Rows += r.Rows
+	}
+	return m
+}
+
+func newRange(start int64, hash uint64) *netstorage.RangeDigest {
+	return &netstorage.RangeDigest{StartTime: start, EndTime: start + 9, Series: 1, Rows: 10, Hash: hash}
+}
+
+func newDigest(msts ...*netstorage.MeasurementDigest) *netstorage.ShardDigest {
+	d := &netstorage.ShardDigest{Measurements: msts}
+	for _, m := range msts {
+		d.Hash = d.Hash*31 + m.Hash
+	}
+	return d
+}
+
+func newTestService() (*Service, *mockEngine, *mockStore) {
+	e := &mockEngine{}
+	store := &mockStore{}
+	s := NewService(config.NewRepairConfig())
+	s.MetaClient = &mockMetaClient{}
+	s.Engine = e
+	s.Store = store
+	return s, e, store
+}
+
+func TestService_Repair(t *testing.T) {
+	s, e, store := newTestService()
+	e.digest = newDigest(
+		newMeasurement("cpu", newRange(0, 1), newRange(10, 2), newRange(20, 3)),
+		newMeasurement("disk", newRange(0, 4)),
+		newMeasurement("mem", newRange(0, 5)),
+	)
+	store.digest = newDigest(
+		newMeasurement("cpu", newRange(0, 1), newRange(10, 6)),
+		newMeasurement("disk", newRange(0, 4)),
+		newMeasurement("mem", newRange(0, 7)),
+	)
+
+	// the divergences are reported but not repaired in the first round
+	s.handle()
+	require.Equal(t, []uint64{2}, store.nodes)
+	repairs := s.Repairs()
+	require.Equal(t, 2, len(repairs))
+	for _, r := range repairs {
+		require.Equal(t, StateDetected, r.State)
+		require.Equal(t, uint32(1), r.PeerPtId)
+	}
+	require.Equal(t, 0, len(store.repairs))
+
+	// only the rows of the leader in the diverged time ranges are streamed to the follower
+	s.handle()
+	require.Equal(t, map[string][]util.TimeRange{
+		"cpu": {{Min: 10, Max: 19}, {Min: 20, Max: 29}},
+		"mem": {{Min: 0, Max: 9}},
+	}, e.scanned)
+	require.Equal(t, []string{"cpu", "mem"}, store.repairs)
+	repairs = s.Repairs()
+	require.Equal(t, 2, len(repairs))
+	require.Equal(t, "cpu", repairs[0].Measurement)
+	require.Equal(t, StateRepaired, repairs[0].State)
+	require.Equal(t, uint32(2), repairs[0].Ranges)
+	require.Equal(t, int64(10), repairs[0].Rows)
+	require.Equal(t, "mem", repairs[1].Measurement)
+	require.Equal(t, StateFailed, repairs[1].State)
+	require.Equal(t, "write rows failed", repairs[1].Error)
+}
+
+func TestService_DivergenceRemains(t *testing.T) {
+	s, e, store := newTestService()
+	s.Config.ConfirmRounds = 1
+	e.digest = newDigest(newMeasurement("cpu", newRange(0, 1)))
+	// the follower has the rows which are not on the leader
+	store.digest = newDigest(newMeasurement("cpu", newRange(0, 2), newRange(10, 3)))
+
+	s.handle()
+	require.Equal(t, []string{"cpu"}, store.repairs)
+
+	// the divergence is not repaired again with the same digests
+	s.handle()
+	s.handle()
+	require.Equal(t, []string{"cpu"}, store.repairs)
+	repairs := s.Repairs()
+	require.Equal(t, 2, len(repairs))
+	require.Equal(t, StateRepaired, repairs[0].State)
+	require.Equal(t, StateFailed, repairs[1].State)
+	require.Contains(t, repairs[1].Error, "remains after the repair")
+
+	// the replicas converge
+	store.digest = e.digest
+	s.handle()
+	require.Equal(t, StateConverged, s.Repairs()[1].State)
+	require.Equal(t, 0, len(s.divergences))
+	require.Equal(t, 0, len(s.repaired))
+}
+
+func TestService_DivergenceRemainsAfterPending(t *testing.T) {
+	s, e, store := newTestService()
+	s.Config.ConfirmRounds = 1
+	e.digest = newDigest(newMeasurement("cpu", newRange(0, 1)))
+	diverged := newDigest(newMeasurement("cpu", newRange(0, 2), newRange(10, 3)))
+	store.digest = diverged
+
+	s.handle()
+	require.Equal(t, []string{"cpu"}, store.repairs)
+
+	// the repaired rows are in the out-of-order files of the follower until they are merged
+	pending := newDigest(newMeasurement("cpu"))
+	pending.Measurements[0].Pending = true
+	pending.Hash = 100
+	store.digest = pending
+	s.handle()
+	s.handle()
+	require.Equal(t, 1, len(s.repaired))
+
+	// the same divergence is found after the merge, it is not repaired again
+	store.digest = diverged
+	s.handle()
+	s.handle()
+	require.Equal(t, []string{"cpu"}, store.repairs)
+	repairs := s.Repairs()
+	require.Equal(t, 2, len(repairs))
+	require.Equal(t, StateFailed, repairs[1].State)
+
+	// the divergence is not reported as converged while the follower is pending
+	store.digest = pending
+	s.handle()
+	require.Equal(t, StateFailed, s.Repairs()[1].State)
+	require.Equal(t, 1, len(s.divergences))
+}
+
+func TestService_Converged(t *testing.T) {
+	s, e, store := newTestService()
+	e.digest = newDigest(newMeasurement("cpu", newRange(0, 1)))
+	store.digest = newDigest(newMeasurement("cpu", newRange(0, 2)))
+	s.handle()
+
+	// the follower changes, the divergence is confirmed again
+	store.digest = newDigest(newMeasurement("cpu", newRange(0, 3)))
+	s.handle()
+	require.Equal(t, 0, len(store.repairs))
+	require.Equal(t, StateDetected, s.Repairs()[0].State)
+
+	// the follower catches up with the leader
+	store.digest = e.digest
+	s.handle()
+	repairs := s.Repairs()
+	require.Equal(t, 1, len(repairs))
+	require.Equal(t, StateConverged, repairs[0].State)
+	require.Equal(t, 0, len(s.divergences))
+	require.Equal(t, 0, len(store.repairs))
+}
+
+func TestDiffDigests(t *testing.T) {
+	local := newDigest(
+		newMeasurement("cpu", newRange(0, 1), newRange(20, 2)),
+		newMeasurement("disk", newRange(0, 3)),
+		newMeasurement("io", newRange(0, 4)),
+		&netstorage.MeasurementDigest{Name: "net", Pending: true},
+	)
+	remote := newDigest(
+		newMeasurement("cpu", newRange(10, 5), newRange(20, 6)),
+		newMeasurement("disk", newRange(0, 3)),
+		&netstorage.MeasurementDigest{Name: "io", Pending: true},
+		newMeasurement("mem", newRange(0, 7)),
+		newMeasurement("net", newRange(0, 8)),
+	)
+	cpu, mem := local.Measurements[0], remote.Measurements[3]
+	require.Equal(t, []measurementDiff{
+		{Name: "cpu", LocalHash: cpu.Hash, RemoteHash: remote.Measurements[0].Hash,
+			Ranges: []util.TimeRange{{Min: 0, Max: 9}, {Min: 10, Max: 19}, {Min: 20, Max: 29}}},
+		{Name: "mem", RemoteHash: mem.Hash, Ranges: []util.TimeRange{{Min: 0, Max: 9}}},
+	}, diffDigests(local, remote))
+}
+
+func TestOwnedShard(t *testing.T) {
+	sgi := &meta.ShardGroupInfo{Shards: []meta.ShardInfo{
+		{ID: 11, Owners: []uint32{1}},
+		{ID: 12, Owners: []uint32{0}},
+	}}
+	id, ok := ownedShard(sgi, 0)
+	require.True(t, ok)
+	require.Equal(t, uint64(12), id)
+	_, ok = ownedShard(sgi, 2)
+	require.False(t, ok)
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// appendLineProtocol appends the row in line protocol to dst. The float fields of NaN or Inf, which can not be
+// written by line protocol, are skipped, and so is the row without any other field.
+func appendLineProtocol(dst []byte, row *influx.Row) []byte {
+	start := len(dst)
+	dst = appendEscaped(dst, influx.GetOriginMstName(row.Name), false)
+	for i := range row.Tags {
+		if row.Tags[i].Value == "" {
+			continue
+		}
+		dst = append(dst, ',')
+		dst = appendEscaped(dst, row.Tags[i].Key, true)
+		dst = append(dst, '=')
+		dst = appendEscaped(dst, row.Tags[i].Value, true)
+	}
+
+	fields := 0
+	for i := range row.Fields {
+		f := &row.Fields[i]
+		if f.Type == influx.Field_Type_Float && (math.IsNaN(f.NumValue) || math.IsInf(f.NumValue, 0)) {
+			continue
+		}
+		if fields == 0 {
+			dst = append(dst, ' ')
+		} else {
+			dst = append(dst, ',')
+		}
+		fields++
+		dst = appendEscaped(dst, f.Key, true)
+		dst = append(dst, '=')
+		switch f.Type {
+		case influx.Field_Type_Int:
+			dst = strconv.AppendInt(dst, int64(f.NumValue), 10)
+			dst = append(dst, 'i')
+		case influx.Field_Type_UInt:
+			dst = strconv.AppendUint(dst, uint64(f.NumValue), 10)
+			dst = append(dst, 'u')
+		case influx.Field_Type_Boolean:
+			dst = strconv.AppendBool(dst, f.NumValue == 1)
+		case influx.Field_Type_String:
+			dst = appendQuoted(dst, f.StrValue)
+		default:
+			dst = strconv.AppendFloat(dst, f.NumValue, 'g', -1, 64)
+		}
+	}
+	if fields == 0 {
+		return dst[:start]
+	}
+
+	dst = append(dst, ' ')
+	dst = strconv.AppendInt(dst, row.Timestamp, 10)
+	return append(dst, '\n')
+}
+
+// appendEscaped escapes the commas and the spaces of the measurement, and the equal signs of the keys and the tag values
+func appendEscaped(dst []byte, s string, equal bool) []byte {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == ',' || c == ' ' || (equal && c == '=') {
+			dst = append(dst, '\\')
+		}
+		dst = append(dst, c)
+	}
+	return dst
+}
+
+func appendQuoted(dst []byte, s string) []byte {
+	dst = append(dst, '"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			dst = append(dst, '\\')
+		}
+		dst = append(dst, s[i])
+	}
+	return append(dst, '"')
+}
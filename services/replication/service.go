@@ -0,0 +1,363 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services"
+	"go.uber.org/zap"
+)
+
+type metaClient interface {
+	Databases() map[string]*meta.DatabaseInfo
+	IsMasterPt(ptId uint32, database string) bool
+	DBPtView(database string) (meta.DBPtInfos, error)
+	DBRepGroups(database string) []meta.ReplicaGroup
+	ShardOwner(shardID uint64) (database, policy string, sgi *meta.ShardGroupInfo)
+	UpdateReplicationCheckpoint(database string, shardID uint64, seq int64) error
+}
+
+type engine interface {
+	SetAsyncReplication(db string, enabled bool)
+	ReplicationSegments() []*netstorage.ReplicationSegment
+	ReplayReplicationSegment(seg *netstorage.ReplicationSegment, fn func(rows []influx.Row) error) (int64, error)
+	RemoveReplicationSegment(seg *netstorage.ReplicationSegment) error
+	DropReplicationArchive(db string) error
+}
+
+// Service ships the wal of the primary databases to the target clusters. The wal files are archived after they
+// are flushed, and each archived file is written to the target through the http write path in sequence order.
+// The sequence of the last shipped file of each shard is checkpointed in meta, so the shipping is resumed after
+// the restart or the failover of the partition without shipping the checkpointed files again. The replicas of
+// a partition share one checkpoint, the followers remove the files archived before the checkpoint of the master.
+type Service struct {
+	MetaClient metaClient
+	Engine     engine
+
+	Logger *logger.Logger
+
+	Config *config.AsyncReplicationConfig
+
+	base      services.Base
+	client    *http.Client
+	host      string
+	primaries map[string]struct{}
+}
+
+func NewService(c config.AsyncReplicationConfig) *Service {
+	host, _ := os.Hostname()
+	s := &Service{
+		Logger:    logger.NewLogger(errno.ModuleHA),
+		Config:    &c,
+		client:    &http.Client{Timeout: time.Duration(c.WriteTimeout)},
+		host:      host,
+		primaries: make(map[string]struct{}),
+	}
+	s.base.Init("async-replication", time.Duration(c.RunInterval), s.handle)
+	return s
+}
+
+func (s *Service) Open() error {
+	s.Logger.Info("service open", zap.Duration("RunInterval", time.Duration(s.Config.RunInterval)),
+		zap.Int("BatchRows", s.Config.BatchRows))
+	return s.base.Open()
+}
+
+func (s *Service) Close() error {
+	return s.base.Close()
+}
+
+func (s *Service) handle() {
+	primaries := make(map[string]*meta.DatabaseInfo)
+	for name, dbi := range s.MetaClient.Databases() {
+		if dbi.Replication.IsPrimary() && !dbi.MarkDeleted {
+			primaries[name] = dbi
+		}
+	}
+	for name := range primaries {
+		s.Engine.SetAsyncReplication(name, true)
+		s.primaries[name] = struct{}{}
+	}
+	for name := range s.primaries {
+		if _, ok := primaries[name]; !ok {
+			s.Engine.SetAsyncReplication(name, false)
+			statistics.ReplicationStat.Remove(name)
+			delete(s.primaries, name)
+		}
+	}
+
+	segments := make(map[string][]*netstorage.ReplicationSegment)
+	for _, seg := range s.Engine.ReplicationSegments() {
+		segments[seg.Db] = append(segments[seg.Db], seg)
+	}
+	for db, segs := range segments {
+		dbi, ok := primaries[db]
+		if ok {
+			s.shipDatabase(dbi, s.pruneArchive(db, segs))
+			continue
+		}
+		// the replication of the database has been dropped
+		if err := s.Engine.DropReplicationArchive(db); err != nil {
+			s.Logger.Warn("drop replication archive failed", zap.String("db", db), zap.Error(err))
+		}
+	}
+	s.updatePending(primaries)
+}
+
+// pruneArchive drops the oldest segments of the database until the archive is within the limits of the size and
+// the age, the dropped segments are never shipped. It returns the remaining segments in the original order.
+func (s *Service) pruneArchive(db string, segs []*netstorage.ReplicationSegment) []*netstorage.ReplicationSegment {
+	maxSize, maxAge := int64(s.Config.MaxArchiveSize), time.Duration(s.Config.MaxArchiveAge)
+	if maxSize <= 0 && maxAge <= 0 {
+		return segs
+	}
+	// the sequence of a segment is the time it is archived
+	oldest := make([]*netstorage.ReplicationSegment, len(segs))
+	copy(oldest, segs)
+	sort.SliceStable(oldest, func(i, j int) bool {
+		return oldest[i].Seq < oldest[j].Seq
+	})
+	var total int64
+	for _, seg := range segs {
+		total += seg.Size
+	}
+
+	now := time.Now().UnixNano()
+	dropped := make(map[*netstorage.ReplicationSegment]struct{})
+	var droppedBytes int64
+	for _, seg := range oldest {
+		if (maxSize <= 0 || total <= maxSize) && (maxAge <= 0 || now-seg.Seq <= int64(maxAge)) {
+			break
+		}
+		if err := s.Engine.RemoveReplicationSegment(seg); err != nil {
+			s.Logger.Warn("drop replication segment failed", zap.String("db", db), zap.String("path", seg.Path), zap.Error(err))
+			break
+		}
+		dropped[seg] = struct{}{}
+		total -= seg.Size
+		droppedBytes += seg.Size
+	}
+	if len(dropped) == 0 {
+		return segs
+	}
+	statistics.ReplicationStat.AddDropped(db, int64(len(dropped)), droppedBytes)
+	s.Logger.Warn("replication segments are dropped without being shipped", zap.String("db", db),
+		zap.Int("segments", len(dropped)), zap.Int64("bytes", droppedBytes))
+
+	remain := segs[:0:0]
+	for _, seg := range segs {
+		if _, ok := dropped[seg]; !ok {
+			remain = append(remain, seg)
+		}
+	}
+	return remain
+}
+
+// shipDatabase ships the segments of the database, which are sorted by shard and sequence
+func (s *Service) shipDatabase(dbi *meta.DatabaseInfo, segs []*netstorage.ReplicationSegment) {
+	for i := 0; i < len(segs); {
+		j := i + 1
+		for j < len(segs) && segs[j].ShardID == segs[i].ShardID {
+			j++
+		}
+		if err := s.shipShard(dbi, segs[i:j]); err != nil {
+			// the shard is shipped from the failed segment in the next round
+			statistics.ReplicationStat.AddError(dbi.Name)
+			s.Logger.Warn("ship wal segment failed", zap.String("db", dbi.Name), zap.Uint64("shard", segs[i].ShardID),
+				zap.String("target", dbi.Replication.Target), zap.Error(err))
+		}
+		i = j
+	}
+}
+
+func (s *Service) shipShard(dbi *meta.DatabaseInfo, segs []*netstorage.ReplicationSegment) error {
+	cpShard := s.checkpointShard(dbi, segs[0])
+	checkpoint := dbi.Replication.Checkpoint(cpShard)
+	// the followers of a replicated partition only remove the segments shipped by the master
+	follower := dbi.ReplicaN > 1 && !s.MetaClient.IsMasterPt(segs[0].PtId, dbi.Name)
+	for _, seg := range segs {
+		if seg.Seq <= checkpoint {
+			if err := s.Engine.RemoveReplicationSegment(seg); err != nil {
+				return err
+			}
+			continue
+		}
+		if follower {
+			return nil
+		}
+
+		rows, err := s.shipSegment(dbi, seg)
+		if err != nil {
+			return err
+		}
+		if err = s.MetaClient.UpdateReplicationCheckpoint(dbi.Name, cpShard, seg.Seq); err != nil {
+			return err
+		}
+		statistics.ReplicationStat.AddShipped(dbi.Name, 1, rows)
+		if err = s.Engine.RemoveReplicationSegment(seg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkpointShard returns the shard whose checkpoint is shared by the replicas of the partition of the segment.
+// The replicas of a replica group checkpoint the shard of the shard group owned by the smallest pt of the group,
+// so the checkpoint of the master is found by its followers and by the new master after the failover.
+func (s *Service) checkpointShard(dbi *meta.DatabaseInfo, seg *netstorage.ReplicationSegment) uint64 {
+	if dbi.ReplicaN <= 1 {
+		return seg.ShardID
+	}
+	ptView, err := s.MetaClient.DBPtView(dbi.Name)
+	if err != nil || int(seg.PtId) >= len(ptView) {
+		return seg.ShardID
+	}
+	groups := s.MetaClient.DBRepGroups(dbi.Name)
+	rgID := ptView[seg.PtId].RGID
+	if int(rgID) >= len(groups) {
+		return seg.ShardID
+	}
+	_, _, sgi := s.MetaClient.ShardOwner(seg.ShardID)
+	if sgi == nil {
+		return seg.ShardID
+	}
+
+	pt := groups[rgID].MasterPtID
+	for _, p := range groups[rgID].Peers {
+		pt = min(pt, p.ID)
+	}
+	for i := range sgi.Shards {
+		if len(sgi.Shards[i].Owners) > 0 && sgi.Shards[i].Owners[0] == pt {
+			return sgi.Shards[i].ID
+		}
+	}
+	return seg.ShardID
+}
+
+// shipSegment writes the rows of the segment to the target in batches. The records written by arrow flight are
+// not shipped, they are counted in the statistics of the database.
+func (s *Service) shipSegment(dbi *meta.DatabaseInfo, seg *netstorage.ReplicationSegment) (int64, error) {
+	var buf []byte
+	var total int64
+	batch := 0
+	skipped, err := s.Engine.ReplayReplicationSegment(seg, func(rows []influx.Row) error {
+		for i := range rows {
+			buf = appendLineProtocol(buf, &rows[i])
+			batch++
+			if batch < s.Config.BatchRows {
+				continue
+			}
+			if err := s.write(dbi.Replication.Target, dbi.Name, seg.Rp, buf); err != nil {
+				return err
+			}
+			total += int64(batch)
+			buf, batch = buf[:0], 0
+		}
+		return nil
+	})
+	if err != nil {
+		return total, err
+	}
+	if batch > 0 {
+		if err = s.write(dbi.Replication.Target, dbi.Name, seg.Rp, buf); err != nil {
+			return total, err
+		}
+		total += int64(batch)
+	}
+	if skipped > 0 {
+		statistics.ReplicationStat.AddSkipped(dbi.Name, skipped)
+	}
+	return total, nil
+}
+
+func (s *Service) write(target, db, rp string, body []byte) error {
+	u, err := url.Parse(target)
+	if err != nil {
+		return err
+	}
+	u.Path = path.Join(u.Path, "write")
+	params := u.Query()
+	params.Set("db", db)
+	params.Set("rp", rp)
+	params.Set("precision", "ns")
+	u.RawQuery = params.Encode()
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(meta.ReplicationHeader, s.host)
+	if s.Config.Username != "" {
+		req.SetBasicAuth(s.Config.Username, s.Config.Password)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("write to %s failed, status: %d, %s", target, resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// updatePending updates the lag of the primary databases, the lag is the age of the oldest segment not yet shipped
+func (s *Service) updatePending(primaries map[string]*meta.DatabaseInfo) {
+	type pending struct {
+		segments, bytes, oldest int64
+	}
+	pendings := make(map[string]*pending, len(primaries))
+	for name := range primaries {
+		pendings[name] = &pending{}
+	}
+	for _, seg := range s.Engine.ReplicationSegments() {
+		p, ok := pendings[seg.Db]
+		if !ok {
+			continue
+		}
+		p.segments++
+		p.bytes += seg.Size
+		if p.oldest == 0 || seg.Seq < p.oldest {
+			p.oldest = seg.Seq
+		}
+	}
+
+	now := time.Now().UnixNano()
+	for name, p := range pendings {
+		var lag int64
+		if p.oldest > 0 && now > p.oldest {
+			lag = int64(time.Duration(now - p.oldest).Seconds())
+		}
+		statistics.ReplicationStat.SetPending(name, p.segments, p.bytes, lag)
+	}
+}
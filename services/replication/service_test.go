@@ -0,0 +1,346 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMetaClient struct {
+	databases   map[string]*meta.DatabaseInfo
+	checkpoints map[uint64]int64
+	master      uint32
+	ptView      meta.DBPtInfos
+	groups      []meta.ReplicaGroup
+	sgi         *meta.ShardGroupInfo
+}
+
+func (m *mockMetaClient) Databases() map[string]*meta.DatabaseInfo {
+	return m.databases
+}
+
+func (m *mockMetaClient) IsMasterPt(ptId uint32, database string) bool {
+	return ptId == m.master
+}
+
+func (m *mockMetaClient) DBPtView(database string) (meta.DBPtInfos, error) {
+	return m.ptView, nil
+}
+
+func (m *mockMetaClient) DBRepGroups(database string) []meta.ReplicaGroup {
+	return m.groups
+}
+
+func (m *mockMetaClient) ShardOwner(shardID uint64) (string, string, *meta.ShardGroupInfo) {
+	return "", "", m.sgi
+}
+
+func (m *mockMetaClient) UpdateReplicationCheckpoint(database string, shardID uint64, seq int64) error {
+	m.checkpoints[shardID] = seq
+	return nil
+}
+
+type mockEngine struct {
+	mu         sync.Mutex
+	replicated map[string]bool
+	segments   []*netstorage.ReplicationSegment
+	dropped    []string
+	skipped    int64
+}
+
+func (e *mockEngine) SetAsyncReplication(db string, enabled bool) {
+	e.replicated[db] = enabled
+}
+
+func (e *mockEngine) ReplicationSegments() []*netstorage.ReplicationSegment {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]*netstorage.ReplicationSegment{}, e.segments...)
+}
+
+func (e *mockEngine) ReplayReplicationSegment(seg *netstorage.ReplicationSegment, fn func(rows []influx.Row) error) (int64, error) {
+	rows := []influx.Row{
+		{Name: "cpu_0000", Tags: influx.PointTags{{Key: "host", Value: "h1"}}, Timestamp: seg.Seq,
+			Fields: influx.Fields{{Key: "value", NumValue: 1, Type: influx.Field_Type_Float}}},
+		{Name: "cpu_0000", Tags: influx.PointTags{{Key: "host", Value: "h2"}}, Timestamp: seg.Seq,
+			Fields: influx.Fields{{Key: "value", NumValue: 2, Type: influx.Field_Type_Float}}},
+		{Name: "cpu_0000", Tags: influx.PointTags{{Key: "host", Value: "h3"}}, Timestamp: seg.Seq,
+			Fields: influx.Fields{{Key: "value", NumValue: 3, Type: influx.Field_Type_Float}}},
+	}
+	return e.skipped, fn(rows)
+}
+
+func (e *mockEngine) RemoveReplicationSegment(seg *netstorage.ReplicationSegment) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i := range e.segments {
+		if e.segments[i] == seg {
+			e.segments = append(e.segments[:i], e.segments[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (e *mockEngine) DropReplicationArchive(db string) error {
+	e.dropped = append(e.dropped, db)
+	return nil
+}
+
+// newTestConfig returns the config without the age limit of the archive, the segments of the tests are archived
+// at the beginning of the epoch
+func newTestConfig() config.AsyncReplicationConfig {
+	conf := config.NewAsyncReplicationConfig()
+	conf.MaxArchiveAge = 0
+	return conf
+}
+
+func TestAppendLineProtocol(t *testing.T) {
+	row := &influx.Row{
+		Name: "cpu,a b_0000",
+		Tags: influx.PointTags{
+			{Key: "host", Value: "h=1"},
+			{Key: "empty", Value: ""},
+		},
+		Fields: influx.Fields{
+			{Key: "f", NumValue: 1.5, Type: influx.Field_Type_Float},
+			{Key: "i", NumValue: -2, Type: influx.Field_Type_Int},
+			{Key: "u", NumValue: 3, Type: influx.Field_Type_UInt},
+			{Key: "b", NumValue: 1, Type: influx.Field_Type_Boolean},
+			{Key: "s", StrValue: `say "hi"\`, Type: influx.Field_Type_String},
+			{Key: "nan", NumValue: math.NaN(), Type: influx.Field_Type_Float},
+		},
+		Timestamp: 100,
+	}
+	got := string(appendLineProtocol(nil, row))
+	assert.Equal(t, `cpu\,a\ b,host=h\=1 f=1.5,i=-2i,u=3u,b=true,s="say \"hi\"\\" 100`+"\n", got)
+
+	// the row without any field which can be written is skipped
+	row.Fields = row.Fields[5:]
+	assert.Equal(t, "head", string(appendLineProtocol([]byte("head"), row)))
+}
+
+func TestService_Ship(t *testing.T) {
+	var bodies []string
+	var headers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", user)
+		assert.Equal(t, "pwd", password)
+		assert.Equal(t, "/write", r.URL.Path)
+		assert.Equal(t, "db0", r.URL.Query().Get("db"))
+		assert.Equal(t, "rp0", r.URL.Query().Get("rp"))
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		headers = append(headers, r.Header.Get(meta.ReplicationHeader))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	mc := &mockMetaClient{
+		databases: map[string]*meta.DatabaseInfo{
+			"db0": {Name: "db0", ReplicaN: 1, Replication: &meta.ReplicationInfo{
+				Target: server.URL, Role: meta.ReplicationPrimary,
+				Checkpoints: map[uint64]meta.ReplicationCheckpoint{1: {Seq: 10}},
+			}},
+			"db1": {Name: "db1", ReplicaN: 1},
+		},
+		checkpoints: make(map[uint64]int64),
+	}
+	eng := &mockEngine{
+		replicated: make(map[string]bool),
+		skipped:    1,
+		segments: []*netstorage.ReplicationSegment{
+			{Db: "db0", Rp: "rp0", ShardID: 1, Seq: 10, Size: 1},
+			{Db: "db0", Rp: "rp0", ShardID: 1, Seq: 20, Size: 1},
+			{Db: "db0", Rp: "rp0", ShardID: 2, Seq: 30, Size: 1},
+			{Db: "db1", Rp: "rp0", ShardID: 3, Seq: 40, Size: 1},
+		},
+	}
+	conf := newTestConfig()
+	conf.BatchRows = 2
+	conf.Username, conf.Password = "admin", "pwd"
+	s := NewService(conf)
+	s.MetaClient = mc
+	s.Engine = eng
+	statistics.InitReplicationStatistics(nil)
+
+	s.handle()
+	assert.Equal(t, map[string]bool{"db0": true}, eng.replicated)
+	assert.Equal(t, []string{"db1"}, eng.dropped)
+	// the checkpointed segment is removed without shipping
+	assert.Equal(t, map[uint64]int64{1: 20, 2: 30}, mc.checkpoints)
+	require.Equal(t, 4, len(bodies))
+	assert.Equal(t, "cpu,host=h1 value=1 20\ncpu,host=h2 value=2 20\n", bodies[0])
+	assert.Equal(t, "cpu,host=h3 value=3 20\n", bodies[1])
+	assert.Equal(t, s.host, headers[0])
+	require.Equal(t, 1, len(eng.segments))
+	assert.Equal(t, "db1", eng.segments[0].Db)
+
+	stat, ok := statistics.ReplicationStat.Get("db0")
+	require.True(t, ok)
+	assert.Equal(t, int64(2), stat.ShippedSegments)
+	assert.Equal(t, int64(6), stat.ShippedRows)
+	// the records written by arrow flight are counted for the shipped segments
+	assert.Equal(t, int64(2), stat.SkippedRecords)
+	assert.Equal(t, int64(0), stat.PendingSegments)
+
+	// the replication is dropped
+	mc.databases["db0"].Replication = nil
+	s.handle()
+	assert.Equal(t, map[string]bool{"db0": false}, eng.replicated)
+	_, ok = statistics.ReplicationStat.Get("db0")
+	assert.False(t, ok)
+}
+
+func TestService_ShipFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":"database \"db0\" is a replication standby"}`))
+	}))
+	defer server.Close()
+
+	mc := &mockMetaClient{
+		databases: map[string]*meta.DatabaseInfo{
+			"db0": {Name: "db0", ReplicaN: 3, Replication: &meta.ReplicationInfo{Target: server.URL, Role: meta.ReplicationPrimary}},
+		},
+		checkpoints: make(map[uint64]int64),
+	}
+	eng := &mockEngine{
+		replicated: make(map[string]bool),
+		segments: []*netstorage.ReplicationSegment{
+			{Db: "db0", Rp: "rp0", PtId: 0, ShardID: 1, Seq: 10, Size: 1},
+			{Db: "db0", Rp: "rp0", PtId: 0, ShardID: 1, Seq: 20, Size: 1},
+			// the segment of the follower is kept until the master ships it
+			{Db: "db0", Rp: "rp0", PtId: 1, ShardID: 2, Seq: 30, Size: 1},
+		},
+	}
+	s := NewService(newTestConfig())
+	s.MetaClient = mc
+	s.Engine = eng
+	statistics.InitReplicationStatistics(nil)
+
+	s.handle()
+	assert.Equal(t, 0, len(mc.checkpoints))
+	assert.Equal(t, 3, len(eng.segments))
+	stat, _ := statistics.ReplicationStat.Get("db0")
+	assert.Equal(t, int64(1), stat.Errors)
+	assert.Equal(t, int64(3), stat.PendingSegments)
+	assert.Equal(t, int64(3), stat.PendingBytes)
+
+	err := s.write(server.URL, "db0", "rp0", []byte("cpu value=1"))
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "replication standby"))
+}
+
+func TestService_ShipReplicas(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	mc := &mockMetaClient{
+		databases: map[string]*meta.DatabaseInfo{
+			"db0": {Name: "db0", ReplicaN: 2, Replication: &meta.ReplicationInfo{
+				Target: server.URL, Role: meta.ReplicationPrimary,
+				Checkpoints: map[uint64]meta.ReplicationCheckpoint{1: {Seq: 20}},
+			}},
+		},
+		checkpoints: make(map[uint64]int64),
+		ptView:      meta.DBPtInfos{{PtId: 0, RGID: 0}, {PtId: 1, RGID: 0}},
+		groups:      []meta.ReplicaGroup{{ID: 0, MasterPtID: 0, Peers: []meta.Peer{{ID: 1, PtRole: meta.Slave}}}},
+		sgi:         &meta.ShardGroupInfo{ID: 1, Shards: []meta.ShardInfo{{ID: 1, Owners: []uint32{0}}, {ID: 2, Owners: []uint32{1}}}},
+	}
+	eng := &mockEngine{
+		replicated: make(map[string]bool),
+		segments: []*netstorage.ReplicationSegment{
+			{Db: "db0", Rp: "rp0", PtId: 0, ShardID: 1, Seq: 10, Size: 1},
+			{Db: "db0", Rp: "rp0", PtId: 0, ShardID: 1, Seq: 30, Size: 1},
+			{Db: "db0", Rp: "rp0", PtId: 1, ShardID: 2, Seq: 15, Size: 1},
+			{Db: "db0", Rp: "rp0", PtId: 1, ShardID: 2, Seq: 25, Size: 1},
+		},
+	}
+	s := NewService(newTestConfig())
+	s.MetaClient = mc
+	s.Engine = eng
+	statistics.InitReplicationStatistics(nil)
+
+	// the follower removes the segments archived before the checkpoint of the master
+	s.handle()
+	assert.Equal(t, map[uint64]int64{1: 30}, mc.checkpoints)
+	require.Equal(t, 1, len(eng.segments))
+	assert.Equal(t, int64(25), eng.segments[0].Seq)
+
+	// the new master resumes from the checkpoint of the old master after the failover
+	mc.master = 1
+	mc.groups[0] = meta.ReplicaGroup{ID: 0, MasterPtID: 1, Peers: []meta.Peer{{ID: 0, PtRole: meta.Slave}}}
+	mc.databases["db0"].Replication.Checkpoints[1] = meta.ReplicationCheckpoint{Seq: 30}
+	eng.segments = append(eng.segments, &netstorage.ReplicationSegment{Db: "db0", Rp: "rp0", PtId: 1, ShardID: 2, Seq: 35, Size: 1})
+	s.handle()
+	assert.Equal(t, map[uint64]int64{1: 35}, mc.checkpoints)
+	assert.Equal(t, 0, len(eng.segments))
+	stat, _ := statistics.ReplicationStat.Get("db0")
+	assert.Equal(t, int64(2), stat.ShippedSegments)
+}
+
+func TestService_PruneArchive(t *testing.T) {
+	now := time.Now().UnixNano()
+	eng := &mockEngine{
+		replicated: make(map[string]bool),
+		segments: []*netstorage.ReplicationSegment{
+			{Db: "db0", Rp: "rp0", ShardID: 1, Seq: now - int64(2*time.Hour), Size: 10},
+			{Db: "db0", Rp: "rp0", ShardID: 1, Seq: now - 2, Size: 10},
+			{Db: "db0", Rp: "rp0", ShardID: 2, Seq: now - 3, Size: 10},
+			{Db: "db0", Rp: "rp0", ShardID: 2, Seq: now - 1, Size: 10},
+		},
+	}
+	conf := config.NewAsyncReplicationConfig()
+	conf.MaxArchiveSize = 20
+	conf.MaxArchiveAge = toml.Duration(time.Hour)
+	s := NewService(conf)
+	s.Engine = eng
+	statistics.InitReplicationStatistics(nil)
+
+	// the aged segment and the oldest segment beyond the size are dropped
+	segs := s.pruneArchive("db0", eng.ReplicationSegments())
+	require.Equal(t, 2, len(segs))
+	assert.Equal(t, now-2, segs[0].Seq)
+	assert.Equal(t, now-1, segs[1].Seq)
+	assert.Equal(t, 2, len(eng.segments))
+	stat, _ := statistics.ReplicationStat.Get("db0")
+	assert.Equal(t, int64(2), stat.DroppedSegments)
+	assert.Equal(t, int64(20), stat.DroppedBytes)
+
+	// no limit
+	s.Config.MaxArchiveSize, s.Config.MaxArchiveAge = 0, 0
+	assert.Equal(t, 2, len(s.pruneArchive("db0", eng.ReplicationSegments())))
+}
@@ -53,7 +53,8 @@ func init() {
 			&Query{
 				name:    "show continuous query should succeed",
 				command: `SHOW CONTINUOUS QUERIES`,
-				exp:     `{"results":[{"statement_id":0,"series":[{"name":"db0","columns":["name","query"],"values":[["cq0_1","CREATE CONTINUOUS QUERY cq0_1 ON db0 RESAMPLE EVERY 1h FOR 90m BEGIN SELECT mean(passengers) INTO db0.autogen.average_passengers FROM db0.autogen.bus_data GROUP BY time(30m) END"]]},{"name":"db1","columns":["name","query"],"values":[["cq1_1","CREATE CONTINUOUS QUERY cq1_1 ON db1 RESAMPLE EVERY 1h FOR 90m BEGIN SELECT min(passengers) INTO db1.autogen.min_passengers FROM db1.autogen.bus_data GROUP BY time(15m) END"]]},{"name":"db2","columns":["name","query"]}]}]}`,
+				exp:     `^\{"results":\[\{"statement_id":0,"series":\[\{"name":"db0","columns":\["name","query","owner","last_run","lag","last_error"\],"values":\[\["cq0_1","CREATE\ CONTINUOUS\ QUERY\ cq0_1\ ON\ db0\ RESAMPLE\ EVERY\ 1h\ FOR\ 90m\ BEGIN\ SELECT\ mean\(passengers\)\ INTO\ db0\.autogen\.average_passengers\ FROM\ db0\.autogen\.bus_data\ GROUP\ BY\ time\(30m\)\ END","[^"]*","[^"]*","[^"]*","[^"]*"\]\]\},\{"name":"db1","columns":\["name","query","owner","last_run","lag","last_error"\],"values":\[\["cq1_1","CREATE\ CONTINUOUS\ QUERY\ cq1_1\ ON\ db1\ RESAMPLE\ EVERY\ 1h\ FOR\ 90m\ BEGIN\ SELECT\ min\(passengers\)\ INTO\ db1\.autogen\.min_passengers\ FROM\ db1\.autogen\.bus_data\ GROUP\ BY\ time\(15m\)\ END","[^"]*","[^"]*","[^"]*","[^"]*"\]\]\},\{"name":"db2","columns":\["name","query","owner","last_run","lag","last_error"\]\}\]\}\]\}$`,
+				pattern: true,
 			},
 		},
 	}
@@ -83,7 +84,8 @@ func init() {
 			&Query{
 				name:    `show continuous queries`,
 				command: `SHOW CONTINUOUS QUERIES`,
-				exp:     `{"results":[{"statement_id":0,"series":[{"name":"db0","columns":["name","query"],"values":[["cq1","CREATE CONTINUOUS QUERY cq1 ON db0 BEGIN SELECT min(value) INTO db0.rp1.min_value FROM db0.rp0.cpu GROUP BY time(5s) END"],["cq2","CREATE CONTINUOUS QUERY cq2 ON db0 BEGIN SELECT max(value) INTO db0.rp2.max_value FROM db0.rp0.cpu GROUP BY time(5s) END"]]}]}]}`,
+				exp:     `^\{"results":\[\{"statement_id":0,"series":\[\{"name":"db0","columns":\["name","query","owner","last_run","lag","last_error"\],"values":\[\["cq1","CREATE\ CONTINUOUS\ QUERY\ cq1\ ON\ db0\ BEGIN\ SELECT\ min\(value\)\ INTO\ db0\.rp1\.min_value\ FROM\ db0\.rp0\.cpu\ GROUP\ BY\ time\(5s\)\ END","[^"]*","[^"]*","[^"]*","[^"]*"\],\["cq2","CREATE\ CONTINUOUS\ QUERY\ cq2\ ON\ db0\ BEGIN\ SELECT\ max\(value\)\ INTO\ db0\.rp2\.max_value\ FROM\ db0\.rp0\.cpu\ GROUP\ BY\ time\(5s\)\ END","[^"]*","[^"]*","[^"]*","[^"]*"\]\]\}\]\}\]\}$`,
+				pattern: true,
 			},
 		},
 	}